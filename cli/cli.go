@@ -17,6 +17,7 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/events/logger"
 	"github.com/NordSecurity/nordvpn-linux/events/subs"
 	filesharepb "github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/gateway"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	meshpb "github.com/NordSecurity/nordvpn-linux/meshnet/pb"
 	"github.com/NordSecurity/nordvpn-linux/snapconf"
@@ -178,6 +179,22 @@ func NewApp(version, environment, hash, salt string,
 				Usage:  SetFirewallMarkUsageText,
 				Action: cmd.SetFirewallMark,
 			},
+			{
+				Name:         "routing-table",
+				Usage:        SetRoutingTableUsageText,
+				Action:       cmd.SetRoutingTable,
+				BashComplete: cmd.SetRoutingTableAutoComplete,
+				ArgsUsage:    SetRoutingTableArgsUsageText,
+				Description:  SetRoutingTableDescription,
+			},
+			{
+				Name:         "route-metric",
+				Usage:        SetRouteMetricUsageText,
+				Action:       cmd.SetRouteMetric,
+				BashComplete: cmd.SetRouteMetricAutoComplete,
+				ArgsUsage:    SetRouteMetricArgsUsageText,
+				Description:  SetRouteMetricDescription,
+			},
 			{
 				Name:      "ipv6",
 				Usage:     SetIpv6UsageText,
@@ -205,17 +222,12 @@ func NewApp(version, environment, hash, salt string,
 				BashComplete: cmd.SetBoolAutocomplete,
 			},
 			{
-				Name:      "analytics",
-				Usage:     SetAnalyticsUsageText,
-				Action:    cmd.SetAnalytics,
-				ArgsUsage: MsgSetBoolArgsUsage,
-				Description: fmt.Sprintf(
-					MsgSetBoolDescription,
-					SetAnalyticsUsageText,
-					"analytics",
-					"analytics",
-				),
-				BashComplete: cmd.SetBoolAutocomplete,
+				Name:         "analytics",
+				Usage:        SetAnalyticsUsageText,
+				Action:       cmd.SetAnalytics,
+				ArgsUsage:    SetAnalyticsArgsUsage,
+				Description:  SetAnalyticsDescription,
+				BashComplete: cmd.SetAnalyticsAutocomplete,
 			},
 			{
 				Name:         "killswitch",
@@ -256,6 +268,108 @@ func NewApp(version, environment, hash, salt string,
 					"tray",
 				),
 			},
+			{
+				Name:         "tray-icon",
+				Usage:        SetTrayIconUsageText,
+				Action:       cmd.SetTrayIcon,
+				BashComplete: cmd.SetTrayIconAutoComplete,
+				ArgsUsage:    SetTrayIconArgsUsageText,
+				Description:  SetTrayIconDescription,
+			},
+			{
+				Name:         "tray-hotkey",
+				Usage:        SetTrayHotkeyUsageText,
+				Action:       cmd.SetTrayHotkey,
+				BashComplete: cmd.SetBoolAutocomplete,
+				ArgsUsage:    MsgSetBoolArgsUsage,
+				Description: fmt.Sprintf(
+					MsgSetBoolDescription,
+					SetTrayHotkeyUsageText,
+					"tray-hotkey",
+					"tray-hotkey",
+				),
+			},
+			{
+				Name:         "tray-minimal",
+				Usage:        SetTrayMinimalUsageText,
+				Action:       cmd.SetTrayMinimal,
+				BashComplete: cmd.SetBoolAutocomplete,
+				ArgsUsage:    MsgSetBoolArgsUsage,
+				Description: fmt.Sprintf(
+					MsgSetBoolDescription,
+					SetTrayMinimalUsageText,
+					"tray-minimal",
+					"tray-minimal",
+				),
+			},
+			{
+				Name:         "tray-menu",
+				Usage:        SetTrayMenuUsageText,
+				Action:       cmd.SetTrayMenu,
+				BashComplete: cmd.SetTrayMenuAutoComplete,
+				ArgsUsage:    SetTrayMenuArgsUsageText,
+				Description:  SetTrayMenuDescription,
+			},
+			{
+				Name:         "log-level",
+				Usage:        SetLogLevelUsageText,
+				Action:       cmd.SetLogLevel,
+				BashComplete: cmd.SetLogLevelAutoComplete,
+				ArgsUsage:    SetLogLevelArgsUsageText,
+				Description:  SetLogLevelDescription,
+			},
+			{
+				Name:         "daemon-log-level",
+				Usage:        SetDaemonLogLevelUsageText,
+				Action:       cmd.SetDaemonLogLevel,
+				BashComplete: cmd.SetDaemonLogLevelAutoComplete,
+				ArgsUsage:    SetDaemonLogLevelArgsUsageText,
+				Description:  SetDaemonLogLevelDescription,
+				Flags: []cli.Flag{
+					&cli.StringSliceFlag{
+						Name:  flagDaemonLogLevelDebug,
+						Usage: SetDaemonLogLevelDebugUsage,
+					},
+					&cli.BoolFlag{
+						Name:  flagDaemonLogLevelPersist,
+						Usage: SetDaemonLogLevelPersistUsage,
+					},
+				},
+			},
+			{
+				Name:         "trusted-network-action",
+				Usage:        SetTrustedNetworkActionUsageText,
+				Action:       cmd.SetTrustedNetworkAction,
+				BashComplete: cmd.SetTrustedNetworkActionAutoComplete,
+				ArgsUsage:    SetTrustedNetworkActionArgsUsageText,
+				Description:  SetTrustedNetworkActionDescription,
+			},
+			{
+				Name:         "defer-on-metered",
+				Usage:        SetDeferOnMeteredUsageText,
+				Action:       cmd.SetDeferOnMetered,
+				BashComplete: cmd.SetBoolAutocomplete,
+				ArgsUsage:    MsgSetBoolArgsUsage,
+				Description: fmt.Sprintf(
+					MsgSetBoolDescription,
+					SetDeferOnMeteredUsageText,
+					"defer-on-metered",
+					"defer-on-metered",
+				),
+			},
+			{
+				Name:         "obfuscation-fallback",
+				Usage:        SetObfuscationFallbackUsageText,
+				Action:       cmd.SetObfuscationFallback,
+				BashComplete: cmd.SetBoolAutocomplete,
+				ArgsUsage:    MsgSetBoolArgsUsage,
+				Description: fmt.Sprintf(
+					MsgSetBoolDescription,
+					SetObfuscationFallbackUsageText,
+					"obfuscation-fallback",
+					"obfuscation-fallback",
+				),
+			},
 			{
 				Name:         "obfuscate",
 				Usage:        SetObfuscateUsageText,
@@ -270,6 +384,96 @@ func NewApp(version, environment, hash, salt string,
 				),
 				Hidden: cmd.Except(config.Technology_OPENVPN),
 			},
+			{
+				Name:         "connect-retry",
+				Usage:        SetConnectRetryUsageText,
+				Action:       cmd.SetConnectRetry,
+				BashComplete: cmd.SetConnectRetryAutoComplete,
+				ArgsUsage:    SetConnectRetryArgsUsageText,
+				Description:  SetConnectRetryDescription,
+			},
+			{
+				Name:         "connect-timeout",
+				Usage:        SetConnectTimeoutUsageText,
+				Action:       cmd.SetConnectTimeout,
+				BashComplete: cmd.SetConnectTimeoutAutoComplete,
+				ArgsUsage:    SetConnectTimeoutArgsUsageText,
+				Description:  SetConnectTimeoutDescription,
+			},
+			{
+				Name:         "max-server-load",
+				Usage:        SetMaxServerLoadUsageText,
+				Action:       cmd.SetMaxServerLoad,
+				BashComplete: cmd.SetMaxServerLoadAutoComplete,
+				ArgsUsage:    SetMaxServerLoadArgsUsageText,
+				Description:  SetMaxServerLoadDescription,
+			},
+			{
+				Name:         "mtu",
+				Usage:        SetMTUUsageText,
+				Action:       cmd.SetMTU,
+				BashComplete: cmd.SetMTUAutoComplete,
+				ArgsUsage:    SetMTUArgsUsageText,
+				Description:  SetMTUDescription,
+			},
+			{
+				Name:         "openvpn-port",
+				Usage:        SetOpenVPNPortUsageText,
+				Action:       cmd.SetOpenVPNPort,
+				BashComplete: cmd.SetOpenVPNPortAutoComplete,
+				ArgsUsage:    SetOpenVPNPortArgsUsageText,
+				Description:  SetOpenVPNPortDescription,
+				Hidden:       cmd.Except(config.Technology_OPENVPN),
+			},
+			{
+				Name:         "openvpn-directives",
+				Usage:        SetOpenVPNDirectivesUsageText,
+				Action:       cmd.SetOpenVPNDirectives,
+				BashComplete: cmd.SetOpenVPNDirectivesAutoComplete,
+				ArgsUsage:    SetOpenVPNDirectivesArgsUsageText,
+				Description:  SetOpenVPNDirectivesDescription,
+				Hidden:       cmd.Except(config.Technology_OPENVPN),
+			},
+			{
+				Name:         "keepalive",
+				Usage:        SetPersistentKeepaliveUsageText,
+				Action:       cmd.SetPersistentKeepalive,
+				BashComplete: cmd.SetPersistentKeepaliveAutoComplete,
+				ArgsUsage:    SetPersistentKeepaliveArgsUsageText,
+				Description:  SetPersistentKeepaliveDescription,
+				Hidden:       cmd.Except(config.Technology_NORDLYNX),
+			},
+			{
+				Name:         "health-monitor",
+				Usage:        SetHealthMonitorUsageText,
+				Action:       cmd.SetHealthMonitor,
+				BashComplete: cmd.SetHealthMonitorAutoComplete,
+				ArgsUsage:    SetHealthMonitorArgsUsageText,
+				Description:  SetHealthMonitorDescription,
+			},
+			{
+				Name:         "interface",
+				Usage:        SetInterfaceNameUsageText,
+				Action:       cmd.SetInterfaceName,
+				BashComplete: cmd.SetInterfaceNameAutoComplete,
+				ArgsUsage:    SetInterfaceNameArgsUsageText,
+				Description:  SetInterfaceNameDescription,
+			},
+			{
+				Name:         "stealth",
+				Usage:        SetStealthUsageText,
+				Action:       cmd.SetStealth,
+				BashComplete: cmd.SetBoolAutocomplete,
+				ArgsUsage:    SetStealthArgsUsageText,
+				Description:  SetStealthDescription,
+				Hidden:       cmd.Except(config.Technology_OPENVPN),
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  flagStealthProxy,
+						Usage: SetStealthProxyUsage,
+					},
+				},
+			},
 			{
 				Name:         "protocol",
 				Usage:        SetProtocolUsageText,
@@ -287,6 +491,14 @@ func NewApp(version, environment, hash, salt string,
 				ArgsUsage:    SetTechnologyArgsUsageText,
 				Description:  SetTechnologyDescription,
 			},
+			{
+				Name:         "fileshare-file-limit",
+				Usage:        SetFileshareFileLimitUsageText,
+				Action:       cmd.SetFileshareFileLimit,
+				BashComplete: cmd.SetFileshareFileLimitAutoComplete,
+				ArgsUsage:    SetFileshareFileLimitArgsUsageText,
+				Description:  SetFileshareFileLimitDescription,
+			},
 			{
 				Name:         "meshnet",
 				Aliases:      []string{"mesh"},
@@ -298,11 +510,12 @@ func NewApp(version, environment, hash, salt string,
 			},
 			{
 				Name:      "lan-discovery",
+				Aliases:   []string{"allow-lan"},
 				Usage:     SetLANDiscoveryUsage,
 				ArgsUsage: MsgSetBoolArgsUsage,
 				Description: fmt.Sprintf(
 					MsgSetBoolDescription,
-					SetLANDiscoveryUsage,
+					SetLANDiscoveryDescription,
 					"lan-discovery",
 					"lan-discovery",
 				),
@@ -362,6 +575,21 @@ func NewApp(version, environment, hash, salt string,
 			Action:             cmd.Account,
 			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
 		},
+		{
+			Name:         "benchmarks",
+			Usage:        BenchmarksUsageText,
+			Action:       cmd.Benchmarks,
+			BashComplete: cmd.ConnectAutoComplete,
+			ArgsUsage:    BenchmarksArgsUsageText,
+			Description:  BenchmarksDescription,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    flagGroup,
+					Aliases: []string{"g"},
+					Usage:   BenchmarksFlagGroupUsageText,
+				},
+			},
+		},
 		{
 			Name:         "cities",
 			Usage:        CitiesUsageText,
@@ -384,6 +612,38 @@ func NewApp(version, environment, hash, salt string,
 					Aliases: []string{"g"},
 					Usage:   ConnectFlagGroupUsageText,
 				},
+				&cli.StringSliceFlag{
+					Name:  flagDNS,
+					Usage: ConnectFlagDNSUsageText,
+				},
+				&cli.BoolFlag{
+					Name:  flagFastest,
+					Usage: ConnectFlagFastestUsageText,
+				},
+				&cli.StringFlag{
+					Name:  flagFavorite,
+					Usage: ConnectFlagFavoriteUsageText,
+				},
+				&cli.StringFlag{
+					Name:  flagVia,
+					Usage: ConnectFlagViaUsageText,
+				},
+				&cli.BoolFlag{
+					Name:  flagDryRun,
+					Usage: ConnectFlagDryRunUsageText,
+				},
+				&cli.BoolFlag{
+					Name:  flagRandom,
+					Usage: ConnectFlagRandomUsageText,
+				},
+				&cli.StringFlag{
+					Name:  flagWithin,
+					Usage: ConnectFlagWithinUsageText,
+				},
+				&cli.StringFlag{
+					Name:  flagDedicatedIP,
+					Usage: ConnectFlagDedicatedIPUsage,
+				},
 			},
 		},
 		{
@@ -392,6 +652,12 @@ func NewApp(version, environment, hash, salt string,
 			Action:             cmd.Countries,
 			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
 		},
+		{
+			Name:        "dedicated-ip",
+			Usage:       DedicatedIPUsageText,
+			Description: DedicatedIPDescription,
+			Action:      cmd.DedicatedIP,
+		},
 		{
 			Name:               "disconnect",
 			Aliases:            []string{"d"},
@@ -399,6 +665,13 @@ func NewApp(version, environment, hash, salt string,
 			Action:             cmd.Disconnect,
 			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
 		},
+		{
+			Name:        "pause",
+			Usage:       PauseUsageText,
+			Action:      cmd.Pause,
+			ArgsUsage:   PauseArgsUsageText,
+			Description: PauseDescription,
+		},
 		{
 			Name:               "groups",
 			Usage:              GroupsUsageText,
@@ -447,6 +720,88 @@ func NewApp(version, environment, hash, salt string,
 			Action: cmd.Click,
 			Hidden: true,
 		},
+		{
+			Name:        "pending",
+			Usage:       PendingUsageText,
+			Description: PendingDescription,
+			Action:      cmd.Pending,
+			Subcommands: []*cli.Command{
+				{
+					Name:         "cancel",
+					Usage:        PendingCancelUsageText,
+					ArgsUsage:    PendingCancelArgsUsage,
+					Action:       cmd.PendingCancel,
+					BashComplete: cmd.PendingAutoComplete,
+				},
+			},
+		},
+		{
+			Name:        "gateway",
+			Usage:       GatewayUsageText,
+			Description: GatewayDescription,
+			Action:      cmd.Gateway,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  flagGatewayListen,
+					Usage: GatewayListenUsage,
+					Value: gateway.DefaultAddress,
+				},
+				&cli.StringFlag{
+					Name:      flagGatewayTokenFile,
+					Usage:     GatewayTokenUsage,
+					TakesFile: true,
+				},
+			},
+		},
+		{
+			Name:        "history",
+			Usage:       HistoryUsageText,
+			Description: HistoryDescription,
+			Action:      cmd.History,
+			Flags: []cli.Flag{
+				&cli.Int64Flag{
+					Name:  flagLimit,
+					Usage: HistoryFlagLimitUsageText,
+					Value: defaultHistoryLimit,
+				},
+			},
+		},
+		{
+			Name:               "logs",
+			Usage:              LogsUsageText,
+			Action:             cmd.Logs,
+			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  flagLogsUser,
+					Usage: LogsUserUsageText,
+				},
+				&cli.IntFlag{
+					Name:  flagLogsLines,
+					Usage: LogsLinesUsageText,
+					Value: defaultLogsLines,
+				},
+			},
+		},
+		{
+			Name:        "repair",
+			Usage:       RepairUsageText,
+			Description: RepairDescription,
+			Action:      cmd.Repair,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:    flagRepairAssumeYes,
+					Aliases: []string{"y"},
+					Usage:   RepairFlagYesUsage,
+				},
+			},
+		},
+		{
+			Name:        "preflight",
+			Usage:       PreflightUsageText,
+			Description: PreflightDescription,
+			Action:      cmd.Preflight,
+		},
 		{
 			Name:         "rate",
 			Usage:        RateUsageText,
@@ -462,16 +817,69 @@ func NewApp(version, environment, hash, salt string,
 		},
 		&setCommand,
 		{
-			Name:               "settings",
-			Usage:              SettingsUsageText,
-			Action:             cmd.Settings,
-			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+			Name:   "settings",
+			Usage:  SettingsUsageText,
+			Action: cmd.Settings,
+			Subcommands: []*cli.Command{
+				{
+					Name:        "export",
+					Usage:       SettingsExportUsageText,
+					ArgsUsage:   SettingsExportArgsUsage,
+					Description: SettingsExportDescription,
+					Action:      cmd.SettingsExport,
+				},
+				{
+					Name:        "import",
+					Usage:       SettingsImportUsageText,
+					ArgsUsage:   SettingsImportArgsUsage,
+					Description: SettingsImportDescription,
+					Action:      cmd.SettingsImport,
+				},
+				{
+					Name:        "analytics-events",
+					Usage:       SettingsAnalyticsEventsUsageText,
+					Description: SettingsAnalyticsEventsDescription,
+					Action:      cmd.SettingsAnalyticsEvents,
+				},
+			},
+		},
+		{
+			Name:        "stats",
+			Usage:       StatsUsageText,
+			Description: StatsDescription,
+			Action:      cmd.Stats,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  flagStatsBy,
+					Usage: StatsFlagByUsageText,
+					Value: "day",
+				},
+				&cli.Int64Flag{
+					Name:  flagLimit,
+					Usage: StatsFlagLimitUsageText,
+					Value: defaultStatsLimit,
+				},
+			},
 		},
 		{
 			Name:               "status",
 			Usage:              StatusUsageText,
 			Action:             cmd.Status,
 			CustomHelpTemplate: CommandWithoutArgsHelpTemplate,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  flagStatusVerbose,
+					Usage: StatusVerboseUsageText,
+				},
+				&cli.BoolFlag{
+					Name:  flagStatusServices,
+					Usage: StatusServicesUsageText,
+				},
+				&cli.BoolFlag{
+					Name:  flagStatusWatch,
+					Usage: StatusWatchUsageText,
+				},
+			},
 		},
 		{
 			Name:               "version",
@@ -552,6 +960,229 @@ func NewApp(version, environment, hash, salt string,
 				},
 			},
 		},
+		{
+			Name:  "split-tunnel",
+			Usage: SplitTunnelUsageText,
+			Subcommands: []*cli.Command{
+				{
+					Name:        "add",
+					Usage:       SplitTunnelAddUsageText,
+					Action:      cmd.SplitTunnelAdd,
+					ArgsUsage:   SplitTunnelAddArgsUsageText,
+					Description: SplitTunnelAddDescription,
+				},
+				{
+					Name:         "remove",
+					Usage:        SplitTunnelRemoveUsageText,
+					Action:       cmd.SplitTunnelRemove,
+					BashComplete: cmd.SplitTunnelRemoveAutoComplete,
+					ArgsUsage:    SplitTunnelRemoveArgsUsageText,
+					Description:  SplitTunnelRemoveDescription,
+				},
+				{
+					Name:   "list",
+					Usage:  SplitTunnelListUsageText,
+					Action: cmd.SplitTunnelList,
+				},
+				{
+					Name:         "mode",
+					Usage:        SplitTunnelModeUsageText,
+					Action:       cmd.SplitTunnelMode,
+					BashComplete: cmd.SplitTunnelModeAutoComplete,
+					ArgsUsage:    SplitTunnelModeArgsUsageText,
+					Description:  SplitTunnelModeDescription,
+				},
+			},
+		},
+		{
+			Name:  "trusted",
+			Usage: TrustedNetworksUsageText,
+			Subcommands: []*cli.Command{
+				{
+					Name:  "add",
+					Usage: "Adds a network to the trusted networks",
+					Subcommands: []*cli.Command{
+						{
+							Name:        "ssid",
+							Usage:       TrustedAddSSIDUsageText,
+							Action:      cmd.TrustedAddSSID,
+							ArgsUsage:   TrustedAddSSIDArgsUsageText,
+							Description: TrustedAddSSIDDescription,
+						},
+						{
+							Name:        "subnet",
+							Usage:       TrustedAddSubnetUsageText,
+							Action:      cmd.TrustedAddSubnet,
+							ArgsUsage:   TrustedAddSubnetArgsUsageText,
+							Description: TrustedAddSubnetDescription,
+						},
+					},
+				},
+				{
+					Name:  "remove",
+					Usage: "Removes a network from the trusted networks",
+					Subcommands: []*cli.Command{
+						{
+							Name:        "ssid",
+							Usage:       TrustedRemoveSSIDUsageText,
+							Action:      cmd.TrustedRemoveSSID,
+							ArgsUsage:   TrustedRemoveSSIDArgsUsageText,
+							Description: TrustedRemoveSSIDDescription,
+						},
+						{
+							Name:        "subnet",
+							Usage:       TrustedRemoveSubnetUsageText,
+							Action:      cmd.TrustedRemoveSubnet,
+							ArgsUsage:   TrustedRemoveSubnetArgsUsageText,
+							Description: TrustedRemoveSubnetDescription,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  "favorite",
+			Usage: FavoriteUsageText,
+			Subcommands: []*cli.Command{
+				{
+					Name:         "add",
+					Usage:        FavoriteAddUsageText,
+					Action:       cmd.FavoriteAdd,
+					BashComplete: cmd.ConnectAutoComplete,
+					ArgsUsage:    FavoriteAddArgsUsageText,
+					Description:  FavoriteAddDescription,
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:    flagGroup,
+							Aliases: []string{"g"},
+							Usage:   FavoriteAddFlagGroupUsageText,
+						},
+					},
+				},
+				{
+					Name:         "remove",
+					Usage:        FavoriteRemoveUsageText,
+					Action:       cmd.FavoriteRemove,
+					BashComplete: cmd.FavoriteAutoComplete,
+					ArgsUsage:    FavoriteRemoveArgsUsageText,
+					Description:  FavoriteRemoveDescription,
+				},
+				{
+					Name:   "list",
+					Usage:  FavoriteListUsageText,
+					Action: cmd.FavoriteList,
+				},
+			},
+		},
+		{
+			Name:  "schedule",
+			Usage: ScheduleUsageText,
+			Subcommands: []*cli.Command{
+				{
+					Name:        "add",
+					Usage:       ScheduleAddUsageText,
+					Action:      cmd.ScheduleAdd,
+					ArgsUsage:   ScheduleAddArgsUsageText,
+					Description: ScheduleAddDescription,
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  flagDays,
+							Usage: ScheduleAddFlagDaysUsageText,
+						},
+						&cli.StringFlag{
+							Name:    flagGroup,
+							Aliases: []string{"g"},
+							Usage:   ScheduleAddFlagGroupUsageText,
+						},
+					},
+				},
+				{
+					Name:         "remove",
+					Usage:        ScheduleRemoveUsageText,
+					Action:       cmd.ScheduleRemove,
+					BashComplete: cmd.ScheduleAutoComplete,
+					ArgsUsage:    ScheduleRemoveArgsUsageText,
+					Description:  ScheduleRemoveDescription,
+				},
+				{
+					Name:   "list",
+					Usage:  ScheduleListUsageText,
+					Action: cmd.ScheduleList,
+				},
+			},
+		},
+		{
+			Name:  "profile",
+			Usage: ProfileUsageText,
+			Subcommands: []*cli.Command{
+				{
+					Name:        "create",
+					Usage:       ProfileCreateUsageText,
+					Action:      cmd.ProfileCreate,
+					ArgsUsage:   ProfileArgsUsageText,
+					Description: ProfileCreateDescription,
+				},
+				{
+					Name:         "switch",
+					Usage:        ProfileSwitchUsageText,
+					Action:       cmd.ProfileSwitch,
+					BashComplete: cmd.ProfileAutoComplete,
+					ArgsUsage:    ProfileArgsUsageText,
+					Description:  ProfileSwitchDescription,
+				},
+				{
+					Name:         "delete",
+					Usage:        ProfileDeleteUsageText,
+					Action:       cmd.ProfileDelete,
+					BashComplete: cmd.ProfileAutoComplete,
+					ArgsUsage:    ProfileArgsUsageText,
+					Description:  ProfileDeleteDescription,
+				},
+				{
+					Name:   "list",
+					Usage:  ProfileListUsageText,
+					Action: cmd.ProfileList,
+				},
+			},
+		},
+		{
+			Name:  "hook",
+			Usage: HookUsageText,
+			Subcommands: []*cli.Command{
+				{
+					Name:         "add",
+					Usage:        HookAddUsageText,
+					Action:       cmd.HookAdd,
+					BashComplete: cmd.HookEventAutoComplete,
+					ArgsUsage:    HookArgsUsageText,
+					Description:  HookAddDescription,
+					Flags: []cli.Flag{
+						&cli.Int64Flag{
+							Name:  flagTimeout,
+							Usage: HookAddFlagTimeoutUsageText,
+						},
+						&cli.StringFlag{
+							Name:  flagOnFailure,
+							Value: string(config.HookFailureIgnore),
+							Usage: HookAddFlagOnFailureUsageText,
+						},
+					},
+				},
+				{
+					Name:         "remove",
+					Usage:        HookRemoveUsageText,
+					Action:       cmd.HookRemove,
+					BashComplete: cmd.HookEventAutoComplete,
+					ArgsUsage:    HookArgsUsageText,
+					Description:  HookRemoveDescription,
+				},
+				{
+					Name:   "list",
+					Usage:  HookListUsageText,
+					Action: cmd.HookList,
+				},
+			},
+		},
 		{
 			Name:   "user",
 			Action: cmd.User,
@@ -643,6 +1274,24 @@ func fileshareCommand(c *cmd) *cli.Command {
 				ArgsUsage:    MsgFileshareCancelArgsUsage,
 				BashComplete: c.FileshareAutoCompleteTransfersCancel,
 			},
+			{
+				Name:        FileshareResendName,
+				Action:      c.FileshareResend,
+				Usage:       MsgFileshareResendUsage,
+				ArgsUsage:   MsgFileshareResendArgsUsage,
+				Description: MsgFileshareResendDescription,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  flagFileshareTo,
+						Usage: MsgFileshareResendToUsage,
+					},
+					&cli.BoolFlag{
+						Name:  flagFileshareNoWait,
+						Usage: MsgFileshareNoWaitUsage,
+					},
+				},
+				BashComplete: c.FileshareAutoCompleteTransfersResend,
+			},
 			{
 				Name:         FileshareClearName,
 				Action:       c.FileshareClear,
@@ -676,6 +1325,10 @@ func meshnetCommand(c *cmd) *cli.Command {
 								Usage:   MsgMeshnetPeerListFilters,
 								Aliases: []string{"f"},
 							},
+							&cli.BoolFlag{
+								Name:  flagPeerListVerbose,
+								Usage: MsgMeshnetPeerListVerbose,
+							},
 						},
 						Description:  PeerListDescription,
 						BashComplete: c.FiltersAutoComplete,
@@ -826,6 +1479,154 @@ func meshnetCommand(c *cmd) *cli.Command {
 							},
 						},
 					},
+					{
+						Name:  "route",
+						Usage: MsgMeshnetPeerRouteUsage,
+						Subcommands: []*cli.Command{
+							{
+								Name:         "set",
+								Aliases:      []string{"s"},
+								Usage:        MsgMeshnetPeerRouteSetUsage,
+								ArgsUsage:    MsgMeshnetPeerRouteSetArgsUsage,
+								Description:  MeshPeerRouteSetDescription,
+								Action:       c.MeshPeerRouteSet,
+								BashComplete: c.MeshPeerAutoComplete,
+							},
+							{
+								Name:         "accept",
+								Aliases:      []string{"a"},
+								Usage:        MsgMeshnetPeerRouteAcceptUsage,
+								ArgsUsage:    MsgMeshnetPeerRouteAcceptArgsUsage,
+								Description:  MeshPeerRouteAcceptDescription,
+								Action:       c.MeshPeerRouteAccept,
+								BashComplete: c.MeshPeerAutoComplete,
+							},
+							{
+								Name:   "clear",
+								Usage:  MsgMeshnetPeerRouteClearUsage,
+								Action: c.MeshPeerRouteClear,
+							},
+						},
+					},
+					{
+						Name:  "bandwidth",
+						Usage: MsgMeshnetPeerBandwidthUsage,
+						Subcommands: []*cli.Command{
+							{
+								Name:         "set",
+								Aliases:      []string{"s"},
+								Usage:        MsgMeshnetPeerSetBandwidthUsage,
+								ArgsUsage:    MsgMeshnetPeerSetBandwidthArgsUsage,
+								Description:  MeshPeerBandwidthSetDescription,
+								Action:       c.MeshPeerSetBandwidth,
+								BashComplete: c.MeshPeerNicknameAutoComplete,
+							},
+							{
+								Name:         "remove",
+								Aliases:      []string{"r"},
+								Usage:        MsgMeshnetPeerRemoveBandwidthUsage,
+								ArgsUsage:    MsgMeshnetPeerNoteArgsUsage,
+								Action:       c.MeshPeerRemoveBandwidth,
+								BashComplete: c.MeshPeerNicknameAutoComplete,
+							},
+						},
+					},
+					{
+						Name:  "note",
+						Usage: MsgMeshnetPeerNoteUsage,
+						Subcommands: []*cli.Command{
+							{
+								Name:         "set",
+								Aliases:      []string{"s"},
+								Usage:        MsgMeshnetPeerSetNoteUsage,
+								ArgsUsage:    MsgMeshnetPeerSetNoteArgsUsage,
+								Action:       c.MeshPeerSetNote,
+								BashComplete: c.MeshPeerNicknameAutoComplete,
+							},
+							{
+								Name:         "remove",
+								Aliases:      []string{"r"},
+								Usage:        MsgMeshnetPeerRemoveNoteUsage,
+								ArgsUsage:    MsgMeshnetPeerNoteArgsUsage,
+								Action:       c.MeshPeerRemoveNote,
+								BashComplete: c.MeshPeerNicknameAutoComplete,
+							},
+						},
+					},
+					{
+						Name:  "tag",
+						Usage: MsgMeshnetPeerTagUsage,
+						Subcommands: []*cli.Command{
+							{
+								Name:         "set",
+								Aliases:      []string{"s"},
+								Usage:        MsgMeshnetPeerSetTagsUsage,
+								ArgsUsage:    MsgMeshnetPeerSetTagsArgsUsage,
+								Action:       c.MeshPeerSetTags,
+								BashComplete: c.MeshPeerNicknameAutoComplete,
+							},
+							{
+								Name:         "remove",
+								Aliases:      []string{"r"},
+								Usage:        MsgMeshnetPeerRemoveTagsUsage,
+								ArgsUsage:    MsgMeshnetPeerNoteArgsUsage,
+								Action:       c.MeshPeerRemoveTags,
+								BashComplete: c.MeshPeerNicknameAutoComplete,
+							},
+						},
+					},
+				},
+			},
+			{
+				Name:  "advertise",
+				Usage: MsgMeshnetAdvertiseUsage,
+				Subcommands: []*cli.Command{
+					{
+						Name:        "set",
+						Aliases:     []string{"s"},
+						Usage:       MsgMeshnetAdvertiseSetUsage,
+						ArgsUsage:   MsgMeshnetAdvertiseSetArgsUsage,
+						Description: MeshAdvertiseSetDescription,
+						Action:      c.MeshAdvertiseSet,
+					},
+					{
+						Name:   "clear",
+						Usage:  MsgMeshnetAdvertiseClearUsage,
+						Action: c.MeshAdvertiseClear,
+					},
+				},
+			},
+			{
+				Name:  "group",
+				Usage: MsgMeshnetGroupUsage,
+				Subcommands: []*cli.Command{
+					{
+						Name:   "list",
+						Action: c.MeshGroupList,
+						Usage:  MsgMeshnetGroupListUsage,
+					},
+					{
+						Name:         "add",
+						Action:       c.MeshGroupAdd,
+						Usage:        MsgMeshnetGroupAddUsage,
+						ArgsUsage:    MsgMeshnetGroupPeerArgsUsage,
+						BashComplete: c.MeshGroupAutoComplete,
+					},
+					{
+						Name:         "remove",
+						Action:       c.MeshGroupRemove,
+						Usage:        MsgMeshnetGroupRemoveUsage,
+						ArgsUsage:    MsgMeshnetGroupPeerArgsUsage,
+						BashComplete: c.MeshGroupAutoComplete,
+					},
+					{
+						Name:         "permissions",
+						Action:       c.MeshGroupPermissions,
+						Usage:        MsgMeshnetGroupPermissionsUsage,
+						ArgsUsage:    MsgMeshnetGroupPermissionsArgs,
+						Description:  MeshGroupPermissionsDescription,
+						BashComplete: c.MeshGroupAutoComplete,
+					},
 				},
 			},
 			{
@@ -902,6 +1703,95 @@ func meshnetCommand(c *cmd) *cli.Command {
 						ArgsUsage:    MsgMeshnetInviteArgsUsage,
 						BashComplete: c.MeshInviteAutoCompletion,
 					},
+					{
+						Name:         "resend",
+						Action:       c.MeshInviteResend,
+						Usage:        MsgMeshnetInviteResendUsage,
+						ArgsUsage:    MsgMeshnetInviteArgsUsage,
+						BashComplete: c.MeshInviteAutoCompletion,
+					},
+					{
+						Name:         "ttl",
+						Action:       c.MeshInviteTTL,
+						Usage:        MsgMeshnetInviteTTLUsage,
+						Description:  MsgMeshnetInviteTTLDescription,
+						ArgsUsage:    MsgMeshnetInviteTTLArgsUsage,
+						BashComplete: c.MeshInviteTTLAutoComplete,
+					},
+					{
+						Name:        "code",
+						Action:      c.MeshInviteCode,
+						Usage:       MsgMeshnetInviteCodeUsage,
+						Description: MsgMeshnetInviteCodeDescription,
+						Flags: []cli.Flag{
+							&cli.BoolFlag{
+								Name:  flagAllowIncomingTraffic,
+								Usage: MsgMeshnetInviteAllowIncomingTrafficUsage,
+							},
+							&cli.BoolFlag{
+								Name:  flagAllowTrafficRouting,
+								Usage: MsgMeshnetAllowTrafficRoutingUsage,
+							},
+							&cli.BoolFlag{
+								Name:  flagAllowLocalNetwork,
+								Usage: MsgMeshnetAllowLocalNetworkUsage,
+							},
+							&cli.BoolFlag{
+								Name:  flagAllowFileshare,
+								Usage: MsgMeshnetAllowFileshare,
+							},
+						},
+					},
+					{
+						Name:         "auto-accept",
+						Action:       c.MeshInviteAutoAccept,
+						Usage:        MsgMeshnetInviteAutoAcceptUsage,
+						Description:  MsgMeshnetInviteAutoAcceptDescription,
+						ArgsUsage:    MsgSetBoolArgsUsage,
+						BashComplete: c.SetBoolAutocomplete,
+						Flags: []cli.Flag{
+							&cli.BoolFlag{
+								Name:  flagAllowIncomingTraffic,
+								Usage: MsgMeshnetInviteAllowIncomingTrafficUsage,
+							},
+							&cli.BoolFlag{
+								Name:  flagAllowTrafficRouting,
+								Usage: MsgMeshnetAllowTrafficRoutingUsage,
+							},
+							&cli.BoolFlag{
+								Name:  flagAllowLocalNetwork,
+								Usage: MsgMeshnetAllowLocalNetworkUsage,
+							},
+							&cli.BoolFlag{
+								Name:  flagAllowFileshare,
+								Usage: MsgMeshnetAllowFileshare,
+							},
+						},
+					},
+					{
+						Name:      "redeem",
+						Action:    c.MeshInviteRedeem,
+						Usage:     MsgMeshnetInviteRedeemUsage,
+						ArgsUsage: MsgMeshnetInviteRedeemArgsUsage,
+						Flags: []cli.Flag{
+							&cli.BoolFlag{
+								Name:  flagAllowIncomingTraffic,
+								Usage: MsgMeshnetInviteAllowIncomingTrafficUsage,
+							},
+							&cli.BoolFlag{
+								Name:  flagAllowTrafficRouting,
+								Usage: MsgMeshnetAllowTrafficRoutingUsage,
+							},
+							&cli.BoolFlag{
+								Name:  flagAllowLocalNetwork,
+								Usage: MsgMeshnetAllowLocalNetworkUsage,
+							},
+							&cli.BoolFlag{
+								Name:  flagAllowFileshare,
+								Usage: MsgMeshnetAllowFileshare,
+							},
+						},
+					},
 				},
 			},
 			{
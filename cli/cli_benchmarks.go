@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Benchmarks help text
+const (
+	BenchmarksUsageText          = "Measures latency to the recommended servers"
+	BenchmarksFlagGroupUsageText = "Specify a server group to measure"
+	BenchmarksArgsUsageText      = "[<country>|<country_code>|<city>|<group>|<country> <city>]"
+	BenchmarksDescription        = `Use this command to measure the latency to the recommended servers. The servers are listed from the fastest one.
+Provide the same arguments as for the connect command to measure the servers in a specific location or group. For example: 'nordvpn benchmarks Germany'
+Use 'nordvpn connect --fastest' to connect to the server with the lowest latency.`
+)
+
+// Benchmarks prints the round trip times to the recommended servers
+func (c *cmd) Benchmarks(ctx *cli.Context) error {
+	serverTag := strings.ToLower(strings.Join(ctx.Args().Slice(), " "))
+	resp, err := c.client.Benchmarks(context.Background(), &pb.BenchmarksRequest{
+		ServerTag:   serverTag,
+		ServerGroup: ctx.String(flagGroup),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.GetType() {
+	case internal.CodeSuccess:
+	case internal.CodeNothingToDo:
+		color.Yellow(BenchmarksDedicatedIP)
+		return nil
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	default:
+		return formatError(internal.ErrUnhandled)
+	}
+
+	return printBenchmarks(os.Stdout, resp.GetServers())
+}
+
+func printBenchmarks(w io.Writer, servers []*pb.ServerBenchmark) error {
+	writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "SERVER\tLOCATION\tLOAD\tLATENCY")
+	for _, server := range servers {
+		location := server.GetCountry()
+		if server.GetCity() != "" {
+			location += ", " + server.GetCity()
+		}
+		latency := BenchmarksNoResponse
+		if server.GetRtt() >= 0 {
+			latency = time.Duration(server.GetRtt()).Round(100 * time.Microsecond).String()
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%d%%\t%s\n", server.GetHostname(), location, server.GetLoad(), latency)
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,27 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintBenchmarks(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var out bytes.Buffer
+	err := printBenchmarks(&out, []*pb.ServerBenchmark{
+		{Hostname: "de1.nordvpn.com", Country: "Germany", City: "Berlin", Load: 12, Rtt: int64(23456 * time.Microsecond)},
+		{Hostname: "de2.nordvpn.com", Country: "Germany", Load: 7, Rtt: -1},
+	})
+	assert.NoError(t, err)
+	expected := "SERVER           LOCATION         LOAD  LATENCY\n" +
+		"de1.nordvpn.com  Germany, Berlin  12%   23.5ms\n" +
+		"de2.nordvpn.com  Germany          7%    " + BenchmarksNoResponse + "\n"
+	assert.Equal(t, expected, out.String())
+}
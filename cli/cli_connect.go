@@ -19,15 +19,30 @@ import (
 
 // Connect help text
 const (
-	ConnectUsageText          = "Connects you to VPN"
-	ConnectFlagGroupUsageText = "Specify a server group to connect to"
-	ConnectArgsUsageText      = "[<country>|<server>|<country_code>|<city>|<group>|<country> <city>]"
-	ConnectDescription        = `Use this command to connect to NordVPN. Adding no arguments to the command will connect you to the recommended server.
+	ConnectUsageText             = "Connects you to VPN"
+	ConnectFlagGroupUsageText    = "Specify a server group to connect to"
+	ConnectFlagDNSUsageText      = "Use the given DNS servers for this connection instead of the configured ones"
+	ConnectFlagFastestUsageText  = "Probe the recommended servers and connect to the one with the lowest latency"
+	ConnectFlagFavoriteUsageText = "Connect to the favorite server or location saved under the given name"
+	ConnectFlagViaUsageText      = "Specify a country where the traffic enters Double VPN server chain"
+	ConnectFlagDryRunUsageText   = "Show the server, DNS, routes and firewall rules which would be used without connecting"
+	ConnectFlagRandomUsageText   = "Connect to a random server instead of the recommended one"
+	ConnectFlagWithinUsageText   = "Limit the random server to the given country or group"
+	ConnectFlagDedicatedIPUsage  = "Connect to the dedicated IP server with the given label, see 'nordvpn dedicated-ip'"
+	ConnectArgsUsageText         = "[<country>|<server>|<country_code>|<city>|<group>|<country> <city>]"
+	ConnectDescription           = `Use this command to connect to NordVPN. Adding no arguments to the command will connect you to the recommended server.
 Provide a <country> argument to connect to a specific country. For example: 'nordvpn connect Australia'
 Provide a <server> argument to connect to a specific server. For example: 'nordvpn connect jp35'
 Provide a <country_code> argument to connect to a specific country. For example: 'nordvpn connect us'
 Provide a <city> argument to connect to a specific city. For example: 'nordvpn connect Hungary Budapest'
 Provide a <group> argument to connect to a specific servers group. For example: 'nordvpn connect Onion_Over_VPN'
+Provide the --dns flag to use other DNS servers for this connection only. For example: 'nordvpn connect --dns tls://dns.quad9.net de'
+Provide the --favorite flag to connect to the server or location saved with 'nordvpn favorite add'. For example: 'nordvpn connect --favorite work'
+Provide the --fastest flag to connect to the recommended server with the lowest latency. For example: 'nordvpn connect --fastest Germany'
+Provide the --via flag with the Double VPN group to choose the entry country, the argument is the exit country then. For example: 'nordvpn connect --group double_vpn --via Canada United_States'
+Provide the --random flag to connect to a random server, add the --within flag to limit it to a country or a group. For example: 'nordvpn connect --random --within Germany'
+Provide the --dedicated-ip flag to connect to one of your dedicated IP servers. For example: 'nordvpn connect --dedicated-ip de123'
+Provide the --dry-run flag to review the changes which connecting would make without applying them. For example: 'nordvpn connect --dry-run Germany'
 
 Press the Tab key to see auto-suggestions for countries and cities.`
 )
@@ -68,6 +83,49 @@ func (c *cmd) Connect(ctx *cli.Context) error {
 	serverTag := strings.Join(args.Slice(), " ")
 	serverTag = strings.ToLower(serverTag)
 	serverGroup := ctx.String(flagGroup)
+	favorite := ctx.String(flagFavorite)
+	if favorite != "" && (serverTag != "" || serverGroup != "") {
+		return formatError(errors.New(ConnectFavoriteArgs))
+	}
+	via := ctx.String(flagVia)
+	if via != "" && ctx.Bool(flagFastest) {
+		return formatError(errors.New(ConnectViaFastest))
+	}
+	random := ctx.Bool(flagRandom)
+	if random && (via != "" || ctx.Bool(flagFastest)) {
+		return formatError(errors.New(ConnectRandomFastest))
+	}
+	if within := ctx.String(flagWithin); within != "" {
+		if !random {
+			return formatError(errors.New(ConnectWithinRandom))
+		}
+		if serverTag != "" || favorite != "" {
+			return formatError(errors.New(ConnectWithinArgs))
+		}
+		serverTag = strings.ToLower(within)
+	}
+
+	dedicatedIP := strings.ToLower(ctx.String(flagDedicatedIP))
+	if dedicatedIP != "" && (serverTag != "" || serverGroup != "" || favorite != "" || via != "" ||
+		random || ctx.Bool(flagFastest)) {
+		return formatError(errors.New(ConnectDedicatedIPArgs))
+	}
+
+	request := &pb.ConnectRequest{
+		ServerTag:   serverTag,
+		ServerGroup: serverGroup,
+		Dns:         ctx.StringSlice(flagDNS),
+		Fastest:     ctx.Bool(flagFastest),
+		Favorite:    favorite,
+		Via:         strings.ToLower(via),
+		Random:      random,
+		DedicatedIp: dedicatedIP,
+	}
+	if ctx.Bool(flagDryRun) {
+		return c.connectDryRun(request)
+	}
+
+	c.preflightBeforeConnect()
 
 	ch := make(chan os.Signal, 1)
 	signal.Notify(ch, os.Interrupt)
@@ -81,10 +139,13 @@ func (c *cmd) Connect(ctx *cli.Context) error {
 		}
 	}(ch)
 
-	resp, err := c.client.Connect(context.Background(), &pb.ConnectRequest{
-		ServerTag:   serverTag,
-		ServerGroup: serverGroup,
-	})
+	var resp interface{ Recv() (*pb.Payload, error) }
+	var err error
+	if dedicatedIP != "" {
+		resp, err = c.client.ConnectDedicatedIP(context.Background(), &pb.ConnectDedicatedIPRequest{Label: dedicatedIP})
+	} else {
+		resp, err = c.client.Connect(context.Background(), request)
+	}
 	if err != nil {
 		return formatError(err)
 	}
@@ -105,6 +166,8 @@ func (c *cmd) Connect(ctx *cli.Context) error {
 		switch out.Type {
 		case internal.CodeFailure:
 			rpcErr = errors.New(client.ConnectCantConnect)
+		case internal.CodeFormatError:
+			rpcErr = errors.New(ConnectDNSInvalid)
 		case internal.CodeExpiredRenewToken:
 			color.Yellow(client.RelogRequest)
 			if rpcErr = c.Login(ctx); rpcErr != nil {
@@ -134,19 +197,29 @@ func (c *cmd) Connect(ctx *cli.Context) error {
 		case internal.CodeDisconnected:
 			color.Yellow(fmt.Sprintf(client.ConnectCanceled, internal.StringsToInterfaces(out.Data)...))
 		case internal.CodeTagNonexisting:
-			rpcErr = errors.New(internal.TagNonexistentErrorMessage)
+			rpcErr = tagNonexistentError(out.Data)
 		case internal.CodeGroupNonexisting:
 			rpcErr = errors.New(internal.GroupNonexistentErrorMessage)
 		case internal.CodeServerUnavailable:
 			rpcErr = errors.New(internal.ServerUnavailableErrorMessage)
 		case internal.CodeDoubleGroupError:
 			rpcErr = errors.New(internal.DoubleGroupErrorMessage)
+		case internal.CodeFavoriteNotFound:
+			rpcErr = fmt.Errorf(FavoriteNotFoundError, favorite)
+		case internal.CodeDoubleVPNRequired:
+			rpcErr = errors.New(ConnectViaDoubleVPNRequired)
+		case internal.CodeHookFailed:
+			rpcErr = fmt.Errorf(ConnectHookFailed, out.Data[0])
 		case internal.CodeVPNRunning:
 			color.Yellow(client.ConnectConnected)
 		case internal.CodeNothingToDo:
 			color.Yellow(client.ConnectConnecting)
 		case internal.CodeUFWDisabled:
 			color.Yellow(client.UFWDisabledMessage)
+		case internal.CodeQueuedOffline:
+			color.Yellow(fmt.Sprintf(MsgConnectQueuedOffline, internal.StringsToInterfaces(out.Data)...))
+		case internal.CodeConnectAttempt:
+			color.Yellow(fmt.Sprintf(MsgConnectAttempt, internal.StringsToInterfaces(out.Data)...))
 		case internal.CodeConnecting:
 			color.Green(fmt.Sprintf(client.ConnectStart, internal.StringsToInterfaces(out.Data)...))
 		case internal.CodeConnected:
@@ -162,3 +235,11 @@ func (c *cmd) ConnectAutoComplete(ctx *cli.Context) {
 	groupName, hasGroupFlag := getFlagValue(flagGroup, ctx)
 	c.printServersForAutoComplete(args.First(), hasGroupFlag, groupName)
 }
+
+// tagNonexistentError returns the error for the mistyped server tag together with the close matches found by daemon
+func tagNonexistentError(suggestions []string) error {
+	if len(suggestions) == 0 {
+		return errors.New(internal.TagNonexistentErrorMessage)
+	}
+	return fmt.Errorf(ConnectTagSuggestions, strings.Join(suggestions, ", "))
+}
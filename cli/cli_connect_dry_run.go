@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/client"
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+)
+
+// connectDryRun prints the changes which connecting with the request would make without connecting
+func (c *cmd) connectDryRun(request *pb.ConnectRequest) error {
+	plan, err := c.client.ConnectDryRun(context.Background(), request)
+	if err != nil {
+		return formatError(err)
+	}
+
+	if plan.Type != internal.CodeSuccess {
+		return formatError(connectPlanError(plan.Type, request.GetFavorite()))
+	}
+
+	fmt.Printf("Server: %s (%s)\n", plan.GetServerName(), plan.GetServerHostname())
+	fmt.Printf("Server IP: %s\n", plan.GetServerIp())
+	location := plan.GetCountry()
+	if plan.GetCity() != "" {
+		location += ", " + plan.GetCity()
+	}
+	if plan.GetVirtualLocation() {
+		location += " (virtual)"
+	}
+	fmt.Printf("Location: %s\n", location)
+	fmt.Printf("Technology: %s\n", plan.GetTechnology())
+	if plan.GetTechnology() == config.Technology_OPENVPN {
+		fmt.Printf("Protocol: %s\n", plan.GetProtocol())
+		fmt.Printf("OpenVPN Port: %s\n", openVPNPortLabel(uint16(plan.GetOpenvpnPort())))
+		fmt.Printf("Obfuscate: %s\n", nstrings.GetBoolLabel(plan.GetObfuscated()))
+	}
+	if plan.GetTechnology() == config.Technology_NORDLYNX {
+		fmt.Printf("Post-quantum VPN: %s\n", nstrings.GetBoolLabel(plan.GetPostquantum()))
+	}
+	fmt.Printf("DNS: %s\n", strings.Join(plan.GetDns(), ", "))
+	printPlanList("Routes", plan.GetRoutes())
+	printPlanList("Firewall", plan.GetFirewallRules())
+
+	color.Yellow(MsgConnectDryRun)
+	return nil
+}
+
+func printPlanList(title string, items []string) {
+	fmt.Printf("%s:\n", title)
+	for _, item := range items {
+		fmt.Printf("  - %s\n", item)
+	}
+}
+
+// connectPlanError returns the error for the response code of the dry run
+func connectPlanError(code int64, favorite string) error {
+	switch code {
+	case internal.CodeFormatError:
+		return errors.New(ConnectDNSInvalid)
+	case internal.CodeConfigError:
+		return ErrConfig
+	case internal.CodeTokenRenewError:
+		return errors.New(client.AccountTokenRenewError)
+	case internal.CodeAccountExpired:
+		return fmt.Errorf(ExpiredAccountMessage, client.SubscriptionURL)
+	case internal.CodeDedicatedIPRenewError:
+		return fmt.Errorf(NoDedicatedIPMessage, client.SubscriptionDedicatedIPURL)
+	case internal.CodeDedicatedIPNoServer:
+		return errors.New(NoDedidcatedIPServerMessage)
+	case internal.CodeDedicatedIPServiceButNoServers:
+		return errors.New(NoPreferredDedicatedIPLocationSelected)
+	case internal.CodeTagNonexisting:
+		return errors.New(internal.TagNonexistentErrorMessage)
+	case internal.CodeGroupNonexisting:
+		return errors.New(internal.GroupNonexistentErrorMessage)
+	case internal.CodeServerUnavailable:
+		return errors.New(internal.ServerUnavailableErrorMessage)
+	case internal.CodeDoubleGroupError:
+		return errors.New(internal.DoubleGroupErrorMessage)
+	case internal.CodeFavoriteNotFound:
+		return fmt.Errorf(FavoriteNotFoundError, favorite)
+	case internal.CodeDoubleVPNRequired:
+		return errors.New(ConnectViaDoubleVPNRequired)
+	}
+	return internal.ErrUnhandled
+}
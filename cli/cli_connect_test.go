@@ -146,3 +146,11 @@ func TestConnectAutoComplete(t *testing.T) {
 		})
 	}
 }
+
+func TestTagNonexistentError(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.EqualError(t, tagNonexistentError(nil), internal.TagNonexistentErrorMessage)
+	assert.EqualError(t, tagNonexistentError([]string{"germany", "de1234"}),
+		"The specified server does not exist. Did you mean: germany, de1234?")
+}
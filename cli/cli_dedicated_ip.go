@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/NordSecurity/nordvpn-linux/client"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Dedicated IP help text
+const (
+	DedicatedIPUsageText   = "Shows the servers of your dedicated IP subscription"
+	DedicatedIPDescription = `Use this command to see the servers selected for your dedicated IP services.
+Connect to one of them by its label with the --dedicated-ip flag.
+
+Example: 'nordvpn connect --dedicated-ip de123'`
+)
+
+func (c *cmd) DedicatedIP(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	resp, err := c.client.DedicatedIPServers(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeTokenRenewError:
+		return formatError(errors.New(client.AccountTokenRenewError))
+	case internal.CodeDedicatedIPRenewError:
+		link := client.SubscriptionDedicatedIPURL
+		tokenData, err := c.getTrustedPassTokenData()
+		if err == nil {
+			link = fmt.Sprintf(client.SubscriptionDedicatedIPURLLogin, tokenData.token, tokenData.owner_id)
+		}
+		return formatError(fmt.Errorf(NoDedicatedIPMessage, link))
+	case internal.CodeSuccess:
+		return printDedicatedIPServers(os.Stdout, resp.GetServers())
+	}
+	return formatError(internal.ErrUnhandled)
+}
+
+func printDedicatedIPServers(w io.Writer, servers []*pb.DedicatedIPServer) error {
+	writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "LABEL\tSERVER\tLOCATION\tEXPIRES")
+	for _, server := range servers {
+		if server.GetLabel() == "" {
+			fmt.Fprintf(writer, "-\t%s\t-\t%s\n", MsgDedicatedIPNoServer, server.GetExpiresAt())
+			continue
+		}
+		location := server.GetCountry()
+		if server.GetCity() != "" {
+			location = fmt.Sprintf("%s, %s", location, server.GetCity())
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", server.GetLabel(), server.GetName(), location, server.GetExpiresAt())
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintDedicatedIPServers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	servers := []*pb.DedicatedIPServer{
+		{
+			Label:     "de123",
+			Name:      "Germany #123",
+			Hostname:  "de123.nordvpn.com",
+			Country:   "Germany",
+			City:      "Berlin",
+			ExpiresAt: "2030-01-01 00:00:00",
+		},
+		{ExpiresAt: "2030-02-01 00:00:00"},
+	}
+
+	var out bytes.Buffer
+	assert.NoError(t, printDedicatedIPServers(&out, servers))
+	assert.Equal(t, `LABEL  SERVER              LOCATION         EXPIRES
+de123  Germany #123        Germany, Berlin  2030-01-01 00:00:00
+-      no server selected  -                2030-02-01 00:00:00
+`, out.String())
+}
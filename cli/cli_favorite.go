@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/client"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Favorite help text
+const (
+	FavoriteUsageText = "Saves servers and locations under short names to connect to them quickly"
+
+	FavoriteAddUsageText          = "Saves the server or the location as a favorite"
+	FavoriteAddFlagGroupUsageText = "Specify a server group of the favorite"
+	FavoriteAddArgsUsageText      = "<name> [<country>|<server>|<country_code>|<city>|<group>|<country> <city>]"
+	FavoriteAddDescription        = `Use this command to save the server or the location under the name. The location is given the same way as for the connect command.
+<name> can contain lowercase letters, digits, '-' and '_'.
+
+Example: 'nordvpn favorite add work Germany Berlin'
+Example: 'nordvpn favorite add p2p --group p2p Netherlands'
+
+Use 'nordvpn connect --favorite <name>' to connect to the favorite.`
+
+	FavoriteRemoveUsageText     = "Removes the favorite"
+	FavoriteRemoveArgsUsageText = "<name>"
+	FavoriteRemoveDescription   = `Use this command to remove the favorite.
+
+Example: 'nordvpn favorite remove work'`
+
+	FavoriteListUsageText = "Shows the favorites"
+)
+
+func (c *cmd) FavoriteAdd(ctx *cli.Context) error {
+	args := ctx.Args()
+	serverGroup := ctx.String(flagGroup)
+	if args.Len() < 1 || (args.Len() == 1 && serverGroup == "") {
+		return formatError(argsCountError(ctx))
+	}
+	name := args.First()
+
+	resp, err := c.client.AddFavorite(context.Background(), &pb.Favorite{
+		Name:        name,
+		ServerTag:   strings.ToLower(strings.Join(args.Tail(), " ")),
+		ServerGroup: serverGroup,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(FavoriteInvalidName, name))
+	case internal.CodeNothingToDo:
+		return formatError(fmt.Errorf(FavoriteExistsError, name))
+	case internal.CodeDedicatedIPRenewError:
+		return formatError(fmt.Errorf(NoDedicatedIPMessage, client.SubscriptionDedicatedIPURL))
+	case internal.CodeDedicatedIPNoServer:
+		return formatError(errors.New(NoDedidcatedIPServerMessage))
+	case internal.CodeDedicatedIPServiceButNoServers:
+		return formatError(errors.New(NoPreferredDedicatedIPLocationSelected))
+	case internal.CodeSuccess:
+		color.Green(FavoriteAddSuccess, resp.Data[0])
+		return nil
+	}
+	return formatError(internal.ErrUnhandled)
+}
+
+func (c *cmd) FavoriteRemove(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+	name := ctx.Args().First()
+
+	resp, err := c.client.RemoveFavorite(context.Background(), &pb.RemoveFavoriteRequest{Name: name})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFavoriteNotFound:
+		return formatError(fmt.Errorf(FavoriteNotFoundError, name))
+	case internal.CodeSuccess:
+		color.Green(FavoriteRemoveSuccess, resp.Data[0])
+		return nil
+	}
+	return formatError(internal.ErrUnhandled)
+}
+
+func (c *cmd) FavoriteList(ctx *cli.Context) error {
+	resp, err := c.client.Favorites(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	if resp.Type != internal.CodeSuccess {
+		return formatError(ErrConfig)
+	}
+
+	if len(resp.Favorites) == 0 {
+		fmt.Println(FavoriteListEmpty)
+		return nil
+	}
+	for _, favorite := range resp.Favorites {
+		fmt.Printf("%s: %s\n", favorite.Name, favoriteTarget(favorite))
+	}
+	return nil
+}
+
+// favoriteTarget describes the favorite the same way as it would be given to the connect command
+func favoriteTarget(favorite *pb.Favorite) string {
+	var target []string
+	if favorite.ServerTag != "" {
+		target = append(target, favorite.ServerTag)
+	}
+	if favorite.ServerGroup != "" {
+		target = append(target, "--group "+favorite.ServerGroup)
+	}
+	return strings.Join(target, " ")
+}
+
+// FavoriteAutoComplete autocompletes the names of favorites
+func (c *cmd) FavoriteAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	resp, err := c.client.Favorites(context.Background(), &pb.Empty{})
+	if err != nil {
+		return
+	}
+	for _, favorite := range resp.Favorites {
+		fmt.Println(favorite.Name)
+	}
+}
@@ -56,7 +56,7 @@ func statusLoop(fileshareClient pb.FileshareClient, client transferStatusClient,
 			}
 
 			if fileshareError := resp.GetError(); fileshareError != nil {
-				if err := getFileshareResponseToError(fileshareError); err != nil {
+				if err := FileshareResponseToError(fileshareError); err != nil {
 					transferErrorChan <- err
 					return
 				}
@@ -96,7 +96,7 @@ func statusLoop(fileshareClient pb.FileshareClient, client transferStatusClient,
 			return formatError(err)
 		}
 
-		if err := getFileshareResponseToError(resp); err != nil {
+		if err := FileshareResponseToError(resp); err != nil {
 			return formatError(err)
 		}
 
@@ -170,11 +170,69 @@ func (c *cmd) FileshareSend(ctx *cli.Context) error {
 	}
 
 	if resp.GetError() != nil {
-		if err := getFileshareResponseToError(resp.GetError()); err != nil {
+		if err := FileshareResponseToError(resp.GetError(), resp.GetFileLimit()); err != nil {
 			return formatError(err)
 		}
 	}
 
+	if resp.GetStatus() == pb.Status_PENDING && resp.GetPendingId() != "" {
+		color.Yellow(MsgFileshareSendQueued, resp.GetPendingId())
+		return nil
+	}
+
+	if ctx.IsSet(flagFileshareNoWait) {
+		color.Green(MsgFileshareSendNoWait, resp.TransferId)
+		return nil
+	}
+
+	fmt.Printf("\r%s", MsgFileshareWaitAccept)
+
+	return statusLoop(c.fileshareClient, client, resp.TransferId)
+}
+
+// FileshareResend rpc
+func (c *cmd) FileshareResend(ctx *cli.Context) error {
+	args := ctx.Args()
+
+	if args.Len() != 1 {
+		return argsParseError(ctx)
+	}
+
+	// disable spinner, we will show message to the user instead
+	c.loaderInterceptor.enabled = false
+	resendContext, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+
+	client, err := c.fileshareClient.Resend(resendContext, &pb.ResendRequest{
+		TransferId: args.First(),
+		Peer:       ctx.String(flagFileshareTo),
+		Silent:     ctx.IsSet(flagFileshareNoWait),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	// check first response to determine that transfer was started successfully
+	resp, err := client.Recv()
+	if err != nil {
+		return formatError(err)
+	}
+
+	for _, path := range resp.GetMissingPaths() {
+		color.Yellow(MsgFileshareResendMissingPath, path)
+	}
+
+	if resp.GetError() != nil {
+		if err := FileshareResponseToError(resp.GetError(), resp.GetFileLimit()); err != nil {
+			return formatError(err)
+		}
+	}
+
+	if resp.GetStatus() == pb.Status_PENDING && resp.GetPendingId() != "" {
+		color.Yellow(MsgFileshareSendQueued, resp.GetPendingId())
+		return nil
+	}
+
 	if ctx.IsSet(flagFileshareNoWait) {
 		color.Green(MsgFileshareSendNoWait, resp.TransferId)
 		return nil
@@ -288,7 +346,7 @@ func (c *cmd) FileshareAccept(ctx *cli.Context) error {
 	}
 
 	if resp.GetError() != nil {
-		if err := getFileshareResponseToError(resp.GetError(), path); err != nil {
+		if err := FileshareResponseToError(resp.GetError(), path); err != nil {
 			return formatError(err)
 		}
 	}
@@ -324,7 +382,7 @@ func (c *cmd) FileshareCancel(ctx *cli.Context) error {
 		return formatError(err)
 	}
 
-	if err := getFileshareResponseToError(resp); err != nil {
+	if err := FileshareResponseToError(resp); err != nil {
 		return formatError(err)
 	}
 
@@ -358,7 +416,7 @@ func (c *cmd) FileshareClear(ctx *cli.Context) error {
 	if err != nil {
 		return formatError(err)
 	}
-	if err := getFileshareResponseToError(resp); err != nil {
+	if err := FileshareResponseToError(resp); err != nil {
 		return formatError(err)
 	}
 
@@ -366,8 +424,8 @@ func (c *cmd) FileshareClear(ctx *cli.Context) error {
 	return nil
 }
 
-// getFileshareResponseToError converts resp to error. Params are used in case of some error messages.
-func getFileshareResponseToError(resp *pb.Error, params ...any) error {
+// FileshareResponseToError converts resp to error. Params are used in case of some error messages.
+func FileshareResponseToError(resp *pb.Error, params ...any) error {
 	if resp == nil {
 		return errors.New(AccountInternalError)
 	}
@@ -420,7 +478,7 @@ func fileshareErrorCodeToError(code pb.FileshareErrorCode, params ...any) error
 	case pb.FileshareErrorCode_TRANSFER_INVALIDATED:
 		return errors.New(MsgFileshareTransferInvalidated)
 	case pb.FileshareErrorCode_TOO_MANY_FILES:
-		return errors.New(MsgTooManyFiles)
+		return fmt.Errorf(MsgTooManyFiles, params...)
 	case pb.FileshareErrorCode_DIRECTORY_TOO_DEEP:
 		return errors.New(MsgDirectoryToDeep)
 	case pb.FileshareErrorCode_SENDING_NOT_ALLOWED:
@@ -443,6 +501,10 @@ func fileshareErrorCodeToError(code pb.FileshareErrorCode, params ...any) error
 		return errors.New(MsgNoFiles)
 	case pb.FileshareErrorCode_ACCEPT_DIR_NO_PERMISSIONS:
 		return fmt.Errorf(MsgNoPermissions, params...)
+	case pb.FileshareErrorCode_RESEND_INCOMING:
+		return errors.New(MsgFileshareResendIncoming)
+	case pb.FileshareErrorCode_PENDING_SEND_NOT_FOUND:
+		return errors.New(MsgPendingNotFound)
 	case pb.FileshareErrorCode_PURGE_FAILURE:
 		return errors.New(MsgFileshareClearFailure)
 	default:
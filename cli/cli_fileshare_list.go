@@ -35,7 +35,7 @@ func (c *cmd) getTransfers() ([]*pb.Transfer, error) {
 			}
 			return nil, formatError(err)
 		}
-		if err := getFileshareResponseToError(resp.GetError()); err != nil {
+		if err := FileshareResponseToError(resp.GetError()); err != nil {
 			return nil, formatError(err)
 		}
 
@@ -128,6 +128,16 @@ func (c *cmd) FileshareAutoCompleteTransfersCancel(ctx *cli.Context) {
 	})
 }
 
+// FileshareAutoCompleteTransfersResend does transfer id autocompletion for `fileshare resend`
+func (c *cmd) FileshareAutoCompleteTransfersResend(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	c.fileshareAutoCompleteTransfers(ctx, pb.Direction_OUTGOING, func(s pb.Status) bool {
+		return true
+	})
+}
+
 func transferToOutputString(transfer *pb.Transfer) string {
 	var builder strings.Builder
 	const (
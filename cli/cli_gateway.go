@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/NordSecurity/nordvpn-linux/gateway"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Gateway help text
+const (
+	GatewayUsageText   = "Runs a local HTTP/JSON gateway to the NordVPN daemon"
+	GatewayListenUsage = "Loopback address and port to listen on"
+	GatewayTokenUsage  = "File with the token which clients must send in the 'Authorization: Bearer <token>' header. It is created with a random token if it does not exist."
+	GatewayDescription = `Use this command to let scripts and home automation tools connect, disconnect and read the status without gRPC. The gateway runs with your permissions until it is stopped and accepts connections only from this device.
+
+Endpoints: 'GET /v1/status', 'GET /v1/settings', 'POST /v1/connect' with an optional body '{"server_tag": "germany", "server_group": "p2p"}' and 'POST /v1/disconnect'.
+
+Example: 'nordvpn gateway'
+Example: 'curl -X POST -H "Authorization: Bearer $(cat ~/.config/nordvpn/gateway-token)" http://127.0.0.1:6960/v1/connect'`
+	flagGatewayListen    = "listen"
+	flagGatewayTokenFile = "token-file"
+)
+
+// Gateway serves the HTTP/JSON gateway until interrupted
+func (c *cmd) Gateway(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return formatError(argsCountError(ctx))
+	}
+	// gateway requests must not draw the loader in the terminal
+	c.loaderInterceptor.enabled = false
+
+	tokenPath := ctx.String(flagGatewayTokenFile)
+	if tokenPath == "" {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return formatError(err)
+		}
+		configDir, err := internal.GetConfigDirPath(homeDir)
+		if err != nil {
+			return formatError(err)
+		}
+		tokenPath = filepath.Join(configDir, gateway.TokenFileName)
+	}
+	token, err := gateway.LoadToken(tokenPath)
+	if err != nil {
+		return formatError(fmt.Errorf(MsgGatewayToken, tokenPath, err))
+	}
+
+	listener, err := gateway.Listen(ctx.String(flagGatewayListen))
+	if err != nil {
+		return formatError(err)
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-signals
+		listener.Close()
+	}()
+
+	color.Green(MsgGatewayListening, listener.Addr(), tokenPath)
+	if err := gateway.New(c.client, token).Serve(listener); err != nil {
+		return formatError(err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+
+	"github.com/fatih/color"
+	"github.com/hako/durafmt"
+	"github.com/urfave/cli/v2"
+)
+
+// History help text
+const (
+	HistoryUsageText          = "Shows the latest connection attempts"
+	HistoryFlagLimitUsageText = "Number of the latest connection attempts to show"
+	HistoryDescription        = `Use this command to see when and where VPN was connected, whether connecting has succeeded and how the connection has ended.
+Connections which ended as 'lost' went down without being disconnected, for example, because of the network issues.
+
+Example: 'nordvpn history --limit 5'`
+)
+
+const defaultHistoryLimit = 20
+
+func (c *cmd) History(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	resp, err := c.client.ConnectionHistory(context.Background(), &pb.ConnectionHistoryRequest{
+		Limit: ctx.Int64(flagLimit),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	if len(resp.GetEntries()) == 0 {
+		color.Yellow(MsgHistoryEmpty)
+		return nil
+	}
+	return printHistory(os.Stdout, resp.GetEntries(), time.Now())
+}
+
+func printHistory(w io.Writer, entries []*pb.ConnectionHistoryEntry, now time.Time) error {
+	writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "STARTED\tSERVER\tTECHNOLOGY\tRESULT\tCONNECTED FOR\tENDED")
+	for _, entry := range entries {
+		started := time.Unix(entry.GetStarted(), 0)
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			started.Format(time.DateTime),
+			historyServer(entry),
+			historyTechnology(entry),
+			historyResult(entry),
+			historyConnectedFor(entry, started, now),
+			historyEnd(entry),
+		)
+	}
+	return writer.Flush()
+}
+
+func historyServer(entry *pb.ConnectionHistoryEntry) string {
+	server := entry.GetServer()
+	if server == "" {
+		server = "-"
+	}
+	if entry.GetCity() != "" {
+		return fmt.Sprintf("%s (%s, %s)", server, entry.GetCountry(), entry.GetCity())
+	}
+	if entry.GetCountry() != "" {
+		return fmt.Sprintf("%s (%s)", server, entry.GetCountry())
+	}
+	return server
+}
+
+func historyTechnology(entry *pb.ConnectionHistoryEntry) string {
+	if entry.GetTechnology() == config.Technology_OPENVPN {
+		return entry.GetTechnology().String() + "/" + entry.GetProtocol().String()
+	}
+	return entry.GetTechnology().String()
+}
+
+func historyResult(entry *pb.ConnectionHistoryEntry) string {
+	result := entry.GetResult()
+	if entry.GetError() != "" {
+		result += ": " + entry.GetError()
+	}
+	if entry.GetAutomatic() {
+		result += " (automatic)"
+	}
+	return result
+}
+
+func historyConnectedFor(entry *pb.ConnectionHistoryEntry, started time.Time, now time.Time) string {
+	if entry.GetResult() != "connected" {
+		return "-"
+	}
+	switch {
+	case entry.GetEnded() != 0:
+		return durafmt.Parse(time.Unix(entry.GetEnded(), 0).Sub(started)).LimitFirstN(2).String()
+	case entry.GetEndReason() == "":
+		return durafmt.Parse(now.Sub(started).Truncate(time.Second)).LimitFirstN(2).String() + " (active)"
+	}
+	return "-"
+}
+
+func historyEnd(entry *pb.ConnectionHistoryEntry) string {
+	if entry.GetEndReason() == "" {
+		return "-"
+	}
+	end := entry.GetEndReason()
+	if entry.GetEnded() != 0 {
+		end = fmt.Sprintf("%s at %s", end, time.Unix(entry.GetEnded(), 0).Format(time.DateTime))
+	}
+	if entry.GetDownload() != 0 || entry.GetUpload() != 0 {
+		end = fmt.Sprintf("%s (%s received, %s sent)", end,
+			Uint64ToHumanBytes(entry.GetDownload()), Uint64ToHumanBytes(entry.GetUpload()))
+	}
+	return end
+}
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistoryColumns(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	started := time.Date(2024, 5, 1, 22, 0, 0, 0, time.Local)
+	now := started.Add(90 * time.Minute)
+
+	tests := []struct {
+		name         string
+		entry        *pb.ConnectionHistoryEntry
+		technology   string
+		result       string
+		connectedFor string
+		end          string
+	}{
+		{
+			name: "active",
+			entry: &pb.ConnectionHistoryEntry{
+				Technology: config.Technology_NORDLYNX, Protocol: config.Protocol_UDP, Result: "connected",
+			},
+			technology:   "NORDLYNX",
+			result:       "connected",
+			connectedFor: "1 hour 30 minutes (active)",
+			end:          "-",
+		},
+		{
+			name: "lost",
+			entry: &pb.ConnectionHistoryEntry{
+				Technology: config.Technology_OPENVPN, Protocol: config.Protocol_TCP, Result: "connected",
+				Automatic: true, Ended: started.Add(5 * time.Hour).Unix(), EndReason: "lost",
+			},
+			technology:   "OPENVPN/TCP",
+			result:       "connected (automatic)",
+			connectedFor: "5 hours",
+			end:          "lost at " + started.Add(5*time.Hour).Format(time.DateTime),
+		},
+		{
+			name: "failed",
+			entry: &pb.ConnectionHistoryEntry{
+				Technology: config.Technology_NORDLYNX, Result: "failed", Error: "handshake timeout",
+			},
+			technology:   "NORDLYNX",
+			result:       "failed: handshake timeout",
+			connectedFor: "-",
+			end:          "-",
+		},
+		{
+			name: "daemon stopped during connection",
+			entry: &pb.ConnectionHistoryEntry{
+				Technology: config.Technology_NORDLYNX, Result: "connected", EndReason: "daemon stopped",
+			},
+			technology:   "NORDLYNX",
+			result:       "connected",
+			connectedFor: "-",
+			end:          "daemon stopped",
+		},
+		{
+			name: "daemon stopped while connected",
+			entry: &pb.ConnectionHistoryEntry{
+				Technology: config.Technology_NORDLYNX, Result: "connected",
+				Ended: started.Add(time.Hour).Unix(), EndReason: "daemon stopped", Download: 3 << 20, Upload: 512,
+			},
+			technology:   "NORDLYNX",
+			result:       "connected",
+			connectedFor: "1 hour",
+			end: "daemon stopped at " + started.Add(time.Hour).Format(time.DateTime) +
+				" (3.00 MiB received, 512 B sent)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.entry.Started = started.Unix()
+			assert.Equal(t, test.technology, historyTechnology(test.entry))
+			assert.Equal(t, test.result, historyResult(test.entry))
+			assert.Equal(t, test.connectedFor, historyConnectedFor(test.entry, started, now))
+			assert.Equal(t, test.end, historyEnd(test.entry))
+		})
+	}
+}
+
+func TestHistoryServer(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, "de1.nordvpn.com (Germany, Berlin)",
+		historyServer(&pb.ConnectionHistoryEntry{Server: "de1.nordvpn.com", Country: "Germany", City: "Berlin"}))
+	assert.Equal(t, "de1.nordvpn.com (Germany)",
+		historyServer(&pb.ConnectionHistoryEntry{Server: "de1.nordvpn.com", Country: "Germany"}))
+	assert.Equal(t, "-", historyServer(&pb.ConnectionHistoryEntry{}))
+}
@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Hook help text
+const (
+	HookUsageText     = "Runs executables on VPN connection events"
+	HookArgsUsageText = "<pre-connect|connect|disconnect|reconnect> <executable>"
+
+	HookAddUsageText              = "Adds the executable to be run on the event"
+	HookAddFlagTimeoutUsageText   = "Seconds after which the executable is stopped, 30 by default and 300 at most"
+	HookAddFlagOnFailureUsageText = "What happens when the executable fails or times out: 'ignore' or 'abort'. Only pre-connect hooks can abort the connection."
+	HookAddDescription            = `Use this command to run the executable when VPN is about to connect, has connected, has been disconnected or has been restored after going down.
+Hooks are run as root, therefore the executable and the directories on the way to it must be owned by root and writable only by root.
+Hooks of the same event are run in the order they were added.
+
+The event is described by the environment variables: NORDVPN_EVENT, NORDVPN_SERVER, NORDVPN_SERVER_IP, NORDVPN_COUNTRY, NORDVPN_CITY, NORDVPN_TECHNOLOGY, NORDVPN_PROTOCOL and NORDVPN_INTERFACE.
+
+Example: 'nordvpn hook add connect /etc/nordvpn/hooks/mount-shares.sh'
+Example: 'nordvpn hook add --timeout 10 --on-failure abort pre-connect /etc/nordvpn/hooks/check.sh'`
+
+	HookRemoveUsageText   = "Removes the executable from the event"
+	HookRemoveDescription = `Use this command to stop running the executable on the event.
+
+Example: 'nordvpn hook remove connect /etc/nordvpn/hooks/mount-shares.sh'`
+
+	HookListUsageText = "Shows the hooks"
+)
+
+func (c *cmd) HookAdd(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return formatError(argsCountError(ctx))
+	}
+	event := ctx.Args().First()
+	path, err := filepath.Abs(ctx.Args().Get(1))
+	if err != nil {
+		return formatError(err)
+	}
+
+	resp, err := c.client.AddHook(context.Background(), &pb.Hook{
+		Event:          event,
+		Path:           path,
+		TimeoutSeconds: ctx.Int64(flagTimeout),
+		FailurePolicy:  ctx.String(flagOnFailure),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(errors.New(HookInvalidArgs))
+	case internal.CodeHookInvalidExecutable:
+		return formatError(fmt.Errorf(HookInvalidExecutable, resp.Data[0], resp.Data[1]))
+	case internal.CodeNothingToDo:
+		return formatError(fmt.Errorf(HookExistsError, path, event))
+	case internal.CodeSuccess:
+		color.Green(HookAddSuccess, path, event)
+		return nil
+	}
+	return formatError(internal.ErrUnhandled)
+}
+
+func (c *cmd) HookRemove(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return formatError(argsCountError(ctx))
+	}
+	event := ctx.Args().First()
+	path, err := filepath.Abs(ctx.Args().Get(1))
+	if err != nil {
+		return formatError(err)
+	}
+
+	resp, err := c.client.RemoveHook(context.Background(), &pb.Hook{Event: event, Path: path})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeHookNotFound:
+		return formatError(fmt.Errorf(HookNotFoundError, path, event))
+	case internal.CodeSuccess:
+		color.Green(HookRemoveSuccess, path, event)
+		return nil
+	}
+	return formatError(internal.ErrUnhandled)
+}
+
+func (c *cmd) HookList(ctx *cli.Context) error {
+	resp, err := c.client.Hooks(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	if resp.Type != internal.CodeSuccess {
+		return formatError(ErrConfig)
+	}
+
+	if len(resp.Hooks) == 0 {
+		fmt.Println(HookListEmpty)
+		return nil
+	}
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "EVENT\tEXECUTABLE\tTIMEOUT\tON FAILURE")
+	for _, hook := range resp.Hooks {
+		fmt.Fprintf(writer, "%s\t%s\t%ds\t%s\n",
+			hook.GetEvent(), hook.GetPath(), hook.GetTimeoutSeconds(), hook.GetFailurePolicy())
+	}
+	return writer.Flush()
+}
+
+// HookEventAutoComplete autocompletes the hook events
+func (c *cmd) HookEventAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for _, event := range config.HookEvents {
+		fmt.Println(event)
+	}
+}
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Logs command help text
+const (
+	LogsUsageText      = "Shows the logs of the NordVPN daemon"
+	LogsUserUsageText  = "Shows the logs of the user services (norduserd and fileshare) of the current user instead"
+	LogsLinesUsageText = "Number of the most recent lines to show"
+
+	flagLogsUser  = "user"
+	flagLogsLines = "lines"
+
+	defaultLogsLines = 100
+	journalctlExec   = "journalctl"
+)
+
+func (c *cmd) Logs(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return formatError(argsCountError(ctx))
+	}
+	lines := ctx.Int(flagLogsLines)
+	if lines <= 0 {
+		return formatError(argsParseError(ctx))
+	}
+
+	if !ctx.Bool(flagLogsUser) {
+		return runJournalctl(daemonLogsArgs(lines))
+	}
+
+	if _, err := exec.LookPath(journalctlExec); err == nil && internal.FileExists(internal.JournalSocket) {
+		return runJournalctl(userLogsArgs(os.Getuid(), lines))
+	}
+	return printUserLogFiles(lines)
+}
+
+func daemonLogsArgs(lines int) []string {
+	return []string{"--unit", "nordvpnd", "--lines", strconv.Itoa(lines), "--no-pager"}
+}
+
+// userLogsArgs selects the entries of the user services by the fields added to their journal entries
+func userLogsArgs(uid int, lines int) []string {
+	return []string{
+		"_UID=" + strconv.Itoa(uid),
+		"SYSLOG_IDENTIFIER=" + internal.Norduserd,
+		"SYSLOG_IDENTIFIER=" + internal.Fileshare,
+		"--lines", strconv.Itoa(lines),
+		"--no-pager",
+	}
+}
+
+func runJournalctl(args []string) error {
+	// #nosec G204 -- arguments are constructed by the application
+	cmd := exec.Command(journalctlExec, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return formatError(fmt.Errorf("reading the journal: %w", err))
+	}
+	return nil
+}
+
+// printUserLogFiles prints the logs written to the files when journal is not available
+func printUserLogFiles(lines int) error {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return formatError(err)
+	}
+	cacheDir, err := internal.GetCacheDirPath(homeDir)
+	if err != nil {
+		return formatError(err)
+	}
+
+	for _, name := range []string{internal.NorduserdLogFileName, internal.FileshareLogFileName} {
+		path := filepath.Join(cacheDir, name)
+		// #nosec G304 -- log file paths are constant
+		file, err := os.Open(path)
+		if err != nil {
+			continue
+		}
+		tail, err := tailLines(file, lines)
+		_ = file.Close()
+		if err != nil {
+			return formatError(err)
+		}
+
+		fmt.Printf("==> %s <==\n", path)
+		for _, line := range tail {
+			fmt.Println(line)
+		}
+	}
+	return nil
+}
+
+// tailLines returns at most n last lines
+func tailLines(r io.Reader, n int) ([]string, error) {
+	lines := []string{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+		if len(lines) > n {
+			lines = lines[1:]
+		}
+	}
+	return lines, scanner.Err()
+}
@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTailLines(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	lines, err := tailLines(strings.NewReader("1\n2\n3\n4\n"), 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3", "4"}, lines)
+
+	lines, err = tailLines(strings.NewReader("1\n"), 5)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1"}, lines)
+}
+
+func TestUserLogsArgs(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, []string{
+		"_UID=1000",
+		"SYSLOG_IDENTIFIER=norduserd",
+		"SYSLOG_IDENTIFIER=nordfileshare",
+		"--lines", "50",
+		"--no-pager",
+	}, userLogsArgs(1000, 50))
+}
@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Meshnet advertise help text
+const MeshAdvertiseSetDescription = `Use this command to let the peer devices reach a network behind this device, e.g. its local network.
+Only the peer devices allowed to route traffic through this device can reach the advertised subnets, after accepting them with 'nordvpn meshnet peer route accept'.
+
+Example: 'nordvpn meshnet advertise set 192.168.1.0/24'`
+
+// MeshAdvertiseSet advertises the subnets reachable through this device to the peers
+func (c *cmd) MeshAdvertiseSet(ctx *cli.Context) error {
+	if ctx.NArg() == 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	subnets := ctx.Args().Slice()
+	resp, err := c.meshClient.SetAdvertisedSubnets(
+		context.Background(),
+		&pb.SetAdvertisedSubnetsRequest{Subnets: subnets},
+	)
+	if err != nil {
+		return formatError(err)
+	}
+
+	label := strings.Join(subnets, ", ")
+	if isAdvertisedSubnetsAlreadySet(resp) {
+		color.Yellow(MsgMeshnetAdvertiseAlreadySet, label)
+		return nil
+	}
+	if err := advertisedSubnetsResponseToError(resp); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetAdvertiseSetSuccess, label)
+	return nil
+}
+
+// MeshAdvertiseClear stops advertising the subnets to the peers
+func (c *cmd) MeshAdvertiseClear(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	resp, err := c.meshClient.SetAdvertisedSubnets(context.Background(), &pb.SetAdvertisedSubnetsRequest{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	if isAdvertisedSubnetsAlreadySet(resp) {
+		color.Yellow(MsgMeshnetAdvertiseAlreadyCleared)
+		return nil
+	}
+	if err := advertisedSubnetsResponseToError(resp); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetAdvertiseClearSuccess)
+	return nil
+}
+
+func isAdvertisedSubnetsAlreadySet(resp *pb.AdvertisedSubnetsResponse) bool {
+	code, ok := resp.GetResponse().(*pb.AdvertisedSubnetsResponse_AdvertisedSubnetsErrorCode)
+	return ok && code.AdvertisedSubnetsErrorCode == pb.AdvertisedSubnetsErrorCode_ADVERTISED_SUBNETS_ALREADY_SET
+}
+
+func advertisedSubnetsResponseToError(resp *pb.AdvertisedSubnetsResponse) error {
+	if resp == nil {
+		return errors.New(AccountInternalError)
+	}
+	switch resp := resp.Response.(type) {
+	case *pb.AdvertisedSubnetsResponse_Empty:
+		return nil
+	case *pb.AdvertisedSubnetsResponse_AdvertisedSubnetsErrorCode:
+		return advertisedSubnetsErrorCodeToError(resp.AdvertisedSubnetsErrorCode)
+	case *pb.AdvertisedSubnetsResponse_ServiceErrorCode:
+		return serviceErrorCodeToError(resp.ServiceErrorCode)
+	case *pb.AdvertisedSubnetsResponse_MeshnetErrorCode:
+		return meshnetErrorToError(resp.MeshnetErrorCode)
+	default:
+		return errors.New(AccountInternalError)
+	}
+}
+
+func advertisedSubnetsErrorCodeToError(code pb.AdvertisedSubnetsErrorCode) error {
+	switch code {
+	case pb.AdvertisedSubnetsErrorCode_INVALID_ADVERTISED_SUBNET:
+		return errors.New(MsgMeshnetAdvertiseInvalidSubnet)
+	case pb.AdvertisedSubnetsErrorCode_ADVERTISED_SUBNETS_OVERLAP:
+		return errors.New(MsgMeshnetAdvertiseSubnetsOverlap)
+	case pb.AdvertisedSubnetsErrorCode_TOO_MANY_ADVERTISED_SUBNETS:
+		return fmt.Errorf(MsgMeshnetAdvertiseTooManySubnets, config.MaxAdvertisedSubnets)
+	default:
+		return errors.New(AccountInternalError)
+	}
+}
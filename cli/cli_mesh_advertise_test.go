@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdvertisedSubnetsResponseToError(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		resp     *pb.AdvertisedSubnetsResponse
+		expected string
+	}{
+		{
+			name: "success",
+			resp: &pb.AdvertisedSubnetsResponse{Response: &pb.AdvertisedSubnetsResponse_Empty{}},
+		},
+		{
+			name: "invalid subnet",
+			resp: &pb.AdvertisedSubnetsResponse{Response: &pb.AdvertisedSubnetsResponse_AdvertisedSubnetsErrorCode{
+				AdvertisedSubnetsErrorCode: pb.AdvertisedSubnetsErrorCode_INVALID_ADVERTISED_SUBNET,
+			}},
+			expected: MsgMeshnetAdvertiseInvalidSubnet,
+		},
+		{
+			name: "overlapping subnets",
+			resp: &pb.AdvertisedSubnetsResponse{Response: &pb.AdvertisedSubnetsResponse_AdvertisedSubnetsErrorCode{
+				AdvertisedSubnetsErrorCode: pb.AdvertisedSubnetsErrorCode_ADVERTISED_SUBNETS_OVERLAP,
+			}},
+			expected: MsgMeshnetAdvertiseSubnetsOverlap,
+		},
+		{
+			name: "too many subnets",
+			resp: &pb.AdvertisedSubnetsResponse{Response: &pb.AdvertisedSubnetsResponse_AdvertisedSubnetsErrorCode{
+				AdvertisedSubnetsErrorCode: pb.AdvertisedSubnetsErrorCode_TOO_MANY_ADVERTISED_SUBNETS,
+			}},
+			expected: "At most 16 subnets can be advertised.",
+		},
+		{
+			name: "not logged in",
+			resp: &pb.AdvertisedSubnetsResponse{Response: &pb.AdvertisedSubnetsResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+			}},
+			expected: internal.ErrNotLoggedIn.Error(),
+		},
+		{
+			name:     "empty response",
+			resp:     &pb.AdvertisedSubnetsResponse{},
+			expected: AccountInternalError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := advertisedSubnetsResponseToError(test.resp)
+			if test.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, test.expected)
+		})
+	}
+}
+
+func TestIsAdvertisedSubnetsAlreadySet(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.True(t, isAdvertisedSubnetsAlreadySet(&pb.AdvertisedSubnetsResponse{
+		Response: &pb.AdvertisedSubnetsResponse_AdvertisedSubnetsErrorCode{
+			AdvertisedSubnetsErrorCode: pb.AdvertisedSubnetsErrorCode_ADVERTISED_SUBNETS_ALREADY_SET,
+		},
+	}))
+	assert.False(t, isAdvertisedSubnetsAlreadySet(&pb.AdvertisedSubnetsResponse{
+		Response: &pb.AdvertisedSubnetsResponse_Empty{},
+	}))
+	assert.False(t, isAdvertisedSubnetsAlreadySet(nil))
+}
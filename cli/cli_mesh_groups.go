@@ -0,0 +1,210 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/meshnet"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Meshnet group help text
+const MeshGroupPermissionsDescription = `Use this command to change the permissions of all the peers in a group at once.
+Permissions are incoming, routing, local and fileshare, the ones which are not given are left as they are.
+
+Example: 'nordvpn meshnet group permissions work incoming=allow fileshare=allow routing=deny'`
+
+func (c *cmd) MeshGroupList(ctx *cli.Context) error {
+	resp, err := c.meshClient.GetPeerGroups(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	if code, ok := resp.Response.(*pb.GetPeerGroupsResponse_ServiceErrorCode); ok {
+		return formatError(serviceErrorCodeToError(code.ServiceErrorCode))
+	}
+
+	groups := resp.GetGroups().GetGroups()
+	if len(groups) == 0 {
+		color.Yellow(MsgMeshnetGroupNone)
+		return nil
+	}
+
+	// peers are shown by their names when possible, the ones which left the meshnet by their public keys
+	names := map[string]string{}
+	if peersResp, err := c.meshClient.GetPeers(context.Background(), &pb.Empty{}); err == nil {
+		if peers, err := getPeersResponseToPeerList(peersResp); err == nil {
+			byPubkey, _ := meshnet.MakePeerMaps(peers)
+			for pubkey, peer := range byPubkey {
+				names[pubkey] = peerDisplayName(peer)
+			}
+		}
+	}
+
+	for _, group := range groups {
+		var peers []string
+		for _, pubkey := range group.GetPubkeys() {
+			if name, ok := names[pubkey]; ok {
+				pubkey = name
+			}
+			peers = append(peers, pubkey)
+		}
+		fmt.Printf("%s: %s\n", color.New(color.Bold).Sprint(group.GetName()), strings.Join(peers, ", "))
+	}
+	return nil
+}
+
+func peerDisplayName(peer *pb.Peer) string {
+	if peer.GetNickname() != "" {
+		return peer.GetNickname()
+	}
+	return peer.GetHostname()
+}
+
+func (c *cmd) MeshGroupAdd(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return formatError(argsCountError(ctx))
+	}
+	group, identifier := ctx.Args().Get(0), ctx.Args().Get(1)
+
+	resp, err := c.meshClient.AddPeerToGroup(context.Background(), &pb.PeerGroupRequest{
+		Group:      group,
+		Identifier: identifier,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := peerGroupResponseToError(resp, group, identifier); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetGroupAddSuccess, identifier, group)
+	return nil
+}
+
+func (c *cmd) MeshGroupRemove(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return formatError(argsCountError(ctx))
+	}
+	group, identifier := ctx.Args().Get(0), ctx.Args().Get(1)
+
+	resp, err := c.meshClient.RemovePeerFromGroup(context.Background(), &pb.PeerGroupRequest{
+		Group:      group,
+		Identifier: identifier,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := peerGroupResponseToError(resp, group, identifier); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetGroupRemoveSuccess, identifier, group)
+	return nil
+}
+
+func (c *cmd) MeshGroupPermissions(ctx *cli.Context) error {
+	if ctx.NArg() < 2 {
+		return formatError(argsCountError(ctx))
+	}
+
+	req, err := parseGroupPermissions(ctx.Args().First(), ctx.Args().Tail())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.meshClient.SetPeerGroupPermissions(context.Background(), req)
+	if err != nil {
+		return formatError(err)
+	}
+	if err := peerGroupResponseToError(resp, req.GetGroup(), ""); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetGroupPermissionsSet, req.GetGroup())
+	return nil
+}
+
+func (c *cmd) MeshGroupAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	resp, err := c.meshClient.GetPeerGroups(context.Background(), &pb.Empty{})
+	if err != nil {
+		return
+	}
+	for _, group := range resp.GetGroups().GetGroups() {
+		fmt.Println(group.GetName())
+	}
+}
+
+// parseGroupPermissions parses the permission changes given as <permission>=allow|deny
+func parseGroupPermissions(group string, args []string) (*pb.SetPeerGroupPermissionsRequest, error) {
+	req := &pb.SetPeerGroupPermissionsRequest{Group: group}
+	for _, arg := range args {
+		name, value, ok := strings.Cut(strings.ToLower(arg), "=")
+		if !ok {
+			return nil, fmt.Errorf("permission %q is not in the <permission>=allow|deny form", arg)
+		}
+
+		var change pb.PermissionChange
+		switch value {
+		case "allow":
+			change = pb.PermissionChange_PERMISSION_ALLOW
+		case "deny":
+			change = pb.PermissionChange_PERMISSION_DENY
+		default:
+			return nil, fmt.Errorf("permission %q can only be allowed or denied", name)
+		}
+
+		switch name {
+		case "incoming":
+			req.Incoming = change
+		case "routing":
+			req.Routing = change
+		case "local":
+			req.LocalNetwork = change
+		case "fileshare":
+			req.Fileshare = change
+		default:
+			return nil, fmt.Errorf("unknown permission %q", name)
+		}
+	}
+	return req, nil
+}
+
+func peerGroupResponseToError(resp *pb.PeerGroupResponse, group string, identifier string) error {
+	if resp == nil {
+		return errors.New(AccountInternalError)
+	}
+
+	switch resp := resp.Response.(type) {
+	case *pb.PeerGroupResponse_Empty:
+		return nil
+	case *pb.PeerGroupResponse_ServiceErrorCode:
+		return serviceErrorCodeToError(resp.ServiceErrorCode)
+	case *pb.PeerGroupResponse_MeshnetErrorCode:
+		return meshnetErrorToError(resp.MeshnetErrorCode)
+	case *pb.PeerGroupResponse_UpdatePeerErrorCode:
+		return updatePeerErrorCodeToError(resp.UpdatePeerErrorCode, identifier)
+	case *pb.PeerGroupResponse_PeerGroupErrorCode:
+		switch resp.PeerGroupErrorCode {
+		case pb.PeerGroupErrorCode_GROUP_NOT_FOUND:
+			return fmt.Errorf(MsgMeshnetGroupNotFound, group)
+		case pb.PeerGroupErrorCode_INVALID_GROUP_NAME:
+			return fmt.Errorf(MsgMeshnetGroupInvalidName, config.MaxPeerGroupNameLength)
+		case pb.PeerGroupErrorCode_PEER_ALREADY_IN_GROUP:
+			return fmt.Errorf(MsgMeshnetGroupPeerInGroup, identifier, group)
+		case pb.PeerGroupErrorCode_PEER_NOT_IN_GROUP:
+			return fmt.Errorf(MsgMeshnetGroupPeerNotInGroup, identifier, group)
+		case pb.PeerGroupErrorCode_TOO_MANY_GROUPS:
+			return fmt.Errorf(MsgMeshnetGroupTooMany, config.MaxPeerGroups)
+		}
+	}
+	return errors.New(AccountInternalError)
+}
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGroupPermissions(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected *pb.SetPeerGroupPermissionsRequest
+	}{
+		{
+			name: "all permissions",
+			args: []string{"incoming=allow", "routing=deny", "local=allow", "fileshare=deny"},
+			expected: &pb.SetPeerGroupPermissionsRequest{
+				Group:        "work",
+				Incoming:     pb.PermissionChange_PERMISSION_ALLOW,
+				Routing:      pb.PermissionChange_PERMISSION_DENY,
+				LocalNetwork: pb.PermissionChange_PERMISSION_ALLOW,
+				Fileshare:    pb.PermissionChange_PERMISSION_DENY,
+			},
+		},
+		{
+			name: "other permissions are unchanged",
+			args: []string{"Fileshare=Allow"},
+			expected: &pb.SetPeerGroupPermissionsRequest{
+				Group:     "work",
+				Fileshare: pb.PermissionChange_PERMISSION_ALLOW,
+			},
+		},
+		{name: "missing value", args: []string{"incoming"}},
+		{name: "invalid value", args: []string{"incoming=yes"}},
+		{name: "unknown permission", args: []string{"everything=allow"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req, err := parseGroupPermissions("work", test.args)
+			if test.expected == nil {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected.String(), req.String())
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
 
@@ -50,6 +51,10 @@ func (c *cmd) MeshGetInvites(ctx *cli.Context) error {
 		str := fmt.Sprintf("%s: %s",
 			color.New(color.FgYellow, color.Bold).Sprintf("Email"),
 			color.New(color.FgYellow).Sprintf(invite.Email))
+		if invite.GetExpiresAt() != nil {
+			str += " (" + fmt.Sprintf(MsgMeshnetInviteExpires,
+				invite.GetExpiresAt().AsTime().Local().Format(time.DateTime)) + ")"
+		}
 		buf.WriteString(str + "\n")
 	}
 
@@ -69,6 +74,14 @@ func (c *cmd) MeshGetInvites(ctx *cli.Context) error {
 		buf.WriteString(str + "\n")
 	}
 
+	if invites.AutoAccept {
+		buf.WriteString("\n" + MsgMeshnetInviteAutoAcceptNote + "\n")
+	}
+	if invites.InviteTtl != 0 {
+		ttl := time.Duration(invites.InviteTtl) * time.Second
+		buf.WriteString("\n" + fmt.Sprintf(MsgMeshnetInviteTTLNote, inviteTTLLabel(ttl)) + "\n")
+	}
+
 	fmt.Print(buf.String())
 	return nil
 }
@@ -205,6 +218,28 @@ func (c *cmd) MeshInviteRevoke(ctx *cli.Context) error {
 	)
 }
 
+// MeshInviteResend sends a meshnet invite resend request to a daemon
+func (c *cmd) MeshInviteResend(ctx *cli.Context) error {
+	email := ctx.Args().First()
+	if email == "" {
+		return formatError(argsCountError(ctx))
+	}
+
+	resp, err := c.meshClient.ResendInvite(
+		context.Background(),
+		&pb.DenyInviteRequest{Email: email},
+	)
+	if err != nil {
+		return formatError(err)
+	}
+
+	if err := inviteResponseToError(resp, email); err != nil {
+		return formatError(err)
+	}
+	color.Green(MsgMeshnetInviteResendSuccess, email)
+	return nil
+}
+
 // MeshInviteDeny sends the meshnet accept invite request to a daemon
 func (c *cmd) MeshInviteAccept(ctx *cli.Context) error {
 	reqFn := func(email string) (
@@ -322,7 +357,7 @@ func (c *cmd) MeshInviteAutoCompletion(ctx *cli.Context) {
 	}
 
 	var invs []*pb.Invite
-	if ctx.Command.Name == "revoke" {
+	if ctx.Command.Name == "revoke" || ctx.Command.Name == "resend" {
 		invs = invites.Sent
 	} else {
 		invs = invites.Received
@@ -397,6 +432,8 @@ func inviteErrorCodeToError(
 		return errors.New(MsgMeshnetInviteSendDeviceCount)
 	case pb.InviteResponseErrorCode_LIMIT_REACHED:
 		return errors.New(MsgMeshnetInviteWeeklyLimit)
+	case pb.InviteResponseErrorCode_INVITE_NOT_FOUND:
+		return fmt.Errorf(MsgMeshnetInviteNoSentInvitation, email)
 	default:
 		return errors.New(AccountInternalError)
 	}
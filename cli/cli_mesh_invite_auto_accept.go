@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// MeshInviteAutoAccept enables or disables accepting the invites from the devices of the same account automatically
+func (c *cmd) MeshInviteAutoAccept(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	flag, err := nstrings.BoolFromString(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	req := &pb.SetAutoAcceptInvitesRequest{Enabled: flag}
+	if flag {
+		permissions := c.meshPermissions(ctx)
+		req.AllowIncomingTraffic = permissions.allowTraffic
+		req.AllowTrafficRouting = permissions.routeTraffic
+		req.AllowLocalNetwork = permissions.localNetwork
+		req.AllowFileshare = permissions.fileshare
+	}
+
+	resp, err := c.meshClient.SetAutoAcceptInvites(context.Background(), req)
+	if err != nil {
+		return formatError(err)
+	}
+
+	if err := autoAcceptInvitesResponseToError(resp); err != nil {
+		return formatError(err)
+	}
+
+	if flag {
+		color.Green(MsgMeshnetInviteAutoAcceptEnabled)
+	} else {
+		color.Green(MsgMeshnetInviteAutoAcceptDisabled)
+	}
+	return nil
+}
+
+func autoAcceptInvitesResponseToError(resp *pb.AutoAcceptInvitesResponse) error {
+	if resp == nil {
+		return errors.New(AccountInternalError)
+	}
+	switch resp := resp.Response.(type) {
+	case *pb.AutoAcceptInvitesResponse_Empty:
+		return nil
+	case *pb.AutoAcceptInvitesResponse_ServiceErrorCode:
+		return serviceErrorCodeToError(resp.ServiceErrorCode)
+	case *pb.AutoAcceptInvitesResponse_MeshnetErrorCode:
+		return meshnetErrorToError(resp.MeshnetErrorCode)
+	default:
+		return errors.New(AccountInternalError)
+	}
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoAcceptInvitesResponseToError(t *testing.T) {
+	category.Set(t, category.Unit)
+	tests := []struct {
+		name string
+		resp *pb.AutoAcceptInvitesResponse
+		err  error
+	}{
+		{
+			name: "unknown",
+			err:  errors.New(itsUsMsg),
+		},
+		{
+			name: "service response code",
+			resp: &pb.AutoAcceptInvitesResponse{
+				Response: &pb.AutoAcceptInvitesResponse_ServiceErrorCode{
+					ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+				},
+			},
+			err: internal.ErrNotLoggedIn,
+		},
+		{
+			name: "meshnet disabled",
+			resp: &pb.AutoAcceptInvitesResponse{
+				Response: &pb.AutoAcceptInvitesResponse_MeshnetErrorCode{
+					MeshnetErrorCode: pb.MeshnetErrorCode_NOT_ENABLED,
+				},
+			},
+			err: errors.New(MsgMeshnetNotEnabled),
+		},
+		{
+			name: "no error",
+			resp: &pb.AutoAcceptInvitesResponse{
+				Response: &pb.AutoAcceptInvitesResponse_Empty{
+					Empty: &pb.Empty{},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.err, autoAcceptInvitesResponseToError(tt.resp))
+		})
+	}
+}
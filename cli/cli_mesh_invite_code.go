@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// MeshInviteCode creates a short-lived invite code which lets another device join the meshnet
+func (c *cmd) MeshInviteCode(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	permissions := c.meshPermissions(ctx)
+	resp, err := c.meshClient.CreateInviteCode(
+		context.Background(),
+		&pb.CreateInviteCodeRequest{
+			AllowIncomingTraffic: permissions.allowTraffic,
+			AllowTrafficRouting:  permissions.routeTraffic,
+			AllowLocalNetwork:    permissions.localNetwork,
+			AllowFileshare:       permissions.fileshare,
+		},
+	)
+	if err != nil {
+		return formatError(err)
+	}
+
+	code, err := createInviteCodeResponseToInviteCode(resp)
+	if err != nil {
+		return formatError(err)
+	}
+
+	color.Green(
+		MsgMeshnetInviteCodeCreated,
+		code.GetCode(),
+		code.GetPayload(),
+		code.GetExpiresAt().AsTime().Local().Format(time.DateTime),
+	)
+	return nil
+}
+
+// MeshInviteRedeem joins the meshnet of another device using its invite code
+func (c *cmd) MeshInviteRedeem(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	permissions := c.meshPermissions(ctx)
+	resp, err := c.meshClient.RedeemInviteCode(
+		context.Background(),
+		&pb.RedeemInviteCodeRequest{
+			Code:                 ctx.Args().First(),
+			AllowIncomingTraffic: permissions.allowTraffic,
+			AllowTrafficRouting:  permissions.routeTraffic,
+			AllowLocalNetwork:    permissions.localNetwork,
+			AllowFileshare:       permissions.fileshare,
+		},
+	)
+	if err != nil {
+		return formatError(err)
+	}
+
+	if err := redeemInviteCodeResponseToError(resp); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetInviteRedeemSuccess)
+	return nil
+}
+
+func createInviteCodeResponseToInviteCode(resp *pb.CreateInviteCodeResponse) (*pb.InviteCode, error) {
+	if resp == nil {
+		return nil, errors.New(AccountInternalError)
+	}
+	switch resp := resp.Response.(type) {
+	case *pb.CreateInviteCodeResponse_InviteCode:
+		return resp.InviteCode, nil
+	case *pb.CreateInviteCodeResponse_InviteCodeErrorCode:
+		return nil, inviteCodeErrorCodeToError(resp.InviteCodeErrorCode)
+	case *pb.CreateInviteCodeResponse_ServiceErrorCode:
+		return nil, serviceErrorCodeToError(resp.ServiceErrorCode)
+	case *pb.CreateInviteCodeResponse_MeshnetErrorCode:
+		return nil, meshnetErrorToError(resp.MeshnetErrorCode)
+	default:
+		return nil, errors.New(AccountInternalError)
+	}
+}
+
+func redeemInviteCodeResponseToError(resp *pb.RedeemInviteCodeResponse) error {
+	if resp == nil {
+		return errors.New(AccountInternalError)
+	}
+	switch resp := resp.Response.(type) {
+	case *pb.RedeemInviteCodeResponse_Empty:
+		return nil
+	case *pb.RedeemInviteCodeResponse_InviteCodeErrorCode:
+		return inviteCodeErrorCodeToError(resp.InviteCodeErrorCode)
+	case *pb.RedeemInviteCodeResponse_ServiceErrorCode:
+		return serviceErrorCodeToError(resp.ServiceErrorCode)
+	case *pb.RedeemInviteCodeResponse_MeshnetErrorCode:
+		return meshnetErrorToError(resp.MeshnetErrorCode)
+	default:
+		return errors.New(AccountInternalError)
+	}
+}
+
+func inviteCodeErrorCodeToError(code pb.InviteCodeErrorCode) error {
+	switch code {
+	case pb.InviteCodeErrorCode_INVALID_INVITE_CODE:
+		return errors.New(MsgMeshnetInviteCodeInvalid)
+	case pb.InviteCodeErrorCode_INVITE_CODE_PEER_COUNT:
+		return errors.New(MsgMeshnetInviteAcceptDeviceCount)
+	default:
+		return errors.New(AccountInternalError)
+	}
+}
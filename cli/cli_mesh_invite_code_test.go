@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedeemInviteCodeResponseToError(t *testing.T) {
+	category.Set(t, category.Unit)
+	tests := []struct {
+		name string
+		resp *pb.RedeemInviteCodeResponse
+		err  error
+	}{
+		{
+			name: "unknown",
+			err:  errors.New(itsUsMsg),
+		},
+		{
+			name: "service response code",
+			resp: &pb.RedeemInviteCodeResponse{
+				Response: &pb.RedeemInviteCodeResponse_ServiceErrorCode{
+					ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+				},
+			},
+			err: internal.ErrNotLoggedIn,
+		},
+		{
+			name: "invalid code",
+			resp: &pb.RedeemInviteCodeResponse{
+				Response: &pb.RedeemInviteCodeResponse_InviteCodeErrorCode{
+					InviteCodeErrorCode: pb.InviteCodeErrorCode_INVALID_INVITE_CODE,
+				},
+			},
+			err: errors.New(MsgMeshnetInviteCodeInvalid),
+		},
+		{
+			name: "device count",
+			resp: &pb.RedeemInviteCodeResponse{
+				Response: &pb.RedeemInviteCodeResponse_InviteCodeErrorCode{
+					InviteCodeErrorCode: pb.InviteCodeErrorCode_INVITE_CODE_PEER_COUNT,
+				},
+			},
+			err: errors.New(MsgMeshnetInviteAcceptDeviceCount),
+		},
+		{
+			name: "no error",
+			resp: &pb.RedeemInviteCodeResponse{
+				Response: &pb.RedeemInviteCodeResponse_Empty{
+					Empty: &pb.Empty{},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.err, redeemInviteCodeResponseToError(tt.resp))
+		})
+	}
+}
+
+func TestCreateInviteCodeResponseToInviteCode(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	code := &pb.InviteCode{Code: "ABCD-1234", Payload: "nordvpn://meshnet/join?code=ABCD-1234"}
+	got, err := createInviteCodeResponseToInviteCode(&pb.CreateInviteCodeResponse{
+		Response: &pb.CreateInviteCodeResponse_InviteCode{InviteCode: code},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, code, got)
+
+	_, err = createInviteCodeResponseToInviteCode(&pb.CreateInviteCodeResponse{
+		Response: &pb.CreateInviteCodeResponse_MeshnetErrorCode{
+			MeshnetErrorCode: pb.MeshnetErrorCode_NOT_ENABLED,
+		},
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const inviteTTLDefault = "default"
+
+// MeshInviteTTL sets how long the sent invites stay valid
+func (c *cmd) MeshInviteTTL(ctx *cli.Context) error {
+	if ctx.NArg() == 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	ttl, err := parseInviteTTL(strings.Join(ctx.Args().Slice(), " "))
+	if err != nil {
+		return formatError(err)
+	}
+
+	resp, err := c.meshClient.SetInviteTTL(
+		context.Background(),
+		&pb.SetInviteTTLRequest{Ttl: uint32(ttl.Seconds())},
+	)
+	if err != nil {
+		return formatError(err)
+	}
+
+	if resp.GetInviteTtlErrorCode() == pb.InviteTTLErrorCode_INVITE_TTL_ALREADY_SET {
+		color.Yellow(MsgMeshnetInviteTTLAlreadySet, inviteTTLLabel(ttl))
+		return nil
+	}
+	if err := inviteTTLResponseToError(resp); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetInviteTTLSuccess, inviteTTLLabel(ttl))
+	return nil
+}
+
+func (c *cmd) MeshInviteTTLAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() == 0 {
+		fmt.Println(inviteTTLDefault)
+	}
+}
+
+// parseInviteTTL parses the time span and checks whether it is in the allowed range. It returns 0 for the default of
+// the API.
+func parseInviteTTL(arg string) (time.Duration, error) {
+	if strings.EqualFold(arg, inviteTTLDefault) {
+		return 0, nil
+	}
+	years, months, days, seconds, err := parseTimespan(arg)
+	if err != nil {
+		return 0, err
+	}
+	ttl := time.Duration(days)*24*time.Hour + time.Duration(seconds)*time.Second
+	// zero is the default, it is set only with 'default'
+	if years != 0 || months != 0 || ttl == 0 || config.ValidateInviteTTL(ttl) != nil {
+		return 0, errors.New(MsgMeshnetInviteTTLInvalid)
+	}
+	return ttl, nil
+}
+
+func inviteTTLLabel(ttl time.Duration) string {
+	const day = 24 * time.Hour
+	switch {
+	case ttl == 0:
+		return MsgMeshnetInviteTTLDefault
+	case ttl == day:
+		return "1 day"
+	case ttl%day == 0:
+		return fmt.Sprintf("%d days", ttl/day)
+	case ttl == time.Hour:
+		return "1 hour"
+	case ttl%time.Hour == 0:
+		return fmt.Sprintf("%d hours", ttl/time.Hour)
+	default:
+		return ttl.String()
+	}
+}
+
+func inviteTTLResponseToError(resp *pb.InviteTTLResponse) error {
+	if resp == nil {
+		return errors.New(AccountInternalError)
+	}
+	switch resp := resp.Response.(type) {
+	case *pb.InviteTTLResponse_Empty:
+		return nil
+	case *pb.InviteTTLResponse_InviteTtlErrorCode:
+		if resp.InviteTtlErrorCode == pb.InviteTTLErrorCode_INVALID_INVITE_TTL {
+			return errors.New(MsgMeshnetInviteTTLInvalid)
+		}
+		return errors.New(AccountInternalError)
+	case *pb.InviteTTLResponse_ServiceErrorCode:
+		return serviceErrorCodeToError(resp.ServiceErrorCode)
+	case *pb.InviteTTLResponse_MeshnetErrorCode:
+		return meshnetErrorToError(resp.MeshnetErrorCode)
+	default:
+		return errors.New(AccountInternalError)
+	}
+}
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInviteTTL(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		arg      string
+		expected time.Duration
+		err      bool
+	}{
+		{arg: "7d", expected: 7 * 24 * time.Hour},
+		{arg: "1h", expected: time.Hour},
+		{arg: "1d 12h", expected: 36 * time.Hour},
+		{arg: "30d", expected: 30 * 24 * time.Hour},
+		{arg: "default", expected: 0},
+		{arg: "DEFAULT", expected: 0},
+		{arg: "0", err: true},
+		{arg: "59m", err: true},
+		{arg: "31d", err: true},
+		{arg: "1y", err: true},
+		{arg: "forever", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.arg, func(t *testing.T) {
+			ttl, err := parseInviteTTL(test.arg)
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, ttl)
+		})
+	}
+}
+
+func TestInviteTTLLabel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, MsgMeshnetInviteTTLDefault, inviteTTLLabel(0))
+	assert.Equal(t, "1 hour", inviteTTLLabel(time.Hour))
+	assert.Equal(t, "36 hours", inviteTTLLabel(36*time.Hour))
+	assert.Equal(t, "1 day", inviteTTLLabel(24*time.Hour))
+	assert.Equal(t, "7 days", inviteTTLLabel(7*24*time.Hour))
+	assert.Equal(t, "1h30m0s", inviteTTLLabel(90*time.Minute))
+}
@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Meshnet peer bandwidth help text
+const MeshPeerBandwidthSetDescription = `Use this command to cap the bandwidth a peer device can use while routing traffic or accessing the local network through this device.
+The limit applies to uploads and downloads separately. It is in Mbit/s unless 'kbit', 'mbit' or 'gbit' is appended.
+
+Example: 'nordvpn meshnet peer bandwidth set guest-123.nord 20mbit'`
+
+// MeshPeerSetBandwidth limits the traffic the peer can route through this device
+func (c *cmd) MeshPeerSetBandwidth(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return formatError(argsCountError(ctx))
+	}
+
+	limit, err := parseBandwidthLimit(ctx.Args().Get(1))
+	if err != nil {
+		return formatError(err)
+	}
+
+	peer, err := c.retrievePeerFromArgs(ctx)
+	if err != nil {
+		return formatError(err)
+	}
+
+	resp, err := c.meshClient.SetPeerBandwidthLimit(context.Background(), &pb.SetPeerBandwidthLimitRequest{
+		Identifier: peer.GetIdentifier(),
+		Limit:      limit,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	if isPeerBandwidthLimitAlreadySet(resp) {
+		color.Yellow(MsgMeshnetPeerBandwidthAlreadySet, peerDisplayName(peer), bandwidthLimitToString(limit))
+		return nil
+	}
+	if err := peerBandwidthLimitResponseToError(resp, peer.GetHostname()); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetPeerSetBandwidthSuccess, peerDisplayName(peer), bandwidthLimitToString(limit))
+	return nil
+}
+
+// MeshPeerRemoveBandwidth removes the bandwidth limit of the peer
+func (c *cmd) MeshPeerRemoveBandwidth(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	peer, err := c.retrievePeerFromArgs(ctx)
+	if err != nil {
+		return formatError(err)
+	}
+
+	resp, err := c.meshClient.SetPeerBandwidthLimit(context.Background(), &pb.SetPeerBandwidthLimitRequest{
+		Identifier: peer.GetIdentifier(),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	if isPeerBandwidthLimitAlreadySet(resp) {
+		color.Yellow(MsgMeshnetPeerBandwidthAlreadyRemoved, peerDisplayName(peer))
+		return nil
+	}
+	if err := peerBandwidthLimitResponseToError(resp, peer.GetHostname()); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetPeerRemoveBandwidthSuccess, peerDisplayName(peer))
+	return nil
+}
+
+// parseBandwidthLimit converts the limit with an optional unit to kbit/s, Mbit/s is used when the unit is missing
+func parseBandwidthLimit(value string) (uint32, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	multiplier := uint64(1000)
+	for unit, unitMultiplier := range map[string]uint64{"kbit": 1, "mbit": 1000, "gbit": 1_000_000} {
+		if strings.HasSuffix(value, unit) {
+			value = strings.TrimSuffix(value, unit)
+			multiplier = unitMultiplier
+			break
+		}
+	}
+
+	amount, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || amount == 0 || amount*multiplier > math.MaxUint32 {
+		return 0, invalidBandwidthLimitError()
+	}
+	limit := uint32(amount * multiplier)
+	if err := config.ValidatePeerBandwidthLimit(limit); err != nil {
+		return 0, invalidBandwidthLimitError()
+	}
+	return limit, nil
+}
+
+// bandwidthLimitToString describes the limit in kbit/s using the largest unit it can be expressed in
+func bandwidthLimitToString(limit uint32) string {
+	switch {
+	case limit == 0:
+		return "-"
+	case limit%1_000_000 == 0:
+		return fmt.Sprintf("%d Gbit/s", limit/1_000_000)
+	case limit%1000 == 0:
+		return fmt.Sprintf("%d Mbit/s", limit/1000)
+	default:
+		return fmt.Sprintf("%d kbit/s", limit)
+	}
+}
+
+func invalidBandwidthLimitError() error {
+	return fmt.Errorf(
+		MsgMeshnetPeerBandwidthInvalid,
+		bandwidthLimitToString(config.MinPeerBandwidthLimit),
+		bandwidthLimitToString(config.MaxPeerBandwidthLimit),
+	)
+}
+
+func isPeerBandwidthLimitAlreadySet(resp *pb.PeerBandwidthLimitResponse) bool {
+	code, ok := resp.GetResponse().(*pb.PeerBandwidthLimitResponse_PeerBandwidthLimitErrorCode)
+	return ok && code.PeerBandwidthLimitErrorCode == pb.PeerBandwidthLimitErrorCode_BANDWIDTH_LIMIT_ALREADY_SET
+}
+
+func peerBandwidthLimitResponseToError(resp *pb.PeerBandwidthLimitResponse, identifier string) error {
+	if resp == nil {
+		return errors.New(AccountInternalError)
+	}
+
+	switch resp := resp.Response.(type) {
+	case *pb.PeerBandwidthLimitResponse_Empty:
+		return nil
+	case *pb.PeerBandwidthLimitResponse_ServiceErrorCode:
+		return serviceErrorCodeToError(resp.ServiceErrorCode)
+	case *pb.PeerBandwidthLimitResponse_MeshnetErrorCode:
+		return meshnetErrorToError(resp.MeshnetErrorCode)
+	case *pb.PeerBandwidthLimitResponse_UpdatePeerErrorCode:
+		return updatePeerErrorCodeToError(resp.UpdatePeerErrorCode, identifier)
+	case *pb.PeerBandwidthLimitResponse_PeerBandwidthLimitErrorCode:
+		if resp.PeerBandwidthLimitErrorCode == pb.PeerBandwidthLimitErrorCode_INVALID_BANDWIDTH_LIMIT {
+			return invalidBandwidthLimitError()
+		}
+	}
+	return errors.New(AccountInternalError)
+}
@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBandwidthLimit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		value    string
+		expected uint32
+		invalid  bool
+	}{
+		{value: "20", expected: 20_000},
+		{value: "20mbit", expected: 20_000},
+		{value: "512kbit", expected: 512},
+		{value: "1Gbit", expected: 1_000_000},
+		{value: "10gbit", expected: 10_000_000},
+		{value: "11gbit", invalid: true},
+		{value: "32kbit", invalid: true},
+		{value: "0", invalid: true},
+		{value: "-5", invalid: true},
+		{value: "fast", invalid: true},
+		{value: "5000000gbit", invalid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			limit, err := parseBandwidthLimit(test.value)
+			if test.invalid {
+				assert.ErrorContains(t, err, "The bandwidth limit must be from 64 kbit/s to 10 Gbit/s.")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, limit)
+		})
+	}
+}
+
+func TestBandwidthLimitToString(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, "-", bandwidthLimitToString(0))
+	assert.Equal(t, "512 kbit/s", bandwidthLimitToString(512))
+	assert.Equal(t, "1500 kbit/s", bandwidthLimitToString(1500))
+	assert.Equal(t, "20 Mbit/s", bandwidthLimitToString(20_000))
+	assert.Equal(t, "2 Gbit/s", bandwidthLimitToString(2_000_000))
+}
+
+func TestPeerBandwidthLimitResponseToError(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.NoError(t, peerBandwidthLimitResponseToError(&pb.PeerBandwidthLimitResponse{
+		Response: &pb.PeerBandwidthLimitResponse_Empty{},
+	}, "guest"))
+	assert.ErrorContains(t, peerBandwidthLimitResponseToError(&pb.PeerBandwidthLimitResponse{
+		Response: &pb.PeerBandwidthLimitResponse_PeerBandwidthLimitErrorCode{
+			PeerBandwidthLimitErrorCode: pb.PeerBandwidthLimitErrorCode_INVALID_BANDWIDTH_LIMIT,
+		},
+	}, "guest"), "The bandwidth limit must be from")
+	assert.Error(t, peerBandwidthLimitResponseToError(&pb.PeerBandwidthLimitResponse{
+		Response: &pb.PeerBandwidthLimitResponse_UpdatePeerErrorCode{
+			UpdatePeerErrorCode: pb.UpdatePeerErrorCode_PEER_NOT_FOUND,
+		},
+	}, "guest"))
+	assert.ErrorContains(t, peerBandwidthLimitResponseToError(nil, "guest"), AccountInternalError)
+	assert.True(t, isPeerBandwidthLimitAlreadySet(&pb.PeerBandwidthLimitResponse{
+		Response: &pb.PeerBandwidthLimitResponse_PeerBandwidthLimitErrorCode{
+			PeerBandwidthLimitErrorCode: pb.PeerBandwidthLimitErrorCode_BANDWIDTH_LIMIT_ALREADY_SET,
+		},
+	}))
+}
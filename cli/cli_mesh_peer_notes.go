@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// MeshPeerSetNote sets a note for the peer, the remaining arguments are joined into the note
+func (c *cmd) MeshPeerSetNote(ctx *cli.Context) error {
+	if ctx.NArg() < 2 {
+		return formatError(argsCountError(ctx))
+	}
+
+	peer, err := c.retrievePeerFromArgs(ctx)
+	if err != nil {
+		return formatError(err)
+	}
+
+	note := strings.Join(ctx.Args().Tail(), " ")
+	resp, err := c.meshClient.SetPeerNote(context.Background(), &pb.SetPeerNoteRequest{
+		Identifier: peer.GetIdentifier(),
+		Note:       note,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := peerNoteResponseToError(resp, peer.GetHostname()); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetPeerSetNoteSuccess, peerDisplayName(peer))
+	return nil
+}
+
+// MeshPeerRemoveNote removes the note of the peer
+func (c *cmd) MeshPeerRemoveNote(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	peer, err := c.retrievePeerFromArgs(ctx)
+	if err != nil {
+		return formatError(err)
+	}
+
+	resp, err := c.meshClient.SetPeerNote(context.Background(), &pb.SetPeerNoteRequest{
+		Identifier: peer.GetIdentifier(),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := peerNoteResponseToError(resp, peer.GetHostname()); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetPeerRemoveNoteSuccess, peerDisplayName(peer))
+	return nil
+}
+
+// MeshPeerSetTags replaces the tags of the peer
+func (c *cmd) MeshPeerSetTags(ctx *cli.Context) error {
+	if ctx.NArg() < 2 {
+		return formatError(argsCountError(ctx))
+	}
+
+	peer, err := c.retrievePeerFromArgs(ctx)
+	if err != nil {
+		return formatError(err)
+	}
+
+	tags := ctx.Args().Tail()
+	resp, err := c.meshClient.SetPeerTags(context.Background(), &pb.SetPeerTagsRequest{
+		Identifier: peer.GetIdentifier(),
+		Tags:       tags,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := peerNoteResponseToError(resp, peer.GetHostname()); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetPeerSetTagsSuccess, peerDisplayName(peer), strings.Join(tags, ", "))
+	return nil
+}
+
+// MeshPeerRemoveTags removes all the tags of the peer
+func (c *cmd) MeshPeerRemoveTags(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	peer, err := c.retrievePeerFromArgs(ctx)
+	if err != nil {
+		return formatError(err)
+	}
+
+	resp, err := c.meshClient.SetPeerTags(context.Background(), &pb.SetPeerTagsRequest{
+		Identifier: peer.GetIdentifier(),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+	if err := peerNoteResponseToError(resp, peer.GetHostname()); err != nil {
+		return formatError(err)
+	}
+
+	color.Green(MsgMeshnetPeerRemoveTagsSuccess, peerDisplayName(peer))
+	return nil
+}
+
+func peerNoteResponseToError(resp *pb.PeerNoteResponse, identifier string) error {
+	if resp == nil {
+		return errors.New(AccountInternalError)
+	}
+
+	switch resp := resp.Response.(type) {
+	case *pb.PeerNoteResponse_Empty:
+		return nil
+	case *pb.PeerNoteResponse_ServiceErrorCode:
+		return serviceErrorCodeToError(resp.ServiceErrorCode)
+	case *pb.PeerNoteResponse_MeshnetErrorCode:
+		return meshnetErrorToError(resp.MeshnetErrorCode)
+	case *pb.PeerNoteResponse_UpdatePeerErrorCode:
+		return updatePeerErrorCodeToError(resp.UpdatePeerErrorCode, identifier)
+	case *pb.PeerNoteResponse_PeerNoteErrorCode:
+		switch resp.PeerNoteErrorCode {
+		case pb.PeerNoteErrorCode_PEER_NOTE_TOO_LONG:
+			return fmt.Errorf(MsgMeshnetPeerNoteTooLong, config.MaxPeerNoteLength)
+		case pb.PeerNoteErrorCode_INVALID_PEER_TAG:
+			return fmt.Errorf(MsgMeshnetPeerTagInvalid, config.MaxPeerTagLength)
+		case pb.PeerNoteErrorCode_TOO_MANY_PEER_TAGS:
+			return fmt.Errorf(MsgMeshnetPeerTagsTooMany, config.MaxPeerTags)
+		}
+	}
+	return errors.New(AccountInternalError)
+}
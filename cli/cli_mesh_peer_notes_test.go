@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerNoteResponseToError(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name string
+		resp *pb.PeerNoteResponse
+		err  error
+	}{
+		{
+			name: "nil response",
+			err:  errors.New(itsUsMsg),
+		},
+		{
+			name: "success",
+			resp: &pb.PeerNoteResponse{Response: &pb.PeerNoteResponse_Empty{}},
+		},
+		{
+			name: "not logged in",
+			resp: &pb.PeerNoteResponse{
+				Response: &pb.PeerNoteResponse_ServiceErrorCode{
+					ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+				},
+			},
+			err: internal.ErrNotLoggedIn,
+		},
+		{
+			name: "note too long",
+			resp: &pb.PeerNoteResponse{
+				Response: &pb.PeerNoteResponse_PeerNoteErrorCode{
+					PeerNoteErrorCode: pb.PeerNoteErrorCode_PEER_NOTE_TOO_LONG,
+				},
+			},
+			err: fmt.Errorf(MsgMeshnetPeerNoteTooLong, config.MaxPeerNoteLength),
+		},
+		{
+			name: "invalid tag",
+			resp: &pb.PeerNoteResponse{
+				Response: &pb.PeerNoteResponse_PeerNoteErrorCode{
+					PeerNoteErrorCode: pb.PeerNoteErrorCode_INVALID_PEER_TAG,
+				},
+			},
+			err: fmt.Errorf(MsgMeshnetPeerTagInvalid, config.MaxPeerTagLength),
+		},
+		{
+			name: "too many tags",
+			resp: &pb.PeerNoteResponse{
+				Response: &pb.PeerNoteResponse_PeerNoteErrorCode{
+					PeerNoteErrorCode: pb.PeerNoteErrorCode_TOO_MANY_PEER_TAGS,
+				},
+			},
+			err: fmt.Errorf(MsgMeshnetPeerTagsTooMany, config.MaxPeerTags),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.err, peerNoteResponseToError(test.resp, "nas.nord"))
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+
+	daemonpb "github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Meshnet peer route help text
+const MeshPeerRouteSetDescription = `Use this command to reach a network behind a peer device, e.g. its local network, while the rest of the traffic goes through the VPN server.
+The peer device has to allow traffic routing. Subnets can be routed through a single peer device at a time, setting them again replaces the previous ones.
+With NordLynx, the VPN connection uses its own interface meanwhile.
+
+Example: 'nordvpn meshnet peer route set laptop-123.nord 192.168.1.0/24'`
+
+const MeshPeerRouteAcceptDescription = `Use this command to reach the subnets a peer device advertises, e.g. its local network, while the rest of the traffic goes through the VPN server.
+The peer device has to allow traffic routing. All of the advertised subnets are routed if none are specified.
+
+Example: 'nordvpn meshnet peer route accept gateway-123.nord 192.168.1.0/24'`
+
+func (c *cmd) MeshPeerRouteSet(ctx *cli.Context) error {
+	if ctx.NArg() < 2 {
+		return formatError(argsCountError(ctx))
+	}
+
+	subnets := ctx.Args().Tail()
+	peer, err := c.retrievePeerFromArgs(ctx)
+	if err != nil {
+		return formatError(err)
+	}
+	if !peer.GetIsRoutable() {
+		return formatError(fmt.Errorf(MsgMeshnetPeerDoesNotAllowRouting, ctx.Args().First()))
+	}
+
+	return c.setPeerRoutes(peer, ctx.Args().First(), subnets)
+}
+
+// MeshPeerRouteAccept routes the subnets advertised by the peer through it
+func (c *cmd) MeshPeerRouteAccept(ctx *cli.Context) error {
+	if ctx.NArg() < 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	peer, err := c.retrievePeerFromArgs(ctx)
+	if err != nil {
+		return formatError(err)
+	}
+	name := ctx.Args().First()
+	if !peer.GetIsRoutable() {
+		return formatError(fmt.Errorf(MsgMeshnetPeerDoesNotAllowRouting, name))
+	}
+
+	subnets, err := acceptedPeerSubnets(peer, name, ctx.Args().Tail())
+	if err != nil {
+		return formatError(err)
+	}
+	return c.setPeerRoutes(peer, name, subnets)
+}
+
+// acceptedPeerSubnets returns the requested subnets if the peer advertises all of them, or all of the advertised
+// subnets if none are requested
+func acceptedPeerSubnets(peer *pb.Peer, name string, requested []string) ([]string, error) {
+	advertised := peer.GetAdvertisedSubnets()
+	if len(advertised) == 0 {
+		return nil, fmt.Errorf(MsgMeshnetPeerRouteNoneAdvertised, name)
+	}
+	if len(requested) == 0 {
+		return advertised, nil
+	}
+
+	subnets := make([]string, 0, len(requested))
+	for _, value := range requested {
+		subnet, err := netip.ParsePrefix(value)
+		if err != nil || !slices.Contains(advertised, subnet.Masked().String()) {
+			return nil, fmt.Errorf(MsgMeshnetPeerRouteNotAdvertised, name, value)
+		}
+		subnets = append(subnets, subnet.Masked().String())
+	}
+	return subnets, nil
+}
+
+func (c *cmd) setPeerRoutes(peer *pb.Peer, name string, subnets []string) error {
+	resp, err := c.client.SetMeshnetPeerRoutes(context.Background(), &daemonpb.SetMeshnetPeerRoutesRequest{
+		PublicKey: peer.GetPubkey(),
+		Subnets:   subnets,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	label := strings.Join(subnets, ", ")
+	switch resp.Type {
+	case internal.CodeSuccess:
+		color.Green(MsgMeshnetPeerRouteSetSuccess, label, name)
+	case internal.CodeNothingToDo:
+		color.Yellow(MsgMeshnetPeerRouteAlreadySet, label, name)
+	}
+	return peerRoutesResponseToError(resp)
+}
+
+func (c *cmd) MeshPeerRouteClear(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	resp, err := c.client.SetMeshnetPeerRoutes(context.Background(), &daemonpb.SetMeshnetPeerRoutesRequest{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeSuccess:
+		color.Green(MsgMeshnetPeerRouteClearSuccess)
+	case internal.CodeNothingToDo:
+		color.Yellow(MsgMeshnetPeerRouteAlreadyCleared)
+	}
+	return peerRoutesResponseToError(resp)
+}
+
+// peerRoutesResponseToError returns an error for the failed responses and prints the reconnect hint for the
+// successful ones
+func peerRoutesResponseToError(resp *daemonpb.Payload) error {
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		if len(resp.Data) > 0 {
+			return formatError(errors.New(resp.Data[0]))
+		}
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeFailure:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeSuccess:
+		if len(resp.Data) > 0 {
+			if reconnect, _ := strconv.ParseBool(resp.Data[0]); reconnect {
+				color.Yellow(SetReconnect)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"testing"
+
+	daemonpb "github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerRoutesResponseToError(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		resp     *daemonpb.Payload
+		expected string
+	}{
+		{
+			name: "success",
+			resp: &daemonpb.Payload{Type: internal.CodeSuccess, Data: []string{"true"}},
+		},
+		{
+			name: "nothing to do",
+			resp: &daemonpb.Payload{Type: internal.CodeNothingToDo},
+		},
+		{
+			name:     "invalid subnet",
+			resp:     &daemonpb.Payload{Type: internal.CodeFormatError, Data: []string{"invalid subnet"}},
+			expected: "Invalid subnet.",
+		},
+		{
+			name:     "config error",
+			resp:     &daemonpb.Payload{Type: internal.CodeConfigError},
+			expected: ErrConfig.Error(),
+		},
+		{
+			name:     "failure",
+			resp:     &daemonpb.Payload{Type: internal.CodeFailure},
+			expected: internal.ErrUnhandled.Error(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := peerRoutesResponseToError(test.resp)
+			if test.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, test.expected)
+		})
+	}
+}
+
+func TestAcceptedPeerSubnets(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	gateway := &pb.Peer{AdvertisedSubnets: []string{"192.168.1.0/24", "10.10.0.0/16"}}
+	tests := []struct {
+		name      string
+		peer      *pb.Peer
+		requested []string
+		expected  []string
+		err       string
+	}{
+		{
+			name:     "all advertised",
+			peer:     gateway,
+			expected: []string{"192.168.1.0/24", "10.10.0.0/16"},
+		},
+		{
+			name:      "masked subnet",
+			peer:      gateway,
+			requested: []string{"10.10.1.1/16"},
+			expected:  []string{"10.10.0.0/16"},
+		},
+		{
+			name:      "not advertised",
+			peer:      gateway,
+			requested: []string{"192.168.2.0/24"},
+			err:       "does not advertise the subnet 192.168.2.0/24",
+		},
+		{
+			name:      "malformed",
+			peer:      gateway,
+			requested: []string{"192.168.1.0"},
+			err:       "does not advertise the subnet 192.168.1.0",
+		},
+		{
+			name: "nothing advertised",
+			peer: &pb.Peer{},
+			err:  "does not advertise any subnets",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			subnets, err := acceptedPeerSubnets(test.peer, "gateway.nord", test.requested)
+			if test.err != "" {
+				assert.ErrorContains(t, err, test.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, subnets)
+		})
+	}
+}
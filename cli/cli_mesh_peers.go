@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/signal"
 	"strings"
+	"time"
 
 	daemonpb "github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/fileshare"
@@ -20,6 +21,7 @@ import (
 
 const (
 	flagFilter          = "filter"
+	flagPeerListVerbose = "verbose"
 	externalFilter      = "external"
 	internalFilter      = "internal"
 	PeerListDescription = "Press the Tab key to see auto-suggestions for filters."
@@ -98,7 +100,13 @@ var availableFilters map[string]func(*pb.PeerList) *pb.PeerList = map[string]fun
 // MeshPeerList queries the peer list from the meshnet service, and
 // displays it to stdout
 func (c *cmd) MeshPeerList(ctx *cli.Context) error {
-	resp, err := c.meshClient.GetPeers(
+	getPeers := c.meshClient.GetPeers
+	verbose := ctx.Bool(flagPeerListVerbose)
+	if verbose {
+		// measuring the connected peers takes a few seconds
+		getPeers = c.meshClient.MeasurePeers
+	}
+	resp, err := getPeers(
 		context.Background(),
 		&pb.Empty{},
 	)
@@ -121,9 +129,9 @@ func (c *cmd) MeshPeerList(ctx *cli.Context) error {
 				condition = value
 			}
 		}
-		fmt.Println(strings.TrimSpace(peersToOutputString(peers, condition)))
+		fmt.Println(strings.TrimSpace(peersToOutputString(peers, condition, verbose)))
 	} else {
-		fmt.Println(strings.TrimSpace(peersToOutputString(peers, "")))
+		fmt.Println(strings.TrimSpace(peersToOutputString(peers, "", verbose)))
 	}
 	return nil
 }
@@ -134,7 +142,7 @@ func (c *cmd) FiltersAutoComplete(ctx *cli.Context) {
 	}
 }
 
-func peersToOutputString(peers *pb.PeerList, condition string) string {
+func peersToOutputString(peers *pb.PeerList, condition string, verbose bool) string {
 	var builder strings.Builder
 	boldCol := color.New(color.Bold)
 	builder.WriteString(boldCol.Sprintf("This device:") + "\n")
@@ -145,7 +153,7 @@ func peersToOutputString(peers *pb.PeerList, condition string) string {
 			builder.WriteString("[no peers]\n")
 		}
 		for _, p := range peers.Local {
-			builder.WriteString(peerToOutputString(p) + "\n")
+			builder.WriteString(peerToOutputString(p, verbose) + "\n")
 		}
 		builder.WriteString("\n")
 	}
@@ -155,7 +163,7 @@ func peersToOutputString(peers *pb.PeerList, condition string) string {
 			builder.WriteString("[no peers]\n")
 		}
 		for _, p := range peers.External {
-			builder.WriteString(peerToOutputString(p) + "\n")
+			builder.WriteString(peerToOutputString(p, verbose) + "\n")
 		}
 	}
 	return builder.String()
@@ -183,7 +191,7 @@ func selfToOutputString(peer *pb.Peer) string {
 	return titledKeyvalListToColoredString(title, color.FgGreen, kvs)
 }
 
-func peerToOutputString(peer *pb.Peer) string {
+func peerToOutputString(peer *pb.Peer, verbose bool) string {
 	// if peer has nickname, then it will be displayed first, otherwise is the hostname
 	var title keyval
 	var alternativeName keyval
@@ -211,9 +219,50 @@ func peerToOutputString(peer *pb.Peer) string {
 		{Key: "Allows Sending Files", Value: nstrings.GetBoolLabel(peer.IsFileshareAllowed)},
 		{Key: "Accept Fileshare Automatically", Value: nstrings.GetBoolLabel(peer.AlwaysAcceptFiles)},
 	}
+	if peer.Note != "" {
+		kvs = append(kvs, keyval{Key: "Note", Value: peer.Note})
+	}
+	if len(peer.Tags) > 0 {
+		kvs = append(kvs, keyval{Key: "Tags", Value: strings.Join(peer.Tags, ", ")})
+	}
+	if peer.BandwidthLimit > 0 {
+		kvs = append(kvs, keyval{Key: "Bandwidth Limit", Value: bandwidthLimitToString(peer.BandwidthLimit)})
+	}
+	if len(peer.AdvertisedSubnets) > 0 {
+		kvs = append(kvs, keyval{Key: "Advertised Subnets", Value: strings.Join(peer.AdvertisedSubnets, ", ")})
+	}
+	if verbose && peer.Status == pb.PeerStatus_CONNECTED {
+		kvs = append(kvs,
+			keyval{Key: "Connection Path", Value: peerPathToString(peer.Path)},
+			keyval{Key: "Round Trip Time", Value: peerRttToString(peer.Rtt)},
+		)
+	}
 	return titledKeyvalListToColoredString(title, color.FgYellow, kvs)
 }
 
+func peerPathToString(path pb.PeerPath) string {
+	switch path {
+	case pb.PeerPath_PATH_DIRECT:
+		return "direct"
+	case pb.PeerPath_PATH_RELAY:
+		return "relay"
+	default:
+		return "unknown"
+	}
+}
+
+// peerRttToString describes the round trip time measured to the peer, negative when the peer did not respond
+func peerRttToString(rtt int64) string {
+	switch {
+	case rtt < 0:
+		return MsgMeshnetPeerNoResponse
+	case rtt == 0:
+		return "-"
+	default:
+		return time.Duration(rtt).Round(100 * time.Microsecond).String()
+	}
+}
+
 func titledKeyvalListToColoredString(
 	title keyval,
 	titleAttr color.Attribute,
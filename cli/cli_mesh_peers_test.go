@@ -153,3 +153,50 @@ func TestMeshPeerList(t *testing.T) {
 		})
 	}
 }
+
+func TestPeerToOutputString_Verbose(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name       string
+		peer       *pb.Peer
+		verbose    bool
+		expected   []string
+		unexpected []string
+	}{
+		{
+			name:       "not verbose",
+			peer:       &pb.Peer{Status: pb.PeerStatus_CONNECTED, Path: pb.PeerPath_PATH_DIRECT, Rtt: 12300000},
+			unexpected: []string{"Connection Path", "Round Trip Time"},
+		},
+		{
+			name:     "direct",
+			peer:     &pb.Peer{Status: pb.PeerStatus_CONNECTED, Path: pb.PeerPath_PATH_DIRECT, Rtt: 12345678},
+			verbose:  true,
+			expected: []string{"direct", "12.3ms"},
+		},
+		{
+			name:     "relay without response",
+			peer:     &pb.Peer{Status: pb.PeerStatus_CONNECTED, Path: pb.PeerPath_PATH_RELAY, Rtt: -1},
+			verbose:  true,
+			expected: []string{"relay", MsgMeshnetPeerNoResponse},
+		},
+		{
+			name:       "disconnected",
+			peer:       &pb.Peer{Status: pb.PeerStatus_DISCONNECTED},
+			verbose:    true,
+			unexpected: []string{"Connection Path", "Round Trip Time"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := peerToOutputString(test.peer, test.verbose)
+			for _, expected := range test.expected {
+				assert.Contains(t, output, expected)
+			}
+			for _, unexpected := range test.unexpected {
+				assert.NotContains(t, output, unexpected)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Pause help text
+const (
+	PauseUsageText     = "Disconnects you from VPN and connects again after the given time"
+	PauseArgsUsageText = `<duration>`
+	PauseDescription   = `Use this command to disconnect from VPN for a while.
+After <duration> passes, you are connected to the same server or location again.
+Auto-connect does not connect while VPN is paused. Connecting or disconnecting manually ends the pause.
+
+<duration> is between 1 minute and 24 hours, for example 30m, 1h or 1h 30m.
+
+Example: 'nordvpn pause 30m'`
+)
+
+const (
+	minPauseDuration = time.Minute
+	maxPauseDuration = 24 * time.Hour
+)
+
+func (c *cmd) Pause(ctx *cli.Context) error {
+	if ctx.NArg() == 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	duration, err := parsePauseDuration(strings.Join(ctx.Args().Slice(), " "))
+	if err != nil {
+		return formatError(err)
+	}
+
+	resp, err := c.client.Pause(context.Background(), &pb.PauseRequest{Duration: int64(duration)})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeVPNNotRunning:
+		color.Yellow(DisconnectNotConnected)
+	case internal.CodeFailure:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeSuccess:
+		until := time.Now().Add(duration)
+		if len(resp.Data) > 0 {
+			if unix, err := strconv.ParseInt(resp.Data[0], 10, 64); err == nil {
+				until = time.Unix(unix, 0)
+			}
+		}
+		color.Green(PauseSuccess, until.Format(time.Kitchen))
+	}
+	return nil
+}
+
+// parsePauseDuration parses the time span and checks whether it is in the allowed range
+func parsePauseDuration(arg string) (time.Duration, error) {
+	years, months, days, seconds, err := parseTimespan(arg)
+	if err != nil {
+		return 0, err
+	}
+	duration := time.Duration(days)*24*time.Hour + time.Duration(seconds)*time.Second
+	if years != 0 || months != 0 || duration < minPauseDuration || duration > maxPauseDuration {
+		return 0, fmt.Errorf(PauseDurationError)
+	}
+	return duration, nil
+}
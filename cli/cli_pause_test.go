@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePauseDuration(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		arg      string
+		duration time.Duration
+		valid    bool
+	}{
+		{arg: "30m", duration: 30 * time.Minute, valid: true},
+		{arg: "1h 30m", duration: 90 * time.Minute, valid: true},
+		{arg: "1 hour", duration: time.Hour, valid: true},
+		{arg: "1d", duration: 24 * time.Hour, valid: true},
+		{arg: "30"},
+		{arg: "2d"},
+		{arg: "1M"},
+		{arg: "soon"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.arg, func(t *testing.T) {
+			duration, err := parsePauseDuration(test.arg)
+			if test.valid {
+				assert.NoError(t, err)
+				assert.Equal(t, test.duration, duration)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/fileshare"
+	filesharepb "github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Pending help text
+const (
+	PendingUsageText       = "Lists actions queued while you are offline"
+	PendingDescription     = "Actions requested while the device or the Meshnet peer is offline are executed once the network is back. Connecting is postponed until the network is back and file transfers are started once the peer comes online."
+	PendingCancelUsageText = "Cancels a queued action"
+	PendingCancelArgsUsage = "<action_id>"
+)
+
+type pendingEntry struct {
+	id          string
+	description string
+	created     time.Time
+}
+
+// pendingEntries collects the actions queued by the daemon and by fileshare. Fileshare entries are
+// skipped when fileshare is not running.
+func (c *cmd) pendingEntries() ([]pendingEntry, error) {
+	resp, err := c.client.PendingActions(context.Background(), &pb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []pendingEntry
+	for _, action := range resp.GetActions() {
+		entries = append(entries, pendingEntry{
+			id:          action.GetId(),
+			description: action.GetDescription(),
+			created:     time.Unix(action.GetCreated(), 0),
+		})
+	}
+
+	fileshareResp, err := c.fileshareClient.ListPending(context.Background(), &filesharepb.Empty{})
+	if err != nil {
+		return entries, nil
+	}
+	for _, send := range fileshareResp.GetSends() {
+		entries = append(entries, pendingEntry{
+			id:          send.GetId(),
+			description: fmt.Sprintf("send %s to %s", strings.Join(send.GetPaths(), ", "), send.GetPeer()),
+			created:     send.GetCreated().AsTime(),
+		})
+	}
+	return entries, nil
+}
+
+// Pending lists the queued actions
+func (c *cmd) Pending(ctx *cli.Context) error {
+	entries, err := c.pendingEntries()
+	if err != nil {
+		return formatError(err)
+	}
+
+	if len(entries) == 0 {
+		color.Yellow(MsgPendingNoActions)
+		return nil
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "ID\tQUEUED\tACTION")
+	for _, entry := range entries {
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", entry.id, entry.created.Format(time.DateTime), entry.description)
+	}
+	return writer.Flush()
+}
+
+// PendingCancel removes the action from the queue
+func (c *cmd) PendingCancel(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return argsParseError(ctx)
+	}
+	id := ctx.Args().First()
+
+	if strings.HasPrefix(id, fileshare.PendingSendIDPrefix) {
+		resp, err := c.fileshareClient.CancelPending(context.Background(), &filesharepb.CancelPendingRequest{Id: id})
+		if err != nil {
+			return formatError(err)
+		}
+		if err := FileshareResponseToError(resp); err != nil {
+			return formatError(err)
+		}
+		color.Green(MsgPendingCanceled, id)
+		return nil
+	}
+
+	resp, err := c.client.CancelPendingAction(context.Background(), &pb.CancelPendingActionRequest{Id: id})
+	if err != nil {
+		return formatError(err)
+	}
+	if resp.GetType() != internal.CodeSuccess {
+		return errors.New(MsgPendingNotFound)
+	}
+	color.Green(MsgPendingCanceled, id)
+	return nil
+}
+
+// PendingAutoComplete autocompletes IDs of the queued actions
+func (c *cmd) PendingAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	entries, err := c.pendingEntries()
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		fmt.Println(entry.id)
+	}
+}
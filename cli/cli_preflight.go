@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Preflight help text
+const (
+	PreflightUsageText   = "Checks the kernel features and capabilities required for the VPN connection"
+	PreflightDescription = "Checks for the tun and WireGuard kernel modules, the iptables support and the CAP_NET_ADMIN capability of the NordVPN daemon. The same checks are run before connecting, and the missing features are reported together with the way to fix them."
+)
+
+// Preflight reports the missing kernel features and capabilities
+func (c *cmd) Preflight(ctx *cli.Context) error {
+	resp, err := c.client.Preflight(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	if len(resp.GetFindings()) == 0 {
+		color.Green(MsgPreflightNoFindings)
+		return nil
+	}
+	printPreflightFindings(resp.GetFindings())
+	return nil
+}
+
+// preflightBeforeConnect reports the missing features before the connection attempt fails with a generic error. The
+// connection is attempted anyway, because the checks can't foresee everything, e.g. a module loaded on demand.
+func (c *cmd) preflightBeforeConnect() {
+	resp, err := c.client.Preflight(context.Background(), &pb.Empty{})
+	if err != nil {
+		return
+	}
+	printPreflightFindings(resp.GetFindings())
+}
+
+func printPreflightFindings(findings []*pb.PreflightFinding) {
+	for _, finding := range findings {
+		msg := fmt.Sprintf(MsgPreflightFinding, finding.GetDescription(), finding.GetHint())
+		if finding.GetSeverity() == pb.PreflightSeverity_PREFLIGHT_ERROR {
+			color.Red(msg)
+		} else {
+			color.Yellow(msg)
+		}
+	}
+}
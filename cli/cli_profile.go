@@ -0,0 +1,186 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Profile help text
+const (
+	ProfileUsageText     = "Saves the settings under short names to switch between them with one command"
+	ProfileArgsUsageText = "<name>"
+
+	ProfileCreateUsageText   = "Saves the current settings as a profile"
+	ProfileCreateDescription = `Use this command to save the current protocol, custom DNS, allowlist and auto-connect settings under the name.
+<name> can contain lowercase letters, digits, '-' and '_'.
+
+Example: 'nordvpn profile create work'`
+
+	ProfileSwitchUsageText   = "Applies the settings of the profile"
+	ProfileSwitchDescription = `Use this command to apply the protocol, custom DNS, allowlist and auto-connect settings saved in the profile.
+
+Example: 'nordvpn profile switch streaming'`
+
+	ProfileDeleteUsageText   = "Deletes the profile"
+	ProfileDeleteDescription = `Use this command to delete the profile. Settings applied by the profile are not changed.
+
+Example: 'nordvpn profile delete work'`
+
+	ProfileListUsageText = "Shows the profiles"
+)
+
+func (c *cmd) ProfileCreate(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+	name := ctx.Args().First()
+
+	resp, err := c.client.CreateProfile(context.Background(), &pb.ProfileRequest{Name: name})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(fmt.Errorf(ProfileInvalidName, name))
+	case internal.CodeNothingToDo:
+		return formatError(fmt.Errorf(ProfileExistsError, name))
+	case internal.CodeSuccess:
+		color.Green(ProfileCreateSuccess, resp.Data[0])
+		return nil
+	}
+	return formatError(internal.ErrUnhandled)
+}
+
+func (c *cmd) ProfileSwitch(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+	name := ctx.Args().First()
+
+	resp, err := c.client.SwitchProfile(context.Background(), &pb.ProfileRequest{Name: name})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeProfileNotFound:
+		return formatError(fmt.Errorf(ProfileNotFoundError, name))
+	case internal.CodeFailure:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeVPNRunning:
+		color.Yellow(ProfileSwitchReconnect, resp.Data[0])
+		return nil
+	case internal.CodeSuccess:
+		color.Green(ProfileSwitchSuccess, resp.Data[0])
+		return nil
+	}
+	return formatError(internal.ErrUnhandled)
+}
+
+func (c *cmd) ProfileDelete(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+	name := ctx.Args().First()
+
+	resp, err := c.client.DeleteProfile(context.Background(), &pb.ProfileRequest{Name: name})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeProfileNotFound:
+		return formatError(fmt.Errorf(ProfileNotFoundError, name))
+	case internal.CodeSuccess:
+		color.Green(ProfileDeleteSuccess, resp.Data[0])
+		return nil
+	}
+	return formatError(internal.ErrUnhandled)
+}
+
+func (c *cmd) ProfileList(ctx *cli.Context) error {
+	resp, err := c.client.Profiles(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	if resp.Type != internal.CodeSuccess {
+		return formatError(ErrConfig)
+	}
+
+	if len(resp.Profiles) == 0 {
+		fmt.Println(ProfileListEmpty)
+		return nil
+	}
+	for _, profile := range resp.Profiles {
+		fmt.Printf("%s:\n%s", profile.Name, profileSettings(profile))
+	}
+	return nil
+}
+
+// profileSettings describes the settings saved in the profile, one per line
+func profileSettings(profile *pb.Profile) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "\tProtocol: %s\n", profile.GetProtocol())
+	if len(profile.GetDns()) == 0 {
+		fmt.Fprintln(&b, "\tDNS: default")
+	} else {
+		fmt.Fprintf(&b, "\tDNS: %s\n", strings.Join(profile.GetDns(), ", "))
+	}
+
+	autoConnect := "disabled"
+	if profile.GetAutoConnect() {
+		autoConnect = "enabled"
+		if profile.GetAutoConnectServerTag() != "" {
+			autoConnect += ", " + profile.GetAutoConnectServerTag()
+		}
+	}
+	fmt.Fprintf(&b, "\tAuto-connect: %s\n", autoConnect)
+
+	allowlist := profile.GetAllowlist()
+	if ports := allowlist.GetPorts().GetUdp(); len(ports) > 0 {
+		fmt.Fprintf(&b, "\tAllowlisted UDP ports: %s\n", joinPorts(ports))
+	}
+	if ports := allowlist.GetPorts().GetTcp(); len(ports) > 0 {
+		fmt.Fprintf(&b, "\tAllowlisted TCP ports: %s\n", joinPorts(ports))
+	}
+	if subnets := allowlist.GetSubnets(); len(subnets) > 0 {
+		fmt.Fprintf(&b, "\tAllowlisted subnets: %s\n", strings.Join(subnets, ", "))
+	}
+	return b.String()
+}
+
+func joinPorts(ports []int64) string {
+	values := make([]string, 0, len(ports))
+	for _, port := range ports {
+		values = append(values, fmt.Sprint(port))
+	}
+	return strings.Join(values, ", ")
+}
+
+// ProfileAutoComplete autocompletes the names of profiles
+func (c *cmd) ProfileAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	resp, err := c.client.Profiles(context.Background(), &pb.Empty{})
+	if err != nil {
+		return
+	}
+	for _, profile := range resp.Profiles {
+		fmt.Println(profile.Name)
+	}
+}
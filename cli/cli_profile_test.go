@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfileSettings(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		profile  *pb.Profile
+		expected string
+	}{
+		{
+			name:    "defaults",
+			profile: &pb.Profile{Protocol: config.Protocol_UDP},
+			expected: "\tProtocol: UDP\n" +
+				"\tDNS: default\n" +
+				"\tAuto-connect: disabled\n",
+		},
+		{
+			name: "all settings",
+			profile: &pb.Profile{
+				Protocol: config.Protocol_TCP,
+				Dns:      []string{"1.1.1.1", "8.8.8.8"},
+				Allowlist: &pb.Allowlist{
+					Ports:   &pb.Ports{Udp: []int64{53}, Tcp: []int64{22, 443}},
+					Subnets: []string{"10.0.0.0/8"},
+				},
+				AutoConnect:          true,
+				AutoConnectServerTag: "germany",
+			},
+			expected: "\tProtocol: TCP\n" +
+				"\tDNS: 1.1.1.1, 8.8.8.8\n" +
+				"\tAuto-connect: enabled, germany\n" +
+				"\tAllowlisted UDP ports: 53\n" +
+				"\tAllowlisted TCP ports: 22, 443\n" +
+				"\tAllowlisted subnets: 10.0.0.0/8\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, profileSettings(test.profile))
+		})
+	}
+}
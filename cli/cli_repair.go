@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Repair help text
+const (
+	RepairUsageText     = "Detects and repairs common issues which break connectivity"
+	RepairDescription   = "Checks for the firewall rules, VPN interfaces, routes and DNS settings left after the VPN connection, and for the NordVPN user service which is not responding. Each detected issue is repaired after your confirmation."
+	RepairFlagYesUsage  = "Repair all detected issues without asking for confirmation"
+	flagRepairAssumeYes = "yes"
+)
+
+// Repair detects the issues, asks which of them to repair and reports the results
+func (c *cmd) Repair(ctx *cli.Context) error {
+	resp, err := c.client.Repair(context.Background(), &pb.RepairRequest{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	if len(resp.GetIssues()) == 0 {
+		color.Green(MsgRepairNoIssues)
+		return nil
+	}
+
+	var fix []string
+	for _, issue := range resp.GetIssues() {
+		if ctx.Bool(flagRepairAssumeYes) ||
+			readForConfirmation(os.Stdin, fmt.Sprintf(MsgRepairConfirm, issue.GetDescription()), true) {
+			fix = append(fix, issue.GetId())
+		}
+	}
+
+	if len(fix) == 0 {
+		color.Yellow(MsgRepairNothingRepaired)
+		return nil
+	}
+
+	resp, err = c.client.Repair(context.Background(), &pb.RepairRequest{Fix: fix})
+	if err != nil {
+		return formatError(err)
+	}
+
+	failed := false
+	for _, issue := range resp.GetIssues() {
+		switch issue.GetStatus() {
+		case pb.RepairStatus_REPAIRED:
+			color.Green(MsgRepairRepaired, issue.GetDescription())
+		case pb.RepairStatus_REPAIR_FAILED:
+			failed = true
+			color.Red(MsgRepairFailed, issue.GetDescription(), issue.GetError())
+		case pb.RepairStatus_DETECTED:
+		}
+	}
+
+	if failed {
+		return formatError(errors.New(MsgRepairIncomplete))
+	}
+	return nil
+}
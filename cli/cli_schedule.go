@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/client"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Schedule help text
+const (
+	ScheduleUsageText = "Connects and disconnects automatically at the given time"
+
+	ScheduleAddUsageText          = "Adds the rule which connects or disconnects at the given time"
+	ScheduleAddFlagDaysUsageText  = "Specify the days of the week, e.g. daily, weekdays, weekends, mon-fri or mon,wed,fri"
+	ScheduleAddFlagGroupUsageText = "Specify a server group to connect to"
+	ScheduleAddArgsUsageText      = "<name> connect|disconnect <HH:MM> [<country>|<server>|<country_code>|<city>|<group>|<country> <city>]"
+	ScheduleAddDescription        = `Use this command to connect or disconnect at the given local time. The rule is applied every day unless the --days flag is provided.
+The connect rule can be given the location the same way as the connect command, the recommended server is used otherwise.
+<name> can contain lowercase letters, digits, '-' and '_'.
+
+Connecting or disconnecting manually takes precedence: the rule due within 30 minutes after it is skipped.
+Rules applied at the same time on the same day conflict with each other and cannot be added.
+
+Example: 'nordvpn schedule add morning connect 09:00 --days weekdays Germany'
+Example: 'nordvpn schedule add evening disconnect 18:00 --days mon-fri'`
+
+	ScheduleRemoveUsageText     = "Removes the schedule rule"
+	ScheduleRemoveArgsUsageText = "<name>"
+	ScheduleRemoveDescription   = `Use this command to remove the schedule rule.
+
+Example: 'nordvpn schedule remove morning'`
+
+	ScheduleListUsageText = "Shows the schedule rules"
+)
+
+func (c *cmd) ScheduleAdd(ctx *cli.Context) error {
+	args := ctx.Args()
+	if args.Len() < 3 {
+		return formatError(argsCountError(ctx))
+	}
+	name := args.Get(0)
+	action := strings.ToLower(args.Get(1))
+	serverTag := strings.ToLower(strings.Join(args.Slice()[3:], " "))
+	serverGroup := ctx.String(flagGroup)
+	if action != "connect" && (serverTag != "" || serverGroup != "") {
+		return formatError(errors.New(ScheduleDisconnectLocation))
+	}
+
+	resp, err := c.client.AddScheduleRule(context.Background(), &pb.ScheduleRule{
+		Name:        name,
+		Action:      action,
+		At:          args.Get(2),
+		Days:        ctx.String(flagDays),
+		ServerTag:   serverTag,
+		ServerGroup: serverGroup,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		if len(resp.Data) > 0 {
+			return formatError(fmt.Errorf(ScheduleInvalidRule, resp.Data[0]))
+		}
+		return formatError(fmt.Errorf(ScheduleInvalidName, name))
+	case internal.CodeNothingToDo:
+		return formatError(fmt.Errorf(ScheduleExistsError, name))
+	case internal.CodeScheduleConflict:
+		return formatError(fmt.Errorf(ScheduleConflictError, resp.Data[0]))
+	case internal.CodeTagNonexisting:
+		return formatError(errors.New(internal.TagNonexistentErrorMessage))
+	case internal.CodeGroupNonexisting:
+		return formatError(errors.New(internal.GroupNonexistentErrorMessage))
+	case internal.CodeServerUnavailable:
+		return formatError(errors.New(internal.ServerUnavailableErrorMessage))
+	case internal.CodeDoubleGroupError:
+		return formatError(errors.New(internal.DoubleGroupErrorMessage))
+	case internal.CodeDedicatedIPRenewError:
+		return formatError(fmt.Errorf(NoDedicatedIPMessage, client.SubscriptionDedicatedIPURL))
+	case internal.CodeDedicatedIPNoServer:
+		return formatError(errors.New(NoDedidcatedIPServerMessage))
+	case internal.CodeDedicatedIPServiceButNoServers:
+		return formatError(errors.New(NoPreferredDedicatedIPLocationSelected))
+	case internal.CodeSuccess:
+		color.Green(ScheduleAddSuccess, resp.Data[0])
+		return nil
+	}
+	return formatError(internal.ErrUnhandled)
+}
+
+func (c *cmd) ScheduleRemove(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+	name := ctx.Args().First()
+
+	resp, err := c.client.RemoveScheduleRule(context.Background(), &pb.RemoveScheduleRuleRequest{Name: name})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeScheduleRuleNotFound:
+		return formatError(fmt.Errorf(ScheduleNotFoundError, name))
+	case internal.CodeSuccess:
+		color.Green(ScheduleRemoveSuccess, resp.Data[0])
+		return nil
+	}
+	return formatError(internal.ErrUnhandled)
+}
+
+func (c *cmd) ScheduleList(ctx *cli.Context) error {
+	resp, err := c.client.ScheduleRules(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+	if resp.Type != internal.CodeSuccess {
+		return formatError(ErrConfig)
+	}
+
+	if len(resp.Rules) == 0 {
+		fmt.Println(ScheduleListEmpty)
+		return nil
+	}
+	for _, rule := range resp.Rules {
+		fmt.Printf("%s: %s\n", rule.Name, scheduleRuleDescription(rule))
+	}
+	return nil
+}
+
+// scheduleRuleDescription describes the rule the same way as it would be given to the schedule add command
+func scheduleRuleDescription(rule *pb.ScheduleRule) string {
+	description := []string{rule.Action, rule.At, "--days " + rule.Days}
+	if rule.ServerTag != "" {
+		description = append(description, rule.ServerTag)
+	}
+	if rule.ServerGroup != "" {
+		description = append(description, "--group "+rule.ServerGroup)
+	}
+	return strings.Join(description, " ")
+}
+
+// ScheduleAutoComplete autocompletes the names of schedule rules
+func (c *cmd) ScheduleAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	resp, err := c.client.ScheduleRules(context.Background(), &pb.Empty{})
+	if err != nil {
+		return
+	}
+	for _, rule := range resp.Rules {
+		fmt.Println(rule.Name)
+	}
+}
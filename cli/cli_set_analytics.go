@@ -3,7 +3,9 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/nstrings"
@@ -19,9 +21,38 @@ const SetAnalyticsUsageText = "Help us improve by sending anonymous " +
 	"performance, and feature usage data – nothing that could " +
 	"identify you."
 
+const (
+	SetAnalyticsArgsUsage   = "[crash|usage|performance] <enabled>|<disabled>"
+	SetAnalyticsDescription = SetAnalyticsUsageText + `
+
+Provide a category to allow or forbid only its data, while analytics stays enabled:
+crash - failed connections and logins
+usage - settings changes and feature usage
+performance - timings of connections and logins
+
+Use 'nordvpn settings analytics-events' to see the latest data and whether it was sent.
+
+Supported values for <disabled>: 0, false, disable, off, disabled
+Example: nordvpn set analytics off
+Example: nordvpn set analytics usage off
+
+Supported values for <enabled>: 1, true, enable, on, enabled
+Example: nordvpn set analytics on`
+)
+
+var analyticsCategories = map[config.AnalyticsCategory]pb.AnalyticsCategory{
+	config.AnalyticsCrash:       pb.AnalyticsCategory_ANALYTICS_CRASH,
+	config.AnalyticsUsage:       pb.AnalyticsCategory_ANALYTICS_USAGE,
+	config.AnalyticsPerformance: pb.AnalyticsCategory_ANALYTICS_PERFORMANCE,
+}
+
 // SetAnalytics
 func (c *cmd) SetAnalytics(ctx *cli.Context) error {
-	if ctx.NArg() != 1 {
+	switch ctx.NArg() {
+	case 1:
+	case 2:
+		return c.setAnalyticsCategory(ctx)
+	default:
 		return formatError(argsCountError(ctx))
 	}
 
@@ -45,3 +76,75 @@ func (c *cmd) SetAnalytics(ctx *cli.Context) error {
 	}
 	return nil
 }
+
+func (c *cmd) setAnalyticsCategory(ctx *cli.Context) error {
+	name := strings.ToLower(ctx.Args().First())
+	category, ok := analyticsCategories[config.AnalyticsCategory(name)]
+	if !ok {
+		return formatError(argsParseError(ctx))
+	}
+
+	flag, err := nstrings.BoolFromString(ctx.Args().Get(1))
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetAnalyticsCategory(context.Background(), &pb.SetAnalyticsCategoryRequest{
+		Category: category,
+		Enabled:  flag,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	setting := fmt.Sprintf("Analytics of %s data", name)
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, setting, nstrings.GetBoolLabel(flag)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, setting, nstrings.GetBoolLabel(flag)))
+	}
+	return nil
+}
+
+// SetAnalyticsAutocomplete suggests the analytics categories and the boolean values
+func (c *cmd) SetAnalyticsAutocomplete(ctx *cli.Context) {
+	switch ctx.NArg() {
+	case 0:
+		for _, category := range config.AnalyticsCategories {
+			fmt.Println(category)
+		}
+		for _, v := range nstrings.GetBools() {
+			fmt.Println(v)
+		}
+	case 1:
+		if _, ok := analyticsCategories[config.AnalyticsCategory(ctx.Args().First())]; !ok {
+			return
+		}
+		for _, v := range nstrings.GetBools() {
+			fmt.Println(v)
+		}
+	}
+}
+
+// analyticsConsentLabel lists the analytics categories which are allowed to be sent
+func analyticsConsentLabel(consent *pb.AnalyticsConsent) string {
+	var allowed []string
+	if consent.GetCrash() {
+		allowed = append(allowed, string(config.AnalyticsCrash))
+	}
+	if consent.GetUsage() {
+		allowed = append(allowed, string(config.AnalyticsUsage))
+	}
+	if consent.GetPerformance() {
+		allowed = append(allowed, string(config.AnalyticsPerformance))
+	}
+	if len(allowed) == 0 {
+		return "none"
+	}
+	return strings.Join(allowed, ", ")
+}
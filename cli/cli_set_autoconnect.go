@@ -22,6 +22,8 @@ const (
 	SetAutoConnectArgsUsageText = `<enabled>|<disabled> [<country>|<server>|<country_code>|<city>|<group>|<country> <city>]`
 	SetAutoConnectDescription   = `Enables or disables auto-connect. When enabled, this feature will automatically try to connect to VPN on operating system startup.
 
+Auto-connect and its target are set for the user running the command. The daemon uses the choice of the user logged in at the console, or the most recent choice of any user if nobody is logged in yet.
+
 Supported values for <disabled>: 0, false, disable, off, disabled
 Example: nordvpn set autoconnect off
 
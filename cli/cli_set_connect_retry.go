@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set connect retry help text
+const (
+	SetConnectRetryUsageText     = "Sets how many times and how often the failed connection is retried"
+	SetConnectRetryArgsUsageText = `<attempts> [<attempt_timeout>] [<backoff>]|off`
+	SetConnectRetryDescription   = `Use this command to retry the failed connection instead of waiting for a single attempt.
+<attempts> is the total number of the connection attempts, from 1 to 10.
+<attempt_timeout> limits how long a single attempt can take, e.g. 30s, up to 5m. Use 0 to wait until the VPN gives up.
+<backoff> is the delay before the first retry, e.g. 5s, up to 1m. It is doubled after every next retry.
+The server is selected again for every attempt. Set it to 'off' to report the failure after the first attempt.
+
+Example: 'nordvpn set connect-retry 5 30s 5s'
+Example: 'nordvpn set connect-retry off'`
+)
+
+const connectRetryOff = "off"
+
+func (c *cmd) SetConnectRetry(ctx *cli.Context) error {
+	if ctx.NArg() < 1 || ctx.NArg() > 3 {
+		return formatError(argsCountError(ctx))
+	}
+
+	retry, err := parseConnectRetry(ctx.Args().Slice())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetConnectRetry(context.Background(), retry)
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Connect retry", connectRetryLabel(retry)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Connect retry", connectRetryLabel(retry)))
+	}
+	return nil
+}
+
+func (c *cmd) SetConnectRetryAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() == 0 {
+		fmt.Println(connectRetryOff)
+	}
+}
+
+// parseConnectRetry parses the number of attempts followed by the optional attempt timeout and backoff durations.
+// The durations are sent in whole seconds.
+func parseConnectRetry(args []string) (*pb.ConnectRetry, error) {
+	if len(args) == 1 && strings.EqualFold(args[0], connectRetryOff) {
+		return &pb.ConnectRetry{}, nil
+	}
+
+	attempts, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	if attempts == 0 || attempts > config.MaxConnectAttempts {
+		return nil, config.ErrConnectAttempts
+	}
+	retry := &pb.ConnectRetry{Attempts: uint32(attempts)}
+
+	if len(args) > 1 {
+		if retry.AttemptTimeoutSeconds, err = parseRetrySeconds(args[1], config.MaxConnectAttemptTimeout); err != nil {
+			return nil, err
+		}
+	}
+	if len(args) > 2 {
+		if retry.BackoffSeconds, err = parseRetrySeconds(args[2], config.MaxConnectBackoff); err != nil {
+			return nil, err
+		}
+	}
+	return retry, nil
+}
+
+func parseRetrySeconds(arg string, limit time.Duration) (uint32, error) {
+	if arg == "0" {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(arg)
+	if err != nil {
+		return 0, err
+	}
+	if duration < 0 || duration > limit || duration%time.Second != 0 {
+		return 0, errors.New("duration must be given in whole seconds within the limit")
+	}
+	return uint32(duration / time.Second), nil
+}
+
+func connectRetryLabel(retry *pb.ConnectRetry) string {
+	if retry.GetAttempts() <= 1 {
+		return connectRetryOff
+	}
+	timeout := "no timeout"
+	if retry.GetAttemptTimeoutSeconds() > 0 {
+		timeout = fmt.Sprintf("%s timeout", time.Duration(retry.GetAttemptTimeoutSeconds())*time.Second)
+	}
+	backoff := config.DefaultConnectBackoff
+	if retry.GetBackoffSeconds() > 0 {
+		backoff = time.Duration(retry.GetBackoffSeconds()) * time.Second
+	}
+	return fmt.Sprintf("%d attempts, %s, %s backoff", retry.GetAttempts(), timeout, backoff)
+}
@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConnectRetry(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		args     string
+		expected *pb.ConnectRetry
+		err      bool
+	}{
+		{args: "off", expected: &pb.ConnectRetry{}},
+		{args: "3", expected: &pb.ConnectRetry{Attempts: 3}},
+		{args: "5 30s", expected: &pb.ConnectRetry{Attempts: 5, AttemptTimeoutSeconds: 30}},
+		{args: "5 0 10s", expected: &pb.ConnectRetry{Attempts: 5, BackoffSeconds: 10}},
+		{args: "5 1m30s 1m", expected: &pb.ConnectRetry{Attempts: 5, AttemptTimeoutSeconds: 90, BackoffSeconds: 60}},
+		{args: "0", err: true},
+		{args: "11", err: true},
+		{args: "many", err: true},
+		{args: "5 10m", err: true},
+		{args: "5 30s 2m", err: true},
+		{args: "5 1500ms", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.args, func(t *testing.T) {
+			retry, err := parseConnectRetry(strings.Fields(test.args))
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, retry)
+		})
+	}
+}
+
+func TestConnectRetryLabel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, "off", connectRetryLabel(nil))
+	assert.Equal(t, "off", connectRetryLabel(&pb.ConnectRetry{Attempts: 1, BackoffSeconds: 10}))
+	assert.Equal(t, "3 attempts, no timeout, 5s backoff", connectRetryLabel(&pb.ConnectRetry{Attempts: 3}))
+	assert.Equal(t, "5 attempts, 30s timeout, 10s backoff",
+		connectRetryLabel(&pb.ConnectRetry{Attempts: 5, AttemptTimeoutSeconds: 30, BackoffSeconds: 10}))
+}
@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set connect timeout help text
+const (
+	SetConnectTimeoutUsageText     = "Sets how long to wait for the server while connecting"
+	SetConnectTimeoutArgsUsageText = `<timeout> <duration>|default`
+	SetConnectTimeoutDescription   = `Use this command to wait longer for the server on very slow networks or to give up sooner on the unresponsive servers.
+Supported values for <timeout>:
+	nordlynx-handshake - the wait for the first handshake with the NordLynx server
+	openvpn-connect - the wait for the OpenVPN server to respond
+	openvpn-handshake - the TLS handshake with the OpenVPN server
+<duration> is given in whole seconds, e.g. 30s, from 1s to 5m. Set it to 'default' to use the default wait of the technology.
+The timeouts are applied to the next connection.
+
+Example: 'nordvpn set connect-timeout nordlynx-handshake 30s'
+Example: 'nordvpn set connect-timeout openvpn-handshake 2m'
+Example: 'nordvpn set connect-timeout openvpn-connect default'`
+)
+
+const (
+	connectTimeoutNordLynxHandshake = "nordlynx-handshake"
+	connectTimeoutOpenVPNConnect    = "openvpn-connect"
+	connectTimeoutOpenVPNHandshake  = "openvpn-handshake"
+	connectTimeoutDefault           = "default"
+)
+
+var connectTimeoutNames = []string{
+	connectTimeoutNordLynxHandshake,
+	connectTimeoutOpenVPNConnect,
+	connectTimeoutOpenVPNHandshake,
+}
+
+func (c *cmd) SetConnectTimeout(ctx *cli.Context) error {
+	if ctx.NArg() != 2 {
+		return formatError(argsCountError(ctx))
+	}
+
+	settings, err := c.getSettings()
+	if err != nil {
+		return formatError(err)
+	}
+
+	name := strings.ToLower(ctx.Args().First())
+	timeouts, err := parseConnectTimeout(settings.GetConnectTimeouts(), name, ctx.Args().Get(1))
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetConnectTimeouts(context.Background(), timeouts)
+	if err != nil {
+		return formatError(err)
+	}
+
+	label := connectTimeoutLabel(connectTimeoutSeconds(timeouts, name))
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Connect timeout "+name, label))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Connect timeout "+name, label))
+	}
+	return nil
+}
+
+func (c *cmd) SetConnectTimeoutAutoComplete(ctx *cli.Context) {
+	switch ctx.NArg() {
+	case 0:
+		for _, name := range connectTimeoutNames {
+			fmt.Println(name)
+		}
+	case 1:
+		fmt.Println(connectTimeoutDefault)
+	}
+}
+
+// parseConnectTimeout returns the current timeouts with the named one changed to the given duration
+func parseConnectTimeout(current *pb.ConnectTimeouts, name string, value string) (*pb.ConnectTimeouts, error) {
+	var seconds uint32
+	if !strings.EqualFold(value, connectTimeoutDefault) {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, err
+		}
+		if duration < config.MinConnectTimeout || duration > config.MaxConnectTimeout || duration%time.Second != 0 {
+			return nil, config.ErrConnectTimeout
+		}
+		seconds = uint32(duration / time.Second)
+	}
+
+	timeouts := &pb.ConnectTimeouts{
+		NordlynxHandshakeSeconds: current.GetNordlynxHandshakeSeconds(),
+		OpenvpnConnectSeconds:    current.GetOpenvpnConnectSeconds(),
+		OpenvpnHandshakeSeconds:  current.GetOpenvpnHandshakeSeconds(),
+	}
+	switch name {
+	case connectTimeoutNordLynxHandshake:
+		timeouts.NordlynxHandshakeSeconds = seconds
+	case connectTimeoutOpenVPNConnect:
+		timeouts.OpenvpnConnectSeconds = seconds
+	case connectTimeoutOpenVPNHandshake:
+		timeouts.OpenvpnHandshakeSeconds = seconds
+	default:
+		return nil, errors.New("unknown connect timeout")
+	}
+	return timeouts, nil
+}
+
+func connectTimeoutSeconds(timeouts *pb.ConnectTimeouts, name string) uint32 {
+	switch name {
+	case connectTimeoutNordLynxHandshake:
+		return timeouts.GetNordlynxHandshakeSeconds()
+	case connectTimeoutOpenVPNConnect:
+		return timeouts.GetOpenvpnConnectSeconds()
+	case connectTimeoutOpenVPNHandshake:
+		return timeouts.GetOpenvpnHandshakeSeconds()
+	}
+	return 0
+}
+
+func connectTimeoutLabel(seconds uint32) string {
+	if seconds == 0 {
+		return connectTimeoutDefault
+	}
+	return (time.Duration(seconds) * time.Second).String()
+}
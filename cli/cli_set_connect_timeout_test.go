@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConnectTimeout(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	current := &pb.ConnectTimeouts{NordlynxHandshakeSeconds: 20, OpenvpnConnectSeconds: 10}
+	tests := []struct {
+		name     string
+		value    string
+		expected *pb.ConnectTimeouts
+		err      bool
+	}{
+		{
+			name:     connectTimeoutNordLynxHandshake,
+			value:    "1m",
+			expected: &pb.ConnectTimeouts{NordlynxHandshakeSeconds: 60, OpenvpnConnectSeconds: 10},
+		},
+		{
+			name:     connectTimeoutOpenVPNHandshake,
+			value:    "2m30s",
+			expected: &pb.ConnectTimeouts{NordlynxHandshakeSeconds: 20, OpenvpnConnectSeconds: 10, OpenvpnHandshakeSeconds: 150},
+		},
+		{
+			name:     connectTimeoutOpenVPNConnect,
+			value:    "default",
+			expected: &pb.ConnectTimeouts{NordlynxHandshakeSeconds: 20},
+		},
+		{name: connectTimeoutOpenVPNConnect, value: "0", err: true},
+		{name: connectTimeoutOpenVPNConnect, value: "10m", err: true},
+		{name: connectTimeoutOpenVPNConnect, value: "1500ms", err: true},
+		{name: "wireguard-handshake", value: "10s", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name+" "+test.value, func(t *testing.T) {
+			timeouts, err := parseConnectTimeout(current, test.name, test.value)
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, timeouts)
+		})
+	}
+}
+
+func TestConnectTimeoutLabel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, "default", connectTimeoutLabel(0))
+	assert.Equal(t, "1m30s", connectTimeoutLabel(90))
+}
@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set daemon log level help text
+const (
+	SetDaemonLogLevelUsageText     = "Sets the log level of the NordVPN daemon"
+	SetDaemonLogLevelArgsUsageText = `<level>`
+	SetDaemonLogLevelDebugUsage    = "Log debug messages of the component regardless of the level. Supported components: firewall, vpn, meshnet, api."
+	SetDaemonLogLevelPersistUsage  = "Keep the log level after the daemon is restarted"
+	SetDaemonLogLevelDescription   = `Use this command to choose how much the NordVPN daemon logs without restarting it.
+Supported values for <level>: error, warning, info, debug.
+Debug messages of the individual components can be enabled with the --debug option, which can be repeated. Components not listed have their debug messages disabled.
+The change lasts until the daemon is restarted unless the --persist option is used.
+
+Use 'nordvpn logs' to view the logs.
+
+Example: 'nordvpn set daemon-log-level debug'
+Example: 'nordvpn set daemon-log-level --debug firewall --debug vpn --persist info'`
+	flagDaemonLogLevelDebug   = "debug"
+	flagDaemonLogLevelPersist = "persist"
+)
+
+func (c *cmd) SetDaemonLogLevel(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	level, err := internal.ParseLogLevel(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+	components, err := internal.ParseLogComponents(ctx.StringSlice(flagDaemonLogLevelDebug))
+	if err != nil {
+		return formatError(err)
+	}
+
+	resp, err := c.client.SetDaemonLogLevel(context.Background(), &pb.SetDaemonLogLevelRequest{
+		Level:           string(level),
+		DebugComponents: logComponentNames(components),
+		Persist:         ctx.Bool(flagDaemonLogLevelPersist),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	value := daemonLogLevelLabel(string(level), logComponentNames(components))
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeFailure:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Daemon log level", value))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Daemon log level", value))
+		if !ctx.Bool(flagDaemonLogLevelPersist) {
+			color.Yellow(SetDaemonLogLevelUntilRestart)
+		}
+	}
+
+	return nil
+}
+
+func (c *cmd) SetDaemonLogLevelAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for _, level := range internal.LogLevels {
+		fmt.Println(level)
+	}
+}
+
+func logComponentNames(components []internal.LogComponent) []string {
+	names := []string{}
+	for _, component := range components {
+		names = append(names, string(component))
+	}
+	return names
+}
+
+// daemonLogLevelLabel describes the log level together with the components with debug logging enabled
+func daemonLogLevelLabel(level string, components []string) string {
+	if len(components) == 0 {
+		return level
+	}
+	return fmt.Sprintf("%s, debug: %s", level, strings.Join(components, ", "))
+}
@@ -26,6 +26,15 @@ Example: nordvpn set dns off
 Arguments <servers> is a list of IP addresses separated by space
 Example: nordvpn set dns 1.1.1.1 1.0.0.1
 
+DNS-over-TLS servers are given as tls://<host>[:port] and DNS-over-HTTPS servers as https://<host>[:port][/path].
+Certificate of the server is verified against <host>. DNS-over-TLS server given by the IP address can be verified
+against another name with tls://<ip>#<name>.
+Example: nordvpn set dns tls://dns.quad9.net https://cloudflare-dns.com/dns-query
+
+Encrypted servers are always tried first. IP addresses listed together with encrypted servers are used only as
+a fallback when none of the encrypted servers answers, otherwise DNS is never sent unencrypted.
+Example: nordvpn set dns tls://1.1.1.1#cloudflare-dns.com 9.9.9.9
+
 Limits:
   Can set up to 3 DNS servers
 
@@ -49,6 +58,8 @@ func handleSetDNSStatus(code pb.SetDNSStatus, dns []string) error {
 	switch code {
 	case pb.SetDNSStatus_INVALID_DNS_ADDRESS:
 		return fmt.Errorf(SetDNSInvalidAddress)
+	case pb.SetDNSStatus_INVALID_DNS_HOSTNAME:
+		return fmt.Errorf(SetDNSInvalidHostname)
 	case pb.SetDNSStatus_TOO_MANY_VALUES:
 		return fmt.Errorf(SetDNSTooManyValues)
 	case pb.SetDNSStatus_DNS_CONFIGURED_TPL_RESET:
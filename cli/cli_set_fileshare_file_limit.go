@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/fileshare"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set fileshare file limit help text
+const (
+	SetFileshareFileLimitUsageText     = "Sets the maximum number of files in a single fileshare transfer"
+	SetFileshareFileLimitArgsUsageText = `<count>|default`
+	SetFileshareFileLimitDescription   = `Use this command to change how many files can be sent or received in a single transfer. Transfers with more files are refused by the sender and rejected by the receiver.
+The limit applies to this device only, the peer on the other side of the transfer uses its own limit. Running fileshare is restarted to apply the limit.
+Set the limit to 'default' to use the default limit of 1000 files again.
+
+Example: 'nordvpn set fileshare-file-limit 5000'
+Example: 'nordvpn set fileshare-file-limit default'`
+)
+
+const fileshareFileLimitDefault = "default"
+
+func (c *cmd) SetFileshareFileLimit(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	limit, err := parseFileshareFileLimit(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetFileshareFileLimit(context.Background(), &pb.SetUint32Request{Value: limit})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Fileshare file limit", fileshareFileLimitLabel(limit)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Fileshare file limit", fileshareFileLimitLabel(limit)))
+	}
+	return nil
+}
+
+func (c *cmd) SetFileshareFileLimitAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() == 0 {
+		fmt.Println(fileshareFileLimitDefault)
+	}
+}
+
+// parseFileshareFileLimit returns 0 for the default limit
+func parseFileshareFileLimit(arg string) (uint32, error) {
+	if strings.EqualFold(arg, fileshareFileLimitDefault) {
+		return 0, nil
+	}
+	limit, err := strconv.ParseUint(arg, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	// zero is the default, it is set only with 'default'
+	if limit == 0 || config.ValidateFileshareFileLimit(uint32(limit)) != nil {
+		return 0, errors.New("file limit must be between 1 and 100000")
+	}
+	return uint32(limit), nil
+}
+
+func fileshareFileLimitLabel(limit uint32) string {
+	if limit == 0 {
+		return fmt.Sprintf("%d (%s)", fileshare.TransferFileLimit, fileshareFileLimitDefault)
+	}
+	return strconv.FormatUint(uint64(limit), 10)
+}
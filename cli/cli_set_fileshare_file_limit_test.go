@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFileshareFileLimit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		arg      string
+		expected uint32
+		err      bool
+	}{
+		{arg: "1", expected: 1},
+		{arg: "5000", expected: 5000},
+		{arg: "100000", expected: 100000},
+		{arg: "default", expected: 0},
+		{arg: "DEFAULT", expected: 0},
+		{arg: "0", err: true},
+		{arg: "100001", err: true},
+		{arg: "-1", err: true},
+		{arg: "many", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.arg, func(t *testing.T) {
+			limit, err := parseFileshareFileLimit(test.arg)
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, limit)
+		})
+	}
+}
+
+func TestFileshareFileLimitLabel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, "1000 (default)", fileshareFileLimitLabel(0))
+	assert.Equal(t, "5000", fileshareFileLimitLabel(5000))
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/nstrings"
@@ -60,6 +61,10 @@ func (c *cmd) SetFirewallMark(ctx *cli.Context) error {
 		return formatError(err)
 	}
 
+	if err := config.ValidateFirewallMark(uint32(mark)); err != nil {
+		return formatError(err)
+	}
+
 	resp, err := c.client.SetFirewallMark(context.Background(), &pb.SetUint32Request{Value: uint32(mark)})
 	if err != nil {
 		return formatError(err)
@@ -68,10 +73,12 @@ func (c *cmd) SetFirewallMark(ctx *cli.Context) error {
 	switch resp.Type {
 	case internal.CodeConfigError:
 		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
 	case internal.CodeNothingToDo:
 		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Firewall Mark", args.First()))
 	case internal.CodeSuccess:
-		color.Yellow("Restart daemon (e.g. `sudo systemctl restart nordvpnd` on systemd distros) for this setting to take an effect.")
+		color.Yellow(SetRestartDaemon)
 		color.Green(fmt.Sprintf(MsgSetSuccess, "Firewall Mark", args.First()))
 	}
 	return nil
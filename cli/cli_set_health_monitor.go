@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set health monitor help text
+const (
+	SetHealthMonitorUsageText     = "Sets how quickly the failing VPN connection is recovered"
+	SetHealthMonitorArgsUsageText = `<sensitivity>`
+	SetHealthMonitorDescription   = `Use this command to choose how quickly the VPN connection is recovered when it stops working.
+The connection is checked every 30 seconds. When the checks fail, the connection is established again with the same server. If it fails again soon after, the next fastest server is used.
+Supported values for <sensitivity>: off, low, normal or high. With 'high', the connection is also recovered when the latency exceeds 1 second.
+Default: normal.
+
+Example: 'nordvpn set health-monitor high'
+Example: 'nordvpn set health-monitor off'`
+)
+
+func (c *cmd) SetHealthMonitor(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	sensitivity := ctx.Args().First()
+	resp, err := c.client.SetHealthMonitor(context.Background(), &pb.SetStringRequest{Value: sensitivity})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Health monitor", sensitivity))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Health monitor", sensitivity))
+	}
+	return nil
+}
+
+func (c *cmd) SetHealthMonitorAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for _, sensitivity := range config.HealthSensitivities {
+		fmt.Println(sensitivity)
+	}
+}
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set interface name help text
+const (
+	SetInterfaceNameUsageText     = "Sets the name of the VPN tunnel interface"
+	SetInterfaceNameArgsUsageText = `<name>|default`
+	SetInterfaceNameDescription   = `Use this command to give the VPN tunnel interface a stable name, for example, for firewall scripts, monitoring or setups with several VPNs.
+By default, the interface is named after the technology: nordlynx, nordtun for OpenVPN or nordwhisper. The name may be up to 15 characters long and may not contain spaces, '/' or ':'.
+While meshnet is enabled, NordLynx keeps the nordlynx name, as meshnet runs on the same interface.
+Set the name to 'default' to use the default name of the technology again.
+
+Example: 'nordvpn set interface vpn0'
+Example: 'nordvpn set interface default'`
+)
+
+const interfaceNameDefault = "default"
+
+func (c *cmd) SetInterfaceName(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	name, err := parseInterfaceName(ctx.Args().First())
+	if err != nil {
+		return formatError(err)
+	}
+
+	resp, err := c.client.SetInterfaceName(context.Background(), &pb.SetStringRequest{Value: name})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeConflict:
+		return formatError(fmt.Errorf(SetInterfaceNameExists, name))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Interface", interfaceNameLabel(name)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Interface", interfaceNameLabel(name)))
+		if len(resp.Data) > 0 {
+			if reconnect, _ := strconv.ParseBool(resp.Data[0]); reconnect {
+				color.Yellow(SetReconnect)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *cmd) SetInterfaceNameAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() == 0 {
+		fmt.Println(interfaceNameDefault)
+	}
+}
+
+// parseInterfaceName returns an empty name for the default name of the technology
+func parseInterfaceName(arg string) (string, error) {
+	if strings.EqualFold(arg, interfaceNameDefault) {
+		return "", nil
+	}
+	if arg == "" {
+		return "", config.ErrInterfaceName
+	}
+	if err := config.ValidateInterfaceName(arg); err != nil {
+		return "", err
+	}
+	return arg, nil
+}
+
+func interfaceNameLabel(name string) string {
+	if name == "" {
+		return interfaceNameDefault
+	}
+	return name
+}
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInterfaceName(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		arg      string
+		expected string
+		err      bool
+	}{
+		{arg: "vpn0", expected: "vpn0"},
+		{arg: "nordlynx", expected: "nordlynx"},
+		{arg: "default", expected: ""},
+		{arg: "DEFAULT", expected: ""},
+		{arg: "", err: true},
+		{arg: "vpn 0", err: true},
+		{arg: "averyveryverylongname", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.arg, func(t *testing.T) {
+			name, err := parseInterfaceName(test.arg)
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, name)
+		})
+	}
+}
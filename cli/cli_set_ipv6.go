@@ -12,7 +12,8 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
-const SetIpv6UsageText = "Enables or disables use of the IPv6."
+const SetIpv6UsageText = "Enables or disables use of the IPv6. When enabled, IPv6 traffic is routed through " +
+	"the VPN tunnel if the server supports it and blocked otherwise."
 
 func (c *cmd) SetIpv6(ctx *cli.Context) error {
 	if ctx.NArg() != 1 {
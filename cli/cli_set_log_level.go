@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set log level help text
+const (
+	SetLogLevelUsageText     = "Sets the log level of the user services for all users"
+	SetLogLevelArgsUsageText = `<level>`
+	SetLogLevelDescription   = `Use this command to choose how much the user services (norduserd and fileshare) log.
+Supported values for <level>: error, warning, info, debug.
+
+Use 'nordvpn logs --user' to view the logs.
+
+Example: 'nordvpn set log-level debug'`
+)
+
+func (c *cmd) SetLogLevel(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	level, err := internal.ParseLogLevel(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetLogLevel(context.Background(), &pb.SetLogLevelRequest{Level: string(level)})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Log level", level))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Log level", level))
+	}
+
+	return nil
+}
+
+func (c *cmd) SetLogLevelAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for _, level := range internal.LogLevels {
+		fmt.Println(level)
+	}
+}
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set max server load help text
+const (
+	SetMaxServerLoadUsageText     = "Sets the highest load of the recommended servers"
+	SetMaxServerLoadArgsUsageText = `<percent>|off`
+	SetMaxServerLoadDescription   = `Use this command to avoid congested servers. Servers loaded above the given percent are not recommended, more distant servers are chosen instead.
+The least loaded server is used when every matching server is busier. The limit does not apply when connecting to a specific server.
+Set the limit to 'off' to recommend the servers by the distance and the load again.
+
+Example: 'nordvpn set max-server-load 60'
+Example: 'nordvpn set max-server-load off'`
+)
+
+const maxServerLoadOff = "off"
+
+func (c *cmd) SetMaxServerLoad(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	maxLoad, err := parseMaxServerLoad(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetMaxServerLoad(context.Background(), &pb.SetUint32Request{Value: maxLoad})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Max server load", maxServerLoadLabel(maxLoad)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Max server load", maxServerLoadLabel(maxLoad)))
+	}
+	return nil
+}
+
+func (c *cmd) SetMaxServerLoadAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() == 0 {
+		fmt.Println(maxServerLoadOff)
+	}
+}
+
+// parseMaxServerLoad returns 0 when the limit is turned off, percent sign is optional
+func parseMaxServerLoad(arg string) (uint32, error) {
+	if strings.EqualFold(arg, maxServerLoadOff) {
+		return 0, nil
+	}
+	maxLoad, err := strconv.ParseUint(strings.TrimSuffix(arg, "%"), 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	if maxLoad == 0 || maxLoad > config.MaxServerLoadLimit {
+		return 0, errors.New("server load must be between 1 and 100")
+	}
+	return uint32(maxLoad), nil
+}
+
+func maxServerLoadLabel(maxLoad uint32) string {
+	if maxLoad == 0 {
+		return maxServerLoadOff
+	}
+	return fmt.Sprintf("%d%%", maxLoad)
+}
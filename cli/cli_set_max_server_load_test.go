@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMaxServerLoad(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		arg      string
+		expected uint32
+		err      bool
+	}{
+		{arg: "60", expected: 60},
+		{arg: "60%", expected: 60},
+		{arg: "100", expected: 100},
+		{arg: "off", expected: 0},
+		{arg: "OFF", expected: 0},
+		{arg: "0", err: true},
+		{arg: "101", err: true},
+		{arg: "-1", err: true},
+		{arg: "high", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.arg, func(t *testing.T) {
+			maxLoad, err := parseMaxServerLoad(test.arg)
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, maxLoad)
+		})
+	}
+}
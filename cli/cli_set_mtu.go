@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set MTU help text
+const (
+	SetMTUUsageText     = "Sets the MTU of the VPN tunnel"
+	SetMTUArgsUsageText = `<bytes>|auto`
+	SetMTUDescription   = `Use this command if websites or downloads stall while connected, for example, on PPPoE or LTE connections which drop the large packets.
+By default, the MTU is calculated from the MTU of your network and lowered after connecting until the packets pass through the tunnel. Set the MTU to 'auto' to detect it again.
+The MTU must be between 576 and 1500 bytes.
+
+Example: 'nordvpn set mtu 1380'
+Example: 'nordvpn set mtu auto'`
+)
+
+const mtuAuto = "auto"
+
+func (c *cmd) SetMTU(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	mtu, err := parseMTU(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetMTU(context.Background(), &pb.SetUint32Request{Value: mtu})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "MTU", mtuLabel(mtu)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "MTU", mtuLabel(mtu)))
+		if len(resp.Data) > 0 {
+			if reconnect, _ := strconv.ParseBool(resp.Data[0]); reconnect {
+				color.Yellow(SetReconnect)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *cmd) SetMTUAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() == 0 {
+		fmt.Println(mtuAuto)
+	}
+}
+
+// parseMTU returns 0 for the MTU detected after connecting
+func parseMTU(arg string) (uint32, error) {
+	if strings.EqualFold(arg, mtuAuto) {
+		return 0, nil
+	}
+	mtu, err := strconv.ParseUint(arg, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	// zero is the automatic MTU, it is set only with 'auto'
+	if mtu == 0 || config.ValidateMTU(uint32(mtu)) != nil {
+		return 0, config.ErrMTU
+	}
+	return uint32(mtu), nil
+}
+
+func mtuLabel(mtu uint32) string {
+	if mtu == 0 {
+		return mtuAuto
+	}
+	return strconv.FormatUint(uint64(mtu), 10)
+}
@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMTU(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		arg      string
+		expected uint32
+		err      bool
+	}{
+		{arg: "1380", expected: 1380},
+		{arg: "576", expected: 576},
+		{arg: "1500", expected: 1500},
+		{arg: "auto", expected: 0},
+		{arg: "AUTO", expected: 0},
+		{arg: "0", err: true},
+		{arg: "575", err: true},
+		{arg: "1501", err: true},
+		{arg: "-1", err: true},
+		{arg: "large", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.arg, func(t *testing.T) {
+			mtu, err := parseMTU(test.arg)
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, mtu)
+		})
+	}
+}
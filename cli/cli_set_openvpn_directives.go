@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set OpenVPN directives help text
+const (
+	SetOpenVPNDirectivesUsageText     = "Sets the directives appended to the OpenVPN config"
+	SetOpenVPNDirectivesArgsUsageText = `<directive>...|off`
+	SetOpenVPNDirectivesDescription   = `Use this command to tune the OpenVPN connection with the directives which are not available as the settings.
+Every directive is given as a single argument and replaces the directives set before. Only the following directives with numeric arguments are allowed:
+verb, mute, mute-replay-warnings, sndbuf, rcvbuf, fast-io, tcp-nodelay, txqueuelen, tun-mtu, mssfix, fragment, reneg-sec, replay-window and explicit-exit-notify.
+Set it to 'off' to remove the directives.
+
+Example: 'nordvpn set openvpn-directives "sndbuf 524288" "rcvbuf 524288"'
+Example: 'nordvpn set openvpn-directives off'`
+)
+
+const openVPNDirectivesOff = "off"
+
+func (c *cmd) SetOpenVPNDirectives(ctx *cli.Context) error {
+	if ctx.NArg() < 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	directives := ctx.Args().Slice()
+	if len(directives) == 1 && strings.EqualFold(directives[0], openVPNDirectivesOff) {
+		directives = nil
+	}
+
+	resp, err := c.client.SetOpenVPNDirectives(context.Background(),
+		&pb.SetOpenVPNDirectivesRequest{Directives: directives})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		if len(resp.Data) > 0 {
+			return formatError(fmt.Errorf(SetOpenVPNDirectivesInvalid, resp.Data[0]))
+		}
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "OpenVPN directives", openVPNDirectivesLabel(directives)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "OpenVPN directives", openVPNDirectivesLabel(directives)))
+		if len(resp.Data) > 0 {
+			if reconnect, _ := strconv.ParseBool(resp.Data[0]); reconnect {
+				color.Yellow(SetReconnect)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *cmd) SetOpenVPNDirectivesAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() == 0 {
+		fmt.Println(openVPNDirectivesOff)
+	}
+}
+
+func openVPNDirectivesLabel(directives []string) string {
+	if len(directives) == 0 {
+		return openVPNDirectivesOff
+	}
+	normalized := make([]string, 0, len(directives))
+	for _, directive := range directives {
+		normalized = append(normalized, config.NormalizeOpenVPNDirective(directive))
+	}
+	return strings.Join(normalized, ", ")
+}
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set OpenVPN port help text
+const (
+	SetOpenVPNPortUsageText     = "Sets the port of the OpenVPN servers"
+	SetOpenVPNPortArgsUsageText = `<port>|default`
+	SetOpenVPNPortDescription   = `Use this command to connect to the OpenVPN servers through a custom port, for example, if your network blocks the default ones.
+By default, UDP connections use the port 1194 and TCP connections use the port 443. The custom port is not used by obfuscated servers.
+Set the port to 'default' to use the default port of the protocol again.
+
+Example: 'nordvpn set openvpn-port 443'
+Example: 'nordvpn set openvpn-port default'`
+)
+
+const openVPNPortDefault = "default"
+
+func (c *cmd) SetOpenVPNPort(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	port, err := parseOpenVPNPort(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	settings, err := c.getSettings()
+	if err != nil {
+		return formatError(err)
+	}
+	if settings.GetTechnology() != config.Technology_OPENVPN {
+		return formatError(errors.New(SetOpenVPNPortUnavailable))
+	}
+
+	resp, err := c.client.SetOpenVPNPort(context.Background(), &pb.SetUint32Request{Value: uint32(port)})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "OpenVPN port", openVPNPortLabel(port)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "OpenVPN port", openVPNPortLabel(port)))
+		if len(resp.Data) > 0 {
+			if reconnect, _ := strconv.ParseBool(resp.Data[0]); reconnect {
+				color.Yellow(SetReconnect)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *cmd) SetOpenVPNPortAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() == 0 {
+		fmt.Println(openVPNPortDefault)
+	}
+}
+
+// parseOpenVPNPort returns 0 for the default port
+func parseOpenVPNPort(arg string) (uint16, error) {
+	if strings.EqualFold(arg, openVPNPortDefault) {
+		return 0, nil
+	}
+	port, err := strconv.ParseUint(arg, 10, 16)
+	if err != nil {
+		return 0, err
+	}
+	if port == 0 {
+		return 0, errors.New("port must be positive")
+	}
+	return uint16(port), nil
+}
+
+func openVPNPortLabel(port uint16) string {
+	if port == 0 {
+		return openVPNPortDefault
+	}
+	return strconv.Itoa(int(port))
+}
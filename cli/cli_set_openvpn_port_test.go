@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOpenVPNPort(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		arg      string
+		expected uint16
+		err      bool
+	}{
+		{arg: "443", expected: 443},
+		{arg: "65535", expected: 65535},
+		{arg: "default", expected: 0},
+		{arg: "DEFAULT", expected: 0},
+		{arg: "0", err: true},
+		{arg: "65536", err: true},
+		{arg: "-1", err: true},
+		{arg: "udp", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.arg, func(t *testing.T) {
+			port, err := parseOpenVPNPort(test.arg)
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, port)
+		})
+	}
+}
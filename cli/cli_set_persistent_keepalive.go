@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set persistent keepalive help text
+const (
+	SetPersistentKeepaliveUsageText     = "Sets the interval of the NordLynx keepalive packets"
+	SetPersistentKeepaliveArgsUsageText = `<seconds>|default`
+	SetPersistentKeepaliveDescription   = `Use this command if the VPN connection stalls after being idle, for example, when your router drops the idle connections quickly.
+NordLynx sends the keepalive packets every 25 seconds by default. A shorter interval keeps the connection open behind such routers.
+Set the interval to 'default' to use the default interval again.
+
+Example: 'nordvpn set keepalive 10'
+Example: 'nordvpn set keepalive default'`
+)
+
+const persistentKeepaliveDefault = "default"
+
+func (c *cmd) SetPersistentKeepalive(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	interval, err := parsePersistentKeepalive(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	settings, err := c.getSettings()
+	if err != nil {
+		return formatError(err)
+	}
+	if settings.GetTechnology() != config.Technology_NORDLYNX {
+		return formatError(errors.New(SetPersistentKeepaliveUnavailable))
+	}
+
+	resp, err := c.client.SetPersistentKeepalive(context.Background(), &pb.SetUint32Request{Value: uint32(interval)})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Keepalive", persistentKeepaliveLabel(interval)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Keepalive", persistentKeepaliveLabel(interval)))
+		if len(resp.Data) > 0 {
+			if reconnect, _ := strconv.ParseBool(resp.Data[0]); reconnect {
+				color.Yellow(SetReconnect)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *cmd) SetPersistentKeepaliveAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() == 0 {
+		fmt.Println(persistentKeepaliveDefault)
+	}
+}
+
+// parsePersistentKeepalive returns 0 for the default interval
+func parsePersistentKeepalive(arg string) (uint16, error) {
+	if strings.EqualFold(arg, persistentKeepaliveDefault) {
+		return 0, nil
+	}
+	interval, err := strconv.ParseUint(arg, 10, 16)
+	if err != nil {
+		return 0, err
+	}
+	if interval == 0 {
+		return 0, errors.New("interval must be positive")
+	}
+	return uint16(interval), nil
+}
+
+func persistentKeepaliveLabel(interval uint16) string {
+	if interval == 0 {
+		return persistentKeepaliveDefault
+	}
+	return strconv.Itoa(int(interval)) + "s"
+}
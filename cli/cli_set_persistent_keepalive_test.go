@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePersistentKeepalive(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		arg      string
+		expected uint16
+		err      bool
+	}{
+		{arg: "10", expected: 10},
+		{arg: "65535", expected: 65535},
+		{arg: "default", expected: 0},
+		{arg: "DEFAULT", expected: 0},
+		{arg: "0", err: true},
+		{arg: "65536", err: true},
+		{arg: "-1", err: true},
+		{arg: "10s", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.arg, func(t *testing.T) {
+			interval, err := parsePersistentKeepalive(test.arg)
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, interval)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set route metric help text
+const (
+	SetRouteMetricUsageText     = "Sets the metric of the VPN default routes"
+	SetRouteMetricArgsUsageText = `<metric>|default`
+	SetRouteMetricDescription   = `Use this command if other routing daemons, e.g. NetworkManager or a dynamic routing protocol, install default routes of their own.
+The route with the lower metric wins, so set a lower metric than theirs for the VPN to win and a higher one for the VPN to lose.
+By default, the kernel default metric is used. Set the metric to 'default' to use it again.
+
+Example: 'nordvpn set route-metric 50'
+Example: 'nordvpn set route-metric default'`
+)
+
+const routeMetricDefault = "default"
+
+func (c *cmd) SetRouteMetric(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	metric, err := parseRouteMetric(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetRouteMetric(context.Background(), &pb.SetUint32Request{Value: metric})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Route metric", routeMetricLabel(metric)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Route metric", routeMetricLabel(metric)))
+		if len(resp.Data) > 0 {
+			if reconnect, _ := strconv.ParseBool(resp.Data[0]); reconnect {
+				color.Yellow(SetReconnect)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *cmd) SetRouteMetricAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() == 0 {
+		fmt.Println(routeMetricDefault)
+	}
+}
+
+// parseRouteMetric returns 0 for the kernel default metric
+func parseRouteMetric(arg string) (uint32, error) {
+	if strings.EqualFold(arg, routeMetricDefault) {
+		return 0, nil
+	}
+	metric, err := strconv.ParseUint(arg, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(metric), nil
+}
+
+func routeMetricLabel(metric uint32) string {
+	if metric == 0 {
+		return routeMetricDefault
+	}
+	return strconv.FormatUint(uint64(metric), 10)
+}
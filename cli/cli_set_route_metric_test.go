@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRouteMetric(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		arg      string
+		expected uint32
+		err      bool
+	}{
+		{arg: "50", expected: 50},
+		{arg: "0", expected: 0},
+		{arg: "4294967295", expected: 4294967295},
+		{arg: "default", expected: 0},
+		{arg: "DEFAULT", expected: 0},
+		{arg: "4294967296", err: true},
+		{arg: "-1", err: true},
+		{arg: "low", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.arg, func(t *testing.T) {
+			metric, err := parseRouteMetric(test.arg)
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, metric)
+		})
+	}
+}
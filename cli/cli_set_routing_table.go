@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set routing table help text
+const (
+	SetRoutingTableUsageText     = "Sets the ID of the routing table used for the VPN routes"
+	SetRoutingTableArgsUsageText = `<id>|default`
+	SetRoutingTableDescription   = `Use this command if other policy-routing software, e.g. Tailscale or a custom setup, uses the same routing table.
+By default, the table 205 is used, or the next free one if it is taken. The custom ID is used the same way. The tables 253, 254 and 255 are reserved by the kernel.
+The setting takes effect after the daemon restart. Set the ID to 'default' to use the default table again.
+
+Example: 'nordvpn set routing-table 1000'
+Example: 'nordvpn set routing-table default'`
+)
+
+const routingTableDefault = "default"
+
+func (c *cmd) SetRoutingTable(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	table, err := parseRoutingTable(ctx.Args().First())
+	if err != nil {
+		return formatError(err)
+	}
+
+	resp, err := c.client.SetRoutingTable(context.Background(), &pb.SetUint32Request{Value: table})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Routing table", routingTableLabel(table)))
+	case internal.CodeSuccess:
+		color.Yellow(SetRestartDaemon)
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Routing table", routingTableLabel(table)))
+	}
+	return nil
+}
+
+func (c *cmd) SetRoutingTableAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() == 0 {
+		fmt.Println(routingTableDefault)
+	}
+}
+
+// parseRoutingTable returns 0 for the default table
+func parseRoutingTable(arg string) (uint32, error) {
+	if strings.EqualFold(arg, routingTableDefault) {
+		return 0, nil
+	}
+	id, err := strconv.ParseUint(arg, 10, 32)
+	if err != nil || id == 0 {
+		return 0, config.ErrRoutingTable
+	}
+	if err := config.ValidateRoutingTable(uint32(id)); err != nil {
+		return 0, err
+	}
+	return uint32(id), nil
+}
+
+func routingTableLabel(id uint32) string {
+	if id == 0 {
+		return routingTableDefault
+	}
+	return strconv.FormatUint(uint64(id), 10)
+}
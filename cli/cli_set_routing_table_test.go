@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRoutingTable(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		arg      string
+		expected uint32
+		err      bool
+	}{
+		{arg: "52", expected: 52},
+		{arg: "1000", expected: 1000},
+		{arg: "default", expected: 0},
+		{arg: "Default", expected: 0},
+		{arg: "0", err: true},
+		{arg: "254", err: true},
+		{arg: "60001", err: true},
+		{arg: "-1", err: true},
+		{arg: "main", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.arg, func(t *testing.T) {
+			id, err := parseRoutingTable(test.arg)
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.expected, id)
+		})
+	}
+}
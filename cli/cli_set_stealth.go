@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set stealth help text
+const (
+	SetStealthUsageText     = "Enables or disables the stealth mode which runs OpenVPN over TCP 443, optionally through a proxy"
+	SetStealthArgsUsageText = `[--proxy <url>] <enabled>|<disabled>`
+	SetStealthProxyUsage    = "Connect through the HTTPS (http://host:port) or SOCKS5 (socks5://host:port) proxy"
+	SetStealthDescription   = `Use this command on the networks where only the web ports are open, e.g. in hotels or offices.
+In the stealth mode OpenVPN connects over TCP to the port 443, the same one used by HTTPS, regardless of the protocol and port settings.
+If the network allows only the connections through a proxy, give its address with the --proxy option. HTTPS proxies supporting the CONNECT method and SOCKS5 proxies are supported, proxies requiring a password are not.
+
+Example: 'nordvpn set stealth on'
+Example: 'nordvpn set stealth --proxy socks5://10.0.0.1:1080 on'
+Example: 'nordvpn set stealth off'`
+	flagStealthProxy = "proxy"
+)
+
+func (c *cmd) SetStealth(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	enabled, err := nstrings.BoolFromString(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+	stealth := &pb.Stealth{Enabled: enabled, Proxy: ctx.String(flagStealthProxy)}
+	if err := (config.Stealth{Enabled: stealth.Enabled, Proxy: stealth.Proxy}).Validate(); err != nil {
+		return formatError(err)
+	}
+
+	settings, err := c.getSettings()
+	if err != nil {
+		return formatError(err)
+	}
+	if settings.GetTechnology() != config.Technology_OPENVPN {
+		return formatError(errors.New(SetStealthUnavailable))
+	}
+
+	resp, err := c.client.SetStealth(context.Background(), stealth)
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Stealth", stealthLabel(stealth)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Stealth", stealthLabel(stealth)))
+		if len(resp.Data) > 0 {
+			if reconnect, _ := strconv.ParseBool(resp.Data[0]); reconnect {
+				color.Yellow(SetReconnect)
+			}
+		}
+	}
+	return nil
+}
+
+func stealthLabel(stealth *pb.Stealth) string {
+	label := nstrings.GetBoolLabel(stealth.GetEnabled())
+	if stealth.GetEnabled() && stealth.GetProxy() != "" {
+		label += " through " + stealth.GetProxy()
+	}
+	return label
+}
@@ -0,0 +1,20 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStealthLabel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, "disabled", stealthLabel(nil))
+	assert.Equal(t, "enabled", stealthLabel(&pb.Stealth{Enabled: true}))
+	assert.Equal(t, "enabled through socks5://10.0.0.1:1080",
+		stealthLabel(&pb.Stealth{Enabled: true, Proxy: "socks5://10.0.0.1:1080"}))
+	assert.Equal(t, "disabled", stealthLabel(&pb.Stealth{Proxy: "socks5://10.0.0.1:1080"}))
+}
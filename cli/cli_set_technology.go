@@ -19,11 +19,16 @@ const (
 	SetTechnologyUsageText     = "Sets the technology"
 	SetTechnologyArgsUsageText = `<technology>`
 	SetTechnologyDescription   = `Use this command to set the technology.
-Supported values for <technology>: OPENVPN or NORDLYNX.
+Supported values for <technology>: OPENVPN, NORDLYNX, NORDWHISPER or AUTO.
+NORDWHISPER makes the VPN traffic look like the regular web traffic. Use it on the networks which block both OpenVPN and NordLynx.
+AUTO tries NordLynx, OpenVPN UDP, OpenVPN TCP and the obfuscated servers in order until the connection succeeds. The technology which has worked is remembered for every network and the failing ones are skipped next time.
 
 Example: 'nordvpn set technology OPENVPN'`
 )
 
+// technologyAuto selects the technology automatically
+const technologyAuto = "AUTO"
+
 func (c *cmd) SetTechnology(ctx *cli.Context) error {
 	args := ctx.Args()
 
@@ -36,17 +41,23 @@ func (c *cmd) SetTechnology(ctx *cli.Context) error {
 	}
 
 	var tech config.Technology
+	var auto bool
 	switch strings.ToUpper(args.First()) {
 	case config.Technology_OPENVPN.String():
 		tech = config.Technology_OPENVPN
 	case config.Technology_NORDLYNX.String():
 		tech = config.Technology_NORDLYNX
+	case config.Technology_NORDWHISPER.String():
+		tech = config.Technology_NORDWHISPER
+	case technologyAuto:
+		auto = true
 	default:
 		return formatError(argsParseError(ctx))
 	}
 
 	resp, err := c.client.SetTechnology(context.Background(), &pb.SetTechnologyRequest{
 		Technology: tech,
+		Auto:       auto,
 	})
 	if err != nil {
 		return formatError(err)
@@ -61,6 +72,8 @@ func (c *cmd) SetTechnology(ctx *cli.Context) error {
 		return formatError(fmt.Errorf(SetTechnologyDepsError, internal.StringsToInterfaces(resp.Data)...))
 	case internal.CodePqWithoutNordlynx:
 		return formatError(fmt.Errorf(SetTechnologyDisablePQ))
+	case internal.CodeTechnologyUnavailable:
+		return formatError(fmt.Errorf(SetTechnologyUnavailable, internal.StringsToInterfaces(resp.Data)...))
 	case internal.CodeNothingToDo:
 		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Technology", strings.Join(resp.Data, " ")))
 	case internal.CodeSuccessWithoutAC:
@@ -86,4 +99,5 @@ func (c *cmd) SetTechnologyAutoComplete(ctx *cli.Context) {
 	for _, item := range resp.Data {
 		fmt.Println(item)
 	}
+	fmt.Println(technologyAuto)
 }
@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/nstrings"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// SetTrayHotkeyUsageText is shown next to tray-hotkey command by nordvpn set --help
+const SetTrayHotkeyUsageText = "Enables or disables the global keyboard shortcut which connects to or disconnects from VPN. The shortcut is registered by the tray through the desktop portal, Ctrl+Alt+N is suggested and it can be changed in the desktop settings."
+
+func (c *cmd) SetTrayHotkey(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	flag, err := nstrings.BoolFromString(ctx.Args().First())
+	if err != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetTrayHotkey(context.Background(), &pb.SetTrayHotkeyRequest{
+		Uid:     int64(os.Getuid()),
+		Enabled: flag,
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Tray hotkey", nstrings.GetBoolLabel(flag)))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Tray hotkey", nstrings.GetBoolLabel(flag)))
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set tray icon theme help text
+const (
+	SetTrayIconUsageText     = "Sets the look of the NordVPN icon in the system tray"
+	SetTrayIconArgsUsageText = `<theme>`
+	SetTrayIconDescription   = `Use this command to set the look of the NordVPN icon in the system tray.
+Supported values for <theme>:
+  auto       - follows the dark or light preference of the desktop
+  colored    - blue icon when connected
+  symbolic   - icon recolored by the desktop theme
+  monochrome - single color icon matching the panel
+
+Example: 'nordvpn set tray-icon symbolic'`
+)
+
+func (c *cmd) SetTrayIcon(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	theme, ok := config.TrayIconTheme_value[strings.ToUpper(ctx.Args().First())]
+	if !ok {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetTrayIconTheme(context.Background(), &pb.SetTrayIconThemeRequest{
+		Uid:   int64(os.Getuid()),
+		Theme: config.TrayIconTheme(theme),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Tray icon", strings.Join(resp.Data, " ")))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Tray icon", strings.Join(resp.Data, " ")))
+	}
+
+	return nil
+}
+
+func (c *cmd) SetTrayIconAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for i := 0; i < len(config.TrayIconTheme_name); i++ {
+		fmt.Println(strings.ToLower(config.TrayIconTheme(i).String()))
+	}
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/exp/slices"
+)
+
+// Set tray menu help text
+const (
+	SetTrayMenuUsageText     = "Sets which sections are shown in the tray menu and in what order for all users"
+	SetTrayMenuArgsUsageText = `<section>... | default`
+	SetTrayMenuDescription   = `Use this command to choose the sections of the NordVPN tray menu. Sections are shown in the given order and omitted sections are hidden.
+Supported values for <section>:
+  vpn       - connection status and controls
+  fileshare - incoming file transfers
+  settings  - notification and other settings
+  account   - account details, log in and log out
+
+Use 'default' to restore the default layout.
+
+Example: 'nordvpn set tray-menu vpn account'`
+)
+
+const trayMenuDefault = "default"
+
+func (c *cmd) SetTrayMenu(ctx *cli.Context) error {
+	if ctx.NArg() == 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	sections := ctx.Args().Slice()
+	if len(sections) == 1 && sections[0] == trayMenuDefault {
+		sections = nil
+	}
+	if config.ValidateTrayMenu(sections) != nil {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.SetTrayMenu(context.Background(), &pb.SetTrayMenuRequest{Sections: sections})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Tray menu", strings.Join(resp.Data, ", ")))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Tray menu", strings.Join(resp.Data, ", ")))
+	}
+
+	return nil
+}
+
+func (c *cmd) SetTrayMenuAutoComplete(ctx *cli.Context) {
+	given := ctx.Args().Slice()
+	if slices.Contains(given, trayMenuDefault) {
+		return
+	}
+	if len(given) == 0 {
+		fmt.Println(trayMenuDefault)
+	}
+	for _, section := range config.DefaultTrayMenu {
+		if !slices.Contains(given, section) {
+			fmt.Println(section)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Set trusted network action help text
+const (
+	SetTrustedNetworkActionUsageText     = "Sets what happens when the device joins a trusted network"
+	SetTrustedNetworkActionArgsUsageText = `<action>`
+	SetTrustedNetworkActionDescription   = `Use this command to choose what happens with VPN connection on the trusted networks.
+Supported values for <action>:
+  skip - auto-connect does not connect, but the existing connection is kept (default)
+  disconnect - VPN is disconnected when the device joins a trusted network
+
+Use 'nordvpn trusted' to manage the trusted networks.
+
+Example: 'nordvpn set trusted-network-action disconnect'`
+)
+
+func (c *cmd) SetTrustedNetworkAction(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	action := ctx.Args().First()
+	resp, err := c.client.SetTrustedNetworkAction(context.Background(),
+		&pb.SetTrustedNetworkActionRequest{Action: action})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Trusted network action", action))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Trusted network action", action))
+	}
+
+	return nil
+}
+
+func (c *cmd) SetTrustedNetworkActionAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for _, action := range config.TrustedNetworkActions {
+		fmt.Println(action)
+	}
+}
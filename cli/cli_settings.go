@@ -32,21 +32,48 @@ func (c *cmd) Settings(ctx *cli.Context) error {
 		return formatError(err)
 	}
 
-	fmt.Printf("Technology: %s\n", settings.GetTechnology())
+	if settings.GetAutoTechnology() {
+		fmt.Printf("Technology: %s\n", technologyAuto)
+	} else {
+		fmt.Printf("Technology: %s\n", settings.GetTechnology())
+	}
 	if settings.Technology == config.Technology_OPENVPN {
 		fmt.Printf("Protocol: %s\n", settings.GetProtocol())
+		fmt.Printf("OpenVPN Port: %s\n", openVPNPortLabel(uint16(settings.GetOpenvpnPort())))
+		if len(settings.GetOpenvpnDirectives()) > 0 {
+			fmt.Printf("OpenVPN directives: %s\n", openVPNDirectivesLabel(settings.GetOpenvpnDirectives()))
+		}
+	}
+	if settings.Technology == config.Technology_NORDLYNX {
+		fmt.Printf("Keepalive: %s\n", persistentKeepaliveLabel(uint16(settings.GetPersistentKeepalive())))
 	}
+	fmt.Printf("Interface: %s\n", interfaceNameLabel(settings.GetInterfaceName()))
+	fmt.Printf("MTU: %s\n", mtuLabel(settings.GetMtu()))
+	fmt.Printf("Health monitor: %s\n", settings.GetHealthMonitor())
 	fmt.Printf("Firewall: %+v\n", nstrings.GetBoolLabel(settings.GetFirewall()))
 	fmt.Printf("Firewall Mark: 0x%x\n", settings.GetFwmark())
+	fmt.Printf("Routing Table: %s\n", routingTableLabel(settings.GetRoutingTable()))
+	fmt.Printf("Route Metric: %s\n", routeMetricLabel(settings.GetRouteMetric()))
 	fmt.Printf("Routing: %+v\n", nstrings.GetBoolLabel(settings.GetRouting()))
 	fmt.Printf("Analytics: %+v\n", nstrings.GetBoolLabel(settings.GetAnalytics()))
+	if settings.GetAnalytics() {
+		fmt.Printf("Analytics categories: %s\n", analyticsConsentLabel(settings.GetAnalyticsConsent()))
+	}
 	fmt.Printf("Kill Switch: %+v\n", nstrings.GetBoolLabel(settings.GetKillSwitch()))
 	fmt.Printf("Threat Protection Lite: %+v\n", nstrings.GetBoolLabel(settings.ThreatProtectionLite))
 	if settings.Technology == config.Technology_OPENVPN {
 		fmt.Printf("Obfuscate: %+v\n", nstrings.GetBoolLabel(settings.GetObfuscate()))
+		fmt.Printf("Stealth: %s\n", stealthLabel(settings.GetStealth()))
 	}
+	fmt.Printf("Obfuscation fallback: %+v\n", nstrings.GetBoolLabel(settings.GetObfuscationFallback()))
 	fmt.Printf("Notify: %+v\n", nstrings.GetBoolLabel(settings.UserSettings.Notify))
 	fmt.Printf("Tray: %+v\n", nstrings.GetBoolLabel(settings.UserSettings.Tray))
+	if settings.UserSettings.Tray {
+		fmt.Printf("Tray icon: %s\n", strings.ToLower(settings.UserSettings.TrayIconTheme.String()))
+		fmt.Printf("Tray hotkey: %+v\n", nstrings.GetBoolLabel(settings.UserSettings.TrayHotkey))
+		fmt.Printf("Tray minimal mode: %+v\n", nstrings.GetBoolLabel(settings.TrayMinimal))
+		fmt.Printf("Tray menu: %s\n", strings.Join(config.TrayMenuOrDefault(settings.TrayMenu), ", "))
+	}
 	fmt.Printf("Auto-connect: %+v\n", nstrings.GetBoolLabel(settings.AutoConnectData.Enabled))
 	if settings.AutoConnectData.Enabled && internal.IsDevEnv(string(c.environment)) {
 		fmt.Printf("Auto-connect country: %s\n", settings.AutoConnectData.Country)
@@ -56,6 +83,7 @@ func (c *cmd) Settings(ctx *cli.Context) error {
 
 	fmt.Printf("IPv6: %+v\n", nstrings.GetBoolLabel(settings.Ipv6))
 	fmt.Printf("Meshnet: %+v\n", nstrings.GetBoolLabel(settings.Meshnet))
+	fmt.Printf("Fileshare file limit: %s\n", fileshareFileLimitLabel(settings.GetFileshareFileLimit()))
 	if len(settings.Dns) == 0 {
 		fmt.Printf("DNS: %+v\n", nstrings.GetBoolLabel(false))
 	} else {
@@ -63,11 +91,26 @@ func (c *cmd) Settings(ctx *cli.Context) error {
 	}
 	fmt.Printf("LAN Discovery: %+v\n", nstrings.GetBoolLabel(settings.LanDiscovery))
 	fmt.Printf("Virtual Location: %+v\n", nstrings.GetBoolLabel(settings.VirtualLocation))
+	fmt.Printf("Max server load: %s\n", maxServerLoadLabel(settings.GetMaxServerLoad()))
+	fmt.Printf("Connect retry: %s\n", connectRetryLabel(settings.GetConnectRetry()))
+	timeouts := settings.GetConnectTimeouts()
+	if settings.Technology == config.Technology_OPENVPN {
+		fmt.Printf("Connect timeout: %s\n", connectTimeoutLabel(timeouts.GetOpenvpnConnectSeconds()))
+		fmt.Printf("Handshake timeout: %s\n", connectTimeoutLabel(timeouts.GetOpenvpnHandshakeSeconds()))
+	}
+	if settings.Technology == config.Technology_NORDLYNX {
+		fmt.Printf("Handshake timeout: %s\n", connectTimeoutLabel(timeouts.GetNordlynxHandshakeSeconds()))
+	}
+	fmt.Printf("Defer on metered network: %+v\n", nstrings.GetBoolLabel(settings.DeferOnMetered))
 	if settings.Technology == config.Technology_NORDLYNX {
 		fmt.Printf("Post-quantum VPN: %+v\n", nstrings.GetBoolLabel(settings.PostquantumVpn))
 	}
 
+	fmt.Printf("Log level: %s\n", settings.LogLevel)
+	fmt.Printf("Daemon log level: %s\n", daemonLogLevelLabel(settings.DaemonLogLevel, settings.DebugComponents))
+
 	displayAllowlist(settings.Allowlist)
+	displayTrustedNetworks(settings.TrustedNetworks)
 	return nil
 }
 
@@ -140,3 +183,22 @@ func displayAllowlist(allowlist *pb.Allowlist) {
 		}
 	}
 }
+
+func displayTrustedNetworks(trusted *pb.TrustedNetworks) {
+	if len(trusted.GetSsids())+len(trusted.GetSubnets()) == 0 {
+		return
+	}
+	fmt.Printf("Trusted network action: %s\n", trusted.GetAction())
+	if ssids := trusted.GetSsids(); len(ssids) > 0 {
+		fmt.Printf("Trusted Wi-Fi networks:\n")
+		for _, ssid := range ssids {
+			fmt.Printf("\t%s\n", ssid)
+		}
+	}
+	if subnets := trusted.GetSubnets(); len(subnets) > 0 {
+		fmt.Printf("Trusted subnets:\n")
+		for _, subnet := range subnets {
+			fmt.Printf("\t%s\n", subnet)
+		}
+	}
+}
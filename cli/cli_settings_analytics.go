@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Settings analytics events help text
+const (
+	SettingsAnalyticsEventsUsageText   = "Shows the latest analytics data and whether it was sent"
+	SettingsAnalyticsEventsDescription = `Use this command to inspect the analytics data recorded since the daemon has started.
+Data is dropped instead of sent when analytics or its category is disabled.
+
+Example: 'nordvpn set analytics usage off'`
+)
+
+func (c *cmd) SettingsAnalyticsEvents(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	resp, err := c.client.AnalyticsEvents(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	if resp.Type != internal.CodeSuccess {
+		return formatError(internal.ErrUnhandled)
+	}
+	if len(resp.GetEvents()) == 0 {
+		fmt.Println(MsgAnalyticsNoEvents)
+		return nil
+	}
+	return printAnalyticsEvents(os.Stdout, resp.GetEvents())
+}
+
+func printAnalyticsEvents(w io.Writer, events []*pb.AnalyticsEvent) error {
+	writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "TIME\tCATEGORY\tEVENT\tSTATUS\tDATA")
+	for _, event := range events {
+		status := "dropped"
+		if event.GetSent() {
+			status = "sent"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n",
+			time.UnixMilli(event.GetTimestamp()).Format(time.DateTime),
+			analyticsCategoryLabel(event.GetCategory()),
+			event.GetName(),
+			status,
+			event.GetData(),
+		)
+	}
+	return writer.Flush()
+}
+
+func analyticsCategoryLabel(category pb.AnalyticsCategory) string {
+	return strings.ToLower(strings.TrimPrefix(category.String(), "ANALYTICS_"))
+}
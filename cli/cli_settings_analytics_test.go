@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyticsConsentLabel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, "crash, usage, performance",
+		analyticsConsentLabel(&pb.AnalyticsConsent{Crash: true, Usage: true, Performance: true}))
+	assert.Equal(t, "crash", analyticsConsentLabel(&pb.AnalyticsConsent{Crash: true}))
+	assert.Equal(t, "none", analyticsConsentLabel(&pb.AnalyticsConsent{}))
+	assert.Equal(t, "none", analyticsConsentLabel(nil))
+}
+
+func TestPrintAnalyticsEvents(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	timestamp := time.Date(2024, 3, 1, 12, 30, 0, 0, time.Local).UnixMilli()
+	var out bytes.Buffer
+	err := printAnalyticsEvents(&out, []*pb.AnalyticsEvent{
+		{Timestamp: timestamp, Category: pb.AnalyticsCategory_ANALYTICS_USAGE, Name: "killswitch", Data: "true", Sent: true},
+		{Timestamp: timestamp, Category: pb.AnalyticsCategory_ANALYTICS_CRASH, Name: "connect", Data: "{}"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `TIME                 CATEGORY  EVENT       STATUS   DATA
+2024-03-01 12:30:00  usage     killswitch  sent     true
+2024-03-01 12:30:00  crash     connect     dropped  {}
+`, out.String())
+}
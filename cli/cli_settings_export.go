@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Settings export and import help text
+const (
+	SettingsExportUsageText   = "Exports the settings to a file"
+	SettingsExportArgsUsage   = "[file]"
+	SettingsExportDescription = `Use this command to save the current settings to a JSON file, which can be imported on another machine.
+Access tokens, Meshnet keys and other account data are not exported.
+The settings are printed to the standard output when the file is not provided.
+
+Example: 'nordvpn settings export nordvpn-settings.json'`
+	SettingsImportUsageText   = "Imports the settings from a file"
+	SettingsImportArgsUsage   = "<file>"
+	SettingsImportDescription = `Use this command to replace the current settings with the ones exported by 'nordvpn settings export'.
+Use '-' to read the settings from the standard input. Disconnect from VPN before importing the settings.
+
+Example: 'nordvpn settings import nordvpn-settings.json'`
+)
+
+func (c *cmd) SettingsExport(ctx *cli.Context) error {
+	if ctx.NArg() > 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	resp, err := c.client.ExportSettings(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeSuccess:
+	default:
+		return formatError(internal.ErrUnhandled)
+	}
+
+	settings := resp.Data[0]
+	path := ctx.Args().First()
+	if path == "" {
+		fmt.Println(settings)
+		return nil
+	}
+	if err := os.WriteFile(path, []byte(settings+"\n"), internal.PermUserRW); err != nil {
+		return formatError(err)
+	}
+	color.Green(MsgSettingsExported, path)
+	return nil
+}
+
+func (c *cmd) SettingsImport(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	path := ctx.Args().First()
+	var settings []byte
+	var err error
+	if path == "-" {
+		settings, err = io.ReadAll(os.Stdin)
+	} else {
+		settings, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return formatError(err)
+	}
+
+	resp, err := c.client.ImportSettings(context.Background(), &pb.ImportSettingsRequest{Settings: string(settings)})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		reason := "invalid settings"
+		if len(resp.Data) > 0 {
+			reason = resp.Data[0]
+		}
+		return formatError(fmt.Errorf(MsgSettingsImportInvalid, reason))
+	case internal.CodeOutdated:
+		return formatError(errors.New(MsgSettingsImportVersion))
+	case internal.CodeVPNRunning:
+		return formatError(errors.New(MsgSettingsImportVPNRunning))
+	case internal.CodePqAndMeshnetSimultaneously:
+		return formatError(errors.New(SetPqAndMeshnet))
+	case internal.CodeSuccess:
+		color.Green(MsgSettingsImported)
+		return nil
+	}
+	return formatError(internal.ErrUnhandled)
+}
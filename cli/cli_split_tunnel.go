@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Split tunnel help text
+const (
+	SplitTunnelUsageText = "Routes the traffic of the selected apps outside of VPN or only through VPN"
+
+	SplitTunnelAddUsageText     = "Adds the app to the split tunnel"
+	SplitTunnelAddArgsUsageText = `<app>`
+	SplitTunnelAddDescription   = `Use this command to route the traffic of the app differently from the rest of the traffic.
+In exclude mode (default) the traffic of the app bypasses VPN. In include mode only the traffic of the split tunnel
+apps goes through VPN. Use 'nordvpn split-tunnel mode' to change the mode.
+
+<app> is the name of the executable, which matches the executable in any directory, or its absolute path.
+Already running instances of the app are affected as well.
+
+Example: 'nordvpn split-tunnel add firefox'
+Example: 'nordvpn split-tunnel add /usr/bin/transmission-gtk'
+
+Notes:
+  Split tunneling requires cgroup v2`
+
+	SplitTunnelRemoveUsageText     = "Removes the app from the split tunnel"
+	SplitTunnelRemoveArgsUsageText = `<app>`
+	SplitTunnelRemoveDescription   = `Use this command to route the traffic of the app in the same way as the rest of the traffic.
+
+Example: 'nordvpn split-tunnel remove firefox'`
+
+	SplitTunnelListUsageText = "Shows the apps in the split tunnel"
+
+	SplitTunnelModeUsageText     = "Sets whether the split tunnel apps bypass VPN or only they use VPN"
+	SplitTunnelModeArgsUsageText = `<mode>`
+	SplitTunnelModeDescription   = `Use this command to choose how the split tunnel apps are routed.
+Supported values for <mode>:
+  exclude - traffic of the apps bypasses VPN (default)
+  include - only traffic of the apps goes through VPN
+
+Example: 'nordvpn split-tunnel mode include'`
+)
+
+func (c *cmd) SplitTunnelAdd(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+	return c.setSplitTunnelApp(ctx, false, SplitTunnelAddSuccess, SplitTunnelAddExistsError)
+}
+
+func (c *cmd) SplitTunnelRemove(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+	return c.setSplitTunnelApp(ctx, true, SplitTunnelRemoveSuccess, SplitTunnelRemoveError)
+}
+
+// setSplitTunnelApp sends the request and prints the message formatted with the app as accepted by the daemon
+func (c *cmd) setSplitTunnelApp(ctx *cli.Context, remove bool, successMsg string, noopMsg string) error {
+	resp, err := c.client.SetSplitTunnelApp(context.Background(),
+		&pb.SetSplitTunnelAppRequest{App: ctx.Args().First(), Remove: remove})
+	if err != nil {
+		return formatError(err)
+	}
+
+	app := ctx.Args().First()
+	if len(resp.Data) > 0 {
+		app = resp.Data[0]
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeSplitTunnelNotSupported:
+		return formatError(fmt.Errorf(SplitTunnelNotSupported))
+	case internal.CodeFailure:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeNothingToDo:
+		return formatError(fmt.Errorf(noopMsg, app))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(successMsg, app))
+	}
+	return nil
+}
+
+func (c *cmd) SplitTunnelList(ctx *cli.Context) error {
+	resp, err := c.client.SplitTunnelApps(context.Background(), &pb.Empty{})
+	if err != nil {
+		return formatError(err)
+	}
+
+	if len(resp.Apps) == 0 {
+		fmt.Println(SplitTunnelListEmpty)
+		return nil
+	}
+
+	if config.SplitTunnelMode(resp.Mode) == config.SplitTunnelInclude {
+		fmt.Println(SplitTunnelListInclude)
+	} else {
+		fmt.Println(SplitTunnelListExclude)
+	}
+	for _, app := range resp.Apps {
+		if app.Running {
+			fmt.Printf("  %s (running)\n", app.Name)
+		} else {
+			fmt.Printf("  %s\n", app.Name)
+		}
+	}
+	return nil
+}
+
+func (c *cmd) SplitTunnelMode(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+
+	mode := ctx.Args().First()
+	resp, err := c.client.SetSplitTunnelMode(context.Background(), &pb.SetSplitTunnelModeRequest{Mode: mode})
+	if err != nil {
+		return formatError(err)
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeSplitTunnelNotSupported:
+		return formatError(fmt.Errorf(SplitTunnelNotSupported))
+	case internal.CodeFailure:
+		return formatError(internal.ErrUnhandled)
+	case internal.CodeNothingToDo:
+		color.Yellow(fmt.Sprintf(MsgAlreadySet, "Split tunnel mode", mode))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(MsgSetSuccess, "Split tunnel mode", mode))
+	}
+	return nil
+}
+
+func (c *cmd) SplitTunnelModeAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	for _, mode := range config.SplitTunnelModes {
+		fmt.Println(mode)
+	}
+}
+
+func (c *cmd) SplitTunnelRemoveAutoComplete(ctx *cli.Context) {
+	if ctx.NArg() > 0 {
+		return
+	}
+	resp, err := c.client.SplitTunnelApps(context.Background(), &pb.Empty{})
+	if err != nil {
+		return
+	}
+	for _, app := range resp.Apps {
+		fmt.Println(app.Name)
+	}
+}
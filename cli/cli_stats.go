@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Stats help text
+const (
+	StatsUsageText          = "Shows the amount of data transferred through VPN"
+	StatsFlagByUsageText    = "Group the totals by 'day', 'week' or 'server'"
+	StatsFlagLimitUsageText = "Number of the latest days or weeks to show"
+	StatsDescription        = `Use this command to see how much data VPN has carried, for example, when your connection has a data cap.
+Totals of the last 92 days are kept. Weeks start on Monday.
+
+Example: 'nordvpn stats --by week --limit 4'`
+)
+
+const (
+	flagStatsBy       = "by"
+	defaultStatsLimit = 7
+)
+
+var statsGroupings = map[string]pb.BandwidthGrouping{
+	"day":    pb.BandwidthGrouping_BY_DAY,
+	"week":   pb.BandwidthGrouping_BY_WEEK,
+	"server": pb.BandwidthGrouping_BY_SERVER,
+}
+
+func (c *cmd) Stats(ctx *cli.Context) error {
+	if ctx.NArg() != 0 {
+		return formatError(argsCountError(ctx))
+	}
+
+	groupBy, ok := statsGroupings[ctx.String(flagStatsBy)]
+	if !ok {
+		return formatError(argsParseError(ctx))
+	}
+
+	resp, err := c.client.BandwidthUsage(context.Background(), &pb.BandwidthUsageRequest{
+		GroupBy: groupBy,
+		Limit:   ctx.Int64(flagLimit),
+	})
+	if err != nil {
+		return formatError(err)
+	}
+
+	if len(resp.GetEntries()) == 0 {
+		color.Yellow(MsgStatsEmpty)
+		return nil
+	}
+	return printStats(os.Stdout, groupBy, resp)
+}
+
+func printStats(w io.Writer, groupBy pb.BandwidthGrouping, resp *pb.BandwidthUsageResponse) error {
+	writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(writer, "%s\tRECEIVED\tSENT\tTOTAL\n", statsColumn(groupBy))
+	for _, entry := range resp.GetEntries() {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n",
+			statsPeriod(groupBy, entry),
+			Uint64ToHumanBytes(entry.GetDownload()),
+			Uint64ToHumanBytes(entry.GetUpload()),
+			Uint64ToHumanBytes(entry.GetDownload()+entry.GetUpload()),
+		)
+	}
+	fmt.Fprintf(writer, "TOTAL\t%s\t%s\t%s\n",
+		Uint64ToHumanBytes(resp.GetDownload()),
+		Uint64ToHumanBytes(resp.GetUpload()),
+		Uint64ToHumanBytes(resp.GetDownload()+resp.GetUpload()),
+	)
+	return writer.Flush()
+}
+
+func statsColumn(groupBy pb.BandwidthGrouping) string {
+	switch groupBy {
+	case pb.BandwidthGrouping_BY_WEEK:
+		return "WEEK"
+	case pb.BandwidthGrouping_BY_SERVER:
+		return "SERVER"
+	case pb.BandwidthGrouping_BY_DAY:
+	}
+	return "DAY"
+}
+
+func statsPeriod(groupBy pb.BandwidthGrouping, entry *pb.BandwidthUsageEntry) string {
+	switch groupBy {
+	case pb.BandwidthGrouping_BY_WEEK:
+		return "from " + time.Unix(entry.GetPeriodStart(), 0).Format(time.DateOnly)
+	case pb.BandwidthGrouping_BY_SERVER:
+		if entry.GetServer() == "" {
+			return "-"
+		}
+		return entry.GetServer()
+	case pb.BandwidthGrouping_BY_DAY:
+	}
+	return time.Unix(entry.GetPeriodStart(), 0).Format(time.DateOnly)
+}
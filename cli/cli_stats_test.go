@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintStats(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	day := time.Date(2024, 5, 6, 0, 0, 0, 0, time.Local)
+	tests := []struct {
+		name     string
+		groupBy  pb.BandwidthGrouping
+		entry    *pb.BandwidthUsageEntry
+		expected string
+	}{
+		{
+			name:    "by day",
+			groupBy: pb.BandwidthGrouping_BY_DAY,
+			entry:   &pb.BandwidthUsageEntry{PeriodStart: day.Unix(), Download: 2048, Upload: 1024},
+			expected: "DAY         RECEIVED  SENT      TOTAL\n" +
+				"2024-05-06  2.00 KiB  1.00 KiB  3.00 KiB\n" +
+				"TOTAL       2.00 KiB  1.00 KiB  3.00 KiB\n",
+		},
+		{
+			name:    "by week",
+			groupBy: pb.BandwidthGrouping_BY_WEEK,
+			entry:   &pb.BandwidthUsageEntry{PeriodStart: day.Unix(), Download: 2048, Upload: 1024},
+			expected: "WEEK             RECEIVED  SENT      TOTAL\n" +
+				"from 2024-05-06  2.00 KiB  1.00 KiB  3.00 KiB\n" +
+				"TOTAL            2.00 KiB  1.00 KiB  3.00 KiB\n",
+		},
+		{
+			name:    "by server",
+			groupBy: pb.BandwidthGrouping_BY_SERVER,
+			entry:   &pb.BandwidthUsageEntry{Server: "de1.nordvpn.com", Download: 2048, Upload: 1024},
+			expected: "SERVER           RECEIVED  SENT      TOTAL\n" +
+				"de1.nordvpn.com  2.00 KiB  1.00 KiB  3.00 KiB\n" +
+				"TOTAL            2.00 KiB  1.00 KiB  3.00 KiB\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var out bytes.Buffer
+			resp := &pb.BandwidthUsageResponse{
+				Entries:  []*pb.BandwidthUsageEntry{test.entry},
+				Download: test.entry.Download,
+				Upload:   test.entry.Upload,
+			}
+			require.NoError(t, printStats(&out, test.groupBy, resp))
+			assert.Equal(t, test.expected, out.String())
+		})
+	}
+}
@@ -2,26 +2,109 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
+	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 
 	"github.com/hako/durafmt"
 	"github.com/urfave/cli/v2"
 )
 
-// StatusUsageText is shown next to status command by nordvpn --help
-const StatusUsageText = "Shows connection status"
+const (
+	// StatusUsageText is shown next to status command by nordvpn --help
+	StatusUsageText = "Shows connection status"
+	// StatusVerboseUsageText is shown next to the verbose flag of status command by nordvpn status --help
+	StatusVerboseUsageText = "Additionally shows the health of the tunnel: handshakes and round trip time to the server"
+	// StatusServicesUsageText is shown next to the services flag of status command by nordvpn status --help
+	StatusServicesUsageText = "Additionally shows the state of the per-user helper services"
+	// StatusWatchUsageText is shown next to the watch flag of status command by nordvpn status --help
+	StatusWatchUsageText = "Keeps running and shows the status again whenever the connection state or the server changes"
+
+	flagStatusVerbose  = "verbose"
+	flagStatusServices = "services"
+	flagStatusWatch    = "watch"
+)
 
 func (c *cmd) Status(ctx *cli.Context) error {
-	resp, err := c.client.Status(context.Background(), &pb.Empty{})
+	if ctx.Bool(flagStatusWatch) {
+		return c.watchStatus()
+	}
+
+	var health *pb.TunnelHealth
+	if ctx.Bool(flagStatusVerbose) {
+		resp, err := c.client.StatusVerbose(context.Background(), &pb.Empty{})
+		if err != nil {
+			return formatError(err)
+		}
+		fmt.Print(Status(resp.GetStatus()))
+		health = resp.GetHealth()
+	} else {
+		resp, err := c.client.Status(context.Background(), &pb.Empty{})
+		if err != nil {
+			return formatError(err)
+		}
+		fmt.Print(Status(resp))
+	}
+
+	// statistics only add details to the status, so the status is still shown if they are not available
+	if statistics, err := c.client.Statistics(context.Background(), &pb.Empty{}); err == nil {
+		fmt.Print(Statistics(statistics))
+	}
+	fmt.Print(TunnelHealth(health))
+
+	if ctx.Bool(flagStatusServices) {
+		resp, err := c.client.ServicesStatus(context.Background(), &pb.Empty{})
+		if err != nil {
+			return formatError(err)
+		}
+		fmt.Print(ServicesStatus(resp))
+	}
+	return nil
+}
+
+// watchStatus prints the status every time the daemon reports a transition until the stream is closed
+func (c *cmd) watchStatus() error {
+	stream, err := c.client.StatusStream(context.Background(), &pb.Empty{})
 	if err != nil {
 		return formatError(err)
 	}
-	fmt.Print(Status(resp))
-	return nil
+
+	var previous *pb.StatusResponse
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return formatError(err)
+		}
+
+		if !statusTransition(previous, resp) {
+			continue
+		}
+		if previous != nil {
+			fmt.Println()
+		}
+		fmt.Print(Status(resp))
+		previous = resp
+	}
+}
+
+// statusTransition reports whether the connection state or the server has changed. Refreshed transfer statistics
+// are not considered a transition.
+func statusTransition(previous *pb.StatusResponse, current *pb.StatusResponse) bool {
+	return previous == nil ||
+		previous.State != current.State ||
+		previous.Hostname != current.Hostname ||
+		previous.Ip != current.Ip ||
+		previous.NorduserHealth != current.NorduserHealth ||
+		previous.MeteredDeferral != current.MeteredDeferral ||
+		previous.CaptivePortal != current.CaptivePortal
 }
 
 // Status returns ready to print status string.
@@ -53,6 +136,15 @@ func Status(resp *pb.StatusResponse) string {
 		b.WriteString(fmt.Sprintf("City: %s\n", resp.City))
 	}
 
+	// datacenter is worth mentioning only for virtual locations, other servers are hosted in their country
+	if resp.DatacenterCountry != "" && resp.DatacenterCountry != resp.Country {
+		b.WriteString(fmt.Sprintf("Datacenter: %s\n", resp.DatacenterCountry))
+	}
+
+	if features := serverFeatures(resp); len(features) > 0 {
+		b.WriteString(fmt.Sprintf("Server features: %s\n", strings.Join(features, ", ")))
+	}
+
 	if resp.Uptime != -1 {
 		b.WriteString(
 			fmt.Sprintf("Current technology: %s\n", resp.Technology.String()),
@@ -66,14 +158,143 @@ func Status(resp *pb.StatusResponse) string {
 	if resp.Download != 0 || resp.Upload != 0 {
 		b.WriteString(fmt.Sprintf(
 			"Transfer: %s received, %s sent\n",
-			uint64ToHumanBytes(resp.Download), uint64ToHumanBytes(resp.Upload)),
+			Uint64ToHumanBytes(resp.Download), Uint64ToHumanBytes(resp.Upload)),
 		)
 	}
 
+	if resp.UserspaceTunnel {
+		b.WriteString(StatusUserSpaceTunnel)
+	}
+
 	if resp.Uptime != -1 {
 		// truncate to skip milliseconds from being displayed
 		uptime := time.Duration(resp.Uptime).Truncate(1000 * time.Millisecond)
 		b.WriteString(fmt.Sprintf("Uptime: %s\n", durafmt.Parse(uptime).String()))
 	}
+
+	if resp.PausedUntil != 0 {
+		b.WriteString(fmt.Sprintf(StatusPausedUntil, time.Unix(resp.PausedUntil, 0).Format(time.Kitchen)))
+	}
+
+	b.WriteString(splitTunnelStatus(resp.GetSplitTunnel()))
+
+	if resp.MeteredDeferral {
+		b.WriteString(StatusMeteredDeferral)
+	} else if resp.Metered {
+		b.WriteString(StatusMetered)
+	}
+
+	if resp.CaptivePortal != "" {
+		b.WriteString(fmt.Sprintf(StatusCaptivePortal, resp.CaptivePortal))
+	}
+
+	switch resp.NorduserHealth {
+	case pb.NorduserHealth_NORDUSER_RESTARTING:
+		b.WriteString("User service: restarting after a crash\n")
+	case pb.NorduserHealth_NORDUSER_FAILED:
+		b.WriteString("User service: stopped after repeated crashes, run 'nordvpn repair' to start it again\n")
+	case pb.NorduserHealth_NORDUSER_UNKNOWN,
+		pb.NorduserHealth_NORDUSER_RUNNING,
+		pb.NorduserHealth_NORDUSER_STOPPED:
+	}
+	return b.String()
+}
+
+// serverFeatures returns the names of the features supported by the connected server
+func serverFeatures(resp *pb.StatusResponse) []string {
+	var features []string
+	if resp.Obfuscated {
+		features = append(features, "Obfuscated")
+	}
+	if resp.P2P {
+		features = append(features, "P2P")
+	}
+	if resp.DedicatedIp {
+		features = append(features, "Dedicated IP")
+	}
+	return features
+}
+
+// splitTunnelStatus returns ready to print list of the apps routed differently from the rest of the traffic
+func splitTunnelStatus(splitTunnel *pb.SplitTunnel) string {
+	if len(splitTunnel.GetApps()) == 0 {
+		return ""
+	}
+
+	apps := make([]string, 0, len(splitTunnel.GetApps()))
+	for _, app := range splitTunnel.GetApps() {
+		if app.Running {
+			apps = append(apps, app.Name+" (running)")
+		} else {
+			apps = append(apps, app.Name)
+		}
+	}
+	if config.SplitTunnelMode(splitTunnel.Mode) == config.SplitTunnelInclude {
+		return fmt.Sprintf(StatusSplitTunnelInclude, strings.Join(apps, ", "))
+	}
+	return fmt.Sprintf(StatusSplitTunnelExclude, strings.Join(apps, ", "))
+}
+
+// Statistics returns ready to print connection statistics which are not a part of the status.
+func Statistics(resp *pb.StatisticsResponse) string {
+	if !resp.Connected || resp.ServerLoad < 0 {
+		return ""
+	}
+	return fmt.Sprintf("Server load: %d%%\n", resp.ServerLoad)
+}
+
+// TunnelHealth returns ready to print tunnel health string.
+func TunnelHealth(health *pb.TunnelHealth) string {
+	if health == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	if health.HandshakeAvailable {
+		age := time.Duration(health.LastHandshakeAge).Truncate(time.Second)
+		b.WriteString(fmt.Sprintf("Last handshake: %s ago\n", durafmt.Parse(age).String()))
+		b.WriteString(fmt.Sprintf("Rekeys: %d\n", health.Rekeys))
+	}
+
+	if health.PingsSent > 0 {
+		loss := float64(health.PingsSent-health.PingsReceived) / float64(health.PingsSent) * 100
+		b.WriteString(fmt.Sprintf("Ping: %d sent, %d received, %.0f%% packet loss\n",
+			health.PingsSent, health.PingsReceived, loss))
+	}
+
+	if health.Rtt >= 0 {
+		rtt := time.Duration(health.Rtt).Round(time.Millisecond / 10)
+		b.WriteString(fmt.Sprintf("Round trip time: %s\n", rtt))
+	} else {
+		b.WriteString("Round trip time: server is not reachable\n")
+	}
+	return b.String()
+}
+
+// ServicesStatus returns ready to print norduserd health check results.
+func ServicesStatus(resp *pb.ServicesStatusResponse) string {
+	if len(resp.GetNorduser()) == 0 {
+		return "User service: status is not available\n"
+	}
+
+	var b strings.Builder
+	for _, status := range resp.GetNorduser() {
+		b.WriteString(fmt.Sprintf("User service (uid %d): ", status.Uid))
+		switch {
+		case status.Outdated:
+			b.WriteString("outdated, restarting it after the upgrade\n")
+		case status.SocketReachable:
+			uptime := time.Duration(status.Uptime).Truncate(time.Second)
+			b.WriteString(fmt.Sprintf("running, version %s, uptime %s\n", status.Version, durafmt.Parse(uptime).String()))
+		case status.Running:
+			b.WriteString(fmt.Sprintf("running but not responding: %s\n", status.Error))
+		case status.Health == pb.NorduserHealth_NORDUSER_RESTARTING:
+			b.WriteString("restarting after a crash\n")
+		case status.Health == pb.NorduserHealth_NORDUSER_FAILED:
+			b.WriteString("stopped after repeated crashes, run 'nordvpn repair' to start it again\n")
+		default:
+			b.WriteString("not running\n")
+		}
+	}
 	return b.String()
 }
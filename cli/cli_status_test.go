@@ -2,6 +2,7 @@ package cli
 
 import (
 	"testing"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
@@ -41,6 +42,50 @@ Current technology: NORDLYNX
 Current protocol: UDP
 Transfer: 69 B received, 69 B sent
 Uptime: 13 seconds
+`,
+		},
+		{
+			name: "connected to virtual location",
+			resp: &pb.StatusResponse{
+				State:             "Connected",
+				Technology:        config.Technology_NORDLYNX,
+				Protocol:          config.Protocol_UDP,
+				Name:              "Pakistan #3",
+				VirtualLocation:   true,
+				Hostname:          "pk3.nordvpn.com",
+				Country:           "Pakistan",
+				DatacenterCountry: "Singapore",
+				P2P:               true,
+				Obfuscated:        true,
+				Uptime:            13e9,
+			},
+			expected: `Status: Connected
+Server: Pakistan #3 - Virtual
+Hostname: pk3.nordvpn.com
+Country: Pakistan
+Datacenter: Singapore
+Server features: Obfuscated, P2P
+Current technology: NORDLYNX
+Current protocol: UDP
+Uptime: 13 seconds
+`,
+		},
+		{
+			name: "userspace tunnel",
+			resp: &pb.StatusResponse{
+				State:           "Connected",
+				Technology:      config.Technology_NORDLYNX,
+				Protocol:        config.Protocol_UDP,
+				Hostname:        "de3.nordvpn.com",
+				Uptime:          13e9,
+				UserspaceTunnel: true,
+			},
+			expected: `Status: Connected
+Hostname: de3.nordvpn.com
+Current technology: NORDLYNX
+Current protocol: UDP
+Note: WireGuard kernel module is not available, NordLynx runs in userspace and may be slower
+Uptime: 13 seconds
 `,
 		},
 		{
@@ -50,6 +95,40 @@ Uptime: 13 seconds
 				Uptime: -1,
 			},
 			expected: `Status: Disconnected
+`,
+		},
+		{
+			name: "metered network",
+			resp: &pb.StatusResponse{
+				State:   "Disconnected",
+				Uptime:  -1,
+				Metered: true,
+			},
+			expected: `Status: Disconnected
+Metered network: yes
+`,
+		},
+		{
+			name: "postponed on metered network",
+			resp: &pb.StatusResponse{
+				State:           "Disconnected",
+				Uptime:          -1,
+				Metered:         true,
+				MeteredDeferral: true,
+			},
+			expected: `Status: Disconnected
+Metered network: yes, auto-connect, server list updates and queued file sends are postponed
+`,
+		},
+		{
+			name: "captive portal",
+			resp: &pb.StatusResponse{
+				State:         "Connecting",
+				Uptime:        -1,
+				CaptivePortal: "http://10.0.0.1/login",
+			},
+			expected: `Status: Connecting
+Captive portal: log in at http://10.0.0.1/login
 `,
 		},
 	}
@@ -60,3 +139,146 @@ Uptime: 13 seconds
 		})
 	}
 }
+
+func TestTunnelHealth(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		health   *pb.TunnelHealth
+		expected string
+	}{
+		{
+			name: "nordlynx",
+			health: &pb.TunnelHealth{
+				HandshakeAvailable: true,
+				LastHandshakeAge:   75e9,
+				Rekeys:             3,
+				Rtt:                25e6,
+				PingsSent:          3,
+				PingsReceived:      3,
+			},
+			expected: `Last handshake: 1 minute 15 seconds ago
+Rekeys: 3
+Ping: 3 sent, 3 received, 0% packet loss
+Round trip time: 25ms
+`,
+		},
+		{
+			name: "openvpn",
+			health: &pb.TunnelHealth{
+				Rtt:           31e6,
+				PingsSent:     3,
+				PingsReceived: 2,
+			},
+			expected: `Ping: 3 sent, 2 received, 33% packet loss
+Round trip time: 31ms
+`,
+		},
+		{
+			name: "unreachable",
+			health: &pb.TunnelHealth{
+				Rtt:       -1,
+				PingsSent: 3,
+			},
+			expected: `Ping: 3 sent, 0 received, 100% packet loss
+Round trip time: server is not reachable
+`,
+		},
+		{
+			name:     "disconnected",
+			health:   nil,
+			expected: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, TunnelHealth(test.health))
+		})
+	}
+}
+
+func TestServicesStatus(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		resp     *pb.ServicesStatusResponse
+		expected string
+	}{
+		{
+			name:     "no status",
+			resp:     &pb.ServicesStatusResponse{},
+			expected: "User service: status is not available\n",
+		},
+		{
+			name: "running",
+			resp: &pb.ServicesStatusResponse{Norduser: []*pb.NorduserStatus{{
+				Uid:             1000,
+				Running:         true,
+				SocketReachable: true,
+				Version:         "3.18.0",
+				Uptime:          int64(2 * time.Hour),
+			}}},
+			expected: "User service (uid 1000): running, version 3.18.0, uptime 2 hours\n",
+		},
+		{
+			name: "not responding",
+			resp: &pb.ServicesStatusResponse{Norduser: []*pb.NorduserStatus{{
+				Uid:     1000,
+				Running: true,
+				Error:   "connection refused",
+			}}},
+			expected: "User service (uid 1000): running but not responding: connection refused\n",
+		},
+		{
+			name: "outdated",
+			resp: &pb.ServicesStatusResponse{Norduser: []*pb.NorduserStatus{{
+				Uid:             1000,
+				Running:         true,
+				SocketReachable: true,
+				Outdated:        true,
+			}}},
+			expected: "User service (uid 1000): outdated, restarting it after the upgrade\n",
+		},
+		{
+			name: "multiple users",
+			resp: &pb.ServicesStatusResponse{Norduser: []*pb.NorduserStatus{
+				{Uid: 1000, Health: pb.NorduserHealth_NORDUSER_RESTARTING},
+				{Uid: 1001},
+			}},
+			expected: `User service (uid 1000): restarting after a crash
+User service (uid 1001): not running
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ServicesStatus(test.resp))
+		})
+	}
+}
+
+func TestStatusTransition(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	connected := &pb.StatusResponse{State: "Connected", Hostname: "de1.nordvpn.com", Ip: "1.2.3.4", Download: 1}
+
+	assert.True(t, statusTransition(nil, connected))
+	assert.False(t, statusTransition(connected,
+		&pb.StatusResponse{State: "Connected", Hostname: "de1.nordvpn.com", Ip: "1.2.3.4", Download: 100}))
+	assert.True(t, statusTransition(connected,
+		&pb.StatusResponse{State: "Reconnecting", Hostname: "de1.nordvpn.com", Ip: "1.2.3.4"}))
+	assert.True(t, statusTransition(connected,
+		&pb.StatusResponse{State: "Connected", Hostname: "de2.nordvpn.com", Ip: "5.6.7.8"}))
+}
+
+func TestStatistics(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, "Server load: 23%\n", Statistics(&pb.StatisticsResponse{Connected: true, ServerLoad: 23}))
+	assert.Empty(t, Statistics(&pb.StatisticsResponse{Connected: true, ServerLoad: -1}))
+	assert.Empty(t, Statistics(&pb.StatisticsResponse{Uptime: -1, ServerLoad: -1}))
+}
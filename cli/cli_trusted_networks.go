@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Trusted networks help text
+const (
+	TrustedNetworksUsageText = "Adds or removes the networks where VPN is not needed"
+
+	TrustedAddSSIDUsageText     = "Adds Wi-Fi network to the trusted networks"
+	TrustedAddSSIDArgsUsageText = `<name>`
+	TrustedAddSSIDDescription   = `Use this command to trust Wi-Fi network.
+Auto-connect does not connect to VPN while the device uses only trusted networks. What happens to the existing
+connection on the trusted network can be set with 'nordvpn set trusted-network-action'.
+
+Example: 'nordvpn trusted add ssid "Home Wi-Fi"'`
+
+	TrustedAddSubnetUsageText     = "Adds wired subnet to the trusted networks"
+	TrustedAddSubnetArgsUsageText = `<address>`
+	TrustedAddSubnetDescription   = `Use this command to trust wired network.
+The network is trusted if the address of the device belongs to the subnet.
+
+Example: 'nordvpn trusted add subnet 192.168.1.0/24'
+
+Notes:
+  Address should be in CIDR notation`
+
+	TrustedRemoveSSIDUsageText     = "Removes Wi-Fi network from the trusted networks"
+	TrustedRemoveSSIDArgsUsageText = `<name>`
+	TrustedRemoveSSIDDescription   = `Use this command to stop trusting Wi-Fi network.
+
+Example: 'nordvpn trusted remove ssid "Home Wi-Fi"'`
+
+	TrustedRemoveSubnetUsageText     = "Removes wired subnet from the trusted networks"
+	TrustedRemoveSubnetArgsUsageText = `<address>`
+	TrustedRemoveSubnetDescription   = `Use this command to stop trusting wired network.
+
+Example: 'nordvpn trusted remove subnet 192.168.1.0/24'`
+)
+
+func (c *cmd) TrustedAddSSID(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+	ssid := ctx.Args().First()
+	return c.setTrustedNetwork(ctx,
+		&pb.SetTrustedNetworkRequest{Network: &pb.SetTrustedNetworkRequest_Ssid{Ssid: ssid}},
+		TrustedNetworkAddSSIDSuccess,
+		TrustedNetworkAddSSIDExistsError,
+	)
+}
+
+func (c *cmd) TrustedAddSubnet(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+	subnet := ctx.Args().First()
+	return c.setTrustedNetwork(ctx,
+		&pb.SetTrustedNetworkRequest{Network: &pb.SetTrustedNetworkRequest_Subnet{Subnet: subnet}},
+		TrustedNetworkAddSubnetSuccess,
+		TrustedNetworkAddSubnetExistsError,
+	)
+}
+
+func (c *cmd) TrustedRemoveSSID(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+	ssid := ctx.Args().First()
+	return c.setTrustedNetwork(ctx,
+		&pb.SetTrustedNetworkRequest{Network: &pb.SetTrustedNetworkRequest_Ssid{Ssid: ssid}, Remove: true},
+		TrustedNetworkRemoveSSIDSuccess,
+		TrustedNetworkRemoveSSIDError,
+	)
+}
+
+func (c *cmd) TrustedRemoveSubnet(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return formatError(argsCountError(ctx))
+	}
+	subnet := ctx.Args().First()
+	return c.setTrustedNetwork(ctx,
+		&pb.SetTrustedNetworkRequest{Network: &pb.SetTrustedNetworkRequest_Subnet{Subnet: subnet}, Remove: true},
+		TrustedNetworkRemoveSubnetSuccess,
+		TrustedNetworkRemoveSubnetError,
+	)
+}
+
+// setTrustedNetwork sends the request and prints the message formatted with the network as accepted by the daemon
+func (c *cmd) setTrustedNetwork(
+	ctx *cli.Context,
+	req *pb.SetTrustedNetworkRequest,
+	successMsg string,
+	noopMsg string,
+) error {
+	resp, err := c.client.SetTrustedNetwork(context.Background(), req)
+	if err != nil {
+		return formatError(err)
+	}
+
+	network := ctx.Args().First()
+	if len(resp.Data) > 0 {
+		network = resp.Data[0]
+	}
+
+	switch resp.Type {
+	case internal.CodeConfigError:
+		return formatError(ErrConfig)
+	case internal.CodeFormatError:
+		return formatError(argsParseError(ctx))
+	case internal.CodeNothingToDo:
+		return formatError(fmt.Errorf(noopMsg, network))
+	case internal.CodeSuccess:
+		color.Green(fmt.Sprintf(successMsg, network))
+	}
+	return nil
+}
@@ -13,6 +13,18 @@ const (
 
 const (
 	flagGroup         = "group"
+	flagDNS           = "dns"
+	flagFastest       = "fastest"
+	flagFavorite      = "favorite"
+	flagVia           = "via"
+	flagDryRun        = "dry-run"
+	flagRandom        = "random"
+	flagWithin        = "within"
+	flagDedicatedIP   = "dedicated-ip"
+	flagDays          = "days"
+	flagLimit         = "limit"
+	flagTimeout       = "timeout"
+	flagOnFailure     = "on-failure"
 	flagToken         = "token"
 	flagLoginCallback = "callback"
 	stringProtocol    = "protocol"
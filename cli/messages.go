@@ -25,7 +25,39 @@ We couldn’t process your payment. Please try again.`
 
 	SetReconnect = "You are connected to NordVPN. Please reconnect to enable the setting."
 
+	SetRestartDaemon = "Restart daemon (e.g. `sudo systemctl restart nordvpnd` on systemd distros) for this setting to take an effect."
+
 	MsgNothingToRate = "There was no connection - nothing to rate."
+
+	MsgConnectDryRun        = "Dry run: nothing was changed. Run the command without --dry-run to connect."
+	MsgConnectAttempt       = "Connection attempt %s of %s."
+	MsgConnectQueuedOffline = "You're offline. NordVPN will connect once the network is back (ID: %s). Use 'nordvpn pending' to see or cancel queued actions."
+	MsgPendingNoActions     = "There are no queued actions."
+	MsgPendingCanceled      = "Queued action %s was canceled."
+	MsgPendingNotFound      = "There is no queued action with this ID."
+	MsgHistoryEmpty         = "There are no connection attempts in the history."
+	MsgStatsEmpty           = "No data has been transferred through VPN yet."
+	MsgDedicatedIPNoServer  = "no server selected"
+	MsgAnalyticsNoEvents    = "No analytics data was recorded since the daemon has started."
+
+	MsgRepairNoIssues        = "No connectivity issues were found."
+	MsgRepairConfirm         = "%s. Repair it?"
+	MsgRepairNothingRepaired = "Nothing was repaired."
+	MsgRepairRepaired        = "Repaired: %s."
+	MsgRepairFailed          = "Failed to repair: %s (%s)."
+	MsgRepairIncomplete      = "Some issues could not be repaired. If the problem persists, restart the NordVPN service or contact our customer support."
+
+	MsgPreflightNoFindings = "All kernel features and capabilities required for the VPN connection are available."
+	MsgPreflightFinding    = "%s. %s"
+
+	MsgGatewayListening = "Gateway is listening on http://%s. Clients must send the token stored in %s. Press Ctrl+C to stop."
+	MsgGatewayToken     = "Failed to load the gateway token from %s: %w"
+
+	MsgSettingsExported         = "Settings are exported to %s."
+	MsgSettingsImported         = "Settings are imported successfully."
+	MsgSettingsImportInvalid    = "The settings file is not valid: %s."
+	MsgSettingsImportVersion    = "The settings were exported by a newer version of the app. Update the app to import them."
+	MsgSettingsImportVPNRunning = "You are connected to NordVPN. Please disconnect before importing the settings."
 	// MsgSetSuccess is a generic success message template.
 	MsgSetSuccess = "%s is set to '%s' successfully."
 	// MsgAlreadySet is a generic noop message template.
@@ -65,14 +97,30 @@ Example: nordvpn set %s on`
 	SetProtocolUnavailable = "Protocol setting is not available when the set technology is not OpenVPN"
 	SetProtocolAlreadySet  = "Protocol is already set to %s"
 
-	SetTechnologyDepsError = "Missing %s kernel module or configuration utility."
+	SetOpenVPNPortUnavailable = "OpenVPN port setting is not available when the set technology is not OpenVPN"
+
+	SetOpenVPNDirectivesInvalid = "OpenVPN directives were not set: %s."
+
+	SetPersistentKeepaliveUnavailable = "Keepalive setting is not available when the set technology is not NordLynx"
+
+	SetStealthUnavailable = "Stealth mode is not available when the set technology is not OpenVPN"
+
+	SetInterfaceNameExists = "Interface '%s' already exists. Please choose another name."
+
+	SetDaemonLogLevelUntilRestart = "The log level will be reset when the daemon is restarted. Use the --persist option to keep it."
+
+	SetTechnologyDepsError   = "Missing %s kernel module or configuration utility."
+	SetTechnologyUnavailable = "%s technology is not available in this version of the app."
 
 	SetDNSDisableThreatProtectionLite = "Disabling Threat Protection Lite."
 	SetDNSInvalidAddress              = "The provided IP address is invalid."
+	SetDNSInvalidHostname             = "The provided DNS server hostname is invalid."
+	ConnectDNSInvalid                 = "The provided DNS servers are invalid. Up to 3 IP addresses or tls:// and https:// URLs can be provided."
 	SetDNSTooManyValues               = "More than 3 DNS addresses provided."
 	SetDNSAlreadySet                  = "DNS is already set to %s."
 
 	SetLANDiscoveryUsage          = "Access printers, TVs, and other devices on your local network while connected to a VPN."
+	SetLANDiscoveryDescription    = SetLANDiscoveryUsage + " Private networks 10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16 and link-local 169.254.0.0/16 are reachable without adding them to the allowlist."
 	SetLANDiscoveryAlreadyEnabled = "LAN discovery is already set to %s."
 	SetLANDiscoveryAllowlistReset = "Just a little heads-up: Enabling local network discovery will remove your private subnets from the allowlist."
 
@@ -95,6 +143,15 @@ Example: nordvpn set %s on`
 	AllowlistRemoveSubnetExistsError = "Subnet %s is not allowlisted."
 	AllowlistRemoveSubnetSuccess     = "Subnet %s is removed from the allowlist successfully."
 
+	TrustedNetworkAddSSIDExistsError   = "Wi-Fi network %s is already trusted."
+	TrustedNetworkAddSSIDSuccess       = "Wi-Fi network %s is trusted successfully."
+	TrustedNetworkAddSubnetExistsError = "Subnet %s is already trusted."
+	TrustedNetworkAddSubnetSuccess     = "Subnet %s is trusted successfully."
+	TrustedNetworkRemoveSSIDError      = "Wi-Fi network %s is not trusted."
+	TrustedNetworkRemoveSSIDSuccess    = "Wi-Fi network %s is removed from the trusted networks successfully."
+	TrustedNetworkRemoveSubnetError    = "Subnet %s is not trusted."
+	TrustedNetworkRemoveSubnetSuccess  = "Subnet %s is removed from the trusted networks successfully."
+
 	AllowlistRemoveAllError   = "Allowlist elements could not be removed."
 	AllowlistRemoveAllSuccess = "All ports and subnets have been removed from the allowlist successfully."
 
@@ -114,6 +171,73 @@ Example: nordvpn set %s on`
 	DisconnectNotConnected        = "You are not connected to NordVPN."
 	DisconnectConnectionRating    = "How would you rate your connection quality on a scale from 1 (poor) to 5 (excellent)? Type '%s rate [1-5]'."
 
+	PauseSuccess       = "You are disconnected from NordVPN. You will be connected again at %s."
+	PauseDurationError = "Pause duration must be between 1 minute and 24 hours."
+	StatusPausedUntil  = "Paused until: %s\n"
+
+	StatusMetered         = "Metered network: yes\n"
+	StatusMeteredDeferral = "Metered network: yes, auto-connect, server list updates and queued file sends are postponed\n"
+	StatusCaptivePortal   = "Captive portal: log in at %s\n"
+	StatusUserSpaceTunnel = "Note: WireGuard kernel module is not available, NordLynx runs in userspace and may be slower\n"
+
+	SplitTunnelAddSuccess     = "%s is added to the split tunnel successfully."
+	SplitTunnelAddExistsError = "%s is already in the split tunnel."
+	SplitTunnelRemoveSuccess  = "%s is removed from the split tunnel successfully."
+	SplitTunnelRemoveError    = "%s is not in the split tunnel."
+	SplitTunnelNotSupported   = "Split tunneling is not supported on this system, it requires cgroup v2."
+	SplitTunnelListEmpty      = "There are no apps in the split tunnel."
+	SplitTunnelListExclude    = "Apps bypassing VPN:"
+	SplitTunnelListInclude    = "Only these apps use VPN:"
+	StatusSplitTunnelExclude  = "Apps bypassing VPN: %s\n"
+	StatusSplitTunnelInclude  = "Only these apps use VPN: %s\n"
+
+	FavoriteAddSuccess    = "Favorite %s is saved successfully."
+	FavoriteExistsError   = "Favorite %s already exists. Remove it first to save it again."
+	FavoriteInvalidName   = "Favorite name %s is invalid. It can contain lowercase letters, digits, '-' and '_'."
+	FavoriteRemoveSuccess = "Favorite %s is removed successfully."
+	FavoriteNotFoundError = "There is no favorite named %s."
+	FavoriteListEmpty     = "There are no favorites."
+	ConnectFavoriteArgs   = "Connecting to a favorite cannot be combined with a location or a group."
+
+	ScheduleAddSuccess         = "Schedule rule %s is added successfully."
+	ScheduleExistsError        = "Schedule rule %s already exists. Remove it first to add it again."
+	ScheduleInvalidName        = "Schedule rule name %s is invalid. It can contain lowercase letters, digits, '-' and '_'."
+	ScheduleInvalidRule        = "Schedule rule is invalid: %s."
+	ScheduleConflictError      = "The rule conflicts with schedule rule %s applied at the same time."
+	ScheduleDisconnectLocation = "Only the connect rule can be given a location or a group."
+	ScheduleRemoveSuccess      = "Schedule rule %s is removed successfully."
+	ScheduleNotFoundError      = "There is no schedule rule named %s."
+	ScheduleListEmpty          = "There are no schedule rules."
+
+	ConnectViaDoubleVPNRequired = "The entry country can be chosen only for the Double VPN group. For example: 'nordvpn connect --group double_vpn --via Canada'"
+	ConnectViaFastest           = "The entry country of Double VPN cannot be combined with the --fastest flag."
+	ConnectRandomFastest        = "The --random flag cannot be combined with the --fastest or --via flags."
+	ConnectWithinRandom         = "The --within flag can be used only together with the --random flag."
+	ConnectWithinArgs           = "The --within flag cannot be combined with a location or a favorite."
+	ConnectDedicatedIPArgs      = "The --dedicated-ip flag cannot be combined with a location, a group or other server selection flags."
+	ConnectTagSuggestions       = "The specified server does not exist. Did you mean: %s?"
+
+	ProfileCreateSuccess   = "Profile %s is created successfully."
+	ProfileExistsError     = "Profile %s already exists. Delete it first to create it again."
+	ProfileInvalidName     = "Profile name %s is invalid. It can contain lowercase letters, digits, '-' and '_'."
+	ProfileSwitchSuccess   = "Switched to profile %s successfully."
+	ProfileSwitchReconnect = "Switched to profile %s successfully. Reconnect to start using the new protocol."
+	ProfileDeleteSuccess   = "Profile %s is deleted successfully."
+	ProfileNotFoundError   = "There is no profile named %s."
+	ProfileListEmpty       = "There are no profiles."
+
+	HookAddSuccess        = "Hook %s is added to the %s event successfully."
+	HookExistsError       = "Hook %s is already added to the %s event."
+	HookInvalidArgs       = "Hook event, timeout or failure policy is invalid. Aborting on failure is supported only by pre-connect hooks."
+	HookInvalidExecutable = "Hook %s cannot be used: %s."
+	HookRemoveSuccess     = "Hook %s is removed from the %s event successfully."
+	HookNotFoundError     = "Hook %s is not added to the %s event."
+	HookListEmpty         = "There are no hooks."
+	ConnectHookFailed     = "Connection was aborted because the pre-connect hook has failed: %s"
+
+	BenchmarksDedicatedIP = "Your dedicated IP server is used when connecting, there are no servers to compare."
+	BenchmarksNoResponse  = "no response"
+
 	CitiesNotFoundError = "Servers by city are not available for this country."
 
 	CheckYourInternetConnMessage           = "Please check your internet connection and try again."
@@ -171,6 +295,33 @@ Example: nordvpn set meshnet on`
 	MsgMeshnetAllowLocalNetworkUsage          = "Allow the peer to access local network when routing traffic through this device."
 	MsgMeshnetAllowFileshare                  = "Allow the peer to send you files."
 
+	MsgMeshnetInviteCodeUsage       = "Creates a short-lived code for another device to join your Meshnet without an email invitation."
+	MsgMeshnetInviteCodeDescription = MsgMeshnetInviteCodeUsage + "\n" + "The code can be typed in on the other device or scanned as a QR code, for example after rendering the link with 'qrencode -t ansiutf8 <link>'."
+	MsgMeshnetInviteCodeCreated     = "Invite code: %s\nQR code link: %s\nThe code expires at %s."
+	MsgMeshnetInviteRedeemUsage     = "Joins the Meshnet of another device using its invite code."
+	MsgMeshnetInviteRedeemArgsUsage = "<code>|<qr_code_link>"
+	MsgMeshnetInviteRedeemSuccess   = "You have joined the Meshnet using the invite code."
+	MsgMeshnetInviteCodeInvalid     = "The invite code is invalid or has expired."
+
+	MsgMeshnetInviteAutoAcceptUsage       = "Enables or disables accepting the invitations from the devices of your own account automatically."
+	MsgMeshnetInviteAutoAcceptDescription = MsgMeshnetInviteAutoAcceptUsage + "\n" + "The permissions are granted to the inviting devices. Invitations from other accounts still have to be accepted with 'nordvpn meshnet invite accept'.\n\nExample: 'nordvpn meshnet invite auto-accept on --allow-incoming-traffic'"
+	MsgMeshnetInviteAutoAcceptEnabled     = "Invitations from the devices of your account will be accepted automatically."
+	MsgMeshnetInviteAutoAcceptDisabled    = "Invitations from the devices of your account will no longer be accepted automatically."
+	MsgMeshnetInviteAutoAcceptNote        = "Invitations from the devices of your account are accepted automatically."
+
+	MsgMeshnetInviteResendUsage      = "Sends a sent invitation again with the same permissions and a renewed expiry."
+	MsgMeshnetInviteResendSuccess    = "Meshnet invitation to '%s' was sent again."
+	MsgMeshnetInviteNoSentInvitation = "no invitation to '%s' was found"
+	MsgMeshnetInviteExpires          = "expires at %s"
+	MsgMeshnetInviteTTLUsage         = "Sets how long the sent invitations stay valid."
+	MsgMeshnetInviteTTLArgsUsage     = "<time_span>|default"
+	MsgMeshnetInviteTTLDescription   = MsgMeshnetInviteTTLUsage + "\n" + "The time span must be between 1 hour and 30 days. The invitations which are already sent keep their expiry until they are sent again with 'nordvpn meshnet invite resend'.\n\nExample: 'nordvpn meshnet invite ttl 7d'\nExample: 'nordvpn meshnet invite ttl default'"
+	MsgMeshnetInviteTTLSuccess       = "Sent invitations will stay valid for %s."
+	MsgMeshnetInviteTTLAlreadySet    = "Sent invitations already stay valid for %s."
+	MsgMeshnetInviteTTLInvalid       = "Invitations can stay valid from 1 hour to 30 days."
+	MsgMeshnetInviteTTLNote          = "Sent invitations stay valid for %s."
+	MsgMeshnetInviteTTLDefault       = "the default time"
+
 	// Meshnet set commands group
 	MsgMeshnetSetUsage = "Set a Meshnet configuration option."
 
@@ -186,6 +337,8 @@ Example: nordvpn set meshnet on`
 
 	// Peers
 	MsgMeshnetPeerListFilters = "Filters list of available peers in a Meshnet. To apply multiple filters, separate them with a comma. Please note that you will see an empty list if you apply contradictory filters."
+	MsgMeshnetPeerListVerbose = "Additionally shows how the connected peers are reached, directly or through a relay, and the round trip times to them"
+	MsgMeshnetPeerNoResponse  = "no response"
 	MsgMeshnetPeerUsage       = "Manage Meshnet peers."
 	MsgMeshnetPeerDescription = `Manage your Meshnet devices.
 Learn more:
@@ -261,6 +414,75 @@ Learn more:
 	MsgMeshnetNicknameAlreadyEmpty        = "The nickname is already removed for this device."
 	MsgMeshnetPeerResetNicknameSuccessful = "The nickname for the peer '%s' has been removed. The default hostname is '%s'."
 
+	MsgMeshnetPeerRouteUsage           = "Routes subnets through a peer device while the rest of the traffic goes through the VPN."
+	MsgMeshnetPeerRouteSetUsage        = "Routes the subnets, e.g. the local network of the peer device, through the specified peer device."
+	MsgMeshnetPeerRouteSetArgsUsage    = "<peer_hostname>|<peer_nickname>|<peer_ip>|<peer_pubkey> <subnet>..."
+	MsgMeshnetPeerRouteClearUsage      = "Stops routing subnets through a peer device."
+	MsgMeshnetPeerRouteSetSuccess      = "Subnets %s are now routed through the peer '%s'."
+	MsgMeshnetPeerRouteAlreadySet      = "Subnets %s are already routed through the peer '%s'."
+	MsgMeshnetPeerRouteClearSuccess    = "Subnets are no longer routed through a peer."
+	MsgMeshnetPeerRouteAlreadyCleared  = "No subnets are routed through a peer."
+	MsgMeshnetPeerRouteAcceptUsage     = "Routes the subnets advertised by the specified peer device through it, all of them if none are specified."
+	MsgMeshnetPeerRouteAcceptArgsUsage = "<peer_hostname>|<peer_nickname>|<peer_ip>|<peer_pubkey> [<subnet>...]"
+	MsgMeshnetPeerRouteNotAdvertised   = "Peer '%s' does not advertise the subnet %s."
+	MsgMeshnetPeerRouteNoneAdvertised  = "Peer '%s' does not advertise any subnets."
+
+	MsgMeshnetAdvertiseUsage          = "Advertises subnets reachable through this device, e.g. its local network, to the peer devices."
+	MsgMeshnetAdvertiseSetUsage       = "Advertises the subnets to the peer devices, replacing the previously advertised ones."
+	MsgMeshnetAdvertiseSetArgsUsage   = "<subnet>..."
+	MsgMeshnetAdvertiseClearUsage     = "Stops advertising subnets to the peer devices."
+	MsgMeshnetAdvertiseSetSuccess     = "Subnets %s are now advertised to the peer devices."
+	MsgMeshnetAdvertiseClearSuccess   = "Subnets are no longer advertised to the peer devices."
+	MsgMeshnetAdvertiseAlreadySet     = "Subnets %s are already advertised."
+	MsgMeshnetAdvertiseAlreadyCleared = "No subnets are advertised."
+	MsgMeshnetAdvertiseInvalidSubnet  = "Subnets must be valid IPv4 CIDR blocks outside of the Meshnet address range."
+	MsgMeshnetAdvertiseSubnetsOverlap = "Advertised subnets must not overlap each other."
+	MsgMeshnetAdvertiseTooManySubnets = "At most %d subnets can be advertised."
+
+	MsgMeshnetPeerNoteUsage         = "Sets/removes a note describing a peer device."
+	MsgMeshnetPeerSetNoteUsage      = "Sets a note for the specified peer device, e.g. 'this is the office NAS'."
+	MsgMeshnetPeerSetNoteArgsUsage  = "<peer_hostname>|<peer_nickname>|<peer_ip>|<peer_pubkey> <note>"
+	MsgMeshnetPeerRemoveNoteUsage   = "Removes the note of the specified peer device."
+	MsgMeshnetPeerTagUsage          = "Sets/removes the tags of a peer device."
+	MsgMeshnetPeerSetTagsUsage      = "Replaces the tags of the specified peer device."
+	MsgMeshnetPeerSetTagsArgsUsage  = "<peer_hostname>|<peer_nickname>|<peer_ip>|<peer_pubkey> <tag>..."
+	MsgMeshnetPeerRemoveTagsUsage   = "Removes all the tags of the specified peer device."
+	MsgMeshnetPeerNoteArgsUsage     = "<peer_hostname>|<peer_nickname>|<peer_ip>|<peer_pubkey>"
+	MsgMeshnetPeerSetNoteSuccess    = "The note for the peer '%s' has been set."
+	MsgMeshnetPeerRemoveNoteSuccess = "The note for the peer '%s' has been removed."
+	MsgMeshnetPeerSetTagsSuccess    = "The tags for the peer '%s' are now set to %s."
+	MsgMeshnetPeerRemoveTagsSuccess = "The tags for the peer '%s' have been removed."
+	MsgMeshnetPeerNoteTooLong       = "The note must be at most %d characters long."
+	MsgMeshnetPeerTagInvalid        = "Tags must be 1 to %d lowercase letters, digits, '-' or '_'."
+	MsgMeshnetPeerTagsTooMany       = "At most %d tags can be set for a peer."
+
+	MsgMeshnetPeerBandwidthUsage          = "Sets/removes the bandwidth limit of a peer device routing traffic through this device."
+	MsgMeshnetPeerSetBandwidthUsage       = "Limits the bandwidth the specified peer device can use through this device."
+	MsgMeshnetPeerSetBandwidthArgsUsage   = "<peer_hostname>|<peer_nickname>|<peer_ip>|<peer_pubkey> <limit>[kbit|mbit|gbit]"
+	MsgMeshnetPeerRemoveBandwidthUsage    = "Removes the bandwidth limit of the specified peer device."
+	MsgMeshnetPeerSetBandwidthSuccess     = "The bandwidth of the peer '%s' is now limited to %s."
+	MsgMeshnetPeerRemoveBandwidthSuccess  = "The bandwidth limit of the peer '%s' has been removed."
+	MsgMeshnetPeerBandwidthAlreadySet     = "The bandwidth of the peer '%s' is already limited to %s."
+	MsgMeshnetPeerBandwidthAlreadyRemoved = "The bandwidth of the peer '%s' is not limited."
+	MsgMeshnetPeerBandwidthInvalid        = "The bandwidth limit must be from %s to %s."
+
+	MsgMeshnetGroupUsage            = "Groups Meshnet peers to manage their permissions at once."
+	MsgMeshnetGroupListUsage        = "Lists the peer groups."
+	MsgMeshnetGroupAddUsage         = "Adds a peer to a group. The group is created if it does not exist yet."
+	MsgMeshnetGroupRemoveUsage      = "Removes a peer from a group. The group is removed together with its last peer."
+	MsgMeshnetGroupPeerArgsUsage    = "<group> <peer_hostname>|<peer_nickname>|<peer_ip>|<peer_pubkey>"
+	MsgMeshnetGroupPermissionsUsage = "Allows/denies permissions for all the peers in a group."
+	MsgMeshnetGroupPermissionsArgs  = "<group> <permission>=allow|deny..."
+	MsgMeshnetGroupAddSuccess       = "Peer '%s' has been added to the group '%s'."
+	MsgMeshnetGroupRemoveSuccess    = "Peer '%s' has been removed from the group '%s'."
+	MsgMeshnetGroupPermissionsSet   = "Permissions of the peers in the group '%s' have been updated."
+	MsgMeshnetGroupNone             = "There are no peer groups."
+	MsgMeshnetGroupNotFound         = "Group '%s' does not exist."
+	MsgMeshnetGroupPeerInGroup      = "Peer '%s' is already in the group '%s'."
+	MsgMeshnetGroupPeerNotInGroup   = "Peer '%s' is not in the group '%s'."
+	MsgMeshnetGroupInvalidName      = "Group name must be 1 to %d lowercase letters, digits, '-' or '_'."
+	MsgMeshnetGroupTooMany          = "You can create up to %d peer groups."
+
 	// errors received for meshnet nicknames
 	MsgMeshnetSetSameNickname           = "The nickname '%s' is already set for this device."
 	MsgMeshnetNicknameIsDomainName      = "The nickname is unavailable: A domain with this name already exists in your system."
@@ -279,11 +501,13 @@ Learn more:
 	FileshareCancelName = "cancel"
 	FileshareListName   = "list"
 	FileshareClearName  = "clear"
+	FileshareResendName = "resend"
 
 	flagFileshareNoWait  = "background"
 	flagFilesharePath    = "path"
 	flagFileshareListIn  = "incoming"
 	flagFileshareListOut = "outgoing"
+	flagFileshareTo      = "to"
 
 	MsgFileshareUsage                     = "Transfer files of any size between Meshnet peers securely and privately"
 	MsgFileshareDescription               = MsgFileshareUsage + "\n" + "Learn more: https://meshnet.nordvpn.com/features/sharing-files-in-meshnet\n\nNote: most arguments (peer name, transfer ID, file name) in fileshare commands can be entered faster using auto-completion. Simply press Tab and the app will suggest valid options for you."
@@ -304,12 +528,15 @@ Learn more:
 	MsgFileshareAlreadyAcceptedError = "This transfer is already completed."
 	MsgFileshareFileInvalidated      = "The transfer of this file is already completed or canceled."
 	MsgFileshareTransferInvalidated  = "This transfer is already completed or canceled."
-	MsgTooManyFiles                  = "Number of files in a transfer cannot exceed 1000. Try archiving the directory."
+	MsgTooManyFiles                  = "The transfer contains more than %d files, which is the limit. Try archiving the directory."
 	MsgNoFiles                       = "The directory you’re trying to send is empty. Please choose another one."
 	MsgDirectoryToDeep               = "File depth cannot exceed 5 directories. Try archiving the directory."
 	MsgSendingNotAllowed             = "This peer does not allow file transfers from you."
 	MsgFileNotInProgress             = "This file is not in progress"
 	MsgNotEnoughSpace                = "The transfer can't be accepted because there's not enough storage on your device."
+	MsgFileshareResendIncoming       = "Only outgoing transfers can be resent."
+	MsgFileshareResendMissingPath    = "%s no longer exists and will not be sent."
+	MsgFileshareSendQueued           = "The peer is offline. The files will be sent once the peer is back online (ID: %s). Use 'nordvpn pending' to see or cancel queued actions."
 	MsgNoPermissions                 = "You don’t have write permissions for the download directory %s. To receive the file transfer, choose another download directory using the --" + flagFilesharePath + " parameter."
 
 	MsgFileshareSendUsage       = "Send files or directories to a Meshnet peer."
@@ -321,6 +548,11 @@ Learn more:
 	MsgFileshareWaitAccept      = "Waiting for the peer to accept your transfer..."
 	MsgTransferNotCreated       = "Can’t send the files. Please check if you have the \"read\" permission for the files you want to send."
 
+	MsgFileshareResendUsage       = "Send the files of a previous outgoing transfer again."
+	MsgFileshareResendArgsUsage   = "<transfer_id>"
+	MsgFileshareResendDescription = MsgFileshareResendUsage + " Files are sent to the same peer unless another one is specified via --" + flagFileshareTo + ". Files which no longer exist are skipped.\n\nTo cancel a transfer in progress, press Ctrl+C"
+	MsgFileshareResendToUsage     = "Send the files to another Meshnet peer."
+
 	MsgFileshareListUsage       = "Lists transfers. If transfer ID is provided, lists files in the transfer."
 	MsgFileshareListArgsUsage   = `[transfer_id]`
 	MsgFileshareListDescription = `Adding no arguments to the command will list transfers.
@@ -5,7 +5,8 @@ import (
 	"math/bits"
 )
 
-func uint64ToHumanBytes(bytes uint64) string {
+// Uint64ToHumanBytes returns the amount of bytes in a human readable form, i.e. 15.90 MiB
+func Uint64ToHumanBytes(bytes uint64) string {
 	if bytes < 1024 {
 		return fmt.Sprintf("%d B", bytes)
 	}
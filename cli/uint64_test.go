@@ -26,7 +26,7 @@ func TestUint64ToHumanBytes(t *testing.T) {
 	}
 
 	for _, data := range tests {
-		got := uint64ToHumanBytes(data.input)
+		got := Uint64ToHumanBytes(data.input)
 		assert.Equal(t, got, data.expected)
 	}
 }
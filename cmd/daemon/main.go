@@ -2,6 +2,7 @@
 package main
 
 import (
+	"context"
 	"crypto/sha256"
 	_ "embed"
 	"errors"
@@ -29,8 +30,11 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/allowlist"
 	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/iptables"
 	"github.com/NordSecurity/nordvpn-linux/daemon/firewall/notables"
+	"github.com/NordSecurity/nordvpn-linux/daemon/hooks"
 	"github.com/NordSecurity/nordvpn-linux/daemon/netstate"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/permissions"
+	"github.com/NordSecurity/nordvpn-linux/daemon/ratelimit"
 	"github.com/NordSecurity/nordvpn-linux/daemon/response"
 	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
 	"github.com/NordSecurity/nordvpn-linux/daemon/routes/ifgroup"
@@ -38,9 +42,11 @@ import (
 	netlinkrouter "github.com/NordSecurity/nordvpn-linux/daemon/routes/netlink"
 	"github.com/NordSecurity/nordvpn-linux/daemon/routes/norouter"
 	"github.com/NordSecurity/nordvpn-linux/daemon/routes/norule"
+	"github.com/NordSecurity/nordvpn-linux/daemon/splittunnel"
 	"github.com/NordSecurity/nordvpn-linux/daemon/state"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordlynx"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordwhisper"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/openvpn"
 	"github.com/NordSecurity/nordvpn-linux/distro"
 	"github.com/NordSecurity/nordvpn-linux/events"
@@ -108,6 +114,10 @@ const (
 	sockTCP socketType = "tcp"
 )
 
+// daemonConnectionsPerUser is how many connections to the daemon socket a single non root user can
+// hold at the same time
+const daemonConnectionsPerUser = 10
+
 func main() {
 	// pprof
 	if internal.IsDevEnv(Environment) {
@@ -142,6 +152,14 @@ func main() {
 		}
 	}
 
+	// debug messages are always logged by the development builds unless configured otherwise
+	daemonLogLevel := cfg.DaemonLogLevel
+	if daemonLogLevel == "" && internal.IsDevEnv(Environment) {
+		daemonLogLevel = internal.LogLevelDebug
+	}
+	logFilter := internal.NewLogFilter(os.Stdout, daemonLogLevel, cfg.DebugComponents)
+	log.SetOutput(logFilter)
+
 	// Events
 
 	daemonEvents := daemonevents.NewEventsEmpty()
@@ -177,7 +195,7 @@ func main() {
 		stateModule,
 		stateFlag,
 		chainPrefix,
-		iptables.FilterSupportedIPTables(internal.GetSupportedIPTables()),
+		internal.GetSupportedIPTables(),
 	)
 	fw := firewall.NewFirewall(
 		&notables.Facade{},
@@ -247,7 +265,7 @@ func main() {
 	)
 	gwret := netlinkrouter.Retriever{}
 	dnsSetter := dns.NewSetter(infoSubject)
-	dnsHostSetter := dns.NewHostsFileSetter(dns.HostsFilePath)
+	dnsHostSetter := dns.NewMagicDNS(dns.NewHostsFileSetter(dns.HostsFilePath))
 
 	eventsDbPath := filepath.Join(internal.DatFilesPath, "moose.db")
 	// TODO: remove once this is fixed: https://github.com/ziglang/zig/issues/11878
@@ -280,7 +298,10 @@ func main() {
 	// obfuscated machineID
 	deviceID := fmt.Sprintf("%x", sha256.Sum256([]byte(cfg.MachineID.String()+Salt)))
 
-	analytics := newAnalytics(eventsDbPath, fsystem, Version, Environment, deviceID)
+	analytics := daemon.NewAnalyticsConsent(
+		newAnalytics(eventsDbPath, fsystem, Version, Environment, deviceID),
+		fsystem,
+	)
 	if cfg.Analytics.Get() {
 		if err := analytics.Enable(); err != nil {
 			log.Println(internal.WarningPrefix, err)
@@ -299,8 +320,16 @@ func main() {
 	internalVpnEvents := vpn.NewInternalVPNEvents()
 
 	// Networker
-	vpnFactory := getVpnFactory(eventsDbPath, cfg.FirewallMark,
+	meshFactory := getVpnFactory(eventsDbPath, cfg.FirewallMark, cfg.PersistentKeepalive,
 		internal.IsDevEnv(Environment), vpnLibConfigGetter, Version, internalVpnEvents)
+	vpnFactory := separateTunnelsFactory(meshFactory, func() bool {
+		var cfg config.Config
+		if err := fsystem.Load(&cfg); err != nil {
+			log.Println(internal.ErrorPrefix, err)
+			return false
+		}
+		return cfg.SeparateTunnels()
+	}, cfg.FirewallMark, internalVpnEvents)
 
 	vpn, err := vpnFactory(cfg.Technology)
 	if err != nil {
@@ -316,7 +345,7 @@ func main() {
 		ifaceNames = append(ifaceNames, d.Name)
 	}
 
-	mesh, err := meshnetImplementation(vpnFactory)
+	mesh, err := meshnetImplementation(meshFactory)
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -339,6 +368,21 @@ func main() {
 
 	statePublisher := state.NewState()
 	internalVpnEvents.Subscribe(statePublisher)
+
+	connectionHistory := daemon.NewConnectionHistory(daemon.ConnectionHistoryFilePath)
+	daemonEvents.Service.Connect.Subscribe(connectionHistory.NotifyConnect)
+	daemonEvents.Service.Disconnect.Subscribe(connectionHistory.NotifyDisconnect)
+	internalVpnEvents.Connected.Subscribe(connectionHistory.NotifyTunnelConnect)
+	internalVpnEvents.Disconnected.Subscribe(connectionHistory.NotifyTunnelDisconnect)
+	bandwidthUsage := daemon.NewBandwidthUsage(daemon.BandwidthUsageFilePath)
+	daemonEvents.Service.Connect.Subscribe(bandwidthUsage.NotifyConnect)
+	internalVpnEvents.Connected.Subscribe(bandwidthUsage.NotifyConnect)
+
+	hookRunner := hooks.NewRunner(fsystem)
+	daemonEvents.Service.Connect.Subscribe(hookRunner.NotifyConnect)
+	daemonEvents.Service.Disconnect.Subscribe(hookRunner.NotifyDisconnect)
+	internalVpnEvents.Connected.Subscribe(hookRunner.NotifyTunnelConnect)
+	internalVpnEvents.Disconnected.Subscribe(hookRunner.NotifyTunnelDisconnect)
 	daemonEvents.User.Subscribe(statePublisher)
 	configEvents.Subscribe(statePublisher)
 
@@ -362,6 +406,7 @@ func main() {
 				routes.NewSysctlRPFilterManager(),
 				ifgroup.NewNetlinkManager(device.ListPhysical),
 				cfg.FirewallMark,
+				cfg.RoutingTable,
 			),
 			cfg.Routing.Get(),
 		),
@@ -380,17 +425,40 @@ func main() {
 		cfg.FirewallMark,
 		cfg.LanDiscovery,
 	)
+	netw.SetRouteMetric(cfg.RouteMetric)
+	// routes are set once meshnet is
+	if err := netw.SetPeerRoutes(cfg.MeshnetPeerRoutes.Peer(), cfg.MeshnetPeerRoutes.Subnets()); err != nil {
+		log.Println(internal.WarningPrefix, err)
+	}
+	// limits are applied once the peers are routed through this device
+	if err := netw.SetPeerBandwidthLimits(cfg.Meshnet.PeerBandwidthLimits); err != nil {
+		log.Println(internal.WarningPrefix, err)
+	}
 
-	keygen, err := keygenImplementation(vpnFactory)
+	keygen, err := keygenImplementation(meshFactory)
 	if err != nil {
 		log.Fatalln(err)
 	}
 
+	norduserLimits := norduserservice.ResourceLimitsFromEnv(os.Getenv)
 	var norduserService norduserservice.Service
 	if snapconf.IsUnderSnap() {
 		norduserService = norduserservice.NewNorduserSnapService()
+	} else if norduserservice.IsSystemdUserUnitAvailable() {
+		// norduserd hosts the tray, so it is needed right away only if the user has the tray enabled
+		trayOff := func(uid uint32) bool {
+			var cfg config.Config
+			if err := fsystem.Load(&cfg); err != nil {
+				return false
+			}
+			return cfg.UsersData.TrayOff[int64(uid)]
+		}
+		norduserService = norduserservice.NewSystemdNorduser(
+			norduserservice.NewChildProcessNorduser(norduserLimits),
+			trayOff,
+		)
 	} else {
-		norduserService = norduserservice.NewChildProcessNorduser()
+		norduserService = norduserservice.NewChildProcessNorduser(norduserLimits)
 	}
 
 	norduserClient := norduserservice.NewNorduserGRPCClient()
@@ -432,6 +500,22 @@ func main() {
 		dataUpdateEvents,
 	)
 
+	monitor, err := netstate.NewNetlinkMonitor(tunnelInterfaces(cfg.InterfaceName))
+	if err != nil {
+		log.Fatalln(err)
+	}
+	// tunnel created with the new interface name must not be taken for a network change
+	configEvents.Config.Subscribe(func(c *config.Config) error {
+		monitor.IgnoreInterfaces(tunnelInterfaces(c.InterfaceName))
+		return nil
+	})
+	pendingActions := daemon.NewPendingActions(monitor.IsOnline)
+
+	splitTunnel := splittunnel.NewCgroup(func(command string, arg ...string) ([]byte, error) {
+		arg = append(arg, "-w", internal.SecondsToWaitForIptablesLock)
+		return exec.Command(command, arg...).CombinedOutput()
+	})
+
 	sharedContext := sharedctx.New()
 	rpc := daemon.NewRPC(
 		internal.Environment(Environment),
@@ -455,10 +539,30 @@ func main() {
 		notificationClient,
 		analytics,
 		norduserService,
+		norduserservice.NewHealthMonitor(norduserClient, norduserService.Restart),
+		norduserClient,
 		meshAPIex,
 		statePublisher,
 		sharedContext,
+		pendingActions,
+		monitor.IdentifyNetwork,
+		netstate.IsMetered,
+		createCaptivePortalProber(cfg.FirewallMark),
+		splitTunnel,
+		connectionHistory,
+		bandwidthUsage,
+		hookRunner,
+		logFilter,
 	)
+	eventStream := rpc.EventStream()
+	internalVpnEvents.Subscribe(eventStream)
+	daemonEvents.User.Subscribe(eventStream)
+	configEvents.Subscribe(eventStream)
+	daemonEvents.Settings.Meshnet.Subscribe(eventStream.NotifyMeshnet)
+	daemonEvents.Settings.Firewall.Subscribe(eventStream.NotifyFirewall)
+	daemonEvents.Settings.Killswitch.Subscribe(eventStream.NotifyKillswitch)
+	meshnetEvents.PeerUpdate.Subscribe(eventStream.NotifyPeerUpdate)
+
 	meshService := meshnet.NewServer(
 		authChecker,
 		fsystem,
@@ -475,7 +579,8 @@ func main() {
 	)
 
 	opts := []grpc.ServerOption{
-		grpc.Creds(internal.NewUnixSocketCredentials(internal.NewDaemonAuthenticator())),
+		// every local user can connect, the calls are authorized by the permission checker
+		grpc.Creds(internal.NewUnixSocketCredentials(internal.NewLocalUserAuthenticator())),
 	}
 
 	norduserMonitor := norduser.NewNorduserProcessMonitor(norduserService)
@@ -492,19 +597,29 @@ func main() {
 	}()
 
 	middleware := grpcmiddleware.Middleware{}
+	permissionMatrix, err := permissions.LoadMatrix(permissions.MatrixPath)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "Failed to load permission matrix, using defaults:", err)
+		permissionMatrix = permissions.DefaultMatrix()
+	}
+	permissionChecker := permissions.NewChecker(permissionMatrix)
+	middleware.AddStreamMiddleware(permissionChecker.StreamMiddleware)
+	middleware.AddUnaryMiddleware(permissionChecker.UnaryMiddleware)
 	if snapconf.IsUnderSnap() {
 		checker := snapconf.NewSnapChecker(errSubject)
 		middleware.AddStreamMiddleware(checker.StreamInterceptor)
 		middleware.AddUnaryMiddleware(checker.UnaryInterceptor)
 	} else {
 		// in non snap environment, norduser is started on the daemon side on every command
-		norduserMiddleware := norduser.NewStartNorduserMiddleware(norduserService)
+		norduserMiddleware := norduser.NewStartNorduserMiddleware(norduserService, permissionChecker.CanControl)
 		middleware.AddStreamMiddleware(norduserMiddleware.StreamMiddleware)
 		middleware.AddUnaryMiddleware(norduserMiddleware.UnaryMiddleware)
 	}
 
-	opts = append(opts, grpc.StreamInterceptor(middleware.StreamIntercept))
-	opts = append(opts, grpc.UnaryInterceptor(middleware.UnaryIntercept))
+	// calls are limited only after they are authorized, so unauthorized clients can't use up the limits
+	limiter := ratelimit.NewLimiter(ratelimit.DefaultLimits())
+	opts = append(opts, grpc.ChainStreamInterceptor(middleware.StreamIntercept, limiter.StreamInterceptor))
+	opts = append(opts, grpc.ChainUnaryInterceptor(middleware.UnaryIntercept, limiter.UnaryInterceptor))
 	s := grpc.NewServer(opts...)
 
 	pb.RegisterDaemonServer(s, rpc)
@@ -523,7 +638,7 @@ func main() {
 			// switch to manual if pids mismatch
 			if os.Getenv(internal.ListenPID) != strconv.Itoa(os.Getpid()) {
 				listenerFunction = internal.ManualListenerIfNotInUse(ConnURL,
-					internal.PermUserRWGroupRW, internal.DaemonPid)
+					internal.PermUserRWGroupRWOthersRW, internal.DaemonPid)
 			}
 			listener, err = listenerFunction()
 			if err != nil {
@@ -536,8 +651,9 @@ func main() {
 				)
 			}
 			// limit count of requests on socket at the same time from
-			// non-authorized users to prevent from crashing daemon
-			listener = netutil.LimitListener(listener, 100)
+			// non-authorized users to prevent from crashing daemon. The socket is open to every
+			// local user, so each of them gets a share of the limit only.
+			listener = netutil.LimitListener(internal.NewUIDLimitListener(listener, daemonConnectionsPerUser), 100)
 		case sockTCP:
 			listener, err = net.Listen("tcp", ConnURL)
 			if err != nil {
@@ -558,21 +674,45 @@ func main() {
 		}
 	}()
 	rpc.StartJobs(statePublisher)
+	if !snapconf.IsUnderSnap() {
+		if err := daemon.NewDBusAPI(rpc, permissionChecker).Start(statePublisher); err != nil {
+			log.Println(internal.WarningPrefix, "D-Bus API is not available:", err)
+		}
+	}
 	meshService.StartJobs()
 	rpc.StartKillSwitch()
+	if err := rpc.ApplySplitTunnel(); err != nil {
+		log.Println(internal.ErrorPrefix, "applying split tunnel:", err)
+	}
 	if internal.IsSystemd() {
 		go rpc.StartSystemShutdownMonitor()
 	}
+	managedSettingsStop := make(chan struct{})
+	go func() {
+		if err := rpc.WatchManagedSettings(daemon.ManagedSettingsPath, managedSettingsStop); err != nil {
+			log.Println(internal.ErrorPrefix, "managed settings are not reloaded:", err)
+		}
+	}()
+	// readiness is sent after the config is loaded and the kill switch is restored
+	go rpc.NotifyReady(internal.SdNotify)
+	watchdogStop := make(chan struct{})
+	if interval, err := internal.SdWatchdogInterval(); err != nil {
+		log.Println(internal.WarningPrefix, "systemd watchdog is not started:", err)
+	} else if interval > 0 {
+		go rpc.StartWatchdog(interval, internal.SdNotify, watchdogStop)
+	}
 
-	if cfg.AutoConnect {
+	if cfg.AutoConnectForAnyone() {
 		go rpc.StartAutoConnect(network.ExponentialBackoff)
 	}
 
-	monitor, err := netstate.NewNetlinkMonitor([]string{openvpn.InterfaceName, nordlynx.InterfaceName})
-	if err != nil {
-		log.Fatalln(err)
-	}
-	monitor.Start(netw)
+	monitor.Start(netstate.Reconnectors{
+		netw,
+		pendingActions,
+		rpc.TrustedNetworkRules(),
+		rpc.MeteredNetwork(),
+		rpc.CaptivePortal(),
+	})
 
 	if authChecker.IsLoggedIn() {
 		go daemon.StartNC("[startup]", notificationClient)
@@ -585,19 +725,62 @@ func main() {
 	// Graceful stop
 
 	internal.WaitSignal()
-	s.GracefulStop()
-	norduserService.StopAll()
-
-	if err := notificationClient.Stop(); err != nil {
-		log.Println(internal.ErrorPrefix, "stopping NC:", err)
-	}
-	if err := netw.Stop(); err != nil {
-		log.Println(internal.ErrorPrefix, "disconnecting from VPN:", err)
+	close(watchdogStop)
+	close(managedSettingsStop)
+	if _, err := internal.SdNotify(internal.SdNotifyStopping); err != nil {
+		log.Println(internal.WarningPrefix, "sending stopping state to systemd:", err)
 	}
-	if err := netw.UnSetMesh(); err != nil && !errors.Is(err, networker.ErrMeshNotActive) {
-		log.Println(internal.ErrorPrefix, "disconnecting from meshnet:", err)
+	drainCtx, cancelDrain := context.WithTimeout(context.Background(), daemon.DrainTimeout)
+	defer cancelDrain()
+	meshService.ClosePeerPresence()
+	rpc.DrainRPCs(drainCtx, s)
+
+	// the cleanup is not waited for past the deadline, the kill switch rules stay in place then
+	teardownCtx, cancelTeardown := context.WithTimeout(context.Background(), daemon.TeardownTimeout)
+	defer cancelTeardown()
+	cleanedUp := make(chan struct{})
+	go func() {
+		defer close(cleanedUp)
+		norduserService.StopAll()
+
+		if err := notificationClient.Stop(); err != nil {
+			log.Println(internal.ErrorPrefix, "stopping NC:", err)
+		}
+		// connection is ended before stopping VPN, so it is not recorded as lost
+		var download, upload uint64
+		if status, err := netw.ConnectionStatus(); err == nil {
+			download, upload = status.Download, status.Upload
+			if err := bandwidthUsage.Record(status.Hostname, download, upload); err != nil {
+				log.Println(internal.WarningPrefix, "recording bandwidth usage:", err)
+			}
+		}
+		connectionHistory.Stop(download, upload)
+		if err := netw.Stop(); err != nil {
+			log.Println(internal.ErrorPrefix, "disconnecting from VPN:", err)
+		}
+		if err := netw.UnSetMesh(); err != nil && !errors.Is(err, networker.ErrMeshNotActive) {
+			log.Println(internal.ErrorPrefix, "disconnecting from meshnet:", err)
+		}
+		if err := rpc.StopKillSwitch(); err != nil {
+			log.Println(internal.ErrorPrefix, "stopping KillSwitch:", err)
+		}
+		if err := splitTunnel.Disable(); err != nil {
+			log.Println(internal.ErrorPrefix, "disabling split tunnel:", err)
+		}
+	}()
+
+	select {
+	case <-cleanedUp:
+	case <-teardownCtx.Done():
+		log.Println(internal.ErrorPrefix, "daemon was not stopped before the teardown deadline, exiting")
 	}
-	if err := rpc.StopKillSwitch(); err != nil {
-		log.Println(internal.ErrorPrefix, "stopping KillSwitch:", err)
+}
+
+// tunnelInterfaces returns the names of the interfaces which can be created by the daemon
+func tunnelInterfaces(configured string) []string {
+	names := []string{openvpn.InterfaceName, nordlynx.InterfaceName, nordwhisper.InterfaceName}
+	if configured != "" {
+		names = append(names, configured)
 	}
+	return names
 }
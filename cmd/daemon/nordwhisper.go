@@ -0,0 +1,20 @@
+package main
+
+import (
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordwhisper"
+)
+
+// nordWhisperEngine provides the NordWhisper protocol. The protocol library is not bundled yet, so the technology
+// can't be selected until it is.
+func nordWhisperEngine() (nordwhisper.Engine, error) {
+	return nil, vpn.ErrTechnologyUnavailable
+}
+
+func nordWhisperImplementation(fwmark uint32, eventsPublisher *vpn.Events) (vpn.VPN, error) {
+	engine, err := nordWhisperEngine()
+	if err != nil {
+		return nil, err
+	}
+	return nordwhisper.New(engine, fwmark, eventsPublisher), nil
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordlynx"
+)
+
+// separateTunnelsFactory connects NordLynx through its own tunnel while meshnet peer routes are set, because the
+// meshnet tunnel has a single exit node which is then used by the peer.
+func separateTunnelsFactory(
+	fn daemon.FactoryFunc,
+	separate func() bool,
+	fwmark uint32,
+	eventsPublisher *vpn.Events,
+) daemon.FactoryFunc {
+	return func(tech config.Technology) (vpn.VPN, error) {
+		if tech == config.Technology_NORDLYNX && separate() {
+			return nordlynx.NewFallback(fwmark, eventsPublisher), nil
+		}
+		return fn(tech)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/NordSecurity/nordvpn-linux/daemon/netstate"
 	"github.com/NordSecurity/nordvpn-linux/events"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/kernel"
@@ -31,26 +32,30 @@ const (
 	envHTTPTransportsKey = "HTTP_TRANSPORTS"
 )
 
+// createMarkedDialer returns the dialer of the connections which bypass the VPN tunnel and the kill switch
+func createMarkedDialer(fwmark uint32) *net.Dialer {
+	return &net.Dialer{
+		Control: func(network, address string, conn syscall.RawConn) error {
+			var operr error
+			if err := conn.Control(func(fd uintptr) {
+				operr = syscall.SetsockoptInt(
+					int(fd),
+					unix.SOL_SOCKET,
+					unix.SO_MARK,
+					int(fwmark),
+				)
+			}); err != nil {
+				return err
+			}
+			return operr
+		},
+		Timeout: request.DefaultTimeout,
+	}
+}
+
 func createH1Transport(resolver network.DNSResolver, fwmark uint32) func() http.RoundTripper {
 	return func() http.RoundTripper {
-		var operr error
-		fwmark := func(fd uintptr) {
-			operr = syscall.SetsockoptInt(
-				int(fd),
-				unix.SOL_SOCKET,
-				unix.SO_MARK,
-				int(fwmark),
-			)
-		}
-		dialer := &net.Dialer{
-			Control: func(network, address string, conn syscall.RawConn) error {
-				if err := conn.Control(fwmark); err != nil {
-					return err
-				}
-				return operr
-			},
-			Timeout: request.DefaultTimeout,
-		}
+		dialer := createMarkedDialer(fwmark)
 		return &http.Transport{
 			DialContext: func(ctx context.Context, netw, addr string) (net.Conn, error) {
 				domain, _, ok := strings.Cut(addr, ":")
@@ -80,6 +85,25 @@ func createH1Transport(resolver network.DNSResolver, fwmark uint32) func() http.
 	}
 }
 
+// createCaptivePortalProber provides the prober for captive portals. Probes and their DNS queries go outside the
+// tunnel, as the portal usually intercepts DNS as well.
+func createCaptivePortalProber(fwmark uint32) netstate.CaptivePortalProber {
+	resolver := &net.Resolver{PreferGo: true, Dial: createMarkedDialer(fwmark).DialContext}
+	dialer := createMarkedDialer(fwmark)
+	dialer.Resolver = resolver
+	return netstate.CaptivePortalProber{
+		Client: &http.Client{
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: request.TransportTimeout,
+				DisableKeepAlives:   true,
+			},
+			Timeout: request.DefaultTimeout,
+		},
+		Resolver: resolver,
+	}
+}
+
 func createH3Transport() *http3.RoundTripper {
 	pool, err := x509.SystemCertPool()
 	if err != nil {
@@ -17,15 +17,17 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/tunnel"
 )
 
-func getVpnFactory(eventsDbPath string, fwmark uint32, envIsDev bool,
+func getVpnFactory(eventsDbPath string, fwmark uint32, keepalive uint16, envIsDev bool,
 	cfg vpn.LibConfigGetter, appVersion string, eventsPublisher *vpn.Events,
 ) daemon.FactoryFunc {
 	return func(tech config.Technology) (vpn.VPN, error) {
 		switch tech {
 		case config.Technology_NORDLYNX:
-			return nordlynx.NewKernelSpace(fwmark, eventsPublisher), nil
+			return nordlynx.NewFallback(fwmark, eventsPublisher), nil
 		case config.Technology_OPENVPN:
 			return openvpn.New(fwmark, eventsPublisher), nil
+		case config.Technology_NORDWHISPER:
+			return nordWhisperImplementation(fwmark, eventsPublisher)
 		case config.Technology_UNKNOWN_TECHNOLOGY:
 			fallthrough
 		default:
@@ -46,11 +48,18 @@ func (noopMesh) Tun() tunnel.T                   { return &tunnel.Tunnel{} }
 func (noopMesh) StatusMap() (map[string]string, error) {
 	return map[string]string{}, nil
 }
+func (noopMesh) PathMap() (map[string]string, error) {
+	return map[string]string{}, nil
+}
 
 func (noopMesh) NetworkChanged() error {
 	return fmt.Errorf("not supported")
 }
 
+func (noopMesh) SetPeerRoutes(string, []netip.Prefix) error {
+	return fmt.Errorf("not supported")
+}
+
 func meshnetImplementation(fn daemon.FactoryFunc) (meshnet.Mesh, error) {
 	return noopMesh(true), nil
 }
@@ -15,10 +15,10 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/meshnet"
 )
 
-func getVpnFactory(eventsDbPath string, fwmark uint32, envIsDev bool,
+func getVpnFactory(eventsDbPath string, fwmark uint32, keepalive uint16, envIsDev bool,
 	cfg vpn.LibConfigGetter, appVersion string, eventsPublisher *vpn.Events,
 ) daemon.FactoryFunc {
-	telio, err := libtelio.New(!envIsDev, eventsDbPath, fwmark, cfg, appVersion, eventsPublisher)
+	telio, err := libtelio.New(!envIsDev, eventsDbPath, fwmark, keepalive, cfg, appVersion, eventsPublisher)
 	if err != nil {
 		// don't exit with `err` here in case the factory will be called with
 		// technology different than `config.Technology_NORDLYNX`
@@ -30,6 +30,8 @@ func getVpnFactory(eventsDbPath string, fwmark uint32, envIsDev bool,
 			return telio, err
 		case config.Technology_OPENVPN:
 			return openvpn.New(fwmark, eventsPublisher), nil
+		case config.Technology_NORDWHISPER:
+			return nordWhisperImplementation(fwmark, eventsPublisher)
 		default:
 			return nil, errors.New("no such technology")
 		}
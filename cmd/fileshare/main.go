@@ -6,12 +6,14 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net"
 	_ "net/http/pprof" // #nosec G108 -- http server is not run in production builds
 	"net/netip"
 	"os"
 	"path/filepath"
+	"strconv"
 
 	childprocess "github.com/NordSecurity/nordvpn-linux/child_process"
 	daemonpb "github.com/NordSecurity/nordvpn-linux/daemon/pb"
@@ -50,13 +52,28 @@ func main() {
 		os.Exit(int(childprocess.CodeFailedToEnable))
 	}
 
+	var logFallback io.Writer = os.Stderr
 	cacheDirPath, err := internal.GetCacheDirPath(homeDir)
 	if err == nil {
 		if logFile, err := openLogFile(filepath.Join(cacheDirPath, internal.FileshareLogFileName)); err == nil {
-			log.SetOutput(logFile)
-			log.SetFlags(log.LstdFlags | log.Lshortfile | log.Lmicroseconds)
+			logFallback = logFile
 		}
 	}
+	// log level is passed by norduserd
+	logLevel, err := internal.ParseLogLevel(os.Getenv(internal.EnvLogLevel))
+	if err != nil {
+		logLevel = internal.DefaultLogLevel
+	}
+	logWriter := internal.NewJournalWriter(internal.Fileshare, map[string]string{
+		internal.JournalFieldUID:       strconv.Itoa(os.Getuid()),
+		internal.JournalFieldComponent: internal.Fileshare,
+	}, logLevel, logFallback)
+	log.SetOutput(logWriter)
+	if logWriter.Journald() {
+		log.SetFlags(log.Lshortfile)
+	} else {
+		log.SetFlags(log.LstdFlags | log.Lshortfile | log.Lmicroseconds)
+	}
 
 	processStatus := fileshare_process.NewFileshareGRPCProcessManager().ProcessStatus()
 	if processStatus == childprocess.Running {
@@ -129,6 +146,12 @@ func main() {
 		os.Exit(int(childprocess.CodeMeshnetNotEnabled))
 	}
 
+	settings, err := daemonClient.Settings(context.Background(), &daemonpb.Empty{})
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "failed to retrieve daemon setting:", err)
+		os.Exit(int(childprocess.CodeFailedToEnable))
+	}
+
 	defaultDownloadDirectory, err := fileshare.GetDefaultDownloadDirectory()
 	if err != nil {
 		log.Println(internal.WarningPrefix, "failed to find default download directory:", err)
@@ -142,6 +165,8 @@ func main() {
 		fileshare.NewStdFilesystem("/"),
 		defaultDownloadDirectory,
 	)
+	transferFileLimit := fileshare.TransferFileLimitOr(settings.GetData().GetFileshareFileLimit())
+	eventManager.SetTransferFileLimit(transferFileLimit)
 
 	privKeyResponse, err := meshClient.GetPrivateKey(context.Background(), &meshpb.Empty{})
 	if err != nil || privKeyResponse.GetPrivateKey() == "" {
@@ -175,6 +200,7 @@ func main() {
 		fileshare.NewPubkeyProvider(meshClient).PubkeyFunc,
 		string(meshPrivKey),
 		storagePath,
+		transferFileLimit,
 	)
 	if err != nil {
 		log.Println(internal.ErrorPrefix, "can't create fileshare implementation:", err)
@@ -193,12 +219,6 @@ func main() {
 		eventManager.SetStorage(storage.NewLibdrop(fileshareImplementation))
 	}
 
-	settings, err := daemonClient.Settings(context.Background(), &daemonpb.Empty{})
-	if err != nil {
-		log.Println(internal.ErrorPrefix, "failed to retrieve daemon setting:", err)
-		os.Exit(int(childprocess.CodeFailedToEnable))
-	}
-
 	if settings != nil && settings.Data.UserSettings.Notify {
 		err = eventManager.EnableNotifications(fileshareImplementation)
 		if err != nil {
@@ -4,13 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log"
+	"net"
 	"os"
 	"os/user"
 	"path"
 	"path/filepath"
 	"slices"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -22,8 +25,10 @@ import (
 
 	childprocess "github.com/NordSecurity/nordvpn-linux/child_process"
 	daemonpb "github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/permissions"
 	"github.com/NordSecurity/nordvpn-linux/fileshare/fileshare_process"
 	filesharepb "github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	grpcmiddleware "github.com/NordSecurity/nordvpn-linux/grpc_middleware"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	meshpb "github.com/NordSecurity/nordvpn-linux/meshnet/pb"
 	"github.com/NordSecurity/nordvpn-linux/norduser"
@@ -33,6 +38,9 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/tray"
 )
 
+// Values set when building the application
+var Version = "0.0.0"
+
 func openLogFile(path string) (*os.File, error) {
 	// #nosec path is constant
 	logFile, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
@@ -64,7 +72,7 @@ func addAutostart() (string, error) {
 	return path, internal.FileWrite(path, []byte(autostartDesktopFileContents), internal.PermUserRW)
 }
 
-func startTray(quitChan chan<- norduser.StopRequest) {
+func startTray(quitChan chan<- norduser.StopRequest, minimal bool) {
 	daemonURL := fmt.Sprintf("%s://%s", internal.Proto, internal.DaemonSocket)
 	conn, err := grpc.Dial(
 		daemonURL,
@@ -79,20 +87,20 @@ func startTray(quitChan chan<- norduser.StopRequest) {
 		return
 	}
 
-	fileshareConn, err := grpc.Dial(
-		fileshare_process.FileshareURL,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
-
 	var fileshareClient filesharepb.FileshareClient
-	if err == nil {
+	if !minimal {
+		fileshareConn, err := grpc.Dial(
+			fileshare_process.FileshareURL,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if err != nil {
+			log.Println(internal.ErrorPrefix, "Error connecting to the NordVPN fileshare daemon:", err)
+			return
+		}
 		fileshareClient = filesharepb.NewFileshareClient(fileshareConn)
-	} else {
-		log.Println(internal.ErrorPrefix, "Error connecting to the NordVPN fileshare daemon:", err)
-		return
 	}
 
-	ti := tray.NewTrayInstance(client, fileshareClient, quitChan)
+	ti := tray.NewTrayInstance(client, fileshareClient, minimal, quitChan)
 	ti.Start()
 
 	onExit := func() {
@@ -107,16 +115,86 @@ func startTray(quitChan chan<- norduser.StopRequest) {
 
 	trayStatus := ti.WaitInitialTrayStatus()
 	if trayStatus == tray.Enabled {
-		for {
-			if systray.IsAvailable() {
-				systray.Run(onReady, onExit)
-				break
+		ti.WaitForHost()
+		systray.Run(onReady, onExit)
+	}
+}
+
+// startWidgetServer exposes meshnet status for the desktop applets over a socket readable only by the user. The
+// returned function stops the server and removes the socket.
+func startWidgetServer(uid int, minimal bool) func() {
+	socketPath := internal.GetNorduserWidgetSocket(uid)
+	if err := os.Remove(socketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Println(internal.ErrorPrefix, "Failed to remove old widget socket file:", err)
+	}
+
+	listener, err := internal.ManualListener(socketPath, internal.PermUserRW)()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "Failed to open widget socket:", err)
+		return func() {}
+	}
+
+	daemonURL := fmt.Sprintf("%s://%s", internal.Proto, internal.DaemonSocket)
+	conn, err := grpc.Dial(
+		daemonURL,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "Error connecting to the NordVPN daemon:", err)
+		closeWidgetListener(listener, socketPath)
+		return func() {}
+	}
+
+	var fileshareConn *grpc.ClientConn
+	var fileshareClient filesharepb.FileshareClient
+	if !minimal {
+		fileshareConn, err = grpc.Dial(
+			fileshare_process.FileshareURL,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		if err != nil {
+			log.Println(internal.ErrorPrefix, "Error connecting to the NordVPN fileshare daemon:", err)
+		} else {
+			fileshareClient = filesharepb.NewFileshareClient(fileshareConn)
+		}
+	}
+
+	widgetServer := norduser.NewWidgetServer(
+		daemonpb.NewDaemonClient(conn),
+		meshpb.NewMeshnetClient(conn),
+		fileshareClient,
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	go widgetServer.Run(ctx)
+	go func() {
+		if err := widgetServer.Serve(listener); err != nil {
+			log.Println(internal.ErrorPrefix, "Widget server stopped:", err)
+		}
+	}()
+
+	return func() {
+		cancel()
+		closeWidgetListener(listener, socketPath)
+		if err := conn.Close(); err != nil {
+			log.Println(internal.ErrorPrefix, "Failed to close grpc connection:", err)
+		}
+		if fileshareConn != nil {
+			if err := fileshareConn.Close(); err != nil {
+				log.Println(internal.ErrorPrefix, "Failed to close fileshare grpc connection:", err)
 			}
-			<-time.After(10 * time.Second)
 		}
 	}
 }
 
+func closeWidgetListener(listener net.Listener, socketPath string) {
+	if err := listener.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+		log.Println(internal.ErrorPrefix, "Failed to close widget socket:", err)
+	}
+	if err := os.Remove(socketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Println(internal.ErrorPrefix, "Failed to remove widget socket file:", err)
+	}
+}
+
 func shouldEnableFileshare(uid uint32) (bool, error) {
 	daemonURL := fmt.Sprintf("%s://%s", internal.Proto, internal.DaemonSocket)
 
@@ -146,20 +224,70 @@ func shouldEnableFileshare(uid uint32) (bool, error) {
 	return meshStatus.GetUid() == uid && meshStatus.GetValue(), nil
 }
 
-func setupLog() {
-	log.SetOutput(os.Stdout)
+// fetchSettings retrieves the settings which affect norduserd from the main daemon
+func fetchSettings() (*daemonpb.Settings, error) {
+	daemonURL := fmt.Sprintf("%s://%s", internal.Proto, internal.DaemonSocket)
 
-	homeDir, err := os.UserHomeDir()
+	grpcConn, err := grpc.Dial(
+		daemonURL,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
 	if err != nil {
-		return
+		return nil, fmt.Errorf("connecting to main daemon: %w", err)
+	}
+
+	defer func() {
+		if err := grpcConn.Close(); err != nil {
+			log.Println(internal.ErrorPrefix, "Failed to close grpc connection")
+		}
+	}()
+
+	resp, err := daemonpb.NewDaemonClient(grpcConn).Settings(context.Background(), &daemonpb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("running settings grpc: %w", err)
 	}
 
-	cacheDirPath, err := internal.GetCacheDirPath(homeDir)
+	return resp.GetData(), nil
+}
 
+// setupLog sends logs to the journal or to the log file if the journal is not available
+func setupLog() *internal.JournalWriter {
+	var fallback io.Writer = os.Stdout
+	log.SetOutput(fallback)
+
+	homeDir, err := os.UserHomeDir()
 	if err == nil {
-		if logFile, err := openLogFile(filepath.Join(cacheDirPath, internal.NorduserdLogFileName)); err == nil {
-			log.SetOutput(logFile)
-			log.SetFlags(log.LstdFlags | log.Lshortfile | log.Lmicroseconds)
+		if cacheDirPath, err := internal.GetCacheDirPath(homeDir); err == nil {
+			if logFile, err := openLogFile(filepath.Join(cacheDirPath, internal.NorduserdLogFileName)); err == nil {
+				fallback = logFile
+			}
+		}
+	}
+
+	level, err := internal.ParseLogLevel(os.Getenv(internal.EnvLogLevel))
+	if err != nil {
+		level = internal.DefaultLogLevel
+	}
+	writer := internal.NewJournalWriter(internal.Norduserd, map[string]string{
+		internal.JournalFieldUID:       strconv.Itoa(os.Getuid()),
+		internal.JournalFieldComponent: internal.Norduserd,
+	}, level, fallback)
+	log.SetOutput(writer)
+	if writer.Journald() {
+		// journal keeps the timestamps
+		log.SetFlags(log.Lshortfile)
+	} else {
+		log.SetFlags(log.LstdFlags | log.Lshortfile | log.Lmicroseconds)
+	}
+	return writer
+}
+
+// logLevelSetter changes the log level of norduserd and of the fileshare processes started afterwards
+func logLevelSetter(writer *internal.JournalWriter) func(internal.LogLevel) {
+	return func(level internal.LogLevel) {
+		writer.SetLevel(level)
+		if err := os.Setenv(internal.EnvLogLevel, string(level)); err != nil {
+			log.Println(internal.WarningPrefix, "Failed to pass the log level to fileshare:", err)
 		}
 	}
 }
@@ -169,6 +297,7 @@ func waitForShutdown(stopChan <-chan norduser.StopRequest,
 	fileshareShutdownChan <-chan interface{},
 	logoutChan <-chan interface{},
 	grpcServer *grpc.Server,
+	stopWidgetServer func(),
 	onShutdown func(bool),
 ) {
 	restart := false
@@ -192,6 +321,7 @@ func waitForShutdown(stopChan <-chan norduser.StopRequest,
 	}
 
 	grpcServer.GracefulStop()
+	stopWidgetServer()
 	fileshareManagementChan <- norduser.Shutdown
 	// shutdownChan will be closed once the shutdown operation is finished
 	<-fileshareShutdownChan
@@ -206,6 +336,8 @@ func waitForShutdown(stopChan <-chan norduser.StopRequest,
 	if restart {
 		log.Println(internal.InfoPrefix, "Norduser daemon restarting")
 		execpath, err := os.Executable()
+		// binary replaced by the package upgrade is reported as deleted, start the new one instead
+		execpath = strings.TrimSuffix(execpath, " (deleted)")
 		if err == nil {
 			err = syscall.Exec(execpath, os.Args, os.Environ())
 			if err != nil {
@@ -215,7 +347,12 @@ func waitForShutdown(stopChan <-chan norduser.StopRequest,
 	}
 }
 
-func startFileshare(uid uint32) (chan<- norduser.FileshareManagementMsg, <-chan interface{}) {
+func startFileshare(uid uint32, minimal bool) (chan<- norduser.FileshareManagementMsg, <-chan interface{}) {
+	if minimal {
+		log.Println(internal.InfoPrefix, "Tray is in minimal mode, fileshare will not be started")
+		return norduser.StartDisabledFileshareManagementLoop()
+	}
+
 	fileshareManagementChan, fileshareShutdownChan := norduser.StartFileshareManagementLoop()
 	if enable, err := shouldEnableFileshare(uid); err != nil {
 		log.Println(internal.ErrorPrefix, "Failed to determine if fileshare should be enabled on startup:", err)
@@ -227,7 +364,7 @@ func startFileshare(uid uint32) (chan<- norduser.FileshareManagementMsg, <-chan
 }
 
 func startSnap() {
-	setupLog()
+	logWriter := setupLog()
 	group, err := user.LookupGroup(internal.NordvpnGroup)
 	if err != nil {
 		log.Println(internal.ErrorPrefix, "Unable to retrieve nordvpn group:", err)
@@ -281,20 +418,31 @@ func startSnap() {
 		log.Println(internal.ErrorPrefix, "Failed to remove old socket file:", err)
 	}
 
-	listener, err := internal.ManualListener(socketPath, internal.PermUserRWGroupRWOthersRW)()
+	checker := newPermissionChecker(uint32(uid))
+	listener, err := internal.ManualListener(socketPath, socketPermissions(checker))()
 	if err != nil {
 		log.Printf("%s Failed to open unix socket: %s", internal.ErrorPrefix, err)
 		os.Exit(int(childprocess.CodeFailedToCreateUnixScoket))
 	}
 	limitedListener := netutil.LimitListener(listener, 100)
 
-	fileshareManagementChan, fileshareShutdownChan := startFileshare(uint32(uid))
+	setLogLevel := logLevelSetter(logWriter)
+	var minimal bool
+	if settings, err := fetchSettings(); err != nil {
+		log.Println(internal.ErrorPrefix, "Failed to fetch the settings:", err)
+	} else {
+		minimal = settings.GetTrayMinimal()
+		if level, err := internal.ParseLogLevel(settings.GetLogLevel()); err == nil {
+			setLogLevel(level)
+		}
+	}
+
+	fileshareManagementChan, fileshareShutdownChan := startFileshare(uint32(uid), minimal)
 
 	stopChan := make(chan norduser.StopRequest)
-	server := norduser.NewServer(fileshareManagementChan, stopChan)
+	server := norduser.NewServer(fileshareManagementChan, stopChan, Version, setLogLevel)
 
-	grpcServer := grpc.NewServer(
-		grpc.Creds(internal.NewUnixSocketCredentials(internal.NewFileshareAuthenticator(uint32(uid)))))
+	grpcServer := newGRPCServer(checker)
 	pb.RegisterNorduserServer(grpcServer, server)
 
 	go func() {
@@ -304,11 +452,12 @@ func startSnap() {
 		}
 	}()
 
-	go startTray(stopChan)
+	go startTray(stopChan, minimal)
+	stopWidgetServer := startWidgetServer(uid, minimal)
 
 	log.Println(internal.InfoPrefix, "Daemon has started")
 
-	waitForShutdown(stopChan, fileshareManagementChan, fileshareShutdownChan, logoutChan, grpcServer,
+	waitForShutdown(stopChan, fileshareManagementChan, fileshareShutdownChan, logoutChan, grpcServer, stopWidgetServer,
 		func(disable bool) {
 			if !disable {
 				return
@@ -321,15 +470,20 @@ func startSnap() {
 }
 
 func start() {
+	// use the listener passed by systemd when started by norduserd.socket user unit on demand
 	listenerFunction := internal.SystemDListener
 
-	setupLog()
+	logWriter := setupLog()
 
-	connURL := internal.GetNorduserSocketFork(os.Geteuid())
-	if err := os.Remove(connURL); err != nil && !errors.Is(err, os.ErrNotExist) {
-		log.Println(internal.ErrorPrefix, "Failed to remove old socket file:", err)
+	checker := newPermissionChecker(uint32(os.Geteuid()))
+	// switch to manual if pids mismatch
+	if os.Getenv(internal.ListenPID) != strconv.Itoa(os.Getpid()) {
+		connURL := internal.GetNorduserSocketFork(os.Geteuid())
+		if err := os.Remove(connURL); err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Println(internal.ErrorPrefix, "Failed to remove old socket file:", err)
+		}
+		listenerFunction = internal.ManualListener(connURL, socketPermissions(checker))
 	}
-	listenerFunction = internal.ManualListener(connURL, internal.PermUserRWX)
 
 	listener, err := listenerFunction()
 	if err != nil {
@@ -349,12 +503,23 @@ func start() {
 		os.Exit(int(childprocess.CodeFailedToEnable))
 	}
 
-	fileshareManagementChan, fileshareShutdownChan := startFileshare(uint32(uid))
+	setLogLevel := logLevelSetter(logWriter)
+	var minimal bool
+	if settings, err := fetchSettings(); err != nil {
+		log.Println(internal.ErrorPrefix, "Failed to fetch the settings:", err)
+	} else {
+		minimal = settings.GetTrayMinimal()
+		if level, err := internal.ParseLogLevel(settings.GetLogLevel()); err == nil {
+			setLogLevel(level)
+		}
+	}
+
+	fileshareManagementChan, fileshareShutdownChan := startFileshare(uint32(uid), minimal)
 
 	stopChan := make(chan norduser.StopRequest)
-	server := norduser.NewServer(fileshareManagementChan, stopChan)
+	server := norduser.NewServer(fileshareManagementChan, stopChan, Version, setLogLevel)
 
-	grpcServer := grpc.NewServer()
+	grpcServer := newGRPCServer(checker)
 	pb.RegisterNorduserServer(grpcServer, server)
 
 	go func() {
@@ -364,13 +529,14 @@ func start() {
 		}
 	}()
 
-	go startTray(stopChan)
+	go startTray(stopChan, minimal)
+	stopWidgetServer := startWidgetServer(uid, minimal)
 
 	log.Println(internal.InfoPrefix, "Norduser daemon has started")
 
 	// logoutChan is not needed in non-snap environment, as startup/shutdown on login/logout is managed by the main daemon
 	waitForShutdown(stopChan, fileshareManagementChan, fileshareShutdownChan, make(<-chan interface{}),
-		grpcServer,
+		grpcServer, stopWidgetServer,
 		func(disable bool) {})
 }
 
@@ -381,3 +547,37 @@ func main() {
 		start()
 	}
 }
+
+// newPermissionChecker loads the permission matrix which authorizes the calls of other users. If the matrix exists
+// but can't be loaded, only the owner is let in instead of falling back to the defaults.
+func newPermissionChecker(uid uint32) *permissions.Checker {
+	matrix, err := permissions.LoadMatrix(permissions.MatrixPath)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "Failed to load permission matrix, only the owner can connect:", err)
+		// status is not shared when the matrix does not list it
+		matrix = permissions.Matrix{}
+	}
+	return permissions.NewOwnerChecker(matrix, uid)
+}
+
+// socketPermissions returns the mode of the norduserd socket. The socket is opened to everyone only if the matrix
+// shares the status with other users, their calls are then authorized by the gRPC server.
+func socketPermissions(checker *permissions.Checker) os.FileMode {
+	if checker.SharesStatus() {
+		return internal.PermUserRWGroupRWOthersRW
+	}
+	return internal.PermUserRW
+}
+
+// newGRPCServer creates the gRPC server of norduserd. The calls are authorized by the permission checker: the owner
+// has full access, other users can only read the status if the matrix grants it.
+func newGRPCServer(checker *permissions.Checker) *grpc.Server {
+	middleware := grpcmiddleware.Middleware{}
+	middleware.AddStreamMiddleware(checker.StreamMiddleware)
+	middleware.AddUnaryMiddleware(checker.UnaryMiddleware)
+	return grpc.NewServer(
+		grpc.Creds(internal.NewUnixSocketCredentials(internal.NewLocalUserAuthenticator())),
+		grpc.StreamInterceptor(middleware.StreamIntercept),
+		grpc.UnaryInterceptor(middleware.UnaryIntercept),
+	)
+}
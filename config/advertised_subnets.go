@@ -0,0 +1,36 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+)
+
+// MaxAdvertisedSubnets is the highest number of subnets announced to the meshnet peers
+const MaxAdvertisedSubnets = 16
+
+var (
+	// ErrAdvertisedSubnetsOverlap is returned when the announced subnets overlap each other
+	ErrAdvertisedSubnetsOverlap = errors.New("advertised subnets must not overlap")
+	// ErrAdvertisedSubnetsCount is returned for too many subnets
+	ErrAdvertisedSubnetsCount = fmt.Errorf("at most %d subnets can be advertised", MaxAdvertisedSubnets)
+)
+
+// ValidateAdvertisedSubnets returns an error if the subnets can't be announced to the meshnet peers. The same
+// subnets can be routed through a meshnet peer by the other devices, so they have to be routable in the same way.
+func ValidateAdvertisedSubnets(subnets []netip.Prefix) error {
+	if len(subnets) > MaxAdvertisedSubnets {
+		return ErrAdvertisedSubnetsCount
+	}
+	for i, subnet := range subnets {
+		if err := ValidatePeerRouteSubnet(subnet); err != nil {
+			return err
+		}
+		for _, other := range subnets[:i] {
+			if other.Overlaps(subnet) {
+				return ErrAdvertisedSubnetsOverlap
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAdvertisedSubnets(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	prefixes := func(values ...string) []netip.Prefix {
+		var subnets []netip.Prefix
+		for _, value := range values {
+			subnets = append(subnets, netip.MustParsePrefix(value))
+		}
+		return subnets
+	}
+	var tooMany []netip.Prefix
+	for i := 0; i <= MaxAdvertisedSubnets; i++ {
+		tooMany = append(tooMany, netip.PrefixFrom(netip.AddrFrom4([4]byte{10, byte(i), 0, 0}), 16))
+	}
+
+	tests := []struct {
+		name    string
+		subnets []netip.Prefix
+		err     error
+	}{
+		{name: "no subnets"},
+		{name: "local network", subnets: prefixes("192.168.1.0/24")},
+		{name: "multiple subnets", subnets: prefixes("192.168.1.0/24", "10.0.0.0/8")},
+		{name: "overlapping subnets", subnets: prefixes("10.0.0.0/8", "10.1.0.0/16"), err: ErrAdvertisedSubnetsOverlap},
+		{name: "default route", subnets: prefixes("0.0.0.0/0"), err: ErrPeerRouteSubnet},
+		{name: "meshnet subnet", subnets: prefixes("100.100.0.0/16"), err: ErrPeerRouteSubnet},
+		{name: "ipv6", subnets: prefixes("fd00::/64"), err: ErrPeerRouteSubnet},
+		{name: "too many", subnets: tooMany, err: ErrAdvertisedSubnetsCount},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ErrorIs(t, ValidateAdvertisedSubnets(test.subnets), test.err)
+		})
+	}
+}
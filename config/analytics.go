@@ -0,0 +1,49 @@
+package config
+
+// AnalyticsCategory groups the analytics events which can be consented to separately.
+type AnalyticsCategory string
+
+const (
+	// AnalyticsCrash are the failures of the connection and other operations
+	AnalyticsCrash AnalyticsCategory = "crash"
+	// AnalyticsUsage are the settings changes and the feature usage
+	AnalyticsUsage AnalyticsCategory = "usage"
+	// AnalyticsPerformance are the timings and results of the connections and the logins
+	AnalyticsPerformance AnalyticsCategory = "performance"
+)
+
+// AnalyticsCategories lists all analytics categories.
+var AnalyticsCategories = []AnalyticsCategory{AnalyticsCrash, AnalyticsUsage, AnalyticsPerformance}
+
+// AnalyticsConsent stores the consent per analytics category. Every category is allowed by
+// default, so the analytics setting alone keeps deciding whether anything is sent.
+type AnalyticsConsent struct {
+	Crash       TrueField `json:"crash"`
+	Usage       TrueField `json:"usage"`
+	Performance TrueField `json:"performance"`
+}
+
+// Allowed reports whether the events of the given category may be sent.
+func (c AnalyticsConsent) Allowed(category AnalyticsCategory) bool {
+	switch category {
+	case AnalyticsCrash:
+		return c.Crash.Get()
+	case AnalyticsUsage:
+		return c.Usage.Get()
+	case AnalyticsPerformance:
+		return c.Performance.Get()
+	}
+	return false
+}
+
+// Set the consent for the given category. Unknown categories are ignored.
+func (c *AnalyticsConsent) Set(category AnalyticsCategory, allowed bool) {
+	switch category {
+	case AnalyticsCrash:
+		c.Crash.Set(allowed)
+	case AnalyticsUsage:
+		c.Usage.Set(allowed)
+	case AnalyticsPerformance:
+		c.Performance.Set(allowed)
+	}
+}
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyticsConsent(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var consent AnalyticsConsent
+	for _, c := range AnalyticsCategories {
+		assert.True(t, consent.Allowed(c))
+	}
+	assert.False(t, consent.Allowed("location"))
+
+	consent.Set(AnalyticsPerformance, false)
+	assert.True(t, consent.Allowed(AnalyticsCrash))
+	assert.True(t, consent.Allowed(AnalyticsUsage))
+	assert.False(t, consent.Allowed(AnalyticsPerformance))
+
+	data, err := json.Marshal(consent)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"crash":null,"usage":null,"performance":false}`, string(data))
+
+	var decoded AnalyticsConsent
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, consent, decoded)
+}
@@ -7,10 +7,14 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/internal"
 )
 
 const defaultFWMarkValue uint32 = 0xe1f1
 
+// MaxServerLoadLimit is the highest value of the server load limit, server load is given in percent
+const MaxServerLoadLimit = 100
+
 func newConfig(machineIDGetter MachineIDGetter) *Config {
 	return &Config{
 		Technology:   Technology_NORDLYNX,
@@ -19,8 +23,13 @@ func newConfig(machineIDGetter MachineIDGetter) *Config {
 		AutoConnectData: AutoConnectData{
 			Protocol: Protocol_UDP,
 		},
-		MachineID:  machineIDGetter.GetMachineID(),
-		UsersData:  &UsersData{Notify: UidBoolMap{}, NotifyOff: UidBoolMap{}, TrayOff: UidBoolMap{}},
+		MachineID: machineIDGetter.GetMachineID(),
+		UsersData: &UsersData{
+			Notify:        UidBoolMap{},
+			NotifyOff:     UidBoolMap{},
+			TrayOff:       UidBoolMap{},
+			TrayIconTheme: map[int64]TrayIconTheme{},
+		},
 		TokensData: map[int64]TokenData{},
 	}
 }
@@ -52,6 +61,67 @@ type Config struct {
 	RCLastUpdate    time.Time           `json:"rc_last_update,omitempty"`
 	// Indicates whether the virtual servers are used. True by default
 	VirtualLocation TrueField `json:"virtual_location,omitempty"`
+	// TrayMinimal limits the tray to connection controls and status, without meshnet and fileshare
+	TrayMinimal bool `json:"tray_minimal,omitempty"`
+	// TrayMenu lists the tray menu sections in display order. Empty means DefaultTrayMenu
+	TrayMenu []string `json:"tray_menu,omitempty"`
+	// LogLevel of norduserd and fileshare. Empty means internal.DefaultLogLevel
+	LogLevel internal.LogLevel `json:"log_level,omitempty"`
+	// DaemonLogLevel of nordvpnd. Empty means internal.DefaultLogLevel
+	DaemonLogLevel internal.LogLevel `json:"daemon_log_level,omitempty"`
+	// DebugComponents of nordvpnd have their debug messages logged regardless of DaemonLogLevel
+	DebugComponents []internal.LogComponent `json:"debug_components,omitempty"`
+	// TrustedNetworks are skipped by auto-connect
+	TrustedNetworks TrustedNetworks `json:"trusted_networks,omitempty"`
+	// DeferOnMetered postpones auto-connect, server list refreshes and queued file sends on metered networks
+	DeferOnMetered bool `json:"defer_on_metered,omitempty"`
+	// ObfuscationFallback connects to the obfuscated servers when the regular connection fails
+	ObfuscationFallback TrueField `json:"obfuscation_fallback,omitempty"`
+	// ObfuscatedNetworks are the keys of networks where only the obfuscated connection has succeeded
+	ObfuscatedNetworks []string `json:"obfuscated_networks,omitempty"`
+	// AutoTechnology tries the rungs of TechnologyLadder in order instead of using Technology only
+	AutoTechnology bool `json:"auto_technology,omitempty"`
+	// TechnologyRungs are the rungs which have succeeded last, keyed by the network
+	TechnologyRungs map[string]TechnologyRung `json:"technology_rungs,omitempty"`
+	// MaxServerLoad is the highest load in percent of the recommended servers, zero means no limit
+	MaxServerLoad int64 `json:"max_server_load,omitempty"`
+	// ConnectRetry controls how the failed connection attempts are retried
+	ConnectRetry ConnectRetry `json:"connect_retry,omitempty"`
+	// ConnectTimeouts override how long the technologies wait while connecting
+	ConnectTimeouts ConnectTimeouts `json:"connect_timeouts,omitempty"`
+	// AnalyticsConsent restricts the analytics to the categories the user has consented to
+	AnalyticsConsent AnalyticsConsent `json:"analytics_consent"`
+	// SplitTunnel defines which applications bypass VPN
+	SplitTunnel SplitTunnel `json:"split_tunnel,omitempty"`
+	// Favorites are the servers and locations saved by the user
+	Favorites Favorites `json:"favorites,omitempty"`
+	// Profiles are the named sets of settings saved by the user
+	Profiles Profiles `json:"profiles,omitempty"`
+	// Schedule are the rules which connect or disconnect at the given time
+	Schedule ScheduleRules `json:"schedule,omitempty"`
+	// Hooks are the executables run on the connection events
+	Hooks Hooks `json:"hooks,omitempty"`
+	// OpenVPNDirectives are appended to the OpenVPN config, see ValidateOpenVPNDirectives
+	OpenVPNDirectives []string `json:"openvpn_directives,omitempty"`
+	// InterfaceName of the VPN tunnel. Empty means the default name of the technology
+	InterfaceName string `json:"interface_name,omitempty"`
+	// PersistentKeepalive is the interval in seconds of the NordLynx keepalive packets. Zero means the default
+	// interval
+	PersistentKeepalive uint16 `json:"persistent_keepalive,omitempty"`
+	// HealthMonitor is the sensitivity of the tunnel health checks. Empty means HealthMonitorNormal
+	HealthMonitor HealthSensitivity `json:"health_monitor,omitempty"`
+	// RoutingTable is the ID of the custom routing table of the VPN routes. If it is used by other software, the
+	// next free ID is taken. Zero means the default ID
+	RoutingTable uint32 `json:"routing_table,omitempty"`
+	// RouteMetric is the metric of the VPN default routes, the lower one wins against the routes of other software.
+	// Zero means the kernel default
+	RouteMetric uint32 `json:"route_metric,omitempty"`
+	// MeshnetPeerRoutes are the subnets routed through a meshnet peer while the rest of the traffic goes through
+	// the VPN server
+	MeshnetPeerRoutes PeerRoutes `json:"meshnet_peer_routes,omitempty"`
+	// MTU of the VPN tunnel. Zero means that it is calculated from the MTU of the default gateway and lowered to the
+	// path MTU probed after connecting
+	MTU uint32 `json:"mtu,omitempty"`
 }
 
 type AutoConnectData struct {
@@ -67,6 +137,10 @@ type AutoConnectData struct {
 	DNS                  DNS       `json:"dns,omitempty"`
 	Allowlist            Allowlist `json:"whitelist,omitempty"`
 	PostquantumVpn       bool      `json:"postquantum_vpn"`
+	// OpenVPNPort overrides the port of the OpenVPN servers, zero means the default port of the protocol
+	OpenVPNPort uint16 `json:"openvpn_port,omitempty"`
+	// Stealth runs OpenVPN over TCP 443, optionally through a proxy
+	Stealth Stealth `json:"stealth,omitempty"`
 }
 
 type DNS []string
@@ -90,6 +164,18 @@ type NCData struct {
 type meshnet struct {
 	EnabledByUID uint32 `json:"enabled_by_uid"` // Linux user which enabled meshnet
 	EnabledByGID uint32 `json:"enabled_by_gid"` // Group of Linux user which enabled meshnet
+	// PeerGroups group the peers, so that the permissions can be changed for the whole group at once
+	PeerGroups PeerGroups `json:"peer_groups,omitempty"`
+	// PeerNotes describe the peers with freeform notes and tags
+	PeerNotes PeerNotes `json:"peer_notes,omitempty"`
+	// PeerBandwidthLimits cap the traffic the peers can route through this device
+	PeerBandwidthLimits PeerBandwidthLimits `json:"peer_bandwidth_limits,omitempty"`
+	// InviteAutoAccept accepts the invites from the devices of the same account automatically when set
+	InviteAutoAccept *InviteAutoAccept `json:"invite_auto_accept,omitempty"`
+	// InviteTTL is how long the sent invites stay valid, 0 means the default of the API
+	InviteTTL time.Duration `json:"invite_ttl,omitempty"`
+	// FileshareFileLimit is the maximum number of files in a single transfer, 0 means the default of fileshare
+	FileshareFileLimit uint32 `json:"fileshare_file_limit,omitempty"`
 }
 
 func (d *NCData) IsUserIDEmpty() bool {
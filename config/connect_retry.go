@@ -0,0 +1,72 @@
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// DefaultConnectAttempts keeps a single connection attempt, so the failure is reported right away
+	DefaultConnectAttempts = 1
+	// MaxConnectAttempts limits how many times the connection can be attempted
+	MaxConnectAttempts = 10
+	// MaxConnectAttemptTimeout limits how long a single connection attempt can take
+	MaxConnectAttemptTimeout = 5 * time.Minute
+	// DefaultConnectBackoff is the delay before the first retry if it is not set
+	DefaultConnectBackoff = 5 * time.Second
+	// MaxConnectBackoff limits the delay between the attempts as it is doubled after every retry
+	MaxConnectBackoff = time.Minute
+)
+
+var (
+	ErrConnectAttempts       = errors.New("connect attempts must be between 1 and 10")
+	ErrConnectAttemptTimeout = errors.New("connect attempt timeout must not be negative or longer than 5 minutes")
+	ErrConnectBackoff        = errors.New("connect backoff must not be negative or longer than 1 minute")
+)
+
+// ConnectRetry controls how the failed connection attempts are retried. Zero values mean the defaults.
+type ConnectRetry struct {
+	// Attempts is the total number of the connection attempts including the first one
+	Attempts int `json:"attempts,omitempty"`
+	// AttemptTimeout limits a single connection attempt, the attempt is limited only by the VPN itself if zero
+	AttemptTimeout time.Duration `json:"attempt_timeout,omitempty"`
+	// Backoff is the delay before the first retry, it is doubled after every next one
+	Backoff time.Duration `json:"backoff,omitempty"`
+}
+
+// Validate returns an error if the retry policy is out of limits
+func (r ConnectRetry) Validate() error {
+	if r.Attempts < 0 || r.Attempts > MaxConnectAttempts {
+		return ErrConnectAttempts
+	}
+	if r.AttemptTimeout < 0 || r.AttemptTimeout > MaxConnectAttemptTimeout {
+		return ErrConnectAttemptTimeout
+	}
+	if r.Backoff < 0 || r.Backoff > MaxConnectBackoff {
+		return ErrConnectBackoff
+	}
+	return nil
+}
+
+// AttemptsOrDefault returns the number of attempts or the default one if it is not set
+func (r ConnectRetry) AttemptsOrDefault() int {
+	if r.Attempts <= 0 {
+		return DefaultConnectAttempts
+	}
+	return r.Attempts
+}
+
+// Delay returns how long to wait before the given attempt, attempts are counted from 1
+func (r ConnectRetry) Delay(attempt int) time.Duration {
+	if attempt <= 1 {
+		return 0
+	}
+	delay := r.Backoff
+	if delay <= 0 {
+		delay = DefaultConnectBackoff
+	}
+	for i := 2; i < attempt && delay < MaxConnectBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, MaxConnectBackoff)
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectRetry_Validate(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name  string
+		retry ConnectRetry
+		err   error
+	}{
+		{name: "defaults", retry: ConnectRetry{}},
+		{name: "limits", retry: ConnectRetry{
+			Attempts:       MaxConnectAttempts,
+			AttemptTimeout: MaxConnectAttemptTimeout,
+			Backoff:        MaxConnectBackoff,
+		}},
+		{name: "too many attempts", retry: ConnectRetry{Attempts: MaxConnectAttempts + 1}, err: ErrConnectAttempts},
+		{name: "negative timeout", retry: ConnectRetry{AttemptTimeout: -time.Second}, err: ErrConnectAttemptTimeout},
+		{name: "long backoff", retry: ConnectRetry{Backoff: 2 * time.Minute}, err: ErrConnectBackoff},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ErrorIs(t, test.retry.Validate(), test.err)
+		})
+	}
+}
+
+func TestConnectRetry_Delay(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	retry := ConnectRetry{Backoff: 10 * time.Second}
+	assert.Equal(t, time.Duration(0), retry.Delay(1))
+	assert.Equal(t, 10*time.Second, retry.Delay(2))
+	assert.Equal(t, 20*time.Second, retry.Delay(3))
+	assert.Equal(t, 40*time.Second, retry.Delay(4))
+	assert.Equal(t, MaxConnectBackoff, retry.Delay(5))
+	assert.Equal(t, MaxConnectBackoff, retry.Delay(MaxConnectAttempts))
+
+	assert.Equal(t, DefaultConnectBackoff, ConnectRetry{}.Delay(2))
+	assert.Equal(t, DefaultConnectAttempts, ConnectRetry{}.AttemptsOrDefault())
+}
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// MinConnectTimeout is the shortest wait which still leaves time to reach the server
+	MinConnectTimeout = time.Second
+	// MaxConnectTimeout limits how long the technologies wait while connecting
+	MaxConnectTimeout = 5 * time.Minute
+)
+
+var ErrConnectTimeout = errors.New("connect timeouts must be between 1 second and 5 minutes")
+
+// ConnectTimeouts override how long the technologies wait while connecting. Zero values mean the defaults of the
+// technologies.
+type ConnectTimeouts struct {
+	// NordLynxHandshake limits the wait for the first handshake with the NordLynx server
+	NordLynxHandshake time.Duration `json:"nordlynx_handshake,omitempty"`
+	// OpenVPNConnect limits the wait for the OpenVPN server to respond
+	OpenVPNConnect time.Duration `json:"openvpn_connect,omitempty"`
+	// OpenVPNHandshake limits the TLS handshake with the OpenVPN server
+	OpenVPNHandshake time.Duration `json:"openvpn_handshake,omitempty"`
+}
+
+// Validate returns an error if any of the timeouts is out of limits
+func (t ConnectTimeouts) Validate() error {
+	for _, timeout := range []time.Duration{t.NordLynxHandshake, t.OpenVPNConnect, t.OpenVPNHandshake} {
+		if timeout != 0 && (timeout < MinConnectTimeout || timeout > MaxConnectTimeout) {
+			return ErrConnectTimeout
+		}
+	}
+	return nil
+}
+
+// For returns the connect and handshake timeouts of the technology
+func (t ConnectTimeouts) For(tech Technology) (connect time.Duration, handshake time.Duration) {
+	switch tech {
+	case Technology_NORDLYNX:
+		return 0, t.NordLynxHandshake
+	case Technology_OPENVPN:
+		return t.OpenVPNConnect, t.OpenVPNHandshake
+	case Technology_UNKNOWN_TECHNOLOGY, Technology_NORDWHISPER:
+	}
+	return 0, 0
+}
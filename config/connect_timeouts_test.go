@@ -0,0 +1,54 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConnectTimeouts_Validate(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		timeouts ConnectTimeouts
+		err      error
+	}{
+		{name: "defaults", timeouts: ConnectTimeouts{}},
+		{name: "limits", timeouts: ConnectTimeouts{
+			NordLynxHandshake: MinConnectTimeout,
+			OpenVPNConnect:    MaxConnectTimeout,
+			OpenVPNHandshake:  MaxConnectTimeout,
+		}},
+		{name: "too short", timeouts: ConnectTimeouts{NordLynxHandshake: time.Millisecond}, err: ErrConnectTimeout},
+		{name: "negative", timeouts: ConnectTimeouts{OpenVPNConnect: -time.Second}, err: ErrConnectTimeout},
+		{name: "too long", timeouts: ConnectTimeouts{OpenVPNHandshake: 10 * time.Minute}, err: ErrConnectTimeout},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ErrorIs(t, test.timeouts.Validate(), test.err)
+		})
+	}
+}
+
+func TestConnectTimeouts_For(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	timeouts := ConnectTimeouts{
+		NordLynxHandshake: 10 * time.Second,
+		OpenVPNConnect:    20 * time.Second,
+		OpenVPNHandshake:  30 * time.Second,
+	}
+
+	connect, handshake := timeouts.For(Technology_NORDLYNX)
+	assert.Equal(t, time.Duration(0), connect)
+	assert.Equal(t, 10*time.Second, handshake)
+
+	connect, handshake = timeouts.For(Technology_OPENVPN)
+	assert.Equal(t, 20*time.Second, connect)
+	assert.Equal(t, 30*time.Second, handshake)
+}
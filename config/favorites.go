@@ -0,0 +1,42 @@
+package config
+
+import (
+	"maps"
+	"slices"
+)
+
+// Favorite is a server or a location saved by the user. It holds the same arguments as the connect command.
+type Favorite struct {
+	ServerTag   string `json:"server_tag,omitempty"`
+	ServerGroup string `json:"server_group,omitempty"`
+}
+
+// Favorites maps the names given by the user to the favorite servers and locations
+type Favorites map[string]Favorite
+
+// With returns a copy of favorites with the favorite saved under the name
+func (f Favorites) With(name string, favorite Favorite) Favorites {
+	favorites := maps.Clone(f)
+	if favorites == nil {
+		favorites = Favorites{}
+	}
+	favorites[name] = favorite
+	return favorites
+}
+
+// Without returns a copy of favorites without the named one
+func (f Favorites) Without(name string) Favorites {
+	favorites := maps.Clone(f)
+	delete(favorites, name)
+	return favorites
+}
+
+// SortedNames returns the names of favorites in alphabetical order
+func (f Favorites) SortedNames() []string {
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,26 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFavorites_DoNotModifyOriginal(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var empty Favorites
+	favorites := empty.With("home", Favorite{ServerTag: "lt"})
+	assert.Nil(t, empty)
+	assert.Equal(t, Favorites{"home": {ServerTag: "lt"}}, favorites)
+
+	more := favorites.With("p2p", Favorite{ServerGroup: "p2p"})
+	assert.Len(t, favorites, 1)
+	assert.Equal(t, []string{"home", "p2p"}, more.SortedNames())
+
+	fewer := more.Without("home")
+	assert.Len(t, more, 2)
+	assert.Equal(t, []string{"p2p"}, fewer.SortedNames())
+}
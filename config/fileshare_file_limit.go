@@ -0,0 +1,17 @@
+package config
+
+import "errors"
+
+// MaxFileshareFileLimit is the highest number of files allowed in a single fileshare transfer
+const MaxFileshareFileLimit = 100000
+
+// ErrFileshareFileLimit is returned for the fileshare file limit out of range
+var ErrFileshareFileLimit = errors.New("fileshare file limit must be between 1 and 100000")
+
+// ValidateFileshareFileLimit returns an error if the limit is out of range. 0 means the default of fileshare.
+func ValidateFileshareFileLimit(limit uint32) error {
+	if limit > MaxFileshareFileLimit {
+		return ErrFileshareFileLimit
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateFileshareFileLimit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name  string
+		limit uint32
+		err   error
+	}{
+		{name: "default", limit: 0},
+		{name: "minimum", limit: 1},
+		{name: "maximum", limit: MaxFileshareFileLimit},
+		{name: "too many", limit: MaxFileshareFileLimit + 1, err: ErrFileshareFileLimit},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ErrorIs(t, ValidateFileshareFileLimit(test.limit), test.err)
+		})
+	}
+}
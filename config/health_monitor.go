@@ -0,0 +1,26 @@
+package config
+
+// HealthSensitivity defines how quickly the active tunnel is recovered after it stops working
+type HealthSensitivity string
+
+const (
+	// HealthMonitorOff does not check the tunnel
+	HealthMonitorOff HealthSensitivity = "off"
+	// HealthMonitorLow recovers the tunnel only after it has been failing for a long time
+	HealthMonitorLow HealthSensitivity = "low"
+	// HealthMonitorNormal recovers the tunnel after it has failed a couple of checks
+	HealthMonitorNormal HealthSensitivity = "normal"
+	// HealthMonitorHigh recovers the tunnel after the first failed check and also when the latency is high
+	HealthMonitorHigh HealthSensitivity = "high"
+)
+
+// HealthSensitivities lists the supported sensitivities
+var HealthSensitivities = []HealthSensitivity{HealthMonitorOff, HealthMonitorLow, HealthMonitorNormal, HealthMonitorHigh}
+
+// HealthMonitorOrDefault returns the default sensitivity if it is not set
+func (c Config) HealthMonitorOrDefault() HealthSensitivity {
+	if c.HealthMonitor == "" {
+		return HealthMonitorNormal
+	}
+	return c.HealthMonitor
+}
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"slices"
+	"time"
+)
+
+// HookEvent is the VPN connection event on which hook scripts are run
+type HookEvent string
+
+const (
+	// HookPreConnect is run after the server is selected and before the tunnel is started
+	HookPreConnect HookEvent = "pre-connect"
+	// HookConnect is run after the connection is established
+	HookConnect HookEvent = "connect"
+	// HookDisconnect is run after the user has disconnected
+	HookDisconnect HookEvent = "disconnect"
+	// HookReconnect is run after the tunnel which went down has been restored
+	HookReconnect HookEvent = "reconnect"
+)
+
+// HookEvents lists the supported hook events in the order they happen
+var HookEvents = []HookEvent{HookPreConnect, HookConnect, HookDisconnect, HookReconnect}
+
+// HookFailurePolicy tells what happens when a hook script fails or times out
+type HookFailurePolicy string
+
+const (
+	// HookFailureIgnore logs the failure and carries on
+	HookFailureIgnore HookFailurePolicy = "ignore"
+	// HookFailureAbort aborts the connection. It is supported only by pre-connect hooks.
+	HookFailureAbort HookFailurePolicy = "abort"
+)
+
+const (
+	// DefaultHookTimeout is used for the hooks without a timeout
+	DefaultHookTimeout = 30 * time.Second
+	// MaxHookTimeout limits how long the connection can be held by a hook
+	MaxHookTimeout = 5 * time.Minute
+)
+
+// Hook is an executable run by the daemon on the connection event
+type Hook struct {
+	Event         HookEvent         `json:"event"`
+	Path          string            `json:"path"`
+	Timeout       time.Duration     `json:"timeout,omitempty"`
+	FailurePolicy HookFailurePolicy `json:"failure_policy,omitempty"`
+}
+
+// TimeoutOrDefault returns the timeout of the hook or the default one if it is not set
+func (h Hook) TimeoutOrDefault() time.Duration {
+	if h.Timeout <= 0 {
+		return DefaultHookTimeout
+	}
+	return h.Timeout
+}
+
+// Hooks are run in the order they were added
+type Hooks []Hook
+
+// Index returns the index of the hook for the event and the path or -1 if there is none
+func (h Hooks) Index(event HookEvent, path string) int {
+	return slices.IndexFunc(h, func(hook Hook) bool {
+		return hook.Event == event && hook.Path == path
+	})
+}
+
+// With returns a copy of hooks with the hook appended
+func (h Hooks) With(hook Hook) Hooks {
+	return append(slices.Clone(h), hook)
+}
+
+// Without returns a copy of hooks without the hook for the event and the path
+func (h Hooks) Without(event HookEvent, path string) Hooks {
+	return slices.DeleteFunc(slices.Clone(h), func(hook Hook) bool {
+		return hook.Event == event && hook.Path == path
+	})
+}
+
+// For returns the hooks run on the event
+func (h Hooks) For(event HookEvent) Hooks {
+	var hooks Hooks
+	for _, hook := range h {
+		if hook.Event == event {
+			hooks = append(hooks, hook)
+		}
+	}
+	return hooks
+}
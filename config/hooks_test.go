@@ -0,0 +1,40 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHooks_DoNotModifyOriginal(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var empty Hooks
+	hooks := empty.With(Hook{Event: HookConnect, Path: "/etc/nordvpn/up.sh"})
+	assert.Nil(t, empty)
+
+	more := hooks.With(Hook{Event: HookDisconnect, Path: "/etc/nordvpn/down.sh"})
+	more = more.With(Hook{Event: HookConnect, Path: "/etc/nordvpn/mount.sh"})
+	assert.Len(t, hooks, 1)
+	assert.Equal(t, 1, more.Index(HookDisconnect, "/etc/nordvpn/down.sh"))
+	assert.Equal(t, -1, more.Index(HookConnect, "/etc/nordvpn/down.sh"))
+	assert.Equal(t, Hooks{
+		{Event: HookConnect, Path: "/etc/nordvpn/up.sh"},
+		{Event: HookConnect, Path: "/etc/nordvpn/mount.sh"},
+	}, more.For(HookConnect))
+
+	fewer := more.Without(HookConnect, "/etc/nordvpn/up.sh")
+	assert.Len(t, more, 3)
+	assert.Equal(t, -1, fewer.Index(HookConnect, "/etc/nordvpn/up.sh"))
+	assert.Len(t, fewer, 2)
+}
+
+func TestHook_TimeoutOrDefault(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, DefaultHookTimeout, Hook{}.TimeoutOrDefault())
+	assert.Equal(t, time.Second, Hook{Timeout: time.Second}.TimeoutOrDefault())
+}
@@ -0,0 +1,55 @@
+package config
+
+import (
+	"errors"
+	"strings"
+)
+
+// MaxInterfaceNameLength is the longest interface name accepted by the kernel, IFNAMSIZ without the terminating null
+const MaxInterfaceNameLength = 15
+
+// ErrInterfaceName is returned for the names which can't be given to a network interface
+var ErrInterfaceName = errors.New("interface name must be 1 to 15 characters long without spaces, '/' or ':'")
+
+// ValidateInterfaceName returns an error if the kernel would refuse to create an interface with the name. An empty
+// name is valid, it stands for the default name of the technology.
+func ValidateInterfaceName(name string) error {
+	if name == "" {
+		return nil
+	}
+	if len(name) > MaxInterfaceNameLength || name == "." || name == ".." {
+		return ErrInterfaceName
+	}
+	if strings.ContainsAny(name, "/:") {
+		return ErrInterfaceName
+	}
+	for _, r := range name {
+		if r <= ' ' || r > '~' {
+			return ErrInterfaceName
+		}
+	}
+	return nil
+}
+
+// SeparateNordLynxInterfaceName is the default name of the NordLynx VPN interface when it runs separately from the
+// meshnet interface
+const SeparateNordLynxInterfaceName = "nordlynx-vpn"
+
+// TunnelInterfaceName returns the name the VPN tunnel interface is created with, or empty if the default name of
+// the technology is used. NordLynx keeps its default name while meshnet is enabled, as meshnet runs on the same
+// interface, unless the tunnels are separate.
+func (c Config) TunnelInterfaceName() string {
+	if c.Technology != Technology_NORDLYNX {
+		return c.InterfaceName
+	}
+	if c.SeparateTunnels() {
+		if c.InterfaceName == "" {
+			return SeparateNordLynxInterfaceName
+		}
+		return c.InterfaceName
+	}
+	if c.Mesh {
+		return ""
+	}
+	return c.InterfaceName
+}
@@ -0,0 +1,81 @@
+package config
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateInterfaceName(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name  string
+		iface string
+		err   error
+	}{
+		{name: "default", iface: ""},
+		{name: "custom", iface: "vpn0"},
+		{name: "longest", iface: "abcdefghijklmno"},
+		{name: "with dash and dot", iface: "nord-vpn.1"},
+		{name: "too long", iface: "abcdefghijklmnop", err: ErrInterfaceName},
+		{name: "space", iface: "vpn 0", err: ErrInterfaceName},
+		{name: "slash", iface: "vpn/0", err: ErrInterfaceName},
+		{name: "colon", iface: "vpn:0", err: ErrInterfaceName},
+		{name: "dot", iface: ".", err: ErrInterfaceName},
+		{name: "dot dot", iface: "..", err: ErrInterfaceName},
+		{name: "non ascii", iface: "vpń", err: ErrInterfaceName},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ErrorIs(t, ValidateInterfaceName(test.iface), test.err)
+		})
+	}
+}
+
+func TestConfig_TunnelInterfaceName(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name       string
+		technology Technology
+		mesh       bool
+		expected   string
+	}{
+		{name: "nordlynx", technology: Technology_NORDLYNX, expected: "vpn0"},
+		{name: "openvpn", technology: Technology_OPENVPN, expected: "vpn0"},
+		{name: "openvpn with meshnet", technology: Technology_OPENVPN, mesh: true, expected: "vpn0"},
+		{name: "nordlynx with meshnet", technology: Technology_NORDLYNX, mesh: true, expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := Config{Technology: test.technology, Mesh: test.mesh, InterfaceName: "vpn0"}
+			assert.Equal(t, test.expected, cfg.TunnelInterfaceName())
+		})
+	}
+}
+
+func TestConfig_TunnelInterfaceName_SeparateTunnels(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	routes := PeerRoutes{{PublicKey: "peer", Subnet: netip.MustParsePrefix("192.168.1.0/24")}}
+
+	cfg := Config{Technology: Technology_NORDLYNX, Mesh: true, MeshnetPeerRoutes: routes}
+	assert.Equal(t, SeparateNordLynxInterfaceName, cfg.TunnelInterfaceName())
+
+	cfg.InterfaceName = "vpn0"
+	assert.Equal(t, "vpn0", cfg.TunnelInterfaceName())
+
+	// tunnels stay separate when meshnet is disabled
+	cfg.Mesh = false
+	cfg.InterfaceName = ""
+	assert.Equal(t, SeparateNordLynxInterfaceName, cfg.TunnelInterfaceName())
+
+	cfg.Technology = Technology_OPENVPN
+	assert.Equal(t, "", cfg.TunnelInterfaceName())
+}
@@ -0,0 +1,10 @@
+package config
+
+// InviteAutoAccept grants the permissions to the devices of the same account whose meshnet invites are accepted
+// automatically
+type InviteAutoAccept struct {
+	AllowIncomingTraffic bool `json:"allow_incoming_traffic"`
+	AllowTrafficRouting  bool `json:"allow_traffic_routing"`
+	AllowLocalNetwork    bool `json:"allow_local_network"`
+	AllowFileshare       bool `json:"allow_fileshare"`
+}
@@ -0,0 +1,25 @@
+package config
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// MinInviteTTL is the shortest time the meshnet invites stay valid, so that the invitee has a chance to notice them
+	MinInviteTTL = time.Hour
+	// MaxInviteTTL is the longest time the meshnet invites stay valid
+	MaxInviteTTL = 30 * 24 * time.Hour
+)
+
+// ErrInviteTTL is returned for the invite TTL out of range
+var ErrInviteTTL = errors.New("invite TTL must be between 1 hour and 30 days")
+
+// ValidateInviteTTL returns an error if the invites can't stay valid for the duration. 0 means the default of the
+// API.
+func ValidateInviteTTL(ttl time.Duration) error {
+	if ttl != 0 && (ttl < MinInviteTTL || ttl > MaxInviteTTL) {
+		return ErrInviteTTL
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateInviteTTL(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name string
+		ttl  time.Duration
+		err  error
+	}{
+		{name: "default", ttl: 0},
+		{name: "minimum", ttl: MinInviteTTL},
+		{name: "maximum", ttl: MaxInviteTTL},
+		{name: "too short", ttl: time.Minute, err: ErrInviteTTL},
+		{name: "too long", ttl: MaxInviteTTL + time.Second, err: ErrInviteTTL},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ErrorIs(t, ValidateInviteTTL(test.ttl), test.err)
+		})
+	}
+}
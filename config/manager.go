@@ -213,6 +213,23 @@ func (f *FilesystemConfigManager) load(c *Config) error {
 		}
 	}
 
+	// Zero firewall mark was accepted by the older versions, but it matches all of the unmarked packets
+	if ValidateFirewallMark(c.FirewallMark) != nil {
+		c.FirewallMark = defaultFWMarkValue
+	}
+	if ValidateRoutingTable(c.RoutingTable) != nil {
+		c.RoutingTable = 0
+	}
+	if ValidateMTU(c.MTU) != nil {
+		c.MTU = 0
+	}
+	if ValidateInviteTTL(c.Meshnet.InviteTTL) != nil {
+		c.Meshnet.InviteTTL = 0
+	}
+	if ValidateFileshareFileLimit(c.Meshnet.FileshareFileLimit) != nil {
+		c.Meshnet.FileshareFileLimit = 0
+	}
+
 	return nil
 }
 
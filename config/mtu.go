@@ -0,0 +1,22 @@
+package config
+
+import "fmt"
+
+const (
+	// MinMTU is the lowest MTU of the VPN tunnel, every IPv4 host has to accept the datagrams of this size
+	MinMTU = 576
+	// MaxMTU is the highest MTU of the VPN tunnel, the tunnel can't carry larger packets than Ethernet
+	MaxMTU = 1500
+)
+
+// ErrMTU is returned for the MTU out of range
+var ErrMTU = fmt.Errorf("MTU must be between %d and %d", MinMTU, MaxMTU)
+
+// ValidateMTU returns an error if the VPN tunnel can't be used with the MTU. Zero is valid, it stands for the MTU
+// detected after connecting.
+func ValidateMTU(mtu uint32) error {
+	if mtu != 0 && (mtu < MinMTU || mtu > MaxMTU) {
+		return ErrMTU
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMTU(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		mtu uint32
+		err error
+	}{
+		{mtu: 0},
+		{mtu: MinMTU},
+		{mtu: 1420},
+		{mtu: MaxMTU},
+		{mtu: 1, err: ErrMTU},
+		{mtu: MinMTU - 1, err: ErrMTU},
+		{mtu: MaxMTU + 1, err: ErrMTU},
+		{mtu: 9000, err: ErrMTU},
+	}
+
+	for _, test := range tests {
+		assert.ErrorIs(t, ValidateMTU(test.mtu), test.err, test.mtu)
+	}
+}
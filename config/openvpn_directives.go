@@ -0,0 +1,83 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MaxOpenVPNDirectives limits how many custom directives can be appended to the OpenVPN config
+const MaxOpenVPNDirectives = 16
+
+var ErrOpenVPNDirective = errors.New("invalid OpenVPN directive")
+
+// openVPNDirectiveArgs is the number of numeric arguments taken by the directives which can be appended to the
+// OpenVPN config. Only the directives tuning the existing connection are allowed, the ones running scripts,
+// reading files or changing the server, routing and authentication are rejected.
+var openVPNDirectiveArgs = map[string]struct{ min, max int }{
+	"verb":                 {1, 1},
+	"mute":                 {1, 1},
+	"mute-replay-warnings": {0, 0},
+	"sndbuf":               {1, 1},
+	"rcvbuf":               {1, 1},
+	"fast-io":              {0, 0},
+	"tcp-nodelay":          {0, 0},
+	"txqueuelen":           {1, 1},
+	"tun-mtu":              {1, 1},
+	"mssfix":               {0, 1},
+	"fragment":             {1, 1},
+	"reneg-sec":            {1, 2},
+	"replay-window":        {1, 2},
+	"explicit-exit-notify": {0, 1},
+}
+
+// ValidateOpenVPNDirectives checks that every directive is allowed and takes the expected number of non-negative
+// numeric arguments
+func ValidateOpenVPNDirectives(directives []string) error {
+	if len(directives) > MaxOpenVPNDirectives {
+		return fmt.Errorf("%w: at most %d directives can be set", ErrOpenVPNDirective, MaxOpenVPNDirectives)
+	}
+	for _, directive := range directives {
+		fields := strings.Fields(directive)
+		if len(fields) == 0 {
+			return fmt.Errorf("%w: directive is empty", ErrOpenVPNDirective)
+		}
+		name, args := strings.TrimPrefix(fields[0], "--"), fields[1:]
+		limits, ok := openVPNDirectiveArgs[name]
+		if !ok {
+			return fmt.Errorf("%w: %q is not allowed", ErrOpenVPNDirective, name)
+		}
+		if len(args) < limits.min || len(args) > limits.max {
+			return fmt.Errorf("%w: %q takes %s", ErrOpenVPNDirective, name, argumentsLabel(limits.min, limits.max))
+		}
+		for _, arg := range args {
+			if _, err := strconv.ParseUint(arg, 10, 32); err != nil {
+				return fmt.Errorf("%w: %q takes only numeric arguments, got %q", ErrOpenVPNDirective, name, arg)
+			}
+		}
+	}
+	return nil
+}
+
+// NormalizeOpenVPNDirective returns the directive as it is written to the OpenVPN config
+func NormalizeOpenVPNDirective(directive string) string {
+	fields := strings.Fields(directive)
+	if len(fields) > 0 {
+		fields[0] = strings.TrimPrefix(fields[0], "--")
+	}
+	return strings.Join(fields, " ")
+}
+
+func argumentsLabel(minArgs, maxArgs int) string {
+	switch {
+	case maxArgs == 0:
+		return "no arguments"
+	case minArgs == maxArgs && minArgs == 1:
+		return "1 argument"
+	case minArgs == maxArgs:
+		return fmt.Sprintf("%d arguments", minArgs)
+	default:
+		return fmt.Sprintf("%d to %d arguments", minArgs, maxArgs)
+	}
+}
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateOpenVPNDirectives(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name       string
+		directives []string
+		valid      bool
+	}{
+		{name: "none", valid: true},
+		{name: "tuning", directives: []string{"sndbuf 524288", "rcvbuf 524288", "verb 4", "fast-io"}, valid: true},
+		{name: "dashes", directives: []string{"--mssfix 1400"}, valid: true},
+		{name: "optional argument", directives: []string{"mssfix", "reneg-sec 3600 300"}, valid: true},
+		{name: "script", directives: []string{"up /tmp/script.sh"}},
+		{name: "route", directives: []string{"route 10.0.0.0 255.0.0.0"}},
+		{name: "missing argument", directives: []string{"sndbuf"}},
+		{name: "extra argument", directives: []string{"verb 4 5"}},
+		{name: "not a number", directives: []string{"verb high"}},
+		{name: "negative", directives: []string{"tun-mtu -1"}},
+		{name: "empty", directives: []string{" "}},
+		{name: "too many", directives: strings.Split(strings.Repeat("fast-io,", MaxOpenVPNDirectives+1), ",")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateOpenVPNDirectives(test.directives)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrOpenVPNDirective)
+			}
+		})
+	}
+}
+
+func TestNormalizeOpenVPNDirective(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, "sndbuf 524288", NormalizeOpenVPNDirective("  --sndbuf   524288 "))
+	assert.Equal(t, "fast-io", NormalizeOpenVPNDirective("fast-io"))
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+)
+
+const (
+	// MinPeerBandwidthLimit is the lowest rate in kbit/s a meshnet peer can be limited to
+	MinPeerBandwidthLimit = 64
+	// MaxPeerBandwidthLimit is the highest rate in kbit/s a meshnet peer can be limited to
+	MaxPeerBandwidthLimit = 10_000_000
+)
+
+var (
+	// ErrPeerBandwidthLimit is returned for the rates out of the allowed range
+	ErrPeerBandwidthLimit = fmt.Errorf(
+		"bandwidth limit must be from %d to %d kbit/s", MinPeerBandwidthLimit, MaxPeerBandwidthLimit,
+	)
+	// ErrPeerBandwidthLimitSet is returned when the peer already has the same limit
+	ErrPeerBandwidthLimitSet = errors.New("bandwidth limit is already set")
+)
+
+// PeerBandwidthLimits maps the public keys of the meshnet peers to the rates in kbit/s they can route traffic
+// through this device at
+type PeerBandwidthLimits map[string]uint32
+
+// ValidatePeerBandwidthLimit returns an error if the peer can't be limited to the rate. 0 means no limit.
+func ValidatePeerBandwidthLimit(limit uint32) error {
+	if limit != 0 && (limit < MinPeerBandwidthLimit || limit > MaxPeerBandwidthLimit) {
+		return ErrPeerBandwidthLimit
+	}
+	return nil
+}
+
+// SetLimit returns the limits with the limit of the peer replaced, the limit is removed when 0. The receiver is
+// not modified, as it is shared with the loaded config.
+func (l PeerBandwidthLimits) SetLimit(publicKey string, limit uint32) (PeerBandwidthLimits, error) {
+	if err := ValidatePeerBandwidthLimit(limit); err != nil {
+		return nil, err
+	}
+	if l[publicKey] == limit {
+		return nil, ErrPeerBandwidthLimitSet
+	}
+
+	limits := maps.Clone(l)
+	if limits == nil {
+		limits = PeerBandwidthLimits{}
+	}
+	if limit == 0 {
+		delete(limits, publicKey)
+	} else {
+		limits[publicKey] = limit
+	}
+	return limits, nil
+}
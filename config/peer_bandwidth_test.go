@@ -0,0 +1,35 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerBandwidthLimits_SetLimit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var limits PeerBandwidthLimits
+	limited, err := limits.SetLimit("key", 5000)
+	assert.NoError(t, err)
+	assert.Equal(t, PeerBandwidthLimits{"key": 5000}, limited)
+	assert.Nil(t, limits)
+
+	_, err = limited.SetLimit("key", 5000)
+	assert.ErrorIs(t, err, ErrPeerBandwidthLimitSet)
+
+	_, err = limited.SetLimit("key", MinPeerBandwidthLimit-1)
+	assert.ErrorIs(t, err, ErrPeerBandwidthLimit)
+
+	_, err = limited.SetLimit("key", MaxPeerBandwidthLimit+1)
+	assert.ErrorIs(t, err, ErrPeerBandwidthLimit)
+
+	unlimited, err := limited.SetLimit("key", 0)
+	assert.NoError(t, err)
+	assert.Empty(t, unlimited)
+	assert.Len(t, limited, 1)
+
+	_, err = unlimited.SetLimit("key", 0)
+	assert.ErrorIs(t, err, ErrPeerBandwidthLimitSet)
+}
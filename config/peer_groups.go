@@ -0,0 +1,100 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+const (
+	// MaxPeerGroups is the highest number of meshnet peer groups
+	MaxPeerGroups = 32
+	// MaxPeerGroupNameLength is the longest name of a meshnet peer group
+	MaxPeerGroupNameLength = 32
+)
+
+var (
+	// ErrPeerGroupName is returned for the names which can't be given to a meshnet peer group
+	ErrPeerGroupName = fmt.Errorf(
+		"group name must be 1 to %d lowercase letters, digits, '-' or '_'", MaxPeerGroupNameLength,
+	)
+	// ErrPeerGroupsCount is returned when a group is added over the limit
+	ErrPeerGroupsCount = fmt.Errorf("at most %d peer groups can be created", MaxPeerGroups)
+	// ErrPeerInGroup is returned when the peer is added to the group it is already in
+	ErrPeerInGroup = errors.New("peer is already in the group")
+	// ErrPeerNotInGroup is returned when the peer is removed from the group it is not in
+	ErrPeerNotInGroup = errors.New("peer is not in the group")
+)
+
+// PeerGroups maps the names of the meshnet peer groups to the public keys of their peers. Public keys identify the
+// peers, as they stay the same for the lifetime of the device in meshnet.
+type PeerGroups map[string][]string
+
+// ValidatePeerGroupName returns an error if the name can't be given to a peer group
+func ValidatePeerGroupName(name string) error {
+	if name == "" || len(name) > MaxPeerGroupNameLength {
+		return ErrPeerGroupName
+	}
+	for _, r := range name {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+			return ErrPeerGroupName
+		}
+	}
+	return nil
+}
+
+// Add returns the groups with the peer added to the group, the group is created if needed. The receiver is not
+// modified, as it is shared with the loaded config.
+func (g PeerGroups) Add(group string, publicKey string) (PeerGroups, error) {
+	if err := ValidatePeerGroupName(group); err != nil {
+		return nil, err
+	}
+	peers, ok := g[group]
+	if !ok && len(g) >= MaxPeerGroups {
+		return nil, ErrPeerGroupsCount
+	}
+	if slices.Contains(peers, publicKey) {
+		return nil, ErrPeerInGroup
+	}
+
+	groups := g.clone()
+	groups[group] = append(slices.Clone(peers), publicKey)
+	return groups, nil
+}
+
+// Remove returns the groups with the peer removed from the group, the group is removed together with its last
+// peer. The receiver is not modified.
+func (g PeerGroups) Remove(group string, publicKey string) (PeerGroups, error) {
+	index := slices.Index(g[group], publicKey)
+	if index == -1 {
+		return nil, ErrPeerNotInGroup
+	}
+
+	groups := g.clone()
+	peers := slices.Delete(slices.Clone(g[group]), index, index+1)
+	if len(peers) == 0 {
+		delete(groups, group)
+	} else {
+		groups[group] = peers
+	}
+	return groups, nil
+}
+
+// Names returns the sorted names of the groups
+func (g PeerGroups) Names() []string {
+	names := make([]string, 0, len(g))
+	for name := range g {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (g PeerGroups) clone() PeerGroups {
+	groups := maps.Clone(g)
+	if groups == nil {
+		groups = PeerGroups{}
+	}
+	return groups
+}
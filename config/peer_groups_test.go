@@ -0,0 +1,85 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePeerGroupName(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name  string
+		valid bool
+	}{
+		{name: "work", valid: true},
+		{name: "home-lab_2", valid: true},
+		{name: strings.Repeat("a", MaxPeerGroupNameLength), valid: true},
+		{name: ""},
+		{name: strings.Repeat("a", MaxPeerGroupNameLength+1)},
+		{name: "Work"},
+		{name: "my group"},
+		{name: "work/home"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidatePeerGroupName(test.name)
+			assert.Equal(t, test.valid, err == nil, err)
+		})
+	}
+}
+
+func TestPeerGroups_AddRemove(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var groups PeerGroups
+	groups, err := groups.Add("work", "key1")
+	assert.NoError(t, err)
+	added, err := groups.Add("work", "key2")
+	assert.NoError(t, err)
+	// receiver is left as it was
+	assert.Equal(t, PeerGroups{"work": {"key1"}}, groups)
+	assert.Equal(t, PeerGroups{"work": {"key1", "key2"}}, added)
+
+	_, err = added.Add("work", "key2")
+	assert.ErrorIs(t, err, ErrPeerInGroup)
+	_, err = added.Add("Work", "key3")
+	assert.ErrorIs(t, err, ErrPeerGroupName)
+
+	removed, err := added.Remove("work", "key1")
+	assert.NoError(t, err)
+	assert.Equal(t, PeerGroups{"work": {"key2"}}, removed)
+	assert.Equal(t, PeerGroups{"work": {"key1", "key2"}}, added)
+
+	_, err = removed.Remove("work", "key1")
+	assert.ErrorIs(t, err, ErrPeerNotInGroup)
+	_, err = removed.Remove("home", "key2")
+	assert.ErrorIs(t, err, ErrPeerNotInGroup)
+
+	// group is removed with its last peer
+	removed, err = removed.Remove("work", "key2")
+	assert.NoError(t, err)
+	assert.Empty(t, removed)
+}
+
+func TestPeerGroups_Limit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	groups := PeerGroups{}
+	for i := 0; i < MaxPeerGroups; i++ {
+		groups[fmt.Sprintf("group%d", i)] = []string{"key"}
+	}
+
+	_, err := groups.Add("another", "key")
+	assert.ErrorIs(t, err, ErrPeerGroupsCount)
+	// existing groups can still grow
+	_, err = groups.Add("group0", "key2")
+	assert.NoError(t, err)
+	assert.Len(t, groups.Names(), MaxPeerGroups)
+	assert.Equal(t, "group0", groups.Names()[0])
+}
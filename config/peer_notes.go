@@ -0,0 +1,101 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"unicode/utf8"
+)
+
+const (
+	// MaxPeerNoteLength is the longest note which can be attached to a meshnet peer
+	MaxPeerNoteLength = 256
+	// MaxPeerTags is the highest number of tags which can be attached to a meshnet peer
+	MaxPeerTags = 16
+	// MaxPeerTagLength is the longest tag which can be attached to a meshnet peer
+	MaxPeerTagLength = 32
+)
+
+var (
+	// ErrPeerNoteLength is returned for the notes which are too long
+	ErrPeerNoteLength = fmt.Errorf("note must be at most %d characters long", MaxPeerNoteLength)
+	// ErrPeerTag is returned for the tags which can't be attached to a meshnet peer
+	ErrPeerTag = fmt.Errorf(
+		"tag must be 1 to %d lowercase letters, digits, '-' or '_'", MaxPeerTagLength,
+	)
+	// ErrPeerTagsCount is returned when too many tags are attached to a meshnet peer
+	ErrPeerTagsCount = fmt.Errorf("at most %d tags can be attached to a peer", MaxPeerTags)
+)
+
+// PeerNote is a freeform description of a meshnet peer
+type PeerNote struct {
+	Note string   `json:"note,omitempty"`
+	Tags []string `json:"tags,omitempty"`
+}
+
+// PeerNotes maps the public keys of the meshnet peers to their notes
+type PeerNotes map[string]PeerNote
+
+// ValidatePeerNote returns an error if the note can't be attached to a peer
+func ValidatePeerNote(note string) error {
+	if utf8.RuneCountInString(note) > MaxPeerNoteLength {
+		return ErrPeerNoteLength
+	}
+	return nil
+}
+
+// ValidatePeerTag returns an error if the tag can't be attached to a peer
+func ValidatePeerTag(tag string) error {
+	if tag == "" || len(tag) > MaxPeerTagLength {
+		return ErrPeerTag
+	}
+	for _, r := range tag {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+			return ErrPeerTag
+		}
+	}
+	return nil
+}
+
+// SetNote returns the notes with the note of the peer replaced. The receiver is not modified, as it is shared
+// with the loaded config.
+func (n PeerNotes) SetNote(publicKey string, note string) (PeerNotes, error) {
+	if err := ValidatePeerNote(note); err != nil {
+		return nil, err
+	}
+	peerNote := n[publicKey]
+	peerNote.Note = note
+	return n.with(publicKey, peerNote), nil
+}
+
+// SetTags returns the notes with the tags of the peer replaced. Duplicate tags are dropped and the rest are
+// sorted. The receiver is not modified.
+func (n PeerNotes) SetTags(publicKey string, tags []string) (PeerNotes, error) {
+	for _, tag := range tags {
+		if err := ValidatePeerTag(tag); err != nil {
+			return nil, err
+		}
+	}
+	tags = slices.Clone(tags)
+	slices.Sort(tags)
+	tags = slices.Compact(tags)
+	if len(tags) > MaxPeerTags {
+		return nil, ErrPeerTagsCount
+	}
+	peerNote := n[publicKey]
+	peerNote.Tags = tags
+	return n.with(publicKey, peerNote), nil
+}
+
+func (n PeerNotes) with(publicKey string, peerNote PeerNote) PeerNotes {
+	notes := maps.Clone(n)
+	if notes == nil {
+		notes = PeerNotes{}
+	}
+	if peerNote.Note == "" && len(peerNote.Tags) == 0 {
+		delete(notes, publicKey)
+	} else {
+		notes[publicKey] = peerNote
+	}
+	return notes
+}
@@ -0,0 +1,79 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePeerTag(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		tag   string
+		valid bool
+	}{
+		{tag: "nas", valid: true},
+		{tag: "office-2_floor", valid: true},
+		{tag: strings.Repeat("a", MaxPeerTagLength), valid: true},
+		{tag: ""},
+		{tag: strings.Repeat("a", MaxPeerTagLength+1)},
+		{tag: "NAS"},
+		{tag: "home lab"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tag, func(t *testing.T) {
+			err := ValidatePeerTag(test.tag)
+			assert.Equal(t, test.valid, err == nil, err)
+		})
+	}
+}
+
+func TestPeerNotes_SetNote(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var notes PeerNotes
+	withNote, err := notes.SetNote("key", "this is the office NAS")
+	assert.NoError(t, err)
+	assert.Equal(t, PeerNotes{"key": {Note: "this is the office NAS"}}, withNote)
+	assert.Nil(t, notes)
+
+	_, err = withNote.SetNote("key", strings.Repeat("ą", MaxPeerNoteLength+1))
+	assert.ErrorIs(t, err, ErrPeerNoteLength)
+
+	withNote, err = withNote.SetNote("key", strings.Repeat("ą", MaxPeerNoteLength))
+	assert.NoError(t, err)
+
+	cleared, err := withNote.SetNote("key", "")
+	assert.NoError(t, err)
+	assert.Empty(t, cleared)
+	assert.Len(t, withNote, 1)
+}
+
+func TestPeerNotes_SetTags(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	notes := PeerNotes{"key": {Note: "printer"}}
+	tagged, err := notes.SetTags("key", []string{"office", "lan", "office"})
+	assert.NoError(t, err)
+	assert.Equal(t, PeerNote{Note: "printer", Tags: []string{"lan", "office"}}, tagged["key"])
+	assert.Equal(t, PeerNote{Note: "printer"}, notes["key"])
+
+	_, err = tagged.SetTags("key", []string{"Office"})
+	assert.ErrorIs(t, err, ErrPeerTag)
+
+	tooMany := make([]string, 0, MaxPeerTags+1)
+	for i := 0; i <= MaxPeerTags; i++ {
+		tooMany = append(tooMany, fmt.Sprintf("tag%d", i))
+	}
+	_, err = tagged.SetTags("key", tooMany)
+	assert.ErrorIs(t, err, ErrPeerTagsCount)
+
+	untagged, err := tagged.SetTags("key", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, PeerNotes{"key": {Note: "printer"}}, untagged)
+}
@@ -0,0 +1,93 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+)
+
+// MaxPeerRoutes is the highest number of subnets routed through a meshnet peer
+const MaxPeerRoutes = 16
+
+var (
+	// ErrPeerRouteSubnet is returned for the subnets which can't be routed through a meshnet peer
+	ErrPeerRouteSubnet = errors.New(
+		"subnet must be a valid IPv4 network other than the default route and the meshnet network",
+	)
+	// ErrPeerRoutesPeer is returned when the subnets are routed through more than one peer, as the meshnet tunnel
+	// has a single exit slot
+	ErrPeerRoutesPeer = errors.New("subnets can be routed through a single meshnet peer only")
+	// ErrPeerRoutesCount is returned for too many subnets
+	ErrPeerRoutesCount = fmt.Errorf("at most %d subnets can be routed through a meshnet peer", MaxPeerRoutes)
+
+	meshnetSubnet = netip.MustParsePrefix("100.64.0.0/10")
+)
+
+// PeerRoute routes the traffic of the subnet through the meshnet peer instead of the VPN server
+type PeerRoute struct {
+	PublicKey string       `json:"public_key"`
+	Subnet    netip.Prefix `json:"subnet"`
+}
+
+// PeerRoutes are the subnets routed through a meshnet peer, e.g. the LAN of the peer, while the rest of the traffic
+// goes through the VPN server
+type PeerRoutes []PeerRoute
+
+// Validate returns an error if the routes can't be set up
+func (r PeerRoutes) Validate() error {
+	if len(r) > MaxPeerRoutes {
+		return ErrPeerRoutesCount
+	}
+	for i, route := range r {
+		if route.PublicKey != r[0].PublicKey {
+			return ErrPeerRoutesPeer
+		}
+		if err := ValidatePeerRouteSubnet(route.Subnet); err != nil {
+			return err
+		}
+		for _, other := range r[:i] {
+			if other.Subnet.Overlaps(route.Subnet) {
+				return fmt.Errorf("subnets %s and %s overlap", other.Subnet, route.Subnet)
+			}
+		}
+	}
+	return nil
+}
+
+// Peer returns the public key of the peer the subnets are routed through, or empty if there are no routes
+func (r PeerRoutes) Peer() string {
+	if len(r) == 0 {
+		return ""
+	}
+	return r[0].PublicKey
+}
+
+// Subnets returns the routed subnets
+func (r PeerRoutes) Subnets() []netip.Prefix {
+	var subnets []netip.Prefix
+	for _, route := range r {
+		subnets = append(subnets, route.Subnet)
+	}
+	return subnets
+}
+
+// ValidatePeerRouteSubnet returns an error if the subnet can't be routed through a meshnet peer. The whole traffic
+// is routed through a peer by connecting to it instead.
+func ValidatePeerRouteSubnet(subnet netip.Prefix) error {
+	switch {
+	case !subnet.IsValid() || !subnet.Addr().Is4():
+		return ErrPeerRouteSubnet
+	case subnet.Bits() == 0:
+		return ErrPeerRouteSubnet
+	case subnet.Overlaps(meshnetSubnet):
+		return ErrPeerRouteSubnet
+	}
+	return nil
+}
+
+// SeparateTunnels reports if NordLynx VPN has to run on its own interface, so that the meshnet tunnel can route the
+// subnets through a peer at the same time. It doesn't depend on meshnet being enabled, so that enabling it doesn't
+// require moving the VPN connection to another interface.
+func (c Config) SeparateTunnels() bool {
+	return len(c.MeshnetPeerRoutes) > 0
+}
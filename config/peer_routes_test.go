@@ -0,0 +1,77 @@
+package config
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerRoutes_Validate(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	route := func(peer string, subnet string) PeerRoute {
+		return PeerRoute{PublicKey: peer, Subnet: netip.MustParsePrefix(subnet)}
+	}
+	tooMany := PeerRoutes{}
+	for i := 0; i <= MaxPeerRoutes; i++ {
+		tooMany = append(tooMany, route("peer", netip.PrefixFrom(netip.AddrFrom4([4]byte{10, byte(i), 0, 0}), 16).String()))
+	}
+
+	tests := []struct {
+		name   string
+		routes PeerRoutes
+		err    error
+	}{
+		{name: "no routes"},
+		{name: "single subnet", routes: PeerRoutes{route("peer", "192.168.1.0/24")}},
+		{
+			name:   "multiple subnets",
+			routes: PeerRoutes{route("peer", "192.168.1.0/24"), route("peer", "10.0.0.0/8")},
+		},
+		{
+			name:   "multiple peers",
+			routes: PeerRoutes{route("peer", "192.168.1.0/24"), route("other", "10.0.0.0/8")},
+			err:    ErrPeerRoutesPeer,
+		},
+		{name: "default route", routes: PeerRoutes{route("peer", "0.0.0.0/0")}, err: ErrPeerRouteSubnet},
+		{name: "meshnet subnet", routes: PeerRoutes{route("peer", "100.100.0.0/16")}, err: ErrPeerRouteSubnet},
+		{name: "IPv6 subnet", routes: PeerRoutes{route("peer", "fd00::/64")}, err: ErrPeerRouteSubnet},
+		{name: "too many subnets", routes: tooMany, err: ErrPeerRoutesCount},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ErrorIs(t, test.routes.Validate(), test.err)
+		})
+	}
+}
+
+func TestPeerRoutes_ValidateOverlap(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	routes := PeerRoutes{
+		{PublicKey: "peer", Subnet: netip.MustParsePrefix("192.168.0.0/16")},
+		{PublicKey: "peer", Subnet: netip.MustParsePrefix("192.168.1.0/24")},
+	}
+	assert.Error(t, routes.Validate())
+}
+
+func TestPeerRoutes_PeerAndSubnets(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, "", PeerRoutes{}.Peer())
+	assert.Empty(t, PeerRoutes{}.Subnets())
+
+	routes := PeerRoutes{
+		{PublicKey: "peer", Subnet: netip.MustParsePrefix("192.168.1.0/24")},
+		{PublicKey: "peer", Subnet: netip.MustParsePrefix("10.0.0.0/8")},
+	}
+	assert.Equal(t, "peer", routes.Peer())
+	assert.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("192.168.1.0/24"),
+		netip.MustParsePrefix("10.0.0.0/8"),
+	}, routes.Subnets())
+}
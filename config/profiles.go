@@ -0,0 +1,63 @@
+package config
+
+import (
+	"maps"
+	"slices"
+)
+
+// Profile is a named set of settings which can be applied at once
+type Profile struct {
+	Protocol  Protocol  `json:"protocol,omitempty"`
+	DNS       DNS       `json:"dns,omitempty"`
+	Allowlist Allowlist `json:"allowlist"`
+	// AutoConnect is true if auto-connect is enabled by the profile
+	AutoConnect bool `json:"auto_connect,omitempty"`
+	// AutoConnectServerTag is the auto-connect target, empty for the recommended server
+	AutoConnectServerTag string `json:"auto_connect_server_tag,omitempty"`
+}
+
+// NewProfile takes a snapshot of the current settings
+func NewProfile(cfg Config) Profile {
+	allowlist := cfg.AutoConnectData.Allowlist
+	return Profile{
+		Protocol: cfg.AutoConnectData.Protocol,
+		DNS:      slices.Clone(cfg.AutoConnectData.DNS),
+		Allowlist: NewAllowlist(
+			allowlist.GetUDPPorts(),
+			allowlist.GetTCPPorts(),
+			allowlist.GetSubnets(),
+		),
+		AutoConnect:          cfg.AutoConnect,
+		AutoConnectServerTag: cfg.AutoConnectData.ServerTag,
+	}
+}
+
+// Profiles maps the names given by the user to the profiles
+type Profiles map[string]Profile
+
+// With returns a copy of profiles with the profile saved under the name
+func (p Profiles) With(name string, profile Profile) Profiles {
+	profiles := maps.Clone(p)
+	if profiles == nil {
+		profiles = Profiles{}
+	}
+	profiles[name] = profile
+	return profiles
+}
+
+// Without returns a copy of profiles without the named one
+func (p Profiles) Without(name string) Profiles {
+	profiles := maps.Clone(p)
+	delete(profiles, name)
+	return profiles
+}
+
+// SortedNames returns the names of profiles in alphabetical order
+func (p Profiles) SortedNames() []string {
+	names := make([]string, 0, len(p))
+	for name := range p {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
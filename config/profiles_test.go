@@ -0,0 +1,52 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewProfile_CopiesSettings(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cfg := Config{
+		AutoConnect: true,
+		AutoConnectData: AutoConnectData{
+			ServerTag: "germany",
+			Protocol:  Protocol_TCP,
+			DNS:       DNS{"1.1.1.1"},
+			Allowlist: NewAllowlist([]int64{53}, []int64{22}, []string{"10.0.0.0/8"}),
+		},
+	}
+	profile := NewProfile(cfg)
+
+	cfg.AutoConnectData.DNS[0] = "8.8.8.8"
+	cfg.AutoConnectData.Allowlist.UpdateSubnets("192.168.0.0/16", false)
+
+	assert.Equal(t, Profile{
+		Protocol:             Protocol_TCP,
+		DNS:                  DNS{"1.1.1.1"},
+		Allowlist:            NewAllowlist([]int64{53}, []int64{22}, []string{"10.0.0.0/8"}),
+		AutoConnect:          true,
+		AutoConnectServerTag: "germany",
+	}, profile)
+}
+
+func TestProfiles_DoNotModifyOriginal(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var empty Profiles
+	profiles := empty.With("work", Profile{Protocol: Protocol_TCP})
+	assert.Nil(t, empty)
+	assert.Equal(t, Profiles{"work": {Protocol: Protocol_TCP}}, profiles)
+
+	more := profiles.With("streaming", Profile{AutoConnect: true})
+	assert.Len(t, profiles, 1)
+	assert.Equal(t, []string{"streaming", "work"}, more.SortedNames())
+
+	fewer := more.Without("work")
+	assert.Len(t, more, 2)
+	assert.Equal(t, []string{"streaming"}, fewer.SortedNames())
+}
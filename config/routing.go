@@ -0,0 +1,41 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+)
+
+// MaxRoutingTable is the highest ID of the custom routing table used for the VPN routes
+const MaxRoutingTable = 60000
+
+var (
+	// ErrFirewallMark is returned for the zero mark, which is the mark of the unmarked packets
+	ErrFirewallMark = errors.New("firewall mark must not be zero")
+	// ErrRoutingTable is returned for the routing table IDs out of range or reserved by the kernel
+	ErrRoutingTable = fmt.Errorf(
+		"routing table must be between 1 and %d and not one of the reserved tables 253, 254 and 255",
+		MaxRoutingTable,
+	)
+)
+
+// ValidateFirewallMark returns an error if the mark can't tell the packets of the daemon apart
+func ValidateFirewallMark(mark uint32) error {
+	if mark == 0 {
+		return ErrFirewallMark
+	}
+	return nil
+}
+
+// ValidateRoutingTable returns an error if the routing table ID can't be used for the VPN routes. Zero is valid, it
+// stands for the default table.
+func ValidateRoutingTable(id uint32) error {
+	switch {
+	case id == 0:
+		return nil
+	case id > MaxRoutingTable:
+		return ErrRoutingTable
+	case id >= 253 && id <= 255: // default, main and local tables
+		return ErrRoutingTable
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateFirewallMark(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.NoError(t, ValidateFirewallMark(defaultFWMarkValue))
+	assert.ErrorIs(t, ValidateFirewallMark(0), ErrFirewallMark)
+}
+
+func TestValidateRoutingTable(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		id  uint32
+		err error
+	}{
+		{id: 0},
+		{id: 1},
+		{id: 52},
+		{id: 205},
+		{id: 252},
+		{id: 253, err: ErrRoutingTable},
+		{id: 254, err: ErrRoutingTable},
+		{id: 255, err: ErrRoutingTable},
+		{id: 256},
+		{id: MaxRoutingTable},
+		{id: MaxRoutingTable + 1, err: ErrRoutingTable},
+	}
+
+	for _, test := range tests {
+		assert.ErrorIs(t, ValidateRoutingTable(test.id), test.err, test.id)
+	}
+}
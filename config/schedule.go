@@ -0,0 +1,205 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ScheduleAction is what the schedule rule does when it is due
+type ScheduleAction string
+
+const (
+	ScheduleConnect    ScheduleAction = "connect"
+	ScheduleDisconnect ScheduleAction = "disconnect"
+)
+
+var (
+	ErrScheduleAction = errors.New("schedule action must be connect or disconnect")
+	ErrScheduleTime   = errors.New("schedule time must be given as HH:MM")
+	ErrScheduleDays   = errors.New("schedule days are invalid")
+)
+
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// ScheduleRule connects or disconnects at the given time of the day on the given days of the week. Server tag and
+// group are the same as the connect command arguments and are used only by the connect rules.
+type ScheduleRule struct {
+	Action ScheduleAction `json:"action"`
+	// At is the local time of the day formatted as HH:MM
+	At string `json:"at"`
+	// Days are the days of the week when the rule is due, rule is due every day if empty
+	Days        []time.Weekday `json:"days,omitempty"`
+	ServerTag   string         `json:"server_tag,omitempty"`
+	ServerGroup string         `json:"server_group,omitempty"`
+}
+
+// Validate returns an error if the rule can't be scheduled
+func (r ScheduleRule) Validate() error {
+	if r.Action != ScheduleConnect && r.Action != ScheduleDisconnect {
+		return ErrScheduleAction
+	}
+	if _, _, err := ParseScheduleTime(r.At); err != nil {
+		return err
+	}
+	for _, day := range r.Days {
+		if day < time.Sunday || day > time.Saturday {
+			return ErrScheduleDays
+		}
+	}
+	return nil
+}
+
+// onDay reports whether the rule is due on the given day of the week
+func (r ScheduleRule) onDay(day time.Weekday) bool {
+	return len(r.Days) == 0 || slices.Contains(r.Days, day)
+}
+
+// Due returns the latest time in (from, to] when the rule was due
+func (r ScheduleRule) Due(from, to time.Time) (time.Time, bool) {
+	hour, minute, err := ParseScheduleTime(r.At)
+	if err != nil || !to.After(from) {
+		return time.Time{}, false
+	}
+
+	// at most one trigger per day, so checking the days backwards from the end finds the latest one
+	day := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, to.Location())
+	for !day.AddDate(0, 0, 1).Before(from) {
+		due := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, to.Location())
+		if due.After(from) && !due.After(to) && r.onDay(due.Weekday()) {
+			return due, true
+		}
+		day = day.AddDate(0, 0, -1)
+	}
+	return time.Time{}, false
+}
+
+// Overlaps reports whether both rules are due at the same time at least on one day
+func (r ScheduleRule) Overlaps(other ScheduleRule) bool {
+	if r.At != other.At {
+		return false
+	}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if r.onDay(day) && other.onDay(day) {
+			return true
+		}
+	}
+	return false
+}
+
+// ScheduleRules maps the names given by the user to the schedule rules
+type ScheduleRules map[string]ScheduleRule
+
+// With returns a copy of rules with the rule saved under the name
+func (s ScheduleRules) With(name string, rule ScheduleRule) ScheduleRules {
+	rules := maps.Clone(s)
+	if rules == nil {
+		rules = ScheduleRules{}
+	}
+	rules[name] = rule
+	return rules
+}
+
+// Without returns a copy of rules without the named one
+func (s ScheduleRules) Without(name string) ScheduleRules {
+	rules := maps.Clone(s)
+	delete(rules, name)
+	return rules
+}
+
+// SortedNames returns the names of rules in alphabetical order
+func (s ScheduleRules) SortedNames() []string {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// ParseScheduleTime parses the time of the day formatted as HH:MM
+func ParseScheduleTime(at string) (int, int, error) {
+	t, err := time.Parse("15:04", at)
+	if err != nil {
+		return 0, 0, ErrScheduleTime
+	}
+	return t.Hour(), t.Minute(), nil
+}
+
+// ParseScheduleDays parses the days of the week. Empty string, "daily", "weekdays" and "weekends" are accepted as
+// well as comma separated days and ranges, e.g. "mon-fri" or "mon,wed,fri". Nil is returned for every day.
+func ParseScheduleDays(days string) ([]time.Weekday, error) {
+	days = strings.ToLower(strings.TrimSpace(days))
+	switch days {
+	case "", "daily":
+		return nil, nil
+	case "weekdays":
+		return []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}, nil
+	case "weekends":
+		return []time.Weekday{time.Sunday, time.Saturday}, nil
+	}
+
+	var result []time.Weekday
+	for _, item := range strings.Split(days, ",") {
+		first, last, isRange := strings.Cut(strings.TrimSpace(item), "-")
+		start, ok := parseWeekday(first)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrScheduleDays, item)
+		}
+		end := start
+		if isRange {
+			if end, ok = parseWeekday(last); !ok {
+				return nil, fmt.Errorf("%w: %s", ErrScheduleDays, item)
+			}
+		}
+		// ranges can wrap around the week, e.g. fri-mon
+		for day := start; ; day = (day + 1) % 7 {
+			if !slices.Contains(result, day) {
+				result = append(result, day)
+			}
+			if day == end {
+				break
+			}
+		}
+	}
+	slices.Sort(result)
+	if len(result) == 7 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+func parseWeekday(name string) (time.Weekday, bool) {
+	name = strings.TrimSpace(name)
+	if len(name) < 3 {
+		return 0, false
+	}
+	day, ok := weekdayNames[name[:3]]
+	if !ok || !strings.HasPrefix(strings.ToLower(day.String()), name) {
+		return 0, false
+	}
+	return day, true
+}
+
+// FormatScheduleDays describes the days of the week the way they are accepted by ParseScheduleDays
+func FormatScheduleDays(days []time.Weekday) string {
+	if len(days) == 0 {
+		return "daily"
+	}
+	names := make([]string, 0, len(days))
+	for _, day := range days {
+		names = append(names, strings.ToLower(day.String()[:3]))
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,132 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseScheduleDays(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	tests := []struct {
+		days     string
+		expected []time.Weekday
+		err      bool
+	}{
+		{days: "", expected: nil},
+		{days: "daily", expected: nil},
+		{days: "weekdays", expected: weekdays},
+		{days: "Mon-Fri", expected: weekdays},
+		{days: "weekends", expected: []time.Weekday{time.Sunday, time.Saturday}},
+		{days: "monday,wed, fri", expected: []time.Weekday{time.Monday, time.Wednesday, time.Friday}},
+		{days: "fri-mon", expected: []time.Weekday{time.Sunday, time.Monday, time.Friday, time.Saturday}},
+		{days: "mon-sun", expected: nil},
+		{days: "mo", err: true},
+		{days: "mon-funday", err: true},
+		{days: "monday-", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.days, func(t *testing.T) {
+			days, err := ParseScheduleDays(test.days)
+			if test.err {
+				assert.ErrorIs(t, err, ErrScheduleDays)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, days)
+		})
+	}
+}
+
+func TestScheduleRule_Due(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	// 2024-01-05 is Friday
+	friday := func(hour, minute int) time.Time { return time.Date(2024, 1, 5, hour, minute, 0, 0, time.UTC) }
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	tests := []struct {
+		name     string
+		rule     ScheduleRule
+		from     time.Time
+		to       time.Time
+		expected time.Time
+		due      bool
+	}{
+		{
+			name:     "due within the interval",
+			rule:     ScheduleRule{At: "09:00"},
+			from:     friday(8, 59),
+			to:       friday(9, 0),
+			expected: friday(9, 0),
+			due:      true,
+		},
+		{
+			name: "due at the start of the interval was applied already",
+			rule: ScheduleRule{At: "09:00"},
+			from: friday(9, 0),
+			to:   friday(9, 1),
+		},
+		{
+			name:     "due on the listed day",
+			rule:     ScheduleRule{At: "18:00", Days: weekdays},
+			from:     friday(17, 59),
+			to:       friday(18, 1),
+			expected: friday(18, 0),
+			due:      true,
+		},
+		{
+			name: "not due on other days",
+			rule: ScheduleRule{At: "18:00", Days: []time.Weekday{time.Saturday}},
+			from: friday(17, 59),
+			to:   friday(18, 1),
+		},
+		{
+			name:     "due before midnight",
+			rule:     ScheduleRule{At: "23:59"},
+			from:     friday(23, 58),
+			to:       friday(24, 1),
+			expected: friday(23, 59),
+			due:      true,
+		},
+		{
+			name: "invalid time",
+			rule: ScheduleRule{At: "9am"},
+			from: friday(8, 0),
+			to:   friday(10, 0),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			at, due := test.rule.Due(test.from, test.to)
+			assert.Equal(t, test.due, due)
+			assert.Equal(t, test.expected, at)
+		})
+	}
+}
+
+func TestScheduleRule_Overlaps(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	weekdays := ScheduleRule{At: "09:00", Days: []time.Weekday{time.Monday, time.Friday}}
+	assert.True(t, weekdays.Overlaps(ScheduleRule{At: "09:00"}))
+	assert.True(t, weekdays.Overlaps(ScheduleRule{At: "09:00", Days: []time.Weekday{time.Friday}}))
+	assert.False(t, weekdays.Overlaps(ScheduleRule{At: "09:00", Days: []time.Weekday{time.Saturday}}))
+	assert.False(t, weekdays.Overlaps(ScheduleRule{At: "09:01"}))
+}
+
+func TestScheduleRule_Validate(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.NoError(t, ScheduleRule{Action: ScheduleConnect, At: "09:00"}.Validate())
+	assert.ErrorIs(t, ScheduleRule{Action: "reconnect", At: "09:00"}.Validate(), ErrScheduleAction)
+	assert.ErrorIs(t, ScheduleRule{Action: ScheduleDisconnect, At: "25:00"}.Validate(), ErrScheduleTime)
+	assert.ErrorIs(t, ScheduleRule{Action: ScheduleDisconnect, At: "18:00", Days: []time.Weekday{7}}.Validate(),
+		ErrScheduleDays)
+}
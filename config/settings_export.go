@@ -0,0 +1,338 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SettingsExportVersion is the version of the exported settings document. It is increased whenever the document
+// changes in a way which older versions cannot import.
+const SettingsExportVersion = 1
+
+var (
+	// ErrSettingsVersion is returned when the document was exported by a newer version of the app
+	ErrSettingsVersion = errors.New("unsupported settings version")
+	// ErrInvalidSettings is returned when the document contains invalid values
+	ErrInvalidSettings = errors.New("invalid settings")
+)
+
+// SettingsExport holds the user facing settings which can be moved to another machine. Tokens, meshnet keys, machine
+// identifiers, analytics consent and hooks are not exported.
+type SettingsExport struct {
+	Version              int               `json:"version"`
+	Technology           string            `json:"technology"`
+	AutoTechnology       bool              `json:"auto_technology,omitempty"`
+	Protocol             string            `json:"protocol"`
+	OpenVPNPort          uint16            `json:"openvpn_port,omitempty"`
+	OpenVPNDirectives    []string          `json:"openvpn_directives,omitempty"`
+	Obfuscate            bool              `json:"obfuscate"`
+	Stealth              Stealth           `json:"stealth"`
+	InterfaceName        string            `json:"interface_name,omitempty"`
+	PersistentKeepalive  uint16            `json:"persistent_keepalive,omitempty"`
+	MTU                  uint32            `json:"mtu,omitempty"`
+	HealthMonitor        HealthSensitivity `json:"health_monitor,omitempty"`
+	PostquantumVPN       bool              `json:"postquantum_vpn"`
+	Firewall             bool              `json:"firewall"`
+	FirewallMark         uint32            `json:"fwmark"`
+	RoutingTable         uint32            `json:"routing_table,omitempty"`
+	RouteMetric          uint32            `json:"route_metric,omitempty"`
+	Routing              bool              `json:"routing"`
+	KillSwitch           bool              `json:"kill_switch"`
+	AutoConnect          bool              `json:"auto_connect"`
+	AutoConnectServerTag string            `json:"auto_connect_server_tag,omitempty"`
+	ThreatProtectionLite bool              `json:"threat_protection_lite"`
+	DNS                  DNS               `json:"dns,omitempty"`
+	Allowlist            AllowlistExport   `json:"allowlist"`
+	IPv6                 bool              `json:"ipv6"`
+	LANDiscovery         bool              `json:"lan_discovery"`
+	VirtualLocation      bool              `json:"virtual_location"`
+	TrayMinimal          bool              `json:"tray_minimal"`
+	TrayMenu             []string          `json:"tray_menu,omitempty"`
+	LogLevel             internal.LogLevel `json:"log_level,omitempty"`
+	TrustedNetworks      TrustedNetworks   `json:"trusted_networks"`
+	DeferOnMetered       bool              `json:"defer_on_metered"`
+	ObfuscationFallback  bool              `json:"obfuscation_fallback"`
+	MaxServerLoad        int64             `json:"max_server_load,omitempty"`
+	ConnectRetry         ConnectRetry      `json:"connect_retry"`
+	ConnectTimeouts      ConnectTimeouts   `json:"connect_timeouts"`
+	SplitTunnel          SplitTunnel       `json:"split_tunnel"`
+	Favorites            Favorites         `json:"favorites,omitempty"`
+	Profiles             Profiles          `json:"profiles,omitempty"`
+	Schedule             ScheduleRules     `json:"schedule,omitempty"`
+}
+
+// AllowlistExport lists the allowlisted ports and subnets in a stable order
+type AllowlistExport struct {
+	UDPPorts []int64  `json:"udp_ports"`
+	TCPPorts []int64  `json:"tcp_ports"`
+	Subnets  []string `json:"subnets"`
+}
+
+// NewSettingsExport takes the exported settings from the config
+func NewSettingsExport(cfg Config) SettingsExport {
+	allowlist := cfg.AutoConnectData.Allowlist
+	udpPorts := allowlist.GetUDPPorts()
+	tcpPorts := allowlist.GetTCPPorts()
+	subnets := allowlist.GetSubnets()
+	slices.Sort(udpPorts)
+	slices.Sort(tcpPorts)
+	slices.Sort(subnets)
+
+	return SettingsExport{
+		Version:              SettingsExportVersion,
+		Technology:           cfg.Technology.String(),
+		AutoTechnology:       cfg.AutoTechnology,
+		Protocol:             cfg.AutoConnectData.Protocol.String(),
+		OpenVPNPort:          cfg.AutoConnectData.OpenVPNPort,
+		OpenVPNDirectives:    cfg.OpenVPNDirectives,
+		Obfuscate:            cfg.AutoConnectData.Obfuscate,
+		Stealth:              cfg.AutoConnectData.Stealth,
+		InterfaceName:        cfg.InterfaceName,
+		PersistentKeepalive:  cfg.PersistentKeepalive,
+		MTU:                  cfg.MTU,
+		HealthMonitor:        cfg.HealthMonitor,
+		PostquantumVPN:       cfg.AutoConnectData.PostquantumVpn,
+		Firewall:             cfg.Firewall,
+		FirewallMark:         cfg.FirewallMark,
+		RoutingTable:         cfg.RoutingTable,
+		RouteMetric:          cfg.RouteMetric,
+		Routing:              cfg.Routing.Get(),
+		KillSwitch:           cfg.KillSwitch,
+		AutoConnect:          cfg.AutoConnect,
+		AutoConnectServerTag: cfg.AutoConnectData.ServerTag,
+		ThreatProtectionLite: cfg.AutoConnectData.ThreatProtectionLite,
+		DNS:                  cfg.AutoConnectData.DNS,
+		Allowlist:            AllowlistExport{UDPPorts: udpPorts, TCPPorts: tcpPorts, Subnets: subnets},
+		IPv6:                 cfg.IPv6,
+		LANDiscovery:         cfg.LanDiscovery,
+		VirtualLocation:      cfg.VirtualLocation.Get(),
+		TrayMinimal:          cfg.TrayMinimal,
+		TrayMenu:             cfg.TrayMenu,
+		LogLevel:             cfg.LogLevel,
+		TrustedNetworks:      cfg.TrustedNetworks,
+		DeferOnMetered:       cfg.DeferOnMetered,
+		ObfuscationFallback:  cfg.ObfuscationFallback.Get(),
+		MaxServerLoad:        cfg.MaxServerLoad,
+		ConnectRetry:         cfg.ConnectRetry,
+		ConnectTimeouts:      cfg.ConnectTimeouts,
+		SplitTunnel:          cfg.SplitTunnel,
+		Favorites:            cfg.Favorites,
+		Profiles:             cfg.Profiles,
+		Schedule:             cfg.Schedule,
+	}
+}
+
+// ParseSettingsExport decodes and validates the exported settings. Unknown fields are rejected, so that settings
+// are not silently dropped.
+func ParseSettingsExport(data []byte) (SettingsExport, error) {
+	var version struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &version); err != nil {
+		return SettingsExport{}, fmt.Errorf("%w: %w", ErrInvalidSettings, err)
+	}
+	if version.Version < 1 || version.Version > SettingsExportVersion {
+		return SettingsExport{}, fmt.Errorf("%w: %d", ErrSettingsVersion, version.Version)
+	}
+
+	var settings SettingsExport
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&settings); err != nil {
+		return SettingsExport{}, fmt.Errorf("%w: %w", ErrInvalidSettings, err)
+	}
+	if err := settings.validate(); err != nil {
+		return SettingsExport{}, fmt.Errorf("%w: %w", ErrInvalidSettings, err)
+	}
+	return settings, nil
+}
+
+func (s SettingsExport) validate() error {
+	technology, ok := Technology_value[s.Technology]
+	if !ok || Technology(technology) == Technology_UNKNOWN_TECHNOLOGY {
+		return fmt.Errorf("unknown technology %q", s.Technology)
+	}
+	protocol, ok := Protocol_value[s.Protocol]
+	if !ok || Protocol(protocol) == Protocol_UNKNOWN_PROTOCOL {
+		return fmt.Errorf("unknown protocol %q", s.Protocol)
+	}
+	if Technology(technology) != Technology_OPENVPN && (s.Obfuscate || s.OpenVPNPort != 0 || s.Stealth.Enabled) {
+		return errors.New("obfuscation, OpenVPN port and stealth can be set only with OPENVPN technology")
+	}
+	if Technology(technology) != Technology_NORDLYNX && s.PostquantumVPN {
+		return errors.New("post-quantum VPN can be set only with NORDLYNX technology")
+	}
+	if s.AutoTechnology && s.PostquantumVPN {
+		return errors.New("post-quantum VPN can't be set with the automatic technology")
+	}
+	if err := ValidateMTU(s.MTU); err != nil {
+		return err
+	}
+	if s.KillSwitch && !s.Firewall {
+		return errors.New("kill switch requires the firewall")
+	}
+	if s.ThreatProtectionLite && len(s.DNS) > 0 {
+		return errors.New("threat protection lite cannot be used with custom DNS")
+	}
+	for _, port := range append(slices.Clone(s.Allowlist.UDPPorts), s.Allowlist.TCPPorts...) {
+		if port < 1 || port > 65535 {
+			return fmt.Errorf("allowlisted port %d is out of range", port)
+		}
+	}
+	for _, subnet := range s.Allowlist.Subnets {
+		if _, err := netip.ParsePrefix(subnet); err != nil {
+			return fmt.Errorf("allowlisted subnet: %w", err)
+		}
+	}
+	if s.MaxServerLoad < 0 || s.MaxServerLoad > MaxServerLoadLimit {
+		return fmt.Errorf("max server load %d is out of range", s.MaxServerLoad)
+	}
+	if err := s.ConnectRetry.Validate(); err != nil {
+		return err
+	}
+	if err := s.ConnectTimeouts.Validate(); err != nil {
+		return err
+	}
+	if err := s.Stealth.Validate(); err != nil {
+		return err
+	}
+	if err := ValidateOpenVPNDirectives(s.OpenVPNDirectives); err != nil {
+		return err
+	}
+	if err := ValidateInterfaceName(s.InterfaceName); err != nil {
+		return err
+	}
+	if err := ValidateRoutingTable(s.RoutingTable); err != nil {
+		return err
+	}
+	if err := ValidateTrayMenu(s.TrayMenu); err != nil {
+		return err
+	}
+	if _, err := internal.ParseLogLevel(string(s.LogLevel)); err != nil {
+		return err
+	}
+	for subnet := range s.TrustedNetworks.Subnets {
+		if _, err := netip.ParsePrefix(subnet); err != nil {
+			return fmt.Errorf("trusted subnet: %w", err)
+		}
+	}
+	if s.TrustedNetworks.Action != "" && !slices.Contains(TrustedNetworkActions, s.TrustedNetworks.Action) {
+		return fmt.Errorf("unknown trusted network action %q", s.TrustedNetworks.Action)
+	}
+	if s.SplitTunnel.Mode != "" && !slices.Contains(SplitTunnelModes, s.SplitTunnel.Mode) {
+		return fmt.Errorf("unknown split tunnel mode %q", s.SplitTunnel.Mode)
+	}
+	if s.HealthMonitor != "" && !slices.Contains(HealthSensitivities, s.HealthMonitor) {
+		return fmt.Errorf("unknown health monitor sensitivity %q", s.HealthMonitor)
+	}
+	for name, profile := range s.Profiles {
+		if _, ok := Protocol_name[int32(profile.Protocol)]; !ok {
+			return fmt.Errorf("profile %q has unknown protocol", name)
+		}
+	}
+	for name, rule := range s.Schedule {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("schedule rule %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Apply returns the config with the exported settings replacing the current ones. Auto-connect location is not
+// resolved from the server tag, so it has to be filled in by the caller.
+func (s SettingsExport) Apply(cfg Config) Config {
+	cfg.Technology = Technology(Technology_value[s.Technology])
+	cfg.AutoTechnology = s.AutoTechnology
+	cfg.AutoConnectData.Protocol = Protocol(Protocol_value[s.Protocol])
+	cfg.AutoConnectData.OpenVPNPort = s.OpenVPNPort
+	cfg.OpenVPNDirectives = s.OpenVPNDirectives
+	cfg.AutoConnectData.Obfuscate = s.Obfuscate
+	cfg.AutoConnectData.Stealth = s.Stealth
+	cfg.InterfaceName = s.InterfaceName
+	cfg.PersistentKeepalive = s.PersistentKeepalive
+	cfg.MTU = s.MTU
+	cfg.HealthMonitor = s.HealthMonitor
+	cfg.AutoConnectData.PostquantumVpn = s.PostquantumVPN
+	cfg.Firewall = s.Firewall
+	// zero mark can't be used, keep the current one
+	if s.FirewallMark != 0 {
+		cfg.FirewallMark = s.FirewallMark
+	}
+	cfg.RoutingTable = s.RoutingTable
+	cfg.RouteMetric = s.RouteMetric
+	cfg.Routing.Set(s.Routing)
+	cfg.KillSwitch = s.KillSwitch
+	cfg.AutoConnect = s.AutoConnect
+	cfg.AutoConnectData.ServerTag = s.AutoConnectServerTag
+	cfg.AutoConnectData.ThreatProtectionLite = s.ThreatProtectionLite
+	cfg.AutoConnectData.DNS = s.DNS
+	cfg.AutoConnectData.Allowlist = NewAllowlist(s.Allowlist.UDPPorts, s.Allowlist.TCPPorts, s.Allowlist.Subnets)
+	cfg.IPv6 = s.IPv6
+	cfg.LanDiscovery = s.LANDiscovery
+	cfg.VirtualLocation.Set(s.VirtualLocation)
+	cfg.TrayMinimal = s.TrayMinimal
+	cfg.TrayMenu = s.TrayMenu
+	cfg.LogLevel = s.LogLevel
+	cfg.TrustedNetworks = s.TrustedNetworks
+	cfg.DeferOnMetered = s.DeferOnMetered
+	cfg.ObfuscationFallback.Set(s.ObfuscationFallback)
+	cfg.MaxServerLoad = s.MaxServerLoad
+	cfg.ConnectRetry = s.ConnectRetry
+	cfg.ConnectTimeouts = s.ConnectTimeouts
+	cfg.SplitTunnel = s.SplitTunnel
+	cfg.Favorites = s.Favorites
+	cfg.Profiles = s.Profiles
+	cfg.Schedule = s.Schedule
+	return cfg
+}
+
+// ReconnectSettings are the exported settings which are used only when the VPN connection is established, so the
+// active connection has to be restarted for them to take effect
+var ReconnectSettings = []string{
+	"technology", "protocol", "openvpn_port", "openvpn_directives", "obfuscate", "stealth", "interface_name",
+	"persistent_keepalive", "mtu", "route_metric", "postquantum_vpn", "ipv6",
+}
+
+// Changed lists the JSON names of the settings which differ from the other settings, sorted by name
+func (s SettingsExport) Changed(other SettingsExport) ([]string, error) {
+	current, err := settingsFields(s)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := settingsFields(other)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []string
+	for name, value := range current {
+		if !bytes.Equal(value, updated[name]) {
+			changed = append(changed, name)
+		}
+	}
+	for name := range updated {
+		if _, ok := current[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	slices.Sort(changed)
+	return changed, nil
+}
+
+func settingsFields(s SettingsExport) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
@@ -0,0 +1,144 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettingsExport_RoundTrip(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cfg := Config{
+		Technology:          Technology_NORDLYNX,
+		Firewall:            true,
+		FirewallMark:        0xe1f1,
+		PersistentKeepalive: 10,
+		MTU:                 1380,
+		KillSwitch:          true,
+		AutoConnect:         true,
+		LanDiscovery:        true,
+		AutoConnectData: AutoConnectData{
+			ID:             42,
+			ServerTag:      "lt",
+			Protocol:       Protocol_UDP,
+			PostquantumVpn: true,
+			DNS:            DNS{"1.1.1.1"},
+			Allowlist:      NewAllowlist([]int64{53, 22}, []int64{443}, []string{"10.0.0.0/8"}),
+		},
+		LogLevel: internal.LogLevelDebug,
+		TrayMenu: []string{TrayMenuVPN},
+	}
+
+	data, err := json.Marshal(NewSettingsExport(cfg))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "\"id\"")
+
+	settings, err := ParseSettingsExport(data)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{22, 53}, settings.Allowlist.UDPPorts)
+
+	imported := settings.Apply(Config{})
+	assert.Equal(t, cfg.Technology, imported.Technology)
+	assert.Equal(t, cfg.FirewallMark, imported.FirewallMark)
+	assert.Equal(t, cfg.PersistentKeepalive, imported.PersistentKeepalive)
+	assert.Equal(t, cfg.MTU, imported.MTU)
+	assert.True(t, imported.KillSwitch)
+	assert.True(t, imported.AutoConnectData.PostquantumVpn)
+	assert.Equal(t, cfg.AutoConnectData.DNS, imported.AutoConnectData.DNS)
+	assert.Equal(t, cfg.AutoConnectData.Allowlist, imported.AutoConnectData.Allowlist)
+	assert.Equal(t, cfg.LogLevel, imported.LogLevel)
+	assert.Equal(t, cfg.TrayMenu, imported.TrayMenu)
+	assert.Zero(t, imported.AutoConnectData.ID)
+}
+
+func TestParseSettingsExport_Invalid(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		settings string
+		err      error
+	}{
+		{name: "not JSON", settings: "settings", err: ErrInvalidSettings},
+		{name: "missing version", settings: `{"technology":"NORDLYNX"}`, err: ErrSettingsVersion},
+		{name: "newer version", settings: `{"version":2}`, err: ErrSettingsVersion},
+		{
+			name:     "unknown field",
+			settings: `{"version":1,"technology":"NORDLYNX","protocol":"UDP","token":"secret"}`,
+			err:      ErrInvalidSettings,
+		},
+		{
+			name:     "unknown technology",
+			settings: `{"version":1,"technology":"IKEV2","protocol":"UDP"}`,
+			err:      ErrInvalidSettings,
+		},
+		{
+			name:     "obfuscation with NordLynx",
+			settings: `{"version":1,"technology":"NORDLYNX","protocol":"UDP","obfuscate":true}`,
+			err:      ErrInvalidSettings,
+		},
+		{
+			name:     "kill switch without firewall",
+			settings: `{"version":1,"technology":"NORDLYNX","protocol":"UDP","kill_switch":true}`,
+			err:      ErrInvalidSettings,
+		},
+		{
+			name: "port out of range",
+			settings: `{"version":1,"technology":"OPENVPN","protocol":"TCP",` +
+				`"allowlist":{"udp_ports":[70000],"tcp_ports":[],"subnets":[]}}`,
+			err: ErrInvalidSettings,
+		},
+		{
+			name:     "unknown log level",
+			settings: `{"version":1,"technology":"NORDLYNX","protocol":"UDP","log_level":"verbose"}`,
+			err:      ErrInvalidSettings,
+		},
+		{
+			name:     "invalid interface name",
+			settings: `{"version":1,"technology":"NORDLYNX","protocol":"UDP","interface_name":"vpn/0"}`,
+			err:      ErrInvalidSettings,
+		},
+		{
+			name:     "MTU out of range",
+			settings: `{"version":1,"technology":"NORDLYNX","protocol":"UDP","mtu":9000}`,
+			err:      ErrInvalidSettings,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseSettingsExport([]byte(test.settings))
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func TestSettingsExport_Changed(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cfg := Config{
+		Technology:      Technology_NORDLYNX,
+		AutoConnectData: AutoConnectData{Protocol: Protocol_UDP, DNS: DNS{"1.1.1.1"}},
+	}
+	current := NewSettingsExport(cfg)
+
+	changed, err := current.Changed(current)
+	require.NoError(t, err)
+	assert.Empty(t, changed)
+
+	updated := current
+	updated.Technology = Technology_OPENVPN.String()
+	updated.KillSwitch = true
+	updated.DNS = nil
+	updated.MaxServerLoad = 50
+
+	changed, err = current.Changed(updated)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dns", "kill_switch", "max_server_load", "technology"}, changed)
+}
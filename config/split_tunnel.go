@@ -0,0 +1,60 @@
+package config
+
+import (
+	"maps"
+	"slices"
+)
+
+// SplitTunnelMode defines how the traffic of the split tunnel apps is routed
+type SplitTunnelMode string
+
+const (
+	// SplitTunnelExclude routes the traffic of the listed apps outside of VPN
+	SplitTunnelExclude SplitTunnelMode = "exclude"
+	// SplitTunnelInclude routes only the traffic of the listed apps through VPN
+	SplitTunnelInclude SplitTunnelMode = "include"
+)
+
+// SplitTunnelModes lists the supported modes
+var SplitTunnelModes = []SplitTunnelMode{SplitTunnelExclude, SplitTunnelInclude}
+
+// SplitTunnel lists the applications which are routed differently than the rest of the system
+type SplitTunnel struct {
+	// Mode of split tunneling. Empty means SplitTunnelExclude
+	Mode SplitTunnelMode `json:"mode,omitempty"`
+	// Apps are identified by the executable name or the absolute path of the executable
+	Apps map[string]bool `json:"apps,omitempty"`
+}
+
+// ModeOrDefault returns the default mode if the mode is not set
+func (s SplitTunnel) ModeOrDefault() SplitTunnelMode {
+	if s.Mode == "" {
+		return SplitTunnelExclude
+	}
+	return s.Mode
+}
+
+// WithApp returns a copy of split tunnel with the app added or removed
+func (s SplitTunnel) WithApp(app string, remove bool) SplitTunnel {
+	apps := maps.Clone(s.Apps)
+	if apps == nil {
+		apps = map[string]bool{}
+	}
+	if remove {
+		delete(apps, app)
+	} else {
+		apps[app] = true
+	}
+	s.Apps = apps
+	return s
+}
+
+// SortedApps returns the apps in alphabetical order
+func (s SplitTunnel) SortedApps() []string {
+	apps := make([]string, 0, len(s.Apps))
+	for app := range s.Apps {
+		apps = append(apps, app)
+	}
+	slices.Sort(apps)
+	return apps
+}
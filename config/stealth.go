@@ -0,0 +1,71 @@
+package config
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+)
+
+// StealthPort is the port OpenVPN connects to in the stealth mode, it is the port of HTTPS and is open on most of
+// the restricted networks
+const StealthPort = 443
+
+const (
+	// StealthProxyHTTP is the proxy which tunnels the connection with the HTTP CONNECT method, i.e. the HTTPS proxy
+	StealthProxyHTTP = "http"
+	// StealthProxySOCKS5 is the SOCKS5 proxy
+	StealthProxySOCKS5 = "socks5"
+)
+
+var (
+	ErrStealthProxy         = errors.New("stealth proxy must be given as http://host:port or socks5://host:port")
+	ErrStealthProxyDisabled = errors.New("stealth proxy can be set only with the stealth mode enabled")
+)
+
+// Stealth runs OpenVPN over TCP on StealthPort, optionally through the proxy, for the networks where only the web
+// ports are open
+type Stealth struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// Proxy is the URL of the proxy the connection goes through, it is not used if empty
+	Proxy string `json:"proxy,omitempty"`
+}
+
+// Validate returns an error if the proxy is malformed or set while the stealth mode is disabled
+func (s Stealth) Validate() error {
+	if s.Proxy == "" {
+		return nil
+	}
+	if !s.Enabled {
+		return ErrStealthProxyDisabled
+	}
+	_, err := ParseStealthProxy(s.Proxy)
+	return err
+}
+
+// StealthProxy is the address of the proxy split into the parts passed to OpenVPN
+type StealthProxy struct {
+	// Type is either StealthProxyHTTP or StealthProxySOCKS5
+	Type string
+	Host string
+	Port uint16
+}
+
+// ParseStealthProxy parses the proxy URL, e.g. socks5://10.0.0.1:1080. Credentials are not supported as they would
+// be stored in the config in plain text.
+func ParseStealthProxy(raw string) (StealthProxy, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return StealthProxy{}, ErrStealthProxy
+	}
+	if u.Scheme != StealthProxyHTTP && u.Scheme != StealthProxySOCKS5 {
+		return StealthProxy{}, ErrStealthProxy
+	}
+	if u.User != nil || (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+		return StealthProxy{}, ErrStealthProxy
+	}
+	port, err := strconv.ParseUint(u.Port(), 10, 16)
+	if u.Hostname() == "" || err != nil || port == 0 {
+		return StealthProxy{}, ErrStealthProxy
+	}
+	return StealthProxy{Type: u.Scheme, Host: u.Hostname(), Port: uint16(port)}, nil
+}
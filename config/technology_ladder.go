@@ -0,0 +1,26 @@
+package config
+
+import "slices"
+
+// TechnologyRung is a single step of the automatic technology selection
+type TechnologyRung string
+
+const (
+	RungNordLynx   TechnologyRung = "nordlynx"
+	RungOpenVPNUDP TechnologyRung = "openvpn_udp"
+	RungOpenVPNTCP TechnologyRung = "openvpn_tcp"
+	// RungObfuscated connects to the obfuscated OpenVPN servers
+	RungObfuscated TechnologyRung = "obfuscated"
+)
+
+// TechnologyLadder lists the rungs in the order they are tried when the technology is selected automatically
+var TechnologyLadder = []TechnologyRung{RungNordLynx, RungOpenVPNUDP, RungOpenVPNTCP, RungObfuscated}
+
+// LadderFrom returns the rungs starting with the given one, the whole ladder is returned for the unknown rung
+func LadderFrom(rung TechnologyRung) []TechnologyRung {
+	index := slices.Index(TechnologyLadder, rung)
+	if index == -1 {
+		return TechnologyLadder
+	}
+	return TechnologyLadder[index:]
+}
@@ -0,0 +1,18 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLadderFrom(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, TechnologyLadder, LadderFrom(""))
+	assert.Equal(t, TechnologyLadder, LadderFrom(RungNordLynx))
+	assert.Equal(t, []TechnologyRung{RungOpenVPNTCP, RungObfuscated}, LadderFrom(RungOpenVPNTCP))
+	assert.Equal(t, TechnologyLadder, LadderFrom("wireguard"))
+}
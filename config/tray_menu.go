@@ -0,0 +1,43 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// Tray menu sections which can be listed in Config.TrayMenu
+const (
+	TrayMenuVPN       = "vpn"
+	TrayMenuFileshare = "fileshare"
+	TrayMenuSettings  = "settings"
+	TrayMenuAccount   = "account"
+)
+
+// DefaultTrayMenu is the tray menu layout used when none is configured
+var DefaultTrayMenu = []string{TrayMenuVPN, TrayMenuFileshare, TrayMenuSettings, TrayMenuAccount}
+
+// ErrTrayMenuSection is returned for tray menu layouts with unknown or repeated sections
+var ErrTrayMenuSection = errors.New("invalid tray menu section")
+
+// ValidateTrayMenu checks that every section is known and listed at most once
+func ValidateTrayMenu(sections []string) error {
+	for i, section := range sections {
+		if !slices.Contains(DefaultTrayMenu, section) {
+			return fmt.Errorf("%w: unknown section %q", ErrTrayMenuSection, section)
+		}
+		if slices.Contains(sections[:i], section) {
+			return fmt.Errorf("%w: section %q is listed more than once", ErrTrayMenuSection, section)
+		}
+	}
+	return nil
+}
+
+// TrayMenuOrDefault returns the configured tray menu layout or DefaultTrayMenu when it is not set
+func TrayMenuOrDefault(sections []string) []string {
+	if len(sections) == 0 {
+		return DefaultTrayMenu
+	}
+	return sections
+}
@@ -0,0 +1,36 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTrayMenu(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		sections []string
+		valid    bool
+	}{
+		{name: "empty", valid: true},
+		{name: "default", sections: DefaultTrayMenu, valid: true},
+		{name: "reordered subset", sections: []string{TrayMenuAccount, TrayMenuVPN}, valid: true},
+		{name: "unknown", sections: []string{TrayMenuVPN, "meshnet"}},
+		{name: "repeated", sections: []string{TrayMenuVPN, TrayMenuSettings, TrayMenuVPN}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateTrayMenu(test.sections)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, ErrTrayMenuSection)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package config
+
+import (
+	"maps"
+	"net/netip"
+	"slices"
+)
+
+// TrustedNetworkAction defines what happens when the device joins a trusted network
+type TrustedNetworkAction string
+
+const (
+	// TrustedNetworkSkip does not connect automatically, but keeps the existing connection
+	TrustedNetworkSkip TrustedNetworkAction = "skip"
+	// TrustedNetworkDisconnect disconnects from VPN
+	TrustedNetworkDisconnect TrustedNetworkAction = "disconnect"
+)
+
+// TrustedNetworkActions lists the supported actions
+var TrustedNetworkActions = []TrustedNetworkAction{TrustedNetworkSkip, TrustedNetworkDisconnect}
+
+// TrustedNetworks are the networks where VPN is not needed. Auto-connect connects only on the untrusted networks.
+type TrustedNetworks struct {
+	// SSIDs of the trusted Wi-Fi networks
+	SSIDs map[string]bool `json:"ssids,omitempty"`
+	// Subnets of the trusted wired networks in CIDR notation
+	Subnets Subnets `json:"subnets,omitempty"`
+	// Action taken on the trusted networks. Empty means TrustedNetworkSkip
+	Action TrustedNetworkAction `json:"action,omitempty"`
+}
+
+// IsEmpty reports whether there are no trusted networks
+func (t TrustedNetworks) IsEmpty() bool {
+	return len(t.SSIDs) == 0 && len(t.Subnets) == 0
+}
+
+// ActionOrDefault returns the default action if the action is not set
+func (t TrustedNetworks) ActionOrDefault() TrustedNetworkAction {
+	if t.Action == "" {
+		return TrustedNetworkSkip
+	}
+	return t.Action
+}
+
+// WithSSID returns a copy of trusted networks with the SSID added or removed
+func (t TrustedNetworks) WithSSID(ssid string, remove bool) TrustedNetworks {
+	ssids := maps.Clone(t.SSIDs)
+	if ssids == nil {
+		ssids = map[string]bool{}
+	}
+	if remove {
+		delete(ssids, ssid)
+	} else {
+		ssids[ssid] = true
+	}
+	t.SSIDs = ssids
+	return t
+}
+
+// WithSubnet returns a copy of trusted networks with the subnet added or removed
+func (t TrustedNetworks) WithSubnet(subnet string, remove bool) TrustedNetworks {
+	subnets := maps.Clone(t.Subnets)
+	if subnets == nil {
+		subnets = Subnets{}
+	}
+	if remove {
+		delete(subnets, subnet)
+	} else {
+		subnets[subnet] = true
+	}
+	t.Subnets = subnets
+	return t
+}
+
+// SortedSSIDs returns trusted SSIDs in alphabetical order
+func (t TrustedNetworks) SortedSSIDs() []string {
+	ssids := make([]string, 0, len(t.SSIDs))
+	for ssid := range t.SSIDs {
+		ssids = append(ssids, ssid)
+	}
+	slices.Sort(ssids)
+	return ssids
+}
+
+// SortedSubnets returns trusted subnets in alphabetical order
+func (t TrustedNetworks) SortedSubnets() []string {
+	subnets := make([]string, 0, len(t.Subnets))
+	for subnet := range t.Subnets {
+		subnets = append(subnets, subnet)
+	}
+	slices.Sort(subnets)
+	return subnets
+}
+
+// TrustsInterface reports whether the network joined through the interface is trusted. Wi-Fi networks are
+// identified by the SSID, other networks by the addresses of the interface.
+func (t TrustedNetworks) TrustsInterface(ssid string, addrs []netip.Addr) bool {
+	if ssid != "" {
+		return t.SSIDs[ssid]
+	}
+
+	for subnet := range t.Subnets {
+		prefix, err := netip.ParsePrefix(subnet)
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			if prefix.Contains(addr) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrustedNetworks_TrustsInterface(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	trusted := TrustedNetworks{
+		SSIDs:   map[string]bool{"Home": true},
+		Subnets: Subnets{"192.168.1.0/24": true},
+	}
+
+	tests := []struct {
+		name    string
+		ssid    string
+		addrs   []netip.Addr
+		trusted bool
+	}{
+		{name: "trusted ssid", ssid: "Home", trusted: true},
+		{name: "untrusted ssid", ssid: "Cafe"},
+		{
+			name:  "ssid is used for wireless networks",
+			ssid:  "Cafe",
+			addrs: []netip.Addr{netip.MustParseAddr("192.168.1.10")},
+		},
+		{
+			name:    "address in trusted subnet",
+			addrs:   []netip.Addr{netip.MustParseAddr("10.0.0.2"), netip.MustParseAddr("192.168.1.10")},
+			trusted: true,
+		},
+		{name: "address outside trusted subnet", addrs: []netip.Addr{netip.MustParseAddr("192.168.2.10")}},
+		{name: "no addresses"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.trusted, trusted.TrustsInterface(test.ssid, test.addrs))
+		})
+	}
+}
+
+func TestTrustedNetworks_WithDoesNotModifyOriginal(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	original := TrustedNetworks{SSIDs: map[string]bool{"Home": true}}
+
+	added := original.WithSSID("Office", false).WithSubnet("10.0.0.0/8", false)
+	assert.Equal(t, []string{"Home", "Office"}, added.SortedSSIDs())
+	assert.Equal(t, []string{"10.0.0.0/8"}, added.SortedSubnets())
+	assert.Equal(t, []string{"Home"}, original.SortedSSIDs())
+
+	removed := added.WithSSID("Home", true)
+	assert.Equal(t, []string{"Office"}, removed.SortedSSIDs())
+	assert.Equal(t, []string{"Home", "Office"}, added.SortedSSIDs())
+}
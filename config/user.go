@@ -5,11 +5,105 @@ import (
 	"encoding/json"
 )
 
-// UsersData stores users which will receive notifications and see the tray icon.
+// UsersData stores settings which are scoped to a single Linux user, such as notifications, the
+// tray icon and autoconnect.
 type UsersData struct {
-	Notify    UidBoolMap `json:"notify"` // To be removed in a next major version
-	NotifyOff UidBoolMap `json:"notify_off"`
-	TrayOff   UidBoolMap `json:"tray_off"`
+	Notify        UidBoolMap              `json:"notify"` // To be removed in a next major version
+	NotifyOff     UidBoolMap              `json:"notify_off"`
+	TrayOff       UidBoolMap              `json:"tray_off"`
+	TrayIconTheme map[int64]TrayIconTheme `json:"tray_icon_theme,omitempty"`
+	TrayHotkey    UidBoolMap              `json:"tray_hotkey,omitempty"`
+	// AutoConnectTarget holds the autoconnect target chosen by each user. The daemon connects to
+	// the target of the user at the console.
+	AutoConnectTarget map[int64]AutoConnectTarget `json:"autoconnect_target,omitempty"`
+	// AutoConnect holds whether each user turned autoconnect on or off. Users who never changed it
+	// follow Config.AutoConnect.
+	AutoConnect map[int64]bool `json:"autoconnect,omitempty"`
+}
+
+// AutoConnectTarget is the server or location a user wants to autoconnect to.
+type AutoConnectTarget struct {
+	ServerTag string      `json:"server_tag,omitempty"`
+	Country   string      `json:"country,omitempty"`
+	City      string      `json:"city,omitempty"`
+	Group     ServerGroup `json:"group,omitempty"`
+}
+
+// AutoConnectTargetFor returns the autoconnect target of the given user. Users who did not choose
+// a target get the default one, which is also what the daemon uses for them.
+func (c Config) AutoConnectTargetFor(uid int64) AutoConnectTarget {
+	if c.UsersData != nil {
+		if target, ok := c.UsersData.AutoConnectTarget[uid]; ok {
+			return target
+		}
+	}
+	return c.DefaultAutoConnectTarget()
+}
+
+// DefaultAutoConnectTarget returns the target used when nobody is logged in on the seat, e.g. on
+// boot. It is the target set most recently by any user.
+func (c Config) DefaultAutoConnectTarget() AutoConnectTarget {
+	return AutoConnectTarget{
+		ServerTag: c.AutoConnectData.ServerTag,
+		Country:   c.AutoConnectData.Country,
+		City:      c.AutoConnectData.City,
+		Group:     c.AutoConnectData.Group,
+	}
+}
+
+// SetAutoConnectTarget stores the target for the given user. It also becomes the default target,
+// as the daemon has no other user to pick the target of before anyone logs in on the seat.
+func (c *Config) SetAutoConnectTarget(uid int64, target AutoConnectTarget) {
+	if c.UsersData == nil {
+		c.UsersData = &UsersData{}
+	}
+	if c.UsersData.AutoConnectTarget == nil {
+		c.UsersData.AutoConnectTarget = map[int64]AutoConnectTarget{}
+	}
+	c.UsersData.AutoConnectTarget[uid] = target
+	c.AutoConnectData.ServerTag = target.ServerTag
+	c.AutoConnectData.Country = target.Country
+	c.AutoConnectData.City = target.City
+	c.AutoConnectData.Group = target.Group
+}
+
+// AutoConnectFor reports whether the given user has autoconnect on. Users who did not turn it on or
+// off get the default, which is also what the daemon uses for them.
+func (c Config) AutoConnectFor(uid int64) bool {
+	if c.UsersData != nil {
+		if enabled, ok := c.UsersData.AutoConnect[uid]; ok {
+			return enabled
+		}
+	}
+	return c.AutoConnect
+}
+
+// AutoConnectForAnyone reports whether autoconnect is on by default or for any of the users
+func (c Config) AutoConnectForAnyone() bool {
+	if c.AutoConnect {
+		return true
+	}
+	if c.UsersData != nil {
+		for _, enabled := range c.UsersData.AutoConnect {
+			if enabled {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SetAutoConnectFor turns autoconnect on or off for the given user. Like the target, it also
+// becomes the default used before anyone logs in on the seat.
+func (c *Config) SetAutoConnectFor(uid int64, enabled bool) {
+	if c.UsersData == nil {
+		c.UsersData = &UsersData{}
+	}
+	if c.UsersData.AutoConnect == nil {
+		c.UsersData.AutoConnect = map[int64]bool{}
+	}
+	c.UsersData.AutoConnect[uid] = enabled
+	c.AutoConnect = enabled
 }
 
 // UidBoolMap is a set of user ids.
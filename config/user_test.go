@@ -0,0 +1,46 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoConnectTargetFor(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cfg := Config{AutoConnectData: AutoConnectData{ServerTag: "lt", Country: "Lithuania"}}
+	legacy := AutoConnectTarget{ServerTag: "lt", Country: "Lithuania"}
+	assert.Equal(t, legacy, cfg.AutoConnectTargetFor(1000))
+	assert.Equal(t, legacy, cfg.AutoConnectTargetFor(1001))
+
+	target := AutoConnectTarget{ServerTag: "de", Country: "Germany"}
+	cfg.SetAutoConnectTarget(1000, target)
+	assert.Equal(t, target, cfg.AutoConnectTargetFor(1000))
+	// users without a target get the default one
+	assert.Equal(t, target, cfg.AutoConnectTargetFor(1001))
+	assert.Equal(t, target, cfg.DefaultAutoConnectTarget())
+	assert.Equal(t, "de", cfg.AutoConnectData.ServerTag)
+	assert.Equal(t, "Germany", cfg.AutoConnectData.Country)
+}
+
+func TestAutoConnectFor(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cfg := Config{AutoConnect: true}
+	assert.True(t, cfg.AutoConnectFor(1000))
+
+	cfg.SetAutoConnectFor(1000, true)
+	cfg.SetAutoConnectFor(1001, false)
+	assert.True(t, cfg.AutoConnectFor(1000))
+	assert.False(t, cfg.AutoConnectFor(1001))
+	// users who did not choose follow the most recent choice
+	assert.False(t, cfg.AutoConnectFor(1002))
+	assert.False(t, cfg.AutoConnect)
+	assert.True(t, cfg.AutoConnectForAnyone())
+
+	cfg.SetAutoConnectFor(1000, false)
+	assert.False(t, cfg.AutoConnectForAnyone())
+}
@@ -10,7 +10,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"sync"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/response"
@@ -20,6 +22,8 @@ import (
 const (
 	// linuxPlatformID defines the linux platform ID on the Notification Centre
 	linuxPlatformID = 500
+	// serversDateFormat is the format of the dates used in the servers API
+	serversDateFormat = "2006-01-02 15:04:05"
 )
 
 type CredentialsAPI interface {
@@ -40,6 +44,7 @@ type InsightsAPI interface {
 
 type ServersAPI interface {
 	Servers() (Servers, http.Header, error)
+	ServersUpdates(since time.Time) (Servers, http.Header, error)
 	RecommendedServers(filter ServersFilter, longitude, latitude float64) (Servers, http.Header, error)
 	Server(id int64) (*Server, error)
 	ServersCountries() (Countries, http.Header, error)
@@ -324,6 +329,33 @@ func (api *DefaultAPI) Servers() (Servers, http.Header, error) {
 	return ret, resp.Header, nil
 }
 
+// ServersUpdates returns the servers updated after the given time. ErrNotModified is returned if nothing has
+// changed since then.
+func (api *DefaultAPI) ServersUpdates(since time.Time) (Servers, http.Header, error) {
+	query := fmt.Sprintf(ServersURLUpdatesQuery, url.QueryEscape(since.UTC().Format(serversDateFormat)))
+	req, err := request.NewRequest(http.MethodGet, api.agent, api.baseURL, ServersURL+query, "application/json", "", "gzip, deflate", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("If-Modified-Since", since.UTC().Format(http.TimeFormat))
+
+	resp, err := api.do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, resp.Header, ErrNotModified
+	}
+
+	var ret Servers
+	if err = json.NewDecoder(resp.Body).Decode(&ret); err != nil {
+		return nil, nil, err
+	}
+	return ret, resp.Header, nil
+}
+
 // ServersCountries returns server countries list
 func (api *DefaultAPI) ServersCountries() (Countries, http.Header, error) {
 	req, err := request.NewRequest(http.MethodGet, api.agent, api.baseURL, ServersCountriesURL, "application/json", "", "gzip, deflate", nil)
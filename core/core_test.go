@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/daemon/response"
 	"github.com/NordSecurity/nordvpn-linux/test/category"
@@ -158,6 +160,37 @@ func TestDefaultAPI_Servers(t *testing.T) {
 	}
 }
 
+func TestDefaultAPI_ServersUpdates(t *testing.T) {
+	category.Set(t, category.Integration)
+
+	since := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	updatesURL := ServersURL + fmt.Sprintf(ServersURLUpdatesQuery, url.QueryEscape("2024-05-01 12:00:00"))
+	tests := []testCase{
+		testNewCase(t, http.StatusOK, updatesURL, "core_servers", nil),
+		testNewCase(t, http.StatusNotModified, updatesURL, "", ErrNotModified),
+		testNewCase(t, http.StatusInternalServerError, updatesURL, "", ErrServerInternal),
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "Wed, 01 May 2024 12:00:00 GMT", r.Header.Get("If-Modified-Since"))
+				test.handler(rw, r)
+			}))
+			defer server.Close()
+
+			api := NewDefaultAPI(
+				"",
+				server.URL,
+				http.DefaultClient,
+				response.NoopValidator{},
+			)
+			_, _, err := api.ServersUpdates(since)
+			assert.True(t, errors.Is(err, test.err))
+		})
+	}
+}
+
 func TestDefaultAPI_Services(t *testing.T) {
 	category.Set(t, category.Integration)
 
@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/netip"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/core/mesh"
 
@@ -38,6 +39,10 @@ const (
 	urlRejectInvitation = urlInvitationSend + "/%s/reject"
 	// urlRevokeInvitation is used to revoke an invitation.
 	urlRevokeInvitation = urlInvitationSend + "/%s"
+	// urlInviteCode is used to create a short-lived invite code.
+	urlInviteCode = urlInvitationSend + "/codes"
+	// urlRedeemInviteCode is used to join the mesh network using an invite code.
+	urlRedeemInviteCode = urlInviteCode + "/redeem"
 	// urlNotifyFileTransfer is used to notify another peer about an incoming notification
 	urlNotifyFileTransfer = urlMeshMachines + "/notifications/file-transfer"
 )
@@ -83,6 +88,7 @@ func peersResponseToMachinePeers(rawPeers []mesh.MachinePeerResponse) []mesh.Mac
 			DoIAllowFileshare:         p.DoIAllowFileshare,
 			AlwaysAcceptFiles:         p.AlwaysAcceptFiles,
 			Nickname:                  p.Nickname,
+			AdvertisedSubnets:         p.AdvertisedSubnets,
 		})
 	}
 
@@ -338,13 +344,14 @@ func (api *DefaultAPI) Map(token string, self uuid.UUID) (*mesh.MachineMap, erro
 
 	return &mesh.MachineMap{
 		Machine: mesh.Machine{
-			ID:              raw.ID,
-			Hostname:        raw.Hostname,
-			PublicKey:       raw.PublicKey,
-			Endpoints:       raw.Endpoints,
-			Address:         addr,
-			Nickname:        raw.Nickname,
-			SupportsRouting: raw.SupportsRouting,
+			ID:                raw.ID,
+			Hostname:          raw.Hostname,
+			PublicKey:         raw.PublicKey,
+			Endpoints:         raw.Endpoints,
+			Address:           addr,
+			Nickname:          raw.Nickname,
+			SupportsRouting:   raw.SupportsRouting,
+			AdvertisedSubnets: raw.AdvertisedSubnets,
 		},
 		Hosts: raw.DNS.Hosts,
 		Peers: peers,
@@ -412,6 +419,7 @@ func (api *DefaultAPI) Invite(
 	token string,
 	self uuid.UUID,
 	email string,
+	ttl time.Duration,
 	doIAllowInbound bool,
 	doIAllowRouting bool,
 	doIAllowLocalNetwork bool,
@@ -426,6 +434,7 @@ func (api *DefaultAPI) Invite(
 		AllowRouting:      doIAllowRouting,
 		AllowLocalNetwork: doIAllowLocalNetwork,
 		AllowFileshare:    doIAllowFileshare,
+		ExpiresIn:         uint32(ttl.Seconds()),
 	})
 	if err != nil {
 		return err
@@ -447,6 +456,93 @@ func (api *DefaultAPI) Invite(
 	return ExtractError(resp)
 }
 
+// CreateInviteCode creates a short-lived code for joining the mesh.
+func (api *DefaultAPI) CreateInviteCode(
+	token string,
+	self uuid.UUID,
+	doIAllowInbound bool,
+	doIAllowRouting bool,
+	doIAllowLocalNetwork bool,
+	doIAllowFileshare bool,
+) (*mesh.InviteCode, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	data, err := json.Marshal(&mesh.CreateInviteCodeRequest{
+		AllowInbound:      doIAllowInbound,
+		AllowRouting:      doIAllowRouting,
+		AllowLocalNetwork: doIAllowLocalNetwork,
+		AllowFileshare:    doIAllowFileshare,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := api.request(
+		fmt.Sprintf(urlInviteCode, self.String()),
+		http.MethodPost,
+		data,
+		token,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := ExtractError(resp); err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var code mesh.InviteCode
+	if err := json.Unmarshal(body, &code); err != nil {
+		return nil, err
+	}
+	return &code, nil
+}
+
+// RedeemInviteCode joins the mesh network of the code creator.
+func (api *DefaultAPI) RedeemInviteCode(
+	token string,
+	self uuid.UUID,
+	code string,
+	doIAllowInbound bool,
+	doIAllowRouting bool,
+	doIAllowLocalNetwork bool,
+	doIAllowFileshare bool,
+) error {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	data, err := json.Marshal(&mesh.RedeemInviteCodeRequest{
+		Code:              code,
+		AllowInbound:      doIAllowInbound,
+		AllowRouting:      doIAllowRouting,
+		AllowLocalNetwork: doIAllowLocalNetwork,
+		AllowFileshare:    doIAllowFileshare,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp, err := api.request(
+		fmt.Sprintf(urlRedeemInviteCode, self.String()),
+		http.MethodPost,
+		data,
+		token,
+	)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return ExtractError(resp)
+}
+
 // Received invitations from other users.
 func (api *DefaultAPI) Received(token string, self uuid.UUID) (mesh.Invitations, error) {
 	api.mu.Lock()
@@ -3,6 +3,7 @@ package mesh
 import (
 	"encoding/json"
 	"net/netip"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -48,6 +49,8 @@ type MachineUpdateRequest struct {
 	Endpoints       []netip.AddrPort `json:"endpoints"`
 	SupportsRouting bool             `json:"traffic_routing_supported"`
 	Nickname        string           `json:"nickname"`
+	// AdvertisedSubnets are announced to the peers as reachable through this device
+	AdvertisedSubnets []netip.Prefix `json:"advertised_subnets"`
 }
 
 type MachinePeerResponse struct {
@@ -75,6 +78,8 @@ type MachinePeerResponse struct {
 	DoIAllowFileshare    bool   `json:"allow_peer_send_files"`
 	AlwaysAcceptFiles    bool   `json:"always_accept_files"`
 	Nickname             string `json:"nickname"`
+
+	AdvertisedSubnets []netip.Prefix `json:"advertised_subnets"`
 }
 
 type MachineMapResponse struct {
@@ -95,6 +100,8 @@ type MachineMapResponse struct {
 	DNS             DNS                   `json:"dns"`
 	Peers           []MachinePeerResponse `json:"peers"`
 	Nickname        string                `json:"nickname"`
+
+	AdvertisedSubnets []netip.Prefix `json:"advertised_subnets"`
 }
 
 // PeerUpdateRequest is used to update one's peer.
@@ -126,6 +133,8 @@ type Invitation struct {
 	AllowInbound      bool      `json:"allow_incoming_connections"`
 	AllowRouting      bool      `json:"allow_peer_traffic_routing"`
 	AllowLocalNetwork bool      `json:"allow_peer_local_network_access"`
+	AllowFileshare    bool      `json:"allow_peer_send_files"`
+	ExpiresAt         time.Time `json:"expires_at"`
 }
 
 type AcceptInvitationRequest struct {
@@ -141,6 +150,29 @@ type SendInvitationRequest struct {
 	AllowRouting      bool   `json:"allow_peer_traffic_routing"`
 	AllowLocalNetwork bool   `json:"allow_peer_local_network_access"`
 	AllowFileshare    bool   `json:"allow_peer_send_files"`
+	// ExpiresIn is the validity of the invitation in seconds, the API default is used when omitted
+	ExpiresIn uint32 `json:"expires_in,omitempty"`
+}
+
+// InviteCode is a short-lived code used to join the mesh network.
+type InviteCode struct {
+	Code      string    `json:"code"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type CreateInviteCodeRequest struct {
+	AllowInbound      bool `json:"allow_incoming_connections"`
+	AllowRouting      bool `json:"allow_peer_traffic_routing"`
+	AllowLocalNetwork bool `json:"allow_peer_local_network_access"`
+	AllowFileshare    bool `json:"allow_peer_send_files"`
+}
+
+type RedeemInviteCodeRequest struct {
+	Code              string `json:"code"`
+	AllowInbound      bool   `json:"allow_incoming_connections"`
+	AllowRouting      bool   `json:"allow_peer_traffic_routing"`
+	AllowLocalNetwork bool   `json:"allow_peer_local_network_access"`
+	AllowFileshare    bool   `json:"allow_peer_send_files"`
 }
 
 type NotificationNewTransactionRequest struct {
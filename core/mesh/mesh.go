@@ -2,6 +2,8 @@
 package mesh
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -41,11 +43,12 @@ type Registry interface {
 
 // Inviter defines a set of operations for managing personal mesh network.
 type Inviter interface {
-	// Invite to mesh network.
+	// Invite to mesh network. Invitation is valid for the ttl, or for the API default if it is 0.
 	Invite(
 		token string,
 		self uuid.UUID,
 		email string,
+		ttl time.Duration,
 		doIAllowInbound bool,
 		doIAllowRouting bool,
 		doIAllowLocalNetwork bool,
@@ -69,6 +72,26 @@ type Inviter interface {
 	Reject(token string, self uuid.UUID, invitation uuid.UUID) error
 	// Revoke an invitation.
 	Revoke(token string, self uuid.UUID, invitation uuid.UUID) error
+	// CreateInviteCode creates a short-lived code which can be redeemed by
+	// another device to join the mesh network without an email invitation.
+	CreateInviteCode(
+		token string,
+		self uuid.UUID,
+		doIAllowInbound bool,
+		doIAllowRouting bool,
+		doIAllowLocalNetwork bool,
+		doIAllowFileshare bool,
+	) (*InviteCode, error)
+	// RedeemInviteCode created by another device.
+	RedeemInviteCode(
+		token string,
+		self uuid.UUID,
+		code string,
+		doIAllowInbound bool,
+		doIAllowRouting bool,
+		doIAllowLocalNetwork bool,
+		doIAllowFileshare bool,
+	) error
 }
 
 // Invitations to join other mesh networks.
@@ -45,6 +45,8 @@ type Machine struct {
 	Address         netip.Addr
 	SupportsRouting bool
 	Nickname        string
+	// AdvertisedSubnets are reachable through this machine for the peers allowed to route traffic through it
+	AdvertisedSubnets []netip.Prefix
 }
 
 func (s Machine) ToProtobuf() *pb.Peer {
@@ -53,14 +55,15 @@ func (s Machine) ToProtobuf() *pb.Peer {
 		ip = s.Address.String()
 	}
 	return &pb.Peer{
-		Identifier: s.ID.String(),
-		Pubkey:     s.PublicKey,
-		Ip:         ip,
-		Endpoints:  s.EndpointsString(),
-		Os:         s.OS.Name,
-		Distro:     s.OS.Distro,
-		Hostname:   s.Hostname,
-		Nickname:   s.Nickname,
+		Identifier:        s.ID.String(),
+		Pubkey:            s.PublicKey,
+		Ip:                ip,
+		Endpoints:         s.EndpointsString(),
+		Os:                s.OS.Name,
+		Distro:            s.OS.Distro,
+		Hostname:          s.Hostname,
+		Nickname:          s.Nickname,
+		AdvertisedSubnets: prefixesToStrings(s.AdvertisedSubnets),
 	}
 }
 
@@ -113,6 +116,8 @@ type MachinePeer struct {
 	DoIAllowFileshare    bool
 	AlwaysAcceptFiles    bool
 	Nickname             string
+	// AdvertisedSubnets are reachable through the peer when it allows traffic routing
+	AdvertisedSubnets []netip.Prefix
 }
 
 func (p MachinePeer) ToProtobuf() *pb.Peer {
@@ -140,9 +145,18 @@ func (p MachinePeer) ToProtobuf() *pb.Peer {
 		DoIAllowFileshare:     p.DoIAllowFileshare,
 		AlwaysAcceptFiles:     p.AlwaysAcceptFiles,
 		Nickname:              p.Nickname,
+		AdvertisedSubnets:     prefixesToStrings(p.AdvertisedSubnets),
 	}
 }
 
+func prefixesToStrings(prefixes []netip.Prefix) []string {
+	var values []string
+	for _, prefix := range prefixes {
+		values = append(values, prefix.String())
+	}
+	return values
+}
+
 // EndpointsString could be replaced with
 // slices.Map(p.Endpoints, func(s Stringer) string { return s.String() })
 // once we upgrade to Go 1.18
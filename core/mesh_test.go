@@ -249,7 +249,7 @@ func TestMeshAPI_Invite(t *testing.T) {
 				http.DefaultClient,
 				response.NoopValidator{},
 			)
-			err := api.Invite("bearer", id, "elite@hacker.nord", false, false, false, false)
+			err := api.Invite("bearer", id, "elite@hacker.nord", 0, false, false, false, false)
 			assert.ErrorIs(t, err, test.err)
 		})
 	}
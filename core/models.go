@@ -38,6 +38,8 @@ const (
 	OpenVPNTCPObfuscated ServerTechnology = 17
 	// WireguardTech represents wireguard technology
 	WireguardTech ServerTechnology = 35
+	// NordWhisperTech represents NordWhisper technology
+	NordWhisperTech ServerTechnology = 51
 )
 
 type ServerBy int
@@ -70,6 +72,8 @@ const (
 	Offline         = "offline"
 	Maintenance     = "maintenance"
 	VirtualLocation = "virtual_location"
+	// DatacenterCountry is the specification naming the country where the virtual location is hosted
+	DatacenterCountry = "datacenter_country"
 )
 
 type UserCreateRequest struct {
@@ -299,6 +303,8 @@ func IsConnectableWithProtocol(tech config.Technology, proto config.Protocol) Pr
 		switch tech {
 		case config.Technology_NORDLYNX:
 			return IsConnectableVia(WireguardTech)(s)
+		case config.Technology_NORDWHISPER:
+			return IsConnectableVia(NordWhisperTech)(s)
 		case config.Technology_OPENVPN:
 			if proto == config.Protocol_UDP {
 				return IsConnectableVia(OpenVPNUDP)(s) ||
@@ -344,6 +350,18 @@ func (s *Server) Country() *Country {
 	return nil
 }
 
+// DatacenterCountry returns the country the server is physically hosted in. Virtual locations name it in their
+// specifications, other servers are hosted in the country of their location.
+func (s *Server) DatacenterCountry() string {
+	if datacenter := s.getSpecificationsForIdentifier(DatacenterCountry); len(datacenter) > 0 && datacenter[0] != "" {
+		return datacenter[0]
+	}
+	if country := s.Country(); country != nil {
+		return country.Name
+	}
+	return ""
+}
+
 func (s *Server) getSpecificationsForIdentifier(identifier string) []string {
 	for _, spec := range s.Specifications {
 		if spec.Identifier == identifier {
@@ -387,6 +405,16 @@ func (s *Server) IPv4() (netip.Addr, error) {
 	return netip.ParseAddr(s.Station)
 }
 
+// IPv6 returns the first IPv6 address of the server
+func (s *Server) IPv6() (netip.Addr, error) {
+	for _, ip := range s.IPs() {
+		if ip.Is6() {
+			return ip, nil
+		}
+	}
+	return netip.Addr{}, fmt.Errorf("server %s does not support IPv6", s.Hostname)
+}
+
 func (s *Server) UnmarshalJSON(b []byte) error {
 	// https://stackoverflow.com/questions/52433467/how-to-call-json-unmarshal-inside-unmarshaljson-without-causing-stack-overflow
 	type Hack Server
@@ -547,6 +547,32 @@ func TestIsConnectableWithProtocol(t *testing.T) {
 			proto:    config.Protocol_TCP,
 			expected: true,
 		},
+		{
+			name: "nordwhisper",
+			server: Server{
+				Status: Online,
+				Technologies: Technologies{{
+					ID:    NordWhisperTech,
+					Pivot: Pivot{Status: Online},
+				}},
+			},
+			tech:     config.Technology_NORDWHISPER,
+			proto:    config.Protocol_UDP,
+			expected: true,
+		},
+		{
+			name: "nordwhisper not supported by the server",
+			server: Server{
+				Status: Online,
+				Technologies: Technologies{{
+					ID:    WireguardTech,
+					Pivot: Pivot{Status: Online},
+				}},
+			},
+			tech:     config.Technology_NORDWHISPER,
+			proto:    config.Protocol_UDP,
+			expected: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -678,6 +704,20 @@ func TestServer_IPv4(t *testing.T) {
 	assert.Equal(t, netip.MustParseAddr("185.176.222.52"), got)
 }
 
+func TestServer_IPv6(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var server Server
+	err := json.Unmarshal([]byte(inputTest), &server)
+	assert.NoError(t, err)
+	got, err := server.IPv6()
+	assert.NoError(t, err)
+	assert.Equal(t, netip.MustParseAddr("::1"), got)
+
+	_, err = (&Server{Station: "185.176.222.52"}).IPv6()
+	assert.Error(t, err)
+}
+
 func TestServerVersion(t *testing.T) {
 	category.Set(t, category.Unit)
 
@@ -88,6 +88,32 @@ const (
 		"&fields[servers.locations.country.city.hub_score]" +
 		"&fields[servers.ips]"
 
+	// ServersURLUpdatesQuery is ServersURLConnectQuery for the servers updated after the given time. Servers
+	// which went offline are included, so they could be removed from the cached list.
+	ServersURLUpdatesQuery = "?limit=1073741824" +
+		"&filters[servers.updated_at][gt]=%s" +
+		"&fields[servers.id]" +
+		"&fields[servers.name]" +
+		"&fields[servers.hostname]" +
+		"&fields[servers.station]" +
+		"&fields[servers.status]" +
+		"&fields[servers.load]" +
+		"&fields[servers.created_at]" +
+		"&fields[servers.groups.id]" +
+		"&fields[servers.groups.title]" +
+		"&fields[servers.technologies.id]" +
+		"&fields[servers.technologies.metadata]" +
+		"&fields[servers.technologies.pivot.status]" +
+		"&fields[servers.specifications.identifier]" +
+		"&fields[servers.specifications.values.value]" +
+		"&fields[servers.locations.country.name]" +
+		"&fields[servers.locations.country.code]" +
+		"&fields[servers.locations.country.city.name]" +
+		"&fields[servers.locations.country.city.latitude]" +
+		"&fields[servers.locations.country.city.longitude]" +
+		"&fields[servers.locations.country.city.hub_score]" +
+		"&fields[servers.ips]"
+
 	RecommendedServersURLConnectQuery = "?limit=%d" +
 		"&filters[servers.status]=online" +
 		"&filters[servers_technologies]=%d" +
@@ -0,0 +1,275 @@
+package daemon
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	daemonevents "github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// analyticsEventsLimit is the number of the latest analytics events kept for the inspection
+const analyticsEventsLimit = 100
+
+// AnalyticsPublisher is the analytics engine receiving the daemon events.
+type AnalyticsPublisher interface {
+	events.Analytics
+	daemonevents.Publisher
+}
+
+// AnalyticsEvent is an event handed to the analytics engine or dropped because of the consent.
+type AnalyticsEvent struct {
+	Time     time.Time
+	Category config.AnalyticsCategory
+	Name     string
+	// Data is the JSON encoded event data
+	Data string
+	Sent bool
+}
+
+// analyticsInspector provides the analytics events recorded for the local inspection.
+type analyticsInspector interface {
+	Events() []AnalyticsEvent
+}
+
+// AnalyticsConsent forwards the analytics events only if the user has consented to their
+// category and keeps the latest events, so the user can verify what is being sent.
+type AnalyticsConsent struct {
+	analytics AnalyticsPublisher
+	cm        config.Manager
+	now       func() time.Time
+	mu        sync.Mutex
+	events    []AnalyticsEvent
+}
+
+func NewAnalyticsConsent(analytics AnalyticsPublisher, cm config.Manager) *AnalyticsConsent {
+	return &AnalyticsConsent{analytics: analytics, cm: cm, now: time.Now}
+}
+
+func (a *AnalyticsConsent) Enable() error  { return a.analytics.Enable() }
+func (a *AnalyticsConsent) Disable() error { return a.analytics.Disable() }
+
+// Events returns the recorded events, oldest first.
+func (a *AnalyticsConsent) Events() []AnalyticsEvent {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]AnalyticsEvent{}, a.events...)
+}
+
+// notify records the event and calls send if the event category is allowed. Events are
+// dropped if the config cannot be loaded, as the consent is unknown then.
+func (a *AnalyticsConsent) notify(
+	category config.AnalyticsCategory,
+	name string,
+	data any,
+	send func() error,
+) error {
+	var cfg config.Config
+	allowed := false
+	if err := a.cm.Load(&cfg); err != nil {
+		log.Println(internal.WarningPrefix, "dropping analytics event, config not loaded:", err)
+	} else {
+		allowed = cfg.Analytics.Get() && cfg.AnalyticsConsent.Allowed(category)
+	}
+
+	a.record(AnalyticsEvent{
+		Time:     a.now(),
+		Category: category,
+		Name:     name,
+		Data:     analyticsEventData(data),
+		Sent:     allowed,
+	})
+
+	if !allowed {
+		return nil
+	}
+	return send()
+}
+
+func (a *AnalyticsConsent) record(event AnalyticsEvent) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.events = append(a.events, event)
+	if len(a.events) > analyticsEventsLimit {
+		a.events = a.events[len(a.events)-analyticsEventsLimit:]
+	}
+}
+
+func analyticsEventData(data any) string {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Sprintf("%+v", data)
+	}
+	return string(b)
+}
+
+// statusCategory puts the failed operations into the crash category, and the rest into the
+// performance one as they report the operation timings.
+func statusCategory(status events.TypeEventStatus) config.AnalyticsCategory {
+	if status == events.StatusFailure {
+		return config.AnalyticsCrash
+	}
+	return config.AnalyticsPerformance
+}
+
+func (a *AnalyticsConsent) NotifyKillswitch(data bool) error {
+	return a.notify(config.AnalyticsUsage, "killswitch", data, func() error {
+		return a.analytics.NotifyKillswitch(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyAutoconnect(data bool) error {
+	return a.notify(config.AnalyticsUsage, "autoconnect", data, func() error {
+		return a.analytics.NotifyAutoconnect(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyDNS(data events.DataDNS) error {
+	return a.notify(config.AnalyticsUsage, "dns", data, func() error {
+		return a.analytics.NotifyDNS(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyThreatProtectionLite(data bool) error {
+	return a.notify(config.AnalyticsUsage, "threat_protection_lite", data, func() error {
+		return a.analytics.NotifyThreatProtectionLite(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyProtocol(data config.Protocol) error {
+	return a.notify(config.AnalyticsUsage, "protocol", data.String(), func() error {
+		return a.analytics.NotifyProtocol(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyAllowlist(data events.DataAllowlist) error {
+	return a.notify(config.AnalyticsUsage, "allowlist", data, func() error {
+		return a.analytics.NotifyAllowlist(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyTechnology(data config.Technology) error {
+	return a.notify(config.AnalyticsUsage, "technology", data.String(), func() error {
+		return a.analytics.NotifyTechnology(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyObfuscate(data bool) error {
+	return a.notify(config.AnalyticsUsage, "obfuscate", data, func() error {
+		return a.analytics.NotifyObfuscate(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyFirewall(data bool) error {
+	return a.notify(config.AnalyticsUsage, "firewall", data, func() error {
+		return a.analytics.NotifyFirewall(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyRouting(data bool) error {
+	return a.notify(config.AnalyticsUsage, "routing", data, func() error {
+		return a.analytics.NotifyRouting(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyNotify(data bool) error {
+	return a.notify(config.AnalyticsUsage, "notify", data, func() error {
+		return a.analytics.NotifyNotify(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyMeshnet(data bool) error {
+	return a.notify(config.AnalyticsUsage, "meshnet", data, func() error {
+		return a.analytics.NotifyMeshnet(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyIpv6(data bool) error {
+	return a.notify(config.AnalyticsUsage, "ipv6", data, func() error {
+		return a.analytics.NotifyIpv6(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyDefaults(data any) error {
+	return a.notify(config.AnalyticsUsage, "defaults", data, func() error {
+		return a.analytics.NotifyDefaults(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyLANDiscovery(data bool) error {
+	return a.notify(config.AnalyticsUsage, "lan_discovery", data, func() error {
+		return a.analytics.NotifyLANDiscovery(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyVirtualLocation(data bool) error {
+	return a.notify(config.AnalyticsUsage, "virtual_location", data, func() error {
+		return a.analytics.NotifyVirtualLocation(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyPostquantumVpn(data bool) error {
+	return a.notify(config.AnalyticsUsage, "post_quantum", data, func() error {
+		return a.analytics.NotifyPostquantumVpn(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyConnect(data events.DataConnect) error {
+	return a.notify(statusCategory(data.EventStatus), "connect", data, func() error {
+		return a.analytics.NotifyConnect(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyDisconnect(data events.DataDisconnect) error {
+	return a.notify(statusCategory(data.EventStatus), "disconnect", data, func() error {
+		return a.analytics.NotifyDisconnect(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyAccountCheck(data core.ServicesResponse) error {
+	return a.notify(config.AnalyticsUsage, "account_check", data, func() error {
+		return a.analytics.NotifyAccountCheck(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyUiItemsClick(data events.UiItemsAction) error {
+	return a.notify(config.AnalyticsUsage, "ui_items_click", data, func() error {
+		return a.analytics.NotifyUiItemsClick(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyHeartBeat(data int) error {
+	return a.notify(config.AnalyticsUsage, "heartbeat", data, func() error {
+		return a.analytics.NotifyHeartBeat(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyDeviceLocation(data core.Insights) error {
+	return a.notify(config.AnalyticsUsage, "device_location", data, func() error {
+		return a.analytics.NotifyDeviceLocation(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyLogin(data events.DataAuthorization) error {
+	return a.notify(statusCategory(data.EventStatus), "login", data, func() error {
+		return a.analytics.NotifyLogin(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyLogout(data events.DataAuthorization) error {
+	return a.notify(statusCategory(data.EventStatus), "logout", data, func() error {
+		return a.analytics.NotifyLogout(data)
+	})
+}
+
+func (a *AnalyticsConsent) NotifyMFA(data bool) error {
+	return a.notify(config.AnalyticsUsage, "mfa", data, func() error {
+		return a.analytics.NotifyMFA(data)
+	})
+}
@@ -0,0 +1,187 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	daemonevents "github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingAnalytics counts the forwarded events, only the notifications used in tests are implemented
+type recordingAnalytics struct {
+	mockAnalytics
+	daemonevents.Publisher
+	killswitch int
+	connect    int
+}
+
+func (r *recordingAnalytics) NotifyKillswitch(bool) error {
+	r.killswitch++
+	return nil
+}
+
+func (r *recordingAnalytics) NotifyConnect(events.DataConnect) error {
+	r.connect++
+	return nil
+}
+
+func TestAnalyticsConsent_Categories(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name               string
+		configure          func(*config.Config)
+		expectedKillswitch int
+		expectedConnect    int
+		expectedSent       []bool
+	}{
+		{
+			name:               "everything is sent by default",
+			configure:          func(*config.Config) {},
+			expectedKillswitch: 1,
+			expectedConnect:    2,
+			expectedSent:       []bool{true, true, true},
+		},
+		{
+			name:         "nothing is sent with analytics disabled",
+			configure:    func(c *config.Config) { c.Analytics.Set(false) },
+			expectedSent: []bool{false, false, false},
+		},
+		{
+			name:            "usage disabled",
+			configure:       func(c *config.Config) { c.AnalyticsConsent.Set(config.AnalyticsUsage, false) },
+			expectedConnect: 2,
+			expectedSent:    []bool{false, true, true},
+		},
+		{
+			name:               "crash disabled",
+			configure:          func(c *config.Config) { c.AnalyticsConsent.Set(config.AnalyticsCrash, false) },
+			expectedKillswitch: 1,
+			expectedConnect:    1,
+			expectedSent:       []bool{true, true, false},
+		},
+		{
+			name:               "performance disabled",
+			configure:          func(c *config.Config) { c.AnalyticsConsent.Set(config.AnalyticsPerformance, false) },
+			expectedKillswitch: 1,
+			expectedConnect:    1,
+			expectedSent:       []bool{true, false, true},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			test.configure(cm.Cfg)
+			analytics := &recordingAnalytics{}
+			consent := NewAnalyticsConsent(analytics, cm)
+
+			assert.NoError(t, consent.NotifyKillswitch(true))
+			assert.NoError(t, consent.NotifyConnect(events.DataConnect{EventStatus: events.StatusSuccess}))
+			assert.NoError(t, consent.NotifyConnect(events.DataConnect{EventStatus: events.StatusFailure}))
+
+			assert.Equal(t, test.expectedKillswitch, analytics.killswitch)
+			assert.Equal(t, test.expectedConnect, analytics.connect)
+
+			recorded := consent.Events()
+			assert.Len(t, recorded, 3)
+			var sent []bool
+			for _, event := range recorded {
+				sent = append(sent, event.Sent)
+			}
+			assert.Equal(t, test.expectedSent, sent)
+			assert.Equal(t, config.AnalyticsUsage, recorded[0].Category)
+			assert.Equal(t, config.AnalyticsPerformance, recorded[1].Category)
+			assert.Equal(t, config.AnalyticsCrash, recorded[2].Category)
+		})
+	}
+}
+
+func TestAnalyticsConsent_ConfigLoadFailureDropsEvents(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.LoadErr = errors.New("load failed")
+	analytics := &recordingAnalytics{}
+	consent := NewAnalyticsConsent(analytics, cm)
+
+	assert.NoError(t, consent.NotifyKillswitch(true))
+	assert.Equal(t, 0, analytics.killswitch)
+	assert.False(t, consent.Events()[0].Sent)
+}
+
+func TestAnalyticsConsent_KeepsLatestEvents(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	consent := NewAnalyticsConsent(&recordingAnalytics{}, mock.NewMockConfigManager())
+	for i := 0; i < analyticsEventsLimit+5; i++ {
+		assert.NoError(t, consent.NotifyConnect(events.DataConnect{TargetServerName: fmt.Sprint(i)}))
+	}
+
+	recorded := consent.Events()
+	assert.Len(t, recorded, analyticsEventsLimit)
+	assert.Contains(t, recorded[0].Data, `"TargetServerName":"5"`)
+	assert.Contains(t, recorded[analyticsEventsLimit-1].Data, fmt.Sprintf(`"TargetServerName":"%d"`, analyticsEventsLimit+4))
+}
+
+func TestAnalyticsEvents(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	consent := NewAnalyticsConsent(&recordingAnalytics{}, mock.NewMockConfigManager())
+	consent.now = func() time.Time { return time.UnixMilli(1700000000000) }
+	assert.NoError(t, consent.NotifyKillswitch(true))
+
+	r := RPC{analytics: consent}
+	resp, err := r.AnalyticsEvents(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.AnalyticsEvent{{
+		Timestamp: 1700000000000,
+		Category:  pb.AnalyticsCategory_ANALYTICS_USAGE,
+		Name:      "killswitch",
+		Data:      "true",
+		Sent:      true,
+	}}, resp.GetEvents())
+
+	r = RPC{analytics: &mockAnalytics{}}
+	resp, err = r.AnalyticsEvents(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetEvents())
+}
+
+func TestSetAnalyticsCategory(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	r := RPC{cm: cm}
+
+	resp, err := r.SetAnalyticsCategory(context.Background(), &pb.SetAnalyticsCategoryRequest{
+		Category: pb.AnalyticsCategory_ANALYTICS_USAGE,
+		Enabled:  true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeNothingToDo, resp.Type)
+
+	resp, err = r.SetAnalyticsCategory(context.Background(), &pb.SetAnalyticsCategoryRequest{
+		Category: pb.AnalyticsCategory_ANALYTICS_USAGE,
+		Enabled:  false,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.False(t, cm.Cfg.AnalyticsConsent.Allowed(config.AnalyticsUsage))
+	assert.True(t, cm.Cfg.AnalyticsConsent.Allowed(config.AnalyticsCrash))
+
+	resp, err = r.SetAnalyticsCategory(context.Background(), &pb.SetAnalyticsCategoryRequest{Category: 42})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeFormatError, resp.Type)
+}
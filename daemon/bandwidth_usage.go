@@ -0,0 +1,205 @@
+package daemon
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"log"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// bandwidthUsageRetention is the number of days for which the transferred bytes are kept
+const bandwidthUsageRetention = 92
+
+// bandwidthSampleInterval defines how often the transferred bytes of the active connection are recorded
+const bandwidthSampleInterval = time.Minute
+
+// BandwidthUsageEntry is the number of bytes transferred through the server during a single day
+type BandwidthUsageEntry struct {
+	// Day is the midnight of the day in the local time
+	Day      time.Time
+	Server   string
+	Download uint64
+	Upload   uint64
+}
+
+// BandwidthTotal is the number of bytes transferred during a day or a week or through a server
+type BandwidthTotal struct {
+	// Start is the start of the day or week, zero when the totals are grouped by server
+	Start    time.Time
+	Server   string
+	Download uint64
+	Upload   uint64
+}
+
+// BandwidthUsage keeps daily totals of the bytes transferred through VPN in a file, so the users on capped
+// connections can see how much of their allowance was used by VPN
+type BandwidthUsage struct {
+	mu       sync.Mutex
+	filePath string
+	entries  []BandwidthUsageEntry
+	// server, download and upload are the counters of the active connection at the last record
+	server   string
+	download uint64
+	upload   uint64
+	now      func() time.Time
+}
+
+// NewBandwidthUsage loads the totals from the file
+func NewBandwidthUsage(filePath string) *BandwidthUsage {
+	b := &BandwidthUsage{filePath: filePath, now: time.Now}
+	if err := b.load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Println(internal.WarningPrefix, "loading bandwidth usage:", err)
+	}
+	return b
+}
+
+// Record adds the bytes transferred by the active connection since the previous record to the totals of today
+func (b *BandwidthUsage) Record(server string, download, upload uint64) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// counters start from zero for every connection
+	if server != b.server || download < b.download || upload < b.upload {
+		b.server, b.download, b.upload = server, 0, 0
+	}
+	downloadDelta, uploadDelta := download-b.download, upload-b.upload
+	b.download, b.upload = download, upload
+	if downloadDelta == 0 && uploadDelta == 0 {
+		return nil
+	}
+
+	today := startOfDay(b.now())
+	entry := b.find(today, server)
+	if entry == nil {
+		b.entries = append(b.entries, BandwidthUsageEntry{Day: today, Server: server})
+		entry = &b.entries[len(b.entries)-1]
+	}
+	entry.Download += downloadDelta
+	entry.Upload += uploadDelta
+	b.prune(today)
+	return b.save()
+}
+
+// NotifyConnect resets the counters of the previous connection, so the new one is recorded from the start
+func (b *BandwidthUsage) NotifyConnect(e events.DataConnect) error {
+	if e.EventStatus != events.StatusSuccess {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.server, b.download, b.upload = "", 0, 0
+	return nil
+}
+
+// ByDay returns the totals of the latest days, newest first. All days are returned if limit is not positive.
+func (b *BandwidthUsage) ByDay(limit int) []BandwidthTotal {
+	return b.byPeriod(func(day time.Time) time.Time { return day }, limit)
+}
+
+// ByWeek returns the totals of the latest weeks starting on Monday, newest first. All weeks are returned if
+// limit is not positive.
+func (b *BandwidthUsage) ByWeek(limit int) []BandwidthTotal {
+	return b.byPeriod(startOfWeek, limit)
+}
+
+// ByServer returns the totals of the servers, the servers which transferred the most bytes are first
+func (b *BandwidthUsage) ByServer() []BandwidthTotal {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var totals []BandwidthTotal
+	index := map[string]int{}
+	for _, entry := range b.entries {
+		i, ok := index[entry.Server]
+		if !ok {
+			i = len(totals)
+			index[entry.Server] = i
+			totals = append(totals, BandwidthTotal{Server: entry.Server})
+		}
+		totals[i].Download += entry.Download
+		totals[i].Upload += entry.Upload
+	}
+	sort.SliceStable(totals, func(i, j int) bool {
+		return totals[i].Download+totals[i].Upload > totals[j].Download+totals[j].Upload
+	})
+	return totals
+}
+
+func (b *BandwidthUsage) byPeriod(periodStart func(day time.Time) time.Time, limit int) []BandwidthTotal {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var totals []BandwidthTotal
+	for _, entry := range b.entries {
+		start := periodStart(entry.Day)
+		if len(totals) == 0 || !totals[len(totals)-1].Start.Equal(start) {
+			totals = append(totals, BandwidthTotal{Start: start})
+		}
+		totals[len(totals)-1].Download += entry.Download
+		totals[len(totals)-1].Upload += entry.Upload
+	}
+
+	if limit <= 0 || limit > len(totals) {
+		limit = len(totals)
+	}
+	latest := make([]BandwidthTotal, 0, limit)
+	for i := len(totals) - 1; i >= len(totals)-limit; i-- {
+		latest = append(latest, totals[i])
+	}
+	return latest
+}
+
+// find returns the entry of the server for the given day, entries are ordered by day
+func (b *BandwidthUsage) find(day time.Time, server string) *BandwidthUsageEntry {
+	for i := len(b.entries) - 1; i >= 0 && b.entries[i].Day.Equal(day); i-- {
+		if b.entries[i].Server == server {
+			return &b.entries[i]
+		}
+	}
+	return nil
+}
+
+// prune removes the entries older than the retention period
+func (b *BandwidthUsage) prune(today time.Time) {
+	oldest := today.AddDate(0, 0, -bandwidthUsageRetention)
+	i := 0
+	for i < len(b.entries) && b.entries[i].Day.Before(oldest) {
+		i++
+	}
+	b.entries = b.entries[i:]
+}
+
+func (b *BandwidthUsage) load() error {
+	content, err := internal.FileRead(b.filePath)
+	if err != nil {
+		return err
+	}
+	return gob.NewDecoder(bytes.NewReader(content)).Decode(&b.entries)
+}
+
+func (b *BandwidthUsage) save() error {
+	if b.filePath == "" {
+		return nil
+	}
+	buffer := &bytes.Buffer{}
+	if err := gob.NewEncoder(buffer).Encode(b.entries); err != nil {
+		return err
+	}
+	return internal.FileWrite(b.filePath, buffer.Bytes(), internal.PermUserRW)
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+func startOfWeek(day time.Time) time.Time {
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
@@ -0,0 +1,96 @@
+package daemon
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBandwidthUsage(filePath string, now *time.Time) *BandwidthUsage {
+	usage := NewBandwidthUsage(filePath)
+	usage.now = func() time.Time { return *now }
+	return usage
+}
+
+func TestBandwidthUsage_Record(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	// Wednesday
+	now := time.Date(2024, 5, 1, 22, 0, 0, 0, time.UTC)
+	usage := newTestBandwidthUsage("", &now)
+
+	require.NoError(t, usage.Record("de1.nordvpn.com", 100, 10))
+	require.NoError(t, usage.Record("de1.nordvpn.com", 300, 30))
+	// counters of the same connection after midnight
+	now = now.Add(4 * time.Hour)
+	require.NoError(t, usage.Record("de1.nordvpn.com", 1000, 100))
+	// another server starts from zero
+	require.NoError(t, usage.Record("us1.nordvpn.com", 50, 5))
+	// reconnected to the same server
+	require.NoError(t, usage.NotifyConnect(events.DataConnect{EventStatus: events.StatusSuccess}))
+	require.NoError(t, usage.Record("us1.nordvpn.com", 20, 2))
+
+	assert.Equal(t, []BandwidthTotal{
+		{Start: time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC), Download: 770, Upload: 77},
+		{Start: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Download: 300, Upload: 30},
+	}, usage.ByDay(0))
+	assert.Len(t, usage.ByDay(1), 1)
+
+	assert.Equal(t, []BandwidthTotal{
+		{Server: "de1.nordvpn.com", Download: 1000, Upload: 100},
+		{Server: "us1.nordvpn.com", Download: 70, Upload: 7},
+	}, usage.ByServer())
+}
+
+func TestBandwidthUsage_ByWeek(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	// Sunday
+	now := time.Date(2024, 5, 5, 12, 0, 0, 0, time.UTC)
+	usage := newTestBandwidthUsage("", &now)
+	require.NoError(t, usage.Record("de1.nordvpn.com", 100, 10))
+	now = now.AddDate(0, 0, 1)
+	require.NoError(t, usage.Record("de1.nordvpn.com", 300, 30))
+	now = now.AddDate(0, 0, 6)
+	require.NoError(t, usage.Record("de1.nordvpn.com", 600, 60))
+
+	assert.Equal(t, []BandwidthTotal{
+		{Start: time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC), Download: 500, Upload: 50},
+		{Start: time.Date(2024, 4, 29, 0, 0, 0, 0, time.UTC), Download: 100, Upload: 10},
+	}, usage.ByWeek(0))
+}
+
+func TestBandwidthUsage_Retention(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	usage := newTestBandwidthUsage("", &now)
+	require.NoError(t, usage.Record("de1.nordvpn.com", 100, 10))
+	now = now.AddDate(0, 0, bandwidthUsageRetention+1)
+	require.NoError(t, usage.Record("de1.nordvpn.com", 300, 30))
+
+	totals := usage.ByDay(0)
+	require.Len(t, totals, 1)
+	assert.Equal(t, uint64(200), totals[0].Download)
+}
+
+func TestBandwidthUsage_Persisted(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	path := filepath.Join(t.TempDir(), "bandwidth.dat")
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	usage := newTestBandwidthUsage(path, &now)
+	require.NoError(t, usage.Record("de1.nordvpn.com", 100, 10))
+
+	loaded := newTestBandwidthUsage(path, &now)
+	assert.Equal(t, []BandwidthTotal{{Server: "de1.nordvpn.com", Download: 100, Upload: 10}}, loaded.ByServer())
+	// counters are not known after a restart, so the first record starts from zero
+	require.NoError(t, loaded.Record("de1.nordvpn.com", 150, 15))
+	assert.Equal(t, []BandwidthTotal{{Server: "de1.nordvpn.com", Download: 250, Upload: 25}}, loaded.ByServer())
+}
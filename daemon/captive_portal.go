@@ -0,0 +1,189 @@
+package daemon
+
+import (
+	"log"
+	"maps"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+)
+
+const (
+	// captivePortalCheckInterval defines how often the connectivity is checked while the user logs in to the portal
+	captivePortalCheckInterval = 5 * time.Second
+	// captivePortalTimeout defines how long the firewall exception for the portal is kept if the user does not log in
+	captivePortalTimeout = 15 * time.Minute
+)
+
+// CaptivePortalProber detects the captive portal of the network
+type CaptivePortalProber interface {
+	// Probe returns the login page of the captive portal, empty if the network is not behind one
+	Probe() (string, error)
+	// LookupPortal returns the addresses of the host serving the login page
+	LookupPortal(portalURL string) ([]netip.Addr, error)
+}
+
+// CaptivePortal checks for the captive portals after the network changes. While the user has not logged in to the
+// portal, traffic to it is allowed even if the kill switch or the VPN connection would block it. Full protection is
+// restored as soon as the network is reachable.
+type CaptivePortal struct {
+	mu     sync.Mutex
+	cm     config.Manager
+	netw   networker.Networker
+	prober CaptivePortalProber
+	// portalURL is the login page of the detected portal, empty if there is none
+	portalURL string
+	// exception is set while the traffic to the portal is allowed
+	exception bool
+	checking  bool
+	interval  time.Duration
+	timeout   time.Duration
+}
+
+func newCaptivePortal(cm config.Manager, netw networker.Networker, prober CaptivePortalProber) *CaptivePortal {
+	return &CaptivePortal{
+		cm:       cm,
+		netw:     netw,
+		prober:   prober,
+		interval: captivePortalCheckInterval,
+		timeout:  captivePortalTimeout,
+	}
+}
+
+// Reconnect checks for the captive portal after the network has changed. It implements netstate.Reconnector.
+func (c *CaptivePortal) Reconnect(stateIsUp bool) {
+	if stateIsUp {
+		go c.check()
+	}
+}
+
+// URL returns the login page of the captive portal, empty if the network is not behind one
+func (c *CaptivePortal) URL() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.portalURL
+}
+
+// check probes for the captive portal and, if there is one, waits for the user to log in
+func (c *CaptivePortal) check() {
+	if c.prober == nil || !c.startChecking() {
+		return
+	}
+	defer c.stopChecking()
+
+	portalURL, err := c.prober.Probe()
+	if err != nil {
+		log.Println(internal.DebugPrefix, "captive portal was not checked:", err)
+		return
+	}
+	if portalURL == "" {
+		return
+	}
+
+	log.Println(internal.InfoPrefix, "captive portal detected, login page:", portalURL)
+	c.allowPortal(portalURL)
+
+	deadline := time.Now().Add(c.timeout)
+	for time.Now().Before(deadline) {
+		<-time.After(c.interval)
+		portalURL, err := c.prober.Probe()
+		if err != nil {
+			// portal may drop the connections while the user logs in
+			log.Println(internal.DebugPrefix, "checking connectivity behind captive portal:", err)
+			continue
+		}
+		if portalURL == "" {
+			log.Println(internal.InfoPrefix, "logged in to captive portal, restoring full protection")
+			c.restore()
+			return
+		}
+	}
+	log.Println(internal.WarningPrefix, "captive portal login timed out, restoring full protection")
+	c.restore()
+}
+
+func (c *CaptivePortal) startChecking() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.checking {
+		return false
+	}
+	c.checking = true
+	return true
+}
+
+func (c *CaptivePortal) stopChecking() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checking = false
+}
+
+// allowPortal remembers the portal and adds its addresses to the allowlist applied to the firewall if the traffic
+// is blocked. The allowlist in the config is not changed.
+func (c *CaptivePortal) allowPortal(portalURL string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.portalURL = portalURL
+
+	if !c.netw.IsNetworkSet() {
+		return
+	}
+	addrs, err := c.prober.LookupPortal(portalURL)
+	if err != nil {
+		log.Println(internal.WarningPrefix, err)
+		return
+	}
+
+	allowlist, err := c.allowlist()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "loading config for captive portal:", err)
+		return
+	}
+	for _, addr := range addrs {
+		allowlist.UpdateSubnets(netip.PrefixFrom(addr, addr.BitLen()).String(), false)
+	}
+	if err := c.netw.SetAllowlist(allowlist); err != nil {
+		log.Println(internal.ErrorPrefix, "allowing traffic to captive portal:", err)
+		return
+	}
+	c.exception = true
+}
+
+// restore forgets the portal and applies the allowlist from the config again
+func (c *CaptivePortal) restore() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.portalURL = ""
+
+	if !c.exception {
+		return
+	}
+	allowlist, err := c.allowlist()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "loading config for captive portal:", err)
+		return
+	}
+	if err := c.netw.SetAllowlist(allowlist); err != nil {
+		log.Println(internal.ErrorPrefix, "removing captive portal from allowlist:", err)
+		return
+	}
+	c.exception = false
+}
+
+// allowlist returns a copy of the allowlist from the config, so it can be extended with the portal
+func (c *CaptivePortal) allowlist() (config.Allowlist, error) {
+	var cfg config.Config
+	if err := c.cm.Load(&cfg); err != nil {
+		return config.Allowlist{}, err
+	}
+	allowlist := cfg.AutoConnectData.Allowlist
+	allowlist.Subnets = maps.Clone(allowlist.Subnets)
+	if allowlist.Subnets == nil {
+		allowlist.Subnets = config.Subnets{}
+	}
+	return allowlist, nil
+}
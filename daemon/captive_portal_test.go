@@ -0,0 +1,136 @@
+package daemon
+
+import (
+	"errors"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockCaptivePortalProber struct {
+	mu sync.Mutex
+	// results are returned by the consecutive probes, the last one is repeated
+	results []string
+	errs    []error
+	probes  int
+}
+
+func (m *mockCaptivePortalProber) Probe() (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	i := min(m.probes, len(m.results)-1)
+	m.probes++
+	var err error
+	if i < len(m.errs) {
+		err = m.errs[i]
+	}
+	return m.results[i], err
+}
+
+func (*mockCaptivePortalProber) LookupPortal(string) ([]netip.Addr, error) {
+	return []netip.Addr{netip.MustParseAddr("10.0.0.1")}, nil
+}
+
+// killSwitchNetworker has the firewall set, so the traffic to the portal has to be allowed
+type killSwitchNetworker struct {
+	networker.Mock
+	mu         sync.Mutex
+	allowlists []config.Allowlist
+}
+
+func (*killSwitchNetworker) IsNetworkSet() bool { return true }
+
+func (n *killSwitchNetworker) SetAllowlist(allowlist config.Allowlist) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.allowlists = append(n.allowlists, allowlist)
+	return nil
+}
+
+func newTestCaptivePortal(netw *killSwitchNetworker, prober CaptivePortalProber) *CaptivePortal {
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.AutoConnectData.Allowlist = config.NewAllowlist(nil, nil, []string{"192.168.1.0/24"})
+	c := newCaptivePortal(cm, netw, prober)
+	c.interval = time.Millisecond
+	c.timeout = time.Second
+	return c
+}
+
+func TestCaptivePortal_LoggedIn(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	netw := &killSwitchNetworker{}
+	prober := &mockCaptivePortalProber{
+		results: []string{"http://10.0.0.1/login", "http://10.0.0.1/login", "", ""},
+		errs:    []error{nil, errors.New("connection reset")},
+	}
+	c := newTestCaptivePortal(netw, prober)
+
+	c.check()
+
+	assert.Empty(t, c.URL())
+	assert.Equal(t, 3, prober.probes)
+	assert.Len(t, netw.allowlists, 2)
+	assert.Equal(t, config.Subnets{"192.168.1.0/24": true, "10.0.0.1/32": true}, netw.allowlists[0].Subnets)
+	assert.Equal(t, config.Subnets{"192.168.1.0/24": true}, netw.allowlists[1].Subnets)
+}
+
+func TestCaptivePortal_NoPortal(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for _, prober := range []*mockCaptivePortalProber{
+		{results: []string{""}},
+		{results: []string{""}, errs: []error{errors.New("network is unreachable")}},
+	} {
+		netw := &killSwitchNetworker{}
+		c := newTestCaptivePortal(netw, prober)
+
+		c.check()
+
+		assert.Empty(t, c.URL())
+		assert.Equal(t, 1, prober.probes)
+		assert.Empty(t, netw.allowlists)
+	}
+}
+
+func TestCaptivePortal_WaitingForLogin(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	netw := &killSwitchNetworker{}
+	prober := &mockCaptivePortalProber{results: []string{"http://10.0.0.1/login"}}
+	c := newTestCaptivePortal(netw, prober)
+	c.interval = time.Hour
+
+	go c.check()
+	assert.Eventually(t, func() bool { return c.URL() != "" }, time.Second, time.Millisecond)
+	assert.Equal(t, "http://10.0.0.1/login", c.URL())
+
+	// checks are not started again while waiting for the login
+	c.check()
+	prober.mu.Lock()
+	defer prober.mu.Unlock()
+	assert.Equal(t, 1, prober.probes)
+}
+
+func TestCaptivePortal_TimedOut(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	netw := &killSwitchNetworker{}
+	prober := &mockCaptivePortalProber{results: []string{"http://10.0.0.1/login"}}
+	c := newTestCaptivePortal(netw, prober)
+	c.timeout = 10 * time.Millisecond
+
+	c.check()
+
+	assert.Empty(t, c.URL())
+	assert.Len(t, netw.allowlists, 2)
+	assert.Equal(t, config.Subnets{"192.168.1.0/24": true}, netw.allowlists[1].Subnets)
+}
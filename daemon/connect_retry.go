@@ -0,0 +1,78 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// retryConnectServer holds back the failure of the connection attempt unless it is the last one, so that the
+// failure is reported only when there are no attempts left
+type retryConnectServer struct {
+	pb.Daemon_ConnectServer
+	last    bool
+	failure *pb.Payload
+}
+
+func (s *retryConnectServer) Send(payload *pb.Payload) error {
+	if payload.GetType() == internal.CodeFailure && !s.last {
+		s.failure = payload
+		return nil
+	}
+	return s.Daemon_ConnectServer.Send(payload)
+}
+
+// connectWithRetry retries the failed connection according to the configured retry policy. The server is selected
+// again for every attempt and the number of the attempt is reported when more than one is configured.
+func (r *RPC) connectWithRetry(
+	ctx context.Context,
+	in *pb.ConnectRequest,
+	srv pb.Daemon_ConnectServer,
+	rung config.TechnologyRung,
+) error {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+	policy := cfg.ConnectRetry
+	attempts := policy.AttemptsOrDefault()
+
+	var failure *pb.Payload
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if delay := policy.Delay(attempt); delay > 0 {
+			log.Println(internal.InfoPrefix, "retrying connection after", delay)
+			select {
+			case <-ctx.Done():
+				return srv.Send(&pb.Payload{Type: internal.CodeDisconnected, Data: failure.GetData()})
+			case <-time.After(delay):
+			}
+		}
+		if attempts > 1 {
+			if err := srv.Send(&pb.Payload{
+				Type: internal.CodeConnectAttempt,
+				Data: []string{strconv.Itoa(attempt), strconv.Itoa(attempts)},
+			}); err != nil {
+				log.Println(internal.ErrorPrefix, err)
+			}
+		}
+
+		attemptCtx, cancel := ctx, context.CancelFunc(func() {})
+		if policy.AttemptTimeout > 0 {
+			attemptCtx, cancel = context.WithTimeout(ctx, policy.AttemptTimeout)
+		}
+		server := &retryConnectServer{Daemon_ConnectServer: srv, last: attempt == attempts}
+		err := r.connect(attemptCtx, in, server, rung)
+		cancel()
+		if err != nil || server.failure == nil {
+			return err
+		}
+		failure = server.failure
+		log.Println(internal.WarningPrefix, "connection attempt", attempt, "of", attempts, "has failed")
+	}
+	return nil
+}
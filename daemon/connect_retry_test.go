@@ -0,0 +1,163 @@
+package daemon
+
+import (
+	"context"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	daemonevents "github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/response"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/sharedctx"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testfirewall "github.com/NordSecurity/nordvpn-linux/test/mock/firewall"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	testnorduser "github.com/NordSecurity/nordvpn-linux/test/mock/norduser/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// flakyNetworker fails the given number of connection attempts before connecting
+type flakyNetworker struct {
+	testnetworker.Mock
+	failures int
+	attempts int
+}
+
+func (n *flakyNetworker) Start(
+	context.Context,
+	vpn.Credentials,
+	vpn.ServerData,
+	config.Allowlist,
+	config.DNS,
+	bool,
+) error {
+	n.attempts++
+	if n.attempts <= n.failures {
+		return mock.ErrOnPurpose
+	}
+	return nil
+}
+
+// payloadRecorder keeps the types of all payloads sent to the client
+type payloadRecorder struct {
+	pb.Daemon_ConnectServer
+	types []int64
+	data  [][]string
+}
+
+func (r *payloadRecorder) Send(payload *pb.Payload) error {
+	r.types = append(r.types, payload.GetType())
+	r.data = append(r.data, payload.GetData())
+	return nil
+}
+
+func TestConnectWithRetry(t *testing.T) {
+	category.Set(t, category.Unit)
+	defer testsCleanup()
+
+	tests := []struct {
+		name             string
+		retry            config.ConnectRetry
+		failures         int
+		expectedAttempts int
+		expectedTypes    []int64
+	}{
+		{
+			name:             "single attempt by default",
+			failures:         1,
+			expectedAttempts: 1,
+			expectedTypes:    []int64{internal.CodeConnecting, internal.CodeFailure},
+		},
+		{
+			name:             "connects after retrying",
+			retry:            config.ConnectRetry{Attempts: 3, Backoff: time.Millisecond},
+			failures:         1,
+			expectedAttempts: 2,
+			expectedTypes: []int64{
+				internal.CodeConnectAttempt, internal.CodeConnecting,
+				internal.CodeConnectAttempt, internal.CodeConnecting, internal.CodeConnected,
+			},
+		},
+		{
+			name:             "fails after the last attempt",
+			retry:            config.ConnectRetry{Attempts: 2, Backoff: time.Millisecond},
+			failures:         2,
+			expectedAttempts: 2,
+			expectedTypes: []int64{
+				internal.CodeConnectAttempt, internal.CodeConnecting,
+				internal.CodeConnectAttempt, internal.CodeConnecting, internal.CodeFailure,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Technology = config.Technology_NORDLYNX
+			cm.Cfg.AutoConnectData = config.AutoConnectData{ID: 1337, Protocol: config.Protocol_UDP}
+			cm.Cfg.UsersData = &config.UsersData{}
+			cm.Cfg.ObfuscationFallback.Set(false)
+			cm.Cfg.ConnectRetry = test.retry
+			dm := testNewDataManager()
+			dm.SetServersData(time.Now(), serversList(), "", time.Time{})
+			netw := &flakyNetworker{failures: test.failures}
+			rpc := NewRPC(
+				internal.Development,
+				&workingLoginChecker{},
+				cm,
+				dm,
+				core.NewDefaultAPI("", "", http.DefaultClient, response.NoopValidator{}),
+				mockServersAPI{},
+				&validCredentialsAPI{},
+				testNewCDNAPI(),
+				testNewRepoAPI(),
+				&mockAuthenticationAPI{},
+				"1.0.0",
+				&testfirewall.FirewallMock{},
+				daemonevents.NewEventsEmpty(),
+				func(config.Technology) (vpn.VPN, error) { return &mock.WorkingVPN{}, nil },
+				newEndpointResolverMock(netip.MustParseAddr("127.0.0.1")),
+				netw,
+				&subs.Subject[string]{},
+				&mock.DNSGetter{Names: []string{"1.1.1.1"}},
+				nil,
+				&mockAnalytics{},
+				&testnorduser.MockNorduserCombinedService{},
+				nil,
+				nil,
+				&RegistryMock{},
+				nil,
+				sharedctx.New(),
+				NewPendingActions(func() bool { return true }),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			server := &payloadRecorder{}
+			assert.NoError(t, rpc.Connect(&pb.ConnectRequest{}, server))
+			assert.Equal(t, test.expectedAttempts, netw.attempts)
+			assert.Equal(t, test.expectedTypes, server.types)
+			if test.retry.Attempts > 1 {
+				attempts := strconv.Itoa(test.retry.Attempts)
+				assert.Equal(t, []string{"1", attempts}, server.data[0])
+				assert.Equal(t, []string{"2", attempts}, server.data[2])
+			}
+		})
+	}
+}
@@ -0,0 +1,330 @@
+package daemon
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// connectionHistoryLimit is the number of the latest connection attempts which are kept
+const connectionHistoryLimit = 200
+
+// lostBeforeDisconnectWindow is the time within which the tunnel going down is attributed to the disconnect
+// requested by the user. Some VPN implementations report stopping the tunnel before the disconnect is published.
+const lostBeforeDisconnectWindow = 5 * time.Second
+
+// sessionResumeWindow is the time within which the connection to the same server made after the daemon restart,
+// e.g. on package upgrade, continues the session which was active when the daemon stopped
+const sessionResumeWindow = 2 * time.Minute
+
+// ConnectionResult is the outcome of the connection attempt
+type ConnectionResult string
+
+const (
+	ConnectionConnecting ConnectionResult = "connecting"
+	ConnectionConnected  ConnectionResult = "connected"
+	ConnectionFailed     ConnectionResult = "failed"
+	ConnectionCanceled   ConnectionResult = "canceled"
+)
+
+// ConnectionEndReason tells why the established connection has ended
+type ConnectionEndReason string
+
+const (
+	// ConnectionEndDisconnected means the user has disconnected or paused the connection
+	ConnectionEndDisconnected ConnectionEndReason = "disconnected"
+	// ConnectionEndLost means the tunnel went down without the user asking for it
+	ConnectionEndLost ConnectionEndReason = "lost"
+	// ConnectionEndReconnected means another connection was started without disconnecting first
+	ConnectionEndReconnected ConnectionEndReason = "reconnected"
+	// ConnectionEndDaemonStopped means the daemon was stopped while connected
+	ConnectionEndDaemonStopped ConnectionEndReason = "daemon stopped"
+)
+
+// ConnectionHistoryEntry is a single connection attempt
+type ConnectionHistoryEntry struct {
+	Started    time.Time
+	Server     string
+	Country    string
+	City       string
+	Technology config.Technology
+	Protocol   config.Protocol
+	// Automatic is true for auto-connect and for the connections restored by the VPN implementation
+	Automatic bool
+	Result    ConnectionResult
+	Error     string
+	// ConnectDuration is the time it took to establish the connection
+	ConnectDuration time.Duration
+	// Ended is zero while the connection is active or if it has not been established
+	Ended     time.Time
+	EndReason ConnectionEndReason
+	// Download and Upload are the bytes transferred, updated periodically while the connection is active
+	Download uint64
+	Upload   uint64
+}
+
+// isActive reports whether the connection was established and has not ended yet
+func (e ConnectionHistoryEntry) isActive() bool {
+	return e.Result == ConnectionConnected && e.EndReason == ""
+}
+
+// ConnectionHistory keeps the latest connection attempts in a file, so the disconnects that happened while
+// nobody was watching can be investigated later
+type ConnectionHistory struct {
+	mu       sync.Mutex
+	filePath string
+	entries  []ConnectionHistoryEntry
+	// resumed is set while the active connection continues the session from before the daemon restart. The bytes
+	// transferred before the restart are not counted by the new tunnel, so they are added to its counters.
+	resumed         bool
+	resumedDownload uint64
+	resumedUpload   uint64
+}
+
+// NewConnectionHistory loads the history from the file. Connections which were active when the daemon
+// was stopped are marked as ended.
+func NewConnectionHistory(filePath string) *ConnectionHistory {
+	h := &ConnectionHistory{filePath: filePath}
+	if err := h.load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Println(internal.WarningPrefix, "loading connection history:", err)
+	}
+	for i := range h.entries {
+		switch {
+		case h.entries[i].isActive():
+			h.entries[i].EndReason = ConnectionEndDaemonStopped
+		case h.entries[i].Result == ConnectionConnecting:
+			h.entries[i].Result = ConnectionFailed
+		}
+	}
+	return h
+}
+
+// Entries returns the latest entries, newest first. All entries are returned if limit is not positive.
+func (h *ConnectionHistory) Entries(limit int) []ConnectionHistoryEntry {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if limit <= 0 || limit > len(h.entries) {
+		limit = len(h.entries)
+	}
+	entries := make([]ConnectionHistoryEntry, 0, limit)
+	for i := len(h.entries) - 1; i >= len(h.entries)-limit; i-- {
+		entries = append(entries, h.entries[i])
+	}
+	return entries
+}
+
+// NotifyConnect records the connection attempts requested through the daemon
+func (h *ConnectionHistory) NotifyConnect(e events.DataConnect) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if e.EventStatus == events.StatusAttempt {
+		h.end(ConnectionEndReconnected)
+		server := e.TargetServerDomain
+		if server == "" {
+			server = e.TargetServerName
+		}
+		h.add(ConnectionHistoryEntry{
+			Started:    time.Now(),
+			Server:     server,
+			Country:    e.TargetServerCountry,
+			City:       e.TargetServerCity,
+			Technology: e.Technology,
+			Protocol:   e.Protocol,
+			Automatic:  e.Auto,
+			Result:     ConnectionConnecting,
+		})
+		return h.save()
+	}
+
+	last := h.last()
+	if last == nil || last.Result != ConnectionConnecting {
+		return nil
+	}
+	last.ConnectDuration = time.Duration(e.DurationMs) * time.Millisecond
+	switch e.EventStatus {
+	case events.StatusSuccess:
+		last.Result = ConnectionConnected
+		h.resume()
+	case events.StatusCanceled:
+		last.Result = ConnectionCanceled
+	case events.StatusFailure:
+		last.Result = ConnectionFailed
+		if e.Error != nil {
+			last.Error = e.Error.Error()
+		}
+	case events.StatusAttempt:
+	}
+	return h.save()
+}
+
+// resume replaces the connection which has just been established with the session ended by the daemon restart if
+// both are made to the same server
+func (h *ConnectionHistory) resume() {
+	if len(h.entries) < 2 {
+		return
+	}
+	last := h.entries[len(h.entries)-1]
+	previous := &h.entries[len(h.entries)-2]
+	if previous.EndReason != ConnectionEndDaemonStopped || previous.Server != last.Server ||
+		time.Since(previous.Ended) > sessionResumeWindow {
+		return
+	}
+
+	h.entries = h.entries[:len(h.entries)-1]
+	previous.Ended = time.Time{}
+	previous.EndReason = ""
+	h.resumed = true
+	h.resumedDownload = previous.Download
+	h.resumedUpload = previous.Upload
+}
+
+// ResumedSession returns the start and the bytes transferred before the daemon restart of the active session if it
+// was resumed after the restart
+func (h *ConnectionHistory) ResumedSession() (started time.Time, download uint64, upload uint64, ok bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	last := h.last()
+	if !h.resumed || last == nil || !last.isActive() {
+		return time.Time{}, 0, 0, false
+	}
+	return last.Started, h.resumedDownload, h.resumedUpload, true
+}
+
+// NotifyDisconnect records the disconnects requested through the daemon
+func (h *ConnectionHistory) NotifyDisconnect(events.DataDisconnect) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	last := h.last()
+	if last != nil && last.EndReason == ConnectionEndLost && time.Since(last.Ended) < lostBeforeDisconnectWindow {
+		last.EndReason = ConnectionEndDisconnected
+		return h.save()
+	}
+	if h.end(ConnectionEndDisconnected) {
+		return h.save()
+	}
+	return nil
+}
+
+// NotifyTunnelConnect records the connections restored by the VPN implementation after the tunnel went down
+func (h *ConnectionHistory) NotifyTunnelConnect(e events.DataConnect) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	last := h.last()
+	if e.EventStatus != events.StatusSuccess || last == nil || last.EndReason != ConnectionEndLost {
+		return nil
+	}
+	h.add(ConnectionHistoryEntry{
+		Started:    time.Now(),
+		Server:     last.Server,
+		Country:    last.Country,
+		City:       last.City,
+		Technology: last.Technology,
+		Protocol:   last.Protocol,
+		Automatic:  true,
+		Result:     ConnectionConnected,
+	})
+	return h.save()
+}
+
+// NotifyTunnelDisconnect records the tunnel going down
+func (h *ConnectionHistory) NotifyTunnelDisconnect(e events.DataDisconnect) error {
+	if e.ByUser {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.end(ConnectionEndLost) {
+		return h.save()
+	}
+	return nil
+}
+
+// Stop marks the active connection as ended by stopping the daemon and records the transferred bytes
+func (h *ConnectionHistory) Stop(download, upload uint64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	download, upload = download+h.resumedDownload, upload+h.resumedUpload
+	if h.end(ConnectionEndDaemonStopped) {
+		last := h.last()
+		last.Download = download
+		last.Upload = upload
+		if err := h.save(); err != nil {
+			log.Println(internal.WarningPrefix, "saving connection history:", err)
+		}
+	}
+}
+
+// RecordTransfer updates the bytes transferred by the active connection
+func (h *ConnectionHistory) RecordTransfer(download, upload uint64) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	last := h.last()
+	if last == nil || !last.isActive() {
+		return nil
+	}
+	last.Download = download + h.resumedDownload
+	last.Upload = upload + h.resumedUpload
+	return h.save()
+}
+
+func (h *ConnectionHistory) last() *ConnectionHistoryEntry {
+	if len(h.entries) == 0 {
+		return nil
+	}
+	return &h.entries[len(h.entries)-1]
+}
+
+// end ends the active connection and reports whether there was one
+func (h *ConnectionHistory) end(reason ConnectionEndReason) bool {
+	last := h.last()
+	if last == nil || !last.isActive() {
+		return false
+	}
+	last.Ended = time.Now()
+	last.EndReason = reason
+	h.resumed = false
+	h.resumedDownload = 0
+	h.resumedUpload = 0
+	return true
+}
+
+func (h *ConnectionHistory) add(entry ConnectionHistoryEntry) {
+	h.entries = append(h.entries, entry)
+	if len(h.entries) > connectionHistoryLimit {
+		h.entries = h.entries[len(h.entries)-connectionHistoryLimit:]
+	}
+}
+
+func (h *ConnectionHistory) load() error {
+	content, err := internal.FileRead(h.filePath)
+	if err != nil {
+		return err
+	}
+	return gob.NewDecoder(bytes.NewReader(content)).Decode(&h.entries)
+}
+
+func (h *ConnectionHistory) save() error {
+	if h.filePath == "" {
+		return nil
+	}
+	buffer := &bytes.Buffer{}
+	if err := gob.NewEncoder(buffer).Encode(h.entries); err != nil {
+		return err
+	}
+	return internal.FileWrite(h.filePath, buffer.Bytes(), internal.PermUserRW)
+}
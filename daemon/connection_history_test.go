@@ -0,0 +1,260 @@
+package daemon
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func connectEvent(status events.TypeEventStatus) events.DataConnect {
+	return events.DataConnect{
+		EventStatus:         status,
+		Technology:          config.Technology_NORDLYNX,
+		Protocol:            config.Protocol_UDP,
+		TargetServerDomain:  "de1.nordvpn.com",
+		TargetServerCountry: "Germany",
+		TargetServerCity:    "Berlin",
+		DurationMs:          1500,
+	}
+}
+
+func TestConnectionHistory_ConnectAndDisconnect(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	history := NewConnectionHistory("")
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusAttempt)))
+	assert.Equal(t, ConnectionConnecting, history.Entries(0)[0].Result)
+
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusSuccess)))
+	entry := history.Entries(0)[0]
+	assert.Equal(t, ConnectionConnected, entry.Result)
+	assert.Equal(t, "de1.nordvpn.com", entry.Server)
+	assert.Equal(t, 1500*time.Millisecond, entry.ConnectDuration)
+	assert.True(t, entry.isActive())
+
+	require.NoError(t, history.NotifyDisconnect(events.DataDisconnect{}))
+	entry = history.Entries(0)[0]
+	assert.Equal(t, ConnectionEndDisconnected, entry.EndReason)
+	assert.False(t, entry.Ended.IsZero())
+
+	// nothing to end
+	require.NoError(t, history.NotifyDisconnect(events.DataDisconnect{}))
+	assert.Len(t, history.Entries(0), 1)
+}
+
+func TestConnectionHistory_Failure(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	history := NewConnectionHistory("")
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusAttempt)))
+	failure := connectEvent(events.StatusFailure)
+	failure.Error = errors.New("handshake timeout")
+	require.NoError(t, history.NotifyConnect(failure))
+
+	entry := history.Entries(0)[0]
+	assert.Equal(t, ConnectionFailed, entry.Result)
+	assert.Equal(t, "handshake timeout", entry.Error)
+	assert.Empty(t, entry.EndReason)
+}
+
+func TestConnectionHistory_LostAndRestored(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	history := NewConnectionHistory("")
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusAttempt)))
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusSuccess)))
+
+	// disconnect requested by the user is not a lost connection
+	require.NoError(t, history.NotifyTunnelDisconnect(events.DataDisconnect{ByUser: true}))
+	assert.True(t, history.Entries(0)[0].isActive())
+
+	require.NoError(t, history.NotifyTunnelDisconnect(events.DataDisconnect{}))
+	assert.Equal(t, ConnectionEndLost, history.Entries(0)[0].EndReason)
+
+	require.NoError(t, history.NotifyTunnelConnect(events.DataConnect{EventStatus: events.StatusSuccess}))
+	entries := history.Entries(0)
+	assert.Len(t, entries, 2)
+	assert.True(t, entries[0].isActive())
+	assert.True(t, entries[0].Automatic)
+	assert.Equal(t, "de1.nordvpn.com", entries[0].Server)
+
+	// tunnel events while connected are not recorded
+	require.NoError(t, history.NotifyTunnelConnect(events.DataConnect{EventStatus: events.StatusSuccess}))
+	assert.Len(t, history.Entries(0), 2)
+}
+
+func TestConnectionHistory_TunnelStoppedBeforeDisconnect(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	history := NewConnectionHistory("")
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusAttempt)))
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusSuccess)))
+	require.NoError(t, history.NotifyTunnelDisconnect(events.DataDisconnect{}))
+	require.NoError(t, history.NotifyDisconnect(events.DataDisconnect{}))
+
+	assert.Equal(t, ConnectionEndDisconnected, history.Entries(0)[0].EndReason)
+}
+
+func TestConnectionHistory_Reconnected(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	history := NewConnectionHistory("")
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusAttempt)))
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusSuccess)))
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusAttempt)))
+
+	entries := history.Entries(0)
+	assert.Equal(t, ConnectionConnecting, entries[0].Result)
+	assert.Equal(t, ConnectionEndReconnected, entries[1].EndReason)
+}
+
+func TestConnectionHistory_Bounded(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	history := NewConnectionHistory("")
+	for i := 0; i < connectionHistoryLimit+10; i++ {
+		require.NoError(t, history.NotifyConnect(connectEvent(events.StatusAttempt)))
+		require.NoError(t, history.NotifyConnect(connectEvent(events.StatusCanceled)))
+	}
+	assert.Len(t, history.Entries(0), connectionHistoryLimit)
+	assert.Len(t, history.Entries(5), 5)
+}
+
+func TestConnectionHistory_Persisted(t *testing.T) {
+	category.Set(t, category.File)
+
+	path := filepath.Join(t.TempDir(), "history.dat")
+	history := NewConnectionHistory(path)
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusAttempt)))
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusSuccess)))
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusAttempt)))
+
+	// daemon was stopped while connecting
+	loaded := NewConnectionHistory(path).Entries(0)
+	assert.Len(t, loaded, 2)
+	assert.Equal(t, ConnectionFailed, loaded[0].Result)
+	assert.Equal(t, ConnectionEndReconnected, loaded[1].EndReason)
+
+	history.Stop(0, 0)
+	assert.Equal(t, ConnectionFailed, NewConnectionHistory(path).Entries(0)[0].Result)
+}
+
+func TestConnectionHistory_ActiveWhenDaemonStopped(t *testing.T) {
+	category.Set(t, category.File)
+
+	path := filepath.Join(t.TempDir(), "history.dat")
+	history := NewConnectionHistory(path)
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusAttempt)))
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusSuccess)))
+
+	assert.Equal(t, ConnectionEndDaemonStopped, NewConnectionHistory(path).Entries(0)[0].EndReason)
+
+	history.Stop(2048, 1024)
+	entry := NewConnectionHistory(path).Entries(0)[0]
+	assert.Equal(t, ConnectionEndDaemonStopped, entry.EndReason)
+	assert.False(t, entry.Ended.IsZero())
+	assert.Equal(t, uint64(2048), entry.Download)
+	assert.Equal(t, uint64(1024), entry.Upload)
+}
+
+func TestConnectionHistory_RecordTransfer(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	history := NewConnectionHistory("")
+	// no active connection
+	require.NoError(t, history.RecordTransfer(100, 10))
+
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusAttempt)))
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusSuccess)))
+	require.NoError(t, history.RecordTransfer(100, 10))
+	require.NoError(t, history.RecordTransfer(300, 20))
+	require.NoError(t, history.NotifyDisconnect(events.DataDisconnect{}))
+	require.NoError(t, history.RecordTransfer(500, 50))
+
+	entry := history.Entries(0)[0]
+	assert.Equal(t, uint64(300), entry.Download)
+	assert.Equal(t, uint64(20), entry.Upload)
+}
+
+func TestConnectionHistory_ResumedAfterRestart(t *testing.T) {
+	category.Set(t, category.File)
+
+	path := filepath.Join(t.TempDir(), "history.dat")
+	history := NewConnectionHistory(path)
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusAttempt)))
+	require.NoError(t, history.NotifyConnect(connectEvent(events.StatusSuccess)))
+	started := history.Entries(0)[0].Started
+	history.Stop(2048, 1024)
+
+	restarted := NewConnectionHistory(path)
+	_, _, _, ok := restarted.ResumedSession()
+	assert.False(t, ok)
+
+	require.NoError(t, restarted.NotifyConnect(connectEvent(events.StatusAttempt)))
+	require.NoError(t, restarted.NotifyConnect(connectEvent(events.StatusSuccess)))
+
+	entries := restarted.Entries(0)
+	require.Len(t, entries, 1)
+	assert.True(t, entries[0].isActive())
+	resumedStart, download, upload, ok := restarted.ResumedSession()
+	assert.True(t, ok)
+	assert.True(t, started.Equal(resumedStart))
+	assert.Equal(t, uint64(2048), download)
+	assert.Equal(t, uint64(1024), upload)
+
+	// new tunnel counts the bytes from zero
+	require.NoError(t, restarted.RecordTransfer(100, 10))
+	assert.Equal(t, uint64(2148), restarted.Entries(0)[0].Download)
+
+	require.NoError(t, restarted.NotifyDisconnect(events.DataDisconnect{}))
+	_, _, _, ok = restarted.ResumedSession()
+	assert.False(t, ok)
+	assert.Equal(t, ConnectionEndDisconnected, restarted.Entries(0)[0].EndReason)
+}
+
+func TestConnectionHistory_NotResumed(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	otherServer := connectEvent(events.StatusSuccess)
+	otherServer.TargetServerDomain = "fr1.nordvpn.com"
+
+	tests := []struct {
+		name string
+		// ended is how long ago the daemon was stopped
+		ended   time.Duration
+		success events.DataConnect
+	}{
+		{name: "other server", success: otherServer},
+		{name: "restarted too late", ended: sessionResumeWindow + time.Minute,
+			success: connectEvent(events.StatusSuccess)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			history := NewConnectionHistory("")
+			require.NoError(t, history.NotifyConnect(connectEvent(events.StatusAttempt)))
+			require.NoError(t, history.NotifyConnect(connectEvent(events.StatusSuccess)))
+			history.Stop(2048, 1024)
+			history.entries[0].Ended = time.Now().Add(-test.ended)
+
+			attempt := test.success
+			attempt.EventStatus = events.StatusAttempt
+			require.NoError(t, history.NotifyConnect(attempt))
+			require.NoError(t, history.NotifyConnect(test.success))
+
+			entries := history.Entries(0)
+			assert.Len(t, entries, 2)
+			assert.Equal(t, ConnectionEndDaemonStopped, entries[1].EndReason)
+			_, _, _, ok := history.ResumedSession()
+			assert.False(t, ok)
+		})
+	}
+}
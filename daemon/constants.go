@@ -36,6 +36,12 @@ var (
 	// VersionFilePath defines filename of latest available version file
 	VersionFilePath = filepath.Join(internal.DatFilesPathCommon, "version.dat")
 
+	// ConnectionHistoryFilePath defines filename of the connection history file
+	ConnectionHistoryFilePath = filepath.Join(internal.DatFilesPath, "history.dat")
+
+	// BandwidthUsageFilePath defines filename of the bandwidth usage file
+	BandwidthUsageFilePath = filepath.Join(internal.DatFilesPath, "bandwidth.dat")
+
 	// IconPath defines icon file path
 	IconPath = internal.PrefixCommonPath("/usr/share/icons/hicolor/scalable/apps/nordvpn.svg")
 )
@@ -28,6 +28,7 @@ type DataManager struct {
 	appData          AppData
 	countryData      CountryData
 	insightsData     InsightsData
+	latencyData      LatencyData
 	serversData      ServersData
 	versionData      VersionData
 	dataUpdateEvents *events.DataUpdateEvents
@@ -125,7 +126,12 @@ func (dm *DataManager) GetServersData() ServersData {
 	return dm.serversData
 }
 
-func (dm *DataManager) SetServersData(updatedAt time.Time, servers core.Servers, hash string) (err error) {
+func (dm *DataManager) SetServersData(
+	updatedAt time.Time,
+	servers core.Servers,
+	hash string,
+	updatesSince time.Time,
+) (err error) {
 	// The assumption here is that event publisher is thread safe/locked. We can publish the update event only after
 	// unlocking the main mutex, otherwise event manager reading anything from DataManager when handling
 	// the event would result in a deadlock.
@@ -137,8 +143,66 @@ func (dm *DataManager) SetServersData(updatedAt time.Time, servers core.Servers,
 	dm.mu.Lock()
 	defer dm.mu.Unlock()
 	dm.serversData.UpdatedAt = updatedAt
+	dm.serversData.FullUpdatedAt = updatedAt
 	dm.serversData.Servers = servers
 	dm.serversData.Hash = hash
+	dm.serversData.UpdatesSince = updatesSince
+	return dm.serversData.save()
+}
+
+// CanUpdateServersIncrementally reports whether the cached servers list is recent enough to apply the updates
+// from the API instead of downloading the whole list
+func (dm *DataManager) CanUpdateServersIncrementally() bool {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	return len(dm.serversData.Servers) > 0 &&
+		!dm.serversData.UpdatesSince.IsZero() &&
+		dm.serversData.isFullListValid()
+}
+
+// MergeServers returns a copy of the cached servers list with the updated servers applied. Servers which are
+// not online anymore are removed, the rest replace the cached ones with the same ID or are added.
+func (dm *DataManager) MergeServers(updated core.Servers) core.Servers {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+
+	changed := make(map[int64]core.Server, len(updated))
+	for _, server := range updated {
+		changed[server.ID] = server
+	}
+
+	servers := make(core.Servers, 0, len(dm.serversData.Servers)+len(updated))
+	for _, server := range dm.serversData.Servers {
+		if update, ok := changed[server.ID]; ok {
+			server = update
+			delete(changed, server.ID)
+		}
+		if server.Status == core.Online {
+			servers = append(servers, server)
+		}
+	}
+	// keep the order of the API for the new servers
+	for _, server := range updated {
+		if _, ok := changed[server.ID]; ok && server.Status == core.Online {
+			servers = append(servers, server)
+		}
+	}
+	return servers
+}
+
+// UpdateServersData stores the servers list after the updates from the API were applied. Unlike SetServersData,
+// it keeps the time of the last full download.
+func (dm *DataManager) UpdateServersData(updatedAt time.Time, servers core.Servers, updatesSince time.Time) (err error) {
+	defer func() {
+		if err == nil {
+			dm.dataUpdateEvents.ServersUpdate.Publish(true)
+		}
+	}()
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	dm.serversData.UpdatedAt = updatedAt
+	dm.serversData.Servers = servers
+	dm.serversData.UpdatesSince = updatesSince
 	return dm.serversData.save()
 }
 
@@ -174,6 +238,27 @@ func (dm *DataManager) SetServerStatus(s core.Server, status core.Status) error
 	return dm.serversData.save()
 }
 
+// GetServerLatency returns the round trip time to the server if it was measured within maxAge
+func (dm *DataManager) GetServerLatency(serverID int64, maxAge time.Duration) (ServerLatency, bool) {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	latency, ok := dm.latencyData.measurements[serverID]
+	if !ok || time.Since(latency.MeasuredAt) > maxAge {
+		return ServerLatency{}, false
+	}
+	return latency, true
+}
+
+// SetServerLatency remembers the round trip time to the server. Negative rtt means the server has not responded.
+func (dm *DataManager) SetServerLatency(serverID int64, rtt time.Duration) {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+	if dm.latencyData.measurements == nil {
+		dm.latencyData.measurements = map[int64]ServerLatency{}
+	}
+	dm.latencyData.measurements[serverID] = ServerLatency{RTT: rtt, MeasuredAt: time.Now()}
+}
+
 func (dm *DataManager) GetAppData() AppData {
 	dm.mu.Lock()
 	defer dm.mu.Unlock()
@@ -205,6 +290,8 @@ func toServerTechnology(
 	switch technology {
 	case config.Technology_NORDLYNX:
 		serverTechnology = core.WireguardTech
+	case config.Technology_NORDWHISPER:
+		serverTechnology = core.NordWhisperTech
 	case config.Technology_OPENVPN:
 		switch protocol {
 		case config.Protocol_TCP:
@@ -20,6 +20,19 @@ type VersionData struct {
 	newerVersionAvailable bool
 }
 
+// LatencyData holds the round trip times measured to the servers. It is kept only in memory, because
+// the measurements are valid only for the network the device was in.
+type LatencyData struct {
+	measurements map[int64]ServerLatency
+}
+
+// ServerLatency is the result of probing the server
+type ServerLatency struct {
+	// RTT is the average round trip time or negative if the server has not responded
+	RTT        time.Duration
+	MeasuredAt time.Time
+}
+
 type InsightsData struct {
 	filePath string
 	Insights core.Insights
@@ -93,6 +106,11 @@ type ServersData struct {
 	UpdatedAt time.Time
 	Servers   core.Servers
 	Hash      string
+	// FullUpdatedAt is the time of the last full download, the list is updated incrementally in between
+	FullUpdatedAt time.Time
+	// UpdatesSince is the time of the servers API at the last download, the next update asks for the servers
+	// changed after it
+	UpdatesSince time.Time
 }
 
 func (data *ServersData) load() error {
@@ -130,6 +148,11 @@ func (data *ServersData) isValid() bool {
 	return data.UpdatedAt.Add(1 * time.Hour).After(time.Now())
 }
 
+// isFullListValid reports whether the list can still be updated incrementally instead of downloading it again
+func (data *ServersData) isFullListValid() bool {
+	return data.FullUpdatedAt.Add(serversFullUpdateInterval).After(time.Now())
+}
+
 func (data *VersionData) load() error {
 	content, err := internal.FileRead(data.filePath)
 	if err != nil {
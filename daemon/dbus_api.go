@@ -0,0 +1,300 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/state"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/godbus/dbus/v5/introspect"
+	"google.golang.org/grpc/metadata"
+)
+
+// D-Bus API of the daemon on the system bus
+const (
+	DBusName      = "org.nordvpn.Daemon"
+	DBusPath      = dbus.ObjectPath("/org/nordvpn/Daemon")
+	DBusInterface = "org.nordvpn.Daemon"
+
+	// dbusStateChanged signal is emitted with the status when the connection state or the server changes
+	dbusStateChanged = "StateChanged"
+	// dbusSettingsChanged signal is emitted with the settings when they are changed
+	dbusSettingsChanged = "SettingsChanged"
+
+	dbusErrorPermissionDenied = DBusInterface + ".Error.PermissionDenied"
+	dbusErrorNotConnected     = DBusInterface + ".Error.NotConnected"
+	dbusErrorFailed           = DBusInterface + ".Error.Failed"
+	dbusErrorShuttingDown     = DBusInterface + ".Error.ShuttingDown"
+)
+
+const dbusIntrospection = `
+<interface name="` + DBusInterface + `">
+	<method name="Connect">
+		<arg name="server_tag" type="s" direction="in"/>
+		<arg name="server_group" type="s" direction="in"/>
+	</method>
+	<method name="Disconnect"/>
+	<method name="Status">
+		<arg name="status" type="a{sv}" direction="out"/>
+	</method>
+	<method name="Settings">
+		<arg name="settings" type="a{sv}" direction="out"/>
+	</method>
+	<signal name="` + dbusStateChanged + `">
+		<arg name="status" type="a{sv}"/>
+	</signal>
+	<signal name="` + dbusSettingsChanged + `">
+		<arg name="settings" type="a{sv}"/>
+	</signal>
+</interface>`
+
+// PermissionChecker decides whether the user can call the daemon method
+type PermissionChecker interface {
+	Check(uid uint32, fullMethod string) error
+}
+
+// DBusAPI publishes connect, disconnect, status and settings of the daemon on the system bus, so the desktop
+// integrations could use them without linking gRPC. Access to the bus name is limited by the D-Bus policy, while
+// the methods changing the connection are subject to the same permissions as the gRPC ones.
+type DBusAPI struct {
+	rpc     *RPC
+	checker PermissionChecker
+	// callerUID returns the user of the D-Bus connection
+	callerUID func(sender dbus.Sender) (uint32, error)
+}
+
+// NewDBusAPI creates the D-Bus API for the daemon RPC
+func NewDBusAPI(rpc *RPC, checker PermissionChecker) *DBusAPI {
+	return &DBusAPI{rpc: rpc, checker: checker}
+}
+
+// Start exports the API on the system bus and emits the signals until the daemon is stopped
+func (d *DBusAPI) Start(statePublisher *state.StatePublisher) error {
+	conn, err := dbus.ConnectSystemBus()
+	if err != nil {
+		return fmt.Errorf("connecting to system dbus: %w", err)
+	}
+	d.callerUID = func(sender dbus.Sender) (uint32, error) {
+		var uid uint32
+		err := conn.BusObject().Call("org.freedesktop.DBus.GetConnectionUnixUser", 0, string(sender)).Store(&uid)
+		return uid, err
+	}
+
+	if err := conn.Export(dbusDaemon{api: d}, DBusPath, DBusInterface); err != nil {
+		conn.Close()
+		return fmt.Errorf("exporting dbus object: %w", err)
+	}
+	introspectable := introspect.Introspectable(
+		introspect.IntrospectDeclarationString + "<node>" + introspect.IntrospectDataString + dbusIntrospection + "</node>",
+	)
+	if err := conn.Export(introspectable, DBusPath, "org.freedesktop.DBus.Introspectable"); err != nil {
+		conn.Close()
+		return fmt.Errorf("exporting dbus introspection: %w", err)
+	}
+
+	reply, err := conn.RequestName(DBusName, dbus.NameFlagDoNotQueue)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("requesting dbus name: %w", err)
+	}
+	if reply != dbus.RequestNameReplyPrimaryOwner {
+		conn.Close()
+		return fmt.Errorf("dbus name %s is already taken", DBusName)
+	}
+
+	go d.emitSignals(conn, statePublisher)
+	return nil
+}
+
+// emitSignals emits the state changes in the same way as they are sent to the StatusStream subscribers
+func (d *DBusAPI) emitSignals(conn *dbus.Conn, statePublisher *state.StatePublisher) {
+	defer conn.Close()
+	stateChan, stopChan := statePublisher.AddSubscriber()
+	defer close(stopChan)
+
+	checkTicker := time.NewTicker(statusStreamCheckInterval)
+	defer checkTicker.Stop()
+
+	transitions := newStatusTransitions(d.rpc.status())
+	for {
+		var status *pb.StatusResponse
+		select {
+		case ev := <-stateChan:
+			if _, ok := ev.(*config.Config); ok {
+				if err := conn.Emit(DBusPath, DBusInterface+"."+dbusSettingsChanged, d.settings()); err != nil {
+					log.Println(internal.WarningPrefix, "emitting dbus signal:", err)
+				}
+				continue
+			}
+			status = transitions.fromEvent(ev, d.rpc.status())
+		case <-checkTicker.C:
+			status = transitions.fromCheck(d.rpc.status())
+		}
+		if status == nil {
+			continue
+		}
+		if err := conn.Emit(DBusPath, DBusInterface+"."+dbusStateChanged, statusToDBus(status)); err != nil {
+			log.Println(internal.WarningPrefix, "emitting dbus signal:", err)
+		}
+	}
+}
+
+func (d *DBusAPI) checkPermission(sender dbus.Sender, fullMethod string) *dbus.Error {
+	// new operations are not started while the daemon tears down the connection
+	if d.rpc.isShuttingDown() {
+		return dbus.NewError(dbusErrorShuttingDown, []any{"daemon is shutting down"})
+	}
+	if d.callerUID == nil {
+		return dbus.NewError(dbusErrorPermissionDenied, []any{internal.ErrNoPermission.Error()})
+	}
+	uid, err := d.callerUID(sender)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "getting dbus caller:", err)
+		return dbus.NewError(dbusErrorPermissionDenied, []any{internal.ErrNoPermission.Error()})
+	}
+	if err := d.checker.Check(uid, fullMethod); err != nil {
+		return dbus.NewError(dbusErrorPermissionDenied, []any{err.Error()})
+	}
+	return nil
+}
+
+func (d *DBusAPI) settings() map[string]dbus.Variant {
+	resp, err := d.rpc.Settings(context.Background(), &pb.Empty{})
+	if err != nil || resp.GetData() == nil {
+		return map[string]dbus.Variant{}
+	}
+	return settingsToDBus(resp.GetData())
+}
+
+// dbusDaemon holds the methods exported on D-Bus
+type dbusDaemon struct {
+	api *DBusAPI
+}
+
+// Connect connects to the server, country, city or group like `nordvpn connect`
+func (d dbusDaemon) Connect(sender dbus.Sender, serverTag string, serverGroup string) *dbus.Error {
+	if err := d.api.checkPermission(sender, "/pb.Daemon/Connect"); err != nil {
+		return err
+	}
+	srv := dbusResponseServer{}
+	err := d.api.rpc.Connect(&pb.ConnectRequest{ServerTag: serverTag, ServerGroup: serverGroup}, &srv)
+	return connectResultToDBus(srv.lastType, err)
+}
+
+// Disconnect disconnects from VPN
+func (d dbusDaemon) Disconnect(sender dbus.Sender) *dbus.Error {
+	if err := d.api.checkPermission(sender, "/pb.Daemon/Disconnect"); err != nil {
+		return err
+	}
+	srv := dbusResponseServer{}
+	err := d.api.rpc.Disconnect(&pb.Empty{}, &srv)
+	return disconnectResultToDBus(srv.lastType, err)
+}
+
+// Status returns the connection status
+func (d dbusDaemon) Status() (map[string]dbus.Variant, *dbus.Error) {
+	return statusToDBus(d.api.rpc.status()), nil
+}
+
+// Settings returns the daemon settings
+func (d dbusDaemon) Settings() (map[string]dbus.Variant, *dbus.Error) {
+	return d.api.settings(), nil
+}
+
+func connectResultToDBus(code int64, err error) *dbus.Error {
+	if err != nil {
+		return dbus.NewError(dbusErrorFailed, []any{err.Error()})
+	}
+	switch code {
+	case internal.CodeConnected, internal.CodeQueuedOffline:
+		return nil
+	case internal.CodeTagNonexisting:
+		return dbus.NewError(dbusErrorFailed, []any{"server or location does not exist"})
+	case internal.CodeGroupNonexisting:
+		return dbus.NewError(dbusErrorFailed, []any{"server group does not exist"})
+	case internal.CodeServerUnavailable:
+		return dbus.NewError(dbusErrorFailed, []any{"server is not available"})
+	case internal.CodeDisconnected:
+		return dbus.NewError(dbusErrorFailed, []any{"connection was canceled"})
+	}
+	return dbus.NewError(dbusErrorFailed, []any{fmt.Sprintf("connecting failed with code %d", code)})
+}
+
+func disconnectResultToDBus(code int64, err error) *dbus.Error {
+	if err != nil {
+		return dbus.NewError(dbusErrorFailed, []any{err.Error()})
+	}
+	switch code {
+	case internal.CodeDisconnected, internal.CodeSuccess:
+		return nil
+	case internal.CodeVPNNotRunning:
+		return dbus.NewError(dbusErrorNotConnected, []any{"VPN is not connected"})
+	}
+	return dbus.NewError(dbusErrorFailed, []any{fmt.Sprintf("disconnecting failed with code %d", code)})
+}
+
+func statusToDBus(status *pb.StatusResponse) map[string]dbus.Variant {
+	uptime := int64(-1)
+	if status.GetUptime() >= 0 {
+		uptime = int64(time.Duration(status.GetUptime()).Seconds())
+	}
+	return map[string]dbus.Variant{
+		"state":            dbus.MakeVariant(status.GetState()),
+		"technology":       dbus.MakeVariant(status.GetTechnology().String()),
+		"protocol":         dbus.MakeVariant(status.GetProtocol().String()),
+		"ip":               dbus.MakeVariant(status.GetIp()),
+		"hostname":         dbus.MakeVariant(status.GetHostname()),
+		"name":             dbus.MakeVariant(status.GetName()),
+		"country":          dbus.MakeVariant(status.GetCountry()),
+		"city":             dbus.MakeVariant(status.GetCity()),
+		"download":         dbus.MakeVariant(status.GetDownload()),
+		"upload":           dbus.MakeVariant(status.GetUpload()),
+		"uptime":           dbus.MakeVariant(uptime),
+		"virtual_location": dbus.MakeVariant(status.GetVirtualLocation()),
+		"paused_until":     dbus.MakeVariant(status.GetPausedUntil()),
+	}
+}
+
+func settingsToDBus(settings *pb.Settings) map[string]dbus.Variant {
+	dns := settings.GetDns()
+	if dns == nil {
+		dns = []string{}
+	}
+	return map[string]dbus.Variant{
+		"technology":             dbus.MakeVariant(settings.GetTechnology().String()),
+		"protocol":               dbus.MakeVariant(settings.GetProtocol().String()),
+		"firewall":               dbus.MakeVariant(settings.GetFirewall()),
+		"kill_switch":            dbus.MakeVariant(settings.GetKillSwitch()),
+		"auto_connect":           dbus.MakeVariant(settings.GetAutoConnectData().GetEnabled()),
+		"ipv6":                   dbus.MakeVariant(settings.GetIpv6()),
+		"meshnet":                dbus.MakeVariant(settings.GetMeshnet()),
+		"dns":                    dbus.MakeVariant(dns),
+		"threat_protection_lite": dbus.MakeVariant(settings.GetThreatProtectionLite()),
+		"lan_discovery":          dbus.MakeVariant(settings.GetLanDiscovery()),
+		"obfuscate":              dbus.MakeVariant(settings.GetObfuscate()),
+		"post_quantum":           dbus.MakeVariant(settings.GetPostquantumVpn()),
+		"virtual_location":       dbus.MakeVariant(settings.GetVirtualLocation()),
+	}
+}
+
+// dbusResponseServer collects the responses of the streaming RPC called on behalf of the D-Bus client
+type dbusResponseServer struct {
+	lastType int64
+}
+
+func (dbusResponseServer) SetHeader(metadata.MD) error  { return nil }
+func (dbusResponseServer) SendHeader(metadata.MD) error { return nil }
+func (dbusResponseServer) SetTrailer(metadata.MD)       {}
+func (dbusResponseServer) Context() context.Context     { return context.Background() }
+func (dbusResponseServer) SendMsg(m interface{}) error  { return nil }
+func (dbusResponseServer) RecvMsg(m interface{}) error  { return nil }
+func (s *dbusResponseServer) Send(data *pb.Payload) error {
+	s.lastType = data.GetType()
+	return nil
+}
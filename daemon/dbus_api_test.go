@@ -0,0 +1,123 @@
+package daemon
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+type uidChecker struct {
+	allowed uint32
+}
+
+func (c uidChecker) Check(uid uint32, _ string) error {
+	if uid != c.allowed {
+		return errors.New("not allowed")
+	}
+	return nil
+}
+
+func TestDBusAPI_CheckPermission(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	rpc := &RPC{shutdown: make(chan struct{})}
+	api := NewDBusAPI(rpc, uidChecker{allowed: 1000})
+	assert.Equal(t, dbusErrorPermissionDenied, api.checkPermission(":1.1", "/pb.Daemon/Connect").Name)
+
+	api.callerUID = func(sender dbus.Sender) (uint32, error) {
+		switch sender {
+		case ":1.1":
+			return 1000, nil
+		case ":1.2":
+			return 1001, nil
+		}
+		return 0, errors.New("unknown sender")
+	}
+	assert.Nil(t, api.checkPermission(":1.1", "/pb.Daemon/Connect"))
+	assert.Equal(t, dbusErrorPermissionDenied, api.checkPermission(":1.2", "/pb.Daemon/Connect").Name)
+	assert.Equal(t, dbusErrorPermissionDenied, api.checkPermission(":1.3", "/pb.Daemon/Connect").Name)
+
+	close(rpc.shutdown)
+	assert.Equal(t, dbusErrorShuttingDown, api.checkPermission(":1.1", "/pb.Daemon/Connect").Name)
+}
+
+func TestConnectResultToDBus(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		code     int64
+		err      error
+		expected string
+	}{
+		{name: "connected", code: internal.CodeConnected},
+		{name: "queued while offline", code: internal.CodeQueuedOffline},
+		{name: "rpc error", err: internal.ErrNotLoggedIn, expected: dbusErrorFailed},
+		{name: "unknown server", code: internal.CodeTagNonexisting, expected: dbusErrorFailed},
+		{name: "canceled", code: internal.CodeDisconnected, expected: dbusErrorFailed},
+		{name: "other failure", code: internal.CodeFailure, expected: dbusErrorFailed},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := connectResultToDBus(test.code, test.err)
+			if test.expected == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.Equal(t, test.expected, err.Name)
+		})
+	}
+}
+
+func TestDisconnectResultToDBus(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Nil(t, disconnectResultToDBus(internal.CodeDisconnected, nil))
+	assert.Equal(t, dbusErrorNotConnected, disconnectResultToDBus(internal.CodeVPNNotRunning, nil).Name)
+	assert.Equal(t, dbusErrorFailed, disconnectResultToDBus(0, errors.New("failure")).Name)
+}
+
+func TestStatusToDBus(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	status := statusToDBus(&pb.StatusResponse{
+		State:      "Connected",
+		Technology: config.Technology_NORDLYNX,
+		Hostname:   "de1.nordvpn.com",
+		Download:   1024,
+		Uptime:     int64(90 * time.Second),
+	})
+	assert.Equal(t, "Connected", status["state"].Value())
+	assert.Equal(t, "NORDLYNX", status["technology"].Value())
+	assert.Equal(t, "de1.nordvpn.com", status["hostname"].Value())
+	assert.Equal(t, uint64(1024), status["download"].Value())
+	assert.Equal(t, int64(90), status["uptime"].Value())
+
+	status = statusToDBus(&pb.StatusResponse{State: "Disconnected", Uptime: -1})
+	assert.Equal(t, int64(-1), status["uptime"].Value())
+}
+
+func TestSettingsToDBus(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	settings := settingsToDBus(&pb.Settings{
+		Technology:      config.Technology_OPENVPN,
+		Protocol:        config.Protocol_TCP,
+		KillSwitch:      true,
+		AutoConnectData: &pb.AutoconnectData{Enabled: true},
+	})
+	assert.Equal(t, "OPENVPN", settings["technology"].Value())
+	assert.Equal(t, "TCP", settings["protocol"].Value())
+	assert.Equal(t, true, settings["kill_switch"].Value())
+	assert.Equal(t, true, settings["auto_connect"].Value())
+	assert.Equal(t, []string{}, settings["dns"].Value())
+}
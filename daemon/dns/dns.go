@@ -13,10 +13,17 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/internal"
 )
 
+// ErrSplitDNSNotSupported is returned when none of the available DNS handling methods can route the queries of
+// the given domains to the interface
+var ErrSplitDNSNotSupported = errors.New("split dns is not supported by the available dns methods")
+
 // Setter is responsible for configuring DNS.
 type Setter interface {
 	Set(iface string, nameservers []string) error
 	Unset(iface string) error
+	// SetDomains routes the DNS queries of the domains to the interface
+	SetDomains(iface string, domains []string) error
+	UnsetDomains(iface string) error
 }
 
 // Method is abstraction of DNS handling method
@@ -24,10 +31,19 @@ type Method interface {
 	Set(iface string, nameservers []string) error
 	Unset(iface string) error
 	Name() string
+	// Available reports if the method can be used on this system
+	Available() bool
+}
+
+// SplitMethod is a DNS handling method which can route the queries of the given domains to the interface
+type SplitMethod interface {
+	Method
+	SetDomains(iface string, domains []string) error
+	UnsetDomains(iface string) error
 }
 
 /*
-DefaultSetter handleds DNS in this order:
+DefaultSetter handleds DNS in this order, skipping the methods which are not available on the system:
 
 1. Try to use systemd-resolved DBUS API.
 
@@ -39,16 +55,23 @@ modifies /etc/resolv.conf by adding or removing lines.
 
 4. In case the resolvconf command line utility fails, /etc/resolv.conf is
 backed up and modified directly by NordVPN.
+
+If any of the nameservers is DNS-over-TLS or DNS-over-HTTPS upstream, the system
+is pointed to the local forwarder which sends the queries to the upstreams.
+
+Split DNS, e.g. for the meshnet peer domains, is supported only by systemd-resolved.
 */
 type DefaultSetter struct {
 	publisher events.Publisher[string]
 	methods   []Method
+	forwarder *Forwarder
 }
 
 func NewSetter(publisher events.Publisher[string]) *DefaultSetter {
 	ds := DefaultSetter{
 		publisher: publisher,
 		methods:   []Method{},
+		forwarder: NewForwarder([]string{primaryNameserver4, secondaryNameserver4}),
 	}
 	ds.methods = append(ds.methods, &Resolved{})
 	// Resolvectl is part of systemd-resolved, but is used under Snap, where some restrictions apply
@@ -70,7 +93,15 @@ func (d *DefaultSetter) Set(iface string, nameservers []string) error {
 		return errors.New("nameservers not provided")
 	}
 
+	nameservers, err := d.forward(nameservers)
+	if err != nil {
+		return err
+	}
+
 	for _, method := range d.methods {
+		if !method.Available() {
+			continue
+		}
 		d.publisher.Publish("set dns for interface [" + iface + "] using: " + method.Name())
 		if err := method.Set(iface, nameservers); err != nil {
 			log.Println(internal.ErrorPrefix, fmt.Errorf("setting dns with %s: %w", method.Name(), err))
@@ -88,18 +119,81 @@ func (d *DefaultSetter) Unset(iface string) error {
 	d.publisher.Publish("unsetting DNS")
 
 	for _, method := range d.methods {
+		if !method.Available() {
+			continue
+		}
 		d.publisher.Publish("unset dns for interface [" + iface + "] using: " + method.Name())
 		if err := method.Unset(iface); err != nil {
 			log.Println(internal.ErrorPrefix, fmt.Errorf("unsetting dns with %s: %w", method.Name(), err))
 			continue
 		}
-		return nil
+		break
 	}
 
+	d.forwarder.Stop()
 	return nil
 }
 
+// SetDomains routes the DNS queries of the domains to the interface using the first available method which
+// supports split DNS.
+func (d *DefaultSetter) SetDomains(iface string, domains []string) error {
+	for _, method := range d.methods {
+		splitMethod, ok := method.(SplitMethod)
+		if !ok || !method.Available() {
+			continue
+		}
+		d.publisher.Publish("set dns domains for interface [" + iface + "] using: " + method.Name())
+		if err := splitMethod.SetDomains(iface, domains); err != nil {
+			log.Println(internal.ErrorPrefix, fmt.Errorf("setting dns domains with %s: %w", method.Name(), err))
+			continue
+		}
+		return nil
+	}
+	return ErrSplitDNSNotSupported
+}
+
+// UnsetDomains removes the domains set by SetDomains
+func (d *DefaultSetter) UnsetDomains(iface string) error {
+	var errs []error
+	for _, method := range d.methods {
+		splitMethod, ok := method.(SplitMethod)
+		if !ok || !method.Available() {
+			continue
+		}
+		if err := splitMethod.UnsetDomains(iface); err != nil {
+			errs = append(errs, fmt.Errorf("unsetting dns domains with %s: %w", method.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// forward starts the forwarder if encrypted upstreams are used and returns the nameservers the system should use
+func (d *DefaultSetter) forward(nameservers []string) ([]string, error) {
+	if !HasEncrypted(nameservers) {
+		d.forwarder.Stop()
+		return nameservers, nil
+	}
+	if d.forwarder == nil {
+		return nil, errors.New("encrypted dns is not supported")
+	}
+
+	upstreams, err := ParseUpstreams(nameservers)
+	if err != nil {
+		return nil, err
+	}
+	d.publisher.Publish("forwarding dns to encrypted upstreams")
+	if err := d.forwarder.Start(upstreams); err != nil {
+		return nil, fmt.Errorf("starting dns forwarder: %w", err)
+	}
+	return []string{ForwarderAddress}, nil
+}
+
 // RestoreResolvConfFile try to restore resolv.conf if target file contains Nordvpn changes
 func RestoreResolvConfFile() {
 	tryToRestoreDNS()
 }
+
+// IsResolvConfFileModified checks if resolv.conf still contains Nordvpn changes
+func IsResolvConfFileModified() bool {
+	return resolvconfFileModified()
+}
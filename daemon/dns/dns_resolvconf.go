@@ -40,6 +40,11 @@ func (m *Resolvconf) Name() string {
 	return "resolvconf"
 }
 
+func (m *Resolvconf) Available() bool {
+	_, err := exec.LookPath(execResolvconf)
+	return err == nil
+}
+
 func resolvconfIfacePrefix(filePath string) (string, error) {
 	if internal.FileExists(filePath) {
 		// #nosec G304 - file path/name is constant
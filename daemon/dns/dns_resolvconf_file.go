@@ -43,6 +43,10 @@ func (m *ResolvConfFile) Name() string {
 	return "resolv.conf, default"
 }
 
+func (m *ResolvConfFile) Available() bool {
+	return true
+}
+
 func setDNSinResolvconfFile(addresses []string) error {
 	if internal.FileExists(resolvconfFilePath) {
 		if out, err := internal.FileRead(resolvconfFilePath); err == nil &&
@@ -141,6 +145,15 @@ func tryToRestoreDNS() {
 	}
 }
 
+func resolvconfFileModified() bool {
+	// symlinked file is managed by other software
+	if internal.IsSymLink(resolvconfFilePath) {
+		return false
+	}
+	out, err := internal.FileRead(resolvconfFilePath)
+	return err == nil && strings.Contains(string(out), resolvconfFileMark)
+}
+
 func restoreFromBackup() error {
 	// restore from backup if backup file exists
 	if internal.FileExists(resolvconfBackupPath) {
@@ -30,6 +30,11 @@ func (m *Resolvectl) Name() string {
 	return "resolvectl"
 }
 
+func (m *Resolvectl) Available() bool {
+	_, err := exec.LookPath(execResolvectl)
+	return err == nil
+}
+
 func setDNSWithResolvectl(iface string, addresses []string) error {
 	cmdStr := []string{"dns", iface}
 	cmdStr = append(cmdStr, addresses...)
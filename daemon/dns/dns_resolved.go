@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"net"
 	"os/exec"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/NordSecurity/nordvpn-linux/internal"
 )
@@ -15,24 +17,128 @@ const (
 	execBusctl = "busctl"
 )
 
-// Systemd-resolved DBUS API based DNS handling method
-type Resolved struct{}
+// resolvedRuntimeDir exists only while systemd-resolved is running
+const resolvedRuntimeDir = "/run/systemd/resolve"
+
+// Systemd-resolved DBUS API based DNS handling method. Besides the DNS of the link, it keeps the routing domains
+// of the links, so that they survive setting and unsetting the DNS of the same link.
+type Resolved struct {
+	mu       sync.Mutex
+	dnsLinks map[string]bool     // links where DNS is set
+	domains  map[string][]string // routing domains by link
+}
 
 func (m *Resolved) Set(iface string, nameservers []string) error {
-	return setDNSWithSystemdResolve(iface, nameservers)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := setDNSWithSystemdResolve(iface, nameservers, m.domains); err != nil {
+		return err
+	}
+	if m.dnsLinks == nil {
+		m.dnsLinks = map[string]bool{}
+	}
+	m.dnsLinks[iface] = true
+	return nil
 }
 
 func (m *Resolved) Unset(iface string) error {
-	return unsetDNSWithSystemdResolve(iface)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := unsetDNSWithSystemdResolve(iface); err != nil {
+		return err
+	}
+	delete(m.dnsLinks, iface)
+	// reverting the link removes its routing domains as well
+	if len(m.domains[iface]) > 0 {
+		return setLinkDomainsWithSystemdResolve(iface, false, m.domains[iface])
+	}
+	return nil
 }
 
 func (m *Resolved) Name() string {
 	return "resolved"
 }
 
+func (m *Resolved) Available() bool {
+	return internal.FileExists(resolvedRuntimeDir)
+}
+
+// SetDomains routes the DNS queries of the domains and their subdomains to the link
+func (m *Resolved) SetDomains(iface string, domains []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := setLinkDomainsWithSystemdResolve(iface, m.dnsLinks[iface], domains); err != nil {
+		return err
+	}
+	if m.domains == nil {
+		m.domains = map[string][]string{}
+	}
+	m.domains[iface] = slices.Clone(domains)
+	return nil
+}
+
+// UnsetDomains removes the routing domains set by SetDomains, the DNS of the link is left untouched
+func (m *Resolved) UnsetDomains(iface string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.domains[iface]; !ok {
+		return nil
+	}
+	if err := setLinkDomainsWithSystemdResolve(iface, m.dnsLinks[iface], nil); err != nil {
+		return err
+	}
+	delete(m.domains, iface)
+	return nil
+}
+
+// linkDomainsArgs returns the busctl arguments of SetLinkDomains. The root domain routes all of the queries
+// which are not routed elsewhere to the link.
+func linkDomainsArgs(index int, catchAll bool, domains []string) []string {
+	var entries []string
+	if catchAll {
+		entries = append(entries, ".", "true")
+	}
+	for _, domain := range domains {
+		entries = append(entries, domain, "true")
+	}
+	args := []string{
+		"call",
+		"org.freedesktop.resolve1",
+		"/org/freedesktop/resolve1",
+		"org.freedesktop.resolve1.Manager",
+		"SetLinkDomains", "ia(sb)", fmt.Sprintf("%d", index), fmt.Sprintf("%d", len(entries)/2),
+	}
+	return append(args, entries...)
+}
+
+func setLinkDomainsWithSystemdResolve(ifname string, catchAll bool, domains []string) error {
+	iface, err := net.InterfaceByName(ifname)
+	if err != nil {
+		return err
+	}
+
+	// #nosec G204 -- input is properly validated
+	out, err := exec.Command(execBusctl, linkDomainsArgs(iface.Index, catchAll, domains)...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("setting link routing domains for %s via dbus: %s: %w", iface.Name, strings.TrimSpace(string(out)), err)
+	}
+
+	out, err = exec.Command(execBusctl,
+		"call",
+		"org.freedesktop.resolve1",
+		"/org/freedesktop/resolve1",
+		"org.freedesktop.resolve1.Manager",
+		"FlushCaches",
+	).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("flushing local dns caches via dbus: %s: %w", strings.TrimSpace(string(out)), err)
+	}
+	return nil
+}
+
 // setDNSWithSystemdResolve uses systemd-resolve dbus API to manage DNS
 // https://www.freedesktop.org/wiki/Software/systemd/resolved/
-func setDNSWithSystemdResolve(ifname string, addresses []string) error {
+func setDNSWithSystemdResolve(ifname string, addresses []string, domains map[string][]string) error {
 	iface, err := net.InterfaceByName(ifname)
 	if err != nil {
 		return err
@@ -66,13 +172,7 @@ func setDNSWithSystemdResolve(ifname string, addresses []string) error {
 
 	// Set routing domains (more info: https://github.com/poettering/systemd/commit/8cedb0aef94da880e61b4c8cfeb7f450f8760ec6)
 	// #nosec G204 -- input is properly validated
-	out, err = exec.Command(execBusctl,
-		"call",
-		"org.freedesktop.resolve1",
-		"/org/freedesktop/resolve1",
-		"org.freedesktop.resolve1.Manager",
-		"SetLinkDomains", "ia(sb)", fmt.Sprintf("%d", iface.Index), "1", ".", "true",
-	).CombinedOutput()
+	out, err = exec.Command(execBusctl, linkDomainsArgs(iface.Index, true, domains[iface.Name])...).CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("setting link routing domains for %s via dbus: %s: %w", iface.Name, strings.TrimSpace(string(out)), err)
 	}
@@ -110,8 +210,9 @@ func setDNSWithSystemdResolve(ifname string, addresses []string) error {
 	// Setup other links
 	for _, link := range links {
 		// lo is managed by systemd-networkd
-		// vpn and managed links should be ignored
-		if link.Name == "lo" || link.Name == iface.Name || !internal.IsNetworkLinkUnmanaged(link.Name) {
+		// vpn, meshnet and managed links should be ignored
+		if link.Name == "lo" || link.Name == iface.Name || len(domains[link.Name]) > 0 ||
+			!internal.IsNetworkLinkUnmanaged(link.Name) {
 			continue
 		}
 
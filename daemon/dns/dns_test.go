@@ -2,6 +2,7 @@ package dns
 
 import (
 	"errors"
+	"slices"
 	"strings"
 	"testing"
 
@@ -11,10 +12,13 @@ import (
 )
 
 type MockMethod struct {
-	err error
+	err         error
+	unavailable bool
+	set         bool
 }
 
 func (m *MockMethod) Set(iface string, nameservers []string) error {
+	m.set = m.err == nil
 	return m.err
 }
 func (m *MockMethod) Unset(iface string) error {
@@ -23,6 +27,29 @@ func (m *MockMethod) Unset(iface string) error {
 func (m *MockMethod) Name() string {
 	return "mock"
 }
+func (m *MockMethod) Available() bool {
+	return !m.unavailable
+}
+
+type MockSplitMethod struct {
+	MockMethod
+	domains map[string][]string
+}
+
+func (m *MockSplitMethod) SetDomains(iface string, domains []string) error {
+	if m.err != nil {
+		return m.err
+	}
+	if m.domains == nil {
+		m.domains = map[string][]string{}
+	}
+	m.domains[iface] = domains
+	return nil
+}
+func (m *MockSplitMethod) UnsetDomains(iface string) error {
+	delete(m.domains, iface)
+	return nil
+}
 
 func newDnsSetterGood() Setter {
 	ds := DefaultSetter{
@@ -121,6 +148,114 @@ func Test_Method(t *testing.T) {
 	}
 }
 
+func TestDefaultSetter_SkipsUnavailableMethods(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	unavailable := &MockMethod{unavailable: true}
+	available := &MockMethod{}
+	ds := DefaultSetter{
+		publisher: &subs.Subject[string]{},
+		methods:   []Method{unavailable, available},
+	}
+
+	assert.NoError(t, ds.Set("nordlynx", []string{"1.1.1.1"}))
+	assert.False(t, unavailable.set)
+	assert.True(t, available.set)
+}
+
+func TestDefaultSetter_SetDomains(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		methods  func(*MockSplitMethod) []Method
+		split    MockSplitMethod
+		expected []string
+		err      error
+	}{
+		{
+			name:     "split method is used",
+			methods:  func(m *MockSplitMethod) []Method { return []Method{&MockMethod{}, m} },
+			expected: []string{"nord"},
+		},
+		{
+			name:    "split method is unavailable",
+			methods: func(m *MockSplitMethod) []Method { return []Method{&MockMethod{}, m} },
+			split:   MockSplitMethod{MockMethod: MockMethod{unavailable: true}},
+			err:     ErrSplitDNSNotSupported,
+		},
+		{
+			name:    "split method fails",
+			methods: func(m *MockSplitMethod) []Method { return []Method{m} },
+			split:   MockSplitMethod{MockMethod: MockMethod{err: errors.New("failed")}},
+			err:     ErrSplitDNSNotSupported,
+		},
+		{
+			name:    "no split methods",
+			methods: func(*MockSplitMethod) []Method { return []Method{&MockMethod{}} },
+			err:     ErrSplitDNSNotSupported,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			split := test.split
+			ds := DefaultSetter{
+				publisher: &subs.Subject[string]{},
+				methods:   test.methods(&split),
+			}
+			assert.ErrorIs(t, ds.SetDomains("nordlynx", []string{"nord"}), test.err)
+			assert.Equal(t, test.expected, split.domains["nordlynx"])
+
+			assert.NoError(t, ds.UnsetDomains("nordlynx"))
+			assert.Empty(t, split.domains["nordlynx"])
+		})
+	}
+}
+
+func TestLinkDomainsArgs(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	prefix := []string{
+		"call",
+		"org.freedesktop.resolve1",
+		"/org/freedesktop/resolve1",
+		"org.freedesktop.resolve1.Manager",
+		"SetLinkDomains", "ia(sb)", "7",
+	}
+	tests := []struct {
+		name     string
+		catchAll bool
+		domains  []string
+		expected []string
+	}{
+		{
+			name:     "catch-all only",
+			catchAll: true,
+			expected: []string{"1", ".", "true"},
+		},
+		{
+			name:     "catch-all with domains",
+			catchAll: true,
+			domains:  []string{"nord"},
+			expected: []string{"2", ".", "true", "nord", "true"},
+		},
+		{
+			name:     "domains only",
+			domains:  []string{"nord"},
+			expected: []string{"1", "nord", "true"},
+		},
+		{
+			name:     "no domains",
+			expected: []string{"0"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, append(slices.Clone(prefix), test.expected...), linkDomainsArgs(7, test.catchAll, test.domains))
+		})
+	}
+}
+
 func Test_InterfacePreifx(t *testing.T) {
 	category.Set(t, category.Integration)
 	filePath := "test/interface-order"
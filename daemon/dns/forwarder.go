@@ -0,0 +1,543 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	// ForwarderAddress is the loopback address the system resolver is pointed to when encrypted DNS is used
+	ForwarderAddress = "127.0.0.153"
+
+	// queryTimeout limits the time spent on a single query, including the fallback upstreams
+	queryTimeout = 5 * time.Second
+	// upstreamTimeout limits the time spent on a single upstream
+	upstreamTimeout = 2 * time.Second
+	// failurePenalty is the time upstream is tried only after the others because it has failed recently
+	failurePenalty = 30 * time.Second
+	// tcpIdleTimeout closes the client connections which do not send queries
+	tcpIdleTimeout = 10 * time.Second
+
+	maxMessageSize = 65535
+	// minUDPSize is the size of the response every client accepts over UDP (RFC 1035)
+	minUDPSize = 512
+
+	dohContentType = "application/dns-message"
+)
+
+// Forwarder is a local DNS stub which forwards the queries to the encrypted upstreams. Queries are sent to the
+// encrypted upstreams first. Plain upstreams are used only when none of the encrypted ones has answered, so DNS is
+// never sent unencrypted unless the user has listed a plain server as a fallback.
+type Forwarder struct {
+	mu         sync.Mutex
+	listenAddr string
+	// bootstrap servers resolve the hostnames of the encrypted upstreams
+	bootstrap []string
+	upstreams []Upstream
+	exchanger []*exchanger
+	udp       net.PacketConn
+	tcp       net.Listener
+	wg        sync.WaitGroup
+}
+
+// NewForwarder creates a forwarder which resolves the hostnames of the upstreams using the bootstrap nameservers
+// unless plain upstreams are configured
+func NewForwarder(bootstrap []string) *Forwarder {
+	return &Forwarder{
+		listenAddr: net.JoinHostPort(ForwarderAddress, "53"),
+		bootstrap:  bootstrap,
+	}
+}
+
+// Start listens for the queries and forwards them to the upstreams. Forwarder is restarted if it is already
+// running with different upstreams.
+func (f *Forwarder) Start(upstreams []Upstream) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.udp != nil {
+		if slices.Equal(f.upstreams, upstreams) {
+			return nil
+		}
+		f.stop()
+	}
+
+	udp, err := net.ListenPacket("udp", f.listenAddr)
+	if err != nil {
+		return fmt.Errorf("listening for dns queries over udp: %w", err)
+	}
+	tcp, err := net.Listen("tcp", f.listenAddr)
+	if err != nil {
+		udp.Close()
+		return fmt.Errorf("listening for dns queries over tcp: %w", err)
+	}
+
+	f.upstreams = slices.Clone(upstreams)
+	f.exchanger = newExchangers(upstreams, f.bootstrapServers(upstreams))
+	f.udp = udp
+	f.tcp = tcp
+
+	exchangers := f.exchanger
+	handle := func(query []byte) []byte { return forward(exchangers, query) }
+	f.wg.Add(2)
+	go func() {
+		defer f.wg.Done()
+		serveUDP(udp, handle)
+	}()
+	go func() {
+		defer f.wg.Done()
+		serveTCP(tcp, handle)
+	}()
+	return nil
+}
+
+// Stop stops listening for the queries. It is safe to call on nil or stopped forwarder.
+func (f *Forwarder) Stop() {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.stop()
+}
+
+// Not thread safe. Lock mu before using
+func (f *Forwarder) stop() {
+	if f.udp == nil {
+		return
+	}
+	f.udp.Close()
+	f.tcp.Close()
+	f.wg.Wait()
+	for _, e := range f.exchanger {
+		e.close()
+	}
+	f.udp = nil
+	f.tcp = nil
+	f.exchanger = nil
+	f.upstreams = nil
+}
+
+// bootstrapServers prefers plain upstreams chosen by the user over the default ones
+func (f *Forwarder) bootstrapServers(upstreams []Upstream) []string {
+	var servers []string
+	for _, upstream := range upstreams {
+		if !upstream.IsEncrypted() {
+			servers = append(servers, upstream.Address())
+		}
+	}
+	if len(servers) > 0 {
+		return servers
+	}
+	for _, server := range f.bootstrap {
+		servers = append(servers, net.JoinHostPort(server, "53"))
+	}
+	return servers
+}
+
+// serveUDP answers the queries with the responses built by handle, nil response is not sent
+func serveUDP(conn net.PacketConn, handle func(query []byte) []byte) {
+	buf := make([]byte, maxMessageSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				log.Println(internal.ErrorPrefix, "reading dns query:", err)
+			}
+			return
+		}
+		query := slices.Clone(buf[:n])
+		go func() {
+			resp := handle(query)
+			if resp == nil {
+				return
+			}
+			if limit := udpSizeLimit(query); len(resp) > limit {
+				// client retries over TCP when it receives truncated response
+				if resp = replyTo(query, dnsmessage.RCodeSuccess, true); resp == nil {
+					return
+				}
+			}
+			if _, err := conn.WriteTo(resp, addr); err != nil && !errors.Is(err, net.ErrClosed) {
+				log.Println(internal.WarningPrefix, "sending dns response:", err)
+			}
+		}()
+	}
+}
+
+func serveTCP(listener net.Listener, handle func(query []byte) []byte) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				log.Println(internal.ErrorPrefix, "accepting dns connection:", err)
+			}
+			return
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer conn.Close()
+			for {
+				if err := conn.SetDeadline(time.Now().Add(tcpIdleTimeout)); err != nil {
+					return
+				}
+				query, err := readStreamMessage(conn)
+				if err != nil {
+					return
+				}
+				resp := handle(query)
+				if resp == nil {
+					return
+				}
+				if err := writeStreamMessage(conn, resp); err != nil {
+					return
+				}
+			}
+		}()
+	}
+}
+
+// forward sends the query to the upstreams until one of them answers. Upstreams which have failed recently are
+// tried last. Returns SERVFAIL response if none of the upstreams has answered or nil if the query is malformed.
+func forward(exchangers []*exchanger, query []byte) []byte {
+	ctx, cancel := context.WithTimeout(context.Background(), queryTimeout)
+	defer cancel()
+
+	var lastErr error
+	for _, e := range orderExchangers(exchangers) {
+		resp, err := e.exchange(ctx, query)
+		if err == nil {
+			return resp
+		}
+		lastErr = err
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	log.Println(internal.WarningPrefix, "no dns upstream has answered:", lastErr)
+	return replyTo(query, dnsmessage.RCodeServerFailure, false)
+}
+
+// orderExchangers keeps the encrypted upstreams before the plain ones, healthy before recently failed ones
+func orderExchangers(exchangers []*exchanger) []*exchanger {
+	ordered := slices.Clone(exchangers)
+	rank := func(e *exchanger) int {
+		r := 0
+		if !e.upstream.IsEncrypted() {
+			r += 2
+		}
+		if e.failedRecently() {
+			r++
+		}
+		return r
+	}
+	slices.SortStableFunc(ordered, func(a, b *exchanger) int { return rank(a) - rank(b) })
+	return ordered
+}
+
+// udpSizeLimit returns the size of the response the client accepts over UDP as advertised with EDNS
+func udpSizeLimit(query []byte) int {
+	var p dnsmessage.Parser
+	if _, err := p.Start(query); err != nil {
+		return minUDPSize
+	}
+	if p.SkipAllQuestions() != nil || p.SkipAllAnswers() != nil || p.SkipAllAuthorities() != nil {
+		return minUDPSize
+	}
+	for {
+		h, err := p.AdditionalHeader()
+		if err != nil {
+			return minUDPSize
+		}
+		if h.Type == dnsmessage.TypeOPT {
+			// class of OPT record holds the UDP payload size
+			return max(int(h.Class), minUDPSize)
+		}
+		if err := p.SkipAdditional(); err != nil {
+			return minUDPSize
+		}
+	}
+}
+
+// replyTo builds the response without records. Returns nil if the query cannot be parsed.
+func replyTo(query []byte, rcode dnsmessage.RCode, truncated bool) []byte {
+	var p dnsmessage.Parser
+	h, err := p.Start(query)
+	if err != nil {
+		return nil
+	}
+	questions, err := p.AllQuestions()
+	if err != nil {
+		return nil
+	}
+
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{
+		ID:                 h.ID,
+		Response:           true,
+		OpCode:             h.OpCode,
+		RecursionDesired:   h.RecursionDesired,
+		RecursionAvailable: true,
+		Truncated:          truncated,
+		RCode:              rcode,
+	})
+	if err := b.StartQuestions(); err != nil {
+		return nil
+	}
+	for _, q := range questions {
+		if err := b.Question(q); err != nil {
+			return nil
+		}
+	}
+	resp, err := b.Finish()
+	if err != nil {
+		return nil
+	}
+	return resp
+}
+
+func readStreamMessage(r io.Reader) ([]byte, error) {
+	var length uint16
+	if err := binary.Read(r, binary.BigEndian, &length); err != nil {
+		return nil, err
+	}
+	msg := make([]byte, length)
+	if _, err := io.ReadFull(r, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func writeStreamMessage(w io.Writer, msg []byte) error {
+	if len(msg) > maxMessageSize {
+		return fmt.Errorf("dns message is too long: %d", len(msg))
+	}
+	buf := make([]byte, 2, 2+len(msg))
+	binary.BigEndian.PutUint16(buf, uint16(len(msg)))
+	_, err := w.Write(append(buf, msg...))
+	return err
+}
+
+// exchanger sends the queries to a single upstream
+type exchanger struct {
+	upstream Upstream
+	dialer   *bootstrapDialer
+	client   *http.Client
+
+	mu       sync.Mutex
+	idle     net.Conn
+	failedAt time.Time
+}
+
+func newExchangers(upstreams []Upstream, bootstrap []string) []*exchanger {
+	dialer := &bootstrapDialer{servers: bootstrap}
+	exchangers := make([]*exchanger, 0, len(upstreams))
+	for _, upstream := range upstreams {
+		e := &exchanger{upstream: upstream, dialer: dialer}
+		if upstream.Protocol == ProtocolHTTPS {
+			e.client = &http.Client{
+				Transport: &http.Transport{
+					DialContext:       dialer.DialContext,
+					TLSClientConfig:   &tls.Config{ServerName: upstream.ServerName, MinVersion: tls.VersionTLS12},
+					ForceAttemptHTTP2: true,
+					IdleConnTimeout:   time.Minute,
+				},
+			}
+		}
+		exchangers = append(exchangers, e)
+	}
+	return exchangers
+}
+
+func (e *exchanger) exchange(ctx context.Context, query []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, upstreamTimeout)
+	defer cancel()
+
+	var resp []byte
+	var err error
+	if e.upstream.Protocol == ProtocolHTTPS {
+		resp, err = e.exchangeHTTPS(ctx, query)
+	} else {
+		resp, err = e.exchangeStream(ctx, query)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err != nil {
+		e.failedAt = time.Now()
+		return nil, fmt.Errorf("%s: %w", e.upstream, err)
+	}
+	e.failedAt = time.Time{}
+	return resp, nil
+}
+
+func (e *exchanger) failedRecently() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return !e.failedAt.IsZero() && time.Since(e.failedAt) < failurePenalty
+}
+
+// exchangeStream sends the query over TCP or TLS reusing the idle connection if there is one
+func (e *exchanger) exchangeStream(ctx context.Context, query []byte) ([]byte, error) {
+	e.mu.Lock()
+	conn := e.idle
+	e.idle = nil
+	e.mu.Unlock()
+
+	if conn != nil {
+		// upstream may have closed the idle connection in the meantime
+		if resp, err := roundTrip(ctx, conn, query); err == nil {
+			e.release(conn)
+			return resp, nil
+		}
+		conn.Close()
+	}
+
+	conn, err := e.dialStream(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := roundTrip(ctx, conn, query)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	e.release(conn)
+	return resp, nil
+}
+
+func (e *exchanger) dialStream(ctx context.Context) (net.Conn, error) {
+	conn, err := e.dialer.DialContext(ctx, "tcp", e.upstream.Address())
+	if err != nil {
+		return nil, err
+	}
+	if e.upstream.Protocol != ProtocolTLS {
+		return conn, nil
+	}
+
+	tlsConn := tls.Client(conn, &tls.Config{ServerName: e.upstream.ServerName, MinVersion: tls.VersionTLS12})
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return tlsConn, nil
+}
+
+// release keeps the connection for the next query
+func (e *exchanger) release(conn net.Conn) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.idle != nil {
+		conn.Close()
+		return
+	}
+	e.idle = conn
+}
+
+func (e *exchanger) exchangeHTTPS(ctx context.Context, query []byte) ([]byte, error) {
+	u := url.URL{Scheme: schemeHTTPS, Host: e.upstream.Address(), Path: e.upstream.Path}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(query))
+	if err != nil {
+		return nil, err
+	}
+	req.Host = e.upstream.ServerName
+	req.Header.Set("Content-Type", dohContentType)
+	req.Header.Set("Accept", dohContentType)
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxMessageSize))
+}
+
+func (e *exchanger) close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.idle != nil {
+		e.idle.Close()
+		e.idle = nil
+	}
+	if e.client != nil {
+		e.client.CloseIdleConnections()
+	}
+}
+
+func roundTrip(ctx context.Context, conn net.Conn, query []byte) ([]byte, error) {
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+	if err := writeStreamMessage(conn, query); err != nil {
+		return nil, err
+	}
+	return readStreamMessage(conn)
+}
+
+// bootstrapDialer resolves the hostnames with the bootstrap servers, because the system resolver points to the
+// forwarder itself
+type bootstrapDialer struct {
+	servers []string
+	dialer  net.Dialer
+}
+
+func (d *bootstrapDialer) DialContext(ctx context.Context, network string, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := netip.ParseAddr(host); err == nil {
+		return d.dialer.DialContext(ctx, network, address)
+	}
+
+	resolver := net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network string, _ string) (net.Conn, error) {
+			var err error = errors.New("no bootstrap dns servers")
+			for _, server := range d.servers {
+				var conn net.Conn
+				if conn, err = d.dialer.DialContext(ctx, network, server); err == nil {
+					return conn, nil
+				}
+			}
+			return nil, err
+		},
+	}
+	addrs, err := resolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return nil, fmt.Errorf("resolving %s: %w", host, err)
+	}
+
+	for _, addr := range addrs {
+		var conn net.Conn
+		conn, err = d.dialer.DialContext(ctx, network, net.JoinHostPort(addr.Unmap().String(), port))
+		if err == nil {
+			return conn, nil
+		}
+	}
+	return nil, err
+}
@@ -0,0 +1,245 @@
+package dns
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+func buildQuery(t *testing.T, udpSize uint16) []byte {
+	t.Helper()
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: 4242, RecursionDesired: true})
+	require.NoError(t, b.StartQuestions())
+	require.NoError(t, b.Question(dnsmessage.Question{
+		Name:  dnsmessage.MustNewName("nordvpn.com."),
+		Type:  dnsmessage.TypeA,
+		Class: dnsmessage.ClassINET,
+	}))
+	if udpSize != 0 {
+		require.NoError(t, b.StartAdditionals())
+		var opt dnsmessage.ResourceHeader
+		require.NoError(t, opt.SetEDNS0(int(udpSize), dnsmessage.RCodeSuccess, false))
+		require.NoError(t, b.OPTResource(opt, dnsmessage.OPTResource{}))
+	}
+	query, err := b.Finish()
+	require.NoError(t, err)
+	return query
+}
+
+func parseResponse(t *testing.T, resp []byte) dnsmessage.Header {
+	t.Helper()
+	var p dnsmessage.Parser
+	h, err := p.Start(resp)
+	require.NoError(t, err)
+	return h
+}
+
+// startPlainUpstream answers every query over TCP with NXDOMAIN
+func startPlainUpstream(t *testing.T) Upstream {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				for {
+					query, err := readStreamMessage(conn)
+					if err != nil {
+						return
+					}
+					if writeStreamMessage(conn, replyTo(query, dnsmessage.RCodeNameError, false)) != nil {
+						return
+					}
+				}
+			}()
+		}
+	}()
+
+	addr := netip.MustParseAddrPort(listener.Addr().String())
+	return Upstream{Protocol: ProtocolPlain, Host: addr.Addr().String(), Port: addr.Port()}
+}
+
+// unreachableUpstream returns the encrypted upstream which refuses the connections
+func unreachableUpstream(t *testing.T) Upstream {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := netip.MustParseAddrPort(listener.Addr().String())
+	listener.Close()
+	return Upstream{Protocol: ProtocolTLS, Host: addr.Addr().String(), Port: addr.Port(), ServerName: "dns.test.com"}
+}
+
+func TestForward_FallsBackToPlainUpstream(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	plain := startPlainUpstream(t)
+	encrypted := unreachableUpstream(t)
+	// plain upstream is listed first, but it is used only after the encrypted one fails
+	exchangers := newExchangers([]Upstream{plain, encrypted}, nil)
+
+	ordered := orderExchangers(exchangers)
+	assert.Equal(t, encrypted, ordered[0].upstream)
+
+	resp := forward(exchangers, buildQuery(t, 0))
+	h := parseResponse(t, resp)
+	assert.Equal(t, uint16(4242), h.ID)
+	assert.Equal(t, dnsmessage.RCodeNameError, h.RCode)
+
+	// failed encrypted upstream is still preferred over the plain fallback
+	assert.True(t, exchangers[1].failedRecently())
+	assert.Equal(t, encrypted, orderExchangers(exchangers)[0].upstream)
+}
+
+func TestOrderExchangers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	first, err := ParseUpstream("tls://dns.quad9.net")
+	require.NoError(t, err)
+	second, err := ParseUpstream("https://cloudflare-dns.com")
+	require.NoError(t, err)
+	plain, err := ParseUpstream("9.9.9.9")
+	require.NoError(t, err)
+	exchangers := newExchangers([]Upstream{plain, first, second}, nil)
+
+	order := func() []Upstream {
+		var upstreams []Upstream
+		for _, e := range orderExchangers(exchangers) {
+			upstreams = append(upstreams, e.upstream)
+		}
+		return upstreams
+	}
+	assert.Equal(t, []Upstream{first, second, plain}, order())
+
+	// recently failed upstream is tried after the healthy ones until the penalty passes
+	exchangers[1].failedAt = time.Now()
+	assert.Equal(t, []Upstream{second, first, plain}, order())
+	exchangers[1].failedAt = time.Now().Add(-failurePenalty)
+	assert.Equal(t, []Upstream{first, second, plain}, order())
+}
+
+func TestForward_NoUpstreamAnswers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	exchangers := newExchangers([]Upstream{unreachableUpstream(t)}, nil)
+
+	resp := forward(exchangers, buildQuery(t, 0))
+	h := parseResponse(t, resp)
+	assert.True(t, h.Response)
+	assert.Equal(t, dnsmessage.RCodeServerFailure, h.RCode)
+
+	assert.Nil(t, forward(exchangers, []byte{1, 2, 3}))
+}
+
+func TestForward_DNSOverHTTPS(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, err := io.ReadAll(r.Body)
+		if err != nil || r.Method != http.MethodPost || r.URL.Path != "/dns-query" ||
+			r.Header.Get("Content-Type") != dohContentType {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", dohContentType)
+		_, _ = w.Write(replyTo(query, dnsmessage.RCodeNameError, false))
+	}))
+	defer server.Close()
+
+	addr := netip.MustParseAddrPort(server.Listener.Addr().String())
+	upstream, err := ParseUpstream("https://" + addr.String())
+	require.NoError(t, err)
+	exchangers := newExchangers([]Upstream{upstream}, nil)
+	// trust the certificate of the test server
+	exchangers[0].client = server.Client()
+
+	resp := forward(exchangers, buildQuery(t, 0))
+	assert.Equal(t, dnsmessage.RCodeNameError, parseResponse(t, resp).RCode)
+}
+
+func TestForwarder_UDP(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	plain := startPlainUpstream(t)
+	forwarder := NewForwarder(nil)
+	forwarder.listenAddr = "127.0.0.1:0"
+	require.NoError(t, forwarder.Start([]Upstream{plain}))
+	defer forwarder.Stop()
+
+	conn, err := net.Dial("udp", forwarder.udp.LocalAddr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.SetDeadline(time.Now().Add(5*time.Second)))
+
+	_, err = conn.Write(buildQuery(t, 1232))
+	require.NoError(t, err)
+	buf := make([]byte, maxMessageSize)
+	n, err := conn.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, dnsmessage.RCodeNameError, parseResponse(t, buf[:n]).RCode)
+
+	// starting with the same upstreams keeps the forwarder running
+	udp := forwarder.udp
+	require.NoError(t, forwarder.Start([]Upstream{plain}))
+	assert.Equal(t, udp, forwarder.udp)
+
+	forwarder.Stop()
+	assert.Nil(t, forwarder.udp)
+	forwarder.Stop()
+}
+
+func TestUDPSizeLimit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, minUDPSize, udpSizeLimit(buildQuery(t, 0)))
+	assert.Equal(t, 1232, udpSizeLimit(buildQuery(t, 1232)))
+	// advertised size smaller than the minimum is ignored
+	assert.Equal(t, minUDPSize, udpSizeLimit(buildQuery(t, 100)))
+	assert.Equal(t, minUDPSize, udpSizeLimit([]byte{1}))
+}
+
+func TestReplyTo_Truncated(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	resp := replyTo(buildQuery(t, 0), dnsmessage.RCodeSuccess, true)
+	var p dnsmessage.Parser
+	h, err := p.Start(resp)
+	require.NoError(t, err)
+	assert.True(t, h.Truncated)
+	assert.Equal(t, uint16(4242), h.ID)
+	questions, err := p.AllQuestions()
+	require.NoError(t, err)
+	assert.Len(t, questions, 1)
+	assert.Equal(t, "nordvpn.com.", questions[0].Name.String())
+}
+
+func TestBootstrapServers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	forwarder := NewForwarder([]string{"103.86.96.100"})
+	encrypted, err := ParseUpstream("tls://dns.quad9.net")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"103.86.96.100:53"}, forwarder.bootstrapServers([]Upstream{encrypted}))
+
+	plain, err := ParseUpstream("9.9.9.9")
+	require.NoError(t, err)
+	assert.Equal(t, []string{net.JoinHostPort("9.9.9.9", strconv.Itoa(53))},
+		forwarder.bootstrapServers([]Upstream{encrypted, plain}))
+}
@@ -0,0 +1,272 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/netip"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+const (
+	// MagicDNSAddress is the loopback address the meshnet peer names are resolved at
+	MagicDNSAddress = "127.0.0.154"
+	// MeshnetDomain is the domain of the meshnet peer names
+	MeshnetDomain = "nord"
+
+	// magicDNSTTL is short, so that the changed peer addresses are picked up quickly
+	magicDNSTTL = 60
+	// resolvedConfPath is a runtime drop-in of systemd-resolved, it is gone after reboot
+	resolvedConfPath = "/run/systemd/resolved.conf.d/nordvpn-meshnet.conf"
+	resolvedService  = "systemd-resolved.service"
+)
+
+// MagicDNS resolves the meshnet peer names without modifying the hosts file. It answers the queries in the
+// meshnet domain on a loopback address and systemd-resolved routes the meshnet domain to it. Hosts file is
+// used instead on the systems without systemd-resolved.
+type MagicDNS struct {
+	mu         sync.Mutex
+	fallback   HostnameSetter
+	listenAddr string
+	confPath   string
+	// resolvedAvailable reports if systemd-resolved is running
+	resolvedAvailable func() bool
+	reloadResolved    func() error
+	udp               net.PacketConn
+	tcp               net.Listener
+	wg                sync.WaitGroup
+	// recordsMu is separate, so that the queries are answered while the listeners are being stopped
+	recordsMu sync.RWMutex
+	records   map[string][]netip.Addr
+}
+
+// NewMagicDNS creates MagicDNS which writes the peer names to the fallback on the systems without
+// systemd-resolved
+func NewMagicDNS(fallback HostnameSetter) *MagicDNS {
+	return &MagicDNS{
+		fallback:          fallback,
+		listenAddr:        net.JoinHostPort(MagicDNSAddress, "53"),
+		confPath:          resolvedConfPath,
+		resolvedAvailable: func() bool { return internal.FileExists(resolvedRuntimeDir) },
+		reloadResolved:    reloadSystemdResolved,
+	}
+}
+
+// SetHosts makes the hosts resolvable by their names in the meshnet domain
+func (m *MagicDNS) SetHosts(hosts Hosts) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.resolvedAvailable() {
+		err := m.serve(hosts)
+		if err == nil {
+			return nil
+		}
+		log.Println(internal.WarningPrefix, "resolving meshnet peers with systemd-resolved, using the hosts file:", err)
+	}
+
+	if err := m.stop(); err != nil {
+		log.Println(internal.WarningPrefix, "stopping meshnet peer resolver:", err)
+	}
+	return m.fallback.SetHosts(hosts)
+}
+
+// UnsetHosts stops resolving the meshnet peer names
+func (m *MagicDNS) UnsetHosts() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return errors.Join(m.stop(), m.fallback.UnsetHosts())
+}
+
+// serve starts answering the queries for the hosts and points systemd-resolved to it. Not thread safe. Lock
+// mu before using
+func (m *MagicDNS) serve(hosts Hosts) error {
+	m.setRecords(hosts)
+	if m.udp != nil {
+		return nil
+	}
+
+	udp, err := net.ListenPacket("udp", m.listenAddr)
+	if err != nil {
+		return fmt.Errorf("listening for dns queries over udp: %w", err)
+	}
+	tcp, err := net.Listen("tcp", m.listenAddr)
+	if err != nil {
+		udp.Close()
+		return fmt.Errorf("listening for dns queries over tcp: %w", err)
+	}
+	m.udp = udp
+	m.tcp = tcp
+	m.wg.Add(2)
+	go func() {
+		defer m.wg.Done()
+		serveUDP(udp, m.answer)
+	}()
+	go func() {
+		defer m.wg.Done()
+		serveTCP(tcp, m.answer)
+	}()
+
+	if err := m.configureResolved(udp.LocalAddr().String()); err != nil {
+		return errors.Join(err, m.stop())
+	}
+	// names could have been written to the hosts file before systemd-resolved was started
+	if err := m.fallback.UnsetHosts(); err != nil {
+		log.Println(internal.WarningPrefix, "removing meshnet peers from the hosts file:", err)
+	}
+	return nil
+}
+
+// stop stops answering the queries and removes the configuration of systemd-resolved. Not thread safe. Lock mu
+// before using
+func (m *MagicDNS) stop() error {
+	m.setRecords(nil)
+	if m.udp == nil {
+		return nil
+	}
+	m.udp.Close()
+	m.tcp.Close()
+	m.wg.Wait()
+	m.udp = nil
+	m.tcp = nil
+
+	if err := os.Remove(m.confPath); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("removing systemd-resolved configuration: %w", err)
+	}
+	return m.reloadResolved()
+}
+
+func (m *MagicDNS) configureResolved(addr string) error {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	server := host
+	if port != "53" {
+		server = addr
+	}
+	conf := fmt.Sprintf(
+		"# Managed by NordVPN, routes the meshnet peer names to the local resolver\n[Resolve]\nDNS=%s\nDomains=~%s\n",
+		server,
+		MeshnetDomain,
+	)
+	// systemd-resolved runs as a separate user, the configuration has to be readable by it
+	if err := os.MkdirAll(filepath.Dir(m.confPath), internal.PermUserRWXGroupRXOthersRX); err != nil {
+		return fmt.Errorf("creating systemd-resolved configuration directory: %w", err)
+	}
+	if err := os.WriteFile(m.confPath, []byte(conf), internal.PermUserRWGroupROthersR); err != nil {
+		return fmt.Errorf("writing systemd-resolved configuration: %w", err)
+	}
+	return m.reloadResolved()
+}
+
+func (m *MagicDNS) setRecords(hosts Hosts) {
+	records := map[string][]netip.Addr{}
+	for _, host := range hosts {
+		for _, name := range append([]string{host.FQDN}, host.DomainNames...) {
+			if name == "" {
+				continue
+			}
+			fqdn := meshnetFQDN(name)
+			if !slices.Contains(records[fqdn], host.IP) {
+				records[fqdn] = append(records[fqdn], host.IP)
+			}
+		}
+	}
+
+	m.recordsMu.Lock()
+	defer m.recordsMu.Unlock()
+	m.records = records
+}
+
+// answer builds the response to the query for the peer name. Queries outside of the meshnet domain are refused.
+// Returns nil if the query is malformed.
+func (m *MagicDNS) answer(query []byte) []byte {
+	var p dnsmessage.Parser
+	h, err := p.Start(query)
+	if err != nil {
+		return nil
+	}
+	q, err := p.Question()
+	if err != nil {
+		return nil
+	}
+
+	name := strings.ToLower(q.Name.String())
+	if !strings.HasSuffix(name, "."+MeshnetDomain+".") {
+		return replyTo(query, dnsmessage.RCodeRefused, false)
+	}
+	m.recordsMu.RLock()
+	addrs, ok := m.records[name]
+	m.recordsMu.RUnlock()
+	if !ok {
+		return replyTo(query, dnsmessage.RCodeNameError, false)
+	}
+
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{
+		ID:               h.ID,
+		Response:         true,
+		OpCode:           h.OpCode,
+		Authoritative:    true,
+		RecursionDesired: h.RecursionDesired,
+	})
+	b.EnableCompression()
+	if err := b.StartQuestions(); err != nil {
+		return nil
+	}
+	if err := b.Question(q); err != nil {
+		return nil
+	}
+	if err := b.StartAnswers(); err != nil {
+		return nil
+	}
+	rh := dnsmessage.ResourceHeader{Name: q.Name, Class: dnsmessage.ClassINET, TTL: magicDNSTTL}
+	for _, addr := range addrs {
+		switch {
+		case q.Type == dnsmessage.TypeA && addr.Is4():
+			err = b.AResource(rh, dnsmessage.AResource{A: addr.As4()})
+		case q.Type == dnsmessage.TypeAAAA && addr.Is6():
+			err = b.AAAAResource(rh, dnsmessage.AAAAResource{AAAA: addr.As16()})
+		}
+		if err != nil {
+			return nil
+		}
+	}
+	resp, err := b.Finish()
+	if err != nil {
+		return nil
+	}
+	return resp
+}
+
+// meshnetFQDN returns the lowercase fully qualified name in the meshnet domain
+func meshnetFQDN(name string) string {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if !strings.HasSuffix(name, "."+MeshnetDomain) {
+		name += "." + MeshnetDomain
+	}
+	return name + "."
+}
+
+func reloadSystemdResolved() error {
+	// #nosec G204 -- input is properly validated
+	out, err := exec.Command(internal.SystemctlExec, "try-reload-or-restart", resolvedService).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("reloading systemd-resolved: %s: %w", strings.TrimSpace(string(out)), err)
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package dns
+
+import (
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+type hostsSetterMock struct {
+	hosts   Hosts
+	unsets  int
+	setHits int
+}
+
+func (h *hostsSetterMock) SetHosts(hosts Hosts) error {
+	h.setHits++
+	h.hosts = hosts
+	return nil
+}
+
+func (h *hostsSetterMock) UnsetHosts() error {
+	h.unsets++
+	h.hosts = nil
+	return nil
+}
+
+func newTestMagicDNS(t *testing.T, fallback HostnameSetter, resolvedAvailable bool) (*MagicDNS, *int) {
+	t.Helper()
+	reloads := 0
+	m := NewMagicDNS(fallback)
+	m.listenAddr = "127.0.0.1:0"
+	m.confPath = filepath.Join(t.TempDir(), "resolved.conf.d", "nordvpn-meshnet.conf")
+	m.resolvedAvailable = func() bool { return resolvedAvailable }
+	m.reloadResolved = func() error { reloads++; return nil }
+	return m, &reloads
+}
+
+func buildNameQuery(t *testing.T, name string, qtype dnsmessage.Type) []byte {
+	t.Helper()
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: 4242, RecursionDesired: true})
+	require.NoError(t, b.StartQuestions())
+	require.NoError(t, b.Question(dnsmessage.Question{
+		Name:  dnsmessage.MustNewName(name),
+		Type:  qtype,
+		Class: dnsmessage.ClassINET,
+	}))
+	query, err := b.Finish()
+	require.NoError(t, err)
+	return query
+}
+
+var magicDNSHosts = Hosts{
+	{
+		IP:          netip.MustParseAddr("100.64.0.1"),
+		FQDN:        "mylaptop",
+		DomainNames: []string{"mylaptop.nord", "laptop-everest.nord", "laptop-everest"},
+	},
+	{
+		IP:          netip.MustParseAddr("fd74:656c:696f::1"),
+		FQDN:        "desktop-alps.nord",
+		DomainNames: []string{"desktop-alps"},
+	},
+}
+
+func TestMagicDNS_Answer(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	m, _ := newTestMagicDNS(t, &hostsSetterMock{}, true)
+	m.setRecords(magicDNSHosts)
+
+	tests := []struct {
+		name     string
+		query    string
+		qtype    dnsmessage.Type
+		rcode    dnsmessage.RCode
+		expected []string
+	}{
+		{name: "nickname", query: "mylaptop.nord.", qtype: dnsmessage.TypeA, expected: []string{"100.64.0.1"}},
+		{name: "hostname", query: "laptop-everest.nord.", qtype: dnsmessage.TypeA, expected: []string{"100.64.0.1"}},
+		{name: "case insensitive", query: "MyLaptop.Nord.", qtype: dnsmessage.TypeA, expected: []string{"100.64.0.1"}},
+		{name: "ipv6", query: "desktop-alps.nord.", qtype: dnsmessage.TypeAAAA, expected: []string{"fd74:656c:696f::1"}},
+		{name: "no record of the type", query: "mylaptop.nord.", qtype: dnsmessage.TypeAAAA},
+		{name: "unknown peer", query: "phone.nord.", qtype: dnsmessage.TypeA, rcode: dnsmessage.RCodeNameError},
+		{name: "outside of meshnet", query: "nordvpn.com.", qtype: dnsmessage.TypeA, rcode: dnsmessage.RCodeRefused},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := m.answer(buildNameQuery(t, test.query, test.qtype))
+			require.NotNil(t, resp)
+
+			var p dnsmessage.Parser
+			h, err := p.Start(resp)
+			require.NoError(t, err)
+			assert.Equal(t, uint16(4242), h.ID)
+			assert.Equal(t, test.rcode, h.RCode)
+			require.NoError(t, p.SkipAllQuestions())
+			answers, err := p.AllAnswers()
+			require.NoError(t, err)
+
+			var addrs []string
+			for _, answer := range answers {
+				switch body := answer.Body.(type) {
+				case *dnsmessage.AResource:
+					addrs = append(addrs, netip.AddrFrom4(body.A).String())
+				case *dnsmessage.AAAAResource:
+					addrs = append(addrs, netip.AddrFrom16(body.AAAA).String())
+				}
+			}
+			assert.Equal(t, test.expected, addrs)
+		})
+	}
+
+	assert.Nil(t, m.answer([]byte{1, 2, 3}))
+}
+
+func TestMagicDNS_SetHosts(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fallback := &hostsSetterMock{}
+	m, reloads := newTestMagicDNS(t, fallback, true)
+
+	require.NoError(t, m.SetHosts(magicDNSHosts))
+	require.NoError(t, m.SetHosts(magicDNSHosts))
+	assert.Equal(t, 0, fallback.setHits)
+	assert.Equal(t, 1, fallback.unsets, "hosts file is cleaned up only when the resolver starts")
+	assert.Equal(t, 1, *reloads)
+
+	conf, err := os.ReadFile(m.confPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(conf), "DNS="+m.udp.LocalAddr().String())
+	assert.Contains(t, string(conf), "Domains=~nord")
+
+	conn, err := net.Dial("udp", m.udp.LocalAddr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write(buildNameQuery(t, "mylaptop.nord.", dnsmessage.TypeA))
+	require.NoError(t, err)
+	buf := make([]byte, minUDPSize)
+	n, err := conn.Read(buf)
+	require.NoError(t, err)
+	assert.Equal(t, dnsmessage.RCodeSuccess, parseResponse(t, buf[:n]).RCode)
+
+	require.NoError(t, m.UnsetHosts())
+	assert.NoFileExists(t, m.confPath)
+	assert.Equal(t, 2, *reloads)
+	assert.Nil(t, m.udp)
+}
+
+func TestMagicDNS_FallsBackToHostsFile(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fallback := &hostsSetterMock{}
+	m, reloads := newTestMagicDNS(t, fallback, false)
+
+	require.NoError(t, m.SetHosts(magicDNSHosts))
+	assert.Equal(t, magicDNSHosts, fallback.hosts)
+	assert.Nil(t, m.udp)
+	assert.NoFileExists(t, m.confPath)
+	assert.Equal(t, 0, *reloads)
+
+	require.NoError(t, m.UnsetHosts())
+	assert.Nil(t, fallback.hosts)
+}
+
+func TestMagicDNS_FallsBackWhenResolvedFails(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fallback := &hostsSetterMock{}
+	m, _ := newTestMagicDNS(t, fallback, true)
+	// configuration directory cannot be created under a file
+	blocker := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(blocker, nil, 0600))
+	m.confPath = filepath.Join(blocker, "nordvpn-meshnet.conf")
+
+	require.NoError(t, m.SetHosts(magicDNSHosts))
+	assert.Equal(t, magicDNSHosts, fallback.hosts)
+	assert.Nil(t, m.udp)
+}
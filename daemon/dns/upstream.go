@@ -0,0 +1,192 @@
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Protocol used to reach the upstream DNS server
+type Protocol int
+
+const (
+	// ProtocolPlain is unencrypted DNS on port 53
+	ProtocolPlain Protocol = iota
+	// ProtocolTLS is DNS-over-TLS (RFC 7858)
+	ProtocolTLS
+	// ProtocolHTTPS is DNS-over-HTTPS (RFC 8484)
+	ProtocolHTTPS
+)
+
+const (
+	schemeTLS   = "tls"
+	schemeHTTPS = "https"
+
+	portPlain = 53
+	portTLS   = 853
+	portHTTPS = 443
+
+	defaultDoHPath = "/dns-query"
+)
+
+var (
+	// ErrInvalidUpstream is returned when the upstream is neither an IP address nor a supported URL
+	ErrInvalidUpstream = errors.New("dns server must be an IP address, tls:// or https:// URL")
+	// ErrInvalidHostname is returned when the host of encrypted upstream is not a valid hostname
+	ErrInvalidHostname = errors.New("invalid dns server hostname")
+)
+
+// Upstream is a DNS server the queries are forwarded to
+type Upstream struct {
+	Protocol Protocol
+	// Host is the IP address or the hostname of the server. Hostnames are allowed only for encrypted protocols.
+	Host string
+	Port uint16
+	// ServerName is used to verify the certificate of encrypted protocols
+	ServerName string
+	// Path of DNS-over-HTTPS endpoint
+	Path string
+}
+
+// ParseUpstream accepts the plain IP address, tls://host[:port] or https://host[:port][/path]. Certificate of
+// DNS-over-TLS server given by the IP address can be verified against the name given as tls://ip#name.
+func ParseUpstream(s string) (Upstream, error) {
+	if addr, err := netip.ParseAddr(s); err == nil {
+		return Upstream{Protocol: ProtocolPlain, Host: addr.String(), Port: portPlain}, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" || u.User != nil || u.RawQuery != "" {
+		return Upstream{}, ErrInvalidUpstream
+	}
+
+	var upstream Upstream
+	switch u.Scheme {
+	case schemeTLS:
+		if u.Path != "" {
+			return Upstream{}, ErrInvalidUpstream
+		}
+		upstream = Upstream{Protocol: ProtocolTLS, Port: portTLS, ServerName: u.Fragment}
+	case schemeHTTPS:
+		if u.Fragment != "" {
+			return Upstream{}, ErrInvalidUpstream
+		}
+		upstream = Upstream{Protocol: ProtocolHTTPS, Port: portHTTPS, Path: u.Path}
+		if upstream.Path == "" || upstream.Path == "/" {
+			upstream.Path = defaultDoHPath
+		}
+	default:
+		return Upstream{}, ErrInvalidUpstream
+	}
+
+	upstream.Host = u.Hostname()
+	if port := u.Port(); port != "" {
+		p, err := strconv.ParseUint(port, 10, 16)
+		if err != nil || p == 0 {
+			return Upstream{}, ErrInvalidUpstream
+		}
+		upstream.Port = uint16(p)
+	}
+
+	if addr, err := netip.ParseAddr(upstream.Host); err == nil {
+		upstream.Host = addr.String()
+	} else if !isValidHostname(upstream.Host) {
+		return Upstream{}, fmt.Errorf("%w: %s", ErrInvalidHostname, upstream.Host)
+	}
+	if upstream.ServerName == "" {
+		upstream.ServerName = upstream.Host
+	} else if !isValidHostname(upstream.ServerName) {
+		return Upstream{}, fmt.Errorf("%w: %s", ErrInvalidHostname, upstream.ServerName)
+	}
+	return upstream, nil
+}
+
+// ParseUpstreams parses all of the given upstreams
+func ParseUpstreams(nameservers []string) ([]Upstream, error) {
+	upstreams := make([]Upstream, 0, len(nameservers))
+	for _, nameserver := range nameservers {
+		upstream, err := ParseUpstream(nameserver)
+		if err != nil {
+			return nil, err
+		}
+		upstreams = append(upstreams, upstream)
+	}
+	return upstreams, nil
+}
+
+// IsEncrypted reports whether queries to the upstream are encrypted
+func (u Upstream) IsEncrypted() bool {
+	return u.Protocol != ProtocolPlain
+}
+
+// Address returns host:port of the upstream
+func (u Upstream) Address() string {
+	return net.JoinHostPort(u.Host, strconv.Itoa(int(u.Port)))
+}
+
+// String returns the upstream in the form accepted by ParseUpstream
+func (u Upstream) String() string {
+	switch u.Protocol {
+	case ProtocolTLS:
+		s := schemeTLS + "://" + u.hostPort(portTLS)
+		if u.ServerName != u.Host {
+			s += "#" + u.ServerName
+		}
+		return s
+	case ProtocolHTTPS:
+		return schemeHTTPS + "://" + u.hostPort(portHTTPS) + u.Path
+	case ProtocolPlain:
+	}
+	return u.Host
+}
+
+// hostPort omits the port if it is the default one for the protocol
+func (u Upstream) hostPort(defaultPort uint16) string {
+	if u.Port == defaultPort {
+		if strings.Contains(u.Host, ":") {
+			return "[" + u.Host + "]"
+		}
+		return u.Host
+	}
+	return u.Address()
+}
+
+// isValidHostname checks whether the name is a fully qualified hostname as defined in RFC 1123
+func isValidHostname(name string) bool {
+	name = strings.TrimSuffix(name, ".")
+	if len(name) == 0 || len(name) > 253 {
+		return false
+	}
+
+	labels := strings.Split(name, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+	// top level domain cannot be numeric, otherwise the name could be confused with the IP address
+	_, err := strconv.Atoi(labels[len(labels)-1])
+	return err != nil
+}
+
+// HasEncrypted reports whether any of the nameservers is an encrypted upstream
+func HasEncrypted(nameservers []string) bool {
+	for _, nameserver := range nameservers {
+		if upstream, err := ParseUpstream(nameserver); err == nil && upstream.IsEncrypted() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package dns
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUpstream(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		input    string
+		expected Upstream
+		err      error
+	}{
+		{
+			input:    "1.1.1.1",
+			expected: Upstream{Protocol: ProtocolPlain, Host: "1.1.1.1", Port: 53},
+		},
+		{
+			input:    "2606:4700:4700::1111",
+			expected: Upstream{Protocol: ProtocolPlain, Host: "2606:4700:4700::1111", Port: 53},
+		},
+		{
+			input:    "tls://dns.quad9.net",
+			expected: Upstream{Protocol: ProtocolTLS, Host: "dns.quad9.net", Port: 853, ServerName: "dns.quad9.net"},
+		},
+		{
+			input:    "tls://1.1.1.1:8853#cloudflare-dns.com",
+			expected: Upstream{Protocol: ProtocolTLS, Host: "1.1.1.1", Port: 8853, ServerName: "cloudflare-dns.com"},
+		},
+		{
+			input: "https://cloudflare-dns.com",
+			expected: Upstream{
+				Protocol: ProtocolHTTPS, Host: "cloudflare-dns.com", Port: 443, ServerName: "cloudflare-dns.com",
+				Path: "/dns-query",
+			},
+		},
+		{
+			input: "https://[2620:fe::fe]:8443/resolve",
+			expected: Upstream{
+				Protocol: ProtocolHTTPS, Host: "2620:fe::fe", Port: 8443, ServerName: "2620:fe::fe", Path: "/resolve",
+			},
+		},
+		{input: "dns.quad9.net", err: ErrInvalidUpstream},
+		{input: "quic://dns.adguard.com", err: ErrInvalidUpstream},
+		{input: "tls://dns.quad9.net/path", err: ErrInvalidUpstream},
+		{input: "https://user@dns.quad9.net", err: ErrInvalidUpstream},
+		{input: "https://dns.quad9.net/dns-query?dns=x", err: ErrInvalidUpstream},
+		{input: "tls://dns.quad9.net:0", err: ErrInvalidUpstream},
+		{input: "tls://dns.quad9.net:70000", err: ErrInvalidUpstream},
+		{input: "tls://localhost", err: ErrInvalidHostname},
+		{input: "tls://dns_quad9.net", err: ErrInvalidHostname},
+		{input: "tls://-dns.quad9.net", err: ErrInvalidHostname},
+		{input: "tls://1.1.1.1#cloudflare dns", err: ErrInvalidHostname},
+		{input: "tls://1.1.1.1#cloudflare", err: ErrInvalidHostname},
+		{input: "https://dns.123", err: ErrInvalidHostname},
+		{input: "tls://" + strings.Repeat("a", 64) + ".com", err: ErrInvalidHostname},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			upstream, err := ParseUpstream(test.input)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.expected, upstream)
+		})
+	}
+}
+
+func TestUpstream_String(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	for _, input := range []string{
+		"1.1.1.1",
+		"tls://dns.quad9.net",
+		"tls://1.1.1.1:8853#cloudflare-dns.com",
+		"tls://[2620:fe::fe]",
+		"https://cloudflare-dns.com/dns-query",
+		"https://[2620:fe::fe]:8443/resolve",
+	} {
+		upstream, err := ParseUpstream(input)
+		assert.NoError(t, err)
+		assert.Equal(t, input, upstream.String())
+	}
+}
+
+func TestHasEncrypted(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.False(t, HasEncrypted(nil))
+	assert.False(t, HasEncrypted([]string{"1.1.1.1", "9.9.9.9"}))
+	assert.True(t, HasEncrypted([]string{"1.1.1.1", "tls://dns.quad9.net"}))
+}
@@ -0,0 +1,94 @@
+package daemon
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// doubleVPNRoute returns the hostname codes of the countries where the traffic enters and leaves NordVPN network.
+// Double VPN servers forward the connection to the exit server on their own, so the client connects to the entry
+// server the same way as to any other server and no additional routing or firewall rules are needed. Both countries
+// are named by the hostname, e.g. ca-us1.nordvpn.com enters in Canada and leaves in the United States.
+func doubleVPNRoute(server core.Server) (string, string, bool) {
+	if !slices.ContainsFunc(server.Groups, core.ByGroup(config.ServerGroup_DoubleVPN)) {
+		return "", "", false
+	}
+	label := strings.TrimRight(strings.Split(server.Hostname, ".")[0], "0123456789")
+	entry, exit, ok := strings.Cut(label, "-")
+	if !ok || entry == "" || exit == "" {
+		return "", "", false
+	}
+	return entry, exit, true
+}
+
+// hostnameCountryCode resolves the country name or code to the code used in server hostnames
+func hostnameCountryCode(tag string, countries core.Countries) (string, bool) {
+	countryIndex, cityIndex := locationByName(tag, countries)
+	if countryIndex == -1 || cityIndex != -1 {
+		return "", false
+	}
+	code := strings.ToLower(countries[countryIndex].Code)
+	if code == "gb" {
+		code = "uk"
+	}
+	return code, true
+}
+
+// selectDoubleVPNServer picks the least loaded Double VPN server entering in the via country and leaving in the
+// exit country. Any exit country is accepted when exitTag is empty.
+func selectDoubleVPNServer(
+	servers core.Servers,
+	countries core.Countries,
+	cfg config.Config,
+	exitTag string,
+	via string,
+) (*core.Server, error) {
+	entry, ok := hostnameCountryCode(via, countries)
+	if !ok {
+		return nil, internal.ErrTagDoesNotExist
+	}
+	exit := ""
+	if exitTag != "" {
+		if exit, ok = hostnameCountryCode(exitTag, countries); !ok {
+			return nil, internal.ErrTagDoesNotExist
+		}
+	}
+
+	var selected *core.Server
+	for i, server := range servers {
+		serverEntry, serverExit, ok := doubleVPNRoute(server)
+		if !ok || serverEntry != entry || (exit != "" && serverExit != exit) {
+			continue
+		}
+		if server.Status != core.Online ||
+			!core.IsConnectableWithProtocol(cfg.Technology, cfg.AutoConnectData.Protocol)(server) ||
+			core.IsObfuscated()(server) != cfg.AutoConnectData.Obfuscate {
+			continue
+		}
+		if selected == nil || server.Load < selected.Load {
+			selected = &servers[i]
+		}
+	}
+
+	if selected == nil {
+		return nil, internal.ErrServerIsUnavailable
+	}
+	return selected, nil
+}
+
+// doubleVPNTarget splits the connect arguments into the exit country and reports whether Double VPN was requested.
+// Double VPN is requested either by the group flag with the optional exit country or by the group as the tag.
+func doubleVPNTarget(tag string, groupFlag string) (string, bool) {
+	group, err := resolveServerGroup(groupFlag, tag)
+	if err != nil || group != config.ServerGroup_DoubleVPN {
+		return "", false
+	}
+	if groupFlag == "" {
+		return "", true
+	}
+	return tag, true
+}
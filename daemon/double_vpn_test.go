@@ -0,0 +1,103 @@
+package daemon
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func doubleVPNServer(id int, hostname string, load int64, techs ...core.ServerTechnology) core.Server {
+	server := getServer(id, hostname, "Germany", "DE", "Berlin", false,
+		core.Groups{{ID: config.ServerGroup_DoubleVPN}}, techs)
+	server.Load = load
+	return server
+}
+
+func TestDoubleVPNRoute(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	entry, exit, ok := doubleVPNRoute(doubleVPNServer(1, "ca-us12.nordvpn.com", 0))
+	assert.True(t, ok)
+	assert.Equal(t, "ca", entry)
+	assert.Equal(t, "us", exit)
+
+	_, _, ok = doubleVPNRoute(doubleVPNServer(2, "de12.nordvpn.com", 0))
+	assert.False(t, ok)
+
+	standard := getServer(3, "ca-us1.nordvpn.com", "Canada", "CA", "Toronto", false,
+		core.Groups{{ID: config.ServerGroup_STANDARD_VPN_SERVERS}}, nil)
+	_, _, ok = doubleVPNRoute(standard)
+	assert.False(t, ok)
+}
+
+func TestSelectDoubleVPNServer(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	servers := core.Servers{
+		doubleVPNServer(1, "fr-de1.nordvpn.com", 40, core.OpenVPNUDP),
+		doubleVPNServer(2, "fr-de2.nordvpn.com", 10, core.OpenVPNUDP),
+		doubleVPNServer(3, "fr-uk1.nordvpn.com", 5, core.OpenVPNUDP),
+		doubleVPNServer(4, "lt-de1.nordvpn.com", 1, core.OpenVPNUDP),
+		// NordLynx is not supported
+		doubleVPNServer(5, "fr-de3.nordvpn.com", 1, core.WireguardTech),
+	}
+	offline := doubleVPNServer(6, "fr-de4.nordvpn.com", 0, core.OpenVPNUDP)
+	offline.Status = core.Offline
+	servers = append(servers, offline)
+
+	cfg := config.Config{
+		Technology:      config.Technology_OPENVPN,
+		AutoConnectData: config.AutoConnectData{Protocol: config.Protocol_UDP},
+	}
+
+	tests := []struct {
+		name        string
+		exit        string
+		via         string
+		expectedID  int64
+		expectedErr error
+	}{
+		{name: "any exit", via: "france", expectedID: 3},
+		{name: "exit by code", exit: "de", via: "fr", expectedID: 2},
+		{name: "united kingdom", exit: "united_kingdom", via: "france", expectedID: 3},
+		{name: "unknown entry", via: "atlantis", expectedErr: internal.ErrTagDoesNotExist},
+		{name: "city is not a country", via: "paris", expectedErr: internal.ErrTagDoesNotExist},
+		{name: "no servers", exit: "france", via: "germany", expectedErr: internal.ErrServerIsUnavailable},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, err := selectDoubleVPNServer(servers, countriesList(), cfg, test.exit, test.via)
+			if test.expectedErr != nil {
+				assert.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedID, server.ID)
+		})
+	}
+}
+
+func TestDoubleVPNTarget(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	exit, ok := doubleVPNTarget("germany", "double_vpn")
+	assert.True(t, ok)
+	assert.Equal(t, "germany", exit)
+
+	exit, ok = doubleVPNTarget("double_vpn", "")
+	assert.True(t, ok)
+	assert.Empty(t, exit)
+
+	_, ok = doubleVPNTarget("germany", "")
+	assert.False(t, ok)
+
+	_, ok = doubleVPNTarget("germany", "p2p")
+	assert.False(t, ok)
+}
@@ -0,0 +1,234 @@
+package daemon
+
+import (
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"google.golang.org/grpc/peer"
+)
+
+// eventStreamBuffer is how many events are kept for the subscriber which is not keeping up, newer events are
+// dropped when the buffer is full
+const eventStreamBuffer = 64
+
+type streamEvent struct {
+	kind pb.DaemonEventType
+	at   time.Time
+	data any
+}
+
+type eventSubscriber struct {
+	types  []pb.DaemonEventType
+	events chan streamEvent
+}
+
+// wants reports whether the subscriber has asked for the events of the kind
+func (s *eventSubscriber) wants(kind pb.DaemonEventType) bool {
+	return len(s.types) == 0 || slices.Contains(s.types, kind)
+}
+
+// EventStream multiplexes the connection, settings, authentication, meshnet and firewall events for the
+// SubscribeEvents subscribers. It is subscribed to the event topics of the daemon, so that the clients don't have to
+// poll every subsystem on their own.
+type EventStream struct {
+	mu          sync.Mutex
+	subscribers map[*eventSubscriber]struct{}
+	now         func() time.Time
+}
+
+func newEventStream(now func() time.Time) *EventStream {
+	return &EventStream{subscribers: map[*eventSubscriber]struct{}{}, now: now}
+}
+
+// subscribe registers the subscriber for the given types of events, all types are received if none are given. The
+// returned function has to be called when the subscriber stops listening.
+func (s *EventStream) subscribe(types []pb.DaemonEventType) (*eventSubscriber, func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sub := &eventSubscriber{types: types, events: make(chan streamEvent, eventStreamBuffer)}
+	s.subscribers[sub] = struct{}{}
+	return sub, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.subscribers, sub)
+	}
+}
+
+func (s *EventStream) publish(kind pb.DaemonEventType, data any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	event := streamEvent{kind: kind, at: s.now(), data: data}
+	for sub := range s.subscribers {
+		if !sub.wants(kind) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			log.Println(internal.WarningPrefix, "event stream subscriber is not keeping up, dropping", kind, "event")
+		}
+	}
+}
+
+// NotifyConnect publishes the connection attempt and its outcome
+func (s *EventStream) NotifyConnect(e events.DataConnect) error {
+	s.publish(pb.DaemonEventType_CONNECTION, e)
+	return nil
+}
+
+// NotifyDisconnect publishes the end of the connection
+func (s *EventStream) NotifyDisconnect(e events.DataDisconnect) error {
+	s.publish(pb.DaemonEventType_CONNECTION, e)
+	return nil
+}
+
+func (s *EventStream) NotifyLogin(events.DataAuthorization) error {
+	s.publish(pb.DaemonEventType_AUTH, pb.LoginEventType_LOGIN)
+	return nil
+}
+
+func (s *EventStream) NotifyLogout(events.DataAuthorization) error {
+	s.publish(pb.DaemonEventType_AUTH, pb.LoginEventType_LOGOUT)
+	return nil
+}
+
+func (s *EventStream) NotifyMFA(bool) error { return nil }
+
+// NotifyConfigChanged publishes the settings, the config is copied as the subscribers convert it later
+func (s *EventStream) NotifyConfigChanged(c *config.Config) error {
+	cfg := *c
+	s.publish(pb.DaemonEventType_SETTINGS, &cfg)
+	return nil
+}
+
+func (s *EventStream) NotifyMeshnet(enabled bool) error {
+	eventType := pb.MeshnetEventType_MESHNET_DISABLED
+	if enabled {
+		eventType = pb.MeshnetEventType_MESHNET_ENABLED
+	}
+	s.publish(pb.DaemonEventType_MESHNET, &pb.MeshnetEvent{Type: eventType})
+	return nil
+}
+
+func (s *EventStream) NotifyPeerUpdate(peerIDs []string) error {
+	s.publish(pb.DaemonEventType_MESHNET, &pb.MeshnetEvent{
+		Type:    pb.MeshnetEventType_MESHNET_PEERS_UPDATED,
+		PeerIds: slices.Clone(peerIDs),
+	})
+	return nil
+}
+
+func (s *EventStream) NotifyFirewall(enabled bool) error {
+	s.publish(pb.DaemonEventType_FIREWALL,
+		&pb.FirewallEvent{Setting: pb.FirewallSetting_FIREWALL_RULES, Enabled: enabled})
+	return nil
+}
+
+func (s *EventStream) NotifyKillswitch(enabled bool) error {
+	s.publish(pb.DaemonEventType_FIREWALL,
+		&pb.FirewallEvent{Setting: pb.FirewallSetting_KILL_SWITCH, Enabled: enabled})
+	return nil
+}
+
+// NotifySettingsReloaded publishes the settings changed by the managed settings file
+func (s *EventStream) NotifySettingsReloaded(e *pb.SettingsReloadEvent) {
+	s.publish(pb.DaemonEventType_SETTINGS, e)
+}
+
+// NotifyTunnelHealth publishes the recovery of the failing tunnel
+func (s *EventStream) NotifyTunnelHealth(e *pb.TunnelHealthEvent) {
+	s.publish(pb.DaemonEventType_CONNECTION, e)
+}
+
+// eventToProtobuf converts the event for the subscriber, settings depend on the user as some of them are per user
+func eventToProtobuf(e streamEvent, uid int64) *pb.DaemonEvent {
+	event := &pb.DaemonEvent{Timestamp: e.at.UnixMilli()}
+	switch data := e.data.(type) {
+	case events.DataConnect:
+		state := pb.ConnectionState_CONNECTING
+		switch data.EventStatus {
+		case events.StatusSuccess:
+			state = pb.ConnectionState_CONNECTED
+		case events.StatusFailure, events.StatusCanceled:
+			state = pb.ConnectionState_DISCONNECTED
+		case events.StatusAttempt:
+		}
+		event.Event = &pb.DaemonEvent_Connection{Connection: &pb.ConnectionStatus{
+			State:          state,
+			ServerIp:       data.TargetServerIP,
+			ServerCountry:  data.TargetServerCountry,
+			ServerCity:     data.TargetServerCity,
+			ServerName:     data.TargetServerName,
+			ServerHostname: data.TargetServerDomain,
+			IsMeshPeer:     data.IsMeshnetPeer,
+			ByUser:         true,
+		}}
+	case events.DataDisconnect:
+		event.Event = &pb.DaemonEvent_Connection{Connection: &pb.ConnectionStatus{
+			State:  pb.ConnectionState_DISCONNECTED,
+			ByUser: data.ByUser,
+		}}
+	case pb.LoginEventType:
+		event.Event = &pb.DaemonEvent_Auth{Auth: &pb.LoginEvent{Type: data}}
+	case *config.Config:
+		event.Event = &pb.DaemonEvent_Settings{Settings: configToProtobuf(data, uid)}
+	case *pb.MeshnetEvent:
+		event.Event = &pb.DaemonEvent_Meshnet{Meshnet: data}
+	case *pb.FirewallEvent:
+		event.Event = &pb.DaemonEvent_Firewall{Firewall: data}
+	case *pb.SettingsReloadEvent:
+		event.Event = &pb.DaemonEvent_SettingsReload{SettingsReload: data}
+	case *pb.TunnelHealthEvent:
+		event.Event = &pb.DaemonEvent_TunnelHealth{TunnelHealth: data}
+	default:
+		return nil
+	}
+	return event
+}
+
+// EventStream returns the stream of events sent to the SubscribeEvents subscribers
+func (r *RPC) EventStream() *EventStream {
+	return r.eventStream
+}
+
+// SubscribeEvents streams the daemon events of the requested types until the subscriber stops listening
+func (r *RPC) SubscribeEvents(in *pb.SubscribeEventsRequest, srv pb.Daemon_SubscribeEventsServer) error {
+	var uid int64
+	if p, ok := peer.FromContext(srv.Context()); ok {
+		cred, ok := p.AuthInfo.(internal.UcredAuth)
+		if !ok {
+			log.Println(internal.ErrorPrefix, "failed to get the uid of the event stream subscriber")
+			return internal.ErrUnhandled
+		}
+		uid = int64(cred.Uid)
+	}
+
+	sub, unsubscribe := r.eventStream.subscribe(in.GetTypes())
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case <-r.shutdown:
+			return nil
+		case e := <-sub.events:
+			event := eventToProtobuf(e, uid)
+			if event == nil {
+				continue
+			}
+			if err := srv.Send(event); err != nil {
+				log.Println(internal.ErrorPrefix, "failed to send event:", err)
+				return err
+			}
+		}
+	}
+}
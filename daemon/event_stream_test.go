@@ -0,0 +1,146 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func receiveEvents(sub *eventSubscriber) []streamEvent {
+	var received []streamEvent
+	for {
+		select {
+		case e := <-sub.events:
+			received = append(received, e)
+		default:
+			return received
+		}
+	}
+}
+
+func TestEventStream_FiltersTypes(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	stream := newEventStream(time.Now)
+	all, unsubscribeAll := stream.subscribe(nil)
+	defer unsubscribeAll()
+	firewall, unsubscribeFirewall := stream.subscribe([]pb.DaemonEventType{pb.DaemonEventType_FIREWALL})
+
+	assert.NoError(t, stream.NotifyConnect(events.DataConnect{EventStatus: events.StatusSuccess}))
+	assert.NoError(t, stream.NotifyKillswitch(true))
+	assert.NoError(t, stream.NotifyLogout(events.DataAuthorization{}))
+
+	assert.Len(t, receiveEvents(all), 3)
+	received := receiveEvents(firewall)
+	require.Len(t, received, 1)
+	assert.Equal(t, pb.DaemonEventType_FIREWALL, received[0].kind)
+
+	unsubscribeFirewall()
+	assert.NoError(t, stream.NotifyFirewall(false))
+	assert.Empty(t, receiveEvents(firewall))
+	assert.Len(t, receiveEvents(all), 1)
+}
+
+func TestEventStream_DropsEventsForSlowSubscriber(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	stream := newEventStream(time.Now)
+	sub, unsubscribe := stream.subscribe(nil)
+	defer unsubscribe()
+
+	for i := 0; i < eventStreamBuffer+10; i++ {
+		assert.NoError(t, stream.NotifyPeerUpdate([]string{"peer"}))
+	}
+	assert.Len(t, receiveEvents(sub), eventStreamBuffer)
+}
+
+func TestEventToProtobuf(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	at := time.UnixMilli(1700000000000)
+	tests := []struct {
+		name     string
+		data     any
+		expected *pb.DaemonEvent
+	}{
+		{
+			name: "connected",
+			data: events.DataConnect{
+				EventStatus:        events.StatusSuccess,
+				TargetServerDomain: "de1.nordvpn.com",
+				TargetServerIP:     "1.2.3.4",
+			},
+			expected: &pb.DaemonEvent{Event: &pb.DaemonEvent_Connection{Connection: &pb.ConnectionStatus{
+				State:          pb.ConnectionState_CONNECTED,
+				ServerHostname: "de1.nordvpn.com",
+				ServerIp:       "1.2.3.4",
+				ByUser:         true,
+			}}},
+		},
+		{
+			name: "connection failed",
+			data: events.DataConnect{EventStatus: events.StatusFailure},
+			expected: &pb.DaemonEvent{Event: &pb.DaemonEvent_Connection{Connection: &pb.ConnectionStatus{
+				State:  pb.ConnectionState_DISCONNECTED,
+				ByUser: true,
+			}}},
+		},
+		{
+			name: "disconnected",
+			data: events.DataDisconnect{ByUser: true},
+			expected: &pb.DaemonEvent{Event: &pb.DaemonEvent_Connection{Connection: &pb.ConnectionStatus{
+				State:  pb.ConnectionState_DISCONNECTED,
+				ByUser: true,
+			}}},
+		},
+		{
+			name: "login",
+			data: pb.LoginEventType_LOGIN,
+			expected: &pb.DaemonEvent{Event: &pb.DaemonEvent_Auth{
+				Auth: &pb.LoginEvent{Type: pb.LoginEventType_LOGIN},
+			}},
+		},
+		{
+			name: "kill switch",
+			data: &pb.FirewallEvent{Setting: pb.FirewallSetting_KILL_SWITCH, Enabled: true},
+			expected: &pb.DaemonEvent{Event: &pb.DaemonEvent_Firewall{
+				Firewall: &pb.FirewallEvent{Setting: pb.FirewallSetting_KILL_SWITCH, Enabled: true},
+			}},
+		},
+		{
+			name: "unknown",
+			data: 42,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.expected != nil {
+				test.expected.Timestamp = at.UnixMilli()
+			}
+			assert.Equal(t, test.expected, eventToProtobuf(streamEvent{at: at, data: test.data}, 0))
+		})
+	}
+}
+
+func TestEventToProtobuf_Settings(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cfg := &config.Config{
+		KillSwitch: true,
+		UsersData:  &config.UsersData{NotifyOff: config.UidBoolMap{1000: true}},
+	}
+	event := eventToProtobuf(streamEvent{data: cfg}, 1000)
+	require.NotNil(t, event)
+	settings := event.GetSettings()
+	require.NotNil(t, settings)
+	assert.True(t, settings.GetKillSwitch())
+	assert.False(t, settings.GetUserSettings().GetNotify())
+}
@@ -109,10 +109,40 @@ func (fw *Firewall) Enable() error {
 		return NewError(ErrFirewallAlreadyEnabled)
 	}
 	fw.enabled = true
+	// system could have changed while the firewall was disabled, e.g. ip6tables modules loaded
+	if refresher, ok := fw.working.(Refresher); ok {
+		refresher.Refresh()
+	}
 	fw.current = fw.working
 	return fw.swap(fw.noop, fw.current)
 }
 
+// LeftoverRules returns the rules present in the system according to the working agent. Nothing is
+// returned if the agent cannot list them.
+func (fw *Firewall) LeftoverRules() ([]string, error) {
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+	flusher, ok := fw.working.(Flusher)
+	if !ok {
+		return nil, nil
+	}
+	return flusher.LeftoverRules()
+}
+
+// Flush removes all of the rules from the system and forgets about them.
+func (fw *Firewall) Flush() error {
+	fw.mu.Lock()
+	defer fw.mu.Unlock()
+	fw.publisher.Publish("flushing firewall rules")
+	if flusher, ok := fw.working.(Flusher); ok {
+		if err := flusher.Flush(); err != nil {
+			return NewError(fmt.Errorf("flushing rules: %w", err))
+		}
+	}
+	fw.rules = OrderedRules{}
+	return nil
+}
+
 // Disable turns all firewall operations into no-ops.
 func (fw *Firewall) Disable() error {
 	fw.mu.Lock()
@@ -327,6 +327,57 @@ func TestFirewallEnable(t *testing.T) {
 	}
 }
 
+type refreshingAgent struct {
+	mockAgent
+	refreshed int
+}
+
+func (r *refreshingAgent) Refresh() {
+	r.refreshed++
+}
+
+func TestFirewallEnable_RefreshesAgent(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	agent := &refreshingAgent{}
+	fw := NewFirewall(&mockAgent{}, agent, &subs.Subject[string]{}, false)
+	assert.NoError(t, fw.Enable())
+	assert.Equal(t, 1, agent.refreshed)
+}
+
+type flushingAgent struct {
+	mockAgent
+	leftover []string
+}
+
+func (f *flushingAgent) LeftoverRules() ([]string, error) {
+	return f.leftover, nil
+}
+
+func (f *flushingAgent) Flush() error {
+	f.leftover = nil
+	return nil
+}
+
+func TestFirewallFlush(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	agent := &flushingAgent{leftover: []string{"iptables -A INPUT -j ACCEPT"}}
+	fw := NewFirewall(&mockAgent{}, agent, &subs.Subject[string]{}, true)
+	assert.NoError(t, fw.Add([]Rule{{Name: "rule"}}))
+
+	rules, err := fw.LeftoverRules()
+	assert.NoError(t, err)
+	assert.Len(t, rules, 1)
+
+	assert.NoError(t, fw.Flush())
+	rules, err = fw.LeftoverRules()
+	assert.NoError(t, err)
+	assert.Empty(t, rules)
+	// flushed rules are not tracked anymore
+	assert.Error(t, fw.Delete([]string{"rule"}))
+}
+
 func TestFirewallDisable(t *testing.T) {
 	category.Set(t, category.Unit)
 
@@ -3,6 +3,7 @@ package iptables
 
 import (
 	"fmt"
+	"log"
 	"net"
 	"net/netip"
 	"os/exec"
@@ -25,6 +26,9 @@ const (
 	connmark ruleTarget = "CONNMARK --save-mark --nfmask 0xffffffff --ctmask 0xffffffff"
 )
 
+// ruleComment marks the rules added by the app
+const ruleComment = "nordvpn"
+
 // ruleTarget specifies what can be passed as an argument to `-j`
 type ruleTarget string
 
@@ -41,32 +45,88 @@ type PortRange struct {
 	Max int
 }
 
+// SupportDetector reports whether the given iptables command can be used on the system
+type SupportDetector func(command string) bool
+
+// commandRunner executes the iptables command and returns its combined output
+type commandRunner func(command string, args ...string) ([]byte, error)
+
+func execCommand(command string, args ...string) ([]byte, error) {
+	// #nosec G204 -- input is properly sanitized
+	return exec.Command(command, args...).CombinedOutput()
+}
+
 // @TODO upgrade to netfilter library. for now we use both ipv4, ipv6 because we disable ipv6
 // IPTables handles all firewall changes with iptables
 type IPTables struct {
-	stateModule       string
-	stateFlag         string
-	chainPrefix       string
-	originalInput     map[string]*bool
-	originalOutput    map[string]*bool
+	stateModule    string
+	stateFlag      string
+	chainPrefix    string
+	originalInput  map[string]*bool
+	originalOutput map[string]*bool
+	// iptablesCommands are the commands used if they are supported by the system
+	iptablesCommands []string
+	isSupported      SupportDetector
+	// supportedIPTables is evaluated on the first use and after Refresh, nil means not evaluated yet
 	supportedIPTables []string
+	runCommand        commandRunner
 	sync.Mutex
 }
 
 // New is a default constructor for IPTables firewall
-func New(stateModule string, stateFlag string, chainPrefix string, supportedIPTables []string) *IPTables {
+func New(stateModule string, stateFlag string, chainPrefix string, iptablesCommands []string) *IPTables {
+	return NewWithSupportDetector(stateModule, stateFlag, chainPrefix, iptablesCommands, IsIPTablesSupported)
+}
+
+// NewWithSupportDetector creates IPTables firewall which uses isSupported to check which of the
+// iptablesCommands can be used
+func NewWithSupportDetector(
+	stateModule string,
+	stateFlag string,
+	chainPrefix string,
+	iptablesCommands []string,
+	isSupported SupportDetector,
+) *IPTables {
 	originalInput := make(map[string]*bool)
 	originalOutput := make(map[string]*bool)
 	return &IPTables{
-		stateModule:       stateModule,
-		stateFlag:         stateFlag,
-		chainPrefix:       chainPrefix,
-		originalInput:     originalInput,
-		originalOutput:    originalOutput,
-		supportedIPTables: supportedIPTables,
+		stateModule:      stateModule,
+		stateFlag:        stateFlag,
+		chainPrefix:      chainPrefix,
+		originalInput:    originalInput,
+		originalOutput:   originalOutput,
+		iptablesCommands: iptablesCommands,
+		isSupported:      isSupported,
+		runCommand:       execCommand,
 	}
 }
 
+// Refresh makes the support of iptables commands to be checked again before applying the next rule,
+// e.g. after the kernel modules were loaded
+func (ipt *IPTables) Refresh() {
+	ipt.Lock()
+	defer ipt.Unlock()
+	ipt.supportedIPTables = nil
+}
+
+// Not thread safe. Lock before using
+func (ipt *IPTables) supported() []string {
+	if ipt.supportedIPTables != nil {
+		return ipt.supportedIPTables
+	}
+
+	supported := []string{}
+	for _, command := range ipt.iptablesCommands {
+		if ipt.isSupported(command) {
+			supported = append(supported, command)
+		} else {
+			log.Println(internal.WarningPrefix, command, "is not supported, its rules will not be applied")
+		}
+	}
+	ipt.supportedIPTables = supported
+	return supported
+}
+
 func (ipt *IPTables) Add(rule firewall.Rule) error {
 	ipt.Lock()
 	defer ipt.Unlock()
@@ -97,7 +157,7 @@ func (ipt *IPTables) applyRule(rule firewall.Rule, add bool) error {
 	module, stateFlag := ipt.getStateModule(rule)
 	allRules := ruleToIPTables(rule, module, stateFlag, ipt.chainPrefix)
 
-	for _, iptableVersion := range ipt.supportedIPTables {
+	for _, iptableVersion := range ipt.supported() {
 		ipTablesRules, ok := allRules[iptableVersion]
 		if !ok {
 			continue
@@ -105,31 +165,94 @@ func (ipt *IPTables) applyRule(rule firewall.Rule, add bool) error {
 		for _, ipTableRule := range ipTablesRules {
 			// -w does not accept arguments on older iptables versions
 			args := fmt.Sprintf("%s %s -w "+internal.SecondsToWaitForIptablesLock, flag, ipTableRule)
-			// #nosec G204 -- input is properly sanitized
-			out, err := exec.Command(iptableVersion, strings.Split(args, " ")...).CombinedOutput()
+			out, err := ipt.runCommand(iptableVersion, strings.Split(args, " ")...)
 			if err != nil {
 				if flag == "-D" && strings.Contains(string(out), "does a matching rule exist in that chain") {
 					return nil
 				}
-				return fmt.Errorf("%s %s rule '%s': %w: %s", errStr, iptableVersion, ipTableRule, err, string(out))
+				err = fmt.Errorf("%s %s rule '%s': %w: %s", errStr, iptableVersion, ipTableRule, err, string(out))
+				// IPv6 rules are applied on the best effort basis, failing them must not leave
+				// the IPv4 part of the operation unfinished
+				if iptableVersion == ipv6Table {
+					log.Println(internal.WarningPrefix, err)
+					continue
+				}
+				return err
 			}
 		}
 	}
 	return nil
 }
 
-// FilterSupportedIPTables filter supported versions based on what exists in the system
-func FilterSupportedIPTables(supportedIPTables []string) []string {
-	var supported []string
-	for _, cmd := range supportedIPTables {
-		// #nosec G204 -- input is properly sanitized
-		_, err := exec.Command(cmd, "-S", "-w", internal.SecondsToWaitForIptablesLock).CombinedOutput()
+// LeftoverRules returns the rules added by the app which are present in the system. Each rule is
+// prefixed with the iptables command it belongs to.
+func (ipt *IPTables) LeftoverRules() ([]string, error) {
+	ipt.Lock()
+	defer ipt.Unlock()
+
+	var rules []string
+	for _, iptableVersion := range ipt.supported() {
+		appliedRules, err := ipt.appliedRules(iptableVersion)
 		if err != nil {
-			continue
+			return nil, err
+		}
+		for _, rule := range appliedRules {
+			rules = append(rules, iptableVersion+" "+rule)
 		}
-		supported = append(supported, cmd)
 	}
-	return supported
+	return rules, nil
+}
+
+// Flush removes the rules added by the app from the system, including the ones which are not
+// tracked anymore, e.g. after a crash
+func (ipt *IPTables) Flush() error {
+	ipt.Lock()
+	defer ipt.Unlock()
+
+	for _, iptableVersion := range ipt.supported() {
+		appliedRules, err := ipt.appliedRules(iptableVersion)
+		if err != nil {
+			return err
+		}
+		for _, rule := range appliedRules {
+			args := append([]string{"-D"}, strings.Fields(strings.TrimPrefix(rule, "-A "))...)
+			args = append(args, "-w", internal.SecondsToWaitForIptablesLock)
+			out, err := ipt.runCommand(iptableVersion, args...)
+			if err != nil {
+				err = fmt.Errorf("deleting %s rule '%s': %w: %s", iptableVersion, rule, err, string(out))
+				if iptableVersion == ipv6Table {
+					log.Println(internal.WarningPrefix, err)
+					continue
+				}
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// appliedRules lists the rules added by the app in the iptables -S format
+//
+// Not thread safe. Lock before using
+func (ipt *IPTables) appliedRules(iptableVersion string) ([]string, error) {
+	out, err := ipt.runCommand(iptableVersion, "-S", "-w", internal.SecondsToWaitForIptablesLock)
+	if err != nil {
+		return nil, fmt.Errorf("listing %s rules: %w: %s", iptableVersion, err, string(out))
+	}
+
+	var rules []string
+	for _, line := range strings.Split(string(out), "\n") {
+		if strings.HasPrefix(line, "-A ") && strings.Contains(line, "--comment "+ruleComment) {
+			rules = append(rules, line)
+		}
+	}
+	return rules, nil
+}
+
+// IsIPTablesSupported checks if the given iptables command can be executed on the system
+func IsIPTablesSupported(command string) bool {
+	_, err := execCommand(command, "-S", "-w", internal.SecondsToWaitForIptablesLock)
+	return err == nil
 }
 
 func trimPrefixes(str string, prefixes ...string) string {
@@ -395,7 +518,7 @@ func generateIPTablesRule(
 	jump := " -j "
 
 	if comment == "" {
-		comment = "nordvpn"
+		comment = ruleComment
 	}
 
 	var acceptComment string
@@ -22,6 +22,109 @@ import (
 func TestAgentInterface(t *testing.T) {
 	assert.Implements(t, (*firewall.Agent)(nil), New("", "", "", []string{ipv4Table, ipv6Table}))
 }
+
+type commandRunnerMock struct {
+	commands []string
+	args     [][]string
+	failing  map[string]bool
+	// listed is the output of the rules listing for each command
+	listed map[string]string
+}
+
+func (m *commandRunnerMock) run(command string, args ...string) ([]byte, error) {
+	m.commands = append(m.commands, command)
+	m.args = append(m.args, args)
+	if m.failing[command] {
+		return []byte("failure"), fmt.Errorf("exit status 1")
+	}
+	if len(args) > 0 && args[0] == "-S" {
+		return []byte(m.listed[command]), nil
+	}
+	return nil, nil
+}
+
+func TestIPTables_SupportDetection(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	ip6tablesSupported := false
+	detected := []string{}
+	detector := func(command string) bool {
+		detected = append(detected, command)
+		return command == ipv4Table || ip6tablesSupported
+	}
+
+	runner := &commandRunnerMock{}
+	ipt := NewWithSupportDetector("", "", "", []string{ipv4Table, ipv6Table}, detector)
+	ipt.runCommand = runner.run
+	assert.Empty(t, detected, "support should not be detected on construction")
+
+	rule := firewall.Rule{Direction: firewall.Inbound, Allow: true}
+	assert.NoError(t, ipt.Add(rule))
+	assert.Equal(t, []string{ipv4Table, ipv6Table}, detected)
+	assert.NotContains(t, runner.commands, ipv6Table)
+
+	// detection result is cached
+	assert.NoError(t, ipt.Add(rule))
+	assert.Len(t, detected, 2)
+
+	ip6tablesSupported = true
+	ipt.Refresh()
+	runner.commands = nil
+	assert.NoError(t, ipt.Add(rule))
+	assert.Len(t, detected, 4)
+	assert.Contains(t, runner.commands, ipv6Table)
+}
+
+func TestIPTables_IPv6FailureDegrades(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	rule := firewall.Rule{Direction: firewall.TwoWay, Allow: true}
+	allSupported := func(string) bool { return true }
+
+	runner := &commandRunnerMock{failing: map[string]bool{ipv6Table: true}}
+	ipt := NewWithSupportDetector("", "", "", []string{ipv4Table, ipv6Table}, allSupported)
+	ipt.runCommand = runner.run
+	assert.NoError(t, ipt.Add(rule))
+	// every IPv4 rule is applied even though IPv6 rules fail
+	assert.Equal(t, len(ruleToIPTables(rule, "", "", "")[ipv4Table]), slices.IndexFunc(runner.commands,
+		func(command string) bool { return command == ipv6Table }))
+
+	runner = &commandRunnerMock{failing: map[string]bool{ipv4Table: true}}
+	ipt = NewWithSupportDetector("", "", "", []string{ipv4Table, ipv6Table}, allSupported)
+	ipt.runCommand = runner.run
+	assert.Error(t, ipt.Add(rule))
+}
+
+func TestIPTables_Flush(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	listed := `-P INPUT ACCEPT
+-A INPUT -i lo -j ACCEPT
+-A INPUT -i nordlynx -m comment --comment nordvpn -j ACCEPT
+-A OUTPUT -o nordlynx -m comment --comment nordvpn -j ACCEPT
+`
+	runner := &commandRunnerMock{listed: map[string]string{ipv4Table: listed}}
+	ipt := NewWithSupportDetector("", "", "", []string{ipv4Table}, func(string) bool { return true })
+	ipt.runCommand = runner.run
+
+	rules, err := ipt.LeftoverRules()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"iptables -A INPUT -i nordlynx -m comment --comment nordvpn -j ACCEPT",
+		"iptables -A OUTPUT -o nordlynx -m comment --comment nordvpn -j ACCEPT",
+	}, rules)
+
+	runner.args = nil
+	assert.NoError(t, ipt.Flush())
+	assert.Equal(t, [][]string{
+		{"-S", "-w", internal.SecondsToWaitForIptablesLock},
+		{"-D", "INPUT", "-i", "nordlynx", "-m", "comment", "--comment", "nordvpn", "-j", "ACCEPT",
+			"-w", internal.SecondsToWaitForIptablesLock},
+		{"-D", "OUTPUT", "-o", "nordlynx", "-m", "comment", "--comment", "nordvpn", "-j", "ACCEPT",
+			"-w", internal.SecondsToWaitForIptablesLock},
+	}, runner.args)
+}
+
 func TestConnectionStateToString(t *testing.T) {
 	category.Set(t, category.Unit)
 	tests := []struct {
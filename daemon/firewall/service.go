@@ -23,3 +23,19 @@ type Agent interface {
 	// Delete a firewall rule
 	Delete(Rule) error
 }
+
+// Refresher is implemented by the agents which cache the capabilities of the system, so they can
+// be checked again on demand.
+type Refresher interface {
+	// Refresh cached capabilities
+	Refresh()
+}
+
+// Flusher is implemented by the agents which can find and remove the rules left in the system even
+// though they are not tracked anymore, e.g. after a crash.
+type Flusher interface {
+	// LeftoverRules returns the rules added by the app which are present in the system
+	LeftoverRules() ([]string, error)
+	// Flush removes the rules added by the app from the system
+	Flush() error
+}
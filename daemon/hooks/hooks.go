@@ -0,0 +1,317 @@
+// Package hooks runs the executables configured by the user on VPN connection events.
+//
+// Hooks are run by the daemon as root, so only the executables which can be modified by root alone are accepted. This
+// applies to the directories on the way to the executable and to the symbolic links as well, and it is checked again
+// right before every run, as the files could have been replaced since the hook was added.
+// Event details are passed through the environment variables:
+//
+//	NORDVPN_EVENT       pre-connect, connect, disconnect or reconnect
+//	NORDVPN_SERVER      hostname of the VPN server
+//	NORDVPN_SERVER_IP   IP address of the VPN server
+//	NORDVPN_COUNTRY     country of the VPN server
+//	NORDVPN_CITY        city of the VPN server
+//	NORDVPN_TECHNOLOGY  NORDLYNX or OPENVPN
+//	NORDVPN_PROTOCOL    UDP or TCP
+//	NORDVPN_INTERFACE   name of the tunnel interface
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordlynx"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordwhisper"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/openvpn"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// hookPath is the PATH given to the hooks instead of the one inherited from the daemon
+const hookPath = "/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+
+var (
+	// ErrNotAbsolute is returned for the relative executable paths
+	ErrNotAbsolute = errors.New("hook path must be absolute")
+	// ErrNotExecutable is returned if the path is not an executable file
+	ErrNotExecutable = errors.New("hook is not an executable file")
+	// ErrInsecure is returned if the executable or any directory on the way to it can be modified by other users
+	// than root
+	ErrInsecure = errors.New("hook and its directories must be owned by root and writable only by their owner")
+)
+
+// Validate checks that the executable is safe to be run by the daemon
+func Validate(path string) error {
+	_, err := Resolve(path)
+	return err
+}
+
+// Resolve returns the path of the executable with the symbolic links resolved if it is safe to be run by the daemon.
+// The resolved path has to be run, so that the links cannot be replaced after the check.
+func Resolve(path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		return "", ErrNotAbsolute
+	}
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Lstat(resolved)
+	if err != nil {
+		return "", err
+	}
+	if !info.Mode().IsRegular() || info.Mode().Perm()&0111 == 0 {
+		return "", ErrNotExecutable
+	}
+	// the links on the way are checked as well, otherwise they could be pointed to another executable
+	for _, p := range []string{path, resolved} {
+		if err := checkOwnership(p); err != nil {
+			return "", err
+		}
+	}
+	return resolved, nil
+}
+
+// checkOwnership checks that every component of the path is owned by root and is not writable by the others.
+// Permissions of the symbolic links are not used, so only their owner is checked.
+func checkOwnership(path string) error {
+	for p := filepath.Clean(path); ; p = filepath.Dir(p) {
+		info, err := os.Lstat(p)
+		if err != nil {
+			return err
+		}
+		stat, ok := info.Sys().(*syscall.Stat_t)
+		if !ok || stat.Uid != 0 {
+			return ErrInsecure
+		}
+		if info.Mode()&os.ModeSymlink == 0 && info.Mode().Perm()&0022 != 0 {
+			return ErrInsecure
+		}
+		if p == "/" {
+			return nil
+		}
+	}
+}
+
+// Env describes the connection the hook is run for
+type Env struct {
+	Server     string
+	ServerIP   string
+	Country    string
+	City       string
+	Technology config.Technology
+	Protocol   config.Protocol
+	// Interface is the name of the tunnel interface, the default name of the technology if empty
+	Interface string
+}
+
+// EnvFromEvent describes the connection of the connect event
+func EnvFromEvent(e events.DataConnect) Env {
+	server := e.TargetServerDomain
+	if server == "" {
+		server = e.TargetServerName
+	}
+	return Env{
+		Server:     server,
+		ServerIP:   e.TargetServerIP,
+		Country:    e.TargetServerCountry,
+		City:       e.TargetServerCity,
+		Technology: e.Technology,
+		Protocol:   e.Protocol,
+	}
+}
+
+func (e Env) environ(event config.HookEvent) []string {
+	return []string{
+		"PATH=" + hookPath,
+		"NORDVPN_EVENT=" + string(event),
+		"NORDVPN_SERVER=" + e.Server,
+		"NORDVPN_SERVER_IP=" + e.ServerIP,
+		"NORDVPN_COUNTRY=" + e.Country,
+		"NORDVPN_CITY=" + e.City,
+		"NORDVPN_TECHNOLOGY=" + e.Technology.String(),
+		"NORDVPN_PROTOCOL=" + e.Protocol.String(),
+		"NORDVPN_INTERFACE=" + e.interfaceName(),
+	}
+}
+
+func (e Env) interfaceName() string {
+	if e.Interface != "" {
+		return e.Interface
+	}
+	switch e.Technology {
+	case config.Technology_NORDLYNX:
+		return nordlynx.InterfaceName
+	case config.Technology_OPENVPN:
+		return openvpn.InterfaceName
+	case config.Technology_NORDWHISPER:
+		return nordwhisper.InterfaceName
+	case config.Technology_UNKNOWN_TECHNOLOGY:
+	}
+	return ""
+}
+
+// ExecuteFunc runs the executable with the environment until the context is done
+type ExecuteFunc func(ctx context.Context, path string, env []string) error
+
+// Runner runs the hooks configured by the user. Hooks of the pre-connect event are run synchronously, so they can
+// abort the connection, while the other ones are run in the background one after another.
+type Runner struct {
+	cm      config.Manager
+	resolve func(path string) (string, error)
+	execute ExecuteFunc
+
+	// runMu keeps the hooks of the consecutive events from overlapping
+	runMu sync.Mutex
+
+	mu sync.Mutex
+	// active is set while the connection requested through the daemon is up
+	active bool
+	// lost is set if the tunnel went down while the connection was active
+	lost bool
+	// env describes the active connection for the disconnect and reconnect hooks
+	env Env
+}
+
+// NewRunner creates a runner executing the hooks as child processes
+func NewRunner(cm config.Manager) *Runner {
+	return &Runner{cm: cm, resolve: Resolve, execute: execute}
+}
+
+// PreConnect runs the pre-connect hooks and returns an error if the connection should be aborted
+func (r *Runner) PreConnect(ctx context.Context, env Env) error {
+	if r == nil {
+		return nil
+	}
+	r.runMu.Lock()
+	defer r.runMu.Unlock()
+	return r.run(ctx, config.HookPreConnect, env)
+}
+
+// NotifyConnect runs the connect hooks once the connection is established
+func (r *Runner) NotifyConnect(e events.DataConnect) error {
+	if e.EventStatus != events.StatusSuccess {
+		return nil
+	}
+	env := EnvFromEvent(e)
+	// connections to the meshnet peers run on the meshnet interface regardless of the technology in use
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err == nil && cfg.Technology == env.Technology {
+		env.Interface = cfg.TunnelInterfaceName()
+	}
+	r.mu.Lock()
+	r.active, r.lost, r.env = true, false, env
+	r.mu.Unlock()
+	r.runInBackground(config.HookConnect, env)
+	return nil
+}
+
+// NotifyDisconnect runs the disconnect hooks if there was a connection
+func (r *Runner) NotifyDisconnect(events.DataDisconnect) error {
+	r.mu.Lock()
+	active, env := r.active, r.env
+	r.active, r.lost = false, false
+	r.mu.Unlock()
+	if active {
+		r.runInBackground(config.HookDisconnect, env)
+	}
+	return nil
+}
+
+// NotifyTunnelConnect runs the reconnect hooks when the tunnel which went down is restored
+func (r *Runner) NotifyTunnelConnect(e events.DataConnect) error {
+	if e.EventStatus != events.StatusSuccess {
+		return nil
+	}
+	r.mu.Lock()
+	reconnected, env := r.active && r.lost, r.env
+	r.lost = false
+	r.mu.Unlock()
+	if reconnected {
+		r.runInBackground(config.HookReconnect, env)
+	}
+	return nil
+}
+
+// NotifyTunnelDisconnect remembers that the tunnel went down without the user asking for it
+func (r *Runner) NotifyTunnelDisconnect(e events.DataDisconnect) error {
+	if e.ByUser {
+		return nil
+	}
+	r.mu.Lock()
+	r.lost = r.active
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *Runner) runInBackground(event config.HookEvent, env Env) {
+	go func() {
+		r.runMu.Lock()
+		defer r.runMu.Unlock()
+		if err := r.run(context.Background(), event, env); err != nil {
+			log.Println(internal.WarningPrefix, err)
+		}
+	}()
+}
+
+// run runs the hooks of the event one after another. Failures are logged unless the hook aborts on failure, then
+// the remaining hooks are not run and the error is returned.
+func (r *Runner) run(ctx context.Context, event config.HookEvent, env Env) error {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		return fmt.Errorf("loading hooks: %w", err)
+	}
+
+	for _, hook := range cfg.Hooks.For(event) {
+		// executable is checked before every run, it could have been replaced since it was added
+		path, err := r.resolve(hook.Path)
+		if err == nil {
+			err = r.runHook(ctx, hook, path, env)
+		}
+		if err == nil {
+			continue
+		}
+		err = fmt.Errorf("%s hook %s: %w", event, hook.Path, err)
+		if hook.FailurePolicy == config.HookFailureAbort {
+			return err
+		}
+		log.Println(internal.WarningPrefix, err)
+	}
+	return nil
+}
+
+// runHook runs the hook from the resolved path
+func (r *Runner) runHook(ctx context.Context, hook config.Hook, path string, env Env) error {
+	ctx, cancel := context.WithTimeout(ctx, hook.TimeoutOrDefault())
+	defer cancel()
+	log.Println(internal.InfoPrefix, "running", hook.Event, "hook", hook.Path)
+	err := r.execute(ctx, path, env.environ(hook.Event))
+	if ctx.Err() != nil {
+		return fmt.Errorf("timed out after %s", hook.TimeoutOrDefault())
+	}
+	return err
+}
+
+func execute(ctx context.Context, path string, env []string) error {
+	// #nosec G204 -- only the executables modifiable by root alone are run
+	cmd := exec.CommandContext(ctx, path)
+	cmd.Env = env
+	cmd.Dir = "/"
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	err := cmd.Run()
+	if out := strings.TrimSpace(output.String()); out != "" {
+		log.Println(internal.DebugPrefix, path, "output:", out)
+	}
+	return err
+}
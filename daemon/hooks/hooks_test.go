@@ -0,0 +1,245 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeExecutor struct {
+	mu    sync.Mutex
+	runs  []string
+	envs  [][]string
+	fail  map[string]bool
+	block map[string]bool
+	done  chan struct{}
+}
+
+func (e *fakeExecutor) execute(ctx context.Context, path string, env []string) error {
+	e.mu.Lock()
+	e.runs = append(e.runs, path)
+	e.envs = append(e.envs, env)
+	e.mu.Unlock()
+	defer func() {
+		if e.done != nil {
+			e.done <- struct{}{}
+		}
+	}()
+	if e.block[path] {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	if e.fail[path] {
+		return errors.New("exit status 1")
+	}
+	return nil
+}
+
+func (e *fakeExecutor) wait(t *testing.T) {
+	t.Helper()
+	select {
+	case <-e.done:
+	case <-time.After(time.Second):
+		t.Fatal("hook was not run")
+	}
+}
+
+func newTestRunner(hooks config.Hooks, executor *fakeExecutor) *Runner {
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Hooks = hooks
+	return &Runner{
+		cm:      cm,
+		resolve: func(path string) (string, error) { return path, nil },
+		execute: executor.execute,
+	}
+}
+
+func TestRunner_PreConnect(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name        string
+		hooks       config.Hooks
+		fail        map[string]bool
+		block       map[string]bool
+		expectedRun []string
+		expectedErr bool
+	}{
+		{
+			name: "failure is ignored",
+			hooks: config.Hooks{
+				{Event: config.HookPreConnect, Path: "/hooks/a"},
+				{Event: config.HookConnect, Path: "/hooks/b"},
+				{Event: config.HookPreConnect, Path: "/hooks/c"},
+			},
+			fail:        map[string]bool{"/hooks/a": true},
+			expectedRun: []string{"/hooks/a", "/hooks/c"},
+		},
+		{
+			name: "failure aborts",
+			hooks: config.Hooks{
+				{Event: config.HookPreConnect, Path: "/hooks/a", FailurePolicy: config.HookFailureAbort},
+				{Event: config.HookPreConnect, Path: "/hooks/c"},
+			},
+			fail:        map[string]bool{"/hooks/a": true},
+			expectedRun: []string{"/hooks/a"},
+			expectedErr: true,
+		},
+		{
+			name: "timeout aborts",
+			hooks: config.Hooks{
+				{
+					Event:         config.HookPreConnect,
+					Path:          "/hooks/a",
+					Timeout:       10 * time.Millisecond,
+					FailurePolicy: config.HookFailureAbort,
+				},
+			},
+			block:       map[string]bool{"/hooks/a": true},
+			expectedRun: []string{"/hooks/a"},
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			executor := &fakeExecutor{fail: test.fail, block: test.block}
+			runner := newTestRunner(test.hooks, executor)
+
+			err := runner.PreConnect(context.Background(), Env{Server: "de1.nordvpn.com"})
+			assert.Equal(t, test.expectedErr, err != nil)
+			assert.Equal(t, test.expectedRun, executor.runs)
+		})
+	}
+}
+
+func TestRunner_PreConnectSkipsInsecureHooks(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	executor := &fakeExecutor{}
+	runner := newTestRunner(config.Hooks{
+		{Event: config.HookPreConnect, Path: "/hooks/a", FailurePolicy: config.HookFailureAbort},
+	}, executor)
+	runner.resolve = func(string) (string, error) { return "", ErrInsecure }
+
+	assert.ErrorIs(t, runner.PreConnect(context.Background(), Env{}), ErrInsecure)
+	assert.Empty(t, executor.runs)
+}
+
+func TestRunner_RunsResolvedPath(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	executor := &fakeExecutor{}
+	runner := newTestRunner(config.Hooks{{Event: config.HookPreConnect, Path: "/hooks/link"}}, executor)
+	runner.resolve = func(string) (string, error) { return "/hooks/target", nil }
+
+	assert.NoError(t, runner.PreConnect(context.Background(), Env{}))
+	assert.Equal(t, []string{"/hooks/target"}, executor.runs)
+}
+
+func TestRunner_Events(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	executor := &fakeExecutor{done: make(chan struct{}, 1)}
+	runner := newTestRunner(config.Hooks{
+		{Event: config.HookConnect, Path: "/hooks/connect"},
+		{Event: config.HookDisconnect, Path: "/hooks/disconnect"},
+		{Event: config.HookReconnect, Path: "/hooks/reconnect"},
+	}, executor)
+
+	// disconnect without a connection
+	require.NoError(t, runner.NotifyDisconnect(events.DataDisconnect{}))
+	require.NoError(t, runner.NotifyConnect(events.DataConnect{EventStatus: events.StatusAttempt}))
+
+	require.NoError(t, runner.NotifyConnect(events.DataConnect{
+		EventStatus:        events.StatusSuccess,
+		TargetServerDomain: "de1.nordvpn.com",
+		Technology:         config.Technology_NORDLYNX,
+	}))
+	executor.wait(t)
+
+	// initial tunnel connection is not a reconnect
+	require.NoError(t, runner.NotifyTunnelConnect(events.DataConnect{EventStatus: events.StatusSuccess}))
+	require.NoError(t, runner.NotifyTunnelDisconnect(events.DataDisconnect{}))
+	require.NoError(t, runner.NotifyTunnelConnect(events.DataConnect{EventStatus: events.StatusSuccess}))
+	executor.wait(t)
+
+	require.NoError(t, runner.NotifyDisconnect(events.DataDisconnect{}))
+	executor.wait(t)
+
+	executor.mu.Lock()
+	defer executor.mu.Unlock()
+	assert.Equal(t, []string{"/hooks/connect", "/hooks/reconnect", "/hooks/disconnect"}, executor.runs)
+	assert.Contains(t, executor.envs[2], "NORDVPN_EVENT=disconnect")
+	assert.Contains(t, executor.envs[2], "NORDVPN_SERVER=de1.nordvpn.com")
+	assert.Contains(t, executor.envs[2], "NORDVPN_INTERFACE=nordlynx")
+}
+
+func TestEnv_Interface(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	env := Env{Technology: config.Technology_OPENVPN}
+	assert.Contains(t, env.environ(config.HookConnect), "NORDVPN_INTERFACE=nordtun")
+
+	env.Interface = "vpn0"
+	assert.Contains(t, env.environ(config.HookConnect), "NORDVPN_INTERFACE=vpn0")
+}
+
+func TestRunner_Nil(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var runner *Runner
+	assert.NoError(t, runner.PreConnect(context.Background(), Env{}))
+}
+
+func TestValidate(t *testing.T) {
+	category.Set(t, category.File)
+
+	dir := t.TempDir()
+	script := filepath.Join(dir, "hook.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\n"), 0700))
+	data := filepath.Join(dir, "data")
+	require.NoError(t, os.WriteFile(data, nil, 0600))
+	writable := filepath.Join(dir, "writable.sh")
+	require.NoError(t, os.WriteFile(writable, []byte("#!/bin/sh\n"), 0700))
+	require.NoError(t, os.Chmod(writable, 0777))
+
+	assert.ErrorIs(t, Validate("hook.sh"), ErrNotAbsolute)
+	assert.ErrorIs(t, Validate(filepath.Join(dir, "missing.sh")), os.ErrNotExist)
+	assert.ErrorIs(t, Validate(dir), ErrNotExecutable)
+	assert.ErrorIs(t, Validate(data), ErrNotExecutable)
+	assert.ErrorIs(t, Validate(writable), ErrInsecure)
+	// temporary directory is writable by everyone or owned by the user
+	assert.ErrorIs(t, Validate(script), ErrInsecure)
+}
+
+func TestResolve(t *testing.T) {
+	category.Set(t, category.File)
+
+	target, err := filepath.EvalSymlinks("/bin/true")
+	if err != nil {
+		t.Skip("/bin/true is not available")
+	}
+
+	resolved, err := Resolve("/bin/true")
+	assert.NoError(t, err)
+	assert.Equal(t, target, resolved)
+
+	// link to the executable of root can be pointed elsewhere by the owner of the directory
+	link := filepath.Join(t.TempDir(), "hook")
+	require.NoError(t, os.Symlink(target, link))
+	_, err = Resolve(link)
+	assert.ErrorIs(t, err, ErrInsecure)
+}
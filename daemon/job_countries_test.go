@@ -23,6 +23,10 @@ func (mockCountriesAPI) Servers() (core.Servers, http.Header, error) {
 	return nil, nil, nil
 }
 
+func (mockCountriesAPI) ServersUpdates(time.Time) (core.Servers, http.Header, error) {
+	return nil, nil, nil
+}
+
 func (mockCountriesAPI) RecommendedServers(core.ServersFilter, float64, float64) (core.Servers, http.Header, error) {
 	return nil, nil, nil
 }
@@ -45,6 +49,10 @@ func (mockFailingCountriesAPI) Servers() (core.Servers, http.Header, error) {
 	return nil, nil, nil
 }
 
+func (mockFailingCountriesAPI) ServersUpdates(time.Time) (core.Servers, http.Header, error) {
+	return nil, nil, fmt.Errorf("500")
+}
+
 func (mockFailingCountriesAPI) RecommendedServers(core.ServersFilter, float64, float64) (core.Servers, http.Header, error) {
 	return nil, nil, nil
 }
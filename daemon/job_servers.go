@@ -3,6 +3,7 @@ package daemon
 import (
 	"errors"
 	"log"
+	"net/http"
 	"sort"
 	"strings"
 	"time"
@@ -12,6 +13,10 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/internal"
 )
 
+// serversFullUpdateInterval defines how often the whole servers list is downloaded, incremental updates are used
+// in between
+const serversFullUpdateInterval = 24 * time.Hour
+
 // JobServers is responsible for population of local server cache which is needed
 // to avoid excess requests to the backend API.
 func JobServers(dm *DataManager, cm config.Manager, api core.ServersAPI, validate bool) func() error {
@@ -28,107 +33,152 @@ func JobServers(dm *DataManager, cm config.Manager, api core.ServersAPI, validat
 
 		// save execution start time
 		currentTime := time.Now()
+		if validate && dm.CanUpdateServersIncrementally() {
+			err := updateServers(dm, api, currentTime)
+			if err == nil {
+				return nil
+			}
+			log.Println(internal.WarningPrefix, "updating servers incrementally, downloading the full list:", err)
+		}
+
 		servers, headers, err := api.Servers()
 		if err != nil {
 			return err
 		}
 
-		if len(servers) == 0 {
-			return errors.New("empty servers list")
+		servers, err = prepareServers(servers, dm.GetInsightsData())
+		if err != nil {
+			return err
 		}
 
-		geoInfoData := dm.GetInsightsData()
-		randomComponent := randFloat(time.Now().UnixNano(), RandomComponentMin, RandomComponentMax)
-
-		// format first server beforehand to create initial values
-		// TODO: change server date format to equivalent from time.RFCXXXX
-		parsedTime, err := time.Parse(internal.ServerDateFormat, servers[0].CreatedAt)
+		err = dm.SetServersData(currentTime, servers, headers.Get(core.HeaderDigest), apiTime(headers, currentTime))
 		if err != nil {
 			return err
 		}
-		timestamp := parsedTime.Unix()
-		dist := distance(
-			geoInfoData.Insights.Latitude,
-			geoInfoData.Insights.Longitude,
-			servers[0].Locations[0].Country.City.Latitude,
-			servers[0].Locations[0].Country.City.Longitude,
-		)
-		servers[0].Timestamp = timestamp
-		servers[0].Distance = dist
+		return nil
+	}
+}
 
-		// set initial minmax values
-		timestampMin := timestamp
-		timestampMax := timestamp
-		distanceMin := dist
-		distanceMax := dist
+// updateServers applies the servers updated since the last update to the cached list
+func updateServers(dm *DataManager, api core.ServersAPI, currentTime time.Time) error {
+	cached := dm.GetServersData()
+	updated, headers, err := api.ServersUpdates(cached.UpdatesSince)
+	if errors.Is(err, core.ErrNotModified) {
+		return dm.UpdateServersData(currentTime, cached.Servers, apiTime(headers, cached.UpdatesSince))
+	}
+	if err != nil {
+		return err
+	}
 
-		var filteredServers core.Servers
+	servers, err := prepareServers(dm.MergeServers(updated), dm.GetInsightsData())
+	if err != nil {
+		return err
+	}
+	return dm.UpdateServersData(currentTime, servers, apiTime(headers, cached.UpdatesSince))
+}
 
-		// first iteration to filter "bad" servers and find minmax values
-		for idx, server := range servers {
-			// store keys to find server easier
-			country := server.Country()
+// apiTime returns the time of the API response from its Last-Modified or Date header, so the servers updates
+// are not affected by the local clock. fallback is returned if neither is present.
+func apiTime(headers http.Header, fallback time.Time) time.Time {
+	for _, key := range []string{"Last-Modified", "Date"} {
+		if t, err := http.ParseTime(headers.Get(key)); err == nil {
+			return t
+		}
+	}
+	return fallback
+}
 
-			servers[idx].Keys = generateKeys(server)
+// prepareServers generates the keys of the servers, calculates their penalty scores and sorts them by it
+func prepareServers(servers core.Servers, geoInfoData InsightsData) (core.Servers, error) {
+	if len(servers) == 0 {
+		return nil, errors.New("empty servers list")
+	}
 
-			// calculate minmax distance and timestamp
-			parsedTime, err := time.Parse(internal.ServerDateFormat, server.CreatedAt)
-			if err != nil {
-				return err
-			}
-			timestamp := parsedTime.Unix()
-			dist := distance(
-				geoInfoData.Insights.Latitude,
-				geoInfoData.Insights.Longitude,
-				country.City.Latitude,
-				country.City.Longitude,
-			)
-			servers[idx].Timestamp = timestamp
-			servers[idx].Distance = dist
-
-			if dist < distanceMin {
-				distanceMin = dist
-			}
-			if dist > distanceMax {
-				distanceMax = dist
-			}
-			if timestamp < timestampMin {
-				timestampMin = timestamp
-			}
-			if timestamp > timestampMax {
-				timestampMax = timestamp
-			}
+	randomComponent := randFloat(time.Now().UnixNano(), RandomComponentMin, RandomComponentMax)
 
-			filteredServers = append(filteredServers, servers[idx])
-		}
-		servers = filteredServers
-
-		// second iteration to calculate penalty scores
-		for idx, server := range servers {
-			penal, partialPenalty := penalty(
-				core.IsObfuscated()(server),
-				server.Distance, distanceMin, distanceMax,
-				server.Timestamp, timestampMin, timestampMax,
-				server.Load,
-				geoInfoData.Insights.CountryCode, server.Locations[0].Country.Code,
-				server.Locations[0].Country.City.HubScore,
-				randomComponent,
-			)
-			servers[idx].Penalty = penal
-			servers[idx].PartialPenalty = partialPenalty
+	// format first server beforehand to create initial values
+	// TODO: change server date format to equivalent from time.RFCXXXX
+	parsedTime, err := time.Parse(internal.ServerDateFormat, servers[0].CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	timestamp := parsedTime.Unix()
+	dist := distance(
+		geoInfoData.Insights.Latitude,
+		geoInfoData.Insights.Longitude,
+		servers[0].Locations[0].Country.City.Latitude,
+		servers[0].Locations[0].Country.City.Longitude,
+	)
+	servers[0].Timestamp = timestamp
+	servers[0].Distance = dist
+
+	// set initial minmax values
+	timestampMin := timestamp
+	timestampMax := timestamp
+	distanceMin := dist
+	distanceMax := dist
+
+	var filteredServers core.Servers
+
+	// first iteration to filter "bad" servers and find minmax values
+	for idx, server := range servers {
+		// store keys to find server easier
+		country := server.Country()
+
+		servers[idx].Keys = generateKeys(server)
+
+		// calculate minmax distance and timestamp
+		parsedTime, err := time.Parse(internal.ServerDateFormat, server.CreatedAt)
+		if err != nil {
+			return nil, err
 		}
+		timestamp := parsedTime.Unix()
+		dist := distance(
+			geoInfoData.Insights.Latitude,
+			geoInfoData.Insights.Longitude,
+			country.City.Latitude,
+			country.City.Longitude,
+		)
+		servers[idx].Timestamp = timestamp
+		servers[idx].Distance = dist
 
-		// sort by penalty score
-		sort.SliceStable(servers, func(i, j int) bool {
-			return servers[i].Penalty < servers[j].Penalty
-		})
-
-		err = dm.SetServersData(currentTime, servers, headers.Get(core.HeaderDigest))
-		if err != nil {
-			return err
+		if dist < distanceMin {
+			distanceMin = dist
 		}
-		return nil
+		if dist > distanceMax {
+			distanceMax = dist
+		}
+		if timestamp < timestampMin {
+			timestampMin = timestamp
+		}
+		if timestamp > timestampMax {
+			timestampMax = timestamp
+		}
+
+		filteredServers = append(filteredServers, servers[idx])
 	}
+	servers = filteredServers
+
+	// second iteration to calculate penalty scores
+	for idx, server := range servers {
+		penal, partialPenalty := penalty(
+			core.IsObfuscated()(server),
+			server.Distance, distanceMin, distanceMax,
+			server.Timestamp, timestampMin, timestampMax,
+			server.Load,
+			geoInfoData.Insights.CountryCode, server.Locations[0].Country.Code,
+			server.Locations[0].Country.City.HubScore,
+			randomComponent,
+		)
+		servers[idx].Penalty = penal
+		servers[idx].PartialPenalty = partialPenalty
+	}
+
+	// sort by penalty score
+	sort.SliceStable(servers, func(i, j int) bool {
+		return servers[i].Penalty < servers[j].Penalty
+	})
+	return servers, nil
 }
 
 // Compute a list of keys for each server to speedup the server picking process at connect
@@ -25,6 +25,10 @@ func (mockServersAPI) Servers() (core.Servers, http.Header, error) {
 	return serversList(), nil, nil
 }
 
+func (mockServersAPI) ServersUpdates(time.Time) (core.Servers, http.Header, error) {
+	return serversList(), nil, nil
+}
+
 func (mockServersAPI) RecommendedServers(filter core.ServersFilter, _ float64, _ float64) (core.Servers, http.Header, error) {
 	if filter.Group == config.ServerGroup_DEDICATED_IP {
 		return nil, nil, fmt.Errorf("API must not be called for Dedicated IP")
@@ -66,6 +70,10 @@ func (mockFailingServersAPI) Servers() (core.Servers, http.Header, error) {
 	return nil, nil, fmt.Errorf("500")
 }
 
+func (mockFailingServersAPI) ServersUpdates(time.Time) (core.Servers, http.Header, error) {
+	return nil, nil, fmt.Errorf("500")
+}
+
 func (mockFailingServersAPI) RecommendedServers(core.ServersFilter, float64, float64) (core.Servers, http.Header, error) {
 	return nil, nil, fmt.Errorf("500")
 }
@@ -246,7 +254,7 @@ func TestJobServers_Valid(t *testing.T) {
 	dm := testNewDataManager()
 	assert.NoError(t, dm.LoadData())
 	original := dm.GetServersData().Servers
-	dm.SetServersData(time.Now(), original, "")
+	dm.SetServersData(time.Now(), original, "", time.Time{})
 
 	err := JobServers(dm, newMockConfigManager(), &mockFailingServersAPI{}, true)()
 	assert.NoError(t, err)
@@ -263,8 +271,150 @@ func TestJobServers_Expired(t *testing.T) {
 
 	dm := testNewDataManager()
 	original, _, _ := mockServersAPI{}.Servers() // do not use filesystem
-	dm.SetServersData(time.Now().Add(time.Duration(-300)*time.Minute), original, "")
+	dm.SetServersData(time.Now().Add(time.Duration(-300)*time.Minute), original, "", time.Time{})
 	err := JobServers(dm, newMockConfigManager(), &mockServersAPI{}, true)()
 	assert.NoError(t, err)
 	assert.False(t, reflect.DeepEqual(dm.GetServersData().Servers, original))
 }
+
+type mockUpdatesServersAPI struct {
+	mockFailingServersAPI
+	updates core.Servers
+	headers http.Header
+	err     error
+	since   time.Time
+}
+
+func (m *mockUpdatesServersAPI) ServersUpdates(since time.Time) (core.Servers, http.Header, error) {
+	m.since = since
+	return m.updates, m.headers, m.err
+}
+
+func TestDataManager_MergeServers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	dm := testNewDataManager()
+	dm.serversData.Servers = core.Servers{
+		{ID: 1, Hostname: "de1.nordvpn.com", Load: 10, Status: core.Online},
+		{ID: 2, Hostname: "de2.nordvpn.com", Load: 20, Status: core.Online},
+		{ID: 3, Hostname: "de3.nordvpn.com", Load: 30, Status: core.Online},
+	}
+
+	servers := dm.MergeServers(core.Servers{
+		{ID: 4, Hostname: "de4.nordvpn.com", Load: 40, Status: core.Online},
+		{ID: 2, Hostname: "de2.nordvpn.com", Load: 50, Status: core.Online},
+		{ID: 3, Hostname: "de3.nordvpn.com", Status: core.Offline},
+		{ID: 5, Hostname: "de5.nordvpn.com", Status: core.Offline},
+	})
+
+	assert.Equal(t, core.Servers{
+		{ID: 1, Hostname: "de1.nordvpn.com", Load: 10, Status: core.Online},
+		{ID: 2, Hostname: "de2.nordvpn.com", Load: 50, Status: core.Online},
+		{ID: 4, Hostname: "de4.nordvpn.com", Load: 40, Status: core.Online},
+	}, servers)
+	// cached list is not modified
+	assert.Equal(t, int64(20), dm.serversData.Servers[1].Load)
+}
+
+// TestJobServers_Incremental checks if the cached servers list is updated instead of downloaded again
+func TestJobServers_Incremental(t *testing.T) {
+	category.Set(t, category.Integration)
+	defer testsCleanup()
+
+	dm := testNewDataManager()
+	assert.NoError(t, JobServers(dm, newMockConfigManager(), &mockServersAPI{}, false)())
+	dm.serversData.UpdatedAt = time.Now().Add(-2 * time.Hour)
+	updatesSince := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	dm.serversData.UpdatesSince = updatesSince
+	var online core.Servers
+	for _, server := range dm.GetServersData().Servers {
+		if server.Status == core.Online {
+			online = append(online, server)
+		}
+	}
+
+	offline := online[0]
+	offline.Status = core.Offline
+	api := &mockUpdatesServersAPI{
+		updates: core.Servers{offline},
+		headers: http.Header{"Date": []string{"Wed, 01 May 2024 14:00:00 GMT"}},
+	}
+	assert.NoError(t, JobServers(dm, newMockConfigManager(), api, true)())
+
+	assert.Equal(t, updatesSince, api.since)
+	assert.Equal(t, updatesSince.Add(2*time.Hour), dm.GetServersData().UpdatesSince.UTC())
+	assert.Len(t, dm.GetServersData().Servers, len(online)-1)
+	assert.True(t, dm.IsServersDataValid())
+}
+
+// TestJobServers_NotModified checks if the cached servers list is kept when nothing has changed
+func TestJobServers_NotModified(t *testing.T) {
+	category.Set(t, category.Integration)
+	defer testsCleanup()
+
+	dm := testNewDataManager()
+	assert.NoError(t, JobServers(dm, newMockConfigManager(), &mockServersAPI{}, false)())
+	dm.serversData.UpdatedAt = time.Now().Add(-2 * time.Hour)
+	cached := dm.GetServersData().Servers
+
+	api := &mockUpdatesServersAPI{err: core.ErrNotModified}
+	assert.NoError(t, JobServers(dm, newMockConfigManager(), api, true)())
+	assert.Equal(t, cached, dm.GetServersData().Servers)
+	assert.True(t, dm.IsServersDataValid())
+}
+
+// TestJobServers_FullUpdate checks if the whole list is downloaded when the last full download is too old
+func TestJobServers_FullUpdate(t *testing.T) {
+	category.Set(t, category.Integration)
+	defer testsCleanup()
+
+	dm := testNewDataManager()
+	assert.NoError(t, JobServers(dm, newMockConfigManager(), &mockServersAPI{}, false)())
+	dm.serversData.UpdatedAt = time.Now().Add(-2 * time.Hour)
+	dm.serversData.FullUpdatedAt = time.Now().Add(-serversFullUpdateInterval)
+
+	// incremental updates are not requested from the failing API
+	err := JobServers(dm, newMockConfigManager(), &mockFailingServersAPI{}, true)()
+	assert.Error(t, err)
+	assert.False(t, dm.IsServersDataValid())
+}
+
+func TestAPITime(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fallback := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		headers  http.Header
+		expected time.Time
+	}{
+		{
+			name: "last modified",
+			headers: http.Header{
+				"Last-Modified": []string{"Wed, 01 May 2024 11:00:00 GMT"},
+				"Date":          []string{"Wed, 01 May 2024 12:00:00 GMT"},
+			},
+			expected: time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "date",
+			headers:  http.Header{"Date": []string{"Wed, 01 May 2024 12:00:00 GMT"}},
+			expected: time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "invalid",
+			headers:  http.Header{"Date": []string{"yesterday"}},
+			expected: fallback,
+		},
+		{
+			name:     "no headers",
+			expected: fallback,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, apiTime(test.headers, fallback).UTC())
+		})
+	}
+}
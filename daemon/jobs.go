@@ -17,6 +17,7 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/events"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/meshnet"
+	"github.com/NordSecurity/nordvpn-linux/norduser/service"
 
 	"google.golang.org/grpc/metadata"
 )
@@ -34,7 +35,7 @@ func (r *RPC) StartJobs(statePublisher *state.StatePublisher) {
 		log.Println(internal.WarningPrefix, "job insights schedule error:", err)
 	}
 
-	if _, err := r.scheduler.NewJob(gocron.DurationJob(1*time.Hour), gocron.NewTask(JobServers(r.dm, r.cm, r.api, true)), gocron.WithName("job servers")); err != nil {
+	if _, err := r.scheduler.NewJob(gocron.DurationJob(1*time.Hour), gocron.NewTask(r.jobServersUnlessMetered(JobServers(r.dm, r.cm, r.api, true))), gocron.WithName("job servers")); err != nil {
 		log.Println(internal.WarningPrefix, "job servers schedule error:", err)
 	}
 	// TODO if autoconnect runs before servers job, it will return zero servers list
@@ -55,6 +56,36 @@ func (r *RPC) StartJobs(statePublisher *state.StatePublisher) {
 		log.Println(internal.WarningPrefix, "job heart beat schedule error:", err)
 	}
 
+	if _, err := r.scheduler.NewJob(gocron.DurationJob(scheduleCheckInterval), gocron.NewTask(r.runSchedule), gocron.WithName("job schedule")); err != nil {
+		log.Println(internal.WarningPrefix, "job schedule schedule error:", err)
+	}
+
+	if _, err := r.scheduler.NewJob(gocron.DurationJob(tunnelHealthCheckInterval), gocron.NewTask(r.checkTunnelHealth), gocron.WithName("job tunnel health")); err != nil {
+		log.Println(internal.WarningPrefix, "job tunnel health schedule error:", err)
+	}
+
+	if _, err := r.scheduler.NewJob(gocron.DurationJob(tunnelHealthCheckInterval), gocron.NewTask(r.pingServer), gocron.WithName("job server ping")); err != nil {
+		log.Println(internal.WarningPrefix, "job server ping schedule error:", err)
+	}
+
+	if r.bandwidthUsage != nil {
+		if _, err := r.scheduler.NewJob(gocron.DurationJob(bandwidthSampleInterval), gocron.NewTask(r.recordBandwidth), gocron.WithName("job bandwidth usage")); err != nil {
+			log.Println(internal.WarningPrefix, "job bandwidth usage schedule error:", err)
+		}
+	}
+
+	if r.metered != nil {
+		if _, err := r.scheduler.NewJob(gocron.DurationJob(5*time.Minute), gocron.NewTask(r.metered.RunDeferred), gocron.WithName("job metered network")); err != nil {
+			log.Println(internal.WarningPrefix, "job metered network schedule error:", err)
+		}
+	}
+
+	if r.norduserMonitor != nil {
+		if _, err := r.scheduler.NewJob(gocron.DurationJob(service.HealthCheckInterval), gocron.NewTask(r.norduserMonitor.CheckAll), gocron.WithName("job norduser health")); err != nil {
+			log.Println(internal.WarningPrefix, "job norduser health schedule error:", err)
+		}
+	}
+
 	r.scheduler.Start()
 	for _, job := range r.scheduler.Jobs() {
 		err := job.RunNow()
@@ -85,6 +116,21 @@ func (r *RPC) StartJobs(statePublisher *state.StatePublisher) {
 	}()
 }
 
+// jobServersUnlessMetered postpones the servers list refresh on the metered network. The list is always downloaded
+// if there is none yet, because connecting is not possible without it.
+func (r *RPC) jobServersUnlessMetered(job func() error) func() error {
+	return func() error {
+		if r.metered != nil && r.dm.ServerDataExists() && r.metered.Defer("servers list refresh", func() {
+			if err := job(); err != nil {
+				log.Println(internal.WarningPrefix, "postponed servers list refresh:", err)
+			}
+		}) {
+			return nil
+		}
+		return job()
+	}
+}
+
 func (r *RPC) StartKillSwitch() {
 	var cfg config.Config
 	err := r.cm.Load(&cfg)
@@ -205,6 +251,21 @@ func connectErrorCheck(err error) bool {
 	return err == nil
 }
 
+// autoConnectFor returns whether the user at the console has autoconnect on and their target. The
+// most recent choice of any user is used if nobody is logged in on the seat yet, which is usually the
+// case on boot.
+func (r *RPC) autoConnectFor(cfg config.Config) (bool, config.AutoConnectTarget) {
+	if r.seatUser != nil {
+		uid, ok, err := r.seatUser()
+		if err != nil {
+			log.Println(internal.WarningPrefix, "looking up the seat user for auto-connect:", err)
+		} else if ok {
+			return cfg.AutoConnectFor(uid), cfg.AutoConnectTargetFor(uid)
+		}
+	}
+	return cfg.AutoConnect, cfg.DefaultAutoConnectTarget()
+}
+
 // StartAutoConnect connect to VPN server if autoconnect is enabled
 func (r *RPC) StartAutoConnect(timeoutFn GetTimeoutFunc) error {
 	tries := 1
@@ -214,6 +275,26 @@ func (r *RPC) StartAutoConnect(timeoutFn GetTimeoutFunc) error {
 			return nil
 		}
 
+		if r.pause.pausedUntil() != 0 {
+			log.Println(internal.InfoPrefix, "auto-connect skipped while VPN is paused")
+			return nil
+		}
+
+		if r.trustedNetworks != nil && r.trustedNetworks.IsTrusted() {
+			log.Println(internal.InfoPrefix, "auto-connect skipped on trusted network")
+			return nil
+		}
+
+		if r.metered != nil && r.metered.Defer("auto-connect", func() {
+			go func() {
+				if err := r.StartAutoConnect(timeoutFn); err != nil {
+					log.Println(internal.ErrorPrefix, "postponed auto-connect:", err)
+				}
+			}()
+		}) {
+			return nil
+		}
+
 		var cfg config.Config
 		err := r.cm.Load(&cfg)
 		if err != nil {
@@ -221,14 +302,19 @@ func (r *RPC) StartAutoConnect(timeoutFn GetTimeoutFunc) error {
 			return err
 		}
 
+		enabled, target := r.autoConnectFor(cfg)
+		if !enabled {
+			log.Println(internal.InfoPrefix, "auto-connect is off for the user at the console")
+			return nil
+		}
 		server := autoconnectServer{}
-		err = r.Connect(&pb.ConnectRequest{ServerTag: cfg.AutoConnectData.ServerTag}, &server)
+		err = r.Connect(&pb.ConnectRequest{ServerTag: target.ServerTag}, &server)
 		if connectErrorCheck(err) && server.err == nil {
 			log.Println(internal.InfoPrefix, "auto-connect success")
 			r.ConnectionParameters.SetConnectionParameters(pb.ConnectionSource_AUTO,
-				ServerParameters{Country: cfg.AutoConnectData.Country,
-					City:  cfg.AutoConnectData.City,
-					Group: cfg.AutoConnectData.Group})
+				ServerParameters{Country: target.Country,
+					City:  target.City,
+					Group: target.Group})
 			return nil
 		}
 		log.Println(internal.ErrorPrefix, "auto-connect failed, err1:", server.err, "| err2:", err)
@@ -120,9 +120,20 @@ func TestStartAutoConnect(t *testing.T) {
 				nil,
 				&mockAnalytics{},
 				&testnorduser.MockNorduserCombinedService{},
+				nil,
+				nil,
 				&RegistryMock{},
 				nil,
 				sharedctx.New(),
+				NewPendingActions(func() bool { return true }),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			err := rpc.StartAutoConnect(mockTimeout)
@@ -142,7 +153,9 @@ func (meshRenewChecker) Register() error                 { return nil }
 
 type invitationsAPI struct{}
 
-func (invitationsAPI) Invite(string, uuid.UUID, string, bool, bool, bool, bool) error { return nil }
+func (invitationsAPI) Invite(string, uuid.UUID, string, time.Duration, bool, bool, bool, bool) error {
+	return nil
+}
 func (invitationsAPI) Sent(string, uuid.UUID) (mesh.Invitations, error) {
 	return mesh.Invitations{}, nil
 }
@@ -154,6 +167,13 @@ func (invitationsAPI) Received(string, uuid.UUID) (mesh.Invitations, error) {
 func (invitationsAPI) Accept(string, uuid.UUID, uuid.UUID, bool, bool, bool, bool) error { return nil }
 func (invitationsAPI) Revoke(string, uuid.UUID, uuid.UUID) error                         { return nil }
 func (invitationsAPI) Reject(string, uuid.UUID, uuid.UUID) error                         { return nil }
+func (invitationsAPI) CreateInviteCode(string, uuid.UUID, bool, bool, bool, bool) (*mesh.InviteCode, error) {
+	return &mesh.InviteCode{}, nil
+}
+
+func (invitationsAPI) RedeemInviteCode(string, uuid.UUID, string, bool, bool, bool, bool) error {
+	return nil
+}
 
 type meshNetworker struct {
 	allowedIncoming  []meshnet.UniqueAddress
@@ -197,12 +217,16 @@ func (n *meshNetworker) BlockFileshare(address meshnet.UniqueAddress) error {
 	return nil
 }
 
-func (*meshNetworker) ResetRouting(mesh.MachinePeer, mesh.MachinePeers) error { return nil }
-func (*meshNetworker) BlockRouting(meshnet.UniqueAddress) error               { return nil }
-func (*meshNetworker) Refresh(mesh.MachineMap) error                          { return nil }
+func (*meshNetworker) ResetRouting(mesh.MachinePeer, mesh.MachinePeers) error  { return nil }
+func (*meshNetworker) SetPeerBandwidthLimits(config.PeerBandwidthLimits) error { return nil }
+func (*meshNetworker) BlockRouting(meshnet.UniqueAddress) error                { return nil }
+func (*meshNetworker) Refresh(mesh.MachineMap) error                           { return nil }
 func (*meshNetworker) StatusMap() (map[string]string, error) {
 	return map[string]string{}, nil
 }
+func (*meshNetworker) PathMap() (map[string]string, error) {
+	return map[string]string{}, nil
+}
 func (*meshNetworker) LastServerName() string { return "" }
 
 func TestStartAutoMeshnet(t *testing.T) {
@@ -287,9 +311,20 @@ func TestStartAutoMeshnet(t *testing.T) {
 				nil,
 				&mockAnalytics{},
 				&testnorduser.MockNorduserCombinedService{},
+				nil,
+				nil,
 				&RegistryMock{},
 				nil,
 				sharedctx.New(),
+				NewPendingActions(func() bool { return true }),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			meshService := meshnet.NewServer(
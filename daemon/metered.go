@@ -0,0 +1,125 @@
+package daemon
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// meteredCacheTime defines how long the detected metered state is used before detecting it again. Status is
+// requested often, so the state is not detected on every request.
+const meteredCacheTime = 30 * time.Second
+
+// MeteredDetector reports whether the network the device uses is metered
+type MeteredDetector func() (bool, error)
+
+// MeteredNetwork postpones background activity while the device uses a metered network if the user enabled it in
+// the settings. Postponed tasks are run once the network is no longer metered.
+type MeteredNetwork struct {
+	mu       sync.Mutex
+	cm       config.Manager
+	detect   MeteredDetector
+	metered  bool
+	detected time.Time
+	// deferred tasks by their names, so that a task postponed several times is run once
+	deferred map[string]func()
+	now      func() time.Time
+}
+
+func newMeteredNetwork(cm config.Manager, detect MeteredDetector) *MeteredNetwork {
+	return &MeteredNetwork{
+		cm:       cm,
+		detect:   detect,
+		deferred: map[string]func(){},
+		now:      time.Now,
+	}
+}
+
+// IsMetered reports whether the network is metered. Network is not considered metered if it can't be detected.
+func (m *MeteredNetwork) IsMetered() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.isMetered(false)
+}
+
+// Deferring reports whether background activity is postponed
+func (m *MeteredNetwork) Deferring() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.deferring(false)
+}
+
+// Defer postpones the task if background activity is postponed and reports whether it was
+func (m *MeteredNetwork) Defer(name string, task func()) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.deferring(false) {
+		return false
+	}
+	log.Println(internal.InfoPrefix, name, "is postponed on metered network")
+	m.deferred[name] = task
+	return true
+}
+
+// Reconnect runs the postponed tasks if the new network is not metered. It implements netstate.Reconnector.
+func (m *MeteredNetwork) Reconnect(stateIsUp bool) {
+	if stateIsUp {
+		m.runDeferred(true)
+	}
+}
+
+// RunDeferred runs the postponed tasks if the network is no longer metered or the setting was disabled
+func (m *MeteredNetwork) RunDeferred() {
+	m.runDeferred(false)
+}
+
+func (m *MeteredNetwork) runDeferred(refresh bool) {
+	m.mu.Lock()
+	if len(m.deferred) == 0 || m.deferring(refresh) {
+		m.mu.Unlock()
+		return
+	}
+	deferred := m.deferred
+	m.deferred = map[string]func(){}
+	m.mu.Unlock()
+
+	for name, task := range deferred {
+		log.Println(internal.InfoPrefix, "running", name, "postponed on metered network")
+		task()
+	}
+}
+
+// deferring reports whether the setting is enabled and the network is metered. Not thread safe. Lock mu before
+// using.
+func (m *MeteredNetwork) deferring(refresh bool) bool {
+	var cfg config.Config
+	if err := m.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, "loading config for metered network:", err)
+		return false
+	}
+	return cfg.DeferOnMetered && m.isMetered(refresh)
+}
+
+// isMetered returns the cached metered state or detects it if the cache is outdated or refresh is requested. Not
+// thread safe. Lock mu before using.
+func (m *MeteredNetwork) isMetered(refresh bool) bool {
+	if m.detect == nil {
+		return false
+	}
+	if !refresh && !m.detected.IsZero() && m.now().Sub(m.detected) < meteredCacheTime {
+		return m.metered
+	}
+
+	metered, err := m.detect()
+	if err != nil {
+		log.Println(internal.DebugPrefix, "failed to detect metered network:", err)
+		metered = false
+	}
+	m.metered = metered
+	m.detected = m.now()
+	return metered
+}
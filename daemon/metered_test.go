@@ -0,0 +1,109 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMeteredNetwork_Defer(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		enabled  bool
+		metered  bool
+		err      error
+		deferred bool
+	}{
+		{name: "metered", enabled: true, metered: true, deferred: true},
+		{name: "not metered", enabled: true},
+		{name: "setting disabled", metered: true},
+		{name: "detection failed", enabled: true, metered: true, err: errors.New("no NetworkManager")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.DeferOnMetered = test.enabled
+			m := newMeteredNetwork(cm, func() (bool, error) { return test.metered, test.err })
+
+			ran := false
+			assert.Equal(t, test.deferred, m.Defer("task", func() { ran = true }))
+			assert.Equal(t, test.deferred, m.Deferring())
+			assert.False(t, ran)
+		})
+	}
+}
+
+func TestMeteredNetwork_RunDeferred(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.DeferOnMetered = true
+	metered := true
+	detections := 0
+	m := newMeteredNetwork(cm, func() (bool, error) {
+		detections++
+		return metered, nil
+	})
+	now := time.Now()
+	m.now = func() time.Time { return now }
+
+	runs := 0
+	assert.True(t, m.Defer("task", func() { runs++ }))
+	assert.True(t, m.Defer("task", func() { runs++ }))
+	assert.Equal(t, 1, detections, "metered state should be cached")
+
+	m.Reconnect(true)
+	assert.Equal(t, 0, runs, "tasks should stay postponed on metered network")
+
+	metered = false
+	m.RunDeferred()
+	assert.Equal(t, 0, runs, "cached metered state should be used")
+
+	now = now.Add(meteredCacheTime)
+	m.RunDeferred()
+	assert.Equal(t, 1, runs, "task postponed several times should run once")
+	assert.False(t, m.IsMetered())
+
+	m.Reconnect(true)
+	assert.Equal(t, 1, runs)
+}
+
+func TestSetDeferOnMetered(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	r := RPC{cm: cm, metered: newMeteredNetwork(cm, func() (bool, error) { return true, nil })}
+
+	resp, err := r.SetDeferOnMetered(context.Background(), &pb.SetGenericRequest{Enabled: true})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.True(t, cm.Cfg.DeferOnMetered)
+
+	resp, err = r.SetDeferOnMetered(context.Background(), &pb.SetGenericRequest{Enabled: true})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeNothingToDo, resp.Type)
+
+	ran := make(chan struct{})
+	assert.True(t, r.metered.Defer("task", func() { close(ran) }))
+
+	resp, err = r.SetDeferOnMetered(context.Background(), &pb.SetGenericRequest{Enabled: false})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.False(t, cm.Cfg.DeferOnMetered)
+	select {
+	case <-ran:
+	case <-time.After(time.Second):
+		assert.Fail(t, "postponed task should run after disabling the setting")
+	}
+}
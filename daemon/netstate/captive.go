@@ -0,0 +1,93 @@
+package netstate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+)
+
+// CaptivePortalProbeURL responds with 204 No Content unless the request is intercepted by a captive portal
+const CaptivePortalProbeURL = "http://connectivitycheck.gstatic.com/generate_204"
+
+// captivePortalBodyLimit is how much of the intercepted response is read, so the connection can be reused
+const captivePortalBodyLimit = 64 * 1024
+
+// ProbeCaptivePortal requests the probe URL over plain HTTP and returns the login page of the captive portal, empty
+// when the network is not behind one. Portals either redirect to the login page or serve it in place of the probe
+// response. An error is returned if the probe URL can't be reached at all.
+func ProbeCaptivePortal(client *http.Client, probeURL string) (string, error) {
+	// redirect is the answer, following it would only load the login page
+	noRedirects := *client
+	noRedirects.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	resp, err := noRedirects.Get(probeURL)
+	if err != nil {
+		return "", fmt.Errorf("probing for captive portal: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, captivePortalBodyLimit))
+
+	switch {
+	case resp.StatusCode == http.StatusNoContent:
+		return "", nil
+	case resp.StatusCode >= http.StatusMultipleChoices && resp.StatusCode < http.StatusBadRequest:
+		location, err := resp.Location()
+		if err != nil {
+			return probeURL, nil
+		}
+		return location.String(), nil
+	default:
+		return probeURL, nil
+	}
+}
+
+// CaptivePortalProber probes for the captive portal and resolves the addresses of its login page
+type CaptivePortalProber struct {
+	Client   *http.Client
+	Resolver *net.Resolver
+	// URL is the probe URL, CaptivePortalProbeURL is used if it is empty
+	URL string
+}
+
+// Probe returns the login page of the captive portal, empty if the network is not behind one
+func (p CaptivePortalProber) Probe() (string, error) {
+	probeURL := p.URL
+	if probeURL == "" {
+		probeURL = CaptivePortalProbeURL
+	}
+	return ProbeCaptivePortal(p.Client, probeURL)
+}
+
+// LookupPortal returns the addresses of the host serving the login page
+func (p CaptivePortalProber) LookupPortal(portalURL string) ([]netip.Addr, error) {
+	u, err := url.Parse(portalURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing captive portal URL: %w", err)
+	}
+	host := u.Hostname()
+	if host == "" {
+		return nil, fmt.Errorf("captive portal URL %q has no host", portalURL)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return []netip.Addr{addr.Unmap()}, nil
+	}
+
+	resolver := p.Resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+	addrs, err := resolver.LookupNetIP(context.Background(), "ip", host)
+	if err != nil {
+		return nil, fmt.Errorf("resolving captive portal %s: %w", host, err)
+	}
+	for i, addr := range addrs {
+		addrs[i] = addr.Unmap()
+	}
+	return addrs, nil
+}
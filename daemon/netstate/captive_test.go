@@ -0,0 +1,99 @@
+package netstate
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbeCaptivePortal(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		// portal is the login page, relative to the probe server if it starts with a slash
+		portal string
+	}{
+		{
+			name: "no portal",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			},
+		},
+		{
+			name: "redirect to login page",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, "http://10.0.0.1/login?orig=probe", http.StatusFound)
+			},
+			portal: "http://10.0.0.1/login?orig=probe",
+		},
+		{
+			name: "relative redirect",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/login" {
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+				http.Redirect(w, r, "/login", http.StatusTemporaryRedirect)
+			},
+			portal: "/login",
+		},
+		{
+			name: "login page in place of the probe",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("<html>Accept the terms</html>"))
+			},
+			portal: "/generate_204",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(test.handler)
+			defer server.Close()
+
+			portal, err := ProbeCaptivePortal(server.Client(), server.URL+"/generate_204")
+			assert.NoError(t, err)
+			switch {
+			case test.portal == "":
+				assert.Empty(t, portal)
+			case strings.HasPrefix(test.portal, "/"):
+				assert.Equal(t, server.URL+test.portal, portal)
+			default:
+				assert.Equal(t, test.portal, portal)
+			}
+		})
+	}
+}
+
+func TestProbeCaptivePortal_Unreachable(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	_, err := ProbeCaptivePortal(server.Client(), server.URL)
+	assert.Error(t, err)
+}
+
+func TestCaptivePortalProber_LookupPortal(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	addrs, err := CaptivePortalProber{}.LookupPortal("http://10.0.0.1:8080/login")
+	assert.NoError(t, err)
+	assert.Equal(t, []netip.Addr{netip.MustParseAddr("10.0.0.1")}, addrs)
+
+	addrs, err = CaptivePortalProber{}.LookupPortal("http://[fd00::1]/login")
+	assert.NoError(t, err)
+	assert.Equal(t, []netip.Addr{netip.MustParseAddr("fd00::1")}, addrs)
+
+	_, err = CaptivePortalProber{}.LookupPortal("/login")
+	assert.Error(t, err)
+}
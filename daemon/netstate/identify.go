@@ -0,0 +1,119 @@
+package netstate
+
+import (
+	"bufio"
+	"fmt"
+	"net/netip"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/vishvananda/netlink"
+)
+
+// sysClassNet lists network interfaces. Wireless interfaces have the wireless directory.
+const sysClassNet = "/sys/class/net"
+
+// NetworkInterface describes the network the device has joined through the interface
+type NetworkInterface struct {
+	Name string
+	// SSID of the Wi-Fi network, empty for the wired networks
+	SSID  string
+	Addrs []netip.Addr
+}
+
+// Network is the set of networks the device uses to reach the internet, i.e. interfaces with the default route
+type Network []NetworkInterface
+
+// Key identifies the network so that decisions made for it can be remembered. Wi-Fi networks are identified by
+// SSID, wired ones by the interface name and the /24 subnet of its address. Empty key is returned for unknown
+// networks.
+func (n Network) Key() string {
+	keys := make([]string, 0, len(n))
+	for _, iface := range n {
+		switch {
+		case iface.SSID != "":
+			keys = append(keys, "ssid:"+iface.SSID)
+		case len(iface.Addrs) > 0:
+			prefix, err := iface.Addrs[0].Prefix(24)
+			if err != nil {
+				continue
+			}
+			keys = append(keys, iface.Name+":"+prefix.String())
+		}
+	}
+	slices.Sort(keys)
+	return strings.Join(keys, ",")
+}
+
+// IdentifyNetwork returns the networks joined through the interfaces with the default route
+func IdentifyNetwork(ignored mapset.Set[string]) (Network, error) {
+	routes, err := netlink.RouteList(nil, netlink.FAMILY_V4)
+	if err != nil {
+		return nil, fmt.Errorf("listing routes: %w", err)
+	}
+
+	seen := mapset.NewSet[string]()
+	network := Network{}
+	for _, r := range routes {
+		if r.Dst != nil || r.Gw == nil {
+			continue
+		}
+		link, err := netlink.LinkByIndex(r.LinkIndex)
+		if err != nil {
+			continue
+		}
+		name := link.Attrs().Name
+		if ignored.Contains(name) || !seen.Add(name) {
+			continue
+		}
+
+		iface := NetworkInterface{Name: name}
+		if addrList, err := netlink.AddrList(link, netlink.FAMILY_V4); err == nil {
+			for _, addr := range addrList {
+				if ip, ok := netip.AddrFromSlice(addr.IP.To4()); ok {
+					iface.Addrs = append(iface.Addrs, ip)
+				}
+			}
+		}
+		if isWireless(name) {
+			ssid, err := wirelessSSID(name)
+			if err != nil {
+				return nil, fmt.Errorf("getting SSID of %s: %w", name, err)
+			}
+			iface.SSID = ssid
+		}
+		network = append(network, iface)
+	}
+	return network, nil
+}
+
+func isWireless(iface string) bool {
+	_, err := os.Stat(filepath.Join(sysClassNet, iface, "wireless"))
+	return err == nil
+}
+
+// wirelessSSID returns the SSID of the network the interface is associated with
+func wirelessSSID(iface string) (string, error) {
+	// #nosec G204 -- interface name comes from the kernel
+	out, err := exec.Command("iw", "dev", iface, "link").Output()
+	if err != nil {
+		return "", fmt.Errorf("running iw: %w", err)
+	}
+	return parseIWLinkSSID(string(out)), nil
+}
+
+// parseIWLinkSSID extracts SSID from the output of 'iw dev <interface> link'
+func parseIWLinkSSID(out string) string {
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if ssid, ok := strings.CutPrefix(line, "SSID: "); ok {
+			return ssid
+		}
+	}
+	return ""
+}
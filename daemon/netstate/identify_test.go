@@ -0,0 +1,70 @@
+package netstate
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIWLinkSSID(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name string
+		out  string
+		ssid string
+	}{
+		{
+			name: "connected",
+			out: `Connected to 3c:37:86:11:22:33 (on wlp2s0)
+	SSID: Home Wi-Fi
+	freq: 5180
+	signal: -52 dBm
+`,
+			ssid: "Home Wi-Fi",
+		},
+		{name: "not connected", out: "Not connected.\n"},
+		{name: "empty"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.ssid, parseIWLinkSSID(test.out))
+		})
+	}
+}
+
+func TestNetwork_Key(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		network  Network
+		expected string
+	}{
+		{name: "unknown network", network: Network{}, expected: ""},
+		{
+			name:     "wireless network",
+			network:  Network{{Name: "wlan0", SSID: "Cafe", Addrs: []netip.Addr{netip.MustParseAddr("10.1.2.3")}}},
+			expected: "ssid:Cafe",
+		},
+		{
+			name: "wired and wireless networks",
+			network: Network{
+				{Name: "wlan0", SSID: "Cafe"},
+				{Name: "eth0", Addrs: []netip.Addr{netip.MustParseAddr("192.168.1.15")}},
+			},
+			expected: "eth0:192.168.1.0/24,ssid:Cafe",
+		},
+		{name: "interface without address", network: Network{{Name: "eth0"}}, expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.network.Key())
+		})
+	}
+}
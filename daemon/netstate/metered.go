@@ -0,0 +1,48 @@
+package netstate
+
+import (
+	"fmt"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	networkManagerDest = "org.freedesktop.NetworkManager"
+	networkManagerPath = "/org/freedesktop/NetworkManager"
+	// networkManagerMetered is the metered state of the primary connection
+	networkManagerMetered = "org.freedesktop.NetworkManager.Metered"
+)
+
+// NMMetered values as defined by NetworkManager
+const (
+	nmMeteredUnknown  uint32 = 0
+	nmMeteredYes      uint32 = 1
+	nmMeteredNo       uint32 = 2
+	nmMeteredGuessYes uint32 = 3
+	nmMeteredGuessNo  uint32 = 4
+)
+
+// IsMetered reports whether NetworkManager considers the primary connection metered, e.g. a mobile hotspot.
+// An error is returned if NetworkManager is not running.
+func IsMetered() (bool, error) {
+	conn, err := dbus.ConnectSystemBus()
+	if err != nil {
+		return false, fmt.Errorf("connecting to system bus: %w", err)
+	}
+	defer conn.Close()
+
+	variant, err := conn.Object(networkManagerDest, networkManagerPath).GetProperty(networkManagerMetered)
+	if err != nil {
+		return false, fmt.Errorf("getting metered state from NetworkManager: %w", err)
+	}
+	metered, ok := variant.Value().(uint32)
+	if !ok {
+		return false, fmt.Errorf("unexpected metered state %v", variant.Value())
+	}
+	return isMeteredState(metered), nil
+}
+
+// isMeteredState returns true if the connection is metered or NetworkManager guesses so
+func isMeteredState(state uint32) bool {
+	return state == nmMeteredYes || state == nmMeteredGuessYes
+}
@@ -0,0 +1,31 @@
+package netstate
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMeteredState(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name    string
+		state   uint32
+		metered bool
+	}{
+		{name: "unknown", state: nmMeteredUnknown},
+		{name: "yes", state: nmMeteredYes, metered: true},
+		{name: "no", state: nmMeteredNo},
+		{name: "guess yes", state: nmMeteredGuessYes, metered: true},
+		{name: "guess no", state: nmMeteredGuessNo},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.metered, isMeteredState(test.state))
+		})
+	}
+}
@@ -1,9 +1,13 @@
 package netstate
 
 import (
+	"log"
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
-	"github.com/NordSecurity/nordvpn-linux/daemon/device"
+	"github.com/NordSecurity/nordvpn-linux/internal"
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/vishvananda/netlink"
 )
@@ -13,14 +17,44 @@ type Reconnector interface {
 	Reconnect(stateIsUp bool)
 }
 
-// NetlinkMonitor keeps track of the interfaces on this host.
+// Reconnectors notifies every reconnector about the network state changes in the given order
+type Reconnectors []Reconnector
+
+// Reconnect implements Reconnector
+func (r Reconnectors) Reconnect(stateIsUp bool) {
+	for _, re := range r {
+		re.Reconnect(stateIsUp)
+	}
+}
+
+const (
+	// settleTime defines how long the monitor waits for the burst of netlink updates to end before checking the
+	// routes, e.g. address, routes and DNS are configured one by one after joining the Wi-Fi network
+	settleTime = time.Second
+)
+
+// defaultRoute identifies the default route of an interface. Roaming to another network keeps the interface, but
+// changes the gateway or the address, so both are a part of the identity.
+type defaultRoute struct {
+	iface   string
+	gateway string
+	// addrs are sorted IPv4 addresses of the interface joined by comma
+	addrs string
+}
+
+// NetlinkMonitor keeps track of the default routes on this host and notifies about changes, so that the tunnel
+// can be re-established after switching networks, docking or resuming from suspend.
 type NetlinkMonitor struct {
 	linkUpdatesChan  chan netlink.LinkUpdate
 	routeUpdatesChan chan netlink.RouteUpdate
+	addrUpdatesChan  chan netlink.AddrUpdate
+	resumeChan       chan struct{}
 	doneChan         chan struct{} // close(doneChan) to terminate Subscribe loop
 	mtx              sync.Mutex
-	cached           mapset.Set[string] // interface cache
-	ignored          mapset.Set[string] // ignore our-selfs created interfaces
+	cached           mapset.Set[defaultRoute] // default routes cache
+	ignored          mapset.Set[string]       // ignore our-selfs created interfaces
+	defaultRoutes    func(ignored mapset.Set[string]) mapset.Set[defaultRoute]
+	settleTime       time.Duration
 }
 
 // NewNetlinkMonitor instantiate netlink monitor
@@ -28,11 +62,15 @@ func NewNetlinkMonitor(ignoreIntfs []string) (*NetlinkMonitor, error) {
 	nlmon := &NetlinkMonitor{
 		linkUpdatesChan:  make(chan netlink.LinkUpdate),
 		routeUpdatesChan: make(chan netlink.RouteUpdate),
+		addrUpdatesChan:  make(chan netlink.AddrUpdate),
+		resumeChan:       make(chan struct{}),
 		doneChan:         make(chan struct{}),
 		mtx:              sync.Mutex{},
+		defaultRoutes:    defaultRoutes,
+		settleTime:       settleTime,
 	}
 	nlmon.ignored = mapset.NewSet(ignoreIntfs...)
-	nlmon.cached = device.InterfacesWithDefaultRoute(nlmon.ignored)
+	nlmon.cached = nlmon.defaultRoutes(nlmon.ignored)
 
 	if err := netlink.LinkSubscribe(nlmon.linkUpdatesChan, nlmon.doneChan); err != nil {
 		return nil, err
@@ -40,17 +78,27 @@ func NewNetlinkMonitor(ignoreIntfs []string) (*NetlinkMonitor, error) {
 	if err := netlink.RouteSubscribe(nlmon.routeUpdatesChan, nlmon.doneChan); err != nil {
 		return nil, err
 	}
+	if err := netlink.AddrSubscribe(nlmon.addrUpdatesChan, nlmon.doneChan); err != nil {
+		return nil, err
+	}
 	return nlmon, nil
 }
 
 // Start start monitoring
 func (m *NetlinkMonitor) Start(re Reconnector) {
+	go func() {
+		if err := watchResume(m.resumeChan, m.doneChan); err != nil {
+			log.Println(internal.WarningPrefix, "resume from suspend will not be detected:", err)
+		}
+	}()
 	go m.run(re)
 }
 
 // run handle incoming netlink update events
 // should be run on separate go routine
 func (m *NetlinkMonitor) run(re Reconnector) {
+	// settled fires once the burst of updates is over, nil while there are no pending updates
+	var settled <-chan time.Time
 	for {
 		select {
 		case <-m.doneChan:
@@ -59,31 +107,121 @@ func (m *NetlinkMonitor) run(re Reconnector) {
 			if !ok {
 				return
 			}
-			m.checkForChanges(re)
+			settled = m.settle(settled)
 		case _, ok := <-m.routeUpdatesChan:
 			if !ok {
 				return
 			}
+			settled = m.settle(settled)
+		case _, ok := <-m.addrUpdatesChan:
+			if !ok {
+				return
+			}
+			settled = m.settle(settled)
+		case <-settled:
+			settled = nil
 			m.checkForChanges(re)
+		case <-m.resumeChan:
+			settled = nil
+			m.reconnectAfterResume(re)
 		}
 	}
 }
 
+// settle starts waiting for the updates to settle if not waiting already, so a constantly changing network
+// does not postpone the check forever
+func (m *NetlinkMonitor) settle(settled <-chan time.Time) <-chan time.Time {
+	if settled != nil {
+		return settled
+	}
+	return time.After(m.settleTime)
+}
+
 func (m *NetlinkMonitor) checkForChanges(re Reconnector) {
-	interfaces := device.InterfacesWithDefaultRoute(m.ignored)
+	routes := m.defaultRoutes(m.ignoredInterfaces())
 
-	if m.setCachedInterfaces(interfaces) {
-		re.Reconnect(!interfaces.IsEmpty())
+	if m.setCachedRoutes(routes) {
+		log.Println(internal.InfoPrefix, "default routes have changed, refreshing connections")
+		re.Reconnect(!routes.IsEmpty())
 	}
 }
 
-func (m *NetlinkMonitor) setCachedInterfaces(interfaces mapset.Set[string]) bool {
+// reconnectAfterResume refreshes connections even if the routes did not change, because the tunnel is usually
+// dead after the suspend while the network looks the same
+func (m *NetlinkMonitor) reconnectAfterResume(re Reconnector) {
+	routes := m.defaultRoutes(m.ignoredInterfaces())
+	m.setCachedRoutes(routes)
+	log.Println(internal.InfoPrefix, "resumed from suspend, refreshing connections")
+	re.Reconnect(!routes.IsEmpty())
+}
+
+// IsOnline reports whether any of the monitored interfaces has a default route
+func (m *NetlinkMonitor) IsOnline() bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return !m.cached.IsEmpty()
+}
+
+// IdentifyNetwork returns the networks joined through the monitored interfaces
+func (m *NetlinkMonitor) IdentifyNetwork() (Network, error) {
+	return IdentifyNetwork(m.ignoredInterfaces())
+}
+
+// IgnoreInterfaces replaces the interfaces created by the daemon, e.g. when the tunnel interface name changes
+func (m *NetlinkMonitor) IgnoreInterfaces(names []string) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.ignored = mapset.NewSet(names...)
+}
+
+func (m *NetlinkMonitor) ignoredInterfaces() mapset.Set[string] {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.ignored
+}
+
+func (m *NetlinkMonitor) setCachedRoutes(routes mapset.Set[defaultRoute]) bool {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
-	if !m.cached.Equal(interfaces) {
-		m.cached = interfaces
+	if !m.cached.Equal(routes) {
+		m.cached = routes
 		return true
 	}
 
 	return false
 }
+
+// defaultRoutes returns IPv4 default routes of the interfaces which are not ignored
+func defaultRoutes(ignored mapset.Set[string]) mapset.Set[defaultRoute] {
+	routes := mapset.NewSet[defaultRoute]()
+	routeList, err := netlink.RouteList(nil, netlink.FAMILY_V4)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "listing routes:", err)
+		return routes
+	}
+
+	for _, r := range routeList {
+		if r.Dst != nil || r.Gw == nil {
+			continue
+		}
+		link, err := netlink.LinkByIndex(r.LinkIndex)
+		if err != nil {
+			continue
+		}
+		name := link.Attrs().Name
+		if ignored.Contains(name) {
+			continue
+		}
+
+		addrs := []string{}
+		if addrList, err := netlink.AddrList(link, netlink.FAMILY_V4); err == nil {
+			for _, addr := range addrList {
+				addrs = append(addrs, addr.IPNet.String())
+			}
+		}
+		slices.Sort(addrs)
+
+		routes.Add(defaultRoute{iface: name, gateway: r.Gw.String(), addrs: strings.Join(addrs, ",")})
+	}
+	return routes
+}
@@ -0,0 +1,103 @@
+package netstate
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+)
+
+type mockReconnector struct {
+	mu    sync.Mutex
+	calls []bool
+}
+
+func (r *mockReconnector) Reconnect(stateIsUp bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, stateIsUp)
+}
+
+func (r *mockReconnector) reconnects() []bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]bool{}, r.calls...)
+}
+
+func newTestMonitor(routes *mapset.Set[defaultRoute]) *NetlinkMonitor {
+	return &NetlinkMonitor{
+		linkUpdatesChan:  make(chan netlink.LinkUpdate),
+		routeUpdatesChan: make(chan netlink.RouteUpdate),
+		addrUpdatesChan:  make(chan netlink.AddrUpdate),
+		resumeChan:       make(chan struct{}),
+		doneChan:         make(chan struct{}),
+		cached:           *routes,
+		ignored:          mapset.NewSet[string](),
+		defaultRoutes:    func(mapset.Set[string]) mapset.Set[defaultRoute] { return *routes },
+		settleTime:       10 * time.Millisecond,
+	}
+}
+
+func TestNetlinkMonitor_CheckForChanges(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	home := defaultRoute{iface: "wlan0", gateway: "192.168.1.1", addrs: "192.168.1.23/24"}
+	routes := mapset.NewSet(home)
+	monitor := newTestMonitor(&routes)
+	re := &mockReconnector{}
+
+	monitor.checkForChanges(re)
+	assert.Empty(t, re.reconnects(), "nothing has changed")
+
+	// roamed to another network using the same interface
+	routes = mapset.NewSet(defaultRoute{iface: "wlan0", gateway: "10.0.0.1", addrs: "10.0.0.54/16"})
+	monitor.checkForChanges(re)
+	assert.Equal(t, []bool{true}, re.reconnects())
+
+	// undocked and Wi-Fi is off
+	routes = mapset.NewSet[defaultRoute]()
+	monitor.checkForChanges(re)
+	assert.Equal(t, []bool{true, false}, re.reconnects())
+	assert.False(t, monitor.IsOnline())
+
+	// resumed from suspend in the same network
+	routes = mapset.NewSet(home)
+	monitor.checkForChanges(re)
+	monitor.reconnectAfterResume(re)
+	assert.Equal(t, []bool{true, false, true, true}, re.reconnects())
+	assert.True(t, monitor.IsOnline())
+}
+
+func TestNetlinkMonitor_BurstOfUpdatesIsHandledOnce(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	routes := mapset.NewSet[defaultRoute]()
+	monitor := newTestMonitor(&routes)
+	re := &mockReconnector{}
+	go monitor.run(re)
+	defer close(monitor.doneChan)
+
+	routes = mapset.NewSet(defaultRoute{iface: "eth0", gateway: "10.0.0.1", addrs: "10.0.0.2/24"})
+	monitor.linkUpdatesChan <- netlink.LinkUpdate{}
+	monitor.addrUpdatesChan <- netlink.AddrUpdate{}
+	monitor.routeUpdatesChan <- netlink.RouteUpdate{}
+
+	assert.Eventually(t, func() bool { return len(re.reconnects()) == 1 }, time.Second, 5*time.Millisecond)
+	time.Sleep(5 * monitor.settleTime)
+	assert.Equal(t, []bool{true}, re.reconnects())
+}
+
+func TestIsResumeSignal(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	name := logindManagerIface + "." + prepareForSleep
+	assert.True(t, isResumeSignal(&dbus.Signal{Name: name, Body: []interface{}{false}}))
+	assert.False(t, isResumeSignal(&dbus.Signal{Name: name, Body: []interface{}{true}}))
+	assert.False(t, isResumeSignal(&dbus.Signal{Name: logindManagerIface + ".SessionNew", Body: []interface{}{false}}))
+	assert.False(t, isResumeSignal(&dbus.Signal{Name: name}))
+}
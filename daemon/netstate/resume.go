@@ -0,0 +1,61 @@
+package netstate
+
+import (
+	"fmt"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	logindPath         = "/org/freedesktop/login1"
+	logindManagerIface = "org.freedesktop.login1.Manager"
+	prepareForSleep    = "PrepareForSleep"
+)
+
+// watchResume sends to resumeChan every time the system resumes from suspend or hibernation until doneChan is
+// closed. logind announces it with PrepareForSleep(false) signal.
+func watchResume(resumeChan chan<- struct{}, doneChan <-chan struct{}) error {
+	conn, err := dbus.ConnectSystemBus()
+	if err != nil {
+		return fmt.Errorf("connecting to system dbus: %w", err)
+	}
+	defer conn.Close()
+
+	if err := conn.AddMatchSignal(
+		dbus.WithMatchInterface(logindManagerIface),
+		dbus.WithMatchObjectPath(logindPath),
+		dbus.WithMatchMember(prepareForSleep),
+	); err != nil {
+		return fmt.Errorf("subscribing to sleep signals: %w", err)
+	}
+
+	signals := make(chan *dbus.Signal, 1)
+	conn.Signal(signals)
+
+	for {
+		select {
+		case <-doneChan:
+			return nil
+		case signal, ok := <-signals:
+			if !ok {
+				return fmt.Errorf("dbus connection closed")
+			}
+			if isResumeSignal(signal) {
+				select {
+				case resumeChan <- struct{}{}:
+				case <-doneChan:
+					return nil
+				}
+			}
+		}
+	}
+}
+
+// isResumeSignal reports whether the signal announces that the system has woken up
+func isResumeSignal(signal *dbus.Signal) bool {
+	if signal.Name != logindManagerIface+"."+prepareForSleep || len(signal.Body) != 1 {
+		return false
+	}
+	sleeping, ok := signal.Body[0].(bool)
+	return ok && !sleeping
+}
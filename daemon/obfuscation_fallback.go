@@ -0,0 +1,168 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"slices"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+)
+
+// obfuscationFallback tracks the networks where the regular VPN connection is blocked, e.g. by deep packet
+// inspection, and only the obfuscated servers can be reached. Obfuscated servers require OpenVPN, so the VPN
+// implementation is replaced while connecting to them.
+type obfuscationFallback struct {
+	mu       sync.Mutex
+	cm       config.Manager
+	identify NetworkIdentifier
+	factory  FactoryFunc
+	// vpnReplaced is true while the VPN implementation differs from the configured technology
+	vpnReplaced bool
+}
+
+func newObfuscationFallback(cm config.Manager, identify NetworkIdentifier, factory FactoryFunc) *obfuscationFallback {
+	return &obfuscationFallback{cm: cm, identify: identify, factory: factory}
+}
+
+// networkKey identifies the current network, empty key is returned if it can't be identified
+func (o *obfuscationFallback) networkKey() string {
+	if o.identify == nil {
+		return ""
+	}
+	network, err := o.identify()
+	if err != nil {
+		log.Println(internal.WarningPrefix, "failed to identify the network:", err)
+		return ""
+	}
+	return network.Key()
+}
+
+// remember saves the network as the one where only the obfuscated connection succeeds
+func (o *obfuscationFallback) remember(key string) {
+	if err := o.cm.SaveWith(func(c config.Config) config.Config {
+		if !slices.Contains(c.ObfuscatedNetworks, key) {
+			c.ObfuscatedNetworks = append(slices.Clone(c.ObfuscatedNetworks), key)
+		}
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, "remembering obfuscated network:", err)
+	}
+}
+
+// setVPN switches to the VPN implementation of the technology used for the connection. Replaced is true if the
+// technology differs from the configured one. Nothing is done if neither the current nor the previous connection
+// replaced the configured technology.
+func (o *obfuscationFallback) setVPN(netw networker.Networker, tech config.Technology, replaced bool) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if !replaced && !o.vpnReplaced {
+		return nil
+	}
+	v, err := o.factory(tech)
+	if err != nil {
+		return err
+	}
+	netw.SetVPN(v)
+	o.vpnReplaced = replaced
+	return nil
+}
+
+// canFallbackToObfuscation reports whether the connection request can be served by the obfuscated servers.
+// Specialty server groups, dedicated IP servers and post-quantum encryption are not available with obfuscation, so
+// they are never replaced silently.
+func canFallbackToObfuscation(cfg config.Config, in *pb.ConnectRequest) bool {
+	return cfg.ObfuscationFallback.Get() &&
+		!cfg.AutoConnectData.Obfuscate &&
+		!cfg.AutoConnectData.PostquantumVpn &&
+		obfuscatedServersServe(in)
+}
+
+// obfuscatedServersServe reports whether the obfuscated servers can serve the connection request
+func obfuscatedServersServe(in *pb.ConnectRequest) bool {
+	return in.GetServerGroup() == "" &&
+		in.GetVia() == "" &&
+		in.GetDedicatedIp() == "" &&
+		groupConvert(internal.RemoveNonAlphanumeric(in.GetServerTag())) == config.ServerGroup_UNDEFINED
+}
+
+// obfuscatedConfig returns the config used to connect to the obfuscated servers
+func obfuscatedConfig(cfg config.Config) config.Config {
+	if cfg.Technology != config.Technology_OPENVPN {
+		cfg.Technology = config.Technology_OPENVPN
+		cfg.AutoConnectData.Protocol = config.Protocol_UDP
+	}
+	cfg.AutoConnectData.Obfuscate = true
+	return cfg
+}
+
+// fallbackConnectServer holds back the failure of the connection attempt, so that it is not reported to the user
+// before the connection is retried with the obfuscated servers. When retrying, every result except the successful
+// or canceled connection is held back.
+type fallbackConnectServer struct {
+	pb.Daemon_ConnectServer
+	retry     bool
+	failure   *pb.Payload
+	connected bool
+}
+
+func (s *fallbackConnectServer) Send(payload *pb.Payload) error {
+	switch payload.GetType() {
+	case internal.CodeConnecting, internal.CodeDisconnected:
+	case internal.CodeConnected:
+		s.connected = true
+	case internal.CodeFailure:
+		s.failure = payload
+		return nil
+	default:
+		if s.retry {
+			s.failure = payload
+			return nil
+		}
+	}
+	return s.Daemon_ConnectServer.Send(payload)
+}
+
+// connectWithFallback connects to the obfuscated servers right away on the networks where only they could be
+// reached before. On other networks the regular connection is retried once with the obfuscated servers after all
+// of the regular attempts fail, and the network is remembered if the retry succeeds. The technology ladder is used
+// instead when the technology is selected automatically.
+func (r *RPC) connectWithFallback(ctx context.Context, in *pb.ConnectRequest, srv pb.Daemon_ConnectServer) error {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+	if r.obfuscation != nil && canUseTechnologyLadder(cfg) {
+		return r.connectWithLadder(ctx, in, srv, cfg)
+	}
+	if r.obfuscation == nil || !canFallbackToObfuscation(cfg, in) {
+		return r.connectWithRetry(ctx, in, srv, "")
+	}
+
+	network := r.obfuscation.networkKey()
+	if network != "" && slices.Contains(cfg.ObfuscatedNetworks, network) {
+		log.Println(internal.InfoPrefix, "regular connection is blocked on this network, using obfuscated servers")
+		return r.connectWithRetry(ctx, in, srv, config.RungObfuscated)
+	}
+
+	regular := &fallbackConnectServer{Daemon_ConnectServer: srv}
+	if err := r.connectWithRetry(ctx, in, regular, ""); err != nil || regular.failure == nil {
+		return err
+	}
+
+	log.Println(internal.InfoPrefix, "regular connection has failed, retrying with obfuscated servers")
+	obfuscated := &fallbackConnectServer{Daemon_ConnectServer: srv, retry: true}
+	if err := r.connect(ctx, in, obfuscated, config.RungObfuscated); err != nil || obfuscated.failure != nil {
+		log.Println(internal.WarningPrefix, "obfuscated connection has failed:", err, obfuscated.failure.GetType())
+		return srv.Send(regular.failure)
+	}
+	if obfuscated.connected && network != "" {
+		log.Println(internal.InfoPrefix, "remembering that only obfuscated servers can be reached on this network")
+		r.obfuscation.remember(network)
+	}
+	return nil
+}
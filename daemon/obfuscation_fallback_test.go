@@ -0,0 +1,193 @@
+package daemon
+
+import (
+	"context"
+	"net/http"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	daemonevents "github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/daemon/netstate"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/response"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/sharedctx"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testfirewall "github.com/NordSecurity/nordvpn-linux/test/mock/firewall"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	testnorduser "github.com/NordSecurity/nordvpn-linux/test/mock/norduser/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// dpiNetworker fails to connect unless the server is obfuscated
+type dpiNetworker struct {
+	testnetworker.Mock
+	attempts []bool
+}
+
+func (n *dpiNetworker) Start(
+	_ context.Context,
+	_ vpn.Credentials,
+	serverData vpn.ServerData,
+	_ config.Allowlist,
+	_ config.DNS,
+	_ bool,
+) error {
+	n.attempts = append(n.attempts, serverData.Obfuscated)
+	if !serverData.Obfuscated {
+		return mock.ErrOnPurpose
+	}
+	return nil
+}
+
+func TestCanFallbackToObfuscation(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		cfg      func(*config.Config)
+		in       *pb.ConnectRequest
+		expected bool
+	}{
+		{name: "recommended server", in: &pb.ConnectRequest{}, expected: true},
+		{name: "country", in: &pb.ConnectRequest{ServerTag: "germany"}, expected: true},
+		{name: "group", in: &pb.ConnectRequest{ServerGroup: "p2p"}},
+		{name: "group as the server tag", in: &pb.ConnectRequest{ServerTag: "p2p"}},
+		{name: "double VPN entry", in: &pb.ConnectRequest{ServerTag: "germany", Via: "canada"}},
+		{
+			name: "disabled",
+			cfg:  func(c *config.Config) { c.ObfuscationFallback.Set(false) },
+			in:   &pb.ConnectRequest{},
+		},
+		{
+			name: "already obfuscated",
+			cfg:  func(c *config.Config) { c.AutoConnectData.Obfuscate = true },
+			in:   &pb.ConnectRequest{},
+		},
+		{
+			name: "post-quantum",
+			cfg:  func(c *config.Config) { c.AutoConnectData.PostquantumVpn = true },
+			in:   &pb.ConnectRequest{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := config.Config{Technology: config.Technology_NORDLYNX}
+			if test.cfg != nil {
+				test.cfg(&cfg)
+			}
+			assert.Equal(t, test.expected, canFallbackToObfuscation(cfg, test.in))
+		})
+	}
+}
+
+func TestFallbackConnectServer(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	srv := &mockRPCServer{}
+	regular := &fallbackConnectServer{Daemon_ConnectServer: srv}
+	assert.NoError(t, regular.Send(&pb.Payload{Type: internal.CodeConnecting}))
+	assert.Equal(t, internal.CodeConnecting, srv.msg.Type)
+	assert.NoError(t, regular.Send(&pb.Payload{Type: internal.CodeFailure}))
+	assert.Equal(t, internal.CodeConnecting, srv.msg.Type)
+	assert.Equal(t, internal.CodeFailure, regular.failure.Type)
+	assert.NoError(t, regular.Send(&pb.Payload{Type: internal.CodeTagNonexisting}))
+	assert.Equal(t, internal.CodeTagNonexisting, srv.msg.Type)
+
+	retry := &fallbackConnectServer{Daemon_ConnectServer: srv, retry: true}
+	assert.NoError(t, retry.Send(&pb.Payload{Type: internal.CodeServerUnavailable}))
+	assert.Equal(t, internal.CodeServerUnavailable, retry.failure.Type)
+	assert.NoError(t, retry.Send(&pb.Payload{Type: internal.CodeConnected}))
+	assert.Equal(t, internal.CodeConnected, srv.msg.Type)
+	assert.True(t, retry.connected)
+}
+
+func TestConnectWithFallback(t *testing.T) {
+	category.Set(t, category.Unit)
+	defer testsCleanup()
+
+	network := netstate.Network{{Name: "wlan0", SSID: "Cafe"}}
+	tests := []struct {
+		name               string
+		obfuscatedNetworks []string
+		expectedAttempts   []bool
+		expectedNetworks   []string
+	}{
+		{
+			name:             "falls back and remembers the network",
+			expectedAttempts: []bool{false, true},
+			expectedNetworks: []string{"ssid:Cafe"},
+		},
+		{
+			name:               "uses obfuscation right away on the remembered network",
+			obfuscatedNetworks: []string{"ssid:Cafe"},
+			expectedAttempts:   []bool{true},
+			expectedNetworks:   []string{"ssid:Cafe"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Technology = config.Technology_NORDLYNX
+			cm.Cfg.AutoConnectData = config.AutoConnectData{ID: 1337, Protocol: config.Protocol_UDP}
+			cm.Cfg.UsersData = &config.UsersData{}
+			cm.Cfg.ObfuscatedNetworks = test.obfuscatedNetworks
+			dm := testNewDataManager()
+			dm.SetServersData(time.Now(), serversList(), "", time.Time{})
+			netw := &dpiNetworker{}
+			rpc := NewRPC(
+				internal.Development,
+				&workingLoginChecker{},
+				cm,
+				dm,
+				core.NewDefaultAPI("", "", http.DefaultClient, response.NoopValidator{}),
+				mockServersAPI{},
+				&validCredentialsAPI{},
+				testNewCDNAPI(),
+				testNewRepoAPI(),
+				&mockAuthenticationAPI{},
+				"1.0.0",
+				&testfirewall.FirewallMock{},
+				daemonevents.NewEventsEmpty(),
+				func(config.Technology) (vpn.VPN, error) { return &mock.WorkingVPN{}, nil },
+				newEndpointResolverMock(netip.MustParseAddr("127.0.0.1")),
+				netw,
+				&subs.Subject[string]{},
+				&mock.DNSGetter{Names: []string{"1.1.1.1"}},
+				nil,
+				&mockAnalytics{},
+				&testnorduser.MockNorduserCombinedService{},
+				nil,
+				nil,
+				&RegistryMock{},
+				nil,
+				sharedctx.New(),
+				NewPendingActions(func() bool { return true }),
+				func() (netstate.Network, error) { return network, nil },
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			server := &mockRPCServer{}
+			err := rpc.Connect(&pb.ConnectRequest{}, server)
+			assert.NoError(t, err)
+			assert.Equal(t, internal.CodeConnected, server.msg.Type)
+			assert.Equal(t, test.expectedAttempts, netw.attempts)
+			assert.Equal(t, test.expectedNetworks, cm.Cfg.ObfuscatedNetworks)
+		})
+	}
+}
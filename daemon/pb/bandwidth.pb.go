@@ -0,0 +1,396 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: bandwidth.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BandwidthGrouping selects how the transferred bytes are summed up
+type BandwidthGrouping int32
+
+const (
+	BandwidthGrouping_BY_DAY    BandwidthGrouping = 0
+	BandwidthGrouping_BY_WEEK   BandwidthGrouping = 1
+	BandwidthGrouping_BY_SERVER BandwidthGrouping = 2
+)
+
+// Enum value maps for BandwidthGrouping.
+var (
+	BandwidthGrouping_name = map[int32]string{
+		0: "BY_DAY",
+		1: "BY_WEEK",
+		2: "BY_SERVER",
+	}
+	BandwidthGrouping_value = map[string]int32{
+		"BY_DAY":    0,
+		"BY_WEEK":   1,
+		"BY_SERVER": 2,
+	}
+)
+
+func (x BandwidthGrouping) Enum() *BandwidthGrouping {
+	p := new(BandwidthGrouping)
+	*p = x
+	return p
+}
+
+func (x BandwidthGrouping) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BandwidthGrouping) Descriptor() protoreflect.EnumDescriptor {
+	return file_bandwidth_proto_enumTypes[0].Descriptor()
+}
+
+func (BandwidthGrouping) Type() protoreflect.EnumType {
+	return &file_bandwidth_proto_enumTypes[0]
+}
+
+func (x BandwidthGrouping) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BandwidthGrouping.Descriptor instead.
+func (BandwidthGrouping) EnumDescriptor() ([]byte, []int) {
+	return file_bandwidth_proto_rawDescGZIP(), []int{0}
+}
+
+type BandwidthUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupBy BandwidthGrouping `protobuf:"varint,1,opt,name=group_by,json=groupBy,proto3,enum=pb.BandwidthGrouping" json:"group_by,omitempty"`
+	// number of the latest days or weeks to return, all are returned when 0
+	Limit int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *BandwidthUsageRequest) Reset() {
+	*x = BandwidthUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bandwidth_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BandwidthUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BandwidthUsageRequest) ProtoMessage() {}
+
+func (x *BandwidthUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bandwidth_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BandwidthUsageRequest.ProtoReflect.Descriptor instead.
+func (*BandwidthUsageRequest) Descriptor() ([]byte, []int) {
+	return file_bandwidth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BandwidthUsageRequest) GetGroupBy() BandwidthGrouping {
+	if x != nil {
+		return x.GroupBy
+	}
+	return BandwidthGrouping_BY_DAY
+}
+
+func (x *BandwidthUsageRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type BandwidthUsageEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unix time of the day or week start, 0 when grouped by server
+	PeriodStart int64 `protobuf:"varint,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	// server hostname, empty when grouped by day or week
+	Server   string `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	Download uint64 `protobuf:"varint,3,opt,name=download,proto3" json:"download,omitempty"`
+	Upload   uint64 `protobuf:"varint,4,opt,name=upload,proto3" json:"upload,omitempty"`
+}
+
+func (x *BandwidthUsageEntry) Reset() {
+	*x = BandwidthUsageEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bandwidth_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BandwidthUsageEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BandwidthUsageEntry) ProtoMessage() {}
+
+func (x *BandwidthUsageEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_bandwidth_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BandwidthUsageEntry.ProtoReflect.Descriptor instead.
+func (*BandwidthUsageEntry) Descriptor() ([]byte, []int) {
+	return file_bandwidth_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BandwidthUsageEntry) GetPeriodStart() int64 {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return 0
+}
+
+func (x *BandwidthUsageEntry) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *BandwidthUsageEntry) GetDownload() uint64 {
+	if x != nil {
+		return x.Download
+	}
+	return 0
+}
+
+func (x *BandwidthUsageEntry) GetUpload() uint64 {
+	if x != nil {
+		return x.Upload
+	}
+	return 0
+}
+
+type BandwidthUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// newest days or weeks are first, servers are sorted by the total bytes transferred
+	Entries []*BandwidthUsageEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// totals of the returned entries
+	Download uint64 `protobuf:"varint,2,opt,name=download,proto3" json:"download,omitempty"`
+	Upload   uint64 `protobuf:"varint,3,opt,name=upload,proto3" json:"upload,omitempty"`
+}
+
+func (x *BandwidthUsageResponse) Reset() {
+	*x = BandwidthUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_bandwidth_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BandwidthUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BandwidthUsageResponse) ProtoMessage() {}
+
+func (x *BandwidthUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_bandwidth_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BandwidthUsageResponse.ProtoReflect.Descriptor instead.
+func (*BandwidthUsageResponse) Descriptor() ([]byte, []int) {
+	return file_bandwidth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BandwidthUsageResponse) GetEntries() []*BandwidthUsageEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *BandwidthUsageResponse) GetDownload() uint64 {
+	if x != nil {
+		return x.Download
+	}
+	return 0
+}
+
+func (x *BandwidthUsageResponse) GetUpload() uint64 {
+	if x != nil {
+		return x.Upload
+	}
+	return 0
+}
+
+var File_bandwidth_proto protoreflect.FileDescriptor
+
+var file_bandwidth_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x5f, 0x0a, 0x15, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30,
+	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x6e, 0x64, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x55, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x7f, 0x0a,
+	0x16, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x61,
+	0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x55, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x2a, 0x3b,
+	0x0a, 0x11, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x69, 0x6e, 0x67, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x59, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x42, 0x59, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x42, 0x59, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0x02, 0x42, 0x31, 0x5a, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_bandwidth_proto_rawDescOnce sync.Once
+	file_bandwidth_proto_rawDescData = file_bandwidth_proto_rawDesc
+)
+
+func file_bandwidth_proto_rawDescGZIP() []byte {
+	file_bandwidth_proto_rawDescOnce.Do(func() {
+		file_bandwidth_proto_rawDescData = protoimpl.X.CompressGZIP(file_bandwidth_proto_rawDescData)
+	})
+	return file_bandwidth_proto_rawDescData
+}
+
+var file_bandwidth_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_bandwidth_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_bandwidth_proto_goTypes = []interface{}{
+	(BandwidthGrouping)(0),         // 0: pb.BandwidthGrouping
+	(*BandwidthUsageRequest)(nil),  // 1: pb.BandwidthUsageRequest
+	(*BandwidthUsageEntry)(nil),    // 2: pb.BandwidthUsageEntry
+	(*BandwidthUsageResponse)(nil), // 3: pb.BandwidthUsageResponse
+}
+var file_bandwidth_proto_depIdxs = []int32{
+	0, // 0: pb.BandwidthUsageRequest.group_by:type_name -> pb.BandwidthGrouping
+	2, // 1: pb.BandwidthUsageResponse.entries:type_name -> pb.BandwidthUsageEntry
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_bandwidth_proto_init() }
+func file_bandwidth_proto_init() {
+	if File_bandwidth_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_bandwidth_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BandwidthUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bandwidth_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BandwidthUsageEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_bandwidth_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BandwidthUsageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_bandwidth_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_bandwidth_proto_goTypes,
+		DependencyIndexes: file_bandwidth_proto_depIdxs,
+		EnumInfos:         file_bandwidth_proto_enumTypes,
+		MessageInfos:      file_bandwidth_proto_msgTypes,
+	}.Build()
+	File_bandwidth_proto = out.File
+	file_bandwidth_proto_rawDesc = nil
+	file_bandwidth_proto_goTypes = nil
+	file_bandwidth_proto_depIdxs = nil
+}
@@ -0,0 +1,969 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: event_stream.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// DaemonEventType groups the events which can be subscribed to
+type DaemonEventType int32
+
+const (
+	DaemonEventType_CONNECTION DaemonEventType = 0
+	DaemonEventType_SETTINGS   DaemonEventType = 1
+	DaemonEventType_AUTH       DaemonEventType = 2
+	DaemonEventType_MESHNET    DaemonEventType = 3
+	DaemonEventType_FIREWALL   DaemonEventType = 4
+)
+
+// Enum value maps for DaemonEventType.
+var (
+	DaemonEventType_name = map[int32]string{
+		0: "CONNECTION",
+		1: "SETTINGS",
+		2: "AUTH",
+		3: "MESHNET",
+		4: "FIREWALL",
+	}
+	DaemonEventType_value = map[string]int32{
+		"CONNECTION": 0,
+		"SETTINGS":   1,
+		"AUTH":       2,
+		"MESHNET":    3,
+		"FIREWALL":   4,
+	}
+)
+
+func (x DaemonEventType) Enum() *DaemonEventType {
+	p := new(DaemonEventType)
+	*p = x
+	return p
+}
+
+func (x DaemonEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DaemonEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_stream_proto_enumTypes[0].Descriptor()
+}
+
+func (DaemonEventType) Type() protoreflect.EnumType {
+	return &file_event_stream_proto_enumTypes[0]
+}
+
+func (x DaemonEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DaemonEventType.Descriptor instead.
+func (DaemonEventType) EnumDescriptor() ([]byte, []int) {
+	return file_event_stream_proto_rawDescGZIP(), []int{0}
+}
+
+type MeshnetEventType int32
+
+const (
+	MeshnetEventType_MESHNET_ENABLED       MeshnetEventType = 0
+	MeshnetEventType_MESHNET_DISABLED      MeshnetEventType = 1
+	MeshnetEventType_MESHNET_PEERS_UPDATED MeshnetEventType = 2
+)
+
+// Enum value maps for MeshnetEventType.
+var (
+	MeshnetEventType_name = map[int32]string{
+		0: "MESHNET_ENABLED",
+		1: "MESHNET_DISABLED",
+		2: "MESHNET_PEERS_UPDATED",
+	}
+	MeshnetEventType_value = map[string]int32{
+		"MESHNET_ENABLED":       0,
+		"MESHNET_DISABLED":      1,
+		"MESHNET_PEERS_UPDATED": 2,
+	}
+)
+
+func (x MeshnetEventType) Enum() *MeshnetEventType {
+	p := new(MeshnetEventType)
+	*p = x
+	return p
+}
+
+func (x MeshnetEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MeshnetEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_stream_proto_enumTypes[1].Descriptor()
+}
+
+func (MeshnetEventType) Type() protoreflect.EnumType {
+	return &file_event_stream_proto_enumTypes[1]
+}
+
+func (x MeshnetEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MeshnetEventType.Descriptor instead.
+func (MeshnetEventType) EnumDescriptor() ([]byte, []int) {
+	return file_event_stream_proto_rawDescGZIP(), []int{1}
+}
+
+type FirewallSetting int32
+
+const (
+	// FIREWALL_RULES is the firewall setting itself, it can't be named FIREWALL as it would clash with the event type
+	FirewallSetting_FIREWALL_RULES FirewallSetting = 0
+	FirewallSetting_KILL_SWITCH    FirewallSetting = 1
+)
+
+// Enum value maps for FirewallSetting.
+var (
+	FirewallSetting_name = map[int32]string{
+		0: "FIREWALL_RULES",
+		1: "KILL_SWITCH",
+	}
+	FirewallSetting_value = map[string]int32{
+		"FIREWALL_RULES": 0,
+		"KILL_SWITCH":    1,
+	}
+)
+
+func (x FirewallSetting) Enum() *FirewallSetting {
+	p := new(FirewallSetting)
+	*p = x
+	return p
+}
+
+func (x FirewallSetting) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FirewallSetting) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_stream_proto_enumTypes[2].Descriptor()
+}
+
+func (FirewallSetting) Type() protoreflect.EnumType {
+	return &file_event_stream_proto_enumTypes[2]
+}
+
+func (x FirewallSetting) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FirewallSetting.Descriptor instead.
+func (FirewallSetting) EnumDescriptor() ([]byte, []int) {
+	return file_event_stream_proto_rawDescGZIP(), []int{2}
+}
+
+type TunnelHealthProblem int32
+
+const (
+	// STALE_HANDSHAKE means that the session has not been renewed for too long
+	TunnelHealthProblem_STALE_HANDSHAKE TunnelHealthProblem = 0
+	// NO_INBOUND_TRAFFIC means that nothing has been received through the tunnel since the previous check
+	TunnelHealthProblem_NO_INBOUND_TRAFFIC TunnelHealthProblem = 1
+	TunnelHealthProblem_HIGH_LATENCY       TunnelHealthProblem = 2
+)
+
+// Enum value maps for TunnelHealthProblem.
+var (
+	TunnelHealthProblem_name = map[int32]string{
+		0: "STALE_HANDSHAKE",
+		1: "NO_INBOUND_TRAFFIC",
+		2: "HIGH_LATENCY",
+	}
+	TunnelHealthProblem_value = map[string]int32{
+		"STALE_HANDSHAKE":    0,
+		"NO_INBOUND_TRAFFIC": 1,
+		"HIGH_LATENCY":       2,
+	}
+)
+
+func (x TunnelHealthProblem) Enum() *TunnelHealthProblem {
+	p := new(TunnelHealthProblem)
+	*p = x
+	return p
+}
+
+func (x TunnelHealthProblem) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TunnelHealthProblem) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_stream_proto_enumTypes[3].Descriptor()
+}
+
+func (TunnelHealthProblem) Type() protoreflect.EnumType {
+	return &file_event_stream_proto_enumTypes[3]
+}
+
+func (x TunnelHealthProblem) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TunnelHealthProblem.Descriptor instead.
+func (TunnelHealthProblem) EnumDescriptor() ([]byte, []int) {
+	return file_event_stream_proto_rawDescGZIP(), []int{3}
+}
+
+type TunnelRecoveryAction int32
+
+const (
+	// RECONNECT_SAME_SERVER establishes a new session with the same server
+	TunnelRecoveryAction_RECONNECT_SAME_SERVER TunnelRecoveryAction = 0
+	// RECONNECT_NEXT_SERVER connects to the fastest of the other recommended servers
+	TunnelRecoveryAction_RECONNECT_NEXT_SERVER TunnelRecoveryAction = 1
+)
+
+// Enum value maps for TunnelRecoveryAction.
+var (
+	TunnelRecoveryAction_name = map[int32]string{
+		0: "RECONNECT_SAME_SERVER",
+		1: "RECONNECT_NEXT_SERVER",
+	}
+	TunnelRecoveryAction_value = map[string]int32{
+		"RECONNECT_SAME_SERVER": 0,
+		"RECONNECT_NEXT_SERVER": 1,
+	}
+)
+
+func (x TunnelRecoveryAction) Enum() *TunnelRecoveryAction {
+	p := new(TunnelRecoveryAction)
+	*p = x
+	return p
+}
+
+func (x TunnelRecoveryAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TunnelRecoveryAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_event_stream_proto_enumTypes[4].Descriptor()
+}
+
+func (TunnelRecoveryAction) Type() protoreflect.EnumType {
+	return &file_event_stream_proto_enumTypes[4]
+}
+
+func (x TunnelRecoveryAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TunnelRecoveryAction.Descriptor instead.
+func (TunnelRecoveryAction) EnumDescriptor() ([]byte, []int) {
+	return file_event_stream_proto_rawDescGZIP(), []int{4}
+}
+
+// SubscribeEventsRequest selects the types of events to receive, all events are sent if no types are given
+type SubscribeEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Types []DaemonEventType `protobuf:"varint,1,rep,packed,name=types,proto3,enum=pb.DaemonEventType" json:"types,omitempty"`
+}
+
+func (x *SubscribeEventsRequest) Reset() {
+	*x = SubscribeEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_stream_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_event_stream_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeEventsRequest) Descriptor() ([]byte, []int) {
+	return file_event_stream_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeEventsRequest) GetTypes() []DaemonEventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type MeshnetEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type MeshnetEventType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.MeshnetEventType" json:"type,omitempty"`
+	// peer_ids are the identifiers of the updated peers
+	PeerIds []string `protobuf:"bytes,2,rep,name=peer_ids,json=peerIds,proto3" json:"peer_ids,omitempty"`
+}
+
+func (x *MeshnetEvent) Reset() {
+	*x = MeshnetEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_stream_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MeshnetEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeshnetEvent) ProtoMessage() {}
+
+func (x *MeshnetEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_stream_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeshnetEvent.ProtoReflect.Descriptor instead.
+func (*MeshnetEvent) Descriptor() ([]byte, []int) {
+	return file_event_stream_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MeshnetEvent) GetType() MeshnetEventType {
+	if x != nil {
+		return x.Type
+	}
+	return MeshnetEventType_MESHNET_ENABLED
+}
+
+func (x *MeshnetEvent) GetPeerIds() []string {
+	if x != nil {
+		return x.PeerIds
+	}
+	return nil
+}
+
+type FirewallEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Setting FirewallSetting `protobuf:"varint,1,opt,name=setting,proto3,enum=pb.FirewallSetting" json:"setting,omitempty"`
+	Enabled bool            `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *FirewallEvent) Reset() {
+	*x = FirewallEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_stream_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FirewallEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FirewallEvent) ProtoMessage() {}
+
+func (x *FirewallEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_stream_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FirewallEvent.ProtoReflect.Descriptor instead.
+func (*FirewallEvent) Descriptor() ([]byte, []int) {
+	return file_event_stream_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FirewallEvent) GetSetting() FirewallSetting {
+	if x != nil {
+		return x.Setting
+	}
+	return FirewallSetting_FIREWALL_RULES
+}
+
+func (x *FirewallEvent) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// SettingsReloadEvent is sent after the managed settings file has been changed and applied
+type SettingsReloadEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// changed are the names of the changed settings as used by the settings export
+	Changed []string `protobuf:"bytes,1,rep,name=changed,proto3" json:"changed,omitempty"`
+	// reconnect_required are the changed settings which take effect after reconnecting to VPN
+	ReconnectRequired []string `protobuf:"bytes,2,rep,name=reconnect_required,json=reconnectRequired,proto3" json:"reconnect_required,omitempty"`
+}
+
+func (x *SettingsReloadEvent) Reset() {
+	*x = SettingsReloadEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_stream_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettingsReloadEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettingsReloadEvent) ProtoMessage() {}
+
+func (x *SettingsReloadEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_stream_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettingsReloadEvent.ProtoReflect.Descriptor instead.
+func (*SettingsReloadEvent) Descriptor() ([]byte, []int) {
+	return file_event_stream_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SettingsReloadEvent) GetChanged() []string {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+func (x *SettingsReloadEvent) GetReconnectRequired() []string {
+	if x != nil {
+		return x.ReconnectRequired
+	}
+	return nil
+}
+
+// TunnelHealthEvent is sent when the health monitor recovers the failing tunnel, it is of the CONNECTION type
+type TunnelHealthEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Problem TunnelHealthProblem  `protobuf:"varint,1,opt,name=problem,proto3,enum=pb.TunnelHealthProblem" json:"problem,omitempty"`
+	Action  TunnelRecoveryAction `protobuf:"varint,2,opt,name=action,proto3,enum=pb.TunnelRecoveryAction" json:"action,omitempty"`
+	// server_hostname is the server of the failing tunnel
+	ServerHostname string `protobuf:"bytes,3,opt,name=server_hostname,json=serverHostname,proto3" json:"server_hostname,omitempty"`
+}
+
+func (x *TunnelHealthEvent) Reset() {
+	*x = TunnelHealthEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_stream_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TunnelHealthEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TunnelHealthEvent) ProtoMessage() {}
+
+func (x *TunnelHealthEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_stream_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TunnelHealthEvent.ProtoReflect.Descriptor instead.
+func (*TunnelHealthEvent) Descriptor() ([]byte, []int) {
+	return file_event_stream_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TunnelHealthEvent) GetProblem() TunnelHealthProblem {
+	if x != nil {
+		return x.Problem
+	}
+	return TunnelHealthProblem_STALE_HANDSHAKE
+}
+
+func (x *TunnelHealthEvent) GetAction() TunnelRecoveryAction {
+	if x != nil {
+		return x.Action
+	}
+	return TunnelRecoveryAction_RECONNECT_SAME_SERVER
+}
+
+func (x *TunnelHealthEvent) GetServerHostname() string {
+	if x != nil {
+		return x.ServerHostname
+	}
+	return ""
+}
+
+type DaemonEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// timestamp is the unix time in milliseconds when the event happened
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Types that are assignable to Event:
+	//	*DaemonEvent_Connection
+	//	*DaemonEvent_Settings
+	//	*DaemonEvent_Auth
+	//	*DaemonEvent_Meshnet
+	//	*DaemonEvent_Firewall
+	//	*DaemonEvent_SettingsReload
+	//	*DaemonEvent_TunnelHealth
+	Event isDaemonEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *DaemonEvent) Reset() {
+	*x = DaemonEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_event_stream_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DaemonEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DaemonEvent) ProtoMessage() {}
+
+func (x *DaemonEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_event_stream_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DaemonEvent.ProtoReflect.Descriptor instead.
+func (*DaemonEvent) Descriptor() ([]byte, []int) {
+	return file_event_stream_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DaemonEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (m *DaemonEvent) GetEvent() isDaemonEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *DaemonEvent) GetConnection() *ConnectionStatus {
+	if x, ok := x.GetEvent().(*DaemonEvent_Connection); ok {
+		return x.Connection
+	}
+	return nil
+}
+
+func (x *DaemonEvent) GetSettings() *Settings {
+	if x, ok := x.GetEvent().(*DaemonEvent_Settings); ok {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *DaemonEvent) GetAuth() *LoginEvent {
+	if x, ok := x.GetEvent().(*DaemonEvent_Auth); ok {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *DaemonEvent) GetMeshnet() *MeshnetEvent {
+	if x, ok := x.GetEvent().(*DaemonEvent_Meshnet); ok {
+		return x.Meshnet
+	}
+	return nil
+}
+
+func (x *DaemonEvent) GetFirewall() *FirewallEvent {
+	if x, ok := x.GetEvent().(*DaemonEvent_Firewall); ok {
+		return x.Firewall
+	}
+	return nil
+}
+
+func (x *DaemonEvent) GetSettingsReload() *SettingsReloadEvent {
+	if x, ok := x.GetEvent().(*DaemonEvent_SettingsReload); ok {
+		return x.SettingsReload
+	}
+	return nil
+}
+
+func (x *DaemonEvent) GetTunnelHealth() *TunnelHealthEvent {
+	if x, ok := x.GetEvent().(*DaemonEvent_TunnelHealth); ok {
+		return x.TunnelHealth
+	}
+	return nil
+}
+
+type isDaemonEvent_Event interface {
+	isDaemonEvent_Event()
+}
+
+type DaemonEvent_Connection struct {
+	Connection *ConnectionStatus `protobuf:"bytes,2,opt,name=connection,proto3,oneof"`
+}
+
+type DaemonEvent_Settings struct {
+	Settings *Settings `protobuf:"bytes,3,opt,name=settings,proto3,oneof"`
+}
+
+type DaemonEvent_Auth struct {
+	Auth *LoginEvent `protobuf:"bytes,4,opt,name=auth,proto3,oneof"`
+}
+
+type DaemonEvent_Meshnet struct {
+	Meshnet *MeshnetEvent `protobuf:"bytes,5,opt,name=meshnet,proto3,oneof"`
+}
+
+type DaemonEvent_Firewall struct {
+	Firewall *FirewallEvent `protobuf:"bytes,6,opt,name=firewall,proto3,oneof"`
+}
+
+type DaemonEvent_SettingsReload struct {
+	SettingsReload *SettingsReloadEvent `protobuf:"bytes,7,opt,name=settings_reload,json=settingsReload,proto3,oneof"`
+}
+
+type DaemonEvent_TunnelHealth struct {
+	TunnelHealth *TunnelHealthEvent `protobuf:"bytes,8,opt,name=tunnel_health,json=tunnelHealth,proto3,oneof"`
+}
+
+func (*DaemonEvent_Connection) isDaemonEvent_Event() {}
+
+func (*DaemonEvent_Settings) isDaemonEvent_Event() {}
+
+func (*DaemonEvent_Auth) isDaemonEvent_Event() {}
+
+func (*DaemonEvent_Meshnet) isDaemonEvent_Event() {}
+
+func (*DaemonEvent_Firewall) isDaemonEvent_Event() {}
+
+func (*DaemonEvent_SettingsReload) isDaemonEvent_Event() {}
+
+func (*DaemonEvent_TunnelHealth) isDaemonEvent_Event() {}
+
+var File_event_stream_proto protoreflect.FileDescriptor
+
+var file_event_stream_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x1a, 0x0e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x43, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x29, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x70, 0x62, 0x2e, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x53, 0x0a, 0x0c, 0x4d, 0x65,
+	0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65,
+	0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22,
+	0x58, 0x0a, 0x0d, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x2d, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x5e, 0x0a, 0x13, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0xa1, 0x01, 0x0a, 0x11, 0x54, 0x75,
+	0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x31, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c,
+	0x65, 0x6d, 0x12, 0x30, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x68,
+	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9f, 0x03,
+	0x0a, 0x0b, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x36, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x24, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52,
+	0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68,
+	0x6e, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x68,
+	0x6e, 0x65, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x72, 0x65, 0x77,
+	0x61, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x08, 0x66, 0x69, 0x72, 0x65,
+	0x77, 0x61, 0x6c, 0x6c, 0x12, 0x42, 0x0a, 0x0f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x5f, 0x72, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3c, 0x0a, 0x0d, 0x74, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2a,
+	0x54, 0x0a, 0x0f, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x53, 0x10, 0x01,
+	0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x48, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x45,
+	0x53, 0x48, 0x4e, 0x45, 0x54, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x52, 0x45, 0x57,
+	0x41, 0x4c, 0x4c, 0x10, 0x04, 0x2a, 0x58, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x45, 0x53,
+	0x48, 0x4e, 0x45, 0x54, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14,
+	0x0a, 0x10, 0x4d, 0x45, 0x53, 0x48, 0x4e, 0x45, 0x54, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45, 0x53, 0x48, 0x4e, 0x45, 0x54, 0x5f,
+	0x50, 0x45, 0x45, 0x52, 0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x2a,
+	0x36, 0x0a, 0x0f, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x49, 0x52, 0x45, 0x57, 0x41, 0x4c, 0x4c, 0x5f, 0x52,
+	0x55, 0x4c, 0x45, 0x53, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4b, 0x49, 0x4c, 0x4c, 0x5f, 0x53,
+	0x57, 0x49, 0x54, 0x43, 0x48, 0x10, 0x01, 0x2a, 0x54, 0x0a, 0x13, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x13,
+	0x0a, 0x0f, 0x53, 0x54, 0x41, 0x4c, 0x45, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x53, 0x48, 0x41, 0x4b,
+	0x45, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4e, 0x4f, 0x5f, 0x49, 0x4e, 0x42, 0x4f, 0x55, 0x4e,
+	0x44, 0x5f, 0x54, 0x52, 0x41, 0x46, 0x46, 0x49, 0x43, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x48,
+	0x49, 0x47, 0x48, 0x5f, 0x4c, 0x41, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10, 0x02, 0x2a, 0x4c, 0x0a,
+	0x14, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
+	0x43, 0x54, 0x5f, 0x53, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0x00,
+	0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f, 0x4e, 0x45,
+	0x58, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x10, 0x01, 0x42, 0x31, 0x5a, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_event_stream_proto_rawDescOnce sync.Once
+	file_event_stream_proto_rawDescData = file_event_stream_proto_rawDesc
+)
+
+func file_event_stream_proto_rawDescGZIP() []byte {
+	file_event_stream_proto_rawDescOnce.Do(func() {
+		file_event_stream_proto_rawDescData = protoimpl.X.CompressGZIP(file_event_stream_proto_rawDescData)
+	})
+	return file_event_stream_proto_rawDescData
+}
+
+var file_event_stream_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_event_stream_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_event_stream_proto_goTypes = []interface{}{
+	(DaemonEventType)(0),           // 0: pb.DaemonEventType
+	(MeshnetEventType)(0),          // 1: pb.MeshnetEventType
+	(FirewallSetting)(0),           // 2: pb.FirewallSetting
+	(TunnelHealthProblem)(0),       // 3: pb.TunnelHealthProblem
+	(TunnelRecoveryAction)(0),      // 4: pb.TunnelRecoveryAction
+	(*SubscribeEventsRequest)(nil), // 5: pb.SubscribeEventsRequest
+	(*MeshnetEvent)(nil),           // 6: pb.MeshnetEvent
+	(*FirewallEvent)(nil),          // 7: pb.FirewallEvent
+	(*SettingsReloadEvent)(nil),    // 8: pb.SettingsReloadEvent
+	(*TunnelHealthEvent)(nil),      // 9: pb.TunnelHealthEvent
+	(*DaemonEvent)(nil),            // 10: pb.DaemonEvent
+	(*ConnectionStatus)(nil),       // 11: pb.ConnectionStatus
+	(*Settings)(nil),               // 12: pb.Settings
+	(*LoginEvent)(nil),             // 13: pb.LoginEvent
+}
+var file_event_stream_proto_depIdxs = []int32{
+	0,  // 0: pb.SubscribeEventsRequest.types:type_name -> pb.DaemonEventType
+	1,  // 1: pb.MeshnetEvent.type:type_name -> pb.MeshnetEventType
+	2,  // 2: pb.FirewallEvent.setting:type_name -> pb.FirewallSetting
+	3,  // 3: pb.TunnelHealthEvent.problem:type_name -> pb.TunnelHealthProblem
+	4,  // 4: pb.TunnelHealthEvent.action:type_name -> pb.TunnelRecoveryAction
+	11, // 5: pb.DaemonEvent.connection:type_name -> pb.ConnectionStatus
+	12, // 6: pb.DaemonEvent.settings:type_name -> pb.Settings
+	13, // 7: pb.DaemonEvent.auth:type_name -> pb.LoginEvent
+	6,  // 8: pb.DaemonEvent.meshnet:type_name -> pb.MeshnetEvent
+	7,  // 9: pb.DaemonEvent.firewall:type_name -> pb.FirewallEvent
+	8,  // 10: pb.DaemonEvent.settings_reload:type_name -> pb.SettingsReloadEvent
+	9,  // 11: pb.DaemonEvent.tunnel_health:type_name -> pb.TunnelHealthEvent
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_event_stream_proto_init() }
+func file_event_stream_proto_init() {
+	if File_event_stream_proto != nil {
+		return
+	}
+	file_settings_proto_init()
+	file_state_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_event_stream_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_stream_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MeshnetEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_stream_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FirewallEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_stream_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettingsReloadEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_stream_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TunnelHealthEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_event_stream_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DaemonEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_event_stream_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*DaemonEvent_Connection)(nil),
+		(*DaemonEvent_Settings)(nil),
+		(*DaemonEvent_Auth)(nil),
+		(*DaemonEvent_Meshnet)(nil),
+		(*DaemonEvent_Firewall)(nil),
+		(*DaemonEvent_SettingsReload)(nil),
+		(*DaemonEvent_TunnelHealth)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_event_stream_proto_rawDesc,
+			NumEnums:      5,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_event_stream_proto_goTypes,
+		DependencyIndexes: file_event_stream_proto_depIdxs,
+		EnumInfos:         file_event_stream_proto_enumTypes,
+		MessageInfos:      file_event_stream_proto_msgTypes,
+	}.Build()
+	File_event_stream_proto = out.File
+	file_event_stream_proto_rawDesc = nil
+	file_event_stream_proto_goTypes = nil
+	file_event_stream_proto_depIdxs = nil
+}
@@ -0,0 +1,298 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: preflight.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PreflightSeverity int32
+
+const (
+	// connection may still work, e.g. using another technology
+	PreflightSeverity_PREFLIGHT_WARNING PreflightSeverity = 0
+	// connection is expected to fail
+	PreflightSeverity_PREFLIGHT_ERROR PreflightSeverity = 1
+)
+
+// Enum value maps for PreflightSeverity.
+var (
+	PreflightSeverity_name = map[int32]string{
+		0: "PREFLIGHT_WARNING",
+		1: "PREFLIGHT_ERROR",
+	}
+	PreflightSeverity_value = map[string]int32{
+		"PREFLIGHT_WARNING": 0,
+		"PREFLIGHT_ERROR":   1,
+	}
+)
+
+func (x PreflightSeverity) Enum() *PreflightSeverity {
+	p := new(PreflightSeverity)
+	*p = x
+	return p
+}
+
+func (x PreflightSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PreflightSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_preflight_proto_enumTypes[0].Descriptor()
+}
+
+func (PreflightSeverity) Type() protoreflect.EnumType {
+	return &file_preflight_proto_enumTypes[0]
+}
+
+func (x PreflightSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PreflightSeverity.Descriptor instead.
+func (PreflightSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_preflight_proto_rawDescGZIP(), []int{0}
+}
+
+// PreflightFinding is a missing kernel feature or capability which is required for the VPN connection
+type PreflightFinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Severity    PreflightSeverity `protobuf:"varint,2,opt,name=severity,proto3,enum=pb.PreflightSeverity" json:"severity,omitempty"`
+	Description string            `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// hint tells how to resolve the finding
+	Hint string `protobuf:"bytes,4,opt,name=hint,proto3" json:"hint,omitempty"`
+}
+
+func (x *PreflightFinding) Reset() {
+	*x = PreflightFinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_preflight_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreflightFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreflightFinding) ProtoMessage() {}
+
+func (x *PreflightFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_preflight_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreflightFinding.ProtoReflect.Descriptor instead.
+func (*PreflightFinding) Descriptor() ([]byte, []int) {
+	return file_preflight_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PreflightFinding) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PreflightFinding) GetSeverity() PreflightSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return PreflightSeverity_PREFLIGHT_WARNING
+}
+
+func (x *PreflightFinding) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PreflightFinding) GetHint() string {
+	if x != nil {
+		return x.Hint
+	}
+	return ""
+}
+
+type PreflightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Findings []*PreflightFinding `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
+}
+
+func (x *PreflightResponse) Reset() {
+	*x = PreflightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_preflight_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreflightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreflightResponse) ProtoMessage() {}
+
+func (x *PreflightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_preflight_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreflightResponse.ProtoReflect.Descriptor instead.
+func (*PreflightResponse) Descriptor() ([]byte, []int) {
+	return file_preflight_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PreflightResponse) GetFindings() []*PreflightFinding {
+	if x != nil {
+		return x.Findings
+	}
+	return nil
+}
+
+var File_preflight_proto protoreflect.FileDescriptor
+
+var file_preflight_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x70, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x8b, 0x01, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x66, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70,
+	0x62, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x69, 0x6e, 0x74, 0x22, 0x45, 0x0a, 0x11, 0x50, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e,
+	0x50, 0x72, 0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x46, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x08, 0x66, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2a, 0x3f, 0x0a, 0x11, 0x50, 0x72,
+	0x65, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x15, 0x0a, 0x11, 0x50, 0x52, 0x45, 0x46, 0x4c, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x57, 0x41, 0x52,
+	0x4e, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x45, 0x46, 0x4c, 0x49,
+	0x47, 0x48, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x42, 0x31, 0x5a, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_preflight_proto_rawDescOnce sync.Once
+	file_preflight_proto_rawDescData = file_preflight_proto_rawDesc
+)
+
+func file_preflight_proto_rawDescGZIP() []byte {
+	file_preflight_proto_rawDescOnce.Do(func() {
+		file_preflight_proto_rawDescData = protoimpl.X.CompressGZIP(file_preflight_proto_rawDescData)
+	})
+	return file_preflight_proto_rawDescData
+}
+
+var file_preflight_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_preflight_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_preflight_proto_goTypes = []interface{}{
+	(PreflightSeverity)(0),    // 0: pb.PreflightSeverity
+	(*PreflightFinding)(nil),  // 1: pb.PreflightFinding
+	(*PreflightResponse)(nil), // 2: pb.PreflightResponse
+}
+var file_preflight_proto_depIdxs = []int32{
+	0, // 0: pb.PreflightFinding.severity:type_name -> pb.PreflightSeverity
+	1, // 1: pb.PreflightResponse.findings:type_name -> pb.PreflightFinding
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_preflight_proto_init() }
+func file_preflight_proto_init() {
+	if File_preflight_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_preflight_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreflightFinding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_preflight_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreflightResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_preflight_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_preflight_proto_goTypes,
+		DependencyIndexes: file_preflight_proto_depIdxs,
+		EnumInfos:         file_preflight_proto_enumTypes,
+		MessageInfos:      file_preflight_proto_msgTypes,
+	}.Build()
+	File_preflight_proto = out.File
+	file_preflight_proto_rawDesc = nil
+	file_preflight_proto_goTypes = nil
+	file_preflight_proto_depIdxs = nil
+}
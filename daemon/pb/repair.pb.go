@@ -0,0 +1,359 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: repair.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RepairStatus int32
+
+const (
+	RepairStatus_DETECTED      RepairStatus = 0
+	RepairStatus_REPAIRED      RepairStatus = 1
+	RepairStatus_REPAIR_FAILED RepairStatus = 2
+)
+
+// Enum value maps for RepairStatus.
+var (
+	RepairStatus_name = map[int32]string{
+		0: "DETECTED",
+		1: "REPAIRED",
+		2: "REPAIR_FAILED",
+	}
+	RepairStatus_value = map[string]int32{
+		"DETECTED":      0,
+		"REPAIRED":      1,
+		"REPAIR_FAILED": 2,
+	}
+)
+
+func (x RepairStatus) Enum() *RepairStatus {
+	p := new(RepairStatus)
+	*p = x
+	return p
+}
+
+func (x RepairStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RepairStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_repair_proto_enumTypes[0].Descriptor()
+}
+
+func (RepairStatus) Type() protoreflect.EnumType {
+	return &file_repair_proto_enumTypes[0]
+}
+
+func (x RepairStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RepairStatus.Descriptor instead.
+func (RepairStatus) EnumDescriptor() ([]byte, []int) {
+	return file_repair_proto_rawDescGZIP(), []int{0}
+}
+
+type RepairRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// fix lists IDs of the detected issues to repair, issues are only detected if it is empty
+	Fix []string `protobuf:"bytes,1,rep,name=fix,proto3" json:"fix,omitempty"`
+}
+
+func (x *RepairRequest) Reset() {
+	*x = RepairRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repair_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairRequest) ProtoMessage() {}
+
+func (x *RepairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_repair_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairRequest.ProtoReflect.Descriptor instead.
+func (*RepairRequest) Descriptor() ([]byte, []int) {
+	return file_repair_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RepairRequest) GetFix() []string {
+	if x != nil {
+		return x.Fix
+	}
+	return nil
+}
+
+// RepairIssue is a failure state which breaks connectivity
+type RepairIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Status      RepairStatus `protobuf:"varint,3,opt,name=status,proto3,enum=pb.RepairStatus" json:"status,omitempty"`
+	Error       string       `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *RepairIssue) Reset() {
+	*x = RepairIssue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repair_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepairIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairIssue) ProtoMessage() {}
+
+func (x *RepairIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_repair_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairIssue.ProtoReflect.Descriptor instead.
+func (*RepairIssue) Descriptor() ([]byte, []int) {
+	return file_repair_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RepairIssue) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RepairIssue) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RepairIssue) GetStatus() RepairStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RepairStatus_DETECTED
+}
+
+func (x *RepairIssue) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type RepairResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Issues []*RepairIssue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+}
+
+func (x *RepairResponse) Reset() {
+	*x = RepairResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_repair_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepairResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairResponse) ProtoMessage() {}
+
+func (x *RepairResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_repair_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairResponse.ProtoReflect.Descriptor instead.
+func (*RepairResponse) Descriptor() ([]byte, []int) {
+	return file_repair_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RepairResponse) GetIssues() []*RepairIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+var File_repair_proto protoreflect.FileDescriptor
+
+var file_repair_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02,
+	0x70, 0x62, 0x22, 0x21, 0x0a, 0x0d, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x03, 0x66, 0x69, 0x78, 0x22, 0x7f, 0x0a, 0x0b, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x39, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x73, 0x2a, 0x3d, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0c, 0x0a, 0x08, 0x52, 0x45, 0x50, 0x41, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a,
+	0x0d, 0x52, 0x45, 0x50, 0x41, 0x49, 0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02,
+	0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e,
+	0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64,
+	0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_repair_proto_rawDescOnce sync.Once
+	file_repair_proto_rawDescData = file_repair_proto_rawDesc
+)
+
+func file_repair_proto_rawDescGZIP() []byte {
+	file_repair_proto_rawDescOnce.Do(func() {
+		file_repair_proto_rawDescData = protoimpl.X.CompressGZIP(file_repair_proto_rawDescData)
+	})
+	return file_repair_proto_rawDescData
+}
+
+var file_repair_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_repair_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_repair_proto_goTypes = []interface{}{
+	(RepairStatus)(0),      // 0: pb.RepairStatus
+	(*RepairRequest)(nil),  // 1: pb.RepairRequest
+	(*RepairIssue)(nil),    // 2: pb.RepairIssue
+	(*RepairResponse)(nil), // 3: pb.RepairResponse
+}
+var file_repair_proto_depIdxs = []int32{
+	0, // 0: pb.RepairIssue.status:type_name -> pb.RepairStatus
+	2, // 1: pb.RepairResponse.issues:type_name -> pb.RepairIssue
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_repair_proto_init() }
+func file_repair_proto_init() {
+	if File_repair_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_repair_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repair_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairIssue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_repair_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_repair_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_repair_proto_goTypes,
+		DependencyIndexes: file_repair_proto_depIdxs,
+		EnumInfos:         file_repair_proto_enumTypes,
+		MessageInfos:      file_repair_proto_msgTypes,
+	}.Build()
+	File_repair_proto = out.File
+	file_repair_proto_rawDesc = nil
+	file_repair_proto_goTypes = nil
+	file_repair_proto_depIdxs = nil
+}
@@ -79,6 +79,7 @@ const (
 	Technology_OPENVPN_UDP            Technology = 3
 	Technology_OBFUSCATED_OPENVPN_TCP Technology = 4
 	Technology_OBFUSCATED_OPENVPN_UDP Technology = 5
+	Technology_NORDWHISPER            Technology = 6
 )
 
 // Enum value maps for Technology.
@@ -90,6 +91,7 @@ var (
 		3: "OPENVPN_UDP",
 		4: "OBFUSCATED_OPENVPN_TCP",
 		5: "OBFUSCATED_OPENVPN_UDP",
+		6: "NORDWHISPER",
 	}
 	Technology_value = map[string]int32{
 		"UNKNOWN_TECHNLOGY":      0,
@@ -98,6 +100,7 @@ var (
 		"OPENVPN_UDP":            3,
 		"OBFUSCATED_OPENVPN_TCP": 4,
 		"OBFUSCATED_OPENVPN_UDP": 5,
+		"NORDWHISPER":            6,
 	}
 )
 
@@ -445,6 +448,313 @@ func (*ServersResponse_Servers) isServersResponse_Response() {}
 
 func (*ServersResponse_Error) isServersResponse_Response() {}
 
+type SearchServersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// country name or code, all countries are searched when empty
+	Country string             `protobuf:"bytes,1,opt,name=country,proto3" json:"country,omitempty"`
+	Group   config.ServerGroup `protobuf:"varint,2,opt,name=group,proto3,enum=config.ServerGroup" json:"group,omitempty"`
+	// technologies which must be supported by the server
+	Features []Technology `protobuf:"varint,3,rep,packed,name=features,proto3,enum=pb.Technology" json:"features,omitempty"`
+	// maximum load in percent, load is not limited when 0
+	MaxLoad int64 `protobuf:"varint,4,opt,name=max_load,json=maxLoad,proto3" json:"max_load,omitempty"`
+	Offset  int64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// number of servers in the page, default page size is used when 0
+	Limit int64 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SearchServersRequest) Reset() {
+	*x = SearchServersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_servers_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchServersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchServersRequest) ProtoMessage() {}
+
+func (x *SearchServersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchServersRequest.ProtoReflect.Descriptor instead.
+func (*SearchServersRequest) Descriptor() ([]byte, []int) {
+	return file_servers_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchServersRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *SearchServersRequest) GetGroup() config.ServerGroup {
+	if x != nil {
+		return x.Group
+	}
+	return config.ServerGroup(0)
+}
+
+func (x *SearchServersRequest) GetFeatures() []Technology {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *SearchServersRequest) GetMaxLoad() int64 {
+	if x != nil {
+		return x.MaxLoad
+	}
+	return 0
+}
+
+func (x *SearchServersRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchServersRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ServerDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Server      *Server `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	CountryCode string  `protobuf:"bytes,2,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	Country     string  `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	City        string  `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	Load        int64   `protobuf:"varint,5,opt,name=load,proto3" json:"load,omitempty"`
+}
+
+func (x *ServerDetails) Reset() {
+	*x = ServerDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_servers_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerDetails) ProtoMessage() {}
+
+func (x *ServerDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerDetails.ProtoReflect.Descriptor instead.
+func (*ServerDetails) Descriptor() ([]byte, []int) {
+	return file_servers_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ServerDetails) GetServer() *Server {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *ServerDetails) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *ServerDetails) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ServerDetails) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ServerDetails) GetLoad() int64 {
+	if x != nil {
+		return x.Load
+	}
+	return 0
+}
+
+type ServersPage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Servers []*ServerDetails `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	// number of servers matching the filters
+	Total int64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *ServersPage) Reset() {
+	*x = ServersPage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_servers_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServersPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServersPage) ProtoMessage() {}
+
+func (x *ServersPage) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServersPage.ProtoReflect.Descriptor instead.
+func (*ServersPage) Descriptor() ([]byte, []int) {
+	return file_servers_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ServersPage) GetServers() []*ServerDetails {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *ServersPage) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SearchServersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*SearchServersResponse_Page
+	//	*SearchServersResponse_Error
+	Response isSearchServersResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *SearchServersResponse) Reset() {
+	*x = SearchServersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_servers_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchServersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchServersResponse) ProtoMessage() {}
+
+func (x *SearchServersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_servers_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchServersResponse.ProtoReflect.Descriptor instead.
+func (*SearchServersResponse) Descriptor() ([]byte, []int) {
+	return file_servers_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *SearchServersResponse) GetResponse() isSearchServersResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *SearchServersResponse) GetPage() *ServersPage {
+	if x, ok := x.GetResponse().(*SearchServersResponse_Page); ok {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *SearchServersResponse) GetError() ServersError {
+	if x, ok := x.GetResponse().(*SearchServersResponse_Error); ok {
+		return x.Error
+	}
+	return ServersError_NO_ERROR
+}
+
+type isSearchServersResponse_Response interface {
+	isSearchServersResponse_Response()
+}
+
+type SearchServersResponse_Page struct {
+	Page *ServersPage `protobuf:"bytes,1,opt,name=page,proto3,oneof"`
+}
+
+type SearchServersResponse_Error struct {
+	Error ServersError `protobuf:"varint,2,opt,name=error,proto3,enum=pb.ServersError,oneof"`
+}
+
+func (*SearchServersResponse_Page) isSearchServersResponse_Response() {}
+
+func (*SearchServersResponse_Error) isSearchServersResponse_Response() {}
+
 var File_servers_proto protoreflect.FileDescriptor
 
 var file_servers_proto_rawDesc = []byte{
@@ -485,24 +795,61 @@ var file_servers_proto_rawDesc = []byte{
 	0x72, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x45, 0x72,
 	0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x4c, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x5f, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x45, 0x54, 0x5f, 0x43, 0x4f,
-	0x4e, 0x46, 0x49, 0x47, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
-	0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x53, 0x5f, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x2a, 0x8b, 0x01, 0x0a, 0x0a, 0x54, 0x65, 0x63, 0x68, 0x6e,
-	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x15, 0x0a, 0x11, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
-	0x5f, 0x54, 0x45, 0x43, 0x48, 0x4e, 0x4c, 0x4f, 0x47, 0x59, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
-	0x4e, 0x4f, 0x52, 0x44, 0x4c, 0x59, 0x4e, 0x58, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x50,
-	0x45, 0x4e, 0x56, 0x50, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4f,
-	0x50, 0x45, 0x4e, 0x56, 0x50, 0x4e, 0x5f, 0x55, 0x44, 0x50, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16,
-	0x4f, 0x42, 0x46, 0x55, 0x53, 0x43, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x56,
-	0x50, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x42, 0x46, 0x55,
-	0x53, 0x43, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x56, 0x50, 0x4e, 0x5f, 0x55,
-	0x44, 0x50, 0x10, 0x05, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f,
-	0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61,
-	0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x65,
+	0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x0d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x22, 0x0a,
+	0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69,
+	0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x50, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x50, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x74, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x50, 0x61, 0x67, 0x65,
+	0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x4c,
+	0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x0c,
+	0x0a, 0x08, 0x4e, 0x4f, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10,
+	0x47, 0x45, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x52,
+	0x56, 0x45, 0x52, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x2a, 0x9c, 0x01, 0x0a,
+	0x0a, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x15, 0x0a, 0x11, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x45, 0x43, 0x48, 0x4e, 0x4c, 0x4f, 0x47, 0x59,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x52, 0x44, 0x4c, 0x59, 0x4e, 0x58, 0x10, 0x01,
+	0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x50, 0x45, 0x4e, 0x56, 0x50, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x10,
+	0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x50, 0x45, 0x4e, 0x56, 0x50, 0x4e, 0x5f, 0x55, 0x44, 0x50,
+	0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x42, 0x46, 0x55, 0x53, 0x43, 0x41, 0x54, 0x45, 0x44,
+	0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x56, 0x50, 0x4e, 0x5f, 0x54, 0x43, 0x50, 0x10, 0x04, 0x12, 0x1a,
+	0x0a, 0x16, 0x4f, 0x42, 0x46, 0x55, 0x53, 0x43, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x4f, 0x50, 0x45,
+	0x4e, 0x56, 0x50, 0x4e, 0x5f, 0x55, 0x44, 0x50, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f,
+	0x52, 0x44, 0x57, 0x48, 0x49, 0x53, 0x50, 0x45, 0x52, 0x10, 0x06, 0x42, 0x31, 0x5a, 0x2f, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c,
+	0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -518,30 +865,40 @@ func file_servers_proto_rawDescGZIP() []byte {
 }
 
 var file_servers_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_servers_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_servers_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_servers_proto_goTypes = []interface{}{
-	(ServersError)(0),       // 0: pb.ServersError
-	(Technology)(0),         // 1: pb.Technology
-	(*Server)(nil),          // 2: pb.Server
-	(*ServerCity)(nil),      // 3: pb.ServerCity
-	(*ServerCountry)(nil),   // 4: pb.ServerCountry
-	(*ServersMap)(nil),      // 5: pb.ServersMap
-	(*ServersResponse)(nil), // 6: pb.ServersResponse
-	(config.ServerGroup)(0), // 7: config.ServerGroup
+	(ServersError)(0),             // 0: pb.ServersError
+	(Technology)(0),               // 1: pb.Technology
+	(*Server)(nil),                // 2: pb.Server
+	(*ServerCity)(nil),            // 3: pb.ServerCity
+	(*ServerCountry)(nil),         // 4: pb.ServerCountry
+	(*ServersMap)(nil),            // 5: pb.ServersMap
+	(*ServersResponse)(nil),       // 6: pb.ServersResponse
+	(*SearchServersRequest)(nil),  // 7: pb.SearchServersRequest
+	(*ServerDetails)(nil),         // 8: pb.ServerDetails
+	(*ServersPage)(nil),           // 9: pb.ServersPage
+	(*SearchServersResponse)(nil), // 10: pb.SearchServersResponse
+	(config.ServerGroup)(0),       // 11: config.ServerGroup
 }
 var file_servers_proto_depIdxs = []int32{
-	7, // 0: pb.Server.server_groups:type_name -> config.ServerGroup
-	1, // 1: pb.Server.technologies:type_name -> pb.Technology
-	2, // 2: pb.ServerCity.servers:type_name -> pb.Server
-	3, // 3: pb.ServerCountry.cities:type_name -> pb.ServerCity
-	4, // 4: pb.ServersMap.servers_by_country:type_name -> pb.ServerCountry
-	5, // 5: pb.ServersResponse.servers:type_name -> pb.ServersMap
-	0, // 6: pb.ServersResponse.error:type_name -> pb.ServersError
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	11, // 0: pb.Server.server_groups:type_name -> config.ServerGroup
+	1,  // 1: pb.Server.technologies:type_name -> pb.Technology
+	2,  // 2: pb.ServerCity.servers:type_name -> pb.Server
+	3,  // 3: pb.ServerCountry.cities:type_name -> pb.ServerCity
+	4,  // 4: pb.ServersMap.servers_by_country:type_name -> pb.ServerCountry
+	5,  // 5: pb.ServersResponse.servers:type_name -> pb.ServersMap
+	0,  // 6: pb.ServersResponse.error:type_name -> pb.ServersError
+	11, // 7: pb.SearchServersRequest.group:type_name -> config.ServerGroup
+	1,  // 8: pb.SearchServersRequest.features:type_name -> pb.Technology
+	2,  // 9: pb.ServerDetails.server:type_name -> pb.Server
+	8,  // 10: pb.ServersPage.servers:type_name -> pb.ServerDetails
+	9,  // 11: pb.SearchServersResponse.page:type_name -> pb.ServersPage
+	0,  // 12: pb.SearchServersResponse.error:type_name -> pb.ServersError
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_servers_proto_init() }
@@ -610,18 +967,70 @@ func file_servers_proto_init() {
 				return nil
 			}
 		}
+		file_servers_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchServersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_servers_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_servers_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServersPage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_servers_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchServersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_servers_proto_msgTypes[4].OneofWrappers = []interface{}{
 		(*ServersResponse_Servers)(nil),
 		(*ServersResponse_Error)(nil),
 	}
+	file_servers_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*SearchServersResponse_Page)(nil),
+		(*SearchServersResponse_Error)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_servers_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   5,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
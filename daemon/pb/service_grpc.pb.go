@@ -27,8 +27,12 @@ type DaemonClient interface {
 	Cities(ctx context.Context, in *CitiesRequest, opts ...grpc.CallOption) (*ServerGroupsList, error)
 	Connect(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (Daemon_ConnectClient, error)
 	ConnectCancel(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error)
+	ConnectDryRun(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*ConnectPlan, error)
+	ConnectDedicatedIP(ctx context.Context, in *ConnectDedicatedIPRequest, opts ...grpc.CallOption) (Daemon_ConnectDedicatedIPClient, error)
+	DedicatedIPServers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DedicatedIPServersResponse, error)
 	Countries(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServerGroupsList, error)
 	Disconnect(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_DisconnectClient, error)
+	Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*Payload, error)
 	Groups(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServerGroupsList, error)
 	IsLoggedIn(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Bool, error)
 	LoginWithToken(ctx context.Context, in *LoginWithTokenRequest, opts ...grpc.CallOption) (*LoginResponse, error)
@@ -45,28 +49,85 @@ type DaemonClient interface {
 	SetDNS(ctx context.Context, in *SetDNSRequest, opts ...grpc.CallOption) (*SetDNSResponse, error)
 	SetFirewall(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetFirewallMark(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error)
+	SetRoutingTable(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error)
+	SetRouteMetric(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error)
+	SetMeshnetPeerRoutes(ctx context.Context, in *SetMeshnetPeerRoutesRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetRouting(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetAnalytics(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetAnalyticsCategory(ctx context.Context, in *SetAnalyticsCategoryRequest, opts ...grpc.CallOption) (*Payload, error)
+	AnalyticsEvents(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AnalyticsEventsResponse, error)
 	SetKillSwitch(ctx context.Context, in *SetKillSwitchRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetNotify(ctx context.Context, in *SetNotifyRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetTray(ctx context.Context, in *SetTrayRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetTrayIconTheme(ctx context.Context, in *SetTrayIconThemeRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetTrayHotkey(ctx context.Context, in *SetTrayHotkeyRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetTrayMinimal(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetDeferOnMetered(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetObfuscationFallback(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetTrayMenu(ctx context.Context, in *SetTrayMenuRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetDaemonLogLevel(ctx context.Context, in *SetDaemonLogLevelRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetTrustedNetwork(ctx context.Context, in *SetTrustedNetworkRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetTrustedNetworkAction(ctx context.Context, in *SetTrustedNetworkActionRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetSplitTunnelApp(ctx context.Context, in *SetSplitTunnelAppRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetSplitTunnelMode(ctx context.Context, in *SetSplitTunnelModeRequest, opts ...grpc.CallOption) (*Payload, error)
+	SplitTunnelApps(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SplitTunnel, error)
+	AddFavorite(ctx context.Context, in *Favorite, opts ...grpc.CallOption) (*Payload, error)
+	RemoveFavorite(ctx context.Context, in *RemoveFavoriteRequest, opts ...grpc.CallOption) (*Payload, error)
+	Favorites(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FavoritesList, error)
+	CreateProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*Payload, error)
+	SwitchProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*Payload, error)
+	DeleteProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*Payload, error)
+	Profiles(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ProfilesList, error)
+	AddHook(ctx context.Context, in *Hook, opts ...grpc.CallOption) (*Payload, error)
+	RemoveHook(ctx context.Context, in *Hook, opts ...grpc.CallOption) (*Payload, error)
+	Hooks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HooksList, error)
+	AddScheduleRule(ctx context.Context, in *ScheduleRule, opts ...grpc.CallOption) (*Payload, error)
+	RemoveScheduleRule(ctx context.Context, in *RemoveScheduleRuleRequest, opts ...grpc.CallOption) (*Payload, error)
+	ScheduleRules(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ScheduleRulesList, error)
 	SetObfuscate(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetProtocol(ctx context.Context, in *SetProtocolRequest, opts ...grpc.CallOption) (*SetProtocolResponse, error)
+	SetMTU(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error)
+	SetOpenVPNPort(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error)
+	SetOpenVPNDirectives(ctx context.Context, in *SetOpenVPNDirectivesRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetInterfaceName(ctx context.Context, in *SetStringRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetPersistentKeepalive(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error)
+	SetHealthMonitor(ctx context.Context, in *SetStringRequest, opts ...grpc.CallOption) (*Payload, error)
+	SetMaxServerLoad(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error)
+	SetFileshareFileLimit(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error)
+	SetConnectRetry(ctx context.Context, in *ConnectRetry, opts ...grpc.CallOption) (*Payload, error)
+	SetConnectTimeouts(ctx context.Context, in *ConnectTimeouts, opts ...grpc.CallOption) (*Payload, error)
+	SetStealth(ctx context.Context, in *Stealth, opts ...grpc.CallOption) (*Payload, error)
 	SetTechnology(ctx context.Context, in *SetTechnologyRequest, opts ...grpc.CallOption) (*Payload, error)
 	SetLANDiscovery(ctx context.Context, in *SetLANDiscoveryRequest, opts ...grpc.CallOption) (*SetLANDiscoveryResponse, error)
 	SetAllowlist(ctx context.Context, in *SetAllowlistRequest, opts ...grpc.CallOption) (*Payload, error)
 	UnsetAllowlist(ctx context.Context, in *SetAllowlistRequest, opts ...grpc.CallOption) (*Payload, error)
 	UnsetAllAllowlist(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error)
 	Settings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SettingsResponse, error)
+	ExportSettings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error)
+	ImportSettings(ctx context.Context, in *ImportSettingsRequest, opts ...grpc.CallOption) (*Payload, error)
 	SettingsProtocols(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error)
 	SettingsTechnologies(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error)
 	Status(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StatusResponse, error)
+	StatusStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_StatusStreamClient, error)
+	StatusVerbose(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StatusVerboseResponse, error)
+	Statistics(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StatisticsResponse, error)
+	Benchmarks(ctx context.Context, in *BenchmarksRequest, opts ...grpc.CallOption) (*BenchmarksResponse, error)
+	ServicesStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServicesStatusResponse, error)
 	SetIpv6(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
 	ClaimOnlinePurchase(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ClaimOnlinePurchaseResponse, error)
 	SetVirtualLocation(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
 	SubscribeToStateChanges(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_SubscribeToStateChangesClient, error)
+	SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (Daemon_SubscribeEventsClient, error)
 	GetServers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServersResponse, error)
+	SearchServers(ctx context.Context, in *SearchServersRequest, opts ...grpc.CallOption) (*SearchServersResponse, error)
 	SetPostQuantum(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error)
+	PendingActions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PendingActionsResponse, error)
+	ConnectionHistory(ctx context.Context, in *ConnectionHistoryRequest, opts ...grpc.CallOption) (*ConnectionHistoryResponse, error)
+	CancelPendingAction(ctx context.Context, in *CancelPendingActionRequest, opts ...grpc.CallOption) (*Payload, error)
+	Repair(ctx context.Context, in *RepairRequest, opts ...grpc.CallOption) (*RepairResponse, error)
+	Preflight(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PreflightResponse, error)
+	BandwidthUsage(ctx context.Context, in *BandwidthUsageRequest, opts ...grpc.CallOption) (*BandwidthUsageResponse, error)
 }
 
 type daemonClient struct {
@@ -145,6 +206,56 @@ func (c *daemonClient) ConnectCancel(ctx context.Context, in *Empty, opts ...grp
 	return out, nil
 }
 
+func (c *daemonClient) ConnectDryRun(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*ConnectPlan, error) {
+	out := new(ConnectPlan)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/ConnectDryRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ConnectDedicatedIP(ctx context.Context, in *ConnectDedicatedIPRequest, opts ...grpc.CallOption) (Daemon_ConnectDedicatedIPClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[1], "/pb.Daemon/ConnectDedicatedIP", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonConnectDedicatedIPClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_ConnectDedicatedIPClient interface {
+	Recv() (*Payload, error)
+	grpc.ClientStream
+}
+
+type daemonConnectDedicatedIPClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonConnectDedicatedIPClient) Recv() (*Payload, error) {
+	m := new(Payload)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) DedicatedIPServers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DedicatedIPServersResponse, error) {
+	out := new(DedicatedIPServersResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/DedicatedIPServers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) Countries(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServerGroupsList, error) {
 	out := new(ServerGroupsList)
 	err := c.cc.Invoke(ctx, "/pb.Daemon/Countries", in, out, opts...)
@@ -155,7 +266,7 @@ func (c *daemonClient) Countries(ctx context.Context, in *Empty, opts ...grpc.Ca
 }
 
 func (c *daemonClient) Disconnect(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_DisconnectClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[1], "/pb.Daemon/Disconnect", opts...)
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[2], "/pb.Daemon/Disconnect", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -186,6 +297,15 @@ func (x *daemonDisconnectClient) Recv() (*Payload, error) {
 	return m, nil
 }
 
+func (c *daemonClient) Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Pause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) Groups(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServerGroupsList, error) {
 	out := new(ServerGroupsList)
 	err := c.cc.Invoke(ctx, "/pb.Daemon/Groups", in, out, opts...)
@@ -214,7 +334,7 @@ func (c *daemonClient) LoginWithToken(ctx context.Context, in *LoginWithTokenReq
 }
 
 func (c *daemonClient) LoginOAuth2(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_LoginOAuth2Client, error) {
-	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[2], "/pb.Daemon/LoginOAuth2", opts...)
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[3], "/pb.Daemon/LoginOAuth2", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -353,6 +473,33 @@ func (c *daemonClient) SetFirewallMark(ctx context.Context, in *SetUint32Request
 	return out, nil
 }
 
+func (c *daemonClient) SetRoutingTable(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetRoutingTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetRouteMetric(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetRouteMetric", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetMeshnetPeerRoutes(ctx context.Context, in *SetMeshnetPeerRoutesRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetMeshnetPeerRoutes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) SetRouting(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
 	err := c.cc.Invoke(ctx, "/pb.Daemon/SetRouting", in, out, opts...)
@@ -371,6 +518,24 @@ func (c *daemonClient) SetAnalytics(ctx context.Context, in *SetGenericRequest,
 	return out, nil
 }
 
+func (c *daemonClient) SetAnalyticsCategory(ctx context.Context, in *SetAnalyticsCategoryRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetAnalyticsCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) AnalyticsEvents(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AnalyticsEventsResponse, error) {
+	out := new(AnalyticsEventsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/AnalyticsEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *daemonClient) SetKillSwitch(ctx context.Context, in *SetKillSwitchRequest, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
 	err := c.cc.Invoke(ctx, "/pb.Daemon/SetKillSwitch", in, out, opts...)
@@ -398,1093 +563,2811 @@ func (c *daemonClient) SetTray(ctx context.Context, in *SetTrayRequest, opts ...
 	return out, nil
 }
 
-func (c *daemonClient) SetObfuscate(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+func (c *daemonClient) SetTrayIconTheme(ctx context.Context, in *SetTrayIconThemeRequest, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
-	err := c.cc.Invoke(ctx, "/pb.Daemon/SetObfuscate", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetTrayIconTheme", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daemonClient) SetProtocol(ctx context.Context, in *SetProtocolRequest, opts ...grpc.CallOption) (*SetProtocolResponse, error) {
-	out := new(SetProtocolResponse)
-	err := c.cc.Invoke(ctx, "/pb.Daemon/SetProtocol", in, out, opts...)
+func (c *daemonClient) SetTrayHotkey(ctx context.Context, in *SetTrayHotkeyRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetTrayHotkey", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daemonClient) SetTechnology(ctx context.Context, in *SetTechnologyRequest, opts ...grpc.CallOption) (*Payload, error) {
+func (c *daemonClient) SetTrayMinimal(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
-	err := c.cc.Invoke(ctx, "/pb.Daemon/SetTechnology", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetTrayMinimal", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daemonClient) SetLANDiscovery(ctx context.Context, in *SetLANDiscoveryRequest, opts ...grpc.CallOption) (*SetLANDiscoveryResponse, error) {
-	out := new(SetLANDiscoveryResponse)
-	err := c.cc.Invoke(ctx, "/pb.Daemon/SetLANDiscovery", in, out, opts...)
+func (c *daemonClient) SetDeferOnMetered(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetDeferOnMetered", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daemonClient) SetAllowlist(ctx context.Context, in *SetAllowlistRequest, opts ...grpc.CallOption) (*Payload, error) {
+func (c *daemonClient) SetObfuscationFallback(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
-	err := c.cc.Invoke(ctx, "/pb.Daemon/SetAllowlist", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetObfuscationFallback", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daemonClient) UnsetAllowlist(ctx context.Context, in *SetAllowlistRequest, opts ...grpc.CallOption) (*Payload, error) {
+func (c *daemonClient) SetTrayMenu(ctx context.Context, in *SetTrayMenuRequest, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
-	err := c.cc.Invoke(ctx, "/pb.Daemon/UnsetAllowlist", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetTrayMenu", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daemonClient) UnsetAllAllowlist(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error) {
+func (c *daemonClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
-	err := c.cc.Invoke(ctx, "/pb.Daemon/UnsetAllAllowlist", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetLogLevel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daemonClient) Settings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SettingsResponse, error) {
-	out := new(SettingsResponse)
-	err := c.cc.Invoke(ctx, "/pb.Daemon/Settings", in, out, opts...)
+func (c *daemonClient) SetDaemonLogLevel(ctx context.Context, in *SetDaemonLogLevelRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetDaemonLogLevel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daemonClient) SettingsProtocols(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error) {
+func (c *daemonClient) SetTrustedNetwork(ctx context.Context, in *SetTrustedNetworkRequest, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
-	err := c.cc.Invoke(ctx, "/pb.Daemon/SettingsProtocols", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetTrustedNetwork", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daemonClient) SettingsTechnologies(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error) {
+func (c *daemonClient) SetTrustedNetworkAction(ctx context.Context, in *SetTrustedNetworkActionRequest, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
-	err := c.cc.Invoke(ctx, "/pb.Daemon/SettingsTechnologies", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetTrustedNetworkAction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daemonClient) Status(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StatusResponse, error) {
-	out := new(StatusResponse)
-	err := c.cc.Invoke(ctx, "/pb.Daemon/Status", in, out, opts...)
+func (c *daemonClient) SetSplitTunnelApp(ctx context.Context, in *SetSplitTunnelAppRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetSplitTunnelApp", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daemonClient) SetIpv6(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+func (c *daemonClient) SetSplitTunnelMode(ctx context.Context, in *SetSplitTunnelModeRequest, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
-	err := c.cc.Invoke(ctx, "/pb.Daemon/SetIpv6", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetSplitTunnelMode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daemonClient) ClaimOnlinePurchase(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ClaimOnlinePurchaseResponse, error) {
-	out := new(ClaimOnlinePurchaseResponse)
-	err := c.cc.Invoke(ctx, "/pb.Daemon/ClaimOnlinePurchase", in, out, opts...)
+func (c *daemonClient) SplitTunnelApps(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SplitTunnel, error) {
+	out := new(SplitTunnel)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SplitTunnelApps", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daemonClient) SetVirtualLocation(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+func (c *daemonClient) AddFavorite(ctx context.Context, in *Favorite, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
-	err := c.cc.Invoke(ctx, "/pb.Daemon/SetVirtualLocation", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/AddFavorite", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daemonClient) SubscribeToStateChanges(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_SubscribeToStateChangesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[3], "/pb.Daemon/SubscribeToStateChanges", opts...)
+func (c *daemonClient) RemoveFavorite(ctx context.Context, in *RemoveFavoriteRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/RemoveFavorite", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &daemonSubscribeToStateChangesClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
+	return out, nil
+}
+
+func (c *daemonClient) Favorites(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*FavoritesList, error) {
+	out := new(FavoritesList)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Favorites", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *daemonClient) CreateProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/CreateProfile", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return x, nil
+	return out, nil
 }
 
-type Daemon_SubscribeToStateChangesClient interface {
-	Recv() (*AppState, error)
-	grpc.ClientStream
+func (c *daemonClient) SwitchProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SwitchProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type daemonSubscribeToStateChangesClient struct {
-	grpc.ClientStream
+func (c *daemonClient) DeleteProfile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/DeleteProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *daemonSubscribeToStateChangesClient) Recv() (*AppState, error) {
-	m := new(AppState)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *daemonClient) Profiles(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ProfilesList, error) {
+	out := new(ProfilesList)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Profiles", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *daemonClient) GetServers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServersResponse, error) {
-	out := new(ServersResponse)
-	err := c.cc.Invoke(ctx, "/pb.Daemon/GetServers", in, out, opts...)
+func (c *daemonClient) AddHook(ctx context.Context, in *Hook, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/AddHook", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *daemonClient) SetPostQuantum(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+func (c *daemonClient) RemoveHook(ctx context.Context, in *Hook, opts ...grpc.CallOption) (*Payload, error) {
 	out := new(Payload)
-	err := c.cc.Invoke(ctx, "/pb.Daemon/SetPostQuantum", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/RemoveHook", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// DaemonServer is the server API for Daemon service.
-// All implementations must embed UnimplementedDaemonServer
-// for forward compatibility
-type DaemonServer interface {
-	AccountInfo(context.Context, *Empty) (*AccountResponse, error)
-	TokenInfo(context.Context, *Empty) (*TokenInfoResponse, error)
-	Cities(context.Context, *CitiesRequest) (*ServerGroupsList, error)
-	Connect(*ConnectRequest, Daemon_ConnectServer) error
-	ConnectCancel(context.Context, *Empty) (*Payload, error)
-	Countries(context.Context, *Empty) (*ServerGroupsList, error)
-	Disconnect(*Empty, Daemon_DisconnectServer) error
-	Groups(context.Context, *Empty) (*ServerGroupsList, error)
-	IsLoggedIn(context.Context, *Empty) (*Bool, error)
-	LoginWithToken(context.Context, *LoginWithTokenRequest) (*LoginResponse, error)
-	LoginOAuth2(*Empty, Daemon_LoginOAuth2Server) error
-	LoginOAuth2Callback(context.Context, *String) (*Empty, error)
-	Logout(context.Context, *LogoutRequest) (*Payload, error)
-	Plans(context.Context, *Empty) (*PlansResponse, error)
-	Ping(context.Context, *Empty) (*PingResponse, error)
-	RateConnection(context.Context, *RateRequest) (*Payload, error)
-	Register(context.Context, *RegisterRequest) (*Payload, error)
-	SetAutoConnect(context.Context, *SetAutoconnectRequest) (*Payload, error)
-	SetThreatProtectionLite(context.Context, *SetThreatProtectionLiteRequest) (*SetThreatProtectionLiteResponse, error)
-	SetDefaults(context.Context, *Empty) (*Payload, error)
-	SetDNS(context.Context, *SetDNSRequest) (*SetDNSResponse, error)
-	SetFirewall(context.Context, *SetGenericRequest) (*Payload, error)
-	SetFirewallMark(context.Context, *SetUint32Request) (*Payload, error)
-	SetRouting(context.Context, *SetGenericRequest) (*Payload, error)
-	SetAnalytics(context.Context, *SetGenericRequest) (*Payload, error)
-	SetKillSwitch(context.Context, *SetKillSwitchRequest) (*Payload, error)
-	SetNotify(context.Context, *SetNotifyRequest) (*Payload, error)
-	SetTray(context.Context, *SetTrayRequest) (*Payload, error)
-	SetObfuscate(context.Context, *SetGenericRequest) (*Payload, error)
-	SetProtocol(context.Context, *SetProtocolRequest) (*SetProtocolResponse, error)
-	SetTechnology(context.Context, *SetTechnologyRequest) (*Payload, error)
-	SetLANDiscovery(context.Context, *SetLANDiscoveryRequest) (*SetLANDiscoveryResponse, error)
-	SetAllowlist(context.Context, *SetAllowlistRequest) (*Payload, error)
-	UnsetAllowlist(context.Context, *SetAllowlistRequest) (*Payload, error)
-	UnsetAllAllowlist(context.Context, *Empty) (*Payload, error)
-	Settings(context.Context, *Empty) (*SettingsResponse, error)
-	SettingsProtocols(context.Context, *Empty) (*Payload, error)
-	SettingsTechnologies(context.Context, *Empty) (*Payload, error)
-	Status(context.Context, *Empty) (*StatusResponse, error)
-	SetIpv6(context.Context, *SetGenericRequest) (*Payload, error)
-	ClaimOnlinePurchase(context.Context, *Empty) (*ClaimOnlinePurchaseResponse, error)
-	SetVirtualLocation(context.Context, *SetGenericRequest) (*Payload, error)
-	SubscribeToStateChanges(*Empty, Daemon_SubscribeToStateChangesServer) error
-	GetServers(context.Context, *Empty) (*ServersResponse, error)
-	SetPostQuantum(context.Context, *SetGenericRequest) (*Payload, error)
-	mustEmbedUnimplementedDaemonServer()
+func (c *daemonClient) Hooks(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HooksList, error) {
+	out := new(HooksList)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Hooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedDaemonServer must be embedded to have forward compatible implementations.
-type UnimplementedDaemonServer struct {
+func (c *daemonClient) AddScheduleRule(ctx context.Context, in *ScheduleRule, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/AddScheduleRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedDaemonServer) AccountInfo(context.Context, *Empty) (*AccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
+func (c *daemonClient) RemoveScheduleRule(ctx context.Context, in *RemoveScheduleRuleRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/RemoveScheduleRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) TokenInfo(context.Context, *Empty) (*TokenInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TokenInfo not implemented")
+
+func (c *daemonClient) ScheduleRules(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ScheduleRulesList, error) {
+	out := new(ScheduleRulesList)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/ScheduleRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) Cities(context.Context, *CitiesRequest) (*ServerGroupsList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Cities not implemented")
+
+func (c *daemonClient) SetObfuscate(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetObfuscate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) Connect(*ConnectRequest, Daemon_ConnectServer) error {
-	return status.Errorf(codes.Unimplemented, "method Connect not implemented")
+
+func (c *daemonClient) SetProtocol(ctx context.Context, in *SetProtocolRequest, opts ...grpc.CallOption) (*SetProtocolResponse, error) {
+	out := new(SetProtocolResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetProtocol", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) ConnectCancel(context.Context, *Empty) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConnectCancel not implemented")
+
+func (c *daemonClient) SetMTU(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetMTU", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) Countries(context.Context, *Empty) (*ServerGroupsList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Countries not implemented")
+
+func (c *daemonClient) SetOpenVPNPort(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetOpenVPNPort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) Disconnect(*Empty, Daemon_DisconnectServer) error {
-	return status.Errorf(codes.Unimplemented, "method Disconnect not implemented")
+
+func (c *daemonClient) SetOpenVPNDirectives(ctx context.Context, in *SetOpenVPNDirectivesRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetOpenVPNDirectives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) Groups(context.Context, *Empty) (*ServerGroupsList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
+
+func (c *daemonClient) SetInterfaceName(ctx context.Context, in *SetStringRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetInterfaceName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) IsLoggedIn(context.Context, *Empty) (*Bool, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IsLoggedIn not implemented")
+
+func (c *daemonClient) SetPersistentKeepalive(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetPersistentKeepalive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) LoginWithToken(context.Context, *LoginWithTokenRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginWithToken not implemented")
+
+func (c *daemonClient) SetHealthMonitor(ctx context.Context, in *SetStringRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetHealthMonitor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) LoginOAuth2(*Empty, Daemon_LoginOAuth2Server) error {
-	return status.Errorf(codes.Unimplemented, "method LoginOAuth2 not implemented")
+
+func (c *daemonClient) SetMaxServerLoad(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetMaxServerLoad", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) LoginOAuth2Callback(context.Context, *String) (*Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoginOAuth2Callback not implemented")
+
+func (c *daemonClient) SetFileshareFileLimit(ctx context.Context, in *SetUint32Request, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetFileshareFileLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) Logout(context.Context, *LogoutRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+
+func (c *daemonClient) SetConnectRetry(ctx context.Context, in *ConnectRetry, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetConnectRetry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) Plans(context.Context, *Empty) (*PlansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Plans not implemented")
+
+func (c *daemonClient) SetConnectTimeouts(ctx context.Context, in *ConnectTimeouts, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetConnectTimeouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) Ping(context.Context, *Empty) (*PingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+
+func (c *daemonClient) SetStealth(ctx context.Context, in *Stealth, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetStealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) RateConnection(context.Context, *RateRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RateConnection not implemented")
+
+func (c *daemonClient) SetTechnology(ctx context.Context, in *SetTechnologyRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetTechnology", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) Register(context.Context, *RegisterRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+
+func (c *daemonClient) SetLANDiscovery(ctx context.Context, in *SetLANDiscoveryRequest, opts ...grpc.CallOption) (*SetLANDiscoveryResponse, error) {
+	out := new(SetLANDiscoveryResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetLANDiscovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetAutoConnect(context.Context, *SetAutoconnectRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAutoConnect not implemented")
+
+func (c *daemonClient) SetAllowlist(ctx context.Context, in *SetAllowlistRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetThreatProtectionLite(context.Context, *SetThreatProtectionLiteRequest) (*SetThreatProtectionLiteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetThreatProtectionLite not implemented")
+
+func (c *daemonClient) UnsetAllowlist(ctx context.Context, in *SetAllowlistRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/UnsetAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetDefaults(context.Context, *Empty) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDefaults not implemented")
+
+func (c *daemonClient) UnsetAllAllowlist(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/UnsetAllAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetDNS(context.Context, *SetDNSRequest) (*SetDNSResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDNS not implemented")
+
+func (c *daemonClient) Settings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*SettingsResponse, error) {
+	out := new(SettingsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Settings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetFirewall(context.Context, *SetGenericRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetFirewall not implemented")
+
+func (c *daemonClient) ExportSettings(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/ExportSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetFirewallMark(context.Context, *SetUint32Request) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetFirewallMark not implemented")
+
+func (c *daemonClient) ImportSettings(ctx context.Context, in *ImportSettingsRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/ImportSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetRouting(context.Context, *SetGenericRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetRouting not implemented")
+
+func (c *daemonClient) SettingsProtocols(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SettingsProtocols", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetAnalytics(context.Context, *SetGenericRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAnalytics not implemented")
+
+func (c *daemonClient) SettingsTechnologies(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SettingsTechnologies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetKillSwitch(context.Context, *SetKillSwitchRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetKillSwitch not implemented")
+
+func (c *daemonClient) Status(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetNotify(context.Context, *SetNotifyRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetNotify not implemented")
+
+func (c *daemonClient) StatusStream(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_StatusStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[4], "/pb.Daemon/StatusStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonStatusStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedDaemonServer) SetTray(context.Context, *SetTrayRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetTray not implemented")
+
+type Daemon_StatusStreamClient interface {
+	Recv() (*StatusResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedDaemonServer) SetObfuscate(context.Context, *SetGenericRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetObfuscate not implemented")
+
+type daemonStatusStreamClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedDaemonServer) SetProtocol(context.Context, *SetProtocolRequest) (*SetProtocolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetProtocol not implemented")
+
+func (x *daemonStatusStreamClient) Recv() (*StatusResponse, error) {
+	m := new(StatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedDaemonServer) SetTechnology(context.Context, *SetTechnologyRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetTechnology not implemented")
+
+func (c *daemonClient) StatusVerbose(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StatusVerboseResponse, error) {
+	out := new(StatusVerboseResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/StatusVerbose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetLANDiscovery(context.Context, *SetLANDiscoveryRequest) (*SetLANDiscoveryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLANDiscovery not implemented")
+
+func (c *daemonClient) Statistics(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*StatisticsResponse, error) {
+	out := new(StatisticsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Statistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) SetAllowlist(context.Context, *SetAllowlistRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAllowlist not implemented")
+
+func (c *daemonClient) Benchmarks(ctx context.Context, in *BenchmarksRequest, opts ...grpc.CallOption) (*BenchmarksResponse, error) {
+	out := new(BenchmarksResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Benchmarks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDaemonServer) UnsetAllowlist(context.Context, *SetAllowlistRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsetAllowlist not implemented")
+
+func (c *daemonClient) ServicesStatus(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServicesStatusResponse, error) {
+	out := new(ServicesStatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/ServicesStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetIpv6(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetIpv6", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ClaimOnlinePurchase(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ClaimOnlinePurchaseResponse, error) {
+	out := new(ClaimOnlinePurchaseResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/ClaimOnlinePurchase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetVirtualLocation(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetVirtualLocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SubscribeToStateChanges(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Daemon_SubscribeToStateChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[5], "/pb.Daemon/SubscribeToStateChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonSubscribeToStateChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_SubscribeToStateChangesClient interface {
+	Recv() (*AppState, error)
+	grpc.ClientStream
+}
+
+type daemonSubscribeToStateChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonSubscribeToStateChangesClient) Recv() (*AppState, error) {
+	m := new(AppState)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (Daemon_SubscribeEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[6], "/pb.Daemon/SubscribeEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonSubscribeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_SubscribeEventsClient interface {
+	Recv() (*DaemonEvent, error)
+	grpc.ClientStream
+}
+
+type daemonSubscribeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonSubscribeEventsClient) Recv() (*DaemonEvent, error) {
+	m := new(DaemonEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *daemonClient) GetServers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ServersResponse, error) {
+	out := new(ServersResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/GetServers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SearchServers(ctx context.Context, in *SearchServersRequest, opts ...grpc.CallOption) (*SearchServersResponse, error) {
+	out := new(SearchServersResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SearchServers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetPostQuantum(ctx context.Context, in *SetGenericRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/SetPostQuantum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) PendingActions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PendingActionsResponse, error) {
+	out := new(PendingActionsResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/PendingActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ConnectionHistory(ctx context.Context, in *ConnectionHistoryRequest, opts ...grpc.CallOption) (*ConnectionHistoryResponse, error) {
+	out := new(ConnectionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/ConnectionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) CancelPendingAction(ctx context.Context, in *CancelPendingActionRequest, opts ...grpc.CallOption) (*Payload, error) {
+	out := new(Payload)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/CancelPendingAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) Repair(ctx context.Context, in *RepairRequest, opts ...grpc.CallOption) (*RepairResponse, error) {
+	out := new(RepairResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Repair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) Preflight(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PreflightResponse, error) {
+	out := new(PreflightResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/Preflight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) BandwidthUsage(ctx context.Context, in *BandwidthUsageRequest, opts ...grpc.CallOption) (*BandwidthUsageResponse, error) {
+	out := new(BandwidthUsageResponse)
+	err := c.cc.Invoke(ctx, "/pb.Daemon/BandwidthUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DaemonServer is the server API for Daemon service.
+// All implementations must embed UnimplementedDaemonServer
+// for forward compatibility
+type DaemonServer interface {
+	AccountInfo(context.Context, *Empty) (*AccountResponse, error)
+	TokenInfo(context.Context, *Empty) (*TokenInfoResponse, error)
+	Cities(context.Context, *CitiesRequest) (*ServerGroupsList, error)
+	Connect(*ConnectRequest, Daemon_ConnectServer) error
+	ConnectCancel(context.Context, *Empty) (*Payload, error)
+	ConnectDryRun(context.Context, *ConnectRequest) (*ConnectPlan, error)
+	ConnectDedicatedIP(*ConnectDedicatedIPRequest, Daemon_ConnectDedicatedIPServer) error
+	DedicatedIPServers(context.Context, *Empty) (*DedicatedIPServersResponse, error)
+	Countries(context.Context, *Empty) (*ServerGroupsList, error)
+	Disconnect(*Empty, Daemon_DisconnectServer) error
+	Pause(context.Context, *PauseRequest) (*Payload, error)
+	Groups(context.Context, *Empty) (*ServerGroupsList, error)
+	IsLoggedIn(context.Context, *Empty) (*Bool, error)
+	LoginWithToken(context.Context, *LoginWithTokenRequest) (*LoginResponse, error)
+	LoginOAuth2(*Empty, Daemon_LoginOAuth2Server) error
+	LoginOAuth2Callback(context.Context, *String) (*Empty, error)
+	Logout(context.Context, *LogoutRequest) (*Payload, error)
+	Plans(context.Context, *Empty) (*PlansResponse, error)
+	Ping(context.Context, *Empty) (*PingResponse, error)
+	RateConnection(context.Context, *RateRequest) (*Payload, error)
+	Register(context.Context, *RegisterRequest) (*Payload, error)
+	SetAutoConnect(context.Context, *SetAutoconnectRequest) (*Payload, error)
+	SetThreatProtectionLite(context.Context, *SetThreatProtectionLiteRequest) (*SetThreatProtectionLiteResponse, error)
+	SetDefaults(context.Context, *Empty) (*Payload, error)
+	SetDNS(context.Context, *SetDNSRequest) (*SetDNSResponse, error)
+	SetFirewall(context.Context, *SetGenericRequest) (*Payload, error)
+	SetFirewallMark(context.Context, *SetUint32Request) (*Payload, error)
+	SetRoutingTable(context.Context, *SetUint32Request) (*Payload, error)
+	SetRouteMetric(context.Context, *SetUint32Request) (*Payload, error)
+	SetMeshnetPeerRoutes(context.Context, *SetMeshnetPeerRoutesRequest) (*Payload, error)
+	SetRouting(context.Context, *SetGenericRequest) (*Payload, error)
+	SetAnalytics(context.Context, *SetGenericRequest) (*Payload, error)
+	SetAnalyticsCategory(context.Context, *SetAnalyticsCategoryRequest) (*Payload, error)
+	AnalyticsEvents(context.Context, *Empty) (*AnalyticsEventsResponse, error)
+	SetKillSwitch(context.Context, *SetKillSwitchRequest) (*Payload, error)
+	SetNotify(context.Context, *SetNotifyRequest) (*Payload, error)
+	SetTray(context.Context, *SetTrayRequest) (*Payload, error)
+	SetTrayIconTheme(context.Context, *SetTrayIconThemeRequest) (*Payload, error)
+	SetTrayHotkey(context.Context, *SetTrayHotkeyRequest) (*Payload, error)
+	SetTrayMinimal(context.Context, *SetGenericRequest) (*Payload, error)
+	SetDeferOnMetered(context.Context, *SetGenericRequest) (*Payload, error)
+	SetObfuscationFallback(context.Context, *SetGenericRequest) (*Payload, error)
+	SetTrayMenu(context.Context, *SetTrayMenuRequest) (*Payload, error)
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*Payload, error)
+	SetDaemonLogLevel(context.Context, *SetDaemonLogLevelRequest) (*Payload, error)
+	SetTrustedNetwork(context.Context, *SetTrustedNetworkRequest) (*Payload, error)
+	SetTrustedNetworkAction(context.Context, *SetTrustedNetworkActionRequest) (*Payload, error)
+	SetSplitTunnelApp(context.Context, *SetSplitTunnelAppRequest) (*Payload, error)
+	SetSplitTunnelMode(context.Context, *SetSplitTunnelModeRequest) (*Payload, error)
+	SplitTunnelApps(context.Context, *Empty) (*SplitTunnel, error)
+	AddFavorite(context.Context, *Favorite) (*Payload, error)
+	RemoveFavorite(context.Context, *RemoveFavoriteRequest) (*Payload, error)
+	Favorites(context.Context, *Empty) (*FavoritesList, error)
+	CreateProfile(context.Context, *ProfileRequest) (*Payload, error)
+	SwitchProfile(context.Context, *ProfileRequest) (*Payload, error)
+	DeleteProfile(context.Context, *ProfileRequest) (*Payload, error)
+	Profiles(context.Context, *Empty) (*ProfilesList, error)
+	AddHook(context.Context, *Hook) (*Payload, error)
+	RemoveHook(context.Context, *Hook) (*Payload, error)
+	Hooks(context.Context, *Empty) (*HooksList, error)
+	AddScheduleRule(context.Context, *ScheduleRule) (*Payload, error)
+	RemoveScheduleRule(context.Context, *RemoveScheduleRuleRequest) (*Payload, error)
+	ScheduleRules(context.Context, *Empty) (*ScheduleRulesList, error)
+	SetObfuscate(context.Context, *SetGenericRequest) (*Payload, error)
+	SetProtocol(context.Context, *SetProtocolRequest) (*SetProtocolResponse, error)
+	SetMTU(context.Context, *SetUint32Request) (*Payload, error)
+	SetOpenVPNPort(context.Context, *SetUint32Request) (*Payload, error)
+	SetOpenVPNDirectives(context.Context, *SetOpenVPNDirectivesRequest) (*Payload, error)
+	SetInterfaceName(context.Context, *SetStringRequest) (*Payload, error)
+	SetPersistentKeepalive(context.Context, *SetUint32Request) (*Payload, error)
+	SetHealthMonitor(context.Context, *SetStringRequest) (*Payload, error)
+	SetMaxServerLoad(context.Context, *SetUint32Request) (*Payload, error)
+	SetFileshareFileLimit(context.Context, *SetUint32Request) (*Payload, error)
+	SetConnectRetry(context.Context, *ConnectRetry) (*Payload, error)
+	SetConnectTimeouts(context.Context, *ConnectTimeouts) (*Payload, error)
+	SetStealth(context.Context, *Stealth) (*Payload, error)
+	SetTechnology(context.Context, *SetTechnologyRequest) (*Payload, error)
+	SetLANDiscovery(context.Context, *SetLANDiscoveryRequest) (*SetLANDiscoveryResponse, error)
+	SetAllowlist(context.Context, *SetAllowlistRequest) (*Payload, error)
+	UnsetAllowlist(context.Context, *SetAllowlistRequest) (*Payload, error)
+	UnsetAllAllowlist(context.Context, *Empty) (*Payload, error)
+	Settings(context.Context, *Empty) (*SettingsResponse, error)
+	ExportSettings(context.Context, *Empty) (*Payload, error)
+	ImportSettings(context.Context, *ImportSettingsRequest) (*Payload, error)
+	SettingsProtocols(context.Context, *Empty) (*Payload, error)
+	SettingsTechnologies(context.Context, *Empty) (*Payload, error)
+	Status(context.Context, *Empty) (*StatusResponse, error)
+	StatusStream(*Empty, Daemon_StatusStreamServer) error
+	StatusVerbose(context.Context, *Empty) (*StatusVerboseResponse, error)
+	Statistics(context.Context, *Empty) (*StatisticsResponse, error)
+	Benchmarks(context.Context, *BenchmarksRequest) (*BenchmarksResponse, error)
+	ServicesStatus(context.Context, *Empty) (*ServicesStatusResponse, error)
+	SetIpv6(context.Context, *SetGenericRequest) (*Payload, error)
+	ClaimOnlinePurchase(context.Context, *Empty) (*ClaimOnlinePurchaseResponse, error)
+	SetVirtualLocation(context.Context, *SetGenericRequest) (*Payload, error)
+	SubscribeToStateChanges(*Empty, Daemon_SubscribeToStateChangesServer) error
+	SubscribeEvents(*SubscribeEventsRequest, Daemon_SubscribeEventsServer) error
+	GetServers(context.Context, *Empty) (*ServersResponse, error)
+	SearchServers(context.Context, *SearchServersRequest) (*SearchServersResponse, error)
+	SetPostQuantum(context.Context, *SetGenericRequest) (*Payload, error)
+	PendingActions(context.Context, *Empty) (*PendingActionsResponse, error)
+	ConnectionHistory(context.Context, *ConnectionHistoryRequest) (*ConnectionHistoryResponse, error)
+	CancelPendingAction(context.Context, *CancelPendingActionRequest) (*Payload, error)
+	Repair(context.Context, *RepairRequest) (*RepairResponse, error)
+	Preflight(context.Context, *Empty) (*PreflightResponse, error)
+	BandwidthUsage(context.Context, *BandwidthUsageRequest) (*BandwidthUsageResponse, error)
+	mustEmbedUnimplementedDaemonServer()
+}
+
+// UnimplementedDaemonServer must be embedded to have forward compatible implementations.
+type UnimplementedDaemonServer struct {
+}
+
+func (UnimplementedDaemonServer) AccountInfo(context.Context, *Empty) (*AccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
+}
+func (UnimplementedDaemonServer) TokenInfo(context.Context, *Empty) (*TokenInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenInfo not implemented")
+}
+func (UnimplementedDaemonServer) Cities(context.Context, *CitiesRequest) (*ServerGroupsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cities not implemented")
+}
+func (UnimplementedDaemonServer) Connect(*ConnectRequest, Daemon_ConnectServer) error {
+	return status.Errorf(codes.Unimplemented, "method Connect not implemented")
+}
+func (UnimplementedDaemonServer) ConnectCancel(context.Context, *Empty) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectCancel not implemented")
+}
+func (UnimplementedDaemonServer) ConnectDryRun(context.Context, *ConnectRequest) (*ConnectPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectDryRun not implemented")
+}
+func (UnimplementedDaemonServer) ConnectDedicatedIP(*ConnectDedicatedIPRequest, Daemon_ConnectDedicatedIPServer) error {
+	return status.Errorf(codes.Unimplemented, "method ConnectDedicatedIP not implemented")
+}
+func (UnimplementedDaemonServer) DedicatedIPServers(context.Context, *Empty) (*DedicatedIPServersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DedicatedIPServers not implemented")
+}
+func (UnimplementedDaemonServer) Countries(context.Context, *Empty) (*ServerGroupsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Countries not implemented")
+}
+func (UnimplementedDaemonServer) Disconnect(*Empty, Daemon_DisconnectServer) error {
+	return status.Errorf(codes.Unimplemented, "method Disconnect not implemented")
+}
+func (UnimplementedDaemonServer) Pause(context.Context, *PauseRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (UnimplementedDaemonServer) Groups(context.Context, *Empty) (*ServerGroupsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Groups not implemented")
+}
+func (UnimplementedDaemonServer) IsLoggedIn(context.Context, *Empty) (*Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsLoggedIn not implemented")
+}
+func (UnimplementedDaemonServer) LoginWithToken(context.Context, *LoginWithTokenRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginWithToken not implemented")
+}
+func (UnimplementedDaemonServer) LoginOAuth2(*Empty, Daemon_LoginOAuth2Server) error {
+	return status.Errorf(codes.Unimplemented, "method LoginOAuth2 not implemented")
+}
+func (UnimplementedDaemonServer) LoginOAuth2Callback(context.Context, *String) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginOAuth2Callback not implemented")
+}
+func (UnimplementedDaemonServer) Logout(context.Context, *LogoutRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedDaemonServer) Plans(context.Context, *Empty) (*PlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Plans not implemented")
+}
+func (UnimplementedDaemonServer) Ping(context.Context, *Empty) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedDaemonServer) RateConnection(context.Context, *RateRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateConnection not implemented")
+}
+func (UnimplementedDaemonServer) Register(context.Context, *RegisterRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedDaemonServer) SetAutoConnect(context.Context, *SetAutoconnectRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoConnect not implemented")
+}
+func (UnimplementedDaemonServer) SetThreatProtectionLite(context.Context, *SetThreatProtectionLiteRequest) (*SetThreatProtectionLiteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetThreatProtectionLite not implemented")
+}
+func (UnimplementedDaemonServer) SetDefaults(context.Context, *Empty) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaults not implemented")
+}
+func (UnimplementedDaemonServer) SetDNS(context.Context, *SetDNSRequest) (*SetDNSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDNS not implemented")
+}
+func (UnimplementedDaemonServer) SetFirewall(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFirewall not implemented")
+}
+func (UnimplementedDaemonServer) SetFirewallMark(context.Context, *SetUint32Request) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFirewallMark not implemented")
+}
+func (UnimplementedDaemonServer) SetRoutingTable(context.Context, *SetUint32Request) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoutingTable not implemented")
+}
+func (UnimplementedDaemonServer) SetRouteMetric(context.Context, *SetUint32Request) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRouteMetric not implemented")
+}
+func (UnimplementedDaemonServer) SetMeshnetPeerRoutes(context.Context, *SetMeshnetPeerRoutesRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMeshnetPeerRoutes not implemented")
+}
+func (UnimplementedDaemonServer) SetRouting(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRouting not implemented")
+}
+func (UnimplementedDaemonServer) SetAnalytics(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAnalytics not implemented")
+}
+func (UnimplementedDaemonServer) SetAnalyticsCategory(context.Context, *SetAnalyticsCategoryRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAnalyticsCategory not implemented")
+}
+func (UnimplementedDaemonServer) AnalyticsEvents(context.Context, *Empty) (*AnalyticsEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyticsEvents not implemented")
+}
+func (UnimplementedDaemonServer) SetKillSwitch(context.Context, *SetKillSwitchRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKillSwitch not implemented")
+}
+func (UnimplementedDaemonServer) SetNotify(context.Context, *SetNotifyRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNotify not implemented")
+}
+func (UnimplementedDaemonServer) SetTray(context.Context, *SetTrayRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTray not implemented")
+}
+func (UnimplementedDaemonServer) SetTrayIconTheme(context.Context, *SetTrayIconThemeRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTrayIconTheme not implemented")
+}
+func (UnimplementedDaemonServer) SetTrayHotkey(context.Context, *SetTrayHotkeyRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTrayHotkey not implemented")
+}
+func (UnimplementedDaemonServer) SetTrayMinimal(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTrayMinimal not implemented")
+}
+func (UnimplementedDaemonServer) SetDeferOnMetered(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDeferOnMetered not implemented")
+}
+func (UnimplementedDaemonServer) SetObfuscationFallback(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetObfuscationFallback not implemented")
+}
+func (UnimplementedDaemonServer) SetTrayMenu(context.Context, *SetTrayMenuRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTrayMenu not implemented")
+}
+func (UnimplementedDaemonServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedDaemonServer) SetDaemonLogLevel(context.Context, *SetDaemonLogLevelRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDaemonLogLevel not implemented")
+}
+func (UnimplementedDaemonServer) SetTrustedNetwork(context.Context, *SetTrustedNetworkRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTrustedNetwork not implemented")
+}
+func (UnimplementedDaemonServer) SetTrustedNetworkAction(context.Context, *SetTrustedNetworkActionRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTrustedNetworkAction not implemented")
+}
+func (UnimplementedDaemonServer) SetSplitTunnelApp(context.Context, *SetSplitTunnelAppRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSplitTunnelApp not implemented")
+}
+func (UnimplementedDaemonServer) SetSplitTunnelMode(context.Context, *SetSplitTunnelModeRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSplitTunnelMode not implemented")
+}
+func (UnimplementedDaemonServer) SplitTunnelApps(context.Context, *Empty) (*SplitTunnel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitTunnelApps not implemented")
+}
+func (UnimplementedDaemonServer) AddFavorite(context.Context, *Favorite) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFavorite not implemented")
+}
+func (UnimplementedDaemonServer) RemoveFavorite(context.Context, *RemoveFavoriteRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFavorite not implemented")
+}
+func (UnimplementedDaemonServer) Favorites(context.Context, *Empty) (*FavoritesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Favorites not implemented")
+}
+func (UnimplementedDaemonServer) CreateProfile(context.Context, *ProfileRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProfile not implemented")
+}
+func (UnimplementedDaemonServer) SwitchProfile(context.Context, *ProfileRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwitchProfile not implemented")
+}
+func (UnimplementedDaemonServer) DeleteProfile(context.Context, *ProfileRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProfile not implemented")
+}
+func (UnimplementedDaemonServer) Profiles(context.Context, *Empty) (*ProfilesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Profiles not implemented")
+}
+func (UnimplementedDaemonServer) AddHook(context.Context, *Hook) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddHook not implemented")
+}
+func (UnimplementedDaemonServer) RemoveHook(context.Context, *Hook) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveHook not implemented")
+}
+func (UnimplementedDaemonServer) Hooks(context.Context, *Empty) (*HooksList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hooks not implemented")
+}
+func (UnimplementedDaemonServer) AddScheduleRule(context.Context, *ScheduleRule) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddScheduleRule not implemented")
+}
+func (UnimplementedDaemonServer) RemoveScheduleRule(context.Context, *RemoveScheduleRuleRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveScheduleRule not implemented")
+}
+func (UnimplementedDaemonServer) ScheduleRules(context.Context, *Empty) (*ScheduleRulesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleRules not implemented")
+}
+func (UnimplementedDaemonServer) SetObfuscate(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetObfuscate not implemented")
+}
+func (UnimplementedDaemonServer) SetProtocol(context.Context, *SetProtocolRequest) (*SetProtocolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProtocol not implemented")
+}
+func (UnimplementedDaemonServer) SetMTU(context.Context, *SetUint32Request) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMTU not implemented")
+}
+func (UnimplementedDaemonServer) SetOpenVPNPort(context.Context, *SetUint32Request) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOpenVPNPort not implemented")
+}
+func (UnimplementedDaemonServer) SetOpenVPNDirectives(context.Context, *SetOpenVPNDirectivesRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOpenVPNDirectives not implemented")
+}
+func (UnimplementedDaemonServer) SetInterfaceName(context.Context, *SetStringRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInterfaceName not implemented")
+}
+func (UnimplementedDaemonServer) SetPersistentKeepalive(context.Context, *SetUint32Request) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPersistentKeepalive not implemented")
+}
+func (UnimplementedDaemonServer) SetHealthMonitor(context.Context, *SetStringRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHealthMonitor not implemented")
+}
+func (UnimplementedDaemonServer) SetMaxServerLoad(context.Context, *SetUint32Request) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxServerLoad not implemented")
+}
+func (UnimplementedDaemonServer) SetFileshareFileLimit(context.Context, *SetUint32Request) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFileshareFileLimit not implemented")
+}
+func (UnimplementedDaemonServer) SetConnectRetry(context.Context, *ConnectRetry) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConnectRetry not implemented")
+}
+func (UnimplementedDaemonServer) SetConnectTimeouts(context.Context, *ConnectTimeouts) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConnectTimeouts not implemented")
+}
+func (UnimplementedDaemonServer) SetStealth(context.Context, *Stealth) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStealth not implemented")
+}
+func (UnimplementedDaemonServer) SetTechnology(context.Context, *SetTechnologyRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTechnology not implemented")
+}
+func (UnimplementedDaemonServer) SetLANDiscovery(context.Context, *SetLANDiscoveryRequest) (*SetLANDiscoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLANDiscovery not implemented")
+}
+func (UnimplementedDaemonServer) SetAllowlist(context.Context, *SetAllowlistRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllowlist not implemented")
+}
+func (UnimplementedDaemonServer) UnsetAllowlist(context.Context, *SetAllowlistRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsetAllowlist not implemented")
+}
+func (UnimplementedDaemonServer) UnsetAllAllowlist(context.Context, *Empty) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnsetAllAllowlist not implemented")
+}
+func (UnimplementedDaemonServer) Settings(context.Context, *Empty) (*SettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Settings not implemented")
+}
+func (UnimplementedDaemonServer) ExportSettings(context.Context, *Empty) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSettings not implemented")
+}
+func (UnimplementedDaemonServer) ImportSettings(context.Context, *ImportSettingsRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSettings not implemented")
+}
+func (UnimplementedDaemonServer) SettingsProtocols(context.Context, *Empty) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettingsProtocols not implemented")
+}
+func (UnimplementedDaemonServer) SettingsTechnologies(context.Context, *Empty) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettingsTechnologies not implemented")
+}
+func (UnimplementedDaemonServer) Status(context.Context, *Empty) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (UnimplementedDaemonServer) StatusStream(*Empty, Daemon_StatusStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method StatusStream not implemented")
+}
+func (UnimplementedDaemonServer) StatusVerbose(context.Context, *Empty) (*StatusVerboseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatusVerbose not implemented")
+}
+func (UnimplementedDaemonServer) Statistics(context.Context, *Empty) (*StatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Statistics not implemented")
+}
+func (UnimplementedDaemonServer) Benchmarks(context.Context, *BenchmarksRequest) (*BenchmarksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Benchmarks not implemented")
+}
+func (UnimplementedDaemonServer) ServicesStatus(context.Context, *Empty) (*ServicesStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServicesStatus not implemented")
+}
+func (UnimplementedDaemonServer) SetIpv6(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIpv6 not implemented")
+}
+func (UnimplementedDaemonServer) ClaimOnlinePurchase(context.Context, *Empty) (*ClaimOnlinePurchaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimOnlinePurchase not implemented")
+}
+func (UnimplementedDaemonServer) SetVirtualLocation(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVirtualLocation not implemented")
+}
+func (UnimplementedDaemonServer) SubscribeToStateChanges(*Empty, Daemon_SubscribeToStateChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeToStateChanges not implemented")
+}
+func (UnimplementedDaemonServer) SubscribeEvents(*SubscribeEventsRequest, Daemon_SubscribeEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvents not implemented")
+}
+func (UnimplementedDaemonServer) GetServers(context.Context, *Empty) (*ServersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServers not implemented")
+}
+func (UnimplementedDaemonServer) SearchServers(context.Context, *SearchServersRequest) (*SearchServersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchServers not implemented")
+}
+func (UnimplementedDaemonServer) SetPostQuantum(context.Context, *SetGenericRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPostQuantum not implemented")
+}
+func (UnimplementedDaemonServer) PendingActions(context.Context, *Empty) (*PendingActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingActions not implemented")
+}
+func (UnimplementedDaemonServer) ConnectionHistory(context.Context, *ConnectionHistoryRequest) (*ConnectionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionHistory not implemented")
+}
+func (UnimplementedDaemonServer) CancelPendingAction(context.Context, *CancelPendingActionRequest) (*Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPendingAction not implemented")
+}
+func (UnimplementedDaemonServer) Repair(context.Context, *RepairRequest) (*RepairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Repair not implemented")
+}
+func (UnimplementedDaemonServer) Preflight(context.Context, *Empty) (*PreflightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Preflight not implemented")
+}
+func (UnimplementedDaemonServer) BandwidthUsage(context.Context, *BandwidthUsageRequest) (*BandwidthUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BandwidthUsage not implemented")
+}
+func (UnimplementedDaemonServer) mustEmbedUnimplementedDaemonServer() {}
+
+// UnsafeDaemonServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DaemonServer will
+// result in compilation errors.
+type UnsafeDaemonServer interface {
+	mustEmbedUnimplementedDaemonServer()
+}
+
+func RegisterDaemonServer(s grpc.ServiceRegistrar, srv DaemonServer) {
+	s.RegisterService(&Daemon_ServiceDesc, srv)
+}
+
+func _Daemon_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).AccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/AccountInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).AccountInfo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_TokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).TokenInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/TokenInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).TokenInfo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Cities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Cities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Cities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Cities(ctx, req.(*CitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Connect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ConnectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).Connect(m, &daemonConnectServer{stream})
+}
+
+type Daemon_ConnectServer interface {
+	Send(*Payload) error
+	grpc.ServerStream
+}
+
+type daemonConnectServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonConnectServer) Send(m *Payload) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Daemon_ConnectCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ConnectCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/ConnectCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ConnectCancel(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ConnectDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ConnectDryRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/ConnectDryRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ConnectDryRun(ctx, req.(*ConnectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ConnectDedicatedIP_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ConnectDedicatedIPRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).ConnectDedicatedIP(m, &daemonConnectDedicatedIPServer{stream})
+}
+
+type Daemon_ConnectDedicatedIPServer interface {
+	Send(*Payload) error
+	grpc.ServerStream
+}
+
+type daemonConnectDedicatedIPServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonConnectDedicatedIPServer) Send(m *Payload) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Daemon_DedicatedIPServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).DedicatedIPServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/DedicatedIPServers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).DedicatedIPServers(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Countries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Countries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Countries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Countries(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Disconnect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).Disconnect(m, &daemonDisconnectServer{stream})
+}
+
+type Daemon_DisconnectServer interface {
+	Send(*Payload) error
+	grpc.ServerStream
+}
+
+type daemonDisconnectServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonDisconnectServer) Send(m *Payload) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Daemon_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Pause(ctx, req.(*PauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Groups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Groups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Groups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Groups(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_IsLoggedIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).IsLoggedIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/IsLoggedIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).IsLoggedIn(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_LoginWithToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginWithTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).LoginWithToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/LoginWithToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).LoginWithToken(ctx, req.(*LoginWithTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_LoginOAuth2_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).LoginOAuth2(m, &daemonLoginOAuth2Server{stream})
+}
+
+type Daemon_LoginOAuth2Server interface {
+	Send(*String) error
+	grpc.ServerStream
+}
+
+type daemonLoginOAuth2Server struct {
+	grpc.ServerStream
+}
+
+func (x *daemonLoginOAuth2Server) Send(m *String) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Daemon_LoginOAuth2Callback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(String)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).LoginOAuth2Callback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/LoginOAuth2Callback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).LoginOAuth2Callback(ctx, req.(*String))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Plans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Plans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Plans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Plans(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Ping(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_RateConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).RateConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/RateConnection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).RateConnection(ctx, req.(*RateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetAutoConnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAutoconnectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetAutoConnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetAutoConnect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetAutoConnect(ctx, req.(*SetAutoconnectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetThreatProtectionLite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetThreatProtectionLiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetThreatProtectionLite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetThreatProtectionLite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetThreatProtectionLite(ctx, req.(*SetThreatProtectionLiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetDefaults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetDefaults(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetDNS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDNSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetDNS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetDNS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetDNS(ctx, req.(*SetDNSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetFirewall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetFirewall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetFirewall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetFirewall(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetFirewallMark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUint32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetFirewallMark(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetFirewallMark",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetFirewallMark(ctx, req.(*SetUint32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetRoutingTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUint32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetRoutingTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetRoutingTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetRoutingTable(ctx, req.(*SetUint32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetRouteMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUint32Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetRouteMetric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetRouteMetric",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetRouteMetric(ctx, req.(*SetUint32Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetMeshnetPeerRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMeshnetPeerRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetMeshnetPeerRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetMeshnetPeerRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetMeshnetPeerRoutes(ctx, req.(*SetMeshnetPeerRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetRouting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetRouting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetRouting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetRouting(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetAnalytics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetAnalytics(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetAnalyticsCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAnalyticsCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetAnalyticsCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetAnalyticsCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetAnalyticsCategory(ctx, req.(*SetAnalyticsCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_AnalyticsEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).AnalyticsEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/AnalyticsEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).AnalyticsEvents(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetKillSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKillSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetKillSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetKillSwitch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetKillSwitch(ctx, req.(*SetKillSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetNotify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetNotify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetNotify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetNotify(ctx, req.(*SetNotifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetTray_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTrayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetTray(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetTray",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetTray(ctx, req.(*SetTrayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetTrayIconTheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTrayIconThemeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetTrayIconTheme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetTrayIconTheme",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetTrayIconTheme(ctx, req.(*SetTrayIconThemeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDaemonServer) UnsetAllAllowlist(context.Context, *Empty) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnsetAllAllowlist not implemented")
+
+func _Daemon_SetTrayHotkey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTrayHotkeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetTrayHotkey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetTrayHotkey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetTrayHotkey(ctx, req.(*SetTrayHotkeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDaemonServer) Settings(context.Context, *Empty) (*SettingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Settings not implemented")
+
+func _Daemon_SetTrayMinimal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetTrayMinimal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetTrayMinimal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetTrayMinimal(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDaemonServer) SettingsProtocols(context.Context, *Empty) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SettingsProtocols not implemented")
+
+func _Daemon_SetDeferOnMetered_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetDeferOnMetered(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetDeferOnMetered",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetDeferOnMetered(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDaemonServer) SettingsTechnologies(context.Context, *Empty) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SettingsTechnologies not implemented")
+
+func _Daemon_SetObfuscationFallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetObfuscationFallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetObfuscationFallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetObfuscationFallback(ctx, req.(*SetGenericRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDaemonServer) Status(context.Context, *Empty) (*StatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+
+func _Daemon_SetTrayMenu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTrayMenuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetTrayMenu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetTrayMenu",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetTrayMenu(ctx, req.(*SetTrayMenuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDaemonServer) SetIpv6(context.Context, *SetGenericRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetIpv6 not implemented")
+
+func _Daemon_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDaemonServer) ClaimOnlinePurchase(context.Context, *Empty) (*ClaimOnlinePurchaseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimOnlinePurchase not implemented")
+
+func _Daemon_SetDaemonLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDaemonLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetDaemonLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetDaemonLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetDaemonLogLevel(ctx, req.(*SetDaemonLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDaemonServer) SetVirtualLocation(context.Context, *SetGenericRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetVirtualLocation not implemented")
+
+func _Daemon_SetTrustedNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTrustedNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetTrustedNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetTrustedNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetTrustedNetwork(ctx, req.(*SetTrustedNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDaemonServer) SubscribeToStateChanges(*Empty, Daemon_SubscribeToStateChangesServer) error {
-	return status.Errorf(codes.Unimplemented, "method SubscribeToStateChanges not implemented")
+
+func _Daemon_SetTrustedNetworkAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTrustedNetworkActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetTrustedNetworkAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetTrustedNetworkAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetTrustedNetworkAction(ctx, req.(*SetTrustedNetworkActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDaemonServer) GetServers(context.Context, *Empty) (*ServersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetServers not implemented")
+
+func _Daemon_SetSplitTunnelApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSplitTunnelAppRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetSplitTunnelApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetSplitTunnelApp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetSplitTunnelApp(ctx, req.(*SetSplitTunnelAppRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDaemonServer) SetPostQuantum(context.Context, *SetGenericRequest) (*Payload, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetPostQuantum not implemented")
+
+func _Daemon_SetSplitTunnelMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSplitTunnelModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetSplitTunnelMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SetSplitTunnelMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetSplitTunnelMode(ctx, req.(*SetSplitTunnelModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDaemonServer) mustEmbedUnimplementedDaemonServer() {}
 
-// UnsafeDaemonServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to DaemonServer will
-// result in compilation errors.
-type UnsafeDaemonServer interface {
-	mustEmbedUnimplementedDaemonServer()
+func _Daemon_SplitTunnelApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SplitTunnelApps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SplitTunnelApps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SplitTunnelApps(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterDaemonServer(s grpc.ServiceRegistrar, srv DaemonServer) {
-	s.RegisterService(&Daemon_ServiceDesc, srv)
+func _Daemon_AddFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Favorite)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).AddFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/AddFavorite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).AddFavorite(ctx, req.(*Favorite))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_RemoveFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFavoriteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).AccountInfo(ctx, in)
+		return srv.(DaemonServer).RemoveFavorite(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/AccountInfo",
+		FullMethod: "/pb.Daemon/RemoveFavorite",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).AccountInfo(ctx, req.(*Empty))
+		return srv.(DaemonServer).RemoveFavorite(ctx, req.(*RemoveFavoriteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_TokenInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Daemon_Favorites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).TokenInfo(ctx, in)
+		return srv.(DaemonServer).Favorites(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/TokenInfo",
+		FullMethod: "/pb.Daemon/Favorites",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).TokenInfo(ctx, req.(*Empty))
+		return srv.(DaemonServer).Favorites(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Cities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CitiesRequest)
+func _Daemon_CreateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Cities(ctx, in)
+		return srv.(DaemonServer).CreateProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Cities",
+		FullMethod: "/pb.Daemon/CreateProfile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Cities(ctx, req.(*CitiesRequest))
+		return srv.(DaemonServer).CreateProfile(ctx, req.(*ProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Connect_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ConnectRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Daemon_SwitchProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(DaemonServer).Connect(m, &daemonConnectServer{stream})
+	if interceptor == nil {
+		return srv.(DaemonServer).SwitchProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SwitchProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SwitchProfile(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type Daemon_ConnectServer interface {
-	Send(*Payload) error
-	grpc.ServerStream
+func _Daemon_DeleteProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).DeleteProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/DeleteProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).DeleteProfile(ctx, req.(*ProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type daemonConnectServer struct {
-	grpc.ServerStream
+func _Daemon_Profiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Profiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Profiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Profiles(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *daemonConnectServer) Send(m *Payload) error {
-	return x.ServerStream.SendMsg(m)
+func _Daemon_AddHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hook)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).AddHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/AddHook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).AddHook(ctx, req.(*Hook))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_ConnectCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_RemoveHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hook)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).ConnectCancel(ctx, in)
+		return srv.(DaemonServer).RemoveHook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/ConnectCancel",
+		FullMethod: "/pb.Daemon/RemoveHook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).ConnectCancel(ctx, req.(*Empty))
+		return srv.(DaemonServer).RemoveHook(ctx, req.(*Hook))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Countries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Daemon_Hooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Countries(ctx, in)
+		return srv.(DaemonServer).Hooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Countries",
+		FullMethod: "/pb.Daemon/Hooks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Countries(ctx, req.(*Empty))
+		return srv.(DaemonServer).Hooks(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Disconnect_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(DaemonServer).Disconnect(m, &daemonDisconnectServer{stream})
-}
-
-type Daemon_DisconnectServer interface {
-	Send(*Payload) error
-	grpc.ServerStream
-}
-
-type daemonDisconnectServer struct {
-	grpc.ServerStream
-}
-
-func (x *daemonDisconnectServer) Send(m *Payload) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _Daemon_Groups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_AddScheduleRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleRule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Groups(ctx, in)
+		return srv.(DaemonServer).AddScheduleRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Groups",
+		FullMethod: "/pb.Daemon/AddScheduleRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Groups(ctx, req.(*Empty))
+		return srv.(DaemonServer).AddScheduleRule(ctx, req.(*ScheduleRule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_IsLoggedIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_RemoveScheduleRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveScheduleRuleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).IsLoggedIn(ctx, in)
+		return srv.(DaemonServer).RemoveScheduleRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/IsLoggedIn",
+		FullMethod: "/pb.Daemon/RemoveScheduleRule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).IsLoggedIn(ctx, req.(*Empty))
+		return srv.(DaemonServer).RemoveScheduleRule(ctx, req.(*RemoveScheduleRuleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_LoginWithToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginWithTokenRequest)
+func _Daemon_ScheduleRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).LoginWithToken(ctx, in)
+		return srv.(DaemonServer).ScheduleRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/LoginWithToken",
+		FullMethod: "/pb.Daemon/ScheduleRules",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).LoginWithToken(ctx, req.(*LoginWithTokenRequest))
+		return srv.(DaemonServer).ScheduleRules(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_LoginOAuth2_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(DaemonServer).LoginOAuth2(m, &daemonLoginOAuth2Server{stream})
-}
-
-type Daemon_LoginOAuth2Server interface {
-	Send(*String) error
-	grpc.ServerStream
-}
-
-type daemonLoginOAuth2Server struct {
-	grpc.ServerStream
-}
-
-func (x *daemonLoginOAuth2Server) Send(m *String) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _Daemon_LoginOAuth2Callback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(String)
+func _Daemon_SetObfuscate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGenericRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).LoginOAuth2Callback(ctx, in)
+		return srv.(DaemonServer).SetObfuscate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/LoginOAuth2Callback",
+		FullMethod: "/pb.Daemon/SetObfuscate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).LoginOAuth2Callback(ctx, req.(*String))
+		return srv.(DaemonServer).SetObfuscate(ctx, req.(*SetGenericRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogoutRequest)
+func _Daemon_SetProtocol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProtocolRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Logout(ctx, in)
+		return srv.(DaemonServer).SetProtocol(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Logout",
+		FullMethod: "/pb.Daemon/SetProtocol",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Logout(ctx, req.(*LogoutRequest))
+		return srv.(DaemonServer).SetProtocol(ctx, req.(*SetProtocolRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Plans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_SetMTU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUint32Request)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Plans(ctx, in)
+		return srv.(DaemonServer).SetMTU(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Plans",
+		FullMethod: "/pb.Daemon/SetMTU",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Plans(ctx, req.(*Empty))
+		return srv.(DaemonServer).SetMTU(ctx, req.(*SetUint32Request))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_SetOpenVPNPort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUint32Request)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Ping(ctx, in)
+		return srv.(DaemonServer).SetOpenVPNPort(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Ping",
+		FullMethod: "/pb.Daemon/SetOpenVPNPort",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Ping(ctx, req.(*Empty))
+		return srv.(DaemonServer).SetOpenVPNPort(ctx, req.(*SetUint32Request))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_RateConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RateRequest)
+func _Daemon_SetOpenVPNDirectives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOpenVPNDirectivesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).RateConnection(ctx, in)
+		return srv.(DaemonServer).SetOpenVPNDirectives(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/RateConnection",
+		FullMethod: "/pb.Daemon/SetOpenVPNDirectives",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).RateConnection(ctx, req.(*RateRequest))
+		return srv.(DaemonServer).SetOpenVPNDirectives(ctx, req.(*SetOpenVPNDirectivesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _Daemon_SetInterfaceName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStringRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Register(ctx, in)
+		return srv.(DaemonServer).SetInterfaceName(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Register",
+		FullMethod: "/pb.Daemon/SetInterfaceName",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(DaemonServer).SetInterfaceName(ctx, req.(*SetStringRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetAutoConnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetAutoconnectRequest)
+func _Daemon_SetPersistentKeepalive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUint32Request)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetAutoConnect(ctx, in)
+		return srv.(DaemonServer).SetPersistentKeepalive(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetAutoConnect",
+		FullMethod: "/pb.Daemon/SetPersistentKeepalive",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetAutoConnect(ctx, req.(*SetAutoconnectRequest))
+		return srv.(DaemonServer).SetPersistentKeepalive(ctx, req.(*SetUint32Request))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetThreatProtectionLite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetThreatProtectionLiteRequest)
+func _Daemon_SetHealthMonitor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStringRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetThreatProtectionLite(ctx, in)
+		return srv.(DaemonServer).SetHealthMonitor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetThreatProtectionLite",
+		FullMethod: "/pb.Daemon/SetHealthMonitor",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetThreatProtectionLite(ctx, req.(*SetThreatProtectionLiteRequest))
+		return srv.(DaemonServer).SetHealthMonitor(ctx, req.(*SetStringRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_SetMaxServerLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUint32Request)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetDefaults(ctx, in)
+		return srv.(DaemonServer).SetMaxServerLoad(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetDefaults",
+		FullMethod: "/pb.Daemon/SetMaxServerLoad",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetDefaults(ctx, req.(*Empty))
+		return srv.(DaemonServer).SetMaxServerLoad(ctx, req.(*SetUint32Request))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetDNS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetDNSRequest)
+func _Daemon_SetFileshareFileLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUint32Request)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetDNS(ctx, in)
+		return srv.(DaemonServer).SetFileshareFileLimit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetDNS",
+		FullMethod: "/pb.Daemon/SetFileshareFileLimit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetDNS(ctx, req.(*SetDNSRequest))
+		return srv.(DaemonServer).SetFileshareFileLimit(ctx, req.(*SetUint32Request))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetFirewall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetGenericRequest)
+func _Daemon_SetConnectRetry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectRetry)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetFirewall(ctx, in)
+		return srv.(DaemonServer).SetConnectRetry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetFirewall",
+		FullMethod: "/pb.Daemon/SetConnectRetry",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetFirewall(ctx, req.(*SetGenericRequest))
+		return srv.(DaemonServer).SetConnectRetry(ctx, req.(*ConnectRetry))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetFirewallMark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetUint32Request)
+func _Daemon_SetConnectTimeouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectTimeouts)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetFirewallMark(ctx, in)
+		return srv.(DaemonServer).SetConnectTimeouts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetFirewallMark",
+		FullMethod: "/pb.Daemon/SetConnectTimeouts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetFirewallMark(ctx, req.(*SetUint32Request))
+		return srv.(DaemonServer).SetConnectTimeouts(ctx, req.(*ConnectTimeouts))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetRouting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetGenericRequest)
+func _Daemon_SetStealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Stealth)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetRouting(ctx, in)
+		return srv.(DaemonServer).SetStealth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetRouting",
+		FullMethod: "/pb.Daemon/SetStealth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetRouting(ctx, req.(*SetGenericRequest))
+		return srv.(DaemonServer).SetStealth(ctx, req.(*Stealth))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetGenericRequest)
+func _Daemon_SetTechnology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTechnologyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetAnalytics(ctx, in)
+		return srv.(DaemonServer).SetTechnology(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetAnalytics",
+		FullMethod: "/pb.Daemon/SetTechnology",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetAnalytics(ctx, req.(*SetGenericRequest))
+		return srv.(DaemonServer).SetTechnology(ctx, req.(*SetTechnologyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetKillSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetKillSwitchRequest)
+func _Daemon_SetLANDiscovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLANDiscoveryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetKillSwitch(ctx, in)
+		return srv.(DaemonServer).SetLANDiscovery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetKillSwitch",
+		FullMethod: "/pb.Daemon/SetLANDiscovery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetKillSwitch(ctx, req.(*SetKillSwitchRequest))
+		return srv.(DaemonServer).SetLANDiscovery(ctx, req.(*SetLANDiscoveryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetNotify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetNotifyRequest)
+func _Daemon_SetAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAllowlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetNotify(ctx, in)
+		return srv.(DaemonServer).SetAllowlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetNotify",
+		FullMethod: "/pb.Daemon/SetAllowlist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetNotify(ctx, req.(*SetNotifyRequest))
+		return srv.(DaemonServer).SetAllowlist(ctx, req.(*SetAllowlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetTray_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetTrayRequest)
+func _Daemon_UnsetAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAllowlistRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetTray(ctx, in)
+		return srv.(DaemonServer).UnsetAllowlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetTray",
+		FullMethod: "/pb.Daemon/UnsetAllowlist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetTray(ctx, req.(*SetTrayRequest))
+		return srv.(DaemonServer).UnsetAllowlist(ctx, req.(*SetAllowlistRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetObfuscate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetGenericRequest)
+func _Daemon_UnsetAllAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetObfuscate(ctx, in)
+		return srv.(DaemonServer).UnsetAllAllowlist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetObfuscate",
+		FullMethod: "/pb.Daemon/UnsetAllAllowlist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetObfuscate(ctx, req.(*SetGenericRequest))
+		return srv.(DaemonServer).UnsetAllAllowlist(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetProtocol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetProtocolRequest)
+func _Daemon_Settings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetProtocol(ctx, in)
+		return srv.(DaemonServer).Settings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetProtocol",
+		FullMethod: "/pb.Daemon/Settings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetProtocol(ctx, req.(*SetProtocolRequest))
+		return srv.(DaemonServer).Settings(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetTechnology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetTechnologyRequest)
+func _Daemon_ExportSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetTechnology(ctx, in)
+		return srv.(DaemonServer).ExportSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetTechnology",
+		FullMethod: "/pb.Daemon/ExportSettings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetTechnology(ctx, req.(*SetTechnologyRequest))
+		return srv.(DaemonServer).ExportSettings(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetLANDiscovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetLANDiscoveryRequest)
+func _Daemon_ImportSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSettingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetLANDiscovery(ctx, in)
+		return srv.(DaemonServer).ImportSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetLANDiscovery",
+		FullMethod: "/pb.Daemon/ImportSettings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetLANDiscovery(ctx, req.(*SetLANDiscoveryRequest))
+		return srv.(DaemonServer).ImportSettings(ctx, req.(*ImportSettingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SetAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetAllowlistRequest)
+func _Daemon_SettingsProtocols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SetAllowlist(ctx, in)
+		return srv.(DaemonServer).SettingsProtocols(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SetAllowlist",
+		FullMethod: "/pb.Daemon/SettingsProtocols",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SetAllowlist(ctx, req.(*SetAllowlistRequest))
+		return srv.(DaemonServer).SettingsProtocols(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_UnsetAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetAllowlistRequest)
+func _Daemon_SettingsTechnologies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).UnsetAllowlist(ctx, in)
+		return srv.(DaemonServer).SettingsTechnologies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/UnsetAllowlist",
+		FullMethod: "/pb.Daemon/SettingsTechnologies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).UnsetAllowlist(ctx, req.(*SetAllowlistRequest))
+		return srv.(DaemonServer).SettingsTechnologies(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_UnsetAllAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Daemon_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).UnsetAllAllowlist(ctx, in)
+		return srv.(DaemonServer).Status(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/UnsetAllAllowlist",
+		FullMethod: "/pb.Daemon/Status",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).UnsetAllAllowlist(ctx, req.(*Empty))
+		return srv.(DaemonServer).Status(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Settings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Daemon_StatusStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).StatusStream(m, &daemonStatusStreamServer{stream})
+}
+
+type Daemon_StatusStreamServer interface {
+	Send(*StatusResponse) error
+	grpc.ServerStream
+}
+
+type daemonStatusStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonStatusStreamServer) Send(m *StatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Daemon_StatusVerbose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Settings(ctx, in)
+		return srv.(DaemonServer).StatusVerbose(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Settings",
+		FullMethod: "/pb.Daemon/StatusVerbose",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Settings(ctx, req.(*Empty))
+		return srv.(DaemonServer).StatusVerbose(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SettingsProtocols_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Daemon_Statistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SettingsProtocols(ctx, in)
+		return srv.(DaemonServer).Statistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SettingsProtocols",
+		FullMethod: "/pb.Daemon/Statistics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SettingsProtocols(ctx, req.(*Empty))
+		return srv.(DaemonServer).Statistics(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_SettingsTechnologies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
+func _Daemon_Benchmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).SettingsTechnologies(ctx, in)
+		return srv.(DaemonServer).Benchmarks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/SettingsTechnologies",
+		FullMethod: "/pb.Daemon/Benchmarks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).SettingsTechnologies(ctx, req.(*Empty))
+		return srv.(DaemonServer).Benchmarks(ctx, req.(*BenchmarksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Daemon_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Daemon_ServicesStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DaemonServer).Status(ctx, in)
+		return srv.(DaemonServer).ServicesStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.Daemon/Status",
+		FullMethod: "/pb.Daemon/ServicesStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DaemonServer).Status(ctx, req.(*Empty))
+		return srv.(DaemonServer).ServicesStatus(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1564,6 +3447,27 @@ func (x *daemonSubscribeToStateChangesServer) Send(m *AppState) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Daemon_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).SubscribeEvents(m, &daemonSubscribeEventsServer{stream})
+}
+
+type Daemon_SubscribeEventsServer interface {
+	Send(*DaemonEvent) error
+	grpc.ServerStream
+}
+
+type daemonSubscribeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonSubscribeEventsServer) Send(m *DaemonEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Daemon_GetServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -1582,6 +3486,24 @@ func _Daemon_GetServers_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Daemon_SearchServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchServersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SearchServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/SearchServers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SearchServers(ctx, req.(*SearchServersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Daemon_SetPostQuantum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetGenericRequest)
 	if err := dec(in); err != nil {
@@ -1600,6 +3522,114 @@ func _Daemon_SetPostQuantum_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Daemon_PendingActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).PendingActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/PendingActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).PendingActions(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ConnectionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ConnectionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/ConnectionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ConnectionHistory(ctx, req.(*ConnectionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_CancelPendingAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPendingActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).CancelPendingAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/CancelPendingAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).CancelPendingAction(ctx, req.(*CancelPendingActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Repair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Repair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Repair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Repair(ctx, req.(*RepairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Preflight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Preflight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/Preflight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Preflight(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_BandwidthUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BandwidthUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).BandwidthUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Daemon/BandwidthUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).BandwidthUsage(ctx, req.(*BandwidthUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Daemon_ServiceDesc is the grpc.ServiceDesc for Daemon service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1623,10 +3653,22 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConnectCancel",
 			Handler:    _Daemon_ConnectCancel_Handler,
 		},
+		{
+			MethodName: "ConnectDryRun",
+			Handler:    _Daemon_ConnectDryRun_Handler,
+		},
+		{
+			MethodName: "DedicatedIPServers",
+			Handler:    _Daemon_DedicatedIPServers_Handler,
+		},
 		{
 			MethodName: "Countries",
 			Handler:    _Daemon_Countries_Handler,
 		},
+		{
+			MethodName: "Pause",
+			Handler:    _Daemon_Pause_Handler,
+		},
 		{
 			MethodName: "Groups",
 			Handler:    _Daemon_Groups_Handler,
@@ -1687,6 +3729,18 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetFirewallMark",
 			Handler:    _Daemon_SetFirewallMark_Handler,
 		},
+		{
+			MethodName: "SetRoutingTable",
+			Handler:    _Daemon_SetRoutingTable_Handler,
+		},
+		{
+			MethodName: "SetRouteMetric",
+			Handler:    _Daemon_SetRouteMetric_Handler,
+		},
+		{
+			MethodName: "SetMeshnetPeerRoutes",
+			Handler:    _Daemon_SetMeshnetPeerRoutes_Handler,
+		},
 		{
 			MethodName: "SetRouting",
 			Handler:    _Daemon_SetRouting_Handler,
@@ -1695,6 +3749,14 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetAnalytics",
 			Handler:    _Daemon_SetAnalytics_Handler,
 		},
+		{
+			MethodName: "SetAnalyticsCategory",
+			Handler:    _Daemon_SetAnalyticsCategory_Handler,
+		},
+		{
+			MethodName: "AnalyticsEvents",
+			Handler:    _Daemon_AnalyticsEvents_Handler,
+		},
 		{
 			MethodName: "SetKillSwitch",
 			Handler:    _Daemon_SetKillSwitch_Handler,
@@ -1707,6 +3769,110 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetTray",
 			Handler:    _Daemon_SetTray_Handler,
 		},
+		{
+			MethodName: "SetTrayIconTheme",
+			Handler:    _Daemon_SetTrayIconTheme_Handler,
+		},
+		{
+			MethodName: "SetTrayHotkey",
+			Handler:    _Daemon_SetTrayHotkey_Handler,
+		},
+		{
+			MethodName: "SetTrayMinimal",
+			Handler:    _Daemon_SetTrayMinimal_Handler,
+		},
+		{
+			MethodName: "SetDeferOnMetered",
+			Handler:    _Daemon_SetDeferOnMetered_Handler,
+		},
+		{
+			MethodName: "SetObfuscationFallback",
+			Handler:    _Daemon_SetObfuscationFallback_Handler,
+		},
+		{
+			MethodName: "SetTrayMenu",
+			Handler:    _Daemon_SetTrayMenu_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _Daemon_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "SetDaemonLogLevel",
+			Handler:    _Daemon_SetDaemonLogLevel_Handler,
+		},
+		{
+			MethodName: "SetTrustedNetwork",
+			Handler:    _Daemon_SetTrustedNetwork_Handler,
+		},
+		{
+			MethodName: "SetTrustedNetworkAction",
+			Handler:    _Daemon_SetTrustedNetworkAction_Handler,
+		},
+		{
+			MethodName: "SetSplitTunnelApp",
+			Handler:    _Daemon_SetSplitTunnelApp_Handler,
+		},
+		{
+			MethodName: "SetSplitTunnelMode",
+			Handler:    _Daemon_SetSplitTunnelMode_Handler,
+		},
+		{
+			MethodName: "SplitTunnelApps",
+			Handler:    _Daemon_SplitTunnelApps_Handler,
+		},
+		{
+			MethodName: "AddFavorite",
+			Handler:    _Daemon_AddFavorite_Handler,
+		},
+		{
+			MethodName: "RemoveFavorite",
+			Handler:    _Daemon_RemoveFavorite_Handler,
+		},
+		{
+			MethodName: "Favorites",
+			Handler:    _Daemon_Favorites_Handler,
+		},
+		{
+			MethodName: "CreateProfile",
+			Handler:    _Daemon_CreateProfile_Handler,
+		},
+		{
+			MethodName: "SwitchProfile",
+			Handler:    _Daemon_SwitchProfile_Handler,
+		},
+		{
+			MethodName: "DeleteProfile",
+			Handler:    _Daemon_DeleteProfile_Handler,
+		},
+		{
+			MethodName: "Profiles",
+			Handler:    _Daemon_Profiles_Handler,
+		},
+		{
+			MethodName: "AddHook",
+			Handler:    _Daemon_AddHook_Handler,
+		},
+		{
+			MethodName: "RemoveHook",
+			Handler:    _Daemon_RemoveHook_Handler,
+		},
+		{
+			MethodName: "Hooks",
+			Handler:    _Daemon_Hooks_Handler,
+		},
+		{
+			MethodName: "AddScheduleRule",
+			Handler:    _Daemon_AddScheduleRule_Handler,
+		},
+		{
+			MethodName: "RemoveScheduleRule",
+			Handler:    _Daemon_RemoveScheduleRule_Handler,
+		},
+		{
+			MethodName: "ScheduleRules",
+			Handler:    _Daemon_ScheduleRules_Handler,
+		},
 		{
 			MethodName: "SetObfuscate",
 			Handler:    _Daemon_SetObfuscate_Handler,
@@ -1715,6 +3881,50 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetProtocol",
 			Handler:    _Daemon_SetProtocol_Handler,
 		},
+		{
+			MethodName: "SetMTU",
+			Handler:    _Daemon_SetMTU_Handler,
+		},
+		{
+			MethodName: "SetOpenVPNPort",
+			Handler:    _Daemon_SetOpenVPNPort_Handler,
+		},
+		{
+			MethodName: "SetOpenVPNDirectives",
+			Handler:    _Daemon_SetOpenVPNDirectives_Handler,
+		},
+		{
+			MethodName: "SetInterfaceName",
+			Handler:    _Daemon_SetInterfaceName_Handler,
+		},
+		{
+			MethodName: "SetPersistentKeepalive",
+			Handler:    _Daemon_SetPersistentKeepalive_Handler,
+		},
+		{
+			MethodName: "SetHealthMonitor",
+			Handler:    _Daemon_SetHealthMonitor_Handler,
+		},
+		{
+			MethodName: "SetMaxServerLoad",
+			Handler:    _Daemon_SetMaxServerLoad_Handler,
+		},
+		{
+			MethodName: "SetFileshareFileLimit",
+			Handler:    _Daemon_SetFileshareFileLimit_Handler,
+		},
+		{
+			MethodName: "SetConnectRetry",
+			Handler:    _Daemon_SetConnectRetry_Handler,
+		},
+		{
+			MethodName: "SetConnectTimeouts",
+			Handler:    _Daemon_SetConnectTimeouts_Handler,
+		},
+		{
+			MethodName: "SetStealth",
+			Handler:    _Daemon_SetStealth_Handler,
+		},
 		{
 			MethodName: "SetTechnology",
 			Handler:    _Daemon_SetTechnology_Handler,
@@ -1739,6 +3949,14 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Settings",
 			Handler:    _Daemon_Settings_Handler,
 		},
+		{
+			MethodName: "ExportSettings",
+			Handler:    _Daemon_ExportSettings_Handler,
+		},
+		{
+			MethodName: "ImportSettings",
+			Handler:    _Daemon_ImportSettings_Handler,
+		},
 		{
 			MethodName: "SettingsProtocols",
 			Handler:    _Daemon_SettingsProtocols_Handler,
@@ -1751,6 +3969,22 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _Daemon_Status_Handler,
 		},
+		{
+			MethodName: "StatusVerbose",
+			Handler:    _Daemon_StatusVerbose_Handler,
+		},
+		{
+			MethodName: "Statistics",
+			Handler:    _Daemon_Statistics_Handler,
+		},
+		{
+			MethodName: "Benchmarks",
+			Handler:    _Daemon_Benchmarks_Handler,
+		},
+		{
+			MethodName: "ServicesStatus",
+			Handler:    _Daemon_ServicesStatus_Handler,
+		},
 		{
 			MethodName: "SetIpv6",
 			Handler:    _Daemon_SetIpv6_Handler,
@@ -1767,10 +4001,38 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetServers",
 			Handler:    _Daemon_GetServers_Handler,
 		},
+		{
+			MethodName: "SearchServers",
+			Handler:    _Daemon_SearchServers_Handler,
+		},
 		{
 			MethodName: "SetPostQuantum",
 			Handler:    _Daemon_SetPostQuantum_Handler,
 		},
+		{
+			MethodName: "PendingActions",
+			Handler:    _Daemon_PendingActions_Handler,
+		},
+		{
+			MethodName: "ConnectionHistory",
+			Handler:    _Daemon_ConnectionHistory_Handler,
+		},
+		{
+			MethodName: "CancelPendingAction",
+			Handler:    _Daemon_CancelPendingAction_Handler,
+		},
+		{
+			MethodName: "Repair",
+			Handler:    _Daemon_Repair_Handler,
+		},
+		{
+			MethodName: "Preflight",
+			Handler:    _Daemon_Preflight_Handler,
+		},
+		{
+			MethodName: "BandwidthUsage",
+			Handler:    _Daemon_BandwidthUsage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1778,6 +4040,11 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Daemon_Connect_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ConnectDedicatedIP",
+			Handler:       _Daemon_ConnectDedicatedIP_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Disconnect",
 			Handler:       _Daemon_Disconnect_Handler,
@@ -1788,11 +4055,21 @@ var Daemon_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Daemon_LoginOAuth2_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StatusStream",
+			Handler:       _Daemon_StatusStream_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeToStateChanges",
 			Handler:       _Daemon_SubscribeToStateChanges_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _Daemon_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "service.proto",
 }
@@ -123,6 +123,7 @@ const (
 	SetDNSStatus_DNS_CONFIGURED_TPL_RESET SetDNSStatus = 1
 	SetDNSStatus_INVALID_DNS_ADDRESS      SetDNSStatus = 2
 	SetDNSStatus_TOO_MANY_VALUES          SetDNSStatus = 3
+	SetDNSStatus_INVALID_DNS_HOSTNAME     SetDNSStatus = 4
 )
 
 // Enum value maps for SetDNSStatus.
@@ -132,12 +133,14 @@ var (
 		1: "DNS_CONFIGURED_TPL_RESET",
 		2: "INVALID_DNS_ADDRESS",
 		3: "TOO_MANY_VALUES",
+		4: "INVALID_DNS_HOSTNAME",
 	}
 	SetDNSStatus_value = map[string]int32{
 		"DNS_CONFIGURED":           0,
 		"DNS_CONFIGURED_TPL_RESET": 1,
 		"INVALID_DNS_ADDRESS":      2,
 		"TOO_MANY_VALUES":          3,
+		"INVALID_DNS_HOSTNAME":     4,
 	}
 )
 
@@ -412,6 +415,53 @@ func (x *SetUint32Request) GetValue() uint32 {
 	return 0
 }
 
+type SetStringRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SetStringRequest) Reset() {
+	*x = SetStringRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetStringRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStringRequest) ProtoMessage() {}
+
+func (x *SetStringRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStringRequest.ProtoReflect.Descriptor instead.
+func (*SetStringRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetStringRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type SetThreatProtectionLiteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -423,7 +473,7 @@ type SetThreatProtectionLiteRequest struct {
 func (x *SetThreatProtectionLiteRequest) Reset() {
 	*x = SetThreatProtectionLiteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[3]
+		mi := &file_set_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -436,7 +486,7 @@ func (x *SetThreatProtectionLiteRequest) String() string {
 func (*SetThreatProtectionLiteRequest) ProtoMessage() {}
 
 func (x *SetThreatProtectionLiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[3]
+	mi := &file_set_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,7 +499,7 @@ func (x *SetThreatProtectionLiteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetThreatProtectionLiteRequest.ProtoReflect.Descriptor instead.
 func (*SetThreatProtectionLiteRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{3}
+	return file_set_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SetThreatProtectionLiteRequest) GetThreatProtectionLite() bool {
@@ -465,7 +515,6 @@ type SetThreatProtectionLiteResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//
 	//	*SetThreatProtectionLiteResponse_ErrorCode
 	//	*SetThreatProtectionLiteResponse_SetThreatProtectionLiteStatus
 	Response isSetThreatProtectionLiteResponse_Response `protobuf_oneof:"response"`
@@ -474,7 +523,7 @@ type SetThreatProtectionLiteResponse struct {
 func (x *SetThreatProtectionLiteResponse) Reset() {
 	*x = SetThreatProtectionLiteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[4]
+		mi := &file_set_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -487,7 +536,7 @@ func (x *SetThreatProtectionLiteResponse) String() string {
 func (*SetThreatProtectionLiteResponse) ProtoMessage() {}
 
 func (x *SetThreatProtectionLiteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[4]
+	mi := &file_set_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -500,7 +549,7 @@ func (x *SetThreatProtectionLiteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetThreatProtectionLiteResponse.ProtoReflect.Descriptor instead.
 func (*SetThreatProtectionLiteResponse) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{4}
+	return file_set_proto_rawDescGZIP(), []int{5}
 }
 
 func (m *SetThreatProtectionLiteResponse) GetResponse() isSetThreatProtectionLiteResponse_Response {
@@ -553,7 +602,7 @@ type SetDNSRequest struct {
 func (x *SetDNSRequest) Reset() {
 	*x = SetDNSRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[5]
+		mi := &file_set_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -566,7 +615,7 @@ func (x *SetDNSRequest) String() string {
 func (*SetDNSRequest) ProtoMessage() {}
 
 func (x *SetDNSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[5]
+	mi := &file_set_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +628,7 @@ func (x *SetDNSRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDNSRequest.ProtoReflect.Descriptor instead.
 func (*SetDNSRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{5}
+	return file_set_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SetDNSRequest) GetDns() []string {
@@ -602,7 +651,6 @@ type SetDNSResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//
 	//	*SetDNSResponse_ErrorCode
 	//	*SetDNSResponse_SetDnsStatus
 	Response isSetDNSResponse_Response `protobuf_oneof:"response"`
@@ -611,7 +659,7 @@ type SetDNSResponse struct {
 func (x *SetDNSResponse) Reset() {
 	*x = SetDNSResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[6]
+		mi := &file_set_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -624,7 +672,7 @@ func (x *SetDNSResponse) String() string {
 func (*SetDNSResponse) ProtoMessage() {}
 
 func (x *SetDNSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[6]
+	mi := &file_set_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -637,7 +685,7 @@ func (x *SetDNSResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDNSResponse.ProtoReflect.Descriptor instead.
 func (*SetDNSResponse) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{6}
+	return file_set_proto_rawDescGZIP(), []int{7}
 }
 
 func (m *SetDNSResponse) GetResponse() isSetDNSResponse_Response {
@@ -689,7 +737,7 @@ type SetKillSwitchRequest struct {
 func (x *SetKillSwitchRequest) Reset() {
 	*x = SetKillSwitchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[7]
+		mi := &file_set_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -702,7 +750,7 @@ func (x *SetKillSwitchRequest) String() string {
 func (*SetKillSwitchRequest) ProtoMessage() {}
 
 func (x *SetKillSwitchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[7]
+	mi := &file_set_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -715,7 +763,7 @@ func (x *SetKillSwitchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetKillSwitchRequest.ProtoReflect.Descriptor instead.
 func (*SetKillSwitchRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{7}
+	return file_set_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SetKillSwitchRequest) GetKillSwitch() bool {
@@ -744,7 +792,7 @@ type SetNotifyRequest struct {
 func (x *SetNotifyRequest) Reset() {
 	*x = SetNotifyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[8]
+		mi := &file_set_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -757,7 +805,437 @@ func (x *SetNotifyRequest) String() string {
 func (*SetNotifyRequest) ProtoMessage() {}
 
 func (x *SetNotifyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[8]
+	mi := &file_set_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotifyRequest.ProtoReflect.Descriptor instead.
+func (*SetNotifyRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetNotifyRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *SetNotifyRequest) GetNotify() bool {
+	if x != nil {
+		return x.Notify
+	}
+	return false
+}
+
+type SetTrayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid  int64 `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Tray bool  `protobuf:"varint,3,opt,name=tray,proto3" json:"tray,omitempty"`
+}
+
+func (x *SetTrayRequest) Reset() {
+	*x = SetTrayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTrayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrayRequest) ProtoMessage() {}
+
+func (x *SetTrayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrayRequest.ProtoReflect.Descriptor instead.
+func (*SetTrayRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetTrayRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *SetTrayRequest) GetTray() bool {
+	if x != nil {
+		return x.Tray
+	}
+	return false
+}
+
+type SetTrayIconThemeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid   int64                `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Theme config.TrayIconTheme `protobuf:"varint,3,opt,name=theme,proto3,enum=config.TrayIconTheme" json:"theme,omitempty"`
+}
+
+func (x *SetTrayIconThemeRequest) Reset() {
+	*x = SetTrayIconThemeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTrayIconThemeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrayIconThemeRequest) ProtoMessage() {}
+
+func (x *SetTrayIconThemeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrayIconThemeRequest.ProtoReflect.Descriptor instead.
+func (*SetTrayIconThemeRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetTrayIconThemeRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *SetTrayIconThemeRequest) GetTheme() config.TrayIconTheme {
+	if x != nil {
+		return x.Theme
+	}
+	return config.TrayIconTheme(0)
+}
+
+type SetTrayHotkeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid     int64 `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Enabled bool  `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *SetTrayHotkeyRequest) Reset() {
+	*x = SetTrayHotkeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTrayHotkeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrayHotkeyRequest) ProtoMessage() {}
+
+func (x *SetTrayHotkeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrayHotkeyRequest.ProtoReflect.Descriptor instead.
+func (*SetTrayHotkeyRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetTrayHotkeyRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *SetTrayHotkeyRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetTrayMenuRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sections []string `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
+}
+
+func (x *SetTrayMenuRequest) Reset() {
+	*x = SetTrayMenuRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTrayMenuRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrayMenuRequest) ProtoMessage() {}
+
+func (x *SetTrayMenuRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrayMenuRequest.ProtoReflect.Descriptor instead.
+func (*SetTrayMenuRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetTrayMenuRequest) GetSections() []string {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+// SetOpenVPNDirectivesRequest replaces the directives appended to the OpenVPN config, empty list removes them
+type SetOpenVPNDirectivesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Directives []string `protobuf:"bytes,1,rep,name=directives,proto3" json:"directives,omitempty"`
+}
+
+func (x *SetOpenVPNDirectivesRequest) Reset() {
+	*x = SetOpenVPNDirectivesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOpenVPNDirectivesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOpenVPNDirectivesRequest) ProtoMessage() {}
+
+func (x *SetOpenVPNDirectivesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOpenVPNDirectivesRequest.ProtoReflect.Descriptor instead.
+func (*SetOpenVPNDirectivesRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetOpenVPNDirectivesRequest) GetDirectives() []string {
+	if x != nil {
+		return x.Directives
+	}
+	return nil
+}
+
+type SetLogLevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelRequest) ProtoMessage() {}
+
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetLogLevelRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+type SetDaemonLogLevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	// debug_components have their debug messages logged regardless of the level
+	DebugComponents []string `protobuf:"bytes,2,rep,name=debug_components,json=debugComponents,proto3" json:"debug_components,omitempty"`
+	// persist keeps the log level after the daemon is restarted
+	Persist bool `protobuf:"varint,3,opt,name=persist,proto3" json:"persist,omitempty"`
+}
+
+func (x *SetDaemonLogLevelRequest) Reset() {
+	*x = SetDaemonLogLevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDaemonLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDaemonLogLevelRequest) ProtoMessage() {}
+
+func (x *SetDaemonLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDaemonLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetDaemonLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetDaemonLogLevelRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *SetDaemonLogLevelRequest) GetDebugComponents() []string {
+	if x != nil {
+		return x.DebugComponents
+	}
+	return nil
+}
+
+func (x *SetDaemonLogLevelRequest) GetPersist() bool {
+	if x != nil {
+		return x.Persist
+	}
+	return false
+}
+
+type SetTrustedNetworkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Network:
+	//	*SetTrustedNetworkRequest_Ssid
+	//	*SetTrustedNetworkRequest_Subnet
+	Network isSetTrustedNetworkRequest_Network `protobuf_oneof:"network"`
+	Remove  bool                               `protobuf:"varint,3,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (x *SetTrustedNetworkRequest) Reset() {
+	*x = SetTrustedNetworkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTrustedNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrustedNetworkRequest) ProtoMessage() {}
+
+func (x *SetTrustedNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -765,54 +1243,83 @@ func (x *SetNotifyRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrustedNetworkRequest.ProtoReflect.Descriptor instead.
+func (*SetTrustedNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{17}
+}
+
+func (m *SetTrustedNetworkRequest) GetNetwork() isSetTrustedNetworkRequest_Network {
+	if m != nil {
+		return m.Network
+	}
+	return nil
 }
 
-// Deprecated: Use SetNotifyRequest.ProtoReflect.Descriptor instead.
-func (*SetNotifyRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{8}
+func (x *SetTrustedNetworkRequest) GetSsid() string {
+	if x, ok := x.GetNetwork().(*SetTrustedNetworkRequest_Ssid); ok {
+		return x.Ssid
+	}
+	return ""
 }
 
-func (x *SetNotifyRequest) GetUid() int64 {
-	if x != nil {
-		return x.Uid
+func (x *SetTrustedNetworkRequest) GetSubnet() string {
+	if x, ok := x.GetNetwork().(*SetTrustedNetworkRequest_Subnet); ok {
+		return x.Subnet
 	}
-	return 0
+	return ""
 }
 
-func (x *SetNotifyRequest) GetNotify() bool {
+func (x *SetTrustedNetworkRequest) GetRemove() bool {
 	if x != nil {
-		return x.Notify
+		return x.Remove
 	}
 	return false
 }
 
-type SetTrayRequest struct {
+type isSetTrustedNetworkRequest_Network interface {
+	isSetTrustedNetworkRequest_Network()
+}
+
+type SetTrustedNetworkRequest_Ssid struct {
+	Ssid string `protobuf:"bytes,1,opt,name=ssid,proto3,oneof"`
+}
+
+type SetTrustedNetworkRequest_Subnet struct {
+	Subnet string `protobuf:"bytes,2,opt,name=subnet,proto3,oneof"`
+}
+
+func (*SetTrustedNetworkRequest_Ssid) isSetTrustedNetworkRequest_Network() {}
+
+func (*SetTrustedNetworkRequest_Subnet) isSetTrustedNetworkRequest_Network() {}
+
+type SetTrustedNetworkActionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid  int64 `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
-	Tray bool  `protobuf:"varint,3,opt,name=tray,proto3" json:"tray,omitempty"`
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
 }
 
-func (x *SetTrayRequest) Reset() {
-	*x = SetTrayRequest{}
+func (x *SetTrustedNetworkActionRequest) Reset() {
+	*x = SetTrustedNetworkActionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[9]
+		mi := &file_set_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetTrayRequest) String() string {
+func (x *SetTrustedNetworkActionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetTrayRequest) ProtoMessage() {}
+func (*SetTrustedNetworkActionRequest) ProtoMessage() {}
 
-func (x *SetTrayRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[9]
+func (x *SetTrustedNetworkActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -823,23 +1330,16 @@ func (x *SetTrayRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetTrayRequest.ProtoReflect.Descriptor instead.
-func (*SetTrayRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *SetTrayRequest) GetUid() int64 {
-	if x != nil {
-		return x.Uid
-	}
-	return 0
+// Deprecated: Use SetTrustedNetworkActionRequest.ProtoReflect.Descriptor instead.
+func (*SetTrustedNetworkActionRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SetTrayRequest) GetTray() bool {
+func (x *SetTrustedNetworkActionRequest) GetAction() string {
 	if x != nil {
-		return x.Tray
+		return x.Action
 	}
-	return false
+	return ""
 }
 
 type SetProtocolRequest struct {
@@ -853,7 +1353,7 @@ type SetProtocolRequest struct {
 func (x *SetProtocolRequest) Reset() {
 	*x = SetProtocolRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[10]
+		mi := &file_set_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -866,7 +1366,7 @@ func (x *SetProtocolRequest) String() string {
 func (*SetProtocolRequest) ProtoMessage() {}
 
 func (x *SetProtocolRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[10]
+	mi := &file_set_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -879,7 +1379,7 @@ func (x *SetProtocolRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetProtocolRequest.ProtoReflect.Descriptor instead.
 func (*SetProtocolRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{10}
+	return file_set_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SetProtocolRequest) GetProtocol() config.Protocol {
@@ -895,7 +1395,6 @@ type SetProtocolResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//
 	//	*SetProtocolResponse_ErrorCode
 	//	*SetProtocolResponse_SetProtocolStatus
 	Response isSetProtocolResponse_Response `protobuf_oneof:"response"`
@@ -904,7 +1403,7 @@ type SetProtocolResponse struct {
 func (x *SetProtocolResponse) Reset() {
 	*x = SetProtocolResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[11]
+		mi := &file_set_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -917,7 +1416,7 @@ func (x *SetProtocolResponse) String() string {
 func (*SetProtocolResponse) ProtoMessage() {}
 
 func (x *SetProtocolResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[11]
+	mi := &file_set_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -930,7 +1429,7 @@ func (x *SetProtocolResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetProtocolResponse.ProtoReflect.Descriptor instead.
 func (*SetProtocolResponse) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{11}
+	return file_set_proto_rawDescGZIP(), []int{20}
 }
 
 func (m *SetProtocolResponse) GetResponse() isSetProtocolResponse_Response {
@@ -976,12 +1475,14 @@ type SetTechnologyRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Technology config.Technology `protobuf:"varint,2,opt,name=technology,proto3,enum=config.Technology" json:"technology,omitempty"`
+	// auto selects the technology automatically, technology is ignored if set
+	Auto bool `protobuf:"varint,3,opt,name=auto,proto3" json:"auto,omitempty"`
 }
 
 func (x *SetTechnologyRequest) Reset() {
 	*x = SetTechnologyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[12]
+		mi := &file_set_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -994,7 +1495,7 @@ func (x *SetTechnologyRequest) String() string {
 func (*SetTechnologyRequest) ProtoMessage() {}
 
 func (x *SetTechnologyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[12]
+	mi := &file_set_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1007,7 +1508,7 @@ func (x *SetTechnologyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetTechnologyRequest.ProtoReflect.Descriptor instead.
 func (*SetTechnologyRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{12}
+	return file_set_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *SetTechnologyRequest) GetTechnology() config.Technology {
@@ -1017,6 +1518,13 @@ func (x *SetTechnologyRequest) GetTechnology() config.Technology {
 	return config.Technology(0)
 }
 
+func (x *SetTechnologyRequest) GetAuto() bool {
+	if x != nil {
+		return x.Auto
+	}
+	return false
+}
+
 type PortRange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1029,7 +1537,7 @@ type PortRange struct {
 func (x *PortRange) Reset() {
 	*x = PortRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[13]
+		mi := &file_set_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1042,7 +1550,7 @@ func (x *PortRange) String() string {
 func (*PortRange) ProtoMessage() {}
 
 func (x *PortRange) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[13]
+	mi := &file_set_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1055,7 +1563,7 @@ func (x *PortRange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PortRange.ProtoReflect.Descriptor instead.
 func (*PortRange) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{13}
+	return file_set_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *PortRange) GetStartPort() int64 {
@@ -1083,7 +1591,7 @@ type SetAllowlistSubnetRequest struct {
 func (x *SetAllowlistSubnetRequest) Reset() {
 	*x = SetAllowlistSubnetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[14]
+		mi := &file_set_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1096,7 +1604,7 @@ func (x *SetAllowlistSubnetRequest) String() string {
 func (*SetAllowlistSubnetRequest) ProtoMessage() {}
 
 func (x *SetAllowlistSubnetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[14]
+	mi := &file_set_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1109,7 +1617,7 @@ func (x *SetAllowlistSubnetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAllowlistSubnetRequest.ProtoReflect.Descriptor instead.
 func (*SetAllowlistSubnetRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{14}
+	return file_set_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SetAllowlistSubnetRequest) GetSubnet() string {
@@ -1132,7 +1640,7 @@ type SetAllowlistPortsRequest struct {
 func (x *SetAllowlistPortsRequest) Reset() {
 	*x = SetAllowlistPortsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[15]
+		mi := &file_set_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1145,7 +1653,7 @@ func (x *SetAllowlistPortsRequest) String() string {
 func (*SetAllowlistPortsRequest) ProtoMessage() {}
 
 func (x *SetAllowlistPortsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[15]
+	mi := &file_set_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1158,7 +1666,7 @@ func (x *SetAllowlistPortsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAllowlistPortsRequest.ProtoReflect.Descriptor instead.
 func (*SetAllowlistPortsRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{15}
+	return file_set_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SetAllowlistPortsRequest) GetIsUdp() bool {
@@ -1188,7 +1696,6 @@ type SetAllowlistRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Request:
-	//
 	//	*SetAllowlistRequest_SetAllowlistSubnetRequest
 	//	*SetAllowlistRequest_SetAllowlistPortsRequest
 	Request isSetAllowlistRequest_Request `protobuf_oneof:"request"`
@@ -1197,7 +1704,7 @@ type SetAllowlistRequest struct {
 func (x *SetAllowlistRequest) Reset() {
 	*x = SetAllowlistRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[16]
+		mi := &file_set_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1210,7 +1717,7 @@ func (x *SetAllowlistRequest) String() string {
 func (*SetAllowlistRequest) ProtoMessage() {}
 
 func (x *SetAllowlistRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[16]
+	mi := &file_set_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1223,7 +1730,7 @@ func (x *SetAllowlistRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAllowlistRequest.ProtoReflect.Descriptor instead.
 func (*SetAllowlistRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{16}
+	return file_set_proto_rawDescGZIP(), []int{25}
 }
 
 func (m *SetAllowlistRequest) GetRequest() isSetAllowlistRequest_Request {
@@ -1274,7 +1781,7 @@ type SetLANDiscoveryRequest struct {
 func (x *SetLANDiscoveryRequest) Reset() {
 	*x = SetLANDiscoveryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[17]
+		mi := &file_set_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1287,7 +1794,7 @@ func (x *SetLANDiscoveryRequest) String() string {
 func (*SetLANDiscoveryRequest) ProtoMessage() {}
 
 func (x *SetLANDiscoveryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[17]
+	mi := &file_set_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1300,7 +1807,7 @@ func (x *SetLANDiscoveryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetLANDiscoveryRequest.ProtoReflect.Descriptor instead.
 func (*SetLANDiscoveryRequest) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{17}
+	return file_set_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *SetLANDiscoveryRequest) GetEnabled() bool {
@@ -1316,7 +1823,6 @@ type SetLANDiscoveryResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Response:
-	//
 	//	*SetLANDiscoveryResponse_ErrorCode
 	//	*SetLANDiscoveryResponse_SetLanDiscoveryStatus
 	Response isSetLANDiscoveryResponse_Response `protobuf_oneof:"response"`
@@ -1325,7 +1831,7 @@ type SetLANDiscoveryResponse struct {
 func (x *SetLANDiscoveryResponse) Reset() {
 	*x = SetLANDiscoveryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_set_proto_msgTypes[18]
+		mi := &file_set_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1338,7 +1844,7 @@ func (x *SetLANDiscoveryResponse) String() string {
 func (*SetLANDiscoveryResponse) ProtoMessage() {}
 
 func (x *SetLANDiscoveryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_set_proto_msgTypes[18]
+	mi := &file_set_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1351,7 +1857,7 @@ func (x *SetLANDiscoveryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetLANDiscoveryResponse.ProtoReflect.Descriptor instead.
 func (*SetLANDiscoveryResponse) Descriptor() ([]byte, []int) {
-	return file_set_proto_rawDescGZIP(), []int{18}
+	return file_set_proto_rawDescGZIP(), []int{27}
 }
 
 func (m *SetLANDiscoveryResponse) GetResponse() isSetLANDiscoveryResponse_Response {
@@ -1391,6 +1897,61 @@ func (*SetLANDiscoveryResponse_ErrorCode) isSetLANDiscoveryResponse_Response() {
 
 func (*SetLANDiscoveryResponse_SetLanDiscoveryStatus) isSetLANDiscoveryResponse_Response() {}
 
+type SetMeshnetPeerRoutesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PublicKey string   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Subnets   []string `protobuf:"bytes,2,rep,name=subnets,proto3" json:"subnets,omitempty"`
+}
+
+func (x *SetMeshnetPeerRoutesRequest) Reset() {
+	*x = SetMeshnetPeerRoutesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_set_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMeshnetPeerRoutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMeshnetPeerRoutesRequest) ProtoMessage() {}
+
+func (x *SetMeshnetPeerRoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_set_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMeshnetPeerRoutesRequest.ProtoReflect.Descriptor instead.
+func (*SetMeshnetPeerRoutesRequest) Descriptor() ([]byte, []int) {
+	return file_set_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetMeshnetPeerRoutesRequest) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *SetMeshnetPeerRoutesRequest) GetSubnets() []string {
+	if x != nil {
+		return x.Subnets
+	}
+	return nil
+}
+
 var File_set_proto protoreflect.FileDescriptor
 
 var file_set_proto_rawDesc = []byte{
@@ -1398,160 +1959,210 @@ var file_set_proto_rawDesc = []byte{
 	0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x63,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x74, 0x65, 0x63,
-	0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x50, 0x0a,
-	0x15, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x22,
-	0x2d, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71,
+	0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x74, 0x72, 0x61, 0x79, 0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x5f,
+	0x74, 0x68, 0x65, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x50, 0x0a, 0x15, 0x53,
+	0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x28,
-	0x0a, 0x10, 0x53, 0x65, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x56, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x54,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x61, 0x67, 0x22, 0x2d, 0x0a,
+	0x11, 0x53, 0x65, 0x74, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x28, 0x0a, 0x10,
+	0x53, 0x65, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x28, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x56, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x14, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x1f, 0x53, 0x65, 0x74,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x6d, 0x0a, 0x21, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52,
+	0x1d, 0x73, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x0d, 0x53, 0x65,
+	0x74, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x64, 0x6e, 0x73, 0x12, 0x34, 0x0a,
+	0x16, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x74,
 	0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
-	0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x68,
-	0x72, 0x65, 0x61, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x6c, 0x69, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x74, 0x68, 0x72, 0x65,
-	0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65,
-	0x22, 0xcf, 0x01, 0x0a, 0x1f, 0x53, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72,
-	0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65,
-	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x6d, 0x0a, 0x21, 0x73, 0x65, 0x74, 0x5f, 0x74,
-	0x68, 0x72, 0x65, 0x61, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x6c, 0x69, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61,
-	0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x1d, 0x73, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65,
-	0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x57, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x03, 0x64, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x5f,
-	0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f,
-	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x0e,
-	0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
-	0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x38, 0x0a, 0x0e, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x6e, 0x73, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53,
-	0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x73,
-	0x65, 0x74, 0x44, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x64, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4b, 0x69,
-	0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x12, 0x2b, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69,
-	0x73, 0x74, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x3c, 0x0a,
-	0x10, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
-	0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x22, 0x36, 0x0a, 0x0e, 0x53,
-	0x65, 0x74, 0x54, 0x72, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x69, 0x74, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x0e, 0x73, 0x65, 0x74,
+	0x5f, 0x64, 0x6e, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x44, 0x6e, 0x73, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x64, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x5f,
+	0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6b, 0x69,
+	0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x2b, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x3c, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x22, 0x36, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x54, 0x72, 0x61, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x72, 0x61, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x74, 0x72, 0x61, 0x79, 0x22, 0x58, 0x0a, 0x17, 0x53,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x79, 0x49, 0x63, 0x6f, 0x6e, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x54, 0x72, 0x61, 0x79, 0x49, 0x63, 0x6f, 0x6e, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x05,
+	0x74, 0x68, 0x65, 0x6d, 0x65, 0x22, 0x42, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x54, 0x72, 0x61, 0x79,
+	0x48, 0x6f, 0x74, 0x6b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
 	0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x72, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x74,
-	0x72, 0x61, 0x79, 0x22, 0x42, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x9d, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x31, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x47, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x11, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x54, 0x65,
-	0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x32, 0x0a, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x65, 0x63,
-	0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c,
-	0x6f, 0x67, 0x79, 0x22, 0x45, 0x0a, 0x09, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x33, 0x0a, 0x19, 0x53, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x22,
-	0x76, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x50,
-	0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x69,
-	0x73, 0x5f, 0x75, 0x64, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x55,
-	0x64, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x74, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x54, 0x63, 0x70, 0x12, 0x2c, 0x0a, 0x0a, 0x70, 0x6f, 0x72,
-	0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x70, 0x62, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x70, 0x6f,
-	0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xe1, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x60, 0x0a, 0x1c, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74,
-	0x5f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x19, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
-	0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x5d, 0x0a, 0x1b, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69,
-	0x73, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x18, 0x73, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
-	0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x32, 0x0a, 0x16, 0x53,
-	0x65, 0x74, 0x4c, 0x41, 0x4e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22,
-	0xae, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x4c, 0x41, 0x4e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x54,
-	0x0a, 0x18, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x61, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x41, 0x4e, 0x44, 0x69, 0x73, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x15, 0x73,
-	0x65, 0x74, 0x4c, 0x61, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2a, 0x3e, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x00, 0x12, 0x10, 0x0a,
-	0x0c, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12,
-	0x0f, 0x0a, 0x0b, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x02,
-	0x2a, 0x51, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f,
-	0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x50, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55,
-	0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x50, 0x4c, 0x5f, 0x43, 0x4f, 0x4e,
-	0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x5f, 0x44, 0x4e, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x45,
-	0x54, 0x10, 0x01, 0x2a, 0x6e, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x74, 0x61,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x30, 0x0a, 0x12, 0x53, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3d, 0x0a, 0x1b, 0x53,
+	0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x50, 0x4e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x12, 0x53, 0x65,
+	0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x75, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x44, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x62, 0x75,
+	0x67, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x22, 0x6d, 0x0a,
+	0x18, 0x53, 0x65, 0x74, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x73, 0x73, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x73, 0x73, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x42, 0x09, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0x38, 0x0a, 0x1e,
+	0x53, 0x65, 0x74, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x42, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x9d, 0x01, 0x0a, 0x13, 0x53,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x47, 0x0a, 0x13, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x11, 0x73, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5e, 0x0a, 0x14, 0x53, 0x65,
+	0x74, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x54, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x0a, 0x74, 0x65, 0x63, 0x68,
+	0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x61, 0x75, 0x74, 0x6f, 0x22, 0x45, 0x0a, 0x09, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x50, 0x6f, 0x72,
+	0x74, 0x22, 0x33, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73,
+	0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x22, 0x76, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x75, 0x64, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x55, 0x64, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f,
+	0x74, 0x63, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x54, 0x63, 0x70,
+	0x12, 0x2c, 0x0a, 0x0a, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x09, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0xe1,
+	0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x60, 0x0a, 0x1c, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x19, 0x73,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x5d, 0x0a, 0x1b, 0x73, 0x65, 0x74, 0x5f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x50,
+	0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x18, 0x73,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x32, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4c, 0x41, 0x4e, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x4c, 0x41,
+	0x4e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x54, 0x0a, 0x18, 0x73, 0x65, 0x74, 0x5f, 0x6c, 0x61, 0x6e,
+	0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74,
+	0x4c, 0x41, 0x4e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x48, 0x00, 0x52, 0x15, 0x73, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x4d, 0x65,
+	0x73, 0x68, 0x6e, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x2a,
+	0x3e, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c,
+	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x02, 0x2a,
+	0x51, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x12, 0x0a, 0x0e, 0x54, 0x50, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x50, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x5f, 0x44, 0x4e, 0x53, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54,
+	0x10, 0x01, 0x2a, 0x88, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x74, 0x61,
 	0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x4e, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49,
 	0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x4e, 0x53, 0x5f, 0x43,
 	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x5f, 0x54, 0x50, 0x4c, 0x5f, 0x52, 0x45,
 	0x53, 0x45, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
 	0x5f, 0x44, 0x4e, 0x53, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x13,
 	0x0a, 0x0f, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45,
-	0x53, 0x10, 0x03, 0x2a, 0x64, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x54,
-	0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10,
-	0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x43, 0x4f,
-	0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x5f, 0x56, 0x50, 0x4e, 0x5f, 0x4f, 0x4e, 0x10,
-	0x01, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x45, 0x43,
-	0x48, 0x4e, 0x4f, 0x4c, 0x4f, 0x47, 0x59, 0x10, 0x02, 0x2a, 0x5b, 0x0a, 0x15, 0x53, 0x65, 0x74,
-	0x4c, 0x41, 0x4e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f,
-	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24,
+	0x53, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x44,
+	0x4e, 0x53, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x04, 0x2a, 0x64, 0x0a,
+	0x11, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x50,
+	0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52,
+	0x45, 0x44, 0x5f, 0x56, 0x50, 0x4e, 0x5f, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x45, 0x43, 0x48, 0x4e, 0x4f, 0x4c, 0x4f, 0x47,
+	0x59, 0x10, 0x02, 0x2a, 0x5b, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4c, 0x41, 0x4e, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x14,
 	0x44, 0x49, 0x53, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
-	0x55, 0x52, 0x45, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52,
-	0x45, 0x53, 0x45, 0x54, 0x10, 0x01, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
-	0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f,
-	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x55, 0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x56,
+	0x45, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x44, 0x5f, 0x41,
+	0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x54, 0x10, 0x01,
+	0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e,
+	0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64,
+	0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1567,7 +2178,7 @@ func file_set_proto_rawDescGZIP() []byte {
 }
 
 var file_set_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_set_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_set_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_set_proto_goTypes = []interface{}{
 	(SetErrorCode)(0),                       // 0: pb.SetErrorCode
 	(SetThreatProtectionLiteStatus)(0),      // 1: pb.SetThreatProtectionLiteStatus
@@ -1577,46 +2188,58 @@ var file_set_proto_goTypes = []interface{}{
 	(*SetAutoconnectRequest)(nil),           // 5: pb.SetAutoconnectRequest
 	(*SetGenericRequest)(nil),               // 6: pb.SetGenericRequest
 	(*SetUint32Request)(nil),                // 7: pb.SetUint32Request
-	(*SetThreatProtectionLiteRequest)(nil),  // 8: pb.SetThreatProtectionLiteRequest
-	(*SetThreatProtectionLiteResponse)(nil), // 9: pb.SetThreatProtectionLiteResponse
-	(*SetDNSRequest)(nil),                   // 10: pb.SetDNSRequest
-	(*SetDNSResponse)(nil),                  // 11: pb.SetDNSResponse
-	(*SetKillSwitchRequest)(nil),            // 12: pb.SetKillSwitchRequest
-	(*SetNotifyRequest)(nil),                // 13: pb.SetNotifyRequest
-	(*SetTrayRequest)(nil),                  // 14: pb.SetTrayRequest
-	(*SetProtocolRequest)(nil),              // 15: pb.SetProtocolRequest
-	(*SetProtocolResponse)(nil),             // 16: pb.SetProtocolResponse
-	(*SetTechnologyRequest)(nil),            // 17: pb.SetTechnologyRequest
-	(*PortRange)(nil),                       // 18: pb.PortRange
-	(*SetAllowlistSubnetRequest)(nil),       // 19: pb.SetAllowlistSubnetRequest
-	(*SetAllowlistPortsRequest)(nil),        // 20: pb.SetAllowlistPortsRequest
-	(*SetAllowlistRequest)(nil),             // 21: pb.SetAllowlistRequest
-	(*SetLANDiscoveryRequest)(nil),          // 22: pb.SetLANDiscoveryRequest
-	(*SetLANDiscoveryResponse)(nil),         // 23: pb.SetLANDiscoveryResponse
-	(*Allowlist)(nil),                       // 24: pb.Allowlist
-	(config.Protocol)(0),                    // 25: config.Protocol
-	(config.Technology)(0),                  // 26: config.Technology
+	(*SetStringRequest)(nil),                // 8: pb.SetStringRequest
+	(*SetThreatProtectionLiteRequest)(nil),  // 9: pb.SetThreatProtectionLiteRequest
+	(*SetThreatProtectionLiteResponse)(nil), // 10: pb.SetThreatProtectionLiteResponse
+	(*SetDNSRequest)(nil),                   // 11: pb.SetDNSRequest
+	(*SetDNSResponse)(nil),                  // 12: pb.SetDNSResponse
+	(*SetKillSwitchRequest)(nil),            // 13: pb.SetKillSwitchRequest
+	(*SetNotifyRequest)(nil),                // 14: pb.SetNotifyRequest
+	(*SetTrayRequest)(nil),                  // 15: pb.SetTrayRequest
+	(*SetTrayIconThemeRequest)(nil),         // 16: pb.SetTrayIconThemeRequest
+	(*SetTrayHotkeyRequest)(nil),            // 17: pb.SetTrayHotkeyRequest
+	(*SetTrayMenuRequest)(nil),              // 18: pb.SetTrayMenuRequest
+	(*SetOpenVPNDirectivesRequest)(nil),     // 19: pb.SetOpenVPNDirectivesRequest
+	(*SetLogLevelRequest)(nil),              // 20: pb.SetLogLevelRequest
+	(*SetDaemonLogLevelRequest)(nil),        // 21: pb.SetDaemonLogLevelRequest
+	(*SetTrustedNetworkRequest)(nil),        // 22: pb.SetTrustedNetworkRequest
+	(*SetTrustedNetworkActionRequest)(nil),  // 23: pb.SetTrustedNetworkActionRequest
+	(*SetProtocolRequest)(nil),              // 24: pb.SetProtocolRequest
+	(*SetProtocolResponse)(nil),             // 25: pb.SetProtocolResponse
+	(*SetTechnologyRequest)(nil),            // 26: pb.SetTechnologyRequest
+	(*PortRange)(nil),                       // 27: pb.PortRange
+	(*SetAllowlistSubnetRequest)(nil),       // 28: pb.SetAllowlistSubnetRequest
+	(*SetAllowlistPortsRequest)(nil),        // 29: pb.SetAllowlistPortsRequest
+	(*SetAllowlistRequest)(nil),             // 30: pb.SetAllowlistRequest
+	(*SetLANDiscoveryRequest)(nil),          // 31: pb.SetLANDiscoveryRequest
+	(*SetLANDiscoveryResponse)(nil),         // 32: pb.SetLANDiscoveryResponse
+	(*SetMeshnetPeerRoutesRequest)(nil),     // 33: pb.SetMeshnetPeerRoutesRequest
+	(*Allowlist)(nil),                       // 34: pb.Allowlist
+	(config.TrayIconTheme)(0),               // 35: config.TrayIconTheme
+	(config.Protocol)(0),                    // 36: config.Protocol
+	(config.Technology)(0),                  // 37: config.Technology
 }
 var file_set_proto_depIdxs = []int32{
 	0,  // 0: pb.SetThreatProtectionLiteResponse.error_code:type_name -> pb.SetErrorCode
 	1,  // 1: pb.SetThreatProtectionLiteResponse.set_threat_protection_lite_status:type_name -> pb.SetThreatProtectionLiteStatus
 	0,  // 2: pb.SetDNSResponse.error_code:type_name -> pb.SetErrorCode
 	2,  // 3: pb.SetDNSResponse.set_dns_status:type_name -> pb.SetDNSStatus
-	24, // 4: pb.SetKillSwitchRequest.allowlist:type_name -> pb.Allowlist
-	25, // 5: pb.SetProtocolRequest.protocol:type_name -> config.Protocol
-	0,  // 6: pb.SetProtocolResponse.error_code:type_name -> pb.SetErrorCode
-	3,  // 7: pb.SetProtocolResponse.set_protocol_status:type_name -> pb.SetProtocolStatus
-	26, // 8: pb.SetTechnologyRequest.technology:type_name -> config.Technology
-	18, // 9: pb.SetAllowlistPortsRequest.port_range:type_name -> pb.PortRange
-	19, // 10: pb.SetAllowlistRequest.set_allowlist_subnet_request:type_name -> pb.SetAllowlistSubnetRequest
-	20, // 11: pb.SetAllowlistRequest.set_allowlist_ports_request:type_name -> pb.SetAllowlistPortsRequest
-	0,  // 12: pb.SetLANDiscoveryResponse.error_code:type_name -> pb.SetErrorCode
-	4,  // 13: pb.SetLANDiscoveryResponse.set_lan_discovery_status:type_name -> pb.SetLANDiscoveryStatus
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	34, // 4: pb.SetKillSwitchRequest.allowlist:type_name -> pb.Allowlist
+	35, // 5: pb.SetTrayIconThemeRequest.theme:type_name -> config.TrayIconTheme
+	36, // 6: pb.SetProtocolRequest.protocol:type_name -> config.Protocol
+	0,  // 7: pb.SetProtocolResponse.error_code:type_name -> pb.SetErrorCode
+	3,  // 8: pb.SetProtocolResponse.set_protocol_status:type_name -> pb.SetProtocolStatus
+	37, // 9: pb.SetTechnologyRequest.technology:type_name -> config.Technology
+	27, // 10: pb.SetAllowlistPortsRequest.port_range:type_name -> pb.PortRange
+	28, // 11: pb.SetAllowlistRequest.set_allowlist_subnet_request:type_name -> pb.SetAllowlistSubnetRequest
+	29, // 12: pb.SetAllowlistRequest.set_allowlist_ports_request:type_name -> pb.SetAllowlistPortsRequest
+	0,  // 13: pb.SetLANDiscoveryResponse.error_code:type_name -> pb.SetErrorCode
+	4,  // 14: pb.SetLANDiscoveryResponse.set_lan_discovery_status:type_name -> pb.SetLANDiscoveryStatus
+	15, // [15:15] is the sub-list for method output_type
+	15, // [15:15] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_set_proto_init() }
@@ -1663,7 +2286,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetThreatProtectionLiteRequest); i {
+			switch v := v.(*SetStringRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1675,7 +2298,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetThreatProtectionLiteResponse); i {
+			switch v := v.(*SetThreatProtectionLiteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1687,7 +2310,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetDNSRequest); i {
+			switch v := v.(*SetThreatProtectionLiteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1699,7 +2322,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetDNSResponse); i {
+			switch v := v.(*SetDNSRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1711,7 +2334,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetKillSwitchRequest); i {
+			switch v := v.(*SetDNSResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1723,7 +2346,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetNotifyRequest); i {
+			switch v := v.(*SetKillSwitchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1735,7 +2358,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetTrayRequest); i {
+			switch v := v.(*SetNotifyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1747,7 +2370,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetProtocolRequest); i {
+			switch v := v.(*SetTrayRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1759,7 +2382,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetProtocolResponse); i {
+			switch v := v.(*SetTrayIconThemeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1771,7 +2394,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetTechnologyRequest); i {
+			switch v := v.(*SetTrayHotkeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1783,7 +2406,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PortRange); i {
+			switch v := v.(*SetTrayMenuRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1795,7 +2418,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetAllowlistSubnetRequest); i {
+			switch v := v.(*SetOpenVPNDirectivesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1807,7 +2430,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetAllowlistPortsRequest); i {
+			switch v := v.(*SetLogLevelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1819,7 +2442,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetAllowlistRequest); i {
+			switch v := v.(*SetDaemonLogLevelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1831,7 +2454,7 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetLANDiscoveryRequest); i {
+			switch v := v.(*SetTrustedNetworkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1843,6 +2466,114 @@ func file_set_proto_init() {
 			}
 		}
 		file_set_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTrustedNetworkActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetProtocolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetProtocolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTechnologyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAllowlistSubnetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAllowlistPortsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAllowlistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLANDiscoveryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_set_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetLANDiscoveryResponse); i {
 			case 0:
 				return &v.state
@@ -1854,24 +2585,40 @@ func file_set_proto_init() {
 				return nil
 			}
 		}
+		file_set_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMeshnetPeerRoutesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_set_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_set_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*SetThreatProtectionLiteResponse_ErrorCode)(nil),
 		(*SetThreatProtectionLiteResponse_SetThreatProtectionLiteStatus)(nil),
 	}
-	file_set_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_set_proto_msgTypes[7].OneofWrappers = []interface{}{
 		(*SetDNSResponse_ErrorCode)(nil),
 		(*SetDNSResponse_SetDnsStatus)(nil),
 	}
-	file_set_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_set_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*SetTrustedNetworkRequest_Ssid)(nil),
+		(*SetTrustedNetworkRequest_Subnet)(nil),
+	}
+	file_set_proto_msgTypes[20].OneofWrappers = []interface{}{
 		(*SetProtocolResponse_ErrorCode)(nil),
 		(*SetProtocolResponse_SetProtocolStatus)(nil),
 	}
-	file_set_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_set_proto_msgTypes[25].OneofWrappers = []interface{}{
 		(*SetAllowlistRequest_SetAllowlistSubnetRequest)(nil),
 		(*SetAllowlistRequest_SetAllowlistPortsRequest)(nil),
 	}
-	file_set_proto_msgTypes[18].OneofWrappers = []interface{}{
+	file_set_proto_msgTypes[27].OneofWrappers = []interface{}{
 		(*SetLANDiscoveryResponse_ErrorCode)(nil),
 		(*SetLANDiscoveryResponse_SetLanDiscoveryStatus)(nil),
 	}
@@ -1881,7 +2628,7 @@ func file_set_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_set_proto_rawDesc,
 			NumEnums:      5,
-			NumMessages:   19,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -170,6 +170,45 @@ type Settings struct {
 	VirtualLocation      bool                  `protobuf:"varint,16,opt,name=virtualLocation,proto3" json:"virtualLocation,omitempty"`
 	PostquantumVpn       bool                  `protobuf:"varint,17,opt,name=postquantum_vpn,json=postquantumVpn,proto3" json:"postquantum_vpn,omitempty"`
 	UserSettings         *UserSpecificSettings `protobuf:"bytes,18,opt,name=user_settings,json=userSettings,proto3" json:"user_settings,omitempty"`
+	TrayMinimal          bool                  `protobuf:"varint,19,opt,name=tray_minimal,json=trayMinimal,proto3" json:"tray_minimal,omitempty"`
+	TrayMenu             []string              `protobuf:"bytes,20,rep,name=tray_menu,json=trayMenu,proto3" json:"tray_menu,omitempty"`
+	// log level of the user services
+	LogLevel        string           `protobuf:"bytes,21,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
+	TrustedNetworks *TrustedNetworks `protobuf:"bytes,22,opt,name=trusted_networks,json=trustedNetworks,proto3" json:"trusted_networks,omitempty"`
+	// MTU of the VPN tunnel, 0 if it is detected after connecting
+	Mtu uint32 `protobuf:"varint,23,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	// OpenVPN server port, 0 if the default port of the protocol is used
+	OpenvpnPort uint32 `protobuf:"varint,24,opt,name=openvpn_port,json=openvpnPort,proto3" json:"openvpn_port,omitempty"`
+	// log level currently used by the daemon
+	DaemonLogLevel string `protobuf:"bytes,25,opt,name=daemon_log_level,json=daemonLogLevel,proto3" json:"daemon_log_level,omitempty"`
+	// components of the daemon with debug logging enabled
+	DebugComponents []string `protobuf:"bytes,26,rep,name=debug_components,json=debugComponents,proto3" json:"debug_components,omitempty"`
+	// auto-connect, server list refreshes and queued file sends are postponed on metered networks
+	DeferOnMetered bool `protobuf:"varint,27,opt,name=defer_on_metered,json=deferOnMetered,proto3" json:"defer_on_metered,omitempty"`
+	// obfuscated servers are used when the regular connection fails
+	ObfuscationFallback bool `protobuf:"varint,28,opt,name=obfuscation_fallback,json=obfuscationFallback,proto3" json:"obfuscation_fallback,omitempty"`
+	// highest load in percent of the recommended servers, zero means no limit
+	MaxServerLoad    uint32            `protobuf:"varint,29,opt,name=max_server_load,json=maxServerLoad,proto3" json:"max_server_load,omitempty"`
+	ConnectRetry     *ConnectRetry     `protobuf:"bytes,30,opt,name=connect_retry,json=connectRetry,proto3" json:"connect_retry,omitempty"`
+	AnalyticsConsent *AnalyticsConsent `protobuf:"bytes,31,opt,name=analytics_consent,json=analyticsConsent,proto3" json:"analytics_consent,omitempty"`
+	Stealth          *Stealth          `protobuf:"bytes,32,opt,name=stealth,proto3" json:"stealth,omitempty"`
+	// name of the tunnel interface, empty if the default name of the technology is used
+	InterfaceName string `protobuf:"bytes,33,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	// ID of the custom routing table of the VPN routes, 0 if the default ID is used
+	RoutingTable uint32 `protobuf:"varint,34,opt,name=routing_table,json=routingTable,proto3" json:"routing_table,omitempty"`
+	// interval of the NordLynx keepalive packets in seconds, 0 if the default interval is used
+	PersistentKeepalive uint32 `protobuf:"varint,35,opt,name=persistent_keepalive,json=persistentKeepalive,proto3" json:"persistent_keepalive,omitempty"`
+	// sensitivity of the tunnel health checks: off, low, normal or high
+	HealthMonitor   string           `protobuf:"bytes,36,opt,name=health_monitor,json=healthMonitor,proto3" json:"health_monitor,omitempty"`
+	ConnectTimeouts *ConnectTimeouts `protobuf:"bytes,37,opt,name=connect_timeouts,json=connectTimeouts,proto3" json:"connect_timeouts,omitempty"`
+	// technology is selected automatically by trying NordLynx, OpenVPN UDP, OpenVPN TCP and obfuscated servers in order
+	AutoTechnology bool `protobuf:"varint,38,opt,name=auto_technology,json=autoTechnology,proto3" json:"auto_technology,omitempty"`
+	// directives appended to the OpenVPN config
+	OpenvpnDirectives []string `protobuf:"bytes,39,rep,name=openvpn_directives,json=openvpnDirectives,proto3" json:"openvpn_directives,omitempty"`
+	// metric of the VPN default routes, 0 if the kernel default is used
+	RouteMetric uint32 `protobuf:"varint,40,opt,name=route_metric,json=routeMetric,proto3" json:"route_metric,omitempty"`
+	// maximum number of files in a single fileshare transfer, 0 if the default limit is used
+	FileshareFileLimit uint32 `protobuf:"varint,41,opt,name=fileshare_file_limit,json=fileshareFileLimit,proto3" json:"fileshare_file_limit,omitempty"`
 }
 
 func (x *Settings) Reset() {
@@ -330,20 +369,551 @@ func (x *Settings) GetUserSettings() *UserSpecificSettings {
 	return nil
 }
 
+func (x *Settings) GetTrayMinimal() bool {
+	if x != nil {
+		return x.TrayMinimal
+	}
+	return false
+}
+
+func (x *Settings) GetTrayMenu() []string {
+	if x != nil {
+		return x.TrayMenu
+	}
+	return nil
+}
+
+func (x *Settings) GetLogLevel() string {
+	if x != nil {
+		return x.LogLevel
+	}
+	return ""
+}
+
+func (x *Settings) GetTrustedNetworks() *TrustedNetworks {
+	if x != nil {
+		return x.TrustedNetworks
+	}
+	return nil
+}
+
+func (x *Settings) GetMtu() uint32 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *Settings) GetOpenvpnPort() uint32 {
+	if x != nil {
+		return x.OpenvpnPort
+	}
+	return 0
+}
+
+func (x *Settings) GetDaemonLogLevel() string {
+	if x != nil {
+		return x.DaemonLogLevel
+	}
+	return ""
+}
+
+func (x *Settings) GetDebugComponents() []string {
+	if x != nil {
+		return x.DebugComponents
+	}
+	return nil
+}
+
+func (x *Settings) GetDeferOnMetered() bool {
+	if x != nil {
+		return x.DeferOnMetered
+	}
+	return false
+}
+
+func (x *Settings) GetObfuscationFallback() bool {
+	if x != nil {
+		return x.ObfuscationFallback
+	}
+	return false
+}
+
+func (x *Settings) GetMaxServerLoad() uint32 {
+	if x != nil {
+		return x.MaxServerLoad
+	}
+	return 0
+}
+
+func (x *Settings) GetConnectRetry() *ConnectRetry {
+	if x != nil {
+		return x.ConnectRetry
+	}
+	return nil
+}
+
+func (x *Settings) GetAnalyticsConsent() *AnalyticsConsent {
+	if x != nil {
+		return x.AnalyticsConsent
+	}
+	return nil
+}
+
+func (x *Settings) GetStealth() *Stealth {
+	if x != nil {
+		return x.Stealth
+	}
+	return nil
+}
+
+func (x *Settings) GetInterfaceName() string {
+	if x != nil {
+		return x.InterfaceName
+	}
+	return ""
+}
+
+func (x *Settings) GetRoutingTable() uint32 {
+	if x != nil {
+		return x.RoutingTable
+	}
+	return 0
+}
+
+func (x *Settings) GetPersistentKeepalive() uint32 {
+	if x != nil {
+		return x.PersistentKeepalive
+	}
+	return 0
+}
+
+func (x *Settings) GetHealthMonitor() string {
+	if x != nil {
+		return x.HealthMonitor
+	}
+	return ""
+}
+
+func (x *Settings) GetConnectTimeouts() *ConnectTimeouts {
+	if x != nil {
+		return x.ConnectTimeouts
+	}
+	return nil
+}
+
+func (x *Settings) GetAutoTechnology() bool {
+	if x != nil {
+		return x.AutoTechnology
+	}
+	return false
+}
+
+func (x *Settings) GetOpenvpnDirectives() []string {
+	if x != nil {
+		return x.OpenvpnDirectives
+	}
+	return nil
+}
+
+func (x *Settings) GetRouteMetric() uint32 {
+	if x != nil {
+		return x.RouteMetric
+	}
+	return 0
+}
+
+func (x *Settings) GetFileshareFileLimit() uint32 {
+	if x != nil {
+		return x.FileshareFileLimit
+	}
+	return 0
+}
+
+// Stealth runs OpenVPN over TCP 443 for the networks where only the web ports are open
+type Stealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// proxy the connection goes through, e.g. socks5://10.0.0.1:1080, empty if it is not used
+	Proxy string `protobuf:"bytes,2,opt,name=proxy,proto3" json:"proxy,omitempty"`
+}
+
+func (x *Stealth) Reset() {
+	*x = Stealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_settings_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stealth) ProtoMessage() {}
+
+func (x *Stealth) ProtoReflect() protoreflect.Message {
+	mi := &file_settings_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stealth.ProtoReflect.Descriptor instead.
+func (*Stealth) Descriptor() ([]byte, []int) {
+	return file_settings_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Stealth) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Stealth) GetProxy() string {
+	if x != nil {
+		return x.Proxy
+	}
+	return ""
+}
+
+// AnalyticsConsent lists the analytics categories the user has consented to
+type AnalyticsConsent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Crash       bool `protobuf:"varint,1,opt,name=crash,proto3" json:"crash,omitempty"`
+	Usage       bool `protobuf:"varint,2,opt,name=usage,proto3" json:"usage,omitempty"`
+	Performance bool `protobuf:"varint,3,opt,name=performance,proto3" json:"performance,omitempty"`
+}
+
+func (x *AnalyticsConsent) Reset() {
+	*x = AnalyticsConsent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_settings_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnalyticsConsent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyticsConsent) ProtoMessage() {}
+
+func (x *AnalyticsConsent) ProtoReflect() protoreflect.Message {
+	mi := &file_settings_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyticsConsent.ProtoReflect.Descriptor instead.
+func (*AnalyticsConsent) Descriptor() ([]byte, []int) {
+	return file_settings_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AnalyticsConsent) GetCrash() bool {
+	if x != nil {
+		return x.Crash
+	}
+	return false
+}
+
+func (x *AnalyticsConsent) GetUsage() bool {
+	if x != nil {
+		return x.Usage
+	}
+	return false
+}
+
+func (x *AnalyticsConsent) GetPerformance() bool {
+	if x != nil {
+		return x.Performance
+	}
+	return false
+}
+
+// ConnectRetry controls how the failed connection attempts are retried, zero values mean the defaults
+type ConnectRetry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// attempts is the total number of the connection attempts including the first one
+	Attempts uint32 `protobuf:"varint,1,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// attempt_timeout_seconds limits a single connection attempt, zero means no limit
+	AttemptTimeoutSeconds uint32 `protobuf:"varint,2,opt,name=attempt_timeout_seconds,json=attemptTimeoutSeconds,proto3" json:"attempt_timeout_seconds,omitempty"`
+	// backoff_seconds is the delay before the first retry, it is doubled after every next one
+	BackoffSeconds uint32 `protobuf:"varint,3,opt,name=backoff_seconds,json=backoffSeconds,proto3" json:"backoff_seconds,omitempty"`
+}
+
+func (x *ConnectRetry) Reset() {
+	*x = ConnectRetry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_settings_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectRetry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectRetry) ProtoMessage() {}
+
+func (x *ConnectRetry) ProtoReflect() protoreflect.Message {
+	mi := &file_settings_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectRetry.ProtoReflect.Descriptor instead.
+func (*ConnectRetry) Descriptor() ([]byte, []int) {
+	return file_settings_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ConnectRetry) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *ConnectRetry) GetAttemptTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.AttemptTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ConnectRetry) GetBackoffSeconds() uint32 {
+	if x != nil {
+		return x.BackoffSeconds
+	}
+	return 0
+}
+
+// ConnectTimeouts override how long the technologies wait while connecting, zero values mean the defaults
+type ConnectTimeouts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// nordlynx_handshake_seconds limits the wait for the first handshake with the NordLynx server
+	NordlynxHandshakeSeconds uint32 `protobuf:"varint,1,opt,name=nordlynx_handshake_seconds,json=nordlynxHandshakeSeconds,proto3" json:"nordlynx_handshake_seconds,omitempty"`
+	// openvpn_connect_seconds limits the wait for the OpenVPN server to respond
+	OpenvpnConnectSeconds uint32 `protobuf:"varint,2,opt,name=openvpn_connect_seconds,json=openvpnConnectSeconds,proto3" json:"openvpn_connect_seconds,omitempty"`
+	// openvpn_handshake_seconds limits the TLS handshake with the OpenVPN server
+	OpenvpnHandshakeSeconds uint32 `protobuf:"varint,3,opt,name=openvpn_handshake_seconds,json=openvpnHandshakeSeconds,proto3" json:"openvpn_handshake_seconds,omitempty"`
+}
+
+func (x *ConnectTimeouts) Reset() {
+	*x = ConnectTimeouts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_settings_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectTimeouts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectTimeouts) ProtoMessage() {}
+
+func (x *ConnectTimeouts) ProtoReflect() protoreflect.Message {
+	mi := &file_settings_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectTimeouts.ProtoReflect.Descriptor instead.
+func (*ConnectTimeouts) Descriptor() ([]byte, []int) {
+	return file_settings_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ConnectTimeouts) GetNordlynxHandshakeSeconds() uint32 {
+	if x != nil {
+		return x.NordlynxHandshakeSeconds
+	}
+	return 0
+}
+
+func (x *ConnectTimeouts) GetOpenvpnConnectSeconds() uint32 {
+	if x != nil {
+		return x.OpenvpnConnectSeconds
+	}
+	return 0
+}
+
+func (x *ConnectTimeouts) GetOpenvpnHandshakeSeconds() uint32 {
+	if x != nil {
+		return x.OpenvpnHandshakeSeconds
+	}
+	return 0
+}
+
+// ImportSettingsRequest holds the settings document created by ExportSettings
+type ImportSettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings string `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+}
+
+func (x *ImportSettingsRequest) Reset() {
+	*x = ImportSettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_settings_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSettingsRequest) ProtoMessage() {}
+
+func (x *ImportSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_settings_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSettingsRequest.ProtoReflect.Descriptor instead.
+func (*ImportSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_settings_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ImportSettingsRequest) GetSettings() string {
+	if x != nil {
+		return x.Settings
+	}
+	return ""
+}
+
+// TrustedNetworks are skipped by auto-connect
+type TrustedNetworks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ssids   []string `protobuf:"bytes,1,rep,name=ssids,proto3" json:"ssids,omitempty"`
+	Subnets []string `protobuf:"bytes,2,rep,name=subnets,proto3" json:"subnets,omitempty"`
+	// what happens after joining the trusted network: skip or disconnect
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *TrustedNetworks) Reset() {
+	*x = TrustedNetworks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_settings_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrustedNetworks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustedNetworks) ProtoMessage() {}
+
+func (x *TrustedNetworks) ProtoReflect() protoreflect.Message {
+	mi := &file_settings_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustedNetworks.ProtoReflect.Descriptor instead.
+func (*TrustedNetworks) Descriptor() ([]byte, []int) {
+	return file_settings_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TrustedNetworks) GetSsids() []string {
+	if x != nil {
+		return x.Ssids
+	}
+	return nil
+}
+
+func (x *TrustedNetworks) GetSubnets() []string {
+	if x != nil {
+		return x.Subnets
+	}
+	return nil
+}
+
+func (x *TrustedNetworks) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
 type UserSpecificSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uid    int64 `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	Notify bool  `protobuf:"varint,2,opt,name=notify,proto3" json:"notify,omitempty"`
-	Tray   bool  `protobuf:"varint,3,opt,name=tray,proto3" json:"tray,omitempty"`
+	Uid           int64                `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Notify        bool                 `protobuf:"varint,2,opt,name=notify,proto3" json:"notify,omitempty"`
+	Tray          bool                 `protobuf:"varint,3,opt,name=tray,proto3" json:"tray,omitempty"`
+	TrayIconTheme config.TrayIconTheme `protobuf:"varint,4,opt,name=tray_icon_theme,json=trayIconTheme,proto3,enum=config.TrayIconTheme" json:"tray_icon_theme,omitempty"`
+	TrayHotkey    bool                 `protobuf:"varint,5,opt,name=tray_hotkey,json=trayHotkey,proto3" json:"tray_hotkey,omitempty"`
 }
 
 func (x *UserSpecificSettings) Reset() {
 	*x = UserSpecificSettings{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_settings_proto_msgTypes[3]
+		mi := &file_settings_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -356,7 +926,7 @@ func (x *UserSpecificSettings) String() string {
 func (*UserSpecificSettings) ProtoMessage() {}
 
 func (x *UserSpecificSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_settings_proto_msgTypes[3]
+	mi := &file_settings_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,7 +939,7 @@ func (x *UserSpecificSettings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserSpecificSettings.ProtoReflect.Descriptor instead.
 func (*UserSpecificSettings) Descriptor() ([]byte, []int) {
-	return file_settings_proto_rawDescGZIP(), []int{3}
+	return file_settings_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UserSpecificSettings) GetUid() int64 {
@@ -393,6 +963,20 @@ func (x *UserSpecificSettings) GetTray() bool {
 	return false
 }
 
+func (x *UserSpecificSettings) GetTrayIconTheme() config.TrayIconTheme {
+	if x != nil {
+		return x.TrayIconTheme
+	}
+	return config.TrayIconTheme(0)
+}
+
+func (x *UserSpecificSettings) GetTrayHotkey() bool {
+	if x != nil {
+		return x.TrayHotkey
+	}
+	return false
+}
+
 var File_settings_proto protoreflect.FileDescriptor
 
 var file_settings_proto_rawDesc = []byte{
@@ -402,69 +986,179 @@ var file_settings_proto_rawDesc = []byte{
 	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x63, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x48, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
-	0x62, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x91, 0x01, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x0c,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x22, 0xb2, 0x05, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x73, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54,
-	0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e,
-	0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c,
-	0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74,
-	0x63, 0x68, 0x12, 0x3f, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x70, 0x62, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x0f, 0x61, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x04, 0x69, 0x70, 0x76, 0x36, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x68, 0x6e,
-	0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x66,
-	0x77, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x77, 0x6d,
-	0x61, 0x72, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63,
-	0x73, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03,
-	0x64, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x5f, 0x70, 0x72,
-	0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x14, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x6e, 0x5f, 0x64,
-	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
-	0x6c, 0x61, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x09,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x09,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x62, 0x66,
-	0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x62,
-	0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75,
-	0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x74, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x75, 0x6d,
-	0x5f, 0x76, 0x70, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x6f, 0x73, 0x74,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x75, 0x6d, 0x56, 0x70, 0x6e, 0x12, 0x3d, 0x0a, 0x0d, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x0c, 0x75, 0x73, 0x65,
-	0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x54, 0x0a, 0x14, 0x55, 0x73, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x74,
+	0x72, 0x61, 0x79, 0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x65, 0x6d, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x48, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x91,
+	0x01, 0x0a, 0x0f, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x0c, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x22, 0x92, 0x0d, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x32, 0x0a, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x54, 0x65, 0x63,
+	0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x52, 0x0a, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x12, 0x3f, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x0f, 0x61, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x69, 0x70, 0x76, 0x36, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x77, 0x6d,
+	0x61, 0x72, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x66, 0x77, 0x6d, 0x61, 0x72,
+	0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x64, 0x6e,
+	0x73, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x14, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x61, 0x6e, 0x5f, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6c, 0x61,
+	0x6e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x09, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x62, 0x66, 0x75, 0x73,
+	0x63, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x62, 0x66, 0x75,
+	0x73, 0x63, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x27, 0x0a, 0x0f, 0x70, 0x6f, 0x73, 0x74, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x75, 0x6d, 0x5f, 0x76,
+	0x70, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x6f, 0x73, 0x74, 0x71, 0x75,
+	0x61, 0x6e, 0x74, 0x75, 0x6d, 0x56, 0x70, 0x6e, 0x12, 0x3d, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x63, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x0c, 0x75, 0x73, 0x65, 0x72, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x79, 0x5f,
+	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74,
+	0x72, 0x61, 0x79, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x72,
+	0x61, 0x79, 0x5f, 0x6d, 0x65, 0x6e, 0x75, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x72, 0x61, 0x79, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x3e, 0x0a, 0x10, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x73, 0x52, 0x0f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x6d, 0x74, 0x75, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x76, 0x70,
+	0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x70,
+	0x65, 0x6e, 0x76, 0x70, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x19, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28,
+	0x0a, 0x10, 0x64, 0x65, 0x66, 0x65, 0x72, 0x5f, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x65, 0x66, 0x65, 0x72, 0x4f,
+	0x6e, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x6f, 0x62, 0x66, 0x75,
+	0x73, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x0f, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x1d,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4c,
+	0x6f, 0x61, 0x64, 0x12, 0x35, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x41, 0x0a, 0x11, 0x61, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x18,
+	0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x74, 0x69, 0x63, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x10, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x07, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x07, 0x73, 0x74, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x22, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x31, 0x0a, 0x14, 0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x6b,
+	0x65, 0x65, 0x70, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
+	0x70, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x4b, 0x65, 0x65, 0x70, 0x61, 0x6c,
+	0x69, 0x76, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x6d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x10, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x25,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x75,
+	0x74, 0x6f, 0x5f, 0x74, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c, 0x6f, 0x67, 0x79, 0x18, 0x26, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x6f, 0x6c,
+	0x6f, 0x67, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x70, 0x65, 0x6e, 0x76, 0x70, 0x6e, 0x5f, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x73, 0x18, 0x27, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x11, 0x6f, 0x70, 0x65, 0x6e, 0x76, 0x70, 0x6e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x30, 0x0a, 0x14, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x29, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x12, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x39, 0x0a, 0x07, 0x53, 0x74, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x22, 0x60, 0x0a, 0x10, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x72, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x72, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x75, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x6e, 0x63, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x73, 0x12, 0x36, 0x0a, 0x17, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x15, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x61, 0x63,
+	0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x6e, 0x6f, 0x72, 0x64, 0x6c, 0x79,
+	0x6e, 0x78, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x18, 0x6e, 0x6f, 0x72, 0x64,
+	0x6c, 0x79, 0x6e, 0x78, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x6f, 0x70, 0x65, 0x6e, 0x76, 0x70, 0x6e, 0x5f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x6f, 0x70, 0x65, 0x6e, 0x76, 0x70, 0x6e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x19,
+	0x6f, 0x70, 0x65, 0x6e, 0x76, 0x70, 0x6e, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b,
+	0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x17, 0x6f, 0x70, 0x65, 0x6e, 0x76, 0x70, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b,
+	0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x33, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x59, 0x0a,
+	0x0f, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x73, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x73, 0x69, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb4, 0x01, 0x0a, 0x14, 0x55, 0x73, 0x65,
 	0x72, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
 	0x73, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
 	0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x08, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x72, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x74, 0x72, 0x61, 0x79, 0x42,
+	0x72, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x74, 0x72, 0x61, 0x79, 0x12,
+	0x3d, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x79, 0x5f, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x65,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x54, 0x72, 0x61, 0x79, 0x49, 0x63, 0x6f, 0x6e, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x52,
+	0x0d, 0x74, 0x72, 0x61, 0x79, 0x49, 0x63, 0x6f, 0x6e, 0x54, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x79, 0x5f, 0x68, 0x6f, 0x74, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x79, 0x48, 0x6f, 0x74, 0x6b, 0x65, 0x79, 0x42,
 	0x31, 0x5a, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f,
 	0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76,
 	0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f,
@@ -483,30 +1177,43 @@ func file_settings_proto_rawDescGZIP() []byte {
 	return file_settings_proto_rawDescData
 }
 
-var file_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_settings_proto_goTypes = []interface{}{
-	(*SettingsResponse)(nil),     // 0: pb.SettingsResponse
-	(*AutoconnectData)(nil),      // 1: pb.AutoconnectData
-	(*Settings)(nil),             // 2: pb.Settings
-	(*UserSpecificSettings)(nil), // 3: pb.UserSpecificSettings
-	(config.ServerGroup)(0),      // 4: config.ServerGroup
-	(config.Technology)(0),       // 5: config.Technology
-	(config.Protocol)(0),         // 6: config.Protocol
-	(*Allowlist)(nil),            // 7: pb.Allowlist
+	(*SettingsResponse)(nil),      // 0: pb.SettingsResponse
+	(*AutoconnectData)(nil),       // 1: pb.AutoconnectData
+	(*Settings)(nil),              // 2: pb.Settings
+	(*Stealth)(nil),               // 3: pb.Stealth
+	(*AnalyticsConsent)(nil),      // 4: pb.AnalyticsConsent
+	(*ConnectRetry)(nil),          // 5: pb.ConnectRetry
+	(*ConnectTimeouts)(nil),       // 6: pb.ConnectTimeouts
+	(*ImportSettingsRequest)(nil), // 7: pb.ImportSettingsRequest
+	(*TrustedNetworks)(nil),       // 8: pb.TrustedNetworks
+	(*UserSpecificSettings)(nil),  // 9: pb.UserSpecificSettings
+	(config.ServerGroup)(0),       // 10: config.ServerGroup
+	(config.Technology)(0),        // 11: config.Technology
+	(config.Protocol)(0),          // 12: config.Protocol
+	(*Allowlist)(nil),             // 13: pb.Allowlist
+	(config.TrayIconTheme)(0),     // 14: config.TrayIconTheme
 }
 var file_settings_proto_depIdxs = []int32{
-	2, // 0: pb.SettingsResponse.data:type_name -> pb.Settings
-	4, // 1: pb.AutoconnectData.server_group:type_name -> config.ServerGroup
-	5, // 2: pb.Settings.technology:type_name -> config.Technology
-	1, // 3: pb.Settings.auto_connect_data:type_name -> pb.AutoconnectData
-	6, // 4: pb.Settings.protocol:type_name -> config.Protocol
-	7, // 5: pb.Settings.allowlist:type_name -> pb.Allowlist
-	3, // 6: pb.Settings.user_settings:type_name -> pb.UserSpecificSettings
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	2,  // 0: pb.SettingsResponse.data:type_name -> pb.Settings
+	10, // 1: pb.AutoconnectData.server_group:type_name -> config.ServerGroup
+	11, // 2: pb.Settings.technology:type_name -> config.Technology
+	1,  // 3: pb.Settings.auto_connect_data:type_name -> pb.AutoconnectData
+	12, // 4: pb.Settings.protocol:type_name -> config.Protocol
+	13, // 5: pb.Settings.allowlist:type_name -> pb.Allowlist
+	9,  // 6: pb.Settings.user_settings:type_name -> pb.UserSpecificSettings
+	8,  // 7: pb.Settings.trusted_networks:type_name -> pb.TrustedNetworks
+	5,  // 8: pb.Settings.connect_retry:type_name -> pb.ConnectRetry
+	4,  // 9: pb.Settings.analytics_consent:type_name -> pb.AnalyticsConsent
+	3,  // 10: pb.Settings.stealth:type_name -> pb.Stealth
+	6,  // 11: pb.Settings.connect_timeouts:type_name -> pb.ConnectTimeouts
+	14, // 12: pb.UserSpecificSettings.tray_icon_theme:type_name -> config.TrayIconTheme
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_settings_proto_init() }
@@ -553,6 +1260,78 @@ func file_settings_proto_init() {
 			}
 		}
 		file_settings_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_settings_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnalyticsConsent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_settings_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectRetry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_settings_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectTimeouts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_settings_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_settings_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrustedNetworks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_settings_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserSpecificSettings); i {
 			case 0:
 				return &v.state
@@ -571,7 +1350,7 @@ func file_settings_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_settings_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,367 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: split_tunnel.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SplitTunnelApp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// executable name or absolute path of the executable
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// true if any process of the app is running
+	Running bool `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`
+}
+
+func (x *SplitTunnelApp) Reset() {
+	*x = SplitTunnelApp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_split_tunnel_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplitTunnelApp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitTunnelApp) ProtoMessage() {}
+
+func (x *SplitTunnelApp) ProtoReflect() protoreflect.Message {
+	mi := &file_split_tunnel_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitTunnelApp.ProtoReflect.Descriptor instead.
+func (*SplitTunnelApp) Descriptor() ([]byte, []int) {
+	return file_split_tunnel_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SplitTunnelApp) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SplitTunnelApp) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+// SplitTunnel lists the apps which are routed differently than the rest of the system. In exclude mode the apps
+// bypass VPN, in include mode only the apps use VPN.
+type SplitTunnel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode string            `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Apps []*SplitTunnelApp `protobuf:"bytes,2,rep,name=apps,proto3" json:"apps,omitempty"`
+}
+
+func (x *SplitTunnel) Reset() {
+	*x = SplitTunnel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_split_tunnel_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplitTunnel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitTunnel) ProtoMessage() {}
+
+func (x *SplitTunnel) ProtoReflect() protoreflect.Message {
+	mi := &file_split_tunnel_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitTunnel.ProtoReflect.Descriptor instead.
+func (*SplitTunnel) Descriptor() ([]byte, []int) {
+	return file_split_tunnel_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SplitTunnel) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *SplitTunnel) GetApps() []*SplitTunnelApp {
+	if x != nil {
+		return x.Apps
+	}
+	return nil
+}
+
+type SetSplitTunnelAppRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	App    string `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+	Remove bool   `protobuf:"varint,2,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (x *SetSplitTunnelAppRequest) Reset() {
+	*x = SetSplitTunnelAppRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_split_tunnel_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSplitTunnelAppRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSplitTunnelAppRequest) ProtoMessage() {}
+
+func (x *SetSplitTunnelAppRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_split_tunnel_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSplitTunnelAppRequest.ProtoReflect.Descriptor instead.
+func (*SetSplitTunnelAppRequest) Descriptor() ([]byte, []int) {
+	return file_split_tunnel_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetSplitTunnelAppRequest) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *SetSplitTunnelAppRequest) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
+type SetSplitTunnelModeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+}
+
+func (x *SetSplitTunnelModeRequest) Reset() {
+	*x = SetSplitTunnelModeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_split_tunnel_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSplitTunnelModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSplitTunnelModeRequest) ProtoMessage() {}
+
+func (x *SetSplitTunnelModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_split_tunnel_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSplitTunnelModeRequest.ProtoReflect.Descriptor instead.
+func (*SetSplitTunnelModeRequest) Descriptor() ([]byte, []int) {
+	return file_split_tunnel_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SetSplitTunnelModeRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+var File_split_tunnel_proto protoreflect.FileDescriptor
+
+var file_split_tunnel_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x5f, 0x74, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x70, 0x62, 0x22, 0x3e, 0x0a, 0x0e, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x49, 0x0a, 0x0b, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x61,
+	0x70, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x53,
+	0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x52, 0x04, 0x61,
+	0x70, 0x70, 0x73, 0x22, 0x44, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70,
+	0x70, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x2f, 0x0a, 0x19, 0x53, 0x65, 0x74,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69,
+	0x6e, 0x75, 0x78, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_split_tunnel_proto_rawDescOnce sync.Once
+	file_split_tunnel_proto_rawDescData = file_split_tunnel_proto_rawDesc
+)
+
+func file_split_tunnel_proto_rawDescGZIP() []byte {
+	file_split_tunnel_proto_rawDescOnce.Do(func() {
+		file_split_tunnel_proto_rawDescData = protoimpl.X.CompressGZIP(file_split_tunnel_proto_rawDescData)
+	})
+	return file_split_tunnel_proto_rawDescData
+}
+
+var file_split_tunnel_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_split_tunnel_proto_goTypes = []interface{}{
+	(*SplitTunnelApp)(nil),            // 0: pb.SplitTunnelApp
+	(*SplitTunnel)(nil),               // 1: pb.SplitTunnel
+	(*SetSplitTunnelAppRequest)(nil),  // 2: pb.SetSplitTunnelAppRequest
+	(*SetSplitTunnelModeRequest)(nil), // 3: pb.SetSplitTunnelModeRequest
+}
+var file_split_tunnel_proto_depIdxs = []int32{
+	0, // 0: pb.SplitTunnel.apps:type_name -> pb.SplitTunnelApp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_split_tunnel_proto_init() }
+func file_split_tunnel_proto_init() {
+	if File_split_tunnel_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_split_tunnel_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitTunnelApp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_split_tunnel_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitTunnel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_split_tunnel_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSplitTunnelAppRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_split_tunnel_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSplitTunnelModeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_split_tunnel_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_split_tunnel_proto_goTypes,
+		DependencyIndexes: file_split_tunnel_proto_depIdxs,
+		MessageInfos:      file_split_tunnel_proto_msgTypes,
+	}.Build()
+	File_split_tunnel_proto = out.File
+	file_split_tunnel_proto_rawDesc = nil
+	file_split_tunnel_proto_goTypes = nil
+	file_split_tunnel_proto_depIdxs = nil
+}
@@ -0,0 +1,130 @@
+package daemon
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// PendingActionIDPrefix distinguishes daemon actions from the ones queued by other services
+const PendingActionIDPrefix = "vpn-"
+
+// PendingActionKind identifies what the queued action does. Only the latest action of each kind is
+// kept because it reflects the current intent of the user.
+type PendingActionKind string
+
+const (
+	PendingActionConnect PendingActionKind = "connect"
+)
+
+// PendingAction is an action requested while the device was offline
+type PendingAction struct {
+	ID          string
+	Kind        PendingActionKind
+	Description string
+	Created     time.Time
+	run         func()
+}
+
+// PendingActions queues the user actions while there is no network and executes them once
+// the network is back
+type PendingActions struct {
+	mu       sync.Mutex
+	lastID   uint64
+	actions  []PendingAction
+	isOnline func() bool
+}
+
+// NewPendingActions creates a queue which uses isOnline to detect the offline state
+func NewPendingActions(isOnline func() bool) *PendingActions {
+	return &PendingActions{isOnline: isOnline}
+}
+
+// IsOffline reports whether there is no network to execute the actions
+func (p *PendingActions) IsOffline() bool {
+	return !p.isOnline()
+}
+
+// Add queues the action replacing the previously queued action of the same kind. The ID of the
+// queued action is returned.
+func (p *PendingActions) Add(kind PendingActionKind, description string, run func()) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.removeKind(kind)
+	p.lastID++
+	action := PendingAction{
+		ID:          fmt.Sprintf("%s%d", PendingActionIDPrefix, p.lastID),
+		Kind:        kind,
+		Description: description,
+		Created:     time.Now(),
+		run:         run,
+	}
+	p.actions = append(p.actions, action)
+	log.Println(internal.InfoPrefix, "queued action until the network is back:", action.ID, description)
+	return action.ID
+}
+
+// List returns the queued actions from oldest to newest
+func (p *PendingActions) List() []PendingAction {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	actions := make([]PendingAction, len(p.actions))
+	copy(actions, p.actions)
+	return actions
+}
+
+// Cancel removes the action with the given ID from the queue. false is returned if there is no
+// such action.
+func (p *PendingActions) Cancel(id string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for i, action := range p.actions {
+		if action.ID == id {
+			p.actions = append(p.actions[:i], p.actions[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// CancelKind removes the queued action of the given kind
+func (p *PendingActions) CancelKind(kind PendingActionKind) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.removeKind(kind)
+}
+
+// Not thread safe. Lock mu before using
+func (p *PendingActions) removeKind(kind PendingActionKind) bool {
+	for i, action := range p.actions {
+		if action.Kind == kind {
+			p.actions = append(p.actions[:i], p.actions[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Reconnect executes the queued actions when the network is back
+func (p *PendingActions) Reconnect(stateIsUp bool) {
+	if !stateIsUp {
+		return
+	}
+
+	p.mu.Lock()
+	actions := p.actions
+	p.actions = nil
+	p.mu.Unlock()
+
+	for _, action := range actions {
+		log.Println(internal.InfoPrefix, "network is back, executing queued action:", action.ID, action.Description)
+		go action.run()
+	}
+}
@@ -0,0 +1,55 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPendingActions(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	online := false
+	actions := NewPendingActions(func() bool { return online })
+	assert.True(t, actions.IsOffline())
+
+	executed := make(chan string, 2)
+	first := actions.Add(PendingActionConnect, "connect to de", func() { executed <- "de" })
+	second := actions.Add(PendingActionConnect, "connect to lt", func() { executed <- "lt" })
+	assert.NotEqual(t, first, second)
+
+	// only the latest connect is kept
+	list := actions.List()
+	assert.Len(t, list, 1)
+	assert.Equal(t, second, list[0].ID)
+	assert.Equal(t, "connect to lt", list[0].Description)
+
+	actions.Reconnect(false)
+	assert.Len(t, actions.List(), 1)
+
+	online = true
+	actions.Reconnect(true)
+	assert.Empty(t, actions.List())
+	select {
+	case name := <-executed:
+		assert.Equal(t, "lt", name)
+	case <-time.After(time.Second):
+		assert.Fail(t, "queued action was not executed")
+	}
+}
+
+func TestPendingActions_Cancel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	actions := NewPendingActions(func() bool { return false })
+	id := actions.Add(PendingActionConnect, "connect", func() { assert.Fail(t, "canceled action was executed") })
+
+	assert.False(t, actions.Cancel("vpn-100"))
+	assert.True(t, actions.Cancel(id))
+	assert.False(t, actions.CancelKind(PendingActionConnect))
+
+	actions.Reconnect(true)
+}
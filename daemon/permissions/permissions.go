@@ -0,0 +1,449 @@
+// Package permissions implements per-feature authorization of the local daemon clients.
+package permissions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Feature groups gRPC methods which share the same access requirements
+type Feature string
+
+const (
+	// FeatureConnect covers connecting to and disconnecting from VPN
+	FeatureConnect Feature = "connect"
+	// FeatureSettings covers changes of the application settings
+	FeatureSettings Feature = "settings"
+	// FeatureMeshnetPermissions covers changes of the permissions granted to meshnet peers and the
+	// invites which add new peers to the meshnet
+	FeatureMeshnetPermissions Feature = "meshnet_permissions"
+	// FeatureAllowlist covers allowlist edits
+	FeatureAllowlist Feature = "allowlist"
+	// FeatureStatus covers read-only calls such as status, settings and server lists
+	FeatureStatus Feature = "status"
+	// FeatureControl covers every call which is not part of FeatureStatus, including logout. Its
+	// groups are required on top of the groups of the other features.
+	FeatureControl Feature = "control"
+)
+
+const (
+	// AdminGroup is the group reserved for privileged daemon clients
+	AdminGroup = "nordvpnadmin"
+	// ControlGroup is the group whose members are allowed to control the daemon
+	ControlGroup = "nordvpn"
+	// StatusGroup is the group whose members are only allowed to read the status
+	StatusGroup = "nordvpn-status"
+)
+
+// uidPrefix marks the matrix entries which name a single user instead of a group, e.g. uid:1001
+const uidPrefix = "uid:"
+
+// MatrixPath defines where the permission matrix is read from. Besides the daemon, the file is read by norduserd
+// running as the unprivileged user, so it has to be owned by root and readable by everyone (0644). norduserd shares
+// nothing with other users if the file exists but can't be read or parsed.
+var MatrixPath = filepath.Join(internal.AppDataPath, "permissions.json")
+
+// Matrix maps features to groups and users allowed to use them. Users are given as uid:<id>.
+// Features not present in the matrix are available to every local user.
+type Matrix map[Feature][]string
+
+// DefaultMatrix lets every local user read the status until the StatusGroup is created, leaves
+// connect/disconnect to the ControlGroup and reserves settings, meshnet permission changes and
+// allowlist edits for the AdminGroup
+func DefaultMatrix() Matrix {
+	return Matrix{
+		FeatureStatus:             {StatusGroup},
+		FeatureControl:            {ControlGroup},
+		FeatureSettings:           {AdminGroup},
+		FeatureMeshnetPermissions: {AdminGroup},
+		FeatureAllowlist:          {AdminGroup},
+	}
+}
+
+// LoadMatrix reads the matrix from the given JSON file. DefaultMatrix is returned if the file does
+// not exist. FeatureControl is limited to the ControlGroup unless the file sets it, an empty list
+// gives every local user full control.
+func LoadMatrix(path string) (Matrix, error) {
+	// #nosec G304 -- path is set by the daemon
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return DefaultMatrix(), nil
+		}
+		return nil, fmt.Errorf("reading permission matrix: %w", err)
+	}
+
+	var matrix Matrix
+	if err := json.Unmarshal(data, &matrix); err != nil {
+		return nil, fmt.Errorf("parsing permission matrix: %w", err)
+	}
+
+	for feature, principals := range matrix {
+		if !isKnownFeature(feature) {
+			return nil, fmt.Errorf("unknown feature in permission matrix: %s", feature)
+		}
+		for _, principal := range principals {
+			if _, isUser, err := parseUID(principal); isUser && err != nil {
+				return nil, fmt.Errorf("invalid user in permission matrix: %w", err)
+			}
+		}
+	}
+	if _, ok := matrix[FeatureControl]; !ok {
+		if matrix == nil {
+			matrix = Matrix{}
+		}
+		matrix[FeatureControl] = []string{ControlGroup}
+	}
+	return matrix, nil
+}
+
+func isKnownFeature(feature Feature) bool {
+	switch feature {
+	case FeatureConnect,
+		FeatureSettings,
+		FeatureMeshnetPermissions,
+		FeatureAllowlist,
+		FeatureStatus,
+		FeatureControl:
+		return true
+	}
+	return false
+}
+
+// methodFeatures maps gRPC methods to the features. Methods which are not listed, including user
+// specific settings such as notifications and tray, are restricted by FeatureControl only.
+var methodFeatures = map[string]Feature{
+	"/pb.Daemon/Ping":                    FeatureStatus,
+	"/pb.Daemon/IsLoggedIn":              FeatureStatus,
+	"/pb.Daemon/Status":                  FeatureStatus,
+	"/pb.Daemon/StatusStream":            FeatureStatus,
+	"/pb.Daemon/StatusVerbose":           FeatureStatus,
+	"/pb.Daemon/Statistics":              FeatureStatus,
+	"/pb.Daemon/ServicesStatus":          FeatureStatus,
+	"/pb.Daemon/Settings":                FeatureStatus,
+	"/pb.Daemon/SettingsProtocols":       FeatureStatus,
+	"/pb.Daemon/SettingsTechnologies":    FeatureStatus,
+	"/pb.Daemon/SubscribeToStateChanges": FeatureStatus,
+	"/pb.Daemon/Countries":               FeatureStatus,
+	"/pb.Daemon/Cities":                  FeatureStatus,
+	"/pb.Daemon/Groups":                  FeatureStatus,
+	"/pb.Daemon/Preflight":               FeatureStatus,
+
+	"/pb.Daemon/Connect":             FeatureConnect,
+	"/pb.Daemon/ConnectCancel":       FeatureConnect,
+	"/pb.Daemon/ConnectDedicatedIP":  FeatureConnect,
+	"/pb.Daemon/Disconnect":          FeatureConnect,
+	"/pb.Daemon/Pause":               FeatureConnect,
+	"/pb.Daemon/CancelPendingAction": FeatureConnect,
+
+	"/pb.Daemon/SetAllowlist":      FeatureAllowlist,
+	"/pb.Daemon/UnsetAllowlist":    FeatureAllowlist,
+	"/pb.Daemon/UnsetAllAllowlist": FeatureAllowlist,
+
+	"/pb.Daemon/SetAutoConnect":          FeatureSettings,
+	"/pb.Daemon/SetThreatProtectionLite": FeatureSettings,
+	"/pb.Daemon/SetDefaults":             FeatureSettings,
+	"/pb.Daemon/ImportSettings":          FeatureSettings,
+	"/pb.Daemon/SetDNS":                  FeatureSettings,
+	"/pb.Daemon/SetFirewall":             FeatureSettings,
+	"/pb.Daemon/SetFirewallMark":         FeatureSettings,
+	"/pb.Daemon/SetRoutingTable":         FeatureSettings,
+	"/pb.Daemon/SetRouteMetric":          FeatureSettings,
+	"/pb.Daemon/SetMeshnetPeerRoutes":    FeatureSettings,
+	"/pb.Daemon/SetRouting":              FeatureSettings,
+	"/pb.Daemon/SetAnalytics":            FeatureSettings,
+	"/pb.Daemon/SetAnalyticsCategory":    FeatureSettings,
+	"/pb.Daemon/SetKillSwitch":           FeatureSettings,
+	"/pb.Daemon/SetTrayMinimal":          FeatureSettings,
+	"/pb.Daemon/SetDeferOnMetered":       FeatureSettings,
+	"/pb.Daemon/SetObfuscationFallback":  FeatureSettings,
+	"/pb.Daemon/SetTrayMenu":             FeatureSettings,
+	"/pb.Daemon/SetLogLevel":             FeatureSettings,
+	"/pb.Daemon/SetDaemonLogLevel":       FeatureSettings,
+	"/pb.Daemon/SetTrustedNetwork":       FeatureSettings,
+	"/pb.Daemon/SetTrustedNetworkAction": FeatureSettings,
+	"/pb.Daemon/SetSplitTunnelApp":       FeatureSettings,
+	"/pb.Daemon/SetSplitTunnelMode":      FeatureSettings,
+	"/pb.Daemon/AddFavorite":             FeatureSettings,
+	"/pb.Daemon/RemoveFavorite":          FeatureSettings,
+	"/pb.Daemon/AddScheduleRule":         FeatureSettings,
+	"/pb.Daemon/RemoveScheduleRule":      FeatureSettings,
+	"/pb.Daemon/CreateProfile":           FeatureSettings,
+	"/pb.Daemon/SwitchProfile":           FeatureSettings,
+	"/pb.Daemon/DeleteProfile":           FeatureSettings,
+	"/pb.Daemon/AddHook":                 FeatureSettings,
+	"/pb.Daemon/RemoveHook":              FeatureSettings,
+	"/pb.Daemon/SetObfuscate":            FeatureSettings,
+	"/pb.Daemon/SetProtocol":             FeatureSettings,
+	"/pb.Daemon/SetMTU":                  FeatureSettings,
+	"/pb.Daemon/SetOpenVPNPort":          FeatureSettings,
+	"/pb.Daemon/SetOpenVPNDirectives":    FeatureSettings,
+	"/pb.Daemon/SetInterfaceName":        FeatureSettings,
+	"/pb.Daemon/SetPersistentKeepalive":  FeatureSettings,
+	"/pb.Daemon/SetHealthMonitor":        FeatureSettings,
+	"/pb.Daemon/SetMaxServerLoad":        FeatureSettings,
+	"/pb.Daemon/SetFileshareFileLimit":   FeatureSettings,
+	"/pb.Daemon/SetConnectRetry":         FeatureSettings,
+	"/pb.Daemon/SetConnectTimeouts":      FeatureSettings,
+	"/pb.Daemon/SetStealth":              FeatureSettings,
+	"/pb.Daemon/SetTechnology":           FeatureSettings,
+	"/pb.Daemon/SetLANDiscovery":         FeatureSettings,
+	"/pb.Daemon/SetIpv6":                 FeatureSettings,
+	"/pb.Daemon/SetVirtualLocation":      FeatureSettings,
+	"/pb.Daemon/SetPostQuantum":          FeatureSettings,
+	"/pb.Daemon/Repair":                  FeatureSettings,
+
+	"/meshpb.Meshnet/SetAutoAcceptInvites": FeatureSettings,
+	"/meshpb.Meshnet/SetInviteTTL":         FeatureSettings,
+
+	// exported settings and the meshnet private key give away the identity of this device
+	"/pb.Daemon/ExportSettings":     FeatureSettings,
+	"/meshpb.Meshnet/GetPrivateKey": FeatureSettings,
+
+	"/meshpb.Meshnet/Invite":                    FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/AcceptInvite":              FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/CreateInviteCode":          FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/RedeemInviteCode":          FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/AllowRouting":              FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/DenyRouting":               FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/AllowIncoming":             FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/DenyIncoming":              FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/AllowLocalNetwork":         FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/DenyLocalNetwork":          FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/AllowFileshare":            FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/DenyFileshare":             FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/EnableAutomaticFileshare":  FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/DisableAutomaticFileshare": FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/AddPeerToGroup":            FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/RemovePeerFromGroup":       FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/SetPeerGroupPermissions":   FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/SetPeerNote":               FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/SetPeerTags":               FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/SetAdvertisedSubnets":      FeatureMeshnetPermissions,
+	"/meshpb.Meshnet/SetPeerBandwidthLimit":     FeatureMeshnetPermissions,
+
+	"/norduserpb.Norduser/Ping":   FeatureStatus,
+	"/norduserpb.Norduser/Health": FeatureStatus,
+}
+
+// parseUID returns the user ID of the matrix entry naming a single user. isUser is false for the
+// group names.
+func parseUID(principal string) (uid uint32, isUser bool, err error) {
+	id, ok := strings.CutPrefix(principal, uidPrefix)
+	if !ok {
+		return 0, false, nil
+	}
+	parsed, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return 0, true, fmt.Errorf("parsing %s: %w", principal, err)
+	}
+	return uint32(parsed), true, nil
+}
+
+// featureForMethod returns the feature the given gRPC method belongs to
+func featureForMethod(fullMethod string) (Feature, bool) {
+	feature, ok := methodFeatures[fullMethod]
+	return feature, ok
+}
+
+// Checker enforces the permission matrix for the daemon gRPC calls
+type Checker struct {
+	matrix Matrix
+	// owner is set for the services run on behalf of a single user
+	owner *uint32
+	// groupExists reports whether group with the given name is present in the system
+	groupExists func(name string) bool
+	// userGroups returns names of the groups the user belongs to
+	userGroups func(uid uint32) ([]string, error)
+}
+
+func NewChecker(matrix Matrix) *Checker {
+	return &Checker{
+		matrix:      matrix,
+		groupExists: groupExists,
+		userGroups:  userGroups,
+	}
+}
+
+// NewOwnerChecker returns the checker of the services run on behalf of the owner, such as
+// norduserd. The owner and root can call every method. Other users can only read the status and
+// only if the matrix restricts it to the groups or users they belong to.
+func NewOwnerChecker(matrix Matrix, owner uint32) *Checker {
+	checker := NewChecker(matrix)
+	checker.owner = &owner
+	return checker
+}
+
+// Check returns an error if the user is not allowed to call the given gRPC method
+func (c *Checker) Check(uid uint32, fullMethod string) error {
+	// root?
+	if uid == 0 {
+		return nil
+	}
+
+	feature, ok := featureForMethod(fullMethod)
+	if c.owner != nil {
+		if uid == *c.owner {
+			return nil
+		}
+		if feature != FeatureStatus || len(c.required(FeatureStatus)) == 0 {
+			return status.Error(codes.PermissionDenied, internal.ErrNoPermission.Error())
+		}
+		return c.checkFeature(uid, FeatureStatus)
+	}
+
+	if feature != FeatureStatus {
+		if err := c.checkFeature(uid, FeatureControl); err != nil {
+			return err
+		}
+	}
+	if !ok {
+		return nil
+	}
+	return c.checkFeature(uid, feature)
+}
+
+// SharesStatus reports whether users other than the owner may read the status, i.e. whether the
+// matrix restricts the status to groups present on this system or to single users
+func (c *Checker) SharesStatus() bool {
+	return len(c.required(FeatureStatus)) > 0
+}
+
+// CanControl reports whether the user can call more than the status methods, i.e. passes
+// FeatureControl
+func (c *Checker) CanControl(uid uint32) bool {
+	return uid == 0 || c.checkFeature(uid, FeatureControl) == nil
+}
+
+// required returns the groups and users of the feature which apply on this system. Groups which
+// were not created by the admin are ignored to keep the daemon usable without any additional setup,
+// except for the control groups which would otherwise open the daemon to every local user.
+func (c *Checker) required(feature Feature) []string {
+	var required []string
+	for _, principal := range c.matrix[feature] {
+		if _, isUser, _ := parseUID(principal); isUser || feature == FeatureControl || c.groupExists(principal) {
+			required = append(required, principal)
+		}
+	}
+	return required
+}
+
+// checkFeature returns an error if the user is not listed in the feature and is not a member of
+// any group required by it
+func (c *Checker) checkFeature(uid uint32, feature Feature) error {
+	required := c.required(feature)
+	if len(required) == 0 {
+		return nil
+	}
+	// whoever controls the daemon can read its status as well
+	if feature == FeatureStatus {
+		required = append(required, c.required(FeatureControl)...)
+	}
+
+	var requiredGroups []string
+	for _, principal := range required {
+		id, isUser, err := parseUID(principal)
+		if !isUser {
+			requiredGroups = append(requiredGroups, principal)
+		} else if err == nil && id == uid {
+			return nil
+		}
+	}
+	if len(requiredGroups) == 0 {
+		return status.Error(codes.PermissionDenied, internal.ErrNoPermission.Error())
+	}
+
+	groups, err := c.userGroups(uid)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "checking permissions:", err)
+		return status.Error(codes.PermissionDenied, internal.ErrNoPermission.Error())
+	}
+
+	for _, group := range groups {
+		for _, requiredGroup := range requiredGroups {
+			if group == requiredGroup {
+				return nil
+			}
+		}
+	}
+
+	return status.Errorf(
+		codes.PermissionDenied,
+		"this action requires membership in the %s group",
+		strings.Join(requiredGroups, " or "),
+	)
+}
+
+func (c *Checker) middleware(ctx context.Context, fullMethod string) error {
+	if feature, _ := featureForMethod(fullMethod); feature == FeatureStatus && c.owner == nil &&
+		len(c.required(FeatureStatus)) == 0 {
+		return nil
+	}
+
+	peer, ok := peer.FromContext(ctx)
+	if !ok || peer.AuthInfo == nil {
+		return status.Error(codes.PermissionDenied, internal.ErrNoPermission.Error())
+	}
+
+	ucred, err := internal.StringToUcred(peer.AuthInfo.AuthType())
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "failed to convert auth info to user credentials:", err)
+		return status.Error(codes.PermissionDenied, internal.ErrNoPermission.Error())
+	}
+
+	return c.Check(ucred.Uid, fullMethod)
+}
+
+func (c *Checker) StreamMiddleware(srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo) error {
+	return c.middleware(ss.Context(), info.FullMethod)
+}
+
+func (c *Checker) UnaryMiddleware(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo) (interface{}, error) {
+	return nil, c.middleware(ctx, info.FullMethod)
+}
+
+func groupExists(name string) bool {
+	_, err := user.LookupGroup(name)
+	return err == nil
+}
+
+func userGroups(uid uint32) ([]string, error) {
+	userInfo, err := user.LookupId(strconv.FormatUint(uint64(uid), 10))
+	if err != nil {
+		return nil, fmt.Errorf("looking up user info: %w", err)
+	}
+
+	groupIDs, err := userInfo.GroupIds()
+	if err != nil {
+		return nil, fmt.Errorf("looking up user groups: %w", err)
+	}
+
+	var names []string
+	for _, groupID := range groupIDs {
+		groupInfo, err := user.LookupGroupId(groupID)
+		if err != nil {
+			return nil, fmt.Errorf("looking up user group: %w", err)
+		}
+		names = append(names, groupInfo.Name)
+	}
+	return names, nil
+}
@@ -0,0 +1,537 @@
+package permissions
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	daemonpb "github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	meshpb "github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func newTestChecker(matrix Matrix, existingGroups []string, groups map[uint32][]string) *Checker {
+	return &Checker{
+		matrix: matrix,
+		groupExists: func(name string) bool {
+			for _, group := range existingGroups {
+				if group == name {
+					return true
+				}
+			}
+			return false
+		},
+		userGroups: func(uid uint32) ([]string, error) {
+			userGroups, ok := groups[uid]
+			if !ok {
+				return nil, errors.New("user not found")
+			}
+			return userGroups, nil
+		},
+	}
+}
+
+func TestChecker_Check(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	users := map[uint32][]string{
+		1000: {"nordvpn"},
+		1001: {"nordvpn", AdminGroup},
+		1003: {"users"},
+		1004: {AdminGroup},
+	}
+
+	tests := []struct {
+		name           string
+		uid            uint32
+		method         string
+		existingGroups []string
+		allowed        bool
+	}{
+		{
+			name:           "root is always allowed",
+			uid:            0,
+			method:         "/pb.Daemon/SetFirewall",
+			existingGroups: []string{AdminGroup},
+			allowed:        true,
+		},
+		{
+			name:           "regular user can connect",
+			uid:            1000,
+			method:         "/pb.Daemon/Connect",
+			existingGroups: []string{AdminGroup},
+			allowed:        true,
+		},
+		{
+			name:           "regular user can disconnect",
+			uid:            1000,
+			method:         "/pb.Daemon/Disconnect",
+			existingGroups: []string{AdminGroup},
+			allowed:        true,
+		},
+		{
+			name:           "regular user cannot change settings",
+			uid:            1000,
+			method:         "/pb.Daemon/SetFirewall",
+			existingGroups: []string{AdminGroup},
+			allowed:        false,
+		},
+		{
+			name:           "regular user cannot edit allowlist",
+			uid:            1000,
+			method:         "/pb.Daemon/SetAllowlist",
+			existingGroups: []string{AdminGroup},
+			allowed:        false,
+		},
+		{
+			name:           "regular user cannot change meshnet permissions",
+			uid:            1000,
+			method:         "/meshpb.Meshnet/AllowIncoming",
+			existingGroups: []string{AdminGroup},
+			allowed:        false,
+		},
+		{
+			name:           "regular user cannot invite to meshnet",
+			uid:            1000,
+			method:         "/meshpb.Meshnet/Invite",
+			existingGroups: []string{AdminGroup},
+			allowed:        false,
+		},
+		{
+			name:           "regular user cannot redeem invite code",
+			uid:            1000,
+			method:         "/meshpb.Meshnet/RedeemInviteCode",
+			existingGroups: []string{AdminGroup},
+			allowed:        false,
+		},
+		{
+			name:           "regular user cannot read meshnet private key",
+			uid:            1000,
+			method:         "/meshpb.Meshnet/GetPrivateKey",
+			existingGroups: []string{AdminGroup},
+			allowed:        false,
+		},
+		{
+			name:           "regular user cannot export settings",
+			uid:            1000,
+			method:         "/pb.Daemon/ExportSettings",
+			existingGroups: []string{AdminGroup},
+			allowed:        false,
+		},
+		{
+			name:           "admin can accept invite",
+			uid:            1001,
+			method:         "/meshpb.Meshnet/AcceptInvite",
+			existingGroups: []string{AdminGroup},
+			allowed:        true,
+		},
+		{
+			name:           "regular user can change user specific settings",
+			uid:            1000,
+			method:         "/pb.Daemon/SetNotify",
+			existingGroups: []string{AdminGroup},
+			allowed:        true,
+		},
+		{
+			name:           "regular user can read settings",
+			uid:            1000,
+			method:         "/pb.Daemon/Settings",
+			existingGroups: []string{AdminGroup},
+			allowed:        true,
+		},
+		{
+			name:           "admin can change settings",
+			uid:            1001,
+			method:         "/pb.Daemon/SetFirewall",
+			existingGroups: []string{AdminGroup},
+			allowed:        true,
+		},
+		{
+			name:    "restrictions are disabled when admin group does not exist",
+			uid:     1000,
+			method:  "/pb.Daemon/SetFirewall",
+			allowed: true,
+		},
+		{
+			name:           "local user can read status",
+			uid:            1003,
+			method:         "/pb.Daemon/Status",
+			existingGroups: []string{AdminGroup},
+			allowed:        true,
+		},
+		{
+			name:           "local user can read settings",
+			uid:            1003,
+			method:         "/pb.Daemon/Settings",
+			existingGroups: []string{AdminGroup},
+			allowed:        true,
+		},
+		{
+			name:           "local user cannot connect",
+			uid:            1003,
+			method:         "/pb.Daemon/Connect",
+			existingGroups: []string{AdminGroup},
+			allowed:        false,
+		},
+		{
+			name:           "local user cannot logout",
+			uid:            1003,
+			method:         "/pb.Daemon/Logout",
+			existingGroups: []string{AdminGroup},
+			allowed:        false,
+		},
+		{
+			name:    "local user cannot change settings when admin group does not exist",
+			uid:     1003,
+			method:  "/pb.Daemon/SetFirewall",
+			allowed: false,
+		},
+		{
+			name:           "admin outside of the control group cannot change settings",
+			uid:            1004,
+			method:         "/pb.Daemon/SetFirewall",
+			existingGroups: []string{AdminGroup},
+			allowed:        false,
+		},
+		{
+			name:           "regular user can logout",
+			uid:            1000,
+			method:         "/pb.Daemon/Logout",
+			existingGroups: []string{AdminGroup},
+			allowed:        true,
+		},
+		{
+			name:           "unknown user is denied restricted feature",
+			uid:            1002,
+			method:         "/pb.Daemon/UnsetAllAllowlist",
+			existingGroups: []string{AdminGroup},
+			allowed:        false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			checker := newTestChecker(DefaultMatrix(), test.existingGroups, users)
+			err := checker.Check(test.uid, test.method)
+			if test.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestChecker_CustomMatrix(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	matrix := Matrix{FeatureConnect: {"vpnusers"}}
+	users := map[uint32][]string{
+		1000: {"nordvpn"},
+		1001: {"nordvpn", "vpnusers"},
+	}
+	checker := newTestChecker(matrix, []string{"vpnusers", AdminGroup}, users)
+
+	assert.Error(t, checker.Check(1000, "/pb.Daemon/Connect"))
+	assert.NoError(t, checker.Check(1001, "/pb.Daemon/Connect"))
+	// features missing in the matrix are not restricted
+	assert.NoError(t, checker.Check(1000, "/pb.Daemon/SetFirewall"))
+}
+
+func TestChecker_StatusPolicy(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	users := map[uint32][]string{
+		1000: {"nordvpn"},
+		1001: {"users"},
+	}
+
+	checker := newTestChecker(Matrix{FeatureControl: {}}, nil, users)
+	assert.NoError(t, checker.Check(1001, "/pb.Daemon/Connect"))
+
+	checker = newTestChecker(Matrix{FeatureControl: {ControlGroup}, FeatureStatus: {ControlGroup}},
+		[]string{ControlGroup}, users)
+	assert.NoError(t, checker.Check(1000, "/pb.Daemon/Status"))
+	assert.Error(t, checker.Check(1001, "/pb.Daemon/Status"))
+
+	// restricted status requires peer info
+	_, err := checker.UnaryMiddleware(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/pb.Daemon/Status"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestChecker_StatusGroup(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	users := map[uint32][]string{
+		1000: {ControlGroup},
+		1001: {StatusGroup},
+		1002: {"users"},
+	}
+	checker := newTestChecker(DefaultMatrix(), []string{ControlGroup, StatusGroup}, users)
+
+	assert.NoError(t, checker.Check(1000, "/pb.Daemon/Status"))
+	assert.NoError(t, checker.Check(1001, "/pb.Daemon/Status"))
+	assert.NoError(t, checker.Check(1001, "/pb.Daemon/Settings"))
+	assert.Error(t, checker.Check(1001, "/pb.Daemon/Connect"))
+	assert.Error(t, checker.Check(1002, "/pb.Daemon/Status"))
+
+	// restricted status requires peer info
+	_, err := checker.UnaryMiddleware(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/pb.Daemon/Status"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestChecker_Users(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	users := map[uint32][]string{
+		1000: {"users"},
+		1001: {"users"},
+	}
+	matrix := Matrix{
+		FeatureControl: {ControlGroup, "uid:1000"},
+		FeatureStatus:  {"uid:1001"},
+	}
+	checker := newTestChecker(matrix, []string{ControlGroup}, users)
+
+	assert.NoError(t, checker.Check(1000, "/pb.Daemon/Connect"))
+	assert.NoError(t, checker.Check(1000, "/pb.Daemon/Status"))
+	assert.NoError(t, checker.Check(1001, "/pb.Daemon/Status"))
+	assert.Error(t, checker.Check(1001, "/pb.Daemon/Connect"))
+
+	// users are matched without looking up their groups
+	checker = newTestChecker(Matrix{FeatureControl: {"uid:1002"}}, nil, users)
+	assert.NoError(t, checker.Check(1002, "/pb.Daemon/Connect"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(checker.Check(1000, "/pb.Daemon/Connect")))
+}
+
+func TestOwnerChecker(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	users := map[uint32][]string{
+		1000: {ControlGroup},
+		1001: {StatusGroup},
+		1002: {"users"},
+	}
+
+	newOwnerChecker := func(matrix Matrix, existingGroups []string) *Checker {
+		checker := newTestChecker(matrix, existingGroups, users)
+		owner := uint32(1000)
+		checker.owner = &owner
+		return checker
+	}
+
+	checker := newOwnerChecker(DefaultMatrix(), []string{ControlGroup})
+	assert.NoError(t, checker.Check(0, "/norduserpb.Norduser/Stop"))
+	assert.NoError(t, checker.Check(1000, "/norduserpb.Norduser/Stop"))
+	// other users need the status to be granted explicitly
+	assert.Error(t, checker.Check(1001, "/norduserpb.Norduser/Ping"))
+	assert.Error(t, checker.Check(1002, "/norduserpb.Norduser/Ping"))
+
+	checker = newOwnerChecker(DefaultMatrix(), []string{ControlGroup, StatusGroup})
+	assert.NoError(t, checker.Check(1001, "/norduserpb.Norduser/Health"))
+	assert.Error(t, checker.Check(1001, "/norduserpb.Norduser/Stop"))
+	assert.Error(t, checker.Check(1002, "/norduserpb.Norduser/Health"))
+
+	// owner checks always require peer info
+	_, err := checker.UnaryMiddleware(context.Background(), nil,
+		&grpc.UnaryServerInfo{FullMethod: "/norduserpb.Norduser/Ping"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestChecker_CanControl(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	checker := newTestChecker(DefaultMatrix(), []string{ControlGroup, StatusGroup}, map[uint32][]string{
+		1000: {ControlGroup},
+		1001: {StatusGroup},
+		1002: {"users"},
+	})
+	assert.True(t, checker.CanControl(0))
+	assert.True(t, checker.CanControl(1000))
+	assert.False(t, checker.CanControl(1001))
+	assert.False(t, checker.CanControl(1002))
+}
+
+func TestChecker_SharesStatus(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name           string
+		matrix         Matrix
+		existingGroups []string
+		shares         bool
+	}{
+		{name: "status group is not created", matrix: DefaultMatrix()},
+		{name: "status group is created", matrix: DefaultMatrix(), existingGroups: []string{StatusGroup}, shares: true},
+		{name: "single user", matrix: Matrix{FeatureStatus: {"uid:1001"}}, shares: true},
+		{name: "empty matrix", matrix: Matrix{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			checker := newTestChecker(test.matrix, test.existingGroups, nil)
+			checker.owner = new(uint32)
+			assert.Equal(t, test.shares, checker.SharesStatus())
+		})
+	}
+}
+
+func TestChecker_UnaryMiddleware(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	checker := newTestChecker(DefaultMatrix(), []string{AdminGroup}, map[uint32][]string{1000: {"nordvpn"}})
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.Daemon/SetAllowlist"}
+
+	_, err := checker.UnaryMiddleware(context.Background(), nil, info)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: internal.UcredAuth{Uid: 1000}})
+	_, err = checker.UnaryMiddleware(ctx, nil, info)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	ctx = peer.NewContext(context.Background(), &peer.Peer{AuthInfo: internal.UcredAuth{Uid: 0}})
+	_, err = checker.UnaryMiddleware(ctx, nil, info)
+	assert.NoError(t, err)
+
+	// unrestricted methods do not require peer info
+	_, err = checker.UnaryMiddleware(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/pb.Daemon/Status"})
+	assert.NoError(t, err)
+}
+
+func TestLoadMatrix(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	dir := t.TempDir()
+
+	matrix, err := LoadMatrix(filepath.Join(dir, "missing.json"))
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultMatrix(), matrix)
+
+	path := filepath.Join(dir, "permissions.json")
+	assert.NoError(t, os.WriteFile(path, []byte(`{"connect":["vpnusers"],"settings":[]}`), 0600))
+	matrix, err = LoadMatrix(path)
+	assert.NoError(t, err)
+	assert.Equal(t, Matrix{
+		FeatureConnect:  {"vpnusers"},
+		FeatureSettings: {},
+		FeatureControl:  {ControlGroup},
+	}, matrix)
+
+	assert.NoError(t, os.WriteFile(path, []byte(`{"control":[],"status":["nordvpn"]}`), 0600))
+	matrix, err = LoadMatrix(path)
+	assert.NoError(t, err)
+	assert.Equal(t, Matrix{FeatureControl: {}, FeatureStatus: {ControlGroup}}, matrix)
+
+	assert.NoError(t, os.WriteFile(path, []byte(`{"control":["nordvpn","uid:1001"]}`), 0600))
+	matrix, err = LoadMatrix(path)
+	assert.NoError(t, err)
+	assert.Equal(t, Matrix{FeatureControl: {ControlGroup, "uid:1001"}}, matrix)
+
+	assert.NoError(t, os.WriteFile(path, []byte(`{"control":["uid:root"]}`), 0600))
+	_, err = LoadMatrix(path)
+	assert.Error(t, err)
+
+	assert.NoError(t, os.WriteFile(path, []byte(`{"unknown":["vpnusers"]}`), 0600))
+	_, err = LoadMatrix(path)
+	assert.Error(t, err)
+
+	assert.NoError(t, os.WriteFile(path, []byte(`not json`), 0600))
+	_, err = LoadMatrix(path)
+	assert.Error(t, err)
+
+	_, err = LoadMatrix(dir)
+	assert.Error(t, err, "matrix which exists but can't be read is not replaced with the defaults")
+}
+
+// controlOnlyMethods lists the methods which are deliberately left out of methodFeatures, i.e. are
+// restricted by FeatureControl only. New methods have to be added either here or to methodFeatures.
+var controlOnlyMethods = map[string]bool{
+	"/pb.Daemon/AccountInfo":         true,
+	"/pb.Daemon/TokenInfo":           true,
+	"/pb.Daemon/ConnectDryRun":       true,
+	"/pb.Daemon/DedicatedIPServers":  true,
+	"/pb.Daemon/LoginWithToken":      true,
+	"/pb.Daemon/LoginOAuth2":         true,
+	"/pb.Daemon/LoginOAuth2Callback": true,
+	"/pb.Daemon/Logout":              true,
+	"/pb.Daemon/Plans":               true,
+	"/pb.Daemon/RateConnection":      true,
+	"/pb.Daemon/Register":            true,
+	"/pb.Daemon/AnalyticsEvents":     true,
+	"/pb.Daemon/SetNotify":           true,
+	"/pb.Daemon/SetTray":             true,
+	"/pb.Daemon/SetTrayIconTheme":    true,
+	"/pb.Daemon/SetTrayHotkey":       true,
+	"/pb.Daemon/SplitTunnelApps":     true,
+	"/pb.Daemon/Favorites":           true,
+	"/pb.Daemon/Profiles":            true,
+	"/pb.Daemon/Hooks":               true,
+	"/pb.Daemon/ScheduleRules":       true,
+	"/pb.Daemon/Benchmarks":          true,
+	"/pb.Daemon/ClaimOnlinePurchase": true,
+	"/pb.Daemon/GetServers":          true,
+	"/pb.Daemon/SearchServers":       true,
+	"/pb.Daemon/PendingActions":      true,
+	"/pb.Daemon/ConnectionHistory":   true,
+	"/pb.Daemon/BandwidthUsage":      true,
+	"/pb.Daemon/SubscribeEvents":     true,
+
+	"/meshpb.Meshnet/EnableMeshnet":         true,
+	"/meshpb.Meshnet/IsEnabled":             true,
+	"/meshpb.Meshnet/DisableMeshnet":        true,
+	"/meshpb.Meshnet/RefreshMeshnet":        true,
+	"/meshpb.Meshnet/GetInvites":            true,
+	"/meshpb.Meshnet/RevokeInvite":          true,
+	"/meshpb.Meshnet/ResendInvite":          true,
+	"/meshpb.Meshnet/DenyInvite":            true,
+	"/meshpb.Meshnet/GetPeers":              true,
+	"/meshpb.Meshnet/RemovePeer":            true,
+	"/meshpb.Meshnet/ChangePeerNickname":    true,
+	"/meshpb.Meshnet/ChangeMachineNickname": true,
+	"/meshpb.Meshnet/Connect":               true,
+	"/meshpb.Meshnet/ConnectCancel":         true,
+	"/meshpb.Meshnet/NotifyNewTransfer":     true,
+	"/meshpb.Meshnet/GetPeerGroups":         true,
+	"/meshpb.Meshnet/SubscribePeerPresence": true,
+	"/meshpb.Meshnet/MeasurePeers":          true,
+}
+
+func TestMethodFeatures_CoverServices(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	methods := map[string]bool{}
+	for _, desc := range []grpc.ServiceDesc{daemonpb.Daemon_ServiceDesc, meshpb.Meshnet_ServiceDesc} {
+		for _, method := range desc.Methods {
+			methods["/"+desc.ServiceName+"/"+method.MethodName] = true
+		}
+		for _, stream := range desc.Streams {
+			methods["/"+desc.ServiceName+"/"+stream.StreamName] = true
+		}
+	}
+
+	for method := range methods {
+		_, mapped := methodFeatures[method]
+		assert.True(t, mapped || controlOnlyMethods[method],
+			"%s is neither in methodFeatures nor in controlOnlyMethods", method)
+		assert.False(t, mapped && controlOnlyMethods[method],
+			"%s is both in methodFeatures and in controlOnlyMethods", method)
+	}
+	for method := range controlOnlyMethods {
+		assert.True(t, methods[method], "%s does not exist", method)
+	}
+	for method := range methodFeatures {
+		if strings.HasPrefix(method, "/norduserpb.") {
+			continue
+		}
+		assert.True(t, methods[method], "%s does not exist", method)
+	}
+}
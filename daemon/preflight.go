@@ -0,0 +1,157 @@
+package daemon
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const (
+	PreflightTun       = "tun"
+	PreflightWireGuard = "wireguard"
+	PreflightFirewall  = "firewall"
+	PreflightNetAdmin  = "net_admin"
+)
+
+const (
+	tunDevicePath = "/dev/net/tun"
+	// capNetAdmin is the bit of CAP_NET_ADMIN in the capability sets
+	capNetAdmin = 12
+)
+
+// preflightCheck detects a missing kernel feature or capability which makes the VPN connection fail
+type preflightCheck struct {
+	id          string
+	severity    pb.PreflightSeverity
+	description string
+	hint        string
+	missing     func() bool
+}
+
+// preflightSystem gives the checks access to the system, so that it can be replaced in tests
+type preflightSystem struct {
+	fileExists func(path string) bool
+	run        func(name string, arg ...string) error
+	capEff     func() (uint64, error)
+}
+
+func newPreflightSystem() preflightSystem {
+	return preflightSystem{
+		fileExists: internal.FileExists,
+		run: func(name string, arg ...string) error {
+			// #nosec G204 -- only the constant commands are run
+			return exec.Command(name, arg...).Run()
+		},
+		capEff: effectiveCapabilities,
+	}
+}
+
+// runPreflight returns the findings of the failed checks
+func runPreflight(checks []preflightCheck) []*pb.PreflightFinding {
+	var findings []*pb.PreflightFinding
+	for _, check := range checks {
+		if !check.missing() {
+			continue
+		}
+		findings = append(findings, &pb.PreflightFinding{
+			Id:          check.id,
+			Severity:    check.severity,
+			Description: check.description,
+			Hint:        check.hint,
+		})
+	}
+	return findings
+}
+
+// preflightChecks returns the checks of the kernel features and capabilities used by the configured technology
+func preflightChecks(cfg config.Config, sys preflightSystem) []preflightCheck {
+	checks := []preflightCheck{
+		{
+			id:          PreflightNetAdmin,
+			severity:    pb.PreflightSeverity_PREFLIGHT_ERROR,
+			description: "NordVPN daemon does not have the CAP_NET_ADMIN capability",
+			hint:        "Run nordvpnd as root. In a container, add the NET_ADMIN capability.",
+			missing: func() bool {
+				caps, err := sys.capEff()
+				// don't report what can't be checked
+				return err == nil && caps&(1<<capNetAdmin) == 0
+			},
+		},
+		{
+			id:          PreflightTun,
+			severity:    pb.PreflightSeverity_PREFLIGHT_ERROR,
+			description: "TUN device " + tunDevicePath + " is not available",
+			hint:        "Load the tun kernel module with 'modprobe tun'. In a container, pass the " + tunDevicePath + " device.",
+			missing: func() bool {
+				return !sys.fileExists(tunDevicePath)
+			},
+		},
+	}
+
+	if cfg.Technology == config.Technology_NORDLYNX {
+		checks = append(checks, preflightCheck{
+			id:          PreflightWireGuard,
+			severity:    pb.PreflightSeverity_PREFLIGHT_WARNING,
+			description: "WireGuard kernel module is not available, slower user space NordLynx will be used",
+			hint:        "Install the WireGuard kernel module or update the kernel to 5.6 or newer.",
+			missing: func() bool {
+				return !sys.fileExists("/sys/module/wireguard") &&
+					// modules.builtin is consulted as well, so the built-in module is found too
+					sys.run("modprobe", "--dry-run", "wireguard") != nil
+			},
+		})
+	}
+
+	if cfg.Firewall {
+		checks = append(checks, preflightCheck{
+			id:          PreflightFirewall,
+			severity:    pb.PreflightSeverity_PREFLIGHT_ERROR,
+			description: "iptables is not available or the kernel lacks nf_tables or the legacy iptables support",
+			hint:        "Install iptables and make sure that the nf_tables or the ip_tables kernel module can be loaded.",
+			missing: func() bool {
+				return sys.run("iptables", "-w", internal.SecondsToWaitForIptablesLock, "-S", "INPUT") != nil
+			},
+		})
+	}
+	return checks
+}
+
+// effectiveCapabilities returns the effective capability set of the daemon
+func effectiveCapabilities() (uint64, error) {
+	file, err := os.Open("/proc/self/status")
+	if err != nil {
+		return 0, err
+	}
+	// #nosec G307 -- no writes are made
+	defer file.Close()
+	return parseCapEff(file)
+}
+
+// parseCapEff returns the CapEff field of the process status
+func parseCapEff(status io.Reader) (uint64, error) {
+	scanner := bufio.NewScanner(status)
+	for scanner.Scan() {
+		value, ok := strings.CutPrefix(scanner.Text(), "CapEff:")
+		if !ok {
+			continue
+		}
+		caps, err := strconv.ParseUint(strings.TrimSpace(value), 16, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parsing CapEff: %w", err)
+		}
+		return caps, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	return 0, errors.New("CapEff not found")
+}
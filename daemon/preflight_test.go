@@ -0,0 +1,177 @@
+package daemon
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreflightChecks(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	const allCaps = 0x1ffffffffff
+	errFailed := errors.New("failed")
+	tests := []struct {
+		name       string
+		cfg        config.Config
+		files      []string
+		failingCmd string
+		caps       uint64
+		capsErr    error
+		expected   []string
+	}{
+		{
+			name:     "everything is available",
+			cfg:      config.Config{Technology: config.Technology_NORDLYNX, Firewall: true},
+			files:    []string{tunDevicePath, "/sys/module/wireguard"},
+			caps:     allCaps,
+			expected: nil,
+		},
+		{
+			name:     "built-in wireguard module",
+			cfg:      config.Config{Technology: config.Technology_NORDLYNX},
+			files:    []string{tunDevicePath},
+			caps:     allCaps,
+			expected: nil,
+		},
+		{
+			name:       "wireguard module is missing",
+			cfg:        config.Config{Technology: config.Technology_NORDLYNX},
+			files:      []string{tunDevicePath},
+			failingCmd: "modprobe",
+			caps:       allCaps,
+			expected:   []string{PreflightWireGuard},
+		},
+		{
+			name:       "wireguard module is not needed for openvpn",
+			cfg:        config.Config{Technology: config.Technology_OPENVPN},
+			files:      []string{tunDevicePath},
+			failingCmd: "modprobe",
+			caps:       allCaps,
+			expected:   nil,
+		},
+		{
+			name:       "iptables is missing",
+			cfg:        config.Config{Technology: config.Technology_OPENVPN, Firewall: true},
+			files:      []string{tunDevicePath},
+			failingCmd: "iptables",
+			caps:       allCaps,
+			expected:   []string{PreflightFirewall},
+		},
+		{
+			name:       "iptables is not needed without the firewall",
+			cfg:        config.Config{Technology: config.Technology_OPENVPN},
+			files:      []string{tunDevicePath},
+			failingCmd: "iptables",
+			caps:       allCaps,
+			expected:   nil,
+		},
+		{
+			name:     "tun and capability are missing",
+			cfg:      config.Config{Technology: config.Technology_OPENVPN},
+			caps:     allCaps &^ (1 << capNetAdmin),
+			expected: []string{PreflightNetAdmin, PreflightTun},
+		},
+		{
+			name:     "capabilities can't be read",
+			cfg:      config.Config{Technology: config.Technology_OPENVPN},
+			files:    []string{tunDevicePath},
+			capsErr:  errFailed,
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sys := preflightSystem{
+				fileExists: func(path string) bool {
+					for _, file := range test.files {
+						if file == path {
+							return true
+						}
+					}
+					return false
+				},
+				run: func(name string, arg ...string) error {
+					if name == test.failingCmd {
+						return errFailed
+					}
+					return nil
+				},
+				capEff: func() (uint64, error) { return test.caps, test.capsErr },
+			}
+
+			var ids []string
+			for _, finding := range runPreflight(preflightChecks(test.cfg, sys)) {
+				ids = append(ids, finding.GetId())
+				assert.NotEmpty(t, finding.GetHint())
+			}
+			assert.Equal(t, test.expected, ids)
+		})
+	}
+}
+
+func TestPreflightChecks_Severity(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	sys := preflightSystem{
+		fileExists: func(string) bool { return false },
+		run:        func(string, ...string) error { return errors.New("failed") },
+		capEff:     func() (uint64, error) { return 0, nil },
+	}
+	cfg := config.Config{Technology: config.Technology_NORDLYNX, Firewall: true}
+
+	for _, finding := range runPreflight(preflightChecks(cfg, sys)) {
+		expected := pb.PreflightSeverity_PREFLIGHT_ERROR
+		if finding.GetId() == PreflightWireGuard {
+			// user space implementation is used instead
+			expected = pb.PreflightSeverity_PREFLIGHT_WARNING
+		}
+		assert.Equal(t, expected, finding.GetSeverity(), finding.GetId())
+	}
+}
+
+func TestParseCapEff(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		status   string
+		expected uint64
+		hasError bool
+	}{
+		{
+			name:     "root",
+			status:   "Name:\tnordvpnd\nCapInh:\t0000000000000000\nCapPrm:\t000001ffffffffff\nCapEff:\t000001ffffffffff\n",
+			expected: 0x1ffffffffff,
+		},
+		{
+			name:     "no capabilities",
+			status:   "Name:\tnordvpnd\nCapEff:\t0000000000000000\n",
+			expected: 0,
+		},
+		{
+			name:     "malformed",
+			status:   "CapEff:\tnot-hex\n",
+			hasError: true,
+		},
+		{
+			name:     "missing",
+			status:   "Name:\tnordvpnd\n",
+			hasError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			caps, err := parseCapEff(strings.NewReader(test.status))
+			assert.Equal(t, test.hasError, err != nil)
+			assert.Equal(t, test.expected, caps)
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Package ratelimit bounds how often the local daemon clients can call the expensive gRPC methods.
+package ratelimit
+
+import (
+	"context"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// unknownClient is used for the calls whose user can't be determined, all of them share the same limit
+const unknownClient = math.MaxUint32
+
+// Limit describes how often a single client can call a method and how many calls of all clients can be handled
+// at the same time
+type Limit struct {
+	// Rate is the average number of calls per second a client can make
+	Rate float64
+	// Burst is the number of calls a client can make at once before Rate applies
+	Burst int
+	// MaxInFlight is the number of calls handled at the same time, it is not bounded if zero
+	MaxInFlight int
+}
+
+// DefaultLimits returns the limits of the methods which reach the API, start the connection or go through the whole
+// servers list. The other methods are not limited.
+func DefaultLimits() map[string]Limit {
+	connect := Limit{Rate: 0.5, Burst: 5, MaxInFlight: 4}
+	servers := Limit{Rate: 2, Burst: 10, MaxInFlight: 8}
+	return map[string]Limit{
+		"/pb.Daemon/Connect":            connect,
+		"/pb.Daemon/ConnectDedicatedIP": connect,
+		"/pb.Daemon/ConnectDryRun":      servers,
+		"/pb.Daemon/GetServers":         servers,
+		"/pb.Daemon/SearchServers":      servers,
+		"/pb.Daemon/Benchmarks":         {Rate: 0.1, Burst: 2, MaxInFlight: 1},
+	}
+}
+
+// bucket holds the calls a client can still make to a method
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+type bucketKey struct {
+	uid    uint32
+	method string
+}
+
+// Limiter rejects the calls exceeding the limits of the method with codes.ResourceExhausted. Calls are rejected
+// instead of being queued, so a client calling in a loop can't make the daemon wait on it.
+type Limiter struct {
+	mu     sync.Mutex
+	limits map[string]Limit
+	// buckets are kept per user, their number is bounded by the local users
+	buckets  map[bucketKey]*bucket
+	inFlight map[string]int
+	now      func() time.Time
+}
+
+func NewLimiter(limits map[string]Limit) *Limiter {
+	return &Limiter{
+		limits:   limits,
+		buckets:  map[bucketKey]*bucket{},
+		inFlight: map[string]int{},
+		now:      time.Now,
+	}
+}
+
+// acquire takes the call of the user to the method into account. The returned function has to be called once the
+// call is handled.
+func (l *Limiter) acquire(uid uint32, method string) (func(), error) {
+	limit, ok := l.limits[method]
+	if !ok {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if limit.MaxInFlight > 0 && l.inFlight[method] >= limit.MaxInFlight {
+		log.Println(internal.WarningPrefix, "too many calls of", method, "in progress, rejecting")
+		return nil, status.Error(codes.ResourceExhausted, internal.ErrTooManyRequests.Error())
+	}
+
+	now := l.now()
+	key := bucketKey{uid: uid, method: method}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updated: now}
+		l.buckets[key] = b
+	}
+	b.tokens = min(float64(limit.Burst), b.tokens+now.Sub(b.updated).Seconds()*limit.Rate)
+	b.updated = now
+	if b.tokens < 1 {
+		log.Println(internal.WarningPrefix, "user", uid, "calls", method, "too often, rejecting")
+		return nil, status.Error(codes.ResourceExhausted, internal.ErrTooManyRequests.Error())
+	}
+	b.tokens--
+
+	l.inFlight[method]++
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		l.inFlight[method]--
+	}, nil
+}
+
+// acquireFromContext identifies the client by the user credentials of the connection
+func (l *Limiter) acquireFromContext(ctx context.Context, method string) (func(), error) {
+	uid := uint32(unknownClient)
+	if peer, ok := peer.FromContext(ctx); ok && peer.AuthInfo != nil {
+		if ucred, err := internal.StringToUcred(peer.AuthInfo.AuthType()); err == nil {
+			uid = ucred.Uid
+		}
+	}
+	return l.acquire(uid, method)
+}
+
+// StreamInterceptor method can be provided to gRPC server options as a grpc.StreamInterceptor
+func (l *Limiter) StreamInterceptor(srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	release, err := l.acquireFromContext(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	defer release()
+	return handler(srv, ss)
+}
+
+// UnaryInterceptor method can be provided to gRPC server options as a grpc.UnaryInterceptor
+func (l *Limiter) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	release, err := l.acquireFromContext(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return handler(ctx, req)
+}
@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testMethod = "/pb.Daemon/Connect"
+
+func newTestLimiter(limit Limit) (*Limiter, *time.Time) {
+	now := time.Unix(1700000000, 0)
+	limiter := NewLimiter(map[string]Limit{testMethod: limit})
+	limiter.now = func() time.Time { return now }
+	return limiter, &now
+}
+
+func TestLimiter_Rate(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	limiter, now := newTestLimiter(Limit{Rate: 1, Burst: 2})
+
+	for i := 0; i < 2; i++ {
+		release, err := limiter.acquire(1000, testMethod)
+		assert.NoError(t, err)
+		release()
+	}
+	_, err := limiter.acquire(1000, testMethod)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// other clients have their own limits
+	release, err := limiter.acquire(1001, testMethod)
+	assert.NoError(t, err)
+	release()
+
+	*now = now.Add(time.Second)
+	release, err = limiter.acquire(1000, testMethod)
+	assert.NoError(t, err)
+	release()
+	_, err = limiter.acquire(1000, testMethod)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// tokens are not accumulated above the burst
+	*now = now.Add(time.Hour)
+	for i := 0; i < 2; i++ {
+		release, err := limiter.acquire(1000, testMethod)
+		assert.NoError(t, err)
+		release()
+	}
+	_, err = limiter.acquire(1000, testMethod)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestLimiter_MaxInFlight(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	limiter, _ := newTestLimiter(Limit{Rate: 1, Burst: 10, MaxInFlight: 2})
+
+	release1, err := limiter.acquire(1000, testMethod)
+	assert.NoError(t, err)
+	release2, err := limiter.acquire(1001, testMethod)
+	assert.NoError(t, err)
+
+	_, err = limiter.acquire(1002, testMethod)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	release1()
+	release3, err := limiter.acquire(1002, testMethod)
+	assert.NoError(t, err)
+	release2()
+	release3()
+}
+
+func TestLimiter_UnlimitedMethod(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	limiter, _ := newTestLimiter(Limit{Rate: 0, Burst: 0})
+
+	for i := 0; i < 100; i++ {
+		release, err := limiter.acquire(1000, "/pb.Daemon/Status")
+		assert.NoError(t, err)
+		release()
+	}
+	_, err := limiter.acquire(1000, testMethod)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestLimiter_UnaryInterceptor(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	limiter, _ := newTestLimiter(Limit{Rate: 0, Burst: 1, MaxInFlight: 1})
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+
+	calls := 0
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		calls++
+		// the call is counted as in progress while it is handled
+		_, err := limiter.acquire(1000, testMethod)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		return nil, nil
+	}
+
+	_, err := limiter.UnaryInterceptor(context.Background(), nil, info, handler)
+	assert.NoError(t, err)
+	_, err = limiter.UnaryInterceptor(context.Background(), nil, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, 1, calls)
+	assert.Zero(t, limiter.inFlight[testMethod])
+}
@@ -0,0 +1,216 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
+	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordlynx"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordwhisper"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/norduser/process"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	RepairFirewall = "firewall"
+	RepairRoutes   = "routes"
+	RepairDNS      = "dns"
+	RepairNorduser = "norduserd"
+)
+
+// openvpnInterfaceName is the name of the interface created by openvpn. It is not imported from the
+// openvpn package to avoid pulling its dependencies into the daemon package.
+const openvpnInterfaceName = "nordtun"
+
+// repairCheck detects and fixes one of the failure states which break connectivity
+type repairCheck struct {
+	id          string
+	description string
+	detect      func() bool
+	fix         func() error
+}
+
+// repairUser identifies the user whose helper processes are checked
+type repairUser struct {
+	uid  uint32
+	gid  uint32
+	home string
+}
+
+// runRepair detects the issues and repairs the ones listed in fix
+func runRepair(checks []repairCheck, fix []string) []*pb.RepairIssue {
+	var issues []*pb.RepairIssue
+	for _, check := range checks {
+		if !check.detect() {
+			continue
+		}
+
+		issue := &pb.RepairIssue{
+			Id:          check.id,
+			Description: check.description,
+			Status:      pb.RepairStatus_DETECTED,
+		}
+		if slices.Contains(fix, check.id) {
+			if err := check.fix(); err != nil {
+				log.Println(internal.ErrorPrefix, "failed to repair", check.id+":", err)
+				issue.Status = pb.RepairStatus_REPAIR_FAILED
+				issue.Error = err.Error()
+			} else {
+				log.Println(internal.InfoPrefix, "repaired", check.id)
+				issue.Status = pb.RepairStatus_REPAIRED
+			}
+		}
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+// repairChecks returns the checks of the states which are left after the VPN connection was not
+// torn down properly, e.g. because of a crash
+func (r *RPC) repairChecks(cfg config.Config, user *repairUser) []repairCheck {
+	// connection related states are expected while VPN, meshnet or kill switch are in use
+	isIdle := func() bool {
+		return !r.netw.IsVPNActive() && !r.netw.IsMeshnetActive()
+	}
+
+	checks := []repairCheck{
+		{
+			id:          RepairFirewall,
+			description: "Firewall rules were left after the VPN connection",
+			detect: func() bool {
+				if !isIdle() || cfg.KillSwitch {
+					return false
+				}
+				if r.netw.IsNetworkSet() {
+					return true
+				}
+				rules, err := r.leftoverFirewallRules()
+				if err != nil {
+					log.Println(internal.WarningPrefix, "failed to list firewall rules:", err)
+				}
+				return len(rules) > 0
+			},
+			fix: r.repairFirewall,
+		},
+		{
+			id:          RepairRoutes,
+			description: "VPN interfaces and their routes were left after the VPN connection",
+			detect: func() bool {
+				return isIdle() && len(leftoverVPNInterfaces(cfg.InterfaceName)) > 0
+			},
+			fix: func() error {
+				for _, name := range leftoverVPNInterfaces(cfg.InterfaceName) {
+					if err := deleteInterface(name); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+		{
+			id:          RepairDNS,
+			description: "DNS settings of the VPN connection were left in /etc/resolv.conf",
+			detect: func() bool {
+				return !r.netw.IsVPNActive() && dns.IsResolvConfFileModified()
+			},
+			fix: func() error {
+				dns.RestoreResolvConfFile()
+				if dns.IsResolvConfFileModified() {
+					return errors.New("/etc/resolv.conf could not be restored")
+				}
+				return nil
+			},
+		},
+	}
+
+	if user != nil {
+		checks = append(checks, repairCheck{
+			id:          RepairNorduser,
+			description: "NordVPN user service (norduserd) is not responding",
+			detect: func() bool {
+				return process.NewNorduserProcessClient(user.uid).Ping(true) != nil
+			},
+			fix: func() error {
+				if err := r.norduser.Stop(user.uid, true); err != nil {
+					log.Println(internal.WarningPrefix, "failed to stop norduserd:", err)
+				}
+				if err := r.norduser.Enable(user.uid, user.gid, user.home); err != nil {
+					return fmt.Errorf("starting norduserd: %w", err)
+				}
+				return nil
+			},
+		})
+	}
+
+	return checks
+}
+
+func (r *RPC) leftoverFirewallRules() ([]string, error) {
+	flusher, ok := r.fw.(firewall.Flusher)
+	if !ok {
+		return nil, nil
+	}
+	return flusher.LeftoverRules()
+}
+
+func (r *RPC) repairFirewall() error {
+	if r.netw.IsNetworkSet() {
+		if err := r.netw.UnsetFirewall(); err != nil {
+			log.Println(internal.WarningPrefix, "failed to unset firewall, flushing the rules:", err)
+		}
+	}
+
+	flusher, ok := r.fw.(firewall.Flusher)
+	if !ok {
+		return nil
+	}
+	return flusher.Flush()
+}
+
+// leftoverVPNInterfaces returns the existing interfaces with the default names of the technologies or the configured
+// name. Interfaces which the daemon could not have created are left alone even if the name matches, as the
+// configured name could have been taken by another interface since.
+func leftoverVPNInterfaces(configured string) []string {
+	candidates := []string{nordlynx.InterfaceName, nordwhisper.InterfaceName, openvpnInterfaceName}
+	if configured != "" && !slices.Contains(candidates, configured) {
+		candidates = append(candidates, configured)
+	}
+	var names []string
+	for _, name := range candidates {
+		link, err := netlink.LinkByName(name)
+		if err != nil {
+			continue
+		}
+		if !isTunnelLinkType(link.Type()) {
+			log.Println(internal.WarningPrefix, "interface", name, "of type", link.Type(), "is not a VPN tunnel, skipping")
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// isTunnelLinkType reports whether the interface of the given link type could have been created by the daemon.
+// NordLynx creates a WireGuard interface, OpenVPN and NordWhisper create TUN devices.
+func isTunnelLinkType(linkType string) bool {
+	return linkType == "wireguard" || linkType == "tuntap"
+}
+
+// deleteInterface removes the interface together with the routes through it
+func deleteInterface(name string) error {
+	link, err := netlink.LinkByName(name)
+	if err != nil {
+		return fmt.Errorf("getting interface %s: %w", name, err)
+	}
+	if err := netlink.LinkDel(link); err != nil {
+		return fmt.Errorf("deleting interface %s: %w", name, err)
+	}
+	return nil
+}
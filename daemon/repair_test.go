@@ -0,0 +1,63 @@
+package daemon
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunRepair(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	fixed := []string{}
+	check := func(id string, detected bool, fixErr error) repairCheck {
+		return repairCheck{
+			id:          id,
+			description: id + " is broken",
+			detect:      func() bool { return detected },
+			fix: func() error {
+				fixed = append(fixed, id)
+				return fixErr
+			},
+		}
+	}
+	checks := []repairCheck{
+		check(RepairFirewall, true, nil),
+		check(RepairRoutes, false, nil),
+		check(RepairDNS, true, fmt.Errorf("read-only file system")),
+		check(RepairNorduser, true, nil),
+	}
+
+	issues := runRepair(checks, nil)
+	assert.Empty(t, fixed, "nothing should be fixed without confirmation")
+	assert.Len(t, issues, 3)
+	for _, issue := range issues {
+		assert.Equal(t, pb.RepairStatus_DETECTED, issue.Status)
+	}
+
+	issues = runRepair(checks, []string{RepairFirewall, RepairRoutes, RepairDNS})
+	assert.Equal(t, []string{RepairFirewall, RepairDNS}, fixed)
+	assert.Equal(t, []*pb.RepairIssue{
+		{Id: RepairFirewall, Description: "firewall is broken", Status: pb.RepairStatus_REPAIRED},
+		{
+			Id:          RepairDNS,
+			Description: "dns is broken",
+			Status:      pb.RepairStatus_REPAIR_FAILED,
+			Error:       "read-only file system",
+		},
+		{Id: RepairNorduser, Description: "norduserd is broken", Status: pb.RepairStatus_DETECTED},
+	}, issues)
+}
+
+func TestIsTunnelLinkType(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.True(t, isTunnelLinkType("wireguard"))
+	assert.True(t, isTunnelLinkType("tuntap"))
+	assert.False(t, isTunnelLinkType("device"))
+	assert.False(t, isTunnelLinkType("bridge"))
+	assert.False(t, isTunnelLinkType("veth"))
+}
@@ -8,6 +8,7 @@ import (
 	"net"
 	"sync"
 
+	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
 	"github.com/NordSecurity/nordvpn-linux/daemon/routes/ifgroup"
 	"github.com/NordSecurity/nordvpn-linux/internal"
@@ -21,6 +22,7 @@ type Router struct {
 	ifgroupManager  ifgroup.Manager
 	tableID         uint
 	fwmark          uint32
+	firstTableID    uint // tried first for the custom routing table
 	allowSubnets    []string
 	mu              sync.Mutex
 }
@@ -30,11 +32,17 @@ func NewRouter(
 	rpFilterManager routes.RPFilterManager,
 	ifgroupManager ifgroup.Manager,
 	fwmark uint32,
+	tableID uint32,
 ) *Router {
+	firstTableID := routes.TableID()
+	if tableID != 0 {
+		firstTableID = uint(tableID)
+	}
 	return &Router{
 		rpFilterManager: rpFilterManager,
 		ifgroupManager:  ifgroupManager,
 		fwmark:          fwmark,
+		firstTableID:    firstTableID,
 	}
 }
 
@@ -88,7 +96,7 @@ func (r *Router) SetupRoutingRules(
 			if err != nil {
 				return err
 			}
-			routingTableID, err = calculateCustomTableID(ipv6)
+			routingTableID, err = calculateCustomTableID(ipv6, r.firstTableID)
 			if err != nil {
 				return err
 			}
@@ -123,11 +131,14 @@ func (r *Router) SetupRoutingRules(
 
 		// on top, add allowlisted subnet routing rules
 		for _, subnet := range allowSubnets {
-			subnetRuleID, err := calculateRulePriority(ipv6)
+			_, subnetIPNet, err := net.ParseCIDR(subnet)
 			if err != nil {
 				return err
 			}
-			_, subnetIPNet, err := net.ParseCIDR(subnet)
+			if !isSubnetOfFamily(subnetIPNet, ipv6) {
+				continue
+			}
+			subnetRuleID, err := calculateRulePriority(ipv6)
 			if err != nil {
 				return err
 			}
@@ -271,8 +282,8 @@ func calculateRulePriority(ipv6 bool) (uint, error) {
 	return prioID, nil
 }
 
-// calculateCustomTableID find out non-in-use id for new custom routing table
-func calculateCustomTableID(ipv6 bool) (uint, error) {
+// calculateCustomTableID find out non-in-use id for new custom routing table starting from the given one
+func calculateCustomTableID(ipv6 bool, first uint) (uint, error) {
 	// # find out all table ids
 	// CMD: ip route show table all
 	// # sample output:
@@ -296,13 +307,13 @@ func calculateCustomTableID(ipv6 bool) (uint, error) {
 	}
 
 	// find table id not in use by others
-	tblID := routes.TableID()
+	tblID := first
 	for {
 		if !allID[tblID] {
 			break
 		}
 		tblID = tblID + 1
-		if tblID > 60000 {
+		if tblID > config.MaxRoutingTable {
 			return 0, fmt.Errorf("unable to calculate custom table id")
 		}
 	}
@@ -380,7 +391,7 @@ func addAllowSubnetRule(prioID uint, subnet *net.IPNet, ipv6 bool) error {
 func removeAllowSubnetRules(subnets []string, ipv6 bool) {
 	for _, subnet := range subnets {
 		_, subnetIPNet, err := net.ParseCIDR(subnet)
-		if err != nil {
+		if err != nil || !isSubnetOfFamily(subnetIPNet, ipv6) {
 			continue
 		}
 		if err := removeAllowSubnetRule(subnetIPNet, ipv6); err != nil {
@@ -487,6 +498,11 @@ func suppressRule(prioID int, ipv6 bool, skipGroup bool) *netlink.Rule {
 	return rule
 }
 
+// isSubnetOfFamily returns true if the subnet can be used in the rules of the given family
+func isSubnetOfFamily(subnet *net.IPNet, ipv6 bool) bool {
+	return (subnet.IP.To4() == nil) == ipv6
+}
+
 func toNetlinkFamily(val bool) int {
 	if val {
 		return netlink.FAMILY_V6
@@ -1,8 +1,10 @@
 package iprule
 
 import (
+	"net"
 	"testing"
 
+	"github.com/NordSecurity/nordvpn-linux/daemon/routes"
 	"github.com/NordSecurity/nordvpn-linux/test/category"
 
 	"github.com/stretchr/testify/assert"
@@ -15,7 +17,7 @@ func TestFwmarkRule(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Greater(t, prioID, uint(0))
 
-	tblID, err := calculateCustomTableID(false)
+	tblID, err := calculateCustomTableID(false, routes.TableID())
 	assert.NoError(t, err)
 	assert.Greater(t, tblID, uint(0))
 
@@ -47,7 +49,7 @@ func TestMultiFwmarkRule(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Greater(t, prioID, uint(0))
 
-	tblID, err := calculateCustomTableID(false)
+	tblID, err := calculateCustomTableID(false, routes.TableID())
 	assert.NoError(t, err)
 	assert.Greater(t, tblID, uint(0))
 
@@ -67,7 +69,7 @@ func TestMultiFwmarkRule(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Greater(t, prioID2, uint(0))
 
-	tblID2, err := calculateCustomTableID(false)
+	tblID2, err := calculateCustomTableID(false, routes.TableID())
 	assert.NoError(t, err)
 	assert.Greater(t, tblID2, uint(0))
 
@@ -103,7 +105,7 @@ func TestSuppressRule(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Greater(t, prioID, uint(0))
 
-	tblID, err := calculateCustomTableID(false)
+	tblID, err := calculateCustomTableID(false, routes.TableID())
 	assert.NoError(t, err)
 	assert.Greater(t, tblID, uint(0))
 
@@ -164,7 +166,7 @@ func TestCustomTable(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Greater(t, prioID, uint(0))
 
-	tblID, err := calculateCustomTableID(false)
+	tblID, err := calculateCustomTableID(false, routes.TableID())
 	assert.NoError(t, err)
 	assert.Greater(t, tblID, uint(0))
 
@@ -183,3 +185,17 @@ func TestCustomTable(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Greater(t, prioID, prioID2)
 }
+
+func TestIsSubnetOfFamily(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	_, ipv4Subnet, err := net.ParseCIDR("192.168.1.0/24")
+	assert.NoError(t, err)
+	_, ipv6Subnet, err := net.ParseCIDR("fd00::/8")
+	assert.NoError(t, err)
+
+	assert.True(t, isSubnetOfFamily(ipv4Subnet, false))
+	assert.False(t, isSubnetOfFamily(ipv4Subnet, true))
+	assert.True(t, isSubnetOfFamily(ipv6Subnet, true))
+	assert.False(t, isSubnetOfFamily(ipv6Subnet, false))
+}
@@ -82,6 +82,7 @@ func toNetlinkRoute(route routes.Route) netlink.Route {
 		Dst:       prefixToIPNet(route.Subnet),
 		Table:     int(tableID),
 		Scope:     scope,
+		Priority:  int(route.Metric),
 	}
 }
 
@@ -231,6 +231,16 @@ func TestRouter_Has(t *testing.T) {
 			route:    exRoute,
 			contains: false,
 		},
+		{
+			name: "not found with different metric",
+			list: existingRoutes,
+			route: routes.Route{
+				Device: lo,
+				Subnet: netip.MustParsePrefix("127.0.0.1/32"),
+				Metric: 100,
+			},
+			contains: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -241,6 +251,20 @@ func TestRouter_Has(t *testing.T) {
 	}
 }
 
+func TestToNetlinkRoute_Metric(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	route := routes.Route{
+		Subnet: netip.MustParsePrefix("0.0.0.0/0"),
+		Device: net.Interface{Name: "nordtun", Index: 10},
+		Metric: 50,
+	}
+	assert.Equal(t, 50, toNetlinkRoute(route).Priority)
+
+	route.Metric = 0
+	assert.Equal(t, 0, toNetlinkRoute(route).Priority)
+}
+
 func route(t *testing.T, destination netip.Addr, maskIP netip.Addr, cidrMask int) routes.Route {
 	t.Helper()
 	return routes.Route{
@@ -19,6 +19,8 @@ type Route struct {
 	Subnet  netip.Prefix
 	Device  net.Interface
 	TableID uint
+	// Metric is the priority of the route, the lower one wins. Zero leaves the kernel default.
+	Metric uint32
 }
 
 // IsEqual compares to routes for equality.
@@ -26,7 +28,8 @@ func (r *Route) IsEqual(to Route) bool {
 	return r.Gateway == to.Gateway &&
 		r.Subnet == to.Subnet &&
 		r.Device.Name == to.Device.Name &&
-		r.TableID == to.TableID
+		r.TableID == to.TableID &&
+		r.Metric == to.Metric
 }
 
 // Agent is stateless and is responsible for creating and deleting source based
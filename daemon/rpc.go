@@ -3,6 +3,8 @@
 package daemon
 
 import (
+	"log"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -13,7 +15,9 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
 	daemonevents "github.com/NordSecurity/nordvpn-linux/daemon/events"
 	"github.com/NordSecurity/nordvpn-linux/daemon/firewall"
+	"github.com/NordSecurity/nordvpn-linux/daemon/hooks"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/splittunnel"
 	"github.com/NordSecurity/nordvpn-linux/daemon/state"
 	"github.com/NordSecurity/nordvpn-linux/events"
 	"github.com/NordSecurity/nordvpn-linux/internal"
@@ -39,24 +43,51 @@ type RPC struct {
 	repo           *RepoAPI
 	authentication core.Authentication
 	lastServer     core.Server
-	version        string
-	events         *daemonevents.Events
+	// lastTarget is the request of the last connection, used to connect again after the pause
+	lastTarget      *pb.ConnectRequest
+	pause           vpnPause
+	schedule        *vpnSchedule
+	serverLoadCache serverLoadCache
+	probeLatency    LatencyProber
+	version         string
+	events          *daemonevents.Events
 	// factory picks which VPN implementation to use
 	factory              FactoryFunc
 	endpointResolver     network.EndpointResolver
 	endpoint             network.Endpoint
 	scheduler            gocron.Scheduler
 	netw                 networker.Networker
+	fw                   firewall.Service
 	publisher            events.Publisher[string]
 	nameservers          dns.Getter
 	ncClient             nc.NotificationClient
 	analytics            events.Analytics
 	norduser             service.Service
+	norduserMonitor      *service.HealthMonitor
+	norduserClient       service.NorduserClient
 	meshRegistry         mesh.Registry
 	systemShutdown       atomic.Bool
 	statePublisher       *state.StatePublisher
 	ConnectionParameters ParametersStorage
 	connectContext       *sharedctx.Context
+	pendingActions       *PendingActions
+	trustedNetworks      *TrustedNetworkRules
+	metered              *MeteredNetwork
+	captivePortal        *CaptivePortal
+	healthMonitor        *healthMonitor
+	serverPing           *serverPingTracker
+	obfuscation          *obfuscationFallback
+	eventStream          *EventStream
+	splitTunnel          splittunnel.Agent
+	connectionHistory    *ConnectionHistory
+	bandwidthUsage       *BandwidthUsage
+	hooks                *hooks.Runner
+	logFilter            *internal.LogFilter
+	// seatUser returns the user at the console, whose autoconnect target is used
+	seatUser func() (int64, bool, error)
+	// shutdown is closed when the daemon starts draining the RPCs
+	shutdown     chan struct{}
+	shutdownOnce sync.Once
 	pb.UnimplementedDaemonServer
 }
 
@@ -82,35 +113,94 @@ func NewRPC(
 	ncClient nc.NotificationClient,
 	analytics events.Analytics,
 	norduser service.Service,
+	norduserMonitor *service.HealthMonitor,
+	norduserClient service.NorduserClient,
 	meshRegistry mesh.Registry,
 	statePublisher *state.StatePublisher,
 	connectContext *sharedctx.Context,
+	pendingActions *PendingActions,
+	identifyNetwork NetworkIdentifier,
+	detectMetered MeteredDetector,
+	captivePortalProber CaptivePortalProber,
+	splitTunnel splittunnel.Agent,
+	connectionHistory *ConnectionHistory,
+	bandwidthUsage *BandwidthUsage,
+	hookRunner *hooks.Runner,
+	logFilter *internal.LogFilter,
 ) *RPC {
 	scheduler, _ := gocron.NewScheduler(gocron.WithLocation(time.UTC))
-	return &RPC{
-		environment:      environment,
-		ac:               ac,
-		cm:               cm,
-		dm:               dm,
-		api:              api,
-		serversAPI:       serversAPI,
-		credentialsAPI:   credentialsAPI,
-		cdn:              cdn,
-		repo:             repo,
-		authentication:   authentication,
-		version:          version,
-		factory:          factory,
-		events:           events,
-		endpointResolver: endpointResolver,
-		scheduler:        scheduler,
-		netw:             netw,
-		publisher:        publisher,
-		nameservers:      nameservers,
-		ncClient:         ncClient,
-		analytics:        analytics,
-		norduser:         norduser,
-		meshRegistry:     meshRegistry,
-		statePublisher:   statePublisher,
-		connectContext:   connectContext,
+	r := &RPC{
+		environment:       environment,
+		ac:                ac,
+		cm:                cm,
+		dm:                dm,
+		api:               api,
+		serversAPI:        serversAPI,
+		credentialsAPI:    credentialsAPI,
+		cdn:               cdn,
+		repo:              repo,
+		authentication:    authentication,
+		version:           version,
+		factory:           factory,
+		events:            events,
+		endpointResolver:  endpointResolver,
+		scheduler:         scheduler,
+		netw:              netw,
+		fw:                fw,
+		publisher:         publisher,
+		nameservers:       nameservers,
+		ncClient:          ncClient,
+		analytics:         analytics,
+		norduser:          norduser,
+		norduserMonitor:   norduserMonitor,
+		norduserClient:    norduserClient,
+		meshRegistry:      meshRegistry,
+		statePublisher:    statePublisher,
+		connectContext:    connectContext,
+		pendingActions:    pendingActions,
+		splitTunnel:       splitTunnel,
+		connectionHistory: connectionHistory,
+		bandwidthUsage:    bandwidthUsage,
+		hooks:             hookRunner,
+		logFilter:         logFilter,
+		probeLatency:      pingLatency,
+		metered:           newMeteredNetwork(cm, detectMetered),
+		captivePortal:     newCaptivePortal(cm, netw, captivePortalProber),
+		schedule:          newVPNSchedule(time.Now),
+		healthMonitor:     newHealthMonitor(time.Now),
+		serverPing:        newServerPingTracker(network.PingWithStatistics),
+		obfuscation:       newObfuscationFallback(cm, identifyNetwork, factory),
+		eventStream:       newEventStream(time.Now),
+		seatUser:          seatUser,
+		shutdown:          make(chan struct{}),
 	}
+	r.trustedNetworks = newTrustedNetworkRules(
+		cm,
+		identifyNetwork,
+		netw.IsVPNActive,
+		func() {
+			go func() {
+				if err := r.StartAutoConnect(network.ExponentialBackoff); err != nil {
+					log.Println(internal.ErrorPrefix, "auto-connect on untrusted network:", err)
+				}
+			}()
+		},
+		func() error { return r.Disconnect(&pb.Empty{}, &autoconnectServer{}) },
+	)
+	return r
+}
+
+// TrustedNetworkRules returns the rules applied when the device changes networks
+func (r *RPC) TrustedNetworkRules() *TrustedNetworkRules {
+	return r.trustedNetworks
+}
+
+// MeteredNetwork returns the tasks postponed while the device uses a metered network
+func (r *RPC) MeteredNetwork() *MeteredNetwork {
+	return r.metered
+}
+
+// CaptivePortal returns the captive portal checks done when the device changes networks
+func (r *RPC) CaptivePortal() *CaptivePortal {
+	return r.captivePortal
 }
@@ -0,0 +1,78 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+var analyticsCategoryToConfig = map[pb.AnalyticsCategory]config.AnalyticsCategory{
+	pb.AnalyticsCategory_ANALYTICS_CRASH:       config.AnalyticsCrash,
+	pb.AnalyticsCategory_ANALYTICS_USAGE:       config.AnalyticsUsage,
+	pb.AnalyticsCategory_ANALYTICS_PERFORMANCE: config.AnalyticsPerformance,
+}
+
+var analyticsCategoryToProtobuf = map[config.AnalyticsCategory]pb.AnalyticsCategory{
+	config.AnalyticsCrash:       pb.AnalyticsCategory_ANALYTICS_CRASH,
+	config.AnalyticsUsage:       pb.AnalyticsCategory_ANALYTICS_USAGE,
+	config.AnalyticsPerformance: pb.AnalyticsCategory_ANALYTICS_PERFORMANCE,
+}
+
+func analyticsConsentToProtobuf(consent config.AnalyticsConsent) *pb.AnalyticsConsent {
+	return &pb.AnalyticsConsent{
+		Crash:       consent.Crash.Get(),
+		Usage:       consent.Usage.Get(),
+		Performance: consent.Performance.Get(),
+	}
+}
+
+// SetAnalyticsCategory allows or forbids sending the analytics events of a single category
+func (r *RPC) SetAnalyticsCategory(ctx context.Context, in *pb.SetAnalyticsCategoryRequest) (*pb.Payload, error) {
+	category, ok := analyticsCategoryToConfig[in.GetCategory()]
+	if !ok {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	if cfg.AnalyticsConsent.Allowed(category) == in.GetEnabled() {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.AnalyticsConsent.Set(category, in.GetEnabled())
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// AnalyticsEvents returns the latest analytics events together with whether they were sent
+func (r *RPC) AnalyticsEvents(ctx context.Context, _ *pb.Empty) (*pb.AnalyticsEventsResponse, error) {
+	inspector, ok := r.analytics.(analyticsInspector)
+	if !ok {
+		return &pb.AnalyticsEventsResponse{Type: internal.CodeSuccess}, nil
+	}
+
+	var events []*pb.AnalyticsEvent
+	for _, event := range inspector.Events() {
+		events = append(events, &pb.AnalyticsEvent{
+			Timestamp: event.Time.UnixMilli(),
+			Category:  analyticsCategoryToProtobuf[event.Category],
+			Name:      event.Name,
+			Data:      event.Data,
+			Sent:      event.Sent,
+		})
+	}
+
+	return &pb.AnalyticsEventsResponse{Type: internal.CodeSuccess, Events: events}, nil
+}
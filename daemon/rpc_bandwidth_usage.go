@@ -0,0 +1,60 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// recordBandwidth records the bytes transferred by the active connection
+func (r *RPC) recordBandwidth() {
+	if r.bandwidthUsage == nil {
+		return
+	}
+	status, err := r.netw.ConnectionStatus()
+	if err != nil {
+		return
+	}
+	if err := r.bandwidthUsage.Record(status.Hostname, status.Download, status.Upload); err != nil {
+		log.Println(internal.WarningPrefix, "recording bandwidth usage:", err)
+	}
+	if r.connectionHistory != nil {
+		if err := r.connectionHistory.RecordTransfer(status.Download, status.Upload); err != nil {
+			log.Println(internal.WarningPrefix, "recording connection transfer:", err)
+		}
+	}
+}
+
+// BandwidthUsage returns the bytes transferred through VPN grouped by day, week or server
+func (r *RPC) BandwidthUsage(_ context.Context, in *pb.BandwidthUsageRequest) (*pb.BandwidthUsageResponse, error) {
+	if r.bandwidthUsage == nil {
+		return &pb.BandwidthUsageResponse{}, nil
+	}
+	// include the bytes transferred since the last sample
+	r.recordBandwidth()
+
+	var totals []BandwidthTotal
+	switch in.GetGroupBy() {
+	case pb.BandwidthGrouping_BY_DAY:
+		totals = r.bandwidthUsage.ByDay(int(in.GetLimit()))
+	case pb.BandwidthGrouping_BY_WEEK:
+		totals = r.bandwidthUsage.ByWeek(int(in.GetLimit()))
+	case pb.BandwidthGrouping_BY_SERVER:
+		totals = r.bandwidthUsage.ByServer()
+	}
+
+	resp := &pb.BandwidthUsageResponse{}
+	for _, total := range totals {
+		resp.Entries = append(resp.Entries, &pb.BandwidthUsageEntry{
+			PeriodStart: unixOrZero(total.Start),
+			Server:      total.Server,
+			Download:    total.Download,
+			Upload:      total.Upload,
+		})
+		resp.Download += total.Download
+		resp.Upload += total.Upload
+	}
+	return resp, nil
+}
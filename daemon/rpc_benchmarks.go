@@ -0,0 +1,238 @@
+package daemon
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/network"
+)
+
+const (
+	// benchmarkCandidates is the number of recommended servers probed when looking for the fastest one
+	benchmarkCandidates = 10
+	// benchmarkPingCount is the number of echo requests sent to each of the probed servers
+	benchmarkPingCount = 3
+	// latencyMaxAge defines how long the measured round trip time is used before the server is probed again
+	latencyMaxAge = 10 * time.Minute
+)
+
+// LatencyProber measures the average round trip time to the address
+type LatencyProber func(addr string) (time.Duration, error)
+
+// pingLatency measures the round trip time with ICMP echo requests
+func pingLatency(addr string) (time.Duration, error) {
+	stats, err := network.PingWithStatistics(addr, benchmarkPingCount)
+	if err != nil {
+		return 0, err
+	}
+	if stats.Received == 0 {
+		return 0, errors.New("no ping response received")
+	}
+	return stats.AvgRTT, nil
+}
+
+// serverBenchmark is the round trip time to the server, negative if the server has not responded
+type serverBenchmark struct {
+	server core.Server
+	rtt    time.Duration
+}
+
+// Benchmarks measures the round trip time to the recommended servers matching the tag and the group
+func (r *RPC) Benchmarks(ctx context.Context, in *pb.BenchmarksRequest) (*pb.BenchmarksResponse, error) {
+	if !r.ac.IsLoggedIn() {
+		return nil, internal.ErrNotLoggedIn
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.BenchmarksResponse{Type: internal.CodeConfigError}, nil
+	}
+	insights := r.dm.GetInsightsData().Insights
+
+	servers, _, err := r.benchmarkCandidates(
+		r.serversAPI,
+		r.dm.GetCountryData().Countries,
+		r.dm.GetServersData().Servers,
+		insights.Longitude,
+		insights.Latitude,
+		cfg.Technology,
+		cfg.AutoConnectData.Protocol,
+		cfg.AutoConnectData.Obfuscate,
+		internal.RemoveNonAlphanumeric(in.GetServerTag()),
+		in.GetServerGroup(),
+		cfg.VirtualLocation.Get(),
+		cfg.MaxServerLoad,
+	)
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrDedicatedIPServer):
+		// dedicated IP servers are assigned to the user, there is nothing to choose from
+		return &pb.BenchmarksResponse{Type: internal.CodeNothingToDo}, nil
+	case errors.Is(err, internal.ErrTagDoesNotExist),
+		errors.Is(err, internal.ErrGroupDoesNotExist),
+		errors.Is(err, internal.ErrServerIsUnavailable),
+		errors.Is(err, internal.ErrDoubleGroup),
+		errors.Is(err, internal.ErrVirtualServerSelected):
+		return nil, err
+	default:
+		log.Println(internal.ErrorPrefix, "picking servers for benchmarks:", err)
+		return nil, internal.ErrUnhandled
+	}
+
+	// fresh measurements are requested explicitly, so the cache is not used
+	resp := &pb.BenchmarksResponse{Type: internal.CodeSuccess}
+	for _, benchmark := range r.benchmarkServers(servers, false) {
+		server := benchmark.server
+		serverBenchmark := &pb.ServerBenchmark{
+			Name:     server.Name,
+			Hostname: server.Hostname,
+			Load:     server.Load,
+			Rtt:      int64(benchmark.rtt),
+		}
+		if country, err := server.Locations.Country(); err == nil {
+			serverBenchmark.Country = country.Name
+			serverBenchmark.City = country.City.Name
+		}
+		resp.Servers = append(resp.Servers, serverBenchmark)
+	}
+	return resp, nil
+}
+
+// pickFastestServer picks the recommended server with the lowest round trip time. It has the same signature as
+// PickServer, so it can be used in its place.
+func (r *RPC) pickFastestServer(
+	api core.ServersAPI,
+	countries core.Countries,
+	servers core.Servers,
+	longitude float64,
+	latitude float64,
+	tech config.Technology,
+	protocol config.Protocol,
+	obfuscated bool,
+	tag string,
+	groupFlag string,
+	allowVirtualServer bool,
+	maxLoad int64,
+) (core.Server, bool, error) {
+	candidates, remote, err := r.benchmarkCandidates(
+		api,
+		countries,
+		servers,
+		longitude,
+		latitude,
+		tech,
+		protocol,
+		obfuscated,
+		tag,
+		groupFlag,
+		allowVirtualServer,
+		maxLoad,
+	)
+	if err != nil {
+		return core.Server{}, remote, err
+	}
+
+	fastest := r.benchmarkServers(candidates, true)[0]
+	if fastest.rtt < 0 {
+		log.Println(internal.WarningPrefix, "none of the recommended servers has responded to ping")
+	} else {
+		log.Println(internal.InfoPrefix, "fastest server", fastest.server.Hostname, "rtt", fastest.rtt)
+	}
+	return fastest.server, remote, nil
+}
+
+// benchmarkCandidates returns the recommended servers worth probing
+func (r *RPC) benchmarkCandidates(
+	api core.ServersAPI,
+	countries core.Countries,
+	servers core.Servers,
+	longitude float64,
+	latitude float64,
+	tech config.Technology,
+	protocol config.Protocol,
+	obfuscated bool,
+	tag string,
+	groupFlag string,
+	allowVirtualServer bool,
+	maxLoad int64,
+) ([]core.Server, bool, error) {
+	candidates, remote, err := getServers(
+		api,
+		countries,
+		servers,
+		longitude,
+		latitude,
+		tech,
+		protocol,
+		obfuscated,
+		tag,
+		groupFlag,
+		benchmarkCandidates,
+		allowVirtualServer,
+		maxLoad,
+	)
+	if err != nil {
+		return nil, remote, err
+	}
+	// locally cached servers are not limited, the most recommended ones are at the beginning
+	return candidates[:min(len(candidates), benchmarkCandidates)], remote, nil
+}
+
+// benchmarkServers probes the servers in parallel and orders them by the round trip time. Servers which have not
+// responded are at the end, servers with equal round trip time keep their recommendation order.
+func (r *RPC) benchmarkServers(servers []core.Server, useCache bool) []serverBenchmark {
+	benchmarks := make([]serverBenchmark, len(servers))
+	var wg sync.WaitGroup
+	for i, server := range servers {
+		benchmarks[i].server = server
+		if useCache {
+			if latency, ok := r.dm.GetServerLatency(server.ID, latencyMaxAge); ok {
+				benchmarks[i].rtt = latency.RTT
+				continue
+			}
+		}
+
+		wg.Add(1)
+		go func(benchmark *serverBenchmark) {
+			defer wg.Done()
+			benchmark.rtt = r.probeServer(benchmark.server)
+			r.dm.SetServerLatency(benchmark.server.ID, benchmark.rtt)
+		}(&benchmarks[i])
+	}
+	wg.Wait()
+
+	slices.SortStableFunc(benchmarks, func(a, b serverBenchmark) int {
+		if (a.rtt < 0) != (b.rtt < 0) {
+			if a.rtt < 0 {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Compare(a.rtt, b.rtt)
+	})
+	return benchmarks
+}
+
+// probeServer returns the round trip time to the server or -1 if it has not responded
+func (r *RPC) probeServer(server core.Server) time.Duration {
+	ip, err := server.IPv4()
+	if err != nil {
+		return -1
+	}
+	rtt, err := r.probeLatency(ip.String())
+	if err != nil {
+		log.Println(internal.DebugPrefix, "probing", server.Hostname, "failed:", err)
+		return -1
+	}
+	return rtt
+}
@@ -0,0 +1,91 @@
+package daemon
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeProber returns the configured round trip times and counts the probes
+type fakeProber struct {
+	mu     sync.Mutex
+	rtts   map[string]time.Duration
+	probed []string
+}
+
+func (p *fakeProber) probe(addr string) (time.Duration, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.probed = append(p.probed, addr)
+	rtt, ok := p.rtts[addr]
+	if !ok {
+		return 0, fmt.Errorf("timeout")
+	}
+	return rtt, nil
+}
+
+func TestBenchmarkServers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	servers := []core.Server{
+		{ID: 1, Hostname: "de1.nordvpn.com", Station: "10.0.0.1"},
+		{ID: 2, Hostname: "de2.nordvpn.com", Station: "10.0.0.2"},
+		{ID: 3, Hostname: "de3.nordvpn.com", Station: "10.0.0.3"},
+		{ID: 4, Hostname: "de4.nordvpn.com", Station: "10.0.0.4"},
+		{ID: 5, Hostname: "de5.nordvpn.com"},
+	}
+	prober := &fakeProber{rtts: map[string]time.Duration{
+		"10.0.0.2": 40 * time.Millisecond,
+		"10.0.0.3": 20 * time.Millisecond,
+		"10.0.0.4": 40 * time.Millisecond,
+	}}
+	rpc := RPC{dm: testNewDataManager(), probeLatency: prober.probe}
+
+	var order []int64
+	var rtts []time.Duration
+	for _, benchmark := range rpc.benchmarkServers(servers, true) {
+		order = append(order, benchmark.server.ID)
+		rtts = append(rtts, benchmark.rtt)
+	}
+	// equal round trip times keep the recommendation order, servers without response are the last
+	assert.Equal(t, []int64{3, 2, 4, 1, 5}, order)
+	assert.Equal(t, []time.Duration{20 * time.Millisecond, 40 * time.Millisecond, 40 * time.Millisecond, -1, -1}, rtts)
+	// server without an IP address is not probed
+	assert.Len(t, prober.probed, 4)
+
+	latency, ok := rpc.dm.GetServerLatency(3, latencyMaxAge)
+	assert.True(t, ok)
+	assert.Equal(t, 20*time.Millisecond, latency.RTT)
+
+	// cached measurements are reused
+	prober.probed = nil
+	rpc.benchmarkServers(servers, true)
+	assert.Empty(t, prober.probed)
+
+	// fresh measurements ignore the cache
+	rpc.benchmarkServers(servers, false)
+	assert.Len(t, prober.probed, 4)
+}
+
+func TestServerLatency_MaxAge(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	dm := testNewDataManager()
+	_, ok := dm.GetServerLatency(1, latencyMaxAge)
+	assert.False(t, ok)
+
+	dm.SetServerLatency(1, 15*time.Millisecond)
+	latency, ok := dm.GetServerLatency(1, latencyMaxAge)
+	assert.True(t, ok)
+	assert.Equal(t, 15*time.Millisecond, latency.RTT)
+
+	time.Sleep(time.Millisecond)
+	_, ok = dm.GetServerLatency(1, time.Nanosecond)
+	assert.False(t, ok)
+}
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"log"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/hooks"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/events"
@@ -26,6 +28,23 @@ func isDedicatedIP(server core.Server) bool {
 
 // Connect initiates and handles the VPN connection process
 func (r *RPC) Connect(in *pb.ConnectRequest, srv pb.Daemon_ConnectServer) (retErr error) {
+	if _, ok := validateNameservers(in.GetDns()); !ok || len(in.GetDns()) > maxNameservers {
+		return srv.Send(&pb.Payload{Type: internal.CodeFormatError})
+	}
+
+	if in.GetFavorite() != "" {
+		var cfg config.Config
+		if err := r.cm.Load(&cfg); err != nil {
+			log.Println(internal.ErrorPrefix, err)
+			return srv.Send(&pb.Payload{Type: internal.CodeConfigError})
+		}
+		favorite, ok := resolveFavorite(in, cfg.Favorites)
+		if !ok {
+			return srv.Send(&pb.Payload{Type: internal.CodeFavoriteNotFound, Data: []string{in.GetFavorite()}})
+		}
+		in = favorite
+	}
+
 	var err error
 	// TODO: Currently this only listens to a given context in `netw.Start()`, therefore gets
 	// stopped on `ctx.Done()` only if it happens while `netw.Start()` is being executed.
@@ -36,18 +55,49 @@ func (r *RPC) Connect(in *pb.ConnectRequest, srv pb.Daemon_ConnectServer) (retEr
 	//     whole `r.connect` until it exits.
 	// In order to fix this, all of expensive operations should implement `ctx.Done()` handling
 	// and have context bypassed to them.
+	// connecting expresses the latest intent of the user so the connection is not made again after the pause
+	if r.pause.cancel() {
+		log.Println(internal.InfoPrefix, "pause was ended by connect")
+	}
+	if r.schedule != nil && isManual(srv) {
+		r.schedule.recordManual()
+	}
+
+	if r.pendingActions.IsOffline() && r.ac.IsLoggedIn() {
+		return r.queueConnect(in, srv)
+	}
+
 	if !r.connectContext.TryExecuteWith(func(ctx context.Context) {
-		err = r.connect(ctx, in, srv)
+		err = r.connectWithFallback(ctx, in, srv)
 	}) {
 		return srv.Send(&pb.Payload{Type: internal.CodeNothingToDo})
 	}
 	return err
 }
 
+// queueConnect postpones the connection until the network is back instead of failing while the
+// device is offline
+func (r *RPC) queueConnect(in *pb.ConnectRequest, srv pb.Daemon_ConnectServer) error {
+	target := strings.TrimSpace(strings.Join([]string{in.GetServerTag(), in.GetServerGroup()}, " "))
+	description := "connect to the recommended server"
+	if target != "" {
+		description = "connect to " + target
+	}
+
+	id := r.pendingActions.Add(PendingActionConnect, description, func() {
+		server := autoconnectServer{}
+		if err := r.Connect(in, &server); !connectErrorCheck(err) || server.err != nil {
+			log.Println(internal.ErrorPrefix, "queued connect failed, err1:", server.err, "| err2:", err)
+		}
+	})
+	return srv.Send(&pb.Payload{Type: internal.CodeQueuedOffline, Data: []string{id}})
+}
+
 func (r *RPC) connect(
 	ctx context.Context,
 	in *pb.ConnectRequest,
 	srv pb.Daemon_ConnectServer,
+	rung config.TechnologyRung,
 ) (retErr error) {
 	if !r.ac.IsLoggedIn() {
 		return internal.ErrNotLoggedIn
@@ -65,6 +115,8 @@ func (r *RPC) connect(
 	if err := r.cm.Load(&cfg); err != nil {
 		log.Println(internal.ErrorPrefix, err)
 	}
+	configuredTechnology := cfg.Technology
+	cfg = rungConfig(cfg, rung)
 
 	insights := r.dm.GetInsightsData().Insights
 
@@ -91,16 +143,14 @@ func (r *RPC) connect(
 		TargetServerPickerResponse: "",
 	}
 
-	inputServerTag := internal.RemoveNonAlphanumeric(in.GetServerTag())
-
 	log.Println(internal.DebugPrefix, "picking servers for", cfg.Technology, "technology", "input",
 		in.GetServerTag(), in.GetServerGroup())
 
-	server, remote, err := selectServer(r, &insights, cfg, inputServerTag, in.GetServerGroup())
+	server, remote, err := r.pickConnectServer(in, cfg, &insights)
 	if err != nil {
 		var errorCode *internal.ErrorWithCode
 		if errors.As(err, &errorCode) {
-			return srv.Send(&pb.Payload{Type: errorCode.Code})
+			return srv.Send(&pb.Payload{Type: errorCode.Code, Data: errorCode.Data})
 		}
 
 		return err
@@ -131,6 +181,15 @@ func (r *RPC) connect(
 		return internal.ErrUnhandled
 	}
 	r.lastServer = *server
+	r.lastTarget = &pb.ConnectRequest{
+		ServerTag:   in.GetServerTag(),
+		ServerGroup: in.GetServerGroup(),
+		Dns:         in.GetDns(),
+		Fastest:     in.GetFastest(),
+		Via:         in.GetVia(),
+		Random:      in.GetRandom(),
+		DedicatedIp: in.GetDedicatedIp(),
+	}
 
 	tokenData := cfg.TokensData[cfg.AutoConnectData.ID]
 	creds := vpn.Credentials{
@@ -143,17 +202,33 @@ func (r *RPC) connect(
 		city = server.Locations[0].City.Name
 	}
 	serverData := vpn.ServerData{
-		IP:                subnet.Addr(),
-		Hostname:          server.Hostname,
-		Name:              server.Name,
-		Country:           country.Name,
-		City:              city,
-		Protocol:          cfg.AutoConnectData.Protocol,
-		NordLynxPublicKey: server.NordLynxPublicKey,
-		Obfuscated:        cfg.AutoConnectData.Obfuscate,
-		OpenVPNVersion:    server.Version(),
-		VirtualLocation:   server.IsVirtualLocation(),
-		PostQuantum:       cfg.AutoConnectData.PostquantumVpn,
+		IP:                  subnet.Addr(),
+		Hostname:            server.Hostname,
+		Name:                server.Name,
+		Country:             country.Name,
+		City:                city,
+		Protocol:            cfg.AutoConnectData.Protocol,
+		NordLynxPublicKey:   server.NordLynxPublicKey,
+		Obfuscated:          cfg.AutoConnectData.Obfuscate,
+		OpenVPNVersion:      server.Version(),
+		VirtualLocation:     server.IsVirtualLocation(),
+		PostQuantum:         cfg.AutoConnectData.PostquantumVpn,
+		MTU:                 cfg.MTU,
+		OpenVPNPort:         cfg.AutoConnectData.OpenVPNPort,
+		Technology:          cfg.Technology,
+		InterfaceName:       cfg.TunnelInterfaceName(),
+		PersistentKeepalive: cfg.PersistentKeepalive,
+	}
+	serverData.ConnectTimeout, serverData.HandshakeTimeout = cfg.ConnectTimeouts.For(cfg.Technology)
+	if cfg.Technology == config.Technology_OPENVPN && cfg.AutoConnectData.Stealth.Enabled {
+		serverData.OpenVPNProxy = cfg.AutoConnectData.Stealth.Proxy
+	}
+	if cfg.Technology == config.Technology_OPENVPN {
+		serverData.OpenVPNDirectives = cfg.OpenVPNDirectives
+	}
+	if cfg.IPv6 {
+		// IPv6 traffic is routed through the tunnel only if the server supports it, otherwise it is blocked
+		serverData.IPv6, _ = server.IPv6()
 	}
 
 	allowlist := cfg.AutoConnectData.Allowlist
@@ -182,6 +257,23 @@ func (r *RPC) connect(
 		}
 	}()
 
+	if err := r.hooks.PreConnect(ctx, hooks.Env{
+		Server:     server.Hostname,
+		ServerIP:   subnet.Addr().String(),
+		Country:    country.Name,
+		City:       city,
+		Technology: cfg.Technology,
+		Interface:  serverData.InterfaceName,
+		Protocol:   cfg.AutoConnectData.Protocol,
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, "connection aborted:", err)
+		event.EventStatus = events.StatusFailure
+		event.Error = err
+		event.DurationMs = max(int(time.Since(connectingStartTime).Milliseconds()), 1)
+		r.events.Service.Connect.Publish(event)
+		return srv.Send(&pb.Payload{Type: internal.CodeHookFailed, Data: []string{err.Error()}})
+	}
+
 	virtualServer := ""
 	if server.IsVirtualLocation() {
 		virtualServer = " - Virtual"
@@ -192,15 +284,28 @@ func (r *RPC) connect(
 		log.Println(internal.ErrorPrefix, err)
 	}
 
+	nameservers := cfg.AutoConnectData.DNS.Or(r.nameservers.Get(
+		cfg.AutoConnectData.ThreatProtectionLite,
+		server.SupportsIPv6(),
+	))
+	// servers given for this connection take precedence over the configured ones
+	if len(in.GetDns()) > 0 {
+		nameservers = in.GetDns()
+	}
+
+	if r.obfuscation != nil {
+		if err := r.obfuscation.setVPN(r.netw, cfg.Technology, cfg.Technology != configuredTechnology); err != nil {
+			log.Println(internal.ErrorPrefix, err)
+			return internal.ErrUnhandled
+		}
+	}
+
 	err = r.netw.Start(
 		ctx,
 		creds,
 		serverData,
 		allowlist,
-		cfg.AutoConnectData.DNS.Or(r.nameservers.Get(
-			cfg.AutoConnectData.ThreatProtectionLite,
-			server.SupportsIPv6(),
-		)),
+		nameservers,
 		true, // here vpn connect - enable routing to local LAN
 	)
 
@@ -232,6 +337,10 @@ func (r *RPC) connect(
 			log.Println(internal.ErrorPrefix, "failed to disable ipv6:", err)
 		}
 	}
+	if cfg.MTU == 0 {
+		go r.tunePathMTU()
+	}
+
 	event.EventStatus = events.StatusSuccess
 	event.DurationMs = max(int(time.Since(connectingStartTime).Milliseconds()), 1)
 	r.events.Service.Connect.Publish(event)
@@ -243,4 +352,55 @@ func (r *RPC) connect(
 	return nil
 }
 
+// tunePathMTU lowers the MTU of the tunnel to the path MTU. Links such as PPPoE or LTE often drop the large packets
+// without reporting their lower MTU back, so the connection stalls on them without it.
+func (r *RPC) tunePathMTU() {
+	mtu, err := r.netw.ProbePathMTU()
+	if err != nil {
+		log.Println(internal.WarningPrefix, "probing path MTU:", err)
+		return
+	}
+	log.Println(internal.InfoPrefix, "tunnel MTU is", mtu)
+}
+
+// pickConnectServer selects the server for the connection request, the Double VPN server is selected when the entry
+// country is given and the dedicated IP server is selected by its label
+func (r *RPC) pickConnectServer(
+	in *pb.ConnectRequest,
+	cfg config.Config,
+	insights *core.Insights,
+) (*core.Server, bool, error) {
+	if in.GetDedicatedIp() != "" {
+		server, err := selectDedicatedIPServerByLabel(r.ac, r.dm.GetServersData().Servers, cfg, in.GetDedicatedIp())
+		return server, false, err
+	}
+
+	inputServerTag := internal.RemoveNonAlphanumeric(trimServerDomain(in.GetServerTag()))
+	if in.GetVia() == "" {
+		server, remote, err := selectServer(
+			r, insights, cfg, inputServerTag, in.GetServerGroup(), in.GetFastest(), in.GetRandom())
+		if errors.Is(err, internal.ErrTagDoesNotExist) {
+			return nil, false, &internal.ErrorWithCode{
+				Code: internal.CodeTagNonexisting,
+				Data: serverTagSuggestions(
+					inputServerTag, r.dm.GetCountryData().Countries, r.dm.GetServersData().Servers),
+			}
+		}
+		return server, remote, err
+	}
+
+	exitTag, ok := doubleVPNTarget(inputServerTag, in.GetServerGroup())
+	if !ok {
+		return nil, false, internal.NewErrorWithCode(internal.CodeDoubleVPNRequired)
+	}
+	server, err := selectDoubleVPNServer(
+		r.dm.GetServersData().Servers,
+		r.dm.GetCountryData().Countries,
+		cfg,
+		exitTag,
+		internal.RemoveNonAlphanumeric(in.GetVia()),
+	)
+	return server, false, err
+}
+
 type FactoryFunc func(config.Technology) (vpn.VPN, error)
@@ -0,0 +1,225 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/network"
+)
+
+// localNetworks are the networks allowed by LAN discovery
+var localNetworks = []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "169.254.0.0/16"}
+
+// ConnectDryRun resolves the server, DNS, routes and firewall rules which connecting with the request would use
+// without changing the system
+func (r *RPC) ConnectDryRun(ctx context.Context, in *pb.ConnectRequest) (*pb.ConnectPlan, error) {
+	if _, ok := validateNameservers(in.GetDns()); !ok || len(in.GetDns()) > maxNameservers {
+		return &pb.ConnectPlan{Type: internal.CodeFormatError}, nil
+	}
+
+	if !r.ac.IsLoggedIn() {
+		return nil, internal.ErrNotLoggedIn
+	}
+	vpnExpired, err := r.ac.IsVPNExpired()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "checking VPN expiration: ", err)
+		return &pb.ConnectPlan{Type: internal.CodeTokenRenewError}, nil
+	} else if vpnExpired {
+		return &pb.ConnectPlan{Type: internal.CodeAccountExpired}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.ConnectPlan{Type: internal.CodeConfigError}, nil
+	}
+
+	if in.GetFavorite() != "" {
+		favorite, ok := resolveFavorite(in, cfg.Favorites)
+		if !ok {
+			return &pb.ConnectPlan{Type: internal.CodeFavoriteNotFound}, nil
+		}
+		in = favorite
+	}
+	var rung config.TechnologyRung
+	if r.obfuscation != nil && canUseTechnologyLadder(cfg) {
+		rung = technologyLadder(cfg, in, r.obfuscation.networkKey())[0]
+	} else if r.obfuscation != nil && canFallbackToObfuscation(cfg, in) &&
+		slices.Contains(cfg.ObfuscatedNetworks, r.obfuscation.networkKey()) {
+		rung = config.RungObfuscated
+	}
+	cfg = rungConfig(cfg, rung)
+
+	insights := r.dm.GetInsightsData().Insights
+	server, _, err := r.pickConnectServer(in, cfg, &insights)
+	if err != nil {
+		var errorCode *internal.ErrorWithCode
+		if errors.As(err, &errorCode) {
+			return &pb.ConnectPlan{Type: errorCode.Code}, nil
+		}
+		return nil, err
+	}
+
+	endpoint, err := r.connectEndpoint(cfg, server)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return nil, internal.ErrUnhandled
+	}
+	subnet, err := endpoint.Network()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return nil, internal.ErrUnhandled
+	}
+
+	country, err := server.Locations.Country()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+	var city string
+	if len(server.Locations) > 0 {
+		city = server.Locations[0].City.Name
+	}
+
+	nameservers := cfg.AutoConnectData.DNS.Or(r.nameservers.Get(
+		cfg.AutoConnectData.ThreatProtectionLite,
+		server.SupportsIPv6(),
+	))
+	if len(in.GetDns()) > 0 {
+		nameservers = in.GetDns()
+	}
+
+	// IPv6 is routed through the tunnel when the server is reached over IPv6 or supports it
+	serverIPv6, _ := server.IPv6()
+	routesIPv6 := cfg.IPv6 && (subnet.Addr().Is6() || serverIPv6.IsValid())
+
+	return &pb.ConnectPlan{
+		Type:            internal.CodeSuccess,
+		ServerName:      server.Name,
+		ServerHostname:  server.Hostname,
+		ServerIp:        subnet.Addr().String(),
+		Country:         country.Name,
+		City:            city,
+		VirtualLocation: server.IsVirtualLocation(),
+		Technology:      cfg.Technology,
+		Protocol:        cfg.AutoConnectData.Protocol,
+		Obfuscated:      cfg.AutoConnectData.Obfuscate,
+		Postquantum:     cfg.AutoConnectData.PostquantumVpn,
+		OpenvpnPort:     uint32(cfg.AutoConnectData.OpenVPNPort),
+		Dns:             nameservers,
+		Routes:          connectPlanRoutes(cfg, routesIPv6),
+		FirewallRules:   connectPlanFirewallRules(cfg, routesIPv6),
+	}, nil
+}
+
+// connectEndpoint returns the endpoint used to reach the server, IPv6 is preferred when it is enabled and routable
+func (r *RPC) connectEndpoint(cfg config.Config, server *core.Server) (network.Endpoint, error) {
+	if cfg.IPv6 && r.endpointResolver != nil {
+		return network.DefaultEndpoint(r.endpointResolver, server.IPs()), nil
+	}
+	ip, err := server.IPv4()
+	if err != nil {
+		return network.Endpoint{}, err
+	}
+	return network.NewIPv4Endpoint(ip), nil
+}
+
+// connectPlanRoutes describes the routes which are set up when connecting
+func connectPlanRoutes(cfg config.Config, routesIPv6 bool) []string {
+	if !cfg.Routing.Get() {
+		return []string{"routing is disabled, the routing table is not changed"}
+	}
+
+	routes := []string{"0.0.0.0/0 through the VPN tunnel"}
+	if routesIPv6 {
+		routes = append(routes, "::/0 through the VPN tunnel")
+	}
+
+	allowlist := cfg.AutoConnectData.Allowlist
+	subnets := allowlist.GetSubnets()
+	slices.Sort(subnets)
+	for _, subnet := range subnets {
+		routes = append(routes, subnet+" outside the VPN tunnel (allowlisted)")
+	}
+	if cfg.LanDiscovery {
+		for _, subnet := range localNetworks {
+			if !allowlist.Subnets[subnet] {
+				routes = append(routes, subnet+" outside the VPN tunnel (LAN discovery)")
+			}
+		}
+	}
+	for _, ports := range allowlistedPorts(allowlist) {
+		routes = append(routes, ports+" outside the VPN tunnel (allowlisted)")
+	}
+	return routes
+}
+
+// connectPlanFirewallRules describes the firewall rules which are added when connecting
+func connectPlanFirewallRules(cfg config.Config, routesIPv6 bool) []string {
+	if !cfg.Firewall {
+		return []string{"firewall is disabled, no rules are added"}
+	}
+
+	rules := []string{
+		"block incoming, outgoing and forwarded traffic outside the VPN tunnel",
+		fmt.Sprintf("allow traffic marked with %#x", cfg.FirewallMark),
+	}
+
+	allowlist := cfg.AutoConnectData.Allowlist
+	subnets := allowlist.GetSubnets()
+	if cfg.LanDiscovery {
+		for _, subnet := range localNetworks {
+			if !allowlist.Subnets[subnet] {
+				subnets = append(subnets, subnet)
+			}
+		}
+	}
+	slices.Sort(subnets)
+	if len(subnets) > 0 {
+		rules = append(rules, "allow traffic to and from "+strings.Join(subnets, ", "))
+	}
+	for _, ports := range allowlistedPorts(allowlist) {
+		rules = append(rules, "allow traffic on "+ports)
+	}
+
+	if !allowlist.Ports.TCP[53] && !allowlist.Ports.UDP[53] {
+		rules = append(rules, "block DNS requests to local networks")
+	}
+	if !routesIPv6 {
+		rules = append(rules, "block IPv6 traffic")
+	}
+	if cfg.KillSwitch {
+		rules = append(rules, "keep blocking traffic after disconnecting (kill switch)")
+	}
+	return rules
+}
+
+// allowlistedPorts lists the allowlisted ports grouped by the protocol
+func allowlistedPorts(allowlist config.Allowlist) []string {
+	var ports []string
+	for _, pair := range []struct {
+		name  string
+		ports []int64
+	}{
+		{name: "TCP", ports: allowlist.GetTCPPorts()},
+		{name: "UDP", ports: allowlist.GetUDPPorts()},
+	} {
+		if len(pair.ports) == 0 {
+			continue
+		}
+		slices.Sort(pair.ports)
+		var list []string
+		for _, port := range pair.ports {
+			list = append(list, fmt.Sprint(port))
+		}
+		ports = append(ports, fmt.Sprintf("%s ports %s", pair.name, strings.Join(list, ", ")))
+	}
+	return ports
+}
@@ -0,0 +1,122 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnectDryRun(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		request      *pb.ConnectRequest
+		expectedCode int64
+	}{
+		{name: "recommended server", request: &pb.ConnectRequest{}, expectedCode: internal.CodeSuccess},
+		{name: "country", request: &pb.ConnectRequest{ServerTag: "germany"}, expectedCode: internal.CodeSuccess},
+		{name: "invalid DNS", request: &pb.ConnectRequest{Dns: []string{"dns"}}, expectedCode: internal.CodeFormatError},
+		{
+			name:         "unknown favorite",
+			request:      &pb.ConnectRequest{Favorite: "work"},
+			expectedCode: internal.CodeFavoriteNotFound,
+		},
+		{
+			name:         "entry country without Double VPN",
+			request:      &pb.ConnectRequest{ServerTag: "germany", Via: "lithuania"},
+			expectedCode: internal.CodeDoubleVPNRequired,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := newMockConfigManager()
+			cm.c.AutoConnectData.Allowlist = config.NewAllowlist([]int64{53}, nil, []string{"1.2.3.0/24"})
+			dm := testNewDataManager()
+			dm.SetServersData(time.Now(), serversList(), "", time.Time{})
+			netw := &testnetworker.Mock{}
+			r := RPC{
+				ac:          &workingLoginChecker{},
+				cm:          cm,
+				dm:          dm,
+				serversAPI:  mockServersAPI{},
+				netw:        netw,
+				nameservers: &mock.DNSGetter{Names: []string{"103.86.96.100"}},
+			}
+
+			plan, err := r.ConnectDryRun(context.Background(), test.request)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedCode, plan.Type)
+			assert.False(t, netw.VpnActive)
+			if test.expectedCode != internal.CodeSuccess {
+				return
+			}
+
+			assert.NotEmpty(t, plan.ServerHostname)
+			assert.NotEmpty(t, plan.ServerIp)
+			assert.Equal(t, config.Technology_OPENVPN, plan.Technology)
+			assert.Equal(t, config.Protocol_UDP, plan.Protocol)
+			assert.Equal(t, []string{"103.86.96.100"}, plan.Dns)
+			assert.Equal(t, []string{
+				"0.0.0.0/0 through the VPN tunnel",
+				"1.2.3.0/24 outside the VPN tunnel (allowlisted)",
+				"UDP ports 53 outside the VPN tunnel (allowlisted)",
+			}, plan.Routes)
+			assert.Equal(t, []string{
+				"block incoming, outgoing and forwarded traffic outside the VPN tunnel",
+				"allow traffic marked with 0x0",
+				"allow traffic to and from 1.2.3.0/24",
+				"allow traffic on UDP ports 53",
+				"block IPv6 traffic",
+			}, plan.FirewallRules)
+		})
+	}
+}
+
+func TestConnectPlanRules(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cfg := config.Config{
+		Firewall:     true,
+		FirewallMark: 0xe1f1,
+		KillSwitch:   true,
+		LanDiscovery: true,
+		AutoConnectData: config.AutoConnectData{
+			Allowlist: config.NewAllowlist(nil, []int64{443, 22}, []string{"10.0.0.0/8"}),
+		},
+	}
+
+	assert.Equal(t, []string{
+		"0.0.0.0/0 through the VPN tunnel",
+		"::/0 through the VPN tunnel",
+		"10.0.0.0/8 outside the VPN tunnel (allowlisted)",
+		"172.16.0.0/12 outside the VPN tunnel (LAN discovery)",
+		"192.168.0.0/16 outside the VPN tunnel (LAN discovery)",
+		"169.254.0.0/16 outside the VPN tunnel (LAN discovery)",
+		"TCP ports 22, 443 outside the VPN tunnel (allowlisted)",
+	}, connectPlanRoutes(cfg, true))
+	assert.Equal(t, []string{
+		"block incoming, outgoing and forwarded traffic outside the VPN tunnel",
+		"allow traffic marked with 0xe1f1",
+		"allow traffic to and from 10.0.0.0/8, 169.254.0.0/16, 172.16.0.0/12, 192.168.0.0/16",
+		"allow traffic on TCP ports 22, 443",
+		"block DNS requests to local networks",
+		"keep blocking traffic after disconnecting (kill switch)",
+	}, connectPlanFirewallRules(cfg, true))
+
+	cfg.Firewall = false
+	cfg.Routing.Set(false)
+	assert.Equal(t, []string{"routing is disabled, the routing table is not changed"}, connectPlanRoutes(cfg, false))
+	assert.Equal(t, []string{"firewall is disabled, no rules are added"}, connectPlanFirewallRules(cfg, false))
+}
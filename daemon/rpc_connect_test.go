@@ -338,7 +338,7 @@ func TestRpcConnect(t *testing.T) {
 				tokenData.ServiceExpiry = time.Now().Add(time.Hour * 1).Format(internal.ServerDateFormat)
 				cm.c.TokensData[cm.c.AutoConnectData.ID] = tokenData
 				dm := testNewDataManager()
-				dm.SetServersData(time.Now(), serversList(), "")
+				dm.SetServersData(time.Now(), serversList(), "", time.Time{})
 				api := core.NewDefaultAPI(
 					"",
 					"",
@@ -367,9 +367,20 @@ func TestRpcConnect(t *testing.T) {
 					nil,
 					&mockAnalytics{},
 					&testnorduser.MockNorduserCombinedService{},
+					nil,
+					nil,
 					&RegistryMock{},
 					nil,
 					sharedctx.New(),
+					NewPendingActions(func() bool { return true }),
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
+					nil,
 				)
 				server := &mockRPCServer{}
 				err := rpc.Connect(&pb.ConnectRequest{ServerGroup: test.serverGroup, ServerTag: test.serverTag}, server)
@@ -429,9 +440,20 @@ func TestRpcReconnect(t *testing.T) {
 		nil,
 		&mockAnalytics{},
 		&testnorduser.MockNorduserCombinedService{},
+		nil,
+		nil,
 		&RegistryMock{},
 		nil,
 		sharedctx.New(),
+		NewPendingActions(func() bool { return true }),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 	err := rpc.Connect(&pb.ConnectRequest{}, &mockRPCServer{})
 	assert.NoError(t, err)
@@ -0,0 +1,40 @@
+package daemon
+
+import (
+	"context"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+)
+
+// unixOrZero returns 0 for zero time instead of the negative Unix time
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+// ConnectionHistory lists the latest connection attempts, newest first
+func (r *RPC) ConnectionHistory(_ context.Context, in *pb.ConnectionHistoryRequest) (*pb.ConnectionHistoryResponse, error) {
+	var entries []*pb.ConnectionHistoryEntry
+	for _, entry := range r.connectionHistory.Entries(int(in.GetLimit())) {
+		entries = append(entries, &pb.ConnectionHistoryEntry{
+			Started:         unixOrZero(entry.Started),
+			Server:          entry.Server,
+			Country:         entry.Country,
+			City:            entry.City,
+			Technology:      entry.Technology,
+			Protocol:        entry.Protocol,
+			Automatic:       entry.Automatic,
+			Result:          string(entry.Result),
+			Error:           entry.Error,
+			ConnectDuration: int64(entry.ConnectDuration),
+			Ended:           unixOrZero(entry.Ended),
+			EndReason:       string(entry.EndReason),
+			Download:        entry.Download,
+			Upload:          entry.Upload,
+		})
+	}
+	return &pb.ConnectionHistoryResponse{Entries: entries}, nil
+}
@@ -0,0 +1,111 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/auth"
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// serverLabel is the first part of the server hostname, the same as used to connect to the specific server
+func serverLabel(server core.Server) string {
+	return strings.Split(server.Hostname, ".")[0]
+}
+
+// DedicatedIPServers lists the servers selected for the dedicated IP services of the user
+func (r *RPC) DedicatedIPServers(ctx context.Context, in *pb.Empty) (*pb.DedicatedIPServersResponse, error) {
+	if !r.ac.IsLoggedIn() {
+		return nil, internal.ErrNotLoggedIn
+	}
+
+	dipServices, err := r.ac.GetDedicatedIPServices()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "getting dedicated IP services:", err)
+		return &pb.DedicatedIPServersResponse{Type: internal.CodeTokenRenewError}, nil
+	}
+	if len(dipServices) == 0 {
+		return &pb.DedicatedIPServersResponse{Type: internal.CodeDedicatedIPRenewError}, nil
+	}
+
+	servers := r.dm.GetServersData().Servers
+	resp := &pb.DedicatedIPServersResponse{Type: internal.CodeSuccess}
+	for _, service := range dipServices {
+		dipServer := &pb.DedicatedIPServer{ExpiresAt: service.ExpiresAt}
+		if service.ServerID != auth.NoServerSelected {
+			server, err := getServerByID(servers, service.ServerID)
+			if err != nil {
+				log.Println(internal.WarningPrefix, "DIP server", service.ServerID, "not found:", err)
+				continue
+			}
+			dipServer.Label = serverLabel(*server)
+			dipServer.Name = server.Name
+			dipServer.Hostname = server.Hostname
+			if country, err := server.Locations.Country(); err == nil {
+				dipServer.Country = country.Name
+				dipServer.City = country.City.Name
+			}
+		}
+		resp.Servers = append(resp.Servers, dipServer)
+	}
+	return resp, nil
+}
+
+// ConnectDedicatedIP connects to the dedicated IP server of the user with the given label
+func (r *RPC) ConnectDedicatedIP(in *pb.ConnectDedicatedIPRequest, srv pb.Daemon_ConnectDedicatedIPServer) error {
+	if in.GetLabel() == "" {
+		return srv.Send(&pb.Payload{Type: internal.CodeDedicatedIPNoServer})
+	}
+	return r.Connect(&pb.ConnectRequest{DedicatedIp: in.GetLabel()}, srv)
+}
+
+// selectDedicatedIPServerByLabel selects the server of the user dedicated IP services by its label without
+// searching through all of the servers
+func selectDedicatedIPServerByLabel(
+	authChecker auth.Checker,
+	servers core.Servers,
+	cfg config.Config,
+	label string,
+) (*core.Server, error) {
+	dedicatedIPServices, err := authChecker.GetDedicatedIPServices()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "getting dedicated IP service data:", err)
+		return nil, internal.ErrUnhandled
+	}
+
+	if len(dedicatedIPServices) == 0 {
+		return nil, internal.NewErrorWithCode(internal.CodeDedicatedIPRenewError)
+	}
+
+	if !isAnyDIPServersAvailable(dedicatedIPServices) {
+		return nil, internal.NewErrorWithCode(internal.CodeDedicatedIPServiceButNoServers)
+	}
+
+	for _, service := range dedicatedIPServices {
+		if service.ServerID == auth.NoServerSelected {
+			continue
+		}
+		index := slices.IndexFunc(servers, func(s core.Server) bool {
+			return s.ID == service.ServerID && strings.EqualFold(serverLabel(s), label)
+		})
+		if index == -1 {
+			continue
+		}
+
+		server := servers[index]
+		if !core.IsConnectableWithProtocol(cfg.Technology, cfg.AutoConnectData.Protocol)(server) ||
+			(core.IsObfuscated()(server) != cfg.AutoConnectData.Obfuscate) {
+			log.Println(internal.ErrorPrefix, "failed to connect because the server doesn't support user settings")
+			return nil, internal.ErrServerIsUnavailable
+		}
+		return &server, nil
+	}
+
+	log.Println(internal.ErrorPrefix, "server", label, "is not in the DIP servers list")
+	return nil, internal.NewErrorWithCode(internal.CodeDedicatedIPNoServer)
+}
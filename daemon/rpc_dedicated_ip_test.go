@@ -0,0 +1,80 @@
+package daemon
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/auth"
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectDedicatedIPServerByLabel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cfg := config.Config{
+		Technology:      config.Technology_NORDLYNX,
+		AutoConnectData: config.AutoConnectData{Protocol: config.Protocol_UDP},
+	}
+	obfuscatedCfg := cfg
+	obfuscatedCfg.Technology = config.Technology_OPENVPN
+	obfuscatedCfg.AutoConnectData.Obfuscate = true
+
+	tests := []struct {
+		name          string
+		cfg           config.Config
+		services      []auth.DedicatedIPService
+		label         string
+		expectedID    int64
+		expectedError error
+	}{
+		{
+			name:       "server of the service",
+			cfg:        cfg,
+			services:   []auth.DedicatedIPService{{ServerID: auth.NoServerSelected}, {ServerID: 7}},
+			label:      "LT7",
+			expectedID: 7,
+		},
+		{
+			name:          "server not in the services",
+			cfg:           cfg,
+			services:      []auth.DedicatedIPService{{ServerID: 7}},
+			label:         "lt8",
+			expectedError: internal.NewErrorWithCode(internal.CodeDedicatedIPNoServer),
+		},
+		{
+			name:          "no services",
+			cfg:           cfg,
+			label:         "lt7",
+			expectedError: internal.NewErrorWithCode(internal.CodeDedicatedIPRenewError),
+		},
+		{
+			name:          "no server selected",
+			cfg:           cfg,
+			services:      []auth.DedicatedIPService{{ServerID: auth.NoServerSelected}},
+			label:         "lt7",
+			expectedError: internal.NewErrorWithCode(internal.CodeDedicatedIPServiceButNoServers),
+		},
+		{
+			name:          "server does not support settings",
+			cfg:           obfuscatedCfg,
+			services:      []auth.DedicatedIPService{{ServerID: 7}},
+			label:         "lt7",
+			expectedError: internal.ErrServerIsUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			checker := &workingLoginChecker{dedicatedIPService: test.services}
+			server, err := selectDedicatedIPServerByLabel(checker, serversList(), test.cfg, test.label)
+			assert.Equal(t, test.expectedError, err)
+			if test.expectedError != nil {
+				return
+			}
+			assert.Equal(t, test.expectedID, server.ID)
+		})
+	}
+}
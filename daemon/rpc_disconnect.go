@@ -12,6 +12,17 @@ import (
 )
 
 func (r *RPC) Disconnect(_ *pb.Empty, srv pb.Daemon_DisconnectServer) error {
+	// disconnect expresses the latest intent of the user so the connection is not made later
+	if r.pendingActions.CancelKind(PendingActionConnect) {
+		log.Println(internal.InfoPrefix, "queued connect was canceled by disconnect")
+	}
+	if r.pause.cancel() {
+		log.Println(internal.InfoPrefix, "pause was ended by disconnect")
+	}
+	if r.schedule != nil && isManual(srv) {
+		r.schedule.recordManual()
+	}
+
 	if !r.netw.IsVPNActive() {
 		if err := r.netw.UnsetFirewall(); err != nil && !errors.Is(err, firewall.ErrRuleNotFound) {
 			log.Println(internal.WarningPrefix, "failed to force unset firewall on disconnect:", err)
@@ -21,11 +32,24 @@ func (r *RPC) Disconnect(_ *pb.Empty, srv pb.Daemon_DisconnectServer) error {
 		})
 	}
 
-	if err := r.netw.Stop(); err != nil {
+	if err := r.disconnect(); err != nil {
 		log.Println(internal.ErrorPrefix, err)
 		return internal.ErrUnhandled
 	}
 
+	return srv.Send(&pb.Payload{
+		Type: internal.CodeDisconnected,
+	})
+}
+
+// disconnect stops VPN and publishes the disconnect event
+func (r *RPC) disconnect() error {
+	// counters of the connection are gone once it is stopped
+	r.recordBandwidth()
+	if err := r.netw.Stop(); err != nil {
+		return err
+	}
+
 	var cfg config.Config
 	if err := r.cm.Load(&cfg); err != nil {
 		log.Println(internal.ErrorPrefix, err)
@@ -38,7 +62,5 @@ func (r *RPC) Disconnect(_ *pb.Empty, srv pb.Daemon_DisconnectServer) error {
 		ThreatProtectionLite: cfg.AutoConnectData.ThreatProtectionLite,
 	})
 
-	return srv.Send(&pb.Payload{
-		Type: internal.CodeDisconnected,
-	})
+	return nil
 }
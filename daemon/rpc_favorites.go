@@ -0,0 +1,132 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// favoriteNamePattern keeps favorite names usable as a single command line argument
+var favoriteNamePattern = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
+
+func normalizeFavoriteName(name string) (string, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	return name, favoriteNamePattern.MatchString(name)
+}
+
+// AddFavorite saves the server or the location under the given name. The target is validated the same way as the
+// connect command arguments.
+func (r *RPC) AddFavorite(ctx context.Context, in *pb.Favorite) (*pb.Payload, error) {
+	if !r.ac.IsLoggedIn() {
+		return nil, internal.ErrNotLoggedIn
+	}
+
+	name, ok := normalizeFavoriteName(in.GetName())
+	if !ok || (in.GetServerTag() == "" && in.GetServerGroup() == "") {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if _, ok := cfg.Favorites[name]; ok {
+		return &pb.Payload{Type: internal.CodeNothingToDo, Data: []string{name}}, nil
+	}
+
+	insights := r.dm.GetInsightsData().Insights
+	serverTag := internal.RemoveNonAlphanumeric(in.GetServerTag())
+	if _, _, err := selectServer(r, &insights, cfg, serverTag, in.GetServerGroup(), false, false); err != nil {
+		log.Println(internal.ErrorPrefix, "no server found for favorite", in.GetServerTag(), in.GetServerGroup(), err)
+
+		var errorCode *internal.ErrorWithCode
+		if errors.As(err, &errorCode) {
+			return &pb.Payload{Type: errorCode.Code}, nil
+		}
+		return nil, err
+	}
+
+	favorite := config.Favorite{ServerTag: in.GetServerTag(), ServerGroup: in.GetServerGroup()}
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Favorites = c.Favorites.With(name, favorite)
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{name}}, nil
+}
+
+// RemoveFavorite removes the saved server or location
+func (r *RPC) RemoveFavorite(ctx context.Context, in *pb.RemoveFavoriteRequest) (*pb.Payload, error) {
+	name, _ := normalizeFavoriteName(in.GetName())
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if _, ok := cfg.Favorites[name]; !ok {
+		return &pb.Payload{Type: internal.CodeFavoriteNotFound, Data: []string{name}}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Favorites = c.Favorites.Without(name)
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{name}}, nil
+}
+
+// Favorites lists the saved servers and locations
+func (r *RPC) Favorites(ctx context.Context, in *pb.Empty) (*pb.FavoritesList, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.FavoritesList{Type: internal.CodeConfigError}, nil
+	}
+
+	resp := &pb.FavoritesList{Type: internal.CodeSuccess}
+	for _, name := range cfg.Favorites.SortedNames() {
+		favorite := cfg.Favorites[name]
+		resp.Favorites = append(resp.Favorites, &pb.Favorite{
+			Name:        name,
+			ServerTag:   favorite.ServerTag,
+			ServerGroup: favorite.ServerGroup,
+		})
+	}
+	return resp, nil
+}
+
+// resolveFavorite replaces the server tag and the group of the connect request with the ones of the favorite
+func resolveFavorite(in *pb.ConnectRequest, favorites config.Favorites) (*pb.ConnectRequest, bool) {
+	if in.GetFavorite() == "" {
+		return in, true
+	}
+	name, _ := normalizeFavoriteName(in.GetFavorite())
+	favorite, ok := favorites[name]
+	if !ok {
+		return nil, false
+	}
+	return &pb.ConnectRequest{
+		ServerTag:   favorite.ServerTag,
+		ServerGroup: favorite.ServerGroup,
+		Dns:         in.GetDns(),
+		Fastest:     in.GetFastest(),
+		Via:         in.GetVia(),
+		Random:      in.GetRandom(),
+	}, true
+}
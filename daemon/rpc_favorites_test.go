@@ -0,0 +1,136 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddFavorite(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name              string
+		req               *pb.Favorite
+		expectedType      int64
+		expectedErr       error
+		expectedFavorites config.Favorites
+	}{
+		{
+			name:         "add country",
+			req:          &pb.Favorite{Name: "Work", ServerTag: "germany"},
+			expectedType: internal.CodeSuccess,
+			expectedFavorites: config.Favorites{
+				"home": {ServerTag: "lt"},
+				"work": {ServerTag: "germany"},
+			},
+		},
+		{
+			name:         "add group",
+			req:          &pb.Favorite{Name: "dvpn", ServerGroup: "double_vpn"},
+			expectedType: internal.CodeSuccess,
+			expectedFavorites: config.Favorites{
+				"home": {ServerTag: "lt"},
+				"dvpn": {ServerGroup: "double_vpn"},
+			},
+		},
+		{
+			name:              "name already used",
+			req:               &pb.Favorite{Name: "home", ServerTag: "germany"},
+			expectedType:      internal.CodeNothingToDo,
+			expectedFavorites: config.Favorites{"home": {ServerTag: "lt"}},
+		},
+		{
+			name:              "invalid name",
+			req:               &pb.Favorite{Name: "my home", ServerTag: "germany"},
+			expectedType:      internal.CodeFormatError,
+			expectedFavorites: config.Favorites{"home": {ServerTag: "lt"}},
+		},
+		{
+			name:              "no target",
+			req:               &pb.Favorite{Name: "work"},
+			expectedType:      internal.CodeFormatError,
+			expectedFavorites: config.Favorites{"home": {ServerTag: "lt"}},
+		},
+		{
+			name:              "nonexistent location",
+			req:               &pb.Favorite{Name: "work", ServerTag: "invalid_name"},
+			expectedErr:       internal.ErrTagDoesNotExist,
+			expectedFavorites: config.Favorites{"home": {ServerTag: "lt"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg = &config.Config{
+				Technology:      config.Technology_NORDLYNX,
+				AutoConnectData: config.AutoConnectData{Protocol: config.Protocol_UDP},
+				Favorites:       config.Favorites{"home": {ServerTag: "lt"}},
+			}
+			dm := DataManager{serversData: ServersData{Servers: serversList()}}
+			r := RPC{cm: cm, ac: mockAutoconnectAuthChecker{}, dm: &dm, serversAPI: &mockServersAPI{}}
+
+			resp, err := r.AddFavorite(context.Background(), test.req)
+			assert.Equal(t, test.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, test.expectedType, resp.GetType())
+			}
+			assert.Equal(t, test.expectedFavorites, cm.Cfg.Favorites)
+		})
+	}
+}
+
+func TestRemoveFavorite(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Favorites = config.Favorites{"home": {ServerTag: "lt"}, "work": {ServerTag: "de"}}
+	r := RPC{cm: cm}
+
+	resp, err := r.RemoveFavorite(context.Background(), &pb.RemoveFavoriteRequest{Name: "Home"})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.GetType())
+	assert.Equal(t, config.Favorites{"work": {ServerTag: "de"}}, cm.Cfg.Favorites)
+
+	resp, err = r.RemoveFavorite(context.Background(), &pb.RemoveFavoriteRequest{Name: "home"})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeFavoriteNotFound, resp.GetType())
+
+	list, err := r.Favorites(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, list.GetType())
+	assert.Equal(t, []*pb.Favorite{{Name: "work", ServerTag: "de"}}, list.GetFavorites())
+
+	r.cm = failingConfigManager{}
+	resp, err = r.RemoveFavorite(context.Background(), &pb.RemoveFavoriteRequest{Name: "work"})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeConfigError, resp.GetType())
+}
+
+func TestResolveFavorite(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	favorites := config.Favorites{"dvpn": {ServerTag: "de", ServerGroup: "double_vpn"}}
+
+	in := &pb.ConnectRequest{ServerTag: "lt"}
+	resolved, ok := resolveFavorite(in, favorites)
+	assert.True(t, ok)
+	assert.Equal(t, in, resolved)
+
+	resolved, ok = resolveFavorite(&pb.ConnectRequest{Favorite: "DVPN", Dns: []string{"1.1.1.1"}, Fastest: true}, favorites)
+	assert.True(t, ok)
+	assert.Equal(t, &pb.ConnectRequest{
+		ServerTag: "de", ServerGroup: "double_vpn", Dns: []string{"1.1.1.1"}, Fastest: true,
+	}, resolved)
+
+	_, ok = resolveFavorite(&pb.ConnectRequest{Favorite: "home"}, favorites)
+	assert.False(t, ok)
+}
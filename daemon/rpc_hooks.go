@@ -0,0 +1,113 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/hooks"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// hookFromProtobuf converts and validates everything except the executable itself
+func hookFromProtobuf(in *pb.Hook) (config.Hook, bool) {
+	hook := config.Hook{
+		Event:         config.HookEvent(in.GetEvent()),
+		Path:          in.GetPath(),
+		Timeout:       time.Duration(in.GetTimeoutSeconds()) * time.Second,
+		FailurePolicy: config.HookFailurePolicy(in.GetFailurePolicy()),
+	}
+	if hook.FailurePolicy == "" {
+		hook.FailurePolicy = config.HookFailureIgnore
+	}
+
+	switch {
+	case !slices.Contains(config.HookEvents, hook.Event),
+		hook.Timeout < 0 || hook.Timeout > config.MaxHookTimeout,
+		hook.FailurePolicy != config.HookFailureIgnore && hook.FailurePolicy != config.HookFailureAbort,
+		hook.FailurePolicy == config.HookFailureAbort && hook.Event != config.HookPreConnect:
+		return config.Hook{}, false
+	}
+	return hook, true
+}
+
+// AddHook adds the executable to be run on the connection event. Only the executables which can be modified by root
+// alone are accepted, because hooks are run by the daemon.
+func (r *RPC) AddHook(ctx context.Context, in *pb.Hook) (*pb.Payload, error) {
+	hook, ok := hookFromProtobuf(in)
+	if !ok {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	if err := hooks.Validate(hook.Path); err != nil {
+		return &pb.Payload{Type: internal.CodeHookInvalidExecutable, Data: []string{hook.Path, err.Error()}}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if cfg.Hooks.Index(hook.Event, hook.Path) != -1 {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Hooks = c.Hooks.With(hook)
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// RemoveHook removes the executable from the connection event
+func (r *RPC) RemoveHook(ctx context.Context, in *pb.Hook) (*pb.Payload, error) {
+	event := config.HookEvent(in.GetEvent())
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if cfg.Hooks.Index(event, in.GetPath()) == -1 {
+		return &pb.Payload{Type: internal.CodeHookNotFound}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Hooks = c.Hooks.Without(event, in.GetPath())
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+// Hooks lists the hooks in the order they are run
+func (r *RPC) Hooks(ctx context.Context, in *pb.Empty) (*pb.HooksList, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.HooksList{Type: internal.CodeConfigError}, nil
+	}
+
+	resp := &pb.HooksList{Type: internal.CodeSuccess}
+	for _, hook := range cfg.Hooks {
+		resp.Hooks = append(resp.Hooks, &pb.Hook{
+			Event:          string(hook.Event),
+			Path:           hook.Path,
+			TimeoutSeconds: int64(hook.TimeoutOrDefault() / time.Second),
+			FailurePolicy:  string(hook.FailurePolicy),
+		})
+	}
+	return resp, nil
+}
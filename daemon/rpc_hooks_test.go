@@ -0,0 +1,125 @@
+package daemon
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHookFromProtobuf(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		in       *pb.Hook
+		expected config.Hook
+		ok       bool
+	}{
+		{
+			name: "defaults",
+			in:   &pb.Hook{Event: "connect", Path: "/etc/nordvpn/up.sh"},
+			expected: config.Hook{
+				Event:         config.HookConnect,
+				Path:          "/etc/nordvpn/up.sh",
+				FailurePolicy: config.HookFailureIgnore,
+			},
+			ok: true,
+		},
+		{
+			name: "pre-connect aborting",
+			in:   &pb.Hook{Event: "pre-connect", Path: "/etc/nordvpn/check.sh", TimeoutSeconds: 5, FailurePolicy: "abort"},
+			expected: config.Hook{
+				Event:         config.HookPreConnect,
+				Path:          "/etc/nordvpn/check.sh",
+				Timeout:       5 * time.Second,
+				FailurePolicy: config.HookFailureAbort,
+			},
+			ok: true,
+		},
+		{
+			name: "unknown event",
+			in:   &pb.Hook{Event: "connected", Path: "/etc/nordvpn/up.sh"},
+		},
+		{
+			name: "timeout too long",
+			in:   &pb.Hook{Event: "connect", Path: "/etc/nordvpn/up.sh", TimeoutSeconds: 3600},
+		},
+		{
+			name: "unknown failure policy",
+			in:   &pb.Hook{Event: "connect", Path: "/etc/nordvpn/up.sh", FailurePolicy: "retry"},
+		},
+		{
+			name: "abort after connecting",
+			in:   &pb.Hook{Event: "connect", Path: "/etc/nordvpn/up.sh", FailurePolicy: "abort"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hook, ok := hookFromProtobuf(test.in)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, hook)
+		})
+	}
+}
+
+func TestHooks_AddAndRemove(t *testing.T) {
+	category.Set(t, category.File)
+
+	cm := mock.NewMockConfigManager()
+	rpc := RPC{cm: cm}
+
+	dir := t.TempDir()
+	script := filepath.Join(dir, "up.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\n"), 0700))
+
+	resp, err := rpc.AddHook(context.Background(), &pb.Hook{Event: "connect", Path: "up.sh"})
+	require.NoError(t, err)
+	assert.Equal(t, internal.CodeHookInvalidExecutable, resp.Type)
+
+	// only the executables in the directories modifiable by root alone are accepted
+	resp, err = rpc.AddHook(context.Background(), &pb.Hook{Event: "connect", Path: script})
+	require.NoError(t, err)
+	assert.Equal(t, internal.CodeHookInvalidExecutable, resp.Type)
+
+	if _, err := os.Stat("/bin/true"); err != nil {
+		t.Skip("/bin/true is not available")
+	}
+	script = "/bin/true"
+	resp, err = rpc.AddHook(context.Background(), &pb.Hook{Event: "connect", Path: script})
+	require.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+
+	resp, err = rpc.AddHook(context.Background(), &pb.Hook{Event: "connect", Path: script})
+	require.NoError(t, err)
+	assert.Equal(t, internal.CodeNothingToDo, resp.Type)
+
+	list, err := rpc.Hooks(context.Background(), &pb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, []*pb.Hook{{
+		Event:          "connect",
+		Path:           script,
+		TimeoutSeconds: int64(config.DefaultHookTimeout / time.Second),
+		FailurePolicy:  "ignore",
+	}}, list.Hooks)
+
+	resp, err = rpc.RemoveHook(context.Background(), &pb.Hook{Event: "disconnect", Path: script})
+	require.NoError(t, err)
+	assert.Equal(t, internal.CodeHookNotFound, resp.Type)
+
+	resp, err = rpc.RemoveHook(context.Background(), &pb.Hook{Event: "connect", Path: script})
+	require.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.Empty(t, cm.Cfg.Hooks)
+}
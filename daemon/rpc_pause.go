@@ -0,0 +1,112 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const (
+	minPauseDuration = time.Minute
+	maxPauseDuration = 24 * time.Hour
+)
+
+// vpnPause connects to VPN again when the pause ends. Only one pause is active at a time.
+type vpnPause struct {
+	mu    sync.Mutex
+	timer *time.Timer
+	until time.Time
+}
+
+// start replaces the active pause. resume is called when the pause ends unless it is canceled before.
+func (p *vpnPause) start(duration time.Duration, resume func()) time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.timer != nil {
+		p.timer.Stop()
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(duration, func() {
+		p.mu.Lock()
+		if p.timer != timer {
+			p.mu.Unlock()
+			return
+		}
+		p.timer = nil
+		p.until = time.Time{}
+		p.mu.Unlock()
+		resume()
+	})
+	p.timer = timer
+	p.until = time.Now().Add(duration)
+	return p.until
+}
+
+// cancel ends the pause without connecting. Returns false if there was no active pause.
+func (p *vpnPause) cancel() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.timer == nil {
+		return false
+	}
+	p.timer.Stop()
+	p.timer = nil
+	p.until = time.Time{}
+	return true
+}
+
+// pausedUntil returns unix time in seconds when the pause ends or 0 if VPN is not paused
+func (p *vpnPause) pausedUntil() int64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.timer == nil {
+		return 0
+	}
+	return p.until.Unix()
+}
+
+// Pause disconnects from VPN and connects to the same target again when the duration elapses. Auto-connect does
+// not connect while paused, connecting or disconnecting manually ends the pause.
+func (r *RPC) Pause(ctx context.Context, in *pb.PauseRequest) (*pb.Payload, error) {
+	duration := time.Duration(in.GetDuration())
+	if duration < minPauseDuration || duration > maxPauseDuration {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	if !r.netw.IsVPNActive() {
+		return &pb.Payload{Type: internal.CodeVPNNotRunning}, nil
+	}
+
+	target := r.lastTarget
+	// pause is started before disconnecting, so the status sent on disconnect already includes it
+	until := r.pause.start(duration, func() { r.resumeAfterPause(target) })
+	if err := r.disconnect(); err != nil {
+		r.pause.cancel()
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeFailure}, nil
+	}
+	log.Println(internal.InfoPrefix, "VPN is paused until", until.Format(time.RFC3339))
+
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{strconv.FormatInt(until.Unix(), 10)}}, nil
+}
+
+func (r *RPC) resumeAfterPause(target *pb.ConnectRequest) {
+	if r.netw.IsVPNActive() {
+		return
+	}
+
+	log.Println(internal.InfoPrefix, "pause has ended, connecting")
+	server := autoconnectServer{}
+	if err := r.Connect(target, &server); !connectErrorCheck(err) || server.err != nil {
+		log.Println(internal.ErrorPrefix, "connect after pause failed, err1:", server.err, "| err2:", err)
+	}
+}
@@ -0,0 +1,121 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	daemonevents "github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVPNPause(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	t.Run("resumes when the pause ends", func(t *testing.T) {
+		var pause vpnPause
+		resumed := make(chan struct{})
+		until := pause.start(10*time.Millisecond, func() { close(resumed) })
+		assert.Equal(t, until.Unix(), pause.pausedUntil())
+
+		select {
+		case <-resumed:
+		case <-time.After(time.Second):
+			t.Fatal("pause did not end")
+		}
+		assert.Zero(t, pause.pausedUntil())
+	})
+
+	t.Run("canceled pause does not resume", func(t *testing.T) {
+		var pause vpnPause
+		resumed := make(chan struct{})
+		pause.start(10*time.Millisecond, func() { close(resumed) })
+		assert.True(t, pause.cancel())
+		assert.False(t, pause.cancel())
+		assert.Zero(t, pause.pausedUntil())
+
+		select {
+		case <-resumed:
+			t.Fatal("canceled pause has resumed")
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	t.Run("new pause replaces the previous one", func(t *testing.T) {
+		var pause vpnPause
+		first := make(chan struct{})
+		second := make(chan struct{})
+		pause.start(10*time.Millisecond, func() { close(first) })
+		pause.start(20*time.Millisecond, func() { close(second) })
+
+		select {
+		case <-second:
+		case <-time.After(time.Second):
+			t.Fatal("pause did not end")
+		}
+		select {
+		case <-first:
+			t.Fatal("replaced pause has resumed")
+		default:
+		}
+	})
+}
+
+func TestPause(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		duration     time.Duration
+		vpnActive    bool
+		expectedType int64
+		paused       bool
+	}{
+		{
+			name:         "pause",
+			duration:     30 * time.Minute,
+			vpnActive:    true,
+			expectedType: internal.CodeSuccess,
+			paused:       true,
+		},
+		{
+			name:         "not connected",
+			duration:     30 * time.Minute,
+			expectedType: internal.CodeVPNNotRunning,
+		},
+		{
+			name:         "too short",
+			duration:     time.Second,
+			vpnActive:    true,
+			expectedType: internal.CodeFormatError,
+		},
+		{
+			name:         "too long",
+			duration:     25 * time.Hour,
+			vpnActive:    true,
+			expectedType: internal.CodeFormatError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := RPC{
+				cm:     mock.NewMockConfigManager(),
+				netw:   &networker.Mock{VpnActive: test.vpnActive},
+				events: daemonevents.NewEventsEmpty(),
+			}
+			defer r.pause.cancel()
+
+			resp, err := r.Pause(context.Background(), &pb.PauseRequest{Duration: int64(test.duration)})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedType, resp.Type)
+			assert.Equal(t, test.paused, r.pause.pausedUntil() != 0)
+		})
+	}
+}
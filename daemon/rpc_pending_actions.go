@@ -0,0 +1,30 @@
+package daemon
+
+import (
+	"context"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// PendingActions lists the actions queued while the device is offline
+func (r *RPC) PendingActions(context.Context, *pb.Empty) (*pb.PendingActionsResponse, error) {
+	var actions []*pb.PendingAction
+	for _, action := range r.pendingActions.List() {
+		actions = append(actions, &pb.PendingAction{
+			Id:          action.ID,
+			Kind:        string(action.Kind),
+			Description: action.Description,
+			Created:     action.Created.Unix(),
+		})
+	}
+	return &pb.PendingActionsResponse{Actions: actions}, nil
+}
+
+// CancelPendingAction removes the action from the queue so it is not executed when the network is back
+func (r *RPC) CancelPendingAction(_ context.Context, in *pb.CancelPendingActionRequest) (*pb.Payload, error) {
+	if !r.pendingActions.Cancel(in.GetId()) {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
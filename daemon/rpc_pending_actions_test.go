@@ -0,0 +1,38 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPCConnect_QueuedWhileOffline(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	rpc := RPC{
+		ac:             &workingLoginChecker{},
+		pendingActions: NewPendingActions(func() bool { return false }),
+	}
+
+	server := &mockRPCServer{}
+	err := rpc.Connect(&pb.ConnectRequest{ServerTag: "de"}, server)
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.Payload{Type: internal.CodeQueuedOffline, Data: []string{"vpn-1"}}, server.msg)
+
+	list := rpc.pendingActions.List()
+	assert.Len(t, list, 1)
+	assert.Equal(t, "connect to de", list[0].Description)
+
+	resp, err := rpc.CancelPendingAction(context.Background(), &pb.CancelPendingActionRequest{Id: "vpn-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+
+	resp, err = rpc.CancelPendingAction(context.Background(), &pb.CancelPendingActionRequest{Id: "vpn-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeNothingToDo, resp.Type)
+}
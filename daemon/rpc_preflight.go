@@ -0,0 +1,22 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// Preflight checks the kernel features and capabilities used by the VPN connection, so that the missing ones can be
+// reported before the connection fails
+func (r *RPC) Preflight(ctx context.Context, in *pb.Empty) (*pb.PreflightResponse, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return nil, internal.ErrUnhandled
+	}
+
+	return &pb.PreflightResponse{Findings: runPreflight(preflightChecks(cfg, newPreflightSystem()))}, nil
+}
@@ -0,0 +1,187 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"slices"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// CreateProfile saves the current protocol, DNS, allowlist and auto-connect settings under the given name. Profile
+// names follow the same rules as favorite names.
+func (r *RPC) CreateProfile(ctx context.Context, in *pb.ProfileRequest) (*pb.Payload, error) {
+	name, ok := normalizeFavoriteName(in.GetName())
+	if !ok {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if _, ok := cfg.Profiles[name]; ok {
+		return &pb.Payload{Type: internal.CodeNothingToDo, Data: []string{name}}, nil
+	}
+
+	profile := config.NewProfile(cfg)
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Profiles = c.Profiles.With(name, profile)
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{name}}, nil
+}
+
+// SwitchProfile applies the settings of the profile. CodeVPNRunning is returned when the protocol has changed while
+// connected, because the new protocol is used only after reconnecting.
+func (r *RPC) SwitchProfile(ctx context.Context, in *pb.ProfileRequest) (*pb.Payload, error) {
+	name, _ := normalizeFavoriteName(in.GetName())
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	profile, ok := cfg.Profiles[name]
+	if !ok {
+		return &pb.Payload{Type: internal.CodeProfileNotFound, Data: []string{name}}, nil
+	}
+
+	allowlist := config.NewAllowlist(
+		profile.Allowlist.GetUDPPorts(),
+		profile.Allowlist.GetTCPPorts(),
+		profile.Allowlist.GetSubnets(),
+	)
+	if cfg.LanDiscovery {
+		// private networks are already allowed by LAN discovery
+		for subnet := range allowlist.Subnets {
+			if containsPrivateNetwork(subnet) {
+				allowlist.UpdateSubnets(subnet, true)
+			}
+		}
+	}
+	if err := r.netw.SetAllowlist(allowlist); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeFailure}, nil
+	}
+
+	threatProtectionLite := cfg.AutoConnectData.ThreatProtectionLite && len(profile.DNS) == 0
+	nameservers := profile.DNS.Or(r.nameservers.Get(threatProtectionLite, cfg.IPv6))
+	if err := r.netw.SetDNS(nameservers); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeFailure}, nil
+	}
+
+	var parameters ServerParameters
+	if profile.AutoConnect && profile.AutoConnectServerTag != "" {
+		parameters = GetServerParameters(
+			profile.AutoConnectServerTag,
+			profile.AutoConnectServerTag,
+			r.dm.GetCountryData().Countries,
+		)
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.AutoConnect = profile.AutoConnect
+		if profile.AutoConnect {
+			c.AutoConnectData.ServerTag = profile.AutoConnectServerTag
+			c.AutoConnectData.Country = parameters.Country
+			c.AutoConnectData.City = parameters.City
+			c.AutoConnectData.Group = parameters.Group
+		}
+		c.AutoConnectData.Protocol = profile.Protocol
+		c.AutoConnectData.ThreatProtectionLite = threatProtectionLite
+		c.AutoConnectData.DNS = profile.DNS
+		c.AutoConnectData.Allowlist = allowlist
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	r.events.Settings.Protocol.Publish(profile.Protocol)
+	r.events.Settings.DNS.Publish(events.DataDNS{Ips: profile.DNS})
+	r.events.Settings.Allowlist.Publish(events.DataAllowlist{
+		TCPPorts: allowlist.GetTCPPorts(),
+		UDPPorts: allowlist.GetUDPPorts(),
+		Subnets:  allowlist.GetSubnets(),
+	})
+	r.events.Settings.Autoconnect.Publish(profile.AutoConnect)
+
+	if profile.Protocol != cfg.AutoConnectData.Protocol &&
+		cfg.Technology == config.Technology_OPENVPN &&
+		r.netw.IsVPNActive() {
+		return &pb.Payload{Type: internal.CodeVPNRunning, Data: []string{name}}, nil
+	}
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{name}}, nil
+}
+
+// DeleteProfile removes the saved profile. Settings applied by the profile are kept.
+func (r *RPC) DeleteProfile(ctx context.Context, in *pb.ProfileRequest) (*pb.Payload, error) {
+	name, _ := normalizeFavoriteName(in.GetName())
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if _, ok := cfg.Profiles[name]; !ok {
+		return &pb.Payload{Type: internal.CodeProfileNotFound, Data: []string{name}}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Profiles = c.Profiles.Without(name)
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{name}}, nil
+}
+
+// Profiles lists the saved profiles
+func (r *RPC) Profiles(ctx context.Context, in *pb.Empty) (*pb.ProfilesList, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.ProfilesList{Type: internal.CodeConfigError}, nil
+	}
+
+	resp := &pb.ProfilesList{Type: internal.CodeSuccess}
+	for _, name := range cfg.Profiles.SortedNames() {
+		profile := cfg.Profiles[name]
+		udpPorts := profile.Allowlist.GetUDPPorts()
+		tcpPorts := profile.Allowlist.GetTCPPorts()
+		subnets := profile.Allowlist.GetSubnets()
+		slices.Sort(udpPorts)
+		slices.Sort(tcpPorts)
+		slices.Sort(subnets)
+		resp.Profiles = append(resp.Profiles, &pb.Profile{
+			Name:     name,
+			Protocol: profile.Protocol,
+			Dns:      profile.DNS,
+			Allowlist: &pb.Allowlist{
+				Ports: &pb.Ports{
+					Udp: udpPorts,
+					Tcp: tcpPorts,
+				},
+				Subnets: subnets,
+			},
+			AutoConnect:          profile.AutoConnect,
+			AutoConnectServerTag: profile.AutoConnectServerTag,
+		})
+	}
+	return resp, nil
+}
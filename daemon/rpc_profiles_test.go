@@ -0,0 +1,164 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newProfilesRPC(cfg config.Config) (*RPC, *mock.ConfigManager, *networker.Mock) {
+	cm := mock.NewMockConfigManager()
+	cm.Cfg = &cfg
+	netw := &networker.Mock{}
+	return &RPC{
+		cm:          cm,
+		netw:        netw,
+		nameservers: &mock.DNSGetter{Names: []string{"103.86.96.100"}},
+		events:      events.NewEventsEmpty(),
+		dm:          testNewDataManager(),
+	}, cm, netw
+}
+
+func TestProfiles_CreateAndDelete(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	rpc, cm, _ := newProfilesRPC(config.Config{
+		AutoConnectData: config.AutoConnectData{
+			Protocol:  config.Protocol_TCP,
+			DNS:       config.DNS{"1.1.1.1"},
+			Allowlist: config.NewAllowlist([]int64{53}, nil, nil),
+		},
+	})
+
+	resp, err := rpc.CreateProfile(context.Background(), &pb.ProfileRequest{Name: "Work"})
+	require.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.Equal(t, []string{"work"}, resp.Data)
+
+	resp, err = rpc.CreateProfile(context.Background(), &pb.ProfileRequest{Name: "work"})
+	require.NoError(t, err)
+	assert.Equal(t, internal.CodeNothingToDo, resp.Type)
+
+	resp, err = rpc.CreateProfile(context.Background(), &pb.ProfileRequest{Name: "work profile"})
+	require.NoError(t, err)
+	assert.Equal(t, internal.CodeFormatError, resp.Type)
+
+	list, err := rpc.Profiles(context.Background(), &pb.Empty{})
+	require.NoError(t, err)
+	require.Len(t, list.Profiles, 1)
+	assert.Equal(t, config.Protocol_TCP, list.Profiles[0].Protocol)
+	assert.Equal(t, []string{"1.1.1.1"}, list.Profiles[0].Dns)
+	assert.Equal(t, []int64{53}, list.Profiles[0].Allowlist.Ports.Udp)
+
+	resp, err = rpc.DeleteProfile(context.Background(), &pb.ProfileRequest{Name: "work"})
+	require.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.Empty(t, cm.Cfg.Profiles)
+
+	resp, err = rpc.DeleteProfile(context.Background(), &pb.ProfileRequest{Name: "work"})
+	require.NoError(t, err)
+	assert.Equal(t, internal.CodeProfileNotFound, resp.Type)
+}
+
+func TestSwitchProfile(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	streaming := config.Profile{
+		Protocol:             config.Protocol_UDP,
+		Allowlist:            config.NewAllowlist(nil, []int64{8080}, []string{"192.168.1.0/24", "1.1.1.0/24"}),
+		AutoConnect:          true,
+		AutoConnectServerTag: "germany",
+	}
+
+	tests := []struct {
+		name              string
+		cfg               config.Config
+		vpnActive         bool
+		profile           string
+		expectedCode      int64
+		expectedDNS       []string
+		expectedAllowlist config.Allowlist
+	}{
+		{
+			name: "unknown profile",
+			cfg: config.Config{
+				Profiles: config.Profiles{"streaming": streaming},
+			},
+			profile:      "work",
+			expectedCode: internal.CodeProfileNotFound,
+		},
+		{
+			name: "settings are applied",
+			cfg: config.Config{
+				Technology: config.Technology_OPENVPN,
+				AutoConnectData: config.AutoConnectData{
+					Protocol:             config.Protocol_UDP,
+					ThreatProtectionLite: true,
+					DNS:                  config.DNS{"1.1.1.1"},
+				},
+				Profiles: config.Profiles{"streaming": streaming},
+			},
+			vpnActive:         true,
+			profile:           "Streaming",
+			expectedCode:      internal.CodeSuccess,
+			expectedDNS:       []string{"103.86.96.100"},
+			expectedAllowlist: config.NewAllowlist(nil, []int64{8080}, []string{"192.168.1.0/24", "1.1.1.0/24"}),
+		},
+		{
+			name: "private subnets are skipped with LAN discovery",
+			cfg: config.Config{
+				LanDiscovery: true,
+				Profiles:     config.Profiles{"streaming": streaming},
+			},
+			profile:           "streaming",
+			expectedCode:      internal.CodeSuccess,
+			expectedDNS:       []string{"103.86.96.100"},
+			expectedAllowlist: config.NewAllowlist(nil, []int64{8080}, []string{"1.1.1.0/24"}),
+		},
+		{
+			name: "protocol changed while connected",
+			cfg: config.Config{
+				Technology:      config.Technology_OPENVPN,
+				AutoConnectData: config.AutoConnectData{Protocol: config.Protocol_TCP},
+				Profiles:        config.Profiles{"streaming": streaming},
+			},
+			vpnActive:         true,
+			profile:           "streaming",
+			expectedCode:      internal.CodeVPNRunning,
+			expectedDNS:       []string{"103.86.96.100"},
+			expectedAllowlist: config.NewAllowlist(nil, []int64{8080}, []string{"192.168.1.0/24", "1.1.1.0/24"}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rpc, cm, netw := newProfilesRPC(test.cfg)
+			netw.VpnActive = test.vpnActive
+
+			resp, err := rpc.SwitchProfile(context.Background(), &pb.ProfileRequest{Name: test.profile})
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			if test.expectedCode == internal.CodeProfileNotFound {
+				return
+			}
+
+			assert.Equal(t, test.expectedDNS, netw.Dns)
+			assert.Equal(t, test.expectedAllowlist, netw.Allowlist)
+			assert.Equal(t, test.expectedAllowlist, cm.Cfg.AutoConnectData.Allowlist)
+			assert.Equal(t, config.Protocol_UDP, cm.Cfg.AutoConnectData.Protocol)
+			assert.Empty(t, cm.Cfg.AutoConnectData.DNS)
+			assert.True(t, cm.Cfg.AutoConnect)
+			assert.Equal(t, "germany", cm.Cfg.AutoConnectData.ServerTag)
+		})
+	}
+}
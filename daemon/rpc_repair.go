@@ -0,0 +1,45 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"os/user"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"google.golang.org/grpc/peer"
+)
+
+// Repair detects the failure states which break connectivity and repairs the ones requested
+func (r *RPC) Repair(ctx context.Context, in *pb.RepairRequest) (*pb.RepairResponse, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return nil, internal.ErrUnhandled
+	}
+
+	return &pb.RepairResponse{
+		Issues: runRepair(r.repairChecks(cfg, repairUserFromContext(ctx)), in.GetFix()),
+	}, nil
+}
+
+// repairUserFromContext returns the user calling the RPC or nil if the user is unknown
+func repairUserFromContext(ctx context.Context) *repairUser {
+	peer, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	cred, ok := peer.AuthInfo.(internal.UcredAuth)
+	if !ok {
+		return nil
+	}
+
+	u, err := user.LookupId(strconv.FormatUint(uint64(cred.Uid), 10))
+	if err != nil {
+		log.Println(internal.WarningPrefix, "failed to find user by UID:", err)
+		return nil
+	}
+	return &repairUser{uid: cred.Uid, gid: cred.Gid, home: u.HomeDir}
+}
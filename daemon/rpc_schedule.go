@@ -0,0 +1,126 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// AddScheduleRule saves the rule which connects or disconnects at the given time. Rules due at the same time on
+// the same day are rejected as they would conflict with each other.
+func (r *RPC) AddScheduleRule(ctx context.Context, in *pb.ScheduleRule) (*pb.Payload, error) {
+	name, ok := normalizeFavoriteName(in.GetName())
+	if !ok {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+	days, err := config.ParseScheduleDays(in.GetDays())
+	if err != nil {
+		return &pb.Payload{Type: internal.CodeFormatError, Data: []string{err.Error()}}, nil
+	}
+	rule := config.ScheduleRule{
+		Action: config.ScheduleAction(in.GetAction()),
+		At:     in.GetAt(),
+		Days:   days,
+	}
+	if rule.Action == config.ScheduleConnect {
+		rule.ServerTag = in.GetServerTag()
+		rule.ServerGroup = in.GetServerGroup()
+	}
+	if err := rule.Validate(); err != nil {
+		return &pb.Payload{Type: internal.CodeFormatError, Data: []string{err.Error()}}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if _, ok := cfg.Schedule[name]; ok {
+		return &pb.Payload{Type: internal.CodeNothingToDo, Data: []string{name}}, nil
+	}
+	for _, other := range cfg.Schedule.SortedNames() {
+		if rule.Overlaps(cfg.Schedule[other]) {
+			return &pb.Payload{Type: internal.CodeScheduleConflict, Data: []string{other}}, nil
+		}
+	}
+
+	if rule.Action == config.ScheduleConnect && (rule.ServerTag != "" || rule.ServerGroup != "") {
+		if !r.ac.IsLoggedIn() {
+			return nil, internal.ErrNotLoggedIn
+		}
+		insights := r.dm.GetInsightsData().Insights
+		serverTag := internal.RemoveNonAlphanumeric(rule.ServerTag)
+		if _, _, err := selectServer(r, &insights, cfg, serverTag, rule.ServerGroup, false, false); err != nil {
+			log.Println(internal.ErrorPrefix, "no server found for schedule rule", rule.ServerTag, rule.ServerGroup, err)
+
+			var errorCode *internal.ErrorWithCode
+			if errors.As(err, &errorCode) {
+				return &pb.Payload{Type: errorCode.Code}, nil
+			}
+			return nil, err
+		}
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Schedule = c.Schedule.With(name, rule)
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{name}}, nil
+}
+
+// RemoveScheduleRule removes the schedule rule
+func (r *RPC) RemoveScheduleRule(ctx context.Context, in *pb.RemoveScheduleRuleRequest) (*pb.Payload, error) {
+	name, _ := normalizeFavoriteName(in.GetName())
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if _, ok := cfg.Schedule[name]; !ok {
+		return &pb.Payload{Type: internal.CodeScheduleRuleNotFound, Data: []string{name}}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Schedule = c.Schedule.Without(name)
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{name}}, nil
+}
+
+// ScheduleRules lists the schedule rules
+func (r *RPC) ScheduleRules(ctx context.Context, in *pb.Empty) (*pb.ScheduleRulesList, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.ScheduleRulesList{Type: internal.CodeConfigError}, nil
+	}
+
+	resp := &pb.ScheduleRulesList{Type: internal.CodeSuccess}
+	for _, name := range cfg.Schedule.SortedNames() {
+		rule := cfg.Schedule[name]
+		resp.Rules = append(resp.Rules, &pb.ScheduleRule{
+			Name:        name,
+			Action:      string(rule.Action),
+			At:          rule.At,
+			Days:        config.FormatScheduleDays(rule.Days),
+			ServerTag:   rule.ServerTag,
+			ServerGroup: rule.ServerGroup,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,124 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddScheduleRule(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	evening := config.ScheduleRule{
+		Action: config.ScheduleDisconnect,
+		At:     "18:00",
+		Days:   []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+	}
+	tests := []struct {
+		name          string
+		req           *pb.ScheduleRule
+		expectedType  int64
+		expectedErr   error
+		expectedRules config.ScheduleRules
+	}{
+		{
+			name:         "add connect rule",
+			req:          &pb.ScheduleRule{Name: "Morning", Action: "connect", At: "09:00", Days: "weekdays", ServerTag: "germany"},
+			expectedType: internal.CodeSuccess,
+			expectedRules: config.ScheduleRules{
+				"evening": evening,
+				"morning": {Action: config.ScheduleConnect, At: "09:00", Days: evening.Days, ServerTag: "germany"},
+			},
+		},
+		{
+			name:         "disconnect rule ignores location",
+			req:          &pb.ScheduleRule{Name: "weekend", Action: "disconnect", At: "18:00", Days: "sat", ServerTag: "germany"},
+			expectedType: internal.CodeSuccess,
+			expectedRules: config.ScheduleRules{
+				"evening": evening,
+				"weekend": {Action: config.ScheduleDisconnect, At: "18:00", Days: []time.Weekday{time.Saturday}},
+			},
+		},
+		{
+			name:          "conflicting rule",
+			req:           &pb.ScheduleRule{Name: "friday", Action: "connect", At: "18:00", Days: "fri"},
+			expectedType:  internal.CodeScheduleConflict,
+			expectedRules: config.ScheduleRules{"evening": evening},
+		},
+		{
+			name:          "name already used",
+			req:           &pb.ScheduleRule{Name: "evening", Action: "disconnect", At: "19:00"},
+			expectedType:  internal.CodeNothingToDo,
+			expectedRules: config.ScheduleRules{"evening": evening},
+		},
+		{
+			name:          "invalid time",
+			req:           &pb.ScheduleRule{Name: "morning", Action: "connect", At: "9am"},
+			expectedType:  internal.CodeFormatError,
+			expectedRules: config.ScheduleRules{"evening": evening},
+		},
+		{
+			name:          "invalid days",
+			req:           &pb.ScheduleRule{Name: "morning", Action: "connect", At: "09:00", Days: "someday"},
+			expectedType:  internal.CodeFormatError,
+			expectedRules: config.ScheduleRules{"evening": evening},
+		},
+		{
+			name:          "invalid action",
+			req:           &pb.ScheduleRule{Name: "morning", Action: "reconnect", At: "09:00"},
+			expectedType:  internal.CodeFormatError,
+			expectedRules: config.ScheduleRules{"evening": evening},
+		},
+		{
+			name:          "nonexistent location",
+			req:           &pb.ScheduleRule{Name: "morning", Action: "connect", At: "09:00", ServerTag: "invalid_name"},
+			expectedErr:   internal.ErrTagDoesNotExist,
+			expectedRules: config.ScheduleRules{"evening": evening},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg = &config.Config{
+				Technology:      config.Technology_NORDLYNX,
+				AutoConnectData: config.AutoConnectData{Protocol: config.Protocol_UDP},
+				Schedule:        config.ScheduleRules{"evening": evening},
+			}
+			dm := DataManager{serversData: ServersData{Servers: serversList()}}
+			r := RPC{cm: cm, ac: mockAutoconnectAuthChecker{}, dm: &dm, serversAPI: &mockServersAPI{}}
+
+			resp, err := r.AddScheduleRule(context.Background(), test.req)
+			assert.Equal(t, test.expectedErr, err)
+			if err == nil {
+				assert.Equal(t, test.expectedType, resp.GetType())
+			}
+			assert.Equal(t, test.expectedRules, cm.Cfg.Schedule)
+		})
+	}
+}
+
+func TestRemoveScheduleRule(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Schedule = config.ScheduleRules{"morning": {Action: config.ScheduleConnect, At: "09:00"}}
+	r := RPC{cm: cm}
+
+	resp, err := r.RemoveScheduleRule(context.Background(), &pb.RemoveScheduleRuleRequest{Name: "Morning"})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.GetType())
+	assert.Empty(t, cm.Cfg.Schedule)
+
+	resp, err = r.RemoveScheduleRule(context.Background(), &pb.RemoveScheduleRuleRequest{Name: "morning"})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeScheduleRuleNotFound, resp.GetType())
+}
@@ -0,0 +1,134 @@
+package daemon
+
+import (
+	"cmp"
+	"context"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const (
+	// defaultServersPageSize is used when the page size is not given
+	defaultServersPageSize = 50
+	// maxServersPageSize limits the size of a single response
+	maxServersPageSize = 500
+)
+
+func technologyFromProtobuf(tech pb.Technology) core.ServerTechnology {
+	switch tech {
+	case pb.Technology_NORDLYNX:
+		return core.WireguardTech
+	case pb.Technology_OPENVPN_TCP:
+		return core.OpenVPNTCP
+	case pb.Technology_OPENVPN_UDP:
+		return core.OpenVPNUDP
+	case pb.Technology_OBFUSCATED_OPENVPN_TCP:
+		return core.OpenVPNTCPObfuscated
+	case pb.Technology_OBFUSCATED_OPENVPN_UDP:
+		return core.OpenVPNUDPObfuscated
+	case pb.Technology_NORDWHISPER:
+		return core.NordWhisperTech
+	case pb.Technology_UNKNOWN_TECHNLOGY:
+	}
+	return core.Unknown
+}
+
+// searchFilter returns a predicate matching online servers which satisfy all of the given filters
+func searchFilter(in *pb.SearchServersRequest, allowVirtual bool) core.Predicate {
+	country := internal.SnakeCase(in.GetCountry())
+	return func(s core.Server) bool {
+		if !core.IsOnline()(s) || !allowVirtual && s.IsVirtualLocation() {
+			return false
+		}
+		if country != "" {
+			c := s.Country()
+			if c == nil ||
+				!strings.EqualFold(country, internal.SnakeCase(c.Name)) &&
+					!strings.EqualFold(country, internal.SnakeCase(c.Code)) {
+				return false
+			}
+		}
+		if in.GetGroup() != config.ServerGroup_UNDEFINED &&
+			!slices.ContainsFunc(s.Groups, core.ByGroup(in.GetGroup())) {
+			return false
+		}
+		for _, feature := range in.GetFeatures() {
+			if !core.IsConnectableVia(technologyFromProtobuf(feature))(s) {
+				return false
+			}
+		}
+		return in.GetMaxLoad() <= 0 || s.Load <= in.GetMaxLoad()
+	}
+}
+
+// pageBounds returns the range of the page within total items
+func pageBounds(offset, limit int64, total int) (int, int) {
+	if limit <= 0 {
+		limit = defaultServersPageSize
+	}
+	limit = min(limit, maxServersPageSize)
+	start := int(min(max(offset, 0), int64(total)))
+	return start, int(min(int64(start)+limit, int64(total)))
+}
+
+func serverToDetails(server core.Server) *pb.ServerDetails {
+	details := &pb.ServerDetails{
+		Server: &pb.Server{
+			Id:           server.ID,
+			HostName:     server.Hostname,
+			Virtual:      server.IsVirtualLocation(),
+			ServerGroups: groupFilter(server.Groups),
+			Technologies: technologiesToProtobuf(server.Technologies),
+		},
+		Load: server.Load,
+	}
+	if country := server.Country(); country != nil {
+		details.CountryCode = country.Code
+		details.Country = country.Name
+		details.City = country.City.Name
+	}
+	return details
+}
+
+// SearchServers returns a page of the servers matching the filters. Servers are ordered by country, city and ID, so
+// the pages stay consistent until the servers list is updated.
+func (r *RPC) SearchServers(ctx context.Context, in *pb.SearchServersRequest) (*pb.SearchServersResponse, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, "loading config:", err)
+		return &pb.SearchServersResponse{Response: &pb.SearchServersResponse_Error{
+			Error: pb.ServersError_GET_CONFIG_ERROR,
+		}}, nil
+	}
+
+	servers := internal.Filter(r.dm.GetServersData().Servers, searchFilter(in, cfg.VirtualLocation.Get()))
+	slices.SortFunc(servers, func(a, b core.Server) int {
+		var aCountry, bCountry core.Country
+		if a.Country() != nil {
+			aCountry = *a.Country()
+		}
+		if b.Country() != nil {
+			bCountry = *b.Country()
+		}
+		if c := cmp.Compare(aCountry.Name, bCountry.Name); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(aCountry.City.Name, bCountry.City.Name); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	start, end := pageBounds(in.GetOffset(), in.GetLimit(), len(servers))
+	page := &pb.ServersPage{Total: int64(len(servers))}
+	for _, server := range servers[start:end] {
+		page.Servers = append(page.Servers, serverToDetails(server))
+	}
+	return &pb.SearchServersResponse{Response: &pb.SearchServersResponse_Page{Page: page}}, nil
+}
@@ -0,0 +1,190 @@
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchServers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	standard := core.Groups{{ID: config.ServerGroup_STANDARD_VPN_SERVERS, Title: "Standard VPN"}}
+	p2p := core.Groups{{ID: config.ServerGroup_P2P, Title: "P2P"}}
+	withLoad := func(server core.Server, load int64) core.Server {
+		server.Load = load
+		return server
+	}
+	offline := getServer(7, "de7", "Germany", "DE", "Berlin", false, standard, []core.ServerTechnology{core.WireguardTech})
+	offline.Status = core.Offline
+
+	servers := core.Servers{
+		withLoad(getServer(5, "fr5", "France", "FR", "Paris", false, standard,
+			[]core.ServerTechnology{core.WireguardTech, core.OpenVPNUDP}), 20),
+		withLoad(getServer(2, "de2", "Germany", "DE", "Frankfurt", false, p2p,
+			[]core.ServerTechnology{core.OpenVPNUDP}), 60),
+		withLoad(getServer(3, "de3", "Germany", "DE", "Berlin", false, standard,
+			[]core.ServerTechnology{core.WireguardTech}), 10),
+		withLoad(getServer(1, "de1", "Germany", "DE", "Berlin", false, p2p,
+			[]core.ServerTechnology{core.WireguardTech}), 40),
+		withLoad(getServer(4, "bz4", "Belize", "BZ", "Belmopan", true, standard,
+			[]core.ServerTechnology{core.WireguardTech}), 5),
+		offline,
+	}
+
+	tests := []struct {
+		name         string
+		request      *pb.SearchServersRequest
+		allowVirtual bool
+		expectedIDs  []int64
+		total        int64
+	}{
+		{
+			name:        "all servers",
+			request:     &pb.SearchServersRequest{},
+			expectedIDs: []int64{5, 1, 3, 2},
+			total:       4,
+		},
+		{
+			name:         "virtual servers are allowed",
+			request:      &pb.SearchServersRequest{},
+			allowVirtual: true,
+			expectedIDs:  []int64{4, 5, 1, 3, 2},
+			total:        5,
+		},
+		{
+			name:        "country by code",
+			request:     &pb.SearchServersRequest{Country: "de"},
+			expectedIDs: []int64{1, 3, 2},
+			total:       3,
+		},
+		{
+			name:        "country by name",
+			request:     &pb.SearchServersRequest{Country: "germany"},
+			expectedIDs: []int64{1, 3, 2},
+			total:       3,
+		},
+		{
+			name:        "group",
+			request:     &pb.SearchServersRequest{Group: config.ServerGroup_P2P},
+			expectedIDs: []int64{1, 2},
+			total:       2,
+		},
+		{
+			name:        "features",
+			request:     &pb.SearchServersRequest{Features: []pb.Technology{pb.Technology_NORDLYNX, pb.Technology_OPENVPN_UDP}},
+			expectedIDs: []int64{5},
+			total:       1,
+		},
+		{
+			name:        "load ceiling",
+			request:     &pb.SearchServersRequest{MaxLoad: 20},
+			expectedIDs: []int64{5, 3},
+			total:       2,
+		},
+		{
+			name:        "page",
+			request:     &pb.SearchServersRequest{Offset: 1, Limit: 2},
+			expectedIDs: []int64{1, 3},
+			total:       4,
+		},
+		{
+			name:    "offset past the end",
+			request: &pb.SearchServersRequest{Offset: 10},
+			total:   4,
+		},
+		{
+			name:    "nothing found",
+			request: &pb.SearchServersRequest{Country: "lt"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfgManager := mock.NewMockConfigManager()
+			cfgManager.Cfg.VirtualLocation.Set(test.allowVirtual)
+			dm := DataManager{}
+			dm.serversData.Servers = servers
+			r := RPC{dm: &dm, cm: cfgManager}
+
+			resp, err := r.SearchServers(context.Background(), test.request)
+			assert.NoError(t, err)
+			page := resp.GetPage()
+			assert.NotNil(t, page)
+			assert.Equal(t, test.total, page.GetTotal())
+
+			var ids []int64
+			for _, server := range page.GetServers() {
+				ids = append(ids, server.GetServer().GetId())
+			}
+			assert.Equal(t, test.expectedIDs, ids)
+		})
+	}
+}
+
+func TestSearchServers_Details(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	server := getServer(1, "de1.nordvpn.com", "Germany", "DE", "Berlin", false,
+		core.Groups{{ID: config.ServerGroup_P2P, Title: "P2P"}}, []core.ServerTechnology{core.WireguardTech})
+	server.Load = 42
+	dm := DataManager{}
+	dm.serversData.Servers = core.Servers{server}
+	r := RPC{dm: &dm, cm: mock.NewMockConfigManager()}
+
+	resp, err := r.SearchServers(context.Background(), &pb.SearchServersRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.ServerDetails{{
+		Server: &pb.Server{
+			Id:           1,
+			HostName:     "de1.nordvpn.com",
+			ServerGroups: []config.ServerGroup{config.ServerGroup_P2P},
+			Technologies: []pb.Technology{pb.Technology_NORDLYNX},
+		},
+		CountryCode: "DE",
+		Country:     "Germany",
+		City:        "Berlin",
+		Load:        42,
+	}}, resp.GetPage().GetServers())
+}
+
+func TestSearchServers_ConfigError(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cfgManager := mock.NewMockConfigManager()
+	cfgManager.LoadErr = fmt.Errorf("failed to load config")
+	r := RPC{dm: &DataManager{}, cm: cfgManager}
+
+	resp, err := r.SearchServers(context.Background(), &pb.SearchServersRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.ServersError_GET_CONFIG_ERROR, resp.GetError())
+}
+
+func TestPageBounds(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		offset, limit int64
+		total         int
+		start, end    int
+	}{
+		{offset: 0, limit: 0, total: 120, start: 0, end: defaultServersPageSize},
+		{offset: 100, limit: 0, total: 120, start: 100, end: 120},
+		{offset: -5, limit: 10, total: 120, start: 0, end: 10},
+		{offset: 0, limit: 1000, total: 1000, start: 0, end: maxServersPageSize},
+		{offset: 200, limit: 10, total: 120, start: 120, end: 120},
+	}
+
+	for _, test := range tests {
+		start, end := pageBounds(test.offset, test.limit, test.total)
+		assert.Equal(t, test.start, start)
+		assert.Equal(t, test.end, end)
+	}
+}
@@ -26,6 +26,8 @@ func technologiesToProtobuf(technologies core.Technologies) []pb.Technology {
 			technologiesProto = append(technologiesProto, pb.Technology_OBFUSCATED_OPENVPN_TCP)
 		case core.WireguardTech:
 			technologiesProto = append(technologiesProto, pb.Technology_NORDLYNX)
+		case core.NordWhisperTech:
+			technologiesProto = append(technologiesProto, pb.Technology_NORDWHISPER)
 		}
 	}
 
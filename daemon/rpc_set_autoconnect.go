@@ -9,6 +9,7 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/core"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/internal"
+	"google.golang.org/grpc/peer"
 )
 
 func (r *RPC) SetAutoConnect(ctx context.Context, in *pb.SetAutoconnectRequest) (*pb.Payload, error) {
@@ -16,12 +17,23 @@ func (r *RPC) SetAutoConnect(ctx context.Context, in *pb.SetAutoconnectRequest)
 		return nil, internal.ErrNotLoggedIn
 	}
 
+	var uid int64
+	if peer, ok := peer.FromContext(ctx); ok {
+		cred, ok := peer.AuthInfo.(internal.UcredAuth)
+		if !ok {
+			return &pb.Payload{
+				Type: internal.CodeFailure,
+			}, nil
+		}
+		uid = int64(cred.Uid)
+	}
+
 	var cfg config.Config
 	if err := r.cm.Load(&cfg); err != nil {
 		log.Println(internal.ErrorPrefix, err)
 	}
 
-	if !cfg.AutoConnect && !in.GetEnabled() {
+	if !cfg.AutoConnectFor(uid) && !in.GetEnabled() {
 		return &pb.Payload{
 			Type: internal.CodeNothingToDo,
 		}, nil
@@ -53,7 +65,7 @@ func (r *RPC) SetAutoConnect(ctx context.Context, in *pb.SetAutoconnectRequest)
 		if serverTag != "" {
 			insights := r.dm.GetInsightsData().Insights
 
-			server, _, err := selectServer(r, &insights, cfg, serverTag, "")
+			server, _, err := selectServer(r, &insights, cfg, serverTag, "", false, false)
 			if err != nil {
 				log.Println(internal.ErrorPrefix, "no server found for autoconnect", serverTag, err)
 
@@ -74,13 +86,9 @@ func (r *RPC) SetAutoConnect(ctx context.Context, in *pb.SetAutoconnectRequest)
 		}
 
 		if err := r.cm.SaveWith(func(c config.Config) config.Config {
-			c.AutoConnect = in.GetEnabled()
+			c.SetAutoConnectFor(uid, in.GetEnabled())
 			c.AutoConnectData = config.AutoConnectData{
 				ID:                   cfg.AutoConnectData.ID,
-				ServerTag:            serverTag,
-				Country:              parameters.Country,
-				City:                 parameters.City,
-				Group:                parameters.Group,
 				Protocol:             cfg.AutoConnectData.Protocol,
 				ThreatProtectionLite: cfg.AutoConnectData.ThreatProtectionLite,
 				Obfuscate:            cfg.AutoConnectData.Obfuscate,
@@ -88,6 +96,12 @@ func (r *RPC) SetAutoConnect(ctx context.Context, in *pb.SetAutoconnectRequest)
 				Allowlist:            cfg.AutoConnectData.Allowlist,
 				PostquantumVpn:       cfg.AutoConnectData.PostquantumVpn,
 			}
+			c.SetAutoConnectTarget(uid, config.AutoConnectTarget{
+				ServerTag: serverTag,
+				Country:   parameters.Country,
+				City:      parameters.City,
+				Group:     parameters.Group,
+			})
 			return c
 		}); err != nil {
 			log.Println(internal.ErrorPrefix, err)
@@ -97,7 +111,7 @@ func (r *RPC) SetAutoConnect(ctx context.Context, in *pb.SetAutoconnectRequest)
 		}
 	} else {
 		if err := r.cm.SaveWith(func(c config.Config) config.Config {
-			c.AutoConnect = in.GetEnabled()
+			c.SetAutoConnectFor(uid, in.GetEnabled())
 			return c
 		}); err != nil {
 			log.Println(internal.ErrorPrefix, err)
@@ -2,6 +2,7 @@ package daemon
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/NordSecurity/nordvpn-linux/auth"
@@ -12,6 +13,7 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/test/category"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/peer"
 )
 
 type mockAutoconnectAuthChecker struct {
@@ -181,3 +183,97 @@ func TestAutoconnect(t *testing.T) {
 		})
 	}
 }
+
+func TestAutoconnect_TargetIsPerUser(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	mockConfigManager := newMockConfigManager()
+	mockConfigManager.c = config.Config{
+		Technology:      config.Technology_NORDLYNX,
+		AutoConnectData: config.AutoConnectData{Protocol: config.Protocol_UDP},
+	}
+	mockEvents := events.Events{Settings: &events.SettingsEvents{Autoconnect: &events.MockPublisherSubscriber[bool]{}}}
+	dm := DataManager{serversData: ServersData{Servers: serversList()}}
+	r := RPC{cm: mockConfigManager, ac: mockAutoconnectAuthChecker{}, events: &mockEvents, dm: &dm, serversAPI: &mockServersAPI{}}
+
+	setAs := func(uid uint32, serverTag string) {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: internal.UcredAuth{Uid: uid}})
+		resp, err := r.SetAutoConnect(ctx, &pb.SetAutoconnectRequest{Enabled: true, ServerTag: serverTag})
+		assert.NoError(t, err)
+		assert.Equal(t, internal.CodeSuccess, resp.Type)
+	}
+
+	setAs(1000, "germany")
+	setAs(1001, "double_vpn")
+
+	cfg := mockConfigManager.c
+	assert.Equal(t, "germany", cfg.AutoConnectTargetFor(1000).ServerTag)
+	assert.Equal(t, config.ServerGroup_UNDEFINED, configToProtobuf(&cfg, 1000).AutoConnectData.ServerGroup)
+	assert.Equal(t, "double_vpn", cfg.AutoConnectTargetFor(1001).ServerTag)
+	assert.Equal(t, config.ServerGroup_DoubleVPN, configToProtobuf(&cfg, 1001).AutoConnectData.ServerGroup)
+	// users without a target see the one used when nobody is at the console
+	assert.Equal(t, "double_vpn", cfg.AutoConnectTargetFor(1002).ServerTag)
+
+	targetFor := func(cfg config.Config) string {
+		_, target := r.autoConnectFor(cfg)
+		return target.ServerTag
+	}
+	r.seatUser = func() (int64, bool, error) { return 1000, true, nil }
+	assert.Equal(t, "germany", targetFor(cfg))
+	r.seatUser = func() (int64, bool, error) { return 1002, true, nil }
+	assert.Equal(t, "double_vpn", targetFor(cfg))
+	// the daemon autoconnects on boot to the target set most recently
+	r.seatUser = func() (int64, bool, error) { return 0, false, nil }
+	assert.Equal(t, "double_vpn", targetFor(cfg))
+	r.seatUser = func() (int64, bool, error) { return 0, false, errors.New("no logind") }
+	assert.Equal(t, "double_vpn", targetFor(cfg))
+}
+
+func TestAutoconnect_EnabledIsPerUser(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	mockConfigManager := newMockConfigManager()
+	mockConfigManager.c = config.Config{
+		Technology:      config.Technology_NORDLYNX,
+		AutoConnectData: config.AutoConnectData{Protocol: config.Protocol_UDP},
+	}
+	mockEvents := events.Events{Settings: &events.SettingsEvents{Autoconnect: &events.MockPublisherSubscriber[bool]{}}}
+	dm := DataManager{serversData: ServersData{Servers: serversList()}}
+	r := RPC{cm: mockConfigManager, ac: mockAutoconnectAuthChecker{}, events: &mockEvents, dm: &dm, serversAPI: &mockServersAPI{}}
+
+	setAs := func(uid uint32, enabled bool) {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: internal.UcredAuth{Uid: uid}})
+		resp, err := r.SetAutoConnect(ctx, &pb.SetAutoconnectRequest{Enabled: enabled, ServerTag: "germany"})
+		assert.NoError(t, err)
+		assert.Equal(t, internal.CodeSuccess, resp.Type)
+	}
+
+	setAs(1000, true)
+	setAs(1001, true)
+	setAs(1001, false)
+
+	cfg := mockConfigManager.c
+	assert.True(t, configToProtobuf(&cfg, 1000).AutoConnectData.Enabled)
+	assert.False(t, configToProtobuf(&cfg, 1001).AutoConnectData.Enabled)
+	assert.True(t, cfg.AutoConnectForAnyone())
+
+	enabledFor := func(cfg config.Config) bool {
+		enabled, _ := r.autoConnectFor(cfg)
+		return enabled
+	}
+	r.seatUser = func() (int64, bool, error) { return 1000, true, nil }
+	assert.True(t, enabledFor(cfg))
+	r.seatUser = func() (int64, bool, error) { return 1001, true, nil }
+	assert.False(t, enabledFor(cfg))
+	// the daemon follows the most recent choice on boot
+	r.seatUser = func() (int64, bool, error) { return 0, false, nil }
+	assert.False(t, enabledFor(cfg))
+
+	// turning it off for one user keeps it on for the other
+	ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: internal.UcredAuth{Uid: 1001}})
+	resp, err := r.SetAutoConnect(ctx, &pb.SetAutoconnectRequest{Enabled: false})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeNothingToDo, resp.Type)
+	cfg = mockConfigManager.c
+	assert.True(t, cfg.AutoConnectFor(1000))
+}
@@ -0,0 +1,51 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetConnectRetry sets how many times and how often the failed connection is retried
+func (r *RPC) SetConnectRetry(ctx context.Context, in *pb.ConnectRetry) (*pb.Payload, error) {
+	retry := config.ConnectRetry{
+		Attempts:       int(in.GetAttempts()),
+		AttemptTimeout: time.Duration(in.GetAttemptTimeoutSeconds()) * time.Second,
+		Backoff:        time.Duration(in.GetBackoffSeconds()) * time.Second,
+	}
+	if err := retry.Validate(); err != nil {
+		return &pb.Payload{Type: internal.CodeFormatError, Data: []string{err.Error()}}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if cfg.ConnectRetry == retry {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.ConnectRetry = retry
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+func connectRetryToProtobuf(retry config.ConnectRetry) *pb.ConnectRetry {
+	return &pb.ConnectRetry{
+		Attempts:              uint32(retry.Attempts),
+		AttemptTimeoutSeconds: uint32(retry.AttemptTimeout / time.Second),
+		BackoffSeconds:        uint32(retry.Backoff / time.Second),
+	}
+}
@@ -0,0 +1,51 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetConnectTimeouts sets how long the technologies wait for the server while connecting
+func (r *RPC) SetConnectTimeouts(ctx context.Context, in *pb.ConnectTimeouts) (*pb.Payload, error) {
+	timeouts := config.ConnectTimeouts{
+		NordLynxHandshake: time.Duration(in.GetNordlynxHandshakeSeconds()) * time.Second,
+		OpenVPNConnect:    time.Duration(in.GetOpenvpnConnectSeconds()) * time.Second,
+		OpenVPNHandshake:  time.Duration(in.GetOpenvpnHandshakeSeconds()) * time.Second,
+	}
+	if err := timeouts.Validate(); err != nil {
+		return &pb.Payload{Type: internal.CodeFormatError, Data: []string{err.Error()}}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if cfg.ConnectTimeouts == timeouts {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.ConnectTimeouts = timeouts
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+func connectTimeoutsToProtobuf(timeouts config.ConnectTimeouts) *pb.ConnectTimeouts {
+	return &pb.ConnectTimeouts{
+		NordlynxHandshakeSeconds: uint32(timeouts.NordLynxHandshake / time.Second),
+		OpenvpnConnectSeconds:    uint32(timeouts.OpenVPNConnect / time.Second),
+		OpenvpnHandshakeSeconds:  uint32(timeouts.OpenVPNHandshake / time.Second),
+	}
+}
@@ -0,0 +1,65 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetConnectTimeouts(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      config.ConnectTimeouts
+		request      *pb.ConnectTimeouts
+		expectedCode int64
+		expected     config.ConnectTimeouts
+	}{
+		{
+			name:         "timeouts are set",
+			request:      &pb.ConnectTimeouts{NordlynxHandshakeSeconds: 20, OpenvpnHandshakeSeconds: 120},
+			expectedCode: internal.CodeSuccess,
+			expected:     config.ConnectTimeouts{NordLynxHandshake: 20 * time.Second, OpenVPNHandshake: 2 * time.Minute},
+		},
+		{
+			name:         "defaults are restored",
+			current:      config.ConnectTimeouts{OpenVPNConnect: 10 * time.Second},
+			request:      &pb.ConnectTimeouts{},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "timeouts are already set",
+			current:      config.ConnectTimeouts{OpenVPNConnect: 10 * time.Second},
+			request:      &pb.ConnectTimeouts{OpenvpnConnectSeconds: 10},
+			expectedCode: internal.CodeNothingToDo,
+			expected:     config.ConnectTimeouts{OpenVPNConnect: 10 * time.Second},
+		},
+		{
+			name:         "timeout is too long",
+			request:      &pb.ConnectTimeouts{NordlynxHandshakeSeconds: 3600},
+			expectedCode: internal.CodeFormatError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.ConnectTimeouts = test.current
+			r := RPC{cm: cm}
+
+			resp, err := r.SetConnectTimeouts(context.Background(), test.request)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.ConnectTimeouts)
+		})
+	}
+}
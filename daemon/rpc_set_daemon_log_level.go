@@ -0,0 +1,79 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"slices"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetDaemonLogLevel changes the log level of the daemon and the components with debug logging enabled without
+// restarting it. The change is saved to the config only when requested, otherwise it lasts until the restart.
+func (r *RPC) SetDaemonLogLevel(ctx context.Context, in *pb.SetDaemonLogLevelRequest) (*pb.Payload, error) {
+	if r.logFilter == nil {
+		return &pb.Payload{Type: internal.CodeFailure}, nil
+	}
+
+	level, err := internal.ParseLogLevel(in.GetLevel())
+	if err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+	components, err := internal.ParseLogComponents(in.GetDebugComponents())
+	if err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	currentLevel, currentComponents := r.logFilter.Get()
+	changed := currentLevel != level || !slices.Equal(currentComponents, components)
+	persisted := internal.LogLevelOrDefault(cfg.DaemonLogLevel) == level &&
+		slices.Equal(cfg.DebugComponents, components)
+	if !changed && (!in.GetPersist() || persisted) {
+		return &pb.Payload{Type: internal.CodeNothingToDo, Data: []string{string(level)}}, nil
+	}
+
+	if in.GetPersist() && !persisted {
+		if err := r.cm.SaveWith(func(c config.Config) config.Config {
+			c.DaemonLogLevel = level
+			c.DebugComponents = components
+			if len(components) == 0 {
+				c.DebugComponents = nil
+			}
+			return c
+		}); err != nil {
+			log.Println(internal.ErrorPrefix, err)
+			return &pb.Payload{Type: internal.CodeConfigError}, nil
+		}
+	}
+
+	r.logFilter.Set(level, components)
+	log.Println(internal.InfoPrefix, "daemon log level is set to", level, "with debug logging for", components)
+
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{string(level)}}, nil
+}
+
+// daemonLogLevel returns the log level and the components with debug logging enabled currently used by the daemon
+func (r *RPC) daemonLogLevel(cfg config.Config) (internal.LogLevel, []internal.LogComponent) {
+	if r.logFilter == nil {
+		return internal.LogLevelOrDefault(cfg.DaemonLogLevel), cfg.DebugComponents
+	}
+	return r.logFilter.Get()
+}
+
+func logComponentsToStrings(components []internal.LogComponent) []string {
+	names := []string{}
+	for _, component := range components {
+		names = append(names, string(component))
+	}
+	return names
+}
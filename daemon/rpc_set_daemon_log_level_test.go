@@ -0,0 +1,112 @@
+package daemon
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDaemonLogLevel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name                        string
+		currentLevel                internal.LogLevel
+		currentComponents           []internal.LogComponent
+		persistedLevel              internal.LogLevel
+		request                     *pb.SetDaemonLogLevelRequest
+		expectedCode                int64
+		expectedLevel               internal.LogLevel
+		expectedComponents          []internal.LogComponent
+		expectedPersistedLevel      internal.LogLevel
+		expectedPersistedComponents []internal.LogComponent
+	}{
+		{
+			name:               "level is set until restart",
+			currentLevel:       internal.LogLevelInfo,
+			request:            &pb.SetDaemonLogLevelRequest{Level: "debug"},
+			expectedCode:       internal.CodeSuccess,
+			expectedLevel:      internal.LogLevelDebug,
+			expectedComponents: []internal.LogComponent{},
+		},
+		{
+			name:         "level and components are persisted",
+			currentLevel: internal.LogLevelInfo,
+			request: &pb.SetDaemonLogLevelRequest{
+				Level:           "warning",
+				DebugComponents: []string{"vpn", "Firewall", "vpn"},
+				Persist:         true,
+			},
+			expectedCode:  internal.CodeSuccess,
+			expectedLevel: internal.LogLevelWarning,
+			expectedComponents: []internal.LogComponent{
+				internal.LogComponentFirewall, internal.LogComponentVPN,
+			},
+			expectedPersistedLevel: internal.LogLevelWarning,
+			expectedPersistedComponents: []internal.LogComponent{
+				internal.LogComponentFirewall, internal.LogComponentVPN,
+			},
+		},
+		{
+			name:                   "runtime level is persisted",
+			currentLevel:           internal.LogLevelDebug,
+			currentComponents:      []internal.LogComponent{},
+			request:                &pb.SetDaemonLogLevelRequest{Level: "debug", Persist: true},
+			expectedCode:           internal.CodeSuccess,
+			expectedLevel:          internal.LogLevelDebug,
+			expectedComponents:     []internal.LogComponent{},
+			expectedPersistedLevel: internal.LogLevelDebug,
+		},
+		{
+			name:                   "level is already set",
+			currentLevel:           internal.LogLevelDebug,
+			currentComponents:      []internal.LogComponent{},
+			persistedLevel:         internal.LogLevelInfo,
+			request:                &pb.SetDaemonLogLevelRequest{Level: "debug"},
+			expectedCode:           internal.CodeNothingToDo,
+			expectedLevel:          internal.LogLevelDebug,
+			expectedComponents:     []internal.LogComponent{},
+			expectedPersistedLevel: internal.LogLevelInfo,
+		},
+		{
+			name:          "unknown level",
+			currentLevel:  internal.LogLevelInfo,
+			request:       &pb.SetDaemonLogLevelRequest{Level: "verbose"},
+			expectedCode:  internal.CodeFormatError,
+			expectedLevel: internal.LogLevelInfo,
+		},
+		{
+			name:          "unknown component",
+			currentLevel:  internal.LogLevelInfo,
+			request:       &pb.SetDaemonLogLevelRequest{Level: "info", DebugComponents: []string{"tray"}},
+			expectedCode:  internal.CodeFormatError,
+			expectedLevel: internal.LogLevelInfo,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.DaemonLogLevel = test.persistedLevel
+			filter := internal.NewLogFilter(io.Discard, test.currentLevel, test.currentComponents)
+			r := RPC{cm: cm, logFilter: filter}
+
+			resp, err := r.SetDaemonLogLevel(context.Background(), test.request)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+
+			level, components := filter.Get()
+			assert.Equal(t, test.expectedLevel, level)
+			assert.Equal(t, test.expectedComponents, components)
+			assert.Equal(t, test.expectedPersistedLevel, cm.Cfg.DaemonLogLevel)
+			assert.Equal(t, test.expectedPersistedComponents, cm.Cfg.DebugComponents)
+		})
+	}
+}
@@ -55,6 +55,9 @@ func (r *RPC) SetDefaults(ctx context.Context, in *pb.Empty) (*pb.Payload, error
 		}, nil
 	}
 	r.netw.SetVPN(v)
+	if err := r.netw.SetPeerRoutes("", nil); err != nil {
+		log.Println(internal.WarningPrefix, err)
+	}
 
 	r.events.Settings.Defaults.Publish(nil)
 	r.events.Settings.Publish(cfg)
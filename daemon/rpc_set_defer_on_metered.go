@@ -0,0 +1,38 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetDeferOnMetered toggles postponing auto-connect, server list refreshes and queued file sends while the network
+// is metered. Tasks postponed so far are run once the setting is disabled.
+func (r *RPC) SetDeferOnMetered(ctx context.Context, in *pb.SetGenericRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if cfg.DeferOnMetered == in.GetEnabled() {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.DeferOnMetered = in.GetEnabled()
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if !in.GetEnabled() && r.metered != nil {
+		go r.metered.RunDeferred()
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
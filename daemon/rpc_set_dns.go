@@ -2,16 +2,20 @@ package daemon
 
 import (
 	"context"
+	"errors"
 	"log"
-	"net"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/dns"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/events"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	"golang.org/x/exp/slices"
 )
 
+// maxNameservers is the number of DNS servers which can be set
+const maxNameservers = 3
+
 func (r *RPC) SetDNS(ctx context.Context, in *pb.SetDNSRequest) (*pb.SetDNSResponse, error) {
 	var cfg config.Config
 	if err := r.cm.Load(&cfg); err != nil {
@@ -20,7 +24,7 @@ func (r *RPC) SetDNS(ctx context.Context, in *pb.SetDNSRequest) (*pb.SetDNSRespo
 
 	nameservers := in.GetDns()
 
-	if len(nameservers) > 3 {
+	if len(nameservers) > maxNameservers {
 		return &pb.SetDNSResponse{
 			Response: &pb.SetDNSResponse_SetDnsStatus{SetDnsStatus: pb.SetDNSStatus_TOO_MANY_VALUES},
 		}, nil
@@ -34,12 +38,10 @@ func (r *RPC) SetDNS(ctx context.Context, in *pb.SetDNSRequest) (*pb.SetDNSRespo
 		}, nil
 	}
 
-	for _, address := range nameservers {
-		if parsedAddress := net.ParseIP(address); parsedAddress == nil {
-			return &pb.SetDNSResponse{
-				Response: &pb.SetDNSResponse_SetDnsStatus{SetDnsStatus: pb.SetDNSStatus_INVALID_DNS_ADDRESS},
-			}, nil
-		}
+	if status, ok := validateNameservers(nameservers); !ok {
+		return &pb.SetDNSResponse{
+			Response: &pb.SetDNSResponse_SetDnsStatus{SetDnsStatus: status},
+		}, nil
 	}
 
 	newThreatProtectionLiteStatus := cfg.AutoConnectData.ThreatProtectionLite
@@ -81,3 +83,16 @@ func (r *RPC) SetDNS(ctx context.Context, in *pb.SetDNSRequest) (*pb.SetDNSRespo
 	return &pb.SetDNSResponse{
 		Response: &pb.SetDNSResponse_SetDnsStatus{SetDnsStatus: pb.SetDNSStatus_DNS_CONFIGURED}}, nil
 }
+
+// validateNameservers checks that nameservers are IP addresses or DNS-over-TLS/HTTPS upstreams with valid hostnames
+func validateNameservers(nameservers []string) (pb.SetDNSStatus, bool) {
+	for _, nameserver := range nameservers {
+		if _, err := dns.ParseUpstream(nameserver); err != nil {
+			if errors.Is(err, dns.ErrInvalidHostname) {
+				return pb.SetDNSStatus_INVALID_DNS_HOSTNAME, false
+			}
+			return pb.SetDNSStatus_INVALID_DNS_ADDRESS, false
+		}
+	}
+	return pb.SetDNSStatus_DNS_CONFIGURED, true
+}
@@ -75,6 +75,12 @@ func TestSetDNS_Success(t *testing.T) {
 			expectedDNS:         currentDNSMock,
 			expectedDNSInConfig: currentDNSMock,
 		},
+		{
+			name:                "set encrypted DNS with fallback",
+			requestedDNS:        config.DNS{"9.9.9.9", "https://dns.quad9.net/dns-query", "tls://1.1.1.1#cloudflare-dns.com"},
+			expectedDNS:         config.DNS{"9.9.9.9", "https://dns.quad9.net/dns-query", "tls://1.1.1.1#cloudflare-dns.com"},
+			expectedDNSInConfig: config.DNS{"9.9.9.9", "https://dns.quad9.net/dns-query", "tls://1.1.1.1#cloudflare-dns.com"},
+		},
 		{
 			name:                "set new DNS ipv6",
 			requestedDNS:        dnsV6Mock,
@@ -221,6 +227,20 @@ func TestSetDNS_Errors(t *testing.T) {
 				Response: &pb.SetDNSResponse_SetDnsStatus{SetDnsStatus: pb.SetDNSStatus_INVALID_DNS_ADDRESS},
 			},
 		},
+		{
+			name:         "unsupported scheme",
+			requestedDNS: config.DNS{"quic://dns.adguard.com"},
+			expectedResponse: &pb.SetDNSResponse{
+				Response: &pb.SetDNSResponse_SetDnsStatus{SetDnsStatus: pb.SetDNSStatus_INVALID_DNS_ADDRESS},
+			},
+		},
+		{
+			name:         "invalid hostname",
+			requestedDNS: config.DNS{"tls://dns_quad9.net"},
+			expectedResponse: &pb.SetDNSResponse{
+				Response: &pb.SetDNSResponse_SetDnsStatus{SetDnsStatus: pb.SetDNSStatus_INVALID_DNS_HOSTNAME},
+			},
+		},
 		{
 			name:         "network error",
 			requestedDNS: dnsMock,
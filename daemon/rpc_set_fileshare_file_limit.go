@@ -0,0 +1,56 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetFileshareFileLimit sets the maximum number of files in a single fileshare transfer, 0 restores the default
+func (r *RPC) SetFileshareFileLimit(ctx context.Context, in *pb.SetUint32Request) (*pb.Payload, error) {
+	limit := in.GetValue()
+	if config.ValidateFileshareFileLimit(limit) != nil {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if cfg.Meshnet.FileshareFileLimit == limit {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.Meshnet.FileshareFileLimit = limit
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	// fileshare reads the limit on start
+	if cfg.Mesh {
+		r.restartFileshare(cfg.Meshnet.EnabledByUID)
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
+
+func (r *RPC) restartFileshare(uid uint32) {
+	if r.norduserClient == nil {
+		return
+	}
+	if err := r.norduserClient.StopFileshare(uid); err != nil {
+		log.Println(internal.WarningPrefix, "failed to stop fileshare:", err)
+		return
+	}
+	if err := r.norduserClient.StartFileshare(uid); err != nil {
+		log.Println(internal.WarningPrefix, "failed to start fileshare:", err)
+	}
+}
@@ -0,0 +1,105 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFileshareRestartClient struct {
+	stopped []uint32
+	started []uint32
+}
+
+func (m *mockFileshareRestartClient) StartFileshare(uid uint32) error {
+	m.started = append(m.started, uid)
+	return nil
+}
+
+func (m *mockFileshareRestartClient) StopFileshare(uid uint32) error {
+	m.stopped = append(m.stopped, uid)
+	return nil
+}
+
+func (m *mockFileshareRestartClient) SetLogLevel(uint32, internal.LogLevel) error { return nil }
+
+func TestSetFileshareFileLimit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	const meshnetUID = 1000
+
+	tests := []struct {
+		name          string
+		current       uint32
+		meshnet       bool
+		limit         uint32
+		expectedCode  int64
+		expectedLimit uint32
+		restarted     bool
+	}{
+		{
+			name:          "limit is set",
+			limit:         5000,
+			expectedCode:  internal.CodeSuccess,
+			expectedLimit: 5000,
+		},
+		{
+			name:          "fileshare is restarted",
+			meshnet:       true,
+			limit:         5000,
+			expectedCode:  internal.CodeSuccess,
+			expectedLimit: 5000,
+			restarted:     true,
+		},
+		{
+			name:         "limit is reset",
+			current:      5000,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:          "limit is already set",
+			current:       5000,
+			meshnet:       true,
+			limit:         5000,
+			expectedCode:  internal.CodeNothingToDo,
+			expectedLimit: 5000,
+		},
+		{
+			name:          "limit is too high",
+			current:       5000,
+			limit:         config.MaxFileshareFileLimit + 1,
+			expectedCode:  internal.CodeFormatError,
+			expectedLimit: 5000,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Mesh = test.meshnet
+			cm.Cfg.Meshnet.EnabledByUID = meshnetUID
+			cm.Cfg.Meshnet.FileshareFileLimit = test.current
+			client := &mockFileshareRestartClient{}
+			r := RPC{cm: cm, norduserClient: client}
+
+			resp, err := r.SetFileshareFileLimit(context.Background(), &pb.SetUint32Request{Value: test.limit})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedLimit, cm.Cfg.Meshnet.FileshareFileLimit)
+			if test.restarted {
+				assert.Equal(t, []uint32{meshnetUID}, client.stopped)
+				assert.Equal(t, []uint32{meshnetUID}, client.started)
+			} else {
+				assert.Empty(t, client.stopped)
+				assert.Empty(t, client.started)
+			}
+		})
+	}
+}
@@ -53,7 +53,12 @@ func (r *RPC) SetFirewall(ctx context.Context, in *pb.SetGenericRequest) (*pb.Pa
 	return &pb.Payload{Type: internal.CodeSuccess}, nil
 }
 
+// SetFirewallMark sets the mark of the packets sent by the daemon, it is used after the daemon restart
 func (r *RPC) SetFirewallMark(ctx context.Context, in *pb.SetUint32Request) (*pb.Payload, error) {
+	if err := config.ValidateFirewallMark(in.GetValue()); err != nil {
+		return &pb.Payload{Type: internal.CodeFormatError, Data: []string{err.Error()}}, nil
+	}
+
 	var cfg config.Config
 	if err := r.cm.Load(&cfg); err != nil {
 		log.Println(internal.ErrorPrefix, err)
@@ -0,0 +1,41 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"slices"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetHealthMonitor sets the sensitivity of the tunnel health checks
+func (r *RPC) SetHealthMonitor(ctx context.Context, in *pb.SetStringRequest) (*pb.Payload, error) {
+	sensitivity := config.HealthSensitivity(in.GetValue())
+	if !slices.Contains(config.HealthSensitivities, sensitivity) {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if cfg.HealthMonitorOrDefault() == sensitivity {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.HealthMonitor = sensitivity
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+	// failed checks counted with the previous sensitivity are forgotten
+	r.healthMonitor.reset()
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
@@ -0,0 +1,66 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetHealthMonitor(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      config.HealthSensitivity
+		value        string
+		expectedCode int64
+		expected     config.HealthSensitivity
+	}{
+		{
+			name:         "sensitivity is set",
+			value:        "high",
+			expectedCode: internal.CodeSuccess,
+			expected:     config.HealthMonitorHigh,
+		},
+		{
+			name:         "monitor is turned off",
+			current:      config.HealthMonitorLow,
+			value:        "off",
+			expectedCode: internal.CodeSuccess,
+			expected:     config.HealthMonitorOff,
+		},
+		{
+			name:         "default sensitivity is already set",
+			value:        "normal",
+			expectedCode: internal.CodeNothingToDo,
+		},
+		{
+			name:         "unknown sensitivity",
+			current:      config.HealthMonitorLow,
+			value:        "max",
+			expectedCode: internal.CodeFormatError,
+			expected:     config.HealthMonitorLow,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.HealthMonitor = test.current
+			r := RPC{cm: cm, healthMonitor: newHealthMonitor(time.Now)}
+
+			resp, err := r.SetHealthMonitor(context.Background(), &pb.SetStringRequest{Value: test.value})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.HealthMonitor)
+		})
+	}
+}
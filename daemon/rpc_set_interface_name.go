@@ -0,0 +1,61 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"net"
+	"slices"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordlynx"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordwhisper"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetInterfaceName sets the name of the tunnel interface, empty name resets it to the default name of the technology
+func (r *RPC) SetInterfaceName(ctx context.Context, in *pb.SetStringRequest) (*pb.Payload, error) {
+	name := in.GetValue()
+	if err := config.ValidateInterfaceName(name); err != nil {
+		return &pb.Payload{Type: internal.CodeFormatError, Data: []string{err.Error()}}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if cfg.InterfaceName == name {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if interfaceNameConflicts(name) {
+		return &pb.Payload{Type: internal.CodeConflict, Data: []string{name}}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.InterfaceName = name
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{
+		Type: internal.CodeSuccess,
+		Data: []string{strconv.FormatBool(r.netw.IsVPNActive())},
+	}, nil
+}
+
+// interfaceNameConflicts reports whether the interface with the given name exists and is not one of the
+// daemon's own. The tunnel would fail to start, or an interface it does not own would be removed when it stops.
+func interfaceNameConflicts(name string) bool {
+	ownInterfaces := []string{nordlynx.InterfaceName, nordwhisper.InterfaceName, openvpnInterfaceName}
+	if name == "" || slices.Contains(ownInterfaces, name) {
+		return false
+	}
+	_, err := net.InterfaceByName(name)
+	return err == nil
+}
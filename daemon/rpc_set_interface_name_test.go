@@ -0,0 +1,90 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetInterfaceName(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      string
+		vpnActive    bool
+		iface        string
+		expectedCode int64
+		expectedData []string
+		expected     string
+	}{
+		{
+			name:         "name is set",
+			iface:        "vpn0",
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"false"},
+			expected:     "vpn0",
+		},
+		{
+			name:         "reconnect is needed",
+			vpnActive:    true,
+			iface:        "vpn0",
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"true"},
+			expected:     "vpn0",
+		},
+		{
+			name:         "name is reset",
+			current:      "vpn0",
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"false"},
+		},
+		{
+			name:         "default name of other technology",
+			iface:        "nordlynx",
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"false"},
+			expected:     "nordlynx",
+		},
+		{
+			name:         "name is already set",
+			current:      "vpn0",
+			iface:        "vpn0",
+			expectedCode: internal.CodeNothingToDo,
+			expected:     "vpn0",
+		},
+		{
+			name:         "invalid name",
+			iface:        "vpn/0",
+			expectedCode: internal.CodeFormatError,
+			expectedData: []string{"interface name must be 1 to 15 characters long without spaces, '/' or ':'"},
+		},
+		{
+			name:         "interface exists",
+			iface:        "lo",
+			expectedCode: internal.CodeConflict,
+			expectedData: []string{"lo"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.InterfaceName = test.current
+			r := RPC{cm: cm, netw: &networker.Mock{VpnActive: test.vpnActive}}
+
+			resp, err := r.SetInterfaceName(context.Background(), &pb.SetStringRequest{Value: test.iface})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedData, resp.Data)
+			assert.Equal(t, test.expected, cm.Cfg.InterfaceName)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/norduser/service"
+)
+
+// SetLogLevel sets the log level of norduserd and fileshare for all users. Running norduserd instances are
+// updated right away, fileshare picks up the change when it is started next time.
+func (r *RPC) SetLogLevel(ctx context.Context, in *pb.SetLogLevelRequest) (*pb.Payload, error) {
+	level, err := internal.ParseLogLevel(in.GetLevel())
+	if err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if internal.LogLevelOrDefault(cfg.LogLevel) == level {
+		return &pb.Payload{Type: internal.CodeNothingToDo, Data: []string{string(level)}}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.LogLevel = level
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	r.propagateLogLevel(level)
+
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{string(level)}}, nil
+}
+
+func (r *RPC) propagateLogLevel(level internal.LogLevel) {
+	if r.norduserClient == nil {
+		return
+	}
+
+	uids, err := service.RunningUIDs()
+	if err != nil {
+		log.Println(internal.WarningPrefix, "failed to list norduserd instances:", err)
+		return
+	}
+	for _, uid := range uids {
+		if err := r.norduserClient.SetLogLevel(uid, level); err != nil {
+			log.Println(internal.WarningPrefix, "failed to set log level of norduserd for user", uid, ":", err)
+		}
+	}
+}
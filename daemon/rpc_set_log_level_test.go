@@ -0,0 +1,79 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetLogLevel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      internal.LogLevel
+		level        string
+		expectedType int64
+		expectedCfg  internal.LogLevel
+	}{
+		{
+			name:         "debug",
+			level:        "debug",
+			expectedType: internal.CodeSuccess,
+			expectedCfg:  internal.LogLevelDebug,
+		},
+		{
+			name:         "case insensitive",
+			current:      internal.LogLevelDebug,
+			level:        "ERROR",
+			expectedType: internal.CodeSuccess,
+			expectedCfg:  internal.LogLevelError,
+		},
+		{
+			name:         "already set",
+			current:      internal.LogLevelWarning,
+			level:        "warning",
+			expectedType: internal.CodeNothingToDo,
+			expectedCfg:  internal.LogLevelWarning,
+		},
+		{
+			name:         "default is already used",
+			level:        "info",
+			expectedType: internal.CodeNothingToDo,
+		},
+		{
+			name:         "unknown level",
+			current:      internal.LogLevelDebug,
+			level:        "verbose",
+			expectedType: internal.CodeFormatError,
+			expectedCfg:  internal.LogLevelDebug,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.LogLevel = test.current
+			r := RPC{cm: cm}
+
+			resp, err := r.SetLogLevel(context.Background(), &pb.SetLogLevelRequest{Level: test.level})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedType, resp.Type)
+			assert.Equal(t, test.expectedCfg, cm.Cfg.LogLevel)
+		})
+	}
+
+	t.Run("config error", func(t *testing.T) {
+		r := RPC{cm: failingConfigManager{}}
+
+		resp, err := r.SetLogLevel(context.Background(), &pb.SetLogLevelRequest{Level: "debug"})
+		assert.NoError(t, err)
+		assert.Equal(t, internal.CodeConfigError, resp.Type)
+	})
+}
@@ -0,0 +1,39 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetMaxServerLoad sets the highest load of the recommended servers, zero removes the limit. More distant servers
+// are recommended when the nearby ones are busier.
+func (r *RPC) SetMaxServerLoad(ctx context.Context, in *pb.SetUint32Request) (*pb.Payload, error) {
+	if in.GetValue() > config.MaxServerLoadLimit {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+	maxLoad := int64(in.GetValue())
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if cfg.MaxServerLoad == maxLoad {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.MaxServerLoad = maxLoad
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
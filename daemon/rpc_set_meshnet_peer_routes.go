@@ -0,0 +1,70 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"net/netip"
+	"slices"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetMeshnetPeerRoutes routes the subnets through the meshnet peer while the rest of the traffic goes through the
+// VPN server, empty subnets stop routing through the peer. NordLynx VPN runs on its own interface meanwhile, so
+// moving it requires a reconnect.
+func (r *RPC) SetMeshnetPeerRoutes(ctx context.Context, in *pb.SetMeshnetPeerRoutesRequest) (*pb.Payload, error) {
+	var routes config.PeerRoutes
+	for _, value := range in.GetSubnets() {
+		subnet, err := netip.ParsePrefix(value)
+		if err != nil {
+			return &pb.Payload{Type: internal.CodeFormatError, Data: []string{err.Error()}}, nil
+		}
+		routes = append(routes, config.PeerRoute{PublicKey: in.GetPublicKey(), Subnet: subnet.Masked()})
+	}
+	if err := routes.Validate(); err != nil {
+		return &pb.Payload{Type: internal.CodeFormatError, Data: []string{err.Error()}}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if slices.Equal(cfg.MeshnetPeerRoutes, routes) {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.MeshnetPeerRoutes = routes
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	// factory picks the implementation by the saved routes
+	moved := cfg.Technology == config.Technology_NORDLYNX &&
+		cfg.SeparateTunnels() != (len(routes) > 0)
+	if moved {
+		v, err := r.factory(cfg.Technology)
+		if err != nil {
+			log.Println(internal.ErrorPrefix, err)
+			return &pb.Payload{Type: internal.CodeConfigError}, nil
+		}
+		r.netw.SetVPN(v)
+	}
+
+	if err := r.netw.SetPeerRoutes(routes.Peer(), routes.Subnets()); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeFailure}, nil
+	}
+
+	return &pb.Payload{
+		Type: internal.CodeSuccess,
+		Data: []string{strconv.FormatBool(moved && r.netw.IsVPNActive())},
+	}, nil
+}
@@ -0,0 +1,120 @@
+package daemon
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetMeshnetPeerRoutes(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	lan := netip.MustParsePrefix("192.168.1.0/24")
+	current := config.PeerRoutes{{PublicKey: "peer", Subnet: lan}}
+	tests := []struct {
+		name           string
+		current        config.PeerRoutes
+		technology     config.Technology
+		vpnActive      bool
+		publicKey      string
+		subnets        []string
+		expectedCode   int64
+		expectedData   []string
+		expectedRoutes config.PeerRoutes
+	}{
+		{
+			name:           "routes are set",
+			technology:     config.Technology_NORDLYNX,
+			publicKey:      "peer",
+			subnets:        []string{"192.168.1.1/24"},
+			expectedCode:   internal.CodeSuccess,
+			expectedData:   []string{"false"},
+			expectedRoutes: current,
+		},
+		{
+			name:           "nordlynx is moved to its own tunnel",
+			technology:     config.Technology_NORDLYNX,
+			vpnActive:      true,
+			publicKey:      "peer",
+			subnets:        []string{"192.168.1.0/24"},
+			expectedCode:   internal.CodeSuccess,
+			expectedData:   []string{"true"},
+			expectedRoutes: current,
+		},
+		{
+			name:           "openvpn is not moved",
+			technology:     config.Technology_OPENVPN,
+			vpnActive:      true,
+			publicKey:      "peer",
+			subnets:        []string{"192.168.1.0/24"},
+			expectedCode:   internal.CodeSuccess,
+			expectedData:   []string{"false"},
+			expectedRoutes: current,
+		},
+		{
+			name:         "routes are cleared",
+			current:      current,
+			technology:   config.Technology_NORDLYNX,
+			vpnActive:    true,
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"true"},
+		},
+		{
+			name:           "routes are already set",
+			current:        current,
+			technology:     config.Technology_NORDLYNX,
+			publicKey:      "peer",
+			subnets:        []string{"192.168.1.0/24"},
+			expectedCode:   internal.CodeNothingToDo,
+			expectedRoutes: current,
+		},
+		{
+			name:         "invalid subnet",
+			technology:   config.Technology_NORDLYNX,
+			publicKey:    "peer",
+			subnets:      []string{"192.168.1.0"},
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "meshnet subnet",
+			technology:   config.Technology_NORDLYNX,
+			publicKey:    "peer",
+			subnets:      []string{"100.64.0.0/16"},
+			expectedCode: internal.CodeFormatError,
+			expectedData: []string{config.ErrPeerRouteSubnet.Error()},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Technology = test.technology
+			cm.Cfg.MeshnetPeerRoutes = test.current
+			netw := &networker.Mock{VpnActive: test.vpnActive}
+			r := RPC{cm: cm, netw: netw, factory: factoryWithout(config.Technology_UNKNOWN_TECHNOLOGY)}
+
+			resp, err := r.SetMeshnetPeerRoutes(context.Background(), &pb.SetMeshnetPeerRoutesRequest{
+				PublicKey: test.publicKey,
+				Subnets:   test.subnets,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			if test.expectedCode != internal.CodeFormatError || test.expectedData != nil {
+				assert.Equal(t, test.expectedData, resp.Data)
+			}
+			assert.Equal(t, test.expectedRoutes, cm.Cfg.MeshnetPeerRoutes)
+			if test.expectedCode == internal.CodeSuccess {
+				assert.Equal(t, test.expectedRoutes.Subnets(), netw.PeerRoutes)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetMTU(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		currentMTU   uint32
+		vpnActive    bool
+		mtu          uint32
+		expectedCode int64
+		expectedData []string
+		expectedMTU  uint32
+	}{
+		{
+			name:         "MTU is set",
+			mtu:          1380,
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"false"},
+			expectedMTU:  1380,
+		},
+		{
+			name:         "reconnect is needed",
+			vpnActive:    true,
+			mtu:          1380,
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"true"},
+			expectedMTU:  1380,
+		},
+		{
+			name:         "MTU is detected again",
+			currentMTU:   1380,
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"false"},
+		},
+		{
+			name:         "MTU is already set",
+			currentMTU:   1380,
+			mtu:          1380,
+			expectedCode: internal.CodeNothingToDo,
+			expectedMTU:  1380,
+		},
+		{
+			name:         "MTU too low",
+			mtu:          config.MinMTU - 1,
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "MTU too high",
+			currentMTU:   1380,
+			mtu:          9000,
+			expectedCode: internal.CodeFormatError,
+			expectedMTU:  1380,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.MTU = test.currentMTU
+			r := RPC{cm: cm, netw: &networker.Mock{VpnActive: test.vpnActive}}
+
+			resp, err := r.SetMTU(context.Background(), &pb.SetUint32Request{Value: test.mtu})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedData, resp.Data)
+			assert.Equal(t, test.expectedMTU, cm.Cfg.MTU)
+		})
+	}
+}
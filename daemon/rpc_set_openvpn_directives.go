@@ -0,0 +1,47 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"slices"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetOpenVPNDirectives replaces the directives appended to the OpenVPN config. The reason is returned for the
+// rejected directives.
+func (r *RPC) SetOpenVPNDirectives(ctx context.Context, in *pb.SetOpenVPNDirectivesRequest) (*pb.Payload, error) {
+	if err := config.ValidateOpenVPNDirectives(in.GetDirectives()); err != nil {
+		return &pb.Payload{Type: internal.CodeFormatError, Data: []string{err.Error()}}, nil
+	}
+	var directives []string
+	for _, directive := range in.GetDirectives() {
+		directives = append(directives, config.NormalizeOpenVPNDirective(directive))
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if slices.Equal(cfg.OpenVPNDirectives, directives) {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.OpenVPNDirectives = directives
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{
+		Type: internal.CodeSuccess,
+		Data: []string{strconv.FormatBool(r.netw.IsVPNActive() && cfg.Technology == config.Technology_OPENVPN)},
+	}, nil
+}
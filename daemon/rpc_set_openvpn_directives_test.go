@@ -0,0 +1,68 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetOpenVPNDirectives(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      []string
+		directives   []string
+		expectedCode int64
+		expected     []string
+	}{
+		{
+			name:         "directives are set",
+			directives:   []string{"--sndbuf  524288", "verb 4"},
+			expectedCode: internal.CodeSuccess,
+			expected:     []string{"sndbuf 524288", "verb 4"},
+		},
+		{
+			name:         "directives are removed",
+			current:      []string{"verb 4"},
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "directives are already set",
+			current:      []string{"verb 4"},
+			directives:   []string{"verb 4"},
+			expectedCode: internal.CodeNothingToDo,
+			expected:     []string{"verb 4"},
+		},
+		{
+			name:         "unsafe directive",
+			current:      []string{"verb 4"},
+			directives:   []string{"verb 4", "up /tmp/script.sh"},
+			expectedCode: internal.CodeFormatError,
+			expected:     []string{"verb 4"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Technology = config.Technology_OPENVPN
+			cm.Cfg.OpenVPNDirectives = test.current
+			r := RPC{cm: cm, netw: &networker.Mock{}}
+
+			resp, err := r.SetOpenVPNDirectives(context.Background(),
+				&pb.SetOpenVPNDirectivesRequest{Directives: test.directives})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.OpenVPNDirectives)
+		})
+	}
+}
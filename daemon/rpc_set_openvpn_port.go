@@ -0,0 +1,42 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"math"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetOpenVPNPort sets the port OpenVPN connects to, zero resets it to the default port of the protocol
+func (r *RPC) SetOpenVPNPort(ctx context.Context, in *pb.SetUint32Request) (*pb.Payload, error) {
+	if in.GetValue() > math.MaxUint16 {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+	port := uint16(in.GetValue())
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	if cfg.AutoConnectData.OpenVPNPort == port {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.AutoConnectData.OpenVPNPort = port
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{
+		Type: internal.CodeSuccess,
+		Data: []string{strconv.FormatBool(r.netw.IsVPNActive() && cfg.Technology == config.Technology_OPENVPN)},
+	}, nil
+}
@@ -0,0 +1,93 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetOpenVPNPort(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		currentPort  uint16
+		technology   config.Technology
+		vpnActive    bool
+		port         uint32
+		expectedCode int64
+		expectedData []string
+		expectedPort uint16
+	}{
+		{
+			name:         "port is set",
+			technology:   config.Technology_OPENVPN,
+			port:         443,
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"false"},
+			expectedPort: 443,
+		},
+		{
+			name:         "reconnect is needed",
+			technology:   config.Technology_OPENVPN,
+			vpnActive:    true,
+			port:         443,
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"true"},
+			expectedPort: 443,
+		},
+		{
+			name:         "reconnect is not needed for nordlynx",
+			technology:   config.Technology_NORDLYNX,
+			vpnActive:    true,
+			port:         443,
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"false"},
+			expectedPort: 443,
+		},
+		{
+			name:         "port is reset",
+			currentPort:  443,
+			technology:   config.Technology_OPENVPN,
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"false"},
+		},
+		{
+			name:         "port is already set",
+			currentPort:  443,
+			technology:   config.Technology_OPENVPN,
+			port:         443,
+			expectedCode: internal.CodeNothingToDo,
+			expectedPort: 443,
+		},
+		{
+			name:         "port out of range",
+			technology:   config.Technology_OPENVPN,
+			port:         70000,
+			expectedCode: internal.CodeFormatError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Technology = test.technology
+			cm.Cfg.AutoConnectData.OpenVPNPort = test.currentPort
+			r := RPC{cm: cm, netw: &networker.Mock{VpnActive: test.vpnActive}}
+
+			resp, err := r.SetOpenVPNPort(context.Background(), &pb.SetUint32Request{Value: test.port})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedData, resp.Data)
+			assert.Equal(t, test.expectedPort, cm.Cfg.AutoConnectData.OpenVPNPort)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"math"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetPersistentKeepalive sets the interval of the NordLynx keepalive packets in seconds, zero resets it to the
+// default interval
+func (r *RPC) SetPersistentKeepalive(ctx context.Context, in *pb.SetUint32Request) (*pb.Payload, error) {
+	if in.GetValue() > math.MaxUint16 {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+	interval := uint16(in.GetValue())
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+	}
+
+	if cfg.PersistentKeepalive == interval {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.PersistentKeepalive = interval
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{
+		Type: internal.CodeSuccess,
+		Data: []string{strconv.FormatBool(r.netw.IsVPNActive() && cfg.Technology == config.Technology_NORDLYNX)},
+	}, nil
+}
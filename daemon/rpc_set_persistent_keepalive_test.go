@@ -0,0 +1,93 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetPersistentKeepalive(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name             string
+		currentInterval  uint16
+		technology       config.Technology
+		vpnActive        bool
+		interval         uint32
+		expectedCode     int64
+		expectedData     []string
+		expectedInterval uint16
+	}{
+		{
+			name:             "interval is set",
+			technology:       config.Technology_NORDLYNX,
+			interval:         10,
+			expectedCode:     internal.CodeSuccess,
+			expectedData:     []string{"false"},
+			expectedInterval: 10,
+		},
+		{
+			name:             "reconnect is needed",
+			technology:       config.Technology_NORDLYNX,
+			vpnActive:        true,
+			interval:         10,
+			expectedCode:     internal.CodeSuccess,
+			expectedData:     []string{"true"},
+			expectedInterval: 10,
+		},
+		{
+			name:             "reconnect is not needed for openvpn",
+			technology:       config.Technology_OPENVPN,
+			vpnActive:        true,
+			interval:         10,
+			expectedCode:     internal.CodeSuccess,
+			expectedData:     []string{"false"},
+			expectedInterval: 10,
+		},
+		{
+			name:            "interval is reset",
+			currentInterval: 10,
+			technology:      config.Technology_NORDLYNX,
+			expectedCode:    internal.CodeSuccess,
+			expectedData:    []string{"false"},
+		},
+		{
+			name:             "interval is already set",
+			currentInterval:  10,
+			technology:       config.Technology_NORDLYNX,
+			interval:         10,
+			expectedCode:     internal.CodeNothingToDo,
+			expectedInterval: 10,
+		},
+		{
+			name:         "interval out of range",
+			technology:   config.Technology_NORDLYNX,
+			interval:     70000,
+			expectedCode: internal.CodeFormatError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Technology = test.technology
+			cm.Cfg.PersistentKeepalive = test.currentInterval
+			r := RPC{cm: cm, netw: &networker.Mock{VpnActive: test.vpnActive}}
+
+			resp, err := r.SetPersistentKeepalive(context.Background(), &pb.SetUint32Request{Value: test.interval})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedData, resp.Data)
+			assert.Equal(t, test.expectedInterval, cm.Cfg.PersistentKeepalive)
+		})
+	}
+}
@@ -23,7 +23,8 @@ func (r *RPC) SetPostQuantum(ctx context.Context, in *pb.SetGenericRequest) (*pb
 		return &pb.Payload{Type: internal.CodePqAndMeshnetSimultaneously}, nil
 	}
 
-	if cfg.Technology != config.Technology_NORDLYNX {
+	// automatic technology can fall back to OpenVPN
+	if cfg.Technology != config.Technology_NORDLYNX || (cfg.AutoTechnology && in.GetEnabled()) {
 		return &pb.Payload{Type: internal.CodePqWithoutNordlynx}, nil
 	}
 
@@ -0,0 +1,39 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetRouteMetric sets the metric of the VPN default routes, zero resets it to the kernel default. The lower metric
+// wins, so the VPN routes can be made to lose or win against the routes of other routing daemons.
+func (r *RPC) SetRouteMetric(ctx context.Context, in *pb.SetUint32Request) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if cfg.RouteMetric == in.GetValue() {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.RouteMetric = in.GetValue()
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+	r.netw.SetRouteMetric(in.GetValue())
+
+	return &pb.Payload{
+		Type: internal.CodeSuccess,
+		Data: []string{strconv.FormatBool(r.netw.IsVPNActive())},
+	}, nil
+}
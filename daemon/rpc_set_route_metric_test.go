@@ -0,0 +1,73 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetRouteMetric(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name           string
+		currentMetric  uint32
+		vpnActive      bool
+		metric         uint32
+		expectedCode   int64
+		expectedData   []string
+		expectedMetric uint32
+	}{
+		{
+			name:           "metric is set",
+			metric:         50,
+			expectedCode:   internal.CodeSuccess,
+			expectedData:   []string{"false"},
+			expectedMetric: 50,
+		},
+		{
+			name:           "reconnect is needed",
+			vpnActive:      true,
+			metric:         50,
+			expectedCode:   internal.CodeSuccess,
+			expectedData:   []string{"true"},
+			expectedMetric: 50,
+		},
+		{
+			name:          "metric is reset",
+			currentMetric: 50,
+			expectedCode:  internal.CodeSuccess,
+			expectedData:  []string{"false"},
+		},
+		{
+			name:           "metric is already set",
+			currentMetric:  50,
+			metric:         50,
+			expectedCode:   internal.CodeNothingToDo,
+			expectedMetric: 50,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.RouteMetric = test.currentMetric
+			netw := &networker.Mock{VpnActive: test.vpnActive, RouteMetric: test.currentMetric}
+			r := RPC{cm: cm, netw: netw}
+
+			resp, err := r.SetRouteMetric(context.Background(), &pb.SetUint32Request{Value: test.metric})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedData, resp.Data)
+			assert.Equal(t, test.expectedMetric, cm.Cfg.RouteMetric)
+			assert.Equal(t, test.expectedMetric, netw.RouteMetric)
+		})
+	}
+}
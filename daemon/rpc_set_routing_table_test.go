@@ -0,0 +1,81 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetRoutingTable(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      uint32
+		table        uint32
+		expectedCode int64
+		expected     uint32
+	}{
+		{
+			name:         "table is set",
+			table:        52,
+			expectedCode: internal.CodeSuccess,
+			expected:     52,
+		},
+		{
+			name:         "table is reset",
+			current:      52,
+			expectedCode: internal.CodeSuccess,
+		},
+		{
+			name:         "table is already set",
+			current:      52,
+			table:        52,
+			expectedCode: internal.CodeNothingToDo,
+			expected:     52,
+		},
+		{
+			name:         "reserved table",
+			table:        254,
+			expectedCode: internal.CodeFormatError,
+		},
+		{
+			name:         "table out of range",
+			table:        config.MaxRoutingTable + 1,
+			expectedCode: internal.CodeFormatError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.RoutingTable = test.current
+			r := RPC{cm: cm}
+
+			resp, err := r.SetRoutingTable(context.Background(), &pb.SetUint32Request{Value: test.table})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expected, cm.Cfg.RoutingTable)
+		})
+	}
+}
+
+func TestSetFirewallMark_Zero(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.FirewallMark = 0xe1f1
+	r := RPC{cm: cm}
+
+	resp, err := r.SetFirewallMark(context.Background(), &pb.SetUint32Request{Value: 0})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeFormatError, resp.Type)
+	assert.Equal(t, uint32(0xe1f1), cm.Cfg.FirewallMark)
+}
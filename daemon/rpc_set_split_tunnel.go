@@ -0,0 +1,167 @@
+package daemon
+
+import (
+	"context"
+	"errors"
+	"log"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/splittunnel"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetSplitTunnelApp adds the app to the split tunnel or removes it
+func (r *RPC) SetSplitTunnelApp(ctx context.Context, in *pb.SetSplitTunnelAppRequest) (*pb.Payload, error) {
+	app, ok := normalizeSplitTunnelApp(in.GetApp())
+	if !ok {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if cfg.SplitTunnel.Apps[app] != in.GetRemove() {
+		return &pb.Payload{Type: internal.CodeNothingToDo, Data: []string{app}}, nil
+	}
+
+	splitTunnel := cfg.SplitTunnel.WithApp(app, in.GetRemove())
+	if code := r.applySplitTunnel(splitTunnel, cfg.FirewallMark); code != internal.CodeSuccess {
+		return &pb.Payload{Type: code}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.SplitTunnel = splitTunnel
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{app}}, nil
+}
+
+// SetSplitTunnelMode sets whether the split tunnel apps bypass VPN or only they use it
+func (r *RPC) SetSplitTunnelMode(ctx context.Context, in *pb.SetSplitTunnelModeRequest) (*pb.Payload, error) {
+	mode := config.SplitTunnelMode(in.GetMode())
+	if !slices.Contains(config.SplitTunnelModes, mode) {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if cfg.SplitTunnel.ModeOrDefault() == mode {
+		return &pb.Payload{Type: internal.CodeNothingToDo, Data: []string{string(mode)}}, nil
+	}
+
+	splitTunnel := cfg.SplitTunnel
+	splitTunnel.Mode = mode
+	if code := r.applySplitTunnel(splitTunnel, cfg.FirewallMark); code != internal.CodeSuccess {
+		return &pb.Payload{Type: code}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.SplitTunnel.Mode = mode
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{string(mode)}}, nil
+}
+
+// SplitTunnelApps returns the split tunnel apps and whether they are running
+func (r *RPC) SplitTunnelApps(context.Context, *pb.Empty) (*pb.SplitTunnel, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return nil, internal.ErrUnhandled
+	}
+
+	running := map[string]bool{}
+	if r.splitTunnel != nil {
+		for _, app := range r.splitTunnel.Status().Apps {
+			running[app.Name] = app.Running
+		}
+	}
+
+	splitTunnel := &pb.SplitTunnel{Mode: string(cfg.SplitTunnel.ModeOrDefault())}
+	for _, app := range cfg.SplitTunnel.SortedApps() {
+		splitTunnel.Apps = append(splitTunnel.Apps, &pb.SplitTunnelApp{Name: app, Running: running[app]})
+	}
+	return splitTunnel, nil
+}
+
+// ApplySplitTunnel routes the split tunnel apps according to the config
+func (r *RPC) ApplySplitTunnel() error {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		return err
+	}
+	if r.splitTunnel == nil {
+		return nil
+	}
+	return r.splitTunnel.Apply(cfg.SplitTunnel.ModeOrDefault(), cfg.SplitTunnel.SortedApps(), cfg.FirewallMark)
+}
+
+// applySplitTunnel returns the response code for the result of routing the split tunnel apps
+func (r *RPC) applySplitTunnel(splitTunnel config.SplitTunnel, fwmark uint32) int64 {
+	if r.splitTunnel == nil {
+		return internal.CodeSuccess
+	}
+
+	err := r.splitTunnel.Apply(splitTunnel.ModeOrDefault(), splitTunnel.SortedApps(), fwmark)
+	switch {
+	case err == nil:
+		return internal.CodeSuccess
+	case errors.Is(err, splittunnel.ErrNotSupported):
+		return internal.CodeSplitTunnelNotSupported
+	default:
+		log.Println(internal.ErrorPrefix, "applying split tunnel:", err)
+		return internal.CodeFailure
+	}
+}
+
+// normalizeSplitTunnelApp accepts the executable name or the absolute path of the executable
+func normalizeSplitTunnelApp(app string) (string, bool) {
+	app = strings.TrimSpace(app)
+	if app == "" || strings.ContainsAny(app, "\n\x00") {
+		return "", false
+	}
+	if strings.Contains(app, "/") {
+		if !filepath.IsAbs(app) {
+			return "", false
+		}
+		app = filepath.Clean(app)
+	}
+	return app, true
+}
+
+// splitTunnelStatus returns the split tunnel apps reported in the status or nil if split tunneling is not used
+func (r *RPC) splitTunnelStatus() *pb.SplitTunnel {
+	if r.splitTunnel == nil {
+		return nil
+	}
+
+	status := r.splitTunnel.Status()
+	if len(status.Apps) == 0 {
+		return nil
+	}
+
+	splitTunnel := &pb.SplitTunnel{Mode: string(status.Mode)}
+	for _, app := range status.Apps {
+		splitTunnel.Apps = append(splitTunnel.Apps, &pb.SplitTunnelApp{Name: app.Name, Running: app.Running})
+	}
+	return splitTunnel
+}
@@ -0,0 +1,219 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/splittunnel"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockSplitTunnel struct {
+	err     error
+	mode    config.SplitTunnelMode
+	apps    []string
+	running map[string]bool
+}
+
+func (m *mockSplitTunnel) Apply(mode config.SplitTunnelMode, apps []string, fwmark uint32) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.mode = mode
+	m.apps = apps
+	return nil
+}
+
+func (m *mockSplitTunnel) Disable() error {
+	m.apps = nil
+	return nil
+}
+
+func (m *mockSplitTunnel) Status() splittunnel.Status {
+	status := splittunnel.Status{Mode: m.mode}
+	for _, app := range m.apps {
+		status.Apps = append(status.Apps, splittunnel.AppStatus{Name: app, Running: m.running[app]})
+	}
+	return status
+}
+
+func TestSetSplitTunnelApp(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		req          *pb.SetSplitTunnelAppRequest
+		applyErr     error
+		expectedType int64
+		expectedData []string
+		expectedApps []string
+	}{
+		{
+			name:         "add app",
+			req:          &pb.SetSplitTunnelAppRequest{App: "firefox"},
+			expectedType: internal.CodeSuccess,
+			expectedData: []string{"firefox"},
+			expectedApps: []string{"/usr/bin/steam", "firefox"},
+		},
+		{
+			name:         "add path is cleaned",
+			req:          &pb.SetSplitTunnelAppRequest{App: "/usr//bin/../bin/firefox"},
+			expectedType: internal.CodeSuccess,
+			expectedData: []string{"/usr/bin/firefox"},
+			expectedApps: []string{"/usr/bin/firefox", "/usr/bin/steam"},
+		},
+		{
+			name:         "add existing app",
+			req:          &pb.SetSplitTunnelAppRequest{App: "/usr/bin/steam"},
+			expectedType: internal.CodeNothingToDo,
+			expectedData: []string{"/usr/bin/steam"},
+			expectedApps: []string{"/usr/bin/steam"},
+		},
+		{
+			name:         "remove app",
+			req:          &pb.SetSplitTunnelAppRequest{App: "/usr/bin/steam", Remove: true},
+			expectedType: internal.CodeSuccess,
+			expectedData: []string{"/usr/bin/steam"},
+			expectedApps: []string{},
+		},
+		{
+			name:         "remove unknown app",
+			req:          &pb.SetSplitTunnelAppRequest{App: "firefox", Remove: true},
+			expectedType: internal.CodeNothingToDo,
+			expectedData: []string{"firefox"},
+			expectedApps: []string{"/usr/bin/steam"},
+		},
+		{
+			name:         "relative path",
+			req:          &pb.SetSplitTunnelAppRequest{App: "bin/firefox"},
+			expectedType: internal.CodeFormatError,
+			expectedApps: []string{"/usr/bin/steam"},
+		},
+		{
+			name:         "empty app",
+			req:          &pb.SetSplitTunnelAppRequest{App: " "},
+			expectedType: internal.CodeFormatError,
+			expectedApps: []string{"/usr/bin/steam"},
+		},
+		{
+			name:         "cgroup v2 is not available",
+			req:          &pb.SetSplitTunnelAppRequest{App: "firefox"},
+			applyErr:     splittunnel.ErrNotSupported,
+			expectedType: internal.CodeSplitTunnelNotSupported,
+			expectedApps: []string{"/usr/bin/steam"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.SplitTunnel.Apps = map[string]bool{"/usr/bin/steam": true}
+			agent := &mockSplitTunnel{err: test.applyErr}
+			r := RPC{cm: cm, splitTunnel: agent}
+
+			resp, err := r.SetSplitTunnelApp(context.Background(), test.req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedType, resp.Type)
+			assert.Equal(t, test.expectedData, resp.Data)
+			assert.Equal(t, test.expectedApps, cm.Cfg.SplitTunnel.SortedApps())
+			if test.expectedType == internal.CodeSuccess {
+				assert.Equal(t, test.expectedApps, agent.apps)
+			}
+		})
+	}
+
+	t.Run("config error", func(t *testing.T) {
+		r := RPC{cm: failingConfigManager{}}
+
+		resp, err := r.SetSplitTunnelApp(context.Background(), &pb.SetSplitTunnelAppRequest{App: "firefox"})
+		assert.NoError(t, err)
+		assert.Equal(t, internal.CodeConfigError, resp.Type)
+	})
+}
+
+func TestSetSplitTunnelMode(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      config.SplitTunnelMode
+		mode         string
+		expectedType int64
+		expectedMode config.SplitTunnelMode
+	}{
+		{
+			name:         "include",
+			mode:         "include",
+			expectedType: internal.CodeSuccess,
+			expectedMode: config.SplitTunnelInclude,
+		},
+		{
+			name:         "default is already used",
+			mode:         "exclude",
+			expectedType: internal.CodeNothingToDo,
+		},
+		{
+			name:         "back to exclude",
+			current:      config.SplitTunnelInclude,
+			mode:         "exclude",
+			expectedType: internal.CodeSuccess,
+			expectedMode: config.SplitTunnelExclude,
+		},
+		{
+			name:         "unknown mode",
+			current:      config.SplitTunnelInclude,
+			mode:         "bypass",
+			expectedType: internal.CodeFormatError,
+			expectedMode: config.SplitTunnelInclude,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.SplitTunnel.Mode = test.current
+			r := RPC{cm: cm, splitTunnel: &mockSplitTunnel{}}
+
+			resp, err := r.SetSplitTunnelMode(context.Background(),
+				&pb.SetSplitTunnelModeRequest{Mode: test.mode})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedType, resp.Type)
+			assert.Equal(t, test.expectedMode, cm.Cfg.SplitTunnel.Mode)
+		})
+	}
+}
+
+func TestSplitTunnelApps(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.SplitTunnel = config.SplitTunnel{
+		Mode: config.SplitTunnelInclude,
+		Apps: map[string]bool{"firefox": true, "/usr/bin/steam": true},
+	}
+	agent := &mockSplitTunnel{running: map[string]bool{"firefox": true}}
+	r := RPC{cm: cm, splitTunnel: agent}
+	assert.NoError(t, r.ApplySplitTunnel())
+
+	resp, err := r.SplitTunnelApps(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, "include", resp.Mode)
+	assert.Len(t, resp.Apps, 2)
+	assert.Equal(t, "/usr/bin/steam", resp.Apps[0].Name)
+	assert.False(t, resp.Apps[0].Running)
+	assert.Equal(t, "firefox", resp.Apps[1].Name)
+	assert.True(t, resp.Apps[1].Running)
+
+	status := r.splitTunnelStatus()
+	assert.Equal(t, "include", status.Mode)
+	assert.Len(t, status.Apps, 2)
+
+	assert.NoError(t, agent.Disable())
+	assert.Nil(t, r.splitTunnelStatus())
+}
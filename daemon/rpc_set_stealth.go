@@ -0,0 +1,57 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetStealth enables OpenVPN over TCP 443 and sets the proxy the connection goes through
+func (r *RPC) SetStealth(ctx context.Context, in *pb.Stealth) (*pb.Payload, error) {
+	stealth := config.Stealth{Enabled: in.GetEnabled(), Proxy: in.GetProxy()}
+	if err := stealth.Validate(); err != nil {
+		return &pb.Payload{Type: internal.CodeFormatError, Data: []string{err.Error()}}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if cfg.AutoConnectData.Stealth == stealth {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.AutoConnectData.Stealth = stealth
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{
+		Type: internal.CodeSuccess,
+		Data: []string{strconv.FormatBool(r.netw.IsVPNActive() && cfg.Technology == config.Technology_OPENVPN)},
+	}, nil
+}
+
+func stealthToProtobuf(stealth config.Stealth) *pb.Stealth {
+	return &pb.Stealth{Enabled: stealth.Enabled, Proxy: stealth.Proxy}
+}
+
+// stealthConfig returns the config used to connect in the stealth mode. The mode is used only with OpenVPN, as
+// NordLynx can't run over TCP.
+func stealthConfig(cfg config.Config) config.Config {
+	if cfg.Technology != config.Technology_OPENVPN || !cfg.AutoConnectData.Stealth.Enabled {
+		return cfg
+	}
+	cfg.AutoConnectData.Protocol = config.Protocol_TCP
+	cfg.AutoConnectData.OpenVPNPort = config.StealthPort
+	return cfg
+}
@@ -0,0 +1,100 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetStealth(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name            string
+		current         config.Stealth
+		vpnActive       bool
+		stealth         *pb.Stealth
+		expectedCode    int64
+		expectedData    []string
+		expectedStealth config.Stealth
+	}{
+		{
+			name:            "enabled",
+			stealth:         &pb.Stealth{Enabled: true},
+			expectedCode:    internal.CodeSuccess,
+			expectedData:    []string{"false"},
+			expectedStealth: config.Stealth{Enabled: true},
+		},
+		{
+			name:            "enabled with proxy while connected",
+			vpnActive:       true,
+			stealth:         &pb.Stealth{Enabled: true, Proxy: "socks5://10.0.0.1:1080"},
+			expectedCode:    internal.CodeSuccess,
+			expectedData:    []string{"true"},
+			expectedStealth: config.Stealth{Enabled: true, Proxy: "socks5://10.0.0.1:1080"},
+		},
+		{
+			name:         "disabled",
+			current:      config.Stealth{Enabled: true, Proxy: "http://10.0.0.1:3128"},
+			stealth:      &pb.Stealth{},
+			expectedCode: internal.CodeSuccess,
+			expectedData: []string{"false"},
+		},
+		{
+			name:            "already set",
+			current:         config.Stealth{Enabled: true},
+			stealth:         &pb.Stealth{Enabled: true},
+			expectedCode:    internal.CodeNothingToDo,
+			expectedStealth: config.Stealth{Enabled: true},
+		},
+		{
+			name:         "invalid proxy",
+			stealth:      &pb.Stealth{Enabled: true, Proxy: "ftp://10.0.0.1:21"},
+			expectedCode: internal.CodeFormatError,
+			expectedData: []string{config.ErrStealthProxy.Error()},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Technology = config.Technology_OPENVPN
+			cm.Cfg.AutoConnectData.Stealth = test.current
+			r := RPC{cm: cm, netw: &networker.Mock{VpnActive: test.vpnActive}}
+
+			resp, err := r.SetStealth(context.Background(), test.stealth)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.Equal(t, test.expectedData, resp.Data)
+			assert.Equal(t, test.expectedStealth, cm.Cfg.AutoConnectData.Stealth)
+		})
+	}
+}
+
+func TestStealthConfig(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var cfg config.Config
+	cfg.Technology = config.Technology_OPENVPN
+	cfg.AutoConnectData.Protocol = config.Protocol_UDP
+	cfg.AutoConnectData.OpenVPNPort = 53
+
+	assert.Equal(t, cfg, stealthConfig(cfg))
+
+	cfg.AutoConnectData.Stealth.Enabled = true
+	stealth := stealthConfig(cfg)
+	assert.Equal(t, config.Protocol_TCP, stealth.AutoConnectData.Protocol)
+	assert.Equal(t, uint16(config.StealthPort), stealth.AutoConnectData.OpenVPNPort)
+
+	// NordLynx can't run over TCP
+	cfg.Technology = config.Technology_NORDLYNX
+	assert.Equal(t, cfg, stealthConfig(cfg))
+}
@@ -2,11 +2,13 @@ package daemon
 
 import (
 	"context"
+	"errors"
 	"log"
 	"strconv"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 )
 
@@ -16,7 +18,11 @@ func (r *RPC) SetTechnology(ctx context.Context, in *pb.SetTechnologyRequest) (*
 		log.Println(internal.ErrorPrefix, err)
 	}
 
-	if cfg.Technology == in.GetTechnology() {
+	if in.GetAuto() {
+		return r.setAutoTechnology(cfg)
+	}
+
+	if cfg.Technology == in.GetTechnology() && !cfg.AutoTechnology {
 		return &pb.Payload{
 			Type: internal.CodeNothingToDo,
 			Data: []string{in.GetTechnology().String()},
@@ -24,6 +30,12 @@ func (r *RPC) SetTechnology(ctx context.Context, in *pb.SetTechnologyRequest) (*
 	}
 
 	v, err := r.factory(in.GetTechnology())
+	if errors.Is(err, vpn.ErrTechnologyUnavailable) {
+		return &pb.Payload{
+			Type: internal.CodeTechnologyUnavailable,
+			Data: []string{in.GetTechnology().String()},
+		}, nil
+	}
 	if err != nil {
 		log.Println(internal.ErrorPrefix, err)
 		return &pb.Payload{
@@ -41,9 +53,14 @@ func (r *RPC) SetTechnology(ctx context.Context, in *pb.SetTechnologyRequest) (*
 
 	protocol := cfg.AutoConnectData.Protocol
 	obfuscate := cfg.AutoConnectData.Obfuscate
-	if in.GetTechnology() == config.Technology_NORDLYNX {
+	switch in.GetTechnology() {
+	case config.Technology_NORDLYNX:
 		protocol = config.Protocol_UDP
 		obfuscate = false
+	case config.Technology_NORDWHISPER:
+		// obfuscation is provided by the technology itself
+		obfuscate = false
+	case config.Technology_OPENVPN, config.Technology_UNKNOWN_TECHNOLOGY:
 	}
 
 	if in.GetTechnology() != config.Technology_NORDLYNX && cfg.AutoConnectData.PostquantumVpn {
@@ -54,6 +71,8 @@ func (r *RPC) SetTechnology(ctx context.Context, in *pb.SetTechnologyRequest) (*
 
 	if err := r.cm.SaveWith(func(c config.Config) config.Config {
 		c.Technology = in.GetTechnology()
+		c.AutoTechnology = false
+		c.TechnologyRungs = nil
 		c.AutoConnectData.Protocol = protocol
 		c.AutoConnectData.Obfuscate = obfuscate
 		return c
@@ -0,0 +1,55 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// factoryWithout fails to create the VPN of the technology as if it was not included in the build
+func factoryWithout(unavailable config.Technology) FactoryFunc {
+	return func(tech config.Technology) (vpn.VPN, error) {
+		if tech == unavailable {
+			return nil, vpn.ErrTechnologyUnavailable
+		}
+		return &mock.WorkingVPN{}, nil
+	}
+}
+
+func TestSetTechnology_Unavailable(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Technology = config.Technology_NORDLYNX
+	r := RPC{cm: cm, netw: &networker.Mock{}, factory: factoryWithout(config.Technology_NORDWHISPER)}
+
+	resp, err := r.SetTechnology(context.Background(),
+		&pb.SetTechnologyRequest{Technology: config.Technology_NORDWHISPER})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeTechnologyUnavailable, resp.Type)
+	assert.Equal(t, []string{"NORDWHISPER"}, resp.Data)
+	assert.Equal(t, config.Technology_NORDLYNX, cm.Cfg.Technology)
+}
+
+func TestSettingsTechnologies(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	r := RPC{factory: factoryWithout(config.Technology_NORDWHISPER)}
+	resp, err := r.SettingsTechnologies(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"OPENVPN", "NORDLYNX"}, resp.Data)
+
+	r = RPC{factory: factoryWithout(config.Technology_UNKNOWN_TECHNOLOGY)}
+	resp, err = r.SettingsTechnologies(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"OPENVPN", "NORDLYNX", "NORDWHISPER"}, resp.Data)
+}
@@ -0,0 +1,40 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// SetTrayHotkey enables or disables the global shortcut which toggles VPN from the tray of the given user
+func (r *RPC) SetTrayHotkey(ctx context.Context, in *pb.SetTrayHotkeyRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if cfg.UsersData.TrayHotkey[in.GetUid()] == in.GetEnabled() {
+		return &pb.Payload{Type: internal.CodeNothingToDo}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		if !in.GetEnabled() {
+			delete(c.UsersData.TrayHotkey, in.GetUid())
+			return c
+		}
+		if c.UsersData.TrayHotkey == nil {
+			c.UsersData.TrayHotkey = config.UidBoolMap{}
+		}
+		c.UsersData.TrayHotkey[in.GetUid()] = true
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess}, nil
+}
@@ -0,0 +1,70 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetTrayHotkey(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	const uid = 1000
+
+	tests := []struct {
+		name         string
+		current      config.UidBoolMap
+		enabled      bool
+		expectedType int64
+	}{
+		{
+			name:         "enable without previous entries",
+			enabled:      true,
+			expectedType: internal.CodeSuccess,
+		},
+		{
+			name:         "enable next to other user",
+			current:      config.UidBoolMap{1001: true},
+			enabled:      true,
+			expectedType: internal.CodeSuccess,
+		},
+		{
+			name:         "disable",
+			current:      config.UidBoolMap{uid: true},
+			enabled:      false,
+			expectedType: internal.CodeSuccess,
+		},
+		{
+			name:         "already disabled",
+			enabled:      false,
+			expectedType: internal.CodeNothingToDo,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := newMockConfigManager()
+			cm.c.UsersData.TrayHotkey = test.current
+			r := RPC{cm: cm}
+
+			resp, err := r.SetTrayHotkey(context.Background(), &pb.SetTrayHotkeyRequest{Uid: uid, Enabled: test.enabled})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedType, resp.Type)
+			assert.Equal(t, test.enabled, cm.c.UsersData.TrayHotkey[uid])
+		})
+	}
+
+	t.Run("config error", func(t *testing.T) {
+		r := RPC{cm: failingConfigManager{}}
+
+		resp, err := r.SetTrayHotkey(context.Background(), &pb.SetTrayHotkeyRequest{Uid: uid, Enabled: true})
+		assert.NoError(t, err)
+		assert.Equal(t, internal.CodeConfigError, resp.Type)
+	})
+}
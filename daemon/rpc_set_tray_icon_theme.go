@@ -0,0 +1,56 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+func (r *RPC) SetTrayIconTheme(ctx context.Context, in *pb.SetTrayIconThemeRequest) (*pb.Payload, error) {
+	if _, ok := config.TrayIconTheme_name[int32(in.GetTheme())]; !ok {
+		return &pb.Payload{
+			Type: internal.CodeFormatError,
+		}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{
+			Type: internal.CodeConfigError,
+		}, nil
+	}
+
+	if cfg.UsersData.TrayIconTheme[in.GetUid()] == in.GetTheme() {
+		return &pb.Payload{
+			Type: internal.CodeNothingToDo,
+			Data: []string{strings.ToLower(in.GetTheme().String())},
+		}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		if in.GetTheme() == config.TrayIconTheme_AUTO {
+			delete(c.UsersData.TrayIconTheme, in.GetUid())
+			return c
+		}
+		if c.UsersData.TrayIconTheme == nil {
+			c.UsersData.TrayIconTheme = map[int64]config.TrayIconTheme{}
+		}
+		c.UsersData.TrayIconTheme[in.GetUid()] = in.GetTheme()
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{
+			Type: internal.CodeConfigError,
+		}, nil
+	}
+
+	return &pb.Payload{
+		Type: internal.CodeSuccess,
+		Data: []string{strings.ToLower(in.GetTheme().String())},
+	}, nil
+}
@@ -0,0 +1,79 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetTrayIconTheme(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	const uid = 1000
+
+	tests := []struct {
+		name          string
+		currentTheme  config.TrayIconTheme
+		theme         config.TrayIconTheme
+		expectedType  int64
+		expectedTheme config.TrayIconTheme
+	}{
+		{
+			name:          "set symbolic",
+			currentTheme:  config.TrayIconTheme_AUTO,
+			theme:         config.TrayIconTheme_SYMBOLIC,
+			expectedType:  internal.CodeSuccess,
+			expectedTheme: config.TrayIconTheme_SYMBOLIC,
+		},
+		{
+			name:          "already set",
+			currentTheme:  config.TrayIconTheme_MONOCHROME,
+			theme:         config.TrayIconTheme_MONOCHROME,
+			expectedType:  internal.CodeNothingToDo,
+			expectedTheme: config.TrayIconTheme_MONOCHROME,
+		},
+		{
+			name:          "back to auto",
+			currentTheme:  config.TrayIconTheme_COLORED,
+			theme:         config.TrayIconTheme_AUTO,
+			expectedType:  internal.CodeSuccess,
+			expectedTheme: config.TrayIconTheme_AUTO,
+		},
+		{
+			name:          "unknown theme",
+			currentTheme:  config.TrayIconTheme_COLORED,
+			theme:         config.TrayIconTheme(42),
+			expectedType:  internal.CodeFormatError,
+			expectedTheme: config.TrayIconTheme_COLORED,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := newMockConfigManager()
+			cm.c.UsersData.TrayIconTheme = map[int64]config.TrayIconTheme{uid: test.currentTheme}
+			r := RPC{cm: cm}
+
+			resp, err := r.SetTrayIconTheme(context.Background(),
+				&pb.SetTrayIconThemeRequest{Uid: uid, Theme: test.theme})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedType, resp.Type)
+			assert.Equal(t, test.expectedTheme, cm.c.UsersData.TrayIconTheme[uid])
+		})
+	}
+
+	t.Run("config error", func(t *testing.T) {
+		r := RPC{cm: failingConfigManager{}}
+
+		resp, err := r.SetTrayIconTheme(context.Background(),
+			&pb.SetTrayIconThemeRequest{Uid: uid, Theme: config.TrayIconTheme_SYMBOLIC})
+		assert.NoError(t, err)
+		assert.Equal(t, internal.CodeConfigError, resp.Type)
+	})
+}
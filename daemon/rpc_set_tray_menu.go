@@ -0,0 +1,48 @@
+package daemon
+
+import (
+	"context"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"golang.org/x/exp/slices"
+)
+
+// SetTrayMenu sets which tray menu sections are shown and in what order for all users. Empty
+// request restores the default layout. Running trays pick up the change with the settings.
+func (r *RPC) SetTrayMenu(ctx context.Context, in *pb.SetTrayMenuRequest) (*pb.Payload, error) {
+	sections := in.GetSections()
+	if err := config.ValidateTrayMenu(sections); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	layout := config.TrayMenuOrDefault(sections)
+	if slices.Equal(config.TrayMenuOrDefault(cfg.TrayMenu), layout) {
+		return &pb.Payload{Type: internal.CodeNothingToDo, Data: layout}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		// default layout is not stored so that it follows future changes of the defaults
+		if slices.Equal(layout, config.DefaultTrayMenu) {
+			c.TrayMenu = nil
+		} else {
+			c.TrayMenu = layout
+		}
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess, Data: layout}, nil
+}
@@ -0,0 +1,89 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetTrayMenu(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      []string
+		sections     []string
+		expectedType int64
+		expectedCfg  []string
+	}{
+		{
+			name:         "custom layout",
+			sections:     []string{config.TrayMenuAccount, config.TrayMenuVPN},
+			expectedType: internal.CodeSuccess,
+			expectedCfg:  []string{config.TrayMenuAccount, config.TrayMenuVPN},
+		},
+		{
+			name:         "reset to default",
+			current:      []string{config.TrayMenuVPN},
+			expectedType: internal.CodeSuccess,
+		},
+		{
+			name:         "explicit default is not stored",
+			current:      []string{config.TrayMenuVPN},
+			sections:     config.DefaultTrayMenu,
+			expectedType: internal.CodeSuccess,
+		},
+		{
+			name:         "already set",
+			current:      []string{config.TrayMenuVPN},
+			sections:     []string{config.TrayMenuVPN},
+			expectedType: internal.CodeNothingToDo,
+			expectedCfg:  []string{config.TrayMenuVPN},
+		},
+		{
+			name:         "already default",
+			sections:     config.DefaultTrayMenu,
+			expectedType: internal.CodeNothingToDo,
+		},
+		{
+			name:         "unknown section",
+			current:      []string{config.TrayMenuVPN},
+			sections:     []string{config.TrayMenuVPN, "meshnet"},
+			expectedType: internal.CodeFormatError,
+			expectedCfg:  []string{config.TrayMenuVPN},
+		},
+		{
+			name:         "repeated section",
+			sections:     []string{config.TrayMenuVPN, config.TrayMenuVPN},
+			expectedType: internal.CodeFormatError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.TrayMenu = test.current
+			r := RPC{cm: cm}
+
+			resp, err := r.SetTrayMenu(context.Background(), &pb.SetTrayMenuRequest{Sections: test.sections})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedType, resp.Type)
+			assert.Equal(t, test.expectedCfg, cm.Cfg.TrayMenu)
+		})
+	}
+
+	t.Run("config error", func(t *testing.T) {
+		r := RPC{cm: failingConfigManager{}}
+
+		resp, err := r.SetTrayMenu(context.Background(), &pb.SetTrayMenuRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, internal.CodeConfigError, resp.Type)
+	})
+}
@@ -0,0 +1,64 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetTrayMinimal(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name         string
+		current      bool
+		enabled      bool
+		expectedType int64
+	}{
+		{
+			name:         "enable",
+			current:      false,
+			enabled:      true,
+			expectedType: internal.CodeSuccess,
+		},
+		{
+			name:         "disable",
+			current:      true,
+			enabled:      false,
+			expectedType: internal.CodeSuccess,
+		},
+		{
+			name:         "already enabled",
+			current:      true,
+			enabled:      true,
+			expectedType: internal.CodeNothingToDo,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.TrayMinimal = test.current
+			r := RPC{cm: cm}
+
+			resp, err := r.SetTrayMinimal(context.Background(), &pb.SetGenericRequest{Enabled: test.enabled})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedType, resp.Type)
+			assert.Equal(t, test.enabled, cm.Cfg.TrayMinimal)
+		})
+	}
+
+	t.Run("config error", func(t *testing.T) {
+		r := RPC{cm: failingConfigManager{}}
+
+		resp, err := r.SetTrayMinimal(context.Background(), &pb.SetGenericRequest{Enabled: true})
+		assert.NoError(t, err)
+		assert.Equal(t, internal.CodeConfigError, resp.Type)
+	})
+}
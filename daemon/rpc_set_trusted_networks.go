@@ -0,0 +1,101 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"net/netip"
+	"slices"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// maxSSIDLength is the maximum length of Wi-Fi network name in bytes defined by IEEE 802.11
+const maxSSIDLength = 32
+
+// SetTrustedNetwork adds the Wi-Fi network or the wired subnet to the trusted networks or removes it
+func (r *RPC) SetTrustedNetwork(ctx context.Context, in *pb.SetTrustedNetworkRequest) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	trusted := cfg.TrustedNetworks
+	var network string
+	switch in.GetNetwork().(type) {
+	case *pb.SetTrustedNetworkRequest_Ssid:
+		network = in.GetSsid()
+		if network == "" || len(network) > maxSSIDLength {
+			return &pb.Payload{Type: internal.CodeFormatError}, nil
+		}
+		if trusted.SSIDs[network] != in.GetRemove() {
+			return &pb.Payload{Type: internal.CodeNothingToDo, Data: []string{network}}, nil
+		}
+		trusted = trusted.WithSSID(network, in.GetRemove())
+	case *pb.SetTrustedNetworkRequest_Subnet:
+		prefix, err := netip.ParsePrefix(in.GetSubnet())
+		if err != nil || !prefix.Addr().Is4() {
+			return &pb.Payload{Type: internal.CodeFormatError}, nil
+		}
+		network = prefix.Masked().String()
+		if trusted.Subnets[network] != in.GetRemove() {
+			return &pb.Payload{Type: internal.CodeNothingToDo, Data: []string{network}}, nil
+		}
+		trusted = trusted.WithSubnet(network, in.GetRemove())
+	default:
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.TrustedNetworks = trusted
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	// device may already be in the network which became trusted or untrusted
+	if r.trustedNetworks != nil {
+		go r.trustedNetworks.apply()
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{network}}, nil
+}
+
+// SetTrustedNetworkAction sets what happens when the device joins a trusted network
+func (r *RPC) SetTrustedNetworkAction(ctx context.Context, in *pb.SetTrustedNetworkActionRequest) (*pb.Payload, error) {
+	action := config.TrustedNetworkAction(in.GetAction())
+	if !slices.Contains(config.TrustedNetworkActions, action) {
+		return &pb.Payload{Type: internal.CodeFormatError}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if cfg.TrustedNetworks.ActionOrDefault() == action {
+		return &pb.Payload{Type: internal.CodeNothingToDo, Data: []string{string(action)}}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.TrustedNetworks.Action = action
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{string(action)}}, nil
+}
+
+func trustedNetworksToProtobuf(trusted config.TrustedNetworks) *pb.TrustedNetworks {
+	return &pb.TrustedNetworks{
+		Ssids:   trusted.SortedSSIDs(),
+		Subnets: trusted.SortedSubnets(),
+		Action:  string(trusted.ActionOrDefault()),
+	}
+}
@@ -0,0 +1,200 @@
+package daemon
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetTrustedNetwork(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	ssid := func(name string, remove bool) *pb.SetTrustedNetworkRequest {
+		return &pb.SetTrustedNetworkRequest{Network: &pb.SetTrustedNetworkRequest_Ssid{Ssid: name}, Remove: remove}
+	}
+	subnet := func(address string, remove bool) *pb.SetTrustedNetworkRequest {
+		return &pb.SetTrustedNetworkRequest{
+			Network: &pb.SetTrustedNetworkRequest_Subnet{Subnet: address},
+			Remove:  remove,
+		}
+	}
+
+	tests := []struct {
+		name            string
+		req             *pb.SetTrustedNetworkRequest
+		expectedType    int64
+		expectedData    []string
+		expectedSSIDs   []string
+		expectedSubnets []string
+	}{
+		{
+			name:            "add ssid",
+			req:             ssid("Office", false),
+			expectedType:    internal.CodeSuccess,
+			expectedData:    []string{"Office"},
+			expectedSSIDs:   []string{"Home", "Office"},
+			expectedSubnets: []string{"192.168.1.0/24"},
+		},
+		{
+			name:            "add existing ssid",
+			req:             ssid("Home", false),
+			expectedType:    internal.CodeNothingToDo,
+			expectedData:    []string{"Home"},
+			expectedSSIDs:   []string{"Home"},
+			expectedSubnets: []string{"192.168.1.0/24"},
+		},
+		{
+			name:            "remove ssid",
+			req:             ssid("Home", true),
+			expectedType:    internal.CodeSuccess,
+			expectedData:    []string{"Home"},
+			expectedSSIDs:   []string{},
+			expectedSubnets: []string{"192.168.1.0/24"},
+		},
+		{
+			name:            "remove unknown ssid",
+			req:             ssid("Cafe", true),
+			expectedType:    internal.CodeNothingToDo,
+			expectedData:    []string{"Cafe"},
+			expectedSSIDs:   []string{"Home"},
+			expectedSubnets: []string{"192.168.1.0/24"},
+		},
+		{
+			name:            "ssid too long",
+			req:             ssid(strings.Repeat("a", 33), false),
+			expectedType:    internal.CodeFormatError,
+			expectedSSIDs:   []string{"Home"},
+			expectedSubnets: []string{"192.168.1.0/24"},
+		},
+		{
+			name:            "empty ssid",
+			req:             ssid("", false),
+			expectedType:    internal.CodeFormatError,
+			expectedSSIDs:   []string{"Home"},
+			expectedSubnets: []string{"192.168.1.0/24"},
+		},
+		{
+			name:            "add subnet is stored masked",
+			req:             subnet("10.1.2.3/8", false),
+			expectedType:    internal.CodeSuccess,
+			expectedData:    []string{"10.0.0.0/8"},
+			expectedSSIDs:   []string{"Home"},
+			expectedSubnets: []string{"10.0.0.0/8", "192.168.1.0/24"},
+		},
+		{
+			name:            "remove subnet given with host address",
+			req:             subnet("192.168.1.1/24", true),
+			expectedType:    internal.CodeSuccess,
+			expectedData:    []string{"192.168.1.0/24"},
+			expectedSSIDs:   []string{"Home"},
+			expectedSubnets: []string{},
+		},
+		{
+			name:            "invalid subnet",
+			req:             subnet("192.168.1.1", false),
+			expectedType:    internal.CodeFormatError,
+			expectedSSIDs:   []string{"Home"},
+			expectedSubnets: []string{"192.168.1.0/24"},
+		},
+		{
+			name:            "ipv6 subnet",
+			req:             subnet("fd00::/64", false),
+			expectedType:    internal.CodeFormatError,
+			expectedSSIDs:   []string{"Home"},
+			expectedSubnets: []string{"192.168.1.0/24"},
+		},
+		{
+			name:            "no network",
+			req:             &pb.SetTrustedNetworkRequest{},
+			expectedType:    internal.CodeFormatError,
+			expectedSSIDs:   []string{"Home"},
+			expectedSubnets: []string{"192.168.1.0/24"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.TrustedNetworks = config.TrustedNetworks{
+				SSIDs:   map[string]bool{"Home": true},
+				Subnets: config.Subnets{"192.168.1.0/24": true},
+			}
+			r := RPC{cm: cm}
+
+			resp, err := r.SetTrustedNetwork(context.Background(), test.req)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedType, resp.Type)
+			assert.Equal(t, test.expectedData, resp.Data)
+			assert.Equal(t, test.expectedSSIDs, cm.Cfg.TrustedNetworks.SortedSSIDs())
+			assert.Equal(t, test.expectedSubnets, cm.Cfg.TrustedNetworks.SortedSubnets())
+		})
+	}
+
+	t.Run("config error", func(t *testing.T) {
+		r := RPC{cm: failingConfigManager{}}
+
+		resp, err := r.SetTrustedNetwork(context.Background(), ssid("Home", false))
+		assert.NoError(t, err)
+		assert.Equal(t, internal.CodeConfigError, resp.Type)
+	})
+}
+
+func TestSetTrustedNetworkAction(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name           string
+		current        config.TrustedNetworkAction
+		action         string
+		expectedType   int64
+		expectedAction config.TrustedNetworkAction
+	}{
+		{
+			name:           "disconnect",
+			action:         "disconnect",
+			expectedType:   internal.CodeSuccess,
+			expectedAction: config.TrustedNetworkDisconnect,
+		},
+		{
+			name:         "default is already used",
+			action:       "skip",
+			expectedType: internal.CodeNothingToDo,
+		},
+		{
+			name:           "back to skip",
+			current:        config.TrustedNetworkDisconnect,
+			action:         "skip",
+			expectedType:   internal.CodeSuccess,
+			expectedAction: config.TrustedNetworkSkip,
+		},
+		{
+			name:           "unknown action",
+			current:        config.TrustedNetworkDisconnect,
+			action:         "pause",
+			expectedType:   internal.CodeFormatError,
+			expectedAction: config.TrustedNetworkDisconnect,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.TrustedNetworks.Action = test.current
+			r := RPC{cm: cm}
+
+			resp, err := r.SetTrustedNetworkAction(context.Background(),
+				&pb.SetTrustedNetworkActionRequest{Action: test.action})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedType, resp.Type)
+			assert.Equal(t, test.expectedAction, cm.Cfg.TrustedNetworks.Action)
+		})
+	}
+}
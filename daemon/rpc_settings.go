@@ -69,6 +69,9 @@ func (r *RPC) Settings(ctx context.Context, in *pb.Empty) (*pb.SettingsResponse,
 		uid = int64(cred.Uid)
 	}
 
+	daemonLogLevel, debugComponents := r.daemonLogLevel(cfg)
+	autoConnectTarget := cfg.AutoConnectTargetFor(uid)
+
 	return &pb.SettingsResponse{
 		Type: internal.CodeSuccess,
 		Data: &pb.Settings{
@@ -79,28 +82,53 @@ func (r *RPC) Settings(ctx context.Context, in *pb.Empty) (*pb.SettingsResponse,
 			Analytics:  cfg.Analytics.Get(),
 			KillSwitch: cfg.KillSwitch,
 			AutoConnectData: &pb.AutoconnectData{
-				Enabled:     cfg.AutoConnect,
-				Country:     cfg.AutoConnectData.Country,
-				City:        cfg.AutoConnectData.City,
-				ServerGroup: cfg.AutoConnectData.Group,
+				Enabled:     cfg.AutoConnectFor(uid),
+				Country:     autoConnectTarget.Country,
+				City:        autoConnectTarget.City,
+				ServerGroup: autoConnectTarget.Group,
 			},
 			Ipv6:                 cfg.IPv6,
 			Meshnet:              cfg.Mesh,
 			Dns:                  cfg.AutoConnectData.DNS,
 			ThreatProtectionLite: cfg.AutoConnectData.ThreatProtectionLite,
 			Protocol:             cfg.AutoConnectData.Protocol,
+			OpenvpnPort:          uint32(cfg.AutoConnectData.OpenVPNPort),
 			LanDiscovery:         cfg.LanDiscovery,
 			Allowlist: &pb.Allowlist{
 				Ports:   &ports,
 				Subnets: subnets,
 			},
-			Obfuscate:       cfg.AutoConnectData.Obfuscate,
-			PostquantumVpn:  cfg.AutoConnectData.PostquantumVpn,
-			VirtualLocation: cfg.VirtualLocation.Get(),
+			Obfuscate:           cfg.AutoConnectData.Obfuscate,
+			PostquantumVpn:      cfg.AutoConnectData.PostquantumVpn,
+			VirtualLocation:     cfg.VirtualLocation.Get(),
+			TrayMinimal:         cfg.TrayMinimal,
+			TrayMenu:            cfg.TrayMenu,
+			LogLevel:            string(internal.LogLevelOrDefault(cfg.LogLevel)),
+			DaemonLogLevel:      string(daemonLogLevel),
+			DebugComponents:     logComponentsToStrings(debugComponents),
+			TrustedNetworks:     trustedNetworksToProtobuf(cfg.TrustedNetworks),
+			Mtu:                 cfg.MTU,
+			DeferOnMetered:      cfg.DeferOnMetered,
+			ObfuscationFallback: cfg.ObfuscationFallback.Get(),
+			MaxServerLoad:       uint32(cfg.MaxServerLoad),
+			ConnectRetry:        connectRetryToProtobuf(cfg.ConnectRetry),
+			Stealth:             stealthToProtobuf(cfg.AutoConnectData.Stealth),
+			InterfaceName:       cfg.InterfaceName,
+			RoutingTable:        cfg.RoutingTable,
+			RouteMetric:         cfg.RouteMetric,
+			FileshareFileLimit:  cfg.Meshnet.FileshareFileLimit,
+			PersistentKeepalive: uint32(cfg.PersistentKeepalive),
+			HealthMonitor:       string(cfg.HealthMonitorOrDefault()),
+			ConnectTimeouts:     connectTimeoutsToProtobuf(cfg.ConnectTimeouts),
+			AutoTechnology:      cfg.AutoTechnology,
+			OpenvpnDirectives:   cfg.OpenVPNDirectives,
+			AnalyticsConsent:    analyticsConsentToProtobuf(cfg.AnalyticsConsent),
 			UserSettings: &pb.UserSpecificSettings{
-				Uid:    uid,
-				Notify: !cfg.UsersData.NotifyOff[uid],
-				Tray:   !cfg.UsersData.TrayOff[uid],
+				Uid:           uid,
+				Notify:        !cfg.UsersData.NotifyOff[uid],
+				Tray:          !cfg.UsersData.TrayOff[uid],
+				TrayIconTheme: cfg.UsersData.TrayIconTheme[uid],
+				TrayHotkey:    cfg.UsersData.TrayHotkey[uid],
 			},
 		},
 	}, nil
@@ -114,10 +142,13 @@ func (r *RPC) SettingsProtocols(ctx context.Context, _ *pb.Empty) (*pb.Payload,
 }
 
 func (r *RPC) SettingsTechnologies(ctx context.Context, _ *pb.Empty) (*pb.Payload, error) {
+	technologies := []string{config.Technology_OPENVPN.String(), config.Technology_NORDLYNX.String()}
+	// NordWhisper is listed only if it is included in the build
+	if _, err := r.factory(config.Technology_NORDWHISPER); err == nil {
+		technologies = append(technologies, config.Technology_NORDWHISPER.String())
+	}
 	return &pb.Payload{
 		Type: internal.CodeSuccess,
-		Data: []string{
-			config.Technology_OPENVPN.String(), config.Technology_NORDLYNX.String(),
-		},
+		Data: technologies,
 	}, nil
 }
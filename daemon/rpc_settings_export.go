@@ -0,0 +1,166 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// ExportSettings returns the user facing settings as a versioned JSON document which can be imported on another
+// machine
+func (r *RPC) ExportSettings(ctx context.Context, in *pb.Empty) (*pb.Payload, error) {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	data, err := json.MarshalIndent(config.NewSettingsExport(cfg), "", "  ")
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "exporting settings:", err)
+		return &pb.Payload{Type: internal.CodeFailure}, nil
+	}
+	return &pb.Payload{Type: internal.CodeSuccess, Data: []string{string(data)}}, nil
+}
+
+// ImportSettings validates the settings document created by ExportSettings and replaces the current settings with
+// it. VPN has to be disconnected, because the connection settings cannot be changed in place.
+func (r *RPC) ImportSettings(ctx context.Context, in *pb.ImportSettingsRequest) (*pb.Payload, error) {
+	settings, err := config.ParseSettingsExport([]byte(in.GetSettings()))
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "importing settings:", err)
+		if errors.Is(err, config.ErrSettingsVersion) {
+			return &pb.Payload{Type: internal.CodeOutdated}, nil
+		}
+		return &pb.Payload{Type: internal.CodeFormatError, Data: []string{err.Error()}}, nil
+	}
+	if _, ok := validateNameservers(settings.DNS); !ok {
+		return &pb.Payload{Type: internal.CodeFormatError, Data: []string{"invalid DNS server"}}, nil
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	if r.netw.IsVPNActive() {
+		return &pb.Payload{Type: internal.CodeVPNRunning}, nil
+	}
+	if settings.PostquantumVPN && cfg.Mesh {
+		return &pb.Payload{Type: internal.CodePqAndMeshnetSimultaneously}, nil
+	}
+	if settings.InterfaceName != cfg.InterfaceName && interfaceNameConflicts(settings.InterfaceName) {
+		return &pb.Payload{
+			Type: internal.CodeFormatError,
+			Data: []string{fmt.Sprintf("interface %s already exists", settings.InterfaceName)},
+		}, nil
+	}
+
+	return &pb.Payload{Type: r.replaceSettings(settings, cfg)}, nil
+}
+
+// replaceSettings applies the imported settings to the system, saves them and publishes them as changed
+func (r *RPC) replaceSettings(settings config.SettingsExport, cfg config.Config) int64 {
+	imported := r.importedConfig(settings, cfg)
+	if err := r.applyImportedSettings(cfg, imported); err != nil {
+		log.Println(internal.ErrorPrefix, "applying imported settings:", err)
+		return internal.CodeFailure
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		return r.importedConfig(settings, c)
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return internal.CodeConfigError
+	}
+
+	r.events.Settings.Publish(imported)
+	r.propagateLogLevel(internal.LogLevelOrDefault(imported.LogLevel))
+	if err := r.ApplySplitTunnel(); err != nil {
+		log.Println(internal.WarningPrefix, "applying imported split tunnel:", err)
+	}
+
+	return internal.CodeSuccess
+}
+
+// importedConfig replaces the settings of the config with the imported ones and resolves the auto-connect location
+func (r *RPC) importedConfig(settings config.SettingsExport, cfg config.Config) config.Config {
+	cfg = settings.Apply(cfg)
+
+	var parameters ServerParameters
+	if cfg.AutoConnect && cfg.AutoConnectData.ServerTag != "" {
+		parameters = GetServerParameters(
+			cfg.AutoConnectData.ServerTag,
+			cfg.AutoConnectData.ServerTag,
+			r.dm.GetCountryData().Countries,
+		)
+	}
+	cfg.AutoConnectData.Country = parameters.Country
+	cfg.AutoConnectData.City = parameters.City
+	cfg.AutoConnectData.Group = parameters.Group
+
+	if cfg.LanDiscovery {
+		// private networks are already allowed by LAN discovery
+		for subnet := range cfg.AutoConnectData.Allowlist.Subnets {
+			if containsPrivateNetwork(subnet) {
+				cfg.AutoConnectData.Allowlist.UpdateSubnets(subnet, true)
+			}
+		}
+	}
+	return cfg
+}
+
+// applyImportedSettings updates the VPN implementation and the firewall when the imported settings differ from
+// the current ones
+func (r *RPC) applyImportedSettings(current config.Config, imported config.Config) error {
+	if imported.Technology != current.Technology {
+		v, err := r.factory(imported.Technology)
+		if err != nil {
+			return fmt.Errorf("creating VPN: %w", err)
+		}
+		r.netw.SetVPN(v)
+	}
+
+	if current.KillSwitch && !imported.KillSwitch {
+		if err := r.netw.UnsetKillSwitch(); err != nil {
+			return fmt.Errorf("unsetting kill switch: %w", err)
+		}
+	}
+
+	if imported.Firewall != current.Firewall {
+		toggleFirewall := r.netw.DisableFirewall
+		if imported.Firewall {
+			toggleFirewall = r.netw.EnableFirewall
+		}
+		if err := toggleFirewall(); err != nil {
+			return fmt.Errorf("toggling firewall: %w", err)
+		}
+	}
+
+	if imported.LanDiscovery != current.LanDiscovery {
+		r.netw.SetLanDiscovery(imported.LanDiscovery)
+	}
+
+	if imported.RouteMetric != current.RouteMetric {
+		r.netw.SetRouteMetric(imported.RouteMetric)
+	}
+
+	allowlist := imported.AutoConnectData.Allowlist
+	if err := r.netw.SetAllowlist(allowlist); err != nil {
+		return fmt.Errorf("setting allowlist: %w", err)
+	}
+
+	if imported.KillSwitch {
+		if err := r.netw.SetKillSwitch(allowlist); err != nil {
+			return fmt.Errorf("setting kill switch: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettingsExportImport(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	source := mock.NewMockConfigManager()
+	source.Cfg.Technology = config.Technology_NORDLYNX
+	source.Cfg.Firewall = true
+	source.Cfg.AutoConnectData.Protocol = config.Protocol_UDP
+	source.Cfg.AutoConnectData.DNS = config.DNS{"1.1.1.1"}
+	source.Cfg.AutoConnectData.Allowlist = config.NewAllowlist([]int64{22}, nil, []string{"1.2.3.0/24"})
+	source.Cfg.Favorites = config.Favorites{"home": {ServerTag: "lt"}}
+	source.Cfg.TokensData = map[int64]config.TokenData{1: {Token: "secret"}}
+	exporter := RPC{cm: source}
+
+	resp, err := exporter.ExportSettings(context.Background(), &pb.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.NotContains(t, resp.Data[0], "secret")
+
+	target := mock.NewMockConfigManager()
+	target.Cfg.Technology = config.Technology_NORDLYNX
+	target.Cfg.TokensData = map[int64]config.TokenData{2: {Token: "kept"}}
+	target.Cfg.UsersData = &config.UsersData{}
+	netw := &networker.Mock{}
+	importer := RPC{
+		cm:     target,
+		netw:   netw,
+		events: events.NewEventsEmpty(),
+		dm:     testNewDataManager(),
+	}
+
+	resp, err = importer.ImportSettings(context.Background(), &pb.ImportSettingsRequest{Settings: resp.Data[0]})
+	require.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.True(t, target.Cfg.Firewall)
+	assert.Equal(t, source.Cfg.AutoConnectData.DNS, target.Cfg.AutoConnectData.DNS)
+	assert.Equal(t, source.Cfg.AutoConnectData.Allowlist, target.Cfg.AutoConnectData.Allowlist)
+	assert.Equal(t, source.Cfg.AutoConnectData.Allowlist, netw.Allowlist)
+	assert.Equal(t, source.Cfg.Favorites, target.Cfg.Favorites)
+	assert.Equal(t, "kept", target.Cfg.TokensData[2].Token)
+}
+
+func TestImportSettings_Rejected(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	valid := `{"version":1,"technology":"NORDLYNX","protocol":"UDP","postquantum_vpn":true}`
+	tests := []struct {
+		name         string
+		settings     string
+		vpnActive    bool
+		mesh         bool
+		expectedCode int64
+	}{
+		{name: "invalid document", settings: `{"version":1}`, expectedCode: internal.CodeFormatError},
+		{name: "newer version", settings: `{"version":100}`, expectedCode: internal.CodeOutdated},
+		{
+			name:         "invalid DNS",
+			settings:     `{"version":1,"technology":"NORDLYNX","protocol":"UDP","dns":["dns"]}`,
+			expectedCode: internal.CodeFormatError,
+		},
+		{name: "VPN is active", settings: valid, vpnActive: true, expectedCode: internal.CodeVPNRunning},
+		{name: "post-quantum with meshnet", settings: valid, mesh: true, expectedCode: internal.CodePqAndMeshnetSimultaneously},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Mesh = test.mesh
+			r := RPC{
+				cm:     cm,
+				netw:   &networker.Mock{VpnActive: test.vpnActive},
+				events: events.NewEventsEmpty(),
+				dm:     testNewDataManager(),
+			}
+
+			resp, err := r.ImportSettings(context.Background(), &pb.ImportSettingsRequest{Settings: test.settings})
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedCode, resp.Type)
+			assert.False(t, cm.Cfg.AutoConnectData.PostquantumVpn)
+		})
+	}
+}
@@ -26,6 +26,8 @@ func configToProtobuf(cfg *config.Config, uid int64) *pb.Settings {
 
 	notifyOff := cfg.UsersData.NotifyOff[uid]
 	trayOff := cfg.UsersData.TrayOff[uid]
+	trayIconTheme := cfg.UsersData.TrayIconTheme[uid]
+	autoConnectTarget := cfg.AutoConnectTargetFor(uid)
 
 	settings := pb.Settings{
 		Technology: cfg.Technology,
@@ -35,10 +37,10 @@ func configToProtobuf(cfg *config.Config, uid int64) *pb.Settings {
 		Analytics:  cfg.Analytics.Get(),
 		KillSwitch: cfg.KillSwitch,
 		AutoConnectData: &pb.AutoconnectData{
-			Enabled:     cfg.AutoConnect,
-			Country:     cfg.AutoConnectData.Country,
-			City:        cfg.AutoConnectData.City,
-			ServerGroup: cfg.AutoConnectData.Group,
+			Enabled:     cfg.AutoConnectFor(uid),
+			Country:     autoConnectTarget.Country,
+			City:        autoConnectTarget.City,
+			ServerGroup: autoConnectTarget.Group,
 		},
 		Ipv6:                 cfg.IPv6,
 		Meshnet:              cfg.Mesh,
@@ -50,12 +52,34 @@ func configToProtobuf(cfg *config.Config, uid int64) *pb.Settings {
 			Ports:   &ports,
 			Subnets: subnets,
 		},
-		Obfuscate:       cfg.AutoConnectData.Obfuscate,
-		VirtualLocation: cfg.VirtualLocation.Get(),
+		Obfuscate:           cfg.AutoConnectData.Obfuscate,
+		VirtualLocation:     cfg.VirtualLocation.Get(),
+		TrayMinimal:         cfg.TrayMinimal,
+		TrayMenu:            cfg.TrayMenu,
+		LogLevel:            string(internal.LogLevelOrDefault(cfg.LogLevel)),
+		TrustedNetworks:     trustedNetworksToProtobuf(cfg.TrustedNetworks),
+		Mtu:                 cfg.MTU,
+		DeferOnMetered:      cfg.DeferOnMetered,
+		ObfuscationFallback: cfg.ObfuscationFallback.Get(),
+		MaxServerLoad:       uint32(cfg.MaxServerLoad),
+		ConnectRetry:        connectRetryToProtobuf(cfg.ConnectRetry),
+		Stealth:             stealthToProtobuf(cfg.AutoConnectData.Stealth),
+		InterfaceName:       cfg.InterfaceName,
+		RoutingTable:        cfg.RoutingTable,
+		RouteMetric:         cfg.RouteMetric,
+		FileshareFileLimit:  cfg.Meshnet.FileshareFileLimit,
+		PersistentKeepalive: uint32(cfg.PersistentKeepalive),
+		HealthMonitor:       string(cfg.HealthMonitorOrDefault()),
+		ConnectTimeouts:     connectTimeoutsToProtobuf(cfg.ConnectTimeouts),
+		AutoTechnology:      cfg.AutoTechnology,
+		OpenvpnDirectives:   cfg.OpenVPNDirectives,
+		AnalyticsConsent:    analyticsConsentToProtobuf(cfg.AnalyticsConsent),
 		UserSettings: &pb.UserSpecificSettings{
-			Uid:    uid,
-			Notify: !notifyOff,
-			Tray:   !trayOff,
+			Uid:           uid,
+			Notify:        !notifyOff,
+			Tray:          !trayOff,
+			TrayIconTheme: trayIconTheme,
+			TrayHotkey:    cfg.UsersData.TrayHotkey[uid],
 		},
 	}
 
@@ -63,9 +87,10 @@ func configToProtobuf(cfg *config.Config, uid int64) *pb.Settings {
 }
 
 // statusStream starts streaming status events received by stateChan to the subscriber. When the stream is stopped(i.e
-// when subscribers stops listening), stopChan will be closed.
+// when subscribers stops listening or the daemon shuts down), stopChan will be closed.
 func statusStream(stateChan <-chan interface{},
 	stopChan chan<- struct{},
+	shutdown <-chan struct{},
 	uid int64,
 	srv pb.Daemon_SubscribeToStateChangesServer) {
 	for {
@@ -73,6 +98,9 @@ func statusStream(stateChan <-chan interface{},
 		case <-srv.Context().Done():
 			close(stopChan)
 			return
+		case <-shutdown:
+			close(stopChan)
+			return
 		case ev := <-stateChan:
 			switch e := ev.(type) {
 			case events.DataConnect:
@@ -144,7 +172,7 @@ func (r *RPC) SubscribeToStateChanges(_ *pb.Empty, srv pb.Daemon_SubscribeToStat
 	}
 
 	stateChan, stopChan := r.statePublisher.AddSubscriber()
-	statusStream(stateChan, stopChan, uid, srv)
+	statusStream(stateChan, stopChan, r.shutdown, uid, srv)
 
 	return nil
 }
@@ -0,0 +1,93 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// serverLoadRefreshInterval defines how long the load of the current server is cached before it is requested again
+const serverLoadRefreshInterval = 5 * time.Minute
+
+// serverLoadCache keeps the load of the current server, so frequent statistics requests do not reach the API
+type serverLoadCache struct {
+	mu        sync.Mutex
+	serverID  int64
+	load      int64
+	updatedAt time.Time
+}
+
+// Statistics returns the statistics of the active VPN connection
+func (r *RPC) Statistics(context.Context, *pb.Empty) (*pb.StatisticsResponse, error) {
+	status, err := r.netw.ConnectionStatus()
+	if err != nil {
+		return &pb.StatisticsResponse{Uptime: -1, ServerLoad: -1}, nil
+	}
+	r.continueResumedSession(&status)
+
+	uptime := int64(-1)
+	if status.Uptime != nil {
+		uptime = int64(*status.Uptime)
+	}
+
+	return &pb.StatisticsResponse{
+		Connected:  true,
+		Download:   status.Download,
+		Upload:     status.Upload,
+		Uptime:     uptime,
+		ServerLoad: r.serverLoad(status.Hostname),
+		Technology: status.Technology,
+		Protocol:   status.Protocol,
+		Hostname:   status.Hostname,
+	}, nil
+}
+
+// serverLoad returns the load of the server with the given hostname or -1 if the server is not known, e.g. when
+// connected to the meshnet peer
+func (r *RPC) serverLoad(hostname string) int64 {
+	server, ok := r.findServer(hostname)
+	if !ok {
+		return -1
+	}
+
+	r.serverLoadCache.mu.Lock()
+	defer r.serverLoadCache.mu.Unlock()
+
+	cache := &r.serverLoadCache
+	if cache.serverID == server.ID && time.Since(cache.updatedAt) < serverLoadRefreshInterval {
+		return cache.load
+	}
+
+	// load in the servers list is only as fresh as the list itself, so the current value is requested
+	load := server.Load
+	if fresh, err := r.serversAPI.Server(server.ID); err == nil && fresh != nil {
+		load = fresh.Load
+	} else {
+		log.Println(internal.WarningPrefix, "failed to get the load of the current server:", err)
+	}
+
+	cache.serverID = server.ID
+	cache.load = load
+	cache.updatedAt = time.Now()
+	return load
+}
+
+func (r *RPC) findServer(hostname string) (core.Server, bool) {
+	if hostname == "" {
+		return core.Server{}, false
+	}
+	if r.lastServer.Hostname == hostname {
+		return r.lastServer, true
+	}
+	for _, server := range r.dm.GetServersData().Servers {
+		if server.Hostname == hostname {
+			return server, true
+		}
+	}
+	return core.Server{}, false
+}
@@ -0,0 +1,78 @@
+package daemon
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+// loadServersAPI returns the configured load of the server and counts the requests
+type loadServersAPI struct {
+	core.ServersAPI
+	load     int64
+	err      error
+	requests int
+}
+
+func (a *loadServersAPI) Server(id int64) (*core.Server, error) {
+	a.requests++
+	if a.err != nil {
+		return nil, a.err
+	}
+	return &core.Server{ID: id, Load: a.load}, nil
+}
+
+func TestServerLoad(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	lastServer := core.Server{ID: 7, Hostname: "de7.nordvpn.com", Load: 10}
+
+	tests := []struct {
+		name         string
+		hostname     string
+		api          *loadServersAPI
+		expectedLoad int64
+	}{
+		{
+			name:         "fresh load is requested",
+			hostname:     lastServer.Hostname,
+			api:          &loadServersAPI{load: 42},
+			expectedLoad: 42,
+		},
+		{
+			name:         "load from the servers list is used when API fails",
+			hostname:     lastServer.Hostname,
+			api:          &loadServersAPI{err: fmt.Errorf("500")},
+			expectedLoad: 10,
+		},
+		{
+			name:         "unknown server",
+			hostname:     "peer.nord",
+			api:          &loadServersAPI{load: 42},
+			expectedLoad: -1,
+		},
+		{
+			name:         "not connected",
+			api:          &loadServersAPI{load: 42},
+			expectedLoad: -1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rpc := RPC{
+				dm:         &DataManager{},
+				serversAPI: test.api,
+				lastServer: lastServer,
+			}
+
+			assert.Equal(t, test.expectedLoad, rpc.serverLoad(test.hostname))
+			// cached value is used for the subsequent requests
+			assert.Equal(t, test.expectedLoad, rpc.serverLoad(test.hostname))
+			assert.LessOrEqual(t, test.api.requests, 1)
+		})
+	}
+}
@@ -2,22 +2,337 @@ package daemon
 
 import (
 	"context"
+	"errors"
 	"log"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/events"
 	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/networker"
+	"github.com/NordSecurity/nordvpn-linux/norduser/service"
+
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// statusStreamInterval defines how often status is refreshed for the StatusStream subscribers while connected
+	statusStreamInterval = 2 * time.Second
+	// statusStreamCheckInterval defines how often the connection is checked for changes not published as events
+	statusStreamCheckInterval = 500 * time.Millisecond
+	// statusEventTimeout defines how long the state derived from the connection event is kept if the connection
+	// does not reach it
+	statusEventTimeout = time.Minute
 )
 
 // Status of daemon and connection
-func (r *RPC) Status(context.Context, *pb.Empty) (*pb.StatusResponse, error) {
+func (r *RPC) Status(ctx context.Context, _ *pb.Empty) (*pb.StatusResponse, error) {
+	return r.statusForCaller(ctx), nil
+}
+
+// StatusStream sends status of daemon and connection to the subscriber as soon as the connection state or the server
+// changes. While connected, status is also refreshed periodically to keep transfer statistics up to date.
+func (r *RPC) StatusStream(_ *pb.Empty, srv pb.Daemon_StatusStreamServer) error {
+	ctx := srv.Context()
+	stateChan, stopChan := r.statePublisher.AddSubscriber()
+	defer close(stopChan)
+
+	checkTicker := time.NewTicker(statusStreamCheckInterval)
+	defer checkTicker.Stop()
+	refreshTicker := time.NewTicker(statusStreamInterval)
+	defer refreshTicker.Stop()
+
+	transitions := newStatusTransitions(r.statusForCaller(ctx))
+	status := transitions.last
+	for {
+		if status != nil {
+			if err := srv.Send(status); err != nil {
+				log.Println(internal.ErrorPrefix, "failed to send status:", err)
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.shutdown:
+			return nil
+		case ev := <-stateChan:
+			status = transitions.fromEvent(ev, r.statusForCaller(ctx))
+		case <-checkTicker.C:
+			status = transitions.fromCheck(r.statusForCaller(ctx))
+		case <-refreshTicker.C:
+			status = transitions.refresh(r.statusForCaller(ctx))
+		}
+	}
+}
+
+// statusTransitions decides which statuses are pushed to the StatusStream subscriber. Connecting and reconnecting
+// states are only known from the VPN events, so the state derived from the event is kept until the checked status
+// agrees with it, another event arrives or the event expires.
+type statusTransitions struct {
+	last *pb.StatusResponse
+	// pending is the state derived from the last event, empty if the checked status is used
+	pending      string
+	pendingSince time.Time
+	now          func() time.Time
+}
+
+func newStatusTransitions(initial *pb.StatusResponse) *statusTransitions {
+	return &statusTransitions{last: initial, now: time.Now}
+}
+
+// fromEvent returns the status to be sent after the connection event or nil for other events
+func (s *statusTransitions) fromEvent(ev interface{}, current *pb.StatusResponse) *pb.StatusResponse {
+	var status *pb.StatusResponse
+	switch e := ev.(type) {
+	case events.DataConnect:
+		state := "Connected"
+		if e.EventStatus == events.StatusAttempt {
+			// VPN publishes connection attempts on its own when the tunnel is lost and re-established
+			state = "Connecting"
+			if s.last.State == "Connected" || s.last.State == "Reconnecting" {
+				state = "Reconnecting"
+			}
+		} else if e.EventStatus != events.StatusSuccess {
+			state = "Disconnected"
+		}
+
+		if current.State == state {
+			status = current
+		} else if state == "Disconnected" {
+			status = disconnectedStatus(current)
+		} else {
+			status = &pb.StatusResponse{
+				State:          state,
+				Ip:             e.TargetServerIP,
+				Hostname:       e.TargetServerDomain,
+				Name:           e.TargetServerName,
+				Country:        e.TargetServerCountry,
+				City:           e.TargetServerCity,
+				Uptime:         -1,
+				Parameters:     current.Parameters,
+				NorduserHealth: current.NorduserHealth,
+			}
+			// not every VPN implementation includes the server names in the events
+			if status.Hostname == "" && status.Ip == s.last.Ip {
+				status.Hostname = s.last.Hostname
+				status.Name = s.last.Name
+				status.VirtualLocation = s.last.VirtualLocation
+			}
+		}
+	case events.DataDisconnect:
+		status = disconnectedStatus(current)
+	default:
+		return nil
+	}
+
+	if s.pending == "" && !statusChanged(s.last, status) {
+		return nil
+	}
+
+	s.pending = ""
+	if status != current {
+		s.pending = status.State
+		s.pendingSince = s.now()
+	}
+	s.last = status
+	return status
+}
+
+// fromCheck returns the checked status if it differs from the last sent one
+func (s *statusTransitions) fromCheck(current *pb.StatusResponse) *pb.StatusResponse {
+	if s.pending != "" {
+		if current.State != s.pending && s.now().Sub(s.pendingSince) < statusEventTimeout {
+			return nil
+		}
+		// checked status has more details than the event, so it is sent even if the state is the same
+		s.pending = ""
+		s.last = current
+		return current
+	}
+
+	if !statusChanged(s.last, current) {
+		return nil
+	}
+	s.last = current
+	return current
+}
+
+// refresh returns the current status if connected, so the transfer statistics and uptime are updated
+func (s *statusTransitions) refresh(current *pb.StatusResponse) *pb.StatusResponse {
+	if s.pending != "" || s.last.State != "Connected" || current.State != "Connected" {
+		return s.fromCheck(current)
+	}
+	s.last = current
+	return current
+}
+
+func disconnectedStatus(current *pb.StatusResponse) *pb.StatusResponse {
+	return &pb.StatusResponse{
+		State:          "Disconnected",
+		Uptime:         -1,
+		NorduserHealth: current.NorduserHealth,
+		PausedUntil:    current.PausedUntil,
+	}
+}
+
+// statusChanged reports whether the connection state, the server, the pause or the captive portal is different
+func statusChanged(previous *pb.StatusResponse, current *pb.StatusResponse) bool {
+	return previous.State != current.State ||
+		previous.Ip != current.Ip ||
+		previous.Hostname != current.Hostname ||
+		previous.VirtualLocation != current.VirtualLocation ||
+		previous.NorduserHealth != current.NorduserHealth ||
+		previous.PausedUntil != current.PausedUntil ||
+		previous.CaptivePortal != current.CaptivePortal
+}
+
+// StatusVerbose returns status of daemon and connection along with the health statistics of the tunnel
+func (r *RPC) StatusVerbose(ctx context.Context, _ *pb.Empty) (*pb.StatusVerboseResponse, error) {
+	status := r.statusForCaller(ctx)
+	if status.State == "Disconnected" {
+		return &pb.StatusVerboseResponse{Status: status}, nil
+	}
+
+	return &pb.StatusVerboseResponse{
+		Status: status,
+		Health: r.tunnelHealth(status.Ip),
+	}, nil
+}
+
+// statusForCaller adds the details specific to the user making the request to the status
+func (r *RPC) statusForCaller(ctx context.Context) *pb.StatusResponse {
+	status := r.status()
+	status.NorduserHealth = r.norduserHealth(ctx)
+	if r.metered != nil {
+		status.Metered = r.metered.IsMetered()
+		status.MeteredDeferral = r.metered.Deferring()
+	}
+	if r.captivePortal != nil {
+		status.CaptivePortal = r.captivePortal.URL()
+	}
+	return status
+}
+
+// ServicesStatus reports the results of norduserd health checks
+func (r *RPC) ServicesStatus(ctx context.Context, _ *pb.Empty) (*pb.ServicesStatusResponse, error) {
+	if r.norduserMonitor == nil {
+		return &pb.ServicesStatusResponse{}, nil
+	}
+	uid, ok := callerUID(ctx)
+	if !ok {
+		return &pb.ServicesStatusResponse{}, nil
+	}
+
+	var statuses []service.UserStatus
+	if uid == 0 {
+		statuses = r.norduserMonitor.Statuses()
+	} else {
+		statuses = []service.UserStatus{r.norduserMonitor.Status(uid)}
+	}
+
+	resp := pb.ServicesStatusResponse{}
+	for _, status := range statuses {
+		norduserStatus := &pb.NorduserStatus{
+			Uid:             status.UID,
+			Running:         status.Running,
+			SocketReachable: status.SocketReachable,
+			Version:         status.Version,
+			Uptime:          int64(status.Uptime),
+			Health:          r.norduserHealthForUID(status.UID),
+			Outdated:        status.Outdated,
+		}
+		if status.Err != nil {
+			norduserStatus.Error = status.Err.Error()
+		}
+		resp.Norduser = append(resp.Norduser, norduserStatus)
+	}
+	return &resp, nil
+}
+
+// callerUID returns the uid of the user making the request
+func callerUID(ctx context.Context) (uint32, bool) {
+	peer, ok := peer.FromContext(ctx)
+	if !ok {
+		return 0, false
+	}
+	cred, ok := peer.AuthInfo.(internal.UcredAuth)
+	if !ok {
+		return 0, false
+	}
+	return cred.Uid, true
+}
+
+// norduserHealth reports whether norduserd of the caller is running if the norduser service supervises it
+func (r *RPC) norduserHealth(ctx context.Context) pb.NorduserHealth {
+	uid, ok := callerUID(ctx)
+	if !ok {
+		return pb.NorduserHealth_NORDUSER_UNKNOWN
+	}
+	return r.norduserHealthForUID(uid)
+}
+
+func (r *RPC) norduserHealthForUID(uid uint32) pb.NorduserHealth {
+	reporter, ok := r.norduser.(service.HealthReporter)
+	if !ok {
+		return pb.NorduserHealth_NORDUSER_UNKNOWN
+	}
+
+	switch reporter.Health(uid) {
+	case service.HealthRunning:
+		return pb.NorduserHealth_NORDUSER_RUNNING
+	case service.HealthRestarting:
+		return pb.NorduserHealth_NORDUSER_RESTARTING
+	case service.HealthFailed:
+		return pb.NorduserHealth_NORDUSER_FAILED
+	case service.HealthStopped:
+		return pb.NorduserHealth_NORDUSER_STOPPED
+	case service.HealthUnknown:
+	}
+	return pb.NorduserHealth_NORDUSER_UNKNOWN
+}
+
+func (r *RPC) tunnelHealth(serverIP string) *pb.TunnelHealth {
+	health := pb.TunnelHealth{Rtt: -1}
+
+	stats, err := r.netw.SessionStats()
+	if err == nil {
+		health.HandshakeAvailable = true
+		health.LastHandshakeAge = int64(time.Since(stats.LastHandshake))
+		health.Rekeys = stats.Rekeys
+	} else if !errors.Is(err, networker.ErrSessionStatsNotSupported) {
+		log.Println(internal.WarningPrefix, "failed to retrieve tunnel session statistics:", err)
+	}
+
+	pingStats, ok := r.serverPing.get(serverIP)
+	if !ok {
+		return &health
+	}
+	health.PingsSent = uint32(pingStats.Sent)
+	health.PingsReceived = uint32(pingStats.Received)
+	if pingStats.Received > 0 {
+		health.Rtt = int64(pingStats.AvgRTT)
+	}
+
+	return &health
+}
+
+func (r *RPC) status() *pb.StatusResponse {
 	if !r.netw.IsVPNActive() {
 		return &pb.StatusResponse{
-			State:  "Disconnected",
-			Uptime: -1,
-		}, nil
+			State:       "Disconnected",
+			Uptime:      -1,
+			PausedUntil: r.pause.pausedUntil(),
+		}
 	}
 
 	status, _ := r.netw.ConnectionStatus()
+	r.continueResumedSession(&status)
 
 	var uptime int64
 	if status.Uptime != nil {
@@ -44,7 +359,7 @@ func (r *RPC) Status(context.Context, *pb.Empty) (*pb.StatusResponse, error) {
 		log.Println(internal.WarningPrefix, "failed to read connection parameters:", err)
 	}
 
-	return &pb.StatusResponse{
+	resp := &pb.StatusResponse{
 		State:           string(status.State),
 		Technology:      status.Technology,
 		Protocol:        status.Protocol,
@@ -57,11 +372,52 @@ func (r *RPC) Status(context.Context, *pb.Empty) (*pb.StatusResponse, error) {
 		Upload:          status.Upload,
 		Uptime:          uptime,
 		VirtualLocation: status.VirtualLocation,
+		UserspaceTunnel: status.UserSpace,
+		SplitTunnel:     r.splitTunnelStatus(),
 		Parameters: &pb.ConnectionParameters{
 			Source:  connectionParameters.ConnectionSource,
 			Country: connectionParameters.Parameters.Country,
 			City:    connectionParameters.Parameters.City,
 			Group:   connectionParameters.Parameters.Group,
 		},
-	}, nil
+	}
+	r.addServerFeatures(resp)
+	return resp
+}
+
+// continueResumedSession counts the uptime and the bytes transferred from the start of the session which was
+// resumed after the daemon restart instead of from the start of the current tunnel
+func (r *RPC) continueResumedSession(status *networker.ConnectionStatus) {
+	if r.connectionHistory == nil {
+		return
+	}
+	started, download, upload, ok := r.connectionHistory.ResumedSession()
+	if !ok {
+		return
+	}
+	uptime := time.Since(started)
+	status.Uptime = &uptime
+	status.Download += download
+	status.Upload += upload
+}
+
+// addServerFeatures fills in the details of the connected server which are known only from the servers list
+func (r *RPC) addServerFeatures(status *pb.StatusResponse) {
+	if status.Hostname == "" {
+		return
+	}
+	servers := r.dm.GetServersData().Servers
+	index := slices.IndexFunc(servers, func(server core.Server) bool {
+		return strings.EqualFold(server.Hostname, status.Hostname)
+	})
+	if index == -1 {
+		return
+	}
+
+	server := servers[index]
+	status.VirtualLocation = status.VirtualLocation || server.IsVirtualLocation()
+	status.Obfuscated = slices.ContainsFunc(server.Groups, core.ByGroup(config.ServerGroup_OBFUSCATED))
+	status.P2P = slices.ContainsFunc(server.Groups, core.ByGroup(config.ServerGroup_P2P))
+	status.DedicatedIp = isDedicatedIP(server)
+	status.DatacenterCountry = server.DatacenterCountry()
 }
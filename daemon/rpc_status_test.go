@@ -0,0 +1,162 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusTransitions(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	disconnected := &pb.StatusResponse{State: "Disconnected", Uptime: -1}
+	connected := &pb.StatusResponse{
+		State:    "Connected",
+		Ip:       "1.2.3.4",
+		Hostname: "de1.nordvpn.com",
+		Name:     "Germany #1",
+		Download: 100,
+		Uptime:   int64(time.Second),
+	}
+	now := time.Now()
+	transitions := newStatusTransitions(disconnected)
+	transitions.now = func() time.Time { return now }
+
+	// nothing changed
+	assert.Nil(t, transitions.fromCheck(disconnected))
+	assert.Nil(t, transitions.refresh(disconnected))
+	assert.Nil(t, transitions.fromEvent(pb.UpdateEvent_SERVERS_LIST_UPDATE, disconnected))
+
+	status := transitions.fromEvent(events.DataConnect{
+		EventStatus:        events.StatusAttempt,
+		TargetServerIP:     "1.2.3.4",
+		TargetServerDomain: "de1.nordvpn.com",
+		TargetServerName:   "Germany #1",
+	}, disconnected)
+	assert.Equal(t, "Connecting", status.State)
+	assert.Equal(t, "de1.nordvpn.com", status.Hostname)
+	// tunnel is not up yet, connecting state is kept
+	assert.Nil(t, transitions.fromCheck(disconnected))
+
+	status = transitions.fromEvent(events.DataConnect{EventStatus: events.StatusSuccess}, connected)
+	assert.Equal(t, connected, status)
+	assert.Nil(t, transitions.fromCheck(connected))
+
+	// transfer statistics are refreshed while connected
+	assert.Equal(t, connected, transitions.refresh(connected))
+
+	// tunnel was lost and VPN is connecting again on its own
+	status = transitions.fromEvent(events.DataConnect{
+		EventStatus:    events.StatusAttempt,
+		TargetServerIP: "1.2.3.4",
+	}, connected)
+	assert.Equal(t, "Reconnecting", status.State)
+	assert.Equal(t, "de1.nordvpn.com", status.Hostname, "server details should be kept when missing in event")
+	assert.Nil(t, transitions.fromCheck(connected))
+	assert.Nil(t, transitions.refresh(connected))
+
+	// reconnecting state expires if the connection is not re-established
+	now = now.Add(statusEventTimeout)
+	assert.Equal(t, disconnected, transitions.fromCheck(disconnected))
+
+	// already reported as disconnected
+	assert.Nil(t, transitions.fromEvent(events.DataDisconnect{ByUser: true}, disconnected))
+	assert.Nil(t, transitions.fromCheck(disconnected))
+}
+
+func TestStatusTransitions_ServerChangeDetectedByCheck(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	connected := &pb.StatusResponse{State: "Connected", Ip: "1.2.3.4", Hostname: "de1.nordvpn.com"}
+	transitions := newStatusTransitions(connected)
+
+	assert.Nil(t, transitions.fromCheck(&pb.StatusResponse{State: "Connected", Ip: "1.2.3.4",
+		Hostname: "de1.nordvpn.com", Download: 10}))
+
+	switched := &pb.StatusResponse{State: "Connected", Ip: "5.6.7.8", Hostname: "de2.nordvpn.com"}
+	assert.Equal(t, switched, transitions.fromCheck(switched))
+}
+
+func TestAddServerFeatures(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	specification := func(identifier string, value string) core.Specification {
+		spec := core.Specification{Identifier: identifier}
+		spec.Values = append(spec.Values, struct {
+			Value string `json:"value"`
+		}{Value: value})
+		return spec
+	}
+	location := func(country string) core.Locations {
+		return core.Locations{{Country: core.Country{Name: country}}}
+	}
+
+	dm := testNewDataManager()
+	dm.SetServersData(time.Now(), core.Servers{
+		{
+			Hostname:  "pk3.nordvpn.com",
+			Locations: location("Pakistan"),
+			Groups:    core.Groups{{ID: config.ServerGroup_P2P}},
+			Specifications: []core.Specification{
+				specification(core.VirtualLocation, "true"),
+				specification(core.DatacenterCountry, "Singapore"),
+			},
+		},
+		{
+			Hostname:  "de3.nordvpn.com",
+			Locations: location("Germany"),
+			Groups:    core.Groups{{ID: config.ServerGroup_OBFUSCATED}, {ID: config.ServerGroup_DEDICATED_IP}},
+		},
+	}, "", time.Time{})
+	r := RPC{dm: dm}
+
+	tests := []struct {
+		name     string
+		hostname string
+		expected *pb.StatusResponse
+	}{
+		{
+			name:     "virtual location",
+			hostname: "pk3.nordvpn.com",
+			expected: &pb.StatusResponse{
+				Hostname:          "pk3.nordvpn.com",
+				VirtualLocation:   true,
+				P2P:               true,
+				DatacenterCountry: "Singapore",
+			},
+		},
+		{
+			name:     "physical location",
+			hostname: "DE3.nordvpn.com",
+			expected: &pb.StatusResponse{
+				Hostname:          "DE3.nordvpn.com",
+				Obfuscated:        true,
+				DedicatedIp:       true,
+				DatacenterCountry: "Germany",
+			},
+		},
+		{
+			name:     "server not in the list",
+			hostname: "fr1.nordvpn.com",
+			expected: &pb.StatusResponse{Hostname: "fr1.nordvpn.com"},
+		},
+		{
+			name:     "disconnected",
+			expected: &pb.StatusResponse{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status := &pb.StatusResponse{Hostname: test.hostname}
+			r.addServerFeatures(status)
+			assert.Equal(t, test.expected, status)
+		})
+	}
+}
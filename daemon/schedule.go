@@ -0,0 +1,112 @@
+package daemon
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const (
+	// scheduleCheckInterval is how often the schedule rules are checked
+	scheduleCheckInterval = time.Minute
+	// scheduleMissedTolerance limits how late the rule is applied, e.g. after the device wakes up, rules missed by
+	// more than that are skipped
+	scheduleMissedTolerance = 5 * time.Minute
+	// scheduleManualOverride is how long the manual connect or disconnect takes precedence over the schedule rules
+	scheduleManualOverride = 30 * time.Minute
+)
+
+// vpnSchedule tracks which schedule rules are due and when the user connected or disconnected manually
+type vpnSchedule struct {
+	mu      sync.Mutex
+	checked time.Time
+	manual  time.Time
+	now     func() time.Time
+}
+
+func newVPNSchedule(now func() time.Time) *vpnSchedule {
+	return &vpnSchedule{checked: now(), now: now}
+}
+
+// recordManual remembers the time of the connect or disconnect made by the user
+func (s *vpnSchedule) recordManual() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.manual = s.now()
+}
+
+// due returns the latest rule which became due since the last check. Overridden is true if the user connected or
+// disconnected manually shortly before the rule became due or after it, the rule should be skipped then.
+func (s *vpnSchedule) due(rules config.ScheduleRules) (name string, rule config.ScheduleRule, overridden bool, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	from := s.checked
+	if from.Before(now.Add(-scheduleMissedTolerance)) {
+		from = now.Add(-scheduleMissedTolerance)
+	}
+	s.checked = now
+
+	var latest time.Time
+	for _, ruleName := range rules.SortedNames() {
+		at, isDue := rules[ruleName].Due(from, now)
+		if isDue && at.After(latest) {
+			latest, name, rule, ok = at, ruleName, rules[ruleName], true
+		}
+	}
+	if !ok {
+		return "", config.ScheduleRule{}, false, false
+	}
+	overridden = !s.manual.IsZero() && s.manual.After(latest.Add(-scheduleManualOverride))
+	return name, rule, overridden, true
+}
+
+// isManual reports whether the connect or disconnect request was made by the user rather than by the daemon itself
+func isManual(srv any) bool {
+	_, internalRequest := srv.(*autoconnectServer)
+	return !internalRequest
+}
+
+// runSchedule applies the schedule rule which became due since the last check
+func (r *RPC) runSchedule() {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, "loading config for schedule:", err)
+		return
+	}
+
+	name, rule, overridden, ok := r.schedule.due(cfg.Schedule)
+	if !ok {
+		return
+	}
+	if overridden {
+		log.Println(internal.InfoPrefix, "schedule rule", name, "skipped after manual", rule.Action)
+		return
+	}
+
+	switch rule.Action {
+	case config.ScheduleConnect:
+		if !r.ac.IsLoggedIn() || r.netw.IsVPNActive() {
+			return
+		}
+		log.Println(internal.InfoPrefix, "schedule rule", name, "connects")
+		server := autoconnectServer{}
+		err := r.Connect(&pb.ConnectRequest{ServerTag: rule.ServerTag, ServerGroup: rule.ServerGroup}, &server)
+		if !connectErrorCheck(err) || server.err != nil {
+			log.Println(internal.ErrorPrefix, "scheduled connect failed, err1:", server.err, "| err2:", err)
+		}
+	case config.ScheduleDisconnect:
+		if !r.netw.IsVPNActive() {
+			return
+		}
+		log.Println(internal.InfoPrefix, "schedule rule", name, "disconnects")
+		if err := r.Disconnect(&pb.Empty{}, &autoconnectServer{}); err != nil {
+			log.Println(internal.ErrorPrefix, "scheduled disconnect failed:", err)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVPNSchedule_Due(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	rules := config.ScheduleRules{
+		"morning": {Action: config.ScheduleConnect, At: "09:00"},
+		"evening": {Action: config.ScheduleDisconnect, At: "18:00"},
+	}
+	day := func(hour, minute int) time.Time { return time.Date(2024, 1, 5, hour, minute, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name               string
+		checked            time.Time
+		manual             time.Time
+		now                time.Time
+		expectedName       string
+		expectedOverridden bool
+		expectedDue        bool
+	}{
+		{
+			name:         "rule due since the last check",
+			checked:      day(8, 59),
+			now:          day(9, 0),
+			expectedName: "morning",
+			expectedDue:  true,
+		},
+		{
+			name:    "no rule due",
+			checked: day(9, 0),
+			now:     day(9, 1),
+		},
+		{
+			name:         "latest rule after the long gap",
+			checked:      day(17, 58),
+			now:          day(18, 2),
+			expectedName: "evening",
+			expectedDue:  true,
+		},
+		{
+			name:    "rule missed by more than the tolerance",
+			checked: day(8, 0),
+			now:     day(9, 30),
+		},
+		{
+			name:               "manual action before the rule",
+			checked:            day(17, 59),
+			manual:             day(17, 45),
+			now:                day(18, 0),
+			expectedName:       "evening",
+			expectedOverridden: true,
+			expectedDue:        true,
+		},
+		{
+			name:         "manual action long before the rule",
+			checked:      day(17, 59),
+			manual:       day(12, 0),
+			now:          day(18, 0),
+			expectedName: "evening",
+			expectedDue:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schedule := newVPNSchedule(func() time.Time { return test.checked })
+			schedule.manual = test.manual
+			schedule.now = func() time.Time { return test.now }
+
+			name, _, overridden, due := schedule.due(rules)
+			assert.Equal(t, test.expectedDue, due)
+			assert.Equal(t, test.expectedName, name)
+			assert.Equal(t, test.expectedOverridden, overridden)
+
+			// rule is applied once
+			_, _, _, due = schedule.due(rules)
+			assert.False(t, due)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync/atomic"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// readinessAPITimeout limits how long the startup waits for the API reachability check
+const readinessAPITimeout = 20 * time.Second
+
+// SdNotifyFunc sends the service state to systemd, e.g. internal.SdNotify
+type SdNotifyFunc func(state string) (bool, error)
+
+var errProbeTimeout = errors.New("timed out")
+
+// NotifyReady reports the daemon as started to systemd. It has to be called once the config is
+// loaded and the firewall state is restored. The API reachability is checked before, but an
+// unreachable API does not block the startup as the daemon has to work offline too.
+func (r *RPC) NotifyReady(notify SdNotifyFunc) {
+	status := "API is reachable"
+	err := withTimeout(readinessAPITimeout, func() error {
+		_, err := r.api.Insights()
+		return err
+	})
+	if err != nil {
+		log.Println(internal.WarningPrefix, "API is not reachable on startup:", err)
+		status = "API is not reachable, the daemon works offline"
+	}
+
+	if _, err := notify(fmt.Sprintf(internal.SdNotifyStatus, status)); err != nil {
+		log.Println(internal.WarningPrefix, "sending status to systemd:", err)
+	}
+	sent, err := notify(internal.SdNotifyReady)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "sending readiness to systemd:", err)
+		return
+	}
+	if sent {
+		log.Println(internal.InfoPrefix, "readiness sent to systemd")
+	}
+}
+
+// StartWatchdog pings the systemd watchdog twice per interval while the daemon is responsive,
+// so systemd restarts the daemon if it hangs. Pings stop when stop is closed.
+func (r *RPC) StartWatchdog(interval time.Duration, notify SdNotifyFunc, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval / 2)
+	defer ticker.Stop()
+
+	var probing atomic.Bool
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		// a hung probe is not started again, so the pings stop until it returns
+		if !probing.CompareAndSwap(false, true) {
+			log.Println(internal.WarningPrefix, "skipping watchdog ping, daemon is not responding")
+			continue
+		}
+		err := withTimeout(interval/2, func() error {
+			defer probing.Store(false)
+			return r.probeLiveness()
+		})
+		if err != nil {
+			log.Println(internal.WarningPrefix, "skipping watchdog ping, liveness probe failed:", err)
+			continue
+		}
+
+		if _, err := notify(internal.SdNotifyWatchdog); err != nil {
+			log.Println(internal.WarningPrefix, "sending watchdog ping to systemd:", err)
+		}
+	}
+}
+
+// probeLiveness touches the config and the networker, which are shared by most of the daemon
+// operations, so it blocks when one of them is stuck.
+func (r *RPC) probeLiveness() error {
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+	r.netw.IsVPNActive()
+	return nil
+}
+
+// withTimeout runs fn and returns its error or errProbeTimeout if it does not finish in time.
+// fn keeps running in the background after the timeout.
+func withTimeout(timeout time.Duration, fn func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- fn()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(timeout):
+		return errProbeTimeout
+	}
+}
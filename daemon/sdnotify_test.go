@@ -0,0 +1,150 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// insightsAPI implements only the insights call of the API
+type insightsAPI struct {
+	core.CombinedAPI
+	err error
+}
+
+func (a insightsAPI) Insights() (*core.Insights, error) { return &core.Insights{}, a.err }
+
+// hangingNetworker blocks the VPN state check until release is closed
+type hangingNetworker struct {
+	testnetworker.Mock
+	release chan struct{}
+}
+
+func (n *hangingNetworker) IsVPNActive() bool {
+	<-n.release
+	return false
+}
+
+type notifyRecorder struct {
+	mu     sync.Mutex
+	states []string
+}
+
+func (n *notifyRecorder) notify(state string) (bool, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.states = append(n.states, state)
+	return true, nil
+}
+
+func (n *notifyRecorder) count(state string) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	count := 0
+	for _, s := range n.states {
+		if s == state {
+			count++
+		}
+	}
+	return count
+}
+
+func TestNotifyReady(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name           string
+		apiErr         error
+		expectedStatus string
+	}{
+		{
+			name:           "API reachable",
+			expectedStatus: "API is reachable",
+		},
+		{
+			name:           "API unreachable",
+			apiErr:         errors.New("no route to host"),
+			expectedStatus: "API is not reachable, the daemon works offline",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := RPC{api: insightsAPI{err: test.apiErr}}
+			recorder := notifyRecorder{}
+
+			r.NotifyReady(recorder.notify)
+
+			assert.Equal(t, []string{
+				fmt.Sprintf(internal.SdNotifyStatus, test.expectedStatus),
+				internal.SdNotifyReady,
+			}, recorder.states)
+		})
+	}
+}
+
+func TestStartWatchdog(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	netw := &hangingNetworker{release: make(chan struct{})}
+	close(netw.release)
+	r := RPC{cm: mock.NewMockConfigManager(), netw: netw}
+	recorder := notifyRecorder{}
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		r.StartWatchdog(20*time.Millisecond, recorder.notify, stop)
+		close(done)
+	}()
+
+	assert.Eventually(t, func() bool {
+		return recorder.count(internal.SdNotifyWatchdog) >= 2
+	}, time.Second, 5*time.Millisecond)
+	close(stop)
+	<-done
+}
+
+func TestStartWatchdog_HungDaemonIsNotPinged(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	netw := &hangingNetworker{release: make(chan struct{})}
+	defer close(netw.release)
+	r := RPC{cm: mock.NewMockConfigManager(), netw: netw}
+	recorder := notifyRecorder{}
+	stop := make(chan struct{})
+	defer close(stop)
+
+	go r.StartWatchdog(20*time.Millisecond, recorder.notify, stop)
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 0, recorder.count(internal.SdNotifyWatchdog))
+}
+
+func TestStartWatchdog_ConfigFailureIsNotPinged(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.LoadErr = errors.New("config is corrupted")
+	netw := &hangingNetworker{release: make(chan struct{})}
+	close(netw.release)
+	r := RPC{cm: cm, netw: netw}
+	recorder := notifyRecorder{}
+	stop := make(chan struct{})
+	defer close(stop)
+
+	go r.StartWatchdog(20*time.Millisecond, recorder.notify, stop)
+
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 0, recorder.count(internal.SdNotifyWatchdog))
+}
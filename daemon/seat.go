@@ -0,0 +1,50 @@
+package daemon
+
+import (
+	"fmt"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	logindDest          = "org.freedesktop.login1"
+	logindSeatPath      = "/org/freedesktop/login1/seat/seat0"
+	logindSeatIface     = "org.freedesktop.login1.Seat"
+	logindSessionIface  = "org.freedesktop.login1.Session"
+	dbusPropertiesIface = "org.freedesktop.DBus.Properties"
+)
+
+// seatUser returns the user of the session active on the main seat, i.e. the user sitting at the
+// console. ok is false if nobody is logged in on the seat.
+func seatUser() (uid int64, ok bool, err error) {
+	// shared system bus connection is closed by other users of it, so a private one is used
+	conn, err := dbus.ConnectSystemBus()
+	if err != nil {
+		return 0, false, fmt.Errorf("connecting to system dbus: %w", err)
+	}
+	defer conn.Close()
+
+	var session struct {
+		ID   string
+		Path dbus.ObjectPath
+	}
+	err = conn.Object(logindDest, logindSeatPath).
+		Call(dbusPropertiesIface+".Get", 0, logindSeatIface, "ActiveSession").Store(&session)
+	if err != nil {
+		return 0, false, fmt.Errorf("getting active session of the seat: %w", err)
+	}
+	if session.ID == "" {
+		return 0, false, nil
+	}
+
+	var user struct {
+		UID  uint32
+		Path dbus.ObjectPath
+	}
+	err = conn.Object(logindDest, session.Path).
+		Call(dbusPropertiesIface+".Get", 0, logindSessionIface, "User").Store(&user)
+	if err != nil {
+		return 0, false, fmt.Errorf("getting user of session %s: %w", session.ID, err)
+	}
+	return int64(user.UID), true, nil
+}
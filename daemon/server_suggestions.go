@@ -0,0 +1,92 @@
+package daemon
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const (
+	// serverDomain is the domain of the server hostnames, e.g. de1234.nordvpn.com
+	serverDomain = ".nordvpn.com"
+	// maxTagSuggestions limits how many close matches are suggested for a mistyped server tag
+	maxTagSuggestions = 3
+)
+
+// trimServerDomain allows the server to be given by its full hostname, the same way as by its name
+func trimServerDomain(serverTag string) string {
+	if len(serverTag) > len(serverDomain) && strings.EqualFold(serverTag[len(serverTag)-len(serverDomain):], serverDomain) {
+		return serverTag[:len(serverTag)-len(serverDomain)]
+	}
+	return serverTag
+}
+
+// serverTagSuggestions returns the countries, cities and server names which are closest to the mistyped tag. Only
+// the names which differ by at most a third of their length are suggested, the closest ones first.
+func serverTagSuggestions(serverTag string, countries core.Countries, servers core.Servers) []string {
+	serverTag = internal.SnakeCase(serverTag)
+	if serverTag == "" {
+		return nil
+	}
+
+	distances := map[string]int{}
+	suggest := func(name string) {
+		if _, ok := distances[name]; ok || name == "" {
+			return
+		}
+		limit := max(1, len(name)/3)
+		if d := editDistance(serverTag, name); d <= limit {
+			distances[name] = d
+		}
+	}
+	for _, country := range countries {
+		suggest(internal.SnakeCase(country.Name))
+		for _, city := range country.Cities {
+			suggest(internal.SnakeCase(city.Name))
+		}
+	}
+	for _, server := range servers {
+		suggest(strings.ToLower(strings.Split(server.Hostname, ".")[0]))
+	}
+
+	suggestions := make([]string, 0, len(distances))
+	for name := range distances {
+		suggestions = append(suggestions, name)
+	}
+	slices.SortFunc(suggestions, func(a, b string) int {
+		if distances[a] != distances[b] {
+			return distances[a] - distances[b]
+		}
+		return strings.Compare(a, b)
+	})
+	return suggestions[:min(len(suggestions), maxTagSuggestions)]
+}
+
+// editDistance returns the number of single character insertions, deletions, substitutions and transpositions of
+// adjacent characters needed to turn a into b
+func editDistance(a, b string) int {
+	// rows of the distance matrix for the two previous and the current prefix of a
+	prevPrev := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				curr[j] = min(curr[j], prevPrev[j-2]+1)
+			}
+		}
+		prevPrev, prev, curr = prev, curr, prevPrev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,59 @@
+package daemon
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrimServerDomain(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, "de1234", trimServerDomain("de1234.nordvpn.com"))
+	assert.Equal(t, "DE1234", trimServerDomain("DE1234.NordVPN.com"))
+	assert.Equal(t, "de1234", trimServerDomain("de1234"))
+	assert.Equal(t, "germany", trimServerDomain("germany"))
+	assert.Equal(t, ".nordvpn.com", trimServerDomain(".nordvpn.com"))
+}
+
+func TestServerTagSuggestions(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		tag      string
+		expected []string
+	}{
+		{name: "mistyped country", tag: "germny", expected: []string{"germany"}},
+		{name: "mistyped city", tag: "vilnus", expected: []string{"vilnius"}},
+		{name: "mistyped country with city", tag: "Unted Kingdom", expected: []string{"united_kingdom"}},
+		{name: "swapped server digits", tag: "lt61", expected: []string{"lt16"}},
+		{name: "nothing close", tag: "atlantis", expected: []string{}},
+		{name: "empty", tag: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, serverTagSuggestions(test.tag, countriesList(), serversList()))
+		})
+	}
+}
+
+func TestServerTagSuggestions_Limit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	// equally close names are sorted alphabetically
+	assert.Equal(t, []string{"lt15", "lt16", "lt17"}, serverTagSuggestions("lt1", countriesList(), serversList()))
+}
+
+func TestEditDistance(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, 0, editDistance("de1234", "de1234"))
+	assert.Equal(t, 1, editDistance("de1243", "de1234"))
+	assert.Equal(t, 1, editDistance("germny", "germany"))
+	assert.Equal(t, 2, editDistance("kitten", "sittin"))
+	assert.Equal(t, 3, editDistance("", "abc"))
+}
@@ -1,6 +1,7 @@
 package daemon
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"log"
@@ -19,6 +20,15 @@ import (
 var tag = regexp.MustCompile(`^[a-z]{2}[0-9]{2,4}$`)
 var ErrDedicatedIPServer = fmt.Errorf("selected dedicated IP servers group")
 
+const (
+	// recommendedCandidates is the number of recommended servers from which the server is picked
+	recommendedCandidates = 20
+	// randomCandidates is the number of recommended servers from which the random server is picked
+	randomCandidates = 100
+	// loadLimitCandidates is the number of recommended servers requested when the server load is limited
+	loadLimitCandidates = 50
+)
+
 // PickServer by the specified criteria.
 func PickServer(
 	api core.ServersAPI,
@@ -32,6 +42,7 @@ func PickServer(
 	tag string,
 	groupFlag string,
 	allowVirtualServer bool,
+	maxLoad int64,
 ) (core.Server, bool, error) {
 	result, remote, err := getServers(
 		api,
@@ -46,6 +57,46 @@ func PickServer(
 		groupFlag,
 		1,
 		allowVirtualServer,
+		maxLoad,
+	)
+	if err != nil {
+		return core.Server{}, remote, err
+	}
+
+	// #nosec G404 -- not used for cryptographic purposes
+	return result[rand.Intn(len(result))], remote, nil
+}
+
+// PickRandomServer picks any of the servers matching the filters with equal probability. Servers are not ordered
+// by the distance or load, so the consecutive connections are likely to use different servers.
+func PickRandomServer(
+	api core.ServersAPI,
+	countries core.Countries,
+	servers core.Servers,
+	longitude float64,
+	latitude float64,
+	tech config.Technology,
+	protocol config.Protocol,
+	obfuscated bool,
+	tag string,
+	groupFlag string,
+	allowVirtualServer bool,
+	maxLoad int64,
+) (core.Server, bool, error) {
+	result, remote, err := getServers(
+		api,
+		countries,
+		servers,
+		longitude,
+		latitude,
+		tech,
+		protocol,
+		obfuscated,
+		tag,
+		groupFlag,
+		randomCandidates,
+		allowVirtualServer,
+		maxLoad,
 	)
 	if err != nil {
 		return core.Server{}, remote, err
@@ -68,6 +119,7 @@ func getServers(
 	groupFlag string,
 	count int,
 	allowVirtualServer bool,
+	maxLoad int64,
 ) ([]core.Server, bool, error) {
 	var remote = true
 	var err error
@@ -100,6 +152,11 @@ func getServers(
 				tag,
 			)
 		} else {
+			remoteCount := count
+			if maxLoad > 0 {
+				// more distant servers are requested, so that some of them are likely to be under the load limit
+				remoteCount = max(count, loadLimitCandidates)
+			}
 			ret, err = getServersRemote(
 				api,
 				longitude,
@@ -109,7 +166,7 @@ func getServers(
 				obfuscated,
 				serverTag,
 				serverGroup,
-				count,
+				remoteCount,
 			)
 		}
 	}
@@ -145,6 +202,17 @@ func getServers(
 		}
 	}
 
+	if maxLoad > 0 && serverTag.Action != core.ServerByName {
+		ret = underLoad(ret, maxLoad)
+		if remote {
+			limit := count
+			if count == 1 {
+				limit = recommendedCandidates
+			}
+			ret = ret[:min(len(ret), limit)]
+		}
+	}
+
 	if count == 1 && len(ret) > 0 {
 		// #nosec G404 -- not used for cryptographic purposes
 		rng := rand.New(rand.NewSource(time.Now().UnixNano()))
@@ -154,6 +222,21 @@ func getServers(
 	return ret, remote, nil
 }
 
+// underLoad keeps the servers with the load not above the limit in the recommended order. If every server is
+// busier, the least loaded one is kept, so that the connection is still made.
+func underLoad(servers []core.Server, maxLoad int64) []core.Server {
+	if len(servers) == 0 {
+		return servers
+	}
+	filtered := internal.Filter(servers, func(s core.Server) bool { return s.Load <= maxLoad })
+	if len(filtered) > 0 {
+		return filtered
+	}
+	leastLoaded := slices.MinFunc(servers, func(a, b core.Server) int { return cmp.Compare(a.Load, b.Load) })
+	log.Println(internal.WarningPrefix, "all servers are loaded above", maxLoad, "using the least loaded one")
+	return []core.Server{leastLoaded}
+}
+
 func resolveServerGroup(flag, tag string) (config.ServerGroup, error) {
 	tagServerGroup := groupConvert(tag)
 	flagServerGroup := groupConvert(flag)
@@ -213,7 +296,7 @@ func getServersRemote(
 	if serverTech == core.Unknown {
 		return nil, errors.New("unknown technology")
 	}
-	limit := 20
+	limit := recommendedCandidates
 	if count != 1 {
 		limit = count
 	}
@@ -363,7 +446,8 @@ func serverTagFromString(
 			return core.ServerTag{Action: core.ServerByName, ID: server.ID}, nil
 		}
 	}
-	if !tag.MatchString(serverTag) {
+	// server names are validated against the cached list, the API is only asked when there is no list yet
+	if !tag.MatchString(serverTag) || len(servers) > 0 {
 		return core.ServerTag{}, internal.ErrTagDoesNotExist
 	}
 	return core.ServerTag{}, fmt.Errorf("could not determine server tag from %q", serverTag)
@@ -381,6 +465,8 @@ func techToServerTech(tech config.Technology, protocol config.Protocol, obfuscat
 	switch tech {
 	case config.Technology_NORDLYNX:
 		return core.WireguardTech
+	case config.Technology_NORDWHISPER:
+		return core.NordWhisperTech
 	case config.Technology_OPENVPN:
 		switch protocol {
 		case config.Protocol_TCP:
@@ -455,9 +541,25 @@ func isAnyDIPServersAvailable(dedicatedIPServices []auth.DedicatedIPService) boo
 	return index != -1
 }
 
-func selectServer(r *RPC, insights *core.Insights, cfg config.Config, tag string, groupFlag string) (*core.Server, bool, error) {
+func selectServer(
+	r *RPC,
+	insights *core.Insights,
+	cfg config.Config,
+	tag string,
+	groupFlag string,
+	fastest bool,
+	random bool,
+) (*core.Server, bool, error) {
+	pickServer := PickServer
+	switch {
+	case fastest:
+		pickServer = r.pickFastestServer
+	case random:
+		pickServer = PickRandomServer
+	}
+
 	serversList := r.dm.GetServersData().Servers
-	server, remote, err := PickServer(
+	server, remote, err := pickServer(
 		r.serversAPI,
 		r.dm.GetCountryData().Countries,
 		serversList,
@@ -469,6 +571,7 @@ func selectServer(r *RPC, insights *core.Insights, cfg config.Config, tag string
 		tag,
 		groupFlag,
 		cfg.VirtualLocation.Get(),
+		cfg.MaxServerLoad,
 	)
 
 	if err != nil {
@@ -587,6 +587,21 @@ func TestServerTagFromString(t *testing.T) {
 			expected: core.ServerTag{},
 			hasError: true,
 		},
+		{
+			name:      "server name missing from the cached servers",
+			countries: core.Countries{},
+			servers: core.Servers{
+				core.Server{
+					ID:       929912,
+					Name:     "Canada #944",
+					Hostname: "ca944.nordvpn.com",
+				},
+			},
+			tag:      "ca949",
+			group:    config.ServerGroup_UNDEFINED,
+			expected: core.ServerTag{},
+			hasError: true,
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -722,7 +737,7 @@ func TestPickServer(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			server, remote, err := PickServer(test.api,
-				countriesList(), test.servers, test.longitude, test.latitude, test.tech, test.protocol, test.obfuscated, test.tag, test.groupFlag, !test.onlyPhysicServers)
+				countriesList(), test.servers, test.longitude, test.latitude, test.tech, test.protocol, test.obfuscated, test.tag, test.groupFlag, !test.onlyPhysicServers, 0)
 
 			assert.Equal(t, test.expectedError, err)
 			assert.Equal(t, test.expectedRemoteServer, remote)
@@ -732,3 +747,89 @@ func TestPickServer(t *testing.T) {
 		})
 	}
 }
+
+func TestPickRandomServer(t *testing.T) {
+	category.Set(t, category.Unit)
+	tests := []struct {
+		name          string
+		api           core.ServersAPI
+		tag           string
+		expectedError error
+	}{
+		{
+			name: "any server from remote",
+			api:  mockServersAPI{},
+		},
+		{
+			name: "server within country from locally cached servers",
+			api:  mockFailingServersAPI{},
+			tag:  "de",
+		},
+		{
+			name:          "nonexistent country",
+			api:           mockFailingServersAPI{},
+			tag:           "nowhere",
+			expectedError: internal.ErrTagDoesNotExist,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server, _, err := PickRandomServer(test.api, countriesList(), serversList(), 0, 0,
+				config.Technology_NORDLYNX, config.Protocol_UDP, false, test.tag, "", true, 0)
+
+			assert.ErrorIs(t, err, test.expectedError)
+			if test.expectedError != nil {
+				return
+			}
+			assert.NotZero(t, server.ID)
+			if test.tag != "" {
+				country, err := server.Locations.Country()
+				assert.NoError(t, err)
+				assert.Equal(t, "DE", country.Code)
+			}
+		})
+	}
+}
+
+func TestUnderLoad(t *testing.T) {
+	category.Set(t, category.Unit)
+	servers := []core.Server{
+		{ID: 1, Load: 80},
+		{ID: 2, Load: 40},
+		{ID: 3, Load: 60},
+	}
+	tests := []struct {
+		name     string
+		servers  []core.Server
+		maxLoad  int64
+		expected []int64
+	}{
+		{
+			name:     "servers above limit are skipped",
+			servers:  servers,
+			maxLoad:  60,
+			expected: []int64{2, 3},
+		},
+		{
+			name:     "least loaded server when all are above limit",
+			servers:  servers,
+			maxLoad:  30,
+			expected: []int64{2},
+		},
+		{
+			name:    "no servers",
+			maxLoad: 30,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var ids []int64
+			for _, server := range underLoad(test.servers, test.maxLoad) {
+				ids = append(ids, server.ID)
+			}
+			assert.Equal(t, test.expected, ids)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+package daemon
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// ManagedSettingsPath is the settings document maintained by the configuration management tools. It has the format
+// of 'nordvpn settings export' and it is applied whenever it changes.
+var ManagedSettingsPath = filepath.Join(internal.AppDataPath, "settings.json")
+
+// settingsReloadDelay groups the file events, as the tools write and rename the files in several steps
+const settingsReloadDelay = 500 * time.Millisecond
+
+// WatchManagedSettings applies the managed settings document when the daemon starts and whenever the document
+// changes, until stop is closed
+func (r *RPC) WatchManagedSettings(path string, stop <-chan struct{}) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("creating file watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	// the directory is watched, because the tools usually replace the file instead of writing to it
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		return fmt.Errorf("watching %s: %w", filepath.Dir(path), err)
+	}
+
+	r.reloadManagedSettingsAndLog(path)
+
+	var reload <-chan time.Time
+	for {
+		select {
+		case <-stop:
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return errors.New("file watcher was closed")
+			}
+			if event.Name == path && event.Has(fsnotify.Create|fsnotify.Write) {
+				reload = time.After(settingsReloadDelay)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return errors.New("file watcher was closed")
+			}
+			log.Println(internal.WarningPrefix, "watching managed settings:", err)
+		case <-reload:
+			reload = nil
+			r.reloadManagedSettingsAndLog(path)
+		}
+	}
+}
+
+func (r *RPC) reloadManagedSettingsAndLog(path string) {
+	event, err := r.reloadManagedSettings(path)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "reloading managed settings:", err)
+		return
+	}
+	if event == nil {
+		return
+	}
+	log.Println(internal.InfoPrefix, "managed settings reloaded, changed:", event.GetChanged())
+	if len(event.GetReconnectRequired()) > 0 {
+		log.Println(internal.InfoPrefix, "reconnect to VPN to apply:", event.GetReconnectRequired())
+	}
+}
+
+// reloadManagedSettings applies the settings document if it differs from the current settings. Changes of the
+// connection settings are saved, but they take effect on the next connection, so they are reported in the returned
+// event. Nil event is returned if nothing has changed.
+func (r *RPC) reloadManagedSettings(path string) (*pb.SettingsReloadEvent, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	settings, err := config.ParseSettingsExport(data)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := validateNameservers(settings.DNS); !ok {
+		return nil, fmt.Errorf("%w: invalid DNS server", config.ErrInvalidSettings)
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		return nil, fmt.Errorf("loading config: %w", err)
+	}
+
+	// both sides are normalized by the config, so only the effective changes are listed
+	imported := r.importedConfig(settings, cfg)
+	changed, err := config.NewSettingsExport(cfg).Changed(config.NewSettingsExport(imported))
+	if err != nil {
+		return nil, fmt.Errorf("comparing settings: %w", err)
+	}
+	if len(changed) == 0 {
+		return nil, nil
+	}
+	if settings.PostquantumVPN && cfg.Mesh {
+		return nil, errors.New("post-quantum VPN cannot be enabled while meshnet is enabled")
+	}
+	if settings.InterfaceName != cfg.InterfaceName && interfaceNameConflicts(settings.InterfaceName) {
+		return nil, fmt.Errorf("%w: interface %s already exists", config.ErrInvalidSettings, settings.InterfaceName)
+	}
+
+	if code := r.replaceSettings(settings, cfg); code != internal.CodeSuccess {
+		return nil, fmt.Errorf("replacing settings failed with code %d", code)
+	}
+
+	event := &pb.SettingsReloadEvent{Changed: changed}
+	if r.netw.IsVPNActive() {
+		if slices.Contains(changed, "dns") || slices.Contains(changed, "threat_protection_lite") {
+			r.applyReloadedDNS(imported)
+		}
+		for _, name := range changed {
+			if slices.Contains(config.ReconnectSettings, name) {
+				event.ReconnectRequired = append(event.ReconnectRequired, name)
+			}
+		}
+	}
+	r.eventStream.NotifySettingsReloaded(event)
+	return event, nil
+}
+
+// applyReloadedDNS updates the nameservers of the active connection
+func (r *RPC) applyReloadedDNS(cfg config.Config) {
+	tpl := cfg.AutoConnectData.ThreatProtectionLite && len(cfg.AutoConnectData.DNS) == 0
+	nameservers := cfg.AutoConnectData.DNS.Or(r.nameservers.Get(tpl, cfg.IPv6))
+	if err := r.netw.SetDNS(nameservers); err != nil {
+		log.Println(internal.WarningPrefix, "applying reloaded DNS:", err)
+	}
+}
@@ -0,0 +1,148 @@
+package daemon
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	"github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newReloadTestRPC(cm *mock.ConfigManager, netw *networker.Mock) *RPC {
+	cm.Cfg.Technology = config.Technology_NORDLYNX
+	cm.Cfg.AutoConnectData.Protocol = config.Protocol_UDP
+	cm.Cfg.UsersData = &config.UsersData{}
+	return &RPC{
+		cm:          cm,
+		netw:        netw,
+		events:      events.NewEventsEmpty(),
+		dm:          testNewDataManager(),
+		nameservers: &mock.DNSGetter{Names: []string{"103.86.96.100"}},
+		eventStream: newEventStream(time.Now),
+	}
+}
+
+func writeManagedSettings(t *testing.T, path string, cfg config.Config, change func(*config.SettingsExport)) {
+	t.Helper()
+	settings := config.NewSettingsExport(cfg)
+	change(&settings)
+	data, err := json.Marshal(settings)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
+
+func TestReloadManagedSettings(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	path := filepath.Join(t.TempDir(), "settings.json")
+	cm := mock.NewMockConfigManager()
+	netw := &networker.Mock{}
+	r := newReloadTestRPC(cm, netw)
+
+	event, err := r.reloadManagedSettings(path)
+	assert.NoError(t, err)
+	assert.Nil(t, event, "missing file is ignored")
+
+	writeManagedSettings(t, path, *cm.Cfg, func(*config.SettingsExport) {})
+	event, err = r.reloadManagedSettings(path)
+	assert.NoError(t, err)
+	assert.Nil(t, event, "unchanged settings are not applied")
+
+	writeManagedSettings(t, path, *cm.Cfg, func(s *config.SettingsExport) {
+		s.Firewall = true
+		s.Allowlist.TCPPorts = []int64{443}
+	})
+	event, err = r.reloadManagedSettings(path)
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.SettingsReloadEvent{Changed: []string{"allowlist", "firewall"}}, event)
+	assert.True(t, cm.Cfg.Firewall)
+	assert.Equal(t, cm.Cfg.AutoConnectData.Allowlist, netw.Allowlist)
+}
+
+func TestReloadManagedSettings_Connected(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	path := filepath.Join(t.TempDir(), "settings.json")
+	cm := mock.NewMockConfigManager()
+	netw := &networker.Mock{VpnActive: true}
+	r := newReloadTestRPC(cm, netw)
+
+	writeManagedSettings(t, path, *cm.Cfg, func(s *config.SettingsExport) {
+		s.Protocol = config.Protocol_TCP.String()
+		s.DNS = config.DNS{"1.1.1.1"}
+		s.Firewall = true
+		s.KillSwitch = true
+	})
+	event, err := r.reloadManagedSettings(path)
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.SettingsReloadEvent{
+		Changed:           []string{"dns", "firewall", "kill_switch", "protocol"},
+		ReconnectRequired: []string{"protocol"},
+	}, event)
+	assert.Equal(t, config.Protocol_TCP, cm.Cfg.AutoConnectData.Protocol)
+	assert.Equal(t, []string{"1.1.1.1"}, netw.Dns)
+}
+
+func TestReloadManagedSettings_Invalid(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	path := filepath.Join(t.TempDir(), "settings.json")
+	cm := mock.NewMockConfigManager()
+	r := newReloadTestRPC(cm, &networker.Mock{})
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"version": 1, "firewall": "yes"}`), 0o600))
+	event, err := r.reloadManagedSettings(path)
+	assert.ErrorIs(t, err, config.ErrInvalidSettings)
+	assert.Nil(t, event)
+	assert.False(t, cm.Cfg.Firewall)
+
+	writeManagedSettings(t, path, *cm.Cfg, func(s *config.SettingsExport) {
+		s.DNS = config.DNS{"not an address"}
+	})
+	_, err = r.reloadManagedSettings(path)
+	assert.ErrorIs(t, err, config.ErrInvalidSettings)
+}
+
+func TestWatchManagedSettings(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	path := filepath.Join(t.TempDir(), "settings.json")
+	cm := mock.NewMockConfigManager()
+	r := newReloadTestRPC(cm, &networker.Mock{})
+	sub, unsubscribe := r.eventStream.subscribe(nil)
+	defer unsubscribe()
+
+	stop := make(chan struct{})
+	done := make(chan error)
+	go func() { done <- r.WatchManagedSettings(path, stop) }()
+
+	// the file is written to a temporary location and moved into place as the tools do
+	tmp := path + ".tmp"
+	writeManagedSettings(t, tmp, *cm.Cfg, func(s *config.SettingsExport) { s.LANDiscovery = true })
+	require.Eventually(t, func() bool {
+		// the watcher may not be listening yet, so the file is moved again until it is noticed
+		_ = os.Rename(tmp, path)
+		select {
+		case e := <-sub.events:
+			reload, ok := e.data.(*pb.SettingsReloadEvent)
+			return ok && assert.Equal(t, []string{"lan_discovery"}, reload.GetChanged())
+		case <-time.After(2 * settingsReloadDelay):
+			data, _ := os.ReadFile(path)
+			_ = os.WriteFile(tmp, data, 0o600)
+			return false
+		}
+	}, 10*time.Second, 10*time.Millisecond)
+
+	close(stop)
+	assert.NoError(t, <-done)
+}
@@ -0,0 +1,64 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const (
+	// DrainTimeout bounds waiting for the in-flight RPCs on shutdown
+	DrainTimeout = 10 * time.Second
+	// TeardownTimeout bounds stopping VPN, meshnet and the kill switch after the RPCs are
+	// drained. It does not depend on how long the draining took, so together with DrainTimeout
+	// the shutdown finishes before systemd kills the daemon in the middle of tearing down the
+	// firewall.
+	TeardownTimeout = 20 * time.Second
+)
+
+// RPCServer is the part of the gRPC server stopped on shutdown
+type RPCServer interface {
+	GracefulStop()
+	Stop()
+}
+
+// DrainRPCs stops accepting new RPCs and waits for the in-flight ones. The connection being
+// established is canceled, as VPN is stopped anyway, while disconnect is let to finish. The
+// RPCs still running when ctx is done are closed.
+func (r *RPC) DrainRPCs(ctx context.Context, server RPCServer) {
+	r.shutdownOnce.Do(func() { close(r.shutdown) })
+
+	if r.pendingActions.CancelKind(PendingActionConnect) {
+		log.Println(internal.InfoPrefix, "queued connect was canceled by shutdown")
+	}
+	if cancel, _ := r.connectContext.CancelFunc(); cancel != nil {
+		log.Println(internal.InfoPrefix, "canceling the connection in progress due to shutdown")
+		cancel()
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		log.Println(internal.WarningPrefix, "RPCs did not finish before the shutdown deadline, closing them")
+		server.Stop()
+		<-stopped
+	}
+}
+
+// isShuttingDown reports whether the RPCs are being drained
+func (r *RPC) isShuttingDown() bool {
+	select {
+	case <-r.shutdown:
+		return true
+	default:
+		return false
+	}
+}
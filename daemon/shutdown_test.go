@@ -0,0 +1,112 @@
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/sharedctx"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// mockGRPCServer finishes the graceful stop only if in-flight RPCs are not hanging
+type mockGRPCServer struct {
+	hanging bool
+	stop    chan struct{}
+	stopped bool
+}
+
+func newMockRPCServer(hanging bool) *mockGRPCServer {
+	return &mockGRPCServer{hanging: hanging, stop: make(chan struct{})}
+}
+
+func (s *mockGRPCServer) GracefulStop() {
+	if s.hanging {
+		<-s.stop
+	}
+}
+
+func (s *mockGRPCServer) Stop() {
+	s.stopped = true
+	close(s.stop)
+}
+
+type mockEventStreamServer struct {
+	grpc.ServerStream
+}
+
+func (mockEventStreamServer) Context() context.Context   { return context.Background() }
+func (mockEventStreamServer) Send(*pb.DaemonEvent) error { return nil }
+
+func newShutdownTestRPC() *RPC {
+	return &RPC{
+		shutdown:       make(chan struct{}),
+		connectContext: sharedctx.New(),
+		pendingActions: NewPendingActions(func() bool { return false }),
+	}
+}
+
+func TestDrainRPCs(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name    string
+		hanging bool
+		stopped bool
+	}{
+		{name: "RPCs finish", hanging: false, stopped: false},
+		{name: "RPCs hang past the deadline", hanging: true, stopped: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r := newShutdownTestRPC()
+			server := newMockRPCServer(test.hanging)
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			r.DrainRPCs(ctx, server)
+			assert.Equal(t, test.stopped, server.stopped)
+			assert.True(t, r.isShuttingDown())
+		})
+	}
+}
+
+func TestDrainRPCs_CancelsConnect(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	r := newShutdownTestRPC()
+	r.pendingActions.Add(PendingActionConnect, "connect", func() {})
+
+	started := make(chan struct{})
+	canceled := make(chan error)
+	go r.connectContext.TryExecuteWith(func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		canceled <- ctx.Err()
+	})
+	<-started
+
+	r.DrainRPCs(context.Background(), newMockRPCServer(false))
+	assert.ErrorIs(t, <-canceled, context.Canceled)
+	assert.Empty(t, r.pendingActions.List())
+}
+
+func TestDrainRPCs_EndsEventStream(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	r := newShutdownTestRPC()
+	r.eventStream = newEventStream(time.Now)
+
+	done := make(chan error)
+	go func() {
+		done <- r.SubscribeEvents(nil, mockEventStreamServer{})
+	}()
+
+	r.DrainRPCs(context.Background(), newMockRPCServer(false))
+	assert.NoError(t, <-done)
+}
@@ -0,0 +1,372 @@
+// Package splittunnel routes the traffic of the selected applications outside of VPN tunnel or only through it.
+//
+// Processes of the applications are moved to a dedicated cgroup. Packets sent from the cgroup are marked with the
+// firewall mark, so the policy routing sends them through the physical interface in the same way as the packets of
+// VPN connection itself. In include mode the packets sent from outside of the cgroup are marked instead.
+package splittunnel
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const (
+	// CgroupName is the name of the cgroup where the processes of the split tunnel apps are moved
+	CgroupName = "nordvpn-split-tunnel"
+	// RuleComment marks the iptables rules added for split tunneling
+	RuleComment = "nordvpn_split_tunnel"
+
+	iptablesCmd = "iptables"
+	cgroupRoot  = "/sys/fs/cgroup"
+	procRoot    = "/proc"
+	// scanInterval defines how often the new processes of the apps are looked for
+	scanInterval = 2 * time.Second
+)
+
+// ErrNotSupported is returned if the system does not use the unified cgroup hierarchy
+var ErrNotSupported = errors.New("split tunneling requires cgroup v2")
+
+// AppStatus tells whether the app is running
+type AppStatus struct {
+	Name    string
+	Running bool
+}
+
+// Status of split tunneling
+type Status struct {
+	Mode config.SplitTunnelMode
+	Apps []AppStatus
+}
+
+// Agent moves the processes of the split tunnel apps to the cgroup and routes their traffic
+type Agent interface {
+	// Apply routes the traffic of the apps according to the mode. Split tunneling is disabled if there are no apps.
+	Apply(mode config.SplitTunnelMode, apps []string, fwmark uint32) error
+	// Disable moves the processes back to their cgroups and removes the routing rules
+	Disable() error
+	// Status returns the apps and whether they are running
+	Status() Status
+}
+
+type runCommandFunc func(command string, arg ...string) ([]byte, error)
+
+// movedProcess remembers which app the process belongs to and where it was before it was moved
+type movedProcess struct {
+	app    string
+	cgroup string
+}
+
+// Cgroup implements Agent using cgroup v2 and iptables
+type Cgroup struct {
+	mu         sync.Mutex
+	cgroupRoot string
+	procRoot   string
+	runCommand runCommandFunc
+	mode       config.SplitTunnelMode
+	apps       []string
+	moved      map[int]movedProcess
+	stopScan   chan struct{}
+}
+
+// NewCgroup is a default constructor for Cgroup
+func NewCgroup(runCommand runCommandFunc) *Cgroup {
+	return &Cgroup{
+		cgroupRoot: cgroupRoot,
+		procRoot:   procRoot,
+		runCommand: runCommand,
+		moved:      map[int]movedProcess{},
+	}
+}
+
+// Apply moves the processes of the apps to the cgroup, replaces the routing rules and keeps moving the processes
+// started later
+func (c *Cgroup) Apply(mode config.SplitTunnelMode, apps []string, fwmark uint32) error {
+	if len(apps) == 0 {
+		return c.Disable()
+	}
+	if _, err := os.Stat(filepath.Join(c.cgroupRoot, "cgroup.controllers")); err != nil {
+		return ErrNotSupported
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := os.Mkdir(c.cgroupPath(), 0755); err != nil && !errors.Is(err, os.ErrExist) {
+		return fmt.Errorf("creating cgroup: %w", err)
+	}
+	if err := c.clearRules(); err != nil {
+		return err
+	}
+	if err := c.addRules(mode, fwmark); err != nil {
+		return err
+	}
+
+	c.mode = mode
+	c.apps = slices.Clone(apps)
+	for pid, process := range c.moved {
+		if !slices.Contains(c.apps, process.app) {
+			c.release(pid, process.cgroup)
+		}
+	}
+	c.scan()
+
+	if c.stopScan == nil {
+		c.stopScan = make(chan struct{})
+		go c.scanPeriodically(c.stopScan)
+	}
+	return nil
+}
+
+// Disable moves all processes out of the cgroup, removes it and the routing rules
+func (c *Cgroup) Disable() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stopScan != nil {
+		close(c.stopScan)
+		c.stopScan = nil
+	}
+	c.apps = nil
+
+	// cgroup is left only if split tunneling was enabled, possibly before the crash
+	if _, err := os.Stat(c.cgroupPath()); err != nil {
+		return nil
+	}
+
+	pids, err := c.cgroupProcesses()
+	if err != nil {
+		log.Println(internal.WarningPrefix, "listing split tunnel processes:", err)
+	}
+	for _, pid := range pids {
+		c.release(pid, c.moved[pid].cgroup)
+	}
+	c.moved = map[int]movedProcess{}
+
+	if err := c.clearRules(); err != nil {
+		return err
+	}
+	if err := os.Remove(c.cgroupPath()); err != nil {
+		return fmt.Errorf("removing cgroup: %w", err)
+	}
+	return nil
+}
+
+// Status returns the apps in the order they were given and whether any of their processes are running
+func (c *Cgroup) Status() Status {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	running := map[string]bool{}
+	for _, process := range c.moved {
+		running[process.app] = true
+	}
+
+	status := Status{Mode: c.mode}
+	for _, app := range c.apps {
+		status.Apps = append(status.Apps, AppStatus{Name: app, Running: running[app]})
+	}
+	return status
+}
+
+func (c *Cgroup) scanPeriodically(stop <-chan struct{}) {
+	ticker := time.NewTicker(scanInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			c.mu.Lock()
+			c.scan()
+			c.mu.Unlock()
+		}
+	}
+}
+
+// scan moves the processes of the apps to the cgroup and forgets the processes which have exited
+//
+// Not thread safe. Lock mu before using
+func (c *Cgroup) scan() {
+	entries, err := os.ReadDir(c.procRoot)
+	if err != nil {
+		log.Println(internal.WarningPrefix, "listing processes for split tunneling:", err)
+		return
+	}
+
+	alive := map[int]bool{}
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		alive[pid] = true
+		if _, ok := c.moved[pid]; ok {
+			continue
+		}
+
+		// kernel threads and the processes which have exited in the meantime do not have the executable
+		exe, err := os.Readlink(filepath.Join(c.procRoot, entry.Name(), "exe"))
+		if err != nil {
+			continue
+		}
+		app, ok := matchApp(c.apps, exe)
+		if !ok {
+			continue
+		}
+
+		cgroup, err := c.processCgroup(pid)
+		if err != nil {
+			continue
+		}
+		if cgroup == "/"+CgroupName {
+			// started by a process which is already in the cgroup, the original cgroup is not known
+			c.moved[pid] = movedProcess{app: app}
+			continue
+		}
+		if err := c.move(pid, c.cgroupPath()); err != nil {
+			log.Println(internal.WarningPrefix, "moving", exe, "process to split tunnel:", err)
+			continue
+		}
+		c.moved[pid] = movedProcess{app: app, cgroup: cgroup}
+	}
+
+	for pid := range c.moved {
+		if !alive[pid] {
+			delete(c.moved, pid)
+		}
+	}
+}
+
+// release moves the process back to its original cgroup or to the root cgroup if it is not known or does not
+// exist anymore
+//
+// Not thread safe. Lock mu before using
+func (c *Cgroup) release(pid int, cgroup string) {
+	delete(c.moved, pid)
+	if cgroup != "" && c.move(pid, filepath.Join(c.cgroupRoot, cgroup)) == nil {
+		return
+	}
+	if err := c.move(pid, c.cgroupRoot); err != nil {
+		log.Println(internal.WarningPrefix, "moving process", pid, "out of split tunnel:", err)
+	}
+}
+
+func (c *Cgroup) move(pid int, cgroupPath string) error {
+	return os.WriteFile(filepath.Join(cgroupPath, "cgroup.procs"), []byte(strconv.Itoa(pid)), 0644)
+}
+
+func (c *Cgroup) cgroupPath() string {
+	return filepath.Join(c.cgroupRoot, CgroupName)
+}
+
+func (c *Cgroup) cgroupProcesses() ([]int, error) {
+	data, err := os.ReadFile(filepath.Join(c.cgroupPath(), "cgroup.procs"))
+	if err != nil {
+		return nil, err
+	}
+
+	var pids []int
+	for _, field := range strings.Fields(string(data)) {
+		if pid, err := strconv.Atoi(field); err == nil {
+			pids = append(pids, pid)
+		}
+	}
+	return pids, nil
+}
+
+// processCgroup returns the path of the process cgroup in the unified hierarchy
+func (c *Cgroup) processCgroup(pid int) (string, error) {
+	file, err := os.Open(filepath.Join(c.procRoot, strconv.Itoa(pid), "cgroup"))
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if cgroup, ok := strings.CutPrefix(scanner.Text(), "0::"); ok {
+			return cgroup, nil
+		}
+	}
+	return "", fmt.Errorf("process %d is not in the unified hierarchy", pid)
+}
+
+// matchApp returns the app the executable belongs to. Apps given as the absolute path match only that executable,
+// apps given as the name match executables with that name in any directory.
+func matchApp(apps []string, exe string) (string, bool) {
+	// executable replaced by the update is still running
+	exe = strings.TrimSuffix(exe, " (deleted)")
+	for _, app := range apps {
+		if app == exe || (!strings.Contains(app, "/") && app == filepath.Base(exe)) {
+			return app, true
+		}
+	}
+	return "", false
+}
+
+// addRules marks the packets which bypass VPN. Source address of these packets was selected for the tunnel before
+// they were marked, so it is replaced with the address of the physical interface.
+//
+// Not thread safe. Lock mu before using
+func (c *Cgroup) addRules(mode config.SplitTunnelMode, fwmark uint32) error {
+	match := "-m cgroup --path " + CgroupName
+	if mode == config.SplitTunnelInclude {
+		match = "-m cgroup ! --path " + CgroupName
+	}
+	mark := fmt.Sprintf("%#x", fwmark)
+
+	rules := []string{
+		// iptables -t mangle -I OUTPUT -m cgroup --path nordvpn-split-tunnel -j MARK --set-mark 0xe1f1
+		// -m comment --comment nordvpn_split_tunnel
+		fmt.Sprintf("-t mangle -I OUTPUT %s -j MARK --set-mark %s -m comment --comment %s",
+			match, mark, RuleComment),
+		// iptables -t nat -I POSTROUTING -m cgroup --path nordvpn-split-tunnel -m mark --mark 0xe1f1 ! -o lo
+		// -j MASQUERADE -m comment --comment nordvpn_split_tunnel
+		fmt.Sprintf("-t nat -I POSTROUTING %s -m mark --mark %s ! -o lo -j MASQUERADE -m comment --comment %s",
+			match, mark, RuleComment),
+	}
+	for _, rule := range rules {
+		// #nosec G204 -- input is properly sanitized
+		out, err := c.runCommand(iptablesCmd, strings.Fields(rule)...)
+		if err != nil {
+			return fmt.Errorf("iptables inserting rule: %w: %s", err, string(out))
+		}
+	}
+	return nil
+}
+
+// clearRules removes the split tunnel rules, including the ones left after the crash
+//
+// Not thread safe. Lock mu before using
+func (c *Cgroup) clearRules() error {
+	for _, chain := range [][2]string{{"mangle", "OUTPUT"}, {"nat", "POSTROUTING"}} {
+		out, err := c.runCommand(iptablesCmd, "-t", chain[0], "-S", chain[1])
+		if err != nil {
+			return fmt.Errorf("iptables listing rules: %w: %s", err, string(out))
+		}
+		for _, line := range strings.Split(string(out), "\n") {
+			rule, ok := strings.CutPrefix(line, "-A ")
+			if !ok || !strings.Contains(line, "--comment "+RuleComment) {
+				continue
+			}
+			args := append([]string{"-t", chain[0], "-D"}, strings.Fields(rule)...)
+			// #nosec G204 -- input is properly sanitized
+			if out, err := c.runCommand(iptablesCmd, args...); err != nil {
+				return fmt.Errorf("iptables deleting rule: %w: %s", err, string(out))
+			}
+		}
+	}
+	return nil
+}
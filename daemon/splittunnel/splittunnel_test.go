@@ -0,0 +1,144 @@
+package splittunnel
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeIptables struct {
+	rules map[string][]string
+}
+
+func (f *fakeIptables) run(command string, arg ...string) ([]byte, error) {
+	table, action, rest := arg[1], arg[2], arg[3:]
+	switch action {
+	case "-S":
+		var out strings.Builder
+		for _, rule := range f.rules[table] {
+			out.WriteString("-A " + rule + "\n")
+		}
+		return []byte(out.String()), nil
+	case "-I":
+		f.rules[table] = append(f.rules[table], strings.Join(rest, " "))
+	case "-D":
+		rule := strings.Join(rest, " ")
+		for i, existing := range f.rules[table] {
+			if existing == rule {
+				f.rules[table] = append(f.rules[table][:i], f.rules[table][i+1:]...)
+				break
+			}
+		}
+	}
+	return nil, nil
+}
+
+// newTestCgroup returns the agent using temporary cgroup and proc directories
+func newTestCgroup(t *testing.T) (*Cgroup, *fakeIptables) {
+	t.Helper()
+	iptables := &fakeIptables{rules: map[string][]string{}}
+	c := NewCgroup(iptables.run)
+	c.cgroupRoot = t.TempDir()
+	c.procRoot = t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(c.cgroupRoot, "cgroup.controllers"), nil, 0644))
+	require.NoError(t, os.Mkdir(filepath.Join(c.cgroupRoot, "user.slice"), 0755))
+	t.Cleanup(func() { _ = c.Disable() })
+	return c, iptables
+}
+
+func addProcess(t *testing.T, procRoot string, pid int, exe string) {
+	t.Helper()
+	dir := filepath.Join(procRoot, strconv.Itoa(pid))
+	require.NoError(t, os.Mkdir(dir, 0755))
+	require.NoError(t, os.Symlink(exe, filepath.Join(dir, "exe")))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cgroup"), []byte("0::/user.slice\n"), 0644))
+}
+
+func TestCgroup_Apply(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	c, iptables := newTestCgroup(t)
+	addProcess(t, c.procRoot, 100, "/usr/bin/firefox")
+	addProcess(t, c.procRoot, 200, "/usr/lib/steam/steam")
+	addProcess(t, c.procRoot, 300, "/usr/bin/bash")
+
+	err := c.Apply(config.SplitTunnelExclude, []string{"firefox", "/usr/lib/steam/steam"}, 0xe1f1)
+	require.NoError(t, err)
+
+	procs, err := os.ReadFile(filepath.Join(c.cgroupRoot, CgroupName, "cgroup.procs"))
+	require.NoError(t, err)
+	// test files are overwritten by each move, so only the last moved process is left
+	assert.Contains(t, []string{"100", "200"}, string(procs))
+	assert.Len(t, c.moved, 2)
+	assert.Equal(t, Status{
+		Mode: config.SplitTunnelExclude,
+		Apps: []AppStatus{{Name: "firefox", Running: true}, {Name: "/usr/lib/steam/steam", Running: true}},
+	}, c.Status())
+
+	assert.Len(t, iptables.rules["mangle"], 1)
+	assert.Contains(t, iptables.rules["mangle"][0], "-m cgroup --path "+CgroupName)
+	assert.Contains(t, iptables.rules["mangle"][0], "--set-mark 0xe1f1")
+	assert.Len(t, iptables.rules["nat"], 1)
+
+	// removed app is moved back to its original cgroup and rules are replaced
+	err = c.Apply(config.SplitTunnelInclude, []string{"firefox"}, 0xe1f1)
+	require.NoError(t, err)
+	procs, err = os.ReadFile(filepath.Join(c.cgroupRoot, "user.slice", "cgroup.procs"))
+	require.NoError(t, err)
+	assert.Equal(t, "200", string(procs))
+	assert.Len(t, c.moved, 1)
+	assert.Len(t, iptables.rules["mangle"], 1)
+	assert.Contains(t, iptables.rules["mangle"][0], "-m cgroup ! --path "+CgroupName)
+
+	// kernel removes the interface files together with the cgroup, regular directory must be emptied beforehand
+	require.NoError(t, os.Remove(filepath.Join(c.cgroupRoot, CgroupName, "cgroup.procs")))
+	require.NoError(t, c.Disable())
+	assert.NoDirExists(t, filepath.Join(c.cgroupRoot, CgroupName))
+	assert.Empty(t, iptables.rules["mangle"])
+	assert.Empty(t, iptables.rules["nat"])
+	assert.Empty(t, c.Status().Apps)
+}
+
+func TestCgroup_ApplyNotSupported(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	c, _ := newTestCgroup(t)
+	require.NoError(t, os.Remove(filepath.Join(c.cgroupRoot, "cgroup.controllers")))
+
+	assert.ErrorIs(t, c.Apply(config.SplitTunnelExclude, []string{"firefox"}, 0xe1f1), ErrNotSupported)
+	// disabling is still possible, there is nothing to clean up
+	assert.NoError(t, c.Apply(config.SplitTunnelExclude, nil, 0xe1f1))
+}
+
+func TestMatchApp(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	apps := []string{"firefox", "/opt/steam/steam"}
+	tests := []struct {
+		exe         string
+		expectedApp string
+		expectedOk  bool
+	}{
+		{exe: "/usr/bin/firefox", expectedApp: "firefox", expectedOk: true},
+		{exe: "/usr/lib/firefox/firefox (deleted)", expectedApp: "firefox", expectedOk: true},
+		{exe: "/opt/steam/steam", expectedApp: "/opt/steam/steam", expectedOk: true},
+		{exe: "/usr/bin/steam"},
+		{exe: "/usr/bin/firefox-esr"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.exe, func(t *testing.T) {
+			app, ok := matchApp(apps, test.exe)
+			assert.Equal(t, test.expectedOk, ok)
+			assert.Equal(t, test.expectedApp, app)
+		})
+	}
+}
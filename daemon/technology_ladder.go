@@ -0,0 +1,147 @@
+package daemon
+
+import (
+	"context"
+	"log"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// autoTechnology is shown instead of the technology name when the technology is selected automatically
+const autoTechnology = "AUTO"
+
+// canUseTechnologyLadder reports whether the technology is selected automatically. Post-quantum encryption is
+// available only with NordLynx, so the ladder is never used with it.
+func canUseTechnologyLadder(cfg config.Config) bool {
+	return cfg.AutoTechnology && !cfg.AutoConnectData.PostquantumVpn
+}
+
+// technologyLadder returns the rungs tried for the connection request on the network. The ladder starts with the
+// rung which has succeeded last on the network. Obfuscated servers are skipped if they can't serve the request.
+func technologyLadder(cfg config.Config, in *pb.ConnectRequest, network string) []config.TechnologyRung {
+	rungs := config.LadderFrom(cfg.TechnologyRungs[network])
+	if obfuscatedServersServe(in) {
+		return rungs
+	}
+	isObfuscated := func(rung config.TechnologyRung) bool { return rung == config.RungObfuscated }
+	rungs = slices.DeleteFunc(slices.Clone(rungs), isObfuscated)
+	if len(rungs) == 0 {
+		// only the obfuscated servers have worked on the network before
+		rungs = slices.DeleteFunc(slices.Clone(config.TechnologyLadder), isObfuscated)
+	}
+	return rungs
+}
+
+// rungConfig returns the config used to connect with the rung of the technology ladder. The configured technology
+// is used if the rung is empty.
+func rungConfig(cfg config.Config, rung config.TechnologyRung) config.Config {
+	switch rung {
+	case config.RungNordLynx:
+		cfg.Technology = config.Technology_NORDLYNX
+		cfg.AutoConnectData.Protocol = config.Protocol_UDP
+		cfg.AutoConnectData.Obfuscate = false
+	case config.RungOpenVPNUDP:
+		cfg.Technology = config.Technology_OPENVPN
+		cfg.AutoConnectData.Protocol = config.Protocol_UDP
+		cfg.AutoConnectData.Obfuscate = false
+	case config.RungOpenVPNTCP:
+		cfg.Technology = config.Technology_OPENVPN
+		cfg.AutoConnectData.Protocol = config.Protocol_TCP
+		cfg.AutoConnectData.Obfuscate = false
+	case config.RungObfuscated:
+		return obfuscatedConfig(stealthConfig(cfg))
+	}
+	return stealthConfig(cfg)
+}
+
+// rememberRung saves the rung which has succeeded on the network. Nothing is saved for the first rung, as the
+// ladder starts with it anyway.
+func (r *RPC) rememberRung(network string, rung config.TechnologyRung) {
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		rungs := maps.Clone(c.TechnologyRungs)
+		if rungs == nil {
+			rungs = map[string]config.TechnologyRung{}
+		}
+		if rung == config.TechnologyLadder[0] {
+			delete(rungs, network)
+		} else {
+			rungs[network] = rung
+		}
+		c.TechnologyRungs = rungs
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, "remembering technology of the network:", err)
+	}
+}
+
+// connectWithLadder tries the rungs of the technology ladder in order until the connection succeeds. The failure
+// of the first rung is reported if all of them fail. If the rung remembered for the network fails as well, it is
+// forgotten so that the next connection starts from the top of the ladder.
+func (r *RPC) connectWithLadder(
+	ctx context.Context,
+	in *pb.ConnectRequest,
+	srv pb.Daemon_ConnectServer,
+	cfg config.Config,
+) error {
+	network := r.obfuscation.networkKey()
+	remembered, isRemembered := cfg.TechnologyRungs[network]
+
+	var failure *pb.Payload
+	for _, rung := range technologyLadder(cfg, in, network) {
+		log.Println(internal.InfoPrefix, "connecting with", rung)
+		server := &fallbackConnectServer{Daemon_ConnectServer: srv, retry: true}
+		if err := r.connectWithRetry(ctx, in, server, rung); err != nil {
+			return err
+		}
+		if server.connected {
+			if network != "" && remembered != rung {
+				r.rememberRung(network, rung)
+			}
+			return nil
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+		log.Println(internal.WarningPrefix, "connection with", rung, "has failed:", server.failure.GetType())
+		if failure == nil {
+			failure = server.failure
+		}
+	}
+
+	if network != "" && isRemembered {
+		r.rememberRung(network, config.TechnologyLadder[0])
+	}
+	if failure == nil {
+		return nil
+	}
+	return srv.Send(failure)
+}
+
+// setAutoTechnology enables the automatic technology selection. The configured technology is kept, it is used
+// again once a specific technology is set.
+func (r *RPC) setAutoTechnology(cfg config.Config) (*pb.Payload, error) {
+	if cfg.AutoTechnology {
+		return &pb.Payload{Type: internal.CodeNothingToDo, Data: []string{autoTechnology}}, nil
+	}
+	if cfg.AutoConnectData.PostquantumVpn {
+		return &pb.Payload{Type: internal.CodePqWithoutNordlynx}, nil
+	}
+
+	if err := r.cm.SaveWith(func(c config.Config) config.Config {
+		c.AutoTechnology = true
+		return c
+	}); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return &pb.Payload{Type: internal.CodeConfigError}, nil
+	}
+
+	return &pb.Payload{
+		Type: internal.CodeSuccess,
+		Data: []string{strconv.FormatBool(r.netw.IsVPNActive()), autoTechnology},
+	}, nil
+}
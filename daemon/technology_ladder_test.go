@@ -0,0 +1,261 @@
+package daemon
+
+import (
+	"context"
+	"net/http"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	daemonevents "github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/daemon/netstate"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/daemon/response"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/sharedctx"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testfirewall "github.com/NordSecurity/nordvpn-linux/test/mock/firewall"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+	testnorduser "github.com/NordSecurity/nordvpn-linux/test/mock/norduser/service"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// udpBlockingNetworker connects only over OpenVPN TCP, as if the network blocked UDP
+type udpBlockingNetworker struct {
+	testnetworker.Mock
+	attempts []config.TechnologyRung
+}
+
+func (n *udpBlockingNetworker) Start(
+	_ context.Context,
+	_ vpn.Credentials,
+	serverData vpn.ServerData,
+	_ config.Allowlist,
+	_ config.DNS,
+	_ bool,
+) error {
+	rung := config.RungNordLynx
+	switch {
+	case serverData.Obfuscated:
+		rung = config.RungObfuscated
+	case serverData.Technology == config.Technology_OPENVPN && serverData.Protocol == config.Protocol_TCP:
+		rung = config.RungOpenVPNTCP
+	case serverData.Technology == config.Technology_OPENVPN:
+		rung = config.RungOpenVPNUDP
+	}
+	n.attempts = append(n.attempts, rung)
+	if rung != config.RungOpenVPNTCP {
+		return mock.ErrOnPurpose
+	}
+	return nil
+}
+
+func TestTechnologyLadder(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		rungs    map[string]config.TechnologyRung
+		in       *pb.ConnectRequest
+		expected []config.TechnologyRung
+	}{
+		{
+			name:     "whole ladder",
+			in:       &pb.ConnectRequest{},
+			expected: config.TechnologyLadder,
+		},
+		{
+			name:     "starts with the remembered rung",
+			rungs:    map[string]config.TechnologyRung{"ssid:Cafe": config.RungOpenVPNTCP},
+			in:       &pb.ConnectRequest{},
+			expected: []config.TechnologyRung{config.RungOpenVPNTCP, config.RungObfuscated},
+		},
+		{
+			name:     "rung of the other network is ignored",
+			rungs:    map[string]config.TechnologyRung{"ssid:Home": config.RungOpenVPNTCP},
+			in:       &pb.ConnectRequest{},
+			expected: config.TechnologyLadder,
+		},
+		{
+			name:     "obfuscated servers are skipped for the server group",
+			in:       &pb.ConnectRequest{ServerGroup: "p2p"},
+			expected: []config.TechnologyRung{config.RungNordLynx, config.RungOpenVPNUDP, config.RungOpenVPNTCP},
+		},
+		{
+			name:     "remembered obfuscated servers can't serve the request",
+			rungs:    map[string]config.TechnologyRung{"ssid:Cafe": config.RungObfuscated},
+			in:       &pb.ConnectRequest{DedicatedIp: "1.2.3.4"},
+			expected: []config.TechnologyRung{config.RungNordLynx, config.RungOpenVPNUDP, config.RungOpenVPNTCP},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := config.Config{AutoTechnology: true, TechnologyRungs: test.rungs}
+			assert.Equal(t, test.expected, technologyLadder(cfg, test.in, "ssid:Cafe"))
+		})
+	}
+}
+
+func TestRungConfig(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cfg := config.Config{
+		Technology:      config.Technology_NORDWHISPER,
+		AutoConnectData: config.AutoConnectData{Protocol: config.Protocol_TCP},
+	}
+
+	nordlynx := rungConfig(cfg, config.RungNordLynx)
+	assert.Equal(t, config.Technology_NORDLYNX, nordlynx.Technology)
+	assert.Equal(t, config.Protocol_UDP, nordlynx.AutoConnectData.Protocol)
+
+	udp := rungConfig(cfg, config.RungOpenVPNUDP)
+	assert.Equal(t, config.Technology_OPENVPN, udp.Technology)
+	assert.Equal(t, config.Protocol_UDP, udp.AutoConnectData.Protocol)
+
+	tcp := rungConfig(cfg, config.RungOpenVPNTCP)
+	assert.Equal(t, config.Technology_OPENVPN, tcp.Technology)
+	assert.Equal(t, config.Protocol_TCP, tcp.AutoConnectData.Protocol)
+	assert.False(t, tcp.AutoConnectData.Obfuscate)
+
+	obfuscated := rungConfig(cfg, config.RungObfuscated)
+	assert.Equal(t, config.Technology_OPENVPN, obfuscated.Technology)
+	assert.True(t, obfuscated.AutoConnectData.Obfuscate)
+
+	assert.Equal(t, cfg, rungConfig(cfg, ""))
+}
+
+func TestConnectWithLadder(t *testing.T) {
+	category.Set(t, category.Unit)
+	defer testsCleanup()
+
+	network := netstate.Network{{Name: "wlan0", SSID: "Cafe"}}
+	tests := []struct {
+		name             string
+		rungs            map[string]config.TechnologyRung
+		expectedAttempts []config.TechnologyRung
+		expectedRungs    map[string]config.TechnologyRung
+	}{
+		{
+			name:             "climbs the ladder and remembers the rung",
+			expectedAttempts: []config.TechnologyRung{config.RungNordLynx, config.RungOpenVPNUDP, config.RungOpenVPNTCP},
+			expectedRungs:    map[string]config.TechnologyRung{"ssid:Cafe": config.RungOpenVPNTCP},
+		},
+		{
+			name:             "skips the failing rungs on the remembered network",
+			rungs:            map[string]config.TechnologyRung{"ssid:Cafe": config.RungOpenVPNTCP},
+			expectedAttempts: []config.TechnologyRung{config.RungOpenVPNTCP},
+			expectedRungs:    map[string]config.TechnologyRung{"ssid:Cafe": config.RungOpenVPNTCP},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Technology = config.Technology_NORDLYNX
+			cm.Cfg.AutoTechnology = true
+			cm.Cfg.TechnologyRungs = test.rungs
+			cm.Cfg.AutoConnectData = config.AutoConnectData{ID: 1337, Protocol: config.Protocol_UDP}
+			cm.Cfg.UsersData = &config.UsersData{}
+			dm := testNewDataManager()
+			dm.SetServersData(time.Now(), serversList(), "", time.Time{})
+			netw := &udpBlockingNetworker{}
+			rpc := NewRPC(
+				internal.Development,
+				&workingLoginChecker{},
+				cm,
+				dm,
+				core.NewDefaultAPI("", "", http.DefaultClient, response.NoopValidator{}),
+				mockServersAPI{},
+				&validCredentialsAPI{},
+				testNewCDNAPI(),
+				testNewRepoAPI(),
+				&mockAuthenticationAPI{},
+				"1.0.0",
+				&testfirewall.FirewallMock{},
+				daemonevents.NewEventsEmpty(),
+				func(config.Technology) (vpn.VPN, error) { return &mock.WorkingVPN{}, nil },
+				newEndpointResolverMock(netip.MustParseAddr("127.0.0.1")),
+				netw,
+				&subs.Subject[string]{},
+				&mock.DNSGetter{Names: []string{"1.1.1.1"}},
+				nil,
+				&mockAnalytics{},
+				&testnorduser.MockNorduserCombinedService{},
+				nil,
+				nil,
+				&RegistryMock{},
+				nil,
+				sharedctx.New(),
+				NewPendingActions(func() bool { return true }),
+				func() (netstate.Network, error) { return network, nil },
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			server := &mockRPCServer{}
+			err := rpc.Connect(&pb.ConnectRequest{}, server)
+			assert.NoError(t, err)
+			assert.Equal(t, internal.CodeConnected, server.msg.Type)
+			assert.Equal(t, test.expectedAttempts, netw.attempts)
+			assert.Equal(t, test.expectedRungs, cm.Cfg.TechnologyRungs)
+		})
+	}
+}
+
+func TestSetTechnology_Auto(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Technology = config.Technology_OPENVPN
+	r := RPC{
+		cm:      cm,
+		netw:    &testnetworker.Mock{},
+		factory: factoryWithout(config.Technology_UNKNOWN_TECHNOLOGY),
+		events:  daemonevents.NewEventsEmpty(),
+	}
+
+	resp, err := r.SetTechnology(context.Background(), &pb.SetTechnologyRequest{Auto: true})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.True(t, cm.Cfg.AutoTechnology)
+	assert.Equal(t, config.Technology_OPENVPN, cm.Cfg.Technology)
+
+	resp, err = r.SetTechnology(context.Background(), &pb.SetTechnologyRequest{Auto: true})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeNothingToDo, resp.Type)
+
+	// the same technology disables the automatic selection
+	cm.Cfg.TechnologyRungs = map[string]config.TechnologyRung{"ssid:Cafe": config.RungOpenVPNTCP}
+	resp, err = r.SetTechnology(context.Background(),
+		&pb.SetTechnologyRequest{Technology: config.Technology_OPENVPN})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodeSuccess, resp.Type)
+	assert.False(t, cm.Cfg.AutoTechnology)
+	assert.Nil(t, cm.Cfg.TechnologyRungs)
+}
+
+func TestSetTechnology_AutoWithPostQuantum(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Technology = config.Technology_NORDLYNX
+	cm.Cfg.AutoConnectData.PostquantumVpn = true
+	r := RPC{cm: cm, netw: &testnetworker.Mock{}, factory: factoryWithout(config.Technology_UNKNOWN_TECHNOLOGY)}
+
+	resp, err := r.SetTechnology(context.Background(), &pb.SetTechnologyRequest{Auto: true})
+	assert.NoError(t, err)
+	assert.Equal(t, internal.CodePqWithoutNordlynx, resp.Type)
+	assert.False(t, cm.Cfg.AutoTechnology)
+}
@@ -0,0 +1,134 @@
+package daemon
+
+import (
+	"log"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/netstate"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// NetworkIdentifier returns the networks the device currently uses
+type NetworkIdentifier func() (netstate.Network, error)
+
+// TrustedNetworkRules connect on the untrusted networks if auto-connect is enabled and skip auto-connect or
+// disconnect on the trusted ones. Rules are applied only when the device moves between trusted and untrusted
+// networks, so connecting or disconnecting manually is respected while staying in the same network.
+type TrustedNetworkRules struct {
+	mu          sync.Mutex
+	cm          config.Manager
+	identify    NetworkIdentifier
+	isVPNActive func() bool
+	// connect starts auto-connect without waiting for it to finish
+	connect    func()
+	disconnect func() error
+	// trusted is nil until the network is identified for the first time
+	trusted *bool
+}
+
+func newTrustedNetworkRules(
+	cm config.Manager,
+	identify NetworkIdentifier,
+	isVPNActive func() bool,
+	connect func(),
+	disconnect func() error,
+) *TrustedNetworkRules {
+	return &TrustedNetworkRules{
+		cm:          cm,
+		identify:    identify,
+		isVPNActive: isVPNActive,
+		connect:     connect,
+		disconnect:  disconnect,
+	}
+}
+
+// Reconnect applies the rules after the network has changed. It implements netstate.Reconnector.
+func (t *TrustedNetworkRules) Reconnect(stateIsUp bool) {
+	if stateIsUp {
+		t.apply()
+	}
+}
+
+// apply connects or disconnects if the device moved between trusted and untrusted networks
+func (t *TrustedNetworkRules) apply() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var cfg config.Config
+	if err := t.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, "loading config for trusted networks:", err)
+		return
+	}
+
+	previous := t.trusted
+	trusted, ok := t.check(cfg.TrustedNetworks)
+	if !ok || (previous != nil && *previous == trusted) {
+		return
+	}
+
+	if trusted {
+		if cfg.TrustedNetworks.ActionOrDefault() == config.TrustedNetworkDisconnect && t.isVPNActive() {
+			log.Println(internal.InfoPrefix, "joined trusted network, disconnecting")
+			if err := t.disconnect(); err != nil {
+				log.Println(internal.ErrorPrefix, "disconnecting on trusted network:", err)
+			}
+		}
+		return
+	}
+
+	if cfg.AutoConnectForAnyone() && !t.isVPNActive() {
+		log.Println(internal.InfoPrefix, "joined untrusted network, auto-connecting")
+		t.connect()
+	}
+}
+
+// IsTrusted identifies the current network and reports whether it is trusted
+func (t *TrustedNetworkRules) IsTrusted() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var cfg config.Config
+	if err := t.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, "loading config for trusted networks:", err)
+		return false
+	}
+
+	trusted, _ := t.check(cfg.TrustedNetworks)
+	return trusted
+}
+
+// check identifies the current network and remembers whether it is trusted. False is returned as the second
+// value if the network can't be identified. Not thread safe. Lock mu before using.
+func (t *TrustedNetworkRules) check(rules config.TrustedNetworks) (bool, bool) {
+	if t.identify == nil {
+		return false, false
+	}
+
+	network, err := t.identify()
+	if err != nil {
+		log.Println(internal.WarningPrefix, "failed to identify the network:", err)
+		return false, false
+	}
+	if len(network) == 0 {
+		return false, false
+	}
+
+	trusted := isTrustedNetwork(rules, network)
+	t.trusted = &trusted
+	return trusted, true
+}
+
+// isTrustedNetwork reports whether every network the device uses is trusted, otherwise the traffic may leave
+// through the untrusted one
+func isTrustedNetwork(rules config.TrustedNetworks, network netstate.Network) bool {
+	if rules.IsEmpty() || len(network) == 0 {
+		return false
+	}
+	for _, iface := range network {
+		if !rules.TrustsInterface(iface.SSID, iface.Addrs) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,156 @@
+package daemon
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/netstate"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	homeWiFi = netstate.Network{{Name: "wlan0", SSID: "Home"}}
+	cafeWiFi = netstate.Network{{Name: "wlan0", SSID: "Cafe"}}
+	office   = netstate.Network{{Name: "eth0", Addrs: []netip.Addr{netip.MustParseAddr("10.1.2.3")}}}
+)
+
+type trustedNetworksEnv struct {
+	network     netstate.Network
+	identifyErr error
+	vpnActive   bool
+	connects    int
+	disconnects int
+}
+
+func newTestTrustedNetworkRules(cm config.Manager, env *trustedNetworksEnv) *TrustedNetworkRules {
+	return newTrustedNetworkRules(
+		cm,
+		func() (netstate.Network, error) { return env.network, env.identifyErr },
+		func() bool { return env.vpnActive },
+		func() {
+			env.connects++
+			env.vpnActive = true
+		},
+		func() error {
+			env.disconnects++
+			env.vpnActive = false
+			return nil
+		},
+	)
+}
+
+func TestIsTrustedNetwork(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	rules := config.TrustedNetworks{
+		SSIDs:   map[string]bool{"Home": true},
+		Subnets: config.Subnets{"10.0.0.0/8": true},
+	}
+
+	assert.True(t, isTrustedNetwork(rules, homeWiFi))
+	assert.True(t, isTrustedNetwork(rules, office))
+	assert.True(t, isTrustedNetwork(rules, append(homeWiFi, office...)))
+	assert.False(t, isTrustedNetwork(rules, cafeWiFi))
+	assert.False(t, isTrustedNetwork(rules, append(cafeWiFi, office...)), "every network must be trusted")
+	assert.False(t, isTrustedNetwork(rules, netstate.Network{}))
+	assert.False(t, isTrustedNetwork(config.TrustedNetworks{}, homeWiFi))
+}
+
+func TestTrustedNetworkRules_Apply(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name                string
+		action              config.TrustedNetworkAction
+		autoConnect         bool
+		vpnActive           bool
+		networks            []netstate.Network
+		expectedConnects    int
+		expectedDisconnects int
+	}{
+		{
+			name:                "disconnect on joining trusted network",
+			action:              config.TrustedNetworkDisconnect,
+			vpnActive:           true,
+			networks:            []netstate.Network{cafeWiFi, homeWiFi},
+			expectedDisconnects: 1,
+		},
+		{
+			name:      "connection is kept on trusted network by default",
+			vpnActive: true,
+			networks:  []netstate.Network{cafeWiFi, homeWiFi},
+		},
+		{
+			name:             "connect on leaving trusted network",
+			autoConnect:      true,
+			networks:         []netstate.Network{homeWiFi, cafeWiFi},
+			expectedConnects: 1,
+		},
+		{
+			name:     "no connect without auto-connect",
+			networks: []netstate.Network{homeWiFi, cafeWiFi},
+		},
+		{
+			name:        "nothing happens while staying in the same kind of network",
+			action:      config.TrustedNetworkDisconnect,
+			autoConnect: true,
+			networks:    []netstate.Network{cafeWiFi, office, cafeWiFi},
+		},
+		{
+			name:        "offline is ignored",
+			autoConnect: true,
+			networks:    []netstate.Network{homeWiFi, {}, homeWiFi},
+		},
+		{
+			name:                "moving back and forth",
+			action:              config.TrustedNetworkDisconnect,
+			autoConnect:         true,
+			networks:            []netstate.Network{homeWiFi, cafeWiFi, homeWiFi, cafeWiFi},
+			expectedConnects:    2,
+			expectedDisconnects: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.AutoConnect = test.autoConnect
+			cm.Cfg.TrustedNetworks = config.TrustedNetworks{
+				SSIDs:  map[string]bool{"Home": true},
+				Action: test.action,
+			}
+			env := &trustedNetworksEnv{vpnActive: test.vpnActive}
+			rules := newTestTrustedNetworkRules(cm, env)
+
+			env.network = test.networks[0]
+			rules.IsTrusted()
+			for _, network := range test.networks[1:] {
+				env.network = network
+				rules.Reconnect(true)
+			}
+
+			assert.Equal(t, test.expectedConnects, env.connects)
+			assert.Equal(t, test.expectedDisconnects, env.disconnects)
+		})
+	}
+}
+
+func TestTrustedNetworkRules_IsTrusted(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.TrustedNetworks = config.TrustedNetworks{SSIDs: map[string]bool{"Home": true}}
+	env := &trustedNetworksEnv{network: homeWiFi}
+	rules := newTestTrustedNetworkRules(cm, env)
+	assert.True(t, rules.IsTrusted())
+
+	env.identifyErr = errors.New("failed")
+	assert.False(t, rules.IsTrusted())
+
+	assert.False(t, newTrustedNetworkRules(cm, nil, nil, nil, nil).IsTrusted())
+}
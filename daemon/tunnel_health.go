@@ -0,0 +1,295 @@
+package daemon
+
+import (
+	"log"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/network"
+)
+
+const (
+	// tunnelHealthCheckInterval defines how often the health of the active tunnel is checked
+	tunnelHealthCheckInterval = 30 * time.Second
+	// tunnelHealthProbeAddr is the VPN nameserver pinged through the tunnel. Ping measures the latency and makes
+	// sure that something is received through the working tunnel even when it is idle.
+	tunnelHealthProbeAddr = "103.86.96.100"
+	// tunnelRecoveryWindow defines how long after reconnecting to the same server the server is still suspected,
+	// the next-best server is picked if the tunnel fails again within it
+	tunnelRecoveryWindow = 10 * time.Minute
+	// tunnelHealthPingCount defines how many echo requests are sent to the VPN server to measure round trip time
+	tunnelHealthPingCount = 3
+)
+
+// healthThresholds define when the tunnel is unhealthy
+type healthThresholds struct {
+	// handshakeAge is the maximum age of the latest handshake, WireGuard renews the session every 2 minutes
+	handshakeAge time.Duration
+	// latency is the maximum round trip time through the tunnel, zero disables the latency check
+	latency time.Duration
+	// failures is the number of consecutive failed checks after which the tunnel is recovered
+	failures int
+}
+
+// healthThresholdsFor returns the thresholds of the sensitivity, false is returned if the tunnel is not checked
+func healthThresholdsFor(sensitivity config.HealthSensitivity) (healthThresholds, bool) {
+	switch sensitivity {
+	case config.HealthMonitorLow:
+		return healthThresholds{handshakeAge: 5 * time.Minute, failures: 4}, true
+	case config.HealthMonitorNormal:
+		return healthThresholds{handshakeAge: 3 * time.Minute, failures: 2}, true
+	case config.HealthMonitorHigh:
+		return healthThresholds{handshakeAge: 3 * time.Minute, latency: time.Second, failures: 1}, true
+	case config.HealthMonitorOff:
+	}
+	return healthThresholds{}, false
+}
+
+// tunnelHealthSample is the state of the active tunnel measured by a single check
+type tunnelHealthSample struct {
+	serverID int64
+	// download is the amount of data received through the tunnel
+	download uint64
+	// lastHandshake is zero if the technology does not report the handshakes
+	lastHandshake time.Time
+	// rtt is negative if the probe has not been answered
+	rtt time.Duration
+}
+
+// healthMonitor tracks the checks of the active tunnel and decides how the failing tunnel is recovered. First, a new
+// session is established with the same server. If the tunnel fails again soon after, the next-best server is used.
+type healthMonitor struct {
+	mu       sync.Mutex
+	serverID int64
+	download uint64
+	failures int
+	// reconnected is set when the next sample starts the new session
+	reconnected bool
+	// recoveredAt is the time of the latest reconnect to the same server
+	recoveredAt time.Time
+	now         func() time.Time
+}
+
+func newHealthMonitor(now func() time.Time) *healthMonitor {
+	return &healthMonitor{now: now}
+}
+
+// reset forgets the checks of the previous tunnel
+func (h *healthMonitor) reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.serverID = 0
+	h.failures = 0
+	h.recoveredAt = time.Time{}
+}
+
+// check registers the sample and returns the problem and the recovery action if the tunnel has to be recovered
+func (h *healthMonitor) check(
+	sample tunnelHealthSample,
+	thresholds healthThresholds,
+) (pb.TunnelHealthProblem, pb.TunnelRecoveryAction, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	now := h.now()
+	// traffic counters start from zero in the new session
+	if h.reconnected || sample.serverID != h.serverID || sample.download < h.download {
+		if sample.serverID != h.serverID {
+			h.recoveredAt = time.Time{}
+		}
+		h.serverID = sample.serverID
+		h.download = sample.download
+		h.failures = 0
+		h.reconnected = false
+		return 0, 0, false
+	}
+
+	problem, failed := healthProblem(sample, h.download, thresholds, now)
+	h.download = sample.download
+	if !failed {
+		h.failures = 0
+		return 0, 0, false
+	}
+
+	h.failures++
+	log.Println(internal.WarningPrefix, "tunnel health check failed:", problem,
+		"(", h.failures, "of", thresholds.failures, ")")
+	if h.failures < thresholds.failures {
+		return 0, 0, false
+	}
+
+	action := pb.TunnelRecoveryAction_RECONNECT_SAME_SERVER
+	if !h.recoveredAt.IsZero() && now.Sub(h.recoveredAt) < tunnelRecoveryWindow {
+		action = pb.TunnelRecoveryAction_RECONNECT_NEXT_SERVER
+		h.recoveredAt = time.Time{}
+	} else {
+		h.recoveredAt = now
+	}
+	h.failures = 0
+	h.reconnected = true
+	return problem, action, true
+}
+
+// healthProblem returns the first problem found in the sample
+func healthProblem(
+	sample tunnelHealthSample,
+	previousDownload uint64,
+	thresholds healthThresholds,
+	now time.Time,
+) (pb.TunnelHealthProblem, bool) {
+	switch {
+	case !sample.lastHandshake.IsZero() && now.Sub(sample.lastHandshake) > thresholds.handshakeAge:
+		return pb.TunnelHealthProblem_STALE_HANDSHAKE, true
+	case sample.rtt < 0 && sample.download == previousDownload:
+		return pb.TunnelHealthProblem_NO_INBOUND_TRAFFIC, true
+	case thresholds.latency > 0 && sample.rtt > thresholds.latency:
+		return pb.TunnelHealthProblem_HIGH_LATENCY, true
+	}
+	return 0, false
+}
+
+// checkTunnelHealth probes the active tunnel and recovers it if it is failing
+func (r *RPC) checkTunnelHealth() {
+	if !r.netw.IsVPNActive() {
+		r.healthMonitor.reset()
+		return
+	}
+
+	var cfg config.Config
+	if err := r.cm.Load(&cfg); err != nil {
+		log.Println(internal.ErrorPrefix, err)
+		return
+	}
+	thresholds, ok := healthThresholdsFor(cfg.HealthMonitorOrDefault())
+	if !ok {
+		return
+	}
+
+	sample := tunnelHealthSample{serverID: r.lastServer.ID, rtt: -1}
+	if rtt, err := r.probeLatency(tunnelHealthProbeAddr); err == nil {
+		sample.rtt = rtt
+	}
+	// traffic is read after the probe, so the answer to it is counted
+	status, err := r.netw.ConnectionStatus()
+	if err != nil {
+		return
+	}
+	sample.download = status.Download
+	if stats, err := r.netw.SessionStats(); err == nil {
+		sample.lastHandshake = stats.LastHandshake
+	}
+
+	problem, action, recover := r.healthMonitor.check(sample, thresholds)
+	if !recover {
+		return
+	}
+	r.recoverTunnel(problem, action)
+}
+
+// recoverTunnel connects again to the same target. The next-best server is picked by marking the failing one as
+// not responding, so the fastest of the other recommended servers is used. Dedicated IP, Double VPN and the
+// specific servers are always connected to again as there is nothing else to choose from.
+func (r *RPC) recoverTunnel(problem pb.TunnelHealthProblem, action pb.TunnelRecoveryAction) {
+	server := r.lastServer
+	target := &pb.ConnectRequest{ServerTag: server.Hostname}
+	if r.lastTarget != nil {
+		target = &pb.ConnectRequest{
+			ServerTag:   r.lastTarget.GetServerTag(),
+			ServerGroup: r.lastTarget.GetServerGroup(),
+			Dns:         r.lastTarget.GetDns(),
+			Via:         r.lastTarget.GetVia(),
+			DedicatedIp: r.lastTarget.GetDedicatedIp(),
+		}
+	}
+	specific := target.GetVia() != "" || target.GetDedicatedIp() != "" ||
+		trimServerDomain(target.GetServerTag()) == trimServerDomain(server.Hostname)
+
+	switch {
+	case specific:
+		action = pb.TunnelRecoveryAction_RECONNECT_SAME_SERVER
+	case action == pb.TunnelRecoveryAction_RECONNECT_NEXT_SERVER:
+		r.dm.SetServerLatency(server.ID, -1)
+		target.Fastest = true
+	default:
+		target.ServerTag = server.Hostname
+		target.ServerGroup = ""
+	}
+
+	log.Println(internal.WarningPrefix, "tunnel to", server.Hostname, "is unhealthy:", problem, "recovering:", action)
+	r.eventStream.NotifyTunnelHealth(&pb.TunnelHealthEvent{
+		Problem:        problem,
+		Action:         action,
+		ServerHostname: server.Hostname,
+	})
+
+	srv := autoconnectServer{}
+	if err := r.Connect(target, &srv); !connectErrorCheck(err) || srv.err != nil {
+		log.Println(internal.ErrorPrefix, "tunnel recovery failed, err1:", srv.err, "| err2:", err)
+	}
+}
+
+// serverPingTracker remembers the ping statistics of the connected VPN server. The server is pinged in the background
+// because the echo requests take seconds and may be dropped altogether, so they can't be sent on the status request.
+// Neither NordLynx nor the OpenVPN management interface report the round trip time of the tunnel. Thread safe.
+type serverPingTracker struct {
+	mu    sync.Mutex
+	addr  string
+	stats network.PingStatistics
+	valid bool
+	ping  func(addr string, count int) (network.PingStatistics, error)
+}
+
+func newServerPingTracker(ping func(addr string, count int) (network.PingStatistics, error)) *serverPingTracker {
+	return &serverPingTracker{ping: ping}
+}
+
+// update pings the server and remembers the results
+func (s *serverPingTracker) update(addr string) {
+	stats, err := s.ping(addr, tunnelHealthPingCount)
+	if err != nil {
+		log.Println(internal.WarningPrefix, "failed to ping the VPN server:", err)
+		s.reset()
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addr = addr
+	s.stats = stats
+	s.valid = true
+}
+
+// get returns the latest statistics, false is returned if the server has not been pinged yet
+func (s *serverPingTracker) get(addr string) (network.PingStatistics, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.valid || s.addr != addr {
+		return network.PingStatistics{}, false
+	}
+	return s.stats, true
+}
+
+func (s *serverPingTracker) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.addr = ""
+	s.stats = network.PingStatistics{}
+	s.valid = false
+}
+
+// pingServer measures the round trip time to the connected VPN server for the verbose status
+func (r *RPC) pingServer() {
+	if !r.netw.IsVPNActive() {
+		r.serverPing.reset()
+		return
+	}
+	status, err := r.netw.ConnectionStatus()
+	if err != nil || status.IP == (netip.Addr{}) {
+		return
+	}
+	r.serverPing.update(status.IP.String())
+}
@@ -0,0 +1,179 @@
+package daemon
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/network"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	testnetworker "github.com/NordSecurity/nordvpn-linux/test/mock/networker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthMonitor_Problems(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	normal, _ := healthThresholdsFor(config.HealthMonitorNormal)
+	high, _ := healthThresholdsFor(config.HealthMonitorHigh)
+
+	tests := []struct {
+		name       string
+		sample     tunnelHealthSample
+		thresholds healthThresholds
+		problem    pb.TunnelHealthProblem
+		failed     bool
+	}{
+		{
+			name:       "healthy",
+			sample:     tunnelHealthSample{download: 200, lastHandshake: now.Add(-time.Minute), rtt: 50 * time.Millisecond},
+			thresholds: normal,
+		},
+		{
+			name:       "handshakes are not reported",
+			sample:     tunnelHealthSample{download: 200, rtt: 50 * time.Millisecond},
+			thresholds: normal,
+		},
+		{
+			name:       "stale handshake",
+			sample:     tunnelHealthSample{download: 200, lastHandshake: now.Add(-4 * time.Minute), rtt: -1},
+			thresholds: normal,
+			problem:    pb.TunnelHealthProblem_STALE_HANDSHAKE,
+			failed:     true,
+		},
+		{
+			name:       "nothing received",
+			sample:     tunnelHealthSample{download: 100, rtt: -1},
+			thresholds: normal,
+			problem:    pb.TunnelHealthProblem_NO_INBOUND_TRAFFIC,
+			failed:     true,
+		},
+		{
+			name:       "probe is not answered but traffic is flowing",
+			sample:     tunnelHealthSample{download: 200, rtt: -1},
+			thresholds: normal,
+		},
+		{
+			name:       "high latency is ignored by normal sensitivity",
+			sample:     tunnelHealthSample{download: 200, rtt: 2 * time.Second},
+			thresholds: normal,
+		},
+		{
+			name:       "high latency",
+			sample:     tunnelHealthSample{download: 200, rtt: 2 * time.Second},
+			thresholds: high,
+			problem:    pb.TunnelHealthProblem_HIGH_LATENCY,
+			failed:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			problem, failed := healthProblem(test.sample, 100, test.thresholds, now)
+			assert.Equal(t, test.failed, failed)
+			assert.Equal(t, test.problem, problem)
+		})
+	}
+}
+
+func TestHealthThresholdsFor_Off(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	_, ok := healthThresholdsFor(config.HealthMonitorOff)
+	assert.False(t, ok)
+	for _, sensitivity := range []config.HealthSensitivity{
+		config.HealthMonitorLow, config.HealthMonitorNormal, config.HealthMonitorHigh,
+	} {
+		thresholds, ok := healthThresholdsFor(sensitivity)
+		assert.True(t, ok)
+		assert.Greater(t, thresholds.failures, 0)
+	}
+}
+
+func TestHealthMonitor_Recovery(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	monitor := newHealthMonitor(func() time.Time { return now })
+	thresholds, _ := healthThresholdsFor(config.HealthMonitorNormal)
+	stalled := tunnelHealthSample{serverID: 1, download: 100, rtt: -1}
+
+	// the first sample of the session is the baseline
+	_, _, recover := monitor.check(stalled, thresholds)
+	assert.False(t, recover)
+
+	_, _, recover = monitor.check(stalled, thresholds)
+	assert.False(t, recover, "recovered before reaching the failure count")
+
+	problem, action, recover := monitor.check(stalled, thresholds)
+	assert.True(t, recover)
+	assert.Equal(t, pb.TunnelHealthProblem_NO_INBOUND_TRAFFIC, problem)
+	assert.Equal(t, pb.TunnelRecoveryAction_RECONNECT_SAME_SERVER, action)
+
+	// new session with the same server starts the counters from zero
+	now = now.Add(time.Minute)
+	_, _, recover = monitor.check(tunnelHealthSample{serverID: 1, download: 10, rtt: -1}, thresholds)
+	assert.False(t, recover)
+	monitor.check(tunnelHealthSample{serverID: 1, download: 10, rtt: -1}, thresholds)
+	_, action, recover = monitor.check(tunnelHealthSample{serverID: 1, download: 10, rtt: -1}, thresholds)
+	assert.True(t, recover)
+	assert.Equal(t, pb.TunnelRecoveryAction_RECONNECT_NEXT_SERVER, action)
+
+	// failure long after the recovery is handled by the same server again
+	now = now.Add(time.Hour)
+	monitor.check(tunnelHealthSample{serverID: 1, download: 10, rtt: -1}, thresholds)
+	monitor.check(tunnelHealthSample{serverID: 1, download: 10, rtt: -1}, thresholds)
+	_, action, recover = monitor.check(tunnelHealthSample{serverID: 1, download: 10, rtt: -1}, thresholds)
+	assert.True(t, recover)
+	assert.Equal(t, pb.TunnelRecoveryAction_RECONNECT_SAME_SERVER, action)
+}
+
+func TestHealthMonitor_HealthyCheckResetsFailures(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	monitor := newHealthMonitor(time.Now)
+	thresholds, _ := healthThresholdsFor(config.HealthMonitorNormal)
+
+	monitor.check(tunnelHealthSample{serverID: 1, download: 100, rtt: -1}, thresholds)
+	monitor.check(tunnelHealthSample{serverID: 1, download: 100, rtt: -1}, thresholds)
+	monitor.check(tunnelHealthSample{serverID: 1, download: 200, rtt: time.Millisecond}, thresholds)
+	_, _, recover := monitor.check(tunnelHealthSample{serverID: 1, download: 200, rtt: -1}, thresholds)
+	assert.False(t, recover)
+}
+
+func TestTunnelHealth_ServerPing(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	pings := 0
+	tracker := newServerPingTracker(func(addr string, count int) (network.PingStatistics, error) {
+		pings++
+		if addr == "10.0.0.2" {
+			return network.PingStatistics{}, errors.New("ping failed")
+		}
+		return network.PingStatistics{Sent: count, Received: count - 1, AvgRTT: 40 * time.Millisecond}, nil
+	})
+	rpc := RPC{netw: &testnetworker.Mock{}, serverPing: tracker}
+
+	health := rpc.tunnelHealth("10.0.0.1")
+	assert.Equal(t, int64(-1), health.Rtt, "server is not pinged on the status request")
+	assert.Zero(t, health.PingsSent)
+	assert.Zero(t, pings)
+
+	tracker.update("10.0.0.1")
+	health = rpc.tunnelHealth("10.0.0.1")
+	assert.Equal(t, int64(40*time.Millisecond), health.Rtt)
+	assert.Equal(t, uint32(tunnelHealthPingCount), health.PingsSent)
+	assert.Equal(t, uint32(tunnelHealthPingCount-1), health.PingsReceived)
+
+	health = rpc.tunnelHealth("10.0.0.3")
+	assert.Equal(t, int64(-1), health.Rtt, "statistics of the previous server are not reported")
+
+	tracker.update("10.0.0.2")
+	health = rpc.tunnelHealth("10.0.0.1")
+	assert.Equal(t, int64(-1), health.Rtt, "statistics are dropped when the ping fails")
+	assert.Equal(t, 2, pings)
+}
@@ -5,4 +5,6 @@ import "errors"
 var (
 	ErrVPNAIsAlreadyStarted = errors.New("vpn is already started")
 	ErrTunnelAlreadyExists  = errors.New("tunnel already exists")
+	// ErrTechnologyUnavailable is returned when the technology is not included in the build
+	ErrTechnologyUnavailable = errors.New("technology is not available")
 )
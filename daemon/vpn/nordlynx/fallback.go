@@ -0,0 +1,123 @@
+package nordlynx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/tunnel"
+)
+
+// Fallback connects through the WireGuard kernel module and falls back to the embedded userspace implementation
+// when the module is not available, e.g. on old kernels or in containers. The module is checked on every
+// connection, so it is used as soon as it is loaded.
+type Fallback struct {
+	mu              sync.Mutex
+	kernel          vpn.VPN
+	userSpace       vpn.VPN
+	eventsPublisher *vpn.Events
+	// current is the implementation used by the latest connection
+	current     vpn.VPN
+	isUserSpace bool
+}
+
+func NewFallback(fwmark uint32, eventsPublisher *vpn.Events) *Fallback {
+	kernel := NewKernelSpace(fwmark, eventsPublisher)
+	return &Fallback{
+		kernel:          kernel,
+		userSpace:       NewUserSpace(fwmark),
+		eventsPublisher: eventsPublisher,
+		current:         kernel,
+	}
+}
+
+func (f *Fallback) Start(ctx context.Context, creds vpn.Credentials, serverData vpn.ServerData) (err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.current = f.kernel
+	f.isUserSpace = false
+	err = f.kernel.Start(ctx, creds, serverData)
+	if !errors.Is(err, errNoKernelModule) {
+		return err
+	}
+
+	log.Println(internal.WarningPrefix, "wireguard kernel module is not available, using userspace implementation")
+	// kernel implementation has published the connection attempt, its outcome is published here
+	defer func() {
+		if err != nil {
+			f.eventsPublisher.Disconnected.Publish(events.DataDisconnect{})
+			return
+		}
+		f.eventsPublisher.Connected.Publish(events.DataConnect{
+			EventStatus:         events.StatusSuccess,
+			TargetServerIP:      serverData.IP.String(),
+			TargetServerCountry: serverData.Country,
+			TargetServerCity:    serverData.City,
+		})
+	}()
+	if err := f.userSpace.Start(ctx, creds, serverData); err != nil {
+		return fmt.Errorf("turning on userspace nordlynx: %w", err)
+	}
+	f.current = f.userSpace
+	f.isUserSpace = true
+	return nil
+}
+
+func (f *Fallback) Stop() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.isUserSpace {
+		// userspace implementation does not publish the events on its own
+		f.eventsPublisher.Disconnected.Publish(events.DataDisconnect{})
+	}
+	return f.current.Stop()
+}
+
+func (f *Fallback) State() vpn.State {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.current.State()
+}
+
+func (f *Fallback) IsActive() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.current.IsActive()
+}
+
+func (f *Fallback) Tun() tunnel.T {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.current.Tun()
+}
+
+func (f *Fallback) NetworkChanged() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.current.NetworkChanged()
+}
+
+// IsUserSpace reports whether the latest connection uses the userspace implementation
+func (f *Fallback) IsUserSpace() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.isUserSpace && f.current.IsActive()
+}
+
+// SessionStats returns handshake statistics of the active tunnel
+func (f *Fallback) SessionStats() (vpn.SessionStats, error) {
+	f.mu.Lock()
+	current := f.current
+	f.mu.Unlock()
+	getter, ok := current.(vpn.SessionStatsGetter)
+	if !ok {
+		return vpn.SessionStats{}, errors.New("session statistics are not supported")
+	}
+	return getter.SessionStats()
+}
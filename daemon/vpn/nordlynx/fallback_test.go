@@ -0,0 +1,133 @@
+package nordlynx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/tunnel"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeVPN struct {
+	startErr error
+	active   bool
+	stopped  bool
+}
+
+func (f *fakeVPN) Start(context.Context, vpn.Credentials, vpn.ServerData) error {
+	if f.startErr != nil {
+		return f.startErr
+	}
+	f.active = true
+	return nil
+}
+
+func (f *fakeVPN) Stop() error {
+	f.active = false
+	f.stopped = true
+	return nil
+}
+
+func (*fakeVPN) State() vpn.State      { return vpn.ConnectedState }
+func (f *fakeVPN) IsActive() bool      { return f.active }
+func (*fakeVPN) Tun() tunnel.T         { return &tunnel.Tunnel{} }
+func (*fakeVPN) NetworkChanged() error { return nil }
+
+type eventsRecorder struct {
+	connected    []events.DataConnect
+	disconnected int
+}
+
+func (r *eventsRecorder) NotifyConnect(e events.DataConnect) error {
+	r.connected = append(r.connected, e)
+	return nil
+}
+
+func (r *eventsRecorder) NotifyDisconnect(events.DataDisconnect) error {
+	r.disconnected++
+	return nil
+}
+
+func newTestFallback(kernel, userSpace *fakeVPN) (*Fallback, *eventsRecorder) {
+	recorder := &eventsRecorder{}
+	publisher := vpn.NewInternalVPNEvents()
+	publisher.Subscribe(recorder)
+	return &Fallback{
+		kernel:          kernel,
+		userSpace:       userSpace,
+		eventsPublisher: publisher,
+		current:         kernel,
+	}, recorder
+}
+
+func TestFallback_KernelModule(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	kernel, userSpace := &fakeVPN{}, &fakeVPN{}
+	fallback, recorder := newTestFallback(kernel, userSpace)
+
+	assert.NoError(t, fallback.Start(context.Background(), vpn.Credentials{}, vpn.ServerData{}))
+	assert.True(t, kernel.active)
+	assert.False(t, userSpace.active)
+	assert.False(t, fallback.IsUserSpace())
+	assert.Empty(t, recorder.connected)
+
+	assert.NoError(t, fallback.Stop())
+	assert.True(t, kernel.stopped)
+	assert.Zero(t, recorder.disconnected)
+}
+
+func TestFallback_UserSpace(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	kernel := &fakeVPN{startErr: fmt.Errorf("turning on nordlynx: %w", errNoKernelModule)}
+	userSpace := &fakeVPN{}
+	fallback, recorder := newTestFallback(kernel, userSpace)
+
+	server := vpn.ServerData{IP: netip.MustParseAddr("1.2.3.4"), Country: "Germany"}
+	assert.NoError(t, fallback.Start(context.Background(), vpn.Credentials{}, server))
+	assert.True(t, userSpace.active)
+	assert.True(t, fallback.IsActive())
+	assert.True(t, fallback.IsUserSpace())
+	assert.Len(t, recorder.connected, 1)
+	assert.Equal(t, events.StatusSuccess, recorder.connected[0].EventStatus)
+	assert.Equal(t, "1.2.3.4", recorder.connected[0].TargetServerIP)
+
+	assert.NoError(t, fallback.Stop())
+	assert.True(t, userSpace.stopped)
+	assert.False(t, fallback.IsUserSpace())
+	assert.Equal(t, 1, recorder.disconnected)
+}
+
+func TestFallback_Failures(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	// other kernel errors are not worked around
+	kernelErr := errors.New("operation not permitted")
+	fallback, recorder := newTestFallback(&fakeVPN{startErr: kernelErr}, &fakeVPN{})
+	assert.ErrorIs(t, fallback.Start(context.Background(), vpn.Credentials{}, vpn.ServerData{}), kernelErr)
+	assert.False(t, fallback.IsUserSpace())
+	assert.Zero(t, recorder.disconnected)
+
+	userSpaceErr := errors.New("creating tun device: no such file or directory")
+	fallback, recorder = newTestFallback(&fakeVPN{startErr: errNoKernelModule}, &fakeVPN{startErr: userSpaceErr})
+	assert.ErrorIs(t, fallback.Start(context.Background(), vpn.Credentials{}, vpn.ServerData{}), userSpaceErr)
+	assert.False(t, fallback.IsUserSpace())
+	assert.Empty(t, recorder.connected)
+	assert.Equal(t, 1, recorder.disconnected)
+}
+
+func TestIsNoKernelModuleError(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.True(t, isNoKernelModuleError(errors.New("failed to add device exit status 2: Error: Unknown device type.")))
+	assert.True(t, isNoKernelModuleError(errors.New("RTNETLINK answers: Operation not supported")))
+	assert.False(t, isNoKernelModuleError(errors.New("RTNETLINK answers: File exists")))
+}
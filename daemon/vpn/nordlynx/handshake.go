@@ -0,0 +1,164 @@
+package nordlynx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// handshakePollInterval defines how often the handshake is checked while waiting for it
+const handshakePollInterval = 500 * time.Millisecond
+
+// handshakeTrackInterval defines how often the handshake is checked while connected. It is shorter than the
+// WireGuard rekey interval of 2 minutes, so the rekeys are not missed between the checks.
+const handshakeTrackInterval = 30 * time.Second
+
+var errNoHandshake = errors.New("no handshake has been made yet")
+
+// HandshakeTracker remembers the latest handshake of the tunnel in order to count rekeys. Thread safe.
+type HandshakeTracker struct {
+	mu     sync.Mutex
+	latest time.Time
+	rekeys uint32
+	// stop ends the tracking of the current session, nil if it is not tracked
+	stop chan struct{}
+	// interval overrides handshakeTrackInterval in tests
+	interval time.Duration
+}
+
+// Update registers the latest handshake and returns the statistics of the session
+func (h *HandshakeTracker) Update(latest time.Time) vpn.SessionStats {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.update(latest)
+}
+
+func (h *HandshakeTracker) update(latest time.Time) vpn.SessionStats {
+	if !h.latest.IsZero() && latest.After(h.latest) {
+		h.rekeys++
+	}
+	if latest.After(h.latest) {
+		h.latest = latest
+	}
+	return vpn.SessionStats{LastHandshake: h.latest, Rekeys: h.rekeys}
+}
+
+// Track checks the handshake periodically until Reset, so the rekeys made between the status requests are
+// counted too
+func (h *HandshakeTracker) Track(latest func() (time.Time, error)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.stopTracking()
+	stop := make(chan struct{})
+	h.stop = stop
+	interval := h.interval
+	if interval == 0 {
+		interval = handshakeTrackInterval
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+			handshake, err := latest()
+			if err != nil {
+				continue
+			}
+			h.mu.Lock()
+			// handshake of the previous session must not be counted after the reset
+			if h.stop == stop {
+				h.update(handshake)
+			}
+			h.mu.Unlock()
+		}
+	}()
+}
+
+// Reset stops tracking and forgets the handshakes of the previous session
+func (h *HandshakeTracker) Reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.stopTracking()
+	h.latest = time.Time{}
+	h.rekeys = 0
+}
+
+func (h *HandshakeTracker) stopTracking() {
+	if h.stop != nil {
+		close(h.stop)
+		h.stop = nil
+	}
+}
+
+// LatestHandshake returns the time of the latest handshake with the peer on the given WireGuard interface. If
+// publicKey is empty, the latest handshake of all the peers is returned. Both kernel and userspace (through UAPI
+// socket) implementations are supported.
+func LatestHandshake(iface string, publicKey string) (time.Time, error) {
+	// #nosec G204 -- input is properly sanitized
+	out, err := exec.Command("wg", "show", iface, "latest-handshakes").CombinedOutput()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("retrieving latest handshakes: %s: %w", strings.TrimSpace(string(out)), err)
+	}
+	return parseLatestHandshake(string(out), publicKey)
+}
+
+// waitForHandshake checks the latest handshake until it is made or the timeout expires. The connection is not
+// failed if the handshakes can't be retrieved at all.
+func waitForHandshake(ctx context.Context, timeout time.Duration, latest func() (time.Time, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(handshakePollInterval)
+	defer ticker.Stop()
+	for {
+		_, err := latest()
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, errNoHandshake) {
+			log.Println(internal.WarningPrefix, "handshake can't be checked:", err)
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("no handshake with the server within %s", timeout)
+		case <-ticker.C:
+		}
+	}
+}
+
+// parseLatestHandshake parses output of `wg show <iface> latest-handshakes` which consists of lines containing
+// peer public key and the unix timestamp of the latest handshake with it
+func parseLatestHandshake(output string, publicKey string) (time.Time, error) {
+	var latest int64
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 || (publicKey != "" && fields[0] != publicKey) {
+			continue
+		}
+		timestamp, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parsing handshake timestamp: %w", err)
+		}
+		if timestamp > latest {
+			latest = timestamp
+		}
+	}
+	if latest == 0 {
+		return time.Time{}, errNoHandshake
+	}
+	return time.Unix(latest, 0), nil
+}
@@ -0,0 +1,159 @@
+package nordlynx
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLatestHandshake(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name      string
+		output    string
+		publicKey string
+		expected  time.Time
+		hasError  bool
+	}{
+		{
+			name:     "single peer",
+			output:   "Kq1Ajn9FBx6Lm6yDyNW2c4sYcWUjPI34CbjyTKlrF3M=\t1700000000\n",
+			expected: time.Unix(1700000000, 0),
+		},
+		{
+			name: "latest of multiple peers",
+			output: "Kq1Ajn9FBx6Lm6yDyNW2c4sYcWUjPI34CbjyTKlrF3M=\t1700000000\n" +
+				"/h8vcyV9J1Ztjvf8TEDH2gGeqGEVwBo+ovXW4Pv4M3A=\t1700000120\n",
+			expected: time.Unix(1700000120, 0),
+		},
+		{
+			name: "selected peer",
+			output: "Kq1Ajn9FBx6Lm6yDyNW2c4sYcWUjPI34CbjyTKlrF3M=\t1700000000\n" +
+				"/h8vcyV9J1Ztjvf8TEDH2gGeqGEVwBo+ovXW4Pv4M3A=\t1700000120\n",
+			publicKey: "Kq1Ajn9FBx6Lm6yDyNW2c4sYcWUjPI34CbjyTKlrF3M=",
+			expected:  time.Unix(1700000000, 0),
+		},
+		{
+			name:      "selected peer is missing",
+			output:    "/h8vcyV9J1Ztjvf8TEDH2gGeqGEVwBo+ovXW4Pv4M3A=\t1700000120\n",
+			publicKey: "Kq1Ajn9FBx6Lm6yDyNW2c4sYcWUjPI34CbjyTKlrF3M=",
+			hasError:  true,
+		},
+		{
+			name:     "no handshake yet",
+			output:   "Kq1Ajn9FBx6Lm6yDyNW2c4sYcWUjPI34CbjyTKlrF3M=\t0\n",
+			hasError: true,
+		},
+		{
+			name:     "empty output",
+			output:   "",
+			hasError: true,
+		},
+		{
+			name:     "invalid timestamp",
+			output:   "Kq1Ajn9FBx6Lm6yDyNW2c4sYcWUjPI34CbjyTKlrF3M=\tnever\n",
+			hasError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			latest, err := parseLatestHandshake(test.output, test.publicKey)
+			if test.hasError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, latest)
+		})
+	}
+}
+
+func TestHandshakeTracker(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tracker := HandshakeTracker{}
+	first := time.Unix(1700000000, 0)
+
+	stats := tracker.Update(first)
+	assert.Equal(t, first, stats.LastHandshake)
+	assert.Equal(t, uint32(0), stats.Rekeys)
+
+	stats = tracker.Update(first)
+	assert.Equal(t, uint32(0), stats.Rekeys)
+
+	second := first.Add(2 * time.Minute)
+	stats = tracker.Update(second)
+	assert.Equal(t, second, stats.LastHandshake)
+	assert.Equal(t, uint32(1), stats.Rekeys)
+
+	tracker.Reset()
+	stats = tracker.Update(second)
+	assert.Equal(t, uint32(0), stats.Rekeys)
+}
+
+func TestHandshakeTracker_Track(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tracker := HandshakeTracker{interval: time.Millisecond}
+	first := time.Unix(1700000000, 0)
+	handshakes := make(chan time.Time)
+	tracker.Track(func() (time.Time, error) {
+		return <-handshakes, nil
+	})
+
+	// rekeys are counted between the status requests
+	handshakes <- first
+	handshakes <- first.Add(2 * time.Minute)
+	handshakes <- first.Add(4 * time.Minute)
+	// the tracker is done with the previous value once it asks for the next one
+	handshakes <- first.Add(4 * time.Minute)
+
+	stats := tracker.Update(first.Add(4 * time.Minute))
+	assert.Equal(t, first.Add(4*time.Minute), stats.LastHandshake)
+	assert.Equal(t, uint32(2), stats.Rekeys)
+
+	tracker.Reset()
+	select {
+	case handshakes <- first.Add(6 * time.Minute):
+	case <-time.After(10 * time.Millisecond):
+	}
+	stats = tracker.Update(first.Add(8 * time.Minute))
+	assert.Equal(t, uint32(0), stats.Rekeys)
+}
+
+func TestWaitForHandshake(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	t.Run("handshake is made", func(t *testing.T) {
+		calls := 0
+		err := waitForHandshake(context.Background(), time.Minute, func() (time.Time, error) {
+			calls++
+			if calls < 2 {
+				return time.Time{}, errNoHandshake
+			}
+			return time.Now(), nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		err := waitForHandshake(context.Background(), 10*time.Millisecond, func() (time.Time, error) {
+			return time.Time{}, errNoHandshake
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("handshakes can't be retrieved", func(t *testing.T) {
+		err := waitForHandshake(context.Background(), 10*time.Millisecond, func() (time.Time, error) {
+			return time.Time{}, errors.New("wg is not installed")
+		})
+		assert.NoError(t, err)
+	})
+}
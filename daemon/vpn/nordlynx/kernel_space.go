@@ -2,6 +2,7 @@ package nordlynx
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
@@ -9,6 +10,7 @@ import (
 	"os/exec"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/events"
@@ -22,6 +24,7 @@ type KernelSpace struct {
 	fwmark          uint32
 	tun             *tunnel.Tunnel
 	eventsPublisher *vpn.Events
+	handshakes      HandshakeTracker
 	sync.Mutex
 }
 
@@ -34,7 +37,7 @@ func NewKernelSpace(fwmark uint32, eventsPublisher *vpn.Events) *KernelSpace {
 }
 
 func (k *KernelSpace) Start(
-	_ context.Context,
+	ctx context.Context,
 	creds vpn.Credentials,
 	serverData vpn.ServerData,
 ) (err error) {
@@ -53,6 +56,10 @@ func (k *KernelSpace) Start(
 
 	k.eventsPublisher.Connected.Publish(event)
 	defer func() {
+		// without the module the attempt is continued by the userspace implementation
+		if errors.Is(err, errNoKernelModule) {
+			return
+		}
 		if err != nil {
 			k.eventsPublisher.Disconnected.Publish(events.DataDisconnect{})
 			return
@@ -66,19 +73,22 @@ func (k *KernelSpace) Start(
 		k.fwmark,
 		serverData.NordLynxPublicKey,
 		serverData.IP,
+		serverData.PersistentKeepaliveOr(DefaultPersistentKeepalive),
 	)
 
+	name := serverData.InterfaceNameOr(InterfaceName)
 	//check if wireguard is not up already
-	if _, err := exec.Command("ip", "link", "show", "dev", InterfaceName).Output(); err == nil {
+	// #nosec G204 -- interface name is validated when it is set
+	if _, err := exec.Command("ip", "link", "show", "dev", name).Output(); err == nil {
 		return vpn.ErrTunnelAlreadyExists
 	}
 
 	//add wireguard interface
-	if err := upWGInterface(InterfaceName); err != nil {
+	if err := upWGInterface(name); err != nil {
 		return fmt.Errorf("turning on nordlynx: %w", err)
 	}
 
-	iface, err := net.InterfaceByName(InterfaceName)
+	iface, err := net.InterfaceByName(name)
 	if err != nil {
 		if err := k.Stop(); err != nil {
 			log.Println(internal.DeferPrefix, err)
@@ -87,7 +97,7 @@ func (k *KernelSpace) Start(
 	}
 
 	interfaceIps := []netip.Addr{netip.MustParseAddr("10.5.0.2")}
-	ipv6, err := vpn.InterfaceIPv6(serverData.IP, interfaceID())
+	ipv6, err := InterfaceIPv6(serverData.IPv6)
 	if err == nil {
 		interfaceIps = append(interfaceIps, ipv6)
 	}
@@ -115,13 +125,27 @@ func (k *KernelSpace) Start(
 		return err
 	}
 
-	if err := SetMTU(tun.Interface()); err != nil {
+	if err := SetMTU(tun.Interface(), serverData.MTU); err != nil {
 		if err := k.stop(); err != nil {
 			log.Println(internal.WarningPrefix, err)
 		}
 		return fmt.Errorf("setting MTU for nordlynx interface: %w", err)
 	}
 
+	if serverData.HandshakeTimeout > 0 {
+		if err := waitForHandshake(ctx, serverData.HandshakeTimeout, func() (time.Time, error) {
+			return LatestHandshake(name, "")
+		}); err != nil {
+			if err := k.stop(); err != nil {
+				log.Println(internal.WarningPrefix, err)
+			}
+			return err
+		}
+	}
+
+	k.handshakes.Track(func() (time.Time, error) {
+		return LatestHandshake(name, "")
+	})
 	k.active = true
 	k.state = vpn.ConnectedState
 	return nil
@@ -169,6 +193,7 @@ func (k *KernelSpace) stop() error {
 	k.active = false
 	k.tun = nil
 	k.state = vpn.ExitedState
+	k.handshakes.Reset()
 	return nil
 }
 
@@ -203,13 +228,14 @@ Fwmark = %#x
 PublicKey = %s
 AllowedIPs = 0.0.0.0/0,::/0
 Endpoint = %s
-PersistentKeepalive = 25`
+PersistentKeepalive = %d`
 
 func wgQuickConfig(
 	privateKey string,
 	fwmark uint32,
 	publicKey string,
 	serverIP netip.Addr,
+	keepalive uint16,
 ) string {
 	return fmt.Sprintf(
 		wgQuickTemplate,
@@ -220,5 +246,22 @@ func wgQuickConfig(
 			serverIP.String(),
 			strconv.Itoa(defaultPort),
 		),
+		keepalive,
 	)
 }
+
+// SessionStats returns handshake statistics of the active tunnel
+func (k *KernelSpace) SessionStats() (vpn.SessionStats, error) {
+	k.Lock()
+	active, tun := k.active, k.tun
+	k.Unlock()
+	if !active || tun == nil {
+		return vpn.SessionStats{}, errors.New("nordlynx is not active")
+	}
+
+	latest, err := LatestHandshake(tun.Interface().Name, "")
+	if err != nil {
+		return vpn.SessionStats{}, err
+	}
+	return k.handshakes.Update(latest), nil
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordlynx"
 	"github.com/NordSecurity/nordvpn-linux/events"
 	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/meshnet"
 	"github.com/NordSecurity/nordvpn-linux/tunnel"
 	"github.com/google/uuid"
 )
@@ -30,6 +31,8 @@ const (
 	// TelioLocalConfigName defines env key for local config value
 	TelioLocalConfigName     = "TELIO_LOCAL_CFG"
 	defaultHeartbeatInterval = 60 * 60
+	// defaultReconnectTimeout limits the reconnect to the VPN server if the handshake timeout is not set
+	defaultReconnectTimeout = 30 * time.Second
 )
 
 type state struct {
@@ -116,10 +119,12 @@ type Libtelio struct {
 	currentServer     vpn.ServerData
 	currentPrivateKey string
 	isMeshEnabled     bool
+	peerRoutesKey     string
 	meshnetConfig     teliogo.Config
 	isKernelDisabled  bool
 	fwmark            uint32
 	eventsPublisher   *vpn.Events
+	handshakes        nordlynx.HandshakeTracker
 	mu                sync.Mutex
 }
 
@@ -153,7 +158,9 @@ func (cb *telioLoggerCb) Log(logLevel teliogo.TelioLogLevel, payload string) *te
 	return nil
 }
 
-func New(prod bool, eventPath string, fwmark uint32,
+// New creates the libtelio instance, keepalive overrides the keepalive interval of the VPN peers if set because
+// libtelio takes it only when the instance is created
+func New(prod bool, eventPath string, fwmark uint32, keepalive uint16,
 	vpnLibCfg vpn.LibConfigGetter, appVersion string, eventsPublisher *vpn.Events,
 ) (*Libtelio, error) {
 	events := make(chan state)
@@ -172,6 +179,11 @@ func New(prod bool, eventPath string, fwmark uint32,
 		features = &defaultTelioConfig
 	}
 
+	if keepalive != 0 {
+		interval := uint32(keepalive)
+		features.Wireguard.PersistentKeepalive.Vpn = &interval
+	}
+
 	featuresString, err := json.Marshal(features)
 	if err != nil {
 		log.Println(internal.WarningPrefix, "failed to encode telio config:", err)
@@ -228,9 +240,16 @@ func (l *Libtelio) Start(
 
 	log.Println(internal.InfoPrefix, "libtelio version:", teliogo.GetVersionTag())
 
-	if err = l.openTunnel(defaultIP, creds.NordLynxPrivateKey); err != nil {
+	name := serverData.InterfaceNameOr(nordlynx.InterfaceName)
+	if err = l.openTunnel(defaultIP, creds.NordLynxPrivateKey, name); err != nil {
 		return fmt.Errorf("opening the tunnel: %w", err)
 	}
+	// meshnet may have opened the tunnel with the calculated MTU already
+	if serverData.MTU != 0 {
+		if err = nordlynx.SetMTU(l.tun.Interface(), serverData.MTU); err != nil {
+			return fmt.Errorf("setting mtu for the interface: %w", err)
+		}
+	}
 
 	l.currentServer = serverData
 	if err = l.connect(ctx, serverData.IP, serverData.NordLynxPublicKey, serverData.PostQuantum); err != nil {
@@ -258,6 +277,13 @@ func (l *Libtelio) connect(
 	var err error
 	endpoint := net.JoinHostPort(serverIP.String(), "51820")
 	allowedIPs := []string{"0.0.0.0/0"}
+	if err = l.setTunnelIPv6(l.currentServer.IPv6); err != nil {
+		cancel()
+		return fmt.Errorf("setting tunnel IPv6 address: %w", err)
+	}
+	if l.currentServer.IPv6.IsValid() {
+		allowedIPs = append(allowedIPs, "::/0")
+	}
 	if postQuantum {
 		identifier := uuid.NewString()
 		err = l.lib.ConnectToExitNodePostquantum(
@@ -285,15 +311,32 @@ func (l *Libtelio) connect(
 		return fmt.Errorf("libtelio connect: %w", err)
 	}
 
+	// handshake timeout limits only the wait, the context keeps the connection monitor running afterwards
+	var handshakeTimeout <-chan time.Time
+	if l.currentServer.HandshakeTimeout > 0 {
+		timer := time.NewTimer(l.currentServer.HandshakeTimeout)
+		defer timer.Stop()
+		handshakeTimeout = timer.C
+	}
+
 	// Check if the connection actually happened. Disconnect if no actual connection was
 	// created within the timeout or until it was canceled.
 	select {
 	case <-ctx.Done():
 		l.disconnect()
 		return ctx.Err()
+	case <-handshakeTimeout:
+		l.disconnect()
+		return fmt.Errorf("no handshake with the server within %s", l.currentServer.HandshakeTimeout)
 	case <-isConnectedC: // isConnectedC will be closed once connection is established
 	}
 
+	if l.tun != nil {
+		iface, serverPublicKey := l.tun.Interface().Name, serverData.NordLynxPublicKey
+		l.handshakes.Track(func() (time.Time, error) {
+			return nordlynx.LatestHandshake(iface, serverPublicKey)
+		})
+	}
 	l.active = true
 	l.state = vpn.ConnectedState
 	return nil
@@ -327,8 +370,14 @@ func (l *Libtelio) disconnect() error {
 	if err := l.lib.DisconnectFromExitNodes(); err != nil {
 		return fmt.Errorf("stopping libtelio: %w", err)
 	}
+	// tunnel might be kept for meshnet, which does not use IPv6
+	if err := l.setTunnelIPv6(netip.Addr{}); err != nil {
+		log.Println(internal.WarningPrefix, "removing tunnel IPv6 address:", err)
+	}
 	l.active = false
 	l.state = vpn.ExitedState
+	l.peerRoutesKey = ""
+	l.handshakes.Reset()
 	return nil
 }
 
@@ -344,6 +393,23 @@ func (l *Libtelio) State() vpn.State {
 	return l.state
 }
 
+// SessionStats returns handshake statistics of the connection to the VPN server
+func (l *Libtelio) SessionStats() (vpn.SessionStats, error) {
+	l.mu.Lock()
+	active, tun := l.active, l.tun
+	serverPublicKey := l.currentServer.NordLynxPublicKey
+	l.mu.Unlock()
+	if !active || tun == nil {
+		return vpn.SessionStats{}, fmt.Errorf("not connected to the VPN server")
+	}
+
+	latest, err := nordlynx.LatestHandshake(tun.Interface().Name, serverPublicKey)
+	if err != nil {
+		return vpn.SessionStats{}, err
+	}
+	return l.handshakes.Update(latest), nil
+}
+
 func (l *Libtelio) Tun() tunnel.T {
 	l.mu.Lock()
 	defer l.mu.Unlock()
@@ -374,7 +440,7 @@ func (l *Libtelio) Enable(ip netip.Addr, privateKey string) (err error) {
 		}
 	}()
 
-	if err = l.openTunnel(ip, privateKey); err != nil {
+	if err = l.openTunnel(ip, privateKey, nordlynx.InterfaceName); err != nil {
 		return fmt.Errorf("opening the tunnel: %w", err)
 	}
 
@@ -391,7 +457,7 @@ func (l *Libtelio) Enable(ip netip.Addr, privateKey string) (err error) {
 		}
 
 		// Re-connect to the VPN server
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+		ctx, cancel := context.WithTimeout(context.Background(), l.currentServer.HandshakeTimeoutOr(defaultReconnectTimeout))
 		defer cancel()
 		if err = l.connect(
 			ctx,
@@ -425,6 +491,7 @@ func (l *Libtelio) disable() error {
 		return fmt.Errorf("disabling mesh: %w", err)
 	}
 	l.isMeshEnabled = false
+	l.peerRoutesKey = ""
 
 	if !l.active {
 		if err := l.closeTunnel(); err != nil {
@@ -435,6 +502,40 @@ func (l *Libtelio) disable() error {
 	return nil
 }
 
+// SetPeerRoutes makes the meshnet peer an exit node for the given subnets. Libtelio has a
+// single exit node, so this is possible only while the VPN connection uses another tunnel.
+func (l *Libtelio) SetPeerRoutes(publicKey string, subnets []netip.Prefix) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.isMeshEnabled {
+		return fmt.Errorf("meshnet is not enabled")
+	}
+	if l.active {
+		return meshnet.ErrExitNodeInUse
+	}
+
+	if l.peerRoutesKey != "" && (l.peerRoutesKey != publicKey || len(subnets) == 0) {
+		if err := l.lib.DisconnectFromExitNode(l.peerRoutesKey); err != nil {
+			return fmt.Errorf("disconnecting from the peer: %w", err)
+		}
+		l.peerRoutesKey = ""
+	}
+	if len(subnets) == 0 {
+		return nil
+	}
+
+	allowedIPs := make([]string, 0, len(subnets))
+	for _, subnet := range subnets {
+		allowedIPs = append(allowedIPs, subnet.String())
+	}
+	if err := l.lib.ConnectToExitNode(publicKey, &allowedIPs, nil); err != nil {
+		return fmt.Errorf("connecting to the peer: %w", err)
+	}
+	l.peerRoutesKey = publicKey
+	return nil
+}
+
 func (l *Libtelio) NetworkChanged() error {
 	l.mu.Lock()
 	defer l.mu.Unlock()
@@ -450,7 +551,7 @@ func (l *Libtelio) NetworkChanged() error {
 				return err
 			}
 
-			ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+			ctx, cancel := context.WithTimeout(context.Background(), l.currentServer.HandshakeTimeoutOr(defaultReconnectTimeout))
 			defer cancel()
 			if err := l.connect(
 				ctx,
@@ -516,6 +617,33 @@ func (l *Libtelio) StatusMap() (map[string]string, error) {
 	return m, nil
 }
 
+func (l *Libtelio) PathMap() (map[string]string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	statusMap := l.lib.GetStatusMap()
+	m := map[string]string{}
+	for _, node := range statusMap {
+		if node.State != teliogo.NodeStateConnected {
+			continue
+		}
+		m[node.PublicKey] = pathTypeToString(node.Path)
+	}
+
+	return m, nil
+}
+
+func pathTypeToString(path teliogo.PathType) string {
+	switch path {
+	case teliogo.PathTypeDirect:
+		return "direct"
+	case teliogo.PathTypeRelay:
+		return "relay"
+	default:
+		return "unknown"
+	}
+}
+
 func nodeStateToString(state teliogo.NodeState) string {
 	switch state {
 	case teliogo.NodeStateConnected:
@@ -530,16 +658,17 @@ func nodeStateToString(state teliogo.NodeState) string {
 	}
 }
 
-// openTunnel if not opened already
-func (l *Libtelio) openTunnel(ip netip.Addr, privateKey string) (err error) {
+// openTunnel with the given interface name if not opened already. VPN and meshnet share the tunnel, so the name
+// is taken from the one which opens it.
+func (l *Libtelio) openTunnel(ip netip.Addr, privateKey string, name string) (err error) {
 	if l.tun != nil {
 		return nil
 	}
 
 	// clean the network interface from the previous program run
-	if _, err := net.InterfaceByName(nordlynx.InterfaceName); err == nil {
+	if _, err := net.InterfaceByName(name); err == nil {
 		// #nosec G204 -- input is properly sanitized
-		if err := exec.Command("ip", "link", "del", nordlynx.InterfaceName).Run(); err != nil {
+		if err := exec.Command("ip", "link", "del", name).Run(); err != nil {
 			log.Println(internal.WarningPrefix, err)
 		}
 	}
@@ -549,12 +678,12 @@ func (l *Libtelio) openTunnel(ip netip.Addr, privateKey string) (err error) {
 		adapter = teliogo.TelioAdapterTypeBoringTun
 	}
 
-	if err := l.lib.StartNamed(privateKey, adapter, nordlynx.InterfaceName); err != nil {
+	if err := l.lib.StartNamed(privateKey, adapter, name); err != nil {
 		if l.isKernelDisabled {
 			return fmt.Errorf("starting libtelio: %w", err)
 		}
 		adapter = teliogo.TelioAdapterTypeBoringTun
-		if err := l.lib.StartNamed(privateKey, adapter, nordlynx.InterfaceName); err != nil {
+		if err := l.lib.StartNamed(privateKey, adapter, name); err != nil {
 			return fmt.Errorf("starting libtelio on retry with boring-tun: %w", err)
 		}
 		l.isKernelDisabled = true
@@ -570,7 +699,7 @@ func (l *Libtelio) openTunnel(ip netip.Addr, privateKey string) (err error) {
 		return fmt.Errorf("setting fwmark: %w", err)
 	}
 
-	iface, err := net.InterfaceByName(nordlynx.InterfaceName)
+	iface, err := net.InterfaceByName(name)
 	if err != nil {
 		return fmt.Errorf("retrieving the interface: %w", err)
 	}
@@ -587,7 +716,7 @@ func (l *Libtelio) openTunnel(ip netip.Addr, privateKey string) (err error) {
 		return fmt.Errorf("upping the interface: %w", err)
 	}
 
-	err = nordlynx.SetMTU(tun.Interface())
+	err = nordlynx.SetMTU(tun.Interface(), 0)
 	if err != nil {
 		return fmt.Errorf("setting mtu for the interface: %w", err)
 	}
@@ -625,6 +754,41 @@ func (l *Libtelio) updateTunnel(privateKey string, ip netip.Addr) error {
 }
 
 // Private key generation.
+// setTunnelIPv6 replaces the IPv6 address of the tunnel with the one made from the server IPv6 address.
+// IPv6 address is only removed if the server IPv6 address is not valid.
+func (l *Libtelio) setTunnelIPv6(serverIPv6 netip.Addr) error {
+	if l.tun == nil {
+		return nil
+	}
+	var ipv4, ipv6 []netip.Addr
+	for _, ip := range l.tun.IPs() {
+		if ip.Is6() {
+			ipv6 = append(ipv6, ip)
+		} else {
+			ipv4 = append(ipv4, ip)
+		}
+	}
+	if ipv6 != nil {
+		if err := tunnel.New(l.tun.Interface(), ipv6).DelAddrs(); err != nil {
+			return fmt.Errorf("deleting interface addrs: %w", err)
+		}
+		l.tun = tunnel.New(l.tun.Interface(), ipv4)
+	}
+	if !serverIPv6.IsValid() {
+		return nil
+	}
+
+	ip, err := nordlynx.InterfaceIPv6(serverIPv6)
+	if err != nil {
+		return err
+	}
+	if err := tunnel.New(l.tun.Interface(), []netip.Addr{ip}).AddAddrs(); err != nil {
+		return fmt.Errorf("adding interface addrs: %w", err)
+	}
+	l.tun = tunnel.New(l.tun.Interface(), append(ipv4, ip))
+	return nil
+}
+
 func (l *Libtelio) Private() string {
 	return teliogo.GenerateSecretKey()
 }
@@ -6,13 +6,13 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"net/netip"
 	"os/exec"
 	"strings"
-	"syscall"
 
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/internal"
-
-	"golang.org/x/sys/unix"
+	"github.com/NordSecurity/nordvpn-linux/tunnel"
 )
 
 const (
@@ -23,6 +23,9 @@ const (
 	wireguardHeaderSize = 80
 )
 
+// DefaultPersistentKeepalive is the interval of the keepalive packets in seconds
+const DefaultPersistentKeepalive = 25
+
 var (
 	errNoKernelModule            = errors.New("interface of type wireguard not supported")
 	errNoDefaultIpRoute          = errors.New("default gateway not found")
@@ -37,6 +40,11 @@ func interfaceID() [8]byte {
 	return [8]byte{0x0, 0x0, 0x0, 0x11, 0x0, 0x5, 0x0, 0x2}
 }
 
+// InterfaceIPv6 returns nordlynx client IPv6 address for the server
+func InterfaceIPv6(serverIP netip.Addr) (netip.Addr, error) {
+	return vpn.InterfaceIPv6(serverIP, interfaceID())
+}
+
 // getDefaultIpRouteInterface takes output of the `ip route show default` command and returns the
 // interface/device name. If there are multiple default routes in the output, first one will be returned
 func getDefaultIpRouteInterface(ipRouteOutput string) (string, error) {
@@ -56,23 +64,12 @@ func getDefaultIpRouteInterface(ipRouteOutput string) (string, error) {
 	return outputColumns[4], nil
 }
 
-// SetMTU for an interface.
-func SetMTU(iface net.Interface) error {
-	mtu := retrieveAndCalculateMTU()
-
-	fd, err := unix.Socket(syscall.AF_INET, syscall.SOCK_DGRAM, syscall.IPPROTO_IP)
-	if err != nil {
-		return err
-	}
-	defer unix.Close(fd)
-
-	req, err := unix.NewIfreq(iface.Name)
-	if err != nil {
-		return err
+// SetMTU for an interface. The MTU is calculated from the MTU of the default gateway if mtu is zero.
+func SetMTU(iface net.Interface, mtu uint32) error {
+	if mtu == 0 {
+		return tunnel.SetMTU(iface.Name, retrieveAndCalculateMTU())
 	}
-	req.SetUint32(uint32(mtu))
-
-	return unix.IoctlIfreq(fd, unix.SIOCSIFMTU, req)
+	return tunnel.SetMTU(iface.Name, int(mtu))
 }
 
 func retrieveAndCalculateMTU() int {
@@ -125,7 +122,7 @@ func upWGInterface(iface string) error {
 	// recently updated, but the system is yet to be rebooted.
 	// 2. wg command not found in path. (valid while we still rely on wg-tools)
 	if err != nil {
-		if internal.IsCommandAvailable("wg") {
+		if internal.IsCommandAvailable("wg") && !isNoKernelModuleError(err) {
 			return err
 		}
 		return errNoKernelModule
@@ -133,6 +130,12 @@ func upWGInterface(iface string) error {
 	return nil
 }
 
+// isNoKernelModuleError reports whether ip has failed to add the interface because the kernel does not support it
+func isNoKernelModuleError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "unknown device type") || strings.Contains(msg, "not supported")
+}
+
 func deleteInterface(iface net.Interface) error {
 	debug("ip", "link", "delete", iface.Name)
 	out, err := removeDevice(iface.Name)
@@ -145,9 +148,9 @@ func deleteInterface(iface net.Interface) error {
 // addDevice creates a new device with a given
 // name and specified device type.
 func addDevice(device string) error {
-	_, err := exec.Command("ip", "link", "add", device, "type", "wireguard").CombinedOutput()
+	out, err := exec.Command("ip", "link", "add", device, "type", "wireguard").CombinedOutput()
 	if err != nil {
-		return fmt.Errorf("failed to add device %w", err)
+		return fmt.Errorf("failed to add device %w: %s", err, strings.TrimSpace(string(out)))
 	}
 
 	return nil
@@ -2,10 +2,12 @@ package nordlynx
 
 import (
 	"net"
+	"net/netip"
 	"os/exec"
 	"strings"
 	"testing"
 
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/test/category"
 
 	"github.com/stretchr/testify/assert"
@@ -162,3 +164,20 @@ func TestRetrieveAndCalculateMTU(t *testing.T) {
 	mtu := retrieveAndCalculateMTU()
 	assert.Equal(t, defaultGateway.MTU-wireguardHeaderSize, mtu)
 }
+
+func TestWireGuardConfig_PersistentKeepalive(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	serverData := vpn.ServerData{IP: netip.MustParseAddr("1.2.3.4")}
+	key := "aGVsbG8gd29ybGQ="
+
+	conf := wgQuickConfig(key, 0xe1f1, key, serverData.IP,
+		serverData.PersistentKeepaliveOr(DefaultPersistentKeepalive))
+	assert.True(t, strings.HasSuffix(conf, "PersistentKeepalive = 25"))
+
+	serverData.PersistentKeepalive = 10
+	uapi, err := uapiConfig(key, 0xe1f1, key, serverData.IP,
+		serverData.PersistentKeepaliveOr(DefaultPersistentKeepalive))
+	assert.NoError(t, err)
+	assert.True(t, strings.HasSuffix(uapi, "persistent_keepalive_interval=10"))
+}
@@ -16,6 +16,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/internal"
@@ -28,11 +29,12 @@ import (
 )
 
 type UserSpace struct {
-	state  vpn.State
-	active bool
-	fwmark uint32
-	tun    *tunnel.Tunnel
-	conn   int32
+	state      vpn.State
+	active     bool
+	fwmark     uint32
+	tun        *tunnel.Tunnel
+	conn       int32
+	handshakes HandshakeTracker
 	sync.Mutex
 }
 
@@ -52,13 +54,14 @@ replace_allowed_ips=true
 allowed_ip=0.0.0.0/0
 allowed_ip=::/0
 endpoint=%s
-persistent_keepalive_interval=25`
+persistent_keepalive_interval=%d`
 
 func uapiConfig(
 	privateKey string,
 	fwmark uint32,
 	publicKey string,
 	serverIP netip.Addr,
+	keepalive uint16,
 ) (string, error) {
 	// UAPI requires keys as hex encoded raw bytes
 	rawPrivKey, err := base64.StdEncoding.DecodeString(privateKey)
@@ -77,11 +80,12 @@ func uapiConfig(
 			serverIP.String(),
 			strconv.Itoa(defaultPort),
 		),
+		keepalive,
 	), nil
 }
 
 func (u *UserSpace) Start(
-	_ context.Context,
+	ctx context.Context,
 	creds vpn.Credentials,
 	serverData vpn.ServerData,
 ) error {
@@ -96,23 +100,26 @@ func (u *UserSpace) Start(
 		u.fwmark,
 		serverData.NordLynxPublicKey,
 		serverData.IP,
+		serverData.PersistentKeepaliveOr(DefaultPersistentKeepalive),
 	)
 	if err != nil {
 		return fmt.Errorf("generating uapi config: %w", err)
 	}
 	log.Println("UAPI CONFIG:", conf)
 
+	name := serverData.InterfaceNameOr(InterfaceName)
 	// check if wireguard interface is not up already
-	if _, err := exec.Command("ip", "link", "show", "dev", InterfaceName).Output(); err == nil {
+	// #nosec G204 -- interface name is validated when it is set
+	if _, err := exec.Command("ip", "link", "show", "dev", name).Output(); err == nil {
 		return vpn.ErrTunnelAlreadyExists
 	}
 
-	conn, err := wgGoTurnOn(InterfaceName, conf)
+	conn, err := wgGoTurnOn(name, conf)
 	if err != nil {
 		return fmt.Errorf("turning on nordlynx: %w", err)
 	}
 
-	iface, err := net.InterfaceByName(InterfaceName)
+	iface, err := net.InterfaceByName(name)
 	if err != nil {
 		if err := u.stop(); err != nil {
 			log.Println(internal.DeferPrefix, err)
@@ -121,7 +128,7 @@ func (u *UserSpace) Start(
 	}
 
 	interfaceIps := []netip.Addr{netip.MustParseAddr("10.5.0.2")}
-	ipv6, err := vpn.InterfaceIPv6(serverData.IP, interfaceID())
+	ipv6, err := InterfaceIPv6(serverData.IPv6)
 	if err == nil {
 		interfaceIps = append(interfaceIps, ipv6)
 	}
@@ -144,13 +151,27 @@ func (u *UserSpace) Start(
 		return err
 	}
 
-	if err := SetMTU(tun.Interface()); err != nil {
+	if err := SetMTU(tun.Interface(), serverData.MTU); err != nil {
 		if err := u.stop(); err != nil {
 			log.Println(internal.DeferPrefix, err)
 		}
 		return fmt.Errorf("setting MTU for nordlynx interface: %w", err)
 	}
 
+	if serverData.HandshakeTimeout > 0 {
+		if err := waitForHandshake(ctx, serverData.HandshakeTimeout, func() (time.Time, error) {
+			return LatestHandshake(name, "")
+		}); err != nil {
+			if err := u.stop(); err != nil {
+				log.Println(internal.DeferPrefix, err)
+			}
+			return err
+		}
+	}
+
+	u.handshakes.Track(func() (time.Time, error) {
+		return LatestHandshake(name, "")
+	})
 	u.active = true
 	u.state = vpn.ConnectedState
 	return nil
@@ -178,6 +199,7 @@ func (u *UserSpace) stop() error {
 	u.active = false
 	u.tun = nil
 	u.state = vpn.ExitedState
+	u.handshakes.Reset()
 	return nil
 }
 
@@ -298,3 +320,23 @@ func wgGoTurnOff(tHandle int32) error {
 	delete(tHandles, tHandle)
 	return handle.Close()
 }
+
+func (u *UserSpace) NetworkChanged() error {
+	return fmt.Errorf("not supported")
+}
+
+// SessionStats returns handshake statistics of the active tunnel
+func (u *UserSpace) SessionStats() (vpn.SessionStats, error) {
+	u.Lock()
+	active, tun := u.active, u.tun
+	u.Unlock()
+	if !active || tun == nil {
+		return vpn.SessionStats{}, errors.New("nordlynx is not active")
+	}
+
+	latest, err := LatestHandshake(tun.Interface().Name, "")
+	if err != nil {
+		return vpn.SessionStats{}, err
+	}
+	return u.handshakes.Update(latest), nil
+}
@@ -0,0 +1,123 @@
+// Package nordwhisper provides the NordWhisper VPN technology. Its traffic looks like the regular web traffic, so it
+// reaches the servers on the networks which fingerprint and block both WireGuard and OpenVPN.
+package nordwhisper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/tunnel"
+)
+
+// InterfaceName is the name of the tun interface created for the NordWhisper tunnel
+const InterfaceName = "nordwhisper"
+
+// Engine runs the NordWhisper protocol. It is provided by the protocol library, so that the daemon only manages the
+// connection state.
+type Engine interface {
+	// Start creates the interface named serverData.InterfaceName with the addresses assigned and connects it to the
+	// server. Sockets of the tunnel have to be marked with fwmark, so that they are routed outside of the tunnel and
+	// allowed by the firewall.
+	Start(ctx context.Context, creds vpn.Credentials, serverData vpn.ServerData, fwmark uint32) (tunnel.T, error)
+	// Stop closes the connection and removes the interface
+	Stop() error
+}
+
+// NordWhisper implements vpn.VPN on top of the protocol engine
+type NordWhisper struct {
+	mu              sync.Mutex
+	engine          Engine
+	fwmark          uint32
+	eventsPublisher *vpn.Events
+	state           vpn.State
+	active          bool
+	tun             tunnel.T
+}
+
+func New(engine Engine, fwmark uint32, eventsPublisher *vpn.Events) *NordWhisper {
+	return &NordWhisper{
+		engine:          engine,
+		fwmark:          fwmark,
+		eventsPublisher: eventsPublisher,
+		state:           vpn.ExitedState,
+	}
+}
+
+func (n *NordWhisper) Start(ctx context.Context, creds vpn.Credentials, serverData vpn.ServerData) (err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.active {
+		return vpn.ErrVPNAIsAlreadyStarted
+	}
+
+	event := events.DataConnect{
+		EventStatus:         events.StatusAttempt,
+		TargetServerIP:      serverData.IP.String(),
+		TargetServerCountry: serverData.Country,
+		TargetServerCity:    serverData.City,
+	}
+	n.eventsPublisher.Connected.Publish(event)
+	defer func() {
+		if err != nil {
+			n.eventsPublisher.Disconnected.Publish(events.DataDisconnect{})
+			return
+		}
+		event.EventStatus = events.StatusSuccess
+		n.eventsPublisher.Connected.Publish(event)
+	}()
+
+	n.state = vpn.ConnectingState
+	serverData.InterfaceName = serverData.InterfaceNameOr(InterfaceName)
+	tun, err := n.engine.Start(ctx, creds, serverData, n.fwmark)
+	if err != nil {
+		n.state = vpn.ExitedState
+		return fmt.Errorf("starting nordwhisper: %w", err)
+	}
+
+	n.tun = tun
+	n.active = true
+	n.state = vpn.ConnectedState
+	return nil
+}
+
+func (n *NordWhisper) Stop() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.eventsPublisher.Disconnected.Publish(events.DataDisconnect{})
+	if !n.active {
+		return nil
+	}
+	if err := n.engine.Stop(); err != nil {
+		return fmt.Errorf("stopping nordwhisper: %w", err)
+	}
+	n.active = false
+	n.tun = nil
+	n.state = vpn.ExitedState
+	return nil
+}
+
+func (n *NordWhisper) State() vpn.State {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.state
+}
+
+func (n *NordWhisper) IsActive() bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.active
+}
+
+func (n *NordWhisper) Tun() tunnel.T {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.tun
+}
+
+func (n *NordWhisper) NetworkChanged() error {
+	return errors.New("not supported")
+}
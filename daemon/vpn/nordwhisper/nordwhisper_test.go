@@ -0,0 +1,113 @@
+package nordwhisper
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
+	"github.com/NordSecurity/nordvpn-linux/events"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/tunnel"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeEngine struct {
+	startErr error
+	fwmark   uint32
+	iface    string
+	started  bool
+}
+
+func (f *fakeEngine) Start(_ context.Context, _ vpn.Credentials, serverData vpn.ServerData, fwmark uint32) (tunnel.T, error) {
+	if f.startErr != nil {
+		return nil, f.startErr
+	}
+	f.fwmark = fwmark
+	f.iface = serverData.InterfaceName
+	f.started = true
+	return &tunnel.Tunnel{}, nil
+}
+
+func (f *fakeEngine) Stop() error {
+	f.started = false
+	return nil
+}
+
+type eventsRecorder struct {
+	connected    []events.DataConnect
+	disconnected int
+}
+
+func (r *eventsRecorder) NotifyConnect(e events.DataConnect) error {
+	r.connected = append(r.connected, e)
+	return nil
+}
+
+func (r *eventsRecorder) NotifyDisconnect(events.DataDisconnect) error {
+	r.disconnected++
+	return nil
+}
+
+func newTestNordWhisper(engine Engine) (*NordWhisper, *eventsRecorder) {
+	publisher := vpn.NewInternalVPNEvents()
+	recorder := &eventsRecorder{}
+	publisher.Subscribe(recorder)
+	return New(engine, 0xe1f1, publisher), recorder
+}
+
+func TestNordWhisper_StartStop(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	engine := &fakeEngine{}
+	n, recorder := newTestNordWhisper(engine)
+
+	err := n.Start(context.Background(), vpn.Credentials{}, vpn.ServerData{IP: netip.MustParseAddr("1.1.1.1")})
+	assert.NoError(t, err)
+	assert.True(t, n.IsActive())
+	assert.Equal(t, vpn.ConnectedState, n.State())
+	assert.NotNil(t, n.Tun())
+	assert.Equal(t, uint32(0xe1f1), engine.fwmark)
+	assert.Equal(t, InterfaceName, engine.iface)
+	assert.Len(t, recorder.connected, 2)
+	assert.Equal(t, events.StatusSuccess, recorder.connected[1].EventStatus)
+
+	err = n.Start(context.Background(), vpn.Credentials{}, vpn.ServerData{})
+	assert.ErrorIs(t, err, vpn.ErrVPNAIsAlreadyStarted)
+
+	assert.NoError(t, n.Stop())
+	assert.False(t, n.IsActive())
+	assert.False(t, engine.started)
+	assert.Equal(t, vpn.ExitedState, n.State())
+	assert.Equal(t, 1, recorder.disconnected)
+}
+
+func TestNordWhisper_StartFails(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	errEngine := errors.New("blocked")
+	n, recorder := newTestNordWhisper(&fakeEngine{startErr: errEngine})
+
+	err := n.Start(context.Background(), vpn.Credentials{}, vpn.ServerData{IP: netip.MustParseAddr("1.1.1.1")})
+	assert.ErrorIs(t, err, errEngine)
+	assert.False(t, n.IsActive())
+	assert.Equal(t, vpn.ExitedState, n.State())
+	assert.Len(t, recorder.connected, 1)
+	assert.Equal(t, 1, recorder.disconnected)
+}
+
+func TestNordWhisper_StartWithInterfaceName(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	engine := &fakeEngine{}
+	n, _ := newTestNordWhisper(engine)
+
+	err := n.Start(context.Background(), vpn.Credentials{}, vpn.ServerData{
+		IP:            netip.MustParseAddr("1.1.1.1"),
+		InterfaceName: "vpn0",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "vpn0", engine.iface)
+}
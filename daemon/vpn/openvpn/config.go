@@ -7,10 +7,13 @@ import (
 	"net/netip"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 	"text/template"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 
 	"github.com/jbowtie/gokogiri/xml"
@@ -54,20 +57,20 @@ type ovpnConfigData struct {
 
 // setOpenVPNConfig is used to pass generated config to the OpenVPN process.
 // Config has to be passed everytime when new OpenVPN process is started.
-func setOpenVPNConfig(protocol config.Protocol, serverIP netip.Addr, obfuscated bool, serverVersion string) error {
-	if serverVersion == "" {
+func setOpenVPNConfig(serverData vpn.ServerData) error {
+	if serverData.OpenVPNVersion == "" {
 		return ErrServerVersion
 	}
-	return generateConfigFile(protocol, serverIP, obfuscated)
+	return generateConfigFile(serverData)
 }
 
-func generateConfigFile(protocol config.Protocol, serverIP netip.Addr, obfuscated bool) error {
+func generateConfigFile(serverData vpn.ServerData) error {
 	templatePath := internal.OvpnTemplatePath
-	if obfuscated {
+	if serverData.Obfuscated {
 		templatePath = internal.OvpnObfsTemplatePath
 	}
 
-	identifier, err := getConfigIdentifier(protocol, obfuscated)
+	identifier, err := getConfigIdentifier(serverData.Protocol, serverData.Obfuscated)
 	if err != nil {
 		return fmt.Errorf("getting config identifier: %w", err)
 	}
@@ -77,12 +80,13 @@ func generateConfigFile(protocol config.Protocol, serverIP netip.Addr, obfuscate
 		return fmt.Errorf("reading ovpn template file")
 	}
 
-	out, err := generateConfig(serverIP, identifier, template)
+	out, err := generateConfig(serverData.IP, identifier, template)
 	if err != nil {
 		return fmt.Errorf("generating OpenVPN config: %w", err)
 	}
 
-	if err := addExtraParameters(out, serverIP, protocol); err != nil {
+	out, err = addExtraParameters(out, serverData)
+	if err != nil {
 		return fmt.Errorf("adding extra parameters to OpenVPN config: %w", err)
 	}
 
@@ -174,18 +178,53 @@ func getConfigIdentifier(protocol config.Protocol, obfuscated bool) (openvpnID,
 	}
 }
 
-func addExtraParameters(data []byte, serverIP netip.Addr, protocol config.Protocol) error {
+// addExtraParameters returns the config with the parameters overriding the ones of the template. IPv6 address
+// pushed by the server is accepted only if IPv6 traffic is routed through the tunnel, while IPv6 routes are
+// always ignored as the default route is added by the daemon.
+func addExtraParameters(data []byte, serverData vpn.ServerData) ([]byte, error) {
 	args := strings.Split(string(data), "\n")
-	if !serverIP.Is6() {
+	if !serverData.IPv6.IsValid() {
 		args = addOrReplaceArgument(args, "pull-filter ignore \"ifconfig-ipv6\"", "pull-filter ignore \"ifconfig-ipv6\".*$")
 	}
+	if serverData.MTU != 0 {
+		args = addOrReplaceArgument(args, "tun-mtu "+strconv.Itoa(int(serverData.MTU)), "^tun-mtu( .*)?$")
+	}
+	// obfuscated servers listen on their own ports
+	if serverData.OpenVPNPort != 0 && !serverData.Obfuscated {
+		args = replaceRemotePort(args, serverData.OpenVPNPort)
+	}
+	if serverData.OpenVPNProxy != "" {
+		proxy, err := config.ParseStealthProxy(serverData.OpenVPNProxy)
+		if err != nil {
+			return nil, err
+		}
+		args = addOrReplaceArgument(args, proxyArgument(proxy), "^(http|socks)-proxy .*$")
+	}
+	if serverData.ConnectTimeout > 0 {
+		args = addOrReplaceArgument(args, timeoutArgument("server-poll-timeout", serverData.ConnectTimeout),
+			"^server-poll-timeout .*$")
+	}
+	if serverData.HandshakeTimeout > 0 {
+		args = addOrReplaceArgument(args, timeoutArgument("hand-window", serverData.HandshakeTimeout),
+			"^hand-window .*$")
+	}
+	// directives of the user replace the same directives of the template, the config file can be edited by hand,
+	// so they are validated once more
+	if err := config.ValidateOpenVPNDirectives(serverData.OpenVPNDirectives); err != nil {
+		return nil, err
+	}
+	for _, directive := range serverData.OpenVPNDirectives {
+		directive = config.NormalizeOpenVPNDirective(directive)
+		name := strings.Fields(directive)[0]
+		args = addOrReplaceArgument(args, directive, "^"+regexp.QuoteMeta(name)+"( .*)?$")
+	}
 	args = addOrReplaceArgument(args, "pull-filter ignore \"route-ipv6\"", "pull-filter ignore \"route-ipv6\".*$")
 	args = addOrReplaceArgument(args, "ping 15", "ping .*$")
 	args = addOrReplaceArgument(args, "ping-restart 0", "ping-restart .*$")
 	args = addOrReplaceArgument(args, "ping-timer-rem", "ping-timer-rem$")
 	// override openvpn proto (obfuscated sets multiple remotes)
-	if serverIP.Is6() {
-		switch protocol {
+	if serverData.IP.Is6() {
+		switch serverData.Protocol {
 		case config.Protocol_UDP:
 			args = addOrReplaceArgument(args, "proto udp6", "proto udp6$")
 		case config.Protocol_TCP:
@@ -193,11 +232,33 @@ func addExtraParameters(data []byte, serverIP netip.Addr, protocol config.Protoc
 		case config.Protocol_UNKNOWN_PROTOCOL:
 			fallthrough
 		default:
-			return errors.New("unknown protocol")
+			return nil, errors.New("unknown protocol")
 		}
 	}
-	data = []byte(strings.Join(args, "\n"))
-	return nil
+	return []byte(strings.Join(args, "\n")), nil
+}
+
+// replaceRemotePort changes the port of the remote arguments, e.g. "remote 1.1.1.1 1194 udp"
+func replaceRemotePort(args []string, port uint16) []string {
+	reg := regexp.MustCompile(`^(remote\s+\S+)\s+\d+(.*)$`)
+	for idx, arg := range args {
+		args[idx] = reg.ReplaceAllString(arg, "${1} "+strconv.Itoa(int(port))+"${2}")
+	}
+	return args
+}
+
+// proxyArgument returns the OpenVPN option connecting to the server through the proxy, it works only over TCP
+func proxyArgument(proxy config.StealthProxy) string {
+	port := strconv.Itoa(int(proxy.Port))
+	if proxy.Type == config.StealthProxySOCKS5 {
+		return "socks-proxy " + proxy.Host + " " + port
+	}
+	return "http-proxy " + proxy.Host + " " + port
+}
+
+// timeoutArgument returns the OpenVPN option with the timeout in whole seconds
+func timeoutArgument(option string, timeout time.Duration) string {
+	return option + " " + strconv.Itoa(int(timeout/time.Second))
 }
 
 func addOrReplaceArgument(args []string, newArg string, regex string) []string {
@@ -2,9 +2,12 @@ package openvpn
 
 import (
 	"net/netip"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/vpn"
 	"github.com/NordSecurity/nordvpn-linux/test/category"
 
 	"github.com/stretchr/testify/assert"
@@ -82,6 +85,154 @@ func TestGenerateConfigXML(t *testing.T) {
 	}
 }
 
+func TestAddExtraParameters(t *testing.T) {
+	category.Set(t, category.Unit)
+	const ignoreIfconfigIPv6 = `pull-filter ignore "ifconfig-ipv6"`
+	const ignoreRouteIPv6 = `pull-filter ignore "route-ipv6"`
+	const template = "client\nremote 1.1.1.1 1194 udp\nping 10\nverb 3"
+	tests := []struct {
+		name       string
+		serverData vpn.ServerData
+		expected   []string
+		unexpected []string
+	}{
+		{
+			name:       "IPv4 only",
+			serverData: vpn.ServerData{IP: netip.MustParseAddr("1.1.1.1"), Protocol: config.Protocol_UDP},
+			expected: []string{
+				ignoreIfconfigIPv6, ignoreRouteIPv6, "ping 15", "ping-restart 0", "remote 1.1.1.1 1194 udp",
+			},
+			unexpected: []string{"proto udp6"},
+		},
+		{
+			name: "IPv6 in the tunnel",
+			serverData: vpn.ServerData{
+				IP:       netip.MustParseAddr("1.1.1.1"),
+				IPv6:     netip.MustParseAddr("2a02:5740:1:9::11"),
+				Protocol: config.Protocol_UDP,
+			},
+			expected:   []string{ignoreRouteIPv6},
+			unexpected: []string{ignoreIfconfigIPv6},
+		},
+		{
+			name: "IPv6 server",
+			serverData: vpn.ServerData{
+				IP:       netip.MustParseAddr("2a02:5740:1:9::11"),
+				IPv6:     netip.MustParseAddr("2a02:5740:1:9::11"),
+				Protocol: config.Protocol_TCP,
+			},
+			expected:   []string{ignoreRouteIPv6, "proto tcp6"},
+			unexpected: []string{ignoreIfconfigIPv6},
+		},
+		{
+			name: "custom port",
+			serverData: vpn.ServerData{
+				IP:          netip.MustParseAddr("1.1.1.1"),
+				Protocol:    config.Protocol_UDP,
+				OpenVPNPort: 53,
+			},
+			expected:   []string{"remote 1.1.1.1 53 udp"},
+			unexpected: []string{"remote 1.1.1.1 1194 udp"},
+		},
+		{
+			name: "custom port is ignored for obfuscated servers",
+			serverData: vpn.ServerData{
+				IP:          netip.MustParseAddr("1.1.1.1"),
+				Protocol:    config.Protocol_UDP,
+				Obfuscated:  true,
+				OpenVPNPort: 53,
+			},
+			expected: []string{"remote 1.1.1.1 1194 udp"},
+		},
+		{
+			name: "HTTP proxy",
+			serverData: vpn.ServerData{
+				IP:           netip.MustParseAddr("1.1.1.1"),
+				Protocol:     config.Protocol_TCP,
+				OpenVPNPort:  443,
+				OpenVPNProxy: "http://proxy.example.com:3128",
+			},
+			expected: []string{"http-proxy proxy.example.com 3128"},
+		},
+		{
+			name: "SOCKS proxy",
+			serverData: vpn.ServerData{
+				IP:           netip.MustParseAddr("1.1.1.1"),
+				Protocol:     config.Protocol_TCP,
+				OpenVPNProxy: "socks5://10.0.0.1:1080",
+			},
+			expected:   []string{"socks-proxy 10.0.0.1 1080"},
+			unexpected: []string{"http-proxy 10.0.0.1 1080"},
+		},
+		{
+			name: "timeouts",
+			serverData: vpn.ServerData{
+				IP:               netip.MustParseAddr("1.1.1.1"),
+				Protocol:         config.Protocol_UDP,
+				ConnectTimeout:   20 * time.Second,
+				HandshakeTimeout: 2 * time.Minute,
+			},
+			expected: []string{"server-poll-timeout 20", "hand-window 120"},
+		},
+		{
+			name: "MTU",
+			serverData: vpn.ServerData{
+				IP:       netip.MustParseAddr("1.1.1.1"),
+				Protocol: config.Protocol_UDP,
+				MTU:      1380,
+			},
+			expected: []string{"tun-mtu 1380"},
+		},
+		{
+			name: "MTU directive of the user wins",
+			serverData: vpn.ServerData{
+				IP:                netip.MustParseAddr("1.1.1.1"),
+				Protocol:          config.Protocol_UDP,
+				MTU:               1380,
+				OpenVPNDirectives: []string{"tun-mtu 1400"},
+			},
+			expected:   []string{"tun-mtu 1400"},
+			unexpected: []string{"tun-mtu 1380"},
+		},
+		{
+			name: "user directives",
+			serverData: vpn.ServerData{
+				IP:                netip.MustParseAddr("1.1.1.1"),
+				Protocol:          config.Protocol_UDP,
+				OpenVPNDirectives: []string{"sndbuf 524288", "--verb 4"},
+			},
+			expected:   []string{"sndbuf 524288", "verb 4"},
+			unexpected: []string{"verb 3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := addExtraParameters([]byte(template), tt.serverData)
+			assert.NoError(t, err)
+			args := strings.Split(string(out), "\n")
+			assert.NotContains(t, args, "ping 10")
+			for _, arg := range tt.expected {
+				assert.Contains(t, args, arg)
+			}
+			for _, arg := range tt.unexpected {
+				assert.NotContains(t, args, arg)
+			}
+		})
+	}
+}
+
+func TestAddExtraParameters_RejectsDirective(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	_, err := addExtraParameters([]byte("client"), vpn.ServerData{
+		IP:                netip.MustParseAddr("1.1.1.1"),
+		Protocol:          config.Protocol_UDP,
+		OpenVPNDirectives: []string{"up /tmp/script.sh"},
+	})
+	assert.ErrorIs(t, err, config.ErrOpenVPNDirective)
+}
+
 func TestGenerateConfig(t *testing.T) {
 	category.Set(t, category.Unit)
 	tests := []struct {
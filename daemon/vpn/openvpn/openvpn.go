@@ -91,12 +91,7 @@ func (ovpn *OpenVPN) Start(
 		return errors.New("server credentials not provided")
 	}
 
-	err := setOpenVPNConfig(
-		serverData.Protocol,
-		serverData.IP,
-		serverData.Obfuscated,
-		serverData.OpenVPNVersion,
-	)
+	err := setOpenVPNConfig(serverData)
 	if err != nil {
 		ovpn.Unlock()
 		return fmt.Errorf("setting openvpn server to connect to: %w", err)
@@ -126,7 +121,7 @@ func (ovpn *OpenVPN) Start(
 		"--verify-x509-name", fmt.Sprintf("CN=%s", serverData.Hostname), // certificate validation
 		"--mark", strconv.Itoa(int(ovpn.fwmark)),
 		"--dev-type", interfaceType,
-		"--dev", InterfaceName,
+		"--dev", serverData.InterfaceNameOr(InterfaceName),
 	)
 	ovpn.Unlock()
 
@@ -303,9 +298,34 @@ func (ovpn *OpenVPN) startOpenVPN() error {
 func (ovpn *OpenVPN) setTun(tun tunnel.Tunnel) {
 	ovpn.Lock()
 	defer ovpn.Unlock()
+	if ovpn.serverData.IPv6.IsValid() {
+		tun = withIPv6(tun)
+	}
 	ovpn.tun = &tun
 }
 
+// withIPv6 adds the IPv6 address pushed by the server to the tunnel, as only the IPv4 address is
+// reported by the management interface
+func withIPv6(tun tunnel.Tunnel) tunnel.Tunnel {
+	iface := tun.Interface()
+	addrs, err := iface.Addrs()
+	if err != nil {
+		log.Println(internal.WarningPrefix, "retrieving tunnel addresses:", err)
+		return tun
+	}
+	ips := tun.IPs()
+	for _, addr := range addrs {
+		prefix, err := netip.ParsePrefix(addr.String())
+		if err != nil {
+			continue
+		}
+		if ip := prefix.Addr(); ip.Is6() && ip.IsGlobalUnicast() {
+			ips = append(ips, ip)
+		}
+	}
+	return *tunnel.New(iface, ips)
+}
+
 func (ovpn *OpenVPN) Tun() tunnel.T {
 	ovpn.Lock()
 	defer ovpn.Unlock()
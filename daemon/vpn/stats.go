@@ -0,0 +1,22 @@
+package vpn
+
+import "time"
+
+// SessionStats describe the health of the established tunnel session
+type SessionStats struct {
+	// LastHandshake is the time of the latest successful handshake with the server
+	LastHandshake time.Time
+	// Rekeys is the count of handshakes renewed since the session was established
+	Rekeys uint32
+}
+
+// SessionStatsGetter is implemented by VPN technologies which are able to report tunnel session statistics
+type SessionStatsGetter interface {
+	SessionStats() (SessionStats, error)
+}
+
+// UserSpaceReporter is implemented by VPN technologies which may run the tunnel in userspace instead of the kernel
+type UserSpaceReporter interface {
+	// IsUserSpace reports whether the active tunnel runs in userspace
+	IsUserSpace() bool
+}
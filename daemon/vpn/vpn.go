@@ -4,6 +4,7 @@ package vpn
 import (
 	"context"
 	"net/netip"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/tunnel"
@@ -46,4 +47,49 @@ type ServerData struct {
 	OpenVPNVersion    string
 	VirtualLocation   bool
 	PostQuantum       bool
+	// IPv6 of the server which the tunnel IPv6 address is made from. Not valid if IPv6 is not used in the tunnel.
+	IPv6 netip.Addr
+	// MTU of the tunnel, the MTU calculated by the technology is used if zero
+	MTU uint32
+	// OpenVPNPort overrides the port of the OpenVPN server if set
+	OpenVPNPort uint16
+	// OpenVPNProxy is the URL of the proxy OpenVPN connects through if set, see config.ParseStealthProxy
+	OpenVPNProxy string
+	// OpenVPNDirectives are appended to the OpenVPN config, see config.ValidateOpenVPNDirectives
+	OpenVPNDirectives []string
+	// Technology the tunnel is created with
+	Technology config.Technology
+	// InterfaceName of the tunnel, the default name of the technology is used if empty
+	InterfaceName string
+	// PersistentKeepalive interval of the tunnel in seconds, the default interval of the technology is used if zero
+	PersistentKeepalive uint16
+	// ConnectTimeout limits the wait for the server to respond, the default of the technology is used if zero
+	ConnectTimeout time.Duration
+	// HandshakeTimeout limits the wait for the handshake with the server, the default of the technology is used if
+	// zero
+	HandshakeTimeout time.Duration
+}
+
+// InterfaceNameOr provides defaultName in case the interface name is not set
+func (s ServerData) InterfaceNameOr(defaultName string) string {
+	if s.InterfaceName == "" {
+		return defaultName
+	}
+	return s.InterfaceName
+}
+
+// PersistentKeepaliveOr provides defaultInterval in case the keepalive interval is not set
+func (s ServerData) PersistentKeepaliveOr(defaultInterval uint16) uint16 {
+	if s.PersistentKeepalive == 0 {
+		return defaultInterval
+	}
+	return s.PersistentKeepalive
+}
+
+// HandshakeTimeoutOr provides defaultTimeout in case the handshake timeout is not set
+func (s ServerData) HandshakeTimeoutOr(defaultTimeout time.Duration) time.Duration {
+	if s.HandshakeTimeout == 0 {
+		return defaultTimeout
+	}
+	return s.HandshakeTimeout
 }
@@ -392,6 +392,9 @@ func (s *Subscriber) NotifyTechnology(data config.Technology) error {
 		technology = moose.NordvpnappVpnConnectionTechnologyOpenvpn
 	case config.Technology_UNKNOWN_TECHNOLOGY:
 		return errors.New("unknown technology")
+	case config.Technology_NORDWHISPER:
+		// not reported separately yet
+		fallthrough
 	default:
 		technology = moose.NordvpnappVpnConnectionTechnologyRecommended
 	}
@@ -454,6 +457,9 @@ func (s *Subscriber) NotifyConnect(data events.DataConnect) error {
 			technology = moose.NordvpnappVpnConnectionTechnologyNordlynx
 		case config.Technology_UNKNOWN_TECHNOLOGY:
 			technology = moose.NordvpnappVpnConnectionTechnologyNone
+		case config.Technology_NORDWHISPER:
+			// not reported separately yet
+			fallthrough
 		default:
 			technology = moose.NordvpnappVpnConnectionTechnologyRecommended
 		}
@@ -526,6 +532,9 @@ func (s *Subscriber) NotifyDisconnect(data events.DataDisconnect) error {
 			technology = moose.NordvpnappVpnConnectionTechnologyNordlynx
 		case config.Technology_UNKNOWN_TECHNOLOGY:
 			technology = moose.NordvpnappVpnConnectionTechnologyNone
+		case config.Technology_NORDWHISPER:
+			// not reported separately yet
+			fallthrough
 		default:
 			technology = moose.NordvpnappVpnConnectionTechnologyRecommended
 		}
@@ -56,6 +56,8 @@ type EventManager struct {
 	filesystem            Filesystem
 	notificationManager   *NotificationManager
 	defaultDownloadDir    string
+	// maximum number of files in a single transfer, incoming transfers with more files are rejected
+	transferFileLimit int
 }
 
 // NewEventManager loads transfer state from storage, or creates empty state if loading fails.
@@ -74,6 +76,7 @@ func NewEventManager(
 		osInfo:                osInfo,
 		filesystem:            filesystem,
 		defaultDownloadDir:    defaultDownloadDir,
+		transferFileLimit:     TransferFileLimit,
 	}
 }
 
@@ -93,6 +96,20 @@ func (em *EventManager) SetStorage(storage Storage) {
 	em.storage = storage
 }
 
+// SetTransferFileLimit sets the maximum number of files allowed in a single transfer.
+func (em *EventManager) SetTransferFileLimit(limit int) {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+	em.transferFileLimit = limit
+}
+
+// TransferFileLimit returns the maximum number of files allowed in a single transfer.
+func (em *EventManager) TransferFileLimit() int {
+	em.mutex.Lock()
+	defer em.mutex.Unlock()
+	return em.transferFileLimit
+}
+
 func (em *EventManager) EnableNotifications(fileshare Fileshare) error {
 	em.mutex.Lock()
 	defer em.mutex.Unlock()
@@ -173,6 +190,19 @@ func (em *EventManager) handleRequestReceivedEvent(event EventKindRequestReceive
 		}
 		return
 	}
+	if len(event.Files) > em.transferFileLimit {
+		// Reject transfer before it reaches the UI or the storage
+		tooManyFiles := ErrTooManyFiles{Limit: em.transferFileLimit}
+		log.Printf(internal.WarningPrefix+" rejecting transfer %s of %d files from %s: %s\n",
+			event.TransferId, len(event.Files), peer.Hostname, tooManyFiles)
+		if err := em.fileshare.Finalize(event.TransferId); err != nil {
+			log.Printf(internal.WarningPrefix+" failed to auto-reject transfer %s: %s\n", event.TransferId, err)
+		}
+		if em.notificationManager != nil {
+			em.notificationManager.NotifyTransferRejected(event.TransferId, peer.Hostname, tooManyFiles)
+		}
+		return
+	}
 	if !peer.AlwaysAcceptFiles {
 		if em.notificationManager != nil {
 			em.notificationManager.NotifyNewTransfer(event.TransferId, peer.Hostname)
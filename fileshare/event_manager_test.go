@@ -832,6 +832,64 @@ func TestTransferRequestNotification(t *testing.T) {
 	assert.Equal(t, expectedActions, transferRequestNotification.actions)
 }
 
+func TestTransferRequestTooManyFiles(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	transferID := exampleUUID
+
+	notifier := mockNotifier{
+		notifications: []mockNotification{},
+		nextID:        0,
+	}
+
+	notificationManager := NewMockNotificationManager(&mockEventManagerOsInfo{})
+	notificationManager.notifier = &notifier
+
+	fileshare := &mockEventManagerFileshare{}
+	eventManager := NewEventManager(false,
+		&mockMeshClient{},
+		&mockEventManagerOsInfo{},
+		&mockEventManagerFilesystem{},
+		"")
+	eventManager.notificationManager = &notificationManager
+	eventManager.SetFileshare(fileshare)
+	eventManager.SetTransferFileLimit(1)
+
+	peer := exampleIP1
+	hostname := "peer.nord"
+	eventManager.meshClient = &mockMeshClient{externalPeers: []*meshpb.Peer{
+		{
+			Ip:                peer,
+			Hostname:          hostname,
+			DoIAllowFileshare: true,
+			AlwaysAcceptFiles: true,
+		},
+	}}
+
+	eventManager.OnEvent(Event{
+		Kind: EventKindRequestReceived{
+			Peer:       peer,
+			TransferId: transferID,
+			Files: []ReceivedFile{
+				{Id: "testfile1"},
+				{Id: "testfile2"},
+			},
+		},
+	})
+
+	assert.Equal(t, []string{transferID}, fileshare.canceledTransferIDs,
+		"Transfer exceeding file limit was not rejected.")
+	assert.Empty(t, fileshare.acceptedTransferIDS)
+
+	assert.Equal(t, 1, len(notifier.notifications))
+	notification := notifier.getLastNotification()
+	assert.Equal(t, notifyTransferRejected, notification.summary)
+	expectedBody := fmt.Sprintf("%s\n%s",
+		fmt.Sprintf(transferTooManyFilesError, 1),
+		fmt.Sprintf(notifyNewTransferBody, transferID, hostname))
+	assert.Equal(t, expectedBody, notification.body)
+}
+
 func TestTransferRequestNotificationAccept(t *testing.T) {
 	peer := exampleIP1
 
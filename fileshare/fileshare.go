@@ -2,6 +2,7 @@
 package fileshare
 
 import (
+	"fmt"
 	"net/netip"
 	"time"
 
@@ -13,6 +14,25 @@ const (
 	TransferFileLimit = 1000
 )
 
+// ErrTooManyFiles is returned when the number of files in a transfer exceeds the limit. The files
+// are counted only until the limit is exceeded, so the total is not known.
+type ErrTooManyFiles struct {
+	Limit int
+}
+
+func (e ErrTooManyFiles) Error() string {
+	return fmt.Sprintf("transfer contains more than %d files, which is the limit", e.Limit)
+}
+
+// TransferFileLimitOr returns the maximum number of files in a single transfer set in the daemon
+// settings, or TransferFileLimit if it is not set
+func TransferFileLimitOr(limit uint32) int {
+	if limit == 0 {
+		return TransferFileLimit
+	}
+	return int(limit)
+}
+
 // Fileshare defines a set of operations that any type that wants to act as a fileshare service
 // must implement.
 type Fileshare interface {
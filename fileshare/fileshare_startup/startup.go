@@ -1,11 +1,13 @@
 package fileshare_startup
 
 import (
+	"context"
 	"log"
 	"net"
 
 	"google.golang.org/grpc"
 
+	daemonpb "github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/fileshare"
 	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
 	"github.com/NordSecurity/nordvpn-linux/internal"
@@ -32,11 +34,14 @@ func (f *FileshareHandle) GetShutdownChan() <-chan struct{} {
 func (f *FileshareHandle) Shutdown() {
 	f.eventManager.CancelLiveTransfers()
 
-	f.grpcServer.GracefulStop()
-
+	// disable before stopping the server so the socket stays reachable until the transfer state is saved,
+	// norduserd waits for that before exiting
 	if err := f.fileshareImplementation.Disable(); err != nil {
 		log.Println(internal.ErrorPrefix, "disabling fileshare:", err)
 	}
+
+	f.grpcServer.GracefulStop()
+
 	if err := f.grpcConn.Close(); err != nil {
 		log.Println(internal.ErrorPrefix, "closing grpc connection:", err)
 	}
@@ -62,6 +67,13 @@ func Startup(storagePath string,
 		transferHistoryChunkSize,
 		shutdownChan)
 
+	daemonClient := daemonpb.NewDaemonClient(grpcConn)
+	go fileshareServer.RetryPendingSends(fileshare.PendingSendRetryInterval, func() bool {
+		// queued sends are postponed on the metered network if the user enabled it in the settings
+		status, err := daemonClient.Status(context.Background(), &daemonpb.Empty{})
+		return err == nil && status.GetMeteredDeferral()
+	})
+
 	grpcServer := grpc.NewServer()
 	if grpcAuthenticator != nil {
 		grpcServer = grpc.NewServer(grpc.Creds(internal.NewUnixSocketCredentials(grpcAuthenticator)))
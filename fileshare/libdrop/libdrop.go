@@ -23,11 +23,12 @@ var ErrLAddressAlreadyInUse = errors.New("address already in use")
 // Fileshare is the main functional filesharing implementation using norddrop library.
 // Thread safe.
 type Fileshare struct {
-	norddrop     *norddrop.NordDrop
-	eventsDbPath string
-	storagePath  string
-	isProd       bool
-	mutex        sync.Mutex
+	norddrop          *norddrop.NordDrop
+	eventsDbPath      string
+	storagePath       string
+	isProd            bool
+	transferFileLimit int
+	mutex             sync.Mutex
 }
 
 func logLevelToPrefix(level norddrop.LogLevel) string {
@@ -191,6 +192,7 @@ func New(
 	pubkeyFunc func(string) []byte,
 	privKey string,
 	storagePath string,
+	transferFileLimit int,
 ) (*Fileshare, error) {
 	keyStore := defaultKeyStore{
 		pubkeyFunc: pubkeyFunc,
@@ -210,10 +212,11 @@ func New(
 	}
 
 	return &Fileshare{
-		norddrop:     norddrop,
-		eventsDbPath: eventsDbPath,
-		storagePath:  storagePath,
-		isProd:       isProd,
+		norddrop:          norddrop,
+		eventsDbPath:      eventsDbPath,
+		storagePath:       storagePath,
+		isProd:            isProd,
+		transferFileLimit: transferFileLimit,
 	}, nil
 }
 
@@ -242,7 +245,7 @@ func (f *Fileshare) start(
 ) error {
 	config := norddrop.Config{
 		DirDepthLimit:     fileshare.DirDepthLimit,
-		TransferFileLimit: fileshare.TransferFileLimit,
+		TransferFileLimit: uint64(f.transferFileLimit),
 		MooseEventPath:    eventsDbPath,
 		MooseProd:         isProd,
 		StoragePath:       storagePath,
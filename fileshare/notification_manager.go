@@ -25,6 +25,8 @@ const (
 	notifyNewTransferBody       = "Transfer ID: %s\nFrom: %s"
 	notifyNewAutoacceptTransfer = "New transfer accepted automatically"
 	notifyAutoacceptFailed      = "Failed to autoaccept transfer"
+	notifyTransferRejected      = "Incoming transfer declined"
+	transferTooManyFilesError   = "The transfer contains more than %d files, which is the limit."
 
 	acceptFailedNotificationSummary     = "Failed to accept transfer"
 	acceptFileFailedNotificationSummary = "Failed to download file"
@@ -431,6 +433,19 @@ func (nm *NotificationManager) NotifyAutoacceptFailed(transferID string, peer st
 	nm.sendGenericNotification(notifyAutoacceptFailed, body)
 }
 
+// NotifyTransferRejected creates a pop-up gui notification
+func (nm *NotificationManager) NotifyTransferRejected(transferID string, peer string, reason error) {
+	transferInfo := fmt.Sprintf(notifyNewTransferBody, transferID, peer)
+	reasonBody := genericError
+	var tooManyFiles ErrTooManyFiles
+	if errors.As(reason, &tooManyFiles) {
+		reasonBody = fmt.Sprintf(transferTooManyFilesError, tooManyFiles.Limit)
+	}
+	body := fmt.Sprintf("%s\n%s", reasonBody, transferInfo)
+
+	nm.sendGenericNotification(notifyTransferRejected, body)
+}
+
 // CloseNotification cleans up any data associated with notificationID
 func (nm *NotificationManager) CloseNotification(notificationID uint32) {
 	nm.notifications.GetAndDeleteFileNotification(notificationID)
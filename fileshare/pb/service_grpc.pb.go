@@ -28,6 +28,8 @@ type FileshareClient interface {
 	Stop(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 	// Send a file to a peer
 	Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (Fileshare_SendClient, error)
+	// Resend creates a new transfer with the paths of a previous outgoing transfer
+	Resend(ctx context.Context, in *ResendRequest, opts ...grpc.CallOption) (Fileshare_ResendClient, error)
 	// Accept a request from another peer to send you a file
 	Accept(ctx context.Context, in *AcceptRequest, opts ...grpc.CallOption) (Fileshare_AcceptClient, error)
 	// Reject a request from another peer to send you a file
@@ -40,6 +42,10 @@ type FileshareClient interface {
 	SetNotifications(ctx context.Context, in *SetNotificationsRequest, opts ...grpc.CallOption) (*SetNotificationsResponse, error)
 	// PurgeTransfersUntil provided time from fileshare implementation storage
 	PurgeTransfersUntil(ctx context.Context, in *PurgeTransfersUntilRequest, opts ...grpc.CallOption) (*Error, error)
+	// ListPending sends queued until the peers are reachable
+	ListPending(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListPendingResponse, error)
+	// CancelPending send so it is not started when the peer is reachable
+	CancelPending(ctx context.Context, in *CancelPendingRequest, opts ...grpc.CallOption) (*Error, error)
 }
 
 type fileshareClient struct {
@@ -100,8 +106,40 @@ func (x *fileshareSendClient) Recv() (*StatusResponse, error) {
 	return m, nil
 }
 
+func (c *fileshareClient) Resend(ctx context.Context, in *ResendRequest, opts ...grpc.CallOption) (Fileshare_ResendClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Fileshare_ServiceDesc.Streams[1], "/filesharepb.Fileshare/Resend", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &fileshareResendClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Fileshare_ResendClient interface {
+	Recv() (*StatusResponse, error)
+	grpc.ClientStream
+}
+
+type fileshareResendClient struct {
+	grpc.ClientStream
+}
+
+func (x *fileshareResendClient) Recv() (*StatusResponse, error) {
+	m := new(StatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *fileshareClient) Accept(ctx context.Context, in *AcceptRequest, opts ...grpc.CallOption) (Fileshare_AcceptClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Fileshare_ServiceDesc.Streams[1], "/filesharepb.Fileshare/Accept", opts...)
+	stream, err := c.cc.NewStream(ctx, &Fileshare_ServiceDesc.Streams[2], "/filesharepb.Fileshare/Accept", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -142,7 +180,7 @@ func (c *fileshareClient) Cancel(ctx context.Context, in *CancelRequest, opts ..
 }
 
 func (c *fileshareClient) List(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Fileshare_ListClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Fileshare_ServiceDesc.Streams[2], "/filesharepb.Fileshare/List", opts...)
+	stream, err := c.cc.NewStream(ctx, &Fileshare_ServiceDesc.Streams[3], "/filesharepb.Fileshare/List", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -200,6 +238,24 @@ func (c *fileshareClient) PurgeTransfersUntil(ctx context.Context, in *PurgeTran
 	return out, nil
 }
 
+func (c *fileshareClient) ListPending(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListPendingResponse, error) {
+	out := new(ListPendingResponse)
+	err := c.cc.Invoke(ctx, "/filesharepb.Fileshare/ListPending", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fileshareClient) CancelPending(ctx context.Context, in *CancelPendingRequest, opts ...grpc.CallOption) (*Error, error) {
+	out := new(Error)
+	err := c.cc.Invoke(ctx, "/filesharepb.Fileshare/CancelPending", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FileshareServer is the server API for Fileshare service.
 // All implementations must embed UnimplementedFileshareServer
 // for forward compatibility
@@ -210,6 +266,8 @@ type FileshareServer interface {
 	Stop(context.Context, *Empty) (*Empty, error)
 	// Send a file to a peer
 	Send(*SendRequest, Fileshare_SendServer) error
+	// Resend creates a new transfer with the paths of a previous outgoing transfer
+	Resend(*ResendRequest, Fileshare_ResendServer) error
 	// Accept a request from another peer to send you a file
 	Accept(*AcceptRequest, Fileshare_AcceptServer) error
 	// Reject a request from another peer to send you a file
@@ -222,6 +280,10 @@ type FileshareServer interface {
 	SetNotifications(context.Context, *SetNotificationsRequest) (*SetNotificationsResponse, error)
 	// PurgeTransfersUntil provided time from fileshare implementation storage
 	PurgeTransfersUntil(context.Context, *PurgeTransfersUntilRequest) (*Error, error)
+	// ListPending sends queued until the peers are reachable
+	ListPending(context.Context, *Empty) (*ListPendingResponse, error)
+	// CancelPending send so it is not started when the peer is reachable
+	CancelPending(context.Context, *CancelPendingRequest) (*Error, error)
 	mustEmbedUnimplementedFileshareServer()
 }
 
@@ -238,6 +300,9 @@ func (UnimplementedFileshareServer) Stop(context.Context, *Empty) (*Empty, error
 func (UnimplementedFileshareServer) Send(*SendRequest, Fileshare_SendServer) error {
 	return status.Errorf(codes.Unimplemented, "method Send not implemented")
 }
+func (UnimplementedFileshareServer) Resend(*ResendRequest, Fileshare_ResendServer) error {
+	return status.Errorf(codes.Unimplemented, "method Resend not implemented")
+}
 func (UnimplementedFileshareServer) Accept(*AcceptRequest, Fileshare_AcceptServer) error {
 	return status.Errorf(codes.Unimplemented, "method Accept not implemented")
 }
@@ -256,6 +321,12 @@ func (UnimplementedFileshareServer) SetNotifications(context.Context, *SetNotifi
 func (UnimplementedFileshareServer) PurgeTransfersUntil(context.Context, *PurgeTransfersUntilRequest) (*Error, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurgeTransfersUntil not implemented")
 }
+func (UnimplementedFileshareServer) ListPending(context.Context, *Empty) (*ListPendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPending not implemented")
+}
+func (UnimplementedFileshareServer) CancelPending(context.Context, *CancelPendingRequest) (*Error, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPending not implemented")
+}
 func (UnimplementedFileshareServer) mustEmbedUnimplementedFileshareServer() {}
 
 // UnsafeFileshareServer may be embedded to opt out of forward compatibility for this service.
@@ -326,6 +397,27 @@ func (x *fileshareSendServer) Send(m *StatusResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Fileshare_Resend_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResendRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FileshareServer).Resend(m, &fileshareResendServer{stream})
+}
+
+type Fileshare_ResendServer interface {
+	Send(*StatusResponse) error
+	grpc.ServerStream
+}
+
+type fileshareResendServer struct {
+	grpc.ServerStream
+}
+
+func (x *fileshareResendServer) Send(m *StatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Fileshare_Accept_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(AcceptRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -440,6 +532,42 @@ func _Fileshare_PurgeTransfersUntil_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Fileshare_ListPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileshareServer).ListPending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filesharepb.Fileshare/ListPending",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileshareServer).ListPending(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Fileshare_CancelPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FileshareServer).CancelPending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/filesharepb.Fileshare/CancelPending",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FileshareServer).CancelPending(ctx, req.(*CancelPendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Fileshare_ServiceDesc is the grpc.ServiceDesc for Fileshare service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -471,6 +599,14 @@ var Fileshare_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PurgeTransfersUntil",
 			Handler:    _Fileshare_PurgeTransfersUntil_Handler,
 		},
+		{
+			MethodName: "ListPending",
+			Handler:    _Fileshare_ListPending_Handler,
+		},
+		{
+			MethodName: "CancelPending",
+			Handler:    _Fileshare_CancelPending_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -478,6 +614,11 @@ var Fileshare_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Fileshare_Send_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Resend",
+			Handler:       _Fileshare_Resend_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Accept",
 			Handler:       _Fileshare_Accept_Handler,
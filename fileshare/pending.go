@@ -0,0 +1,149 @@
+package fileshare
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	meshpb "github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// PendingSendIDPrefix distinguishes queued sends from the actions queued by other services
+	PendingSendIDPrefix = "fileshare-"
+	// PendingSendRetryInterval defines how often peers of the queued sends are checked
+	PendingSendRetryInterval = 30 * time.Second
+)
+
+type pendingSend struct {
+	id      string
+	req     *pb.SendRequest
+	created time.Time
+}
+
+// pendingSends holds sends to the peers which were not reachable when the send was requested
+type pendingSends struct {
+	mu     sync.Mutex
+	lastID uint64
+	sends  []pendingSend
+}
+
+func (p *pendingSends) add(req *pb.SendRequest) string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.lastID++
+	id := fmt.Sprintf("%s%d", PendingSendIDPrefix, p.lastID)
+	p.sends = append(p.sends, pendingSend{id: id, req: req, created: time.Now()})
+	return id
+}
+
+func (p *pendingSends) list() []pendingSend {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	sends := make([]pendingSend, len(p.sends))
+	copy(sends, p.sends)
+	return sends
+}
+
+// take removes sends matching the filter from the queue and returns them
+func (p *pendingSends) take(filter func(pendingSend) bool) []pendingSend {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var taken, kept []pendingSend
+	for _, send := range p.sends {
+		if filter(send) {
+			taken = append(taken, send)
+		} else {
+			kept = append(kept, send)
+		}
+	}
+	p.sends = kept
+	return taken
+}
+
+// queueSend postpones the send until the peer is reachable
+func (s *Server) queueSend(req *pb.SendRequest, srv statusResponseSender) error {
+	id := s.pending.add(&pb.SendRequest{Peer: req.Peer, Paths: req.Paths, Silent: true})
+	log.Printf("peer %s is not reachable, send is queued as %s", req.Peer, id)
+	return srv.Send(&pb.StatusResponse{Status: pb.Status_PENDING, PendingId: id})
+}
+
+// RetryPendingSends starts the queued sends once their peers are reachable. Sends stay queued while
+// isDeferred returns true. It blocks forever so it should be run on a separate goroutine.
+func (s *Server) RetryPendingSends(interval time.Duration, isDeferred func() bool) {
+	for range time.Tick(interval) {
+		if len(s.pending.list()) == 0 || isDeferred() {
+			continue
+		}
+		s.retryPendingSends()
+	}
+}
+
+func (s *Server) retryPendingSends() {
+	if len(s.pending.list()) == 0 {
+		return
+	}
+
+	peerPubkeyToPeer, peerNameToPeer, err := s.getPeers()
+	if err != nil {
+		return
+	}
+
+	reachable := s.pending.take(func(send pendingSend) bool {
+		peer, ok := peerPubkeyToPeer[send.req.Peer]
+		if !ok {
+			peer, ok = peerNameToPeer[strings.ToLower(send.req.Peer)]
+		}
+		return ok && peer.Status == meshpb.PeerStatus_CONNECTED
+	})
+
+	for _, send := range reachable {
+		log.Printf("peer %s is reachable, starting queued send %s", send.req.Peer, send.id)
+		if err := s.send(send.req, nil, pendingSendStatusLogger{id: send.id}); err != nil {
+			log.Printf("failed to start queued send %s: %s", send.id, err)
+		}
+	}
+}
+
+// pendingSendStatusLogger logs the result of the queued send as there is no client waiting for it
+type pendingSendStatusLogger struct {
+	id string
+}
+
+func (l pendingSendStatusLogger) Send(resp *pb.StatusResponse) error {
+	if _, ok := resp.GetError().GetResponse().(*pb.Error_Empty); resp.GetError() != nil && !ok {
+		log.Printf("queued send %s failed: %s", l.id, resp.GetError())
+	}
+	return nil
+}
+
+// ListPending rpc
+func (s *Server) ListPending(context.Context, *pb.Empty) (*pb.ListPendingResponse, error) {
+	var sends []*pb.PendingSend
+	for _, send := range s.pending.list() {
+		sends = append(sends, &pb.PendingSend{
+			Id:      send.id,
+			Peer:    send.req.Peer,
+			Paths:   send.req.Paths,
+			Created: timestamppb.New(send.created),
+		})
+	}
+	return &pb.ListPendingResponse{Sends: sends}, nil
+}
+
+// CancelPending rpc
+func (s *Server) CancelPending(_ context.Context, req *pb.CancelPendingRequest) (*pb.Error, error) {
+	canceled := s.pending.take(func(send pendingSend) bool { return send.id == req.Id })
+	if len(canceled) == 0 {
+		return fileshareError(pb.FileshareErrorCode_PENDING_SEND_NOT_FOUND), nil
+	}
+	return empty(), nil
+}
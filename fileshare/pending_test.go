@@ -0,0 +1,90 @@
+package fileshare
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	meshpb "github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSend_QueuedUntilPeerIsReachable(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	mockFs := newMockFilesystem()
+	mockFs.MapFS["home/file"] = &fstest.MapFile{}
+
+	peerIP := "38.30.202.86"
+	peer := &meshpb.Peer{
+		Ip:                 peerIP,
+		Hostname:           "internal.peer1.nord",
+		IsFileshareAllowed: true,
+		Status:             meshpb.PeerStatus_DISCONNECTED,
+	}
+	meshClient := &mockMeshClient{isEnabled: true, localPeers: []*meshpb.Peer{peer}}
+	fileshare := &mockServerFileshare{}
+	eventManager := &EventManager{transferFileLimit: TransferFileLimit}
+	server := NewServer(fileshare, eventManager, meshClient, mockFs, &mockOsInfo{}, 0, nil)
+
+	sendServer := mockSendServer{}
+	err := server.Send(&pb.SendRequest{Peer: peerIP, Paths: []string{"home/file"}}, &sendServer)
+	assert.NoError(t, err)
+	assert.Equal(t, &pb.StatusResponse{Status: pb.Status_PENDING, PendingId: "fileshare-1"}, sendServer.response)
+	assert.Nil(t, fileshare.sentPaths)
+
+	pending, err := server.ListPending(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Len(t, pending.Sends, 1)
+	assert.Equal(t, "fileshare-1", pending.Sends[0].Id)
+	assert.Equal(t, peerIP, pending.Sends[0].Peer)
+	assert.Equal(t, []string{"home/file"}, pending.Sends[0].Paths)
+
+	// peer is still offline
+	server.retryPendingSends()
+	assert.Nil(t, fileshare.sentPaths)
+
+	peer.Status = meshpb.PeerStatus_CONNECTED
+	server.retryPendingSends()
+	assert.Equal(t, peerIP, fileshare.destinationPeer)
+	assert.Equal(t, []string{"home/file"}, fileshare.sentPaths)
+
+	pending, err = server.ListPending(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Empty(t, pending.Sends)
+}
+
+func TestCancelPending(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	mockFs := newMockFilesystem()
+	mockFs.MapFS["home/file"] = &fstest.MapFile{}
+
+	peerIP := "38.30.202.86"
+	meshClient := &mockMeshClient{
+		isEnabled: true,
+		localPeers: []*meshpb.Peer{
+			{Ip: peerIP, IsFileshareAllowed: true, Status: meshpb.PeerStatus_DISCONNECTED},
+		},
+	}
+	eventManager := &EventManager{transferFileLimit: TransferFileLimit}
+	server := NewServer(&mockServerFileshare{}, eventManager, meshClient, mockFs, &mockOsInfo{}, 0, nil)
+
+	resp, err := server.CancelPending(context.Background(), &pb.CancelPendingRequest{Id: "fileshare-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, fileshareError(pb.FileshareErrorCode_PENDING_SEND_NOT_FOUND), resp)
+
+	err = server.Send(&pb.SendRequest{Peer: peerIP, Paths: []string{"home/file"}}, &mockSendServer{})
+	assert.NoError(t, err)
+
+	resp, err = server.CancelPending(context.Background(), &pb.CancelPendingRequest{Id: "fileshare-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, empty(), resp)
+
+	pending, err := server.ListPending(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Empty(t, pending.Sends)
+}
@@ -41,6 +41,7 @@ type Server struct {
 	osInfo        OsInfo
 	listChunkSize int
 	shutdownChan  chan<- struct{}
+	pending       pendingSends
 }
 
 // NewServer is a default constructor for a fileshare server
@@ -79,7 +80,8 @@ var (
 
 // getNumberOfFiles returns number of files in a directory and its subdirectories
 // Returns an error if max subdirectory deepth exceeds max_depth, pass a negative number for infinite depth
-func (s *Server) getNumberOfFiles(path string, maxDepth int) (int, error) {
+// Counting stops as soon as more than limit files are found, as the transfer is rejected then anyway
+func (s *Server) getNumberOfFiles(path string, maxDepth int, limit int) (int, error) {
 	if maxDepth == 0 {
 		return 0, errMaxDirectoryDepthReached
 	}
@@ -93,8 +95,11 @@ func (s *Server) getNumberOfFiles(path string, maxDepth int) (int, error) {
 	maxDepth--
 
 	for _, file := range files {
+		if numberOfFiles > limit {
+			break
+		}
 		if file.IsDir() {
-			nestedFiles, err := s.getNumberOfFiles(path+"/"+file.Name(), maxDepth)
+			nestedFiles, err := s.getNumberOfFiles(path+"/"+file.Name(), maxDepth, limit-numberOfFiles)
 			if err != nil {
 				return 0, err
 			}
@@ -107,7 +112,12 @@ func (s *Server) getNumberOfFiles(path string, maxDepth int) (int, error) {
 	return numberOfFiles, err
 }
 
-func (s *Server) startTransferStatusStream(srv pb.Fileshare_SendServer, transferID string) error {
+// statusResponseSender is implemented by the servers of the streams which report transfer status
+type statusResponseSender interface {
+	Send(*pb.StatusResponse) error
+}
+
+func (s *Server) startTransferStatusStream(srv statusResponseSender, transferID string) error {
 	for ev := range s.eventManager.Subscribe(transferID) {
 		//exhaustive:ignore
 		switch ev.Status {
@@ -174,6 +184,57 @@ func (s *Server) Send(req *pb.SendRequest, srv pb.Fileshare_SendServer) error {
 		return srv.Send(&pb.StatusResponse{Error: serviceError(pb.ServiceErrorCode_MESH_NOT_ENABLED)})
 	}
 
+	return s.send(req, nil, srv)
+}
+
+// Resend rpc
+func (s *Server) Resend(req *pb.ResendRequest, srv pb.Fileshare_ResendServer) error {
+	resp, err := s.meshClient.IsEnabled(context.Background(), &meshpb.Empty{})
+	if err != nil || !resp.GetStatus().GetValue() {
+		return srv.Send(&pb.StatusResponse{Error: serviceError(pb.ServiceErrorCode_MESH_NOT_ENABLED)})
+	}
+
+	transfer, err := s.eventManager.GetTransfer(req.TransferId)
+	switch {
+	case errors.Is(err, ErrTransferNotFound):
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_TRANSFER_NOT_FOUND)})
+	case err != nil:
+		log.Printf("error while loading transfer %s for resending: %s", req.TransferId, err)
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_LIB_FAILURE)})
+	}
+
+	if transfer.Direction != pb.Direction_OUTGOING {
+		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_RESEND_INCOMING)})
+	}
+
+	var paths, missingPaths []string
+	for _, path := range GetTransferSourcePaths(transfer) {
+		if _, err := s.filesystem.Stat(path); err != nil {
+			missingPaths = append(missingPaths, path)
+			continue
+		}
+		paths = append(paths, path)
+	}
+
+	if len(paths) == 0 {
+		return srv.Send(&pb.StatusResponse{
+			Error:        fileshareError(pb.FileshareErrorCode_FILE_NOT_FOUND),
+			MissingPaths: missingPaths,
+		})
+	}
+
+	peer := req.Peer
+	if peer == "" {
+		peer = transfer.Peer
+	}
+
+	return s.send(&pb.SendRequest{Peer: peer, Paths: paths, Silent: req.Silent}, missingPaths, srv)
+}
+
+// send creates a new transfer and reports its status. missingPaths are reported back together
+// with the ID of the created transfer.
+func (s *Server) send(req *pb.SendRequest, missingPaths []string, srv statusResponseSender) error {
+	transferFileLimit := s.eventManager.TransferFileLimit()
 	fileCount := 0
 	for _, path := range req.Paths {
 		isDirectory, err := s.isDirectory(path)
@@ -182,7 +243,7 @@ func (s *Server) Send(req *pb.SendRequest, srv pb.Fileshare_SendServer) error {
 		}
 
 		if isDirectory {
-			fileCountInDirectory, err := s.getNumberOfFiles(path, DirDepthLimit)
+			fileCountInDirectory, err := s.getNumberOfFiles(path, DirDepthLimit, transferFileLimit-fileCount)
 			switch {
 			case errors.Is(err, errMaxDirectoryDepthReached):
 				return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_DIRECTORY_TOO_DEEP)})
@@ -195,8 +256,11 @@ func (s *Server) Send(req *pb.SendRequest, srv pb.Fileshare_SendServer) error {
 			fileCount++
 		}
 
-		if fileCount > TransferFileLimit {
-			return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_TOO_MANY_FILES)})
+		if fileCount > transferFileLimit {
+			return srv.Send(&pb.StatusResponse{
+				Error:     fileshareError(pb.FileshareErrorCode_TOO_MANY_FILES),
+				FileLimit: uint32(transferFileLimit),
+			})
 		}
 
 		if fileCount == 0 {
@@ -217,10 +281,6 @@ func (s *Server) Send(req *pb.SendRequest, srv pb.Fileshare_SendServer) error {
 		}
 	}
 
-	if peer.Status == meshpb.PeerStatus_DISCONNECTED {
-		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_PEER_DISCONNECTED)})
-	}
-
 	parsedIP, err := netip.ParseAddr(peer.Ip)
 	if err != nil {
 		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_INVALID_PEER)})
@@ -230,6 +290,11 @@ func (s *Server) Send(req *pb.SendRequest, srv pb.Fileshare_SendServer) error {
 		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_SENDING_NOT_ALLOWED)})
 	}
 
+	// instead of failing, send is started once the peer is back online
+	if peer.Status == meshpb.PeerStatus_DISCONNECTED {
+		return s.queueSend(req, srv)
+	}
+
 	transferID, err := s.fileshare.Send(parsedIP, req.Paths)
 	if err != nil {
 		return srv.Send(&pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_TRANSFER_NOT_CREATED)})
@@ -248,7 +313,11 @@ func (s *Server) Send(req *pb.SendRequest, srv pb.Fileshare_SendServer) error {
 		TransferId: transferID,
 	})
 
-	if err := srv.Send(&pb.StatusResponse{TransferId: transferID, Status: pb.Status_REQUESTED}); err != nil {
+	if err := srv.Send(&pb.StatusResponse{
+		TransferId:   transferID,
+		Status:       pb.Status_REQUESTED,
+		MissingPaths: missingPaths,
+	}); err != nil {
 		return err
 	}
 
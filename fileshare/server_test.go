@@ -30,6 +30,7 @@ type mockServerFileshare struct {
 	cancelReturnValue      error
 	acceptFirstReturnValue error // Only first return has this value, subsequent always have nil
 	destinationPeer        string
+	sentPaths              []string
 	acceptedFiles          []string
 	canceledFiles          []string
 }
@@ -61,6 +62,7 @@ func (mockServerFileshare) Disable() error { return nil }
 
 func (m *mockServerFileshare) Send(peer netip.Addr, paths []string) (string, error) {
 	m.destinationPeer = peer.String()
+	m.sentPaths = paths
 	return "", nil
 }
 
@@ -360,7 +362,7 @@ func TestSend(t *testing.T) {
 		mockFileshare := mockServerFileshare{}
 		server := NewServer(
 			&mockFileshare,
-			&EventManager{},
+			&EventManager{transferFileLimit: TransferFileLimit},
 			&mockMeshClient,
 			mockFs,
 			&mockOsInfo{},
@@ -425,10 +427,13 @@ func TestSendDirectoryFilesystemErrorHandling(t *testing.T) {
 		expectedSendResponse *pb.StatusResponse
 	}{
 		{
-			testName:             "too many files",
-			paths:                []string{directoryTooManyFiles},
-			transferSilent:       true,
-			expectedSendResponse: &pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_TOO_MANY_FILES)},
+			testName:       "too many files",
+			paths:          []string{directoryTooManyFiles},
+			transferSilent: true,
+			expectedSendResponse: &pb.StatusResponse{
+				Error:     fileshareError(pb.FileshareErrorCode_TOO_MANY_FILES),
+				FileLimit: TransferFileLimit,
+			},
 		},
 		{
 			testName:             "file doesent exist",
@@ -443,16 +448,22 @@ func TestSendDirectoryFilesystemErrorHandling(t *testing.T) {
 			expectedSendResponse: &pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_DIRECTORY_TOO_DEEP)},
 		},
 		{
-			testName:             "too many files in multidirectory transfer",
-			paths:                []string{tooManyFilesCumulative1, tooManyFilesCumulative2},
-			transferSilent:       true,
-			expectedSendResponse: &pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_TOO_MANY_FILES)},
+			testName:       "too many files in multidirectory transfer",
+			paths:          []string{tooManyFilesCumulative1, tooManyFilesCumulative2},
+			transferSilent: true,
+			expectedSendResponse: &pb.StatusResponse{
+				Error:     fileshareError(pb.FileshareErrorCode_TOO_MANY_FILES),
+				FileLimit: TransferFileLimit,
+			},
 		},
 		{
-			testName:             "too many files in multifile transfer",
-			paths:                []string{exectFileLimit, file1},
-			transferSilent:       true,
-			expectedSendResponse: &pb.StatusResponse{Error: fileshareError(pb.FileshareErrorCode_TOO_MANY_FILES)},
+			testName:       "too many files in multifile transfer",
+			paths:          []string{exectFileLimit, file1},
+			transferSilent: true,
+			expectedSendResponse: &pb.StatusResponse{
+				Error:     fileshareError(pb.FileshareErrorCode_TOO_MANY_FILES),
+				FileLimit: TransferFileLimit,
+			},
 		},
 		{
 			testName:             "file in multifile transfer doesnt exist",
@@ -471,7 +482,7 @@ func TestSendDirectoryFilesystemErrorHandling(t *testing.T) {
 	for _, test := range fileshareTests {
 		server := NewServer(
 			&mockServerFileshare{},
-			&EventManager{},
+			&EventManager{transferFileLimit: TransferFileLimit},
 			&mockMeshClient{isEnabled: true},
 			mockFs,
 			&mockOsInfo{},
@@ -491,6 +502,167 @@ func TestSendDirectoryFilesystemErrorHandling(t *testing.T) {
 	}
 }
 
+func TestSendCustomTransferFileLimit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	mockFs := newMockFilesystem()
+	directory := "directory"
+	populateMapFs(t, &mockFs.MapFS, directory, 30)
+	otherDirectory := "other"
+	populateMapFs(t, &mockFs.MapFS, otherDirectory, 30)
+
+	eventManager := NewEventManager(false, &mockMeshClient{}, &mockOsInfo{}, mockFs, "")
+	eventManager.SetTransferFileLimit(10)
+
+	server := NewServer(
+		&mockServerFileshare{},
+		eventManager,
+		&mockMeshClient{isEnabled: true},
+		mockFs,
+		&mockOsInfo{},
+		0,
+		nil,
+	)
+
+	sendServer := mockSendServer{}
+	err := server.Send(&pb.SendRequest{
+		Peer:   "100.96.115.182",
+		Paths:  []string{directory, otherDirectory},
+		Silent: true,
+	}, &sendServer)
+	assert.Equal(t, nil, err)
+	// counting stops once the limit is exceeded
+	assert.Equal(t, &pb.StatusResponse{
+		Error:     fileshareError(pb.FileshareErrorCode_TOO_MANY_FILES),
+		FileLimit: 10,
+	}, sendServer.response)
+}
+
+func TestResend(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	mockFs := newMockFilesystem()
+	populateMapFs(t, &mockFs.MapFS, "home/dir", 2)
+	mockFs.MapFS["home/file"] = &fstest.MapFile{}
+
+	peer1IP := "38.30.202.86"
+	peer2IP := "219.150.143.226"
+	peer2Hostname := "internal.peer2.nord"
+	meshClient := &mockMeshClient{
+		isEnabled: true,
+		localPeers: []*meshpb.Peer{
+			{Ip: peer1IP, Hostname: "internal.peer1.nord", IsFileshareAllowed: true, Status: 1},
+			{Ip: peer2IP, Hostname: peer2Hostname, IsFileshareAllowed: true, Status: 1},
+		},
+	}
+
+	outgoingFiles := func(paths ...string) []*pb.File {
+		files := []*pb.File{}
+		for _, path := range paths {
+			files = append(files, &pb.File{Path: path, FullPath: "home/" + path})
+		}
+		return files
+	}
+	transfers := map[string]*pb.Transfer{
+		"outgoing": {
+			Id:        "outgoing",
+			Direction: pb.Direction_OUTGOING,
+			Peer:      peer1IP,
+			Files:     outgoingFiles("dir/0", "dir/1", "file"),
+		},
+		"partially_missing": {
+			Id:        "partially_missing",
+			Direction: pb.Direction_OUTGOING,
+			Peer:      peer1IP,
+			Files:     outgoingFiles("file", "removed"),
+		},
+		"missing": {
+			Id:        "missing",
+			Direction: pb.Direction_OUTGOING,
+			Peer:      peer1IP,
+			Files:     outgoingFiles("removed"),
+		},
+		"incoming": {
+			Id:        "incoming",
+			Direction: pb.Direction_INCOMING,
+			Peer:      peer1IP,
+		},
+	}
+
+	tests := []struct {
+		name             string
+		request          *pb.ResendRequest
+		expectedResponse *pb.StatusResponse
+		expectedPeer     string
+		expectedPaths    []string
+	}{
+		{
+			name:             "resend to the same peer",
+			request:          &pb.ResendRequest{TransferId: "outgoing", Silent: true},
+			expectedResponse: &pb.StatusResponse{Status: pb.Status_REQUESTED},
+			expectedPeer:     peer1IP,
+			expectedPaths:    []string{"home/dir", "home/file"},
+		},
+		{
+			name:             "resend to another peer",
+			request:          &pb.ResendRequest{TransferId: "outgoing", Peer: peer2Hostname, Silent: true},
+			expectedResponse: &pb.StatusResponse{Status: pb.Status_REQUESTED},
+			expectedPeer:     peer2IP,
+			expectedPaths:    []string{"home/dir", "home/file"},
+		},
+		{
+			name:    "missing files are skipped",
+			request: &pb.ResendRequest{TransferId: "partially_missing", Silent: true},
+			expectedResponse: &pb.StatusResponse{
+				Status:       pb.Status_REQUESTED,
+				MissingPaths: []string{"home/removed"},
+			},
+			expectedPeer:  peer1IP,
+			expectedPaths: []string{"home/file"},
+		},
+		{
+			name:    "all files are missing",
+			request: &pb.ResendRequest{TransferId: "missing", Silent: true},
+			expectedResponse: &pb.StatusResponse{
+				Error:        fileshareError(pb.FileshareErrorCode_FILE_NOT_FOUND),
+				MissingPaths: []string{"home/removed"},
+			},
+		},
+		{
+			name:    "incoming transfer",
+			request: &pb.ResendRequest{TransferId: "incoming", Silent: true},
+			expectedResponse: &pb.StatusResponse{
+				Error: fileshareError(pb.FileshareErrorCode_RESEND_INCOMING),
+			},
+		},
+		{
+			name:    "transfer not found",
+			request: &pb.ResendRequest{TransferId: "unknown", Silent: true},
+			expectedResponse: &pb.StatusResponse{
+				Error: fileshareError(pb.FileshareErrorCode_TRANSFER_NOT_FOUND),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fileshare := &mockServerFileshare{}
+			eventManager := &EventManager{
+				storage:           &mockStorage{transfers: transfers},
+				transferFileLimit: TransferFileLimit,
+			}
+			server := NewServer(fileshare, eventManager, meshClient, mockFs, &mockOsInfo{}, 0, nil)
+
+			resendServer := mockSendServer{}
+			err := server.Resend(test.request, &resendServer)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedResponse, resendServer.response)
+			assert.Equal(t, test.expectedPeer, fileshare.destinationPeer)
+			assert.Equal(t, test.expectedPaths, fileshare.sentPaths)
+		})
+	}
+}
+
 func TestAccept(t *testing.T) {
 	category.Set(t, category.Unit)
 
@@ -1,6 +1,8 @@
 package fileshare
 
 import (
+	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
@@ -152,3 +154,22 @@ func GetTransferFileStatus(file *pb.File, in bool) (status string) {
 	}
 	return "-"
 }
+
+// GetTransferSourcePaths reconstructs paths provided by the user when creating an outgoing transfer.
+// Files sent as a part of a directory are reduced to the path of that directory.
+func GetTransferSourcePaths(tr *pb.Transfer) []string {
+	var paths []string
+	for _, file := range tr.Files {
+		if file.FullPath == "" || file.Path == "" {
+			continue
+		}
+
+		basePath := strings.TrimSuffix(file.FullPath, file.Path)
+		topLevel, _, _ := strings.Cut(filepath.ToSlash(file.Path), "/")
+		path := filepath.Join(basePath, topLevel)
+		if !slices.Contains(paths, path) {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
@@ -0,0 +1,28 @@
+package fileshare
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTransferSourcePaths(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	transfer := &pb.Transfer{
+		Files: []*pb.File{
+			{Path: "dir/a.txt", FullPath: "/home/user/dir/a.txt"},
+			{Path: "dir/nested/b.txt", FullPath: "/home/user/dir/nested/b.txt"},
+			{Path: "c.txt", FullPath: "/home/user/c.txt"},
+			{Path: "d.txt", FullPath: "/tmp/d.txt"},
+			{Path: "unknown.txt"},
+		},
+	}
+
+	assert.Equal(t,
+		[]string{"/home/user/dir", "/home/user/c.txt", "/tmp/d.txt"},
+		GetTransferSourcePaths(transfer))
+}
@@ -0,0 +1,312 @@
+// Package gateway exposes the key daemon RPCs as HTTP/JSON on the loopback interface, so that the scripts and the
+// home automation tools which cannot use gRPC are able to control the VPN connection.
+//
+// Every request has to carry the token in the Authorization header:
+//
+//	curl -H "Authorization: Bearer $(cat ~/.config/nordvpn/gateway-token)" http://127.0.0.1:6960/v1/status
+//
+// Supported endpoints:
+//
+//	GET  /v1/status      connection status
+//	GET  /v1/settings    daemon settings
+//	POST /v1/connect     connect, optional body {"server_tag": "de", "server_group": "p2p"}
+//	POST /v1/disconnect  disconnect
+package gateway
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultAddress is the address the gateway listens on when none is given
+	DefaultAddress = "127.0.0.1:6960"
+	// TokenFileName is the name of the token file in the user config directory
+	TokenFileName = "gateway-token"
+
+	tokenLength       = 32
+	maxRequestSize    = 64 * 1024
+	readHeaderTimeout = 10 * time.Second
+)
+
+var (
+	// ErrNotLoopback is returned when the gateway is asked to listen on an address reachable from the network
+	ErrNotLoopback = errors.New("gateway can listen only on a loopback address")
+	// ErrInsecureToken is returned when the token file can be read by other users
+	ErrInsecureToken = errors.New("token file must be readable only by its owner")
+)
+
+var marshaler = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+// Gateway translates HTTP requests to the daemon RPCs
+type Gateway struct {
+	client pb.DaemonClient
+	token  []byte
+	mux    *http.ServeMux
+}
+
+// New creates a gateway which accepts only the requests carrying the token
+func New(client pb.DaemonClient, token string) *Gateway {
+	g := &Gateway{client: client, token: []byte(token), mux: http.NewServeMux()}
+	g.mux.HandleFunc("/v1/status", g.handle(http.MethodGet, g.status))
+	g.mux.HandleFunc("/v1/settings", g.handle(http.MethodGet, g.settings))
+	g.mux.HandleFunc("/v1/connect", g.handle(http.MethodPost, g.connect))
+	g.mux.HandleFunc("/v1/disconnect", g.handle(http.MethodPost, g.disconnect))
+	return g
+}
+
+// Serve handles the requests until the listener is closed
+func (g *Gateway) Serve(listener net.Listener) error {
+	server := &http.Server{Handler: g, ReadHeaderTimeout: readHeaderTimeout}
+	err := server.Serve(listener)
+	if errors.Is(err, http.ErrServerClosed) || errors.Is(err, net.ErrClosed) {
+		return nil
+	}
+	return err
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !g.authorized(r) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		writeError(w, http.StatusUnauthorized, "missing or invalid token")
+		return
+	}
+	g.mux.ServeHTTP(w, r)
+}
+
+func (g *Gateway) authorized(r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(token), g.token) == 1
+}
+
+type handlerFunc func(ctx context.Context, body []byte) (int, proto.Message, error)
+
+func (g *Gateway) handle(method string, handler handlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			w.Header().Set("Allow", method)
+			writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+			return
+		}
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+		if err != nil {
+			writeError(w, http.StatusRequestEntityTooLarge, "request is too large")
+			return
+		}
+
+		code, resp, err := handler(r.Context(), body)
+		if err != nil {
+			writeError(w, code, err.Error())
+			return
+		}
+		data, err := marshaler.Marshal(resp)
+		if err != nil {
+			log.Println(internal.ErrorPrefix, "marshaling gateway response:", err)
+			writeError(w, http.StatusInternalServerError, "internal error")
+			return
+		}
+		writeJSON(w, code, data)
+	}
+}
+
+func (g *Gateway) status(ctx context.Context, _ []byte) (int, proto.Message, error) {
+	resp, err := g.client.Status(ctx, &pb.Empty{})
+	if err != nil {
+		return rpcErrorStatus(err), nil, rpcError(err)
+	}
+	return http.StatusOK, resp, nil
+}
+
+func (g *Gateway) settings(ctx context.Context, _ []byte) (int, proto.Message, error) {
+	resp, err := g.client.Settings(ctx, &pb.Empty{})
+	if err != nil {
+		return rpcErrorStatus(err), nil, rpcError(err)
+	}
+	if resp.GetType() != internal.CodeSuccess {
+		return payloadStatus(resp.GetType()), nil, fmt.Errorf("daemon returned code %d", resp.GetType())
+	}
+	return http.StatusOK, resp.GetData(), nil
+}
+
+func (g *Gateway) connect(ctx context.Context, body []byte) (int, proto.Message, error) {
+	var req pb.ConnectRequest
+	if len(body) > 0 {
+		if err := protojson.Unmarshal(body, &req); err != nil {
+			return http.StatusBadRequest, nil, fmt.Errorf("invalid request: %w", err)
+		}
+	}
+	req.ServerTag = strings.ToLower(req.GetServerTag())
+
+	stream, err := g.client.Connect(ctx, &req)
+	if err != nil {
+		return rpcErrorStatus(err), nil, rpcError(err)
+	}
+	payload, err := lastPayload(stream)
+	if err != nil {
+		return rpcErrorStatus(err), nil, rpcError(err)
+	}
+	// connection which ends with a disconnect was canceled by another client
+	if payload.GetType() == internal.CodeDisconnected {
+		return http.StatusConflict, payload, nil
+	}
+	return payloadStatus(payload.GetType()), payload, nil
+}
+
+func (g *Gateway) disconnect(ctx context.Context, _ []byte) (int, proto.Message, error) {
+	stream, err := g.client.Disconnect(ctx, &pb.Empty{})
+	if err != nil {
+		return rpcErrorStatus(err), nil, rpcError(err)
+	}
+	payload, err := lastPayload(stream)
+	if err != nil {
+		return rpcErrorStatus(err), nil, rpcError(err)
+	}
+	return payloadStatus(payload.GetType()), payload, nil
+}
+
+// lastPayload waits for the streaming RPC to finish and returns its final result
+func lastPayload(stream interface{ Recv() (*pb.Payload, error) }) (*pb.Payload, error) {
+	var last *pb.Payload
+	for {
+		payload, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		last = payload
+	}
+	if last == nil {
+		return nil, errors.New("daemon did not respond")
+	}
+	return last, nil
+}
+
+// payloadStatus maps the daemon response code to the HTTP status
+func payloadStatus(code int64) int {
+	switch code {
+	case internal.CodeSuccess, internal.CodeConnected, internal.CodeDisconnected, internal.CodeNothingToDo:
+		return http.StatusOK
+	case internal.CodeQueuedOffline:
+		return http.StatusAccepted
+	case internal.CodeVPNRunning, internal.CodeVPNNotRunning, internal.CodeConflict:
+		return http.StatusConflict
+	case internal.CodeFormatError, internal.CodeBadRequest, internal.CodeDoubleGroupError,
+		internal.CodeDoubleVPNRequired:
+		return http.StatusBadRequest
+	case internal.CodeTagNonexisting, internal.CodeGroupNonexisting, internal.CodeServerUnavailable,
+		internal.CodeFavoriteNotFound:
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+func rpcErrorStatus(err error) int {
+	switch status.Code(err) {
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.Canceled:
+		return http.StatusRequestTimeout
+	}
+	return http.StatusInternalServerError
+}
+
+func rpcError(err error) error {
+	return errors.New(status.Convert(err).Message())
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	data, err := marshaler.Marshal(&pb.Payload{Type: internal.CodeFailure, Data: []string{message}})
+	if err != nil {
+		w.WriteHeader(code)
+		return
+	}
+	writeJSON(w, code, data)
+}
+
+func writeJSON(w http.ResponseWriter, code int, data []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	// #nosec G104 -- nothing can be done if the client is gone
+	w.Write(append(data, '\n'))
+}
+
+// Listen opens the listener making sure that the gateway is not reachable from the network
+func Listen(address string) (net.Listener, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	if host != "localhost" {
+		ip, err := netip.ParseAddr(host)
+		if err != nil || !ip.IsLoopback() {
+			return nil, ErrNotLoopback
+		}
+	}
+	return net.Listen("tcp", address)
+}
+
+// LoadToken reads the token from the file or creates the file with a new random token
+func LoadToken(path string) (string, error) {
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return newToken(path)
+	}
+	if err != nil {
+		return "", err
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		return "", ErrInsecureToken
+	}
+	// #nosec G304 -- path is given by the user running the gateway
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", path)
+	}
+	return token, nil
+}
+
+func newToken(path string) (string, error) {
+	buf := make([]byte, tokenLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(buf)
+	// #nosec G304 -- path is given by the user running the gateway
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, internal.PermUserRW)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	if _, err := file.WriteString(token + "\n"); err != nil {
+		return "", err
+	}
+	return token, nil
+}
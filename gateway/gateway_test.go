@@ -0,0 +1,240 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testToken = "secret"
+
+type payloadStream struct {
+	grpc.ClientStream
+	payloads []*pb.Payload
+}
+
+func (s *payloadStream) Recv() (*pb.Payload, error) {
+	if len(s.payloads) == 0 {
+		return nil, io.EOF
+	}
+	payload := s.payloads[0]
+	s.payloads = s.payloads[1:]
+	return payload, nil
+}
+
+type mockDaemonClient struct {
+	pb.DaemonClient
+	connectRequest *pb.ConnectRequest
+	connect        []*pb.Payload
+	disconnect     []*pb.Payload
+	statusErr      error
+}
+
+func (c *mockDaemonClient) Status(context.Context, *pb.Empty, ...grpc.CallOption) (*pb.StatusResponse, error) {
+	if c.statusErr != nil {
+		return nil, c.statusErr
+	}
+	return &pb.StatusResponse{State: "Connected", Hostname: "de1.nordvpn.com"}, nil
+}
+
+func (c *mockDaemonClient) Settings(context.Context, *pb.Empty, ...grpc.CallOption) (*pb.SettingsResponse, error) {
+	return &pb.SettingsResponse{
+		Type: internal.CodeSuccess,
+		Data: &pb.Settings{Technology: config.Technology_NORDLYNX},
+	}, nil
+}
+
+func (c *mockDaemonClient) Connect(
+	_ context.Context,
+	in *pb.ConnectRequest,
+	_ ...grpc.CallOption,
+) (pb.Daemon_ConnectClient, error) {
+	c.connectRequest = in
+	return &payloadStream{payloads: c.connect}, nil
+}
+
+func (c *mockDaemonClient) Disconnect(context.Context, *pb.Empty, ...grpc.CallOption) (pb.Daemon_DisconnectClient, error) {
+	return &payloadStream{payloads: c.disconnect}, nil
+}
+
+func request(t *testing.T, g *Gateway, method string, path string, body string, token string) (int, map[string]any) {
+	t.Helper()
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	rec := httptest.NewRecorder()
+	g.ServeHTTP(rec, req)
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	return rec.Code, resp
+}
+
+func TestGateway_Authorization(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	g := New(&mockDaemonClient{}, testToken)
+
+	code, _ := request(t, g, http.MethodGet, "/v1/status", "", "")
+	assert.Equal(t, http.StatusUnauthorized, code)
+	code, _ = request(t, g, http.MethodGet, "/v1/status", "", "wrong")
+	assert.Equal(t, http.StatusUnauthorized, code)
+	code, resp := request(t, g, http.MethodGet, "/v1/status", "", testToken)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "Connected", resp["state"])
+	assert.Equal(t, "de1.nordvpn.com", resp["hostname"])
+}
+
+func TestGateway_Status(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	g := New(&mockDaemonClient{statusErr: status.Error(codes.PermissionDenied, "not allowed")}, testToken)
+
+	code, resp := request(t, g, http.MethodGet, "/v1/status", "", testToken)
+	assert.Equal(t, http.StatusForbidden, code)
+	assert.Equal(t, []any{"not allowed"}, resp["data"])
+
+	code, _ = request(t, g, http.MethodPost, "/v1/status", "", testToken)
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+}
+
+func TestGateway_Settings(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	code, resp := request(t, New(&mockDaemonClient{}, testToken), http.MethodGet, "/v1/settings", "", testToken)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "NORDLYNX", resp["technology"])
+}
+
+func TestGateway_Connect(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		body     string
+		payloads []*pb.Payload
+		code     int
+	}{
+		{
+			name: "connected",
+			body: `{"server_tag": "Germany", "server_group": "p2p"}`,
+			payloads: []*pb.Payload{
+				{Type: internal.CodeConnecting, Data: []string{"Germany #1"}},
+				{Type: internal.CodeConnected, Data: []string{"Germany #1"}},
+			},
+			code: http.StatusOK,
+		},
+		{
+			name:     "queued offline",
+			payloads: []*pb.Payload{{Type: internal.CodeQueuedOffline, Data: []string{"1"}}},
+			code:     http.StatusAccepted,
+		},
+		{
+			name:     "unknown server",
+			body:     `{"server_tag": "nowhere"}`,
+			payloads: []*pb.Payload{{Type: internal.CodeTagNonexisting}},
+			code:     http.StatusNotFound,
+		},
+		{
+			name: "canceled",
+			payloads: []*pb.Payload{
+				{Type: internal.CodeConnecting},
+				{Type: internal.CodeDisconnected},
+			},
+			code: http.StatusConflict,
+		},
+		{
+			name: "invalid body",
+			body: `{"server": "de"}`,
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "no response",
+			code: http.StatusInternalServerError,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := &mockDaemonClient{connect: test.payloads}
+			code, resp := request(t, New(client, testToken), http.MethodPost, "/v1/connect", test.body, testToken)
+			assert.Equal(t, test.code, code)
+			if len(test.payloads) > 0 {
+				last := test.payloads[len(test.payloads)-1]
+				// int64 fields are encoded as strings in the proto JSON mapping
+				assert.Equal(t, strconv.FormatInt(last.Type, 10), resp["type"])
+			}
+		})
+	}
+
+	client := &mockDaemonClient{connect: []*pb.Payload{{Type: internal.CodeConnected}}}
+	request(t, New(client, testToken), http.MethodPost, "/v1/connect",
+		`{"server_tag": "Germany", "server_group": "p2p"}`, testToken)
+	assert.Equal(t, "germany", client.connectRequest.GetServerTag())
+	assert.Equal(t, "p2p", client.connectRequest.GetServerGroup())
+}
+
+func TestGateway_Disconnect(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	client := &mockDaemonClient{disconnect: []*pb.Payload{{Type: internal.CodeDisconnected}}}
+	code, _ := request(t, New(client, testToken), http.MethodPost, "/v1/disconnect", "", testToken)
+	assert.Equal(t, http.StatusOK, code)
+
+	client = &mockDaemonClient{disconnect: []*pb.Payload{{Type: internal.CodeVPNNotRunning}}}
+	code, _ = request(t, New(client, testToken), http.MethodPost, "/v1/disconnect", "", testToken)
+	assert.Equal(t, http.StatusConflict, code)
+}
+
+func TestListen(t *testing.T) {
+	category.Set(t, category.Integration)
+
+	for _, address := range []string{"0.0.0.0:0", "192.168.1.1:0", ":0", "example.com:0"} {
+		_, err := Listen(address)
+		assert.ErrorIs(t, err, ErrNotLoopback, address)
+	}
+
+	listener, err := Listen("127.0.0.1:0")
+	require.NoError(t, err)
+	listener.Close()
+}
+
+func TestLoadToken(t *testing.T) {
+	category.Set(t, category.File)
+
+	path := filepath.Join(t.TempDir(), TokenFileName)
+	token, err := LoadToken(path)
+	require.NoError(t, err)
+	assert.Len(t, token, 2*tokenLength)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(internal.PermUserRW), info.Mode().Perm())
+
+	loaded, err := LoadToken(path)
+	require.NoError(t, err)
+	assert.Equal(t, token, loaded)
+
+	require.NoError(t, os.Chmod(path, 0644))
+	_, err = LoadToken(path)
+	assert.ErrorIs(t, err, ErrInsecureToken)
+}
@@ -12,6 +12,10 @@ const (
 	CodeProxyNone        int64 = 1005
 	CodeSuccessWithArg   int64 = 1006
 	CodeSuccessWithoutAC int64 = 1007
+	// CodeQueuedOffline is returned when the action is postponed until the network is back
+	CodeQueuedOffline int64 = 1008
+	// CodeConnectAttempt reports which of the connection attempts is started
+	CodeConnectAttempt int64 = 1009
 
 	// Warning
 	CodeNothingToDo      int64 = 2000
@@ -61,10 +65,22 @@ const (
 	CodeAllowlistPortNoop              int64 = 3047
 	CodePqAndMeshnetSimultaneously     int64 = 3048
 	CodePqWithoutNordlynx              int64 = 3049
+	CodeSplitTunnelNotSupported        int64 = 3050
+	CodeFavoriteNotFound               int64 = 3051
+	CodeProfileNotFound                int64 = 3052
+	CodeHookInvalidExecutable          int64 = 3053
+	CodeHookNotFound                   int64 = 3054
+	CodeHookFailed                     int64 = 3055
+	CodeDoubleVPNRequired              int64 = 3056
+	CodeScheduleRuleNotFound           int64 = 3057
+	CodeScheduleConflict               int64 = 3058
+	CodeTechnologyUnavailable          int64 = 3059
 )
 
 type ErrorWithCode struct {
 	Code int64
+	// Data is sent to the client together with the code
+	Data []string
 }
 
 func NewErrorWithCode(code int64) error {
@@ -19,6 +19,15 @@ const (
 	// ListenFDNames defines systemDFile descriptors names env key
 	ListenFDNames = "LISTEN_FDNAMES"
 
+	// NotifySocket defines systemd notification socket env key
+	NotifySocket = "NOTIFY_SOCKET"
+
+	// WatchdogUsec defines systemd watchdog timeout env key
+	WatchdogUsec = "WATCHDOG_USEC"
+
+	// WatchdogPID defines systemd watchdog process id env key
+	WatchdogPID = "WATCHDOG_PID"
+
 	// Proto defines protocol to be used
 	Proto = "unix"
 
@@ -162,6 +171,15 @@ func GetNorduserdSocket(uid int) string {
 	return fmt.Sprintf("/run/user/%d/%s/%s.sock", uid, Norduserd, Norduserd)
 }
 
+// GetNorduserWidgetSocket to read meshnet status by the desktop applets. It is kept in the runtime directory of
+// the user, so other users cannot create it in advance.
+func GetNorduserWidgetSocket(uid int) string {
+	if uid == 0 {
+		return fmt.Sprintf("%s/%s-widget.sock", RunDir, Norduserd)
+	}
+	return fmt.Sprintf("/run/user/%d/nordvpn/%s-widget.sock", uid, Norduserd)
+}
+
 func GetNorduserSocketFork(uid int) string {
 	return fmt.Sprintf("/tmp/%d-%s.sock", uid, Norduserd)
 }
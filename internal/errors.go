@@ -21,4 +21,6 @@ var (
 	// but is not
 	ErrNotLoggedIn           = errors.New("you are not logged in")
 	ErrVirtualServerSelected = errors.New(SpecifiedServerIsVirtualLocation)
+	// ErrTooManyRequests is returned when the client calls the daemon more often than allowed
+	ErrTooManyRequests = errors.New(TooManyRequestsErrorMessage)
 )
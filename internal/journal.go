@@ -0,0 +1,194 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// LogLevel limits which messages are logged by the user services
+type LogLevel string
+
+const (
+	LogLevelError   LogLevel = "error"
+	LogLevelWarning LogLevel = "warning"
+	LogLevelInfo    LogLevel = "info"
+	LogLevelDebug   LogLevel = "debug"
+)
+
+// DefaultLogLevel is used when the log level is not configured
+const DefaultLogLevel = LogLevelInfo
+
+// LogLevels lists the supported log levels from the least to the most verbose
+var LogLevels = []LogLevel{LogLevelError, LogLevelWarning, LogLevelInfo, LogLevelDebug}
+
+// LogLevelOrDefault returns the default log level if the level is not set
+func LogLevelOrDefault(level LogLevel) LogLevel {
+	if level == "" {
+		return DefaultLogLevel
+	}
+	return level
+}
+
+// EnvLogLevel passes the log level from norduserd to the processes it starts
+const EnvLogLevel = "NORDVPN_LOG_LEVEL"
+
+// ErrUnknownLogLevel is returned when parsing unsupported log level
+var ErrUnknownLogLevel = errors.New("unknown log level")
+
+// ParseLogLevel returns the log level matching the given name. Empty name means the default level.
+func ParseLogLevel(name string) (LogLevel, error) {
+	if name == "" {
+		return DefaultLogLevel, nil
+	}
+	for _, level := range LogLevels {
+		if string(level) == strings.ToLower(name) {
+			return level, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrUnknownLogLevel, name)
+}
+
+// journalPriority is the syslog priority of the log message
+type journalPriority int
+
+const (
+	priorityError   journalPriority = 3
+	priorityWarning journalPriority = 4
+	priorityInfo    journalPriority = 6
+	priorityDebug   journalPriority = 7
+)
+
+func (l LogLevel) maxPriority() journalPriority {
+	switch l {
+	case LogLevelError:
+		return priorityError
+	case LogLevelWarning:
+		return priorityWarning
+	case LogLevelInfo:
+		return priorityInfo
+	case LogLevelDebug:
+		return priorityDebug
+	}
+	return priorityInfo
+}
+
+// messagePriority derives the priority from the prefix used in the message
+func messagePriority(message string) journalPriority {
+	switch {
+	case strings.Contains(message, ErrorPrefix):
+		return priorityError
+	case strings.Contains(message, WarningPrefix), strings.Contains(message, DeferPrefix):
+		return priorityWarning
+	case strings.Contains(message, DebugPrefix):
+		return priorityDebug
+	}
+	return priorityInfo
+}
+
+// JournalSocket is where journald accepts log entries using its native protocol
+const JournalSocket = "/run/systemd/journal/socket"
+
+// transferIDPattern finds fileshare transfer ids in log messages
+var transferIDPattern = regexp.MustCompile(
+	`(?i)transfer(?: id)?:? ([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})`)
+
+// Journal fields added to the entries of the user services
+const (
+	JournalFieldUID        = "NORDVPN_UID"
+	JournalFieldComponent  = "NORDVPN_COMPONENT"
+	JournalFieldTransferID = "NORDVPN_TRANSFER_ID"
+)
+
+// JournalWriter is used as the output of the standard logger. It drops messages above the log level and sends
+// the rest to journald with structured fields, or to the fallback writer when journald is not available.
+type JournalWriter struct {
+	mu         sync.Mutex
+	conn       net.Conn
+	identifier string
+	fields     map[string]string
+	level      LogLevel
+	fallback   io.Writer
+}
+
+// NewJournalWriter connects to journald. Connection failure is not fatal, messages then go to the fallback
+// writer.
+func NewJournalWriter(identifier string, fields map[string]string, level LogLevel, fallback io.Writer) *JournalWriter {
+	w := &JournalWriter{
+		identifier: identifier,
+		fields:     fields,
+		level:      level,
+		fallback:   fallback,
+	}
+	if conn, err := net.Dial("unixgram", JournalSocket); err == nil {
+		w.conn = conn
+	}
+	return w
+}
+
+// Journald reports whether the messages are sent to journald
+func (w *JournalWriter) Journald() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.conn != nil
+}
+
+// SetLevel changes the log level
+func (w *JournalWriter) SetLevel(level LogLevel) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.level = level
+}
+
+func (w *JournalWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	message := strings.TrimRight(string(p), "\n")
+	priority := messagePriority(message)
+	if priority > w.level.maxPriority() {
+		return len(p), nil
+	}
+
+	if w.conn != nil {
+		if _, err := w.conn.Write(w.entry(message, priority)); err == nil {
+			return len(p), nil
+		}
+	}
+	if w.fallback == nil {
+		return len(p), nil
+	}
+	return w.fallback.Write(p)
+}
+
+// entry serializes the message using journald native protocol. Not thread safe. Lock mu before using.
+func (w *JournalWriter) entry(message string, priority journalPriority) []byte {
+	var b bytes.Buffer
+	writeJournalField(&b, "MESSAGE", message)
+	writeJournalField(&b, "PRIORITY", fmt.Sprint(priority))
+	writeJournalField(&b, "SYSLOG_IDENTIFIER", w.identifier)
+	for key, value := range w.fields {
+		writeJournalField(&b, key, value)
+	}
+	if match := transferIDPattern.FindStringSubmatch(message); match != nil {
+		writeJournalField(&b, JournalFieldTransferID, match[1])
+	}
+	return b.Bytes()
+}
+
+// writeJournalField appends KEY=value line or uses binary form for values spanning multiple lines
+func writeJournalField(b *bytes.Buffer, key string, value string) {
+	if !strings.Contains(value, "\n") {
+		b.WriteString(key + "=" + value + "\n")
+		return
+	}
+	b.WriteString(key + "\n")
+	_ = binary.Write(b, binary.LittleEndian, uint64(len(value)))
+	b.WriteString(value + "\n")
+}
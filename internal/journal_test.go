@@ -0,0 +1,103 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLogLevel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	level, err := ParseLogLevel("")
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultLogLevel, level)
+
+	level, err = ParseLogLevel("Debug")
+	assert.NoError(t, err)
+	assert.Equal(t, LogLevelDebug, level)
+
+	_, err = ParseLogLevel("verbose")
+	assert.ErrorIs(t, err, ErrUnknownLogLevel)
+}
+
+func TestJournalWriter_FiltersByLevel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var fallback bytes.Buffer
+	w := &JournalWriter{level: LogLevelWarning, fallback: &fallback}
+
+	for _, message := range []string{
+		ErrorPrefix + " error\n",
+		WarningPrefix + " warning\n",
+		InfoPrefix + " info\n",
+		"no prefix\n",
+		DebugPrefix + " debug\n",
+	} {
+		n, err := w.Write([]byte(message))
+		assert.NoError(t, err)
+		assert.Equal(t, len(message), n)
+	}
+	assert.Equal(t, ErrorPrefix+" error\n"+WarningPrefix+" warning\n", fallback.String())
+
+	fallback.Reset()
+	w.SetLevel(LogLevelDebug)
+	_, _ = w.Write([]byte(DebugPrefix + " debug\n"))
+	assert.Equal(t, DebugPrefix+" debug\n", fallback.String())
+}
+
+func TestJournalWriter_SendsStructuredEntries(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	socket := filepath.Join(t.TempDir(), "journal.sock")
+	journal, err := net.ListenPacket("unixgram", socket)
+	require.NoError(t, err)
+	defer journal.Close()
+
+	conn, err := net.Dial("unixgram", socket)
+	require.NoError(t, err)
+
+	var fallback bytes.Buffer
+	w := &JournalWriter{
+		conn:       conn,
+		identifier: "norduserd",
+		fields:     map[string]string{JournalFieldUID: "1000"},
+		level:      LogLevelInfo,
+		fallback:   &fallback,
+	}
+
+	_, err = w.Write([]byte(WarningPrefix + " failed to auto-reject transfer 0c8d0b6f-5e8a-4c1e-9b6a-2f1e2d3c4b5a: timeout\n"))
+	assert.NoError(t, err)
+	assert.Empty(t, fallback.String())
+
+	buf := make([]byte, 4096)
+	n, _, err := journal.ReadFrom(buf)
+	require.NoError(t, err)
+	entry := strings.Split(strings.TrimSuffix(string(buf[:n]), "\n"), "\n")
+	assert.ElementsMatch(t, []string{
+		"MESSAGE=" + WarningPrefix + " failed to auto-reject transfer 0c8d0b6f-5e8a-4c1e-9b6a-2f1e2d3c4b5a: timeout",
+		"PRIORITY=4",
+		"SYSLOG_IDENTIFIER=norduserd",
+		"NORDVPN_UID=1000",
+		"NORDVPN_TRANSFER_ID=0c8d0b6f-5e8a-4c1e-9b6a-2f1e2d3c4b5a",
+	}, entry)
+}
+
+func TestWriteJournalField_Multiline(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var b bytes.Buffer
+	writeJournalField(&b, "MESSAGE", "first\nsecond")
+
+	expected := bytes.NewBufferString("MESSAGE\n")
+	_ = binary.Write(expected, binary.LittleEndian, uint64(len("first\nsecond")))
+	expected.WriteString("first\nsecond\n")
+	assert.Equal(t, expected.Bytes(), b.Bytes())
+}
@@ -1,7 +1,9 @@
 package internal
 
 import (
+	"log"
 	"net"
+	"sync"
 
 	"golang.org/x/net/netutil"
 )
@@ -37,3 +39,87 @@ func (l *LimitListener) Accept() (net.Conn, error) {
 	// if all is good, pass execution down the chain
 	return conn, nil
 }
+
+// UIDLimitListener limits how many connections a single user can hold open at the same time, so
+// one local user can't use up the connections shared by every client of a world accessible socket.
+// Connections of root are not limited.
+type UIDLimitListener struct {
+	net.Listener
+	limit int
+	mu    sync.Mutex
+	conns map[uint32]int
+}
+
+func NewUIDLimitListener(l net.Listener, limit int) *UIDLimitListener {
+	return &UIDLimitListener{
+		Listener: l,
+		limit:    limit,
+		conns:    map[uint32]int{},
+	}
+}
+
+// Accept closes the connections of the users who already hold the limit and waits for the next one
+func (l *UIDLimitListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+
+		ucred, err := getUnixCreds(conn, NewLocalUserAuthenticator())
+		if err != nil {
+			log.Println(ErrorPrefix, "getting connection credentials:", err)
+			if err := conn.Close(); err != nil {
+				log.Println(WarningPrefix, err)
+			}
+			continue
+		}
+
+		if !l.acquire(ucred.Uid) {
+			log.Println(WarningPrefix, "too many connections from user", ucred.Uid)
+			if err := conn.Close(); err != nil {
+				log.Println(WarningPrefix, err)
+			}
+			continue
+		}
+		return &uidLimitConn{Conn: conn, release: func() { l.release(ucred.Uid) }}, nil
+	}
+}
+
+func (l *UIDLimitListener) acquire(uid uint32) bool {
+	if uid == 0 {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conns[uid] >= l.limit {
+		return false
+	}
+	l.conns[uid]++
+	return true
+}
+
+func (l *UIDLimitListener) release(uid uint32) {
+	if uid == 0 {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.conns[uid]--
+	if l.conns[uid] <= 0 {
+		delete(l.conns, uid)
+	}
+}
+
+// uidLimitConn gives the slot of its user back when closed
+type uidLimitConn struct {
+	net.Conn
+	releaseOnce sync.Once
+	release     func()
+}
+
+func (c *uidLimitConn) Close() error {
+	err := c.Conn.Close()
+	c.releaseOnce.Do(c.release)
+	return err
+}
@@ -0,0 +1,40 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUIDLimitListener_Limit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	l := NewUIDLimitListener(nil, 2)
+	assert.True(t, l.acquire(1000))
+	assert.True(t, l.acquire(1000))
+	assert.False(t, l.acquire(1000))
+	// other users have their own limit
+	assert.True(t, l.acquire(1001))
+	// root is not limited
+	for i := 0; i < 3; i++ {
+		assert.True(t, l.acquire(0))
+	}
+
+	l.release(1000)
+	assert.True(t, l.acquire(1000))
+	assert.False(t, l.acquire(1000))
+}
+
+func TestUIDLimitConn_CloseReleasesOnce(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	l := NewUIDLimitListener(nil, 1)
+	assert.True(t, l.acquire(1000))
+	conn := &uidLimitConn{Conn: MockNetConn{}, release: func() { l.release(1000) }}
+	assert.NoError(t, conn.Close())
+	assert.NoError(t, conn.Close())
+
+	assert.True(t, l.acquire(1000))
+	assert.False(t, l.acquire(1000))
+}
@@ -0,0 +1,137 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// LogComponent groups the packages whose debug messages can be enabled separately from the log level
+type LogComponent string
+
+const (
+	LogComponentFirewall LogComponent = "firewall"
+	LogComponentVPN      LogComponent = "vpn"
+	LogComponentMeshnet  LogComponent = "meshnet"
+	LogComponentAPI      LogComponent = "api"
+)
+
+// LogComponents lists the supported log components
+var LogComponents = []LogComponent{LogComponentFirewall, LogComponentVPN, LogComponentMeshnet, LogComponentAPI}
+
+const modulePath = "github.com/NordSecurity/nordvpn-linux/"
+
+// componentPackages maps the components to the packages, subpackages included, which belong to them
+var componentPackages = map[LogComponent][]string{
+	LogComponentFirewall: {"daemon/firewall", "ipv6"},
+	LogComponentVPN:      {"daemon/vpn", "daemon/routes", "daemon/dns", "networker", "tunnel"},
+	LogComponentMeshnet:  {"meshnet", "nc"},
+	LogComponentAPI:      {"core", "request", "auth"},
+}
+
+// ErrUnknownLogComponent is returned when parsing unsupported log component
+var ErrUnknownLogComponent = errors.New("unknown log component")
+
+// ParseLogComponents returns the sorted components matching the given names without duplicates
+func ParseLogComponents(names []string) ([]LogComponent, error) {
+	components := []LogComponent{}
+	for _, name := range names {
+		component := LogComponent(strings.ToLower(strings.TrimSpace(name)))
+		if !slices.Contains(LogComponents, component) {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownLogComponent, name)
+		}
+		if !slices.Contains(components, component) {
+			components = append(components, component)
+		}
+	}
+	slices.Sort(components)
+	return components, nil
+}
+
+// LogFilter is used as the output of the standard logger. It drops the messages above the log level, except the
+// debug messages logged by the components with debug logging enabled.
+type LogFilter struct {
+	mu         sync.RWMutex
+	out        io.Writer
+	level      LogLevel
+	components []LogComponent
+}
+
+// NewLogFilter creates a filter writing the remaining messages to out
+func NewLogFilter(out io.Writer, level LogLevel, components []LogComponent) *LogFilter {
+	return &LogFilter{out: out, level: LogLevelOrDefault(level), components: components}
+}
+
+// Set changes the log level and the components with debug logging enabled
+func (f *LogFilter) Set(level LogLevel, components []LogComponent) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.level = LogLevelOrDefault(level)
+	f.components = components
+}
+
+// Get returns the log level and the components with debug logging enabled
+func (f *LogFilter) Get() (LogLevel, []LogComponent) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.level, slices.Clone(f.components)
+}
+
+func (f *LogFilter) Write(p []byte) (int, error) {
+	f.mu.RLock()
+	level, components := f.level, f.components
+	f.mu.RUnlock()
+
+	priority := messagePriority(string(p))
+	if priority > level.maxPriority() &&
+		(priority != priorityDebug || !slices.Contains(components, callerComponent())) {
+		return len(p), nil
+	}
+	return f.out.Write(p)
+}
+
+// callerComponent finds the component of the package which called the standard logger
+func callerComponent() LogComponent {
+	pcs := make([]uintptr, 16)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	inLogger := false
+	for {
+		frame, more := frames.Next()
+		isLogger := strings.HasPrefix(frame.Function, "log.")
+		if inLogger && !isLogger {
+			return packageComponent(functionPackage(frame.Function))
+		}
+		inLogger = inLogger || isLogger
+		if !more {
+			return ""
+		}
+	}
+}
+
+// functionPackage returns the import path of the package from the fully qualified function name
+func functionPackage(function string) string {
+	slash := strings.LastIndex(function, "/")
+	if dot := strings.Index(function[slash+1:], "."); dot >= 0 {
+		return function[:slash+1+dot]
+	}
+	return function
+}
+
+func packageComponent(pkg string) LogComponent {
+	pkg, ok := strings.CutPrefix(pkg, modulePath)
+	if !ok {
+		return ""
+	}
+	for component, prefixes := range componentPackages {
+		for _, prefix := range prefixes {
+			if pkg == prefix || strings.HasPrefix(pkg, prefix+"/") {
+				return component
+			}
+		}
+	}
+	return ""
+}
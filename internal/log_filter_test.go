@@ -0,0 +1,90 @@
+package internal
+
+import (
+	"bytes"
+	"log"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLogComponents(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	components, err := ParseLogComponents([]string{"vpn", "API", "vpn"})
+	assert.NoError(t, err)
+	assert.Equal(t, []LogComponent{LogComponentAPI, LogComponentVPN}, components)
+
+	components, err = ParseLogComponents(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, components)
+
+	_, err = ParseLogComponents([]string{"tray"})
+	assert.ErrorIs(t, err, ErrUnknownLogComponent)
+}
+
+func TestLogFilter_FiltersByLevel(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	var out bytes.Buffer
+	filter := NewLogFilter(&out, LogLevelWarning, []LogComponent{LogComponentVPN})
+	logger := log.New(filter, "", 0)
+
+	logger.Println(ErrorPrefix, "error")
+	logger.Println(WarningPrefix, "warning")
+	logger.Println(InfoPrefix, "info")
+	// the test package does not belong to the vpn component
+	logger.Println(DebugPrefix, "debug")
+	assert.Equal(t, ErrorPrefix+" error\n"+WarningPrefix+" warning\n", out.String())
+
+	out.Reset()
+	filter.Set(LogLevelDebug, nil)
+	logger.Println(DebugPrefix, "debug")
+	assert.Equal(t, DebugPrefix+" debug\n", out.String())
+}
+
+func TestPackageComponent(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		function  string
+		component LogComponent
+	}{
+		{
+			function:  "github.com/NordSecurity/nordvpn-linux/daemon/firewall/iptables.(*IPTables).Add",
+			component: LogComponentFirewall,
+		},
+		{
+			function:  "github.com/NordSecurity/nordvpn-linux/networker.(*Combined).start.func1",
+			component: LogComponentVPN,
+		},
+		{
+			function:  "github.com/NordSecurity/nordvpn-linux/daemon/vpn/nordlynx.InterfaceIPv6",
+			component: LogComponentVPN,
+		},
+		{
+			function:  "github.com/NordSecurity/nordvpn-linux/meshnet.(*Server).EnableMeshnet",
+			component: LogComponentMeshnet,
+		},
+		{
+			function:  "github.com/NordSecurity/nordvpn-linux/core.(*DefaultAPI).Servers",
+			component: LogComponentAPI,
+		},
+		{
+			function: "github.com/NordSecurity/nordvpn-linux/daemon.(*RPC).Connect",
+		},
+		{
+			function: "github.com/NordSecurity/nordvpn-linux/corefoundation.Run",
+		},
+		{
+			function: "main.main",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.function, func(t *testing.T) {
+			assert.Equal(t, test.component, packageComponent(functionPackage(test.function)))
+		})
+	}
+}
@@ -15,6 +15,7 @@ const (
 	GroupNonexistentErrorMessage  = "The specified group does not exist."
 	FilterNonExistentErrorMessage = "The specified filter does not exist."
 	DoubleGroupErrorMessage       = "You cannot connect to a group and set the group option at the same time."
+	TooManyRequestsErrorMessage   = "Too many requests. Please wait a moment and try again."
 
 	DebugPrefix = "[Debug]"
 	// DeferPrefix is used when logging errors in deferred or cleanup code.
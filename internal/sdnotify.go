@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Service states sent to systemd, see
+// https://www.freedesktop.org/software/systemd/man/latest/sd_notify.html
+const (
+	SdNotifyReady    = "READY=1"
+	SdNotifyStopping = "STOPPING=1"
+	SdNotifyWatchdog = "WATCHDOG=1"
+	// SdNotifyStatus is a template of the free form status shown by systemctl status
+	SdNotifyStatus = "STATUS=%s"
+)
+
+// SdNotify sends the state to systemd. It returns false without an error when the process is
+// not run by systemd with notifications enabled.
+func SdNotify(state string) (bool, error) {
+	socket := os.Getenv(NotifySocket)
+	if socket == "" {
+		return false, nil
+	}
+	// abstract namespace sockets are passed with the @ prefix
+	if socket[0] == '@' {
+		socket = "\x00" + socket[1:]
+	}
+
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		return false, fmt.Errorf("connecting to the notification socket: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.Write([]byte(state)); err != nil {
+		return false, fmt.Errorf("sending the notification: %w", err)
+	}
+	return true, nil
+}
+
+// SdWatchdogInterval returns the watchdog timeout requested by systemd or zero if the watchdog
+// is disabled for this process.
+func SdWatchdogInterval() (time.Duration, error) {
+	usecValue := os.Getenv(WatchdogUsec)
+	if usecValue == "" {
+		return 0, nil
+	}
+
+	if pidValue := os.Getenv(WatchdogPID); pidValue != "" {
+		pid, err := strconv.Atoi(pidValue)
+		if err != nil {
+			return 0, fmt.Errorf("parsing %s: %w", WatchdogPID, err)
+		}
+		if pid != os.Getpid() {
+			return 0, nil
+		}
+	}
+
+	usec, err := strconv.ParseInt(usecValue, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing %s: %w", WatchdogUsec, err)
+	}
+	if usec <= 0 {
+		return 0, fmt.Errorf("%s must be positive", WatchdogUsec)
+	}
+	return time.Duration(usec) * time.Microsecond, nil
+}
@@ -0,0 +1,76 @@
+package internal
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSdNotify(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	t.Setenv(NotifySocket, "")
+	sent, err := SdNotify(SdNotifyReady)
+	assert.NoError(t, err)
+	assert.False(t, sent)
+
+	path := filepath.Join(t.TempDir(), "notify.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	t.Setenv(NotifySocket, path)
+	sent, err = SdNotify(SdNotifyReady)
+	assert.NoError(t, err)
+	assert.True(t, sent)
+
+	buf := make([]byte, 64)
+	assert.NoError(t, conn.SetReadDeadline(time.Now().Add(time.Second)))
+	n, err := conn.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, SdNotifyReady, string(buf[:n]))
+
+	t.Setenv(NotifySocket, filepath.Join(t.TempDir(), "missing.sock"))
+	sent, err = SdNotify(SdNotifyReady)
+	assert.Error(t, err)
+	assert.False(t, sent)
+}
+
+func TestSdWatchdogInterval(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	pid := strconv.Itoa(os.Getpid())
+	tests := []struct {
+		name     string
+		usec     string
+		pid      string
+		expected time.Duration
+		hasError bool
+	}{
+		{name: "disabled"},
+		{name: "enabled", usec: "30000000", expected: 30 * time.Second},
+		{name: "enabled for this process", usec: "30000000", pid: pid, expected: 30 * time.Second},
+		{name: "enabled for other process", usec: "30000000", pid: "1"},
+		{name: "invalid timeout", usec: "soon", hasError: true},
+		{name: "zero timeout", usec: "0", hasError: true},
+		{name: "invalid pid", usec: "30000000", pid: "main", hasError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Setenv(WatchdogUsec, test.usec)
+			t.Setenv(WatchdogPID, test.pid)
+
+			interval, err := SdWatchdogInterval()
+			assert.Equal(t, test.hasError, err != nil)
+			assert.Equal(t, test.expected, interval)
+		})
+	}
+}
@@ -108,6 +108,18 @@ func (DaemonAuthenticator) Authenticate(ucred *unix.Ucred) error {
 	return nil
 }
 
+// LocalUserAuthenticator accepts every local user, authorization of the individual calls is left
+// to the gRPC middleware
+type LocalUserAuthenticator struct{}
+
+func NewLocalUserAuthenticator() LocalUserAuthenticator {
+	return LocalUserAuthenticator{}
+}
+
+func (LocalUserAuthenticator) Authenticate(*unix.Ucred) error {
+	return nil
+}
+
 type FileshareAuthenticator struct {
 	DaemonAuthenticator
 	controllingUserUUID uint32
@@ -217,16 +229,29 @@ func StringToUcred(ucredStr string) (unix.Ucred, error) {
 // using `netutil.LimitListener` but it has internal unexported type `limitListenerConn`
 // which wraps original `net.UnixConn` value inside by embeding abstract interface `net.Conn`
 // this way we cannot access `net.UnixConn` value, because of that we use `go reflection`
-// to extract original wrapped value.
+// to extract original wrapped value. Listeners can be stacked, so the wrappers are unwrapped
+// until a connection without an embedded `Conn` is reached.
 func extractConnection(c interface{}) net.Conn {
-	v := reflect.ValueOf(c)
-	if v.Kind() == reflect.Ptr {
-		v = reflect.Indirect(v)
-	}
-	if v.Kind() == reflect.Struct {
-		y := v.FieldByName("Conn")
-		cc := y.Interface().(net.Conn)
-		return cc
+	var conn net.Conn
+	for {
+		if unixConn, ok := c.(*net.UnixConn); ok {
+			return unixConn
+		}
+		v := reflect.ValueOf(c)
+		if v.Kind() == reflect.Ptr {
+			v = reflect.Indirect(v)
+		}
+		if v.Kind() != reflect.Struct {
+			return conn
+		}
+		field := v.FieldByName("Conn")
+		if !field.IsValid() {
+			return conn
+		}
+		inner, ok := field.Interface().(net.Conn)
+		if !ok {
+			return conn
+		}
+		conn, c = inner, inner
 	}
-	return nil
 }
@@ -119,4 +119,9 @@ func Test_ExtractConnection(t *testing.T) {
 	c02 := &MockWrappConnection{Conn: c01}
 	c03 := extractConnection(c02)
 	assert.NotNil(t, c03)
+
+	// test with stacked wrappers
+	var unixConn net.Conn = &net.UnixConn{}
+	c003 := extractConnection(&MockWrappConnection{Conn: &uidLimitConn{Conn: unixConn}})
+	assert.Equal(t, unixConn, c003)
 }
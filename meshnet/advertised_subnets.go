@@ -0,0 +1,159 @@
+package meshnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/NordSecurity/nordvpn-linux/auth"
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+)
+
+// SetAdvertisedSubnets announces the subnets to the peers as reachable through this device. Peers allowed to route
+// traffic through this device can route the subnets through it while using their own VPN connection.
+func (s *Server) SetAdvertisedSubnets(
+	ctx context.Context,
+	req *pb.SetAdvertisedSubnetsRequest,
+) (*pb.AdvertisedSubnetsResponse, error) {
+	if !s.ac.IsLoggedIn() {
+		return &pb.AdvertisedSubnetsResponse{
+			Response: &pb.AdvertisedSubnetsResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+			},
+		}, nil
+	}
+
+	if !s.mc.IsRegistrationInfoCorrect() {
+		return &pb.AdvertisedSubnetsResponse{
+			Response: &pb.AdvertisedSubnetsResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_REGISTERED,
+			},
+		}, nil
+	}
+
+	var subnets []netip.Prefix
+	for _, value := range req.GetSubnets() {
+		subnet, err := netip.ParsePrefix(value)
+		if err != nil {
+			return advertisedSubnetsErrorResponse(pb.AdvertisedSubnetsErrorCode_INVALID_ADVERTISED_SUBNET), nil
+		}
+		subnets = append(subnets, subnet.Masked())
+	}
+	if err := config.ValidateAdvertisedSubnets(subnets); err != nil {
+		return advertisedSubnetsErrorResponse(advertisedSubnetsErrorToCode(err)), nil
+	}
+
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return &pb.AdvertisedSubnetsResponse{
+			Response: &pb.AdvertisedSubnetsResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	if !cfg.Mesh {
+		return &pb.AdvertisedSubnetsResponse{
+			Response: &pb.AdvertisedSubnetsResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_ENABLED,
+			},
+		}, nil
+	}
+
+	if slices.Equal(cfg.MeshDevice.AdvertisedSubnets, subnets) {
+		return advertisedSubnetsErrorResponse(pb.AdvertisedSubnetsErrorCode_ADVERTISED_SUBNETS_ALREADY_SET), nil
+	}
+
+	token := cfg.TokensData[cfg.AutoConnectData.ID].Token
+	info := mesh.MachineUpdateRequest{
+		Nickname:          cfg.MeshDevice.Nickname,
+		SupportsRouting:   true,
+		Endpoints:         cfg.MeshDevice.Endpoints,
+		AdvertisedSubnets: subnets,
+	}
+	if err := s.reg.Update(token, cfg.MeshDevice.ID, info); err != nil {
+		s.pub.Publish(fmt.Errorf("advertising subnets: %w", err))
+		if errors.Is(err, core.ErrUnauthorized) {
+			if err := s.cm.SaveWith(auth.Logout(cfg.AutoConnectData.ID)); err != nil {
+				s.pub.Publish(err)
+				return &pb.AdvertisedSubnetsResponse{
+					Response: &pb.AdvertisedSubnetsResponse_ServiceErrorCode{
+						ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+					},
+				}, nil
+			}
+			return &pb.AdvertisedSubnetsResponse{
+				Response: &pb.AdvertisedSubnetsResponse_ServiceErrorCode{
+					ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+				},
+			}, nil
+		}
+		return &pb.AdvertisedSubnetsResponse{
+			Response: &pb.AdvertisedSubnetsResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+			},
+		}, nil
+	}
+
+	if err := s.cm.SaveWith(func(c config.Config) config.Config {
+		c.MeshDevice.AdvertisedSubnets = subnets
+		return c
+	}); err != nil {
+		// subnets are advertised to the peers already, they are saved again with the next change
+		s.pub.Publish(err)
+		return &pb.AdvertisedSubnetsResponse{
+			Response: &pb.AdvertisedSubnetsResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	// firewall of the exit node is reset with the subnets from the refreshed map
+	resp, err := s.reg.Map(token, cfg.MeshDevice.ID)
+	if err != nil {
+		s.pub.Publish(err)
+		return &pb.AdvertisedSubnetsResponse{
+			Response: &pb.AdvertisedSubnetsResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+			},
+		}, nil
+	}
+
+	if err := s.netw.Refresh(*resp); err != nil {
+		s.pub.Publish(err)
+		return &pb.AdvertisedSubnetsResponse{
+			Response: &pb.AdvertisedSubnetsResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_LIB_FAILURE,
+			},
+		}, nil
+	}
+
+	return &pb.AdvertisedSubnetsResponse{
+		Response: &pb.AdvertisedSubnetsResponse_Empty{},
+	}, nil
+}
+
+func advertisedSubnetsErrorResponse(code pb.AdvertisedSubnetsErrorCode) *pb.AdvertisedSubnetsResponse {
+	return &pb.AdvertisedSubnetsResponse{
+		Response: &pb.AdvertisedSubnetsResponse_AdvertisedSubnetsErrorCode{
+			AdvertisedSubnetsErrorCode: code,
+		},
+	}
+}
+
+func advertisedSubnetsErrorToCode(err error) pb.AdvertisedSubnetsErrorCode {
+	switch {
+	case errors.Is(err, config.ErrAdvertisedSubnetsOverlap):
+		return pb.AdvertisedSubnetsErrorCode_ADVERTISED_SUBNETS_OVERLAP
+	case errors.Is(err, config.ErrAdvertisedSubnetsCount):
+		return pb.AdvertisedSubnetsErrorCode_TOO_MANY_ADVERTISED_SUBNETS
+	default:
+		return pb.AdvertisedSubnetsErrorCode_INVALID_ADVERTISED_SUBNET
+	}
+}
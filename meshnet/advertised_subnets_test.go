@@ -0,0 +1,120 @@
+package meshnet
+
+import (
+	"context"
+	"net/netip"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer_SetAdvertisedSubnets(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Mesh = true
+	cm.Cfg.MeshDevice.Nickname = "gateway"
+	reg := &mock.RegistryMock{}
+	netw := &workingNetworker{}
+	server := newPeerGroupsServer(cm, reg, netw)
+
+	resp, err := server.SetAdvertisedSubnets(context.Background(), &pb.SetAdvertisedSubnetsRequest{
+		Subnets: []string{"192.168.1.1/24", "10.10.0.0/16"},
+	})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.AdvertisedSubnetsResponse_Empty{}, resp.Response)
+
+	expected := []netip.Prefix{netip.MustParsePrefix("192.168.1.0/24"), netip.MustParsePrefix("10.10.0.0/16")}
+	assert.Equal(t, expected, cm.Cfg.MeshDevice.AdvertisedSubnets)
+	assert.Equal(t, expected, reg.CurrentMachine.AdvertisedSubnets)
+	assert.Equal(t, "gateway", reg.CurrentMachine.Nickname, "nickname should be kept")
+	assert.True(t, reg.CurrentMachine.SupportsRouting)
+	assert.Equal(t, 1, netw.refreshes)
+
+	resp, err = server.SetAdvertisedSubnets(context.Background(), &pb.SetAdvertisedSubnetsRequest{
+		Subnets: []string{"192.168.1.0/24", "10.10.0.0/16"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t,
+		pb.AdvertisedSubnetsErrorCode_ADVERTISED_SUBNETS_ALREADY_SET,
+		resp.Response.(*pb.AdvertisedSubnetsResponse_AdvertisedSubnetsErrorCode).AdvertisedSubnetsErrorCode,
+	)
+
+	resp, err = server.SetAdvertisedSubnets(context.Background(), &pb.SetAdvertisedSubnetsRequest{})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.AdvertisedSubnetsResponse_Empty{}, resp.Response)
+	assert.Empty(t, cm.Cfg.MeshDevice.AdvertisedSubnets)
+	assert.Empty(t, reg.CurrentMachine.AdvertisedSubnets)
+	assert.Equal(t, 2, netw.refreshes)
+}
+
+func TestServer_SetAdvertisedSubnets_Invalid(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		subnets  []string
+		expected pb.AdvertisedSubnetsErrorCode
+	}{
+		{
+			name:     "malformed",
+			subnets:  []string{"192.168.1.0"},
+			expected: pb.AdvertisedSubnetsErrorCode_INVALID_ADVERTISED_SUBNET,
+		},
+		{
+			name:     "meshnet subnet",
+			subnets:  []string{"100.64.0.0/16"},
+			expected: pb.AdvertisedSubnetsErrorCode_INVALID_ADVERTISED_SUBNET,
+		},
+		{
+			name:     "overlapping",
+			subnets:  []string{"10.0.0.0/8", "10.1.0.0/16"},
+			expected: pb.AdvertisedSubnetsErrorCode_ADVERTISED_SUBNETS_OVERLAP,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Mesh = true
+			netw := &workingNetworker{}
+			server := newPeerGroupsServer(cm, &mock.RegistryMock{}, netw)
+
+			resp, err := server.SetAdvertisedSubnets(context.Background(), &pb.SetAdvertisedSubnetsRequest{
+				Subnets: test.subnets,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t,
+				test.expected,
+				resp.Response.(*pb.AdvertisedSubnetsResponse_AdvertisedSubnetsErrorCode).AdvertisedSubnetsErrorCode,
+			)
+			assert.Empty(t, cm.Cfg.MeshDevice.AdvertisedSubnets)
+			assert.Equal(t, 0, netw.refreshes)
+		})
+	}
+}
+
+func TestServer_SetAdvertisedSubnets_UpdateFailure(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Mesh = true
+	netw := &workingNetworker{}
+	server := newPeerGroupsServer(cm, &mock.RegistryMock{UpdateErr: core.ErrServerInternal}, netw)
+
+	resp, err := server.SetAdvertisedSubnets(context.Background(), &pb.SetAdvertisedSubnetsRequest{
+		Subnets: []string{"192.168.1.0/24"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t,
+		pb.ServiceErrorCode_API_FAILURE,
+		resp.Response.(*pb.AdvertisedSubnetsResponse_ServiceErrorCode).ServiceErrorCode,
+	)
+	assert.Empty(t, cm.Cfg.MeshDevice.AdvertisedSubnets)
+	assert.Equal(t, 0, netw.refreshes)
+}
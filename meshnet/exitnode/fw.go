@@ -19,6 +19,9 @@ const (
 	missingRuleMessage = "Bad rule (does a matching rule exist in that chain?)"
 
 	iptablesCmd = "iptables"
+
+	// bandwidthLimitPrefix prefixes the names of the hashlimit tables, names are at most 15 characters long
+	bandwidthLimitPrefix = "nordbw"
 )
 
 type runCommandFunc func(command string, arg ...string) ([]byte, error)
@@ -209,7 +212,14 @@ func clearExitnodeForwardRules(commandFunc runCommandFunc) error {
 	return nil
 }
 
-func resetPeersTraffic(peers []TrafficPeer, interfaceNames []string, commandFunc runCommandFunc, killswitch bool) error {
+func resetPeersTraffic(
+	peers []TrafficPeer,
+	advertisedSubnets []netip.Prefix,
+	bandwidthLimits map[netip.Prefix]uint32,
+	interfaceNames []string,
+	commandFunc runCommandFunc,
+	killswitch bool,
+) error {
 	if err := clearMasquerading(commandFunc); err != nil {
 		return fmt.Errorf("clearing masquerade rules: %w", err)
 	}
@@ -272,6 +282,29 @@ func resetPeersTraffic(peers []TrafficPeer, interfaceNames []string, commandFunc
 		}
 	}
 
+	// Allow forwarding to the advertised subnets for 'routing allow' peers, also when killswitch is enabled and
+	// regardless of the local network permission
+	for _, peer := range peers {
+		if peer.Routing {
+			if err := allowAdvertisedSubnetsAccess(peer.IP, advertisedSubnets, "-I", commandFunc); err != nil {
+				return fmt.Errorf(
+					"adding rules to access advertised subnets while resetting peers traffic %v: %w",
+					peer, err,
+				)
+			}
+		}
+	}
+
+	// Drop the packets forwarded from or to the peers above their bandwidth limits, before any of them is accepted
+	for _, peer := range peers {
+		limit := bandwidthLimits[peer.IP]
+		if limit > 0 && (peer.Routing || peer.LocalNetwork) {
+			if err := limitPeerBandwidth(peer.IP, limit, "-I", commandFunc); err != nil {
+				return fmt.Errorf("adding bandwidth limit rules while resetting peers traffic %v: %w", peer, err)
+			}
+		}
+	}
+
 	// Filter FORWARD rules starts here, read bottom to top ^^
 
 	for _, peer := range peers {
@@ -313,6 +346,69 @@ func allowLocalNetworkAccess(subnet netip.Prefix, flag string, commandFunc runCo
 	return nil
 }
 
+func allowAdvertisedSubnetsAccess(
+	subnet netip.Prefix,
+	advertisedSubnets []netip.Prefix,
+	flag string,
+	commandFunc runCommandFunc,
+) error {
+	for _, advertisedSubnet := range advertisedSubnets {
+		args := fmt.Sprintf(
+			"-t filter %s FORWARD -s %s -d %s -j ACCEPT -m comment --comment %s",
+			flag,
+			subnet.String(),
+			advertisedSubnet.String(),
+			transientFilterRuleComment,
+		)
+		// #nosec G204 -- input is properly sanitized
+		out, err := commandFunc(iptablesCmd, strings.Split(args, " ")...)
+		if err != nil {
+			return fmt.Errorf("iptables modifying rule: %w: %s", err, string(out))
+		}
+	}
+
+	return nil
+}
+
+// limitPeerBandwidth drops the packets forwarded from and to the peer above the limit in kbit/s, in each direction
+// separately
+func limitPeerBandwidth(subnet netip.Prefix, limit uint32, flag string, commandFunc runCommandFunc) error {
+	if !subnet.Addr().Is4() {
+		return fmt.Errorf("bandwidth can be limited only for ipv4 peers: %s", subnet)
+	}
+	addr := subnet.Addr().As4()
+	// hashlimit rates are in bytes
+	rate := uint64(limit) * 1000 / 8
+	for _, direction := range []struct {
+		flag string
+		mode string
+		name string
+	}{
+		{flag: "-s", mode: "srcip", name: fmt.Sprintf("%su%x", bandwidthLimitPrefix, addr[:])},
+		{flag: "-d", mode: "dstip", name: fmt.Sprintf("%sd%x", bandwidthLimitPrefix, addr[:])},
+	} {
+		// iptables -t filter -I FORWARD -s 100.64.0.159/32 -m hashlimit --hashlimit-above 125000b/s --hashlimit-mode
+		// srcip --hashlimit-name nordbwu6440009f -j DROP -m comment --comment "<linux-app identifier>"
+		args := fmt.Sprintf(
+			"-t filter %s FORWARD %s %s -m hashlimit --hashlimit-above %db/s --hashlimit-mode %s --hashlimit-name %s -j DROP -m comment --comment %s",
+			flag,
+			direction.flag,
+			subnet.String(),
+			rate,
+			direction.mode,
+			direction.name,
+			transientFilterRuleComment,
+		)
+		// #nosec G204 -- input is properly sanitized
+		out, err := commandFunc(iptablesCmd, strings.Split(args, " ")...)
+		if err != nil {
+			return fmt.Errorf("iptables modifying rule: %w: %s", err, string(out))
+		}
+	}
+
+	return nil
+}
+
 func modifyPeerTraffic(subnet netip.Prefix,
 	flag string,
 	source bool,
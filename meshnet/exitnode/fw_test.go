@@ -45,7 +45,7 @@ func TestFiltering(t *testing.T) {
 	ip := netip.MustParsePrefix("100.77.1.1/32")
 
 	interfaceNames := []string{"eth0"}
-	err = resetPeersTraffic([]TrafficPeer{{ip, true, false}}, interfaceNames, commandFunc, false)
+	err = resetPeersTraffic([]TrafficPeer{{ip, true, false}}, nil, nil, interfaceNames, commandFunc, false)
 	assert.NoError(t, err)
 
 	rc, err = checkFilteringRule(ip.String(), commandFunc)
@@ -198,7 +198,7 @@ func TestResetPeersTraffic(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(fmt.Sprintf("%+v", test.peers), func(t *testing.T) {
-			err = resetPeersTraffic(test.peers, interfaceNames, commandFunc, false)
+			err = resetPeersTraffic(test.peers, nil, nil, interfaceNames, commandFunc, false)
 			assert.NoError(t, err)
 
 			for i, peer := range test.peers {
@@ -19,6 +19,10 @@ const (
 type Node interface {
 	Enable() error
 	ResetPeers(mesh.MachinePeers, bool, bool) error
+	// SetAdvertisedSubnets sets the subnets routing peers can access, applied with the next reset
+	SetAdvertisedSubnets([]netip.Prefix)
+	// SetBandwidthLimits sets the rates the peers can forward traffic at, applied with the next reset
+	SetBandwidthLimits(config.PeerBandwidthLimits)
 	ResetFirewall(lanAvailable bool, killswitch bool) error
 	Disable() error
 	SetAllowlist(config config.Allowlist, lanAvailable bool) error
@@ -31,6 +35,8 @@ type Server struct {
 	runCommandFunc   runCommandFunc
 	sysctlSetter     kernel.SysctlSetter
 	peers            mesh.MachinePeers
+	subnets          []netip.Prefix
+	bandwidthLimits  config.PeerBandwidthLimits
 	allowlistManager allowlistManager
 	enabled          bool
 }
@@ -84,22 +90,43 @@ func (en *Server) ResetPeers(peers mesh.MachinePeers, lanAvailable bool, killswi
 	return en.resetPeers(lanAvailable, killswitch)
 }
 
+// SetAdvertisedSubnets sets the subnets advertised by this device to the peers
+func (en *Server) SetAdvertisedSubnets(subnets []netip.Prefix) {
+	en.mu.Lock()
+	defer en.mu.Unlock()
+
+	en.subnets = subnets
+}
+
+// SetBandwidthLimits sets the bandwidth limits of the peers by their public keys
+func (en *Server) SetBandwidthLimits(limits config.PeerBandwidthLimits) {
+	en.mu.Lock()
+	defer en.mu.Unlock()
+
+	en.bandwidthLimits = limits
+}
+
 func (en *Server) resetPeers(lanAvailable bool, killswitch bool) error {
 	trafficPeers := make([]TrafficPeer, 0, len(en.peers))
+	bandwidthLimits := map[netip.Prefix]uint32{}
 	for _, peer := range en.peers {
 		if peer.Address.IsValid() {
+			ip := netip.PrefixFrom(peer.Address, peer.Address.BitLen())
+			if limit := en.bandwidthLimits[peer.PublicKey]; limit > 0 {
+				bandwidthLimits[ip] = limit
+			}
 			trafficPeers = append(trafficPeers, TrafficPeer{
-				netip.PrefixFrom(peer.Address, peer.Address.BitLen()),
-				peer.DoIAllowRouting,
+				IP:      ip,
+				Routing: peer.DoIAllowRouting,
 				// TODO: Remove '&& lanAvailable'
 				// According to the user-facing documentation meshnet peer local access does not depend on
 				// host VPN lan discovery or allowlists settings
-				peer.DoIAllowLocalNetwork && lanAvailable,
+				LocalNetwork: peer.DoIAllowLocalNetwork && lanAvailable,
 			})
 		}
 	}
 
-	if err := resetPeersTraffic(trafficPeers, en.interfaceNames, en.runCommandFunc, killswitch); err != nil {
+	if err := resetPeersTraffic(trafficPeers, en.subnets, bandwidthLimits, en.interfaceNames, en.runCommandFunc, killswitch); err != nil {
 		return err
 	}
 
@@ -270,6 +270,102 @@ func TestResetPeers_LANDiscoveryDisabled(t *testing.T) {
 		strings.Join(expectedCommands, "\n"), strings.Join(commandExecutor.executedCommands, "\n"))
 }
 
+func TestResetPeers_AdvertisedSubnets(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	peers := mesh.MachinePeers{
+		{
+			Address:         netip.MustParseAddr("100.64.0.1"),
+			DoIAllowRouting: true,
+		},
+		{
+			Address:              netip.MustParseAddr("100.64.0.2"),
+			DoIAllowLocalNetwork: true,
+		},
+	}
+	interfaces := []string{"eth0"}
+	commandExecutor := CommandExecutorMock{}
+	server := NewServer(interfaces, commandExecutor.Execute, config.Allowlist{}, &mock.SysctlSetterMock{})
+	server.SetAdvertisedSubnets([]netip.Prefix{
+		netip.MustParsePrefix("192.168.1.0/24"),
+		netip.MustParsePrefix("10.10.0.0/16"),
+	})
+
+	err := server.ResetPeers(peers, true, true)
+	assert.NoError(t, err)
+
+	expectedCommands := []string{
+		"iptables -t nat -S POSTROUTING",
+		"iptables -S FORWARD",
+		"iptables -t filter -I FORWARD -s 100.64.0.1/32 -j ACCEPT -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -D FORWARD -s 100.64.0.0/10 -d 10.0.0.0/8 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -I FORWARD -s 100.64.0.0/10 -d 10.0.0.0/8 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -D FORWARD -s 100.64.0.0/10 -d 172.16.0.0/12 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -I FORWARD -s 100.64.0.0/10 -d 172.16.0.0/12 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -D FORWARD -s 100.64.0.0/10 -d 192.168.0.0/16 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -I FORWARD -s 100.64.0.0/10 -d 192.168.0.0/16 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -D FORWARD -s 100.64.0.0/10 -d 169.254.0.0/16 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -I FORWARD -s 100.64.0.0/10 -d 169.254.0.0/16 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -I FORWARD -o eth0 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -I FORWARD -s 100.64.0.2/32 -d 10.0.0.0/8 -j ACCEPT -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -I FORWARD -s 100.64.0.2/32 -d 172.16.0.0/12 -j ACCEPT -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -I FORWARD -s 100.64.0.2/32 -d 192.168.0.0/16 -j ACCEPT -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -I FORWARD -s 100.64.0.2/32 -d 169.254.0.0/16 -j ACCEPT -m comment --comment nordvpn-exitnode-transient",
+		// only the peer allowed to route can access the advertised subnets, even with killswitch enabled
+		"iptables -t filter -I FORWARD -s 100.64.0.1/32 -d 192.168.1.0/24 -j ACCEPT -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -I FORWARD -s 100.64.0.1/32 -d 10.10.0.0/16 -j ACCEPT -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t nat -A POSTROUTING -s 100.64.0.1/32 ! -d 100.64.0.0/10 -j MASQUERADE -m comment --comment nordvpn",
+	}
+
+	assert.Equal(t, expectedCommands, commandExecutor.executedCommands,
+		"Firewall was configured incorrectly after meshnet peer restart.\n\nEXPECTED:\n%s\n\nGOT:\n%s",
+		strings.Join(expectedCommands, "\n"), strings.Join(commandExecutor.executedCommands, "\n"))
+}
+
+func TestResetPeers_BandwidthLimits(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	peers := mesh.MachinePeers{
+		{
+			PublicKey:       "routing",
+			Address:         netip.MustParseAddr("100.64.0.1"),
+			DoIAllowRouting: true,
+		},
+		{
+			PublicKey: "not-forwarding",
+			Address:   netip.MustParseAddr("100.64.0.2"),
+		},
+	}
+	commandExecutor := CommandExecutorMock{}
+	server := NewServer([]string{"eth0"}, commandExecutor.Execute, config.Allowlist{}, &mock.SysctlSetterMock{})
+	server.SetBandwidthLimits(config.PeerBandwidthLimits{"routing": 8000, "not-forwarding": 8000})
+
+	err := server.ResetPeers(peers, true, false)
+	assert.NoError(t, err)
+
+	expectedCommands := []string{
+		"iptables -t nat -S POSTROUTING",
+		"iptables -S FORWARD",
+		"iptables -t filter -I FORWARD -s 100.64.0.1/32 -j ACCEPT -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -D FORWARD -s 100.64.0.0/10 -d 10.0.0.0/8 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -I FORWARD -s 100.64.0.0/10 -d 10.0.0.0/8 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -D FORWARD -s 100.64.0.0/10 -d 172.16.0.0/12 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -I FORWARD -s 100.64.0.0/10 -d 172.16.0.0/12 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -D FORWARD -s 100.64.0.0/10 -d 192.168.0.0/16 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -I FORWARD -s 100.64.0.0/10 -d 192.168.0.0/16 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -D FORWARD -s 100.64.0.0/10 -d 169.254.0.0/16 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -I FORWARD -s 100.64.0.0/10 -d 169.254.0.0/16 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		// packets above the limit are dropped before any of them is accepted, 8000 kbit/s is 1000000 bytes/s
+		"iptables -t filter -I FORWARD -s 100.64.0.1/32 -m hashlimit --hashlimit-above 1000000b/s --hashlimit-mode srcip --hashlimit-name nordbwu64400001 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t filter -I FORWARD -d 100.64.0.1/32 -m hashlimit --hashlimit-above 1000000b/s --hashlimit-mode dstip --hashlimit-name nordbwd64400001 -j DROP -m comment --comment nordvpn-exitnode-transient",
+		"iptables -t nat -A POSTROUTING -s 100.64.0.1/32 ! -d 100.64.0.0/10 -j MASQUERADE -m comment --comment nordvpn",
+	}
+
+	assert.Equal(t, expectedCommands, commandExecutor.executedCommands,
+		"Firewall was configured incorrectly after meshnet peer restart.\n\nEXPECTED:\n%s\n\nGOT:\n%s",
+		strings.Join(expectedCommands, "\n"), strings.Join(commandExecutor.executedCommands, "\n"))
+}
+
 func TestSetAllowlist(t *testing.T) {
 	category.Set(t, category.Unit)
 
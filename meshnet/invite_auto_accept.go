@@ -0,0 +1,157 @@
+package meshnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+)
+
+// SetAutoAcceptInvites sets the policy of accepting the invites from the devices of the same account. The invites
+// from the other accounts are not affected and still have to be accepted manually.
+func (s *Server) SetAutoAcceptInvites(
+	ctx context.Context,
+	req *pb.SetAutoAcceptInvitesRequest,
+) (*pb.AutoAcceptInvitesResponse, error) {
+	if !s.ac.IsLoggedIn() {
+		return &pb.AutoAcceptInvitesResponse{
+			Response: &pb.AutoAcceptInvitesResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+			},
+		}, nil
+	}
+
+	if !s.mc.IsRegistrationInfoCorrect() {
+		return &pb.AutoAcceptInvitesResponse{
+			Response: &pb.AutoAcceptInvitesResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_REGISTERED,
+			},
+		}, nil
+	}
+
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return &pb.AutoAcceptInvitesResponse{
+			Response: &pb.AutoAcceptInvitesResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	if !cfg.Mesh {
+		return &pb.AutoAcceptInvitesResponse{
+			Response: &pb.AutoAcceptInvitesResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_ENABLED,
+			},
+		}, nil
+	}
+
+	var policy *config.InviteAutoAccept
+	if req.GetEnabled() {
+		policy = &config.InviteAutoAccept{
+			AllowIncomingTraffic: req.GetAllowIncomingTraffic(),
+			AllowTrafficRouting:  req.GetAllowTrafficRouting(),
+			AllowLocalNetwork:    req.GetAllowLocalNetwork(),
+			AllowFileshare:       req.GetAllowFileshare(),
+		}
+	}
+
+	if err := s.cm.SaveWith(func(c config.Config) config.Config {
+		c.Meshnet.InviteAutoAccept = policy
+		return c
+	}); err != nil {
+		s.pub.Publish(err)
+		return &pb.AutoAcceptInvitesResponse{
+			Response: &pb.AutoAcceptInvitesResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	// invites received before enabling the policy are accepted right away instead of waiting for the job
+	if policy != nil {
+		if err := s.acceptOwnInvites(); err != nil {
+			s.pub.Publish(err)
+		}
+	}
+
+	return &pb.AutoAcceptInvitesResponse{
+		Response: &pb.AutoAcceptInvitesResponse_Empty{},
+	}, nil
+}
+
+// acceptOwnInvites accepts the received invites sent from the devices of the same account if the policy is set.
+// Daemon does not know the email of the account, so it is taken from the local peers, and nothing is accepted while
+// there are none.
+func (s *Server) acceptOwnInvites() error {
+	if !s.ac.IsLoggedIn() || !s.mc.IsRegistrationInfoCorrect() {
+		return nil
+	}
+
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		return fmt.Errorf("reading configuration when accepting invites: %w", err)
+	}
+
+	policy := cfg.Meshnet.InviteAutoAccept
+	if !cfg.Mesh || policy == nil {
+		return nil
+	}
+
+	token := cfg.TokensData[cfg.AutoConnectData.ID].Token
+	received, err := s.invitationAPI.Received(token, cfg.MeshDevice.ID)
+	if err != nil {
+		return fmt.Errorf("listing received invitations: %w", err)
+	}
+	if len(received) == 0 {
+		return nil
+	}
+
+	peers, err := s.listPeers()
+	if err != nil {
+		return err
+	}
+	ownEmails := map[string]bool{}
+	for _, peer := range peers {
+		if peer.IsLocal && peer.Email != "" {
+			ownEmails[strings.ToLower(peer.Email)] = true
+		}
+	}
+
+	var errs []error
+	accepted := 0
+	for _, invitation := range received {
+		if !ownEmails[strings.ToLower(invitation.Email)] {
+			continue
+		}
+		if err := s.invitationAPI.Accept(
+			token,
+			cfg.MeshDevice.ID,
+			invitation.ID,
+			policy.AllowIncomingTraffic,
+			policy.AllowTrafficRouting,
+			policy.AllowLocalNetwork,
+			policy.AllowFileshare,
+		); err != nil {
+			errs = append(errs, fmt.Errorf("accepting invitation from %s: %w", invitation.Email, err))
+			continue
+		}
+		accepted++
+	}
+
+	if accepted > 0 {
+		resp, err := s.reg.Map(token, cfg.MeshDevice.ID)
+		if err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+		if err := s.netw.Refresh(*resp); err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
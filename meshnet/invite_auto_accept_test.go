@@ -0,0 +1,107 @@
+package meshnet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	daemonevents "github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/sharedctx"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnorduser "github.com/NordSecurity/nordvpn-linux/test/mock/norduser/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type autoAcceptInvitationsAPI struct {
+	invitationsAPI
+	received mesh.Invitations
+	accepted []uuid.UUID
+	routing  bool
+}
+
+func (i *autoAcceptInvitationsAPI) Received(string, uuid.UUID) (mesh.Invitations, error) {
+	return i.received, nil
+}
+
+func (i *autoAcceptInvitationsAPI) Accept(_ string, _ uuid.UUID, invitation uuid.UUID, _, routing, _, _ bool) error {
+	i.accepted = append(i.accepted, invitation)
+	i.routing = routing
+	return nil
+}
+
+func newAutoAcceptServer(cm *mock.ConfigManager, inv mesh.Inviter, netw Networker) *Server {
+	return NewServer(
+		meshRenewChecker{},
+		cm,
+		registrationChecker{},
+		inv,
+		netw,
+		&mock.RegistryMock{Peers: mesh.MachinePeers{
+			{ID: uuid.MustParse(exampleUUID1), Email: "Owner@nordvpn.com", IsLocal: true},
+			{ID: uuid.MustParse(exampleUUID2), Email: "friend@nordvpn.com"},
+		}},
+		&mock.DNSGetter{},
+		&subs.Subject[error]{},
+		&subs.Subject[[]string]{},
+		&daemonevents.Events{Settings: &daemonevents.SettingsEvents{Meshnet: &daemonevents.MockPublisherSubscriber[bool]{}}},
+		testnorduser.NewMockNorduserClient(nil),
+		sharedctx.New(),
+	)
+}
+
+func TestServer_SetAutoAcceptInvites(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	own := uuid.MustParse(exampleUUID3)
+	inv := &autoAcceptInvitationsAPI{received: mesh.Invitations{
+		{ID: own, Email: "owner@nordvpn.com"},
+		{ID: uuid.New(), Email: "friend@nordvpn.com"},
+		{ID: uuid.New(), Email: "stranger@nordvpn.com"},
+	}}
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Mesh = true
+	netw := &workingNetworker{}
+	server := newAutoAcceptServer(cm, inv, netw)
+
+	resp, err := server.SetAutoAcceptInvites(context.Background(), &pb.SetAutoAcceptInvitesRequest{
+		Enabled:             true,
+		AllowTrafficRouting: true,
+	})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.AutoAcceptInvitesResponse_Empty{}, resp.Response)
+	assert.Equal(t, &config.InviteAutoAccept{AllowTrafficRouting: true}, cm.Cfg.Meshnet.InviteAutoAccept)
+	assert.Equal(t, []uuid.UUID{own}, inv.accepted, "only the invite from the same account should be accepted")
+	assert.True(t, inv.routing)
+	assert.Equal(t, 1, netw.refreshes)
+
+	resp, err = server.SetAutoAcceptInvites(context.Background(), &pb.SetAutoAcceptInvitesRequest{})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.AutoAcceptInvitesResponse_Empty{}, resp.Response)
+	assert.Nil(t, cm.Cfg.Meshnet.InviteAutoAccept)
+
+	assert.NoError(t, server.acceptOwnInvites())
+	assert.Len(t, inv.accepted, 1, "invites should not be accepted after disabling the policy")
+}
+
+func TestServer_AcceptOwnInvites_NoLocalPeers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	inv := &autoAcceptInvitationsAPI{received: mesh.Invitations{
+		{ID: uuid.New(), Email: "friend@nordvpn.com"},
+	}}
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Mesh = true
+	cm.Cfg.Meshnet.InviteAutoAccept = &config.InviteAutoAccept{}
+	netw := &workingNetworker{}
+	server := newAutoAcceptServer(cm, inv, netw)
+
+	assert.NoError(t, server.acceptOwnInvites())
+	assert.Empty(t, inv.accepted)
+	assert.Equal(t, 0, netw.refreshes)
+}
@@ -0,0 +1,243 @@
+package meshnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/auth"
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	inviteCodePayloadScheme = "nordvpn"
+	inviteCodePayloadHost   = "meshnet"
+	inviteCodePayloadPath   = "/join"
+	inviteCodePayloadQuery  = "code"
+)
+
+// InviteCodePayload returns the content of the QR code for the given invite code
+func InviteCodePayload(code string) string {
+	u := url.URL{
+		Scheme:   inviteCodePayloadScheme,
+		Host:     inviteCodePayloadHost,
+		Path:     inviteCodePayloadPath,
+		RawQuery: url.Values{inviteCodePayloadQuery: []string{code}}.Encode(),
+	}
+	return u.String()
+}
+
+// inviteCodeFromPayload extracts the invite code from the QR code payload. Plain codes are returned as
+// they are.
+func inviteCodeFromPayload(payload string) string {
+	payload = strings.TrimSpace(payload)
+	u, err := url.Parse(payload)
+	if err != nil || u.Scheme != inviteCodePayloadScheme {
+		return payload
+	}
+	if u.Host != inviteCodePayloadHost || u.Path != inviteCodePayloadPath {
+		return ""
+	}
+	return u.Query().Get(inviteCodePayloadQuery)
+}
+
+// CreateInviteCode creates a short-lived code which lets another device join the meshnet without an
+// email invitation
+func (s *Server) CreateInviteCode(
+	ctx context.Context,
+	req *pb.CreateInviteCodeRequest,
+) (*pb.CreateInviteCodeResponse, error) {
+	if !s.ac.IsLoggedIn() {
+		return &pb.CreateInviteCodeResponse{
+			Response: &pb.CreateInviteCodeResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+			},
+		}, nil
+	}
+
+	if !s.mc.IsRegistrationInfoCorrect() {
+		return &pb.CreateInviteCodeResponse{
+			Response: &pb.CreateInviteCodeResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_REGISTERED,
+			},
+		}, nil
+	}
+
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return &pb.CreateInviteCodeResponse{
+			Response: &pb.CreateInviteCodeResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	if !cfg.Mesh {
+		return &pb.CreateInviteCodeResponse{
+			Response: &pb.CreateInviteCodeResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_ENABLED,
+			},
+		}, nil
+	}
+
+	tokenData := cfg.TokensData[cfg.AutoConnectData.ID]
+	code, err := s.invitationAPI.CreateInviteCode(
+		tokenData.Token,
+		cfg.MeshDevice.ID,
+		req.GetAllowIncomingTraffic(),
+		req.GetAllowTrafficRouting(),
+		req.GetAllowLocalNetwork(),
+		req.GetAllowFileshare(),
+	)
+	if err != nil {
+		s.pub.Publish(fmt.Errorf("creating invite code: %w", err))
+		inviteCodeErr, serviceErr := s.inviteCodeError(err, cfg)
+		if inviteCodeErr != nil {
+			return &pb.CreateInviteCodeResponse{
+				Response: &pb.CreateInviteCodeResponse_InviteCodeErrorCode{
+					InviteCodeErrorCode: *inviteCodeErr,
+				},
+			}, nil
+		}
+		return &pb.CreateInviteCodeResponse{
+			Response: &pb.CreateInviteCodeResponse_ServiceErrorCode{
+				ServiceErrorCode: serviceErr,
+			},
+		}, nil
+	}
+
+	return &pb.CreateInviteCodeResponse{
+		Response: &pb.CreateInviteCodeResponse_InviteCode{
+			InviteCode: &pb.InviteCode{
+				Code:      code.Code,
+				Payload:   InviteCodePayload(code.Code),
+				ExpiresAt: timestamppb.New(code.ExpiresAt),
+			},
+		},
+	}, nil
+}
+
+// RedeemInviteCode joins the meshnet of the device which created the invite code. Either the code
+// itself or the QR code payload is accepted.
+func (s *Server) RedeemInviteCode(
+	ctx context.Context,
+	req *pb.RedeemInviteCodeRequest,
+) (*pb.RedeemInviteCodeResponse, error) {
+	if !s.ac.IsLoggedIn() {
+		return &pb.RedeemInviteCodeResponse{
+			Response: &pb.RedeemInviteCodeResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+			},
+		}, nil
+	}
+
+	if !s.mc.IsRegistrationInfoCorrect() {
+		return &pb.RedeemInviteCodeResponse{
+			Response: &pb.RedeemInviteCodeResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_REGISTERED,
+			},
+		}, nil
+	}
+
+	code := inviteCodeFromPayload(req.GetCode())
+	if code == "" {
+		return &pb.RedeemInviteCodeResponse{
+			Response: &pb.RedeemInviteCodeResponse_InviteCodeErrorCode{
+				InviteCodeErrorCode: pb.InviteCodeErrorCode_INVALID_INVITE_CODE,
+			},
+		}, nil
+	}
+
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return &pb.RedeemInviteCodeResponse{
+			Response: &pb.RedeemInviteCodeResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	if !cfg.Mesh {
+		return &pb.RedeemInviteCodeResponse{
+			Response: &pb.RedeemInviteCodeResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_ENABLED,
+			},
+		}, nil
+	}
+
+	tokenData := cfg.TokensData[cfg.AutoConnectData.ID]
+	err := s.invitationAPI.RedeemInviteCode(
+		tokenData.Token,
+		cfg.MeshDevice.ID,
+		code,
+		req.GetAllowIncomingTraffic(),
+		req.GetAllowTrafficRouting(),
+		req.GetAllowLocalNetwork(),
+		req.GetAllowFileshare(),
+	)
+	if err != nil {
+		s.pub.Publish(fmt.Errorf("redeeming invite code: %w", err))
+		inviteCodeErr, serviceErr := s.inviteCodeError(err, cfg)
+		if inviteCodeErr != nil {
+			return &pb.RedeemInviteCodeResponse{
+				Response: &pb.RedeemInviteCodeResponse_InviteCodeErrorCode{
+					InviteCodeErrorCode: *inviteCodeErr,
+				},
+			}, nil
+		}
+		return &pb.RedeemInviteCodeResponse{
+			Response: &pb.RedeemInviteCodeResponse_ServiceErrorCode{
+				ServiceErrorCode: serviceErr,
+			},
+		}, nil
+	}
+
+	resp, err := s.reg.Map(tokenData.Token, cfg.MeshDevice.ID)
+	if err != nil {
+		s.pub.Publish(err)
+		return &pb.RedeemInviteCodeResponse{
+			Response: &pb.RedeemInviteCodeResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+			},
+		}, nil
+	}
+
+	if err := s.netw.Refresh(*resp); err != nil {
+		s.pub.Publish(err)
+		return &pb.RedeemInviteCodeResponse{
+			Response: &pb.RedeemInviteCodeResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_LIB_FAILURE,
+			},
+		}, nil
+	}
+
+	return &pb.RedeemInviteCodeResponse{
+		Response: &pb.RedeemInviteCodeResponse_Empty{},
+	}, nil
+}
+
+// inviteCodeError converts the invite code API error to either the invite code specific error code or
+// the service error code. User is logged out when the token is no longer valid.
+func (s *Server) inviteCodeError(err error, cfg config.Config) (*pb.InviteCodeErrorCode, pb.ServiceErrorCode) {
+	switch {
+	case errors.Is(err, core.ErrNotFound), errors.Is(err, core.ErrBadRequest):
+		return pb.InviteCodeErrorCode_INVALID_INVITE_CODE.Enum(), 0
+	case errors.Is(err, core.ErrMaximumDeviceCount):
+		return pb.InviteCodeErrorCode_INVITE_CODE_PEER_COUNT.Enum(), 0
+	case errors.Is(err, core.ErrUnauthorized):
+		if err := s.cm.SaveWith(auth.Logout(cfg.AutoConnectData.ID)); err != nil {
+			s.pub.Publish(err)
+			return nil, pb.ServiceErrorCode_CONFIG_FAILURE
+		}
+		return nil, pb.ServiceErrorCode_NOT_LOGGED_IN
+	default:
+		return nil, pb.ServiceErrorCode_API_FAILURE
+	}
+}
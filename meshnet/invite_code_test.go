@@ -0,0 +1,198 @@
+package meshnet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	daemonevents "github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/sharedctx"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnorduser "github.com/NordSecurity/nordvpn-linux/test/mock/norduser/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type inviteCodeAPI struct {
+	invitationsAPI
+	err      error
+	redeemed string
+}
+
+func (i *inviteCodeAPI) CreateInviteCode(string, uuid.UUID, bool, bool, bool, bool) (*mesh.InviteCode, error) {
+	if i.err != nil {
+		return nil, i.err
+	}
+	return &mesh.InviteCode{Code: "ABCD-1234", ExpiresAt: time.Unix(1700000000, 0)}, nil
+}
+
+func (i *inviteCodeAPI) RedeemInviteCode(_ string, _ uuid.UUID, code string, _, _, _, _ bool) error {
+	if i.err != nil {
+		return i.err
+	}
+	i.redeemed = code
+	return nil
+}
+
+func newInviteCodeServer(inv mesh.Inviter, netw Networker) *Server {
+	server := NewServer(
+		meshRenewChecker{},
+		&mock.ConfigManager{},
+		registrationChecker{},
+		inv,
+		netw,
+		&mock.RegistryMock{},
+		&mock.DNSGetter{},
+		&subs.Subject[error]{},
+		&subs.Subject[[]string]{},
+		&daemonevents.Events{Settings: &daemonevents.SettingsEvents{Meshnet: &daemonevents.MockPublisherSubscriber[bool]{}}},
+		testnorduser.NewMockNorduserClient(nil),
+		sharedctx.New(),
+	)
+	server.EnableMeshnet(context.Background(), &pb.Empty{})
+	return server
+}
+
+func TestInviteCodeFromPayload(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		payload  string
+		expected string
+	}{
+		{name: "plain code", payload: "ABCD-1234", expected: "ABCD-1234"},
+		{name: "surrounding whitespace", payload: " ABCD-1234\n", expected: "ABCD-1234"},
+		{name: "qr payload", payload: InviteCodePayload("ABCD-1234"), expected: "ABCD-1234"},
+		{name: "unknown path", payload: "nordvpn://meshnet/leave?code=ABCD-1234", expected: ""},
+		{name: "missing code", payload: "nordvpn://meshnet/join", expected: ""},
+		{name: "empty", payload: "", expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, inviteCodeFromPayload(test.payload))
+		})
+	}
+}
+
+func TestServer_CreateInviteCode(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	server := newInviteCodeServer(&inviteCodeAPI{}, &workingNetworker{})
+	resp, err := server.CreateInviteCode(context.Background(), &pb.CreateInviteCodeRequest{})
+	assert.NoError(t, err)
+
+	code := resp.GetInviteCode()
+	assert.NotNil(t, code)
+	assert.Equal(t, "ABCD-1234", code.GetCode())
+	assert.Equal(t, "nordvpn://meshnet/join?code=ABCD-1234", code.GetPayload())
+	assert.Equal(t, int64(1700000000), code.GetExpiresAt().GetSeconds())
+}
+
+func TestServer_CreateInviteCode_Errors(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name            string
+		err             error
+		expectedInvite  *pb.InviteCodeErrorCode
+		expectedService pb.ServiceErrorCode
+	}{
+		{
+			name:           "device count",
+			err:            core.ErrMaximumDeviceCount,
+			expectedInvite: pb.InviteCodeErrorCode_INVITE_CODE_PEER_COUNT.Enum(),
+		},
+		{
+			name:            "unauthorized",
+			err:             core.ErrUnauthorized,
+			expectedService: pb.ServiceErrorCode_NOT_LOGGED_IN,
+		},
+		{
+			name:            "api failure",
+			err:             core.ErrServerInternal,
+			expectedService: pb.ServiceErrorCode_API_FAILURE,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := newInviteCodeServer(&inviteCodeAPI{err: test.err}, &workingNetworker{})
+			resp, err := server.CreateInviteCode(context.Background(), &pb.CreateInviteCodeRequest{})
+			assert.NoError(t, err)
+			if test.expectedInvite != nil {
+				assert.Equal(t,
+					*test.expectedInvite,
+					resp.Response.(*pb.CreateInviteCodeResponse_InviteCodeErrorCode).InviteCodeErrorCode,
+				)
+				return
+			}
+			assert.Equal(t,
+				test.expectedService,
+				resp.Response.(*pb.CreateInviteCodeResponse_ServiceErrorCode).ServiceErrorCode,
+			)
+		})
+	}
+}
+
+func TestServer_RedeemInviteCode(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		code     string
+		redeemed string
+	}{
+		{name: "plain code", code: "ABCD-1234", redeemed: "ABCD-1234"},
+		{name: "qr payload", code: "nordvpn://meshnet/join?code=ABCD-1234", redeemed: "ABCD-1234"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			inv := &inviteCodeAPI{}
+			netw := &workingNetworker{}
+			server := newInviteCodeServer(inv, netw)
+			resp, err := server.RedeemInviteCode(context.Background(), &pb.RedeemInviteCodeRequest{Code: test.code})
+			assert.NoError(t, err)
+			assert.IsType(t, &pb.RedeemInviteCodeResponse_Empty{}, resp.Response)
+			assert.Equal(t, test.redeemed, inv.redeemed)
+			assert.Equal(t, 1, netw.refreshes)
+		})
+	}
+}
+
+func TestServer_RedeemInviteCode_Invalid(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name string
+		code string
+		err  error
+	}{
+		{name: "empty code", code: ""},
+		{name: "malformed payload", code: "nordvpn://meshnet/join"},
+		{name: "unknown code", code: "ABCD-1234", err: core.ErrNotFound},
+		{name: "expired code", code: "ABCD-1234", err: core.ErrBadRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			netw := &workingNetworker{}
+			server := newInviteCodeServer(&inviteCodeAPI{err: test.err}, netw)
+			resp, err := server.RedeemInviteCode(context.Background(), &pb.RedeemInviteCodeRequest{Code: test.code})
+			assert.NoError(t, err)
+			assert.Equal(t,
+				pb.InviteCodeErrorCode_INVALID_INVITE_CODE,
+				resp.Response.(*pb.RedeemInviteCodeResponse_InviteCodeErrorCode).InviteCodeErrorCode,
+			)
+			assert.Equal(t, 0, netw.refreshes)
+		})
+	}
+}
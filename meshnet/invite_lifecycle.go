@@ -0,0 +1,182 @@
+package meshnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+
+	"golang.org/x/exp/slices"
+)
+
+// ResendInvite sends the invite to the email again with the same permissions. API does not renew the invitations,
+// so the old one is revoked first and the new one is valid for the currently configured TTL.
+func (s *Server) ResendInvite(
+	ctx context.Context,
+	req *pb.DenyInviteRequest,
+) (*pb.InviteResponse, error) {
+	if !s.ac.IsLoggedIn() {
+		return &pb.InviteResponse{
+			Response: &pb.InviteResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+			},
+		}, nil
+	}
+
+	if !s.mc.IsRegistrationInfoCorrect() {
+		return &pb.InviteResponse{
+			Response: &pb.InviteResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_REGISTERED,
+			},
+		}, nil
+	}
+
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return &pb.InviteResponse{
+			Response: &pb.InviteResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	if !cfg.Mesh {
+		return &pb.InviteResponse{
+			Response: &pb.InviteResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_ENABLED,
+			},
+		}, nil
+	}
+
+	tokenData := cfg.TokensData[cfg.AutoConnectData.ID]
+	sent, err := s.invitationAPI.Sent(tokenData.Token, cfg.MeshDevice.ID)
+	if err != nil {
+		if errors.Is(err, core.ErrUnauthorized) {
+			return s.inviteErrorResponse(err, cfg.AutoConnectData.ID), nil
+		}
+		s.pub.Publish(fmt.Errorf("listing invitations: %w", err))
+		return &pb.InviteResponse{
+			Response: &pb.InviteResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+			},
+		}, nil
+	}
+
+	index := slices.IndexFunc(sent, func(i mesh.Invitation) bool {
+		return i.Email == req.GetEmail()
+	})
+	if index == -1 {
+		return &pb.InviteResponse{
+			Response: &pb.InviteResponse_InviteResponseErrorCode{
+				InviteResponseErrorCode: pb.InviteResponseErrorCode_INVITE_NOT_FOUND,
+			},
+		}, nil
+	}
+
+	invitation := sent[index]
+	if err := s.invitationAPI.Revoke(tokenData.Token, cfg.MeshDevice.ID, invitation.ID); err != nil {
+		s.pub.Publish(fmt.Errorf("revoking invitation: %w", err))
+		return &pb.InviteResponse{
+			Response: &pb.InviteResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+			},
+		}, nil
+	}
+
+	if err := s.invitationAPI.Invite(
+		tokenData.Token,
+		cfg.MeshDevice.ID,
+		invitation.Email,
+		cfg.Meshnet.InviteTTL,
+		invitation.AllowInbound,
+		invitation.AllowRouting,
+		invitation.AllowLocalNetwork,
+		invitation.AllowFileshare,
+	); err != nil {
+		return s.inviteErrorResponse(err, cfg.AutoConnectData.ID), nil
+	}
+
+	return &pb.InviteResponse{
+		Response: &pb.InviteResponse_Empty{},
+	}, nil
+}
+
+// SetInviteTTL sets how long the invites sent from now on stay valid. The invites which are already sent keep their
+// expiry until they are resent.
+func (s *Server) SetInviteTTL(
+	ctx context.Context,
+	req *pb.SetInviteTTLRequest,
+) (*pb.InviteTTLResponse, error) {
+	if !s.ac.IsLoggedIn() {
+		return &pb.InviteTTLResponse{
+			Response: &pb.InviteTTLResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+			},
+		}, nil
+	}
+
+	if !s.mc.IsRegistrationInfoCorrect() {
+		return &pb.InviteTTLResponse{
+			Response: &pb.InviteTTLResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_REGISTERED,
+			},
+		}, nil
+	}
+
+	ttl := time.Duration(req.GetTtl()) * time.Second
+	if err := config.ValidateInviteTTL(ttl); err != nil {
+		return &pb.InviteTTLResponse{
+			Response: &pb.InviteTTLResponse_InviteTtlErrorCode{
+				InviteTtlErrorCode: pb.InviteTTLErrorCode_INVALID_INVITE_TTL,
+			},
+		}, nil
+	}
+
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return &pb.InviteTTLResponse{
+			Response: &pb.InviteTTLResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	if !cfg.Mesh {
+		return &pb.InviteTTLResponse{
+			Response: &pb.InviteTTLResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_ENABLED,
+			},
+		}, nil
+	}
+
+	if cfg.Meshnet.InviteTTL == ttl {
+		return &pb.InviteTTLResponse{
+			Response: &pb.InviteTTLResponse_InviteTtlErrorCode{
+				InviteTtlErrorCode: pb.InviteTTLErrorCode_INVITE_TTL_ALREADY_SET,
+			},
+		}, nil
+	}
+
+	if err := s.cm.SaveWith(func(c config.Config) config.Config {
+		c.Meshnet.InviteTTL = ttl
+		return c
+	}); err != nil {
+		s.pub.Publish(err)
+		return &pb.InviteTTLResponse{
+			Response: &pb.InviteTTLResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	return &pb.InviteTTLResponse{
+		Response: &pb.InviteTTLResponse_Empty{},
+	}, nil
+}
@@ -0,0 +1,154 @@
+package meshnet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/core"
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type resendInvitationsAPI struct {
+	invitationsAPI
+	sent      mesh.Invitations
+	revoked   []uuid.UUID
+	invited   []string
+	ttl       time.Duration
+	routing   bool
+	inviteErr error
+}
+
+func (i *resendInvitationsAPI) Sent(string, uuid.UUID) (mesh.Invitations, error) {
+	return i.sent, nil
+}
+
+func (i *resendInvitationsAPI) Revoke(_ string, _ uuid.UUID, invitation uuid.UUID) error {
+	i.revoked = append(i.revoked, invitation)
+	return nil
+}
+
+func (i *resendInvitationsAPI) Invite(
+	_ string, _ uuid.UUID, email string, ttl time.Duration, _, routing, _, _ bool,
+) error {
+	if i.inviteErr != nil {
+		return i.inviteErr
+	}
+	i.invited = append(i.invited, email)
+	i.ttl = ttl
+	i.routing = routing
+	return nil
+}
+
+func TestServer_ResendInvite(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	id := uuid.New()
+	sent := mesh.Invitations{{ID: id, Email: "friend@nordvpn.com", AllowRouting: true}}
+
+	tests := []struct {
+		name      string
+		email     string
+		inviteErr error
+		expected  *pb.InviteResponse
+		revoked   []uuid.UUID
+		invited   []string
+	}{
+		{
+			name:     "resent with the same permissions",
+			email:    "friend@nordvpn.com",
+			expected: &pb.InviteResponse{Response: &pb.InviteResponse_Empty{}},
+			revoked:  []uuid.UUID{id},
+			invited:  []string{"friend@nordvpn.com"},
+		},
+		{
+			name:  "not sent",
+			email: "stranger@nordvpn.com",
+			expected: &pb.InviteResponse{Response: &pb.InviteResponse_InviteResponseErrorCode{
+				InviteResponseErrorCode: pb.InviteResponseErrorCode_INVITE_NOT_FOUND,
+			}},
+		},
+		{
+			name:      "weekly limit",
+			email:     "friend@nordvpn.com",
+			inviteErr: core.ErrTooManyRequests,
+			expected: &pb.InviteResponse{Response: &pb.InviteResponse_InviteResponseErrorCode{
+				InviteResponseErrorCode: pb.InviteResponseErrorCode_LIMIT_REACHED,
+			}},
+			revoked: []uuid.UUID{id},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			inv := &resendInvitationsAPI{sent: sent, inviteErr: test.inviteErr}
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Mesh = true
+			cm.Cfg.Meshnet.InviteTTL = 48 * time.Hour
+			server := newAutoAcceptServer(cm, inv, &workingNetworker{})
+
+			resp, err := server.ResendInvite(context.Background(), &pb.DenyInviteRequest{Email: test.email})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected.Response, resp.Response)
+			assert.Equal(t, test.revoked, inv.revoked)
+			assert.Equal(t, test.invited, inv.invited)
+			if test.invited != nil {
+				assert.Equal(t, 48*time.Hour, inv.ttl)
+				assert.True(t, inv.routing)
+			}
+		})
+	}
+}
+
+func TestServer_SetInviteTTL(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		current  time.Duration
+		ttl      uint32
+		expected time.Duration
+		code     *pb.InviteTTLErrorCode
+	}{
+		{name: "set", ttl: 7 * 24 * 3600, expected: 7 * 24 * time.Hour},
+		{name: "reset to default", current: time.Hour, ttl: 0, expected: 0},
+		{
+			name:     "too short",
+			current:  time.Hour,
+			ttl:      60,
+			expected: time.Hour,
+			code:     pb.InviteTTLErrorCode_INVALID_INVITE_TTL.Enum(),
+		},
+		{
+			name:     "already set",
+			current:  time.Hour,
+			ttl:      3600,
+			expected: time.Hour,
+			code:     pb.InviteTTLErrorCode_INVITE_TTL_ALREADY_SET.Enum(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Mesh = true
+			cm.Cfg.Meshnet.InviteTTL = test.current
+			server := newAutoAcceptServer(cm, invitationsAPI{}, &workingNetworker{})
+
+			resp, err := server.SetInviteTTL(context.Background(), &pb.SetInviteTTLRequest{Ttl: test.ttl})
+			assert.NoError(t, err)
+			if test.code == nil {
+				assert.IsType(t, &pb.InviteTTLResponse_Empty{}, resp.Response)
+			} else {
+				assert.Equal(t, *test.code, resp.GetInviteTtlErrorCode())
+			}
+			assert.Equal(t, test.expected, cm.Cfg.Meshnet.InviteTTL)
+		})
+	}
+}
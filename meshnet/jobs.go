@@ -21,6 +21,20 @@ func (s *Server) StartJobs() {
 		log.Println(internal.WarningPrefix, "job monitor fileshare process schedule error:", err)
 	}
 
+	if _, err := s.scheduler.NewJob(
+		gocron.DurationJob(5*time.Second),
+		gocron.NewTask(JobMonitorPeerPresence(s)),
+		gocron.WithName("job monitor peer presence")); err != nil {
+		log.Println(internal.WarningPrefix, "job monitor peer presence schedule error:", err)
+	}
+
+	if _, err := s.scheduler.NewJob(
+		gocron.DurationJob(time.Minute),
+		gocron.NewTask(JobAcceptOwnInvites(s)),
+		gocron.WithName("job accept own invites")); err != nil {
+		log.Println(internal.WarningPrefix, "job accept own invites schedule error:", err)
+	}
+
 	s.scheduler.Start()
 	for _, job := range s.scheduler.Jobs() {
 		err := job.RunNow()
@@ -66,3 +80,17 @@ func JobMonitorFileshareProcess(s *Server) func() error {
 		return nil
 	}
 }
+
+// JobMonitorPeerPresence publishes the changes of the peer presence while anyone is subscribed to them
+func JobMonitorPeerPresence(s *Server) func() error {
+	return func() error {
+		return s.checkPeerPresence()
+	}
+}
+
+// JobAcceptOwnInvites accepts the invites from the devices of the same account while the policy is set
+func JobAcceptOwnInvites(s *Server) func() error {
+	return func() error {
+		return s.acceptOwnInvites()
+	}
+}
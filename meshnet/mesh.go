@@ -25,8 +25,14 @@ type Mesh interface {
 	// StatusMap retrieves the current status map for the related
 	// meshnet peers
 	StatusMap() (map[string]string, error)
+	// PathMap retrieves whether the connections to the meshnet peers go
+	// directly or through a relay
+	PathMap() (map[string]string, error)
 	// NetworkChanged is called at network changes
 	NetworkChanged() error
+	// SetPeerRoutes routes the subnets through the peer identified by the public key, empty
+	// subnets stop routing through it
+	SetPeerRoutes(publicKey string, subnets []netip.Prefix) error
 }
 
 // KeyGenerator for use in meshnet.
@@ -34,7 +34,10 @@ type Networker interface {
 	// except when routing is denied - then BlockRouting must be used. changedPeer is the peer whose routing settings
 	// changed, peers is the map of all the machine peers(including the changed peer).
 	ResetRouting(changedPeer mesh.MachinePeer, peers mesh.MachinePeers) error
+	// SetPeerBandwidthLimits caps the traffic the peers can route through this device
+	SetPeerBandwidthLimits(config.PeerBandwidthLimits) error
 	StatusMap() (map[string]string, error)
+	PathMap() (map[string]string, error)
 	LastServerName() string
 	Start(
 		context.Context,
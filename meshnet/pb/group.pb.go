@@ -0,0 +1,829 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: group.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PermissionChange defines how a permission is changed for the peers of a group
+type PermissionChange int32
+
+const (
+	PermissionChange_PERMISSION_UNCHANGED PermissionChange = 0
+	PermissionChange_PERMISSION_ALLOW     PermissionChange = 1
+	PermissionChange_PERMISSION_DENY      PermissionChange = 2
+)
+
+// Enum value maps for PermissionChange.
+var (
+	PermissionChange_name = map[int32]string{
+		0: "PERMISSION_UNCHANGED",
+		1: "PERMISSION_ALLOW",
+		2: "PERMISSION_DENY",
+	}
+	PermissionChange_value = map[string]int32{
+		"PERMISSION_UNCHANGED": 0,
+		"PERMISSION_ALLOW":     1,
+		"PERMISSION_DENY":      2,
+	}
+)
+
+func (x PermissionChange) Enum() *PermissionChange {
+	p := new(PermissionChange)
+	*p = x
+	return p
+}
+
+func (x PermissionChange) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PermissionChange) Descriptor() protoreflect.EnumDescriptor {
+	return file_group_proto_enumTypes[0].Descriptor()
+}
+
+func (PermissionChange) Type() protoreflect.EnumType {
+	return &file_group_proto_enumTypes[0]
+}
+
+func (x PermissionChange) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PermissionChange.Descriptor instead.
+func (PermissionChange) EnumDescriptor() ([]byte, []int) {
+	return file_group_proto_rawDescGZIP(), []int{0}
+}
+
+// PeerGroupErrorCode defines an error code on managing the peer groups
+type PeerGroupErrorCode int32
+
+const (
+	PeerGroupErrorCode_GROUP_NOT_FOUND       PeerGroupErrorCode = 0
+	PeerGroupErrorCode_INVALID_GROUP_NAME    PeerGroupErrorCode = 1
+	PeerGroupErrorCode_PEER_ALREADY_IN_GROUP PeerGroupErrorCode = 2
+	PeerGroupErrorCode_PEER_NOT_IN_GROUP     PeerGroupErrorCode = 3
+	PeerGroupErrorCode_TOO_MANY_GROUPS       PeerGroupErrorCode = 4
+)
+
+// Enum value maps for PeerGroupErrorCode.
+var (
+	PeerGroupErrorCode_name = map[int32]string{
+		0: "GROUP_NOT_FOUND",
+		1: "INVALID_GROUP_NAME",
+		2: "PEER_ALREADY_IN_GROUP",
+		3: "PEER_NOT_IN_GROUP",
+		4: "TOO_MANY_GROUPS",
+	}
+	PeerGroupErrorCode_value = map[string]int32{
+		"GROUP_NOT_FOUND":       0,
+		"INVALID_GROUP_NAME":    1,
+		"PEER_ALREADY_IN_GROUP": 2,
+		"PEER_NOT_IN_GROUP":     3,
+		"TOO_MANY_GROUPS":       4,
+	}
+)
+
+func (x PeerGroupErrorCode) Enum() *PeerGroupErrorCode {
+	p := new(PeerGroupErrorCode)
+	*p = x
+	return p
+}
+
+func (x PeerGroupErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeerGroupErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_group_proto_enumTypes[1].Descriptor()
+}
+
+func (PeerGroupErrorCode) Type() protoreflect.EnumType {
+	return &file_group_proto_enumTypes[1]
+}
+
+func (x PeerGroupErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeerGroupErrorCode.Descriptor instead.
+func (PeerGroupErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_group_proto_rawDescGZIP(), []int{1}
+}
+
+// PeerGroup defines a named group of meshnet peers
+type PeerGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// pubkeys of the peers in the group
+	Pubkeys []string `protobuf:"bytes,2,rep,name=pubkeys,proto3" json:"pubkeys,omitempty"`
+}
+
+func (x *PeerGroup) Reset() {
+	*x = PeerGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_group_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerGroup) ProtoMessage() {}
+
+func (x *PeerGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_group_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerGroup.ProtoReflect.Descriptor instead.
+func (*PeerGroup) Descriptor() ([]byte, []int) {
+	return file_group_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PeerGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PeerGroup) GetPubkeys() []string {
+	if x != nil {
+		return x.Pubkeys
+	}
+	return nil
+}
+
+// GetPeerGroupsResponse defines a response listing the peer groups
+type GetPeerGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*GetPeerGroupsResponse_Groups
+	//	*GetPeerGroupsResponse_ServiceErrorCode
+	Response isGetPeerGroupsResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *GetPeerGroupsResponse) Reset() {
+	*x = GetPeerGroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_group_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeerGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerGroupsResponse) ProtoMessage() {}
+
+func (x *GetPeerGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerGroupsResponse.ProtoReflect.Descriptor instead.
+func (*GetPeerGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_group_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *GetPeerGroupsResponse) GetResponse() isGetPeerGroupsResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *GetPeerGroupsResponse) GetGroups() *PeerGroupList {
+	if x, ok := x.GetResponse().(*GetPeerGroupsResponse_Groups); ok {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetPeerGroupsResponse) GetServiceErrorCode() ServiceErrorCode {
+	if x, ok := x.GetResponse().(*GetPeerGroupsResponse_ServiceErrorCode); ok {
+		return x.ServiceErrorCode
+	}
+	return ServiceErrorCode_NOT_LOGGED_IN
+}
+
+type isGetPeerGroupsResponse_Response interface {
+	isGetPeerGroupsResponse_Response()
+}
+
+type GetPeerGroupsResponse_Groups struct {
+	Groups *PeerGroupList `protobuf:"bytes,1,opt,name=groups,proto3,oneof"`
+}
+
+type GetPeerGroupsResponse_ServiceErrorCode struct {
+	ServiceErrorCode ServiceErrorCode `protobuf:"varint,2,opt,name=service_error_code,json=serviceErrorCode,proto3,enum=meshpb.ServiceErrorCode,oneof"`
+}
+
+func (*GetPeerGroupsResponse_Groups) isGetPeerGroupsResponse_Response() {}
+
+func (*GetPeerGroupsResponse_ServiceErrorCode) isGetPeerGroupsResponse_Response() {}
+
+// PeerGroupList defines a list of all the peer groups of the device
+type PeerGroupList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*PeerGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *PeerGroupList) Reset() {
+	*x = PeerGroupList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_group_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerGroupList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerGroupList) ProtoMessage() {}
+
+func (x *PeerGroupList) ProtoReflect() protoreflect.Message {
+	mi := &file_group_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerGroupList.ProtoReflect.Descriptor instead.
+func (*PeerGroupList) Descriptor() ([]byte, []int) {
+	return file_group_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PeerGroupList) GetGroups() []*PeerGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// PeerGroupRequest defines a request to add a peer to a group or to remove it from a group
+type PeerGroupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group      string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+}
+
+func (x *PeerGroupRequest) Reset() {
+	*x = PeerGroupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_group_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerGroupRequest) ProtoMessage() {}
+
+func (x *PeerGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerGroupRequest.ProtoReflect.Descriptor instead.
+func (*PeerGroupRequest) Descriptor() ([]byte, []int) {
+	return file_group_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PeerGroupRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *PeerGroupRequest) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+// SetPeerGroupPermissionsRequest defines a request to change the permissions of all the
+// peers in a group at once
+type SetPeerGroupPermissionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group        string           `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Incoming     PermissionChange `protobuf:"varint,2,opt,name=incoming,proto3,enum=meshpb.PermissionChange" json:"incoming,omitempty"`
+	Routing      PermissionChange `protobuf:"varint,3,opt,name=routing,proto3,enum=meshpb.PermissionChange" json:"routing,omitempty"`
+	LocalNetwork PermissionChange `protobuf:"varint,4,opt,name=local_network,json=localNetwork,proto3,enum=meshpb.PermissionChange" json:"local_network,omitempty"`
+	Fileshare    PermissionChange `protobuf:"varint,5,opt,name=fileshare,proto3,enum=meshpb.PermissionChange" json:"fileshare,omitempty"`
+}
+
+func (x *SetPeerGroupPermissionsRequest) Reset() {
+	*x = SetPeerGroupPermissionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_group_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPeerGroupPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPeerGroupPermissionsRequest) ProtoMessage() {}
+
+func (x *SetPeerGroupPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_group_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPeerGroupPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*SetPeerGroupPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_group_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetPeerGroupPermissionsRequest) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *SetPeerGroupPermissionsRequest) GetIncoming() PermissionChange {
+	if x != nil {
+		return x.Incoming
+	}
+	return PermissionChange_PERMISSION_UNCHANGED
+}
+
+func (x *SetPeerGroupPermissionsRequest) GetRouting() PermissionChange {
+	if x != nil {
+		return x.Routing
+	}
+	return PermissionChange_PERMISSION_UNCHANGED
+}
+
+func (x *SetPeerGroupPermissionsRequest) GetLocalNetwork() PermissionChange {
+	if x != nil {
+		return x.LocalNetwork
+	}
+	return PermissionChange_PERMISSION_UNCHANGED
+}
+
+func (x *SetPeerGroupPermissionsRequest) GetFileshare() PermissionChange {
+	if x != nil {
+		return x.Fileshare
+	}
+	return PermissionChange_PERMISSION_UNCHANGED
+}
+
+// PeerGroupResponse defines a response to the peer group changes
+type PeerGroupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*PeerGroupResponse_Empty
+	//	*PeerGroupResponse_PeerGroupErrorCode
+	//	*PeerGroupResponse_UpdatePeerErrorCode
+	//	*PeerGroupResponse_ServiceErrorCode
+	//	*PeerGroupResponse_MeshnetErrorCode
+	Response isPeerGroupResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *PeerGroupResponse) Reset() {
+	*x = PeerGroupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_group_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerGroupResponse) ProtoMessage() {}
+
+func (x *PeerGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_group_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerGroupResponse.ProtoReflect.Descriptor instead.
+func (*PeerGroupResponse) Descriptor() ([]byte, []int) {
+	return file_group_proto_rawDescGZIP(), []int{5}
+}
+
+func (m *PeerGroupResponse) GetResponse() isPeerGroupResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *PeerGroupResponse) GetEmpty() *Empty {
+	if x, ok := x.GetResponse().(*PeerGroupResponse_Empty); ok {
+		return x.Empty
+	}
+	return nil
+}
+
+func (x *PeerGroupResponse) GetPeerGroupErrorCode() PeerGroupErrorCode {
+	if x, ok := x.GetResponse().(*PeerGroupResponse_PeerGroupErrorCode); ok {
+		return x.PeerGroupErrorCode
+	}
+	return PeerGroupErrorCode_GROUP_NOT_FOUND
+}
+
+func (x *PeerGroupResponse) GetUpdatePeerErrorCode() UpdatePeerErrorCode {
+	if x, ok := x.GetResponse().(*PeerGroupResponse_UpdatePeerErrorCode); ok {
+		return x.UpdatePeerErrorCode
+	}
+	return UpdatePeerErrorCode_PEER_NOT_FOUND
+}
+
+func (x *PeerGroupResponse) GetServiceErrorCode() ServiceErrorCode {
+	if x, ok := x.GetResponse().(*PeerGroupResponse_ServiceErrorCode); ok {
+		return x.ServiceErrorCode
+	}
+	return ServiceErrorCode_NOT_LOGGED_IN
+}
+
+func (x *PeerGroupResponse) GetMeshnetErrorCode() MeshnetErrorCode {
+	if x, ok := x.GetResponse().(*PeerGroupResponse_MeshnetErrorCode); ok {
+		return x.MeshnetErrorCode
+	}
+	return MeshnetErrorCode_NOT_REGISTERED
+}
+
+type isPeerGroupResponse_Response interface {
+	isPeerGroupResponse_Response()
+}
+
+type PeerGroupResponse_Empty struct {
+	Empty *Empty `protobuf:"bytes,1,opt,name=empty,proto3,oneof"`
+}
+
+type PeerGroupResponse_PeerGroupErrorCode struct {
+	PeerGroupErrorCode PeerGroupErrorCode `protobuf:"varint,2,opt,name=peer_group_error_code,json=peerGroupErrorCode,proto3,enum=meshpb.PeerGroupErrorCode,oneof"`
+}
+
+type PeerGroupResponse_UpdatePeerErrorCode struct {
+	UpdatePeerErrorCode UpdatePeerErrorCode `protobuf:"varint,3,opt,name=update_peer_error_code,json=updatePeerErrorCode,proto3,enum=meshpb.UpdatePeerErrorCode,oneof"`
+}
+
+type PeerGroupResponse_ServiceErrorCode struct {
+	ServiceErrorCode ServiceErrorCode `protobuf:"varint,4,opt,name=service_error_code,json=serviceErrorCode,proto3,enum=meshpb.ServiceErrorCode,oneof"`
+}
+
+type PeerGroupResponse_MeshnetErrorCode struct {
+	MeshnetErrorCode MeshnetErrorCode `protobuf:"varint,5,opt,name=meshnet_error_code,json=meshnetErrorCode,proto3,enum=meshpb.MeshnetErrorCode,oneof"`
+}
+
+func (*PeerGroupResponse_Empty) isPeerGroupResponse_Response() {}
+
+func (*PeerGroupResponse_PeerGroupErrorCode) isPeerGroupResponse_Response() {}
+
+func (*PeerGroupResponse_UpdatePeerErrorCode) isPeerGroupResponse_Response() {}
+
+func (*PeerGroupResponse_ServiceErrorCode) isPeerGroupResponse_Response() {}
+
+func (*PeerGroupResponse_MeshnetErrorCode) isPeerGroupResponse_Response() {}
+
+var File_group_proto protoreflect.FileDescriptor
+
+var file_group_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6d,
+	0x65, 0x73, 0x68, 0x70, 0x62, 0x1a, 0x0b, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x39, 0x0a, 0x09, 0x50, 0x65, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x62, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79,
+	0x73, 0x22, 0x9e, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x48, 0x0a, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3a, 0x0a, 0x0d, 0x50, 0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65,
+	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0x48,
+	0x0a, 0x10, 0x50, 0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x97, 0x02, 0x0a, 0x1e, 0x53, 0x65, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x34, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x08, 0x69,
+	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70,
+	0x62, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3d, 0x0a, 0x0d, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0c, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x36, 0x0a, 0x09, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x22, 0xff, 0x02, 0x0a, 0x11, 0x50, 0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x4f, 0x0a, 0x15, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x12, 0x70, 0x65,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
+	0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48,
+	0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x57, 0x0a, 0x10, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x45, 0x52, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x45, 0x52, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e,
+	0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x45, 0x52, 0x4d,
+	0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4e, 0x59, 0x10, 0x02, 0x2a, 0x88, 0x01,
+	0x0a, 0x12, 0x50, 0x65, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10,
+	0x01, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44,
+	0x59, 0x5f, 0x49, 0x4e, 0x5f, 0x47, 0x52, 0x4f, 0x55, 0x50, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11,
+	0x50, 0x45, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x47, 0x52, 0x4f, 0x55,
+	0x50, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f,
+	0x47, 0x52, 0x4f, 0x55, 0x50, 0x53, 0x10, 0x04, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_group_proto_rawDescOnce sync.Once
+	file_group_proto_rawDescData = file_group_proto_rawDesc
+)
+
+func file_group_proto_rawDescGZIP() []byte {
+	file_group_proto_rawDescOnce.Do(func() {
+		file_group_proto_rawDescData = protoimpl.X.CompressGZIP(file_group_proto_rawDescData)
+	})
+	return file_group_proto_rawDescData
+}
+
+var file_group_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_group_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_group_proto_goTypes = []interface{}{
+	(PermissionChange)(0),                  // 0: meshpb.PermissionChange
+	(PeerGroupErrorCode)(0),                // 1: meshpb.PeerGroupErrorCode
+	(*PeerGroup)(nil),                      // 2: meshpb.PeerGroup
+	(*GetPeerGroupsResponse)(nil),          // 3: meshpb.GetPeerGroupsResponse
+	(*PeerGroupList)(nil),                  // 4: meshpb.PeerGroupList
+	(*PeerGroupRequest)(nil),               // 5: meshpb.PeerGroupRequest
+	(*SetPeerGroupPermissionsRequest)(nil), // 6: meshpb.SetPeerGroupPermissionsRequest
+	(*PeerGroupResponse)(nil),              // 7: meshpb.PeerGroupResponse
+	(ServiceErrorCode)(0),                  // 8: meshpb.ServiceErrorCode
+	(*Empty)(nil),                          // 9: meshpb.Empty
+	(UpdatePeerErrorCode)(0),               // 10: meshpb.UpdatePeerErrorCode
+	(MeshnetErrorCode)(0),                  // 11: meshpb.MeshnetErrorCode
+}
+var file_group_proto_depIdxs = []int32{
+	4,  // 0: meshpb.GetPeerGroupsResponse.groups:type_name -> meshpb.PeerGroupList
+	8,  // 1: meshpb.GetPeerGroupsResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	2,  // 2: meshpb.PeerGroupList.groups:type_name -> meshpb.PeerGroup
+	0,  // 3: meshpb.SetPeerGroupPermissionsRequest.incoming:type_name -> meshpb.PermissionChange
+	0,  // 4: meshpb.SetPeerGroupPermissionsRequest.routing:type_name -> meshpb.PermissionChange
+	0,  // 5: meshpb.SetPeerGroupPermissionsRequest.local_network:type_name -> meshpb.PermissionChange
+	0,  // 6: meshpb.SetPeerGroupPermissionsRequest.fileshare:type_name -> meshpb.PermissionChange
+	9,  // 7: meshpb.PeerGroupResponse.empty:type_name -> meshpb.Empty
+	1,  // 8: meshpb.PeerGroupResponse.peer_group_error_code:type_name -> meshpb.PeerGroupErrorCode
+	10, // 9: meshpb.PeerGroupResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	8,  // 10: meshpb.PeerGroupResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	11, // 11: meshpb.PeerGroupResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_group_proto_init() }
+func file_group_proto_init() {
+	if File_group_proto != nil {
+		return
+	}
+	file_empty_proto_init()
+	file_peer_proto_init()
+	file_service_response_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_group_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_group_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_group_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerGroupList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_group_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerGroupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_group_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPeerGroupPermissionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_group_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerGroupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_group_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*GetPeerGroupsResponse_Groups)(nil),
+		(*GetPeerGroupsResponse_ServiceErrorCode)(nil),
+	}
+	file_group_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*PeerGroupResponse_Empty)(nil),
+		(*PeerGroupResponse_PeerGroupErrorCode)(nil),
+		(*PeerGroupResponse_UpdatePeerErrorCode)(nil),
+		(*PeerGroupResponse_ServiceErrorCode)(nil),
+		(*PeerGroupResponse_MeshnetErrorCode)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_group_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_group_proto_goTypes,
+		DependencyIndexes: file_group_proto_depIdxs,
+		EnumInfos:         file_group_proto_enumTypes,
+		MessageInfos:      file_group_proto_msgTypes,
+	}.Build()
+	File_group_proto = out.File
+	file_group_proto_rawDesc = nil
+	file_group_proto_goTypes = nil
+	file_group_proto_depIdxs = nil
+}
@@ -95,6 +95,9 @@ const (
 	InviteResponseErrorCode_LIMIT_REACHED InviteResponseErrorCode = 3
 	// PEER_COUNT defines that no more devices can be invited
 	InviteResponseErrorCode_PEER_COUNT InviteResponseErrorCode = 4
+	// INVITE_NOT_FOUND defines that there is no sent invitation to
+	// the specified email to resend
+	InviteResponseErrorCode_INVITE_NOT_FOUND InviteResponseErrorCode = 5
 )
 
 // Enum value maps for InviteResponseErrorCode.
@@ -105,6 +108,7 @@ var (
 		2: "SAME_ACCOUNT_EMAIL",
 		3: "LIMIT_REACHED",
 		4: "PEER_COUNT",
+		5: "INVITE_NOT_FOUND",
 	}
 	InviteResponseErrorCode_value = map[string]int32{
 		"ALREADY_EXISTS":     0,
@@ -112,6 +116,7 @@ var (
 		"SAME_ACCOUNT_EMAIL": 2,
 		"LIMIT_REACHED":      3,
 		"PEER_COUNT":         4,
+		"INVITE_NOT_FOUND":   5,
 	}
 )
 
@@ -142,6 +147,55 @@ func (InviteResponseErrorCode) EnumDescriptor() ([]byte, []int) {
 	return file_invite_proto_rawDescGZIP(), []int{1}
 }
 
+// InviteTTLErrorCode defines the errors of changing the invite TTL
+type InviteTTLErrorCode int32
+
+const (
+	// INVALID_INVITE_TTL defines that the TTL is out of the allowed range
+	InviteTTLErrorCode_INVALID_INVITE_TTL InviteTTLErrorCode = 0
+	// INVITE_TTL_ALREADY_SET defines that the TTL is already the same
+	InviteTTLErrorCode_INVITE_TTL_ALREADY_SET InviteTTLErrorCode = 1
+)
+
+// Enum value maps for InviteTTLErrorCode.
+var (
+	InviteTTLErrorCode_name = map[int32]string{
+		0: "INVALID_INVITE_TTL",
+		1: "INVITE_TTL_ALREADY_SET",
+	}
+	InviteTTLErrorCode_value = map[string]int32{
+		"INVALID_INVITE_TTL":     0,
+		"INVITE_TTL_ALREADY_SET": 1,
+	}
+)
+
+func (x InviteTTLErrorCode) Enum() *InviteTTLErrorCode {
+	p := new(InviteTTLErrorCode)
+	*p = x
+	return p
+}
+
+func (x InviteTTLErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InviteTTLErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_invite_proto_enumTypes[2].Descriptor()
+}
+
+func (InviteTTLErrorCode) Type() protoreflect.EnumType {
+	return &file_invite_proto_enumTypes[2]
+}
+
+func (x InviteTTLErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InviteTTLErrorCode.Descriptor instead.
+func (InviteTTLErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_invite_proto_rawDescGZIP(), []int{2}
+}
+
 // GetInvitesResponse defines a response for GetInvites request
 type GetInvitesResponse struct {
 	state         protoimpl.MessageState
@@ -246,6 +300,12 @@ type InvitesList struct {
 
 	Sent     []*Invite `protobuf:"bytes,1,rep,name=sent,proto3" json:"sent,omitempty"`
 	Received []*Invite `protobuf:"bytes,2,rep,name=received,proto3" json:"received,omitempty"`
+	// auto_accept reports if the invites from the devices of the same
+	// account are accepted automatically
+	AutoAccept bool `protobuf:"varint,3,opt,name=auto_accept,json=autoAccept,proto3" json:"auto_accept,omitempty"`
+	// invite_ttl is how long in seconds the sent invites stay valid, 0
+	// means the default of the API
+	InviteTtl uint32 `protobuf:"varint,4,opt,name=invite_ttl,json=inviteTtl,proto3" json:"invite_ttl,omitempty"`
 }
 
 func (x *InvitesList) Reset() {
@@ -294,6 +354,20 @@ func (x *InvitesList) GetReceived() []*Invite {
 	return nil
 }
 
+func (x *InvitesList) GetAutoAccept() bool {
+	if x != nil {
+		return x.AutoAccept
+	}
+	return false
+}
+
+func (x *InvitesList) GetInviteTtl() uint32 {
+	if x != nil {
+		return x.InviteTtl
+	}
+	return 0
+}
+
 // Invite defines the structure of the meshnet invite
 type Invite struct {
 	state         protoimpl.MessageState
@@ -720,6 +794,346 @@ func (*InviteResponse_ServiceErrorCode) isInviteResponse_Response() {}
 
 func (*InviteResponse_MeshnetErrorCode) isInviteResponse_Response() {}
 
+// SetAutoAcceptInvitesRequest defines a request to accept the invites from
+// the devices of the same account automatically, the invites from the other
+// accounts still have to be accepted manually
+type SetAutoAcceptInvitesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// permissions are granted to the inviting devices
+	AllowIncomingTraffic bool `protobuf:"varint,2,opt,name=allowIncomingTraffic,proto3" json:"allowIncomingTraffic,omitempty"`
+	AllowTrafficRouting  bool `protobuf:"varint,3,opt,name=allowTrafficRouting,proto3" json:"allowTrafficRouting,omitempty"`
+	AllowLocalNetwork    bool `protobuf:"varint,4,opt,name=allowLocalNetwork,proto3" json:"allowLocalNetwork,omitempty"`
+	AllowFileshare       bool `protobuf:"varint,5,opt,name=allowFileshare,proto3" json:"allowFileshare,omitempty"`
+}
+
+func (x *SetAutoAcceptInvitesRequest) Reset() {
+	*x = SetAutoAcceptInvitesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invite_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAutoAcceptInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAutoAcceptInvitesRequest) ProtoMessage() {}
+
+func (x *SetAutoAcceptInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAutoAcceptInvitesRequest.ProtoReflect.Descriptor instead.
+func (*SetAutoAcceptInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_invite_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetAutoAcceptInvitesRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetAutoAcceptInvitesRequest) GetAllowIncomingTraffic() bool {
+	if x != nil {
+		return x.AllowIncomingTraffic
+	}
+	return false
+}
+
+func (x *SetAutoAcceptInvitesRequest) GetAllowTrafficRouting() bool {
+	if x != nil {
+		return x.AllowTrafficRouting
+	}
+	return false
+}
+
+func (x *SetAutoAcceptInvitesRequest) GetAllowLocalNetwork() bool {
+	if x != nil {
+		return x.AllowLocalNetwork
+	}
+	return false
+}
+
+func (x *SetAutoAcceptInvitesRequest) GetAllowFileshare() bool {
+	if x != nil {
+		return x.AllowFileshare
+	}
+	return false
+}
+
+// AutoAcceptInvitesResponse defines the response to the auto accept policy
+// change
+type AutoAcceptInvitesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*AutoAcceptInvitesResponse_Empty
+	//	*AutoAcceptInvitesResponse_ServiceErrorCode
+	//	*AutoAcceptInvitesResponse_MeshnetErrorCode
+	Response isAutoAcceptInvitesResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *AutoAcceptInvitesResponse) Reset() {
+	*x = AutoAcceptInvitesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invite_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AutoAcceptInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AutoAcceptInvitesResponse) ProtoMessage() {}
+
+func (x *AutoAcceptInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AutoAcceptInvitesResponse.ProtoReflect.Descriptor instead.
+func (*AutoAcceptInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_invite_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *AutoAcceptInvitesResponse) GetResponse() isAutoAcceptInvitesResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *AutoAcceptInvitesResponse) GetEmpty() *Empty {
+	if x, ok := x.GetResponse().(*AutoAcceptInvitesResponse_Empty); ok {
+		return x.Empty
+	}
+	return nil
+}
+
+func (x *AutoAcceptInvitesResponse) GetServiceErrorCode() ServiceErrorCode {
+	if x, ok := x.GetResponse().(*AutoAcceptInvitesResponse_ServiceErrorCode); ok {
+		return x.ServiceErrorCode
+	}
+	return ServiceErrorCode_NOT_LOGGED_IN
+}
+
+func (x *AutoAcceptInvitesResponse) GetMeshnetErrorCode() MeshnetErrorCode {
+	if x, ok := x.GetResponse().(*AutoAcceptInvitesResponse_MeshnetErrorCode); ok {
+		return x.MeshnetErrorCode
+	}
+	return MeshnetErrorCode_NOT_REGISTERED
+}
+
+type isAutoAcceptInvitesResponse_Response interface {
+	isAutoAcceptInvitesResponse_Response()
+}
+
+type AutoAcceptInvitesResponse_Empty struct {
+	Empty *Empty `protobuf:"bytes,1,opt,name=empty,proto3,oneof"`
+}
+
+type AutoAcceptInvitesResponse_ServiceErrorCode struct {
+	ServiceErrorCode ServiceErrorCode `protobuf:"varint,2,opt,name=service_error_code,json=serviceErrorCode,proto3,enum=meshpb.ServiceErrorCode,oneof"`
+}
+
+type AutoAcceptInvitesResponse_MeshnetErrorCode struct {
+	MeshnetErrorCode MeshnetErrorCode `protobuf:"varint,3,opt,name=meshnet_error_code,json=meshnetErrorCode,proto3,enum=meshpb.MeshnetErrorCode,oneof"`
+}
+
+func (*AutoAcceptInvitesResponse_Empty) isAutoAcceptInvitesResponse_Response() {}
+
+func (*AutoAcceptInvitesResponse_ServiceErrorCode) isAutoAcceptInvitesResponse_Response() {}
+
+func (*AutoAcceptInvitesResponse_MeshnetErrorCode) isAutoAcceptInvitesResponse_Response() {}
+
+// SetInviteTTLRequest defines a request to change how long the sent invites
+// stay valid
+type SetInviteTTLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ttl is in seconds, 0 resets it to the default of the API
+	Ttl uint32 `protobuf:"varint,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *SetInviteTTLRequest) Reset() {
+	*x = SetInviteTTLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invite_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetInviteTTLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInviteTTLRequest) ProtoMessage() {}
+
+func (x *SetInviteTTLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInviteTTLRequest.ProtoReflect.Descriptor instead.
+func (*SetInviteTTLRequest) Descriptor() ([]byte, []int) {
+	return file_invite_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetInviteTTLRequest) GetTtl() uint32 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+// InviteTTLResponse defines the response to the invite TTL change
+type InviteTTLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*InviteTTLResponse_Empty
+	//	*InviteTTLResponse_InviteTtlErrorCode
+	//	*InviteTTLResponse_ServiceErrorCode
+	//	*InviteTTLResponse_MeshnetErrorCode
+	Response isInviteTTLResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *InviteTTLResponse) Reset() {
+	*x = InviteTTLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invite_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteTTLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteTTLResponse) ProtoMessage() {}
+
+func (x *InviteTTLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteTTLResponse.ProtoReflect.Descriptor instead.
+func (*InviteTTLResponse) Descriptor() ([]byte, []int) {
+	return file_invite_proto_rawDescGZIP(), []int{10}
+}
+
+func (m *InviteTTLResponse) GetResponse() isInviteTTLResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *InviteTTLResponse) GetEmpty() *Empty {
+	if x, ok := x.GetResponse().(*InviteTTLResponse_Empty); ok {
+		return x.Empty
+	}
+	return nil
+}
+
+func (x *InviteTTLResponse) GetInviteTtlErrorCode() InviteTTLErrorCode {
+	if x, ok := x.GetResponse().(*InviteTTLResponse_InviteTtlErrorCode); ok {
+		return x.InviteTtlErrorCode
+	}
+	return InviteTTLErrorCode_INVALID_INVITE_TTL
+}
+
+func (x *InviteTTLResponse) GetServiceErrorCode() ServiceErrorCode {
+	if x, ok := x.GetResponse().(*InviteTTLResponse_ServiceErrorCode); ok {
+		return x.ServiceErrorCode
+	}
+	return ServiceErrorCode_NOT_LOGGED_IN
+}
+
+func (x *InviteTTLResponse) GetMeshnetErrorCode() MeshnetErrorCode {
+	if x, ok := x.GetResponse().(*InviteTTLResponse_MeshnetErrorCode); ok {
+		return x.MeshnetErrorCode
+	}
+	return MeshnetErrorCode_NOT_REGISTERED
+}
+
+type isInviteTTLResponse_Response interface {
+	isInviteTTLResponse_Response()
+}
+
+type InviteTTLResponse_Empty struct {
+	Empty *Empty `protobuf:"bytes,1,opt,name=empty,proto3,oneof"`
+}
+
+type InviteTTLResponse_InviteTtlErrorCode struct {
+	InviteTtlErrorCode InviteTTLErrorCode `protobuf:"varint,2,opt,name=invite_ttl_error_code,json=inviteTtlErrorCode,proto3,enum=meshpb.InviteTTLErrorCode,oneof"`
+}
+
+type InviteTTLResponse_ServiceErrorCode struct {
+	ServiceErrorCode ServiceErrorCode `protobuf:"varint,3,opt,name=service_error_code,json=serviceErrorCode,proto3,enum=meshpb.ServiceErrorCode,oneof"`
+}
+
+type InviteTTLResponse_MeshnetErrorCode struct {
+	MeshnetErrorCode MeshnetErrorCode `protobuf:"varint,4,opt,name=meshnet_error_code,json=meshnetErrorCode,proto3,enum=meshpb.MeshnetErrorCode,oneof"`
+}
+
+func (*InviteTTLResponse_Empty) isInviteTTLResponse_Response() {}
+
+func (*InviteTTLResponse_InviteTtlErrorCode) isInviteTTLResponse_Response() {}
+
+func (*InviteTTLResponse_ServiceErrorCode) isInviteTTLResponse_Response() {}
+
+func (*InviteTTLResponse_MeshnetErrorCode) isInviteTTLResponse_Response() {}
+
 var File_invite_proto protoreflect.FileDescriptor
 
 var file_invite_proto_rawDesc = []byte{
@@ -743,93 +1157,154 @@ var file_invite_proto_rawDesc = []byte{
 	0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
 	0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45,
 	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5d, 0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x52, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x64, 0x22, 0x69, 0x0a, 0x06, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x22, 0xe1,
-	0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x32, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x49,
-	0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x63, 0x6f, 0x6d,
-	0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x6c,
-	0x6c, 0x6f, 0x77, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e,
-	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x72,
-	0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x11,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f,
-	0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6c,
-	0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x22, 0x29, 0x0a, 0x11, 0x44, 0x65, 0x6e, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xc4, 0x02,
-	0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x52, 0x04, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f,
+	0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x54, 0x74, 0x6c, 0x22, 0x69, 0x0a, 0x06, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x22,
+	0xe1, 0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x32, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x30, 0x0a, 0x13, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x54,
+	0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a,
+	0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x22, 0x29, 0x0a, 0x11, 0x44, 0x65, 0x6e, 0x79, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xc4,
+	0x02, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x62, 0x0a, 0x1c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x5f,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x18, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb7, 0x02, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x5e, 0x0a, 0x1a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x17, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73,
+	0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d,
+	0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48,
+	0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xf3, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e,
+	0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x30, 0x0a,
+	0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x2c, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x26, 0x0a,
+	0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x19, 0x41, 0x75, 0x74, 0x6f, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65,
+	0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x0a, 0x13, 0x53, 0x65,
+	0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x54, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x74, 0x74, 0x6c, 0x22, 0xab, 0x02, 0x0a, 0x11, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x54,
+	0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70,
 	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70,
 	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x62, 0x0a, 0x1c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x69,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x18, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48,
-	0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73,
-	0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb7, 0x02, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5e,
-	0x0a, 0x1a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x17, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48,
-	0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73,
-	0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68,
-	0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65,
-	0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00,
-	0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x51,
-	0x0a, 0x18, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4e, 0x4f, 0x5f, 0x53, 0x55,
-	0x43, 0x48, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12,
-	0x10, 0x0a, 0x0c, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10,
-	0x02, 0x2a, 0x7b, 0x0a, 0x17, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e,
-	0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x00,
-	0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x45, 0x4d, 0x41, 0x49,
-	0x4c, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x41, 0x4d, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x4f,
-	0x55, 0x4e, 0x54, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x4c,
-	0x49, 0x4d, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x48, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0e,
-	0x0a, 0x0a, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x04, 0x42, 0x32,
-	0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72,
-	0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70,
-	0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x2f,
-	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x4f, 0x0a, 0x15, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x74, 0x74, 0x6c, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x54,
+	0x54, 0x4c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x12, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x54, 0x74, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d,
+	0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
+	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2a, 0x51, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x54, 0x6f, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a,
+	0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4e, 0x4f,
+	0x5f, 0x53, 0x55, 0x43, 0x48, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x4f, 0x55,
+	0x4e, 0x54, 0x10, 0x02, 0x2a, 0x91, 0x01, 0x0a, 0x17, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x12, 0x0a, 0x0e, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53,
+	0x54, 0x53, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x41, 0x4d, 0x45, 0x5f,
+	0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x12,
+	0x11, 0x0a, 0x0d, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x48, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x05, 0x2a, 0x48, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x54, 0x54, 0x4c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16,
+	0x0a, 0x12, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45,
+	0x5f, 0x54, 0x54, 0x4c, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x45,
+	0x5f, 0x54, 0x54, 0x4c, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x53, 0x45, 0x54,
+	0x10, 0x01, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f,
+	0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6d, 0x65, 0x73, 0x68,
+	0x6e, 0x65, 0x74, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -844,43 +1319,55 @@ func file_invite_proto_rawDescGZIP() []byte {
 	return file_invite_proto_rawDescData
 }
 
-var file_invite_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_invite_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_invite_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_invite_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_invite_proto_goTypes = []interface{}{
-	(RespondToInviteErrorCode)(0),   // 0: meshpb.RespondToInviteErrorCode
-	(InviteResponseErrorCode)(0),    // 1: meshpb.InviteResponseErrorCode
-	(*GetInvitesResponse)(nil),      // 2: meshpb.GetInvitesResponse
-	(*InvitesList)(nil),             // 3: meshpb.InvitesList
-	(*Invite)(nil),                  // 4: meshpb.Invite
-	(*InviteRequest)(nil),           // 5: meshpb.InviteRequest
-	(*DenyInviteRequest)(nil),       // 6: meshpb.DenyInviteRequest
-	(*RespondToInviteResponse)(nil), // 7: meshpb.RespondToInviteResponse
-	(*InviteResponse)(nil),          // 8: meshpb.InviteResponse
-	(ServiceErrorCode)(0),           // 9: meshpb.ServiceErrorCode
-	(MeshnetErrorCode)(0),           // 10: meshpb.MeshnetErrorCode
-	(*timestamppb.Timestamp)(nil),   // 11: google.protobuf.Timestamp
-	(*Empty)(nil),                   // 12: meshpb.Empty
+	(RespondToInviteErrorCode)(0),       // 0: meshpb.RespondToInviteErrorCode
+	(InviteResponseErrorCode)(0),        // 1: meshpb.InviteResponseErrorCode
+	(InviteTTLErrorCode)(0),             // 2: meshpb.InviteTTLErrorCode
+	(*GetInvitesResponse)(nil),          // 3: meshpb.GetInvitesResponse
+	(*InvitesList)(nil),                 // 4: meshpb.InvitesList
+	(*Invite)(nil),                      // 5: meshpb.Invite
+	(*InviteRequest)(nil),               // 6: meshpb.InviteRequest
+	(*DenyInviteRequest)(nil),           // 7: meshpb.DenyInviteRequest
+	(*RespondToInviteResponse)(nil),     // 8: meshpb.RespondToInviteResponse
+	(*InviteResponse)(nil),              // 9: meshpb.InviteResponse
+	(*SetAutoAcceptInvitesRequest)(nil), // 10: meshpb.SetAutoAcceptInvitesRequest
+	(*AutoAcceptInvitesResponse)(nil),   // 11: meshpb.AutoAcceptInvitesResponse
+	(*SetInviteTTLRequest)(nil),         // 12: meshpb.SetInviteTTLRequest
+	(*InviteTTLResponse)(nil),           // 13: meshpb.InviteTTLResponse
+	(ServiceErrorCode)(0),               // 14: meshpb.ServiceErrorCode
+	(MeshnetErrorCode)(0),               // 15: meshpb.MeshnetErrorCode
+	(*timestamppb.Timestamp)(nil),       // 16: google.protobuf.Timestamp
+	(*Empty)(nil),                       // 17: meshpb.Empty
 }
 var file_invite_proto_depIdxs = []int32{
-	3,  // 0: meshpb.GetInvitesResponse.invites:type_name -> meshpb.InvitesList
-	9,  // 1: meshpb.GetInvitesResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	10, // 2: meshpb.GetInvitesResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	4,  // 3: meshpb.InvitesList.sent:type_name -> meshpb.Invite
-	4,  // 4: meshpb.InvitesList.received:type_name -> meshpb.Invite
-	11, // 5: meshpb.Invite.expires_at:type_name -> google.protobuf.Timestamp
-	12, // 6: meshpb.RespondToInviteResponse.empty:type_name -> meshpb.Empty
+	4,  // 0: meshpb.GetInvitesResponse.invites:type_name -> meshpb.InvitesList
+	14, // 1: meshpb.GetInvitesResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	15, // 2: meshpb.GetInvitesResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	5,  // 3: meshpb.InvitesList.sent:type_name -> meshpb.Invite
+	5,  // 4: meshpb.InvitesList.received:type_name -> meshpb.Invite
+	16, // 5: meshpb.Invite.expires_at:type_name -> google.protobuf.Timestamp
+	17, // 6: meshpb.RespondToInviteResponse.empty:type_name -> meshpb.Empty
 	0,  // 7: meshpb.RespondToInviteResponse.respond_to_invite_error_code:type_name -> meshpb.RespondToInviteErrorCode
-	9,  // 8: meshpb.RespondToInviteResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	10, // 9: meshpb.RespondToInviteResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	12, // 10: meshpb.InviteResponse.empty:type_name -> meshpb.Empty
+	14, // 8: meshpb.RespondToInviteResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	15, // 9: meshpb.RespondToInviteResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	17, // 10: meshpb.InviteResponse.empty:type_name -> meshpb.Empty
 	1,  // 11: meshpb.InviteResponse.invite_response_error_code:type_name -> meshpb.InviteResponseErrorCode
-	9,  // 12: meshpb.InviteResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	10, // 13: meshpb.InviteResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	14, // 12: meshpb.InviteResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	15, // 13: meshpb.InviteResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	17, // 14: meshpb.AutoAcceptInvitesResponse.empty:type_name -> meshpb.Empty
+	14, // 15: meshpb.AutoAcceptInvitesResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	15, // 16: meshpb.AutoAcceptInvitesResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	17, // 17: meshpb.InviteTTLResponse.empty:type_name -> meshpb.Empty
+	2,  // 18: meshpb.InviteTTLResponse.invite_ttl_error_code:type_name -> meshpb.InviteTTLErrorCode
+	14, // 19: meshpb.InviteTTLResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	15, // 20: meshpb.InviteTTLResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	21, // [21:21] is the sub-list for method output_type
+	21, // [21:21] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_invite_proto_init() }
@@ -975,6 +1462,54 @@ func file_invite_proto_init() {
 				return nil
 			}
 		}
+		file_invite_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAutoAcceptInvitesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invite_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AutoAcceptInvitesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invite_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetInviteTTLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invite_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteTTLResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_invite_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*GetInvitesResponse_Invites)(nil),
@@ -993,13 +1528,24 @@ func file_invite_proto_init() {
 		(*InviteResponse_ServiceErrorCode)(nil),
 		(*InviteResponse_MeshnetErrorCode)(nil),
 	}
+	file_invite_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*AutoAcceptInvitesResponse_Empty)(nil),
+		(*AutoAcceptInvitesResponse_ServiceErrorCode)(nil),
+		(*AutoAcceptInvitesResponse_MeshnetErrorCode)(nil),
+	}
+	file_invite_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*InviteTTLResponse_Empty)(nil),
+		(*InviteTTLResponse_InviteTtlErrorCode)(nil),
+		(*InviteTTLResponse_ServiceErrorCode)(nil),
+		(*InviteTTLResponse_MeshnetErrorCode)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_invite_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   7,
+			NumEnums:      3,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
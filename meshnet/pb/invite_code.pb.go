@@ -0,0 +1,771 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: invite_code.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// InviteCodeErrorCode defines an error specific to the invite codes
+type InviteCodeErrorCode int32
+
+const (
+	// INVALID_INVITE_CODE defines that the invite code does not exist
+	// or has expired
+	InviteCodeErrorCode_INVALID_INVITE_CODE InviteCodeErrorCode = 0
+	// INVITE_CODE_PEER_COUNT defines that no more devices can be added
+	InviteCodeErrorCode_INVITE_CODE_PEER_COUNT InviteCodeErrorCode = 1
+)
+
+// Enum value maps for InviteCodeErrorCode.
+var (
+	InviteCodeErrorCode_name = map[int32]string{
+		0: "INVALID_INVITE_CODE",
+		1: "INVITE_CODE_PEER_COUNT",
+	}
+	InviteCodeErrorCode_value = map[string]int32{
+		"INVALID_INVITE_CODE":    0,
+		"INVITE_CODE_PEER_COUNT": 1,
+	}
+)
+
+func (x InviteCodeErrorCode) Enum() *InviteCodeErrorCode {
+	p := new(InviteCodeErrorCode)
+	*p = x
+	return p
+}
+
+func (x InviteCodeErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InviteCodeErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_invite_code_proto_enumTypes[0].Descriptor()
+}
+
+func (InviteCodeErrorCode) Type() protoreflect.EnumType {
+	return &file_invite_code_proto_enumTypes[0]
+}
+
+func (x InviteCodeErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InviteCodeErrorCode.Descriptor instead.
+func (InviteCodeErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_invite_code_proto_rawDescGZIP(), []int{0}
+}
+
+// CreateInviteCodeRequest defines the permissions granted to the
+// device which redeems the invite code
+type CreateInviteCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// allowIncomingTraffic defines that another peer is allowed
+	// to send traffic to this device
+	AllowIncomingTraffic bool `protobuf:"varint,1,opt,name=allowIncomingTraffic,proto3" json:"allowIncomingTraffic,omitempty"`
+	// AllowTrafficRouting defines that another peer is allowed to
+	// route traffic through this device
+	AllowTrafficRouting bool `protobuf:"varint,2,opt,name=allowTrafficRouting,proto3" json:"allowTrafficRouting,omitempty"`
+	// AllowLocalNetwork defines that another peer is allowed to
+	// access device's local network when routing traffic through this device
+	AllowLocalNetwork bool `protobuf:"varint,3,opt,name=allowLocalNetwork,proto3" json:"allowLocalNetwork,omitempty"`
+	// AllowFileshare defines that another peer is allowed to send files to this device
+	AllowFileshare bool `protobuf:"varint,4,opt,name=allowFileshare,proto3" json:"allowFileshare,omitempty"`
+}
+
+func (x *CreateInviteCodeRequest) Reset() {
+	*x = CreateInviteCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invite_code_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateInviteCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteCodeRequest) ProtoMessage() {}
+
+func (x *CreateInviteCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_code_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteCodeRequest) Descriptor() ([]byte, []int) {
+	return file_invite_code_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateInviteCodeRequest) GetAllowIncomingTraffic() bool {
+	if x != nil {
+		return x.AllowIncomingTraffic
+	}
+	return false
+}
+
+func (x *CreateInviteCodeRequest) GetAllowTrafficRouting() bool {
+	if x != nil {
+		return x.AllowTrafficRouting
+	}
+	return false
+}
+
+func (x *CreateInviteCodeRequest) GetAllowLocalNetwork() bool {
+	if x != nil {
+		return x.AllowLocalNetwork
+	}
+	return false
+}
+
+func (x *CreateInviteCodeRequest) GetAllowFileshare() bool {
+	if x != nil {
+		return x.AllowFileshare
+	}
+	return false
+}
+
+// InviteCode defines a short-lived code used to join the meshnet
+// without an email invitation
+type InviteCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// code is the short code which can be typed in by hand
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// payload is the content to be encoded into a QR code
+	Payload   string                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *InviteCode) Reset() {
+	*x = InviteCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invite_code_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InviteCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteCode) ProtoMessage() {}
+
+func (x *InviteCode) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_code_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteCode.ProtoReflect.Descriptor instead.
+func (*InviteCode) Descriptor() ([]byte, []int) {
+	return file_invite_code_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InviteCode) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *InviteCode) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *InviteCode) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// CreateInviteCodeResponse defines a response for CreateInviteCode
+// request
+type CreateInviteCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*CreateInviteCodeResponse_InviteCode
+	//	*CreateInviteCodeResponse_InviteCodeErrorCode
+	//	*CreateInviteCodeResponse_ServiceErrorCode
+	//	*CreateInviteCodeResponse_MeshnetErrorCode
+	Response isCreateInviteCodeResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *CreateInviteCodeResponse) Reset() {
+	*x = CreateInviteCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invite_code_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateInviteCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteCodeResponse) ProtoMessage() {}
+
+func (x *CreateInviteCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_code_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteCodeResponse.ProtoReflect.Descriptor instead.
+func (*CreateInviteCodeResponse) Descriptor() ([]byte, []int) {
+	return file_invite_code_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *CreateInviteCodeResponse) GetResponse() isCreateInviteCodeResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *CreateInviteCodeResponse) GetInviteCode() *InviteCode {
+	if x, ok := x.GetResponse().(*CreateInviteCodeResponse_InviteCode); ok {
+		return x.InviteCode
+	}
+	return nil
+}
+
+func (x *CreateInviteCodeResponse) GetInviteCodeErrorCode() InviteCodeErrorCode {
+	if x, ok := x.GetResponse().(*CreateInviteCodeResponse_InviteCodeErrorCode); ok {
+		return x.InviteCodeErrorCode
+	}
+	return InviteCodeErrorCode_INVALID_INVITE_CODE
+}
+
+func (x *CreateInviteCodeResponse) GetServiceErrorCode() ServiceErrorCode {
+	if x, ok := x.GetResponse().(*CreateInviteCodeResponse_ServiceErrorCode); ok {
+		return x.ServiceErrorCode
+	}
+	return ServiceErrorCode_NOT_LOGGED_IN
+}
+
+func (x *CreateInviteCodeResponse) GetMeshnetErrorCode() MeshnetErrorCode {
+	if x, ok := x.GetResponse().(*CreateInviteCodeResponse_MeshnetErrorCode); ok {
+		return x.MeshnetErrorCode
+	}
+	return MeshnetErrorCode_NOT_REGISTERED
+}
+
+type isCreateInviteCodeResponse_Response interface {
+	isCreateInviteCodeResponse_Response()
+}
+
+type CreateInviteCodeResponse_InviteCode struct {
+	InviteCode *InviteCode `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3,oneof"`
+}
+
+type CreateInviteCodeResponse_InviteCodeErrorCode struct {
+	InviteCodeErrorCode InviteCodeErrorCode `protobuf:"varint,2,opt,name=invite_code_error_code,json=inviteCodeErrorCode,proto3,enum=meshpb.InviteCodeErrorCode,oneof"`
+}
+
+type CreateInviteCodeResponse_ServiceErrorCode struct {
+	ServiceErrorCode ServiceErrorCode `protobuf:"varint,3,opt,name=service_error_code,json=serviceErrorCode,proto3,enum=meshpb.ServiceErrorCode,oneof"`
+}
+
+type CreateInviteCodeResponse_MeshnetErrorCode struct {
+	MeshnetErrorCode MeshnetErrorCode `protobuf:"varint,4,opt,name=meshnet_error_code,json=meshnetErrorCode,proto3,enum=meshpb.MeshnetErrorCode,oneof"`
+}
+
+func (*CreateInviteCodeResponse_InviteCode) isCreateInviteCodeResponse_Response() {}
+
+func (*CreateInviteCodeResponse_InviteCodeErrorCode) isCreateInviteCodeResponse_Response() {}
+
+func (*CreateInviteCodeResponse_ServiceErrorCode) isCreateInviteCodeResponse_Response() {}
+
+func (*CreateInviteCodeResponse_MeshnetErrorCode) isCreateInviteCodeResponse_Response() {}
+
+// RedeemInviteCodeRequest defines a request to join the meshnet
+// of the invite code creator
+type RedeemInviteCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// code is either the short code or the QR code payload
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// allowIncomingTraffic defines that another peer is allowed
+	// to send traffic to this device
+	AllowIncomingTraffic bool `protobuf:"varint,2,opt,name=allowIncomingTraffic,proto3" json:"allowIncomingTraffic,omitempty"`
+	// AllowTrafficRouting defines that another peer is allowed to
+	// route traffic through this device
+	AllowTrafficRouting bool `protobuf:"varint,3,opt,name=allowTrafficRouting,proto3" json:"allowTrafficRouting,omitempty"`
+	// AllowLocalNetwork defines that another peer is allowed to
+	// access device's local network when routing traffic through this device
+	AllowLocalNetwork bool `protobuf:"varint,4,opt,name=allowLocalNetwork,proto3" json:"allowLocalNetwork,omitempty"`
+	// AllowFileshare defines that another peer is allowed to send files to this device
+	AllowFileshare bool `protobuf:"varint,5,opt,name=allowFileshare,proto3" json:"allowFileshare,omitempty"`
+}
+
+func (x *RedeemInviteCodeRequest) Reset() {
+	*x = RedeemInviteCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invite_code_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeemInviteCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemInviteCodeRequest) ProtoMessage() {}
+
+func (x *RedeemInviteCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_code_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemInviteCodeRequest.ProtoReflect.Descriptor instead.
+func (*RedeemInviteCodeRequest) Descriptor() ([]byte, []int) {
+	return file_invite_code_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RedeemInviteCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RedeemInviteCodeRequest) GetAllowIncomingTraffic() bool {
+	if x != nil {
+		return x.AllowIncomingTraffic
+	}
+	return false
+}
+
+func (x *RedeemInviteCodeRequest) GetAllowTrafficRouting() bool {
+	if x != nil {
+		return x.AllowTrafficRouting
+	}
+	return false
+}
+
+func (x *RedeemInviteCodeRequest) GetAllowLocalNetwork() bool {
+	if x != nil {
+		return x.AllowLocalNetwork
+	}
+	return false
+}
+
+func (x *RedeemInviteCodeRequest) GetAllowFileshare() bool {
+	if x != nil {
+		return x.AllowFileshare
+	}
+	return false
+}
+
+// RedeemInviteCodeResponse defines a response for RedeemInviteCode
+// request
+type RedeemInviteCodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*RedeemInviteCodeResponse_Empty
+	//	*RedeemInviteCodeResponse_InviteCodeErrorCode
+	//	*RedeemInviteCodeResponse_ServiceErrorCode
+	//	*RedeemInviteCodeResponse_MeshnetErrorCode
+	Response isRedeemInviteCodeResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *RedeemInviteCodeResponse) Reset() {
+	*x = RedeemInviteCodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_invite_code_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RedeemInviteCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemInviteCodeResponse) ProtoMessage() {}
+
+func (x *RedeemInviteCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_invite_code_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemInviteCodeResponse.ProtoReflect.Descriptor instead.
+func (*RedeemInviteCodeResponse) Descriptor() ([]byte, []int) {
+	return file_invite_code_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *RedeemInviteCodeResponse) GetResponse() isRedeemInviteCodeResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *RedeemInviteCodeResponse) GetEmpty() *Empty {
+	if x, ok := x.GetResponse().(*RedeemInviteCodeResponse_Empty); ok {
+		return x.Empty
+	}
+	return nil
+}
+
+func (x *RedeemInviteCodeResponse) GetInviteCodeErrorCode() InviteCodeErrorCode {
+	if x, ok := x.GetResponse().(*RedeemInviteCodeResponse_InviteCodeErrorCode); ok {
+		return x.InviteCodeErrorCode
+	}
+	return InviteCodeErrorCode_INVALID_INVITE_CODE
+}
+
+func (x *RedeemInviteCodeResponse) GetServiceErrorCode() ServiceErrorCode {
+	if x, ok := x.GetResponse().(*RedeemInviteCodeResponse_ServiceErrorCode); ok {
+		return x.ServiceErrorCode
+	}
+	return ServiceErrorCode_NOT_LOGGED_IN
+}
+
+func (x *RedeemInviteCodeResponse) GetMeshnetErrorCode() MeshnetErrorCode {
+	if x, ok := x.GetResponse().(*RedeemInviteCodeResponse_MeshnetErrorCode); ok {
+		return x.MeshnetErrorCode
+	}
+	return MeshnetErrorCode_NOT_REGISTERED
+}
+
+type isRedeemInviteCodeResponse_Response interface {
+	isRedeemInviteCodeResponse_Response()
+}
+
+type RedeemInviteCodeResponse_Empty struct {
+	Empty *Empty `protobuf:"bytes,1,opt,name=empty,proto3,oneof"`
+}
+
+type RedeemInviteCodeResponse_InviteCodeErrorCode struct {
+	InviteCodeErrorCode InviteCodeErrorCode `protobuf:"varint,2,opt,name=invite_code_error_code,json=inviteCodeErrorCode,proto3,enum=meshpb.InviteCodeErrorCode,oneof"`
+}
+
+type RedeemInviteCodeResponse_ServiceErrorCode struct {
+	ServiceErrorCode ServiceErrorCode `protobuf:"varint,3,opt,name=service_error_code,json=serviceErrorCode,proto3,enum=meshpb.ServiceErrorCode,oneof"`
+}
+
+type RedeemInviteCodeResponse_MeshnetErrorCode struct {
+	MeshnetErrorCode MeshnetErrorCode `protobuf:"varint,4,opt,name=meshnet_error_code,json=meshnetErrorCode,proto3,enum=meshpb.MeshnetErrorCode,oneof"`
+}
+
+func (*RedeemInviteCodeResponse_Empty) isRedeemInviteCodeResponse_Response() {}
+
+func (*RedeemInviteCodeResponse_InviteCodeErrorCode) isRedeemInviteCodeResponse_Response() {}
+
+func (*RedeemInviteCodeResponse_ServiceErrorCode) isRedeemInviteCodeResponse_Response() {}
+
+func (*RedeemInviteCodeResponse_MeshnetErrorCode) isRedeemInviteCodeResponse_Response() {}
+
+var File_invite_code_proto protoreflect.FileDescriptor
+
+var file_invite_code_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x1a, 0x0b, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xd5, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a,
+	0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x54, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69,
+	0x63, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0x75, 0x0a, 0x0a, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0xc5, 0x02, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x52, 0x0a, 0x16, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x48, 0x00, 0x52, 0x13, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00,
+	0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68,
+	0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x64,
+	0x65, 0x65, 0x6d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x12, 0x30, 0x0a, 0x13,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2c,
+	0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x26, 0x0a, 0x0e,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x22, 0xb5, 0x02, 0x0a, 0x18, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48,
+	0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70,
+	0x62, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65,
+	0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68,
+	0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10,
+	0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x4a, 0x0a, 0x13,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49,
+	0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x49, 0x4e, 0x56, 0x49, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x45, 0x45, 0x52,
+	0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x01, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75,
+	0x78, 0x2f, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_invite_code_proto_rawDescOnce sync.Once
+	file_invite_code_proto_rawDescData = file_invite_code_proto_rawDesc
+)
+
+func file_invite_code_proto_rawDescGZIP() []byte {
+	file_invite_code_proto_rawDescOnce.Do(func() {
+		file_invite_code_proto_rawDescData = protoimpl.X.CompressGZIP(file_invite_code_proto_rawDescData)
+	})
+	return file_invite_code_proto_rawDescData
+}
+
+var file_invite_code_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_invite_code_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_invite_code_proto_goTypes = []interface{}{
+	(InviteCodeErrorCode)(0),         // 0: meshpb.InviteCodeErrorCode
+	(*CreateInviteCodeRequest)(nil),  // 1: meshpb.CreateInviteCodeRequest
+	(*InviteCode)(nil),               // 2: meshpb.InviteCode
+	(*CreateInviteCodeResponse)(nil), // 3: meshpb.CreateInviteCodeResponse
+	(*RedeemInviteCodeRequest)(nil),  // 4: meshpb.RedeemInviteCodeRequest
+	(*RedeemInviteCodeResponse)(nil), // 5: meshpb.RedeemInviteCodeResponse
+	(*timestamppb.Timestamp)(nil),    // 6: google.protobuf.Timestamp
+	(ServiceErrorCode)(0),            // 7: meshpb.ServiceErrorCode
+	(MeshnetErrorCode)(0),            // 8: meshpb.MeshnetErrorCode
+	(*Empty)(nil),                    // 9: meshpb.Empty
+}
+var file_invite_code_proto_depIdxs = []int32{
+	6, // 0: meshpb.InviteCode.expires_at:type_name -> google.protobuf.Timestamp
+	2, // 1: meshpb.CreateInviteCodeResponse.invite_code:type_name -> meshpb.InviteCode
+	0, // 2: meshpb.CreateInviteCodeResponse.invite_code_error_code:type_name -> meshpb.InviteCodeErrorCode
+	7, // 3: meshpb.CreateInviteCodeResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	8, // 4: meshpb.CreateInviteCodeResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	9, // 5: meshpb.RedeemInviteCodeResponse.empty:type_name -> meshpb.Empty
+	0, // 6: meshpb.RedeemInviteCodeResponse.invite_code_error_code:type_name -> meshpb.InviteCodeErrorCode
+	7, // 7: meshpb.RedeemInviteCodeResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	8, // 8: meshpb.RedeemInviteCodeResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_invite_code_proto_init() }
+func file_invite_code_proto_init() {
+	if File_invite_code_proto != nil {
+		return
+	}
+	file_empty_proto_init()
+	file_service_response_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_invite_code_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInviteCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invite_code_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InviteCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invite_code_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateInviteCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invite_code_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeemInviteCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_invite_code_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RedeemInviteCodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_invite_code_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*CreateInviteCodeResponse_InviteCode)(nil),
+		(*CreateInviteCodeResponse_InviteCodeErrorCode)(nil),
+		(*CreateInviteCodeResponse_ServiceErrorCode)(nil),
+		(*CreateInviteCodeResponse_MeshnetErrorCode)(nil),
+	}
+	file_invite_code_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*RedeemInviteCodeResponse_Empty)(nil),
+		(*RedeemInviteCodeResponse_InviteCodeErrorCode)(nil),
+		(*RedeemInviteCodeResponse_ServiceErrorCode)(nil),
+		(*RedeemInviteCodeResponse_MeshnetErrorCode)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_invite_code_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_invite_code_proto_goTypes,
+		DependencyIndexes: file_invite_code_proto_depIdxs,
+		EnumInfos:         file_invite_code_proto_enumTypes,
+		MessageInfos:      file_invite_code_proto_msgTypes,
+	}.Build()
+	File_invite_code_proto = out.File
+	file_invite_code_proto_rawDesc = nil
+	file_invite_code_proto_goTypes = nil
+	file_invite_code_proto_depIdxs = nil
+}
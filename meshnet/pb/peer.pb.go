@@ -67,6 +67,56 @@ func (PeerStatus) EnumDescriptor() ([]byte, []int) {
 	return file_peer_proto_rawDescGZIP(), []int{0}
 }
 
+// PeerPath defines how the connection with the peer is established
+type PeerPath int32
+
+const (
+	PeerPath_PATH_UNKNOWN PeerPath = 0
+	PeerPath_PATH_RELAY   PeerPath = 1
+	PeerPath_PATH_DIRECT  PeerPath = 2
+)
+
+// Enum value maps for PeerPath.
+var (
+	PeerPath_name = map[int32]string{
+		0: "PATH_UNKNOWN",
+		1: "PATH_RELAY",
+		2: "PATH_DIRECT",
+	}
+	PeerPath_value = map[string]int32{
+		"PATH_UNKNOWN": 0,
+		"PATH_RELAY":   1,
+		"PATH_DIRECT":  2,
+	}
+)
+
+func (x PeerPath) Enum() *PeerPath {
+	p := new(PeerPath)
+	*p = x
+	return p
+}
+
+func (x PeerPath) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeerPath) Descriptor() protoreflect.EnumDescriptor {
+	return file_peer_proto_enumTypes[1].Descriptor()
+}
+
+func (PeerPath) Type() protoreflect.EnumType {
+	return &file_peer_proto_enumTypes[1]
+}
+
+func (x PeerPath) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeerPath.Descriptor instead.
+func (PeerPath) EnumDescriptor() ([]byte, []int) {
+	return file_peer_proto_rawDescGZIP(), []int{1}
+}
+
 // UpdatePeerErrorCode defines an error code on updating a peer within
 // the meshnet
 type UpdatePeerErrorCode int32
@@ -96,11 +146,11 @@ func (x UpdatePeerErrorCode) String() string {
 }
 
 func (UpdatePeerErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_peer_proto_enumTypes[1].Descriptor()
+	return file_peer_proto_enumTypes[2].Descriptor()
 }
 
 func (UpdatePeerErrorCode) Type() protoreflect.EnumType {
-	return &file_peer_proto_enumTypes[1]
+	return &file_peer_proto_enumTypes[2]
 }
 
 func (x UpdatePeerErrorCode) Number() protoreflect.EnumNumber {
@@ -109,7 +159,7 @@ func (x UpdatePeerErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UpdatePeerErrorCode.Descriptor instead.
 func (UpdatePeerErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{1}
+	return file_peer_proto_rawDescGZIP(), []int{2}
 }
 
 // ChangeNicknameErrorCode defines the errors that occur at meshnet nickname changes
@@ -167,11 +217,11 @@ func (x ChangeNicknameErrorCode) String() string {
 }
 
 func (ChangeNicknameErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_peer_proto_enumTypes[2].Descriptor()
+	return file_peer_proto_enumTypes[3].Descriptor()
 }
 
 func (ChangeNicknameErrorCode) Type() protoreflect.EnumType {
-	return &file_peer_proto_enumTypes[2]
+	return &file_peer_proto_enumTypes[3]
 }
 
 func (x ChangeNicknameErrorCode) Number() protoreflect.EnumNumber {
@@ -180,7 +230,105 @@ func (x ChangeNicknameErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ChangeNicknameErrorCode.Descriptor instead.
 func (ChangeNicknameErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{2}
+	return file_peer_proto_rawDescGZIP(), []int{3}
+}
+
+// PeerNoteErrorCode defines the errors that occur at meshnet peer note and tags changes
+type PeerNoteErrorCode int32
+
+const (
+	PeerNoteErrorCode_PEER_NOTE_TOO_LONG PeerNoteErrorCode = 0
+	PeerNoteErrorCode_INVALID_PEER_TAG   PeerNoteErrorCode = 1
+	PeerNoteErrorCode_TOO_MANY_PEER_TAGS PeerNoteErrorCode = 2
+)
+
+// Enum value maps for PeerNoteErrorCode.
+var (
+	PeerNoteErrorCode_name = map[int32]string{
+		0: "PEER_NOTE_TOO_LONG",
+		1: "INVALID_PEER_TAG",
+		2: "TOO_MANY_PEER_TAGS",
+	}
+	PeerNoteErrorCode_value = map[string]int32{
+		"PEER_NOTE_TOO_LONG": 0,
+		"INVALID_PEER_TAG":   1,
+		"TOO_MANY_PEER_TAGS": 2,
+	}
+)
+
+func (x PeerNoteErrorCode) Enum() *PeerNoteErrorCode {
+	p := new(PeerNoteErrorCode)
+	*p = x
+	return p
+}
+
+func (x PeerNoteErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeerNoteErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_peer_proto_enumTypes[4].Descriptor()
+}
+
+func (PeerNoteErrorCode) Type() protoreflect.EnumType {
+	return &file_peer_proto_enumTypes[4]
+}
+
+func (x PeerNoteErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeerNoteErrorCode.Descriptor instead.
+func (PeerNoteErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_peer_proto_rawDescGZIP(), []int{4}
+}
+
+// PeerBandwidthLimitErrorCode defines the errors that occur at meshnet peer
+// bandwidth limit changes
+type PeerBandwidthLimitErrorCode int32
+
+const (
+	PeerBandwidthLimitErrorCode_INVALID_BANDWIDTH_LIMIT     PeerBandwidthLimitErrorCode = 0
+	PeerBandwidthLimitErrorCode_BANDWIDTH_LIMIT_ALREADY_SET PeerBandwidthLimitErrorCode = 1
+)
+
+// Enum value maps for PeerBandwidthLimitErrorCode.
+var (
+	PeerBandwidthLimitErrorCode_name = map[int32]string{
+		0: "INVALID_BANDWIDTH_LIMIT",
+		1: "BANDWIDTH_LIMIT_ALREADY_SET",
+	}
+	PeerBandwidthLimitErrorCode_value = map[string]int32{
+		"INVALID_BANDWIDTH_LIMIT":     0,
+		"BANDWIDTH_LIMIT_ALREADY_SET": 1,
+	}
+)
+
+func (x PeerBandwidthLimitErrorCode) Enum() *PeerBandwidthLimitErrorCode {
+	p := new(PeerBandwidthLimitErrorCode)
+	*p = x
+	return p
+}
+
+func (x PeerBandwidthLimitErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeerBandwidthLimitErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_peer_proto_enumTypes[5].Descriptor()
+}
+
+func (PeerBandwidthLimitErrorCode) Type() protoreflect.EnumType {
+	return &file_peer_proto_enumTypes[5]
+}
+
+func (x PeerBandwidthLimitErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeerBandwidthLimitErrorCode.Descriptor instead.
+func (PeerBandwidthLimitErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_peer_proto_rawDescGZIP(), []int{5}
 }
 
 // AllowRoutingErrorCode defines an error code which is specific to
@@ -212,11 +360,11 @@ func (x AllowRoutingErrorCode) String() string {
 }
 
 func (AllowRoutingErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_peer_proto_enumTypes[3].Descriptor()
+	return file_peer_proto_enumTypes[6].Descriptor()
 }
 
 func (AllowRoutingErrorCode) Type() protoreflect.EnumType {
-	return &file_peer_proto_enumTypes[3]
+	return &file_peer_proto_enumTypes[6]
 }
 
 func (x AllowRoutingErrorCode) Number() protoreflect.EnumNumber {
@@ -225,7 +373,7 @@ func (x AllowRoutingErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AllowRoutingErrorCode.Descriptor instead.
 func (AllowRoutingErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{3}
+	return file_peer_proto_rawDescGZIP(), []int{6}
 }
 
 // DenyRoutingErrorCode defines an error code which is specific to
@@ -257,11 +405,11 @@ func (x DenyRoutingErrorCode) String() string {
 }
 
 func (DenyRoutingErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_peer_proto_enumTypes[4].Descriptor()
+	return file_peer_proto_enumTypes[7].Descriptor()
 }
 
 func (DenyRoutingErrorCode) Type() protoreflect.EnumType {
-	return &file_peer_proto_enumTypes[4]
+	return &file_peer_proto_enumTypes[7]
 }
 
 func (x DenyRoutingErrorCode) Number() protoreflect.EnumNumber {
@@ -270,7 +418,7 @@ func (x DenyRoutingErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DenyRoutingErrorCode.Descriptor instead.
 func (DenyRoutingErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{4}
+	return file_peer_proto_rawDescGZIP(), []int{7}
 }
 
 // AllowIncomingErrorCode defines an error code which is specific to
@@ -302,11 +450,11 @@ func (x AllowIncomingErrorCode) String() string {
 }
 
 func (AllowIncomingErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_peer_proto_enumTypes[5].Descriptor()
+	return file_peer_proto_enumTypes[8].Descriptor()
 }
 
 func (AllowIncomingErrorCode) Type() protoreflect.EnumType {
-	return &file_peer_proto_enumTypes[5]
+	return &file_peer_proto_enumTypes[8]
 }
 
 func (x AllowIncomingErrorCode) Number() protoreflect.EnumNumber {
@@ -315,7 +463,7 @@ func (x AllowIncomingErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AllowIncomingErrorCode.Descriptor instead.
 func (AllowIncomingErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{5}
+	return file_peer_proto_rawDescGZIP(), []int{8}
 }
 
 // DenyIncomingErrorCode defines an error code which is specific to
@@ -347,11 +495,11 @@ func (x DenyIncomingErrorCode) String() string {
 }
 
 func (DenyIncomingErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_peer_proto_enumTypes[6].Descriptor()
+	return file_peer_proto_enumTypes[9].Descriptor()
 }
 
 func (DenyIncomingErrorCode) Type() protoreflect.EnumType {
-	return &file_peer_proto_enumTypes[6]
+	return &file_peer_proto_enumTypes[9]
 }
 
 func (x DenyIncomingErrorCode) Number() protoreflect.EnumNumber {
@@ -360,7 +508,7 @@ func (x DenyIncomingErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DenyIncomingErrorCode.Descriptor instead.
 func (DenyIncomingErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{6}
+	return file_peer_proto_rawDescGZIP(), []int{9}
 }
 
 // AllowLocalNetworkErrorCode defines an error code which is specific to
@@ -392,11 +540,11 @@ func (x AllowLocalNetworkErrorCode) String() string {
 }
 
 func (AllowLocalNetworkErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_peer_proto_enumTypes[7].Descriptor()
+	return file_peer_proto_enumTypes[10].Descriptor()
 }
 
 func (AllowLocalNetworkErrorCode) Type() protoreflect.EnumType {
-	return &file_peer_proto_enumTypes[7]
+	return &file_peer_proto_enumTypes[10]
 }
 
 func (x AllowLocalNetworkErrorCode) Number() protoreflect.EnumNumber {
@@ -405,7 +553,7 @@ func (x AllowLocalNetworkErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AllowLocalNetworkErrorCode.Descriptor instead.
 func (AllowLocalNetworkErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{7}
+	return file_peer_proto_rawDescGZIP(), []int{10}
 }
 
 // DenyLocalNetworkErrorCode defines an error code which is specific to
@@ -437,11 +585,11 @@ func (x DenyLocalNetworkErrorCode) String() string {
 }
 
 func (DenyLocalNetworkErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_peer_proto_enumTypes[8].Descriptor()
+	return file_peer_proto_enumTypes[11].Descriptor()
 }
 
 func (DenyLocalNetworkErrorCode) Type() protoreflect.EnumType {
-	return &file_peer_proto_enumTypes[8]
+	return &file_peer_proto_enumTypes[11]
 }
 
 func (x DenyLocalNetworkErrorCode) Number() protoreflect.EnumNumber {
@@ -450,7 +598,7 @@ func (x DenyLocalNetworkErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DenyLocalNetworkErrorCode.Descriptor instead.
 func (DenyLocalNetworkErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{8}
+	return file_peer_proto_rawDescGZIP(), []int{11}
 }
 
 type AllowFileshareErrorCode int32
@@ -480,11 +628,11 @@ func (x AllowFileshareErrorCode) String() string {
 }
 
 func (AllowFileshareErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_peer_proto_enumTypes[9].Descriptor()
+	return file_peer_proto_enumTypes[12].Descriptor()
 }
 
 func (AllowFileshareErrorCode) Type() protoreflect.EnumType {
-	return &file_peer_proto_enumTypes[9]
+	return &file_peer_proto_enumTypes[12]
 }
 
 func (x AllowFileshareErrorCode) Number() protoreflect.EnumNumber {
@@ -493,7 +641,7 @@ func (x AllowFileshareErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AllowFileshareErrorCode.Descriptor instead.
 func (AllowFileshareErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{9}
+	return file_peer_proto_rawDescGZIP(), []int{12}
 }
 
 type DenyFileshareErrorCode int32
@@ -523,11 +671,11 @@ func (x DenyFileshareErrorCode) String() string {
 }
 
 func (DenyFileshareErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_peer_proto_enumTypes[10].Descriptor()
+	return file_peer_proto_enumTypes[13].Descriptor()
 }
 
 func (DenyFileshareErrorCode) Type() protoreflect.EnumType {
-	return &file_peer_proto_enumTypes[10]
+	return &file_peer_proto_enumTypes[13]
 }
 
 func (x DenyFileshareErrorCode) Number() protoreflect.EnumNumber {
@@ -536,7 +684,7 @@ func (x DenyFileshareErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DenyFileshareErrorCode.Descriptor instead.
 func (DenyFileshareErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{10}
+	return file_peer_proto_rawDescGZIP(), []int{13}
 }
 
 type EnableAutomaticFileshareErrorCode int32
@@ -566,11 +714,11 @@ func (x EnableAutomaticFileshareErrorCode) String() string {
 }
 
 func (EnableAutomaticFileshareErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_peer_proto_enumTypes[11].Descriptor()
+	return file_peer_proto_enumTypes[14].Descriptor()
 }
 
 func (EnableAutomaticFileshareErrorCode) Type() protoreflect.EnumType {
-	return &file_peer_proto_enumTypes[11]
+	return &file_peer_proto_enumTypes[14]
 }
 
 func (x EnableAutomaticFileshareErrorCode) Number() protoreflect.EnumNumber {
@@ -579,7 +727,7 @@ func (x EnableAutomaticFileshareErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EnableAutomaticFileshareErrorCode.Descriptor instead.
 func (EnableAutomaticFileshareErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{11}
+	return file_peer_proto_rawDescGZIP(), []int{14}
 }
 
 type DisableAutomaticFileshareErrorCode int32
@@ -609,11 +757,11 @@ func (x DisableAutomaticFileshareErrorCode) String() string {
 }
 
 func (DisableAutomaticFileshareErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_peer_proto_enumTypes[12].Descriptor()
+	return file_peer_proto_enumTypes[15].Descriptor()
 }
 
 func (DisableAutomaticFileshareErrorCode) Type() protoreflect.EnumType {
-	return &file_peer_proto_enumTypes[12]
+	return &file_peer_proto_enumTypes[15]
 }
 
 func (x DisableAutomaticFileshareErrorCode) Number() protoreflect.EnumNumber {
@@ -622,7 +770,7 @@ func (x DisableAutomaticFileshareErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DisableAutomaticFileshareErrorCode.Descriptor instead.
 func (DisableAutomaticFileshareErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{12}
+	return file_peer_proto_rawDescGZIP(), []int{15}
 }
 
 type ConnectErrorCode int32
@@ -667,11 +815,11 @@ func (x ConnectErrorCode) String() string {
 }
 
 func (ConnectErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_peer_proto_enumTypes[13].Descriptor()
+	return file_peer_proto_enumTypes[16].Descriptor()
 }
 
 func (ConnectErrorCode) Type() protoreflect.EnumType {
-	return &file_peer_proto_enumTypes[13]
+	return &file_peer_proto_enumTypes[16]
 }
 
 func (x ConnectErrorCode) Number() protoreflect.EnumNumber {
@@ -680,7 +828,7 @@ func (x ConnectErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ConnectErrorCode.Descriptor instead.
 func (ConnectErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{13}
+	return file_peer_proto_rawDescGZIP(), []int{16}
 }
 
 // GetPeersResponse defines
@@ -869,6 +1017,20 @@ type Peer struct {
 	AlwaysAcceptFiles     bool       `protobuf:"varint,19,opt,name=always_accept_files,json=alwaysAcceptFiles,proto3" json:"always_accept_files,omitempty"`
 	Status                PeerStatus `protobuf:"varint,14,opt,name=status,proto3,enum=meshpb.PeerStatus" json:"status,omitempty"`
 	Nickname              string     `protobuf:"bytes,20,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	// note is a freeform description of the peer
+	Note string   `protobuf:"bytes,21,opt,name=note,proto3" json:"note,omitempty"`
+	Tags []string `protobuf:"bytes,22,rep,name=tags,proto3" json:"tags,omitempty"`
+	// path is known only for the connected peers
+	Path PeerPath `protobuf:"varint,23,opt,name=path,proto3,enum=meshpb.PeerPath" json:"path,omitempty"`
+	// rtt is the round trip time in nanoseconds, 0 when it was not
+	// measured and -1 when the peer did not respond
+	Rtt int64 `protobuf:"varint,24,opt,name=rtt,proto3" json:"rtt,omitempty"`
+	// advertised_subnets are reachable through the peer when it allows
+	// traffic routing
+	AdvertisedSubnets []string `protobuf:"bytes,25,rep,name=advertised_subnets,json=advertisedSubnets,proto3" json:"advertised_subnets,omitempty"`
+	// bandwidth_limit caps the traffic the peer can route through this
+	// device in kbit/s, 0 when unlimited
+	BandwidthLimit uint32 `protobuf:"varint,26,opt,name=bandwidth_limit,json=bandwidthLimit,proto3" json:"bandwidth_limit,omitempty"`
 }
 
 func (x *Peer) Reset() {
@@ -1043,6 +1205,48 @@ func (x *Peer) GetNickname() string {
 	return ""
 }
 
+func (x *Peer) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Peer) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Peer) GetPath() PeerPath {
+	if x != nil {
+		return x.Path
+	}
+	return PeerPath_PATH_UNKNOWN
+}
+
+func (x *Peer) GetRtt() int64 {
+	if x != nil {
+		return x.Rtt
+	}
+	return 0
+}
+
+func (x *Peer) GetAdvertisedSubnets() []string {
+	if x != nil {
+		return x.AdvertisedSubnets
+	}
+	return nil
+}
+
+func (x *Peer) GetBandwidthLimit() uint32 {
+	if x != nil {
+		return x.BandwidthLimit
+	}
+	return 0
+}
+
 // UpdatePeerRequest defines a request to remove a peer from a meshnet
 type UpdatePeerRequest struct {
 	state         protoimpl.MessageState
@@ -1429,24 +1633,19 @@ func (*ChangeNicknameResponse_MeshnetErrorCode) isChangeNicknameResponse_Respons
 
 func (*ChangeNicknameResponse_ChangeNicknameErrorCode) isChangeNicknameResponse_Response() {}
 
-// AllowRoutingResponse defines a response for allow routing request
-type AllowRoutingResponse struct {
+// SetPeerNoteRequest defines a request to replace the note of a meshnet peer
+type SetPeerNoteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Response:
-	//
-	//	*AllowRoutingResponse_Empty
-	//	*AllowRoutingResponse_UpdatePeerErrorCode
-	//	*AllowRoutingResponse_AllowRoutingErrorCode
-	//	*AllowRoutingResponse_ServiceErrorCode
-	//	*AllowRoutingResponse_MeshnetErrorCode
-	Response isAllowRoutingResponse_Response `protobuf_oneof:"response"`
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// note is removed when empty
+	Note string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
 }
 
-func (x *AllowRoutingResponse) Reset() {
-	*x = AllowRoutingResponse{}
+func (x *SetPeerNoteRequest) Reset() {
+	*x = SetPeerNoteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_peer_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1454,13 +1653,13 @@ func (x *AllowRoutingResponse) Reset() {
 	}
 }
 
-func (x *AllowRoutingResponse) String() string {
+func (x *SetPeerNoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllowRoutingResponse) ProtoMessage() {}
+func (*SetPeerNoteRequest) ProtoMessage() {}
 
-func (x *AllowRoutingResponse) ProtoReflect() protoreflect.Message {
+func (x *SetPeerNoteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_peer_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1472,59 +1671,486 @@ func (x *AllowRoutingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllowRoutingResponse.ProtoReflect.Descriptor instead.
-func (*AllowRoutingResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetPeerNoteRequest.ProtoReflect.Descriptor instead.
+func (*SetPeerNoteRequest) Descriptor() ([]byte, []int) {
 	return file_peer_proto_rawDescGZIP(), []int{8}
 }
 
-func (m *AllowRoutingResponse) GetResponse() isAllowRoutingResponse_Response {
-	if m != nil {
-		return m.Response
+func (x *SetPeerNoteRequest) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
 	}
-	return nil
+	return ""
 }
 
-func (x *AllowRoutingResponse) GetEmpty() *Empty {
-	if x, ok := x.GetResponse().(*AllowRoutingResponse_Empty); ok {
-		return x.Empty
+func (x *SetPeerNoteRequest) GetNote() string {
+	if x != nil {
+		return x.Note
 	}
-	return nil
+	return ""
 }
 
-func (x *AllowRoutingResponse) GetUpdatePeerErrorCode() UpdatePeerErrorCode {
-	if x, ok := x.GetResponse().(*AllowRoutingResponse_UpdatePeerErrorCode); ok {
-		return x.UpdatePeerErrorCode
-	}
-	return UpdatePeerErrorCode_PEER_NOT_FOUND
+// SetPeerTagsRequest defines a request to replace the tags of a meshnet peer
+type SetPeerTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// tags are removed when empty
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
-func (x *AllowRoutingResponse) GetAllowRoutingErrorCode() AllowRoutingErrorCode {
-	if x, ok := x.GetResponse().(*AllowRoutingResponse_AllowRoutingErrorCode); ok {
-		return x.AllowRoutingErrorCode
+func (x *SetPeerTagsRequest) Reset() {
+	*x = SetPeerTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return AllowRoutingErrorCode_ROUTING_ALREADY_ALLOWED
 }
 
-func (x *AllowRoutingResponse) GetServiceErrorCode() ServiceErrorCode {
-	if x, ok := x.GetResponse().(*AllowRoutingResponse_ServiceErrorCode); ok {
-		return x.ServiceErrorCode
-	}
-	return ServiceErrorCode_NOT_LOGGED_IN
+func (x *SetPeerTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AllowRoutingResponse) GetMeshnetErrorCode() MeshnetErrorCode {
-	if x, ok := x.GetResponse().(*AllowRoutingResponse_MeshnetErrorCode); ok {
-		return x.MeshnetErrorCode
+func (*SetPeerTagsRequest) ProtoMessage() {}
+
+func (x *SetPeerTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return MeshnetErrorCode_NOT_REGISTERED
+	return mi.MessageOf(x)
 }
 
-type isAllowRoutingResponse_Response interface {
-	isAllowRoutingResponse_Response()
+// Deprecated: Use SetPeerTagsRequest.ProtoReflect.Descriptor instead.
+func (*SetPeerTagsRequest) Descriptor() ([]byte, []int) {
+	return file_peer_proto_rawDescGZIP(), []int{9}
 }
 
-type AllowRoutingResponse_Empty struct {
-	Empty *Empty `protobuf:"bytes,1,opt,name=empty,proto3,oneof"`
+func (x *SetPeerTagsRequest) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *SetPeerTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// PeerNoteResponse defines a response to the change of the note or tags of a peer
+type PeerNoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*PeerNoteResponse_Empty
+	//	*PeerNoteResponse_UpdatePeerErrorCode
+	//	*PeerNoteResponse_PeerNoteErrorCode
+	//	*PeerNoteResponse_ServiceErrorCode
+	//	*PeerNoteResponse_MeshnetErrorCode
+	Response isPeerNoteResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *PeerNoteResponse) Reset() {
+	*x = PeerNoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerNoteResponse) ProtoMessage() {}
+
+func (x *PeerNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerNoteResponse.ProtoReflect.Descriptor instead.
+func (*PeerNoteResponse) Descriptor() ([]byte, []int) {
+	return file_peer_proto_rawDescGZIP(), []int{10}
+}
+
+func (m *PeerNoteResponse) GetResponse() isPeerNoteResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *PeerNoteResponse) GetEmpty() *Empty {
+	if x, ok := x.GetResponse().(*PeerNoteResponse_Empty); ok {
+		return x.Empty
+	}
+	return nil
+}
+
+func (x *PeerNoteResponse) GetUpdatePeerErrorCode() UpdatePeerErrorCode {
+	if x, ok := x.GetResponse().(*PeerNoteResponse_UpdatePeerErrorCode); ok {
+		return x.UpdatePeerErrorCode
+	}
+	return UpdatePeerErrorCode_PEER_NOT_FOUND
+}
+
+func (x *PeerNoteResponse) GetPeerNoteErrorCode() PeerNoteErrorCode {
+	if x, ok := x.GetResponse().(*PeerNoteResponse_PeerNoteErrorCode); ok {
+		return x.PeerNoteErrorCode
+	}
+	return PeerNoteErrorCode_PEER_NOTE_TOO_LONG
+}
+
+func (x *PeerNoteResponse) GetServiceErrorCode() ServiceErrorCode {
+	if x, ok := x.GetResponse().(*PeerNoteResponse_ServiceErrorCode); ok {
+		return x.ServiceErrorCode
+	}
+	return ServiceErrorCode_NOT_LOGGED_IN
+}
+
+func (x *PeerNoteResponse) GetMeshnetErrorCode() MeshnetErrorCode {
+	if x, ok := x.GetResponse().(*PeerNoteResponse_MeshnetErrorCode); ok {
+		return x.MeshnetErrorCode
+	}
+	return MeshnetErrorCode_NOT_REGISTERED
+}
+
+type isPeerNoteResponse_Response interface {
+	isPeerNoteResponse_Response()
+}
+
+type PeerNoteResponse_Empty struct {
+	Empty *Empty `protobuf:"bytes,1,opt,name=empty,proto3,oneof"`
+}
+
+type PeerNoteResponse_UpdatePeerErrorCode struct {
+	UpdatePeerErrorCode UpdatePeerErrorCode `protobuf:"varint,2,opt,name=update_peer_error_code,json=updatePeerErrorCode,proto3,enum=meshpb.UpdatePeerErrorCode,oneof"`
+}
+
+type PeerNoteResponse_PeerNoteErrorCode struct {
+	PeerNoteErrorCode PeerNoteErrorCode `protobuf:"varint,3,opt,name=peer_note_error_code,json=peerNoteErrorCode,proto3,enum=meshpb.PeerNoteErrorCode,oneof"`
+}
+
+type PeerNoteResponse_ServiceErrorCode struct {
+	ServiceErrorCode ServiceErrorCode `protobuf:"varint,4,opt,name=service_error_code,json=serviceErrorCode,proto3,enum=meshpb.ServiceErrorCode,oneof"`
+}
+
+type PeerNoteResponse_MeshnetErrorCode struct {
+	MeshnetErrorCode MeshnetErrorCode `protobuf:"varint,5,opt,name=meshnet_error_code,json=meshnetErrorCode,proto3,enum=meshpb.MeshnetErrorCode,oneof"`
+}
+
+func (*PeerNoteResponse_Empty) isPeerNoteResponse_Response() {}
+
+func (*PeerNoteResponse_UpdatePeerErrorCode) isPeerNoteResponse_Response() {}
+
+func (*PeerNoteResponse_PeerNoteErrorCode) isPeerNoteResponse_Response() {}
+
+func (*PeerNoteResponse_ServiceErrorCode) isPeerNoteResponse_Response() {}
+
+func (*PeerNoteResponse_MeshnetErrorCode) isPeerNoteResponse_Response() {}
+
+// SetPeerBandwidthLimitRequest defines a request to limit the traffic routed
+// by a meshnet peer through this device
+type SetPeerBandwidthLimitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	// limit is in kbit/s, it is removed when 0
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *SetPeerBandwidthLimitRequest) Reset() {
+	*x = SetPeerBandwidthLimitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPeerBandwidthLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPeerBandwidthLimitRequest) ProtoMessage() {}
+
+func (x *SetPeerBandwidthLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPeerBandwidthLimitRequest.ProtoReflect.Descriptor instead.
+func (*SetPeerBandwidthLimitRequest) Descriptor() ([]byte, []int) {
+	return file_peer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetPeerBandwidthLimitRequest) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *SetPeerBandwidthLimitRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// PeerBandwidthLimitResponse defines a response to the change of the bandwidth
+// limit of a peer
+type PeerBandwidthLimitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*PeerBandwidthLimitResponse_Empty
+	//	*PeerBandwidthLimitResponse_UpdatePeerErrorCode
+	//	*PeerBandwidthLimitResponse_PeerBandwidthLimitErrorCode
+	//	*PeerBandwidthLimitResponse_ServiceErrorCode
+	//	*PeerBandwidthLimitResponse_MeshnetErrorCode
+	Response isPeerBandwidthLimitResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *PeerBandwidthLimitResponse) Reset() {
+	*x = PeerBandwidthLimitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerBandwidthLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerBandwidthLimitResponse) ProtoMessage() {}
+
+func (x *PeerBandwidthLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerBandwidthLimitResponse.ProtoReflect.Descriptor instead.
+func (*PeerBandwidthLimitResponse) Descriptor() ([]byte, []int) {
+	return file_peer_proto_rawDescGZIP(), []int{12}
+}
+
+func (m *PeerBandwidthLimitResponse) GetResponse() isPeerBandwidthLimitResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *PeerBandwidthLimitResponse) GetEmpty() *Empty {
+	if x, ok := x.GetResponse().(*PeerBandwidthLimitResponse_Empty); ok {
+		return x.Empty
+	}
+	return nil
+}
+
+func (x *PeerBandwidthLimitResponse) GetUpdatePeerErrorCode() UpdatePeerErrorCode {
+	if x, ok := x.GetResponse().(*PeerBandwidthLimitResponse_UpdatePeerErrorCode); ok {
+		return x.UpdatePeerErrorCode
+	}
+	return UpdatePeerErrorCode_PEER_NOT_FOUND
+}
+
+func (x *PeerBandwidthLimitResponse) GetPeerBandwidthLimitErrorCode() PeerBandwidthLimitErrorCode {
+	if x, ok := x.GetResponse().(*PeerBandwidthLimitResponse_PeerBandwidthLimitErrorCode); ok {
+		return x.PeerBandwidthLimitErrorCode
+	}
+	return PeerBandwidthLimitErrorCode_INVALID_BANDWIDTH_LIMIT
+}
+
+func (x *PeerBandwidthLimitResponse) GetServiceErrorCode() ServiceErrorCode {
+	if x, ok := x.GetResponse().(*PeerBandwidthLimitResponse_ServiceErrorCode); ok {
+		return x.ServiceErrorCode
+	}
+	return ServiceErrorCode_NOT_LOGGED_IN
+}
+
+func (x *PeerBandwidthLimitResponse) GetMeshnetErrorCode() MeshnetErrorCode {
+	if x, ok := x.GetResponse().(*PeerBandwidthLimitResponse_MeshnetErrorCode); ok {
+		return x.MeshnetErrorCode
+	}
+	return MeshnetErrorCode_NOT_REGISTERED
+}
+
+type isPeerBandwidthLimitResponse_Response interface {
+	isPeerBandwidthLimitResponse_Response()
+}
+
+type PeerBandwidthLimitResponse_Empty struct {
+	Empty *Empty `protobuf:"bytes,1,opt,name=empty,proto3,oneof"`
+}
+
+type PeerBandwidthLimitResponse_UpdatePeerErrorCode struct {
+	UpdatePeerErrorCode UpdatePeerErrorCode `protobuf:"varint,2,opt,name=update_peer_error_code,json=updatePeerErrorCode,proto3,enum=meshpb.UpdatePeerErrorCode,oneof"`
+}
+
+type PeerBandwidthLimitResponse_PeerBandwidthLimitErrorCode struct {
+	PeerBandwidthLimitErrorCode PeerBandwidthLimitErrorCode `protobuf:"varint,3,opt,name=peer_bandwidth_limit_error_code,json=peerBandwidthLimitErrorCode,proto3,enum=meshpb.PeerBandwidthLimitErrorCode,oneof"`
+}
+
+type PeerBandwidthLimitResponse_ServiceErrorCode struct {
+	ServiceErrorCode ServiceErrorCode `protobuf:"varint,4,opt,name=service_error_code,json=serviceErrorCode,proto3,enum=meshpb.ServiceErrorCode,oneof"`
+}
+
+type PeerBandwidthLimitResponse_MeshnetErrorCode struct {
+	MeshnetErrorCode MeshnetErrorCode `protobuf:"varint,5,opt,name=meshnet_error_code,json=meshnetErrorCode,proto3,enum=meshpb.MeshnetErrorCode,oneof"`
+}
+
+func (*PeerBandwidthLimitResponse_Empty) isPeerBandwidthLimitResponse_Response() {}
+
+func (*PeerBandwidthLimitResponse_UpdatePeerErrorCode) isPeerBandwidthLimitResponse_Response() {}
+
+func (*PeerBandwidthLimitResponse_PeerBandwidthLimitErrorCode) isPeerBandwidthLimitResponse_Response() {
+}
+
+func (*PeerBandwidthLimitResponse_ServiceErrorCode) isPeerBandwidthLimitResponse_Response() {}
+
+func (*PeerBandwidthLimitResponse_MeshnetErrorCode) isPeerBandwidthLimitResponse_Response() {}
+
+// AllowRoutingResponse defines a response for allow routing request
+type AllowRoutingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*AllowRoutingResponse_Empty
+	//	*AllowRoutingResponse_UpdatePeerErrorCode
+	//	*AllowRoutingResponse_AllowRoutingErrorCode
+	//	*AllowRoutingResponse_ServiceErrorCode
+	//	*AllowRoutingResponse_MeshnetErrorCode
+	Response isAllowRoutingResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *AllowRoutingResponse) Reset() {
+	*x = AllowRoutingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_peer_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllowRoutingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowRoutingResponse) ProtoMessage() {}
+
+func (x *AllowRoutingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_peer_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllowRoutingResponse.ProtoReflect.Descriptor instead.
+func (*AllowRoutingResponse) Descriptor() ([]byte, []int) {
+	return file_peer_proto_rawDescGZIP(), []int{13}
+}
+
+func (m *AllowRoutingResponse) GetResponse() isAllowRoutingResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *AllowRoutingResponse) GetEmpty() *Empty {
+	if x, ok := x.GetResponse().(*AllowRoutingResponse_Empty); ok {
+		return x.Empty
+	}
+	return nil
+}
+
+func (x *AllowRoutingResponse) GetUpdatePeerErrorCode() UpdatePeerErrorCode {
+	if x, ok := x.GetResponse().(*AllowRoutingResponse_UpdatePeerErrorCode); ok {
+		return x.UpdatePeerErrorCode
+	}
+	return UpdatePeerErrorCode_PEER_NOT_FOUND
+}
+
+func (x *AllowRoutingResponse) GetAllowRoutingErrorCode() AllowRoutingErrorCode {
+	if x, ok := x.GetResponse().(*AllowRoutingResponse_AllowRoutingErrorCode); ok {
+		return x.AllowRoutingErrorCode
+	}
+	return AllowRoutingErrorCode_ROUTING_ALREADY_ALLOWED
+}
+
+func (x *AllowRoutingResponse) GetServiceErrorCode() ServiceErrorCode {
+	if x, ok := x.GetResponse().(*AllowRoutingResponse_ServiceErrorCode); ok {
+		return x.ServiceErrorCode
+	}
+	return ServiceErrorCode_NOT_LOGGED_IN
+}
+
+func (x *AllowRoutingResponse) GetMeshnetErrorCode() MeshnetErrorCode {
+	if x, ok := x.GetResponse().(*AllowRoutingResponse_MeshnetErrorCode); ok {
+		return x.MeshnetErrorCode
+	}
+	return MeshnetErrorCode_NOT_REGISTERED
+}
+
+type isAllowRoutingResponse_Response interface {
+	isAllowRoutingResponse_Response()
+}
+
+type AllowRoutingResponse_Empty struct {
+	Empty *Empty `protobuf:"bytes,1,opt,name=empty,proto3,oneof"`
 }
 
 type AllowRoutingResponse_UpdatePeerErrorCode struct {
@@ -1572,7 +2198,7 @@ type DenyRoutingResponse struct {
 func (x *DenyRoutingResponse) Reset() {
 	*x = DenyRoutingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peer_proto_msgTypes[9]
+		mi := &file_peer_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1585,7 +2211,7 @@ func (x *DenyRoutingResponse) String() string {
 func (*DenyRoutingResponse) ProtoMessage() {}
 
 func (x *DenyRoutingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peer_proto_msgTypes[9]
+	mi := &file_peer_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1598,7 +2224,7 @@ func (x *DenyRoutingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DenyRoutingResponse.ProtoReflect.Descriptor instead.
 func (*DenyRoutingResponse) Descriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{9}
+	return file_peer_proto_rawDescGZIP(), []int{14}
 }
 
 func (m *DenyRoutingResponse) GetResponse() isDenyRoutingResponse_Response {
@@ -1697,7 +2323,7 @@ type AllowIncomingResponse struct {
 func (x *AllowIncomingResponse) Reset() {
 	*x = AllowIncomingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peer_proto_msgTypes[10]
+		mi := &file_peer_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1710,7 +2336,7 @@ func (x *AllowIncomingResponse) String() string {
 func (*AllowIncomingResponse) ProtoMessage() {}
 
 func (x *AllowIncomingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peer_proto_msgTypes[10]
+	mi := &file_peer_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1723,7 +2349,7 @@ func (x *AllowIncomingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AllowIncomingResponse.ProtoReflect.Descriptor instead.
 func (*AllowIncomingResponse) Descriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{10}
+	return file_peer_proto_rawDescGZIP(), []int{15}
 }
 
 func (m *AllowIncomingResponse) GetResponse() isAllowIncomingResponse_Response {
@@ -1822,7 +2448,7 @@ type DenyIncomingResponse struct {
 func (x *DenyIncomingResponse) Reset() {
 	*x = DenyIncomingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peer_proto_msgTypes[11]
+		mi := &file_peer_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1835,7 +2461,7 @@ func (x *DenyIncomingResponse) String() string {
 func (*DenyIncomingResponse) ProtoMessage() {}
 
 func (x *DenyIncomingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peer_proto_msgTypes[11]
+	mi := &file_peer_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1848,7 +2474,7 @@ func (x *DenyIncomingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DenyIncomingResponse.ProtoReflect.Descriptor instead.
 func (*DenyIncomingResponse) Descriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{11}
+	return file_peer_proto_rawDescGZIP(), []int{16}
 }
 
 func (m *DenyIncomingResponse) GetResponse() isDenyIncomingResponse_Response {
@@ -1947,7 +2573,7 @@ type AllowLocalNetworkResponse struct {
 func (x *AllowLocalNetworkResponse) Reset() {
 	*x = AllowLocalNetworkResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peer_proto_msgTypes[12]
+		mi := &file_peer_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1960,7 +2586,7 @@ func (x *AllowLocalNetworkResponse) String() string {
 func (*AllowLocalNetworkResponse) ProtoMessage() {}
 
 func (x *AllowLocalNetworkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peer_proto_msgTypes[12]
+	mi := &file_peer_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1973,7 +2599,7 @@ func (x *AllowLocalNetworkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AllowLocalNetworkResponse.ProtoReflect.Descriptor instead.
 func (*AllowLocalNetworkResponse) Descriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{12}
+	return file_peer_proto_rawDescGZIP(), []int{17}
 }
 
 func (m *AllowLocalNetworkResponse) GetResponse() isAllowLocalNetworkResponse_Response {
@@ -2072,7 +2698,7 @@ type DenyLocalNetworkResponse struct {
 func (x *DenyLocalNetworkResponse) Reset() {
 	*x = DenyLocalNetworkResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peer_proto_msgTypes[13]
+		mi := &file_peer_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2085,7 +2711,7 @@ func (x *DenyLocalNetworkResponse) String() string {
 func (*DenyLocalNetworkResponse) ProtoMessage() {}
 
 func (x *DenyLocalNetworkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peer_proto_msgTypes[13]
+	mi := &file_peer_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2098,7 +2724,7 @@ func (x *DenyLocalNetworkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DenyLocalNetworkResponse.ProtoReflect.Descriptor instead.
 func (*DenyLocalNetworkResponse) Descriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{13}
+	return file_peer_proto_rawDescGZIP(), []int{18}
 }
 
 func (m *DenyLocalNetworkResponse) GetResponse() isDenyLocalNetworkResponse_Response {
@@ -2196,7 +2822,7 @@ type AllowFileshareResponse struct {
 func (x *AllowFileshareResponse) Reset() {
 	*x = AllowFileshareResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peer_proto_msgTypes[14]
+		mi := &file_peer_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2209,7 +2835,7 @@ func (x *AllowFileshareResponse) String() string {
 func (*AllowFileshareResponse) ProtoMessage() {}
 
 func (x *AllowFileshareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peer_proto_msgTypes[14]
+	mi := &file_peer_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2222,7 +2848,7 @@ func (x *AllowFileshareResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AllowFileshareResponse.ProtoReflect.Descriptor instead.
 func (*AllowFileshareResponse) Descriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{14}
+	return file_peer_proto_rawDescGZIP(), []int{19}
 }
 
 func (m *AllowFileshareResponse) GetResponse() isAllowFileshareResponse_Response {
@@ -2320,7 +2946,7 @@ type DenyFileshareResponse struct {
 func (x *DenyFileshareResponse) Reset() {
 	*x = DenyFileshareResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peer_proto_msgTypes[15]
+		mi := &file_peer_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2333,7 +2959,7 @@ func (x *DenyFileshareResponse) String() string {
 func (*DenyFileshareResponse) ProtoMessage() {}
 
 func (x *DenyFileshareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peer_proto_msgTypes[15]
+	mi := &file_peer_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2346,7 +2972,7 @@ func (x *DenyFileshareResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DenyFileshareResponse.ProtoReflect.Descriptor instead.
 func (*DenyFileshareResponse) Descriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{15}
+	return file_peer_proto_rawDescGZIP(), []int{20}
 }
 
 func (m *DenyFileshareResponse) GetResponse() isDenyFileshareResponse_Response {
@@ -2444,7 +3070,7 @@ type EnableAutomaticFileshareResponse struct {
 func (x *EnableAutomaticFileshareResponse) Reset() {
 	*x = EnableAutomaticFileshareResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peer_proto_msgTypes[16]
+		mi := &file_peer_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2457,7 +3083,7 @@ func (x *EnableAutomaticFileshareResponse) String() string {
 func (*EnableAutomaticFileshareResponse) ProtoMessage() {}
 
 func (x *EnableAutomaticFileshareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peer_proto_msgTypes[16]
+	mi := &file_peer_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2470,7 +3096,7 @@ func (x *EnableAutomaticFileshareResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnableAutomaticFileshareResponse.ProtoReflect.Descriptor instead.
 func (*EnableAutomaticFileshareResponse) Descriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{16}
+	return file_peer_proto_rawDescGZIP(), []int{21}
 }
 
 func (m *EnableAutomaticFileshareResponse) GetResponse() isEnableAutomaticFileshareResponse_Response {
@@ -2572,7 +3198,7 @@ type DisableAutomaticFileshareResponse struct {
 func (x *DisableAutomaticFileshareResponse) Reset() {
 	*x = DisableAutomaticFileshareResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peer_proto_msgTypes[17]
+		mi := &file_peer_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2585,7 +3211,7 @@ func (x *DisableAutomaticFileshareResponse) String() string {
 func (*DisableAutomaticFileshareResponse) ProtoMessage() {}
 
 func (x *DisableAutomaticFileshareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peer_proto_msgTypes[17]
+	mi := &file_peer_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2598,7 +3224,7 @@ func (x *DisableAutomaticFileshareResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use DisableAutomaticFileshareResponse.ProtoReflect.Descriptor instead.
 func (*DisableAutomaticFileshareResponse) Descriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{17}
+	return file_peer_proto_rawDescGZIP(), []int{22}
 }
 
 func (m *DisableAutomaticFileshareResponse) GetResponse() isDisableAutomaticFileshareResponse_Response {
@@ -2699,7 +3325,7 @@ type ConnectResponse struct {
 func (x *ConnectResponse) Reset() {
 	*x = ConnectResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peer_proto_msgTypes[18]
+		mi := &file_peer_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2712,7 +3338,7 @@ func (x *ConnectResponse) String() string {
 func (*ConnectResponse) ProtoMessage() {}
 
 func (x *ConnectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peer_proto_msgTypes[18]
+	mi := &file_peer_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2725,7 +3351,7 @@ func (x *ConnectResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectResponse.ProtoReflect.Descriptor instead.
 func (*ConnectResponse) Descriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{18}
+	return file_peer_proto_rawDescGZIP(), []int{23}
 }
 
 func (m *ConnectResponse) GetResponse() isConnectResponse_Response {
@@ -2819,7 +3445,7 @@ type PrivateKeyResponse struct {
 func (x *PrivateKeyResponse) Reset() {
 	*x = PrivateKeyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_peer_proto_msgTypes[19]
+		mi := &file_peer_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2832,7 +3458,7 @@ func (x *PrivateKeyResponse) String() string {
 func (*PrivateKeyResponse) ProtoMessage() {}
 
 func (x *PrivateKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_peer_proto_msgTypes[19]
+	mi := &file_peer_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2845,7 +3471,7 @@ func (x *PrivateKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrivateKeyResponse.ProtoReflect.Descriptor instead.
 func (*PrivateKeyResponse) Descriptor() ([]byte, []int) {
-	return file_peer_proto_rawDescGZIP(), []int{19}
+	return file_peer_proto_rawDescGZIP(), []int{24}
 }
 
 func (m *PrivateKeyResponse) GetResponse() isPrivateKeyResponse_Response {
@@ -2913,7 +3539,7 @@ var file_peer_proto_rawDesc = []byte{
 	0x65, 0x65, 0x72, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x08, 0x65, 0x78,
 	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6d,
 	0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x22, 0xda, 0x05, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1e, 0x0a,
+	0x72, 0x6e, 0x61, 0x6c, 0x22, 0x92, 0x07, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x1e, 0x0a,
 	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x16, 0x0a,
 	0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
@@ -2929,144 +3555,52 @@ var file_peer_proto_rawDesc = []byte{
 	0x64, 0x69, 0x73, 0x74, 0x72, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
 	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2c, 0x0a, 0x12,
 	0x69, 0x73, 0x5f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x49, 0x6e, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73,
-	0x5f, 0x72, 0x6f, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x69, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x69,
-	0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69,
-	0x73, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x12, 0x69, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x41,
-	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x12, 0x64, 0x6f, 0x5f, 0x69, 0x5f, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0f, 0x64, 0x6f, 0x49, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x12, 0x64, 0x6f, 0x5f, 0x69, 0x5f, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0f, 0x64, 0x6f, 0x49, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
-	0x12, 0x36, 0x0a, 0x18, 0x64, 0x6f, 0x5f, 0x69, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x10, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x14, 0x64, 0x6f, 0x49, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x63, 0x61,
-	0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2f, 0x0a, 0x14, 0x64, 0x6f, 0x5f, 0x69,
-	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64, 0x6f, 0x49, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
-	0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x6c, 0x77,
-	0x61, 0x79, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
-	0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x41, 0x63,
-	0x63, 0x65, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x33, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0xaf, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
-	0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d,
-	0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70,
-	0x65, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00,
-	0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
-	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68,
-	0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x57, 0x0a, 0x19, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x22, 0x3a, 0x0a, 0x1c, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x93, 0x03,
-	0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a,
-	0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x5e, 0x0a, 0x1a, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x65,
-	0x73, 0x68, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x69, 0x63, 0x6b, 0x6e,
-	0x61, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x17,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x8b, 0x03, 0x0a, 0x14, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x6f, 0x75,
-	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65,
-	0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65,
-	0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
-	0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x58, 0x0a, 0x18, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
-	0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x15, 0x61, 0x6c, 0x6c, 0x6f,
-	0x77, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
-	0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d,
-	0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
-	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x87, 0x03, 0x0a, 0x13, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70,
-	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
-	0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x55, 0x0a, 0x17, 0x64, 0x65, 0x6e, 0x79, 0x5f, 0x72, 0x6f, 0x75,
-	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x44,
-	0x65, 0x6e, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x14, 0x64, 0x65, 0x6e, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x69,
-	0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74,
-	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e,
-	0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d,
-	0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42,
-	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x03, 0x0a, 0x15,
-	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x49, 0x6e, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73,
+	0x5f, 0x72, 0x6f, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x69, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x69,
+	0x73, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x69,
+	0x73, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x12, 0x69, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x12, 0x64, 0x6f, 0x5f, 0x69, 0x5f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x64, 0x6f, 0x49, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x12, 0x64, 0x6f, 0x5f, 0x69, 0x5f, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x64, 0x6f, 0x49, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x36, 0x0a, 0x18, 0x64, 0x6f, 0x5f, 0x69, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x14, 0x64, 0x6f, 0x49, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x2f, 0x0a, 0x14, 0x64, 0x6f, 0x5f, 0x69,
+	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64, 0x6f, 0x49, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x6c, 0x77,
+	0x61, 0x79, 0x73, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x6c, 0x77, 0x61, 0x79, 0x73, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x16, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
+	0x2e, 0x50, 0x65, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x10, 0x0a, 0x03, 0x72, 0x74, 0x74, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x74,
+	0x74, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x5f,
+	0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61,
+	0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x62, 0x61, 0x6e, 0x64, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x33, 0x0a, 0x11, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0xaf,
+	0x02, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d,
 	0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16,
@@ -3075,89 +3609,74 @@ var file_peer_proto_rawDesc = []byte{
 	0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72,
 	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x5b, 0x0a, 0x19, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
-	0x6e, 0x67, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c,
-	0x6f, 0x77, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x16, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x63, 0x6f,
-	0x6d, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a,
-	0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e,
-	0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73,
-	0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
-	0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8b, 0x03,
-	0x0a, 0x14, 0x44, 0x65, 0x6e, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a,
-	0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
-	0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65,
-	0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x58, 0x0a, 0x18, 0x64, 0x65, 0x6e, 0x79, 0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
-	0x6e, 0x67, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6e,
-	0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x48, 0x00, 0x52, 0x15, 0x64, 0x65, 0x6e, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
-	0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73,
+	0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65,
+	0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e,
+	0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x57, 0x0a, 0x19, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3a, 0x0a, 0x1c, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x4d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63,
+	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63,
+	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x93, 0x03, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65,
+	0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
 	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
 	0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f,
 	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74,
-	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
 	0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e,
 	0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d,
-	0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42,
-	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa0, 0x03, 0x0a, 0x19,
-	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70,
-	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
-	0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x68, 0x0a, 0x1e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x6f,
-	0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6d,
-	0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
-	0x48, 0x00, 0x52, 0x1a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65,
-	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48,
-	0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73,
-	0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68,
-	0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65,
-	0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00,
-	0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c,
-	0x03, 0x0a, 0x18, 0x44, 0x65, 0x6e, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73,
-	0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65,
-	0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48,
-	0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x65, 0x0a, 0x1d, 0x64, 0x65, 0x6e, 0x79, 0x5f, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e,
-	0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6e, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
-	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
-	0x48, 0x00, 0x52, 0x19, 0x64, 0x65, 0x6e, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a,
+	0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x5e, 0x0a, 0x1a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
+	0x6d, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x17, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x69,
+	0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x12, 0x53,
+	0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x48, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72,
+	0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22,
+	0xfb, 0x02, 0x0a, 0x10, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x4c, 0x0a, 0x14, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x11, 0x70, 0x65, 0x65, 0x72,
+	0x4e, 0x6f, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a,
 	0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
 	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68,
 	0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
@@ -3167,33 +3686,40 @@ var file_peer_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73,
 	0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
 	0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x89, 0x03,
-	0x0a, 0x16, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x54, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x6e,
-	0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x6f,
-	0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x65, 0x6e, 0x64,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48,
-	0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73,
-	0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a,
-	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x03, 0x0a, 0x15, 0x44, 0x65,
-	0x6e, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a,
+	0x1c, 0x53, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0xa4, 0x03, 0x0a, 0x1a, 0x50, 0x65, 0x65, 0x72, 0x42, 0x61, 0x6e, 0x64,
+	0x77, 0x69, 0x64, 0x74, 0x68, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x6b, 0x0a,
+	0x1f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e,
+	0x50, 0x65, 0x65, 0x72, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x1b, 0x70,
+	0x65, 0x65, 0x72, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65,
+	0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8b, 0x03, 0x0a, 0x14, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
 	0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70,
@@ -3201,40 +3727,164 @@ var file_peer_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73,
 	0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72,
 	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x51,
-	0x0a, 0x14, 0x64, 0x65, 0x6e, 0x79, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6d,
-	0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6e, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x11,
-	0x64, 0x65, 0x6e, 0x79, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
-	0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d,
-	0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
-	0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xbc, 0x03, 0x0a, 0x20, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f,
-	0x6d, 0x61, 0x74, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a,
-	0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
-	0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65,
-	0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x7d, 0x0a, 0x25, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f,
-	0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f,
+	0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x58,
+	0x0a, 0x18, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f,
 	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x29, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x21, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x46, 0x69,
-	0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48,
+	0x00, 0x52, 0x15, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00,
+	0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68,
+	0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x03, 0x0a, 0x13, 0x44, 0x65, 0x6e,
+	0x79, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65,
+	0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x55, 0x0a, 0x17, 0x64,
+	0x65, 0x6e, 0x79, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x14, 0x64, 0x65,
+	0x6e, 0x79, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12,
+	0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70,
+	0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x8f, 0x03, 0x0a, 0x15, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x63, 0x6f,
+	0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65,
+	0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x5b, 0x0a, 0x19, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e,
+	0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x16, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48,
+	0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8b, 0x03, 0x0a, 0x14, 0x44, 0x65, 0x6e, 0x79, 0x49, 0x6e, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x58, 0x0a, 0x18, 0x64, 0x65, 0x6e, 0x79,
+	0x5f, 0x69, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6e, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x15, 0x64, 0x65, 0x6e,
+	0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12,
+	0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70,
+	0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xa0, 0x03, 0x0a, 0x19, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65,
+	0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x68, 0x0a, 0x1e, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x1a, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65,
+	0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9c, 0x03, 0x0a, 0x18, 0x44, 0x65, 0x6e, 0x79, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x65, 0x0a,
+	0x1d, 0x64, 0x65, 0x6e, 0x79, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x44, 0x65,
+	0x6e, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x19, 0x64, 0x65, 0x6e, 0x79, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48,
+	0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x89, 0x03, 0x0a, 0x16, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65,
+	0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x54, 0x0a, 0x15, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x12, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
 	0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f,
 	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d,
 	0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72,
@@ -3245,24 +3895,100 @@ var file_peer_proto_rawDesc = []byte{
 	0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
 	0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
 	0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0xc1, 0x03, 0x0a, 0x21, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f,
-	0x6d, 0x61, 0x74, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a,
-	0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
-	0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65,
-	0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70,
+	0x22, 0x85, 0x03, 0x0a, 0x15, 0x44, 0x65, 0x6e, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00,
+	0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x51, 0x0a, 0x14, 0x64, 0x65, 0x6e, 0x79, 0x5f, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6e,
+	0x79, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x11, 0x64, 0x65, 0x6e, 0x79, 0x53, 0x65, 0x6e, 0x64, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00,
+	0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68,
+	0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xbc, 0x03, 0x0a, 0x20, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70,
 	0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x80, 0x01, 0x0a, 0x26, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75,
-	0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x61,
+	0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x7d, 0x0a, 0x25, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
+	0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x48, 0x00, 0x52, 0x21, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f,
+	0x6d, 0x61, 0x74, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
+	0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e,
+	0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xc1, 0x03, 0x0a, 0x21, 0x44, 0x69, 0x73, 0x61,
 	0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00,
-	0x52, 0x22, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74,
-	0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a,
+	0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d,
+	0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x26, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x22, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e,
+	0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d,
+	0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf6, 0x02, 0x0a, 0x0f,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65,
+	0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
 	0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
 	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
 	0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
@@ -3273,114 +3999,106 @@ var file_peer_proto_rawDesc = []byte{
 	0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
 	0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45,
 	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xf6, 0x02, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x52, 0x0a, 0x16, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1b, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
-	0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x13,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a,
-	0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68,
-	0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e,
-	0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73,
-	0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
-	0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01,
-	0x0a, 0x12, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52,
-	0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
-	0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x2d, 0x0a,
-	0x0a, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x10, 0x0a, 0x0c, 0x44,
-	0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a,
-	0x09, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x29, 0x0a, 0x13,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
-	0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x00, 0x2a, 0x98, 0x02, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x41, 0x4d, 0x45, 0x5f, 0x4e, 0x49, 0x43, 0x4b,
-	0x4e, 0x41, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41,
-	0x4d, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59,
-	0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x4f, 0x4d, 0x41, 0x49, 0x4e, 0x5f, 0x4e, 0x41, 0x4d,
-	0x45, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x41,
-	0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x48, 0x10, 0x03,
-	0x12, 0x15, 0x0a, 0x11, 0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f,
-	0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x55, 0x50, 0x4c, 0x49,
-	0x43, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x05, 0x12,
-	0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x42,
-	0x49, 0x44, 0x44, 0x45, 0x4e, 0x5f, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x06, 0x12, 0x20, 0x0a, 0x1c,
-	0x53, 0x55, 0x46, 0x46, 0x49, 0x58, 0x5f, 0x4f, 0x52, 0x5f, 0x50, 0x52, 0x45, 0x46, 0x49, 0x58,
-	0x5f, 0x41, 0x52, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x07, 0x12, 0x1f,
-	0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x48, 0x41, 0x53, 0x5f, 0x44,
-	0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x48, 0x59, 0x50, 0x48, 0x45, 0x4e, 0x53, 0x10, 0x08, 0x12,
-	0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x48, 0x41, 0x52, 0x53,
-	0x10, 0x09, 0x2a, 0x34, 0x0a, 0x15, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x6f, 0x75, 0x74, 0x69,
-	0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x52,
-	0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x41,
-	0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x32, 0x0a, 0x14, 0x44, 0x65, 0x6e, 0x79,
-	0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x1a, 0x0a, 0x16, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x4c, 0x52, 0x45,
-	0x41, 0x44, 0x59, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x36, 0x0a, 0x16,
-	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x49,
-	0x4e, 0x47, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57,
-	0x45, 0x44, 0x10, 0x00, 0x2a, 0x34, 0x0a, 0x15, 0x44, 0x65, 0x6e, 0x79, 0x49, 0x6e, 0x63, 0x6f,
-	0x6d, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a,
-	0x17, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44,
-	0x59, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x3f, 0x0a, 0x1a, 0x41, 0x6c,
-	0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x4f, 0x43, 0x41,
-	0x4c, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44,
-	0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x3d, 0x0a, 0x19, 0x44,
-	0x65, 0x6e, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x4f, 0x43, 0x41,
-	0x4c, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44,
-	0x59, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x33, 0x0a, 0x17, 0x41, 0x6c,
-	0x6c, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x41, 0x4c,
-	0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x00, 0x2a,
-	0x31, 0x0a, 0x16, 0x44, 0x65, 0x6e, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45, 0x4e,
-	0x44, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x2a, 0x4c, 0x0a, 0x21, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f,
-	0x6d, 0x61, 0x74, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x23, 0x41, 0x55, 0x54, 0x4f, 0x4d,
-	0x41, 0x54, 0x49, 0x43, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x41,
-	0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00,
-	0x2a, 0x4e, 0x0a, 0x22, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d,
-	0x61, 0x74, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x24, 0x41, 0x55, 0x54, 0x4f, 0x4d, 0x41,
-	0x54, 0x49, 0x43, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x41, 0x4c,
-	0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00,
-	0x2a, 0x94, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x44, 0x4f,
-	0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x5f, 0x52, 0x4f, 0x55,
-	0x54, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44,
-	0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a,
-	0x0e, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10,
-	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x5f, 0x49, 0x50, 0x10,
-	0x03, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x43, 0x4f, 0x4e,
-	0x4e, 0x45, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e,
-	0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78,
-	0x2f, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x70,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x48,
+	0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73,
+	0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x2d, 0x0a, 0x0a, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x2a, 0x3d, 0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x10, 0x0a, 0x0c, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x59, 0x10,
+	0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x41, 0x54, 0x48, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54,
+	0x10, 0x02, 0x2a, 0x29, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x65, 0x65, 0x72,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x45, 0x45,
+	0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x00, 0x2a, 0x98, 0x02,
+	0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x41, 0x4d,
+	0x45, 0x5f, 0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x4f, 0x4d, 0x41,
+	0x49, 0x4e, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x10, 0x02,
+	0x12, 0x14, 0x0a, 0x10, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x52,
+	0x45, 0x41, 0x43, 0x48, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41,
+	0x4d, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x16, 0x0a,
+	0x12, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x49, 0x43, 0x4b, 0x4e,
+	0x41, 0x4d, 0x45, 0x10, 0x05, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
+	0x53, 0x5f, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x5f, 0x57, 0x4f, 0x52, 0x44,
+	0x10, 0x06, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x55, 0x46, 0x46, 0x49, 0x58, 0x5f, 0x4f, 0x52, 0x5f,
+	0x50, 0x52, 0x45, 0x46, 0x49, 0x58, 0x5f, 0x41, 0x52, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x10, 0x07, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x4b, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x48, 0x41, 0x53, 0x5f, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x48, 0x59, 0x50, 0x48,
+	0x45, 0x4e, 0x53, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x43, 0x48, 0x41, 0x52, 0x53, 0x10, 0x09, 0x2a, 0x59, 0x0a, 0x11, 0x50, 0x65, 0x65, 0x72,
+	0x4e, 0x6f, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a,
+	0x12, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x54, 0x45, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c,
+	0x4f, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x54, 0x41, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x54,
+	0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x54, 0x41, 0x47,
+	0x53, 0x10, 0x02, 0x2a, 0x5b, 0x0a, 0x1b, 0x50, 0x65, 0x65, 0x72, 0x42, 0x61, 0x6e, 0x64, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x42, 0x41,
+	0x4e, 0x44, 0x57, 0x49, 0x44, 0x54, 0x48, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x00, 0x12,
+	0x1f, 0x0a, 0x1b, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44, 0x54, 0x48, 0x5f, 0x4c, 0x49, 0x4d,
+	0x49, 0x54, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x01,
+	0x2a, 0x34, 0x0a, 0x15, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x4f, 0x55,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x41, 0x4c, 0x4c,
+	0x4f, 0x57, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x32, 0x0a, 0x14, 0x44, 0x65, 0x6e, 0x79, 0x52, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a,
+	0x0a, 0x16, 0x52, 0x4f, 0x55, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44,
+	0x59, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x36, 0x0a, 0x16, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x49, 0x4e, 0x47,
+	0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44,
+	0x10, 0x00, 0x2a, 0x34, 0x0a, 0x15, 0x44, 0x65, 0x6e, 0x79, 0x49, 0x6e, 0x63, 0x6f, 0x6d, 0x69,
+	0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x49,
+	0x4e, 0x43, 0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f,
+	0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x3f, 0x0a, 0x1a, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f,
+	0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f,
+	0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x3d, 0x0a, 0x19, 0x44, 0x65, 0x6e,
+	0x79, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f,
+	0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f,
+	0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x33, 0x0a, 0x17, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x41, 0x4c, 0x52, 0x45,
+	0x41, 0x44, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x31, 0x0a,
+	0x16, 0x44, 0x65, 0x6e, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45, 0x4e, 0x44, 0x5f,
+	0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x44, 0x45, 0x4e, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x2a, 0x4c, 0x0a, 0x21, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x23, 0x41, 0x55, 0x54, 0x4f, 0x4d, 0x41, 0x54,
+	0x49, 0x43, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x41, 0x4c, 0x52,
+	0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x4e,
+	0x0a, 0x22, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x68, 0x61, 0x72, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x28, 0x0a, 0x24, 0x41, 0x55, 0x54, 0x4f, 0x4d, 0x41, 0x54, 0x49,
+	0x43, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x41, 0x4c, 0x52, 0x45,
+	0x41, 0x44, 0x59, 0x5f, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x2a, 0x94,
+	0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x44, 0x4f, 0x45, 0x53,
+	0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x5f, 0x52, 0x4f, 0x55, 0x54, 0x49,
+	0x4e, 0x47, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f,
+	0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x43,
+	0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x0e, 0x0a, 0x0a, 0x50, 0x45, 0x45, 0x52, 0x5f, 0x4e, 0x4f, 0x5f, 0x49, 0x50, 0x10, 0x03, 0x12,
+	0x16, 0x0a, 0x12, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
+	0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43, 0x45,
+	0x4c, 0x45, 0x44, 0x10, 0x05, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79,
+	0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f, 0x6d,
+	0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -3395,125 +4113,144 @@ func file_peer_proto_rawDescGZIP() []byte {
 	return file_peer_proto_rawDescData
 }
 
-var file_peer_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
-var file_peer_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_peer_proto_enumTypes = make([]protoimpl.EnumInfo, 17)
+var file_peer_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_peer_proto_goTypes = []interface{}{
 	(PeerStatus)(0),                           // 0: meshpb.PeerStatus
-	(UpdatePeerErrorCode)(0),                  // 1: meshpb.UpdatePeerErrorCode
-	(ChangeNicknameErrorCode)(0),              // 2: meshpb.ChangeNicknameErrorCode
-	(AllowRoutingErrorCode)(0),                // 3: meshpb.AllowRoutingErrorCode
-	(DenyRoutingErrorCode)(0),                 // 4: meshpb.DenyRoutingErrorCode
-	(AllowIncomingErrorCode)(0),               // 5: meshpb.AllowIncomingErrorCode
-	(DenyIncomingErrorCode)(0),                // 6: meshpb.DenyIncomingErrorCode
-	(AllowLocalNetworkErrorCode)(0),           // 7: meshpb.AllowLocalNetworkErrorCode
-	(DenyLocalNetworkErrorCode)(0),            // 8: meshpb.DenyLocalNetworkErrorCode
-	(AllowFileshareErrorCode)(0),              // 9: meshpb.AllowFileshareErrorCode
-	(DenyFileshareErrorCode)(0),               // 10: meshpb.DenyFileshareErrorCode
-	(EnableAutomaticFileshareErrorCode)(0),    // 11: meshpb.EnableAutomaticFileshareErrorCode
-	(DisableAutomaticFileshareErrorCode)(0),   // 12: meshpb.DisableAutomaticFileshareErrorCode
-	(ConnectErrorCode)(0),                     // 13: meshpb.ConnectErrorCode
-	(*GetPeersResponse)(nil),                  // 14: meshpb.GetPeersResponse
-	(*PeerList)(nil),                          // 15: meshpb.PeerList
-	(*Peer)(nil),                              // 16: meshpb.Peer
-	(*UpdatePeerRequest)(nil),                 // 17: meshpb.UpdatePeerRequest
-	(*RemovePeerResponse)(nil),                // 18: meshpb.RemovePeerResponse
-	(*ChangePeerNicknameRequest)(nil),         // 19: meshpb.ChangePeerNicknameRequest
-	(*ChangeMachineNicknameRequest)(nil),      // 20: meshpb.ChangeMachineNicknameRequest
-	(*ChangeNicknameResponse)(nil),            // 21: meshpb.ChangeNicknameResponse
-	(*AllowRoutingResponse)(nil),              // 22: meshpb.AllowRoutingResponse
-	(*DenyRoutingResponse)(nil),               // 23: meshpb.DenyRoutingResponse
-	(*AllowIncomingResponse)(nil),             // 24: meshpb.AllowIncomingResponse
-	(*DenyIncomingResponse)(nil),              // 25: meshpb.DenyIncomingResponse
-	(*AllowLocalNetworkResponse)(nil),         // 26: meshpb.AllowLocalNetworkResponse
-	(*DenyLocalNetworkResponse)(nil),          // 27: meshpb.DenyLocalNetworkResponse
-	(*AllowFileshareResponse)(nil),            // 28: meshpb.AllowFileshareResponse
-	(*DenyFileshareResponse)(nil),             // 29: meshpb.DenyFileshareResponse
-	(*EnableAutomaticFileshareResponse)(nil),  // 30: meshpb.EnableAutomaticFileshareResponse
-	(*DisableAutomaticFileshareResponse)(nil), // 31: meshpb.DisableAutomaticFileshareResponse
-	(*ConnectResponse)(nil),                   // 32: meshpb.ConnectResponse
-	(*PrivateKeyResponse)(nil),                // 33: meshpb.PrivateKeyResponse
-	(ServiceErrorCode)(0),                     // 34: meshpb.ServiceErrorCode
-	(MeshnetErrorCode)(0),                     // 35: meshpb.MeshnetErrorCode
-	(*Empty)(nil),                             // 36: meshpb.Empty
+	(PeerPath)(0),                             // 1: meshpb.PeerPath
+	(UpdatePeerErrorCode)(0),                  // 2: meshpb.UpdatePeerErrorCode
+	(ChangeNicknameErrorCode)(0),              // 3: meshpb.ChangeNicknameErrorCode
+	(PeerNoteErrorCode)(0),                    // 4: meshpb.PeerNoteErrorCode
+	(PeerBandwidthLimitErrorCode)(0),          // 5: meshpb.PeerBandwidthLimitErrorCode
+	(AllowRoutingErrorCode)(0),                // 6: meshpb.AllowRoutingErrorCode
+	(DenyRoutingErrorCode)(0),                 // 7: meshpb.DenyRoutingErrorCode
+	(AllowIncomingErrorCode)(0),               // 8: meshpb.AllowIncomingErrorCode
+	(DenyIncomingErrorCode)(0),                // 9: meshpb.DenyIncomingErrorCode
+	(AllowLocalNetworkErrorCode)(0),           // 10: meshpb.AllowLocalNetworkErrorCode
+	(DenyLocalNetworkErrorCode)(0),            // 11: meshpb.DenyLocalNetworkErrorCode
+	(AllowFileshareErrorCode)(0),              // 12: meshpb.AllowFileshareErrorCode
+	(DenyFileshareErrorCode)(0),               // 13: meshpb.DenyFileshareErrorCode
+	(EnableAutomaticFileshareErrorCode)(0),    // 14: meshpb.EnableAutomaticFileshareErrorCode
+	(DisableAutomaticFileshareErrorCode)(0),   // 15: meshpb.DisableAutomaticFileshareErrorCode
+	(ConnectErrorCode)(0),                     // 16: meshpb.ConnectErrorCode
+	(*GetPeersResponse)(nil),                  // 17: meshpb.GetPeersResponse
+	(*PeerList)(nil),                          // 18: meshpb.PeerList
+	(*Peer)(nil),                              // 19: meshpb.Peer
+	(*UpdatePeerRequest)(nil),                 // 20: meshpb.UpdatePeerRequest
+	(*RemovePeerResponse)(nil),                // 21: meshpb.RemovePeerResponse
+	(*ChangePeerNicknameRequest)(nil),         // 22: meshpb.ChangePeerNicknameRequest
+	(*ChangeMachineNicknameRequest)(nil),      // 23: meshpb.ChangeMachineNicknameRequest
+	(*ChangeNicknameResponse)(nil),            // 24: meshpb.ChangeNicknameResponse
+	(*SetPeerNoteRequest)(nil),                // 25: meshpb.SetPeerNoteRequest
+	(*SetPeerTagsRequest)(nil),                // 26: meshpb.SetPeerTagsRequest
+	(*PeerNoteResponse)(nil),                  // 27: meshpb.PeerNoteResponse
+	(*SetPeerBandwidthLimitRequest)(nil),      // 28: meshpb.SetPeerBandwidthLimitRequest
+	(*PeerBandwidthLimitResponse)(nil),        // 29: meshpb.PeerBandwidthLimitResponse
+	(*AllowRoutingResponse)(nil),              // 30: meshpb.AllowRoutingResponse
+	(*DenyRoutingResponse)(nil),               // 31: meshpb.DenyRoutingResponse
+	(*AllowIncomingResponse)(nil),             // 32: meshpb.AllowIncomingResponse
+	(*DenyIncomingResponse)(nil),              // 33: meshpb.DenyIncomingResponse
+	(*AllowLocalNetworkResponse)(nil),         // 34: meshpb.AllowLocalNetworkResponse
+	(*DenyLocalNetworkResponse)(nil),          // 35: meshpb.DenyLocalNetworkResponse
+	(*AllowFileshareResponse)(nil),            // 36: meshpb.AllowFileshareResponse
+	(*DenyFileshareResponse)(nil),             // 37: meshpb.DenyFileshareResponse
+	(*EnableAutomaticFileshareResponse)(nil),  // 38: meshpb.EnableAutomaticFileshareResponse
+	(*DisableAutomaticFileshareResponse)(nil), // 39: meshpb.DisableAutomaticFileshareResponse
+	(*ConnectResponse)(nil),                   // 40: meshpb.ConnectResponse
+	(*PrivateKeyResponse)(nil),                // 41: meshpb.PrivateKeyResponse
+	(ServiceErrorCode)(0),                     // 42: meshpb.ServiceErrorCode
+	(MeshnetErrorCode)(0),                     // 43: meshpb.MeshnetErrorCode
+	(*Empty)(nil),                             // 44: meshpb.Empty
 }
 var file_peer_proto_depIdxs = []int32{
-	15, // 0: meshpb.GetPeersResponse.peers:type_name -> meshpb.PeerList
-	34, // 1: meshpb.GetPeersResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 2: meshpb.GetPeersResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	16, // 3: meshpb.PeerList.self:type_name -> meshpb.Peer
-	16, // 4: meshpb.PeerList.local:type_name -> meshpb.Peer
-	16, // 5: meshpb.PeerList.external:type_name -> meshpb.Peer
+	18, // 0: meshpb.GetPeersResponse.peers:type_name -> meshpb.PeerList
+	42, // 1: meshpb.GetPeersResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	43, // 2: meshpb.GetPeersResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	19, // 3: meshpb.PeerList.self:type_name -> meshpb.Peer
+	19, // 4: meshpb.PeerList.local:type_name -> meshpb.Peer
+	19, // 5: meshpb.PeerList.external:type_name -> meshpb.Peer
 	0,  // 6: meshpb.Peer.status:type_name -> meshpb.PeerStatus
-	36, // 7: meshpb.RemovePeerResponse.empty:type_name -> meshpb.Empty
-	1,  // 8: meshpb.RemovePeerResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
-	34, // 9: meshpb.RemovePeerResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 10: meshpb.RemovePeerResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 11: meshpb.ChangeNicknameResponse.empty:type_name -> meshpb.Empty
-	1,  // 12: meshpb.ChangeNicknameResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
-	34, // 13: meshpb.ChangeNicknameResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 14: meshpb.ChangeNicknameResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	2,  // 15: meshpb.ChangeNicknameResponse.change_nickname_error_code:type_name -> meshpb.ChangeNicknameErrorCode
-	36, // 16: meshpb.AllowRoutingResponse.empty:type_name -> meshpb.Empty
-	1,  // 17: meshpb.AllowRoutingResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
-	3,  // 18: meshpb.AllowRoutingResponse.allow_routing_error_code:type_name -> meshpb.AllowRoutingErrorCode
-	34, // 19: meshpb.AllowRoutingResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 20: meshpb.AllowRoutingResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 21: meshpb.DenyRoutingResponse.empty:type_name -> meshpb.Empty
-	1,  // 22: meshpb.DenyRoutingResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
-	4,  // 23: meshpb.DenyRoutingResponse.deny_routing_error_code:type_name -> meshpb.DenyRoutingErrorCode
-	34, // 24: meshpb.DenyRoutingResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 25: meshpb.DenyRoutingResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 26: meshpb.AllowIncomingResponse.empty:type_name -> meshpb.Empty
-	1,  // 27: meshpb.AllowIncomingResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
-	5,  // 28: meshpb.AllowIncomingResponse.allow_incoming_error_code:type_name -> meshpb.AllowIncomingErrorCode
-	34, // 29: meshpb.AllowIncomingResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 30: meshpb.AllowIncomingResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 31: meshpb.DenyIncomingResponse.empty:type_name -> meshpb.Empty
-	1,  // 32: meshpb.DenyIncomingResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
-	6,  // 33: meshpb.DenyIncomingResponse.deny_incoming_error_code:type_name -> meshpb.DenyIncomingErrorCode
-	34, // 34: meshpb.DenyIncomingResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 35: meshpb.DenyIncomingResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 36: meshpb.AllowLocalNetworkResponse.empty:type_name -> meshpb.Empty
-	1,  // 37: meshpb.AllowLocalNetworkResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
-	7,  // 38: meshpb.AllowLocalNetworkResponse.allow_local_network_error_code:type_name -> meshpb.AllowLocalNetworkErrorCode
-	34, // 39: meshpb.AllowLocalNetworkResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 40: meshpb.AllowLocalNetworkResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 41: meshpb.DenyLocalNetworkResponse.empty:type_name -> meshpb.Empty
-	1,  // 42: meshpb.DenyLocalNetworkResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
-	8,  // 43: meshpb.DenyLocalNetworkResponse.deny_local_network_error_code:type_name -> meshpb.DenyLocalNetworkErrorCode
-	34, // 44: meshpb.DenyLocalNetworkResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 45: meshpb.DenyLocalNetworkResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 46: meshpb.AllowFileshareResponse.empty:type_name -> meshpb.Empty
-	1,  // 47: meshpb.AllowFileshareResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
-	9,  // 48: meshpb.AllowFileshareResponse.allow_send_error_code:type_name -> meshpb.AllowFileshareErrorCode
-	34, // 49: meshpb.AllowFileshareResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 50: meshpb.AllowFileshareResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 51: meshpb.DenyFileshareResponse.empty:type_name -> meshpb.Empty
-	1,  // 52: meshpb.DenyFileshareResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
-	10, // 53: meshpb.DenyFileshareResponse.deny_send_error_code:type_name -> meshpb.DenyFileshareErrorCode
-	34, // 54: meshpb.DenyFileshareResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 55: meshpb.DenyFileshareResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 56: meshpb.EnableAutomaticFileshareResponse.empty:type_name -> meshpb.Empty
-	1,  // 57: meshpb.EnableAutomaticFileshareResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
-	11, // 58: meshpb.EnableAutomaticFileshareResponse.enable_automatic_fileshare_error_code:type_name -> meshpb.EnableAutomaticFileshareErrorCode
-	34, // 59: meshpb.EnableAutomaticFileshareResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 60: meshpb.EnableAutomaticFileshareResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 61: meshpb.DisableAutomaticFileshareResponse.empty:type_name -> meshpb.Empty
-	1,  // 62: meshpb.DisableAutomaticFileshareResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
-	12, // 63: meshpb.DisableAutomaticFileshareResponse.disable_automatic_fileshare_error_code:type_name -> meshpb.DisableAutomaticFileshareErrorCode
-	34, // 64: meshpb.DisableAutomaticFileshareResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 65: meshpb.DisableAutomaticFileshareResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	36, // 66: meshpb.ConnectResponse.empty:type_name -> meshpb.Empty
-	1,  // 67: meshpb.ConnectResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
-	13, // 68: meshpb.ConnectResponse.connect_error_code:type_name -> meshpb.ConnectErrorCode
-	34, // 69: meshpb.ConnectResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	35, // 70: meshpb.ConnectResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
-	34, // 71: meshpb.PrivateKeyResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
-	72, // [72:72] is the sub-list for method output_type
-	72, // [72:72] is the sub-list for method input_type
-	72, // [72:72] is the sub-list for extension type_name
-	72, // [72:72] is the sub-list for extension extendee
-	0,  // [0:72] is the sub-list for field type_name
+	1,  // 7: meshpb.Peer.path:type_name -> meshpb.PeerPath
+	44, // 8: meshpb.RemovePeerResponse.empty:type_name -> meshpb.Empty
+	2,  // 9: meshpb.RemovePeerResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	42, // 10: meshpb.RemovePeerResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	43, // 11: meshpb.RemovePeerResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	44, // 12: meshpb.ChangeNicknameResponse.empty:type_name -> meshpb.Empty
+	2,  // 13: meshpb.ChangeNicknameResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	42, // 14: meshpb.ChangeNicknameResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	43, // 15: meshpb.ChangeNicknameResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	3,  // 16: meshpb.ChangeNicknameResponse.change_nickname_error_code:type_name -> meshpb.ChangeNicknameErrorCode
+	44, // 17: meshpb.PeerNoteResponse.empty:type_name -> meshpb.Empty
+	2,  // 18: meshpb.PeerNoteResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	4,  // 19: meshpb.PeerNoteResponse.peer_note_error_code:type_name -> meshpb.PeerNoteErrorCode
+	42, // 20: meshpb.PeerNoteResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	43, // 21: meshpb.PeerNoteResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	44, // 22: meshpb.PeerBandwidthLimitResponse.empty:type_name -> meshpb.Empty
+	2,  // 23: meshpb.PeerBandwidthLimitResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	5,  // 24: meshpb.PeerBandwidthLimitResponse.peer_bandwidth_limit_error_code:type_name -> meshpb.PeerBandwidthLimitErrorCode
+	42, // 25: meshpb.PeerBandwidthLimitResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	43, // 26: meshpb.PeerBandwidthLimitResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	44, // 27: meshpb.AllowRoutingResponse.empty:type_name -> meshpb.Empty
+	2,  // 28: meshpb.AllowRoutingResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	6,  // 29: meshpb.AllowRoutingResponse.allow_routing_error_code:type_name -> meshpb.AllowRoutingErrorCode
+	42, // 30: meshpb.AllowRoutingResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	43, // 31: meshpb.AllowRoutingResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	44, // 32: meshpb.DenyRoutingResponse.empty:type_name -> meshpb.Empty
+	2,  // 33: meshpb.DenyRoutingResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	7,  // 34: meshpb.DenyRoutingResponse.deny_routing_error_code:type_name -> meshpb.DenyRoutingErrorCode
+	42, // 35: meshpb.DenyRoutingResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	43, // 36: meshpb.DenyRoutingResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	44, // 37: meshpb.AllowIncomingResponse.empty:type_name -> meshpb.Empty
+	2,  // 38: meshpb.AllowIncomingResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	8,  // 39: meshpb.AllowIncomingResponse.allow_incoming_error_code:type_name -> meshpb.AllowIncomingErrorCode
+	42, // 40: meshpb.AllowIncomingResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	43, // 41: meshpb.AllowIncomingResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	44, // 42: meshpb.DenyIncomingResponse.empty:type_name -> meshpb.Empty
+	2,  // 43: meshpb.DenyIncomingResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	9,  // 44: meshpb.DenyIncomingResponse.deny_incoming_error_code:type_name -> meshpb.DenyIncomingErrorCode
+	42, // 45: meshpb.DenyIncomingResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	43, // 46: meshpb.DenyIncomingResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	44, // 47: meshpb.AllowLocalNetworkResponse.empty:type_name -> meshpb.Empty
+	2,  // 48: meshpb.AllowLocalNetworkResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	10, // 49: meshpb.AllowLocalNetworkResponse.allow_local_network_error_code:type_name -> meshpb.AllowLocalNetworkErrorCode
+	42, // 50: meshpb.AllowLocalNetworkResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	43, // 51: meshpb.AllowLocalNetworkResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	44, // 52: meshpb.DenyLocalNetworkResponse.empty:type_name -> meshpb.Empty
+	2,  // 53: meshpb.DenyLocalNetworkResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	11, // 54: meshpb.DenyLocalNetworkResponse.deny_local_network_error_code:type_name -> meshpb.DenyLocalNetworkErrorCode
+	42, // 55: meshpb.DenyLocalNetworkResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	43, // 56: meshpb.DenyLocalNetworkResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	44, // 57: meshpb.AllowFileshareResponse.empty:type_name -> meshpb.Empty
+	2,  // 58: meshpb.AllowFileshareResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	12, // 59: meshpb.AllowFileshareResponse.allow_send_error_code:type_name -> meshpb.AllowFileshareErrorCode
+	42, // 60: meshpb.AllowFileshareResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	43, // 61: meshpb.AllowFileshareResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	44, // 62: meshpb.DenyFileshareResponse.empty:type_name -> meshpb.Empty
+	2,  // 63: meshpb.DenyFileshareResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	13, // 64: meshpb.DenyFileshareResponse.deny_send_error_code:type_name -> meshpb.DenyFileshareErrorCode
+	42, // 65: meshpb.DenyFileshareResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	43, // 66: meshpb.DenyFileshareResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	44, // 67: meshpb.EnableAutomaticFileshareResponse.empty:type_name -> meshpb.Empty
+	2,  // 68: meshpb.EnableAutomaticFileshareResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	14, // 69: meshpb.EnableAutomaticFileshareResponse.enable_automatic_fileshare_error_code:type_name -> meshpb.EnableAutomaticFileshareErrorCode
+	42, // 70: meshpb.EnableAutomaticFileshareResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	43, // 71: meshpb.EnableAutomaticFileshareResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	44, // 72: meshpb.DisableAutomaticFileshareResponse.empty:type_name -> meshpb.Empty
+	2,  // 73: meshpb.DisableAutomaticFileshareResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	15, // 74: meshpb.DisableAutomaticFileshareResponse.disable_automatic_fileshare_error_code:type_name -> meshpb.DisableAutomaticFileshareErrorCode
+	42, // 75: meshpb.DisableAutomaticFileshareResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	43, // 76: meshpb.DisableAutomaticFileshareResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	44, // 77: meshpb.ConnectResponse.empty:type_name -> meshpb.Empty
+	2,  // 78: meshpb.ConnectResponse.update_peer_error_code:type_name -> meshpb.UpdatePeerErrorCode
+	16, // 79: meshpb.ConnectResponse.connect_error_code:type_name -> meshpb.ConnectErrorCode
+	42, // 80: meshpb.ConnectResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	43, // 81: meshpb.ConnectResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	42, // 82: meshpb.PrivateKeyResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	83, // [83:83] is the sub-list for method output_type
+	83, // [83:83] is the sub-list for method input_type
+	83, // [83:83] is the sub-list for extension type_name
+	83, // [83:83] is the sub-list for extension extendee
+	0,  // [0:83] is the sub-list for field type_name
 }
 
 func init() { file_peer_proto_init() }
@@ -3621,7 +4358,7 @@ func file_peer_proto_init() {
 			}
 		}
 		file_peer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AllowRoutingResponse); i {
+			switch v := v.(*SetPeerNoteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3633,7 +4370,7 @@ func file_peer_proto_init() {
 			}
 		}
 		file_peer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DenyRoutingResponse); i {
+			switch v := v.(*SetPeerTagsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3645,7 +4382,7 @@ func file_peer_proto_init() {
 			}
 		}
 		file_peer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AllowIncomingResponse); i {
+			switch v := v.(*PeerNoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3657,7 +4394,7 @@ func file_peer_proto_init() {
 			}
 		}
 		file_peer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DenyIncomingResponse); i {
+			switch v := v.(*SetPeerBandwidthLimitRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3669,7 +4406,7 @@ func file_peer_proto_init() {
 			}
 		}
 		file_peer_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AllowLocalNetworkResponse); i {
+			switch v := v.(*PeerBandwidthLimitResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3681,7 +4418,7 @@ func file_peer_proto_init() {
 			}
 		}
 		file_peer_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DenyLocalNetworkResponse); i {
+			switch v := v.(*AllowRoutingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3693,7 +4430,7 @@ func file_peer_proto_init() {
 			}
 		}
 		file_peer_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AllowFileshareResponse); i {
+			switch v := v.(*DenyRoutingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3705,7 +4442,7 @@ func file_peer_proto_init() {
 			}
 		}
 		file_peer_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DenyFileshareResponse); i {
+			switch v := v.(*AllowIncomingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3717,7 +4454,7 @@ func file_peer_proto_init() {
 			}
 		}
 		file_peer_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnableAutomaticFileshareResponse); i {
+			switch v := v.(*DenyIncomingResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3729,7 +4466,7 @@ func file_peer_proto_init() {
 			}
 		}
 		file_peer_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DisableAutomaticFileshareResponse); i {
+			switch v := v.(*AllowLocalNetworkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3741,7 +4478,7 @@ func file_peer_proto_init() {
 			}
 		}
 		file_peer_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectResponse); i {
+			switch v := v.(*DenyLocalNetworkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3753,6 +4490,66 @@ func file_peer_proto_init() {
 			}
 		}
 		file_peer_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllowFileshareResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DenyFileshareResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableAutomaticFileshareResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisableAutomaticFileshareResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_peer_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrivateKeyResponse); i {
 			case 0:
 				return &v.state
@@ -3783,84 +4580,98 @@ func file_peer_proto_init() {
 		(*ChangeNicknameResponse_MeshnetErrorCode)(nil),
 		(*ChangeNicknameResponse_ChangeNicknameErrorCode)(nil),
 	}
-	file_peer_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_peer_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*PeerNoteResponse_Empty)(nil),
+		(*PeerNoteResponse_UpdatePeerErrorCode)(nil),
+		(*PeerNoteResponse_PeerNoteErrorCode)(nil),
+		(*PeerNoteResponse_ServiceErrorCode)(nil),
+		(*PeerNoteResponse_MeshnetErrorCode)(nil),
+	}
+	file_peer_proto_msgTypes[12].OneofWrappers = []interface{}{
+		(*PeerBandwidthLimitResponse_Empty)(nil),
+		(*PeerBandwidthLimitResponse_UpdatePeerErrorCode)(nil),
+		(*PeerBandwidthLimitResponse_PeerBandwidthLimitErrorCode)(nil),
+		(*PeerBandwidthLimitResponse_ServiceErrorCode)(nil),
+		(*PeerBandwidthLimitResponse_MeshnetErrorCode)(nil),
+	}
+	file_peer_proto_msgTypes[13].OneofWrappers = []interface{}{
 		(*AllowRoutingResponse_Empty)(nil),
 		(*AllowRoutingResponse_UpdatePeerErrorCode)(nil),
 		(*AllowRoutingResponse_AllowRoutingErrorCode)(nil),
 		(*AllowRoutingResponse_ServiceErrorCode)(nil),
 		(*AllowRoutingResponse_MeshnetErrorCode)(nil),
 	}
-	file_peer_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_peer_proto_msgTypes[14].OneofWrappers = []interface{}{
 		(*DenyRoutingResponse_Empty)(nil),
 		(*DenyRoutingResponse_UpdatePeerErrorCode)(nil),
 		(*DenyRoutingResponse_DenyRoutingErrorCode)(nil),
 		(*DenyRoutingResponse_ServiceErrorCode)(nil),
 		(*DenyRoutingResponse_MeshnetErrorCode)(nil),
 	}
-	file_peer_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_peer_proto_msgTypes[15].OneofWrappers = []interface{}{
 		(*AllowIncomingResponse_Empty)(nil),
 		(*AllowIncomingResponse_UpdatePeerErrorCode)(nil),
 		(*AllowIncomingResponse_AllowIncomingErrorCode)(nil),
 		(*AllowIncomingResponse_ServiceErrorCode)(nil),
 		(*AllowIncomingResponse_MeshnetErrorCode)(nil),
 	}
-	file_peer_proto_msgTypes[11].OneofWrappers = []interface{}{
+	file_peer_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*DenyIncomingResponse_Empty)(nil),
 		(*DenyIncomingResponse_UpdatePeerErrorCode)(nil),
 		(*DenyIncomingResponse_DenyIncomingErrorCode)(nil),
 		(*DenyIncomingResponse_ServiceErrorCode)(nil),
 		(*DenyIncomingResponse_MeshnetErrorCode)(nil),
 	}
-	file_peer_proto_msgTypes[12].OneofWrappers = []interface{}{
+	file_peer_proto_msgTypes[17].OneofWrappers = []interface{}{
 		(*AllowLocalNetworkResponse_Empty)(nil),
 		(*AllowLocalNetworkResponse_UpdatePeerErrorCode)(nil),
 		(*AllowLocalNetworkResponse_AllowLocalNetworkErrorCode)(nil),
 		(*AllowLocalNetworkResponse_ServiceErrorCode)(nil),
 		(*AllowLocalNetworkResponse_MeshnetErrorCode)(nil),
 	}
-	file_peer_proto_msgTypes[13].OneofWrappers = []interface{}{
+	file_peer_proto_msgTypes[18].OneofWrappers = []interface{}{
 		(*DenyLocalNetworkResponse_Empty)(nil),
 		(*DenyLocalNetworkResponse_UpdatePeerErrorCode)(nil),
 		(*DenyLocalNetworkResponse_DenyLocalNetworkErrorCode)(nil),
 		(*DenyLocalNetworkResponse_ServiceErrorCode)(nil),
 		(*DenyLocalNetworkResponse_MeshnetErrorCode)(nil),
 	}
-	file_peer_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_peer_proto_msgTypes[19].OneofWrappers = []interface{}{
 		(*AllowFileshareResponse_Empty)(nil),
 		(*AllowFileshareResponse_UpdatePeerErrorCode)(nil),
 		(*AllowFileshareResponse_AllowSendErrorCode)(nil),
 		(*AllowFileshareResponse_ServiceErrorCode)(nil),
 		(*AllowFileshareResponse_MeshnetErrorCode)(nil),
 	}
-	file_peer_proto_msgTypes[15].OneofWrappers = []interface{}{
+	file_peer_proto_msgTypes[20].OneofWrappers = []interface{}{
 		(*DenyFileshareResponse_Empty)(nil),
 		(*DenyFileshareResponse_UpdatePeerErrorCode)(nil),
 		(*DenyFileshareResponse_DenySendErrorCode)(nil),
 		(*DenyFileshareResponse_ServiceErrorCode)(nil),
 		(*DenyFileshareResponse_MeshnetErrorCode)(nil),
 	}
-	file_peer_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_peer_proto_msgTypes[21].OneofWrappers = []interface{}{
 		(*EnableAutomaticFileshareResponse_Empty)(nil),
 		(*EnableAutomaticFileshareResponse_UpdatePeerErrorCode)(nil),
 		(*EnableAutomaticFileshareResponse_EnableAutomaticFileshareErrorCode)(nil),
 		(*EnableAutomaticFileshareResponse_ServiceErrorCode)(nil),
 		(*EnableAutomaticFileshareResponse_MeshnetErrorCode)(nil),
 	}
-	file_peer_proto_msgTypes[17].OneofWrappers = []interface{}{
+	file_peer_proto_msgTypes[22].OneofWrappers = []interface{}{
 		(*DisableAutomaticFileshareResponse_Empty)(nil),
 		(*DisableAutomaticFileshareResponse_UpdatePeerErrorCode)(nil),
 		(*DisableAutomaticFileshareResponse_DisableAutomaticFileshareErrorCode)(nil),
 		(*DisableAutomaticFileshareResponse_ServiceErrorCode)(nil),
 		(*DisableAutomaticFileshareResponse_MeshnetErrorCode)(nil),
 	}
-	file_peer_proto_msgTypes[18].OneofWrappers = []interface{}{
+	file_peer_proto_msgTypes[23].OneofWrappers = []interface{}{
 		(*ConnectResponse_Empty)(nil),
 		(*ConnectResponse_UpdatePeerErrorCode)(nil),
 		(*ConnectResponse_ConnectErrorCode)(nil),
 		(*ConnectResponse_ServiceErrorCode)(nil),
 		(*ConnectResponse_MeshnetErrorCode)(nil),
 	}
-	file_peer_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_peer_proto_msgTypes[24].OneofWrappers = []interface{}{
 		(*PrivateKeyResponse_PrivateKey)(nil),
 		(*PrivateKeyResponse_ServiceErrorCode)(nil),
 	}
@@ -3869,8 +4680,8 @@ func file_peer_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_peer_proto_rawDesc,
-			NumEnums:      14,
-			NumMessages:   20,
+			NumEnums:      17,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -38,10 +38,23 @@ type MeshnetClient interface {
 	// Invite sends the invite to the specified email to join the
 	// meshnet.
 	RevokeInvite(ctx context.Context, in *DenyInviteRequest, opts ...grpc.CallOption) (*RespondToInviteResponse, error)
+	// ResendInvite sends the invite to the specified email again with the
+	// same permissions and a renewed expiry
+	ResendInvite(ctx context.Context, in *DenyInviteRequest, opts ...grpc.CallOption) (*InviteResponse, error)
+	// SetInviteTTL changes how long the sent invites stay valid
+	SetInviteTTL(ctx context.Context, in *SetInviteTTLRequest, opts ...grpc.CallOption) (*InviteTTLResponse, error)
 	// AcceptInvite accepts the invite to join someone's meshnet
 	AcceptInvite(ctx context.Context, in *InviteRequest, opts ...grpc.CallOption) (*RespondToInviteResponse, error)
 	// AcceptInvite denies the invite to join someone's meshnet
 	DenyInvite(ctx context.Context, in *DenyInviteRequest, opts ...grpc.CallOption) (*RespondToInviteResponse, error)
+	// SetAutoAcceptInvites changes the policy of accepting the invites from
+	// the devices of the same account automatically
+	SetAutoAcceptInvites(ctx context.Context, in *SetAutoAcceptInvitesRequest, opts ...grpc.CallOption) (*AutoAcceptInvitesResponse, error)
+	// CreateInviteCode creates a short-lived invite code which can be
+	// redeemed by another device to join this meshnet
+	CreateInviteCode(ctx context.Context, in *CreateInviteCodeRequest, opts ...grpc.CallOption) (*CreateInviteCodeResponse, error)
+	// RedeemInviteCode joins the meshnet of the invite code creator
+	RedeemInviteCode(ctx context.Context, in *RedeemInviteCodeRequest, opts ...grpc.CallOption) (*RedeemInviteCodeResponse, error)
 	// GetPeers retries the list of all meshnet peers related to
 	// this device
 	GetPeers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPeersResponse, error)
@@ -82,6 +95,33 @@ type MeshnetClient interface {
 	NotifyNewTransfer(ctx context.Context, in *NewTransferNotification, opts ...grpc.CallOption) (*NotifyNewTransferResponse, error)
 	// GetPrivateKey is used to send self private key over to fileshare daemon
 	GetPrivateKey(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*PrivateKeyResponse, error)
+	// GetPeerGroups retrieves the peer groups of this device
+	GetPeerGroups(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPeerGroupsResponse, error)
+	// AddPeerToGroup adds a peer to a group, the group is created if it
+	// does not exist yet
+	AddPeerToGroup(ctx context.Context, in *PeerGroupRequest, opts ...grpc.CallOption) (*PeerGroupResponse, error)
+	// RemovePeerFromGroup removes a peer from a group, the group is
+	// removed together with its last peer
+	RemovePeerFromGroup(ctx context.Context, in *PeerGroupRequest, opts ...grpc.CallOption) (*PeerGroupResponse, error)
+	// SetPeerGroupPermissions changes the permissions of all the peers
+	// in a group
+	SetPeerGroupPermissions(ctx context.Context, in *SetPeerGroupPermissionsRequest, opts ...grpc.CallOption) (*PeerGroupResponse, error)
+	// SetPeerNote replaces the note of a peer
+	SetPeerNote(ctx context.Context, in *SetPeerNoteRequest, opts ...grpc.CallOption) (*PeerNoteResponse, error)
+	// SetPeerTags replaces the tags of a peer
+	SetPeerTags(ctx context.Context, in *SetPeerTagsRequest, opts ...grpc.CallOption) (*PeerNoteResponse, error)
+	// SubscribePeerPresence streams the peers going online or offline and
+	// changing their addresses. The current state of the peers is sent first.
+	SubscribePeerPresence(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Meshnet_SubscribePeerPresenceClient, error)
+	// MeasurePeers retrieves the same list as GetPeers with the round trip
+	// times measured to the connected peers
+	MeasurePeers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPeersResponse, error)
+	// SetAdvertisedSubnets announces the subnets, e.g. the local network, to
+	// the peers as reachable through this device
+	SetAdvertisedSubnets(ctx context.Context, in *SetAdvertisedSubnetsRequest, opts ...grpc.CallOption) (*AdvertisedSubnetsResponse, error)
+	// SetPeerBandwidthLimit caps the traffic a peer can route through this
+	// device
+	SetPeerBandwidthLimit(ctx context.Context, in *SetPeerBandwidthLimitRequest, opts ...grpc.CallOption) (*PeerBandwidthLimitResponse, error)
 }
 
 type meshnetClient struct {
@@ -155,6 +195,24 @@ func (c *meshnetClient) RevokeInvite(ctx context.Context, in *DenyInviteRequest,
 	return out, nil
 }
 
+func (c *meshnetClient) ResendInvite(ctx context.Context, in *DenyInviteRequest, opts ...grpc.CallOption) (*InviteResponse, error) {
+	out := new(InviteResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/ResendInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) SetInviteTTL(ctx context.Context, in *SetInviteTTLRequest, opts ...grpc.CallOption) (*InviteTTLResponse, error) {
+	out := new(InviteTTLResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/SetInviteTTL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *meshnetClient) AcceptInvite(ctx context.Context, in *InviteRequest, opts ...grpc.CallOption) (*RespondToInviteResponse, error) {
 	out := new(RespondToInviteResponse)
 	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/AcceptInvite", in, out, opts...)
@@ -173,6 +231,33 @@ func (c *meshnetClient) DenyInvite(ctx context.Context, in *DenyInviteRequest, o
 	return out, nil
 }
 
+func (c *meshnetClient) SetAutoAcceptInvites(ctx context.Context, in *SetAutoAcceptInvitesRequest, opts ...grpc.CallOption) (*AutoAcceptInvitesResponse, error) {
+	out := new(AutoAcceptInvitesResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/SetAutoAcceptInvites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) CreateInviteCode(ctx context.Context, in *CreateInviteCodeRequest, opts ...grpc.CallOption) (*CreateInviteCodeResponse, error) {
+	out := new(CreateInviteCodeResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/CreateInviteCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) RedeemInviteCode(ctx context.Context, in *RedeemInviteCodeRequest, opts ...grpc.CallOption) (*RedeemInviteCodeResponse, error) {
+	out := new(RedeemInviteCodeResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/RedeemInviteCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *meshnetClient) GetPeers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPeersResponse, error) {
 	out := new(GetPeersResponse)
 	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/GetPeers", in, out, opts...)
@@ -335,6 +420,119 @@ func (c *meshnetClient) GetPrivateKey(ctx context.Context, in *Empty, opts ...gr
 	return out, nil
 }
 
+func (c *meshnetClient) GetPeerGroups(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPeerGroupsResponse, error) {
+	out := new(GetPeerGroupsResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/GetPeerGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) AddPeerToGroup(ctx context.Context, in *PeerGroupRequest, opts ...grpc.CallOption) (*PeerGroupResponse, error) {
+	out := new(PeerGroupResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/AddPeerToGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) RemovePeerFromGroup(ctx context.Context, in *PeerGroupRequest, opts ...grpc.CallOption) (*PeerGroupResponse, error) {
+	out := new(PeerGroupResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/RemovePeerFromGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) SetPeerGroupPermissions(ctx context.Context, in *SetPeerGroupPermissionsRequest, opts ...grpc.CallOption) (*PeerGroupResponse, error) {
+	out := new(PeerGroupResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/SetPeerGroupPermissions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) SetPeerNote(ctx context.Context, in *SetPeerNoteRequest, opts ...grpc.CallOption) (*PeerNoteResponse, error) {
+	out := new(PeerNoteResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/SetPeerNote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) SetPeerTags(ctx context.Context, in *SetPeerTagsRequest, opts ...grpc.CallOption) (*PeerNoteResponse, error) {
+	out := new(PeerNoteResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/SetPeerTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) SubscribePeerPresence(ctx context.Context, in *Empty, opts ...grpc.CallOption) (Meshnet_SubscribePeerPresenceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Meshnet_ServiceDesc.Streams[0], "/meshpb.Meshnet/SubscribePeerPresence", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &meshnetSubscribePeerPresenceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Meshnet_SubscribePeerPresenceClient interface {
+	Recv() (*PeerPresenceEvent, error)
+	grpc.ClientStream
+}
+
+type meshnetSubscribePeerPresenceClient struct {
+	grpc.ClientStream
+}
+
+func (x *meshnetSubscribePeerPresenceClient) Recv() (*PeerPresenceEvent, error) {
+	m := new(PeerPresenceEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *meshnetClient) MeasurePeers(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPeersResponse, error) {
+	out := new(GetPeersResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/MeasurePeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) SetAdvertisedSubnets(ctx context.Context, in *SetAdvertisedSubnetsRequest, opts ...grpc.CallOption) (*AdvertisedSubnetsResponse, error) {
+	out := new(AdvertisedSubnetsResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/SetAdvertisedSubnets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *meshnetClient) SetPeerBandwidthLimit(ctx context.Context, in *SetPeerBandwidthLimitRequest, opts ...grpc.CallOption) (*PeerBandwidthLimitResponse, error) {
+	out := new(PeerBandwidthLimitResponse)
+	err := c.cc.Invoke(ctx, "/meshpb.Meshnet/SetPeerBandwidthLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MeshnetServer is the server API for Meshnet service.
 // All implementations must embed UnimplementedMeshnetServer
 // for forward compatibility
@@ -355,10 +553,23 @@ type MeshnetServer interface {
 	// Invite sends the invite to the specified email to join the
 	// meshnet.
 	RevokeInvite(context.Context, *DenyInviteRequest) (*RespondToInviteResponse, error)
+	// ResendInvite sends the invite to the specified email again with the
+	// same permissions and a renewed expiry
+	ResendInvite(context.Context, *DenyInviteRequest) (*InviteResponse, error)
+	// SetInviteTTL changes how long the sent invites stay valid
+	SetInviteTTL(context.Context, *SetInviteTTLRequest) (*InviteTTLResponse, error)
 	// AcceptInvite accepts the invite to join someone's meshnet
 	AcceptInvite(context.Context, *InviteRequest) (*RespondToInviteResponse, error)
 	// AcceptInvite denies the invite to join someone's meshnet
 	DenyInvite(context.Context, *DenyInviteRequest) (*RespondToInviteResponse, error)
+	// SetAutoAcceptInvites changes the policy of accepting the invites from
+	// the devices of the same account automatically
+	SetAutoAcceptInvites(context.Context, *SetAutoAcceptInvitesRequest) (*AutoAcceptInvitesResponse, error)
+	// CreateInviteCode creates a short-lived invite code which can be
+	// redeemed by another device to join this meshnet
+	CreateInviteCode(context.Context, *CreateInviteCodeRequest) (*CreateInviteCodeResponse, error)
+	// RedeemInviteCode joins the meshnet of the invite code creator
+	RedeemInviteCode(context.Context, *RedeemInviteCodeRequest) (*RedeemInviteCodeResponse, error)
 	// GetPeers retries the list of all meshnet peers related to
 	// this device
 	GetPeers(context.Context, *Empty) (*GetPeersResponse, error)
@@ -399,6 +610,33 @@ type MeshnetServer interface {
 	NotifyNewTransfer(context.Context, *NewTransferNotification) (*NotifyNewTransferResponse, error)
 	// GetPrivateKey is used to send self private key over to fileshare daemon
 	GetPrivateKey(context.Context, *Empty) (*PrivateKeyResponse, error)
+	// GetPeerGroups retrieves the peer groups of this device
+	GetPeerGroups(context.Context, *Empty) (*GetPeerGroupsResponse, error)
+	// AddPeerToGroup adds a peer to a group, the group is created if it
+	// does not exist yet
+	AddPeerToGroup(context.Context, *PeerGroupRequest) (*PeerGroupResponse, error)
+	// RemovePeerFromGroup removes a peer from a group, the group is
+	// removed together with its last peer
+	RemovePeerFromGroup(context.Context, *PeerGroupRequest) (*PeerGroupResponse, error)
+	// SetPeerGroupPermissions changes the permissions of all the peers
+	// in a group
+	SetPeerGroupPermissions(context.Context, *SetPeerGroupPermissionsRequest) (*PeerGroupResponse, error)
+	// SetPeerNote replaces the note of a peer
+	SetPeerNote(context.Context, *SetPeerNoteRequest) (*PeerNoteResponse, error)
+	// SetPeerTags replaces the tags of a peer
+	SetPeerTags(context.Context, *SetPeerTagsRequest) (*PeerNoteResponse, error)
+	// SubscribePeerPresence streams the peers going online or offline and
+	// changing their addresses. The current state of the peers is sent first.
+	SubscribePeerPresence(*Empty, Meshnet_SubscribePeerPresenceServer) error
+	// MeasurePeers retrieves the same list as GetPeers with the round trip
+	// times measured to the connected peers
+	MeasurePeers(context.Context, *Empty) (*GetPeersResponse, error)
+	// SetAdvertisedSubnets announces the subnets, e.g. the local network, to
+	// the peers as reachable through this device
+	SetAdvertisedSubnets(context.Context, *SetAdvertisedSubnetsRequest) (*AdvertisedSubnetsResponse, error)
+	// SetPeerBandwidthLimit caps the traffic a peer can route through this
+	// device
+	SetPeerBandwidthLimit(context.Context, *SetPeerBandwidthLimitRequest) (*PeerBandwidthLimitResponse, error)
 	mustEmbedUnimplementedMeshnetServer()
 }
 
@@ -427,12 +665,27 @@ func (UnimplementedMeshnetServer) Invite(context.Context, *InviteRequest) (*Invi
 func (UnimplementedMeshnetServer) RevokeInvite(context.Context, *DenyInviteRequest) (*RespondToInviteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvite not implemented")
 }
+func (UnimplementedMeshnetServer) ResendInvite(context.Context, *DenyInviteRequest) (*InviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendInvite not implemented")
+}
+func (UnimplementedMeshnetServer) SetInviteTTL(context.Context, *SetInviteTTLRequest) (*InviteTTLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInviteTTL not implemented")
+}
 func (UnimplementedMeshnetServer) AcceptInvite(context.Context, *InviteRequest) (*RespondToInviteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvite not implemented")
 }
 func (UnimplementedMeshnetServer) DenyInvite(context.Context, *DenyInviteRequest) (*RespondToInviteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenyInvite not implemented")
 }
+func (UnimplementedMeshnetServer) SetAutoAcceptInvites(context.Context, *SetAutoAcceptInvitesRequest) (*AutoAcceptInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoAcceptInvites not implemented")
+}
+func (UnimplementedMeshnetServer) CreateInviteCode(context.Context, *CreateInviteCodeRequest) (*CreateInviteCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInviteCode not implemented")
+}
+func (UnimplementedMeshnetServer) RedeemInviteCode(context.Context, *RedeemInviteCodeRequest) (*RedeemInviteCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemInviteCode not implemented")
+}
 func (UnimplementedMeshnetServer) GetPeers(context.Context, *Empty) (*GetPeersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPeers not implemented")
 }
@@ -487,6 +740,36 @@ func (UnimplementedMeshnetServer) NotifyNewTransfer(context.Context, *NewTransfe
 func (UnimplementedMeshnetServer) GetPrivateKey(context.Context, *Empty) (*PrivateKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPrivateKey not implemented")
 }
+func (UnimplementedMeshnetServer) GetPeerGroups(context.Context, *Empty) (*GetPeerGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerGroups not implemented")
+}
+func (UnimplementedMeshnetServer) AddPeerToGroup(context.Context, *PeerGroupRequest) (*PeerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPeerToGroup not implemented")
+}
+func (UnimplementedMeshnetServer) RemovePeerFromGroup(context.Context, *PeerGroupRequest) (*PeerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePeerFromGroup not implemented")
+}
+func (UnimplementedMeshnetServer) SetPeerGroupPermissions(context.Context, *SetPeerGroupPermissionsRequest) (*PeerGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPeerGroupPermissions not implemented")
+}
+func (UnimplementedMeshnetServer) SetPeerNote(context.Context, *SetPeerNoteRequest) (*PeerNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPeerNote not implemented")
+}
+func (UnimplementedMeshnetServer) SetPeerTags(context.Context, *SetPeerTagsRequest) (*PeerNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPeerTags not implemented")
+}
+func (UnimplementedMeshnetServer) SubscribePeerPresence(*Empty, Meshnet_SubscribePeerPresenceServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribePeerPresence not implemented")
+}
+func (UnimplementedMeshnetServer) MeasurePeers(context.Context, *Empty) (*GetPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MeasurePeers not implemented")
+}
+func (UnimplementedMeshnetServer) SetAdvertisedSubnets(context.Context, *SetAdvertisedSubnetsRequest) (*AdvertisedSubnetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAdvertisedSubnets not implemented")
+}
+func (UnimplementedMeshnetServer) SetPeerBandwidthLimit(context.Context, *SetPeerBandwidthLimitRequest) (*PeerBandwidthLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPeerBandwidthLimit not implemented")
+}
 func (UnimplementedMeshnetServer) mustEmbedUnimplementedMeshnetServer() {}
 
 // UnsafeMeshnetServer may be embedded to opt out of forward compatibility for this service.
@@ -626,6 +909,42 @@ func _Meshnet_RevokeInvite_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Meshnet_ResendInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenyInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).ResendInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/ResendInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).ResendInvite(ctx, req.(*DenyInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_SetInviteTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInviteTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).SetInviteTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/SetInviteTTL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).SetInviteTTL(ctx, req.(*SetInviteTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Meshnet_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InviteRequest)
 	if err := dec(in); err != nil {
@@ -662,6 +981,60 @@ func _Meshnet_DenyInvite_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Meshnet_SetAutoAcceptInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAutoAcceptInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).SetAutoAcceptInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/SetAutoAcceptInvites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).SetAutoAcceptInvites(ctx, req.(*SetAutoAcceptInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_CreateInviteCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).CreateInviteCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/CreateInviteCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).CreateInviteCode(ctx, req.(*CreateInviteCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_RedeemInviteCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemInviteCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).RedeemInviteCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/RedeemInviteCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).RedeemInviteCode(ctx, req.(*RedeemInviteCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Meshnet_GetPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -986,6 +1359,189 @@ func _Meshnet_GetPrivateKey_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Meshnet_GetPeerGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).GetPeerGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/GetPeerGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).GetPeerGroups(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_AddPeerToGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).AddPeerToGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/AddPeerToGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).AddPeerToGroup(ctx, req.(*PeerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_RemovePeerFromGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).RemovePeerFromGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/RemovePeerFromGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).RemovePeerFromGroup(ctx, req.(*PeerGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_SetPeerGroupPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPeerGroupPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).SetPeerGroupPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/SetPeerGroupPermissions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).SetPeerGroupPermissions(ctx, req.(*SetPeerGroupPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_SetPeerNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPeerNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).SetPeerNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/SetPeerNote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).SetPeerNote(ctx, req.(*SetPeerNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_SetPeerTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPeerTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).SetPeerTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/SetPeerTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).SetPeerTags(ctx, req.(*SetPeerTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_SubscribePeerPresence_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MeshnetServer).SubscribePeerPresence(m, &meshnetSubscribePeerPresenceServer{stream})
+}
+
+type Meshnet_SubscribePeerPresenceServer interface {
+	Send(*PeerPresenceEvent) error
+	grpc.ServerStream
+}
+
+type meshnetSubscribePeerPresenceServer struct {
+	grpc.ServerStream
+}
+
+func (x *meshnetSubscribePeerPresenceServer) Send(m *PeerPresenceEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Meshnet_MeasurePeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).MeasurePeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/MeasurePeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).MeasurePeers(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_SetAdvertisedSubnets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAdvertisedSubnetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).SetAdvertisedSubnets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/SetAdvertisedSubnets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).SetAdvertisedSubnets(ctx, req.(*SetAdvertisedSubnetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Meshnet_SetPeerBandwidthLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPeerBandwidthLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MeshnetServer).SetPeerBandwidthLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/meshpb.Meshnet/SetPeerBandwidthLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MeshnetServer).SetPeerBandwidthLimit(ctx, req.(*SetPeerBandwidthLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Meshnet_ServiceDesc is the grpc.ServiceDesc for Meshnet service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1021,6 +1577,14 @@ var Meshnet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeInvite",
 			Handler:    _Meshnet_RevokeInvite_Handler,
 		},
+		{
+			MethodName: "ResendInvite",
+			Handler:    _Meshnet_ResendInvite_Handler,
+		},
+		{
+			MethodName: "SetInviteTTL",
+			Handler:    _Meshnet_SetInviteTTL_Handler,
+		},
 		{
 			MethodName: "AcceptInvite",
 			Handler:    _Meshnet_AcceptInvite_Handler,
@@ -1029,6 +1593,18 @@ var Meshnet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DenyInvite",
 			Handler:    _Meshnet_DenyInvite_Handler,
 		},
+		{
+			MethodName: "SetAutoAcceptInvites",
+			Handler:    _Meshnet_SetAutoAcceptInvites_Handler,
+		},
+		{
+			MethodName: "CreateInviteCode",
+			Handler:    _Meshnet_CreateInviteCode_Handler,
+		},
+		{
+			MethodName: "RedeemInviteCode",
+			Handler:    _Meshnet_RedeemInviteCode_Handler,
+		},
 		{
 			MethodName: "GetPeers",
 			Handler:    _Meshnet_GetPeers_Handler,
@@ -1101,7 +1677,49 @@ var Meshnet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPrivateKey",
 			Handler:    _Meshnet_GetPrivateKey_Handler,
 		},
+		{
+			MethodName: "GetPeerGroups",
+			Handler:    _Meshnet_GetPeerGroups_Handler,
+		},
+		{
+			MethodName: "AddPeerToGroup",
+			Handler:    _Meshnet_AddPeerToGroup_Handler,
+		},
+		{
+			MethodName: "RemovePeerFromGroup",
+			Handler:    _Meshnet_RemovePeerFromGroup_Handler,
+		},
+		{
+			MethodName: "SetPeerGroupPermissions",
+			Handler:    _Meshnet_SetPeerGroupPermissions_Handler,
+		},
+		{
+			MethodName: "SetPeerNote",
+			Handler:    _Meshnet_SetPeerNote_Handler,
+		},
+		{
+			MethodName: "SetPeerTags",
+			Handler:    _Meshnet_SetPeerTags_Handler,
+		},
+		{
+			MethodName: "MeasurePeers",
+			Handler:    _Meshnet_MeasurePeers_Handler,
+		},
+		{
+			MethodName: "SetAdvertisedSubnets",
+			Handler:    _Meshnet_SetAdvertisedSubnets_Handler,
+		},
+		{
+			MethodName: "SetPeerBandwidthLimit",
+			Handler:    _Meshnet_SetPeerBandwidthLimit_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribePeerPresence",
+			Handler:       _Meshnet_SubscribePeerPresence_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
 }
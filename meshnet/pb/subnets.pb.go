@@ -0,0 +1,382 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: subnets.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AdvertisedSubnetsErrorCode defines an error specific to the advertised
+// subnets
+type AdvertisedSubnetsErrorCode int32
+
+const (
+	// INVALID_ADVERTISED_SUBNET defines that the subnet is not a valid IPv4
+	// network or it overlaps the meshnet network
+	AdvertisedSubnetsErrorCode_INVALID_ADVERTISED_SUBNET AdvertisedSubnetsErrorCode = 0
+	// ADVERTISED_SUBNETS_OVERLAP defines that the subnets overlap each other
+	AdvertisedSubnetsErrorCode_ADVERTISED_SUBNETS_OVERLAP  AdvertisedSubnetsErrorCode = 1
+	AdvertisedSubnetsErrorCode_TOO_MANY_ADVERTISED_SUBNETS AdvertisedSubnetsErrorCode = 2
+	// ADVERTISED_SUBNETS_ALREADY_SET defines that the same subnets are
+	// advertised already
+	AdvertisedSubnetsErrorCode_ADVERTISED_SUBNETS_ALREADY_SET AdvertisedSubnetsErrorCode = 3
+)
+
+// Enum value maps for AdvertisedSubnetsErrorCode.
+var (
+	AdvertisedSubnetsErrorCode_name = map[int32]string{
+		0: "INVALID_ADVERTISED_SUBNET",
+		1: "ADVERTISED_SUBNETS_OVERLAP",
+		2: "TOO_MANY_ADVERTISED_SUBNETS",
+		3: "ADVERTISED_SUBNETS_ALREADY_SET",
+	}
+	AdvertisedSubnetsErrorCode_value = map[string]int32{
+		"INVALID_ADVERTISED_SUBNET":      0,
+		"ADVERTISED_SUBNETS_OVERLAP":     1,
+		"TOO_MANY_ADVERTISED_SUBNETS":    2,
+		"ADVERTISED_SUBNETS_ALREADY_SET": 3,
+	}
+)
+
+func (x AdvertisedSubnetsErrorCode) Enum() *AdvertisedSubnetsErrorCode {
+	p := new(AdvertisedSubnetsErrorCode)
+	*p = x
+	return p
+}
+
+func (x AdvertisedSubnetsErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AdvertisedSubnetsErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_subnets_proto_enumTypes[0].Descriptor()
+}
+
+func (AdvertisedSubnetsErrorCode) Type() protoreflect.EnumType {
+	return &file_subnets_proto_enumTypes[0]
+}
+
+func (x AdvertisedSubnetsErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AdvertisedSubnetsErrorCode.Descriptor instead.
+func (AdvertisedSubnetsErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_subnets_proto_rawDescGZIP(), []int{0}
+}
+
+// SetAdvertisedSubnetsRequest defines the subnets announced to the peers
+// as reachable through this device, empty subnets stop advertising
+type SetAdvertisedSubnetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subnets []string `protobuf:"bytes,1,rep,name=subnets,proto3" json:"subnets,omitempty"`
+}
+
+func (x *SetAdvertisedSubnetsRequest) Reset() {
+	*x = SetAdvertisedSubnetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_subnets_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAdvertisedSubnetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAdvertisedSubnetsRequest) ProtoMessage() {}
+
+func (x *SetAdvertisedSubnetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subnets_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAdvertisedSubnetsRequest.ProtoReflect.Descriptor instead.
+func (*SetAdvertisedSubnetsRequest) Descriptor() ([]byte, []int) {
+	return file_subnets_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetAdvertisedSubnetsRequest) GetSubnets() []string {
+	if x != nil {
+		return x.Subnets
+	}
+	return nil
+}
+
+// AdvertisedSubnetsResponse defines a response for SetAdvertisedSubnets
+// request
+type AdvertisedSubnetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//
+	//	*AdvertisedSubnetsResponse_Empty
+	//	*AdvertisedSubnetsResponse_AdvertisedSubnetsErrorCode
+	//	*AdvertisedSubnetsResponse_ServiceErrorCode
+	//	*AdvertisedSubnetsResponse_MeshnetErrorCode
+	Response isAdvertisedSubnetsResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *AdvertisedSubnetsResponse) Reset() {
+	*x = AdvertisedSubnetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_subnets_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdvertisedSubnetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdvertisedSubnetsResponse) ProtoMessage() {}
+
+func (x *AdvertisedSubnetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subnets_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdvertisedSubnetsResponse.ProtoReflect.Descriptor instead.
+func (*AdvertisedSubnetsResponse) Descriptor() ([]byte, []int) {
+	return file_subnets_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *AdvertisedSubnetsResponse) GetResponse() isAdvertisedSubnetsResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *AdvertisedSubnetsResponse) GetEmpty() *Empty {
+	if x, ok := x.GetResponse().(*AdvertisedSubnetsResponse_Empty); ok {
+		return x.Empty
+	}
+	return nil
+}
+
+func (x *AdvertisedSubnetsResponse) GetAdvertisedSubnetsErrorCode() AdvertisedSubnetsErrorCode {
+	if x, ok := x.GetResponse().(*AdvertisedSubnetsResponse_AdvertisedSubnetsErrorCode); ok {
+		return x.AdvertisedSubnetsErrorCode
+	}
+	return AdvertisedSubnetsErrorCode_INVALID_ADVERTISED_SUBNET
+}
+
+func (x *AdvertisedSubnetsResponse) GetServiceErrorCode() ServiceErrorCode {
+	if x, ok := x.GetResponse().(*AdvertisedSubnetsResponse_ServiceErrorCode); ok {
+		return x.ServiceErrorCode
+	}
+	return ServiceErrorCode_NOT_LOGGED_IN
+}
+
+func (x *AdvertisedSubnetsResponse) GetMeshnetErrorCode() MeshnetErrorCode {
+	if x, ok := x.GetResponse().(*AdvertisedSubnetsResponse_MeshnetErrorCode); ok {
+		return x.MeshnetErrorCode
+	}
+	return MeshnetErrorCode_NOT_REGISTERED
+}
+
+type isAdvertisedSubnetsResponse_Response interface {
+	isAdvertisedSubnetsResponse_Response()
+}
+
+type AdvertisedSubnetsResponse_Empty struct {
+	Empty *Empty `protobuf:"bytes,1,opt,name=empty,proto3,oneof"`
+}
+
+type AdvertisedSubnetsResponse_AdvertisedSubnetsErrorCode struct {
+	AdvertisedSubnetsErrorCode AdvertisedSubnetsErrorCode `protobuf:"varint,2,opt,name=advertised_subnets_error_code,json=advertisedSubnetsErrorCode,proto3,enum=meshpb.AdvertisedSubnetsErrorCode,oneof"`
+}
+
+type AdvertisedSubnetsResponse_ServiceErrorCode struct {
+	ServiceErrorCode ServiceErrorCode `protobuf:"varint,3,opt,name=service_error_code,json=serviceErrorCode,proto3,enum=meshpb.ServiceErrorCode,oneof"`
+}
+
+type AdvertisedSubnetsResponse_MeshnetErrorCode struct {
+	MeshnetErrorCode MeshnetErrorCode `protobuf:"varint,4,opt,name=meshnet_error_code,json=meshnetErrorCode,proto3,enum=meshpb.MeshnetErrorCode,oneof"`
+}
+
+func (*AdvertisedSubnetsResponse_Empty) isAdvertisedSubnetsResponse_Response() {}
+
+func (*AdvertisedSubnetsResponse_AdvertisedSubnetsErrorCode) isAdvertisedSubnetsResponse_Response() {}
+
+func (*AdvertisedSubnetsResponse_ServiceErrorCode) isAdvertisedSubnetsResponse_Response() {}
+
+func (*AdvertisedSubnetsResponse_MeshnetErrorCode) isAdvertisedSubnetsResponse_Response() {}
+
+var File_subnets_proto protoreflect.FileDescriptor
+
+var file_subnets_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x06, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x1a, 0x0b, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x37, 0x0a, 0x1b,
+	0x53, 0x65, 0x74, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x53, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x73, 0x22, 0xcb, 0x02, 0x0a, 0x19, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74,
+	0x69, 0x73, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x48, 0x00, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x67, 0x0a, 0x1d, 0x61, 0x64,
+	0x76, 0x65, 0x72, 0x74, 0x69, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x76, 0x65, 0x72,
+	0x74, 0x69, 0x73, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x1a, 0x61, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69,
+	0x73, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a,
+	0x12, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x73, 0x68,
+	0x70, 0x62, 0x2e, 0x4d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2a, 0xa0, 0x01, 0x0a, 0x1a, 0x41, 0x64, 0x76, 0x65, 0x72, 0x74, 0x69, 0x73,
+	0x65, 0x64, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x41, 0x44,
+	0x56, 0x45, 0x52, 0x54, 0x49, 0x53, 0x45, 0x44, 0x5f, 0x53, 0x55, 0x42, 0x4e, 0x45, 0x54, 0x10,
+	0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x44, 0x56, 0x45, 0x52, 0x54, 0x49, 0x53, 0x45, 0x44, 0x5f,
+	0x53, 0x55, 0x42, 0x4e, 0x45, 0x54, 0x53, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x4c, 0x41, 0x50, 0x10,
+	0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x41, 0x44,
+	0x56, 0x45, 0x52, 0x54, 0x49, 0x53, 0x45, 0x44, 0x5f, 0x53, 0x55, 0x42, 0x4e, 0x45, 0x54, 0x53,
+	0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x44, 0x56, 0x45, 0x52, 0x54, 0x49, 0x53, 0x45, 0x44,
+	0x5f, 0x53, 0x55, 0x42, 0x4e, 0x45, 0x54, 0x53, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x5f, 0x53, 0x45, 0x54, 0x10, 0x03, 0x42, 0x32, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4e, 0x6f, 0x72, 0x64, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x2f, 0x6e, 0x6f, 0x72, 0x64, 0x76, 0x70, 0x6e, 0x2d, 0x6c, 0x69, 0x6e, 0x75, 0x78, 0x2f,
+	0x6d, 0x65, 0x73, 0x68, 0x6e, 0x65, 0x74, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_subnets_proto_rawDescOnce sync.Once
+	file_subnets_proto_rawDescData = file_subnets_proto_rawDesc
+)
+
+func file_subnets_proto_rawDescGZIP() []byte {
+	file_subnets_proto_rawDescOnce.Do(func() {
+		file_subnets_proto_rawDescData = protoimpl.X.CompressGZIP(file_subnets_proto_rawDescData)
+	})
+	return file_subnets_proto_rawDescData
+}
+
+var file_subnets_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_subnets_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_subnets_proto_goTypes = []interface{}{
+	(AdvertisedSubnetsErrorCode)(0),     // 0: meshpb.AdvertisedSubnetsErrorCode
+	(*SetAdvertisedSubnetsRequest)(nil), // 1: meshpb.SetAdvertisedSubnetsRequest
+	(*AdvertisedSubnetsResponse)(nil),   // 2: meshpb.AdvertisedSubnetsResponse
+	(*Empty)(nil),                       // 3: meshpb.Empty
+	(ServiceErrorCode)(0),               // 4: meshpb.ServiceErrorCode
+	(MeshnetErrorCode)(0),               // 5: meshpb.MeshnetErrorCode
+}
+var file_subnets_proto_depIdxs = []int32{
+	3, // 0: meshpb.AdvertisedSubnetsResponse.empty:type_name -> meshpb.Empty
+	0, // 1: meshpb.AdvertisedSubnetsResponse.advertised_subnets_error_code:type_name -> meshpb.AdvertisedSubnetsErrorCode
+	4, // 2: meshpb.AdvertisedSubnetsResponse.service_error_code:type_name -> meshpb.ServiceErrorCode
+	5, // 3: meshpb.AdvertisedSubnetsResponse.meshnet_error_code:type_name -> meshpb.MeshnetErrorCode
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_subnets_proto_init() }
+func file_subnets_proto_init() {
+	if File_subnets_proto != nil {
+		return
+	}
+	file_empty_proto_init()
+	file_service_response_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_subnets_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAdvertisedSubnetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_subnets_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdvertisedSubnetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_subnets_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*AdvertisedSubnetsResponse_Empty)(nil),
+		(*AdvertisedSubnetsResponse_AdvertisedSubnetsErrorCode)(nil),
+		(*AdvertisedSubnetsResponse_ServiceErrorCode)(nil),
+		(*AdvertisedSubnetsResponse_MeshnetErrorCode)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_subnets_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_subnets_proto_goTypes,
+		DependencyIndexes: file_subnets_proto_depIdxs,
+		EnumInfos:         file_subnets_proto_enumTypes,
+		MessageInfos:      file_subnets_proto_msgTypes,
+	}.Build()
+	File_subnets_proto = out.File
+	file_subnets_proto_rawDesc = nil
+	file_subnets_proto_goTypes = nil
+	file_subnets_proto_depIdxs = nil
+}
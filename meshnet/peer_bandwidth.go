@@ -0,0 +1,99 @@
+package meshnet
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+)
+
+// SetPeerBandwidthLimit caps the traffic the peer can route through this device. The limit applies to both the
+// routed and the local network traffic, in each direction separately.
+func (s *Server) SetPeerBandwidthLimit(
+	ctx context.Context,
+	req *pb.SetPeerBandwidthLimitRequest,
+) (*pb.PeerBandwidthLimitResponse, error) {
+	if !s.ac.IsLoggedIn() {
+		return &pb.PeerBandwidthLimitResponse{
+			Response: &pb.PeerBandwidthLimitResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+			},
+		}, nil
+	}
+
+	if !s.mc.IsRegistrationInfoCorrect() {
+		return &pb.PeerBandwidthLimitResponse{
+			Response: &pb.PeerBandwidthLimitResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_REGISTERED,
+			},
+		}, nil
+	}
+
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return &pb.PeerBandwidthLimitResponse{
+			Response: &pb.PeerBandwidthLimitResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	peers, err := s.listPeers()
+	if err != nil {
+		s.pub.Publish(err)
+		return &pb.PeerBandwidthLimitResponse{
+			Response: &pb.PeerBandwidthLimitResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+			},
+		}, nil
+	}
+
+	peer := s.getPeerWithIdentifier(req.GetIdentifier(), peers)
+	if peer == nil {
+		return &pb.PeerBandwidthLimitResponse{
+			Response: &pb.PeerBandwidthLimitResponse_UpdatePeerErrorCode{
+				UpdatePeerErrorCode: pb.UpdatePeerErrorCode_PEER_NOT_FOUND,
+			},
+		}, nil
+	}
+
+	limits, err := cfg.Meshnet.PeerBandwidthLimits.SetLimit(peer.PublicKey, req.GetLimit())
+	if err != nil {
+		code := pb.PeerBandwidthLimitErrorCode_INVALID_BANDWIDTH_LIMIT
+		if errors.Is(err, config.ErrPeerBandwidthLimitSet) {
+			code = pb.PeerBandwidthLimitErrorCode_BANDWIDTH_LIMIT_ALREADY_SET
+		}
+		return &pb.PeerBandwidthLimitResponse{
+			Response: &pb.PeerBandwidthLimitResponse_PeerBandwidthLimitErrorCode{
+				PeerBandwidthLimitErrorCode: code,
+			},
+		}, nil
+	}
+
+	if err := s.cm.SaveWith(func(c config.Config) config.Config {
+		c.Meshnet.PeerBandwidthLimits = limits
+		return c
+	}); err != nil {
+		s.pub.Publish(err)
+		return &pb.PeerBandwidthLimitResponse{
+			Response: &pb.PeerBandwidthLimitResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	if err := s.netw.SetPeerBandwidthLimits(limits); err != nil {
+		s.pub.Publish(err)
+		return &pb.PeerBandwidthLimitResponse{
+			Response: &pb.PeerBandwidthLimitResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_LIB_FAILURE,
+			},
+		}, nil
+	}
+
+	return &pb.PeerBandwidthLimitResponse{
+		Response: &pb.PeerBandwidthLimitResponse_Empty{},
+	}, nil
+}
@@ -0,0 +1,67 @@
+package meshnet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer_SetPeerBandwidthLimit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Mesh = true
+	reg := &mock.RegistryMock{Peers: mesh.MachinePeers{
+		{ID: uuid.MustParse(exampleUUID1), PublicKey: examplePublicKey1, Hostname: "guest.nord"},
+		{ID: uuid.MustParse(exampleUUID2), PublicKey: examplePublicKey2, Hostname: "desktop.nord"},
+	}}
+	netw := &workingNetworker{}
+	server := newPeerGroupsServer(cm, reg, netw)
+	ctx := context.Background()
+
+	resp, err := server.SetPeerBandwidthLimit(ctx, &pb.SetPeerBandwidthLimitRequest{Identifier: "guest.nord", Limit: 5000})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.PeerBandwidthLimitResponse_Empty{}, resp.Response)
+	expected := config.PeerBandwidthLimits{examplePublicKey1: 5000}
+	assert.Equal(t, expected, cm.Cfg.Meshnet.PeerBandwidthLimits)
+	assert.Equal(t, expected, netw.bandwidthLimits)
+
+	resp, err = server.SetPeerBandwidthLimit(ctx, &pb.SetPeerBandwidthLimitRequest{Identifier: exampleUUID1, Limit: 5000})
+	assert.NoError(t, err)
+	assert.Equal(t,
+		pb.PeerBandwidthLimitErrorCode_BANDWIDTH_LIMIT_ALREADY_SET,
+		resp.GetPeerBandwidthLimitErrorCode(),
+	)
+
+	resp, err = server.SetPeerBandwidthLimit(ctx, &pb.SetPeerBandwidthLimitRequest{Identifier: "desktop.nord", Limit: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.PeerBandwidthLimitErrorCode_INVALID_BANDWIDTH_LIMIT, resp.GetPeerBandwidthLimitErrorCode())
+
+	resp, err = server.SetPeerBandwidthLimit(ctx, &pb.SetPeerBandwidthLimitRequest{Identifier: "phone.nord", Limit: 5000})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.PeerBandwidthLimitResponse_UpdatePeerErrorCode{}, resp.Response)
+
+	peers, err := server.GetPeers(ctx, &pb.Empty{})
+	assert.NoError(t, err)
+	for _, peer := range peers.GetPeers().GetExternal() {
+		if peer.GetPubkey() == examplePublicKey1 {
+			assert.Equal(t, uint32(5000), peer.GetBandwidthLimit())
+		} else {
+			assert.Zero(t, peer.GetBandwidthLimit())
+		}
+	}
+
+	resp, err = server.SetPeerBandwidthLimit(ctx, &pb.SetPeerBandwidthLimitRequest{Identifier: "guest.nord"})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.PeerBandwidthLimitResponse_Empty{}, resp.Response)
+	assert.Empty(t, cm.Cfg.Meshnet.PeerBandwidthLimits)
+	assert.Empty(t, netw.bandwidthLimits)
+}
@@ -0,0 +1,245 @@
+package meshnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+)
+
+// GetPeerGroups retrieves the peer groups sorted by their names
+func (s *Server) GetPeerGroups(context.Context, *pb.Empty) (*pb.GetPeerGroupsResponse, error) {
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return &pb.GetPeerGroupsResponse{
+			Response: &pb.GetPeerGroupsResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	groups := cfg.Meshnet.PeerGroups
+	list := &pb.PeerGroupList{}
+	for _, name := range groups.Names() {
+		list.Groups = append(list.Groups, &pb.PeerGroup{Name: name, Pubkeys: groups[name]})
+	}
+	return &pb.GetPeerGroupsResponse{
+		Response: &pb.GetPeerGroupsResponse_Groups{Groups: list},
+	}, nil
+}
+
+// AddPeerToGroup adds the peer to the group, the group is created if it does not exist yet
+func (s *Server) AddPeerToGroup(
+	ctx context.Context,
+	req *pb.PeerGroupRequest,
+) (*pb.PeerGroupResponse, error) {
+	return s.changePeerGroup(req, true, config.PeerGroups.Add)
+}
+
+// RemovePeerFromGroup removes the peer from the group. Peers which have already left the meshnet can be
+// removed by their public key.
+func (s *Server) RemovePeerFromGroup(
+	ctx context.Context,
+	req *pb.PeerGroupRequest,
+) (*pb.PeerGroupResponse, error) {
+	return s.changePeerGroup(req, false, config.PeerGroups.Remove)
+}
+
+func (s *Server) changePeerGroup(
+	req *pb.PeerGroupRequest,
+	mustExist bool,
+	change func(groups config.PeerGroups, group string, publicKey string) (config.PeerGroups, error),
+) (*pb.PeerGroupResponse, error) {
+	cfg, peers, resp := s.peerGroupPrerequisites()
+	if resp != nil {
+		return resp, nil
+	}
+
+	publicKey := req.GetIdentifier()
+	if peer := s.getPeerWithIdentifier(req.GetIdentifier(), peers); peer != nil {
+		publicKey = peer.PublicKey
+	} else if mustExist {
+		return &pb.PeerGroupResponse{
+			Response: &pb.PeerGroupResponse_UpdatePeerErrorCode{
+				UpdatePeerErrorCode: pb.UpdatePeerErrorCode_PEER_NOT_FOUND,
+			},
+		}, nil
+	}
+
+	groups, err := change(cfg.Meshnet.PeerGroups, req.GetGroup(), publicKey)
+	if err != nil {
+		return peerGroupErrorToResponse(err), nil
+	}
+
+	if err := s.cm.SaveWith(func(c config.Config) config.Config {
+		c.Meshnet.PeerGroups = groups
+		return c
+	}); err != nil {
+		s.pub.Publish(err)
+		return &pb.PeerGroupResponse{
+			Response: &pb.PeerGroupResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	return &pb.PeerGroupResponse{
+		Response: &pb.PeerGroupResponse_Empty{},
+	}, nil
+}
+
+// SetPeerGroupPermissions changes the permissions of all the peers in the group which are still in the meshnet.
+// Local network configuration is refreshed once for all of them.
+func (s *Server) SetPeerGroupPermissions(
+	ctx context.Context,
+	req *pb.SetPeerGroupPermissionsRequest,
+) (*pb.PeerGroupResponse, error) {
+	cfg, peers, resp := s.peerGroupPrerequisites()
+	if resp != nil {
+		return resp, nil
+	}
+
+	publicKeys, ok := cfg.Meshnet.PeerGroups[req.GetGroup()]
+	if !ok {
+		return &pb.PeerGroupResponse{
+			Response: &pb.PeerGroupResponse_PeerGroupErrorCode{
+				PeerGroupErrorCode: pb.PeerGroupErrorCode_GROUP_NOT_FOUND,
+			},
+		}, nil
+	}
+
+	token := cfg.TokensData[cfg.AutoConnectData.ID].Token
+	updated, failed := 0, 0
+	for _, peer := range peers {
+		if !slices.Contains(publicKeys, peer.PublicKey) || !changePeerPermissions(&peer, req) {
+			continue
+		}
+		if err := s.updatePeerPermissions(token, cfg.MeshDevice.ID, peer); err != nil {
+			s.pub.Publish(fmt.Errorf("updating permissions of peer %s: %w", peer.PublicKey, err))
+			failed++
+			continue
+		}
+		updated++
+	}
+
+	if updated > 0 && cfg.Mesh {
+		meshMap, err := s.reg.Map(token, cfg.MeshDevice.ID)
+		if err != nil {
+			s.pub.Publish(err)
+			return &pb.PeerGroupResponse{
+				Response: &pb.PeerGroupResponse_ServiceErrorCode{
+					ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+				},
+			}, nil
+		}
+		if err := s.netw.Refresh(*meshMap); err != nil {
+			s.pub.Publish(err)
+			return &pb.PeerGroupResponse{
+				Response: &pb.PeerGroupResponse_MeshnetErrorCode{
+					MeshnetErrorCode: pb.MeshnetErrorCode_LIB_FAILURE,
+				},
+			}, nil
+		}
+	}
+
+	if failed > 0 {
+		return &pb.PeerGroupResponse{
+			Response: &pb.PeerGroupResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+			},
+		}, nil
+	}
+	return &pb.PeerGroupResponse{
+		Response: &pb.PeerGroupResponse_Empty{},
+	}, nil
+}
+
+// peerGroupPrerequisites returns the config and the peers, or the response to return if they can't be retrieved
+func (s *Server) peerGroupPrerequisites() (config.Config, mesh.MachinePeers, *pb.PeerGroupResponse) {
+	var cfg config.Config
+	if !s.ac.IsLoggedIn() {
+		return cfg, nil, &pb.PeerGroupResponse{
+			Response: &pb.PeerGroupResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+			},
+		}
+	}
+
+	if !s.mc.IsRegistrationInfoCorrect() {
+		return cfg, nil, &pb.PeerGroupResponse{
+			Response: &pb.PeerGroupResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_REGISTERED,
+			},
+		}
+	}
+
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return cfg, nil, &pb.PeerGroupResponse{
+			Response: &pb.PeerGroupResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}
+	}
+
+	peers, err := s.listPeers()
+	if err != nil {
+		s.pub.Publish(err)
+		return cfg, nil, &pb.PeerGroupResponse{
+			Response: &pb.PeerGroupResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+			},
+		}
+	}
+	return cfg, peers, nil
+}
+
+// changePeerPermissions applies the requested permission changes to the peer and reports if any of them changed
+func changePeerPermissions(peer *mesh.MachinePeer, req *pb.SetPeerGroupPermissionsRequest) bool {
+	changed := changePermission(&peer.DoIAllowInbound, req.GetIncoming())
+	changed = changePermission(&peer.DoIAllowRouting, req.GetRouting()) || changed
+	changed = changePermission(&peer.DoIAllowLocalNetwork, req.GetLocalNetwork()) || changed
+	changed = changePermission(&peer.DoIAllowFileshare, req.GetFileshare()) || changed
+	return changed
+}
+
+func changePermission(permission *bool, change pb.PermissionChange) bool {
+	var allowed bool
+	switch change {
+	case pb.PermissionChange_PERMISSION_ALLOW:
+		allowed = true
+	case pb.PermissionChange_PERMISSION_DENY:
+		allowed = false
+	case pb.PermissionChange_PERMISSION_UNCHANGED:
+		return false
+	default:
+		return false
+	}
+	if *permission == allowed {
+		return false
+	}
+	*permission = allowed
+	return true
+}
+
+func peerGroupErrorToResponse(err error) *pb.PeerGroupResponse {
+	code := pb.PeerGroupErrorCode_GROUP_NOT_FOUND
+	switch {
+	case errors.Is(err, config.ErrPeerGroupName):
+		code = pb.PeerGroupErrorCode_INVALID_GROUP_NAME
+	case errors.Is(err, config.ErrPeerGroupsCount):
+		code = pb.PeerGroupErrorCode_TOO_MANY_GROUPS
+	case errors.Is(err, config.ErrPeerInGroup):
+		code = pb.PeerGroupErrorCode_PEER_ALREADY_IN_GROUP
+	case errors.Is(err, config.ErrPeerNotInGroup):
+		code = pb.PeerGroupErrorCode_PEER_NOT_IN_GROUP
+	}
+	return &pb.PeerGroupResponse{
+		Response: &pb.PeerGroupResponse_PeerGroupErrorCode{PeerGroupErrorCode: code},
+	}
+}
@@ -0,0 +1,194 @@
+package meshnet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	daemonevents "github.com/NordSecurity/nordvpn-linux/daemon/events"
+	"github.com/NordSecurity/nordvpn-linux/events/subs"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/sharedctx"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+	testnorduser "github.com/NordSecurity/nordvpn-linux/test/mock/norduser/service"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingRegistry struct {
+	mock.RegistryMock
+	configured map[uuid.UUID]mesh.PeerUpdateRequest
+}
+
+func (r *recordingRegistry) Configure(_ string, _ uuid.UUID, peerID uuid.UUID, peer mesh.PeerUpdateRequest) error {
+	if r.ConfigureErr != nil {
+		return r.ConfigureErr
+	}
+	r.configured[peerID] = peer
+	return nil
+}
+
+func newPeerGroupsServer(
+	cm *mock.ConfigManager,
+	reg mesh.Registry,
+	netw Networker,
+) *Server {
+	return NewServer(
+		meshRenewChecker{},
+		cm,
+		registrationChecker{},
+		invitationsAPI{},
+		netw,
+		reg,
+		&mock.DNSGetter{},
+		&subs.Subject[error]{},
+		&subs.Subject[[]string]{},
+		&daemonevents.Events{Settings: &daemonevents.SettingsEvents{Meshnet: &daemonevents.MockPublisherSubscriber[bool]{}}},
+		testnorduser.NewMockNorduserClient(nil),
+		sharedctx.New(),
+	)
+}
+
+func TestServer_PeerGroups(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	reg := &mock.RegistryMock{Peers: mesh.MachinePeers{
+		{ID: uuid.MustParse(exampleUUID1), PublicKey: examplePublicKey1, Hostname: "laptop.nord"},
+		{ID: uuid.MustParse(exampleUUID2), PublicKey: examplePublicKey2, Hostname: "desktop.nord"},
+	}}
+	server := newPeerGroupsServer(cm, reg, &workingNetworker{})
+	ctx := context.Background()
+
+	resp, err := server.AddPeerToGroup(ctx, &pb.PeerGroupRequest{Group: "work", Identifier: "laptop.nord"})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.PeerGroupResponse_Empty{}, resp.Response)
+
+	resp, err = server.AddPeerToGroup(ctx, &pb.PeerGroupRequest{Group: "work", Identifier: exampleUUID2})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.PeerGroupResponse_Empty{}, resp.Response)
+	assert.Equal(t, config.PeerGroups{"work": {examplePublicKey1, examplePublicKey2}}, cm.Cfg.Meshnet.PeerGroups)
+
+	resp, err = server.AddPeerToGroup(ctx, &pb.PeerGroupRequest{Group: "work", Identifier: "laptop.nord"})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.PeerGroupErrorCode_PEER_ALREADY_IN_GROUP, resp.GetPeerGroupErrorCode())
+
+	resp, err = server.AddPeerToGroup(ctx, &pb.PeerGroupRequest{Group: "Work", Identifier: "desktop.nord"})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.PeerGroupErrorCode_INVALID_GROUP_NAME, resp.GetPeerGroupErrorCode())
+
+	resp, err = server.AddPeerToGroup(ctx, &pb.PeerGroupRequest{Group: "home", Identifier: "phone.nord"})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.PeerGroupResponse_UpdatePeerErrorCode{}, resp.Response)
+
+	groups, err := server.GetPeerGroups(ctx, &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Len(t, groups.GetGroups().GetGroups(), 1)
+	assert.Equal(t, "work", groups.GetGroups().GetGroups()[0].GetName())
+
+	// peers which left the meshnet are removed by their public key
+	reg.Peers = reg.Peers[1:]
+	resp, err = server.RemovePeerFromGroup(ctx, &pb.PeerGroupRequest{Group: "work", Identifier: examplePublicKey1})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.PeerGroupResponse_Empty{}, resp.Response)
+
+	resp, err = server.RemovePeerFromGroup(ctx, &pb.PeerGroupRequest{Group: "work", Identifier: examplePublicKey1})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.PeerGroupErrorCode_PEER_NOT_IN_GROUP, resp.GetPeerGroupErrorCode())
+
+	resp, err = server.RemovePeerFromGroup(ctx, &pb.PeerGroupRequest{Group: "work", Identifier: "desktop.nord"})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.PeerGroupResponse_Empty{}, resp.Response)
+	assert.Empty(t, cm.Cfg.Meshnet.PeerGroups)
+}
+
+func TestServer_SetPeerGroupPermissions(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	laptop := uuid.MustParse(exampleUUID1)
+	desktop := uuid.MustParse(exampleUUID2)
+	phone := uuid.MustParse(exampleUUID3)
+	groups := config.PeerGroups{"work": {examplePublicKey1, examplePublicKey2}}
+
+	tests := []struct {
+		name               string
+		group              string
+		meshEnabled        bool
+		configureErr       error
+		expectedResponse   *pb.PeerGroupResponse
+		expectedConfigured []uuid.UUID
+		expectedRefreshes  int
+	}{
+		{
+			name:               "permissions are changed for the group",
+			group:              "work",
+			meshEnabled:        true,
+			expectedResponse:   &pb.PeerGroupResponse{Response: &pb.PeerGroupResponse_Empty{}},
+			expectedConfigured: []uuid.UUID{laptop},
+			expectedRefreshes:  1,
+		},
+		{
+			name:               "network is not refreshed with meshnet disabled",
+			group:              "work",
+			expectedResponse:   &pb.PeerGroupResponse{Response: &pb.PeerGroupResponse_Empty{}},
+			expectedConfigured: []uuid.UUID{laptop},
+		},
+		{
+			name:  "group does not exist",
+			group: "home",
+			expectedResponse: &pb.PeerGroupResponse{Response: &pb.PeerGroupResponse_PeerGroupErrorCode{
+				PeerGroupErrorCode: pb.PeerGroupErrorCode_GROUP_NOT_FOUND,
+			}},
+		},
+		{
+			name:         "api failure",
+			group:        "work",
+			meshEnabled:  true,
+			configureErr: mock.ErrOnPurpose,
+			expectedResponse: &pb.PeerGroupResponse{Response: &pb.PeerGroupResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cm := mock.NewMockConfigManager()
+			cm.Cfg.Mesh = test.meshEnabled
+			cm.Cfg.Meshnet.PeerGroups = groups
+			reg := &recordingRegistry{configured: map[uuid.UUID]mesh.PeerUpdateRequest{}}
+			reg.ConfigureErr = test.configureErr
+			reg.Peers = mesh.MachinePeers{
+				// already has the requested permissions
+				{ID: desktop, PublicKey: examplePublicKey2, DoIAllowInbound: true, DoIAllowFileshare: false},
+				{ID: laptop, PublicKey: examplePublicKey1, DoIAllowRouting: true},
+				// not in the group
+				{ID: phone, PublicKey: "phone", DoIAllowFileshare: true},
+			}
+			netw := &workingNetworker{}
+			server := newPeerGroupsServer(cm, reg, netw)
+
+			resp, err := server.SetPeerGroupPermissions(context.Background(), &pb.SetPeerGroupPermissionsRequest{
+				Group:     test.group,
+				Incoming:  pb.PermissionChange_PERMISSION_ALLOW,
+				Fileshare: pb.PermissionChange_PERMISSION_DENY,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedResponse.Response, resp.Response)
+			assert.Equal(t, test.expectedRefreshes, netw.refreshes)
+
+			var configured []uuid.UUID
+			for id, req := range reg.configured {
+				configured = append(configured, id)
+				assert.True(t, req.DoIAllowInbound)
+				assert.False(t, req.DoIAllowFileshare)
+				// unchanged permissions are kept
+				assert.True(t, req.DoIAllowRouting)
+			}
+			assert.Equal(t, test.expectedConfigured, configured)
+		})
+	}
+}
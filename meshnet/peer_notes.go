@@ -0,0 +1,121 @@
+package meshnet
+
+import (
+	"context"
+	"errors"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+)
+
+// SetPeerNote replaces the note of the peer
+func (s *Server) SetPeerNote(
+	ctx context.Context,
+	req *pb.SetPeerNoteRequest,
+) (*pb.PeerNoteResponse, error) {
+	return s.changePeerNote(req.GetIdentifier(), func(notes config.PeerNotes, publicKey string) (config.PeerNotes, error) {
+		return notes.SetNote(publicKey, req.GetNote())
+	})
+}
+
+// SetPeerTags replaces the tags of the peer
+func (s *Server) SetPeerTags(
+	ctx context.Context,
+	req *pb.SetPeerTagsRequest,
+) (*pb.PeerNoteResponse, error) {
+	return s.changePeerNote(req.GetIdentifier(), func(notes config.PeerNotes, publicKey string) (config.PeerNotes, error) {
+		return notes.SetTags(publicKey, req.GetTags())
+	})
+}
+
+func (s *Server) changePeerNote(
+	identifier string,
+	change func(notes config.PeerNotes, publicKey string) (config.PeerNotes, error),
+) (*pb.PeerNoteResponse, error) {
+	if !s.ac.IsLoggedIn() {
+		return &pb.PeerNoteResponse{
+			Response: &pb.PeerNoteResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+			},
+		}, nil
+	}
+
+	if !s.mc.IsRegistrationInfoCorrect() {
+		return &pb.PeerNoteResponse{
+			Response: &pb.PeerNoteResponse_MeshnetErrorCode{
+				MeshnetErrorCode: pb.MeshnetErrorCode_NOT_REGISTERED,
+			},
+		}, nil
+	}
+
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		s.pub.Publish(err)
+		return &pb.PeerNoteResponse{
+			Response: &pb.PeerNoteResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	peers, err := s.listPeers()
+	if err != nil {
+		s.pub.Publish(err)
+		return &pb.PeerNoteResponse{
+			Response: &pb.PeerNoteResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+			},
+		}, nil
+	}
+
+	peer := s.getPeerWithIdentifier(identifier, peers)
+	if peer == nil {
+		return &pb.PeerNoteResponse{
+			Response: &pb.PeerNoteResponse_UpdatePeerErrorCode{
+				UpdatePeerErrorCode: pb.UpdatePeerErrorCode_PEER_NOT_FOUND,
+			},
+		}, nil
+	}
+
+	notes, err := change(cfg.Meshnet.PeerNotes, peer.PublicKey)
+	if err != nil {
+		return peerNoteErrorToResponse(err), nil
+	}
+
+	if err := s.cm.SaveWith(func(c config.Config) config.Config {
+		c.Meshnet.PeerNotes = notes
+		return c
+	}); err != nil {
+		s.pub.Publish(err)
+		return &pb.PeerNoteResponse{
+			Response: &pb.PeerNoteResponse_ServiceErrorCode{
+				ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
+			},
+		}, nil
+	}
+
+	return &pb.PeerNoteResponse{
+		Response: &pb.PeerNoteResponse_Empty{},
+	}, nil
+}
+
+func peerNoteErrorToResponse(err error) *pb.PeerNoteResponse {
+	code := pb.PeerNoteErrorCode_PEER_NOTE_TOO_LONG
+	switch {
+	case errors.Is(err, config.ErrPeerTag):
+		code = pb.PeerNoteErrorCode_INVALID_PEER_TAG
+	case errors.Is(err, config.ErrPeerTagsCount):
+		code = pb.PeerNoteErrorCode_TOO_MANY_PEER_TAGS
+	}
+	return &pb.PeerNoteResponse{
+		Response: &pb.PeerNoteResponse_PeerNoteErrorCode{PeerNoteErrorCode: code},
+	}
+}
+
+// withPeerNote fills the note and tags of the peer
+func withPeerNote(peer *pb.Peer, notes config.PeerNotes) *pb.Peer {
+	note := notes[peer.GetPubkey()]
+	peer.Note = note.Note
+	peer.Tags = note.Tags
+	return peer
+}
@@ -0,0 +1,69 @@
+package meshnet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer_PeerNotes(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Mesh = true
+	reg := &mock.RegistryMock{Peers: mesh.MachinePeers{
+		{ID: uuid.MustParse(exampleUUID1), PublicKey: examplePublicKey1, Hostname: "nas.nord"},
+		{ID: uuid.MustParse(exampleUUID2), PublicKey: examplePublicKey2, Hostname: "desktop.nord"},
+	}}
+	server := newPeerGroupsServer(cm, reg, &workingNetworker{})
+	ctx := context.Background()
+
+	resp, err := server.SetPeerNote(ctx, &pb.SetPeerNoteRequest{Identifier: "nas.nord", Note: "this is the office NAS"})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.PeerNoteResponse_Empty{}, resp.Response)
+
+	resp, err = server.SetPeerTags(ctx, &pb.SetPeerTagsRequest{Identifier: exampleUUID1, Tags: []string{"office", "storage"}})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.PeerNoteResponse_Empty{}, resp.Response)
+	assert.Equal(t, config.PeerNotes{
+		examplePublicKey1: {Note: "this is the office NAS", Tags: []string{"office", "storage"}},
+	}, cm.Cfg.Meshnet.PeerNotes)
+
+	resp, err = server.SetPeerTags(ctx, &pb.SetPeerTagsRequest{Identifier: "desktop.nord", Tags: []string{"Office"}})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.PeerNoteResponse_PeerNoteErrorCode{}, resp.Response)
+	assert.Equal(t, pb.PeerNoteErrorCode_INVALID_PEER_TAG, resp.GetPeerNoteErrorCode())
+
+	resp, err = server.SetPeerNote(ctx, &pb.SetPeerNoteRequest{Identifier: "phone.nord", Note: "phone"})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.PeerNoteResponse_UpdatePeerErrorCode{}, resp.Response)
+
+	peers, err := server.GetPeers(ctx, &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Len(t, peers.GetPeers().GetExternal(), 2)
+	for _, peer := range peers.GetPeers().GetExternal() {
+		if peer.GetPubkey() == examplePublicKey1 {
+			assert.Equal(t, "this is the office NAS", peer.GetNote())
+			assert.Equal(t, []string{"office", "storage"}, peer.GetTags())
+		} else {
+			assert.Empty(t, peer.GetNote())
+			assert.Empty(t, peer.GetTags())
+		}
+	}
+
+	resp, err = server.SetPeerNote(ctx, &pb.SetPeerNoteRequest{Identifier: "nas.nord"})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.PeerNoteResponse_Empty{}, resp.Response)
+	resp, err = server.SetPeerTags(ctx, &pb.SetPeerTagsRequest{Identifier: "nas.nord"})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.PeerNoteResponse_Empty{}, resp.Response)
+	assert.Empty(t, cm.Cfg.Meshnet.PeerNotes)
+}
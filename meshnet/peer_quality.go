@@ -0,0 +1,80 @@
+package meshnet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+
+	"github.com/go-ping/ping"
+)
+
+// peerPingCount is the number of echo requests sent to each of the measured peers
+const peerPingCount = 3
+
+// peerLatencyProber measures the average round trip time to the peer address
+type peerLatencyProber func(addr string) (time.Duration, error)
+
+func pingPeerLatency(addr string) (time.Duration, error) {
+	pinger, err := ping.NewPinger(addr)
+	if err != nil {
+		return 0, fmt.Errorf("unable resolve %s to ping: %w", addr, err)
+	}
+	pinger.Timeout = peerPingCount * time.Second
+	pinger.SetPrivileged(true)
+	pinger.Count = peerPingCount
+	if err := pinger.Run(); err != nil {
+		return 0, fmt.Errorf("unable to ping: %w", err)
+	}
+	stats := pinger.Statistics()
+	if stats.PacketsRecv == 0 {
+		return 0, errors.New("no ping response received")
+	}
+	return stats.AvgRtt, nil
+}
+
+func peerPath(path string) pb.PeerPath {
+	switch path {
+	case "direct":
+		return pb.PeerPath_PATH_DIRECT
+	case "relay":
+		return pb.PeerPath_PATH_RELAY
+	default:
+		return pb.PeerPath_PATH_UNKNOWN
+	}
+}
+
+// MeasurePeers retrieves the peers the same way as GetPeers and measures the round trip times to the connected ones
+func (s *Server) MeasurePeers(ctx context.Context, _ *pb.Empty) (*pb.GetPeersResponse, error) {
+	resp, err := s.GetPeers(ctx, &pb.Empty{})
+	if err != nil {
+		return resp, err
+	}
+	peers := resp.GetPeers()
+	if peers == nil {
+		return resp, nil
+	}
+
+	var wg sync.WaitGroup
+	for _, peer := range append(peers.GetLocal(), peers.GetExternal()...) {
+		if peer.GetStatus() != pb.PeerStatus_CONNECTED {
+			continue
+		}
+		wg.Add(1)
+		go func(peer *pb.Peer) {
+			defer wg.Done()
+			rtt, err := s.pingPeer(peer.GetIp())
+			if err != nil {
+				peer.Rtt = -1
+				return
+			}
+			peer.Rtt = int64(rtt)
+		}(peer)
+	}
+	wg.Wait()
+
+	return resp, nil
+}
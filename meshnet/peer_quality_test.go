@@ -0,0 +1,112 @@
+package meshnet
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/NordSecurity/nordvpn-linux/test/mock"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type pathNetworker struct {
+	workingNetworker
+	states map[string]string
+	paths  map[string]string
+}
+
+func (n *pathNetworker) StatusMap() (map[string]string, error) { return n.states, nil }
+func (n *pathNetworker) PathMap() (map[string]string, error)   { return n.paths, nil }
+
+func TestServer_MeasurePeers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	const examplePublicKey3 = "kBf1xRdVJ+Y0HTg46oXOPCnBc6m9tCjEQCyYbpbqvEo="
+	cm := mock.NewMockConfigManager()
+	cm.Cfg.Mesh = true
+	reg := &mock.RegistryMock{Peers: mesh.MachinePeers{
+		{
+			ID:        uuid.MustParse(exampleUUID1),
+			PublicKey: examplePublicKey1,
+			Hostname:  "direct.nord",
+			Address:   netip.MustParseAddr("100.64.0.1"),
+		},
+		{
+			ID:        uuid.MustParse(exampleUUID2),
+			PublicKey: examplePublicKey2,
+			Hostname:  "relay.nord",
+			Address:   netip.MustParseAddr("100.64.0.2"),
+		},
+		{
+			ID:        uuid.MustParse(exampleUUID3),
+			PublicKey: examplePublicKey3,
+			Hostname:  "offline.nord",
+			Address:   netip.MustParseAddr("100.64.0.3"),
+		},
+	}}
+	netw := &pathNetworker{
+		states: map[string]string{
+			examplePublicKey1: "connected",
+			examplePublicKey2: "connected",
+			examplePublicKey3: "disconnected",
+		},
+		paths: map[string]string{
+			examplePublicKey1: "direct",
+			examplePublicKey2: "relay",
+		},
+	}
+	server := newPeerGroupsServer(cm, reg, netw)
+	server.pingPeer = func(addr string) (time.Duration, error) {
+		if addr == "100.64.0.2" {
+			return 0, errors.New("no ping response received")
+		}
+		return 12 * time.Millisecond, nil
+	}
+
+	expected := map[string]struct {
+		path pb.PeerPath
+		rtt  int64
+	}{
+		"direct.nord":  {path: pb.PeerPath_PATH_DIRECT, rtt: int64(12 * time.Millisecond)},
+		"relay.nord":   {path: pb.PeerPath_PATH_RELAY, rtt: -1},
+		"offline.nord": {path: pb.PeerPath_PATH_UNKNOWN, rtt: 0},
+	}
+
+	resp, err := server.MeasurePeers(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetPeers().GetExternal(), len(expected))
+	for _, peer := range resp.GetPeers().GetExternal() {
+		assert.Equal(t, expected[peer.GetHostname()].path, peer.GetPath(), peer.GetHostname())
+		assert.Equal(t, expected[peer.GetHostname()].rtt, peer.GetRtt(), peer.GetHostname())
+	}
+
+	// paths are reported without the measurements
+	resp, err = server.GetPeers(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	for _, peer := range resp.GetPeers().GetExternal() {
+		assert.Equal(t, expected[peer.GetHostname()].path, peer.GetPath(), peer.GetHostname())
+		assert.Zero(t, peer.GetRtt(), peer.GetHostname())
+	}
+}
+
+func TestServer_MeasurePeers_NotEnabled(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cm := mock.NewMockConfigManager()
+	server := newPeerGroupsServer(cm, &mock.RegistryMock{}, &workingNetworker{})
+	server.pingPeer = func(string) (time.Duration, error) {
+		t.Fatal("peers should not be measured")
+		return 0, nil
+	}
+
+	resp, err := server.MeasurePeers(context.Background(), &pb.Empty{})
+	assert.NoError(t, err)
+	assert.IsType(t, &pb.GetPeersResponse_MeshnetErrorCode{}, resp.Response)
+}
@@ -0,0 +1,277 @@
+package meshnet
+
+import (
+	"log"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+)
+
+const (
+	// presenceStreamBuffer is how many events are kept for the subscriber which is not keeping up, newer events
+	// are dropped when the buffer is full
+	presenceStreamBuffer = 64
+	// presencePeerListInterval limits how often the peers are listed from the API for the presence subscribers.
+	// Connection states are read from the tunnel on every check.
+	presencePeerListInterval = time.Minute
+)
+
+type trackedPeer struct {
+	peer   mesh.MachinePeer
+	online bool
+}
+
+// peerPresence tracks the connection states and the addresses of the peers for the SubscribePeerPresence
+// subscribers. Peers are tracked only while anyone is subscribed.
+type peerPresence struct {
+	mu          sync.Mutex
+	subscribers map[chan *pb.PeerPresenceEvent]struct{}
+	peers       map[string]trackedPeer
+	listed      mesh.MachinePeers
+	listedAt    time.Time
+	notes       config.PeerNotes
+	// checkMu serializes the checks started by the job and by the new subscribers
+	checkMu   sync.Mutex
+	done      chan struct{}
+	closeOnce sync.Once
+	now       func() time.Time
+}
+
+func newPeerPresence(now func() time.Time) *peerPresence {
+	return &peerPresence{
+		subscribers: map[chan *pb.PeerPresenceEvent]struct{}{},
+		peers:       map[string]trackedPeer{},
+		done:        make(chan struct{}),
+		now:         now,
+	}
+}
+
+// subscribe registers the subscriber, the peers currently online are queued for it first. The returned function
+// has to be called when the subscriber stops listening.
+func (p *peerPresence) subscribe() (<-chan *pb.PeerPresenceEvent, func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	events := make(chan *pb.PeerPresenceEvent, presenceStreamBuffer)
+	at := p.now().UnixMilli()
+	for _, tracked := range p.sortedPeers() {
+		if !tracked.online {
+			continue
+		}
+		select {
+		case events <- p.withNote(presenceEvent(pb.PeerPresenceEventType_PEER_ONLINE, tracked, at)):
+		default:
+		}
+	}
+	p.subscribers[events] = struct{}{}
+	return events, func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		delete(p.subscribers, events)
+		if len(p.subscribers) == 0 {
+			// tracked state gets stale without the checks, it is rebuilt for the next subscriber
+			p.peers = map[string]trackedPeer{}
+			p.listed = nil
+			p.listedAt = time.Time{}
+		}
+	}
+}
+
+func (p *peerPresence) hasSubscribers() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.subscribers) > 0
+}
+
+// close ends the streams of all the subscribers
+func (p *peerPresence) close() {
+	p.closeOnce.Do(func() { close(p.done) })
+}
+
+// peersToList returns the peers listed recently, or false if they have to be listed again. Peers are listed again
+// when the tunnel reports a peer which is not known yet.
+func (p *peerPresence) peersToList(states map[string]string) (mesh.MachinePeers, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.listedAt.IsZero() || p.now().Sub(p.listedAt) >= presencePeerListInterval {
+		return nil, false
+	}
+	for publicKey := range states {
+		if !slices.ContainsFunc(p.listed, func(peer mesh.MachinePeer) bool { return peer.PublicKey == publicKey }) {
+			return nil, false
+		}
+	}
+	return p.listed, true
+}
+
+// update compares the peers with the tracked ones and publishes the changes. Listed peers are reused by the
+// following checks.
+func (p *peerPresence) update(peers mesh.MachinePeers, states map[string]string, notes config.PeerNotes, listed bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	at := p.now().UnixMilli()
+	if listed {
+		p.listed, p.listedAt = peers, p.now()
+	}
+
+	current := make(map[string]trackedPeer, len(peers))
+	var events []*pb.PeerPresenceEvent
+	for _, peer := range peers {
+		tracked := trackedPeer{peer: peer, online: states[peer.PublicKey] == "connected"}
+		current[peer.PublicKey] = tracked
+		old, known := p.peers[peer.PublicKey]
+		switch {
+		case tracked.online && (!known || !old.online):
+			events = append(events, presenceEvent(pb.PeerPresenceEventType_PEER_ONLINE, tracked, at))
+		case !tracked.online && known && old.online:
+			events = append(events, presenceEvent(pb.PeerPresenceEventType_PEER_OFFLINE, tracked, at))
+		}
+		if known && addressChanged(old.peer, peer) {
+			events = append(events, presenceEvent(pb.PeerPresenceEventType_PEER_ADDRESS_CHANGED, tracked, at))
+		}
+	}
+	for publicKey, old := range p.peers {
+		if _, ok := current[publicKey]; !ok && old.online {
+			old.online = false
+			events = append(events, presenceEvent(pb.PeerPresenceEventType_PEER_OFFLINE, old, at))
+		}
+	}
+	p.peers = current
+	p.notes = notes
+	p.publish(events)
+}
+
+// stop publishes the tracked peers as offline when meshnet is not running, peers are listed again once it runs
+func (p *peerPresence) stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	at := p.now().UnixMilli()
+	var events []*pb.PeerPresenceEvent
+	for _, tracked := range p.sortedPeers() {
+		if tracked.online {
+			tracked.online = false
+			events = append(events, presenceEvent(pb.PeerPresenceEventType_PEER_OFFLINE, tracked, at))
+		}
+	}
+	p.peers = map[string]trackedPeer{}
+	p.listed, p.listedAt = nil, time.Time{}
+	p.publish(events)
+}
+
+// publish sends the events to the subscribers, p.mu has to be held
+func (p *peerPresence) publish(events []*pb.PeerPresenceEvent) {
+	for _, event := range events {
+		event = p.withNote(event)
+		for sub := range p.subscribers {
+			select {
+			case sub <- event:
+			default:
+				log.Println(internal.WarningPrefix, "peer presence subscriber is not keeping up, dropping", event.Type, "event")
+			}
+		}
+	}
+}
+
+func (p *peerPresence) withNote(event *pb.PeerPresenceEvent) *pb.PeerPresenceEvent {
+	event.Peer = withPeerNote(event.Peer, p.notes)
+	return event
+}
+
+// sortedPeers returns the tracked peers in a stable order
+func (p *peerPresence) sortedPeers() []trackedPeer {
+	peers := make([]trackedPeer, 0, len(p.peers))
+	for _, tracked := range p.peers {
+		peers = append(peers, tracked)
+	}
+	slices.SortFunc(peers, func(a, b trackedPeer) int {
+		return strings.Compare(a.peer.PublicKey, b.peer.PublicKey)
+	})
+	return peers
+}
+
+func addressChanged(old mesh.MachinePeer, peer mesh.MachinePeer) bool {
+	return old.Address != peer.Address ||
+		!slices.EqualFunc(old.Endpoints, peer.Endpoints, func(a, b netip.AddrPort) bool { return a == b })
+}
+
+func presenceEvent(kind pb.PeerPresenceEventType, tracked trackedPeer, at int64) *pb.PeerPresenceEvent {
+	peer := tracked.peer.ToProtobuf()
+	peer.Status = pb.PeerStatus_DISCONNECTED
+	if tracked.online {
+		peer.Status = pb.PeerStatus_CONNECTED
+	}
+	return &pb.PeerPresenceEvent{Type: kind, Peer: peer, Timestamp: at}
+}
+
+// checkPeerPresence reads the connection states of the peers and publishes the changes to the presence subscribers
+func (s *Server) checkPeerPresence() error {
+	if !s.presence.hasSubscribers() {
+		return nil
+	}
+	s.presence.checkMu.Lock()
+	defer s.presence.checkMu.Unlock()
+
+	var cfg config.Config
+	if err := s.cm.Load(&cfg); err != nil {
+		return err
+	}
+	if !cfg.Mesh || !s.ac.IsLoggedIn() {
+		s.presence.stop()
+		return nil
+	}
+
+	states, err := s.netw.StatusMap()
+	if err != nil {
+		return err
+	}
+	peers, ok := s.presence.peersToList(states)
+	if !ok {
+		if peers, err = s.listPeers(); err != nil {
+			return err
+		}
+	}
+	s.presence.update(peers, states, cfg.Meshnet.PeerNotes, !ok)
+	return nil
+}
+
+// SubscribePeerPresence streams the peers going online or offline and changing their addresses until the
+// subscriber stops listening
+func (s *Server) SubscribePeerPresence(_ *pb.Empty, srv pb.Meshnet_SubscribePeerPresenceServer) error {
+	events, unsubscribe := s.presence.subscribe()
+	defer unsubscribe()
+
+	go func() {
+		if err := s.checkPeerPresence(); err != nil {
+			log.Println(internal.WarningPrefix, "checking peer presence:", err)
+		}
+	}()
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return nil
+		case <-s.presence.done:
+			return nil
+		case event := <-events:
+			if err := srv.Send(event); err != nil {
+				log.Println(internal.ErrorPrefix, "failed to send peer presence event:", err)
+				return err
+			}
+		}
+	}
+}
+
+// ClosePeerPresence ends the SubscribePeerPresence streams, so that they don't hold the daemon shutdown
+func (s *Server) ClosePeerPresence() {
+	s.presence.close()
+}
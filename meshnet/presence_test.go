@@ -0,0 +1,137 @@
+package meshnet
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/core/mesh"
+	"github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func receivePresence(t *testing.T, events <-chan *pb.PeerPresenceEvent) []*pb.PeerPresenceEvent {
+	t.Helper()
+	var received []*pb.PeerPresenceEvent
+	for {
+		select {
+		case event := <-events:
+			received = append(received, event)
+		default:
+			return received
+		}
+	}
+}
+
+func presenceTypes(events []*pb.PeerPresenceEvent) map[string]pb.PeerPresenceEventType {
+	types := map[string]pb.PeerPresenceEventType{}
+	for _, event := range events {
+		types[event.GetPeer().GetPubkey()] = event.GetType()
+	}
+	return types
+}
+
+func TestPeerPresence_Update(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Unix(1700000000, 0)
+	presence := newPeerPresence(func() time.Time { return now })
+	events, unsubscribe := presence.subscribe()
+	defer unsubscribe()
+
+	laptop := mesh.MachinePeer{PublicKey: examplePublicKey1, Hostname: "laptop.nord",
+		Address: netip.MustParseAddr("100.64.0.1")}
+	nas := mesh.MachinePeer{PublicKey: examplePublicKey2, Hostname: "nas.nord",
+		Address: netip.MustParseAddr("100.64.0.2")}
+	notes := config.PeerNotes{examplePublicKey2: {Note: "office NAS"}}
+
+	// peers which are offline from the start are not reported
+	presence.update(mesh.MachinePeers{laptop, nas}, map[string]string{examplePublicKey2: "connected"}, notes, true)
+	received := receivePresence(t, events)
+	assert.Len(t, received, 1)
+	assert.Equal(t, pb.PeerPresenceEventType_PEER_ONLINE, received[0].GetType())
+	assert.Equal(t, "nas.nord", received[0].GetPeer().GetHostname())
+	assert.Equal(t, "office NAS", received[0].GetPeer().GetNote())
+	assert.Equal(t, pb.PeerStatus_CONNECTED, received[0].GetPeer().GetStatus())
+	assert.Equal(t, now.UnixMilli(), received[0].GetTimestamp())
+
+	// nothing changed
+	presence.update(mesh.MachinePeers{laptop, nas}, map[string]string{examplePublicKey2: "connected"}, notes, false)
+	assert.Empty(t, receivePresence(t, events))
+
+	laptop.Endpoints = []netip.AddrPort{netip.MustParseAddrPort("1.2.3.4:51820")}
+	presence.update(mesh.MachinePeers{laptop, nas},
+		map[string]string{examplePublicKey1: "connected", examplePublicKey2: "disconnected"}, notes, true)
+	received = receivePresence(t, events)
+	assert.Len(t, received, 3)
+	assert.Equal(t, pb.PeerPresenceEventType_PEER_ONLINE, received[0].GetType())
+	assert.Equal(t, pb.PeerPresenceEventType_PEER_ADDRESS_CHANGED, received[1].GetType())
+	assert.Equal(t, examplePublicKey1, received[1].GetPeer().GetPubkey())
+	assert.Equal(t, pb.PeerPresenceEventType_PEER_OFFLINE, received[2].GetType())
+	assert.Equal(t, examplePublicKey2, received[2].GetPeer().GetPubkey())
+
+	// new subscribers receive the peers which are online
+	late, unsubscribeLate := presence.subscribe()
+	defer unsubscribeLate()
+	assert.Equal(t,
+		map[string]pb.PeerPresenceEventType{examplePublicKey1: pb.PeerPresenceEventType_PEER_ONLINE},
+		presenceTypes(receivePresence(t, late)))
+
+	// peer which left the meshnet goes offline
+	presence.update(mesh.MachinePeers{nas}, map[string]string{}, notes, true)
+	assert.Equal(t,
+		map[string]pb.PeerPresenceEventType{examplePublicKey1: pb.PeerPresenceEventType_PEER_OFFLINE},
+		presenceTypes(receivePresence(t, events)))
+	assert.Len(t, receivePresence(t, late), 1)
+}
+
+func TestPeerPresence_Stop(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	presence := newPeerPresence(time.Now)
+	events, unsubscribe := presence.subscribe()
+	defer unsubscribe()
+
+	peers := mesh.MachinePeers{
+		{PublicKey: examplePublicKey1, Hostname: "laptop.nord"},
+		{PublicKey: examplePublicKey2, Hostname: "nas.nord"},
+	}
+	presence.update(peers, map[string]string{examplePublicKey1: "connected"}, nil, true)
+	receivePresence(t, events)
+
+	listed, ok := presence.peersToList(map[string]string{examplePublicKey1: "connected"})
+	assert.True(t, ok)
+	assert.Equal(t, peers, listed)
+
+	presence.stop()
+	assert.Equal(t,
+		map[string]pb.PeerPresenceEventType{examplePublicKey1: pb.PeerPresenceEventType_PEER_OFFLINE},
+		presenceTypes(receivePresence(t, events)))
+
+	_, ok = presence.peersToList(nil)
+	assert.False(t, ok)
+}
+
+func TestPeerPresence_PeersToList(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Unix(1700000000, 0)
+	presence := newPeerPresence(func() time.Time { return now })
+	_, ok := presence.peersToList(nil)
+	assert.False(t, ok, "peers were never listed")
+
+	peers := mesh.MachinePeers{{PublicKey: examplePublicKey1}}
+	presence.update(peers, nil, nil, true)
+	_, ok = presence.peersToList(map[string]string{examplePublicKey1: "connected"})
+	assert.True(t, ok)
+
+	_, ok = presence.peersToList(map[string]string{examplePublicKey2: "connected"})
+	assert.False(t, ok, "tunnel reports an unknown peer")
+
+	now = now.Add(presencePeerListInterval)
+	_, ok = presence.peersToList(nil)
+	assert.False(t, ok, "listed peers are outdated")
+}
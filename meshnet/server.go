@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"golang.org/x/sys/unix"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"golang.org/x/exp/slices"
 
@@ -31,6 +32,8 @@ import (
 var (
 	// ErrTunnelClosed while enabling meshnet.
 	ErrTunnelClosed = errors.New("tunnel was closed")
+	// ErrExitNodeInUse while routing subnets through a peer when the VPN connection uses the meshnet tunnel.
+	ErrExitNodeInUse = errors.New("exit node is used by the VPN connection")
 	// MsgMeshnetInviteSendSameAccountEmail is a string used to identify same account error
 	// returned when invite destination address is the same as sender email address
 	MsgMeshnetInviteSendSameAccountEmail = "Bad Request: Email should belong to a different user"
@@ -54,6 +57,8 @@ type Server struct {
 	norduser          service.NorduserFileshareClient
 	scheduler         gocron.Scheduler
 	connectContext    *sharedctx.Context
+	presence          *peerPresence
+	pingPeer          peerLatencyProber
 	pb.UnimplementedMeshnetServer
 }
 
@@ -87,6 +92,8 @@ func NewServer(
 		norduser:          norduser,
 		scheduler:         scheduler,
 		connectContext:    connectContext,
+		presence:          newPeerPresence(time.Now),
+		pingPeer:          pingPeerLatency,
 	}
 }
 
@@ -491,73 +498,80 @@ func (s *Server) Invite(
 		tokenData.Token,
 		cfg.MeshDevice.ID,
 		req.GetEmail(),
+		cfg.Meshnet.InviteTTL,
 		req.GetAllowIncomingTraffic(),
 		req.GetAllowTrafficRouting(),
 		req.GetAllowLocalNetwork(),
 		req.GetAllowFileshare(),
 	)
 	if err != nil {
-		s.pub.Publish(fmt.Errorf("sending invitation: %w", err))
-		if errors.Is(err, core.ErrTooManyRequests) {
-			return &pb.InviteResponse{
-				Response: &pb.InviteResponse_InviteResponseErrorCode{
-					InviteResponseErrorCode: pb.InviteResponseErrorCode_LIMIT_REACHED,
-				},
-			}, nil
+		return s.inviteErrorResponse(err, cfg.AutoConnectData.ID), nil
+	}
+
+	return &pb.InviteResponse{
+		Response: &pb.InviteResponse_Empty{},
+	}, nil
+}
+
+// inviteErrorResponse maps the error of sending the invitation to the response. The user is logged out if the token
+// was rejected.
+func (s *Server) inviteErrorResponse(err error, userID int64) *pb.InviteResponse {
+	s.pub.Publish(fmt.Errorf("sending invitation: %w", err))
+	if errors.Is(err, core.ErrTooManyRequests) {
+		return &pb.InviteResponse{
+			Response: &pb.InviteResponse_InviteResponseErrorCode{
+				InviteResponseErrorCode: pb.InviteResponseErrorCode_LIMIT_REACHED,
+			},
 		}
-		if errors.Is(err, core.ErrMaximumDeviceCount) {
-			return &pb.InviteResponse{
-				Response: &pb.InviteResponse_InviteResponseErrorCode{
-					InviteResponseErrorCode: pb.InviteResponseErrorCode_PEER_COUNT,
-				},
-			}, nil
+	}
+	if errors.Is(err, core.ErrMaximumDeviceCount) {
+		return &pb.InviteResponse{
+			Response: &pb.InviteResponse_InviteResponseErrorCode{
+				InviteResponseErrorCode: pb.InviteResponseErrorCode_PEER_COUNT,
+			},
 		}
-		if errors.Is(err, core.ErrConflict) {
-			return &pb.InviteResponse{
-				Response: &pb.InviteResponse_InviteResponseErrorCode{
-					InviteResponseErrorCode: pb.InviteResponseErrorCode_ALREADY_EXISTS,
-				},
-			}, nil
+	}
+	if errors.Is(err, core.ErrConflict) {
+		return &pb.InviteResponse{
+			Response: &pb.InviteResponse_InviteResponseErrorCode{
+				InviteResponseErrorCode: pb.InviteResponseErrorCode_ALREADY_EXISTS,
+			},
 		}
-		if strings.Contains(err.Error(), "must be a valid email address") {
-			return &pb.InviteResponse{
-				Response: &pb.InviteResponse_InviteResponseErrorCode{
-					InviteResponseErrorCode: pb.InviteResponseErrorCode_INVALID_EMAIL,
-				},
-			}, nil
+	}
+	if strings.Contains(err.Error(), "must be a valid email address") {
+		return &pb.InviteResponse{
+			Response: &pb.InviteResponse_InviteResponseErrorCode{
+				InviteResponseErrorCode: pb.InviteResponseErrorCode_INVALID_EMAIL,
+			},
 		}
-		if strings.Contains(err.Error(), MsgMeshnetInviteSendSameAccountEmail) {
-			return &pb.InviteResponse{
-				Response: &pb.InviteResponse_InviteResponseErrorCode{
-					InviteResponseErrorCode: pb.InviteResponseErrorCode_SAME_ACCOUNT_EMAIL,
-				},
-			}, nil
+	}
+	if strings.Contains(err.Error(), MsgMeshnetInviteSendSameAccountEmail) {
+		return &pb.InviteResponse{
+			Response: &pb.InviteResponse_InviteResponseErrorCode{
+				InviteResponseErrorCode: pb.InviteResponseErrorCode_SAME_ACCOUNT_EMAIL,
+			},
 		}
-		if errors.Is(err, core.ErrUnauthorized) {
-			if err := s.cm.SaveWith(auth.Logout(cfg.AutoConnectData.ID)); err != nil {
-				s.pub.Publish(err)
-				return &pb.InviteResponse{
-					Response: &pb.InviteResponse_ServiceErrorCode{
-						ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
-					},
-				}, nil
-			}
+	}
+	if errors.Is(err, core.ErrUnauthorized) {
+		if err := s.cm.SaveWith(auth.Logout(userID)); err != nil {
+			s.pub.Publish(err)
 			return &pb.InviteResponse{
 				Response: &pb.InviteResponse_ServiceErrorCode{
-					ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
+					ServiceErrorCode: pb.ServiceErrorCode_CONFIG_FAILURE,
 				},
-			}, nil
+			}
 		}
 		return &pb.InviteResponse{
 			Response: &pb.InviteResponse_ServiceErrorCode{
-				ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+				ServiceErrorCode: pb.ServiceErrorCode_NOT_LOGGED_IN,
 			},
-		}, nil
+		}
 	}
-
 	return &pb.InviteResponse{
-		Response: &pb.InviteResponse_Empty{},
-	}, nil
+		Response: &pb.InviteResponse_ServiceErrorCode{
+			ServiceErrorCode: pb.ServiceErrorCode_API_FAILURE,
+		},
+	}
 }
 
 // AcceptInvite from another peer
@@ -946,14 +960,20 @@ func (s *Server) GetInvites(context.Context, *pb.Empty) (*pb.GetInvitesResponse,
 
 	sent := []*pb.Invite{}
 	for _, invitation := range resp {
-		sent = append(sent, &pb.Invite{Email: invitation.Email})
+		invite := &pb.Invite{Email: invitation.Email}
+		if !invitation.ExpiresAt.IsZero() {
+			invite.ExpiresAt = timestamppb.New(invitation.ExpiresAt)
+		}
+		sent = append(sent, invite)
 	}
 
 	return &pb.GetInvitesResponse{
 		Response: &pb.GetInvitesResponse_Invites{
 			Invites: &pb.InvitesList{
-				Received: received,
-				Sent:     sent,
+				Received:   received,
+				Sent:       sent,
+				AutoAccept: cfg.Meshnet.InviteAutoAccept != nil,
+				InviteTtl:  uint32(cfg.Meshnet.InviteTTL.Seconds()),
 			},
 		},
 	}, nil
@@ -1037,7 +1057,7 @@ func (s *Server) GetPeers(context.Context, *pb.Empty) (*pb.GetPeersResponse, err
 		}
 
 		for _, peer := range resp {
-			peers.Local = append(peers.Local, peer.ToProtobuf())
+			peers.Local = append(peers.Local, withPeerNote(peer.ToProtobuf(), cfg.Meshnet.PeerNotes))
 		}
 	} else {
 		token := cfg.TokensData[cfg.AutoConnectData.ID].Token
@@ -1082,11 +1102,17 @@ func (s *Server) GetPeers(context.Context, *pb.Empty) (*pb.GetPeersResponse, err
 		if err != nil {
 			peerMap = map[string]string{}
 		}
+		pathMap, err := s.netw.PathMap()
+		if err != nil {
+			pathMap = map[string]string{}
+		}
 		for _, peer := range resp {
-			protoPeer := peer.ToProtobuf()
+			protoPeer := withPeerNote(peer.ToProtobuf(), cfg.Meshnet.PeerNotes)
+			protoPeer.BandwidthLimit = cfg.Meshnet.PeerBandwidthLimits[peer.PublicKey]
 			status := pb.PeerStatus_DISCONNECTED
 			if peerMap[peer.PublicKey] == "connected" {
 				status = pb.PeerStatus_CONNECTED
+				protoPeer.Path = peerPath(pathMap[peer.PublicKey])
 			}
 			protoPeer.Status = status
 			if peer.IsLocal {
@@ -1533,9 +1559,10 @@ func (s *Server) ChangeMachineNickname(
 
 	// TODO: sometimes IsRegistrationInfoCorrect() re-registers the device => cfg.MeshDevice.ID can be different.
 	info := mesh.MachineUpdateRequest{
-		Nickname:        req.Nickname,
-		SupportsRouting: true,
-		Endpoints:       cfg.MeshDevice.Endpoints,
+		Nickname:          req.Nickname,
+		SupportsRouting:   true,
+		Endpoints:         cfg.MeshDevice.Endpoints,
+		AdvertisedSubnets: cfg.MeshDevice.AdvertisedSubnets,
 	}
 
 	if err := s.reg.Update(token, cfg.MeshDevice.ID, info); err != nil {
@@ -3023,6 +3050,7 @@ func (s *Server) connect(
 			Name:              peer.Nickname,
 			Protocol:          config.Protocol_UDP,
 			NordLynxPublicKey: peer.PublicKey,
+			Technology:        config.Technology_NORDLYNX,
 		},
 		cfg.AutoConnectData.Allowlist,
 		nameservers,
@@ -7,6 +7,7 @@ import (
 	"net/netip"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/auth"
 	"github.com/NordSecurity/nordvpn-linux/config"
@@ -70,6 +71,8 @@ type workingNetworker struct {
 	allowedFileshare []UniqueAddress
 	blockedFileshare []UniqueAddress
 	resetPeers       []string
+	refreshes        int
+	bandwidthLimits  config.PeerBandwidthLimits
 }
 
 func (workingNetworker) Start(
@@ -117,16 +120,26 @@ func (n *workingNetworker) ResetRouting(changedPeer mesh.MachinePeer, peer mesh.
 	return nil
 }
 
+func (n *workingNetworker) SetPeerBandwidthLimits(limits config.PeerBandwidthLimits) error {
+	n.bandwidthLimits = limits
+	return nil
+}
+
 func (*workingNetworker) BlockRouting(UniqueAddress) error { return nil }
-func (*workingNetworker) Refresh(mesh.MachineMap) error    { return nil }
+func (n *workingNetworker) Refresh(mesh.MachineMap) error  { n.refreshes++; return nil }
 func (*workingNetworker) StatusMap() (map[string]string, error) {
 	return map[string]string{}, nil
 }
+func (*workingNetworker) PathMap() (map[string]string, error) {
+	return map[string]string{}, nil
+}
 func (*workingNetworker) LastServerName() string { return "" }
 
 type invitationsAPI struct{}
 
-func (invitationsAPI) Invite(string, uuid.UUID, string, bool, bool, bool, bool) error { return nil }
+func (invitationsAPI) Invite(string, uuid.UUID, string, time.Duration, bool, bool, bool, bool) error {
+	return nil
+}
 func (invitationsAPI) Sent(string, uuid.UUID) (mesh.Invitations, error) {
 	return mesh.Invitations{}, nil
 }
@@ -138,12 +151,19 @@ func (invitationsAPI) Received(string, uuid.UUID) (mesh.Invitations, error) {
 func (invitationsAPI) Accept(string, uuid.UUID, uuid.UUID, bool, bool, bool, bool) error { return nil }
 func (invitationsAPI) Revoke(string, uuid.UUID, uuid.UUID) error                         { return nil }
 func (invitationsAPI) Reject(string, uuid.UUID, uuid.UUID) error                         { return nil }
+func (invitationsAPI) CreateInviteCode(string, uuid.UUID, bool, bool, bool, bool) (*mesh.InviteCode, error) {
+	return &mesh.InviteCode{}, nil
+}
+
+func (invitationsAPI) RedeemInviteCode(string, uuid.UUID, string, bool, bool, bool, bool) error {
+	return nil
+}
 
 type limitedInvitationsAPI struct {
 	invitationsAPI
 }
 
-func (limitedInvitationsAPI) Invite(string, uuid.UUID, string, bool, bool, bool, bool) error {
+func (limitedInvitationsAPI) Invite(string, uuid.UUID, string, time.Duration, bool, bool, bool, bool) error {
 	return core.ErrTooManyRequests
 }
 
@@ -151,7 +171,7 @@ type maximumInvitationsAPI struct {
 	invitationsAPI
 }
 
-func (maximumInvitationsAPI) Invite(string, uuid.UUID, string, bool, bool, bool, bool) error {
+func (maximumInvitationsAPI) Invite(string, uuid.UUID, string, time.Duration, bool, bool, bool, bool) error {
 	return core.ErrMaximumDeviceCount
 }
 
@@ -33,6 +33,34 @@ func LookupAddressWithCustomDNS(addr string, dns string, protocol string) ([]net
 	return ips, nil
 }
 
+// PingStatistics describes results of the ICMP echo requests
+type PingStatistics struct {
+	Sent     int
+	Received int
+	// AvgRTT is the average round trip time of the received responses
+	AvgRTT time.Duration
+}
+
+// PingWithStatistics sends count ICMP echo requests to the given address and reports the results
+func PingWithStatistics(addr string, count int) (PingStatistics, error) {
+	pinger, err := ping.NewPinger(addr)
+	if err != nil {
+		return PingStatistics{}, fmt.Errorf("unable resolve %s to ping: %w", addr, err)
+	}
+	pinger.Timeout = time.Duration(count) * time.Second
+	pinger.SetPrivileged(true)
+	pinger.Count = count
+	if err := pinger.Run(); err != nil {
+		return PingStatistics{}, fmt.Errorf("unable to ping: %w", err)
+	}
+	stats := pinger.Statistics()
+	return PingStatistics{
+		Sent:     stats.PacketsSent,
+		Received: stats.PacketsRecv,
+		AvgRTT:   stats.AvgRtt,
+	}, nil
+}
+
 func Ping(addr string, count int) error {
 	pinger, err := ping.NewPinger(addr)
 	pinger.Timeout = 500 * time.Millisecond
@@ -0,0 +1,44 @@
+package network
+
+import (
+	"os/exec"
+	"strconv"
+)
+
+// pingHeadersSize is the size of the IPv4 and ICMP echo headers which are not counted in the ping payload
+const pingHeadersSize = 28
+
+// PacketFits reports whether the packet of the size reaches the destination without being fragmented
+type PacketFits func(size int) bool
+
+// FindPathMTU returns the largest packet size between low and high which fits through the path. False is returned
+// if not even the smallest size fits, e.g. when the destination does not answer at all.
+func FindPathMTU(low, high int, fits PacketFits) (int, bool) {
+	if fits(high) {
+		return high, true
+	}
+	if !fits(low) {
+		return 0, false
+	}
+	// low always fits and high never does
+	for high-low > 1 {
+		mid := low + (high-low)/2
+		if fits(mid) {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return low, true
+}
+
+// PingDontFragment sends ICMP echo requests of the packet size with the Don't Fragment flag set through the
+// interface and reports whether any of them were answered
+func PingDontFragment(iface string, addr string, size int) bool {
+	// #nosec G204 -- the interface name is validated when it is set and the address is not a user input
+	err := exec.Command(
+		"ping", "-M", "do", "-c", "2", "-i", "0.2", "-W", "1", "-I", iface,
+		"-s", strconv.Itoa(size-pingHeadersSize), addr,
+	).Run()
+	return err == nil
+}
@@ -0,0 +1,39 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindPathMTU(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		pathMTU  int
+		expected int
+		ok       bool
+	}{
+		{name: "highest size fits", pathMTU: 1500, expected: 1420, ok: true},
+		{name: "PPPoE", pathMTU: 1412, expected: 1412, ok: true},
+		{name: "LTE", pathMTU: 1340, expected: 1340, ok: true},
+		{name: "lowest size fits", pathMTU: 576, expected: 576, ok: true},
+		{name: "nothing fits", pathMTU: 500},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			probes := 0
+			mtu, ok := FindPathMTU(576, 1420, func(size int) bool {
+				probes++
+				return size <= test.pathMTU
+			})
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, mtu)
+			assert.LessOrEqual(t, probes, 12)
+		})
+	}
+}
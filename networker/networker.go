@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"math"
+	"net"
 	"net/netip"
 	"strings"
 	"sync"
@@ -27,6 +28,8 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/ipv6"
 	"github.com/NordSecurity/nordvpn-linux/meshnet"
 	"github.com/NordSecurity/nordvpn-linux/meshnet/exitnode"
+	"github.com/NordSecurity/nordvpn-linux/network"
+	"github.com/NordSecurity/nordvpn-linux/tunnel"
 	mapset "github.com/deckarep/golang-set/v2"
 	"golang.org/x/exp/slices"
 )
@@ -35,8 +38,12 @@ var (
 	// errNilVPN is returned when there is a bug in program logic.
 	errNilVPN      = errors.New("vpn is nil")
 	errInactiveVPN = errors.New("not connected to vpn")
+	// errPathMTUProbe is returned when the probes sent through the tunnel are not answered
+	errPathMTUProbe = errors.New("path MTU probes were not answered")
 	// ErrMeshNotActive to report to outside
 	ErrMeshNotActive = errors.New("mesh is not active")
+	// ErrSessionStatsNotSupported is returned when the current VPN technology can't report session statistics
+	ErrSessionStatsNotSupported = errors.New("session statistics are not supported by the current technology")
 	// ErrMeshPeerIsNotRoutable to report to outside
 	ErrMeshPeerIsNotRoutable = errors.New("mesh peer is not routable")
 	// ErrMeshPeerNotFound to report to outside
@@ -45,8 +52,12 @@ var (
 	// connection to be canceled
 	ErrNothingToCancel = errors.New("nothing to cancel")
 	defaultMeshSubnet  = netip.MustParsePrefix("100.64.0.0/10")
+	meshDomain         = "nord"
 )
 
+// pathMTUProbeAddr is the VPN nameserver which answers the echo requests sent through the tunnel
+const pathMTUProbeAddr = "103.86.96.100"
+
 const (
 	// a string to be prepended with peers public key and appended with peers ip address to form the internal rule name
 	// for allowing the incomig connections
@@ -82,6 +93,8 @@ type ConnectionStatus struct {
 	Uptime *time.Duration
 	// Is virtual server
 	VirtualLocation bool
+	// UserSpace is set when the tunnel runs in userspace because the kernel does not support it
+	UserSpace bool
 }
 
 // Networker configures networking for connections.
@@ -105,6 +118,7 @@ type Networker interface {
 	IsVPNActive() bool
 	IsMeshnetActive() bool
 	ConnectionStatus() (ConnectionStatus, error)
+	SessionStats() (vpn.SessionStats, error)
 	EnableFirewall() error
 	DisableFirewall() error
 	EnableRouting()
@@ -118,6 +132,9 @@ type Networker interface {
 	SetVPN(vpn.VPN)
 	LastServerName() string
 	SetLanDiscovery(bool)
+	SetRouteMetric(uint32)
+	ProbePathMTU() (int, error)
+	SetPeerRoutes(publicKey string, subnets []netip.Prefix) error
 	UnsetFirewall() error
 }
 
@@ -159,6 +176,9 @@ type Combined struct {
 	fwmark             uint32
 	mu                 sync.Mutex
 	lanDiscovery       bool
+	routeMetric        uint32 // of the VPN default routes, 0 for the kernel default
+	peerRoutesKey      string // public key of the meshnet peer used as an exit for peerRoutes
+	peerRoutes         []netip.Prefix
 	// need to memorize route to remote LAN state set on mesh peer connect
 	// according how remote peer has set its permission, for later when
 	// doing mesh refresh which may happen in background e.g. when network
@@ -298,7 +318,7 @@ func (netw *Combined) start(
 
 	// if routing rules were set - they will be adjusted as needed
 	if err = netw.policyRouter.SetupRoutingRules(
-		serverData.IP.Is6(),
+		routesIPv6(serverData),
 		netw.enableLocalTraffic,
 		netw.lanDiscovery,
 		allowlist.Subnets.ToSlice(),
@@ -317,6 +337,13 @@ func (netw *Combined) start(
 	start := time.Now()
 	netw.startTime = &start
 	netw.interfaces = device.InterfacesWithDefaultRoute(mapset.NewSet(netw.vpnet.Tun().Interface().Name))
+
+	// peer routes are dropped together with the VPN connection when both share the meshnet tunnel
+	if netw.isMeshnetSet && len(netw.peerRoutes) > 0 {
+		if err := netw.setPeerRoutes(); err != nil {
+			log.Println(internal.WarningPrefix, err)
+		}
+	}
 	return nil
 }
 
@@ -348,13 +375,19 @@ func (netw *Combined) configureNetwork(
 	return netw.disableIPv6IfNeeded()
 }
 
+// disableIPv6IfNeeded blocks IPv6 if it is disabled or if it cannot be routed through the tunnel
 func (netw *Combined) disableIPv6IfNeeded() error {
 	if !netw.ipv6Enabled {
 		if err := netw.denyIPv6(); err != nil {
 			return err
 		}
+		return nil
 	}
 
+	if netw.isNetworkSet && !tunnelHasIPv6(netw.vpnet.Tun()) {
+		log.Println(internal.InfoPrefix, "tunnel has no IPv6 address, blocking IPv6")
+		return netw.ipv6.Block()
+	}
 	return nil
 }
 
@@ -373,11 +406,43 @@ func (netw *Combined) addDefaultRoute() error {
 		Subnet:  netip.MustParsePrefix("0.0.0.0/0"),
 		Device:  netw.vpnet.Tun().Interface(),
 		TableID: netw.policyRouter.TableID(),
+		Metric:  netw.routeMetric,
 	})
 	if err != nil {
 		return fmt.Errorf("adding the default route: %w", err)
 	}
-	return err
+
+	if !tunnelHasIPv6(netw.vpnet.Tun()) {
+		return nil
+	}
+	err = netw.router.Add(routes.Route{
+		Subnet:  netip.MustParsePrefix("::/0"),
+		Device:  netw.vpnet.Tun().Interface(),
+		TableID: netw.policyRouter.TableID(),
+		Metric:  netw.routeMetric,
+	})
+	if err != nil {
+		return fmt.Errorf("adding the IPv6 default route: %w", err)
+	}
+	return nil
+}
+
+// routesIPv6 returns true if IPv6 traffic has to be routed through the tunnel to the server
+func routesIPv6(serverData vpn.ServerData) bool {
+	return serverData.IP.Is6() || serverData.IPv6.IsValid()
+}
+
+// tunnelHasIPv6 returns true if IPv6 address is assigned to the tunnel
+func tunnelHasIPv6(tun tunnel.T) bool {
+	if tun == nil {
+		return false
+	}
+	for _, ip := range tun.IPs() {
+		if ip.Is6() {
+			return true
+		}
+	}
+	return false
 }
 
 func (netw *Combined) configureFirewall(allowlist config.Allowlist) error {
@@ -542,20 +607,20 @@ func (netw *Combined) ConnectionStatus() (ConnectionStatus, error) {
 		return ConnectionStatus{}, fmt.Errorf("acquiring tun interface transfer rates: %w", err)
 	}
 
-	tech := config.Technology_OPENVPN
-	if netw.vpnet.Tun().Interface().Name == "nordlynx" {
-		tech = config.Technology_NORDLYNX
-	}
-
 	var uptime *time.Duration
 	if netw.startTime != nil {
 		dur := time.Since(*netw.startTime)
 		uptime = &dur
 	}
 
+	var userSpace bool
+	if reporter, ok := netw.vpnet.(vpn.UserSpaceReporter); ok {
+		userSpace = reporter.IsUserSpace()
+	}
+
 	return ConnectionStatus{
 		State:           vpn.ConnectedState,
-		Technology:      tech,
+		Technology:      netw.lastServer.Technology,
 		Protocol:        netw.lastServer.Protocol,
 		IP:              netw.lastServer.IP,
 		Name:            netw.lastServer.Name,
@@ -566,9 +631,56 @@ func (netw *Combined) ConnectionStatus() (ConnectionStatus, error) {
 		Upload:          stats.Tx,
 		Uptime:          uptime,
 		VirtualLocation: netw.lastServer.VirtualLocation,
+		UserSpace:       userSpace,
 	}, nil
 }
 
+// SessionStats returns the statistics of the current tunnel session
+func (netw *Combined) SessionStats() (vpn.SessionStats, error) {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	if !netw.isConnectedToVPN() {
+		return vpn.SessionStats{}, errInactiveVPN
+	}
+
+	getter, ok := netw.vpnet.(vpn.SessionStatsGetter)
+	if !ok {
+		return vpn.SessionStats{}, ErrSessionStatsNotSupported
+	}
+	return getter.SessionStats()
+}
+
+// ProbePathMTU finds the largest packet which passes through the tunnel without being fragmented and lowers the
+// MTU of the tunnel interface to it. The path MTU is returned.
+func (netw *Combined) ProbePathMTU() (int, error) {
+	netw.mu.Lock()
+	if !netw.isConnectedToVPN() {
+		netw.mu.Unlock()
+		return 0, errInactiveVPN
+	}
+	name := netw.vpnet.Tun().Interface().Name
+	netw.mu.Unlock()
+
+	// probing takes a while, so it is done without holding the lock. The interface is looked up again, because the
+	// MTU is set after the tunnel is created.
+	iface, err := net.InterfaceByName(name)
+	if err != nil {
+		return 0, fmt.Errorf("looking up the tunnel interface: %w", err)
+	}
+	mtu, ok := network.FindPathMTU(config.MinMTU, iface.MTU, func(size int) bool {
+		return network.PingDontFragment(name, pathMTUProbeAddr, size)
+	})
+	if !ok {
+		return 0, errPathMTUProbe
+	}
+	if mtu < iface.MTU {
+		if err := tunnel.SetMTU(name, mtu); err != nil {
+			return 0, fmt.Errorf("setting the MTU of the tunnel interface: %w", err)
+		}
+	}
+	return mtu, nil
+}
+
 // LastServerName returns last used server hostname
 func (netw *Combined) LastServerName() string {
 	return netw.lastServer.Hostname
@@ -988,7 +1100,7 @@ func (netw *Combined) setAllowlist(allowlist config.Allowlist) error {
 
 	// adjust allow subnet routing rules
 	if err = netw.policyRouter.SetupRoutingRules(
-		false,
+		netw.isVpnSet && routesIPv6(netw.lastServer),
 		netw.enableLocalTraffic,
 		netw.lanDiscovery,
 		netw.allowlist.Subnets.ToSlice(),
@@ -1231,7 +1343,7 @@ func (netw *Combined) setMesh(
 	}
 
 	if err = netw.policyRouter.SetupRoutingRules(
-		false,
+		netw.isVpnSet && routesIPv6(netw.lastServer),
 		netw.enableLocalTraffic,
 		netw.lanDiscovery,
 		netw.allowlist.Subnets.ToSlice(),
@@ -1245,6 +1357,35 @@ func (netw *Combined) setMesh(
 
 	// add routes for new peers and remove for the old ones
 	netw.publisher.Publish("adding mesh route")
+	if err = netw.addMeshRoute(); err != nil {
+		return err
+	}
+
+	err = netw.refresh(cfg)
+	if err != nil {
+		return err
+	}
+
+	if len(netw.peerRoutes) > 0 {
+		if err := netw.setPeerRoutes(); err != nil {
+			log.Println(internal.WarningPrefix, err)
+		}
+	}
+
+	// peer names are resolved from the hosts file, routing the domain to the meshnet interface keeps the
+	// queries for the unknown peer names from leaking to the DNS of the other interfaces
+	netw.publisher.Publish("setting mesh dns domains")
+	if err := netw.dnsSetter.SetDomains(netw.mesh.Tun().Interface().Name, []string{meshDomain}); err != nil {
+		log.Println(internal.WarningPrefix, "setting mesh dns domains:", err)
+	}
+
+	netw.isMeshnetSet = true
+	netw.lastPrivateKey = privateKey
+
+	return nil
+}
+
+func (netw *Combined) addMeshRoute() error {
 	if err := netw.peerRouter.Add(routes.Route{
 		Subnet:  defaultMeshSubnet,
 		Device:  netw.mesh.Tun().Interface(),
@@ -1255,15 +1396,52 @@ func (netw *Combined) setMesh(
 			err,
 		)
 	}
+	return nil
+}
 
-	err = netw.refresh(cfg)
-	if err != nil {
+// SetPeerRoutes routes the subnets through the meshnet peer while the rest of the traffic keeps going
+// through the VPN. Empty subnets stop routing through the peer. Routes are remembered and set
+// whenever meshnet is set.
+func (netw *Combined) SetPeerRoutes(publicKey string, subnets []netip.Prefix) error {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+
+	netw.peerRoutesKey = publicKey
+	netw.peerRoutes = subnets
+	if !netw.isMeshnetSet {
+		return nil
+	}
+
+	// routes of the previous subnets are removed together with the mesh route
+	if err := netw.peerRouter.Flush(); err != nil {
+		return fmt.Errorf("clearing peer routes: %w", err)
+	}
+	if err := netw.addMeshRoute(); err != nil {
 		return err
 	}
 
-	netw.isMeshnetSet = true
-	netw.lastPrivateKey = privateKey
+	err := netw.setPeerRoutes()
+	if errors.Is(err, meshnet.ErrExitNodeInUse) {
+		// set after the VPN reconnects through its own tunnel
+		return nil
+	}
+	return err
+}
 
+func (netw *Combined) setPeerRoutes() error {
+	if err := netw.mesh.SetPeerRoutes(netw.peerRoutesKey, netw.peerRoutes); err != nil {
+		return fmt.Errorf("setting peer routes: %w", err)
+	}
+
+	for _, subnet := range netw.peerRoutes {
+		if err := netw.peerRouter.Add(routes.Route{
+			Subnet:  subnet,
+			Device:  netw.mesh.Tun().Interface(),
+			TableID: netw.policyRouter.TableID(),
+		}); err != nil {
+			return fmt.Errorf("adding peer route: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -1324,6 +1502,7 @@ func (netw *Combined) refresh(cfg mesh.MachineMap) error {
 		// if so, change routing to route to local LAN
 	}
 
+	netw.exitNode.SetAdvertisedSubnets(cfg.Machine.AdvertisedSubnets)
 	lanAvailable := netw.lanDiscovery || !netw.isNetworkSet
 	err = netw.exitNode.ResetPeers(cfg.Peers, lanAvailable, netw.isKillSwitchSet)
 	if err != nil {
@@ -1387,6 +1566,10 @@ func (netw *Combined) unSetMesh() error {
 		return fmt.Errorf("unsetting hosts: %w", err)
 	}
 
+	if err := netw.dnsSetter.UnsetDomains(netw.mesh.Tun().Interface().Name); err != nil {
+		log.Println(internal.WarningPrefix, "unsetting mesh dns domains:", err)
+	}
+
 	if err := netw.defaultMeshUnBlock(); err != nil {
 		return fmt.Errorf(
 			"unblocking the peer subnet: %w",
@@ -1442,6 +1625,12 @@ func (netw *Combined) StatusMap() (map[string]string, error) {
 	return netw.mesh.StatusMap()
 }
 
+func (netw *Combined) PathMap() (map[string]string, error) {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	return netw.mesh.PathMap()
+}
+
 // AllowIncoming traffic from the uniqueAddress.
 func (netw *Combined) AllowIncoming(uniqueAddress meshnet.UniqueAddress, lanAllowed bool) error {
 	netw.mu.Lock()
@@ -1721,6 +1910,24 @@ func (netw *Combined) defaultMeshBlock(ip netip.Addr) error {
 	return nil
 }
 
+// SetRouteMetric sets the metric of the VPN default routes. It is used starting with the next connection, zero
+// leaves the kernel default.
+func (netw *Combined) SetRouteMetric(metric uint32) {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+	netw.routeMetric = metric
+}
+
+// SetPeerBandwidthLimits caps the traffic the peers can route through this device
+func (netw *Combined) SetPeerBandwidthLimits(limits config.PeerBandwidthLimits) error {
+	netw.mu.Lock()
+	defer netw.mu.Unlock()
+
+	netw.exitNode.SetBandwidthLimits(limits)
+	lanAvailable := netw.lanDiscovery || !netw.isNetworkSet
+	return netw.exitNode.ResetFirewall(lanAvailable, netw.isKillSwitchSet)
+}
+
 func (netw *Combined) SetLanDiscovery(enabled bool) {
 	netw.mu.Lock()
 	defer netw.mu.Unlock()
@@ -1732,7 +1939,7 @@ func (netw *Combined) SetLanDiscovery(enabled bool) {
 	// if routing rules were set - they will be adjusted as needed
 	if netw.isMeshnetSet || netw.isVpnSet {
 		if err := netw.policyRouter.SetupRoutingRules(
-			netw.lastServer.IP.Is6(),
+			netw.isVpnSet && routesIPv6(netw.lastServer),
 			netw.enableLocalTraffic,
 			netw.lanDiscovery,
 			netw.allowlist.Subnets.ToSlice(),
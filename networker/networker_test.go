@@ -62,6 +62,16 @@ func (workingRouter) Enable(uint) error      { return nil }
 func (workingRouter) Disable() error         { return nil }
 func (workingRouter) IsEnabled() bool        { return true }
 
+type recordingRouter struct {
+	workingRouter
+	routes []routes.Route
+}
+
+func (r *recordingRouter) Add(route routes.Route) error {
+	r.routes = append(r.routes, route)
+	return nil
+}
+
 type failingRouter struct{}
 
 func (failingRouter) Add(routes.Route) error { return mock.ErrOnPurpose }
@@ -70,15 +80,22 @@ func (failingRouter) Enable(uint) error      { return mock.ErrOnPurpose }
 func (failingRouter) Disable() error         { return mock.ErrOnPurpose }
 func (failingRouter) IsEnabled() bool        { return false }
 
-type workingDNS struct{ setDNS []string }
+type workingDNS struct {
+	setDNS  []string
+	domains []string
+}
 
-func (w *workingDNS) Set(_ string, dns []string) error { w.setDNS = dns; return nil }
-func (w *workingDNS) Unset(string) error               { w.setDNS = nil; return nil }
+func (w *workingDNS) Set(_ string, dns []string) error            { w.setDNS = dns; return nil }
+func (w *workingDNS) Unset(string) error                          { w.setDNS = nil; return nil }
+func (w *workingDNS) SetDomains(_ string, domains []string) error { w.domains = domains; return nil }
+func (w *workingDNS) UnsetDomains(string) error                   { w.domains = nil; return nil }
 
 type failingDNS struct{}
 
-func (failingDNS) Set(string, []string) error { return mock.ErrOnPurpose }
-func (failingDNS) Unset(string) error         { return mock.ErrOnPurpose }
+func (failingDNS) Set(string, []string) error        { return mock.ErrOnPurpose }
+func (failingDNS) Unset(string) error                { return mock.ErrOnPurpose }
+func (failingDNS) SetDomains(string, []string) error { return mock.ErrOnPurpose }
+func (failingDNS) UnsetDomains(string) error         { return mock.ErrOnPurpose }
 
 type workingIpv6 struct{}
 
@@ -177,9 +194,11 @@ func (*workingRoutingSetup) Disable() error        { return nil }
 func (*workingRoutingSetup) IsEnabled() bool       { return true }
 
 type workingExitNode struct {
-	enabled      bool
-	peers        mesh.MachinePeers
-	LanAvailable bool
+	enabled           bool
+	peers             mesh.MachinePeers
+	advertisedSubnets []netip.Prefix
+	bandwidthLimits   config.PeerBandwidthLimits
+	LanAvailable      bool
 }
 
 func newWorkingExitNode() *workingExitNode {
@@ -199,6 +218,14 @@ func (e *workingExitNode) ResetPeers(peers mesh.MachinePeers, lan bool, killswit
 	return nil
 }
 
+func (e *workingExitNode) SetAdvertisedSubnets(subnets []netip.Prefix) {
+	e.advertisedSubnets = subnets
+}
+
+func (e *workingExitNode) SetBandwidthLimits(limits config.PeerBandwidthLimits) {
+	e.bandwidthLimits = limits
+}
+
 func (*workingExitNode) DisablePeer(netip.Addr) error { return nil }
 func (*workingExitNode) Disable() error               { return nil }
 func (e *workingExitNode) SetAllowlist(_ config.Allowlist, lan bool) error {
@@ -214,6 +241,8 @@ func (e *workingExitNode) ResetFirewall(lan bool, killswitch bool) error {
 type workingMesh struct {
 	enableErr         error
 	networkChangedErr error
+	peerRoutesKey     string
+	peerRoutes        []netip.Prefix
 }
 
 func (w *workingMesh) Enable(netip.Addr, string) error { return w.enableErr }
@@ -224,7 +253,15 @@ func (*workingMesh) Tun() tunnel.T                     { return mock.WorkingT{}
 func (*workingMesh) StatusMap() (map[string]string, error) {
 	return map[string]string{}, nil
 }
+func (*workingMesh) PathMap() (map[string]string, error) {
+	return map[string]string{}, nil
+}
 func (w *workingMesh) NetworkChanged() error { return w.networkChangedErr }
+func (w *workingMesh) SetPeerRoutes(publicKey string, subnets []netip.Prefix) error {
+	w.peerRoutesKey = publicKey
+	w.peerRoutes = subnets
+	return nil
+}
 
 type workingHostSetter struct {
 	hosts dns.Hosts
@@ -483,6 +520,70 @@ func TestCombined_TransferRates(t *testing.T) {
 	}
 }
 
+type tunnelVPN struct {
+	mock.WorkingInactiveVPN
+	tun tunnel.T
+}
+
+func (v tunnelVPN) Tun() tunnel.T { return v.tun }
+
+func TestCombined_AddDefaultRoute(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		ips      []netip.Addr
+		expected []netip.Prefix
+	}{
+		{
+			name:     "IPv4 tunnel",
+			ips:      []netip.Addr{netip.MustParseAddr("10.5.0.2")},
+			expected: []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0")},
+		},
+		{
+			name: "IPv6 tunnel",
+			ips: []netip.Addr{
+				netip.MustParseAddr("10.5.0.2"),
+				netip.MustParseAddr("2a02:5740:1:9:0:11:5:2"),
+			},
+			expected: []netip.Prefix{netip.MustParsePrefix("0.0.0.0/0"), netip.MustParsePrefix("::/0")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			router := &recordingRouter{}
+			netw := NewCombined(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, &workingRoutingSetup{}, nil, router, nil, nil, 0, false)
+			netw.vpnet = tunnelVPN{tun: tunnel.New(mock.En0Interface, test.ips)}
+
+			assert.NoError(t, netw.addDefaultRoute())
+			var subnets []netip.Prefix
+			for _, route := range router.routes {
+				subnets = append(subnets, route.Subnet)
+			}
+			assert.Equal(t, test.expected, subnets)
+		})
+	}
+}
+
+func TestCombined_AddDefaultRoute_Metric(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	router := &recordingRouter{}
+	netw := NewCombined(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, &workingRoutingSetup{}, nil, router, nil, nil, 0, false)
+	netw.vpnet = tunnelVPN{tun: tunnel.New(mock.En0Interface, []netip.Addr{
+		netip.MustParseAddr("10.5.0.2"),
+		netip.MustParseAddr("2a02:5740:1:9:0:11:5:2"),
+	})}
+	netw.SetRouteMetric(50)
+
+	assert.NoError(t, netw.addDefaultRoute())
+	assert.Len(t, router.routes, 2)
+	for _, route := range router.routes {
+		assert.Equal(t, uint32(50), route.Metric)
+	}
+}
+
 func TestCombined_SetDNS(t *testing.T) {
 	category.Set(t, category.Unit)
 
@@ -1423,6 +1524,107 @@ func TestCombined_UnSetMesh(t *testing.T) {
 	}
 }
 
+func TestCombined_MeshDomains(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	dnsSetter := &workingDNS{}
+	netw := NewCombined(
+		nil,
+		&workingMesh{},
+		workingGateway{},
+		&subs.Subject[string]{},
+		workingRouter{},
+		dnsSetter,
+		&workingIpv6{},
+		&workingFirewall{},
+		workingAllowlistRouting{},
+		workingDeviceList,
+		&workingRoutingSetup{},
+		&workingHostSetter{},
+		workingRouter{},
+		workingRouter{},
+		&workingExitNode{},
+		0,
+		false,
+	)
+	assert.NoError(t, netw.SetMesh(mesh.MachineMap{}, netip.Addr{}, ""))
+	assert.Equal(t, []string{meshDomain}, dnsSetter.domains)
+
+	assert.NoError(t, netw.UnSetMesh())
+	assert.Empty(t, dnsSetter.domains)
+}
+
+func TestCombined_MeshDomainsNotSupported(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	netw := NewCombined(
+		nil,
+		&workingMesh{},
+		workingGateway{},
+		&subs.Subject[string]{},
+		workingRouter{},
+		failingDNS{},
+		&workingIpv6{},
+		&workingFirewall{},
+		workingAllowlistRouting{},
+		workingDeviceList,
+		&workingRoutingSetup{},
+		&workingHostSetter{},
+		workingRouter{},
+		workingRouter{},
+		&workingExitNode{},
+		0,
+		false,
+	)
+	// the hosts file is used for the peer names anyway
+	assert.NoError(t, netw.SetMesh(mesh.MachineMap{}, netip.Addr{}, ""))
+	assert.NoError(t, netw.UnSetMesh())
+}
+
+func TestCombined_PeerRoutes(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	meshImpl := &workingMesh{}
+	peerRouter := &recordingRouter{}
+	netw := NewCombined(
+		nil,
+		meshImpl,
+		workingGateway{},
+		&subs.Subject[string]{},
+		workingRouter{},
+		&workingDNS{},
+		&workingIpv6{},
+		&workingFirewall{},
+		workingAllowlistRouting{},
+		workingDeviceList,
+		&workingRoutingSetup{},
+		&workingHostSetter{},
+		workingRouter{},
+		peerRouter,
+		&workingExitNode{},
+		0,
+		false,
+	)
+	subnets := []netip.Prefix{netip.MustParsePrefix("192.168.1.0/24")}
+
+	// remembered until meshnet is set
+	assert.NoError(t, netw.SetPeerRoutes("peer", subnets))
+	assert.Empty(t, meshImpl.peerRoutesKey)
+	assert.Empty(t, peerRouter.routes)
+
+	assert.NoError(t, netw.SetMesh(mesh.MachineMap{}, netip.Addr{}, ""))
+	assert.Equal(t, "peer", meshImpl.peerRoutesKey)
+	assert.Equal(t, subnets, meshImpl.peerRoutes)
+	var routed []netip.Prefix
+	for _, route := range peerRouter.routes {
+		routed = append(routed, route.Subnet)
+	}
+	assert.Equal(t, []netip.Prefix{defaultMeshSubnet, subnets[0]}, routed)
+
+	assert.NoError(t, netw.SetPeerRoutes("", nil))
+	assert.Empty(t, meshImpl.peerRoutes)
+}
+
 func TestCombined_Reconnect(t *testing.T) {
 	category.Set(t, category.Unit)
 
@@ -11,6 +11,12 @@ import (
 
 type FileshareManagementMsg int
 
+const (
+	// fileshareExitTimeout is how long fileshare has to save the transfer state and exit on shutdown
+	fileshareExitTimeout = 5 * time.Second
+	fileshareExitPoll    = 100 * time.Millisecond
+)
+
 const (
 	Start FileshareManagementMsg = iota
 	Stop
@@ -29,6 +35,29 @@ func StartFileshareManagementLoop() (chan<- FileshareManagementMsg, <-chan inter
 	return managementChan, shutdownChan
 }
 
+// StartDisabledFileshareManagementLoop starts the management loop which never starts fileshare. It is used when
+// fileshare is not available, i.e. in the minimal tray mode. Shutdown message still closes the shutdown chan.
+func StartDisabledFileshareManagementLoop() (chan<- FileshareManagementMsg, <-chan interface{}) {
+	managementChan := make(chan FileshareManagementMsg)
+	shutdownChan := make(chan interface{})
+
+	go disabledFileshareManagementLoop(managementChan, shutdownChan)
+
+	return managementChan, shutdownChan
+}
+
+func disabledFileshareManagementLoop(managementChan <-chan FileshareManagementMsg, shutdownChan chan interface{}) {
+	for msg := range managementChan {
+		switch msg {
+		case Start:
+			log.Println(internal.InfoPrefix, "fileshare is disabled, ignoring start request")
+		case Stop:
+		case Shutdown:
+			close(shutdownChan)
+		}
+	}
+}
+
 func fileshareManagementLoop(managementChan <-chan FileshareManagementMsg, shutdownChan chan interface{}) {
 	fileshareProcessManager := fileshare_process.NewFileshareGRPCProcessManager()
 	for msg := range managementChan {
@@ -44,12 +73,30 @@ func fileshareManagementLoop(managementChan <-chan FileshareManagementMsg, shutd
 			log.Println(internal.InfoPrefix, "stopping fileshare")
 			if err := fileshareProcessManager.StopProcess(true); err != nil {
 				log.Println(internal.ErrorPrefix, "failed to stop fileshare on shutdown:", err)
+			} else if !waitForFileshareExit(fileshareProcessManager, fileshareExitTimeout, fileshareExitPoll) {
+				log.Println(internal.WarningPrefix, "fileshare did not stop in time")
 			}
 			close(shutdownChan)
 		}
 	}
 }
 
+// waitForFileshareExit returns true if fileshare stops responding before the timeout. Fileshare stops responding
+// once it has finished its shutdown, so this gives ongoing transfers a chance to be saved.
+func waitForFileshareExit(fileshareProcessManager childprocess.ChildProcessManager,
+	timeout time.Duration,
+	pollInterval time.Duration,
+) bool {
+	deadline := time.Now().Add(timeout)
+	for fileshareProcessManager.ProcessStatus() == childprocess.Running {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(pollInterval)
+	}
+	return true
+}
+
 func startFileshare(fileshareProcessManager *childprocess.GRPCChildProcessManager) bool {
 	result, err := fileshareProcessManager.StartProcess()
 	if err != nil {
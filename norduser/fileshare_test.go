@@ -0,0 +1,56 @@
+package norduser
+
+import (
+	"testing"
+	"time"
+
+	childprocess "github.com/NordSecurity/nordvpn-linux/child_process"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledFileshareManagementLoop(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	managementChan, shutdownChan := StartDisabledFileshareManagementLoop()
+
+	managementChan <- Start
+	managementChan <- Stop
+
+	select {
+	case <-shutdownChan:
+		assert.FailNow(t, "shutdown chan closed before receiving Shutdown message")
+	default:
+	}
+
+	managementChan <- Shutdown
+
+	select {
+	case <-shutdownChan:
+	case <-time.After(time.Second):
+		assert.FailNow(t, "shutdown chan was not closed after receiving Shutdown message")
+	}
+}
+
+type stoppingProcessManager struct {
+	childprocess.NoopChildProcessManager
+	// runningChecks is the number of status checks reporting fileshare as still running
+	runningChecks int
+}
+
+func (m *stoppingProcessManager) ProcessStatus() childprocess.ProcessStatus {
+	if m.runningChecks > 0 {
+		m.runningChecks--
+		return childprocess.Running
+	}
+	return childprocess.NotRunning
+}
+
+func TestWaitForFileshareExit(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.True(t, waitForFileshareExit(&stoppingProcessManager{runningChecks: 3}, time.Second, time.Millisecond))
+	assert.False(t, waitForFileshareExit(&stoppingProcessManager{runningChecks: 1000}, 10*time.Millisecond,
+		time.Millisecond))
+}
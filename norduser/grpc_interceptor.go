@@ -8,7 +8,6 @@ import (
 
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/norduser/service"
-	"golang.org/x/sys/unix"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 )
@@ -16,30 +15,43 @@ import (
 // StartNorduserdMiddleware provides a way to start/stop norduserd when handling nordvpnd gRPCs.
 type StartNorduserdMiddleware struct {
 	norduserd service.Service
+	// canControl reports whether the user is allowed to control the daemon. Users who can only
+	// read the status don't get norduserd started for them.
+	canControl func(uid uint32) bool
+	lookupID   func(uid string) (*user.User, error)
 }
 
-func NewStartNorduserMiddleware(norduserd_service service.Service) StartNorduserdMiddleware {
+func NewStartNorduserMiddleware(
+	norduserd_service service.Service,
+	canControl func(uid uint32) bool,
+) StartNorduserdMiddleware {
 	return StartNorduserdMiddleware{
-		norduserd: norduserd_service,
+		norduserd:  norduserd_service,
+		canControl: canControl,
+		lookupID:   user.LookupId,
 	}
 }
 
 func (n *StartNorduserdMiddleware) middleware(ctx context.Context) {
-	var ucred unix.Ucred
 	peer, ok := peer.FromContext(ctx)
 	if !ok || peer.AuthInfo == nil {
-		log.Println("no peer/auth info found in stream context")
-	} else {
-		var err error
-		ucred, err = internal.StringToUcred(peer.AuthInfo.AuthType())
-		if err != nil {
-			log.Println("failed to convert auth info to user credentials:", err.Error())
-		}
+		log.Println(internal.ErrorPrefix, "no peer/auth info found in stream context")
+		return
+	}
+	ucred, err := internal.StringToUcred(peer.AuthInfo.AuthType())
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "failed to convert auth info to user credentials:", err)
+		return
+	}
+
+	if !n.canControl(ucred.Uid) {
+		return
 	}
 
-	u, err := user.LookupId(strconv.FormatInt(int64(ucred.Uid), 10))
+	u, err := n.lookupID(strconv.FormatUint(uint64(ucred.Uid), 10))
 	if err != nil {
-		log.Println("failed to find user by UID:", err)
+		log.Println(internal.ErrorPrefix, "failed to find user by UID:", err)
+		return
 	}
 	if err := n.norduserd.Enable(ucred.Uid, ucred.Gid, u.HomeDir); err != nil {
 		log.Println("failed to enable norduserd:", err)
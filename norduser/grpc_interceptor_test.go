@@ -0,0 +1,80 @@
+package norduser
+
+import (
+	"context"
+	"errors"
+	"os/user"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+type mockNorduserService struct {
+	enabled []uint32
+}
+
+func (m *mockNorduserService) Enable(uid uint32, _ uint32, _ string) error {
+	m.enabled = append(m.enabled, uid)
+	return nil
+}
+
+func (m *mockNorduserService) Stop(uint32, bool) error { return nil }
+func (m *mockNorduserService) StopAll()                {}
+func (m *mockNorduserService) Restart(uint32) error    { return nil }
+
+func TestStartNorduserdMiddleware(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	const (
+		controlUID = 1000
+		statusUID  = 1001
+		unknownUID = 1002
+	)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		enabled []uint32
+	}{
+		{
+			name:    "control user",
+			ctx:     peer.NewContext(context.Background(), &peer.Peer{AuthInfo: internal.UcredAuth{Uid: controlUID}}),
+			enabled: []uint32{controlUID},
+		},
+		{
+			name: "status only user",
+			ctx:  peer.NewContext(context.Background(), &peer.Peer{AuthInfo: internal.UcredAuth{Uid: statusUID}}),
+		},
+		{
+			name: "user lookup fails",
+			ctx:  peer.NewContext(context.Background(), &peer.Peer{AuthInfo: internal.UcredAuth{Uid: unknownUID}}),
+		},
+		{
+			name: "no peer info",
+			ctx:  context.Background(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			service := &mockNorduserService{}
+			middleware := NewStartNorduserMiddleware(service, func(uid uint32) bool {
+				return uid != statusUID
+			})
+			middleware.lookupID = func(uid string) (*user.User, error) {
+				if uid == "1002" {
+					return nil, errors.New("unknown user")
+				}
+				return &user.User{Uid: uid, HomeDir: "/home/user"}, nil
+			}
+
+			_, err := middleware.UnaryMiddleware(test.ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/pb.Daemon/Status"})
+			assert.NoError(t, err)
+			assert.Equal(t, test.enabled, service.enabled)
+		})
+	}
+}
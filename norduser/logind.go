@@ -0,0 +1,163 @@
+package norduser
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/godbus/dbus/v5"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const (
+	logindDest          = "org.freedesktop.login1"
+	logindPath          = "/org/freedesktop/login1"
+	logindManagerIface  = "org.freedesktop.login1.Manager"
+	logindSessionIface  = "org.freedesktop.login1.Session"
+	dbusPropertiesIface = "org.freedesktop.DBus.Properties"
+)
+
+// loginSession describes a single logind session of the user
+type loginSession struct {
+	id       string
+	username string
+	uid      uint32
+	// sessionType is one of x11, wayland, mir, tty or unspecified
+	sessionType string
+	// class is one of user, greeter, lock-screen, background or manager
+	class string
+	// state is one of online, active or closing
+	state string
+}
+
+// graphical reports whether the session runs a graphical desktop
+func (s loginSession) graphical() bool {
+	switch s.sessionType {
+	case "x11", "wayland", "mir":
+		return true
+	}
+	return false
+}
+
+// counted reports whether the session keeps norduserd running. Sessions of display managers and lock
+// screens are not owned by the user, and closing sessions are left behind by processes which survived
+// the logout.
+func (s loginSession) counted() bool {
+	return s.class == "user" && s.state != "closing"
+}
+
+// activeUsersFromSessions returns a map of [username]userType. User type is gui if any of the users sessions is
+// graphical, text otherwise. Users are active as long as at least one of their sessions is.
+func activeUsersFromSessions(sessions []loginSession) userData {
+	users := make(userData)
+	for _, session := range sessions {
+		if !session.counted() {
+			continue
+		}
+
+		if session.graphical() {
+			users[session.username] = loginGUI
+		} else if _, ok := users[session.username]; !ok {
+			users[session.username] = loginText
+		}
+	}
+	return users
+}
+
+// logind lists user sessions using systemd-logind D-Bus API. Unlike utmp, it also knows about graphical sessions
+// which are not registered in utmp and about sessions which are closing.
+type logind struct {
+	conn *dbus.Conn
+}
+
+// newLogind connects to logind. Error is returned if logind is not running.
+func newLogind() (*logind, error) {
+	// shared system bus connection is closed by other users of it, so a private one is used
+	conn, err := dbus.ConnectSystemBus()
+	if err != nil {
+		return nil, fmt.Errorf("connecting to system dbus: %w", err)
+	}
+
+	l := &logind{conn: conn}
+	if _, err := l.listSessions(); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("listing logind sessions: %w", err)
+	}
+	return l, nil
+}
+
+func (l *logind) listSessions() ([]loginSession, error) {
+	var entries []struct {
+		ID   string
+		UID  uint32
+		User string
+		Seat string
+		Path dbus.ObjectPath
+	}
+	err := l.conn.Object(logindDest, logindPath).Call(logindManagerIface+".ListSessions", 0).Store(&entries)
+	if err != nil {
+		return nil, fmt.Errorf("calling ListSessions: %w", err)
+	}
+
+	sessions := make([]loginSession, 0, len(entries))
+	for _, entry := range entries {
+		var properties map[string]dbus.Variant
+		err := l.conn.Object(logindDest, entry.Path).
+			Call(dbusPropertiesIface+".GetAll", 0, logindSessionIface).Store(&properties)
+		if err != nil {
+			// session could have been removed after it was listed
+			log.Println(internal.WarningPrefix, "getting properties of session", entry.ID, ":", err)
+			continue
+		}
+
+		session := loginSession{id: entry.ID, username: entry.User, uid: entry.UID}
+		_ = properties["Type"].Store(&session.sessionType)
+		_ = properties["Class"].Store(&session.class)
+		_ = properties["State"].Store(&session.state)
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+func (l *logind) activeUsers() (userData, error) {
+	sessions, err := l.listSessions()
+	if err != nil {
+		return userData{}, err
+	}
+	log.Printf("%s %d logind sessions found", internal.DebugPrefix, len(sessions))
+	return activeUsersFromSessions(sessions), nil
+}
+
+// watchSessions subscribes to session creation, removal and state changes. Returned channel receives a signal for
+// each of those events.
+func (l *logind) watchSessions() (<-chan *dbus.Signal, error) {
+	if err := l.conn.AddMatchSignal(
+		dbus.WithMatchInterface(logindManagerIface),
+		dbus.WithMatchObjectPath(logindPath),
+	); err != nil {
+		return nil, fmt.Errorf("subscribing to logind manager signals: %w", err)
+	}
+	if err := l.conn.AddMatchSignal(
+		dbus.WithMatchInterface(dbusPropertiesIface),
+		dbus.WithMatchMember("PropertiesChanged"),
+		dbus.WithMatchPathNamespace(logindPath+"/session"),
+		dbus.WithMatchArg(0, logindSessionIface),
+	); err != nil {
+		return nil, fmt.Errorf("subscribing to logind session signals: %w", err)
+	}
+
+	signals := make(chan *dbus.Signal, 10)
+	l.conn.Signal(signals)
+	return signals, nil
+}
+
+// isSessionSignal reports whether the signal changes the set of sessions or their state
+func isSessionSignal(signal *dbus.Signal) bool {
+	switch signal.Name {
+	case logindManagerIface + ".SessionNew",
+		logindManagerIface + ".SessionRemoved",
+		dbusPropertiesIface + ".PropertiesChanged":
+		return true
+	}
+	return false
+}
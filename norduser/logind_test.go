@@ -0,0 +1,106 @@
+package norduser
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	testnorduser "github.com/NordSecurity/nordvpn-linux/test/mock/norduser/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_activeUsersFromSessions(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		sessions []loginSession
+		expected userData
+	}{
+		{
+			name:     "no sessions",
+			expected: userData{},
+		},
+		{
+			name: "ssh and desktop sessions of the same user",
+			sessions: []loginSession{
+				{id: "3", username: "user", sessionType: "tty", class: "user", state: "active"},
+				{id: "2", username: "user", sessionType: "wayland", class: "user", state: "active"},
+			},
+			expected: userData{"user": loginGUI},
+		},
+		{
+			name: "desktop session closed while ssh session remains",
+			sessions: []loginSession{
+				{id: "2", username: "user", sessionType: "wayland", class: "user", state: "closing"},
+				{id: "3", username: "user", sessionType: "tty", class: "user", state: "online"},
+			},
+			expected: userData{"user": loginText},
+		},
+		{
+			name: "multiple graphical sessions",
+			sessions: []loginSession{
+				{id: "2", username: "user", sessionType: "x11", class: "user", state: "online"},
+				{id: "5", username: "user", sessionType: "wayland", class: "user", state: "active"},
+			},
+			expected: userData{"user": loginGUI},
+		},
+		{
+			name: "only closing and greeter sessions",
+			sessions: []loginSession{
+				{id: "c1", username: "gdm", sessionType: "wayland", class: "greeter", state: "active"},
+				{id: "2", username: "user", sessionType: "x11", class: "user", state: "closing"},
+			},
+			expected: userData{},
+		},
+		{
+			name: "multiple users",
+			sessions: []loginSession{
+				{id: "2", username: "user1", sessionType: "x11", class: "user", state: "active"},
+				{id: "3", username: "user2", sessionType: "unspecified", class: "user", state: "online"},
+			},
+			expected: userData{"user1": loginGUI, "user2": loginText},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, activeUsersFromSessions(test.sessions))
+		})
+	}
+}
+
+func Test_handleSessionsUpdate_StopsAfterLastSession(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	const username = "user"
+	norduserd := testnorduser.NewMockNorduserCombinedService()
+	sessions := []loginSession{
+		{id: "2", username: username, sessionType: "wayland", class: "user", state: "active"},
+		{id: "3", username: username, sessionType: "tty", class: "user", state: "active"},
+	}
+	monitor := NorduserProcessMonitor{
+		norduserd:    &norduserd,
+		userIDGetter: newUserIDGetterMock(map[string]userIDs{username: {uid: 1000}}),
+		activeUsers:  func() (userData, error) { return activeUsersFromSessions(sessions), nil },
+	}
+
+	members, err := monitor.handleSessionsUpdate(userSet{username: notActive})
+	assert.NoError(t, err)
+	assert.Equal(t, runningGUI, members[username])
+	assert.Equal(t, []uint32{1000}, norduserd.ActionToUIDs[testnorduser.Enable])
+
+	// ssh session ended, desktop session remains
+	sessions = sessions[:1]
+	members, err = monitor.handleSessionsUpdate(members)
+	assert.NoError(t, err)
+	assert.Equal(t, runningGUI, members[username])
+	assert.Empty(t, norduserd.ActionToUIDs[testnorduser.Stop])
+	assert.Empty(t, norduserd.ActionToUIDs[testnorduser.Restart])
+
+	// last session is closing
+	sessions[0].state = "closing"
+	members, err = monitor.handleSessionsUpdate(members)
+	assert.NoError(t, err)
+	assert.Equal(t, notActive, members[username])
+	assert.Equal(t, []uint32{1000}, norduserd.ActionToUIDs[testnorduser.Stop])
+}
@@ -23,12 +23,16 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type NorduserClient interface {
 	Ping(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	// Health reports details of the running norduser process
+	Health(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HealthResponse, error)
 	// StartsFileshare starts fileshare process
 	StartFileshare(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 	// StopFileshare stops fileshare process
 	StopFileshare(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 	// Stop stops norduser process
 	Stop(ctx context.Context, in *StopNorduserRequest, opts ...grpc.CallOption) (*Empty, error)
+	// SetLogLevel changes the log level of norduser process and of the processes it starts
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*Empty, error)
 }
 
 type norduserClient struct {
@@ -48,6 +52,15 @@ func (c *norduserClient) Ping(ctx context.Context, in *Empty, opts ...grpc.CallO
 	return out, nil
 }
 
+func (c *norduserClient) Health(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	err := c.cc.Invoke(ctx, "/norduserpb.Norduser/Health", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *norduserClient) StartFileshare(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
 	out := new(Empty)
 	err := c.cc.Invoke(ctx, "/norduserpb.Norduser/StartFileshare", in, out, opts...)
@@ -75,17 +88,30 @@ func (c *norduserClient) Stop(ctx context.Context, in *StopNorduserRequest, opts
 	return out, nil
 }
 
+func (c *norduserClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/norduserpb.Norduser/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NorduserServer is the server API for Norduser service.
 // All implementations must embed UnimplementedNorduserServer
 // for forward compatibility
 type NorduserServer interface {
 	Ping(context.Context, *Empty) (*Empty, error)
+	// Health reports details of the running norduser process
+	Health(context.Context, *Empty) (*HealthResponse, error)
 	// StartsFileshare starts fileshare process
 	StartFileshare(context.Context, *Empty) (*Empty, error)
 	// StopFileshare stops fileshare process
 	StopFileshare(context.Context, *Empty) (*Empty, error)
 	// Stop stops norduser process
 	Stop(context.Context, *StopNorduserRequest) (*Empty, error)
+	// SetLogLevel changes the log level of norduser process and of the processes it starts
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*Empty, error)
 	mustEmbedUnimplementedNorduserServer()
 }
 
@@ -96,6 +122,9 @@ type UnimplementedNorduserServer struct {
 func (UnimplementedNorduserServer) Ping(context.Context, *Empty) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
 }
+func (UnimplementedNorduserServer) Health(context.Context, *Empty) (*HealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
 func (UnimplementedNorduserServer) StartFileshare(context.Context, *Empty) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartFileshare not implemented")
 }
@@ -105,6 +134,9 @@ func (UnimplementedNorduserServer) StopFileshare(context.Context, *Empty) (*Empt
 func (UnimplementedNorduserServer) Stop(context.Context, *StopNorduserRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stop not implemented")
 }
+func (UnimplementedNorduserServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
 func (UnimplementedNorduserServer) mustEmbedUnimplementedNorduserServer() {}
 
 // UnsafeNorduserServer may be embedded to opt out of forward compatibility for this service.
@@ -136,6 +168,24 @@ func _Norduser_Ping_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Norduser_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NorduserServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/norduserpb.Norduser/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NorduserServer).Health(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Norduser_StartFileshare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Empty)
 	if err := dec(in); err != nil {
@@ -190,6 +240,24 @@ func _Norduser_Stop_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Norduser_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NorduserServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/norduserpb.Norduser/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NorduserServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Norduser_ServiceDesc is the grpc.ServiceDesc for Norduser service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +269,10 @@ var Norduser_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Ping",
 			Handler:    _Norduser_Ping_Handler,
 		},
+		{
+			MethodName: "Health",
+			Handler:    _Norduser_Health_Handler,
+		},
 		{
 			MethodName: "StartFileshare",
 			Handler:    _Norduser_StartFileshare_Handler,
@@ -213,6 +285,10 @@ var Norduser_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Stop",
 			Handler:    _Norduser_Stop_Handler,
 		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _Norduser_SetLogLevel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
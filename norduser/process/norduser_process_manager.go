@@ -15,6 +15,10 @@ import (
 	"github.com/NordSecurity/nordvpn-linux/snapconf"
 )
 
+// ProtocolVersion is the version of the gRPC protocol between nordvpnd and norduserd. It has to be increased
+// whenever the daemon starts relying on changes to the norduser service.
+const ProtocolVersion = 1
+
 type NorduserProcessClient struct {
 	uid uint32
 }
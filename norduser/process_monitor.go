@@ -6,6 +6,7 @@ import (
 	"slices"
 
 	"github.com/fsnotify/fsnotify"
+	"github.com/godbus/dbus/v5"
 
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/norduser/service"
@@ -111,14 +112,28 @@ type NorduserProcessMonitor struct {
 	norduserd service.Service
 	isSnap    bool
 	userIDGetter
+	// logind is used to track user sessions, nil if logind is not available
+	logind *logind
+	// activeUsers returns users with at least one active session
+	activeUsers func() (userData, error)
 }
 
 func NewNorduserProcessMonitor(service service.Service) NorduserProcessMonitor {
-	return NorduserProcessMonitor{
+	monitor := NorduserProcessMonitor{
 		norduserd:    service,
 		isSnap:       snapconf.IsUnderSnap(),
 		userIDGetter: osGetter{},
+		activeUsers:  getActiveUsers,
 	}
+
+	if logind, err := newLogind(); err == nil {
+		monitor.logind = logind
+		monitor.activeUsers = logind.activeUsers
+	} else {
+		log.Println(internal.WarningPrefix, "logind is not available, falling back to utmp to track sessions:", err)
+	}
+
+	return monitor
 }
 
 func (n *NorduserProcessMonitor) handleGroupFileUpdate(currentGroupMembers userSet) (userSet, error) {
@@ -127,7 +142,7 @@ func (n *NorduserProcessMonitor) handleGroupFileUpdate(currentGroupMembers userS
 		return currentGroupMembers, fmt.Errorf("getting nordvpn group members: %w", err)
 	}
 
-	activeUsers, err := getActiveUsers()
+	activeUsers, err := n.activeUsers()
 	if err != nil {
 		return currentGroupMembers, fmt.Errorf("getting active users after group file update: %w", err)
 	}
@@ -158,10 +173,12 @@ func (n *NorduserProcessMonitor) handleGroupFileUpdate(currentGroupMembers userS
 	return currentGroupMembers, nil
 }
 
-func (n *NorduserProcessMonitor) handleUTMPFileUpdate(currentGroupMembers userSet) (userSet, error) {
-	activeUsers, err := getActiveUsers()
+// handleSessionsUpdate updates norduserd state of every group member after sessions have changed. norduserd is
+// stopped only when the last session of the user ends.
+func (n *NorduserProcessMonitor) handleSessionsUpdate(currentGroupMembers userSet) (userSet, error) {
+	activeUsers, err := n.activeUsers()
 	if err != nil {
-		return currentGroupMembers, fmt.Errorf("getting active users after utmp file update: %w", err)
+		return currentGroupMembers, fmt.Errorf("getting active users after sessions update: %w", err)
 	}
 
 	for username, state := range currentGroupMembers {
@@ -207,6 +224,14 @@ func (n *NorduserProcessMonitor) Start() error {
 	}
 	defer watcher.Close()
 
+	// nil channel blocks forever, so session signals are ignored when logind is not available
+	var sessionSignals <-chan *dbus.Signal
+	if n.logind != nil {
+		if sessionSignals, err = n.logind.watchSessions(); err != nil {
+			log.Println(internal.WarningPrefix, "failed to watch logind sessions, relying on utmp updates:", err)
+		}
+	}
+
 	currentGrupMembers, err := n.handleGroupFileUpdate(make(userSet))
 	if err != nil {
 		return fmt.Errorf("starting norduserd for the initial group members: %w", err)
@@ -230,12 +255,24 @@ func (n *NorduserProcessMonitor) Start() error {
 					}
 				}
 			} else if event.Name == utmpFilePath {
-				if newGroupMembers, err := n.handleUTMPFileUpdate(currentGrupMembers); err != nil {
+				if newGroupMembers, err := n.handleSessionsUpdate(currentGrupMembers); err != nil {
 					log.Println(internal.ErrorPrefix, "failed to handle change of utmp file:", err)
 				} else {
 					currentGrupMembers = newGroupMembers
 				}
 			}
+		case signal, ok := <-sessionSignals:
+			if !ok {
+				return fmt.Errorf("logind signal channel closed")
+			}
+			if !isSessionSignal(signal) {
+				continue
+			}
+			if newGroupMembers, err := n.handleSessionsUpdate(currentGrupMembers); err != nil {
+				log.Println(internal.ErrorPrefix, "failed to handle change of logind sessions:", err)
+			} else {
+				currentGrupMembers = newGroupMembers
+			}
 		case err, ok := <-watcher.Errors:
 			if !ok {
 				return fmt.Errorf("groupfile monitor error channel closed")
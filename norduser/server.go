@@ -2,8 +2,12 @@ package norduser
 
 import (
 	"context"
+	"os"
+	"time"
 
+	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/norduser/pb"
+	"github.com/NordSecurity/nordvpn-linux/norduser/process"
 )
 
 type StopRequest struct {
@@ -15,12 +19,23 @@ type Server struct {
 	pb.UnimplementedNorduserServer
 	fileshareManagementChan chan<- FileshareManagementMsg
 	stopChan                chan<- StopRequest
+	version                 string
+	startedAt               time.Time
+	setLogLevel             func(internal.LogLevel)
 }
 
-func NewServer(fileshareManagementChan chan<- FileshareManagementMsg, stopChan chan<- StopRequest) *Server {
+func NewServer(
+	fileshareManagementChan chan<- FileshareManagementMsg,
+	stopChan chan<- StopRequest,
+	version string,
+	setLogLevel func(internal.LogLevel),
+) *Server {
 	return &Server{
 		fileshareManagementChan: fileshareManagementChan,
 		stopChan:                stopChan,
+		version:                 version,
+		startedAt:               time.Now(),
+		setLogLevel:             setLogLevel,
 	}
 }
 
@@ -28,6 +43,15 @@ func (s *Server) Ping(context.Context, *pb.Empty) (*pb.Empty, error) {
 	return &pb.Empty{}, nil
 }
 
+func (s *Server) Health(context.Context, *pb.Empty) (*pb.HealthResponse, error) {
+	return &pb.HealthResponse{
+		Version:         s.version,
+		Pid:             uint32(os.Getpid()),
+		Uptime:          int64(time.Since(s.startedAt)),
+		ProtocolVersion: process.ProtocolVersion,
+	}, nil
+}
+
 func (s *Server) StartFileshare(context.Context, *pb.Empty) (*pb.Empty, error) {
 	s.fileshareManagementChan <- Start
 
@@ -47,3 +71,12 @@ func (s *Server) Stop(_ context.Context, req *pb.StopNorduserRequest) (*pb.Empty
 	}
 	return &pb.Empty{}, nil
 }
+
+func (s *Server) SetLogLevel(_ context.Context, req *pb.SetLogLevelRequest) (*pb.Empty, error) {
+	level, err := internal.ParseLogLevel(req.GetLevel())
+	if err != nil {
+		return nil, err
+	}
+	s.setLogLevel(level)
+	return &pb.Empty{}, nil
+}
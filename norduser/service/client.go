@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/norduser/pb"
@@ -15,6 +16,17 @@ type NorduserFileshareClient interface {
 	StopFileshare(uid uint32) error
 }
 
+// NorduserLogLevelClient changes the log level of running norduserd instances
+type NorduserLogLevelClient interface {
+	SetLogLevel(uid uint32, level internal.LogLevel) error
+}
+
+// NorduserClient controls the running norduserd instances
+type NorduserClient interface {
+	NorduserFileshareClient
+	NorduserLogLevelClient
+}
+
 type NorduserGRPCClient struct {
 }
 
@@ -64,3 +76,53 @@ func (n NorduserGRPCClient) StopFileshare(uid uint32) error {
 
 	return nil
 }
+
+// healthCheckTimeout limits how long an unresponsive norduserd can block the health check
+const healthCheckTimeout = 3 * time.Second
+
+// Health queries the norduserd of the given user for its version and uptime
+func (n NorduserGRPCClient) Health(uid uint32) (*pb.HealthResponse, error) {
+	clientConn, err := process.GetNorduserClientConnection(int(uid))
+	if err != nil {
+		return nil, fmt.Errorf("connecting to norduser client: %w", err)
+	}
+
+	defer func() {
+		if err := clientConn.Close(); err != nil {
+			log.Println(internal.ErrorPrefix, "failed to close client connection to nord user: ", err)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), healthCheckTimeout)
+	defer cancel()
+
+	client := pb.NewNorduserClient(clientConn)
+	resp, err := client.Health(ctx, &pb.Empty{})
+	if err != nil {
+		return nil, fmt.Errorf("checking norduser health: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SetLogLevel changes the log level of norduserd of the given user
+func (n NorduserGRPCClient) SetLogLevel(uid uint32, level internal.LogLevel) error {
+	clientConn, err := process.GetNorduserClientConnection(int(uid))
+	if err != nil {
+		return fmt.Errorf("connecting to norduser client: %w", err)
+	}
+
+	defer func() {
+		if err := clientConn.Close(); err != nil {
+			log.Println(internal.ErrorPrefix, "failed to close client connection to nord user: ", err)
+		}
+	}()
+
+	client := pb.NewNorduserClient(clientConn)
+	_, err = client.SetLogLevel(context.Background(), &pb.SetLogLevelRequest{Level: string(level)})
+	if err != nil {
+		return fmt.Errorf("setting norduser log level: %w", err)
+	}
+
+	return nil
+}
@@ -3,12 +3,8 @@ package service
 import (
 	"errors"
 	"fmt"
-	"io"
 	"log"
-	"os"
 	"os/exec"
-	"strconv"
-	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -19,93 +15,76 @@ import (
 // ErrNotStarted when disabling norduser
 var ErrNotStarted = errors.New("norduserd wasn't started")
 
-// ChildProcessNorduser manages norduser service through exec.Command
+// ChildProcessNorduser manages norduser service through exec.Command. Crashed processes are restarted with
+// exponential backoff.
 type ChildProcessNorduser struct {
-	mu sync.Mutex
-	wg sync.WaitGroup
+	mu        sync.Mutex
+	wg        sync.WaitGroup
+	processes map[uint32]*supervisedProcess
+	// startProcess starts norduserd for the user
+	startProcess func(uid uint32, gid uint32, home string) (waiter, error)
+	// runningPID returns pid of norduserd running for the user or -1
+	runningPID func(uid uint32) (int, error)
+	now        func() time.Time
+	afterFunc  func(time.Duration, func()) *time.Timer
+	shutdown   shutdownCascade
 }
 
-func NewChildProcessNorduser() *ChildProcessNorduser {
-	return &ChildProcessNorduser{}
+func NewChildProcessNorduser(limits ResourceLimits) *ChildProcessNorduser {
+	return &ChildProcessNorduser{
+		processes: map[uint32]*supervisedProcess{},
+		startProcess: func(uid uint32, gid uint32, home string) (waiter, error) {
+			return startNorduserProcess(uid, gid, home, limits)
+		},
+		runningPID: getPIDForNorduserUID,
+		now:        time.Now,
+		afterFunc:  time.AfterFunc,
+		shutdown:   newShutdownCascade(),
+	}
 }
 
-// handlePsError returns nil if err is nil or if there is no output. It returns unmodified err in any other
-// case.
-func handlePsError(out []byte, err error) error {
-	if err == nil {
-		return nil
+func getPIDForNorduserUID(uid uint32) (int, error) {
+	processes, err := findNorduserProcesses(procDir)
+	if err != nil {
+		return -1, fmt.Errorf("listing norduserd processes: %w", err)
 	}
 
-	var exiterr *exec.ExitError
-	if errors.As(err, &exiterr) {
-		// ps returns error when no processes are shown. We do not treat such cases as errors.
-		if len(out) == 0 {
-			return nil
+	for _, process := range processes {
+		if process.uid == uid {
+			return process.pid, nil
 		}
 	}
-
-	return err
+	return -1, nil
 }
 
-func parseNorduserPIDs(psOutput string) []int {
-	pids := []int{}
-	for _, pidStr := range strings.Split(psOutput, "\n") {
-		pidStr = strings.TrimSpace(pidStr)
-		if pidStr == "" {
-			continue
-		}
-		pid, err := strconv.Atoi(pidStr)
-		if err != nil {
-			log.Println(internal.ErrorPrefix, "failed to parse pid string:", pidStr, "; err:", err)
-			continue
-		}
-
-		pids = append(pids, pid)
+func startNorduserProcess(uid uint32, gid uint32, home string, limits ResourceLimits) (waiter, error) {
+	nordvpnGid, err := internal.GetNordvpnGid()
+	if err != nil {
+		return nil, fmt.Errorf("determining nordvpn gid: %w", err)
 	}
 
-	return pids
-}
-
-func getRunningNorduserPIDs() ([]int, error) {
-	// #nosec G204 -- arguments are constant
-	output, err := exec.Command("ps", "-C", internal.Norduserd, "-o", "pid=").CombinedOutput()
-	if err := handlePsError(output, err); err != nil {
-		return []int{}, fmt.Errorf("listing norduserd pids: %w", err)
+	// #nosec G204 -- no input comes from user
+	cmd := exec.Command(internal.NorduserdBinaryPath)
+	credential := &syscall.Credential{
+		Uid:    uid,
+		Gid:    gid,
+		Groups: []uint32{uint32(nordvpnGid)},
 	}
+	cmd.SysProcAttr = &syscall.SysProcAttr{Credential: credential}
+	// os.UserHomeDir always returns value of $HOME and spawning child process copies
+	// environment variables from a parent process, therefore value of $HOME will be root home
+	// dir, where user usually does not have access.
+	cmd.Env = append(cmd.Env, "HOME="+home)
 
-	return parseNorduserPIDs(string(output)), nil
-}
-
-func findPIDOfUID(uids string, desiredUID uint32) int {
-	for _, uidPid := range strings.Split(uids, "\n") {
-		var pid int
-		var uid int
-		n, err := fmt.Sscanf(uidPid, "%d%d", &uid, &pid)
-		if errors.Is(err, io.EOF) {
-			continue
-		}
-		if err != nil {
-			log.Println(internal.ErrorPrefix, "failed to parse uid pid line:", uidPid, "; err:", err)
-			continue
-		}
-		if n != 2 {
-			log.Println(internal.ErrorPrefix, "invalid input line, expected <uid> <pid> format:", uidPid)
-		}
-		if uid == int(desiredUID) {
-			return pid
-		}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("starting the process: %w", err)
 	}
 
-	return -1
-}
-
-func getPIDForNorduserUID(uid uint32) (int, error) {
-	// #nosec G204 -- arguments are constant
-	output, err := exec.Command("ps", "-C", internal.Norduserd, "-o", "uid=", "-o", "pid=").CombinedOutput()
-	if err := handlePsError(output, err); err != nil {
-		return -1, fmt.Errorf("listing norduser uids/pids: %w", err)
+	if err := limits.apply(cmd.Process.Pid); err != nil {
+		log.Println(internal.WarningPrefix, "failed to apply resource limits to norduserd:", err)
 	}
-	return findPIDOfUID(string(output), uid), nil
+
+	return cmd, nil
 }
 
 // Enable starts norduser process
@@ -113,7 +92,7 @@ func (c *ChildProcessNorduser) Enable(uid uint32, gid uint32, home string) (err
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	pid, err := getPIDForNorduserUID(uid)
+	pid, err := c.runningPID(uid)
 	if err != nil {
 		return fmt.Errorf("failed to determine if the process is already running: %w", err)
 	}
@@ -122,43 +101,95 @@ func (c *ChildProcessNorduser) Enable(uid uint32, gid uint32, home string) (err
 		return nil
 	}
 
-	nordvpnGid, err := internal.GetNordvpnGid()
-	if err != nil {
-		return fmt.Errorf("determining nordvpn gid: %w", err)
-	}
-
-	// #nosec G204 -- no input comes from user
-	cmd := exec.Command(internal.NorduserdBinaryPath)
-	credential := &syscall.Credential{
-		Uid:    uid,
-		Gid:    gid,
-		Groups: []uint32{uint32(nordvpnGid)},
+	if previous, ok := c.processes[uid]; ok {
+		previous.stop()
 	}
-	cmd.SysProcAttr = &syscall.SysProcAttr{Credential: credential}
-	// os.UserHomeDir always returns value of $HOME and spawning child process copies
-	// environment variables from a parent process, therefore value of $HOME will be root home
-	// dir, where user usually does not have access.
-	cmd.Env = append(cmd.Env, "HOME="+home)
+	process := &supervisedProcess{gid: gid, home: home}
+	c.processes[uid] = process
+	return c.start(uid, process)
+}
 
-	if err := cmd.Start(); err != nil {
-		return fmt.Errorf("starting the process: %w", err)
+// start runs norduserd and restarts it if it crashes. Not thread safe, lock mu before using
+func (c *ChildProcessNorduser) start(uid uint32, process *supervisedProcess) error {
+	cmd, err := c.startProcess(uid, process.gid, process.home)
+	if err != nil {
+		process.health = HealthFailed
+		return err
 	}
+	process.health = HealthRunning
 
 	c.wg.Add(1)
 	go func() {
-		cmd.Wait()
-		c.wg.Done()
+		defer c.wg.Done()
+		err := cmd.Wait()
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.processes[uid] != process || process.stopRequested {
+			return
+		}
+		if !isCrash(err) {
+			process.health = HealthStopped
+			return
+		}
+		c.scheduleRestart(uid, process, err)
 	}()
 
 	return nil
 }
 
-// Stop teminates norduser process
-func (c *ChildProcessNorduser) Stop(uid uint32, wait bool) error {
+// scheduleRestart restarts crashed norduserd after the backoff unless it crashes too often. Not thread safe,
+// lock mu before using
+func (c *ChildProcessNorduser) scheduleRestart(uid uint32, process *supervisedProcess, crashErr error) {
+	process.recordCrash(c.now())
+	if len(process.crashes) > maxCrashes {
+		process.health = HealthFailed
+		log.Println(internal.ErrorPrefix, "norduserd of uid", uid, "keeps crashing, not restarting it anymore:",
+			crashErr)
+		return
+	}
+
+	delay := restartDelay(len(process.crashes))
+	log.Println(internal.WarningPrefix, "norduserd of uid", uid, "crashed:", crashErr, "; restarting in", delay)
+	process.health = HealthRestarting
+	process.restartTimer = c.afterFunc(delay, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.processes[uid] != process || process.stopRequested {
+			return
+		}
+		// it could have been started in the meantime, e.g. by the user
+		if pid, err := c.runningPID(uid); err == nil && pid != -1 {
+			process.health = HealthRunning
+			return
+		}
+		if err := c.start(uid, process); err != nil {
+			c.scheduleRestart(uid, process, err)
+		}
+	})
+}
+
+// Health returns health of norduserd started for the user
+func (c *ChildProcessNorduser) Health(uid uint32) Health {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	pid, err := getPIDForNorduserUID(uid)
+	if process, ok := c.processes[uid]; ok {
+		return process.health
+	}
+	return HealthUnknown
+}
+
+// Stop asks norduser process to shut down and terminates it if it doesn't exit in time
+func (c *ChildProcessNorduser) Stop(uid uint32, wait bool) error {
+	c.mu.Lock()
+	if process, ok := c.processes[uid]; ok {
+		process.stop()
+	}
+	pid, err := c.runningPID(uid)
+	// supervising goroutine needs the lock to finish once the process exits
+	c.mu.Unlock()
+
 	if err != nil {
 		return fmt.Errorf("looking up norduserd pid: %w", err)
 	}
@@ -167,39 +198,45 @@ func (c *ChildProcessNorduser) Stop(uid uint32, wait bool) error {
 		return nil
 	}
 
-	if err := syscall.Kill(pid, syscall.SIGTERM); err != nil {
-		if errno, ok := err.(syscall.Errno); ok {
-			if errno == syscall.ESRCH {
-				return nil
-			}
-		}
-		return fmt.Errorf("sending SIGTERM to norduserd: %w", err)
-	}
-
 	if wait {
-		proc, err := os.FindProcess(pid)
-		if err == nil {
-			_, _ = proc.Wait()
-		}
+		return c.shutdown.stop(uid, pid)
 	}
 
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		if err := c.shutdown.stop(uid, pid); err != nil {
+			log.Println(internal.ErrorPrefix, "failed to stop norduserd of uid", uid, ":", err)
+		}
+	}()
 	return nil
 }
 
 func (c *ChildProcessNorduser) StopAll() {
 	c.mu.Lock()
-	defer c.mu.Unlock()
+	for _, process := range c.processes {
+		process.stop()
+	}
+	// supervising goroutines need the lock to finish
+	c.mu.Unlock()
 
-	pids, err := getRunningNorduserPIDs()
+	processes, err := findNorduserProcesses(procDir)
 	if err != nil {
+		log.Println(internal.ErrorPrefix, "failed to list running norduserd instances:", err)
 		return
 	}
 
-	for _, pid := range pids {
-		if err := syscall.Kill(pid, syscall.SIGTERM); err != nil {
-			log.Println(internal.ErrorPrefix, "failed to send a signal to norduserd:", err)
-		}
+	var wg sync.WaitGroup
+	for _, norduser := range processes {
+		wg.Add(1)
+		go func(norduser norduserProcess) {
+			defer wg.Done()
+			if err := c.shutdown.stop(norduser.uid, norduser.pid); err != nil {
+				log.Println(internal.ErrorPrefix, "failed to stop norduserd of uid", norduser.uid, ":", err)
+			}
+		}(norduser)
 	}
+	wg.Wait()
 
 	doneChan := make(chan interface{})
 	go func() {
@@ -217,7 +254,7 @@ func (c *ChildProcessNorduser) Restart(uid uint32) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	pid, err := getPIDForNorduserUID(uid)
+	pid, err := c.runningPID(uid)
 	if err != nil {
 		return fmt.Errorf("looking up norduserd pid: %w", err)
 	}
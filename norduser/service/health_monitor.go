@@ -0,0 +1,216 @@
+package service
+
+import (
+	"cmp"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/norduser/pb"
+	"github.com/NordSecurity/nordvpn-linux/norduser/process"
+
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// UserStatus is the result of the latest norduserd health check for a single user
+type UserStatus struct {
+	UID uint32
+	// Running is true if a norduserd process was found for the user
+	Running bool
+	// SocketReachable is true if norduserd responded to the health RPC
+	SocketReachable bool
+	Version         string
+	Uptime          time.Duration
+	// Outdated is true if norduserd speaks an older protocol than the daemon and has to be restarted
+	Outdated  bool
+	CheckedAt time.Time
+	// Err holds the reason why the socket was not reachable
+	Err error
+}
+
+// broken reports whether norduserd is running but does not respond
+func (s UserStatus) broken() bool {
+	return s.Running && !s.SocketReachable
+}
+
+// HealthCheckInterval defines how often norduserd instances should be checked. Results older than that
+// are refreshed on demand.
+const HealthCheckInterval = 30 * time.Second
+
+type healthChecker interface {
+	Health(uid uint32) (*pb.HealthResponse, error)
+}
+
+// HealthMonitor periodically checks every running norduserd instance and keeps the latest result for each
+// user, so broken instances are reported instead of failing silently.
+type HealthMonitor struct {
+	checker healthChecker
+	// restart is used to restart outdated norduserd instances
+	restart      func(uid uint32) error
+	restartedPID map[uint32]uint32
+	runningUIDs  func() (map[uint32]bool, error)
+	now          func() time.Time
+	statuses     map[uint32]UserStatus
+	mu           sync.Mutex
+	staleTimeout time.Duration
+}
+
+func NewHealthMonitor(checker healthChecker, restart func(uid uint32) error) *HealthMonitor {
+	return &HealthMonitor{
+		checker:      checker,
+		restart:      restart,
+		restartedPID: map[uint32]uint32{},
+		runningUIDs:  runningNorduserUIDs,
+		now:          time.Now,
+		statuses:     map[uint32]UserStatus{},
+		staleTimeout: HealthCheckInterval,
+	}
+}
+
+// RunningUIDs lists users who have norduserd running
+func RunningUIDs() ([]uint32, error) {
+	running, err := runningNorduserUIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	uids := make([]uint32, 0, len(running))
+	for uid := range running {
+		uids = append(uids, uid)
+	}
+	slices.Sort(uids)
+	return uids, nil
+}
+
+func runningNorduserUIDs() (map[uint32]bool, error) {
+	processes, err := findNorduserProcesses(procDir)
+	if err != nil {
+		return nil, err
+	}
+
+	uids := map[uint32]bool{}
+	for _, process := range processes {
+		uids[process.uid] = true
+	}
+	return uids, nil
+}
+
+// CheckAll checks every user with a running norduserd and every user checked previously
+func (m *HealthMonitor) CheckAll() {
+	running, err := m.runningUIDs()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "listing norduserd processes:", err)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	uids := map[uint32]bool{}
+	for uid := range running {
+		uids[uid] = true
+	}
+	for uid := range m.statuses {
+		uids[uid] = true
+	}
+
+	for uid := range uids {
+		m.check(uid, running[uid])
+	}
+}
+
+// Status returns the latest health check result for the given user. Check is performed on demand if the
+// user was not checked yet or the result is older than stale timeout.
+func (m *HealthMonitor) Status(uid uint32) UserStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	status, ok := m.statuses[uid]
+	if ok && m.now().Sub(status.CheckedAt) < m.staleTimeout {
+		return status
+	}
+
+	running, err := m.runningUIDs()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "listing norduserd processes:", err)
+		return status
+	}
+	return m.check(uid, running[uid])
+}
+
+// check performs the health check and stores the result. Not thread safe. Lock mu before using.
+func (m *HealthMonitor) check(uid uint32, running bool) UserStatus {
+	status := UserStatus{
+		UID:       uid,
+		Running:   running,
+		CheckedAt: m.now(),
+	}
+
+	if running {
+		resp, err := m.checker.Health(uid)
+		// instances started before the upgrade may not implement health RPC at all
+		if grpcstatus.Code(err) == codes.Unimplemented {
+			resp, err = &pb.HealthResponse{}, nil
+		}
+		if err != nil {
+			status.Err = err
+		} else {
+			status.SocketReachable = true
+			status.Version = resp.GetVersion()
+			status.Uptime = time.Duration(resp.GetUptime())
+			status.Outdated = resp.GetProtocolVersion() < process.ProtocolVersion
+		}
+
+		if status.Outdated {
+			m.restartOutdated(uid, resp)
+		}
+	}
+
+	previous, ok := m.statuses[uid]
+	if status.broken() && (!ok || !previous.broken()) {
+		log.Println(internal.WarningPrefix, "norduserd for user", uid, "is running but not responding:", status.Err)
+	} else if !status.broken() && ok && previous.broken() {
+		log.Println(internal.InfoPrefix, "norduserd for user", uid, "is responding again")
+	}
+
+	if running {
+		m.statuses[uid] = status
+	} else {
+		delete(m.statuses, uid)
+		delete(m.restartedPID, uid)
+	}
+	return status
+}
+
+// restartOutdated restarts norduserd instance once, so it is replaced by the binary installed with the
+// daemon. Not thread safe. Lock mu before using.
+func (m *HealthMonitor) restartOutdated(uid uint32, resp *pb.HealthResponse) {
+	if pid, ok := m.restartedPID[uid]; ok && pid == resp.GetPid() {
+		return
+	}
+	m.restartedPID[uid] = resp.GetPid()
+
+	log.Println(internal.InfoPrefix, "norduserd for user", uid, "uses protocol version",
+		resp.GetProtocolVersion(), "while", process.ProtocolVersion, "is required, restarting it")
+	go func() {
+		if err := m.restart(uid); err != nil {
+			log.Println(internal.ErrorPrefix, "failed to restart outdated norduserd for user", uid, ":", err)
+		}
+	}()
+}
+
+// Statuses returns the latest health check results of all users sorted by uid
+func (m *HealthMonitor) Statuses() []UserStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	statuses := make([]UserStatus, 0, len(m.statuses))
+	for _, status := range m.statuses {
+		statuses = append(statuses, status)
+	}
+	slices.SortFunc(statuses, func(a, b UserStatus) int { return cmp.Compare(a.UID, b.UID) })
+	return statuses
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/norduser/pb"
+	"github.com/NordSecurity/nordvpn-linux/norduser/process"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+type healthCheckerMock struct {
+	responses map[uint32]*pb.HealthResponse
+	errs      map[uint32]error
+	calls     int
+}
+
+func (h *healthCheckerMock) Health(uid uint32) (*pb.HealthResponse, error) {
+	h.calls++
+	if err, ok := h.errs[uid]; ok {
+		return nil, err
+	}
+	resp, ok := h.responses[uid]
+	if !ok {
+		return nil, errors.New("connection refused")
+	}
+	return resp, nil
+}
+
+func newTestHealthMonitor(checker healthChecker, running map[uint32]bool, now *time.Time) *HealthMonitor {
+	monitor := NewHealthMonitor(checker, func(uint32) error { return nil })
+	monitor.runningUIDs = func() (map[uint32]bool, error) { return running, nil }
+	monitor.now = func() time.Time { return *now }
+	return monitor
+}
+
+func TestHealthMonitor_CheckAll(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Now()
+	checker := &healthCheckerMock{responses: map[uint32]*pb.HealthResponse{
+		1000: {Version: "3.18.0", Uptime: int64(time.Hour), ProtocolVersion: process.ProtocolVersion},
+	}}
+	running := map[uint32]bool{1000: true, 1001: true}
+	monitor := newTestHealthMonitor(checker, running, &now)
+
+	monitor.CheckAll()
+	assert.Equal(t, []UserStatus{
+		{UID: 1000, Running: true, SocketReachable: true, Version: "3.18.0", Uptime: time.Hour, CheckedAt: now},
+		{UID: 1001, Running: true, CheckedAt: now, Err: errors.New("connection refused")},
+	}, monitor.Statuses())
+
+	delete(running, 1001)
+	monitor.CheckAll()
+	assert.Len(t, monitor.Statuses(), 1)
+}
+
+func TestHealthMonitor_Status(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Now()
+	checker := &healthCheckerMock{responses: map[uint32]*pb.HealthResponse{
+		1000: {Version: "3.18.0"},
+	}}
+	monitor := newTestHealthMonitor(checker, map[uint32]bool{1000: true}, &now)
+
+	status := monitor.Status(1000)
+	assert.True(t, status.SocketReachable)
+	assert.Equal(t, 1, checker.calls)
+
+	now = now.Add(HealthCheckInterval / 2)
+	monitor.Status(1000)
+	assert.Equal(t, 1, checker.calls, "fresh result should be reused")
+
+	now = now.Add(HealthCheckInterval)
+	monitor.Status(1000)
+	assert.Equal(t, 2, checker.calls, "stale result should be refreshed")
+
+	status = monitor.Status(1001)
+	assert.False(t, status.Running)
+	assert.Equal(t, 2, checker.calls, "not running norduserd should not be queried")
+}
+
+func TestHealthMonitor_RestartsOutdatedNorduser(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	now := time.Now()
+	checker := &healthCheckerMock{
+		responses: map[uint32]*pb.HealthResponse{
+			1000: {Pid: 10, ProtocolVersion: process.ProtocolVersion - 1},
+			1002: {Pid: 12, ProtocolVersion: process.ProtocolVersion},
+		},
+		errs: map[uint32]error{
+			1001: grpcstatus.Error(codes.Unimplemented, "unknown method Health"),
+		},
+	}
+	running := map[uint32]bool{1000: true, 1001: true, 1002: true}
+	monitor := newTestHealthMonitor(checker, running, &now)
+
+	restarted := make(chan uint32, 10)
+	monitor.restart = func(uid uint32) error {
+		restarted <- uid
+		return nil
+	}
+
+	monitor.CheckAll()
+	statuses := monitor.Statuses()
+	assert.True(t, statuses[0].Outdated)
+	assert.True(t, statuses[1].Outdated)
+	assert.True(t, statuses[1].SocketReachable)
+	assert.False(t, statuses[2].Outdated)
+
+	received := []uint32{}
+	for i := 0; i < 2; i++ {
+		select {
+		case uid := <-restarted:
+			received = append(received, uid)
+		case <-time.After(time.Second):
+			assert.FailNow(t, "outdated norduserd was not restarted")
+		}
+	}
+	assert.ElementsMatch(t, []uint32{1000, 1001}, received)
+
+	// the same process is restarted only once
+	monitor.CheckAll()
+	checker.responses[1000] = &pb.HealthResponse{Pid: 20, ProtocolVersion: process.ProtocolVersion - 1}
+	monitor.CheckAll()
+	select {
+	case uid := <-restarted:
+		assert.Equal(t, uint32(1000), uid)
+	case <-time.After(time.Second):
+		assert.FailNow(t, "new outdated norduserd process was not restarted")
+	}
+	assert.Len(t, restarted, 0)
+}
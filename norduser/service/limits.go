@@ -0,0 +1,69 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const (
+	// EnvNorduserdMemoryLimit overrides the memory limit of norduserd in MiB, 0 disables the limit
+	EnvNorduserdMemoryLimit = "NORDUSERD_MEMORY_LIMIT_MB"
+	// EnvNorduserdNice overrides the niceness of norduserd
+	EnvNorduserdNice = "NORDUSERD_NICE"
+)
+
+// ResourceLimits are applied to norduserd processes started by the daemon and are inherited by their
+// children, i.e. fileshare, so that they can't starve the user session.
+type ResourceLimits struct {
+	// MemoryBytes limits the data segment of the process, 0 means unlimited
+	MemoryBytes uint64
+	// Nice lowers the scheduling priority of the process, so it yields CPU to the rest of the session
+	Nice int
+}
+
+// DefaultResourceLimits match the limits set in norduserd user unit
+var DefaultResourceLimits = ResourceLimits{
+	MemoryBytes: 1 << 30,
+	Nice:        10,
+}
+
+// ResourceLimitsFromEnv returns default limits overridden by the environment variables
+func ResourceLimitsFromEnv(getenv func(string) string) ResourceLimits {
+	limits := DefaultResourceLimits
+	if value := getenv(EnvNorduserdMemoryLimit); value != "" {
+		if mb, err := strconv.ParseUint(value, 10, 32); err == nil {
+			limits.MemoryBytes = mb << 20
+		} else {
+			log.Println(internal.WarningPrefix, "invalid", EnvNorduserdMemoryLimit, "value:", value)
+		}
+	}
+	if value := getenv(EnvNorduserdNice); value != "" {
+		if nice, err := strconv.Atoi(value); err == nil && nice >= -20 && nice <= 19 {
+			limits.Nice = nice
+		} else {
+			log.Println(internal.WarningPrefix, "invalid", EnvNorduserdNice, "value:", value)
+		}
+	}
+	return limits
+}
+
+// apply sets the limits on the running process
+func (l ResourceLimits) apply(pid int) error {
+	if l.MemoryBytes != 0 {
+		limit := unix.Rlimit{Cur: l.MemoryBytes, Max: l.MemoryBytes}
+		if err := unix.Prlimit(pid, unix.RLIMIT_DATA, &limit, nil); err != nil {
+			return fmt.Errorf("limiting memory: %w", err)
+		}
+	}
+	if l.Nice != 0 {
+		if err := unix.Setpriority(unix.PRIO_PROCESS, pid, l.Nice); err != nil {
+			return fmt.Errorf("setting niceness: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+)
+
+func TestResourceLimitsFromEnv(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected ResourceLimits
+	}{
+		{
+			name:     "defaults",
+			expected: DefaultResourceLimits,
+		},
+		{
+			name:     "overridden",
+			env:      map[string]string{EnvNorduserdMemoryLimit: "256", EnvNorduserdNice: "5"},
+			expected: ResourceLimits{MemoryBytes: 256 << 20, Nice: 5},
+		},
+		{
+			name:     "disabled",
+			env:      map[string]string{EnvNorduserdMemoryLimit: "0", EnvNorduserdNice: "0"},
+			expected: ResourceLimits{},
+		},
+		{
+			name:     "invalid values are ignored",
+			env:      map[string]string{EnvNorduserdMemoryLimit: "1G", EnvNorduserdNice: "30"},
+			expected: DefaultResourceLimits,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			getenv := func(key string) string { return test.env[key] }
+			assert.Equal(t, test.expected, ResourceLimitsFromEnv(getenv))
+		})
+	}
+}
+
+func TestResourceLimits_Apply(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	cmd := exec.Command("sleep", "10")
+	assert.NoError(t, cmd.Start())
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+
+	limits := ResourceLimits{MemoryBytes: 512 << 20, Nice: 10}
+	assert.NoError(t, limits.apply(cmd.Process.Pid))
+
+	var limit unix.Rlimit
+	assert.NoError(t, unix.Prlimit(cmd.Process.Pid, unix.RLIMIT_DATA, nil, &limit))
+	assert.Equal(t, uint64(512<<20), limit.Cur)
+
+	// getpriority returns 20 - nice
+	priority, err := unix.Getpriority(unix.PRIO_PROCESS, cmd.Process.Pid)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, 20-priority)
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const procDir = "/proc"
+
+// norduserProcess is a running norduserd instance
+type norduserProcess struct {
+	pid int
+	uid uint32
+}
+
+// processStatus holds the fields of /proc/<pid>/status needed to identify norduserd instances
+type processStatus struct {
+	name string
+	// uid is the effective user id
+	uid uint32
+}
+
+// parseProcessStatus reads the name and the effective uid from /proc/<pid>/status content
+func parseProcessStatus(r io.Reader) (processStatus, error) {
+	var status processStatus
+	var nameFound, uidFound bool
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() && !(nameFound && uidFound) {
+		key, value, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch key {
+		case "Name":
+			status.name = value
+			nameFound = true
+		case "Uid":
+			// real, effective, saved set and filesystem uids
+			fields := strings.Fields(value)
+			if len(fields) < 2 {
+				return processStatus{}, fmt.Errorf("malformed Uid line: %q", value)
+			}
+			uid, err := strconv.ParseUint(fields[1], 10, 32)
+			if err != nil {
+				return processStatus{}, fmt.Errorf("parsing effective uid: %w", err)
+			}
+			status.uid = uint32(uid)
+			uidFound = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return processStatus{}, err
+	}
+	if !nameFound || !uidFound {
+		return processStatus{}, errors.New("name or uid is missing")
+	}
+	return status, nil
+}
+
+// findNorduserProcesses scans procfs mounted at root for norduserd instances. Processes which exit during
+// the scan are skipped.
+func findNorduserProcesses(root string) ([]norduserProcess, error) {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", root, err)
+	}
+
+	processes := []norduserProcess{}
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+
+		file, err := os.Open(filepath.Join(root, entry.Name(), "status"))
+		if err != nil {
+			if !errors.Is(err, fs.ErrNotExist) {
+				return nil, fmt.Errorf("opening status of %d: %w", pid, err)
+			}
+			continue
+		}
+		status, err := parseProcessStatus(file)
+		// #nosec G104 -- file was only read
+		file.Close()
+		if err != nil {
+			continue
+		}
+
+		if status.name == internal.Norduserd {
+			processes = append(processes, norduserProcess{pid: pid, uid: status.uid})
+		}
+	}
+
+	return processes, nil
+}
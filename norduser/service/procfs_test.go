@@ -0,0 +1,96 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func statusContent(name string, uids string) string {
+	return "Name:\t" + name + "\nUmask:\t0022\nState:\tS (sleeping)\nTgid:\t35139\nPid:\t35139\nPPid:\t1\n" +
+		"Uid:\t" + uids + "\nGid:\t1000\t1000\t1000\t1000\n"
+}
+
+func Test_parseProcessStatus(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name           string
+		content        string
+		expectedStatus processStatus
+		expectedErr    bool
+	}{
+		{
+			name:           "norduserd",
+			content:        statusContent("norduserd", "1000\t1000\t1000\t1000"),
+			expectedStatus: processStatus{name: "norduserd", uid: 1000},
+		},
+		{
+			name:           "effective uid is used",
+			content:        statusContent("norduserd", "0\t1001\t1001\t1001"),
+			expectedStatus: processStatus{name: "norduserd", uid: 1001},
+		},
+		{
+			name:           "name with spaces",
+			content:        statusContent("Web Content", "1000\t1000\t1000\t1000"),
+			expectedStatus: processStatus{name: "Web Content", uid: 1000},
+		},
+		{
+			name:        "malformed uid",
+			content:     statusContent("norduserd", "aaaa\tbbbb\tcccc\tdddd"),
+			expectedErr: true,
+		},
+		{
+			name:        "missing uid",
+			content:     "Name:\tnorduserd\n",
+			expectedErr: true,
+		},
+		{
+			name:        "empty",
+			expectedErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status, err := parseProcessStatus(strings.NewReader(test.content))
+			if test.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedStatus, status)
+		})
+	}
+}
+
+func Test_findNorduserProcesses(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	root := t.TempDir()
+	processes := map[string]string{
+		"1":     statusContent("systemd", "0\t0\t0\t0"),
+		"35139": statusContent("norduserd", "1000\t1000\t1000\t1000"),
+		"35153": statusContent("norduserd", "1001\t1001\t1001\t1001"),
+		"35160": "malformed",
+		"self":  statusContent("norduserd", "0\t0\t0\t0"),
+	}
+	for pid, content := range processes {
+		assert.NoError(t, os.Mkdir(filepath.Join(root, pid), 0o755))
+		assert.NoError(t, os.WriteFile(filepath.Join(root, pid, "status"), []byte(content), 0o644))
+	}
+	// process which exited after the directory was listed
+	assert.NoError(t, os.Mkdir(filepath.Join(root, "35170"), 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(root, "uptime"), []byte("1.00 2.00"), 0o644))
+
+	result, err := findNorduserProcesses(root)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []norduserProcess{{pid: 35139, uid: 1000}, {pid: 35153, uid: 1001}}, result)
+
+	_, err = findNorduserProcesses(filepath.Join(root, "missing"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"syscall"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/norduser/process"
+)
+
+const (
+	// gracefulStopTimeout is how long norduserd has to stop fileshare and exit after it was asked to stop
+	gracefulStopTimeout = 10 * time.Second
+	// terminateTimeout is how long norduserd has to exit after SIGTERM before it is killed
+	terminateTimeout = 5 * time.Second
+	exitPollInterval = 100 * time.Millisecond
+)
+
+// shutdownCascade stops norduserd by asking it to shut down over gRPC first, so it can stop fileshare and
+// let it flush the transfer state. Signals are used only if the process does not cooperate.
+type shutdownCascade struct {
+	// requestStop asks norduserd of the user to shut down
+	requestStop func(uid uint32) error
+	signal      func(pid int, sig syscall.Signal) error
+	// exited reports whether the process is gone
+	exited           func(pid int) bool
+	gracefulTimeout  time.Duration
+	terminateTimeout time.Duration
+	pollInterval     time.Duration
+}
+
+func newShutdownCascade() shutdownCascade {
+	return shutdownCascade{
+		requestStop: func(uid uint32) error {
+			return process.NewNorduserGRPCProcessManager(uid).StopProcess(false)
+		},
+		signal:           syscall.Kill,
+		exited:           processExited,
+		gracefulTimeout:  gracefulStopTimeout,
+		terminateTimeout: terminateTimeout,
+		pollInterval:     exitPollInterval,
+	}
+}
+
+func processExited(pid int) bool {
+	return errors.Is(syscall.Kill(pid, 0), syscall.ESRCH)
+}
+
+// stop shuts down norduserd running with the given pid and returns once it is gone
+func (s shutdownCascade) stop(uid uint32, pid int) error {
+	if err := s.requestStop(uid); err != nil {
+		log.Println(internal.WarningPrefix, "failed to ask norduserd of uid", uid, "to stop, terminating it:", err)
+	} else if s.waitForExit(pid, s.gracefulTimeout) {
+		return nil
+	} else {
+		log.Println(internal.WarningPrefix, "norduserd of uid", uid, "did not stop in time, terminating it")
+	}
+
+	if err := s.signal(pid, syscall.SIGTERM); err != nil {
+		if errors.Is(err, syscall.ESRCH) {
+			return nil
+		}
+		return fmt.Errorf("sending SIGTERM to norduserd: %w", err)
+	}
+	if s.waitForExit(pid, s.terminateTimeout) {
+		return nil
+	}
+
+	log.Println(internal.WarningPrefix, "norduserd of uid", uid, "did not exit after SIGTERM, killing it")
+	if err := s.signal(pid, syscall.SIGKILL); err != nil && !errors.Is(err, syscall.ESRCH) {
+		return fmt.Errorf("sending SIGKILL to norduserd: %w", err)
+	}
+	return nil
+}
+
+// waitForExit returns true if the process exits before the timeout
+func (s shutdownCascade) waitForExit(pid int, timeout time.Duration) bool {
+	deadline := time.Now().Add(timeout)
+	for {
+		if s.exited(pid) {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(s.pollInterval)
+	}
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"errors"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeNorduserd exits when it receives one of the configured signals or the stop request
+type fakeNorduserd struct {
+	stopRequestErr error
+	exitOnRequest  bool
+	exitOn         []syscall.Signal
+	signals        []syscall.Signal
+	requested      bool
+	exited         bool
+}
+
+func (f *fakeNorduserd) cascade() shutdownCascade {
+	return shutdownCascade{
+		requestStop: func(uint32) error {
+			f.requested = true
+			if f.stopRequestErr != nil {
+				return f.stopRequestErr
+			}
+			f.exited = f.exitOnRequest
+			return nil
+		},
+		signal: func(_ int, sig syscall.Signal) error {
+			if f.exited {
+				return syscall.ESRCH
+			}
+			f.signals = append(f.signals, sig)
+			for _, exitSig := range f.exitOn {
+				if sig == exitSig {
+					f.exited = true
+				}
+			}
+			return nil
+		},
+		exited:           func(int) bool { return f.exited },
+		gracefulTimeout:  10 * time.Millisecond,
+		terminateTimeout: 10 * time.Millisecond,
+		pollInterval:     time.Millisecond,
+	}
+}
+
+func TestShutdownCascade(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name            string
+		norduserd       fakeNorduserd
+		expectedSignals []syscall.Signal
+	}{
+		{
+			name:      "stops gracefully",
+			norduserd: fakeNorduserd{exitOnRequest: true},
+		},
+		{
+			name:            "stop request fails",
+			norduserd:       fakeNorduserd{stopRequestErr: errors.New("connection refused"), exitOn: []syscall.Signal{syscall.SIGTERM}},
+			expectedSignals: []syscall.Signal{syscall.SIGTERM},
+		},
+		{
+			name:            "stop request ignored",
+			norduserd:       fakeNorduserd{exitOn: []syscall.Signal{syscall.SIGTERM}},
+			expectedSignals: []syscall.Signal{syscall.SIGTERM},
+		},
+		{
+			name:            "hangs",
+			norduserd:       fakeNorduserd{exitOn: []syscall.Signal{syscall.SIGKILL}},
+			expectedSignals: []syscall.Signal{syscall.SIGTERM, syscall.SIGKILL},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			norduserd := test.norduserd
+			assert.NoError(t, norduserd.cascade().stop(1000, 1234))
+			assert.True(t, norduserd.requested)
+			assert.True(t, norduserd.exited)
+			assert.Equal(t, test.expectedSignals, norduserd.signals)
+		})
+	}
+}
@@ -3,9 +3,6 @@ package service
 import (
 	"fmt"
 	"log"
-	"os/exec"
-	"strconv"
-	"strings"
 
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/norduser/process"
@@ -36,27 +33,15 @@ func (n NorduserSnap) Stop(uid uint32, wait bool) error {
 }
 
 func (n NorduserSnap) stopAll(disable bool) {
-	// #nosec G204 -- arg values are constant
-	output, err := exec.Command("ps", "-C", internal.Norduserd, "-o", "uid=").CombinedOutput()
+	processes, err := findNorduserProcesses(procDir)
 	if err != nil {
 		log.Println(internal.ErrorPrefix, "Failed to list running norduserd instances: ", err)
-	}
-
-	uids := string(output)
-	if uids == "" {
 		return
 	}
-	uids = strings.Trim(uids, "\n")
-
-	for _, uid := range strings.Split(uids, "\n") {
-		uidInt, err := strconv.Atoi(strings.TrimSpace(uid))
-		if err != nil {
-			log.Printf("Invalid unix user id, failed to convert from string: %s", uid)
-			continue
-		}
 
-		if err := process.NewNorduserGRPCProcessManager(uint32(uidInt)).StopProcess(disable); err != nil {
-			log.Println(internal.ErrorPrefix, "Failed to stop norduserd for uid: ", uid)
+	for _, norduser := range processes {
+		if err := process.NewNorduserGRPCProcessManager(norduser.uid).StopProcess(disable); err != nil {
+			log.Println(internal.ErrorPrefix, "Failed to stop norduserd for uid: ", norduser.uid)
 		}
 	}
 }
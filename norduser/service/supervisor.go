@@ -0,0 +1,95 @@
+package service
+
+import (
+	"errors"
+	"os/exec"
+	"syscall"
+	"time"
+)
+
+// Health of the norduserd instance of a user
+type Health int
+
+const (
+	// HealthUnknown is reported when norduserd of the user is not supervised
+	HealthUnknown Health = iota
+	HealthRunning
+	// HealthRestarting is reported while waiting to restart crashed norduserd
+	HealthRestarting
+	// HealthFailed is reported when norduserd crashed too many times and is not restarted anymore
+	HealthFailed
+	HealthStopped
+)
+
+// HealthReporter is implemented by the services which supervise norduserd
+type HealthReporter interface {
+	Health(uid uint32) Health
+}
+
+const (
+	restartBackoffBase = time.Second
+	restartBackoffMax  = time.Minute
+	// norduserd is not restarted anymore if it crashes more than maxCrashes times within crashWindow
+	crashWindow = 10 * time.Minute
+	maxCrashes  = 5
+)
+
+// waiter is a started process
+type waiter interface {
+	Wait() error
+}
+
+// supervisedProcess tracks norduserd started for a user
+type supervisedProcess struct {
+	gid           uint32
+	home          string
+	health        Health
+	stopRequested bool
+	crashes       []time.Time
+	restartTimer  *time.Timer
+}
+
+// recordCrash remembers the crash and forgets the ones which happened before the crash window
+func (p *supervisedProcess) recordCrash(now time.Time) {
+	crashes := []time.Time{}
+	for _, crash := range p.crashes {
+		if now.Sub(crash) < crashWindow {
+			crashes = append(crashes, crash)
+		}
+	}
+	p.crashes = append(crashes, now)
+}
+
+func (p *supervisedProcess) stop() {
+	p.stopRequested = true
+	p.health = HealthStopped
+	if p.restartTimer != nil {
+		p.restartTimer.Stop()
+	}
+}
+
+// restartDelay doubles with each consecutive crash up to restartBackoffMax
+func restartDelay(crashes int) time.Duration {
+	delay := restartBackoffBase
+	for i := 1; i < crashes && delay < restartBackoffMax; i++ {
+		delay *= 2
+	}
+	return min(delay, restartBackoffMax)
+}
+
+// isCrash tells if the process exited abnormally. Exiting with 0 and being terminated are expected, e.g. when
+// the user quits the tray or the system shuts down.
+func isCrash(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return true
+	}
+	if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return status.Signal() != syscall.SIGTERM && status.Signal() != syscall.SIGINT
+	}
+	return exitErr.ExitCode() != 0
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"errors"
+	"os/exec"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestartDelay(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, time.Second, restartDelay(1))
+	assert.Equal(t, 2*time.Second, restartDelay(2))
+	assert.Equal(t, 16*time.Second, restartDelay(5))
+	assert.Equal(t, time.Minute, restartDelay(7))
+	assert.Equal(t, time.Minute, restartDelay(100))
+}
+
+func TestIsCrash(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		script   string
+		expected bool
+	}{
+		{name: "success", script: "exit 0", expected: false},
+		{name: "failure", script: "exit 1", expected: true},
+		{name: "terminated", script: "kill -TERM $$", expected: false},
+		{name: "killed", script: "kill -KILL $$", expected: true},
+		{name: "segfault", script: "kill -SEGV $$", expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := exec.Command("sh", "-c", test.script).Run()
+			assert.Equal(t, test.expected, isCrash(err))
+		})
+	}
+
+	assert.True(t, isCrash(errors.New("wait failed")))
+}
+
+type fakeProcess struct {
+	exit chan error
+}
+
+func (p *fakeProcess) Wait() error {
+	return <-p.exit
+}
+
+type fakeProcessStarter struct {
+	mu        sync.Mutex
+	processes []*fakeProcess
+}
+
+func (s *fakeProcessStarter) start(uint32, uint32, string) (waiter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	process := &fakeProcess{exit: make(chan error, 1)}
+	s.processes = append(s.processes, process)
+	return process, nil
+}
+
+func (s *fakeProcessStarter) started() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.processes)
+}
+
+func (s *fakeProcessStarter) last() *fakeProcess {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.processes[len(s.processes)-1]
+}
+
+func newSupervisedNorduser(starter *fakeProcessStarter, restartAfter time.Duration) (*ChildProcessNorduser, *[]time.Duration) {
+	var delays []time.Duration
+	return &ChildProcessNorduser{
+		processes:    map[uint32]*supervisedProcess{},
+		startProcess: starter.start,
+		runningPID:   func(uint32) (int, error) { return -1, nil },
+		now:          time.Now,
+		afterFunc: func(delay time.Duration, f func()) *time.Timer {
+			delays = append(delays, delay)
+			return time.AfterFunc(restartAfter, f)
+		},
+	}, &delays
+}
+
+func TestChildProcessNorduser_RestartsCrashedProcess(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	starter := &fakeProcessStarter{}
+	c, delays := newSupervisedNorduser(starter, 0)
+
+	assert.NoError(t, c.Enable(1000, 1000, "/home/user"))
+	assert.Equal(t, HealthRunning, c.Health(1000))
+
+	starter.last().exit <- errors.New("crash")
+	assert.Eventually(t, func() bool { return starter.started() == 2 }, time.Second, time.Millisecond)
+	assert.Eventually(t, func() bool { return c.Health(1000) == HealthRunning }, time.Second, time.Millisecond)
+
+	starter.last().exit <- errors.New("crash")
+	assert.Eventually(t, func() bool { return starter.started() == 3 }, time.Second, time.Millisecond)
+
+	c.mu.Lock()
+	assert.Equal(t, []time.Duration{time.Second, 2 * time.Second}, *delays)
+	c.mu.Unlock()
+}
+
+func TestChildProcessNorduser_NormalExitIsNotRestarted(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	starter := &fakeProcessStarter{}
+	c, _ := newSupervisedNorduser(starter, 0)
+
+	assert.NoError(t, c.Enable(1000, 1000, "/home/user"))
+	starter.last().exit <- nil
+	assert.Eventually(t, func() bool { return c.Health(1000) == HealthStopped }, time.Second, time.Millisecond)
+	assert.Equal(t, 1, starter.started())
+}
+
+func TestChildProcessNorduser_GivesUpOnRestartStorm(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	starter := &fakeProcessStarter{}
+	c, _ := newSupervisedNorduser(starter, 0)
+
+	assert.NoError(t, c.Enable(1000, 1000, "/home/user"))
+	for i := 1; i <= maxCrashes; i++ {
+		starter.last().exit <- errors.New("crash")
+		started := i + 1
+		assert.Eventually(t, func() bool { return starter.started() == started }, time.Second, time.Millisecond)
+	}
+	starter.last().exit <- errors.New("crash")
+	assert.Eventually(t, func() bool { return c.Health(1000) == HealthFailed }, time.Second, time.Millisecond)
+	assert.Equal(t, maxCrashes+1, starter.started())
+
+	// explicit enable starts from scratch
+	assert.NoError(t, c.Enable(1000, 1000, "/home/user"))
+	assert.Equal(t, HealthRunning, c.Health(1000))
+}
+
+func TestChildProcessNorduser_StopCancelsRestart(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	starter := &fakeProcessStarter{}
+	c, _ := newSupervisedNorduser(starter, time.Hour)
+
+	assert.NoError(t, c.Enable(1000, 1000, "/home/user"))
+	starter.last().exit <- errors.New("crash")
+	assert.Eventually(t, func() bool { return c.Health(1000) == HealthRestarting }, time.Second, time.Millisecond)
+
+	assert.NoError(t, c.Stop(1000, false))
+	assert.Equal(t, HealthStopped, c.Health(1000))
+	assert.Equal(t, HealthUnknown, c.Health(1001))
+}
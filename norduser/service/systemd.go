@@ -0,0 +1,194 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+const (
+	norduserdUnit = "norduserd.service"
+	// norduserdSocketUnit starts norduserd on the first connection to its socket
+	norduserdSocketUnit = "norduserd.socket"
+	// norduserdUnitPath is where the packages install the norduserd user unit
+	norduserdUnitPath = "/usr/lib/systemd/user/" + norduserdUnit
+	// systemdRuntimeDir exists only when the system was booted with systemd
+	systemdRuntimeDir = "/run/systemd/system"
+	userRuntimeDir    = "/run/user"
+)
+
+// IsSystemdUserUnitAvailable reports whether norduserd can be run as a systemd user unit
+func IsSystemdUserUnitAvailable() bool {
+	if _, err := exec.LookPath("systemctl"); err != nil {
+		return false
+	}
+	return internal.FileExists(systemdRuntimeDir) && internal.FileExists(norduserdUnitPath)
+}
+
+// SystemdNorduser manages norduser service as a systemd user unit, so that it is supervised by the service
+// manager of the user. Users without a running service manager, e.g. those logged in before it was started,
+// are handled by the fallback service. Users who don't need norduserd all the time get only the socket unit
+// started, so that norduserd is launched on demand.
+type SystemdNorduser struct {
+	fallback Service
+	// onDemand reports whether norduserd of the user should be started only once something connects to it
+	onDemand func(uid uint32) bool
+	// systemctl runs systemctl on behalf of the user
+	systemctl func(uid uint32, args ...string) ([]byte, error)
+	// userManagerRunning checks if the user has a systemd user instance
+	userManagerRunning func(uid uint32) bool
+	// loggedInUIDs lists users which may have norduserd running
+	loggedInUIDs func() []uint32
+}
+
+func NewSystemdNorduser(fallback Service, onDemand func(uid uint32) bool) *SystemdNorduser {
+	return &SystemdNorduser{
+		fallback:           fallback,
+		onDemand:           onDemand,
+		systemctl:          userSystemctl,
+		userManagerRunning: userManagerRunning,
+		loggedInUIDs:       runtimeDirUIDs,
+	}
+}
+
+func userSystemctl(uid uint32, args ...string) ([]byte, error) {
+	u, err := user.LookupId(strconv.FormatUint(uint64(uid), 10))
+	if err != nil {
+		return nil, fmt.Errorf("looking up user: %w", err)
+	}
+	args = append([]string{"--user", "--machine=" + u.Username + "@.host"}, args...)
+	// #nosec G204 -- unit name is constant and the user name comes from the user database
+	return exec.Command("systemctl", args...).CombinedOutput()
+}
+
+func userManagerRunning(uid uint32) bool {
+	return internal.FileExists(filepath.Join(userRuntimeDir, strconv.FormatUint(uint64(uid), 10), "systemd", "private"))
+}
+
+func runtimeDirUIDs() []uint32 {
+	entries, err := os.ReadDir(userRuntimeDir)
+	if err != nil {
+		return nil
+	}
+
+	uids := []uint32{}
+	for _, entry := range entries {
+		uid, err := strconv.ParseUint(entry.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+		uids = append(uids, uint32(uid))
+	}
+	return uids
+}
+
+// unitState returns the state of norduserd unit reported by systemctl is-active or empty string if the user
+// doesn't have a service manager
+func (s *SystemdNorduser) unitState(uid uint32) string {
+	return s.state(uid, norduserdUnit)
+}
+
+func (s *SystemdNorduser) state(uid uint32, unit string) string {
+	if !s.userManagerRunning(uid) {
+		return ""
+	}
+	// is-active exits with an error for inactive units, so only the output matters
+	out, _ := s.systemctl(uid, "is-active", unit)
+	return strings.TrimSpace(string(out))
+}
+
+func (s *SystemdNorduser) unitActive(uid uint32) bool {
+	return s.unitState(uid) == "active"
+}
+
+// unitsActive reports whether norduserd is running or waiting to be started on demand
+func (s *SystemdNorduser) unitsActive(uid uint32) bool {
+	return s.unitActive(uid) || s.state(uid, norduserdSocketUnit) == "active"
+}
+
+// Health returns health of norduserd unit of the user or of the process started by the fallback
+func (s *SystemdNorduser) Health(uid uint32) Health {
+	switch s.unitState(uid) {
+	case "active":
+		return HealthRunning
+	// units waiting for the automatic restart are reported as activating
+	case "activating":
+		return HealthRestarting
+	case "failed":
+		return HealthFailed
+	}
+
+	if reporter, ok := s.fallback.(HealthReporter); ok {
+		return reporter.Health(uid)
+	}
+	return HealthUnknown
+}
+
+// Enable starts norduserd unit or its socket unit if norduserd is needed only on demand. Falls back to starting
+// the process directly if the unit can't be started.
+func (s *SystemdNorduser) Enable(uid uint32, gid uint32, home string) error {
+	if !s.userManagerRunning(uid) {
+		return s.fallback.Enable(uid, gid, home)
+	}
+
+	unit := norduserdUnit
+	if s.onDemand(uid) {
+		unit = norduserdSocketUnit
+	}
+	out, err := s.systemctl(uid, "start", unit)
+	if err == nil {
+		return nil
+	}
+	log.Println(internal.WarningPrefix, "failed to start", unit, "unit, starting the process directly:",
+		err, strings.TrimSpace(string(out)))
+	return s.fallback.Enable(uid, gid, home)
+}
+
+// Stop stops norduserd units of the user or the process started by the fallback
+func (s *SystemdNorduser) Stop(uid uint32, wait bool) error {
+	if !s.unitsActive(uid) {
+		return s.fallback.Stop(uid, wait)
+	}
+
+	args := []string{"stop", norduserdSocketUnit, norduserdUnit}
+	if !wait {
+		args = append([]string{"--no-block"}, args...)
+	}
+	if out, err := s.systemctl(uid, args...); err != nil {
+		return fmt.Errorf("stopping norduserd unit: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// StopAll stops norduserd units of all logged in users and then the processes started by the fallback
+func (s *SystemdNorduser) StopAll() {
+	for _, uid := range s.loggedInUIDs() {
+		if !s.unitsActive(uid) {
+			continue
+		}
+		if out, err := s.systemctl(uid, "stop", norduserdSocketUnit, norduserdUnit); err != nil {
+			log.Println(internal.ErrorPrefix, "failed to stop norduserd unit:", err, strings.TrimSpace(string(out)))
+		}
+	}
+	s.fallback.StopAll()
+}
+
+// Restart restarts norduserd unit of the user or the process started by the fallback. Unit waiting to be started
+// on demand is started.
+func (s *SystemdNorduser) Restart(uid uint32) error {
+	if !s.unitsActive(uid) {
+		return s.fallback.Restart(uid)
+	}
+
+	if out, err := s.systemctl(uid, "restart", norduserdUnit); err != nil {
+		return fmt.Errorf("restarting norduserd unit: %w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+type serviceMock struct {
+	enabled   []uint32
+	stopped   []uint32
+	restarted []uint32
+	stopAll   bool
+}
+
+func (s *serviceMock) Enable(uid uint32, gid uint32, home string) error {
+	s.enabled = append(s.enabled, uid)
+	return nil
+}
+
+func (s *serviceMock) Stop(uid uint32, wait bool) error {
+	s.stopped = append(s.stopped, uid)
+	return nil
+}
+
+func (s *serviceMock) StopAll() {
+	s.stopAll = true
+}
+
+func (s *serviceMock) Restart(uid uint32) error {
+	s.restarted = append(s.restarted, uid)
+	return nil
+}
+
+type systemctlMock struct {
+	calls        []string
+	active       map[uint32]bool
+	socketActive map[uint32]bool
+	err          error
+}
+
+func (s *systemctlMock) run(uid uint32, args ...string) ([]byte, error) {
+	command := strings.Join(args, " ")
+	s.calls = append(s.calls, command)
+	if args[0] == "is-active" {
+		active := s.active
+		if args[1] == norduserdSocketUnit {
+			active = s.socketActive
+		}
+		if active[uid] {
+			return []byte("active\n"), nil
+		}
+		return []byte("inactive\n"), errors.New("exit status 3")
+	}
+	return nil, s.err
+}
+
+func newTestSystemdNorduser(systemctl *systemctlMock, managers map[uint32]bool) (*SystemdNorduser, *serviceMock) {
+	fallback := &serviceMock{}
+	return &SystemdNorduser{
+		fallback:           fallback,
+		onDemand:           func(uid uint32) bool { return uid == 1001 },
+		systemctl:          systemctl.run,
+		userManagerRunning: func(uid uint32) bool { return managers[uid] },
+		loggedInUIDs:       func() []uint32 { return []uint32{1000, 1001} },
+	}, fallback
+}
+
+func TestSystemdNorduser_Enable(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name             string
+		uid              uint32
+		managerRunning   bool
+		startErr         error
+		expectedCalls    []string
+		expectedFallback []uint32
+	}{
+		{
+			name:           "unit started",
+			uid:            1000,
+			managerRunning: true,
+			expectedCalls:  []string{"start norduserd.service"},
+		},
+		{
+			name:             "no user manager",
+			uid:              1000,
+			expectedFallback: []uint32{1000},
+		},
+		{
+			name:             "unit failed to start",
+			uid:              1000,
+			managerRunning:   true,
+			startErr:         errors.New("exit status 5"),
+			expectedCalls:    []string{"start norduserd.service"},
+			expectedFallback: []uint32{1000},
+		},
+		{
+			name:           "socket started for on demand user",
+			uid:            1001,
+			managerRunning: true,
+			expectedCalls:  []string{"start norduserd.socket"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			systemctl := &systemctlMock{err: test.startErr}
+			s, fallback := newTestSystemdNorduser(systemctl, map[uint32]bool{test.uid: test.managerRunning})
+
+			assert.NoError(t, s.Enable(test.uid, test.uid, "/home/user"))
+			assert.Equal(t, test.expectedCalls, systemctl.calls)
+			assert.Equal(t, test.expectedFallback, fallback.enabled)
+		})
+	}
+}
+
+func TestSystemdNorduser_Stop(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	systemctl := &systemctlMock{active: map[uint32]bool{1000: true}}
+	s, fallback := newTestSystemdNorduser(systemctl, map[uint32]bool{1000: true, 1001: true})
+
+	assert.NoError(t, s.Stop(1000, false))
+	assert.NoError(t, s.Stop(1001, true))
+	assert.Equal(t, []string{
+		"is-active norduserd.service",
+		"--no-block stop norduserd.socket norduserd.service",
+		"is-active norduserd.service",
+		"is-active norduserd.socket",
+	}, systemctl.calls)
+	assert.Equal(t, []uint32{1001}, fallback.stopped)
+
+	// socket waiting for the connection is stopped as well
+	systemctl = &systemctlMock{socketActive: map[uint32]bool{1001: true}}
+	s, fallback = newTestSystemdNorduser(systemctl, map[uint32]bool{1001: true})
+	assert.NoError(t, s.Stop(1001, true))
+	assert.Equal(t, []string{
+		"is-active norduserd.service",
+		"is-active norduserd.socket",
+		"stop norduserd.socket norduserd.service",
+	}, systemctl.calls)
+	assert.Empty(t, fallback.stopped)
+}
+
+func TestSystemdNorduser_Restart(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	systemctl := &systemctlMock{active: map[uint32]bool{1000: true}}
+	s, fallback := newTestSystemdNorduser(systemctl, map[uint32]bool{1000: true})
+
+	assert.NoError(t, s.Restart(1000))
+	assert.NoError(t, s.Restart(1001))
+	assert.Equal(t, []string{"is-active norduserd.service", "restart norduserd.service"}, systemctl.calls)
+	assert.Equal(t, []uint32{1001}, fallback.restarted)
+
+	// norduserd waiting to be started on demand is started
+	systemctl = &systemctlMock{socketActive: map[uint32]bool{1001: true}}
+	s, fallback = newTestSystemdNorduser(systemctl, map[uint32]bool{1001: true})
+	assert.NoError(t, s.Restart(1001))
+	assert.Equal(t, []string{
+		"is-active norduserd.service",
+		"is-active norduserd.socket",
+		"restart norduserd.service",
+	}, systemctl.calls)
+	assert.Empty(t, fallback.restarted)
+}
+
+func TestSystemdNorduser_StopAll(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	systemctl := &systemctlMock{active: map[uint32]bool{1001: true}}
+	s, fallback := newTestSystemdNorduser(systemctl, map[uint32]bool{1000: true, 1001: true})
+
+	s.StopAll()
+	assert.Equal(t, []string{
+		"is-active norduserd.service",
+		"is-active norduserd.socket",
+		"is-active norduserd.service",
+		"stop norduserd.socket norduserd.service",
+	}, systemctl.calls)
+	assert.True(t, fallback.stopAll)
+}
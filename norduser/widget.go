@@ -0,0 +1,239 @@
+package norduser
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	daemonpb "github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	filesharepb "github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	meshpb "github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+)
+
+const (
+	WidgetUpdateInterval = 5 * time.Second
+	widgetWriteTimeout   = 5 * time.Second
+	widgetRequestTimeout = 5 * time.Second
+)
+
+// WidgetSnapshot is a compact meshnet state for the third party desktop applets which do not use gRPC
+type WidgetSnapshot struct {
+	MeshnetEnabled           bool `json:"meshnet_enabled"`
+	PeersOnline              int  `json:"peers_online"`
+	PeersTotal               int  `json:"peers_total"`
+	PendingIncomingTransfers int  `json:"pending_incoming_transfers"`
+	// ExitNode is the name of the peer which routes the traffic of this device
+	ExitNode string `json:"exit_node,omitempty"`
+	// RoutingAllowedPeers is a number of peers allowed to route their traffic through this device
+	RoutingAllowedPeers int `json:"routing_allowed_peers"`
+}
+
+// WidgetServer writes the snapshot as a JSON line to every client connecting to the socket and a new line each time
+// the snapshot changes
+type WidgetServer struct {
+	daemonClient    daemonpb.DaemonClient
+	meshClient      meshpb.MeshnetClient
+	fileshareClient filesharepb.FileshareClient
+	mu              sync.Mutex
+	snapshot        []byte
+	subscribers     map[chan []byte]struct{}
+}
+
+// NewWidgetServer creates a widget server. fileshareClient can be nil when fileshare is not used.
+func NewWidgetServer(
+	daemonClient daemonpb.DaemonClient,
+	meshClient meshpb.MeshnetClient,
+	fileshareClient filesharepb.FileshareClient,
+) *WidgetServer {
+	return &WidgetServer{
+		daemonClient:    daemonClient,
+		meshClient:      meshClient,
+		fileshareClient: fileshareClient,
+		subscribers:     map[chan []byte]struct{}{},
+	}
+}
+
+// Run collects the snapshot periodically until the context is canceled
+func (w *WidgetServer) Run(ctx context.Context) {
+	ticker := time.NewTicker(WidgetUpdateInterval)
+	defer ticker.Stop()
+
+	for {
+		w.publish(w.collect())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Serve accepts widget clients until the listener is closed
+func (w *WidgetServer) Serve(listener net.Listener) error {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		go w.handle(conn)
+	}
+}
+
+func (w *WidgetServer) handle(conn net.Conn) {
+	defer conn.Close()
+
+	updates := make(chan []byte, 1)
+	w.mu.Lock()
+	snapshot := w.snapshot
+	w.subscribers[updates] = struct{}{}
+	w.mu.Unlock()
+
+	defer func() {
+		w.mu.Lock()
+		delete(w.subscribers, updates)
+		w.mu.Unlock()
+	}()
+
+	// clients are not expected to send anything, reading detects when they disconnect
+	closed := make(chan struct{})
+	go func() {
+		// #nosec G104 -- any result means that the client is gone
+		io.Copy(io.Discard, conn)
+		close(closed)
+	}()
+
+	for {
+		if snapshot != nil {
+			if err := conn.SetWriteDeadline(time.Now().Add(widgetWriteTimeout)); err != nil {
+				return
+			}
+			if _, err := conn.Write(snapshot); err != nil {
+				return
+			}
+		}
+
+		select {
+		case snapshot = <-updates:
+		case <-closed:
+			return
+		}
+	}
+}
+
+// publish sends the snapshot to the clients if it has changed
+func (w *WidgetServer) publish(snapshot WidgetSnapshot) {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "Failed to marshal widget snapshot:", err)
+		return
+	}
+	data = append(data, '\n')
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if bytes.Equal(w.snapshot, data) {
+		return
+	}
+	w.snapshot = data
+
+	for updates := range w.subscribers {
+		// only the latest snapshot matters for the clients which are behind
+		select {
+		case <-updates:
+		default:
+		}
+		updates <- data
+	}
+}
+
+func (w *WidgetServer) collect() WidgetSnapshot {
+	ctx, cancel := context.WithTimeout(context.Background(), widgetRequestTimeout)
+	defer cancel()
+
+	var snapshot WidgetSnapshot
+	enabledResp, err := w.meshClient.IsEnabled(ctx, &meshpb.Empty{})
+	if err != nil || !enabledResp.GetStatus().GetValue() {
+		return snapshot
+	}
+	snapshot.MeshnetEnabled = true
+
+	peersResp, err := w.meshClient.GetPeers(ctx, &meshpb.Empty{})
+	if err != nil {
+		log.Println(internal.WarningPrefix, "Failed to get meshnet peers for widget:", err)
+	}
+	peers := append(peersResp.GetPeers().GetLocal(), peersResp.GetPeers().GetExternal()...)
+	snapshot.PeersTotal = len(peers)
+	for _, peer := range peers {
+		if peer.GetStatus() == meshpb.PeerStatus_CONNECTED {
+			snapshot.PeersOnline++
+		}
+		if peer.GetDoIAllowRouting() {
+			snapshot.RoutingAllowedPeers++
+		}
+	}
+
+	statusResp, err := w.daemonClient.Status(ctx, &daemonpb.Empty{})
+	if err != nil {
+		log.Println(internal.WarningPrefix, "Failed to get VPN status for widget:", err)
+	} else {
+		snapshot.ExitNode = exitNodeName(statusResp, peers)
+	}
+
+	if w.fileshareClient != nil {
+		snapshot.PendingIncomingTransfers = w.pendingIncomingTransfers(ctx)
+	}
+
+	return snapshot
+}
+
+// exitNodeName returns the name of the peer the device is connected to via meshnet
+func exitNodeName(status *daemonpb.StatusResponse, peers []*meshpb.Peer) string {
+	if status.GetState() != "Connected" || status.GetHostname() == "" {
+		return ""
+	}
+	for _, peer := range peers {
+		if strings.EqualFold(peer.GetHostname(), status.GetHostname()) {
+			if peer.GetNickname() != "" {
+				return peer.GetNickname()
+			}
+			return peer.GetHostname()
+		}
+	}
+	return ""
+}
+
+func (w *WidgetServer) pendingIncomingTransfers(ctx context.Context) int {
+	stream, err := w.fileshareClient.List(ctx, &filesharepb.Empty{})
+	if err != nil {
+		return 0
+	}
+
+	pending := 0
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				log.Println(internal.WarningPrefix, "Failed to list transfers for widget:", err)
+			}
+			return pending
+		}
+		for _, transfer := range resp.GetTransfers() {
+			if transfer.GetDirection() == filesharepb.Direction_INCOMING &&
+				transfer.GetStatus() == filesharepb.Status_REQUESTED {
+				pending++
+			}
+		}
+	}
+}
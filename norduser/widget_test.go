@@ -0,0 +1,190 @@
+package norduser
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	daemonpb "github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	filesharepb "github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	meshpb "github.com/NordSecurity/nordvpn-linux/meshnet/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"google.golang.org/grpc"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockWidgetDaemonClient struct {
+	daemonpb.DaemonClient
+	status *daemonpb.StatusResponse
+}
+
+func (m *mockWidgetDaemonClient) Status(context.Context, *daemonpb.Empty, ...grpc.CallOption) (*daemonpb.StatusResponse, error) {
+	return m.status, nil
+}
+
+type mockWidgetMeshClient struct {
+	meshpb.MeshnetClient
+	enabled bool
+	peers   *meshpb.PeerList
+}
+
+func (m *mockWidgetMeshClient) IsEnabled(context.Context, *meshpb.Empty, ...grpc.CallOption) (*meshpb.IsEnabledResponse, error) {
+	return &meshpb.IsEnabledResponse{
+		Response: &meshpb.IsEnabledResponse_Status{Status: &meshpb.EnabledStatus{Value: m.enabled}},
+	}, nil
+}
+
+func (m *mockWidgetMeshClient) GetPeers(context.Context, *meshpb.Empty, ...grpc.CallOption) (*meshpb.GetPeersResponse, error) {
+	return &meshpb.GetPeersResponse{Response: &meshpb.GetPeersResponse_Peers{Peers: m.peers}}, nil
+}
+
+type mockWidgetFileshareClient struct {
+	filesharepb.FileshareClient
+	transfers []*filesharepb.Transfer
+}
+
+type mockListClient struct {
+	filesharepb.Fileshare_ListClient
+	responses []*filesharepb.ListResponse
+}
+
+func (m *mockListClient) Recv() (*filesharepb.ListResponse, error) {
+	if len(m.responses) == 0 {
+		return nil, io.EOF
+	}
+	resp := m.responses[0]
+	m.responses = m.responses[1:]
+	return resp, nil
+}
+
+func (m *mockWidgetFileshareClient) List(
+	context.Context, *filesharepb.Empty, ...grpc.CallOption,
+) (filesharepb.Fileshare_ListClient, error) {
+	return &mockListClient{responses: []*filesharepb.ListResponse{{Transfers: m.transfers}}}, nil
+}
+
+func TestWidgetServer_Collect(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	peers := &meshpb.PeerList{
+		Local: []*meshpb.Peer{
+			{Hostname: "peer1.nord", Status: meshpb.PeerStatus_CONNECTED, DoIAllowRouting: true},
+			{Hostname: "peer2.nord", Nickname: "laptop", Status: meshpb.PeerStatus_CONNECTED},
+		},
+		External: []*meshpb.Peer{
+			{Hostname: "peer3.nord", Status: meshpb.PeerStatus_DISCONNECTED, DoIAllowRouting: true},
+		},
+	}
+	transfers := []*filesharepb.Transfer{
+		{Direction: filesharepb.Direction_INCOMING, Status: filesharepb.Status_REQUESTED},
+		{Direction: filesharepb.Direction_INCOMING, Status: filesharepb.Status_SUCCESS},
+		{Direction: filesharepb.Direction_OUTGOING, Status: filesharepb.Status_REQUESTED},
+	}
+
+	tests := []struct {
+		name      string
+		enabled   bool
+		status    *daemonpb.StatusResponse
+		fileshare filesharepb.FileshareClient
+		expected  WidgetSnapshot
+	}{
+		{
+			name:     "meshnet disabled",
+			expected: WidgetSnapshot{},
+		},
+		{
+			name:      "meshnet enabled",
+			enabled:   true,
+			status:    &daemonpb.StatusResponse{State: "Disconnected"},
+			fileshare: &mockWidgetFileshareClient{transfers: transfers},
+			expected: WidgetSnapshot{
+				MeshnetEnabled:           true,
+				PeersOnline:              2,
+				PeersTotal:               3,
+				PendingIncomingTransfers: 1,
+				RoutingAllowedPeers:      2,
+			},
+		},
+		{
+			name:    "routing through peer without fileshare",
+			enabled: true,
+			status:  &daemonpb.StatusResponse{State: "Connected", Hostname: "peer2.nord"},
+			expected: WidgetSnapshot{
+				MeshnetEnabled:      true,
+				PeersOnline:         2,
+				PeersTotal:          3,
+				ExitNode:            "laptop",
+				RoutingAllowedPeers: 2,
+			},
+		},
+		{
+			name:    "connected to VPN server",
+			enabled: true,
+			status:  &daemonpb.StatusResponse{State: "Connected", Hostname: "de1.nordvpn.com"},
+			expected: WidgetSnapshot{
+				MeshnetEnabled:      true,
+				PeersOnline:         2,
+				PeersTotal:          3,
+				RoutingAllowedPeers: 2,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := NewWidgetServer(
+				&mockWidgetDaemonClient{status: test.status},
+				&mockWidgetMeshClient{enabled: test.enabled, peers: peers},
+				test.fileshare,
+			)
+			assert.Equal(t, test.expected, server.collect())
+		})
+	}
+}
+
+func TestWidgetServer_ClientReceivesUpdates(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	server := NewWidgetServer(nil, nil, nil)
+	server.publish(WidgetSnapshot{PeersTotal: 1})
+
+	serverConn, clientConn := net.Pipe()
+	defer clientConn.Close()
+	go server.handle(serverConn)
+
+	reader := bufio.NewReader(clientConn)
+	readSnapshot := func() WidgetSnapshot {
+		assert.NoError(t, clientConn.SetReadDeadline(time.Now().Add(time.Second)))
+		line, err := reader.ReadBytes('\n')
+		assert.NoError(t, err)
+		var snapshot WidgetSnapshot
+		assert.NoError(t, json.Unmarshal(line, &snapshot))
+		return snapshot
+	}
+
+	assert.Equal(t, WidgetSnapshot{PeersTotal: 1}, readSnapshot())
+
+	// wait for the client to be subscribed
+	assert.Eventually(t, func() bool {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		return len(server.subscribers) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// unchanged snapshot is not sent again
+	server.publish(WidgetSnapshot{PeersTotal: 1})
+	server.publish(WidgetSnapshot{PeersTotal: 1, PeersOnline: 1})
+	assert.Equal(t, WidgetSnapshot{PeersTotal: 1, PeersOnline: 1}, readSnapshot())
+
+	assert.NoError(t, clientConn.Close())
+	assert.Eventually(t, func() bool {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+		return len(server.subscribers) == 0
+	}, time.Second, 10*time.Millisecond)
+}
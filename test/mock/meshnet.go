@@ -30,6 +30,7 @@ func (r *RegistryMock) Update(token string, id uuid.UUID, info mesh.MachineUpdat
 	r.CurrentMachine.SupportsRouting = info.SupportsRouting
 	r.CurrentMachine.Nickname = info.Nickname
 	r.CurrentMachine.Endpoints = info.Endpoints
+	r.CurrentMachine.AdvertisedSubnets = info.AdvertisedSubnets
 	return nil
 }
 
@@ -20,6 +20,10 @@ type Mock struct {
 	MeshActive        bool
 	ConnectRetries    int
 	LanDiscovery      bool
+	RouteMetric       uint32
+	PathMTUProbes     int
+	PeerRoutesKey     string
+	PeerRoutes        []netip.Prefix
 	MeshPeers         mesh.MachinePeers
 	MeshnetRetries    int
 	SetDNSErr         error
@@ -56,6 +60,10 @@ func (*Mock) ConnectionStatus() (networker.ConnectionStatus, error) {
 	return networker.ConnectionStatus{}, nil
 }
 
+func (*Mock) SessionStats() (vpn.SessionStats, error) {
+	return vpn.SessionStats{}, nil
+}
+
 func (*Mock) EnableFirewall() error  { return nil }
 func (*Mock) DisableFirewall() error { return nil }
 func (*Mock) EnableRouting()         {}
@@ -102,6 +110,21 @@ func (m *Mock) SetLanDiscovery(enabled bool) {
 	m.LanDiscovery = enabled
 }
 
+func (m *Mock) SetRouteMetric(metric uint32) {
+	m.RouteMetric = metric
+}
+
+func (m *Mock) ProbePathMTU() (int, error) {
+	m.PathMTUProbes++
+	return 1420, nil
+}
+
+func (m *Mock) SetPeerRoutes(publicKey string, subnets []netip.Prefix) error {
+	m.PeerRoutesKey = publicKey
+	m.PeerRoutes = subnets
+	return nil
+}
+
 func (*Mock) UnsetFirewall() error { return nil }
 
 type Failing struct{}
@@ -126,6 +149,10 @@ func (Failing) ConnectionStatus() (networker.ConnectionStatus, error) {
 	return networker.ConnectionStatus{}, nil
 }
 
+func (Failing) SessionStats() (vpn.SessionStats, error) {
+	return vpn.SessionStats{}, mock.ErrOnPurpose
+}
+
 func (Failing) EnableFirewall() error                               { return mock.ErrOnPurpose }
 func (Failing) DisableFirewall() error                              { return mock.ErrOnPurpose }
 func (Failing) EnableRouting()                                      {}
@@ -146,4 +173,7 @@ func (Failing) SetVPN(vpn.VPN)                                      {}
 func (Failing) LastServerName() string                              { return "" }
 func (Failing) SetLanDiscoveryAndResetMesh(bool, mesh.MachinePeers) {}
 func (Failing) SetLanDiscovery(bool)                                {}
+func (Failing) SetRouteMetric(uint32)                               {}
+func (Failing) ProbePathMTU() (int, error)                          { return 0, mock.ErrOnPurpose }
+func (Failing) SetPeerRoutes(string, []netip.Prefix) error          { return mock.ErrOnPurpose }
 func (Failing) UnsetFirewall() error                                { return mock.ErrOnPurpose }
@@ -96,3 +96,7 @@ func (*MeshnetAndVPN) Refresh(mesh.MachineMap) error     { return nil }
 func (*MeshnetAndVPN) StatusMap() (map[string]string, error) {
 	return map[string]string{}, nil
 }
+func (*MeshnetAndVPN) PathMap() (map[string]string, error) {
+	return map[string]string{}, nil
+}
+func (*MeshnetAndVPN) SetPeerRoutes(string, []netip.Prefix) error { return nil }
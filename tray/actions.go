@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/exec"
 	"os/signal"
+	"time"
 
 	"github.com/NordSecurity/nordvpn-linux/cli"
 	"github.com/NordSecurity/nordvpn-linux/client"
@@ -22,7 +23,7 @@ import (
 func (ti *Instance) login() {
 	resp, err := ti.client.IsLoggedIn(context.Background(), &pb.Empty{})
 	if err != nil || resp.GetValue() {
-		ti.notify("You are already logged in")
+		ti.notify(MsgAlreadyLoggedIn)
 		return
 	}
 
@@ -31,7 +32,7 @@ func (ti *Instance) login() {
 		&pb.Empty{},
 	)
 	if err != nil {
-		ti.notify("Login error: %s", err)
+		ti.notifyError(MsgLoginError, err)
 		return
 	}
 
@@ -41,29 +42,32 @@ func (ti *Instance) login() {
 			if err == io.EOF {
 				break
 			}
-			ti.notify("Login error: %s", err)
+			ti.notifyError(MsgLoginError, err)
 			return
 		}
 
 		if url := resp.GetData(); url != "" {
-			// #nosec G204 -- user input is not passed in
-			cmd := exec.Command("xdg-open", url)
-			err = cmd.Run()
-			if err != nil {
+			if err := openURL(url); err != nil {
 				log.Println(internal.ErrorPrefix, "Failed to open login webpage:", err)
 				// we want to force a notification here, otherwise there will be no reaction to user action
-				ti.notifyForce("Continue log in in the browser: %s", url)
+				ti.notifyForce(MsgLoginInBrowser, url)
 			}
 		}
 	}
 }
 
+// openURL opens the URL in the default browser
+func openURL(url string) error {
+	// #nosec G204 -- user input is not passed in
+	return exec.Command("xdg-open", url).Run()
+}
+
 func (ti *Instance) logout(persistToken bool) bool {
 	resp, err := ti.client.Logout(context.Background(), &pb.LogoutRequest{
 		PersistToken: persistToken,
 	})
 	if err != nil {
-		ti.notify("Logout error: %s", err)
+		ti.notifyError(MsgLogoutError, err)
 		return false
 	}
 
@@ -78,15 +82,26 @@ func (ti *Instance) logout(persistToken bool) bool {
 	}
 }
 
-func (ti *Instance) notifyServiceExpired(url string, trustedPassURL string, message string) {
+// subscriptionLink returns the link which logs the user in to the account automatically if the trusted pass
+// is available
+func (ti *Instance) subscriptionLink(url string, trustedPassURL string) string {
 	resp, err := ti.client.TokenInfo(context.Background(), &pb.Empty{})
-
-	link := url
 	if err == nil && (resp.TrustedPassToken != "" && resp.TrustedPassOwnerId != "") {
-		link = fmt.Sprintf(trustedPassURL, resp.TrustedPassToken, resp.TrustedPassOwnerId)
+		return fmt.Sprintf(trustedPassURL, resp.TrustedPassToken, resp.TrustedPassOwnerId)
 	}
+	return url
+}
 
-	ti.notifyForce(message, link)
+func (ti *Instance) notifyServiceExpired(url string, trustedPassURL string, message string) {
+	ti.notifyForce(message, ti.subscriptionLink(url, trustedPassURL))
+}
+
+func (ti *Instance) openSubscription() {
+	link := ti.subscriptionLink(client.SubscriptionURL, client.SubscriptionURLLogin)
+	if err := openURL(link); err != nil {
+		log.Println(internal.ErrorPrefix, "Failed to open subscription page:", err)
+		ti.notifyForce(MsgOpenSubscription, link)
+	}
 }
 
 func (ti *Instance) connect(serverTag string, serverGroup string) bool {
@@ -105,7 +120,7 @@ func (ti *Instance) connect(serverTag string, serverGroup string) bool {
 		ServerGroup: serverGroup,
 	})
 	if err != nil {
-		ti.notify("Connect error: %s", err)
+		ti.notifyError(MsgConnectError, err)
 		return false
 	}
 
@@ -115,19 +130,22 @@ func (ti *Instance) connect(serverTag string, serverGroup string) bool {
 			if err == io.EOF {
 				break
 			}
-			ti.notify("Connect error: %s", err)
+			ti.notifyError(MsgConnectError, err)
 			return false
 		}
 
 		switch out.Type {
 		case internal.CodeFailure:
-			ti.notify("Connect error: %s", nordclient.ConnectCantConnect)
+			ti.notifyError(MsgConnectError, nordclient.ConnectCantConnect)
 		case internal.CodeExpiredRenewToken:
 			ti.notify(nordclient.RelogRequest)
 			ti.login()
 			return ti.connect(serverTag, serverGroup)
 		case internal.CodeTokenRenewError:
-			ti.notify(nordclient.AccountTokenRenewError)
+			ti.notifyWithActions(
+				[]notificationAction{ti.loginAction(), ti.openSettingsAction()},
+				nordclient.AccountTokenRenewError,
+			)
 		case internal.CodeAccountExpired:
 			ti.notifyServiceExpired(client.SubscriptionURL, client.SubscriptionURLLogin, cli.ExpiredAccountMessage)
 		case internal.CodeDedicatedIPRenewError:
@@ -160,7 +178,7 @@ func (ti *Instance) connect(serverTag string, serverGroup string) bool {
 func (ti *Instance) disconnect() bool {
 	resp, err := ti.client.Disconnect(context.Background(), &pb.Empty{})
 	if err != nil {
-		ti.notify("Disconnect error: %s", err)
+		ti.notifyError(MsgDisconnectError, err)
 		return false
 	}
 
@@ -170,7 +188,7 @@ func (ti *Instance) disconnect() bool {
 			if err == io.EOF {
 				break
 			}
-			ti.notify("Disconnect error: %s", err)
+			ti.notifyError(MsgDisconnectError, err)
 			return false
 		}
 
@@ -183,10 +201,29 @@ func (ti *Instance) disconnect() bool {
 	return true
 }
 
+func (ti *Instance) pause(duration time.Duration) bool {
+	resp, err := ti.client.Pause(context.Background(), &pb.PauseRequest{Duration: int64(duration)})
+	if err != nil {
+		ti.notifyError(MsgPauseError, err)
+		return false
+	}
+
+	switch resp.Type {
+	case internal.CodeSuccess:
+		return true
+	case internal.CodeVPNNotRunning:
+		ti.notify(cli.DisconnectNotConnected)
+		return true
+	default:
+		ti.notifyError(MsgPauseError, internal.ErrUnhandled)
+		return false
+	}
+}
+
 func (ti *Instance) setNotify(flag bool) bool {
-	flagText := "off"
+	flagText := MsgOff
 	if flag {
-		flagText = "on"
+		flagText = MsgOn
 	}
 	resp, err := ti.client.SetNotify(context.Background(), &pb.SetNotifyRequest{
 		Uid:    int64(os.Getuid()),
@@ -194,40 +231,43 @@ func (ti *Instance) setNotify(flag bool) bool {
 	})
 	if err != nil {
 		log.Printf("%s Setting notifications %s error: %s", internal.ErrorPrefix, flagText, err)
-		ti.notify("Setting notifications %s error: %s", flagText, err)
+		ti.notifyError(MsgSetNotificationsError, flagText, err)
 		return false
 	}
 
 	switch resp.Type {
 	case internal.CodeConfigError:
-		log.Printf("%s Setting notifications %s error: %s", internal.ErrorPrefix, flagText, "Config file error")
-		ti.notify("Setting notifications %s error: %s", flagText, "Config file error")
+		log.Printf("%s Setting notifications %s error: %s", internal.ErrorPrefix, flagText, MsgConfigFileError)
+		ti.notifyError(MsgSetNotificationsError, flagText, MsgConfigFileError)
 		return false
 	case internal.CodeNothingToDo:
 	case internal.CodeSuccess:
 	}
 
-	_, err = ti.fileshareClient.SetNotifications(context.Background(), &filesharepb.SetNotificationsRequest{Enable: flag})
-	if err != nil {
-		log.Printf("%s Setting fileshare notifications %s error: %s", internal.ErrorPrefix, flagText, err)
+	// fileshare is not running in minimal mode
+	if !ti.minimal {
+		_, err = ti.fileshareClient.SetNotifications(context.Background(), &filesharepb.SetNotificationsRequest{Enable: flag})
+		if err != nil {
+			log.Printf("%s Setting fileshare notifications %s error: %s", internal.ErrorPrefix, flagText, err)
+		}
 	}
 
 	if resp.Type == internal.CodeNothingToDo {
-		ti.notify("Notifications already %s", flagText)
+		ti.notify(MsgNotificationsAlready, flagText)
 	}
 
 	return true
 }
 
 func (ti *Instance) setTray(flag bool) bool {
-	flagText := "off"
+	flagText := MsgOff
 	if flag {
-		flagText = "on"
+		flagText = MsgOn
 	}
 
 	if !flag {
-		log.Printf("%s Tray icon disabled. To enable it again, run the \"nordvpn set tray on command\".", internal.InfoPrefix)
-		ti.notifyForce("Tray icon disabled. To enable it again, run the \"nordvpn set tray on command\".")
+		log.Println(internal.InfoPrefix, MsgTrayDisabled)
+		ti.notifyForce(MsgTrayDisabled)
 	}
 
 	resp, err := ti.client.SetTray(context.Background(), &pb.SetTrayRequest{
@@ -236,17 +276,17 @@ func (ti *Instance) setTray(flag bool) bool {
 	})
 	if err != nil {
 		log.Printf("%s Setting tray %s error: %s", internal.ErrorPrefix, flagText, err)
-		ti.notify("Setting tray %s error: %s", flagText, err)
+		ti.notifyError(MsgSetTrayError, flagText, err)
 		return false
 	}
 
 	switch resp.Type {
 	case internal.CodeConfigError:
-		log.Printf("%s Setting tray %s error: %s", internal.ErrorPrefix, flagText, "Config file error")
-		ti.notify("Setting tray %s error: %s", flagText, "Config file error")
+		log.Printf("%s Setting tray %s error: %s", internal.ErrorPrefix, flagText, MsgConfigFileError)
+		ti.notifyError(MsgSetTrayError, flagText, MsgConfigFileError)
 		return false
 	case internal.CodeNothingToDo:
-		ti.notify("Tray already %s", flagText)
+		ti.notify(MsgTrayAlready, flagText)
 	case internal.CodeSuccess:
 	}
 
@@ -0,0 +1,123 @@
+package tray
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+
+	"github.com/NordSecurity/nordvpn-linux/cli"
+	"github.com/NordSecurity/nordvpn-linux/fileshare"
+	filesharepb "github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"golang.org/x/exp/slices"
+)
+
+// incomingTransfer is a transfer waiting to be accepted or declined by the user
+type incomingTransfer struct {
+	id    string
+	peer  string
+	files int
+	size  uint64
+}
+
+// pendingIncomingTransfers picks the incoming transfers which were not accepted yet
+func pendingIncomingTransfers(transfers []*filesharepb.Transfer) []incomingTransfer {
+	var pending []incomingTransfer
+	for _, transfer := range transfers {
+		if transfer.GetDirection() != filesharepb.Direction_INCOMING ||
+			transfer.GetStatus() != filesharepb.Status_REQUESTED {
+			continue
+		}
+		pending = append(pending, incomingTransfer{
+			id:    transfer.GetId(),
+			peer:  transfer.GetPeer(),
+			files: len(transfer.GetFiles()),
+			size:  transfer.GetTotalSize(),
+		})
+	}
+	return pending
+}
+
+func (ti *Instance) updateIncomingTransfers() bool {
+	stream, err := ti.fileshareClient.List(context.Background(), &filesharepb.Empty{})
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "Error listing fileshare transfers:", err)
+		return false
+	}
+
+	var transfers []*filesharepb.Transfer
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				log.Println(internal.ErrorPrefix, "Error listing fileshare transfers:", err)
+				return false
+			}
+			break
+		}
+		if err := cli.FileshareResponseToError(resp.GetError()); err != nil {
+			// fileshare responds with an error while meshnet is disabled
+			transfers = nil
+			break
+		}
+		transfers = append(transfers, resp.GetTransfers()...)
+	}
+	incomingTransfers := pendingIncomingTransfers(transfers)
+
+	ti.state.mu.Lock()
+	defer ti.state.mu.Unlock()
+	if slices.Equal(ti.state.incomingTransfers, incomingTransfers) {
+		return false
+	}
+	ti.state.incomingTransfers = incomingTransfers
+	return true
+}
+
+// acceptTransfer downloads the files to the default download directory
+func (ti *Instance) acceptTransfer(transfer incomingTransfer) bool {
+	path, err := fileshare.GetDefaultDownloadDirectory()
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "Failed to find default download directory:", err)
+		ti.notifyError(MsgFileshareAcceptError, transfer.peer, err)
+		return false
+	}
+
+	stream, err := ti.fileshareClient.Accept(context.Background(), &filesharepb.AcceptRequest{
+		TransferId: transfer.id,
+		DstPath:    path,
+		Silent:     true,
+	})
+	if err != nil {
+		ti.notifyError(MsgFileshareAcceptError, transfer.peer, err)
+		return false
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		ti.notifyError(MsgFileshareAcceptError, transfer.peer, err)
+		return false
+	}
+	if err := cli.FileshareResponseToError(resp.GetError(), path); err != nil {
+		ti.notifyError(MsgFileshareAcceptError, transfer.peer, err)
+		return false
+	}
+
+	ti.notify(MsgFileshareAccepted, transfer.peer, path)
+	return true
+}
+
+func (ti *Instance) declineTransfer(transfer incomingTransfer) bool {
+	resp, err := ti.fileshareClient.Cancel(context.Background(), &filesharepb.CancelRequest{TransferId: transfer.id})
+	if err == nil {
+		err = cli.FileshareResponseToError(resp)
+	}
+	if err != nil {
+		ti.notifyError(MsgFileshareDeclineError, transfer.peer, err)
+		return false
+	}
+
+	ti.notify(MsgFileshareDeclined, transfer.peer)
+	return true
+}
@@ -0,0 +1,69 @@
+package tray
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	filesharepb "github.com/NordSecurity/nordvpn-linux/fileshare/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"google.golang.org/grpc"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockFileshareClient struct {
+	filesharepb.FileshareClient
+	transfers []*filesharepb.Transfer
+}
+
+type mockListClient struct {
+	filesharepb.Fileshare_ListClient
+	responses []*filesharepb.ListResponse
+}
+
+func (m *mockListClient) Recv() (*filesharepb.ListResponse, error) {
+	if len(m.responses) == 0 {
+		return nil, io.EOF
+	}
+	resp := m.responses[0]
+	m.responses = m.responses[1:]
+	return resp, nil
+}
+
+func (m *mockFileshareClient) List(
+	context.Context, *filesharepb.Empty, ...grpc.CallOption,
+) (filesharepb.Fileshare_ListClient, error) {
+	return &mockListClient{responses: []*filesharepb.ListResponse{{
+		Error:     &filesharepb.Error{Response: &filesharepb.Error_Empty{Empty: &filesharepb.Empty{}}},
+		Transfers: m.transfers,
+	}}}, nil
+}
+
+func TestUpdateIncomingTransfers(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	client := &mockFileshareClient{transfers: []*filesharepb.Transfer{
+		{
+			Id:        "incoming",
+			Direction: filesharepb.Direction_INCOMING,
+			Peer:      "laptop",
+			Status:    filesharepb.Status_REQUESTED,
+			Files:     []*filesharepb.File{{Id: "a"}, {Id: "b"}},
+			TotalSize: 2048,
+		},
+		{Id: "accepted", Direction: filesharepb.Direction_INCOMING, Status: filesharepb.Status_ONGOING},
+		{Id: "outgoing", Direction: filesharepb.Direction_OUTGOING, Status: filesharepb.Status_REQUESTED},
+	}}
+	ti := Instance{fileshareClient: client}
+
+	assert.True(t, ti.updateIncomingTransfers())
+	assert.Equal(t, []incomingTransfer{{id: "incoming", peer: "laptop", files: 2, size: 2048}},
+		ti.state.incomingTransfers)
+
+	assert.False(t, ti.updateIncomingTransfers(), "unchanged transfers should not redraw the menu")
+
+	client.transfers = nil
+	assert.True(t, ti.updateIncomingTransfers())
+	assert.Empty(t, ti.state.incomingTransfers)
+}
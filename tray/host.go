@@ -0,0 +1,114 @@
+package tray
+
+import (
+	"log"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/NordSecurity/systray"
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	statusNotifierWatcherName = "org.kde.StatusNotifierWatcher"
+	// xembedProxyBinary registers StatusNotifierWatcher and shows the items in the legacy XEmbed
+	// system tray, e.g. i3bar
+	xembedProxyBinary      = "snixembed"
+	HostPollInterval       = 10 * time.Second
+	HostMissingNotifyDelay = 30 * time.Second
+)
+
+// statusNotifierWatcherRunning checks if any process owns StatusNotifierWatcher name on the session bus
+func statusNotifierWatcherRunning() bool {
+	conn, err := dbus.SessionBus()
+	if err != nil {
+		return false
+	}
+
+	var hasOwner bool
+	if err := conn.BusObject().Call(
+		"org.freedesktop.DBus.NameHasOwner", 0, statusNotifierWatcherName,
+	).Store(&hasOwner); err != nil {
+		return false
+	}
+	return hasOwner
+}
+
+// xembedProxyPath returns path of the XEmbed proxy executable if it can be used in the current session
+func xembedProxyPath(getenv func(string) string, lookPath func(string) (string, error)) (string, bool) {
+	// XEmbed tray is only available under X11 (or XWayland with an X11 tray)
+	if getenv("DISPLAY") == "" {
+		return "", false
+	}
+
+	path, err := lookPath(xembedProxyBinary)
+	if err != nil {
+		return "", false
+	}
+	return path, true
+}
+
+// startXEmbedProxy starts XEmbed proxy so the tray is shown on desktops without StatusNotifierItem
+// host, e.g. i3
+func (ti *Instance) startXEmbedProxy() bool {
+	path, ok := xembedProxyPath(os.Getenv, exec.LookPath)
+	if !ok {
+		return false
+	}
+
+	// #nosec G204 -- path is looked up from the constant name
+	cmd := exec.Command(path)
+	if err := cmd.Start(); err != nil {
+		log.Println(internal.WarningPrefix, "Failed to start XEmbed tray proxy:", err)
+		return false
+	}
+	log.Println(internal.InfoPrefix, "Started XEmbed tray proxy", path)
+
+	go func() {
+		if err := cmd.Wait(); err != nil && ti.debugMode {
+			log.Println(internal.DebugPrefix, "XEmbed tray proxy exited:", err)
+		}
+	}()
+
+	ti.xembedProxy = cmd
+	return true
+}
+
+func (ti *Instance) stopXEmbedProxy() {
+	if ti.xembedProxy == nil || ti.xembedProxy.Process == nil {
+		return
+	}
+	if err := ti.xembedProxy.Process.Kill(); err != nil {
+		log.Println(internal.WarningPrefix, "Failed to stop XEmbed tray proxy:", err)
+	}
+	ti.xembedProxy = nil
+}
+
+// WaitForHost blocks until the system tray host is available. When there is no StatusNotifierItem
+// host, XEmbed proxy is started if it is installed. Otherwise user is notified once that the tray
+// can't be displayed instead of silently not showing it.
+func (ti *Instance) WaitForHost() {
+	if systray.IsAvailable() {
+		return
+	}
+
+	if !statusNotifierWatcherRunning() && ti.startXEmbedProxy() {
+		log.Println(internal.InfoPrefix, "No StatusNotifierItem host found, using XEmbed system tray")
+	} else {
+		log.Println(internal.WarningPrefix, "No system tray host found, waiting for it to appear")
+	}
+
+	notified := false
+	start := time.Now()
+	for !systray.IsAvailable() {
+		if !notified && time.Since(start) >= HostMissingNotifyDelay {
+			log.Println(internal.WarningPrefix, "System tray is still not available")
+			ti.notifyForce(msgTrayHostMissing, xembedProxyBinary)
+			notified = true
+		}
+		<-time.After(HostPollInterval)
+	}
+}
@@ -0,0 +1,56 @@
+package tray
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestXEmbedProxyPath(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	installed := func(name string) (string, error) { return "/usr/bin/" + name, nil }
+	missing := func(string) (string, error) { return "", errors.New("not found") }
+
+	tests := []struct {
+		name         string
+		display      string
+		lookPath     func(string) (string, error)
+		expectedPath string
+		expectedOk   bool
+	}{
+		{
+			name:         "proxy installed under X11",
+			display:      ":0",
+			lookPath:     installed,
+			expectedPath: "/usr/bin/" + xembedProxyBinary,
+			expectedOk:   true,
+		},
+		{
+			name:     "proxy not installed",
+			display:  ":0",
+			lookPath: missing,
+		},
+		{
+			name:     "no X11 display",
+			lookPath: installed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			getenv := func(key string) string {
+				if key == "DISPLAY" {
+					return test.display
+				}
+				return ""
+			}
+			path, ok := xembedProxyPath(getenv, test.lookPath)
+			assert.Equal(t, test.expectedPath, path)
+			assert.Equal(t, test.expectedOk, ok)
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package tray
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	portalGlobalShortcutsInterface = "org.freedesktop.portal.GlobalShortcuts"
+	portalRequestInterface         = "org.freedesktop.portal.Request"
+	portalSessionInterface         = "org.freedesktop.portal.Session"
+	// portalResponseTimeout is long, because the desktop may ask the user to confirm the shortcut
+	portalResponseTimeout = 2 * time.Minute
+	hotkeyToggleVPN       = "toggle-vpn"
+	// hotkeyToggleVPNTrigger is only a suggestion, the desktop decides on the actual key binding
+	hotkeyToggleVPNTrigger = "CTRL+ALT+N"
+	hotkeySessionToken     = "nordvpn_hotkey_session"
+	hotkeyBindToken        = "nordvpn_hotkey_bind"
+	hotkeyCheckInterval    = 5 * time.Second
+	hotkeyRetryInterval    = 30 * time.Second
+)
+
+var (
+	errPortalRequestFailed   = errors.New("portal request was denied or cancelled")
+	errPortalResponseTimeout = errors.New("portal did not respond in time")
+	errSessionBusClosed      = errors.New("session bus connection closed")
+)
+
+// portalShortcut is a shortcut description in the a(sa{sv}) format of the GlobalShortcuts portal
+type portalShortcut struct {
+	ID      string
+	Options map[string]dbus.Variant
+}
+
+// parsePortalResponse extracts the results from the body of the org.freedesktop.portal.Request.Response signal
+func parsePortalResponse(body []any) (map[string]dbus.Variant, error) {
+	if len(body) != 2 {
+		return nil, fmt.Errorf("unexpected portal response: %v", body)
+	}
+	if code, ok := body[0].(uint32); !ok || code != 0 {
+		return nil, errPortalRequestFailed
+	}
+	results, _ := body[1].(map[string]dbus.Variant)
+	return results, nil
+}
+
+// sessionHandle returns the session handle from the CreateSession results. It is documented as a string, but
+// some portal implementations send an object path.
+func sessionHandle(results map[string]dbus.Variant) (dbus.ObjectPath, error) {
+	switch handle := results["session_handle"].Value().(type) {
+	case string:
+		return dbus.ObjectPath(handle), nil
+	case dbus.ObjectPath:
+		return handle, nil
+	default:
+		return "", errors.New("portal session handle is missing")
+	}
+}
+
+// portalRequest calls the portal method which replies asynchronously with the Response signal of the returned
+// request object. Other signals received in the meantime are dropped.
+func portalRequest(
+	conn *dbus.Conn,
+	signals <-chan *dbus.Signal,
+	method string,
+	args ...any,
+) (map[string]dbus.Variant, error) {
+	var request dbus.ObjectPath
+	if err := conn.Object(portalDestination, portalPath).Call(method, 0, args...).Store(&request); err != nil {
+		return nil, err
+	}
+
+	timeout := time.After(portalResponseTimeout)
+	for {
+		select {
+		case signal, ok := <-signals:
+			if !ok {
+				return nil, errSessionBusClosed
+			}
+			if signal.Path == request && signal.Name == portalRequestInterface+".Response" {
+				return parsePortalResponse(signal.Body)
+			}
+		case <-timeout:
+			return nil, errPortalResponseTimeout
+		}
+	}
+}
+
+// hotkeyMonitor registers the global shortcut toggling VPN while it is enabled in the user settings
+func (ti *Instance) hotkeyMonitor() {
+	for {
+		if !ti.hotkeyEnabled() {
+			time.Sleep(hotkeyCheckInterval)
+			continue
+		}
+		if err := ti.watchHotkey(); err != nil {
+			log.Println(internal.WarningPrefix, "Global shortcut is not available:", err)
+			time.Sleep(hotkeyRetryInterval)
+		}
+	}
+}
+
+func (ti *Instance) hotkeyEnabled() bool {
+	ti.state.mu.RLock()
+	defer ti.state.mu.RUnlock()
+	return ti.state.hotkeyEnabled
+}
+
+// watchHotkey binds the shortcut and handles its activations until it is disabled
+func (ti *Instance) watchHotkey() error {
+	conn, err := dbus.ConnectSessionBus()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := conn.AddMatchSignal(
+		dbus.WithMatchInterface(portalRequestInterface),
+		dbus.WithMatchMember("Response"),
+	); err != nil {
+		return err
+	}
+	if err := conn.AddMatchSignal(
+		dbus.WithMatchObjectPath(portalPath),
+		dbus.WithMatchInterface(portalGlobalShortcutsInterface),
+		dbus.WithMatchMember("Activated"),
+	); err != nil {
+		return err
+	}
+
+	signals := make(chan *dbus.Signal, 10)
+	conn.Signal(signals)
+	defer conn.RemoveSignal(signals)
+
+	results, err := portalRequest(conn, signals, portalGlobalShortcutsInterface+".CreateSession",
+		map[string]dbus.Variant{
+			"handle_token":         dbus.MakeVariant(hotkeySessionToken),
+			"session_handle_token": dbus.MakeVariant(hotkeySessionToken),
+		})
+	if err != nil {
+		return err
+	}
+	session, err := sessionHandle(results)
+	if err != nil {
+		return err
+	}
+	defer conn.Object(portalDestination, session).Call(portalSessionInterface+".Close", 0)
+
+	shortcuts := []portalShortcut{{
+		ID: hotkeyToggleVPN,
+		Options: map[string]dbus.Variant{
+			"description":       dbus.MakeVariant(MsgHotkeyToggleVPN),
+			"preferred_trigger": dbus.MakeVariant(hotkeyToggleVPNTrigger),
+		},
+	}}
+	if _, err := portalRequest(conn, signals, portalGlobalShortcutsInterface+".BindShortcuts",
+		session, shortcuts, "", map[string]dbus.Variant{"handle_token": dbus.MakeVariant(hotkeyBindToken)},
+	); err != nil {
+		return err
+	}
+	log.Println(internal.InfoPrefix, "Registered global shortcut for toggling VPN")
+
+	ticker := time.NewTicker(hotkeyCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case signal, ok := <-signals:
+			if !ok {
+				return errSessionBusClosed
+			}
+			if signal.Name != portalGlobalShortcutsInterface+".Activated" || len(signal.Body) < 2 {
+				continue
+			}
+			signalSession, _ := signal.Body[0].(dbus.ObjectPath)
+			id, _ := signal.Body[1].(string)
+			if signalSession == session && id == hotkeyToggleVPN {
+				go ti.toggleVPN()
+			}
+		case <-ticker.C:
+			if !ti.hotkeyEnabled() {
+				log.Println(internal.InfoPrefix, "Unregistered global shortcut for toggling VPN")
+				return nil
+			}
+		}
+	}
+}
+
+// toggleVPN connects to the recommended server or disconnects depending on the current status. Activations
+// received while the previous one is still in progress are ignored.
+func (ti *Instance) toggleVPN() {
+	if !ti.togglingVPN.CompareAndSwap(false, true) {
+		return
+	}
+	defer ti.togglingVPN.Store(false)
+
+	ti.state.mu.RLock()
+	connected := ti.state.vpnStatus == ConnectedString
+	ti.state.mu.RUnlock()
+
+	var success bool
+	if connected {
+		success = ti.disconnect()
+	} else {
+		success = ti.connect("", "")
+	}
+	if success {
+		ti.updateChan <- true
+	}
+}
@@ -0,0 +1,57 @@
+package tray
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePortalResponse(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	results := map[string]dbus.Variant{"session_handle": dbus.MakeVariant("/session")}
+
+	tests := []struct {
+		name            string
+		body            []any
+		expectedResults map[string]dbus.Variant
+		expectedErr     bool
+	}{
+		{name: "success", body: []any{uint32(0), results}, expectedResults: results},
+		{name: "cancelled", body: []any{uint32(1), results}, expectedErr: true},
+		{name: "other error", body: []any{uint32(2), map[string]dbus.Variant{}}, expectedErr: true},
+		{name: "malformed", body: []any{uint32(0)}, expectedErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results, err := parsePortalResponse(test.body)
+			if test.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedResults, results)
+		})
+	}
+}
+
+func TestSessionHandle(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	const path = "/org/freedesktop/portal/desktop/session/1_1/nordvpn"
+
+	handle, err := sessionHandle(map[string]dbus.Variant{"session_handle": dbus.MakeVariant(path)})
+	assert.NoError(t, err)
+	assert.Equal(t, dbus.ObjectPath(path), handle)
+
+	handle, err = sessionHandle(map[string]dbus.Variant{"session_handle": dbus.MakeVariant(dbus.ObjectPath(path))})
+	assert.NoError(t, err)
+	assert.Equal(t, dbus.ObjectPath(path), handle)
+
+	_, err = sessionHandle(map[string]dbus.Variant{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,228 @@
+package tray
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/notify"
+
+	"github.com/NordSecurity/systray"
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	portalDestination        = "org.freedesktop.portal.Desktop"
+	portalPath               = "/org/freedesktop/portal/desktop"
+	portalSettingsInterface  = "org.freedesktop.portal.Settings"
+	portalAppearanceKey      = "org.freedesktop.appearance"
+	portalColorSchemeKey     = "color-scheme"
+	portalReadTimeout        = 2 * time.Second
+	colorSchemeRetryInterval = 30 * time.Second
+)
+
+// colorScheme is a dark/light preference of the desktop
+type colorScheme int
+
+const (
+	colorSchemeUnknown colorScheme = iota
+	colorSchemeDark
+	colorSchemeLight
+)
+
+var errColorSchemeUnavailable = errors.New("color scheme preference is not available")
+
+// iconNames returns names of the icons used when VPN is connected and disconnected
+func iconNames(theme config.TrayIconTheme, scheme colorScheme, desktop string) (string, string) {
+	switch theme {
+	case config.TrayIconTheme_SYMBOLIC:
+		return "nordvpn-tray-connected-symbolic", "nordvpn-tray-disconnected-symbolic"
+	case config.TrayIconTheme_COLORED:
+		// fixed icons which do not follow the dark/light preference
+		switch {
+		case strings.Contains(desktop, "kde"):
+			// TODO: Kubuntu uses dark tray background instead KDE default white
+			return "nordvpn-tray-blue", "nordvpn-tray-black"
+		case strings.Contains(desktop, "mate"):
+			return "nordvpn-tray-blue", "nordvpn-tray-gray"
+		default:
+			return "nordvpn-tray-blue", "nordvpn-tray-white"
+		}
+	}
+
+	if scheme == colorSchemeUnknown {
+		scheme = desktopColorScheme(desktop)
+	}
+
+	if theme == config.TrayIconTheme_MONOCHROME {
+		if scheme == colorSchemeLight {
+			return "nordvpn-tray-black", "nordvpn-tray-gray"
+		}
+		return "nordvpn-tray-white", "nordvpn-tray-gray"
+	}
+
+	if scheme == colorSchemeLight {
+		return "nordvpn-tray-blue", "nordvpn-tray-black"
+	}
+	return "nordvpn-tray-blue", "nordvpn-tray-white"
+}
+
+// degradedIconName returns name of the icon used when quality of the VPN connection is degraded. It is not
+// following the color scheme, because it has to stand out.
+func degradedIconName(theme config.TrayIconTheme) string {
+	if theme == config.TrayIconTheme_SYMBOLIC {
+		return "nordvpn-tray-degraded-symbolic"
+	}
+	return "nordvpn-tray-degraded"
+}
+
+// desktopColorScheme guesses panel color of the desktops which do not report it
+func desktopColorScheme(desktop string) colorScheme {
+	if strings.Contains(desktop, "kde") {
+		return colorSchemeLight
+	}
+	return colorSchemeDark
+}
+
+// currentDesktop returns lowercase name of the current desktop environment
+func currentDesktop() string {
+	return strings.ToLower(os.Getenv("XDG_CURRENT_DESKTOP"))
+}
+
+// parseColorScheme converts org.freedesktop.appearance color-scheme value
+func parseColorScheme(value dbus.Variant) colorScheme {
+	// Settings.Read wraps the value into an additional variant
+	if inner, ok := value.Value().(dbus.Variant); ok {
+		value = inner
+	}
+
+	scheme, ok := value.Value().(uint32)
+	if !ok {
+		return colorSchemeUnknown
+	}
+
+	switch scheme {
+	case 1:
+		return colorSchemeDark
+	case 2:
+		return colorSchemeLight
+	default:
+		return colorSchemeUnknown
+	}
+}
+
+// readColorScheme asks the xdg desktop portal for the dark/light preference
+func readColorScheme(conn *dbus.Conn) (colorScheme, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), portalReadTimeout)
+	defer cancel()
+
+	var value dbus.Variant
+	err := conn.Object(portalDestination, portalPath).CallWithContext(
+		ctx,
+		portalSettingsInterface+".Read",
+		0,
+		portalAppearanceKey,
+		portalColorSchemeKey,
+	).Store(&value)
+	if err != nil {
+		if strings.Contains(strings.ToLower(os.Getenv("GTK_THEME")), ":dark") {
+			return colorSchemeDark, nil
+		}
+		return colorSchemeUnknown, errors.Join(errColorSchemeUnavailable, err)
+	}
+
+	return parseColorScheme(value), nil
+}
+
+// colorSchemeMonitor follows dark/light preference changes of the desktop and updates the icons
+func (ti *Instance) colorSchemeMonitor() {
+	for {
+		if err := ti.watchColorScheme(); err != nil && ti.debugMode {
+			log.Println(internal.DebugPrefix, "Color scheme monitor:", err)
+		}
+		time.Sleep(colorSchemeRetryInterval)
+	}
+}
+
+func (ti *Instance) watchColorScheme() error {
+	conn, err := dbus.ConnectSessionBus()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	scheme, err := readColorScheme(conn)
+	ti.setColorScheme(scheme)
+	if err != nil {
+		return err
+	}
+
+	if err := conn.AddMatchSignal(
+		dbus.WithMatchObjectPath(portalPath),
+		dbus.WithMatchInterface(portalSettingsInterface),
+		dbus.WithMatchMember("SettingChanged"),
+	); err != nil {
+		return err
+	}
+
+	signals := make(chan *dbus.Signal, 10)
+	conn.Signal(signals)
+	defer conn.RemoveSignal(signals)
+
+	for signal := range signals {
+		if len(signal.Body) != 3 {
+			continue
+		}
+		namespace, _ := signal.Body[0].(string)
+		key, _ := signal.Body[1].(string)
+		value, ok := signal.Body[2].(dbus.Variant)
+		if namespace != portalAppearanceKey || key != portalColorSchemeKey || !ok {
+			continue
+		}
+		ti.setColorScheme(parseColorScheme(value))
+	}
+
+	return errors.New("session bus connection closed")
+}
+
+func (ti *Instance) setColorScheme(scheme colorScheme) {
+	ti.state.mu.Lock()
+	defer ti.state.mu.Unlock()
+
+	if ti.state.colorScheme != scheme {
+		ti.state.colorScheme = scheme
+		ti.updateIcons()
+	}
+}
+
+// Not thread safe. Lock ti.state.mu before using
+func (ti *Instance) updateIcons() {
+	connected, disconnected := iconNames(ti.state.trayIconTheme, ti.state.colorScheme, currentDesktop())
+	ti.iconConnected = notify.GetIconPath(connected)
+	ti.iconDisconnected = notify.GetIconPath(disconnected)
+	ti.iconDegraded = notify.GetIconPath(degradedIconName(ti.state.trayIconTheme))
+
+	if !ti.state.systrayRunning {
+		return
+	}
+	if ti.state.vpnStatus == ConnectedString {
+		systray.SetIconName(ti.connectedIcon())
+	} else {
+		systray.SetIconName(ti.iconDisconnected)
+	}
+}
+
+// connectedIcon returns the icon of the active connection, which reflects its quality
+//
+// Not thread safe. Lock ti.state.mu before using
+func (ti *Instance) connectedIcon() string {
+	if ti.state.connectionQuality.isDegraded() {
+		return ti.iconDegraded
+	}
+	return ti.iconConnected
+}
@@ -0,0 +1,102 @@
+package tray
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIconNames(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name                 string
+		theme                config.TrayIconTheme
+		scheme               colorScheme
+		desktop              string
+		expectedConnected    string
+		expectedDisconnected string
+	}{
+		{
+			name:                 "auto dark",
+			theme:                config.TrayIconTheme_AUTO,
+			scheme:               colorSchemeDark,
+			desktop:              "gnome",
+			expectedConnected:    "nordvpn-tray-blue",
+			expectedDisconnected: "nordvpn-tray-white",
+		},
+		{
+			name:                 "auto light",
+			theme:                config.TrayIconTheme_AUTO,
+			scheme:               colorSchemeLight,
+			desktop:              "gnome",
+			expectedConnected:    "nordvpn-tray-blue",
+			expectedDisconnected: "nordvpn-tray-black",
+		},
+		{
+			name:                 "auto unknown falls back to desktop",
+			theme:                config.TrayIconTheme_AUTO,
+			scheme:               colorSchemeUnknown,
+			desktop:              "kde",
+			expectedConnected:    "nordvpn-tray-blue",
+			expectedDisconnected: "nordvpn-tray-black",
+		},
+		{
+			name:                 "colored ignores preference",
+			theme:                config.TrayIconTheme_COLORED,
+			scheme:               colorSchemeLight,
+			desktop:              "mate",
+			expectedConnected:    "nordvpn-tray-blue",
+			expectedDisconnected: "nordvpn-tray-gray",
+		},
+		{
+			name:                 "monochrome dark",
+			theme:                config.TrayIconTheme_MONOCHROME,
+			scheme:               colorSchemeDark,
+			expectedConnected:    "nordvpn-tray-white",
+			expectedDisconnected: "nordvpn-tray-gray",
+		},
+		{
+			name:                 "monochrome light",
+			theme:                config.TrayIconTheme_MONOCHROME,
+			scheme:               colorSchemeLight,
+			expectedConnected:    "nordvpn-tray-black",
+			expectedDisconnected: "nordvpn-tray-gray",
+		},
+		{
+			name:                 "symbolic",
+			theme:                config.TrayIconTheme_SYMBOLIC,
+			scheme:               colorSchemeLight,
+			expectedConnected:    "nordvpn-tray-connected-symbolic",
+			expectedDisconnected: "nordvpn-tray-disconnected-symbolic",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			connected, disconnected := iconNames(test.theme, test.scheme, test.desktop)
+			assert.Equal(t, test.expectedConnected, connected)
+			assert.Equal(t, test.expectedDisconnected, disconnected)
+		})
+	}
+}
+
+func TestParseColorScheme(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, colorSchemeDark, parseColorScheme(dbus.MakeVariant(uint32(1))))
+	assert.Equal(t, colorSchemeLight, parseColorScheme(dbus.MakeVariant(dbus.MakeVariant(uint32(2)))))
+	assert.Equal(t, colorSchemeUnknown, parseColorScheme(dbus.MakeVariant(uint32(0))))
+	assert.Equal(t, colorSchemeUnknown, parseColorScheme(dbus.MakeVariant("dark")))
+}
+
+func TestDegradedIconName(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	assert.Equal(t, "nordvpn-tray-degraded-symbolic", degradedIconName(config.TrayIconTheme_SYMBOLIC))
+	assert.Equal(t, "nordvpn-tray-degraded", degradedIconName(config.TrayIconTheme_COLORED))
+}
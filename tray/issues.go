@@ -0,0 +1,126 @@
+package tray
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// recentIssuesLimit is the number of errors kept for the recent issues submenu
+const recentIssuesLimit = 10
+
+// recentIssueTimeFormat is used for the timestamps of the recent issues in the menu and in the copied report
+const recentIssueTimeFormat = "2006-01-02 15:04:05"
+
+var errNoClipboardTool = errors.New("no clipboard tool found")
+
+type recentIssue struct {
+	time time.Time
+	text string
+}
+
+func (i recentIssue) String() string {
+	return fmt.Sprintf("%s %s", i.time.Format(recentIssueTimeFormat), i.text)
+}
+
+// issueLog is a ring buffer of the last errors reported to the user
+type issueLog struct {
+	issues [recentIssuesLimit]recentIssue
+	next   int
+	count  int
+}
+
+func (l *issueLog) add(issue recentIssue) {
+	l.issues[l.next] = issue
+	l.next = (l.next + 1) % recentIssuesLimit
+	if l.count < recentIssuesLimit {
+		l.count++
+	}
+}
+
+// list returns the issues starting from the most recent one
+func (l *issueLog) list() []recentIssue {
+	issues := make([]recentIssue, 0, l.count)
+	for i := 1; i <= l.count; i++ {
+		issues = append(issues, l.issues[(l.next-i+recentIssuesLimit)%recentIssuesLimit])
+	}
+	return issues
+}
+
+func (l *issueLog) clear() {
+	*l = issueLog{}
+}
+
+// issuesReport formats the issues for pasting into a bug report
+func issuesReport(issues []recentIssue) string {
+	lines := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		lines = append(lines, issue.String())
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// notifyError records the error in the recent issues and notifies the user about it
+func (ti *Instance) notifyError(text string, a ...any) {
+	issue := recentIssue{time: time.Now(), text: fmt.Sprintf(text, a...)}
+	ti.state.mu.Lock()
+	ti.state.recentIssues.add(issue)
+	ti.state.mu.Unlock()
+	ti.redraw(true)
+	ti.notify("%s", issue.text)
+}
+
+func (ti *Instance) copyRecentIssues() {
+	ti.state.mu.RLock()
+	issues := ti.state.recentIssues.list()
+	ti.state.mu.RUnlock()
+
+	if err := copyToClipboard(issuesReport(issues)); err != nil {
+		log.Println(internal.ErrorPrefix, "Failed to copy recent issues:", err)
+		ti.notifyForce(MsgIssuesCopyError)
+		return
+	}
+	ti.notifyForce(MsgIssuesCopied)
+}
+
+func (ti *Instance) clearRecentIssues() {
+	ti.state.mu.Lock()
+	ti.state.recentIssues.clear()
+	ti.state.mu.Unlock()
+	ti.redraw(true)
+}
+
+// clipboardCommand returns the command which reads the clipboard content from stdin
+func clipboardCommand() ([]string, error) {
+	var candidates [][]string
+	if os.Getenv("WAYLAND_DISPLAY") != "" {
+		candidates = append(candidates, []string{"wl-copy"})
+	}
+	candidates = append(candidates,
+		[]string{"xclip", "-selection", "clipboard"},
+		[]string{"xsel", "--clipboard", "--input"},
+	)
+	for _, candidate := range candidates {
+		if _, err := exec.LookPath(candidate[0]); err == nil {
+			return candidate, nil
+		}
+	}
+	return nil, errNoClipboardTool
+}
+
+func copyToClipboard(text string) error {
+	command, err := clipboardCommand()
+	if err != nil {
+		return err
+	}
+	// #nosec G204 -- only the fixed clipboard commands are run
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+	return cmd.Run()
+}
@@ -0,0 +1,50 @@
+package tray
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueLog(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	issue := func(i int) recentIssue {
+		return recentIssue{time: start.Add(time.Duration(i) * time.Second), text: fmt.Sprintf("error %d", i)}
+	}
+
+	var l issueLog
+	assert.Empty(t, l.list())
+
+	l.add(issue(0))
+	l.add(issue(1))
+	assert.Equal(t, []recentIssue{issue(1), issue(0)}, l.list())
+
+	for i := 2; i < recentIssuesLimit+3; i++ {
+		l.add(issue(i))
+	}
+	issues := l.list()
+	assert.Len(t, issues, recentIssuesLimit)
+	assert.Equal(t, issue(recentIssuesLimit+2), issues[0])
+	assert.Equal(t, issue(3), issues[recentIssuesLimit-1])
+
+	l.clear()
+	assert.Empty(t, l.list())
+}
+
+func TestIssuesReport(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	issues := []recentIssue{
+		{time: time.Date(2024, 5, 1, 12, 0, 5, 0, time.UTC), text: "Connect error: timeout"},
+		{time: time.Date(2024, 5, 1, 11, 59, 0, 0, time.UTC), text: "Login error: canceled"},
+	}
+	assert.Equal(t,
+		"2024-05-01 12:00:05 Connect error: timeout\n2024-05-01 11:59:00 Login error: canceled\n",
+		issuesReport(issues))
+}
@@ -8,14 +8,18 @@ import (
 	"time"
 
 	"github.com/NordSecurity/systray"
+	"github.com/hako/durafmt"
+	"golang.org/x/exp/slices"
 
+	"github.com/NordSecurity/nordvpn-linux/cli"
+	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/norduser"
 )
 
 func addDebugSection(ti *Instance) {
 	systray.AddSeparator()
-	m := systray.AddMenuItem("Active goroutines", "Active goroutines")
+	m := systray.AddMenuItem(MenuActiveGoroutines, MenuActiveGoroutines)
 	m.Disable()
 	go func() {
 		for {
@@ -25,11 +29,11 @@ func addDebugSection(ti *Instance) {
 					return
 				}
 			case <-time.After(1 * time.Second):
-				m.SetTitle(fmt.Sprintf("Active goroutines: %d", runtime.NumGoroutine()))
+				m.SetTitle(fmt.Sprintf(MenuActiveGoroutinesCount, runtime.NumGoroutine()))
 			}
 		}
 	}()
-	mRedraw := systray.AddMenuItem("Redraw", "Redraw")
+	mRedraw := systray.AddMenuItem(MenuRedraw, MenuRedraw)
 	go func() {
 		for {
 			_, open := <-mRedraw.ClickedCh
@@ -39,7 +43,7 @@ func addDebugSection(ti *Instance) {
 			ti.redrawChan <- struct{}{}
 		}
 	}()
-	mUpdate := systray.AddMenuItem("Update", "Update")
+	mUpdate := systray.AddMenuItem(MenuUpdate, MenuUpdate)
 	go func() {
 		for {
 			_, open := <-mUpdate.ClickedCh
@@ -49,7 +53,7 @@ func addDebugSection(ti *Instance) {
 			ti.updateChan <- false
 		}
 	}()
-	mUpdateFull := systray.AddMenuItem("Full update", "Full update")
+	mUpdateFull := systray.AddMenuItem(MenuFullUpdate, MenuFullUpdate)
 	go func() {
 		for {
 			_, open := <-mUpdateFull.ClickedCh
@@ -63,15 +67,15 @@ func addDebugSection(ti *Instance) {
 
 func addQuitItem(ti *Instance) {
 	systray.AddSeparator()
-	m := systray.AddMenuItem("Quit", "Quit")
+	m := systray.AddMenuItem(MenuQuit, MenuQuit)
 	m.Enable()
 	go func() {
 		_, open := <-m.ClickedCh
 		if !open {
 			return
 		}
-		log.Printf("%s Shutting down norduserd. To restart the process, run the \"nordvpn set tray on command\".", internal.InfoPrefix)
-		ti.notifyForce("Shutting down norduserd. To restart the process, run the \"nordvpn set tray on command\".")
+		log.Println(internal.InfoPrefix, MsgShuttingDown)
+		ti.notifyForce(MsgShuttingDown)
 		select {
 		case ti.quitChan <- norduser.StopRequest{}:
 		default:
@@ -79,6 +83,135 @@ func addQuitItem(ti *Instance) {
 	}()
 }
 
+// addMenuSections adds the sections in the configured order, separated from each other. Sections with nothing
+// to show are skipped.
+//
+// Not thread safe. Lock mu before using
+func addMenuSections(ti *Instance) {
+	var sections []func(*Instance)
+	for _, section := range ti.state.trayMenu {
+		switch section {
+		case config.TrayMenuVPN:
+			if ti.state.loggedIn {
+				sections = append(sections, addVpnSection)
+			}
+		case config.TrayMenuFileshare:
+			if ti.state.loggedIn && len(ti.state.incomingTransfers) > 0 {
+				sections = append(sections, addFileshareSection)
+			}
+		case config.TrayMenuSettings:
+			sections = append(sections, addSettingsSection)
+		case config.TrayMenuAccount:
+			sections = append(sections, addAccountSection)
+		}
+	}
+	// without the account section the user would not know why the menu is empty
+	if !ti.state.loggedIn && !slices.Contains(ti.state.trayMenu, config.TrayMenuAccount) {
+		sections = append([]func(*Instance){func(*Instance) { addNotLoggedInItem() }}, sections...)
+	}
+
+	for i, addSection := range sections {
+		if i > 0 {
+			systray.AddSeparator()
+		}
+		addSection(ti)
+	}
+}
+
+// addOnboardingSection shows the current step of the first run setup at the top of the menu
+//
+// Not thread safe. Lock mu before using
+func addOnboardingSection(ti *Instance) {
+	step := ti.state.onboardingStep
+	switch step {
+	case onboardingLogin:
+		mLogin := systray.AddMenuItem(MenuOnboardingLogin, MenuOnboardingLogin)
+		go func() {
+			for {
+				_, open := <-mLogin.ClickedCh
+				if !open {
+					return
+				}
+				ti.login()
+			}
+		}()
+	case onboardingAutoConnect:
+		addOnboardingQuestion(MenuOnboardingAutoConnect, ti.onboardingEnableAutoConnect,
+			func() { ti.advanceOnboarding(step) })
+	case onboardingNotifications:
+		addOnboardingQuestion(MenuOnboardingNotify, ti.onboardingEnableNotifications,
+			func() { ti.advanceOnboarding(step) })
+	case onboardingUndecided, onboardingDone:
+		return
+	}
+	systray.AddSeparator()
+}
+
+func addOnboardingQuestion(question string, turnOn func(), skip func()) {
+	mQuestion := systray.AddMenuItem(question, question)
+	mQuestion.Disable()
+	mTurnOn := systray.AddMenuItem(MenuTurnOn, MenuTurnOn)
+	mSkip := systray.AddMenuItem(MenuNotNow, MenuNotNow)
+	go func() {
+		for {
+			select {
+			case _, open := <-mTurnOn.ClickedCh:
+				if !open {
+					return
+				}
+				turnOn()
+			case _, open := <-mSkip.ClickedCh:
+				if !open {
+					return
+				}
+				skip()
+			}
+		}
+	}()
+}
+
+// addRecentIssuesSection lists the last errors with timestamps, so they can be attached to the bug reports
+//
+// Not thread safe. Lock mu before using
+func addRecentIssuesSection(ti *Instance) {
+	issues := ti.state.recentIssues.list()
+	if len(issues) == 0 {
+		return
+	}
+
+	systray.AddSeparator()
+	title := fmt.Sprintf(MenuRecentIssues, len(issues))
+	mIssues := systray.AddMenuItem(title, title)
+	// Workaround over the dbus issue described here: https://github.com/fyne-io/systray/issues/12
+	time.AfterFunc(100*time.Millisecond, func() { addRecentIssuesSubitems(ti, mIssues, issues) })
+}
+
+func addRecentIssuesSubitems(ti *Instance, mIssues *systray.MenuItem, issues []recentIssue) {
+	for _, issue := range issues {
+		mIssue := mIssues.AddSubMenuItem(issue.String(), issue.text)
+		mIssue.Disable()
+	}
+
+	mCopy := mIssues.AddSubMenuItem(MenuCopyToClipboard, MenuCopyToClipboard)
+	mClear := mIssues.AddSubMenuItem(MenuClear, MenuClear)
+	go func() {
+		for {
+			select {
+			case _, open := <-mCopy.ClickedCh:
+				if !open {
+					return
+				}
+				ti.copyRecentIssues()
+			case _, open := <-mClear.ClickedCh:
+				if !open {
+					return
+				}
+				ti.clearRecentIssues()
+			}
+		}
+	}()
+}
+
 func addDaemonErrorSection(ti *Instance) {
 	if ti.state.daemonAvailable {
 		systray.AddSeparator()
@@ -88,26 +221,44 @@ func addDaemonErrorSection(ti *Instance) {
 }
 
 func addVpnSection(ti *Instance) {
-	mStatus := systray.AddMenuItem("VPN "+strings.ToLower(ti.state.vpnStatus), "VPN "+strings.ToLower(ti.state.vpnStatus))
+	status := fmt.Sprintf(MenuVPNStatus, strings.ToLower(ti.state.vpnStatus))
+	mStatus := systray.AddMenuItem(status, status)
 	mStatus.Disable()
 
 	if ti.state.vpnStatus == ConnectedString {
 		vpnServerName := ti.state.serverName()
 		if vpnServerName != "" {
-			mHostname := systray.AddMenuItem("Server: "+vpnServerName, "Server: "+vpnServerName)
+			server := fmt.Sprintf(MenuServer, vpnServerName)
+			mHostname := systray.AddMenuItem(server, server)
 			mHostname.Disable()
 		}
 
 		if ti.state.vpnCity != "" {
-			mCity := systray.AddMenuItem("City: "+ti.state.vpnCity, "City: "+ti.state.vpnCity)
+			city := fmt.Sprintf(MenuCity, ti.state.vpnCity)
+			mCity := systray.AddMenuItem(city, city)
 			mCity.Disable()
 		}
 
 		if ti.state.vpnCountry != "" {
-			mCountry := systray.AddMenuItem("Country: "+ti.state.vpnCountry, "Country: "+ti.state.vpnCountry)
+			country := fmt.Sprintf(MenuCountry, ti.state.vpnCountry)
+			mCountry := systray.AddMenuItem(country, country)
 			mCountry.Disable()
 		}
-		mDisconnect := systray.AddMenuItem("Disconnect", "Disconnect")
+
+		if ti.state.vpnIP != "" {
+			ip := fmt.Sprintf(MenuIP, ti.state.vpnIP)
+			mIP := systray.AddMenuItem(ip, ip)
+			mIP.Disable()
+		}
+
+		if ti.state.vpnTechnology != "" {
+			technology := fmt.Sprintf(MenuTechnology, ti.state.connectionType())
+			mTechnology := systray.AddMenuItem(technology, technology)
+			mTechnology.Disable()
+		}
+
+		addVpnStatisticsItems(ti)
+		mDisconnect := systray.AddMenuItem(MenuDisconnect, MenuDisconnect)
 		go func() {
 			success := false
 			for !success {
@@ -119,8 +270,14 @@ func addVpnSection(ti *Instance) {
 			}
 			ti.updateChan <- true
 		}()
+		addPauseItem(ti)
 	} else {
-		mConnect := systray.AddMenuItem("Quick Connect", "Quick Connect")
+		if !ti.state.vpnPausedUntil.IsZero() {
+			paused := fmt.Sprintf(MenuPausedUntil, ti.state.vpnPausedUntil.Format(time.Kitchen))
+			mPaused := systray.AddMenuItem(paused, paused)
+			mPaused.Disable()
+		}
+		mConnect := systray.AddMenuItem(MenuQuickConnect, MenuQuickConnect)
 		go func() {
 			success := false
 			for !success {
@@ -133,22 +290,74 @@ func addVpnSection(ti *Instance) {
 			ti.updateChan <- true
 		}()
 	}
-	systray.AddSeparator()
 }
 
-func addAccountSection(ti *Instance) {
-	systray.AddSeparator()
+// pauseDurations are offered in the pause submenu
+var pauseDurations = []time.Duration{5 * time.Minute, 30 * time.Minute, time.Hour}
 
-	if ti.state.loggedIn {
-		if ti.state.accountName != "" {
-			m := systray.AddMenuItem("Logged in as:", "Logged in as:")
-			m.Disable()
+func addPauseItem(ti *Instance) {
+	mPause := systray.AddMenuItem(MenuPause, MenuPause)
+	for _, duration := range pauseDurations {
+		label := fmt.Sprintf(MenuPauseFor, durafmt.Parse(duration).String())
+		mDuration := mPause.AddSubMenuItem(label, label)
+		go func(duration time.Duration) {
+			success := false
+			for !success {
+				_, open := <-mDuration.ClickedCh
+				if !open {
+					return
+				}
+				success = ti.pause(duration)
+			}
+			ti.updateChan <- true
+		}(duration)
+	}
+}
+
+// addVpnStatisticsItems adds items for the connection details which change constantly. Instead of redrawing the
+// whole menu, their titles are updated in place until the menu is reset.
+func addVpnStatisticsItems(ti *Instance) {
+	mUptime := systray.AddMenuItem(fmt.Sprintf(MenuUptimeValue, ti.state.uptime()), MenuUptime)
+	mUptime.Disable()
+	mTransfer := systray.AddMenuItem(fmt.Sprintf(MenuTransferValue, ti.state.transfer()), MenuTransfer)
+	mTransfer.Disable()
+	quality := ti.state.connectionQualityText()
+	mQuality := systray.AddMenuItem(quality, quality)
+	mQuality.Disable()
+	serverLoad := ti.state.serverLoadText()
+	mServerLoad := systray.AddMenuItem(serverLoad, serverLoad)
+	mServerLoad.Disable()
 
-			mName := systray.AddMenuItem(ti.state.accountName, ti.state.accountName)
-			mName.Disable()
+	go func() {
+		for {
+			select {
+			case _, open := <-mUptime.ClickedCh:
+				if !open {
+					return
+				}
+			case <-time.After(1 * time.Second):
+				ti.state.mu.RLock()
+				uptime := ti.state.uptime()
+				transfer := ti.state.transfer()
+				quality := ti.state.connectionQualityText()
+				serverLoad := ti.state.serverLoadText()
+				ti.state.mu.RUnlock()
+				mUptime.SetTitle(fmt.Sprintf(MenuUptimeValue, uptime))
+				mTransfer.SetTitle(fmt.Sprintf(MenuTransferValue, transfer))
+				mQuality.SetTitle(quality)
+				mServerLoad.SetTitle(serverLoad)
+			}
 		}
+	}()
+}
 
-		mLogout := systray.AddMenuItem("Log out", "Log out")
+func addAccountSection(ti *Instance) {
+	if ti.state.loggedIn {
+		mAccount := systray.AddMenuItem(MenuAccount, MenuAccount)
+		// Workaround over the dbus issue described here: https://github.com/fyne-io/systray/issues/12
+		time.AfterFunc(100*time.Millisecond, func() { addAccountSubitems(ti, mAccount) })
+
+		mLogout := systray.AddMenuItem(MenuLogOut, MenuLogOut)
 
 		go func() {
 			success := false
@@ -162,10 +371,9 @@ func addAccountSection(ti *Instance) {
 			ti.updateChan <- true
 		}()
 	} else {
-		m := systray.AddMenuItem("Not logged in", "Not logged in")
-		m.Disable()
+		addNotLoggedInItem()
 
-		mLogin := systray.AddMenuItem("Log in", "Log in")
+		mLogin := systray.AddMenuItem(MenuLogIn, MenuLogIn)
 
 		go func() {
 			for {
@@ -179,8 +387,89 @@ func addAccountSection(ti *Instance) {
 	}
 }
 
+func addAccountSubitems(ti *Instance, mAccount *systray.MenuItem) {
+	ti.state.mu.RLock()
+	email := ti.state.accountEmail
+	subscription := ti.state.subscriptionStatus
+	ti.state.mu.RUnlock()
+
+	if email != "" {
+		mEmail := mAccount.AddSubMenuItem(fmt.Sprintf(MenuEmail, email), email)
+		mEmail.Disable()
+	}
+	if subscription != "" {
+		mSubscription := mAccount.AddSubMenuItem(subscription, subscription)
+		mSubscription.Disable()
+	}
+
+	mRenew := mAccount.AddSubMenuItem(MenuRenewSubscription, MenuRenewSubscription)
+	go func() {
+		for {
+			_, open := <-mRenew.ClickedCh
+			if !open {
+				return
+			}
+			ti.openSubscription()
+		}
+	}()
+}
+
+func addNotLoggedInItem() {
+	m := systray.AddMenuItem(MenuNotLoggedIn, MenuNotLoggedIn)
+	m.Disable()
+}
+
+// addFileshareSection lists the incoming transfers so they can be handled even if the notification was missed
+func addFileshareSection(ti *Instance) {
+	if len(ti.state.incomingTransfers) == 0 {
+		return
+	}
+
+	title := fmt.Sprintf(MenuIncomingTransfers, len(ti.state.incomingTransfers))
+	mTransfers := systray.AddMenuItem(title, title)
+	transfers := slices.Clone(ti.state.incomingTransfers)
+	// Workaround over the dbus issue described here: https://github.com/fyne-io/systray/issues/12
+	time.AfterFunc(100*time.Millisecond, func() { addFileshareSubitems(ti, mTransfers, transfers) })
+}
+
+func addFileshareSubitems(ti *Instance, mTransfers *systray.MenuItem, transfers []incomingTransfer) {
+	for _, transfer := range transfers {
+		transfer := transfer
+		title := fmt.Sprintf(MenuIncomingTransfer, transfer.peer, transfer.files, cli.Uint64ToHumanBytes(transfer.size))
+		mTransfer := mTransfers.AddSubMenuItem(title, title)
+		// nested submenu needs the same workaround as its parent
+		time.AfterFunc(100*time.Millisecond, func() { addTransferActions(ti, mTransfer, transfer) })
+	}
+}
+
+func addTransferActions(ti *Instance, mTransfer *systray.MenuItem, transfer incomingTransfer) {
+	mAccept := mTransfer.AddSubMenuItem(MenuAccept, MenuAccept)
+	mDecline := mTransfer.AddSubMenuItem(MenuDecline, MenuDecline)
+
+	go func() {
+		for {
+			select {
+			case _, open := <-mAccept.ClickedCh:
+				if !open {
+					return
+				}
+				if ti.acceptTransfer(transfer) {
+					ti.updateChan <- false
+				}
+			case _, open := <-mDecline.ClickedCh:
+				if !open {
+					return
+				}
+				if ti.declineTransfer(transfer) {
+					ti.updateChan <- false
+				}
+			}
+		}
+	}()
+}
+
 func addSettingsSection(ti *Instance) {
-	mSettings := systray.AddMenuItem("Settings", "Settings")
+	mSettings := systray.AddMenuItem(MenuSettings, MenuSettings)
 	// Workaround over the dbus issue described here: https://github.com/fyne-io/systray/issues/12
 	// (It affects not only XFCE, but also other desktop environments.)
 	time.AfterFunc(100*time.Millisecond, func() { addSettingsSubitems(ti, mSettings) })
@@ -188,8 +477,8 @@ func addSettingsSection(ti *Instance) {
 
 func addSettingsSubitems(ti *Instance, mSettings *systray.MenuItem) {
 	ti.state.mu.RLock()
-	mNotifications := mSettings.AddSubMenuItemCheckbox("Notifications", "Notifications", ti.state.notificationsStatus == Enabled)
-	mTray := mSettings.AddSubMenuItemCheckbox("Tray icon", "Tray icon", ti.state.trayStatus == Enabled)
+	mNotifications := mSettings.AddSubMenuItemCheckbox(MenuNotifications, MenuNotifications, ti.state.notificationsStatus == Enabled)
+	mTray := mSettings.AddSubMenuItemCheckbox(MenuTrayIcon, MenuTrayIcon, ti.state.trayStatus == Enabled)
 	ti.state.mu.RUnlock()
 
 	go func() {
@@ -0,0 +1,123 @@
+package tray
+
+// All of the user facing tray texts are kept here, the same way as for the CLI, so they can be
+// changed and translated in one place
+
+// Menu labels
+const (
+	MenuActiveGoroutines      = "Active goroutines"
+	MenuActiveGoroutinesCount = "Active goroutines: %d"
+	MenuRedraw                = "Redraw"
+	MenuUpdate                = "Update"
+	MenuFullUpdate            = "Full update"
+	MenuQuit                  = "Quit"
+	MenuVPNStatus             = "VPN %s"
+	MenuServer                = "Server: %s"
+	MenuCity                  = "City: %s"
+	MenuCountry               = "Country: %s"
+	MenuIP                    = "IP: %s"
+	MenuTechnology            = "Technology: %s"
+	MenuUptime                = "Uptime"
+	MenuUptimeValue           = "Uptime: %s"
+	MenuTransfer              = "Transfer"
+	MenuTransferValue         = "Transfer: %s"
+	MenuConnectionQuality     = "Connection quality: %s"
+	MenuServerLoad            = "Server load: %s"
+	MenuDisconnect            = "Disconnect"
+	MenuQuickConnect          = "Quick Connect"
+	MenuPause                 = "Pause"
+	MenuPauseFor              = "For %s"
+	MenuPausedUntil           = "Paused until %s"
+	MenuAccount               = "Account"
+	MenuEmail                 = "Email: %s"
+	MenuSubscriptionActive    = "VPN Service: Active (Expires on %s)"
+	MenuSubscriptionInactive  = "VPN Service: Inactive"
+	MenuRenewSubscription     = "Renew subscription"
+	MenuLogOut                = "Log out"
+	MenuLogIn                 = "Log in"
+	MenuNotLoggedIn           = "Not logged in"
+	MenuSettings              = "Settings"
+	MenuIncomingTransfers     = "Incoming files (%d)"
+	MenuIncomingTransfer      = "%s: %d file(s), %s"
+	MenuAccept                = "Accept"
+	MenuDecline               = "Decline"
+	MenuNotifications         = "Notifications"
+	MenuTrayIcon              = "Tray icon"
+	MenuOnboardingLogin       = "Get started: log in to NordVPN"
+	MenuOnboardingAutoConnect = "Connect automatically on startup?"
+	MenuOnboardingNotify      = "Show VPN status notifications?"
+	MenuTurnOn                = "Turn on"
+	MenuNotNow                = "Not now"
+	MenuRecentIssues          = "Recent issues (%d)"
+	MenuCopyToClipboard       = "Copy to clipboard"
+	MenuClear                 = "Clear"
+)
+
+// Tooltip and notification texts
+const (
+	MsgAppName                = "NordVPN"
+	MsgTooltipStatus          = MsgAppName + "\nVPN %s"
+	MsgVirtualLocation        = " - Virtual"
+	MsgTransferAmounts        = "%s received, %s sent"
+	MsgQualityGood            = "good"
+	MsgQualityDegraded        = "degraded"
+	MsgQualityPoor            = "poor"
+	MsgQualityUnknown         = "measuring"
+	MsgServerLoadUnknown      = "unknown"
+	MsgQualityDetails         = "%s, %.0f%% loss"
+	MsgOn                     = "on"
+	MsgOff                    = "off"
+	MsgConfigFileError        = "Config file error"
+	MsgAlreadyLoggedIn        = "You are already logged in"
+	MsgLoggedIn               = "You've successfully logged in"
+	MsgLoggedOut              = "You've logged out"
+	MsgLoginError             = "Login error: %s"
+	MsgLoginInBrowser         = "Continue log in in the browser: %s"
+	MsgLogoutError            = "Logout error: %s"
+	MsgConnectError           = "Connect error: %s"
+	MsgDisconnectError        = "Disconnect error: %s"
+	MsgPauseError             = "Pause error: %s"
+	MsgPausedUntil            = "VPN is paused. You will be connected again at %s"
+	MsgConnectedTo            = "Connected to %s"
+	MsgDisconnectedFrom       = "Disconnected from %s"
+	MsgCaptivePortal          = "This network requires you to log in: %s"
+	MsgCaptivePortalDone      = "Logged in to the network, full protection is restored"
+	MsgSetNotificationsError  = "Setting notifications %s error: %s"
+	MsgNotificationsAlready   = "Notifications already %s"
+	MsgNotificationsTurnedOn  = "Notifications for NordVPN turned on"
+	MsgNotificationsTurnedOff = "Notifications for NordVPN turned off"
+	MsgSetTrayError           = "Setting tray %s error: %s"
+	MsgTrayAlready            = "Tray already %s"
+	MsgTrayDisabled           = "Tray icon disabled. To enable it again, run the \"nordvpn set tray on command\"."
+	MsgShuttingDown           = "Shutting down norduserd. To restart the process, run the \"nordvpn set tray on command\"."
+	MsgDaemonNotRunning       = "NordVPN daemon is not running\n\n"
+	MsgAddUserToGroup         = "Add the user to the nordvpn group and reboot the system\n\nsudo usermod -aG nordvpn $USER"
+	MsgDaemonReconnected      = "Reconnected to NordVPN's background service"
+	MsgDaemonUnreachable      = "Couldn't connect to NordVPN's background service. Please ensure the service is running."
+	MsgOpenAccountSettings    = "Open account settings in the browser: %s"
+	MsgOpenSubscription       = "Renew the subscription in the browser: %s"
+	MsgFileshareAccepted      = "Downloading files from %s to %s"
+	MsgFileshareAcceptError   = "Failed to accept files from %s: %s"
+	MsgFileshareDeclined      = "Declined files from %s"
+	MsgFileshareDeclineError  = "Failed to decline files from %s: %s"
+	MsgOnboardingWelcome      = "Welcome to NordVPN! Log in to start protecting your connection."
+	MsgOnboardingAutoConnect  = "Do you want to connect to VPN automatically when the computer starts?"
+	MsgOnboardingNotify       = "Do you want to be notified when VPN connects or disconnects?"
+	MsgOnboardingDone         = "You're all set. Use the NordVPN icon in the system tray to connect to VPN."
+	MsgSetAutoConnectError    = "Setting auto-connect %s error: %s"
+	MsgHotkeyToggleVPN        = "Connect to or disconnect from NordVPN"
+	MsgIssuesCopied           = "Recent issues copied to the clipboard"
+	MsgIssuesCopyError        = "Couldn't copy recent issues. Install wl-clipboard, xclip or xsel to use the clipboard."
+	msgTrayHostMissing        = "NordVPN tray icon can't be displayed because there is no system tray " +
+		"running on your desktop. Install a StatusNotifierItem host or %s to see the tray icon."
+)
+
+// Notification action labels
+const (
+	ActionReconnect    = "Reconnect"
+	ActionLogIn        = "Log in"
+	ActionOpenSettings = "Open settings"
+	ActionOpenLogin    = "Open login page"
+	ActionTurnOn       = "Turn on"
+	ActionNotNow       = "Not now"
+)
@@ -9,11 +9,14 @@ import (
 
 	"github.com/NordSecurity/nordvpn-linux/cli"
 	"github.com/NordSecurity/nordvpn-linux/client"
+	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/norduser"
 	"github.com/NordSecurity/nordvpn-linux/snapconf"
 
 	"github.com/NordSecurity/systray"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/status"
 )
 
@@ -49,6 +52,7 @@ func (ti *Instance) updateLoginStatus() bool {
 
 	if !loggedIn && ti.state.loggedIn && ti.state.vpnStatus == ConnectedString {
 		// reset the VPN info if the user logs out while connected to VPN
+		ti.setVpnDetails(&pb.StatusResponse{State: "Disconnected"})
 		ti.setVpnStatus("Disconnected", "", "", "", "", false)
 	}
 
@@ -57,13 +61,14 @@ func (ti *Instance) updateLoginStatus() bool {
 	if !ti.state.loggedIn && loggedIn {
 		ti.state.loggedIn = true
 		changed = true
-		defer ti.notify("You've successfully logged in")
+		defer ti.notify(MsgLoggedIn)
 	} else if ti.state.loggedIn && !loggedIn {
 		ti.state.loggedIn = false
 		ti.accountInfo.reset()
-		ti.state.accountName = ""
+		ti.state.accountEmail = ""
+		ti.state.subscriptionStatus = ""
 		changed = true
-		defer ti.notify("You've logged out")
+		defer ti.notifyWithActions([]notificationAction{ti.loginAction()}, MsgLoggedOut)
 	}
 
 	ti.state.mu.Unlock()
@@ -71,12 +76,16 @@ func (ti *Instance) updateLoginStatus() bool {
 }
 
 func (ti *Instance) updateVpnStatus() bool {
-	changed := false
 	resp, err := ti.client.Status(context.Background(), &pb.Empty{})
 	if err != nil {
 		return ti.updateDaemonConnectionStatus(messageForDaemonError(err))
 	}
 
+	return ti.handleVpnStatus(resp)
+}
+
+func (ti *Instance) handleVpnStatus(resp *pb.StatusResponse) bool {
+	changed := false
 	vpnStatus := resp.State
 	vpnHostname := resp.Hostname
 	vpnCity := resp.City
@@ -94,15 +103,56 @@ func (ti *Instance) updateVpnStatus() bool {
 		changed = ti.updateSettings() || changed
 	}
 
+	changed = ti.setVpnDetails(resp) || changed
+	ti.setCaptivePortal(resp.CaptivePortal)
 	return ti.setVpnStatus(vpnStatus, vpnName, vpnHostname, vpnCity, vpnCountry, resp.VirtualLocation) || changed
 }
 
+// statusStreamMonitor keeps VPN status up to date using the daemon status stream. While the stream is not
+// available, VPN status is polled by the pollingMonitor.
+func (ti *Instance) statusStreamMonitor() {
+	for {
+		stream, err := ti.client.StatusStream(context.Background(), &pb.Empty{})
+		if err == nil {
+			for {
+				resp, err := stream.Recv()
+				if err != nil {
+					break
+				}
+				ti.setStatusStreamActive(true)
+
+				ti.state.mu.RLock()
+				loggedIn := ti.state.loggedIn
+				ti.state.mu.RUnlock()
+				if loggedIn {
+					ti.redraw(ti.handleVpnStatus(resp))
+				}
+			}
+		}
+
+		if ti.setStatusStreamActive(false) && ti.debugMode {
+			log.Println(internal.DebugPrefix, "Status stream closed, falling back to polling")
+		}
+		<-time.After(StatusStreamRetryInterval)
+	}
+}
+
+// setStatusStreamActive returns true if the status stream state has changed
+func (ti *Instance) setStatusStreamActive(active bool) bool {
+	ti.state.mu.Lock()
+	defer ti.state.mu.Unlock()
+	changed := ti.state.statusStreamActive != active
+	ti.state.statusStreamActive = active
+	return changed
+}
+
 func (ti *Instance) updateSettings() bool {
 	const errorRetrievingSettingsLog = "Error retrieving settings:"
 	changed := false
 
 	resp, err := ti.client.Settings(context.Background(), &pb.Empty{})
 	var settings *pb.UserSpecificSettings
+	var trayMenu []string
 
 	if err != nil {
 		log.Println(internal.ErrorPrefix, errorRetrievingSettingsLog, err)
@@ -112,6 +162,10 @@ func (ti *Instance) updateSettings() bool {
 			log.Println(internal.ErrorPrefix, errorRetrievingSettingsLog, client.ConfigMessage)
 		case internal.CodeSuccess:
 			settings = resp.Data.UserSettings
+			trayMenu = config.TrayMenuOrDefault(resp.Data.TrayMenu)
+			if resp.Data.TrayMinimal != ti.minimal {
+				ti.requestRestart()
+			}
 		default:
 			log.Println(internal.ErrorPrefix, errorRetrievingSettingsLog, internal.ErrUnhandled)
 		}
@@ -138,11 +192,11 @@ func (ti *Instance) updateSettings() bool {
 		ti.state.notificationsStatus = newNotificationsStatus
 
 		if newNotificationsStatus == Enabled {
-			defer ti.notifyForce("Notifications for NordVPN turned on")
-			defer log.Println(internal.InfoPrefix, "Notifications for NordVPN turned on")
+			defer ti.notifyForce(MsgNotificationsTurnedOn)
+			defer log.Println(internal.InfoPrefix, MsgNotificationsTurnedOn)
 		} else {
-			defer ti.notifyForce("Notifications for NordVPN turned off")
-			defer log.Println(internal.InfoPrefix, "Notifications for NordVPN turned off")
+			defer ti.notifyForce(MsgNotificationsTurnedOff)
+			defer log.Println(internal.InfoPrefix, MsgNotificationsTurnedOff)
 		}
 	}
 
@@ -167,11 +221,32 @@ func (ti *Instance) updateSettings() bool {
 		}
 	}
 
+	ti.state.hotkeyEnabled = settings.TrayHotkey
+
+	if !slices.Equal(ti.state.trayMenu, trayMenu) {
+		changed = true
+		ti.state.trayMenu = trayMenu
+	}
+
+	if ti.state.trayIconTheme != settings.TrayIconTheme {
+		ti.state.trayIconTheme = settings.TrayIconTheme
+		ti.updateIcons()
+	}
+
 	ti.state.mu.Unlock()
 
 	return changed
 }
 
+// requestRestart asks norduserd to restart, because menu sections and fileshare are set up only on startup
+func (ti *Instance) requestRestart() {
+	log.Println(internal.InfoPrefix, "Tray minimal mode changed, restarting norduserd")
+	select {
+	case ti.quitChan <- norduser.StopRequest{Restart: true}:
+	default:
+	}
+}
+
 func (ti *Instance) updateAccountInfo() bool {
 	payload, err := ti.accountInfo.getAccountInfo(ti.client)
 	if err != nil {
@@ -183,7 +258,6 @@ func (ti *Instance) updateAccountInfo() bool {
 	}
 	changed := false
 	vpnActive := ti.state.vpnActive
-	var accountName string
 
 	switch payload.Type {
 	case internal.CodeUnauthorized:
@@ -194,12 +268,6 @@ func (ti *Instance) updateAccountInfo() bool {
 		log.Println(internal.ErrorPrefix, "CodeTokenRenewError")
 	}
 
-	if payload.Username != "" {
-		accountName = payload.Username
-	} else {
-		accountName = payload.Email
-	}
-
 	switch payload.Type {
 	case internal.CodeSuccess:
 		vpnActive = true
@@ -214,8 +282,14 @@ func (ti *Instance) updateAccountInfo() bool {
 		changed = true
 	}
 
-	if ti.state.accountName != accountName {
-		ti.state.accountName = accountName
+	if ti.state.accountEmail != payload.Email {
+		ti.state.accountEmail = payload.Email
+		changed = true
+	}
+
+	subscriptionStatus := formatSubscriptionStatus(payload.Type, payload.ExpiresAt)
+	if ti.state.subscriptionStatus != subscriptionStatus {
+		ti.state.subscriptionStatus = subscriptionStatus
 		changed = true
 	}
 
@@ -223,6 +297,22 @@ func (ti *Instance) updateAccountInfo() bool {
 	return changed
 }
 
+// formatSubscriptionStatus returns the VPN service status displayed in the account menu or an empty string if
+// the status is unknown
+func formatSubscriptionStatus(accountType int64, expiresAt string) string {
+	switch accountType {
+	case internal.CodeSuccess:
+		expiry, err := time.Parse(internal.ServerDateFormat, expiresAt)
+		if err != nil {
+			return ""
+		}
+		return fmt.Sprintf(MenuSubscriptionActive, expiry.Format("Jan 2, 2006"))
+	case internal.CodeNoService:
+		return MenuSubscriptionInactive
+	}
+	return ""
+}
+
 func (ti *Instance) redraw(result bool) {
 	if result {
 		select {
@@ -244,11 +334,25 @@ func (ti *Instance) pollingMonitor() {
 		if ti.state.daemonAvailable {
 			ti.redraw(ti.updateLoginStatus())
 			ti.redraw(ti.updateSettings())
+			ti.redraw(ti.updateOnboarding())
 			if ti.state.loggedIn {
-				if fullUpdate {
+				// account details are not displayed in minimal mode
+				if fullUpdate && !ti.minimal {
 					ti.redraw(ti.updateAccountInfo())
 				}
-				ti.redraw(ti.updateVpnStatus())
+				ti.state.mu.RLock()
+				fileshareShown := slices.Contains(ti.state.trayMenu, config.TrayMenuFileshare)
+				ti.state.mu.RUnlock()
+				// fileshare is not running in minimal mode
+				if !ti.minimal && ti.fileshareClient != nil && fileshareShown {
+					ti.redraw(ti.updateIncomingTransfers())
+				}
+				ti.state.mu.RLock()
+				statusStreamActive := ti.state.statusStreamActive
+				ti.state.mu.RUnlock()
+				if !statusStreamActive {
+					ti.redraw(ti.updateVpnStatus())
+				}
 				if fullUpdate {
 					fullUpdateLast = time.Now()
 				}
@@ -300,7 +404,7 @@ func messageForDaemonError(err error) string {
 	}
 
 	if strings.Contains(errorMessage, "no such file or directory") {
-		message := "NordVPN daemon is not running\n\n"
+		message := MsgDaemonNotRunning
 		if snapconf.IsUnderSnap() {
 			message += "sudo snap start nordvpn"
 		} else {
@@ -310,7 +414,7 @@ func messageForDaemonError(err error) string {
 	}
 
 	if strings.Contains(errorMessage, "permission denied") || strings.Contains(errorMessage, "connection reset by peer") {
-		return "Add the user to the nordvpn group and reboot the system\n\nsudo usermod -aG nordvpn $USER"
+		return MsgAddUserToGroup
 	}
 
 	if snapconf.IsUnderSnap() {
@@ -336,9 +440,9 @@ func (ti *Instance) updateDaemonConnectionStatus(errorMessage string) bool {
 		changed = true
 		ti.state.daemonAvailable = daemonAvailable
 		if daemonAvailable {
-			defer ti.notify("Reconnected to NordVPN's background service")
+			defer ti.notify(MsgDaemonReconnected)
 		} else {
-			defer ti.notify("Couldn't connect to NordVPN's background service. Please ensure the service is running.")
+			defer ti.notify(MsgDaemonUnreachable)
 		}
 	}
 
@@ -364,20 +468,30 @@ func (ti *Instance) setVpnStatus(
 
 	notifyConnected := func() {
 		// use this helper function to ensure that the connected notification is displaying the latest info from ti.state on defer
-		ti.notify("Connected to %s", ti.state.serverName())
+		ti.notify(MsgConnectedTo, ti.state.serverName())
 	}
 
 	if ti.state.vpnStatus != vpnStatus {
+		// quality of the previous connection is not relevant anymore
+		ti.state.connectionQuality = qualityUnknown
+		ti.state.qualityDetails = ""
 		if vpnStatus == ConnectedString {
 			if ti.state.systrayRunning {
-				systray.SetIconName(ti.iconConnected)
+				systray.SetIconName(ti.connectedIcon())
 			}
 			defer notifyConnected()
 		} else {
 			if ti.state.systrayRunning {
 				systray.SetIconName(ti.iconDisconnected)
 			}
-			defer ti.notify(fmt.Sprintf("Disconnected from %s", ti.state.serverName()))
+			if ti.state.vpnPausedUntil.IsZero() {
+				defer ti.notifyWithActions(
+					[]notificationAction{ti.reconnectAction()},
+					MsgDisconnectedFrom, ti.state.serverName(),
+				)
+			} else {
+				defer ti.notify(MsgPausedUntil, ti.state.vpnPausedUntil.Format(time.Kitchen))
+			}
 		}
 		ti.state.vpnStatus = vpnStatus
 		changed = true
@@ -396,6 +510,64 @@ func (ti *Instance) setVpnStatus(
 	ti.state.vpnCountry = vpnCountry
 	ti.state.vpnVirtualLocation = virtualLocation
 
+	if ti.state.systrayRunning {
+		systray.SetTooltip(ti.state.tooltip())
+	}
+
 	ti.state.mu.Unlock()
 	return changed
 }
+
+// setCaptivePortal notifies the user when the network requires logging in to the captive portal and once the login
+// is done
+func (ti *Instance) setCaptivePortal(portalURL string) {
+	ti.state.mu.Lock()
+	previous := ti.state.captivePortal
+	ti.state.captivePortal = portalURL
+	ti.state.mu.Unlock()
+
+	switch {
+	case portalURL == previous:
+	case portalURL != "":
+		ti.notifyWithActions([]notificationAction{ti.openLoginAction(portalURL)}, MsgCaptivePortal, portalURL)
+	default:
+		ti.notify(MsgCaptivePortalDone)
+	}
+}
+
+// setVpnDetails updates connection details which are changing constantly, thus they don't require menu redraw.
+// Only the change of the pause is reported.
+func (ti *Instance) setVpnDetails(resp *pb.StatusResponse) bool {
+	ti.state.mu.Lock()
+	defer ti.state.mu.Unlock()
+
+	var pausedUntil time.Time
+	if resp.PausedUntil != 0 {
+		pausedUntil = time.Unix(resp.PausedUntil, 0)
+	}
+	pauseChanged := !ti.state.vpnPausedUntil.Equal(pausedUntil)
+	ti.state.vpnPausedUntil = pausedUntil
+
+	if resp.State != ConnectedString {
+		ti.state.vpnTechnology = ""
+		ti.state.vpnProtocol = ""
+		ti.state.vpnIP = ""
+		ti.state.vpnUptime = -1
+		ti.state.vpnDownload = 0
+		ti.state.vpnUpload = 0
+		ti.state.vpnServerLoad = -1
+		return pauseChanged
+	}
+
+	ti.state.lastServerTag, ti.state.lastServerGroup = reconnectTarget(resp)
+	ti.state.vpnTechnology = resp.Technology.String()
+	ti.state.vpnProtocol = resp.Protocol.String()
+	if resp.Protocol == config.Protocol_UNKNOWN_PROTOCOL {
+		ti.state.vpnProtocol = ""
+	}
+	ti.state.vpnIP = resp.Ip
+	ti.state.vpnUptime = time.Duration(resp.Uptime)
+	ti.state.vpnDownload = resp.Download
+	ti.state.vpnUpload = resp.Upload
+	return pauseChanged
+}
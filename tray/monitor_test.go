@@ -0,0 +1,49 @@
+package tray
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/internal"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatSubscriptionStatus(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name        string
+		accountType int64
+		expiresAt   string
+		expected    string
+	}{
+		{
+			name:        "active subscription",
+			accountType: internal.CodeSuccess,
+			expiresAt:   "2026-03-04 10:00:00",
+			expected:    "VPN Service: Active (Expires on Mar 4, 2026)",
+		},
+		{
+			name:        "invalid expiry date",
+			accountType: internal.CodeSuccess,
+			expiresAt:   "tomorrow",
+			expected:    "",
+		},
+		{
+			name:        "inactive subscription",
+			accountType: internal.CodeNoService,
+			expected:    MenuSubscriptionInactive,
+		},
+		{
+			name:        "unknown status",
+			accountType: internal.CodeUnauthorized,
+			expected:    "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, formatSubscriptionStatus(test.accountType, test.expiresAt))
+		})
+	}
+}
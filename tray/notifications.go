@@ -4,24 +4,97 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"sync"
 
 	"github.com/esiqveland/notify"
 	"github.com/godbus/dbus/v5"
 
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	inotify "github.com/NordSecurity/nordvpn-linux/notify"
 )
 
 var dbusNotifierNotConnectedError = errors.New("dbus notifier not connected")
 
+// notificationActionsCapability is reported by the notification servers which can display actions
+const notificationActionsCapability = "actions"
+
+// notificationAction is a button displayed in the notification
+type notificationAction struct {
+	key     string
+	label   string
+	handler func()
+}
+
+// reconnectAction connects to the same location, group or server as the latest connection. Not thread safe. Lock
+// ti.state.mu before using
+func (ti *Instance) reconnectAction() notificationAction {
+	serverTag, serverGroup := ti.state.lastServerTag, ti.state.lastServerGroup
+	return notificationAction{key: "reconnect", label: ActionReconnect, handler: func() {
+		if ti.connect(serverTag, serverGroup) {
+			ti.updateChan <- true
+		}
+	}}
+}
+
+// reconnectTarget returns the server tag and group which target the same place as the connection described by the
+// status. The location is preferred over the server, so another server is picked if the previous one is down.
+func reconnectTarget(resp *pb.StatusResponse) (serverTag string, serverGroup string) {
+	params := resp.GetParameters()
+	for name, group := range config.GroupMap {
+		if group == params.GetGroup() {
+			serverGroup = name
+			break
+		}
+	}
+
+	switch {
+	case params.GetCountry() != "" && params.GetCity() != "":
+		serverTag = internal.SnakeCase(params.GetCountry()) + " " + internal.SnakeCase(params.GetCity())
+	case params.GetCountry() != "":
+		serverTag = internal.SnakeCase(params.GetCountry())
+	case serverGroup == "":
+		// either a specific server or the recommended one was connected to
+		serverTag = resp.GetHostname()
+	}
+	return serverTag, serverGroup
+}
+
+func (ti *Instance) loginAction() notificationAction {
+	return notificationAction{key: "login", label: ActionLogIn, handler: ti.login}
+}
+
+func (ti *Instance) openSettingsAction() notificationAction {
+	return notificationAction{key: "settings", label: ActionOpenSettings, handler: func() {
+		if err := openURL(AccountSettingsURL); err != nil {
+			log.Println(internal.ErrorPrefix, "Failed to open account settings:", err)
+			ti.notifyForce(MsgOpenAccountSettings, AccountSettingsURL)
+		}
+	}}
+}
+
+func (ti *Instance) openLoginAction(portalURL string) notificationAction {
+	return notificationAction{key: "portal", label: ActionOpenLogin, handler: func() {
+		if err := openURL(portalURL); err != nil {
+			log.Println(internal.ErrorPrefix, "Failed to open captive portal:", err)
+		}
+	}}
+}
+
 func (ti *Instance) notify(text string, a ...any) {
+	ti.notifyWithActions(nil, text, a...)
+}
+
+// notifyWithActions sends a notification with the action buttons if they are supported by the notification server
+func (ti *Instance) notifyWithActions(actions []notificationAction, text string, a ...any) {
 	text = fmt.Sprintf(text, a...)
 	ti.state.mu.RLock()
 	notificationsStatus := ti.state.notificationsStatus
 	ti.state.mu.RUnlock()
 	if notificationsStatus == Enabled {
-		if err := ti.notifier.sendNotification("NordVPN", text); err != nil {
+		if err := ti.notifier.sendNotification(MsgAppName, text, actions...); err != nil {
 			if !errors.Is(err, dbusNotifierNotConnectedError) {
 				log.Println(internal.ErrorPrefix, "Failed to send notification:", err)
 			}
@@ -31,8 +104,13 @@ func (ti *Instance) notify(text string, a ...any) {
 
 // notifyForce sends a notification, ignoring users notify setting
 func (ti *Instance) notifyForce(text string, a ...any) {
+	ti.notifyForceWithActions(nil, text, a...)
+}
+
+// notifyForceWithActions sends a notification with the action buttons, ignoring users notify setting
+func (ti *Instance) notifyForceWithActions(actions []notificationAction, text string, a ...any) {
 	text = fmt.Sprintf(text, a...)
-	if err := ti.notifier.sendNotification("NordVPN", text); err != nil {
+	if err := ti.notifier.sendNotification(MsgAppName, text, actions...); err != nil {
 		if !errors.Is(err, dbusNotifierNotConnectedError) {
 			log.Println(internal.ErrorPrefix, "Failed to send forced notification:", err)
 		}
@@ -41,24 +119,52 @@ func (ti *Instance) notifyForce(text string, a ...any) {
 
 // dbusNotifier wraps github.com/esiqveland/notify notifier implementation
 type dbusNotifier struct {
-	mu       sync.Mutex
-	notifier notify.Notifier
+	mu              sync.Mutex
+	notifier        notify.Notifier
+	supportsActions bool
+	// actions maps notification IDs to the handlers of their actions
+	actions map[uint32]map[string]func()
 }
 
 func (n *dbusNotifier) start() {
-	ntf, err := newNotifier()
+	ntf, err := newNotifier(n.onAction, n.onClosed)
 	if err == nil {
 		log.Println(internal.InfoPrefix, "Started dbus notifier")
+		capabilities, err := ntf.GetCapabilities()
+		if err != nil {
+			log.Println(internal.WarningPrefix, "Failed to get notification server capabilities:", err)
+		}
 		n.mu.Lock()
 		n.notifier = ntf
+		n.supportsActions = slices.Contains(capabilities, notificationActionsCapability)
+		n.actions = map[uint32]map[string]func(){}
 		n.mu.Unlock()
 	} else {
 		log.Println(internal.ErrorPrefix, "Failed to start dbus notifier:", err)
 	}
 }
 
-// sendNotification sends notification via dbus. Thread safe.
-func (n *dbusNotifier) sendNotification(summary string, body string) error {
+// onAction runs the handler of the action invoked by the user
+func (n *dbusNotifier) onAction(signal *notify.ActionInvokedSignal) {
+	n.mu.Lock()
+	handler, ok := n.actions[signal.ID][signal.ActionKey]
+	delete(n.actions, signal.ID)
+	n.mu.Unlock()
+
+	if ok {
+		go handler()
+	}
+}
+
+func (n *dbusNotifier) onClosed(signal *notify.NotificationClosedSignal) {
+	n.mu.Lock()
+	delete(n.actions, signal.ID)
+	n.mu.Unlock()
+}
+
+// sendNotification sends notification via dbus. Actions are omitted if the notification server does not support
+// them. Thread safe.
+func (n *dbusNotifier) sendNotification(summary string, body string, actions ...notificationAction) error {
 	n.mu.Lock()
 	defer n.mu.Unlock()
 
@@ -73,16 +179,32 @@ func (n *dbusNotifier) sendNotification(summary string, body string) error {
 				"transient": dbus.MakeVariant(1),
 			},
 		}
-		if _, err := n.notifier.SendNotification(notification); err != nil {
+		if n.supportsActions {
+			for _, action := range actions {
+				notification.Actions = append(notification.Actions, notify.Action{Key: action.key, Label: action.label})
+			}
+		}
+		id, err := n.notifier.SendNotification(notification)
+		if err != nil {
 			return err
 		}
+		if len(notification.Actions) > 0 {
+			handlers := map[string]func(){}
+			for _, action := range actions {
+				handlers[action.key] = action.handler
+			}
+			n.actions[id] = handlers
+		}
 		return nil
 	} else {
 		return dbusNotifierNotConnectedError
 	}
 }
 
-func newNotifier() (notify.Notifier, error) {
+func newNotifier(
+	onAction notify.ActionInvokedHandler,
+	onClosed notify.NotificationClosedHandler,
+) (notify.Notifier, error) {
 	dbusConn, err := dbus.SessionBusPrivate()
 
 	if err != nil {
@@ -105,7 +227,7 @@ func newNotifier() (notify.Notifier, error) {
 		return nil, err
 	}
 
-	ntf, err := notify.New(dbusConn)
+	ntf, err := notify.New(dbusConn, notify.WithOnAction(onAction), notify.WithOnClosed(onClosed))
 
 	if err != nil {
 		return nil, err
@@ -0,0 +1,152 @@
+package tray
+
+import (
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/config"
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+	"github.com/esiqveland/notify"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockNotifier struct {
+	notify.Notifier
+	lastID        uint32
+	notifications []notify.Notification
+}
+
+func (m *mockNotifier) SendNotification(n notify.Notification) (uint32, error) {
+	m.lastID++
+	m.notifications = append(m.notifications, n)
+	return m.lastID, nil
+}
+
+func TestDbusNotifier_Actions(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	invoked := make(chan string, 1)
+	actions := []notificationAction{
+		{key: "reconnect", label: "Reconnect", handler: func() { invoked <- "reconnect" }},
+		{key: "login", label: "Log in", handler: func() { invoked <- "login" }},
+	}
+
+	t.Run("actions are not sent when not supported", func(t *testing.T) {
+		ntf := &mockNotifier{}
+		notifier := dbusNotifier{notifier: ntf, actions: map[uint32]map[string]func(){}}
+
+		assert.NoError(t, notifier.sendNotification("NordVPN", "Disconnected", actions...))
+		assert.Len(t, ntf.notifications, 1)
+		assert.Empty(t, ntf.notifications[0].Actions)
+		assert.Empty(t, notifier.actions)
+	})
+
+	t.Run("invoked action runs its handler", func(t *testing.T) {
+		ntf := &mockNotifier{}
+		notifier := dbusNotifier{notifier: ntf, supportsActions: true, actions: map[uint32]map[string]func(){}}
+
+		assert.NoError(t, notifier.sendNotification("NordVPN", "Disconnected", actions...))
+		assert.Equal(t, []notify.Action{
+			{Key: "reconnect", Label: "Reconnect"},
+			{Key: "login", Label: "Log in"},
+		}, ntf.notifications[0].Actions)
+
+		notifier.onAction(&notify.ActionInvokedSignal{ID: ntf.lastID, ActionKey: "login"})
+		assert.Equal(t, "login", <-invoked)
+		assert.Empty(t, notifier.actions)
+	})
+
+	t.Run("handlers are removed when notification is closed", func(t *testing.T) {
+		ntf := &mockNotifier{}
+		notifier := dbusNotifier{notifier: ntf, supportsActions: true, actions: map[uint32]map[string]func(){}}
+
+		assert.NoError(t, notifier.sendNotification("NordVPN", "Disconnected", actions...))
+		notifier.onClosed(&notify.NotificationClosedSignal{ID: ntf.lastID})
+		assert.Empty(t, notifier.actions)
+
+		notifier.onAction(&notify.ActionInvokedSignal{ID: ntf.lastID, ActionKey: "reconnect"})
+		assert.Empty(t, invoked)
+	})
+
+	t.Run("not connected", func(t *testing.T) {
+		notifier := dbusNotifier{}
+		assert.ErrorIs(t, notifier.sendNotification("NordVPN", "Disconnected", actions...), dbusNotifierNotConnectedError)
+	})
+}
+
+func TestSetCaptivePortal(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	ntf := &mockNotifier{}
+	ti := &Instance{
+		notifier: dbusNotifier{notifier: ntf, supportsActions: true, actions: map[uint32]map[string]func(){}},
+	}
+	ti.state.notificationsStatus = Enabled
+
+	ti.setCaptivePortal("")
+	assert.Empty(t, ntf.notifications)
+
+	ti.setCaptivePortal("http://10.0.0.1/login")
+	ti.setCaptivePortal("http://10.0.0.1/login")
+	assert.Len(t, ntf.notifications, 1)
+	assert.Equal(t, "This network requires you to log in: http://10.0.0.1/login", ntf.notifications[0].Body)
+	assert.Equal(t, []notify.Action{{Key: "portal", Label: ActionOpenLogin}}, ntf.notifications[0].Actions)
+
+	ti.setCaptivePortal("")
+	assert.Len(t, ntf.notifications, 2)
+	assert.Equal(t, MsgCaptivePortalDone, ntf.notifications[1].Body)
+}
+
+func TestReconnectTarget(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name          string
+		status        *pb.StatusResponse
+		expectedTag   string
+		expectedGroup string
+	}{
+		{
+			name: "city",
+			status: &pb.StatusResponse{
+				Hostname:   "de123.nordvpn.com",
+				Parameters: &pb.ConnectionParameters{Country: "Germany", City: "Frankfurt"},
+			},
+			expectedTag: "germany frankfurt",
+		},
+		{
+			name: "country and group",
+			status: &pb.StatusResponse{
+				Hostname:   "us123.nordvpn.com",
+				Parameters: &pb.ConnectionParameters{Country: "United States", Group: config.ServerGroup_P2P},
+			},
+			expectedTag:   "united_states",
+			expectedGroup: "p2p",
+		},
+		{
+			name: "group",
+			status: &pb.StatusResponse{
+				Hostname:   "ch-nl1.nordvpn.com",
+				Parameters: &pb.ConnectionParameters{Group: config.ServerGroup_DoubleVPN},
+			},
+			expectedGroup: "double_vpn",
+		},
+		{
+			name: "server",
+			status: &pb.StatusResponse{
+				Hostname:   "de123.nordvpn.com",
+				Parameters: &pb.ConnectionParameters{Source: pb.ConnectionSource_MANUAL},
+			},
+			expectedTag: "de123.nordvpn.com",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			serverTag, serverGroup := reconnectTarget(test.status)
+			assert.Equal(t, test.expectedTag, serverTag)
+			assert.Equal(t, test.expectedGroup, serverGroup)
+		})
+	}
+}
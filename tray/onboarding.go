@@ -0,0 +1,185 @@
+package tray
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+)
+
+// onboardingMarkerFile is created in the user config directory once the onboarding is finished or skipped
+const onboardingMarkerFile = "tray-onboarding-done"
+
+// onboardingStep is the current step of the guided setup shown on the first run
+type onboardingStep int
+
+const (
+	// onboardingUndecided means it is not known yet whether the onboarding is needed
+	onboardingUndecided onboardingStep = iota
+	onboardingLogin
+	onboardingAutoConnect
+	onboardingNotifications
+	onboardingDone
+)
+
+func (s onboardingStep) active() bool {
+	return s != onboardingUndecided && s != onboardingDone
+}
+
+// nextOnboardingStep returns the step after the transitions which do not need a user choice
+func nextOnboardingStep(step onboardingStep, completedBefore bool, loggedIn bool, notificationsOn bool) onboardingStep {
+	switch step {
+	case onboardingUndecided:
+		// users who were already logged in before the onboarding existed don't need it
+		if completedBefore || loggedIn {
+			return onboardingDone
+		}
+		return onboardingLogin
+	case onboardingLogin:
+		if loggedIn {
+			return onboardingAutoConnect
+		}
+	case onboardingNotifications:
+		if notificationsOn {
+			return onboardingDone
+		}
+	case onboardingAutoConnect, onboardingDone:
+	}
+	return step
+}
+
+func onboardingMarkerPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	configDir, err := internal.GetConfigDirPath(homeDir)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, onboardingMarkerFile), nil
+}
+
+func (ti *Instance) onboardingCompletedBefore() bool {
+	if ti.onboardingMarker == "" {
+		return true
+	}
+	_, err := os.Stat(ti.onboardingMarker)
+	return !errors.Is(err, os.ErrNotExist)
+}
+
+// updateOnboarding moves the onboarding forward and asks the user about the next step
+func (ti *Instance) updateOnboarding() bool {
+	ti.state.mu.Lock()
+	step := ti.state.onboardingStep
+	if step == onboardingDone || ti.state.trayStatus != Enabled {
+		ti.state.mu.Unlock()
+		return false
+	}
+	next := nextOnboardingStep(step,
+		step == onboardingUndecided && (ti.minimal || ti.onboardingCompletedBefore()),
+		ti.state.loggedIn,
+		ti.state.notificationsStatus == Enabled,
+	)
+	ti.state.onboardingStep = next
+	ti.state.mu.Unlock()
+
+	if next == step {
+		return false
+	}
+	ti.enterOnboardingStep(step, next)
+	return true
+}
+
+// advanceOnboarding finishes the step chosen by the user. Choices for the steps which are no longer current, e.g.
+// from the old notifications, are ignored.
+func (ti *Instance) advanceOnboarding(step onboardingStep) {
+	ti.state.mu.Lock()
+	if ti.state.onboardingStep != step {
+		ti.state.mu.Unlock()
+		return
+	}
+	next := step + 1
+	if next == onboardingNotifications && ti.state.notificationsStatus == Enabled {
+		next = onboardingDone
+	}
+	ti.state.onboardingStep = next
+	ti.state.mu.Unlock()
+
+	ti.enterOnboardingStep(step, next)
+	ti.redraw(true)
+}
+
+func (ti *Instance) enterOnboardingStep(previous onboardingStep, step onboardingStep) {
+	switch step {
+	case onboardingLogin:
+		ti.notifyForceWithActions([]notificationAction{ti.loginAction()}, MsgOnboardingWelcome)
+	case onboardingAutoConnect:
+		ti.notifyForceWithActions([]notificationAction{
+			{key: "onboarding_autoconnect", label: ActionTurnOn, handler: ti.onboardingEnableAutoConnect},
+			{key: "onboarding_skip", label: ActionNotNow, handler: func() { ti.advanceOnboarding(onboardingAutoConnect) }},
+		}, MsgOnboardingAutoConnect)
+	case onboardingNotifications:
+		ti.notifyForceWithActions([]notificationAction{
+			{key: "onboarding_notifications", label: ActionTurnOn, handler: ti.onboardingEnableNotifications},
+			{key: "onboarding_skip", label: ActionNotNow, handler: func() { ti.advanceOnboarding(onboardingNotifications) }},
+		}, MsgOnboardingNotify)
+	case onboardingDone:
+		if err := ti.markOnboardingDone(); err != nil {
+			log.Println(internal.ErrorPrefix, "Failed to save onboarding status:", err)
+		}
+		// nothing was shown to the users who didn't need the onboarding
+		if previous != onboardingUndecided {
+			ti.notifyForce(MsgOnboardingDone)
+		}
+	case onboardingUndecided:
+	}
+}
+
+func (ti *Instance) markOnboardingDone() error {
+	if ti.onboardingMarker == "" {
+		return nil
+	}
+	return os.WriteFile(ti.onboardingMarker, nil, internal.PermUserRW)
+}
+
+func (ti *Instance) onboardingEnableAutoConnect() {
+	if ti.setAutoConnect(true) {
+		ti.advanceOnboarding(onboardingAutoConnect)
+	}
+}
+
+func (ti *Instance) onboardingEnableNotifications() {
+	if ti.setNotify(true) {
+		ti.state.mu.Lock()
+		ti.state.notificationsStatus = Enabled
+		ti.state.mu.Unlock()
+		ti.advanceOnboarding(onboardingNotifications)
+	}
+}
+
+func (ti *Instance) setAutoConnect(flag bool) bool {
+	flagText := MsgOff
+	if flag {
+		flagText = MsgOn
+	}
+	resp, err := ti.client.SetAutoConnect(context.Background(), &pb.SetAutoconnectRequest{Enabled: flag})
+	if err != nil {
+		ti.notifyError(MsgSetAutoConnectError, flagText, err)
+		return false
+	}
+
+	switch resp.Type {
+	case internal.CodeSuccess, internal.CodeNothingToDo:
+		return true
+	case internal.CodeConfigError:
+		ti.notifyError(MsgSetAutoConnectError, flagText, MsgConfigFileError)
+	default:
+		ti.notifyError(MsgSetAutoConnectError, flagText, internal.ErrUnhandled)
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+package tray
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextOnboardingStep(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name            string
+		step            onboardingStep
+		completedBefore bool
+		loggedIn        bool
+		notificationsOn bool
+		expected        onboardingStep
+	}{
+		{name: "first run", step: onboardingUndecided, expected: onboardingLogin},
+		{name: "completed before", step: onboardingUndecided, completedBefore: true, expected: onboardingDone},
+		{name: "logged in before", step: onboardingUndecided, loggedIn: true, expected: onboardingDone},
+		{name: "waiting for login", step: onboardingLogin, expected: onboardingLogin},
+		{name: "logged in", step: onboardingLogin, loggedIn: true, expected: onboardingAutoConnect},
+		{name: "waiting for auto-connect choice", step: onboardingAutoConnect, loggedIn: true,
+			expected: onboardingAutoConnect},
+		{name: "waiting for notifications choice", step: onboardingNotifications, loggedIn: true,
+			expected: onboardingNotifications},
+		{name: "notifications turned on elsewhere", step: onboardingNotifications, loggedIn: true,
+			notificationsOn: true, expected: onboardingDone},
+		{name: "done", step: onboardingDone, expected: onboardingDone},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected,
+				nextOnboardingStep(test.step, test.completedBefore, test.loggedIn, test.notificationsOn))
+		})
+	}
+}
+
+func TestAdvanceOnboarding(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	marker := filepath.Join(t.TempDir(), onboardingMarkerFile)
+	ti := Instance{onboardingMarker: marker}
+	ti.state.onboardingStep = onboardingAutoConnect
+
+	// choice from the stale notification is ignored
+	ti.advanceOnboarding(onboardingNotifications)
+	assert.Equal(t, onboardingAutoConnect, ti.state.onboardingStep)
+
+	ti.advanceOnboarding(onboardingAutoConnect)
+	assert.Equal(t, onboardingNotifications, ti.state.onboardingStep)
+	assert.False(t, ti.onboardingCompletedBefore())
+
+	ti.advanceOnboarding(onboardingNotifications)
+	assert.Equal(t, onboardingDone, ti.state.onboardingStep)
+	assert.True(t, ti.onboardingCompletedBefore())
+}
+
+func TestAdvanceOnboarding_NotificationsAlreadyOn(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	ti := Instance{onboardingMarker: filepath.Join(t.TempDir(), onboardingMarkerFile)}
+	ti.state.onboardingStep = onboardingAutoConnect
+	ti.state.notificationsStatus = Enabled
+
+	ti.advanceOnboarding(onboardingAutoConnect)
+	assert.Equal(t, onboardingDone, ti.state.onboardingStep)
+	assert.True(t, ti.onboardingCompletedBefore())
+}
@@ -0,0 +1,161 @@
+package tray
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/internal"
+
+	"github.com/NordSecurity/systray"
+)
+
+const (
+	ConnectionQualityInterval = 30 * time.Second
+	// degradedRTT and poorRTT are round trip times to the VPN server at which calls and video start to suffer
+	degradedRTT = 250 * time.Millisecond
+	poorRTT     = time.Second
+	// staleHandshakeAge is the age after which WireGuard considers the session to be dead
+	staleHandshakeAge = 3 * time.Minute
+)
+
+// connectionQuality of the active VPN connection
+type connectionQuality int
+
+const (
+	qualityUnknown connectionQuality = iota
+	qualityGood
+	qualityDegraded
+	qualityPoor
+)
+
+func (q connectionQuality) String() string {
+	switch q {
+	case qualityGood:
+		return MsgQualityGood
+	case qualityDegraded:
+		return MsgQualityDegraded
+	case qualityPoor:
+		return MsgQualityPoor
+	case qualityUnknown:
+	}
+	return MsgQualityUnknown
+}
+
+// isDegraded reports whether the user should be warned about the connection
+func (q connectionQuality) isDegraded() bool {
+	return q == qualityDegraded || q == qualityPoor
+}
+
+// qualityFromHealth rates the connection by the packet loss and round trip time to the VPN server. When the
+// server does not answer pings at all, the handshake age is used instead, as some servers may filter pings.
+func qualityFromHealth(health *pb.TunnelHealth) connectionQuality {
+	if health == nil {
+		return qualityUnknown
+	}
+
+	if health.HandshakeAvailable && time.Duration(health.LastHandshakeAge) > staleHandshakeAge {
+		return qualityPoor
+	}
+
+	if health.PingsReceived == 0 {
+		// fresh handshake shows that the tunnel is alive even though the server does not answer pings
+		if health.PingsSent == 0 || health.HandshakeAvailable {
+			return qualityUnknown
+		}
+		return qualityPoor
+	}
+
+	loss := float64(health.PingsSent-health.PingsReceived) / float64(health.PingsSent)
+	rtt := time.Duration(health.Rtt)
+	switch {
+	case loss >= 0.5 || rtt >= poorRTT:
+		return qualityPoor
+	case loss > 0 || rtt >= degradedRTT:
+		return qualityDegraded
+	default:
+		return qualityGood
+	}
+}
+
+// qualityDetails returns the measurements shown next to the quality in the menu
+func qualityDetails(health *pb.TunnelHealth) string {
+	if health.GetPingsSent() == 0 || health.GetPingsReceived() == 0 {
+		return ""
+	}
+	loss := float64(health.PingsSent-health.PingsReceived) / float64(health.PingsSent) * 100
+	rtt := time.Duration(health.Rtt).Round(time.Millisecond)
+	return fmt.Sprintf(MsgQualityDetails, rtt, loss)
+}
+
+// connectionQualityMonitor measures the quality of the active connection and refreshes the load of the server.
+// Measurement takes a few seconds, so it is done separately from the status polling.
+func (ti *Instance) connectionQualityMonitor() {
+	ticker := time.NewTicker(ConnectionQualityInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		ti.state.mu.RLock()
+		connected := ti.state.daemonAvailable && ti.state.vpnStatus == ConnectedString
+		ti.state.mu.RUnlock()
+		if !connected {
+			continue
+		}
+
+		resp, err := ti.client.StatusVerbose(context.Background(), &pb.Empty{})
+		if err != nil {
+			log.Println(internal.ErrorPrefix, "Error retrieving tunnel health:", err)
+			continue
+		}
+		if resp.GetStatus().GetState() != ConnectedString {
+			continue
+		}
+		ti.redraw(ti.setConnectionQuality(qualityFromHealth(resp.GetHealth()), qualityDetails(resp.GetHealth())))
+		ti.updateServerLoad()
+	}
+}
+
+// updateServerLoad refreshes the server load shown next to the connection quality. It is updated in place, so
+// it does not require menu redraw.
+func (ti *Instance) updateServerLoad() {
+	resp, err := ti.client.Statistics(context.Background(), &pb.Empty{})
+	if err != nil {
+		log.Println(internal.ErrorPrefix, "Error retrieving connection statistics:", err)
+		return
+	}
+
+	ti.state.mu.Lock()
+	defer ti.state.mu.Unlock()
+	if !resp.GetConnected() || ti.state.vpnStatus != ConnectedString {
+		return
+	}
+	ti.state.vpnServerLoad = resp.GetServerLoad()
+}
+
+// setConnectionQuality updates the icon and returns true when the quality level has changed. Details are
+// refreshed in place so they don't require menu redraw.
+func (ti *Instance) setConnectionQuality(quality connectionQuality, details string) bool {
+	ti.state.mu.Lock()
+	defer ti.state.mu.Unlock()
+
+	ti.state.qualityDetails = details
+	if ti.state.connectionQuality == quality {
+		return false
+	}
+
+	ti.state.connectionQuality = quality
+	if ti.state.systrayRunning && ti.state.vpnStatus == ConnectedString {
+		systray.SetIconName(ti.connectedIcon())
+	}
+	return true
+}
+
+// Not thread safe. Lock mu before using
+func (state *trayState) connectionQualityText() string {
+	if state.qualityDetails == "" {
+		return fmt.Sprintf(MenuConnectionQuality, state.connectionQuality)
+	}
+	return fmt.Sprintf(MenuConnectionQuality, fmt.Sprintf("%s (%s)", state.connectionQuality, state.qualityDetails))
+}
@@ -0,0 +1,100 @@
+package tray
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
+	"github.com/NordSecurity/nordvpn-linux/test/category"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQualityFromHealth(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	tests := []struct {
+		name     string
+		health   *pb.TunnelHealth
+		expected connectionQuality
+	}{
+		{
+			name:     "no health",
+			expected: qualityUnknown,
+		},
+		{
+			name:     "fast and no loss",
+			health:   &pb.TunnelHealth{PingsSent: 3, PingsReceived: 3, Rtt: int64(40 * time.Millisecond)},
+			expected: qualityGood,
+		},
+		{
+			name:     "slow",
+			health:   &pb.TunnelHealth{PingsSent: 3, PingsReceived: 3, Rtt: int64(400 * time.Millisecond)},
+			expected: qualityDegraded,
+		},
+		{
+			name:     "some loss",
+			health:   &pb.TunnelHealth{PingsSent: 3, PingsReceived: 2, Rtt: int64(40 * time.Millisecond)},
+			expected: qualityDegraded,
+		},
+		{
+			name:     "high loss",
+			health:   &pb.TunnelHealth{PingsSent: 3, PingsReceived: 1, Rtt: int64(40 * time.Millisecond)},
+			expected: qualityPoor,
+		},
+		{
+			name:     "unreachable",
+			health:   &pb.TunnelHealth{PingsSent: 3, Rtt: -1},
+			expected: qualityPoor,
+		},
+		{
+			name: "pings filtered with fresh handshake",
+			health: &pb.TunnelHealth{
+				HandshakeAvailable: true,
+				LastHandshakeAge:   int64(time.Minute),
+				PingsSent:          3,
+				Rtt:                -1,
+			},
+			expected: qualityUnknown,
+		},
+		{
+			name: "stale handshake",
+			health: &pb.TunnelHealth{
+				HandshakeAvailable: true,
+				LastHandshakeAge:   int64(5 * time.Minute),
+				PingsSent:          3,
+				PingsReceived:      3,
+				Rtt:                int64(40 * time.Millisecond),
+			},
+			expected: qualityPoor,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, qualityFromHealth(test.health))
+		})
+	}
+}
+
+func TestConnectionQualityText(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	state := trayState{}
+	assert.Equal(t, "Connection quality: measuring", state.connectionQualityText())
+
+	health := &pb.TunnelHealth{PingsSent: 4, PingsReceived: 3, Rtt: int64(52300 * time.Microsecond)}
+	state.connectionQuality = qualityFromHealth(health)
+	state.qualityDetails = qualityDetails(health)
+	assert.Equal(t, "Connection quality: degraded (52ms, 25% loss)", state.connectionQualityText())
+}
+
+func TestServerLoadText(t *testing.T) {
+	category.Set(t, category.Unit)
+
+	state := trayState{vpnServerLoad: -1}
+	assert.Equal(t, "Server load: unknown", state.serverLoadText())
+
+	state.vpnServerLoad = 37
+	assert.Equal(t, "Server load: 37%", state.serverLoadText())
+}
@@ -2,19 +2,24 @@ package tray
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"os"
+	"os/exec"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/NordSecurity/nordvpn-linux/cli"
+	"github.com/NordSecurity/nordvpn-linux/config"
 	"github.com/NordSecurity/nordvpn-linux/daemon/pb"
 	filesharepb "github.com/NordSecurity/nordvpn-linux/fileshare/pb"
 	"github.com/NordSecurity/nordvpn-linux/internal"
 	"github.com/NordSecurity/nordvpn-linux/norduser"
-	"github.com/NordSecurity/nordvpn-linux/notify"
 
 	"github.com/NordSecurity/systray"
+	"github.com/hako/durafmt"
 )
 
 const (
@@ -22,7 +27,9 @@ const (
 	PollingUpdateInterval     = 5 * time.Second
 	PollingFullUpdateInterval = 60 * time.Second
 	AccountInfoUpdateInterval = 24 * time.Hour
+	StatusStreamRetryInterval = 5 * time.Second
 	ConnectedString           = "Connected"
+	AccountSettingsURL        = "https://my.nordaccount.com/dashboard/nordvpn/"
 )
 
 type Status int
@@ -59,6 +66,7 @@ func (ai *accountInfo) reset() {
 type Instance struct {
 	client           pb.DaemonClient
 	fileshareClient  filesharepb.FileshareClient
+	minimal          bool
 	accountInfo      accountInfo
 	debugMode        bool
 	notifier         dbusNotifier
@@ -67,8 +75,12 @@ type Instance struct {
 	updateChan       chan bool
 	iconConnected    string
 	iconDisconnected string
+	iconDegraded     string
 	state            trayState
 	quitChan         chan<- norduser.StopRequest
+	xembedProxy      *exec.Cmd
+	togglingVPN      atomic.Bool
+	onboardingMarker string
 }
 
 type trayState struct {
@@ -79,13 +91,35 @@ type trayState struct {
 	notificationsStatus Status
 	trayStatus          Status
 	daemonError         string
-	accountName         string
+	accountEmail        string
+	subscriptionStatus  string
+	incomingTransfers   []incomingTransfer
 	vpnStatus           string
 	vpnName             string
 	vpnHostname         string
 	vpnCity             string
 	vpnCountry          string
 	vpnVirtualLocation  bool
+	vpnTechnology       string
+	vpnProtocol         string
+	vpnIP               string
+	vpnUptime           time.Duration
+	vpnDownload         uint64
+	vpnUpload           uint64
+	vpnServerLoad       int64
+	vpnPausedUntil      time.Time
+	lastServerTag       string
+	lastServerGroup     string
+	captivePortal       string
+	connectionQuality   connectionQuality
+	qualityDetails      string
+	statusStreamActive  bool
+	trayIconTheme       config.TrayIconTheme
+	trayMenu            []string
+	recentIssues        issueLog
+	hotkeyEnabled       bool
+	onboardingStep      onboardingStep
+	colorScheme         colorScheme
 	mu                  sync.RWMutex
 }
 
@@ -97,14 +131,81 @@ func (state *trayState) serverName() string {
 	}
 	if vpnServerName != "" {
 		if state.vpnVirtualLocation {
-			vpnServerName += " - Virtual"
+			vpnServerName += MsgVirtualLocation
 		}
 	}
 	return vpnServerName
 }
 
-func NewTrayInstance(client pb.DaemonClient, fileshareClient filesharepb.FileshareClient, quitChan chan<- norduser.StopRequest) *Instance {
-	return &Instance{client: client, fileshareClient: fileshareClient, quitChan: quitChan}
+// Not thread safe. Lock mu before using
+func (state *trayState) tooltip() string {
+	if state.vpnStatus != ConnectedString {
+		return fmt.Sprintf(MsgTooltipStatus, strings.ToLower(state.vpnStatus))
+	}
+
+	lines := []string{MsgAppName, fmt.Sprintf(MsgConnectedTo, state.serverName())}
+	if state.vpnCity != "" && state.vpnCountry != "" {
+		lines = append(lines, state.vpnCity+", "+state.vpnCountry)
+	}
+	if state.vpnTechnology != "" {
+		lines = append(lines, state.connectionType())
+	}
+	if state.vpnIP != "" {
+		lines = append(lines, fmt.Sprintf(MenuIP, state.vpnIP))
+	}
+	if state.vpnUptime >= 0 {
+		lines = append(lines, fmt.Sprintf(MenuUptimeValue, state.uptime()))
+	}
+	lines = append(lines, state.transfer())
+	if state.vpnServerLoad >= 0 {
+		lines = append(lines, state.serverLoadText())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Not thread safe. Lock mu before using
+func (state *trayState) connectionType() string {
+	if state.vpnProtocol == "" {
+		return state.vpnTechnology
+	}
+	return fmt.Sprintf("%s (%s)", state.vpnTechnology, state.vpnProtocol)
+}
+
+// Not thread safe. Lock mu before using
+func (state *trayState) uptime() string {
+	// truncate to skip milliseconds from being displayed
+	return durafmt.Parse(state.vpnUptime.Truncate(time.Second)).LimitFirstN(2).String()
+}
+
+// Not thread safe. Lock mu before using
+func (state *trayState) serverLoadText() string {
+	if state.vpnServerLoad < 0 {
+		return fmt.Sprintf(MenuServerLoad, MsgServerLoadUnknown)
+	}
+	return fmt.Sprintf(MenuServerLoad, fmt.Sprintf("%d%%", state.vpnServerLoad))
+}
+
+// Not thread safe. Lock mu before using
+func (state *trayState) transfer() string {
+	return fmt.Sprintf(MsgTransferAmounts,
+		cli.Uint64ToHumanBytes(state.vpnDownload), cli.Uint64ToHumanBytes(state.vpnUpload))
+}
+
+// NewTrayInstance creates a tray. In minimal mode only the VPN status with connection controls is shown
+// and fileshareClient is not used, so it can be nil.
+func NewTrayInstance(
+	client pb.DaemonClient,
+	fileshareClient filesharepb.FileshareClient,
+	minimal bool,
+	quitChan chan<- norduser.StopRequest,
+) *Instance {
+	return &Instance{
+		client:          client,
+		fileshareClient: fileshareClient,
+		minimal:         minimal,
+		quitChan:        quitChan,
+		state:           trayState{trayMenu: config.DefaultTrayMenu},
+	}
 }
 
 func (ti *Instance) WaitInitialTrayStatus() Status {
@@ -121,44 +222,46 @@ func (ti *Instance) Start() {
 		ti.debugMode = false
 	}
 
-	ti.iconConnected = notify.GetIconPath("nordvpn-tray-blue")
-	ti.iconDisconnected = notify.GetIconPath("nordvpn-tray-white")
-
-	currentDesktop := strings.ToLower(os.Getenv("XDG_CURRENT_DESKTOP"))
-	if strings.Contains(currentDesktop, "kde") {
-		// TODO: Kubuntu uses dark tray background instead KDE default white
-		ti.iconDisconnected = notify.GetIconPath("nordvpn-tray-black")
-	}
-	if strings.Contains(currentDesktop, "mate") {
-		ti.iconDisconnected = notify.GetIconPath("nordvpn-tray-gray")
-	}
-
 	ti.state.vpnStatus = "Disconnected"
+	ti.updateIcons()
+	ti.state.vpnUptime = -1
+	ti.state.vpnServerLoad = -1
 	ti.state.notificationsStatus = Invalid
 	ti.redrawChan = make(chan struct{})
 	ti.initialChan = make(chan struct{})
 	ti.updateChan = make(chan bool)
 
+	if marker, err := onboardingMarkerPath(); err == nil {
+		ti.onboardingMarker = marker
+	} else {
+		log.Println(internal.WarningPrefix, "Onboarding is disabled:", err)
+	}
+
 	time.AfterFunc(NotifierStartDelay, func() { ti.notifier.start() })
 
 	go ti.pollingMonitor()
+	go ti.statusStreamMonitor()
+	go ti.colorSchemeMonitor()
+	go ti.connectionQualityMonitor()
+	go ti.hotkeyMonitor()
 }
 
 func (ti *Instance) OnExit() {
 	ti.state.mu.Lock()
 	ti.state.systrayRunning = false
 	ti.state.mu.Unlock()
+	ti.stopXEmbedProxy()
 }
 
 func (ti *Instance) OnReady() {
-	systray.SetTitle("NordVPN")
-	systray.SetTooltip("NordVPN")
+	systray.SetTitle(MsgAppName)
 
 	ti.state.mu.Lock()
+	systray.SetTooltip(ti.state.tooltip())
 	if ti.state.vpnStatus == "Disconnected" {
 		systray.SetIconName(ti.iconDisconnected)
 	} else {
-		systray.SetIconName(ti.iconConnected)
+		systray.SetIconName(ti.connectedIcon())
 	}
 	ti.state.systrayRunning = true
 	ti.state.mu.Unlock()
@@ -167,11 +270,18 @@ func (ti *Instance) OnReady() {
 		for {
 			ti.state.mu.RLock()
 			if ti.state.daemonAvailable {
-				if ti.state.loggedIn {
+				switch {
+				case !ti.minimal:
+					addOnboardingSection(ti)
+					addMenuSections(ti)
+				case ti.state.loggedIn:
 					addVpnSection(ti)
+				default:
+					addNotLoggedInItem()
+				}
+				if !ti.minimal {
+					addRecentIssuesSection(ti)
 				}
-				addSettingsSection(ti)
-				addAccountSection(ti)
 			}
 			if ti.state.daemonError != "" {
 				addDaemonErrorSection(ti)
@@ -137,6 +137,23 @@ func (t *Tunnel) Up() error {
 	return unix.IoctlIfreq(fd, unix.SIOCSIFFLAGS, req)
 }
 
+// SetMTU of the interface with the given name.
+func SetMTU(name string, mtu int) error {
+	fd, err := unix.Socket(syscall.AF_INET, syscall.SOCK_DGRAM, syscall.IPPROTO_IP)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(fd)
+
+	req, err := unix.NewIfreq(name)
+	if err != nil {
+		return err
+	}
+	req.SetUint32(uint32(mtu))
+
+	return unix.IoctlIfreq(fd, unix.SIOCSIFMTU, req)
+}
+
 // TransferRates collects data transfer statistics.
 func (t Tunnel) TransferRates() (Statistics, error) {
 	out, err := os.ReadFile("/sys/class/net/" + t.iface.Name + "/statistics/rx_bytes")